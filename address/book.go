@@ -46,6 +46,10 @@ type AddrWithKeyInfo struct {
 	// ManagedAfter is the time at which the address was imported into the
 	// wallet.
 	ManagedAfter time.Time
+
+	// Expectation is the optional deposit expectation the address was
+	// annotated with when it was created.
+	Expectation *DepositExpectation
 }
 
 // QueryParams holds the set of query params for the address book.
@@ -173,8 +177,8 @@ func NewBook(cfg BookConfig) *Book {
 
 // NewAddress creates a new Taproot Asset address based on the input parameters.
 func (b *Book) NewAddress(ctx context.Context, assetID asset.ID, amount uint64,
-	tapscriptSibling *commitment.TapscriptPreimage) (*AddrWithKeyInfo,
-	error) {
+	tapscriptSibling *commitment.TapscriptPreimage,
+	opts ...NewAddrOption) (*AddrWithKeyInfo, error) {
 
 	// Before we proceed and make new keys, make sure that we actually know
 	// of this asset ID already.
@@ -200,7 +204,7 @@ func (b *Book) NewAddress(ctx context.Context, assetID asset.ID, amount uint64,
 
 	return b.NewAddressWithKeys(
 		ctx, assetID, amount, scriptKey, internalKeyDesc,
-		tapscriptSibling,
+		tapscriptSibling, opts...,
 	)
 }
 
@@ -209,8 +213,30 @@ func (b *Book) NewAddress(ctx context.Context, assetID asset.ID, amount uint64,
 func (b *Book) NewAddressWithKeys(ctx context.Context, assetID asset.ID,
 	amount uint64, scriptKey asset.ScriptKey,
 	internalKeyDesc keychain.KeyDescriptor,
-	tapscriptSibling *commitment.TapscriptPreimage) (*AddrWithKeyInfo,
-	error) {
+	tapscriptSibling *commitment.TapscriptPreimage,
+	opts ...NewAddrOption) (*AddrWithKeyInfo, error) {
+
+	var options newAddrOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	// An address can only ever receive the amount it encodes, so an
+	// expectation for a different amount could never be met. A zero
+	// expected amount defaults to the amount of the address.
+	if options.expectation != nil {
+		switch options.expectation.Amount {
+		case 0:
+			options.expectation.Amount = amount
+
+		case amount:
+
+		default:
+			return nil, fmt.Errorf("expected deposit amount %d "+
+				"doesn't match address amount %d",
+				options.expectation.Amount, amount)
+		}
+	}
 
 	// Before we proceed, we'll make sure that the asset group is known to
 	// the local store. Otherwise, we can't make an address as we haven't
@@ -260,6 +286,7 @@ func (b *Book) NewAddressWithKeys(ctx context.Context, assetID asset.ID,
 		InternalKeyDesc:  internalKeyDesc,
 		TaprootOutputKey: *taprootOutputKey,
 		CreationTime:     time.Now(),
+		Expectation:      options.expectation,
 	}
 
 	if err := b.cfg.Store.InsertAddrs(ctx, addr); err != nil {
@@ -0,0 +1,88 @@
+package address
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+const (
+	// MaxCounterpartyNameLength is the maximum length of the name of a
+	// counterparty in bytes.
+	MaxCounterpartyNameLength = 64
+)
+
+var (
+	// ErrCounterpartyNotFound is returned when a counterparty can't be
+	// found in a counterparty book.
+	ErrCounterpartyNotFound = errors.New("counterparty not found")
+
+	// ErrInvalidCounterparty is returned when a counterparty is malformed.
+	ErrInvalidCounterparty = errors.New("invalid counterparty")
+)
+
+// Counterparty is a known receiver of outbound transfers. It maps a script key
+// that assets are sent to, usually the script key of a Taproot Asset address
+// handed out by the counterparty, to a human-readable name. A counterparty
+// that uses several addresses has one entry per script key, all with the same
+// name.
+type Counterparty struct {
+	// Name is the human-readable name of the counterparty.
+	Name string
+
+	// ScriptKey is the script key the counterparty receives assets on.
+	ScriptKey *btcec.PublicKey
+
+	// TapAddr is the encoded Taproot Asset address the script key was
+	// taken from, if the counterparty was added by address. This is
+	// informational only.
+	TapAddr string
+}
+
+// Validate makes sure the counterparty has a valid name and a script key.
+func (c *Counterparty) Validate() error {
+	switch {
+	case len(c.Name) == 0:
+		return fmt.Errorf("%w: empty name", ErrInvalidCounterparty)
+
+	case len(c.Name) > MaxCounterpartyNameLength:
+		return fmt.Errorf("%w: name exceeds %d bytes",
+			ErrInvalidCounterparty, MaxCounterpartyNameLength)
+
+	case strings.TrimSpace(c.Name) != c.Name:
+		return fmt.Errorf("%w: name %q has surrounding whitespace",
+			ErrInvalidCounterparty, c.Name)
+
+	case c.ScriptKey == nil:
+		return fmt.Errorf("%w: missing script key",
+			ErrInvalidCounterparty)
+	}
+
+	return nil
+}
+
+// CounterpartyBook is an address book that maps the script keys of known
+// counterparties to their names.
+type CounterpartyBook interface {
+	// CounterpartyByScriptKey returns the counterparty that receives
+	// assets on the given script key. If the script key isn't known,
+	// ErrCounterpartyNotFound is returned.
+	CounterpartyByScriptKey(ctx context.Context,
+		scriptKey *btcec.PublicKey) (*Counterparty, error)
+
+	// SetCounterparty adds the given counterparty, or renames it if its
+	// script key is already known.
+	SetCounterparty(ctx context.Context, counterparty *Counterparty) error
+
+	// DeleteCounterparty removes all script keys of the counterparty with
+	// the given name. If no such counterparty is known,
+	// ErrCounterpartyNotFound is returned.
+	DeleteCounterparty(ctx context.Context, name string) error
+
+	// ListCounterparties returns all counterparties of the book, ordered
+	// by name.
+	ListCounterparties(ctx context.Context) ([]*Counterparty, error)
+}
@@ -2,6 +2,7 @@ package address
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -34,6 +35,26 @@ const (
 	StatusCompleted Status = 3
 )
 
+// String returns a human-readable version of Status.
+func (s Status) String() string {
+	switch s {
+	case StatusTransactionDetected:
+		return "transaction_detected"
+
+	case StatusTransactionConfirmed:
+		return "transaction_confirmed"
+
+	case StatusProofReceived:
+		return "proof_received"
+
+	case StatusCompleted:
+		return "completed"
+
+	default:
+		return fmt.Sprintf("<unknown_status(%d)>", s)
+	}
+}
+
 // EventQueryParams holds the set of query params for address events.
 type EventQueryParams struct {
 	// AddrTaprootOutputKey is the optional 32-byte x-only serialized
@@ -93,6 +114,12 @@ type Event struct {
 	// don't keep a reference to it in memory as the proof itself can be
 	// large. The proof can be fetched by the script key of the address.
 	HasProof bool
+
+	// DepositStatus describes how the inbound transfer compares to the
+	// deposit expectation of the address, if it was annotated with one.
+	// The transfer is considered to have arrived at the creation time of
+	// the event, which is when it was first detected.
+	DepositStatus DepositStatus
 }
 
 // EventStorage is the interface that a component storing address events should
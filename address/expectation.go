@@ -0,0 +1,86 @@
+package address
+
+import (
+	"fmt"
+	"time"
+)
+
+// DepositStatus describes how an inbound transfer to an address compares to
+// the deposit expectation the address was annotated with.
+type DepositStatus uint8
+
+const (
+	// DepositUnannotated indicates that the address wasn't annotated with
+	// a deposit expectation, so no comparison can be made.
+	DepositUnannotated DepositStatus = 0
+
+	// DepositExpected indicates that the deposit arrived before the
+	// expiry.
+	DepositExpected DepositStatus = 1
+
+	// DepositLate indicates that the deposit arrived after the expiry.
+	DepositLate DepositStatus = 2
+)
+
+// String returns a human-readable version of DepositStatus.
+func (s DepositStatus) String() string {
+	switch s {
+	case DepositUnannotated:
+		return "unannotated"
+
+	case DepositExpected:
+		return "expected"
+
+	case DepositLate:
+		return "late"
+
+	default:
+		return fmt.Sprintf("<unknown_deposit_status(%d)>", s)
+	}
+}
+
+// DepositExpectation is an optional annotation of an address that describes
+// the deposit the address is expected to receive as part of a larger
+// workflow, for example an exchange trade.
+type DepositExpectation struct {
+	// Amount is the asset amount the address is expected to receive. This
+	// must match the amount of the address itself.
+	Amount uint64
+
+	// Expiry is the time after which a deposit is considered late. A zero
+	// value means the expectation never expires.
+	Expiry time.Time
+}
+
+// Check classifies a deposit that was detected at the given time against the
+// expectation. An address can only ever receive the amount it encodes, so only
+// the arrival time can make a deposit deviate from the expectation.
+func (d *DepositExpectation) Check(detectedAt time.Time) DepositStatus {
+	if d == nil {
+		return DepositUnannotated
+	}
+
+	if !d.Expiry.IsZero() && detectedAt.After(d.Expiry) {
+		return DepositLate
+	}
+
+	return DepositExpected
+}
+
+// NewAddrOption is a functional option that allows callers to modify an
+// address before it is created.
+type NewAddrOption func(*newAddrOptions)
+
+// newAddrOptions is the set of options that can be set when creating a new
+// address.
+type newAddrOptions struct {
+	expectation *DepositExpectation
+}
+
+// WithDepositExpectation annotates the new address with the given deposit
+// expectation, so that late deposits can be flagged.
+func WithDepositExpectation(expectation DepositExpectation) NewAddrOption {
+	return func(o *newAddrOptions) {
+		o.expectation = &expectation
+	}
+}
@@ -0,0 +1,65 @@
+package address
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestDepositExpectationCheck tests that deposits are classified correctly
+// against a deposit expectation.
+func TestDepositExpectationCheck(t *testing.T) {
+	t.Parallel()
+
+	expiry := time.Unix(1690000000, 0)
+	expectation := &DepositExpectation{
+		Amount: 100,
+		Expiry: expiry,
+	}
+	noExpiry := &DepositExpectation{
+		Amount: 100,
+	}
+
+	before := expiry.Add(-time.Second)
+	after := expiry.Add(time.Second)
+
+	testCases := []struct {
+		name        string
+		expectation *DepositExpectation
+		detectedAt  time.Time
+		expected    DepositStatus
+	}{{
+		name:       "unannotated",
+		detectedAt: after,
+		expected:   DepositUnannotated,
+	}, {
+		name:        "expected",
+		expectation: expectation,
+		detectedAt:  before,
+		expected:    DepositExpected,
+	}, {
+		name:        "at expiry",
+		expectation: expectation,
+		detectedAt:  expiry,
+		expected:    DepositExpected,
+	}, {
+		name:        "late",
+		expectation: expectation,
+		detectedAt:  after,
+		expected:    DepositLate,
+	}, {
+		name:        "no expiry",
+		expectation: noExpiry,
+		detectedAt:  after,
+		expected:    DepositExpected,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			status := tc.expectation.Check(tc.detectedAt)
+			require.Equal(t, tc.expected, status)
+		})
+	}
+}
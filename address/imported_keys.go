@@ -0,0 +1,83 @@
+package address
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taproot-assets/asset"
+)
+
+// ErrImportedKeyNotFound is returned when a script key wasn't imported.
+var ErrImportedKeyNotFound = errors.New("imported script key not found")
+
+// ImportedScriptKey is a script key that was derived outside of the wallet,
+// for example by a legacy system or another wallet, and imported so assets
+// sent to it are recognized as our own.
+type ImportedScriptKey struct {
+	// ScriptKey is the imported script key, including its internal key and
+	// tweak.
+	ScriptKey asset.ScriptKey
+
+	// ImportedAt is the time the script key was imported.
+	ImportedAt time.Time
+}
+
+// Validate makes sure the internal key and tweak of the imported script key
+// derive the script key itself. As script keys are often only known in their
+// x-only form, the script key is replaced with the derived key, which has the
+// parity that is committed to in the assets sent to it.
+func (i *ImportedScriptKey) Validate() error {
+	scriptKey := &i.ScriptKey
+	if scriptKey.PubKey == nil || scriptKey.TweakedScriptKey == nil ||
+		scriptKey.RawKey.PubKey == nil {
+
+		return fmt.Errorf("script key and internal key must be set")
+	}
+
+	var derivedKey *btcec.PublicKey
+	if len(scriptKey.Tweak) == 0 {
+		derivedKey = txscript.ComputeTaprootKeyNoScript(
+			scriptKey.RawKey.PubKey,
+		)
+	} else {
+		derivedKey = txscript.ComputeTaprootOutputKey(
+			scriptKey.RawKey.PubKey, scriptKey.Tweak,
+		)
+	}
+
+	if !bytes.Equal(
+		schnorr.SerializePubKey(derivedKey),
+		schnorr.SerializePubKey(scriptKey.PubKey),
+	) {
+		return asset.ErrScriptKeyMismatch
+	}
+
+	scriptKey.PubKey = derivedKey
+
+	return nil
+}
+
+// ImportedKeyStore is the persistent set of externally derived script keys
+// that are treated as local script keys.
+type ImportedKeyStore interface {
+	// ImportScriptKey adds the given script key to the set of imported
+	// script keys. Importing a script key twice is a no-op.
+	ImportScriptKey(ctx context.Context, key *ImportedScriptKey) error
+
+	// FetchImportedScriptKey returns the imported script key with the
+	// given tweaked key. If the script key wasn't imported,
+	// ErrImportedKeyNotFound is returned.
+	FetchImportedScriptKey(ctx context.Context,
+		scriptKey *btcec.PublicKey) (*ImportedScriptKey, error)
+
+	// ListImportedScriptKeys returns all imported script keys, ordered by
+	// the time they were imported.
+	ListImportedScriptKeys(ctx context.Context) ([]*ImportedScriptKey,
+		error)
+}
@@ -15,6 +15,11 @@ const (
 	// TestnetHRP is the HRP for testnet.
 	TestnetHRP = "taptb"
 
+	// TestNet4HRP is the HRP for testnet4. Like the one of signet, it is
+	// shared with testnet3, so an address doesn't tell which of the test
+	// networks it belongs to.
+	TestNet4HRP = "taptb"
+
 	// RegTestHRP is the HRP for regtest.
 	RegTestHRP = "taprt"
 
@@ -78,6 +83,12 @@ var (
 		TapHRP: TestnetHRP,
 	}
 
+	// TestNet4Tap holds the chain params for testnet4.
+	TestNet4Tap = ChainParams{
+		Params: &TestNet4Params,
+		TapHRP: TestNet4HRP,
+	}
+
 	// RegressionNetTap holds the chain params for regtest.
 	RegressionNetTap = ChainParams{
 		Params: &chaincfg.RegressionNetParams,
@@ -115,7 +126,9 @@ func IsForNet(hrp string, net *ChainParams) bool {
 	return hrp == net.TapHRP
 }
 
-// Net returns the ChainParams struct associated with a Taproot Asset HRP.
+// Net returns the ChainParams struct associated with a Taproot Asset HRP. The
+// test networks share a single HRP, for which the testnet3 params are
+// returned.
 func Net(hrp string) (*ChainParams, error) {
 	switch hrp {
 	case MainNetTap.TapHRP:
@@ -155,6 +168,9 @@ func ParamsForChain(name string) ChainParams {
 	case chaincfg.TestNet3Params.Name:
 		return TestNet3Tap
 
+	case TestNet4Params.Name:
+		return TestNet4Tap
+
 	case chaincfg.RegressionNetParams.Name:
 		return RegressionNetTap
 
@@ -182,6 +198,7 @@ func init() {
 	// Register all default networks when the package is initialized.
 	bech32TapPrefixes[MainNetTap.TapHRP+"1"] = struct{}{}
 	bech32TapPrefixes[TestNet3Tap.TapHRP+"1"] = struct{}{}
+	bech32TapPrefixes[TestNet4Tap.TapHRP+"1"] = struct{}{}
 	bech32TapPrefixes[RegressionNetTap.TapHRP+"1"] = struct{}{}
 	bech32TapPrefixes[SigNetTap.TapHRP+"1"] = struct{}{}
 	bech32TapPrefixes[SimNetTap.TapHRP+"1"] = struct{}{}
@@ -0,0 +1,99 @@
+package address
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// testNet4Net is the network magic of testnet4.
+	testNet4Net wire.BitcoinNet = 0x283f161c
+
+	// testNet4GenesisMessage is the message embedded in the coinbase of
+	// the testnet4 genesis block.
+	testNet4GenesisMessage = "03/May/2024 000000000000000000001ebd58c244" +
+		"970b3aa9d783bb001011fbe8ea8e98e00e"
+)
+
+var (
+	// testNet4GenesisBlock is the genesis block of testnet4.
+	testNet4GenesisBlock = newTestNet4GenesisBlock()
+
+	// testNet4GenesisHash is the hash of the testnet4 genesis block.
+	testNet4GenesisHash = testNet4GenesisBlock.BlockHash()
+
+	// TestNet4Params holds the chain params of testnet4 (BIP-0094), which
+	// aren't part of the chaincfg package yet. Apart from its genesis
+	// block, its network magic and its seeds, testnet4 shares the
+	// parameters of testnet3.
+	TestNet4Params = newTestNet4Params()
+)
+
+// newTestNet4GenesisBlock creates the genesis block of testnet4.
+func newTestNet4GenesisBlock() wire.MsgBlock {
+	// The signature script pushes the difficulty bits, the number 4 and
+	// the genesis message, just like the one of the mainnet genesis block.
+	sigScript := []byte{0x04, 0xff, 0xff, 0x00, 0x1d, 0x01, 0x04, 0x4c}
+	sigScript = append(sigScript, byte(len(testNet4GenesisMessage)))
+	sigScript = append(sigScript, testNet4GenesisMessage...)
+
+	// The coinbase output pays to a pay-to-pubkey script of an all-zero
+	// public key, so it can't be spent.
+	pkScript := make([]byte, 0, 35)
+	pkScript = append(pkScript, 0x21)
+	pkScript = append(pkScript, make([]byte, 33)...)
+	pkScript = append(pkScript, 0xac)
+
+	coinbase := wire.NewMsgTx(1)
+	coinbase.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Index: wire.MaxPrevOutIndex,
+		},
+		SignatureScript: sigScript,
+		Sequence:        wire.MaxTxInSequenceNum,
+	})
+	coinbase.AddTxOut(&wire.TxOut{
+		Value:    50 * 1e8,
+		PkScript: pkScript,
+	})
+
+	return wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Version:    1,
+			PrevBlock:  chainhash.Hash{},
+			MerkleRoot: coinbase.TxHash(),
+			Timestamp:  time.Unix(1714777860, 0),
+			Bits:       0x1d00ffff,
+			Nonce:      393743547,
+		},
+		Transactions: []*wire.MsgTx{coinbase},
+	}
+}
+
+// newTestNet4Params creates the chain params of testnet4.
+func newTestNet4Params() chaincfg.Params {
+	params := chaincfg.TestNet3Params
+	params.Name = "testnet4"
+	params.Net = testNet4Net
+	params.DefaultPort = "48333"
+	params.DNSSeeds = []chaincfg.DNSSeed{{
+		Host:         "seed.testnet4.bitcoin.sprovoost.nl",
+		HasFiltering: true,
+	}, {
+		Host:         "seed.testnet4.wiz.biz",
+		HasFiltering: true,
+	}}
+	params.GenesisBlock = &testNet4GenesisBlock
+	params.GenesisHash = &testNet4GenesisHash
+
+	// All soft forks are active from the start on testnet4.
+	params.BIP0034Height = 1
+	params.BIP0065Height = 1
+	params.BIP0066Height = 1
+	params.Checkpoints = nil
+
+	return params
+}
@@ -0,0 +1,43 @@
+package address
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTestNet4Params tests that the testnet4 genesis block matches the one of
+// the actual network and that addresses can be used on testnet4.
+func TestTestNet4Params(t *testing.T) {
+	t.Parallel()
+
+	genesis := TestNet4Params.GenesisBlock
+	require.Equal(
+		t, "7aa0a7ae1e223414cb807e40cd57e667b718e42aaf9306db9102fe2891"+
+			"2b7b4e", genesis.Header.MerkleRoot.String(),
+	)
+	require.Equal(
+		t, "00000000da84f2bafbbc53dee25a72ae507ff4914b867c565be350b0da"+
+			"8bf043", TestNet4Params.GenesisHash.String(),
+	)
+	require.Equal(t, genesis.BlockHash(), *TestNet4Params.GenesisHash)
+
+	// The testnet3 params must not be modified by deriving the testnet4
+	// params from them.
+	testNet3 := ParamsForChain(TestNet3Tap.Name)
+	require.NotEqual(t, testNet3.GenesisHash, TestNet4Params.GenesisHash)
+	require.NotEmpty(t, testNet3.Checkpoints)
+
+	testNet4 := ParamsForChain(TestNet4Params.Name)
+	require.Equal(t, TestNet4Tap, testNet4)
+
+	newAddr, encoded, err := randEncodedAddress(
+		t, &testNet4, false, false, asset.Normal,
+	)
+	require.NoError(t, err)
+
+	decoded, err := DecodeAddress(encoded, &testNet4)
+	require.NoError(t, err)
+	assertAddressEqual(t, newAddr, decoded)
+}
@@ -0,0 +1,311 @@
+package asset
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+const (
+	// MaxAliasLength is the maximum length of an asset alias in bytes.
+	MaxAliasLength = 64
+)
+
+var (
+	// ErrAliasNotFound is returned when an alias or the alias of an asset
+	// can't be found in an alias registry.
+	ErrAliasNotFound = errors.New("asset alias not found")
+
+	// ErrInvalidAlias is returned when an alias is malformed.
+	ErrInvalidAlias = errors.New("invalid asset alias")
+)
+
+// AliasSource denotes where an alias was resolved from.
+type AliasSource uint8
+
+const (
+	// AliasSourceLocal denotes an alias that was set by the operator of the
+	// local node.
+	AliasSourceLocal AliasSource = 0
+
+	// AliasSourceRemote denotes an alias that was resolved through a
+	// remote registry.
+	AliasSourceRemote AliasSource = 1
+)
+
+// String returns a human-readable description of the alias source.
+func (s AliasSource) String() string {
+	switch s {
+	case AliasSourceLocal:
+		return "local"
+
+	case AliasSourceRemote:
+		return "remote"
+
+	default:
+		return fmt.Sprintf("<unknown(%d)>", s)
+	}
+}
+
+// Alias is a stable, human-readable name for an asset ID or an asset group.
+// Unlike the tag of an asset, which is chosen by its issuer and doesn't need
+// to be unique, an alias maps to exactly one asset ID or group key and each
+// asset ID or group key has at most one alias within a registry.
+//
+// NOTE: Aliases aren't committed to on-chain. Only aliases set by the
+// operator of the local node are considered verified, as anybody can claim
+// any name in a remote registry.
+type Alias struct {
+	// Name is the normalized name of the alias.
+	Name string
+
+	// AssetID is the ID of the asset the alias refers to. This is nil if
+	// the alias refers to an asset group.
+	AssetID *ID
+
+	// GroupKey is the tweaked group key of the asset group the alias
+	// refers to. This is nil if the alias refers to a single asset ID.
+	GroupKey *btcec.PublicKey
+
+	// Source is where the alias was resolved from.
+	Source AliasSource
+}
+
+// Unverified returns true if the alias wasn't set by the operator of the local
+// node and must therefore not be trusted blindly.
+func (a *Alias) Unverified() bool {
+	return a.Source != AliasSourceLocal
+}
+
+// Validate makes sure the alias has a valid name and refers to exactly one of
+// an asset ID or group key.
+func (a *Alias) Validate() error {
+	if err := ValidateAliasName(a.Name); err != nil {
+		return err
+	}
+
+	if (a.AssetID == nil) == (a.GroupKey == nil) {
+		return fmt.Errorf("%w: exactly one of asset ID or group key "+
+			"must be set", ErrInvalidAlias)
+	}
+
+	return nil
+}
+
+// NormalizeAliasName returns the canonical form of the given alias name.
+// Aliases are case-insensitive and surrounding whitespace is ignored.
+func NormalizeAliasName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// ValidateAliasName makes sure the given alias name is normalized, not longer
+// than MaxAliasLength and only consists of lowercase letters, digits, dashes,
+// underscores and dots. The name must start with a letter or digit, so it
+// can't be confused with a hex encoded asset ID or group key.
+func ValidateAliasName(name string) error {
+	switch {
+	case len(name) == 0:
+		return fmt.Errorf("%w: empty name", ErrInvalidAlias)
+
+	case len(name) > MaxAliasLength:
+		return fmt.Errorf("%w: name exceeds %d bytes", ErrInvalidAlias,
+			MaxAliasLength)
+
+	case NormalizeAliasName(name) != name:
+		return fmt.Errorf("%w: name %q not normalized", ErrInvalidAlias,
+			name)
+	}
+
+	for idx, c := range name {
+		isAlphaNum := (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9')
+		isSeparator := c == '-' || c == '_' || c == '.'
+		if isAlphaNum || (isSeparator && idx > 0) {
+			continue
+		}
+
+		return fmt.Errorf("%w: invalid character %q in name %q",
+			ErrInvalidAlias, c, name)
+	}
+
+	// An alias that could be parsed as an asset ID or a compressed group
+	// key would be ambiguous when passed in place of one.
+	isHex := strings.Trim(name, "0123456789abcdef") == ""
+	if isHex && (len(name) == 64 || len(name) == 66) {
+		return fmt.Errorf("%w: name %q is indistinguishable from an "+
+			"asset ID or group key", ErrInvalidAlias, name)
+	}
+
+	return nil
+}
+
+// AliasRegistry is a registry that maps human-readable aliases to asset IDs
+// and group keys.
+type AliasRegistry interface {
+	// ResolveAlias returns the alias with the given normalized name. If
+	// the alias isn't known, ErrAliasNotFound is returned.
+	ResolveAlias(ctx context.Context, name string) (*Alias, error)
+
+	// AssetAlias returns the alias of the asset with the given ID. If the
+	// asset ID has no alias but the given group key does, the alias of
+	// the group is returned. The group key may be nil. If neither has an
+	// alias, ErrAliasNotFound is returned.
+	AssetAlias(ctx context.Context, id ID,
+		groupKey *btcec.PublicKey) (*Alias, error)
+
+	// GroupAlias returns the alias of the asset group with the given group
+	// key. If the group has no alias, ErrAliasNotFound is returned.
+	GroupAlias(ctx context.Context,
+		groupKey *btcec.PublicKey) (*Alias, error)
+}
+
+// AliasStore is an alias registry the aliases of which can be modified.
+type AliasStore interface {
+	AliasRegistry
+
+	// SetAlias adds the given alias, or updates the asset ID or group key
+	// it refers to if it already exists. Any other alias of the same asset
+	// ID or group key is replaced.
+	SetAlias(ctx context.Context, alias *Alias) error
+
+	// DeleteAlias removes the alias with the given normalized name. If the
+	// alias isn't known, ErrAliasNotFound is returned.
+	DeleteAlias(ctx context.Context, name string) error
+
+	// ListAliases returns all aliases of the store, ordered by name.
+	ListAliases(ctx context.Context) ([]*Alias, error)
+}
+
+// AliasResolver resolves aliases through the local alias store first and then
+// through an optional remote registry. All aliases returned from the remote
+// registry are marked as remote, and therefore unverified.
+type AliasResolver struct {
+	local  AliasStore
+	remote AliasRegistry
+}
+
+// NewAliasResolver creates a new alias resolver from the given local store and
+// remote registry. The remote registry may be nil.
+func NewAliasResolver(local AliasStore, remote AliasRegistry) *AliasResolver {
+	return &AliasResolver{
+		local:  local,
+		remote: remote,
+	}
+}
+
+// Local returns the local alias store of the resolver.
+func (r *AliasResolver) Local() AliasStore {
+	return r.local
+}
+
+// resolve calls the given lookup function on the local store and, if the
+// alias wasn't found there, on the remote registry.
+func (r *AliasResolver) resolve(
+	lookup func(AliasRegistry) (*Alias, error)) (*Alias, error) {
+
+	alias, err := lookup(r.local)
+	switch {
+	case err == nil:
+		alias.Source = AliasSourceLocal
+		return alias, nil
+
+	case !errors.Is(err, ErrAliasNotFound) || r.remote == nil:
+		return nil, err
+	}
+
+	alias, err = lookup(r.remote)
+	if err != nil {
+		return nil, err
+	}
+
+	// We don't trust the remote registry to return well-formed aliases.
+	if err := alias.Validate(); err != nil {
+		return nil, fmt.Errorf("remote registry returned invalid "+
+			"alias: %w", err)
+	}
+	alias.Source = AliasSourceRemote
+
+	return alias, nil
+}
+
+// ResolveAlias returns the alias with the given name.
+//
+// NOTE: This is part of the AliasRegistry interface.
+func (r *AliasResolver) ResolveAlias(ctx context.Context,
+	name string) (*Alias, error) {
+
+	name = NormalizeAliasName(name)
+	if err := ValidateAliasName(name); err != nil {
+		return nil, err
+	}
+
+	alias, err := r.resolve(func(registry AliasRegistry) (*Alias, error) {
+		return registry.ResolveAlias(ctx, name)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// A remote registry must not resolve a different name than the one
+	// we asked for.
+	if alias.Name != name {
+		return nil, fmt.Errorf("%w: expected alias %q, got %q",
+			ErrInvalidAlias, name, alias.Name)
+	}
+
+	return alias, nil
+}
+
+// AssetAlias returns the alias of the asset with the given ID or group key.
+//
+// NOTE: This is part of the AliasRegistry interface.
+func (r *AliasResolver) AssetAlias(ctx context.Context, id ID,
+	groupKey *btcec.PublicKey) (*Alias, error) {
+
+	alias, err := r.resolve(func(registry AliasRegistry) (*Alias, error) {
+		return registry.AssetAlias(ctx, id, groupKey)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The alias must refer to either the asset ID or group key we asked
+	// for.
+	matchesID := alias.AssetID != nil && *alias.AssetID == id
+	matchesGroup := alias.GroupKey != nil && groupKey != nil &&
+		alias.GroupKey.IsEqual(groupKey)
+	if !matchesID && !matchesGroup {
+		return nil, fmt.Errorf("%w: alias %q doesn't refer to asset "+
+			"%v", ErrInvalidAlias, alias.Name, id)
+	}
+
+	return alias, nil
+}
+
+// GroupAlias returns the alias of the asset group with the given group key.
+//
+// NOTE: This is part of the AliasRegistry interface.
+func (r *AliasResolver) GroupAlias(ctx context.Context,
+	groupKey *btcec.PublicKey) (*Alias, error) {
+
+	alias, err := r.resolve(func(registry AliasRegistry) (*Alias, error) {
+		return registry.GroupAlias(ctx, groupKey)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if alias.GroupKey == nil || !alias.GroupKey.IsEqual(groupKey) {
+		return nil, fmt.Errorf("%w: alias %q doesn't refer to group "+
+			"%x", ErrInvalidAlias, alias.Name,
+			groupKey.SerializeCompressed())
+	}
+
+	return alias, nil
+}
+
+// A compile-time assertion to ensure that AliasResolver meets the
+// AliasRegistry interface.
+var _ AliasRegistry = (*AliasResolver)(nil)
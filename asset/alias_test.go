@@ -0,0 +1,189 @@
+package asset
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAliasStore is a simple in-memory alias store.
+type mockAliasStore struct {
+	aliases map[string]*Alias
+}
+
+func newMockAliasStore(aliases ...*Alias) *mockAliasStore {
+	store := &mockAliasStore{
+		aliases: make(map[string]*Alias),
+	}
+	for _, alias := range aliases {
+		store.aliases[alias.Name] = alias
+	}
+
+	return store
+}
+
+func (m *mockAliasStore) ResolveAlias(_ context.Context,
+	name string) (*Alias, error) {
+
+	alias, ok := m.aliases[name]
+	if !ok {
+		return nil, ErrAliasNotFound
+	}
+
+	aliasCopy := *alias
+	return &aliasCopy, nil
+}
+
+func (m *mockAliasStore) AssetAlias(ctx context.Context, id ID,
+	groupKey *btcec.PublicKey) (*Alias, error) {
+
+	for _, alias := range m.aliases {
+		if alias.AssetID != nil && *alias.AssetID == id {
+			aliasCopy := *alias
+			return &aliasCopy, nil
+		}
+	}
+
+	if groupKey == nil {
+		return nil, ErrAliasNotFound
+	}
+
+	return m.GroupAlias(ctx, groupKey)
+}
+
+func (m *mockAliasStore) GroupAlias(_ context.Context,
+	groupKey *btcec.PublicKey) (*Alias, error) {
+
+	for _, alias := range m.aliases {
+		if alias.GroupKey != nil && alias.GroupKey.IsEqual(groupKey) {
+			aliasCopy := *alias
+			return &aliasCopy, nil
+		}
+	}
+
+	return nil, ErrAliasNotFound
+}
+
+func (m *mockAliasStore) SetAlias(_ context.Context, alias *Alias) error {
+	m.aliases[alias.Name] = alias
+	return nil
+}
+
+func (m *mockAliasStore) DeleteAlias(_ context.Context, name string) error {
+	delete(m.aliases, name)
+	return nil
+}
+
+func (m *mockAliasStore) ListAliases(context.Context) ([]*Alias, error) {
+	var aliases []*Alias
+	for _, alias := range m.aliases {
+		aliases = append(aliases, alias)
+	}
+
+	return aliases, nil
+}
+
+// TestValidateAliasName tests the validation of alias names.
+func TestValidateAliasName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		valid bool
+	}{
+		{name: "usd", valid: true},
+		{name: "usd-coin.v2_test", valid: true},
+		{name: "0xusd", valid: true},
+		{name: strings.Repeat("z", MaxAliasLength), valid: true},
+		{name: strings.Repeat("a", 63) + "g", valid: true},
+		{name: ""},
+		{name: strings.Repeat("a", MaxAliasLength+1)},
+		{name: "USD"},
+		{name: " usd"},
+		{name: "-usd"},
+		{name: "us d"},
+		{name: "usd/eur"},
+		{name: "üsd"},
+		{name: strings.Repeat("ab", 32)},
+		{name: "02" + strings.Repeat("ab", 32)},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateAliasName(tc.name)
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+			continue
+		}
+
+		require.ErrorIs(t, err, ErrInvalidAlias, tc.name)
+	}
+
+	require.Equal(t, "usd", NormalizeAliasName(" USD "))
+}
+
+// TestAliasResolver tests that the alias resolver prefers local aliases and
+// marks aliases of the remote registry as unverified.
+func TestAliasResolver(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	localID := RandID(t)
+	remoteID := RandID(t)
+	groupKey := test.RandPubKey(t)
+
+	local := newMockAliasStore(&Alias{
+		Name:    "usd",
+		AssetID: &localID,
+	})
+	remote := newMockAliasStore(&Alias{
+		Name:    "usd",
+		AssetID: &remoteID,
+	}, &Alias{
+		Name:     "eur",
+		GroupKey: groupKey,
+	}, &Alias{
+		Name: "broken",
+	})
+
+	// Without a remote registry, only local aliases are resolved.
+	resolver := NewAliasResolver(local, nil)
+	alias, err := resolver.ResolveAlias(ctx, " USD")
+	require.NoError(t, err)
+	require.Equal(t, localID, *alias.AssetID)
+	require.False(t, alias.Unverified())
+
+	_, err = resolver.ResolveAlias(ctx, "eur")
+	require.ErrorIs(t, err, ErrAliasNotFound)
+
+	// With a remote registry, local aliases still take precedence, but
+	// aliases only known remotely are resolved as unverified.
+	resolver = NewAliasResolver(local, remote)
+	alias, err = resolver.ResolveAlias(ctx, "usd")
+	require.NoError(t, err)
+	require.Equal(t, localID, *alias.AssetID)
+	require.Equal(t, AliasSourceLocal, alias.Source)
+
+	alias, err = resolver.ResolveAlias(ctx, "eur")
+	require.NoError(t, err)
+	require.True(t, alias.Unverified())
+	require.Equal(t, AliasSourceRemote, alias.Source)
+
+	alias, err = resolver.AssetAlias(ctx, RandID(t), groupKey)
+	require.NoError(t, err)
+	require.Equal(t, "eur", alias.Name)
+	require.True(t, alias.Unverified())
+
+	alias, err = resolver.AssetAlias(ctx, remoteID, nil)
+	require.NoError(t, err)
+	require.Equal(t, "usd", alias.Name)
+	require.True(t, alias.Unverified())
+
+	// Malformed aliases of the remote registry are rejected.
+	_, err = resolver.ResolveAlias(ctx, "broken")
+	require.ErrorIs(t, err, ErrInvalidAlias)
+}
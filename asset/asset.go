@@ -80,6 +80,11 @@ const (
 	// and to ensure compatibility with the remote signer.
 	TaprootAssetsKeyFamily = 212
 
+	// TaprootAssetsChangeKeyFamily is the key family of the separate
+	// account the script keys of asset change outputs can be derived from,
+	// so change can be recovered independently of all other keys.
+	TaprootAssetsChangeKeyFamily = 213
+
 	// V0 is the initial Taproot Asset protocol version.
 	V0 Version = 0
 )
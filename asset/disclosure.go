@@ -0,0 +1,105 @@
+package asset
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+var (
+	// ErrScriptKeyMismatch is returned if the script key of a disclosure
+	// can't be re-derived from the disclosed internal key and tweak.
+	ErrScriptKeyMismatch = errors.New("script key doesn't match internal " +
+		"key and tweak")
+)
+
+// ScriptKeyDisclosure is the information a third party auditor needs to
+// re-derive the script key of an asset output from its internal key. This
+// allows holdings to be audited without handing over any spending keys.
+type ScriptKeyDisclosure struct {
+	// AssetID is the ID of the asset held in the output.
+	AssetID ID
+
+	// Amount is the amount of the asset held in the output.
+	Amount uint64
+
+	// AnchorPoint is the outpoint of the BTC output the asset is anchored
+	// in.
+	AnchorPoint wire.OutPoint
+
+	// ScriptKey is the tweaked script key of the output.
+	ScriptKey *btcec.PublicKey
+
+	// InternalKey is the raw script key before the tweak is applied,
+	// together with its derivation information.
+	InternalKey keychain.KeyDescriptor
+
+	// Tweak is the tweak that is applied on the internal key to get the
+	// script key. If this is empty, then a BIP-0086 tweak is assumed.
+	Tweak []byte
+}
+
+// NewScriptKeyDisclosure creates the script key disclosure of an asset that is
+// anchored in the given outpoint. An error is returned if the internal key of
+// the asset's script key isn't known.
+func NewScriptKeyDisclosure(a *Asset,
+	anchorPoint wire.OutPoint) (*ScriptKeyDisclosure, error) {
+
+	if a.ScriptKey.TweakedScriptKey == nil ||
+		a.ScriptKey.RawKey.PubKey == nil {
+
+		return nil, fmt.Errorf("internal key of script key %x unknown",
+			schnorr.SerializePubKey(a.ScriptKey.PubKey))
+	}
+
+	var tweak []byte
+	if len(a.ScriptKey.Tweak) > 0 {
+		tweak = make([]byte, len(a.ScriptKey.Tweak))
+		copy(tweak, a.ScriptKey.Tweak)
+	}
+
+	return &ScriptKeyDisclosure{
+		AssetID:     a.ID(),
+		Amount:      a.Amount,
+		AnchorPoint: anchorPoint,
+		ScriptKey:   a.ScriptKey.PubKey,
+		InternalKey: a.ScriptKey.RawKey,
+		Tweak:       tweak,
+	}, nil
+}
+
+// Verify re-derives the script key from the disclosed internal key and tweak
+// and makes sure it matches the disclosed script key.
+func (d *ScriptKeyDisclosure) Verify() error {
+	if d.ScriptKey == nil || d.InternalKey.PubKey == nil {
+		return fmt.Errorf("script key and internal key must be set")
+	}
+
+	var derivedKey *btcec.PublicKey
+	if len(d.Tweak) == 0 {
+		derivedKey = txscript.ComputeTaprootKeyNoScript(
+			d.InternalKey.PubKey,
+		)
+	} else {
+		derivedKey = txscript.ComputeTaprootOutputKey(
+			d.InternalKey.PubKey, d.Tweak,
+		)
+	}
+
+	// Script keys are only ever committed to in their 32-byte x-only
+	// representation, so we don't care about the parity here.
+	if !bytes.Equal(
+		schnorr.SerializePubKey(derivedKey),
+		schnorr.SerializePubKey(d.ScriptKey),
+	) {
+		return ErrScriptKeyMismatch
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package asset
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestScriptKeyDisclosure tests that the script key of a disclosure can be
+// re-derived from the disclosed internal key and tweak only.
+func TestScriptKeyDisclosure(t *testing.T) {
+	t.Parallel()
+
+	internalKey := keychain.KeyDescriptor{
+		PubKey: test.RandPrivKey(t).PubKey(),
+		KeyLocator: keychain.KeyLocator{
+			Family: 212,
+			Index:  7,
+		},
+	}
+	anchorPoint := wire.OutPoint{Hash: test.RandHash(), Index: 1}
+
+	// A BIP-0086 script key is re-derived without a tweak.
+	a := RandAssetWithValues(
+		t, RandGenesis(t, Normal), nil, NewScriptKeyBip86(internalKey),
+	)
+	disclosure, err := NewScriptKeyDisclosure(a, anchorPoint)
+	require.NoError(t, err)
+	require.Equal(t, a.ID(), disclosure.AssetID)
+	require.Equal(t, a.Amount, disclosure.Amount)
+	require.Equal(t, anchorPoint, disclosure.AnchorPoint)
+	require.Equal(t, internalKey, disclosure.InternalKey)
+	require.Empty(t, disclosure.Tweak)
+	require.NoError(t, disclosure.Verify())
+
+	// A script key with a tapscript tweak needs the tweak to be disclosed
+	// as well.
+	tweak := test.RandBytes(32)
+	a.ScriptKey = ScriptKey{
+		PubKey: txscript.ComputeTaprootOutputKey(
+			internalKey.PubKey, tweak,
+		),
+		TweakedScriptKey: &TweakedScriptKey{
+			RawKey: internalKey,
+			Tweak:  tweak,
+		},
+	}
+	disclosure, err = NewScriptKeyDisclosure(a, anchorPoint)
+	require.NoError(t, err)
+	require.Equal(t, tweak, disclosure.Tweak)
+	require.NoError(t, disclosure.Verify())
+
+	// A wrong tweak or internal key is detected.
+	disclosure.Tweak = test.RandBytes(32)
+	require.ErrorIs(t, disclosure.Verify(), ErrScriptKeyMismatch)
+
+	disclosure.Tweak = tweak
+	disclosure.InternalKey.PubKey = test.RandPrivKey(t).PubKey()
+	require.ErrorIs(t, disclosure.Verify(), ErrScriptKeyMismatch)
+
+	// Without the internal key, no disclosure can be created.
+	a.ScriptKey = RandScriptKey(t)
+	_, err = NewScriptKeyDisclosure(a, anchorPoint)
+	require.ErrorContains(t, err, "internal key")
+}
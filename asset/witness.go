@@ -0,0 +1,180 @@
+package asset
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// KeySpendSigSize returns the size of a schnorr signature of a taproot key
+// spend with the given sighash type. Signatures with the default sighash type
+// omit the sighash flag.
+func KeySpendSigSize(sigHash txscript.SigHashType) int {
+	if sigHash == txscript.SigHashDefault {
+		return schnorr.SignatureSize
+	}
+
+	return schnorr.SignatureSize + 1
+}
+
+// KeySpendWitnessSize returns the serialized size of the witness stack of a
+// taproot key spend with the given sighash type. As witness data isn't
+// discounted any further, this is also the weight the witness contributes to a
+// segwit transaction.
+func KeySpendWitnessSize(sigHash txscript.SigHashType) int {
+	return keySpendPlaceholder(sigHash).SerializeSize()
+}
+
+// keySpendPlaceholder returns a key spend witness stack with a zero signature
+// of the size a real signature with the given sighash type would have.
+func keySpendPlaceholder(sigHash txscript.SigHashType) wire.TxWitness {
+	return wire.TxWitness{make([]byte, KeySpendSigSize(sigHash))}
+}
+
+// IsGenesis returns true if the witness is the witness of a newly minted
+// asset, which has a zero PrevID and neither a witness stack nor a split
+// commitment.
+func (w *Witness) IsGenesis() bool {
+	return w.PrevID != nil && *w.PrevID == ZeroPrevID &&
+		len(w.TxWitness) == 0 && w.SplitCommitment == nil
+}
+
+// IsSplitCommit returns true if the witness spends a split output by proving
+// its inclusion in the split commitment of a root asset.
+func (w *Witness) IsSplitCommit() bool {
+	return w.SplitCommitment != nil
+}
+
+// IsUnsigned returns true if the witness references a previous input but
+// neither a witness stack nor a split commitment has been added to it yet.
+func (w *Witness) IsUnsigned() bool {
+	return w.PrevID != nil && *w.PrevID != ZeroPrevID &&
+		len(w.TxWitness) == 0 && w.SplitCommitment == nil
+}
+
+// HasAnnex returns true if the last item of the witness stack is an annex, as
+// defined in BIP-0341.
+func (w *Witness) HasAnnex() bool {
+	if len(w.TxWitness) < 2 {
+		return false
+	}
+
+	lastItem := w.TxWitness[len(w.TxWitness)-1]
+	return len(lastItem) > 0 && lastItem[0] == txscript.TaprootAnnexTag
+}
+
+// IsKeySpend returns true if the witness stack is a taproot key spend, which
+// consists of a single schnorr signature and an optional annex.
+func (w *Witness) IsKeySpend() bool {
+	stack := w.TxWitness
+	if w.HasAnnex() {
+		stack = stack[:len(stack)-1]
+	}
+
+	if len(stack) != 1 {
+		return false
+	}
+
+	sigLen := len(stack[0])
+	return sigLen == schnorr.SignatureSize ||
+		sigLen == schnorr.SignatureSize+1
+}
+
+// ScriptSpend returns the leaf script and control block of the witness stack
+// if it is a taproot script path spend.
+func (w *Witness) ScriptSpend() ([]byte, []byte, bool) {
+	stack := w.TxWitness
+	if w.HasAnnex() {
+		stack = stack[:len(stack)-1]
+	}
+
+	if len(stack) < 2 {
+		return nil, nil, false
+	}
+
+	controlBlock := stack[len(stack)-1]
+	if _, err := txscript.ParseControlBlock(controlBlock); err != nil {
+		return nil, nil, false
+	}
+
+	return stack[len(stack)-2], controlBlock, true
+}
+
+// EncodedSize returns the size of the witness when encoded as a TLV stream.
+func (w *Witness) EncodedSize() (uint64, error) {
+	var buf bytes.Buffer
+	if err := w.Encode(&buf); err != nil {
+		return 0, err
+	}
+
+	return uint64(buf.Len()), nil
+}
+
+// EstimateWitnessSize estimates the encoded size of the given witness once it
+// is signed. An unsigned witness is estimated as a key spend with the given
+// sighash type, the witness of a root asset within a split commitment is
+// estimated the same way.
+func EstimateWitnessSize(w *Witness,
+	sigHash txscript.SigHashType) (uint64, error) {
+
+	estimate := withPlaceholderWitness(w, sigHash)
+	size, err := estimate.EncodedSize()
+	if err != nil {
+		return 0, fmt.Errorf("unable to encode witness: %w", err)
+	}
+
+	return size, nil
+}
+
+// EstimateEncodedSize estimates the encoded size of the asset once all of its
+// witnesses are signed. Unsigned witnesses, including the witnesses of the
+// root asset of a split commitment, are estimated as key spends with the given
+// sighash type.
+func (a *Asset) EstimateEncodedSize(
+	sigHash txscript.SigHashType) (uint64, error) {
+
+	estimate := a.Copy()
+	for idx := range estimate.PrevWitnesses {
+		estimate.PrevWitnesses[idx] = *withPlaceholderWitness(
+			&estimate.PrevWitnesses[idx], sigHash,
+		)
+	}
+
+	var buf bytes.Buffer
+	if err := estimate.Encode(&buf); err != nil {
+		return 0, fmt.Errorf("unable to encode asset: %w", err)
+	}
+
+	return uint64(buf.Len()), nil
+}
+
+// withPlaceholderWitness returns a copy of the given witness with a
+// placeholder key spend signature in place of any missing signature. This
+// includes the witnesses of the root asset of a split commitment.
+func withPlaceholderWitness(w *Witness,
+	sigHash txscript.SigHashType) *Witness {
+
+	estimate := *w
+	if estimate.IsUnsigned() {
+		estimate.TxWitness = keySpendPlaceholder(sigHash)
+	}
+
+	if estimate.SplitCommitment != nil {
+		rootAsset := estimate.SplitCommitment.RootAsset.Copy()
+		for idx := range rootAsset.PrevWitnesses {
+			rootAsset.PrevWitnesses[idx] = *withPlaceholderWitness(
+				&rootAsset.PrevWitnesses[idx], sigHash,
+			)
+		}
+
+		estimate.SplitCommitment = &SplitCommitment{
+			Proof:     estimate.SplitCommitment.Proof,
+			RootAsset: *rootAsset,
+		}
+	}
+
+	return &estimate
+}
@@ -0,0 +1,159 @@
+package asset
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWitnessIntrospection tests the classification of asset witnesses.
+func TestWitnessIntrospection(t *testing.T) {
+	t.Parallel()
+
+	prevID := &PrevID{
+		OutPoint:  test.RandOp(t),
+		ID:        RandID(t),
+		ScriptKey: RandSerializedKey(t),
+	}
+
+	genesis := Witness{PrevID: &ZeroPrevID}
+	require.True(t, genesis.IsGenesis())
+	require.False(t, genesis.IsUnsigned())
+
+	unsigned := Witness{PrevID: prevID}
+	require.True(t, unsigned.IsUnsigned())
+	require.False(t, unsigned.IsGenesis())
+	require.False(t, unsigned.IsKeySpend())
+
+	keySpend := Witness{
+		PrevID:    prevID,
+		TxWitness: wire.TxWitness{make([]byte, schnorr.SignatureSize)},
+	}
+	require.True(t, keySpend.IsKeySpend())
+	require.False(t, keySpend.IsUnsigned())
+	require.False(t, keySpend.HasAnnex())
+	_, _, ok := keySpend.ScriptSpend()
+	require.False(t, ok)
+
+	// A key spend with an annex is still a key spend.
+	keySpend.TxWitness = append(
+		keySpend.TxWitness, []byte{txscript.TaprootAnnexTag, 0x01},
+	)
+	require.True(t, keySpend.HasAnnex())
+	require.True(t, keySpend.IsKeySpend())
+
+	// A script spend has the leaf script and control block as its last
+	// two items.
+	leafScript := []byte{txscript.OP_TRUE}
+	controlBlock := txscript.ControlBlock{
+		InternalKey: test.RandPubKey(t),
+		LeafVersion: txscript.BaseLeafVersion,
+	}
+	controlBlockBytes, err := controlBlock.ToBytes()
+	require.NoError(t, err)
+
+	scriptSpend := Witness{
+		PrevID:    prevID,
+		TxWitness: wire.TxWitness{leafScript, controlBlockBytes},
+	}
+	require.False(t, scriptSpend.IsKeySpend())
+	script, cb, ok := scriptSpend.ScriptSpend()
+	require.True(t, ok)
+	require.Equal(t, leafScript, script)
+	require.Equal(t, controlBlockBytes, cb)
+
+	splitWitness := Witness{
+		PrevID: prevID,
+		SplitCommitment: &SplitCommitment{
+			Proof:     *mssmt.RandProof(t),
+			RootAsset: *RandAsset(t, Normal),
+		},
+	}
+	require.True(t, splitWitness.IsSplitCommit())
+	require.False(t, splitWitness.IsUnsigned())
+}
+
+// TestEstimateEncodedSize tests that the estimated size of an unsigned asset
+// matches the size of the asset once it is signed.
+func TestEstimateEncodedSize(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(
+		t, 66, KeySpendWitnessSize(txscript.SigHashDefault),
+	)
+	require.Equal(t, 67, KeySpendWitnessSize(txscript.SigHashAll))
+
+	newPrevID := func() *PrevID {
+		return &PrevID{
+			OutPoint:  test.RandOp(t),
+			ID:        RandID(t),
+			ScriptKey: RandSerializedKey(t),
+		}
+	}
+	encodedSize := func(a *Asset) uint64 {
+		var buf bytes.Buffer
+		require.NoError(t, a.Encode(&buf))
+		return uint64(buf.Len())
+	}
+
+	// We create a root asset with two unsigned inputs and a split asset
+	// that commits to the root asset.
+	root := RandAsset(t, Normal)
+	root.PrevWitnesses = []Witness{{
+		PrevID: newPrevID(),
+	}, {
+		PrevID: newPrevID(),
+	}}
+
+	split := RandAsset(t, Normal)
+	split.PrevWitnesses = []Witness{{
+		PrevID: newPrevID(),
+		SplitCommitment: &SplitCommitment{
+			Proof:     *mssmt.RandProof(t),
+			RootAsset: *root.Copy(),
+		},
+	}}
+
+	for _, sigHash := range []txscript.SigHashType{
+		txscript.SigHashDefault, txscript.SigHashAll,
+	} {
+		rootEstimate, err := root.EstimateEncodedSize(sigHash)
+		require.NoError(t, err)
+		splitEstimate, err := split.EstimateEncodedSize(sigHash)
+		require.NoError(t, err)
+
+		witnessEstimate, err := EstimateWitnessSize(
+			&split.PrevWitnesses[0], sigHash,
+		)
+		require.NoError(t, err)
+
+		// The estimate must not modify the assets.
+		require.True(t, root.PrevWitnesses[0].IsUnsigned())
+		splitRoot := split.PrevWitnesses[0].SplitCommitment.RootAsset
+		require.True(t, splitRoot.PrevWitnesses[0].IsUnsigned())
+
+		// We now sign all inputs and make sure the estimates match.
+		sig := test.RandBytes(KeySpendSigSize(sigHash))
+		signedRoot := root.Copy()
+		for idx := range signedRoot.PrevWitnesses {
+			witness := &signedRoot.PrevWitnesses[idx]
+			witness.TxWitness = wire.TxWitness{sig}
+		}
+		signedSplit := split.Copy()
+		signedSplit.PrevWitnesses[0].SplitCommitment.RootAsset =
+			*signedRoot.Copy()
+
+		require.Equal(t, encodedSize(signedRoot), rootEstimate)
+		require.Equal(t, encodedSize(signedSplit), splitEstimate)
+
+		witnessSize, err := signedSplit.PrevWitnesses[0].EncodedSize()
+		require.NoError(t, err)
+		require.Equal(t, witnessSize, witnessEstimate)
+	}
+}
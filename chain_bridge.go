@@ -16,13 +16,21 @@ import (
 // interface backed by an active remote lnd node.
 type LndRpcChainBridge struct {
 	lnd *lndclient.LndServices
+
+	// mempool is the optional source of the mempool state, which lnd
+	// doesn't expose.
+	mempool tapgarden.MempoolSource
 }
 
 // NewLndRpcChainBridge creates a new chain bridge from an active lnd services
-// client.
-func NewLndRpcChainBridge(lnd *lndclient.LndServices) *LndRpcChainBridge {
+// client. The state of the mempool is queried from the given mempool source,
+// which may be nil if there is none.
+func NewLndRpcChainBridge(lnd *lndclient.LndServices,
+	mempool tapgarden.MempoolSource) *LndRpcChainBridge {
+
 	return &LndRpcChainBridge{
-		lnd: lnd,
+		lnd:     lnd,
+		mempool: mempool,
 	}
 }
 
@@ -59,6 +67,29 @@ func (l *LndRpcChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	}, errChan, nil
 }
 
+// RegisterSpendNtfn registers an intent to be notified once the given outpoint
+// is spent by a confirmed transaction.
+func (l *LndRpcChainBridge) RegisterSpendNtfn(ctx context.Context,
+	outpoint *wire.OutPoint, pkScript []byte,
+	heightHint uint32) (*chainntnfs.SpendEvent, chan error, error) {
+
+	ctx, cancel := context.WithCancel(ctx) // nolint:govet
+	spendChan, errChan, err := l.lnd.ChainNotifier.RegisterSpendNtfn(
+		ctx, outpoint, pkScript, int32(heightHint),
+	)
+	if err != nil {
+		cancel()
+
+		return nil, nil, fmt.Errorf("unable to register for spend: %w",
+			err)
+	}
+
+	return &chainntnfs.SpendEvent{
+		Spend:  spendChan,
+		Cancel: cancel,
+	}, errChan, nil
+}
+
 // RegisterBlockEpochNtfn registers an intent to be notified of each new block
 // connected to the main chain.
 func (l *LndRpcChainBridge) RegisterBlockEpochNtfn(
@@ -152,6 +183,19 @@ func (l *LndRpcChainBridge) EstimateFee(ctx context.Context,
 	return l.lnd.WalletKit.EstimateFeeRate(ctx, int32(confTarget))
 }
 
+// MempoolInfo returns the current state of the mempool from the mempool source
+// of the chain bridge. If there is none, tapgarden.ErrMempoolInfoUnavailable is
+// returned.
+func (l *LndRpcChainBridge) MempoolInfo(
+	ctx context.Context) (*tapgarden.MempoolInfo, error) {
+
+	if l.mempool == nil {
+		return nil, tapgarden.ErrMempoolInfoUnavailable
+	}
+
+	return l.mempool.MempoolInfo(ctx)
+}
+
 // A compile time assertion to ensure LndRpcChainBridge meets the
 // tapgarden.ChainBridge interface.
 var _ tapgarden.ChainBridge = (*LndRpcChainBridge)(nil)
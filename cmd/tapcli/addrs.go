@@ -1,11 +1,15 @@
 package main
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"math"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+
+	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/taprpc"
 	"github.com/urfave/cli"
 )
@@ -20,7 +24,14 @@ var addrCommands = []cli.Command{
 			newAddrCommand,
 			queryAddrsCommand,
 			decodeAddrCommand,
+			inspectAddrCommand,
 			receivesAddrCommand,
+			receiptAddrCommand,
+			verifyReceiptCommand,
+			counterpartyCommand,
+			importScriptKeyCommand,
+			importedScriptKeysCommand,
+			invoiceCommand,
 		},
 	},
 }
@@ -29,6 +40,16 @@ const (
 	groupKeyName = "group_key"
 
 	amtName = "amt"
+
+	expectDepositName = "expect_deposit"
+
+	depositExpiryName = "deposit_expiry"
+
+	keyFamilyName = "key_family"
+
+	keyIndexName = "key_index"
+
+	tapTweakName = "tap_tweak"
 )
 
 var newAddrCommand = cli.Command{
@@ -46,6 +67,18 @@ var newAddrCommand = cli.Command{
 			Name:  amtName,
 			Usage: "the amt of the asset to receive",
 		},
+		cli.BoolFlag{
+			Name: expectDepositName,
+			Usage: "annotate the address with a deposit " +
+				"expectation, so that inbound transfers " +
+				"report a deposit status",
+		},
+		cli.DurationFlag{
+			Name: depositExpiryName,
+			Usage: "the duration (1h, 30m, etc) after which a " +
+				"deposit to the address is flagged as late; " +
+				"implies --" + expectDepositName,
+		},
 	},
 	Action: newAddr,
 }
@@ -65,10 +98,22 @@ func newAddr(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	addr, err := client.NewAddr(ctxc, &taprpc.NewAddrRequest{
+	req := &taprpc.NewAddrRequest{
 		AssetId: assetID,
 		Amt:     ctx.Uint64(amtName),
-	})
+	}
+	if ctx.Bool(expectDepositName) || ctx.IsSet(depositExpiryName) {
+		req.DepositExpectation = &taprpc.DepositExpectation{
+			Amt: req.Amt,
+		}
+
+		if ctx.IsSet(depositExpiryName) {
+			expiry := time.Now().Add(ctx.Duration(depositExpiryName))
+			req.DepositExpectation.ExpiryUnixSeconds = expiry.Unix()
+		}
+	}
+
+	addr, err := client.NewAddr(ctxc, req)
 	if err != nil {
 		return fmt.Errorf("unable to make addr: %w", err)
 	}
@@ -197,6 +242,54 @@ func decodeAddr(ctx *cli.Context) error {
 	return nil
 }
 
+var inspectAddrCommand = cli.Command{
+	Name:      "inspect",
+	ArgsUsage: "[--addr | addr]",
+	Usage: "Decode a taproot asset addr and show how the proof of a " +
+		"transfer to it would be delivered",
+	Description: `
+	Decodes the given address and reports the proof courier that would be
+	used to deliver the proof of a transfer to it, whether this node
+	supports that courier and the expected size of the proof. This can be
+	used to detect incompatible receivers before funding a transfer.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  addrName,
+			Usage: "the address to inspect",
+		},
+	},
+	Action: inspectAddr,
+}
+
+func inspectAddr(ctx *cli.Context) error {
+	var addr string
+	switch {
+	case ctx.String(addrName) != "":
+		addr = ctx.String(addrName)
+
+	case len(ctx.Args()) > 0:
+		addr = ctx.Args().First()
+
+	default:
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.InspectAddr(ctxc, &taprpc.InspectAddrRequest{
+		Addr: addr,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to inspect addr: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var receivesAddrCommand = cli.Command{
 	Name:      "receives",
 	ShortName: "r",
@@ -235,3 +328,512 @@ func addrReceives(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+const receiptName = "receipt"
+
+var receiptAddrCommand = cli.Command{
+	Name:  "receipt",
+	Usage: "Create a signed receipt for an inbound asset transfer",
+	Description: "Create a receipt for a completed inbound asset " +
+		"transfer that is signed by the identity key of the lnd " +
+		"node and can be handed to third parties as evidence of " +
+		"payment",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  addrName,
+			Usage: "the address the asset was received on",
+		},
+		cli.StringFlag{
+			Name: outpointName,
+			Usage: "the outpoint of the inbound transfer in the " +
+				"form txid:vout",
+		},
+	},
+	Action: addrReceipt,
+}
+
+func addrReceipt(ctx *cli.Context) error {
+	if ctx.String(addrName) == "" || ctx.String(outpointName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ExportReceipt(ctxc, &taprpc.ExportReceiptRequest{
+		Addr:     ctx.String(addrName),
+		Outpoint: ctx.String(outpointName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to export receipt: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var verifyReceiptCommand = cli.Command{
+	Name:      "verifyreceipt",
+	ArgsUsage: "[--receipt | receipt]",
+	Usage:     "Verify a signed receipt for an inbound asset transfer",
+	Description: "Verify that a receipt was signed by the node key it " +
+		"contains. This doesn't require a connection to tapd. Whether " +
+		"the node key belongs to the expected party must be checked " +
+		"separately",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  receiptName,
+			Usage: "the hex encoded receipt",
+		},
+	},
+	Action: verifyReceipt,
+}
+
+// receiptJSON is the JSON representation of a verified receipt.
+type receiptJSON struct {
+	Valid      bool   `json:"valid"`
+	AssetID    string `json:"asset_id"`
+	Amount     uint64 `json:"amount"`
+	AnchorTxid string `json:"anchor_txid"`
+	ScriptKey  string `json:"script_key"`
+	BlockHash  string `json:"block_hash"`
+	NodeKey    string `json:"node_key"`
+}
+
+func verifyReceipt(ctx *cli.Context) error {
+	var receiptHex string
+	switch {
+	case ctx.String(receiptName) != "":
+		receiptHex = ctx.String(receiptName)
+
+	case len(ctx.Args()) > 0:
+		receiptHex = ctx.Args().First()
+
+	default:
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	receiptBytes, err := hex.DecodeString(receiptHex)
+	if err != nil {
+		return fmt.Errorf("unable to decode receipt: %w", err)
+	}
+
+	var receipt tapgarden.Receipt
+	if err := receipt.Decode(bytes.NewReader(receiptBytes)); err != nil {
+		return fmt.Errorf("unable to decode receipt: %w", err)
+	}
+
+	if err := tapgarden.VerifyReceipt(&receipt); err != nil {
+		return fmt.Errorf("invalid receipt: %w", err)
+	}
+
+	printJSON(&receiptJSON{
+		Valid:      true,
+		AssetID:    receipt.AssetID.String(),
+		Amount:     receipt.Amount,
+		AnchorTxid: receipt.AnchorTxid.String(),
+		ScriptKey: hex.EncodeToString(
+			receipt.ScriptKey.SerializeCompressed(),
+		),
+		BlockHash: receipt.BlockHash.String(),
+		NodeKey: hex.EncodeToString(
+			receipt.NodeKey.SerializeCompressed(),
+		),
+	})
+	return nil
+}
+
+var counterpartyCommand = cli.Command{
+	Name:  "counterparty",
+	Usage: "manage the book of known counterparties",
+	Description: "manage the book that maps the script keys of known " +
+		"receivers of outbound transfers to their names",
+	Subcommands: []cli.Command{
+		setCounterpartyCommand,
+		removeCounterpartyCommand,
+		listCounterpartiesCommand,
+	},
+}
+
+var setCounterpartyCommand = cli.Command{
+	Name:  "set",
+	Usage: "add a script key or address of a counterparty",
+	Description: "add the script key of a counterparty, either directly " +
+		"or taken from one of its addresses, under the given name; " +
+		"a known script key is renamed",
+	ArgsUsage: "name",
+	Action:    setCounterparty,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  scriptKeyName,
+			Usage: "the script key the counterparty receives on",
+		},
+		cli.StringFlag{
+			Name:  addrName,
+			Usage: "an address of the counterparty",
+		},
+	},
+}
+
+func setCounterparty(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	req := &taprpc.SetCounterpartyRequest{
+		Name: ctx.Args().First(),
+	}
+	switch {
+	case ctx.IsSet(scriptKeyName) && ctx.IsSet(addrName):
+		return fmt.Errorf("only the script_key or addr can be set")
+
+	case ctx.IsSet(scriptKeyName):
+		scriptKey, err := hex.DecodeString(ctx.String(scriptKeyName))
+		if err != nil {
+			return fmt.Errorf("invalid script key: %w", err)
+		}
+		req.Target = &taprpc.SetCounterpartyRequest_ScriptKey{
+			ScriptKey: scriptKey,
+		}
+
+	case ctx.IsSet(addrName):
+		req.Target = &taprpc.SetCounterpartyRequest_TapAddr{
+			TapAddr: ctx.String(addrName),
+		}
+
+	default:
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SetCounterparty(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to set counterparty: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var removeCounterpartyCommand = cli.Command{
+	Name:      "remove",
+	Usage:     "remove all script keys of a counterparty",
+	ArgsUsage: "name",
+	Action:    removeCounterparty,
+}
+
+func removeCounterparty(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.RemoveCounterparty(
+		ctxc, &taprpc.RemoveCounterpartyRequest{
+			Name: ctx.Args().First(),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to remove counterparty: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listCounterpartiesCommand = cli.Command{
+	Name:   "list",
+	Usage:  "list all script keys of the counterparty book",
+	Action: listCounterparties,
+}
+
+func listCounterparties(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListCounterparties(
+		ctxc, &taprpc.ListCounterpartiesRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list counterparties: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var importScriptKeyCommand = cli.Command{
+	Name:  "importscriptkey",
+	Usage: "import an externally derived script key",
+	Description: "import a script key that was derived outside of this " +
+		"wallet, for example by a legacy system or another wallet, " +
+		"so assets sent to it are recognized as our own; the raw " +
+		"internal key and the optional tap tweak must derive the " +
+		"script key",
+	Action: importScriptKey,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  scriptKeyName,
+			Usage: "the script key to import",
+		},
+		cli.StringFlag{
+			Name:  rawKeyName,
+			Usage: "the raw internal key of the script key",
+		},
+		cli.Int64Flag{
+			Name:  keyFamilyName,
+			Usage: "the key family of the internal key, if known",
+		},
+		cli.Int64Flag{
+			Name:  keyIndexName,
+			Usage: "the key index of the internal key, if known",
+		},
+		cli.StringFlag{
+			Name: tapTweakName,
+			Usage: "the tap tweak applied to the internal key; " +
+				"if empty, the script key is a BIP-0086 key",
+		},
+	},
+}
+
+func importScriptKey(ctx *cli.Context) error {
+	if !ctx.IsSet(scriptKeyName) || !ctx.IsSet(rawKeyName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	scriptKey, err := hex.DecodeString(ctx.String(scriptKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid script key: %w", err)
+	}
+
+	// The script key is x-only, so we drop the parity byte of a
+	// compressed key.
+	if len(scriptKey) == btcec.PubKeyBytesLenCompressed {
+		scriptKey = scriptKey[1:]
+	}
+
+	rawKey, err := hex.DecodeString(ctx.String(rawKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid raw key: %w", err)
+	}
+
+	tapTweak, err := hex.DecodeString(ctx.String(tapTweakName))
+	if err != nil {
+		return fmt.Errorf("invalid tap tweak: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	keyDesc := &taprpc.KeyDescriptor{
+		RawKeyBytes: rawKey,
+		KeyLoc: &taprpc.KeyLocator{
+			KeyFamily: int32(ctx.Int64(keyFamilyName)),
+			KeyIndex:  int32(ctx.Int64(keyIndexName)),
+		},
+	}
+	req := &taprpc.ImportScriptKeyRequest{
+		ScriptKey: &taprpc.ScriptKey{
+			PubKey:   scriptKey,
+			KeyDesc:  keyDesc,
+			TapTweak: tapTweak,
+		},
+	}
+	resp, err := client.ImportScriptKey(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to import script key: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var importedScriptKeysCommand = cli.Command{
+	Name:   "importedscriptkeys",
+	Usage:  "list all imported script keys",
+	Action: listImportedScriptKeys,
+}
+
+func listImportedScriptKeys(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListImportedScriptKeys(
+		ctxc, &taprpc.ListImportedScriptKeysRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list imported script keys: %w",
+			err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	invoiceMemoName = "memo"
+
+	invoiceExpiryName = "expiry"
+
+	invoiceIDName = "invoice_id"
+)
+
+var invoiceCommand = cli.Command{
+	Name:  "invoice",
+	Usage: "manage invoices that request a payment to an address",
+	Description: "create, decode and track signed invoices that request " +
+		"an amount of an asset to be paid to a new address; an " +
+		"invoice is settled once a transfer to its address completes",
+	Subcommands: []cli.Command{
+		createInvoiceCommand,
+		decodeInvoiceCommand,
+		lookupInvoiceCommand,
+		listInvoicesCommand,
+	},
+}
+
+var createInvoiceCommand = cli.Command{
+	Name:  "create",
+	Usage: "create a new invoice",
+	Description: "create a new address for the given asset and amount " +
+		"and an invoice for it that is signed by the node",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset genesis ID of the asset to request",
+		},
+		cli.Uint64Flag{
+			Name:  amtName,
+			Usage: "the amt of the asset to request",
+		},
+		cli.DurationFlag{
+			Name: invoiceExpiryName,
+			Usage: "the duration (1h, 30m, etc) for which the " +
+				"invoice can be paid; defaults to one hour",
+		},
+		cli.StringFlag{
+			Name:  invoiceMemoName,
+			Usage: "an optional description of the payment",
+		},
+	},
+	Action: createInvoice,
+}
+
+func createInvoice(ctx *cli.Context) error {
+	if ctx.String(assetIDName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	assetID, err := hex.DecodeString(ctx.String(assetIDName))
+	if err != nil {
+		return fmt.Errorf("unable to decode assetID: %v", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.CreateInvoice(ctxc, &taprpc.CreateInvoiceRequest{
+		AssetId: assetID,
+		Amt:     ctx.Uint64(amtName),
+		ExpirySeconds: int64(
+			ctx.Duration(invoiceExpiryName) / time.Second,
+		),
+		Memo: ctx.String(invoiceMemoName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create invoice: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var decodeInvoiceCommand = cli.Command{
+	Name:      "decode",
+	Usage:     "decode and verify an invoice",
+	ArgsUsage: "invoice",
+	Action:    decodeInvoice,
+}
+
+func decodeInvoice(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.DecodeInvoice(ctxc, &taprpc.DecodeInvoiceRequest{
+		Invoice: ctx.Args().First(),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to decode invoice: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var lookupInvoiceCommand = cli.Command{
+	Name:      "lookup",
+	Usage:     "show an invoice created by this node and its state",
+	ArgsUsage: invoiceIDName,
+	Action:    lookupInvoice,
+}
+
+func lookupInvoice(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	invoiceID, err := hex.DecodeString(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("unable to decode invoice ID: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.LookupInvoice(ctxc, &taprpc.LookupInvoiceRequest{
+		InvoiceId: invoiceID,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to look up invoice: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listInvoicesCommand = cli.Command{
+	Name:   "list",
+	Usage:  "list all invoices created by this node and their state",
+	Action: listInvoices,
+}
+
+func listInvoices(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListInvoices(ctxc, &taprpc.ListInvoicesRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to list invoices: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -4,10 +4,15 @@ import (
 	"encoding/hex"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightninglabs/taproot-assets/tapcfg"
 	"github.com/lightninglabs/taproot-assets/taprpc"
 	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
+	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/urfave/cli"
 )
 
@@ -23,8 +28,24 @@ var assetsCommands = []cli.Command{
 			listUtxosCommand,
 			listGroupsCommand,
 			listAssetBalancesCommand,
+			balanceHistoryCommand,
 			sendAssetsCommand,
 			listTransfersCommand,
+			listDeliveriesCommand,
+			parcelQueueCommand,
+			parcelLogCommand,
+			airdropCommand,
+			templatesCommand,
+			scheduledTransfersCommand,
+			anchorSessionCommand,
+			exportStatementCommand,
+			freezeAssetsCommand,
+			unfreezeAssetsCommand,
+			listFrozenAssetsCommand,
+			annotateLotCommand,
+			aliasCommand,
+			watchCommand,
+			listAtRiskAssetsCommand,
 			fetchMetaCommand,
 		},
 	},
@@ -37,6 +58,7 @@ var (
 	assetMetaBytesName    = "meta_bytes"
 	assetMetaFilePathName = "meta_file_path"
 	assetMetaTypeName     = "meta_type"
+	assetMetaIPFSName     = "pin_meta_to_ipfs"
 	assetEmissionName     = "enable_emission"
 	assetShowWitnessName  = "show_witness"
 	assetShowSpentName    = "show_spent"
@@ -78,6 +100,11 @@ var mintAssetCommand = cli.Command{
 			Name:  assetMetaTypeName,
 			Usage: "the type of the meta data for the asset",
 		},
+		cli.BoolFlag{
+			Name: assetMetaIPFSName,
+			Usage: "if true, the meta data is pinned to IPFS and " +
+				"the asset only commits to a reference of it",
+		},
 		cli.BoolFlag{
 			Name: assetEmissionName,
 			Usage: "if true, then the asset supports on going " +
@@ -165,12 +192,13 @@ func mintAsset(ctx *cli.Context) error {
 
 	resp, err := client.MintAsset(ctxc, &mintrpc.MintAssetRequest{
 		Asset: &mintrpc.MintAsset{
-			AssetType:   parseAssetType(ctx),
-			Name:        ctx.String(assetTagName),
-			AssetMeta:   assetMeta,
-			Amount:      ctx.Uint64(assetSupplyName),
-			GroupKey:    groupKey,
-			GroupAnchor: ctx.String(assetGroupAnchorName),
+			AssetType:     parseAssetType(ctx),
+			Name:          ctx.String(assetTagName),
+			AssetMeta:     assetMeta,
+			Amount:        ctx.Uint64(assetSupplyName),
+			GroupKey:      groupKey,
+			GroupAnchor:   ctx.String(assetGroupAnchorName),
+			PinMetaToIpfs: ctx.Bool(assetMetaIPFSName),
 		},
 		EnableEmission: ctx.Bool(assetEmissionName),
 	})
@@ -419,6 +447,74 @@ func listAssetBalances(ctx *cli.Context) error {
 	return nil
 }
 
+const (
+	balanceHeightName = "height"
+	balanceDateName   = "date"
+)
+
+var balanceHistoryCommand = cli.Command{
+	Name:  "balancehistory",
+	Usage: "list historical asset balances",
+	Description: "list the confirmed balance of each asset as of a given " +
+		"block height or date, as recorded by the most recent balance " +
+		"snapshot taken at or before that point",
+	Action: listBalanceHistory,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  balanceHeightName,
+			Usage: "the block height to list the balances for",
+		},
+		cli.StringFlag{
+			Name: balanceDateName,
+			Usage: "the date to list the balances for, either as " +
+				"YYYY-MM-DD or in RFC 3339 format",
+		},
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "only list the balance of the given asset ID",
+		},
+	},
+}
+
+func listBalanceHistory(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &taprpc.ListBalanceHistoryRequest{
+		Height: uint32(ctx.Uint64(balanceHeightName)),
+	}
+	if ctx.IsSet(balanceDateName) {
+		dateStr := ctx.String(balanceDateName)
+		date, err := time.Parse(time.RFC3339, dateStr)
+		if err != nil {
+			date, err = time.Parse("2006-01-02", dateStr)
+			if err != nil {
+				return fmt.Errorf("invalid date: %w", err)
+			}
+
+			// A plain date includes the whole day.
+			date = date.Add(24*time.Hour - time.Second)
+		}
+		req.Timestamp = date.Unix()
+	}
+	if ctx.IsSet(assetIDName) {
+		assetID, err := hex.DecodeString(ctx.String(assetIDName))
+		if err != nil {
+			return fmt.Errorf("invalid asset ID: %w", err)
+		}
+		req.AssetId = assetID
+	}
+
+	resp, err := client.ListBalanceHistory(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to list balance history: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var sendAssetsCommand = cli.Command{
 	Name:        "send",
 	ShortName:   "s",
@@ -430,12 +526,190 @@ var sendAssetsCommand = cli.Command{
 			Usage: "addr to send to; can be specified multiple " +
 				"times to send to multiple addresses at once",
 		},
+		cli.StringFlag{
+			Name: priorityName,
+			Usage: "the priority class of the transfer, one of " +
+				"normal, urgent or batchable",
+			Value: "normal",
+		},
+		cli.StringFlag{
+			Name: coinSelectName,
+			Usage: "the coin selection strategy, one of max_amount, " +
+				"oldest_lot or newest_lot; if not set, the " +
+				"default strategy of the daemon is used",
+		},
+		cli.StringSliceFlag{
+			Name: spendLotName,
+			Usage: "a lot that may be spent, in the form " +
+				"txid:vout:asset_id:script_key; can be " +
+				"specified multiple times, if set only the " +
+				"given lots are spent",
+		},
+		cli.Uint64Flag{
+			Name: anchorMinConfsName,
+			Usage: "the number of confirmations a BTC UTXO needs " +
+				"to pay for the anchor transaction fees; " +
+				"defaults to 1 if not set",
+		},
+		cli.Uint64Flag{
+			Name: anchorMaxInputsName,
+			Usage: "the maximum number of BTC inputs that may pay " +
+				"for the anchor transaction fees; unlimited " +
+				"if not set",
+		},
+		cli.DurationFlag{
+			Name: confDeadlineName,
+			Usage: "the time from now by which the anchor " +
+				"transaction needs to be confirmed, e.g. 2h; " +
+				"until then its fee is bumped periodically",
+		},
+		cli.DurationFlag{
+			Name: feeBumpIntervalName,
+			Usage: "the interval between two fee bumps of a " +
+				"transfer with a confirmation deadline; " +
+				"defaults to 20m if not set",
+		},
+		cli.Uint64Flag{
+			Name: feeBumpPercentName,
+			Usage: "the percentage the fee rate is raised by " +
+				"with each bump; defaults to 25 if not set",
+		},
+		cli.Uint64Flag{
+			Name: maxFeeRateName,
+			Usage: "the maximum fee rate in sat/vB a fee bump " +
+				"may use; uncapped if not set",
+		},
+		cli.Uint64Flag{
+			Name: broadcastHeightName,
+			Usage: "the earliest block height at which the " +
+				"anchor transaction is broadcast; the " +
+				"transfer is signed and committed right away",
+		},
+		cli.DurationFlag{
+			Name: broadcastAfterName,
+			Usage: "the time from now after which the anchor " +
+				"transaction is broadcast, e.g. 24h; the " +
+				"transfer is signed and committed right away",
+		},
+		cli.Uint64Flag{
+			Name: anchorLockTimeName,
+			Usage: "the explicit lock time of the anchor " +
+				"transaction; defaults to the current block " +
+				"height to discourage fee sniping",
+		},
+		cli.Uint64Flag{
+			Name: anchorSequenceName,
+			Usage: "the explicit sequence number of all inputs " +
+				"of the anchor transaction",
+		},
+		cli.StringFlag{
+			Name: partialSendName,
+			Usage: "how to handle a balance that can't cover all " +
+				"addresses, one of disabled, propose or " +
+				"execute; propose only reports the addresses " +
+				"that can be paid, execute pays them",
+			Value: "disabled",
+		},
 		// TODO(roasbeef): add arg for file name to write sender proof
 		// blob
 	},
 	Action: sendAssets,
 }
 
+const (
+	priorityName = "priority"
+
+	coinSelectName = "coin_select"
+
+	spendLotName = "spend_lot"
+
+	anchorMinConfsName = "anchor_min_confs"
+
+	anchorMaxInputsName = "anchor_max_inputs"
+
+	confDeadlineName = "conf_deadline"
+
+	feeBumpIntervalName = "fee_bump_interval"
+
+	feeBumpPercentName = "fee_bump_percent"
+
+	maxFeeRateName = "max_fee_rate"
+
+	broadcastHeightName = "broadcast_height"
+
+	broadcastAfterName = "broadcast_after"
+
+	anchorLockTimeName = "anchor_locktime"
+
+	anchorSequenceName = "anchor_sequence"
+
+	partialSendName = "partial_send"
+)
+
+// parseParcelPriority parses the given parcel priority class name.
+func parseParcelPriority(name string) (taprpc.ParcelPriority, error) {
+	enumName := "PARCEL_PRIORITY_" + strings.ToUpper(name)
+	priority, ok := taprpc.ParcelPriority_value[enumName]
+	if !ok {
+		return 0, fmt.Errorf("unknown priority: %v", name)
+	}
+
+	return taprpc.ParcelPriority(priority), nil
+}
+
+// parsePartialSendMode parses the given partial send mode name.
+func parsePartialSendMode(name string) (taprpc.PartialSendMode, error) {
+	enumName := "PARTIAL_SEND_" + strings.ToUpper(name)
+	mode, ok := taprpc.PartialSendMode_value[enumName]
+	if !ok {
+		return 0, fmt.Errorf("unknown partial send mode: %v", name)
+	}
+
+	return taprpc.PartialSendMode(mode), nil
+}
+
+// parseCoinSelectStrategy parses the given coin selection strategy name. An
+// empty name selects the default strategy of the daemon.
+func parseCoinSelectStrategy(name string) (taprpc.CoinSelectStrategy, error) {
+	if name == "" {
+		return taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_DEFAULT,
+			nil
+	}
+
+	enumName := "COIN_SELECT_STRATEGY_" + strings.ToUpper(name)
+	strategy, ok := taprpc.CoinSelectStrategy_value[enumName]
+	if !ok {
+		return 0, fmt.Errorf("unknown coin select strategy: %v", name)
+	}
+
+	return taprpc.CoinSelectStrategy(strategy), nil
+}
+
+// parseAssetLotID parses a lot identifier in the form
+// txid:vout:asset_id:script_key.
+func parseAssetLotID(lot string) (*taprpc.AssetLotID, error) {
+	parts := strings.Split(lot, ":")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("lot %v must be in the form "+
+			"txid:vout:asset_id:script_key", lot)
+	}
+
+	assetID, err := hex.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid asset ID: %w", err)
+	}
+	scriptKey, err := hex.DecodeString(parts[3])
+	if err != nil {
+		return nil, fmt.Errorf("invalid script key: %w", err)
+	}
+
+	return &taprpc.AssetLotID{
+		AnchorOutpoint: parts[0] + ":" + parts[1],
+		AssetId:        assetID,
+		ScriptKey:      scriptKey,
+	}, nil
+}
+
 func sendAssets(ctx *cli.Context) error {
 	addrs := ctx.StringSlice(addrName)
 	if ctx.NArg() != 0 || ctx.NumFlags() == 0 || len(addrs) == 0 {
@@ -446,8 +720,77 @@ func sendAssets(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
+	priority, err := parseParcelPriority(ctx.String(priorityName))
+	if err != nil {
+		return err
+	}
+
+	strategy, err := parseCoinSelectStrategy(ctx.String(coinSelectName))
+	if err != nil {
+		return err
+	}
+
+	partialSendMode, err := parsePartialSendMode(
+		ctx.String(partialSendName),
+	)
+	if err != nil {
+		return err
+	}
+
+	var spendLots []*taprpc.AssetLotID
+	for _, lot := range ctx.StringSlice(spendLotName) {
+		lotID, err := parseAssetLotID(lot)
+		if err != nil {
+			return err
+		}
+		spendLots = append(spendLots, lotID)
+	}
+
+	var confDeadline int64
+	if ctx.IsSet(confDeadlineName) {
+		deadline := time.Now().Add(ctx.Duration(confDeadlineName))
+		confDeadline = deadline.Unix()
+	}
+
+	var broadcastAfter int64
+	if ctx.IsSet(broadcastAfterName) {
+		delay := ctx.Duration(broadcastAfterName)
+		broadcastAfter = time.Now().Add(delay).Unix()
+	}
+
+	var anchorLockTime *taprpc.AnchorLockTime
+	if ctx.IsSet(anchorLockTimeName) || ctx.IsSet(anchorSequenceName) {
+		anchorLockTime = &taprpc.AnchorLockTime{
+			OverrideLockTime: ctx.IsSet(anchorLockTimeName),
+			LockTime: uint32(
+				ctx.Uint64(anchorLockTimeName),
+			),
+			OverrideSequence: ctx.IsSet(anchorSequenceName),
+			Sequence: uint32(
+				ctx.Uint64(anchorSequenceName),
+			),
+		}
+	}
+
 	resp, err := client.SendAsset(ctxc, &taprpc.SendAssetRequest{
-		TapAddrs: addrs,
+		TapAddrs:                addrs,
+		Priority:                priority,
+		CoinSelectStrategy:      strategy,
+		SpendLots:               spendLots,
+		AnchorMinConfs:          uint32(ctx.Uint64(anchorMinConfsName)),
+		AnchorMaxInputs:         uint32(ctx.Uint64(anchorMaxInputsName)),
+		ConfDeadlineUnixSeconds: confDeadline,
+		FeeBumpIntervalSeconds: uint32(
+			ctx.Duration(feeBumpIntervalName).Seconds(),
+		),
+		FeeBumpPercent:        uint32(ctx.Uint64(feeBumpPercentName)),
+		MaxFeeRateSatPerVbyte: ctx.Uint64(maxFeeRateName),
+		BroadcastAfterHeight: uint32(
+			ctx.Uint64(broadcastHeightName),
+		),
+		BroadcastAfterUnixSeconds: broadcastAfter,
+		AnchorLockTime:            anchorLockTime,
+		PartialSendMode:           partialSendMode,
 	})
 	if err != nil {
 		return fmt.Errorf("unable to send assets: %w", err)
@@ -457,31 +800,72 @@ func sendAssets(ctx *cli.Context) error {
 	return nil
 }
 
-var listTransfersCommand = cli.Command{
-	Name:      "transfers",
-	ShortName: "t",
-	Usage:     "list asset transfers",
-	Description: "list outgoing transfers of all assets or a selected " +
-		"asset",
-	Action: listTransfers,
+var scheduledTransfersCommand = cli.Command{
+	Name:  "scheduled",
+	Usage: "manage transfers with a delayed broadcast",
+	Description: "list or cancel transfers whose anchor transaction is " +
+		"held back until their broadcast height or time is reached",
+	Subcommands: []cli.Command{
+		listScheduledTransfersCommand,
+		cancelScheduledTransferCommand,
+	},
+}
+
+var listScheduledTransfersCommand = cli.Command{
+	Name:   "list",
+	Usage:  "list the transfers that wait to be broadcast",
+	Action: listScheduledTransfers,
+}
+
+func listScheduledTransfers(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &taprpc.ListScheduledTransfersRequest{}
+	resp, err := client.ListScheduledTransfers(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to list scheduled transfers: %w",
+			err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var cancelScheduledTransferCommand = cli.Command{
+	Name:  "cancel",
+	Usage: "cancel a transfer before it is broadcast",
+	Description: "cancel a scheduled transfer before its anchor " +
+		"transaction is broadcast; the spent assets can be used " +
+		"again right away",
+	Action: cancelScheduledTransfer,
 	Flags: []cli.Flag{
 		cli.StringFlag{
-			Name: assetIDName,
-			Usage: "A specific asset ID to list outgoing " +
-				"transfers for",
+			Name: anchorTxidName,
+			Usage: "the ID of the anchor transaction of the " +
+				"transfer to cancel",
 		},
 	},
 }
 
-func listTransfers(ctx *cli.Context) error {
+func cancelScheduledTransfer(ctx *cli.Context) error {
+	if !ctx.IsSet(anchorTxidName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
 	ctxc := getContext()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	req := &taprpc.ListTransfersRequest{}
-	resp, err := client.ListTransfers(ctxc, req)
+	resp, err := client.CancelScheduledTransfer(
+		ctxc, &taprpc.CancelScheduledTransferRequest{
+			AnchorTxid: ctx.String(anchorTxidName),
+		},
+	)
 	if err != nil {
-		return fmt.Errorf("unable to list asset transfers: %w", err)
+		return fmt.Errorf("unable to cancel scheduled transfer: %w",
+			err)
 	}
 
 	printRespJSON(resp)
@@ -489,33 +873,161 @@ func listTransfers(ctx *cli.Context) error {
 }
 
 const (
-	metaName = "asset_meta"
+	sessionNameName = "name"
 )
 
-var fetchMetaCommand = cli.Command{
-	Name:  "meta",
-	Usage: "fetch asset meta",
-	Description: "fetch the meta bytes for an asset based on the " +
-		"asset_id or meta_hash",
-	Action: fetchMeta,
+var anchorSessionCommand = cli.Command{
+	Name:  "session",
+	Usage: "send the parcels of multiple callers in one transaction",
+	Description: "open a named anchor session that separate callers " +
+		"register parcels into; once the session is sealed, all " +
+		"parcels are sent in a single anchor transaction",
+	Subcommands: []cli.Command{
+		openAnchorSessionCommand,
+		registerAnchorSessionParcelCommand,
+		sealAnchorSessionCommand,
+		abortAnchorSessionCommand,
+	},
+}
+
+var openAnchorSessionCommand = cli.Command{
+	Name:   "open",
+	Usage:  "open a named anchor session",
+	Action: openAnchorSession,
 	Flags: []cli.Flag{
 		cli.StringFlag{
-			Name:  assetIDName,
-			Usage: "asset_id to fetch meta for",
+			Name:  sessionNameName,
+			Usage: "the name of the anchor session",
+		},
+	},
+}
+
+func openAnchorSession(ctx *cli.Context) error {
+	if !ctx.IsSet(sessionNameName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.OpenAnchorSession(
+		ctxc, &taprpc.OpenAnchorSessionRequest{
+			SessionName: ctx.String(sessionNameName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to open anchor session: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var registerAnchorSessionParcelCommand = cli.Command{
+	Name:  "register",
+	Usage: "register a parcel in an open anchor session",
+	Description: "register a parcel that sends to the given addresses; " +
+		"the command blocks until the session is sealed and prints " +
+		"the caller's view of the shared transfer",
+	Action: registerAnchorSessionParcel,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  sessionNameName,
+			Usage: "the name of the anchor session",
+		},
+		cli.StringSliceFlag{
+			Name: addrName,
+			Usage: "addr to send to; can be specified multiple " +
+				"times to send to multiple addresses",
+		},
+	},
+}
+
+func registerAnchorSessionParcel(ctx *cli.Context) error {
+	addrs := ctx.StringSlice(addrName)
+	if !ctx.IsSet(sessionNameName) || len(addrs) == 0 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.RegisterAnchorSessionParcel(
+		ctxc, &taprpc.RegisterAnchorSessionParcelRequest{
+			SessionName: ctx.String(sessionNameName),
+			TapAddrs:    addrs,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to register parcel: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var sealAnchorSessionCommand = cli.Command{
+	Name:   "seal",
+	Usage:  "send all parcels of an anchor session",
+	Action: sealAnchorSession,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  sessionNameName,
+			Usage: "the name of the anchor session",
 		},
 		cli.StringFlag{
-			Name:  metaName,
-			Usage: "meta_hash to fetch meta for",
+			Name: priorityName,
+			Usage: "the priority class of the transfer, one of " +
+				"normal, urgent or batchable",
+			Value: "normal",
 		},
 	},
 }
 
-func fetchMeta(ctx *cli.Context) error {
-	switch {
-	case ctx.IsSet(metaName) && ctx.IsSet(assetIDName):
-		return fmt.Errorf("only the asset_id or meta_hash can be set")
+func sealAnchorSession(ctx *cli.Context) error {
+	if !ctx.IsSet(sessionNameName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
 
-	case !ctx.IsSet(assetIDName) && !ctx.IsSet(metaName):
+	priority, err := parseParcelPriority(ctx.String(priorityName))
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SealAnchorSession(
+		ctxc, &taprpc.SealAnchorSessionRequest{
+			SessionName: ctx.String(sessionNameName),
+			Priority:    priority,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to seal anchor session: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var abortAnchorSessionCommand = cli.Command{
+	Name:   "abort",
+	Usage:  "abort an open anchor session without sending its parcels",
+	Action: abortAnchorSession,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  sessionNameName,
+			Usage: "the name of the anchor session",
+		},
+	},
+}
+
+func abortAnchorSession(ctx *cli.Context) error {
+	if !ctx.IsSet(sessionNameName) {
 		return cli.ShowSubcommandHelp(ctx)
 	}
 
@@ -523,7 +1035,1274 @@ func fetchMeta(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	req := &taprpc.FetchAssetMetaRequest{}
+	resp, err := client.AbortAnchorSession(
+		ctxc, &taprpc.AbortAnchorSessionRequest{
+			SessionName: ctx.String(sessionNameName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to abort anchor session: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	airdropManifestName = "manifest"
+
+	airdropLabelName = "label"
+
+	maxPerTxName = "max_per_tx"
+
+	validateOnlyName = "validate_only"
+
+	airdropIDName = "id"
+
+	includeRecipientsName = "recipients"
+)
+
+var airdropCommand = cli.Command{
+	Name:  "airdrop",
+	Usage: "send assets to many recipients",
+	Description: "send assets to a manifest of many recipients in " +
+		"batched transfers and track the status of every recipient",
+	Subcommands: []cli.Command{
+		startAirdropCommand,
+		resumeAirdropCommand,
+		listAirdropsCommand,
+	},
+}
+
+var startAirdropCommand = cli.Command{
+	Name:  "start",
+	Usage: "start an airdrop from a CSV manifest",
+	Description: `
+	Start sending assets to all recipients of a manifest. The manifest is
+	a CSV file with one address,amount pair per line, where the amount
+	must match the amount of the address. All addresses are validated
+	before any asset is sent, then the recipients are split into batched
+	transfers that are sent in the background.
+	`,
+	Action: startAirdrop,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  airdropManifestName,
+			Usage: "the path to the CSV manifest of the airdrop",
+		},
+		cli.StringFlag{
+			Name:  airdropLabelName,
+			Usage: "a description of the airdrop",
+		},
+		cli.Uint64Flag{
+			Name: maxPerTxName,
+			Usage: "the maximum number of recipients paid by a " +
+				"single anchor transaction; if zero, the " +
+				"default is used",
+		},
+		cli.BoolFlag{
+			Name: validateOnlyName,
+			Usage: "only validate the manifest and show the " +
+				"batches, without sending any assets",
+		},
+	},
+}
+
+func startAirdrop(ctx *cli.Context) error {
+	if !ctx.IsSet(airdropManifestName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	manifest, err := readFile(
+		lncfg.CleanAndExpandPath(ctx.String(airdropManifestName)),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to read manifest: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.StartAirdrop(ctxc, &taprpc.StartAirdropRequest{
+		Label:              ctx.String(airdropLabelName),
+		ManifestCsv:        string(manifest),
+		MaxRecipientsPerTx: uint32(ctx.Uint64(maxPerTxName)),
+		ValidateOnly:       ctx.Bool(validateOnlyName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start airdrop: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var resumeAirdropCommand = cli.Command{
+	Name:  "resume",
+	Usage: "retry the pending and failed recipients of an airdrop",
+	Description: "retry the recipients of an airdrop that weren't paid " +
+		"yet or whose transfer failed; recipients whose transfer is " +
+		"in flight aren't retried",
+	Action: resumeAirdrop,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name:  airdropIDName,
+			Usage: "the ID of the airdrop to resume",
+		},
+	},
+}
+
+func resumeAirdrop(ctx *cli.Context) error {
+	if !ctx.IsSet(airdropIDName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ResumeAirdrop(ctxc, &taprpc.ResumeAirdropRequest{
+		AirdropId: ctx.Int64(airdropIDName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to resume airdrop: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listAirdropsCommand = cli.Command{
+	Name:        "list",
+	Usage:       "list airdrops",
+	Description: "list the airdrops and the status of their recipients",
+	Action:      listAirdrops,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name:  airdropIDName,
+			Usage: "only list the airdrop with the given ID",
+		},
+		cli.BoolFlag{
+			Name:  includeRecipientsName,
+			Usage: "include the status of every recipient",
+		},
+	},
+}
+
+func listAirdrops(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListAirdrops(ctxc, &taprpc.ListAirdropsRequest{
+		AirdropId:         ctx.Int64(airdropIDName),
+		IncludeRecipients: ctx.Bool(includeRecipientsName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list airdrops: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	templateNameName = "name"
+
+	keyRecipientName = "recipient"
+
+	confTargetName = "conf_target"
+
+	totalAmountName = "total_amount"
+)
+
+var templatesCommand = cli.Command{
+	Name:  "templates",
+	Usage: "manage transfer templates",
+	Description: "store named sets of recipients that can be paid " +
+		"repeatedly with a single call",
+	Subcommands: []cli.Command{
+		createTemplateCommand,
+		listTemplatesCommand,
+		deleteTemplateCommand,
+		executeTemplateCommand,
+	},
+}
+
+var createTemplateCommand = cli.Command{
+	Name:  "create",
+	Usage: "create a transfer template",
+	Description: `
+	Create a named transfer template that pays a set of recipients of a
+	single asset. A recipient is either a Taproot Asset address, which is
+	always paid the amount of the address, or a pair of keys in the form
+	script_key:internal_key:amount, where the amount is either fixed or a
+	share of the total amount of an execution in basis points, e.g.
+	2500bps. The shares of all recipients must add up to 10000bps.
+	`,
+	Action: createTemplate,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  templateNameName,
+			Usage: "the unique name of the template",
+		},
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the ID of the asset the recipients are paid in",
+		},
+		cli.StringSliceFlag{
+			Name: addrName,
+			Usage: "an address to pay; can be specified multiple " +
+				"times",
+		},
+		cli.StringSliceFlag{
+			Name: keyRecipientName,
+			Usage: "a recipient in the form " +
+				"script_key:internal_key:amount; can be " +
+				"specified multiple times",
+		},
+		cli.StringFlag{
+			Name: priorityName,
+			Usage: "the priority class of the transfers, one of " +
+				"normal, urgent or batchable",
+			Value: "normal",
+		},
+		cli.DurationFlag{
+			Name: confTargetName,
+			Usage: "the time after an execution by which the " +
+				"anchor transaction needs to be confirmed, " +
+				"e.g. 2h; until then its fee is bumped " +
+				"periodically",
+		},
+		cli.DurationFlag{
+			Name: feeBumpIntervalName,
+			Usage: "the interval between two fee bumps; defaults " +
+				"to 20m if not set",
+		},
+		cli.Uint64Flag{
+			Name: feeBumpPercentName,
+			Usage: "the percentage the fee rate is raised by " +
+				"with each bump; defaults to 25 if not set",
+		},
+		cli.Uint64Flag{
+			Name: maxFeeRateName,
+			Usage: "the maximum fee rate in sat/vB a fee bump " +
+				"may use; uncapped if not set",
+		},
+	},
+}
+
+// parseKeyRecipient parses a template recipient in the form
+// script_key:internal_key:amount, where the amount may be a share in basis
+// points with a bps suffix.
+func parseKeyRecipient(recipient string) (*taprpc.TemplateRecipient, error) {
+	parts := strings.Split(recipient, ":")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid recipient %q, expected "+
+			"script_key:internal_key:amount", recipient)
+	}
+
+	scriptKey, err := hex.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid script key: %w", err)
+	}
+	internalKey, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid internal key: %w", err)
+	}
+
+	rpcRecipient := &taprpc.TemplateRecipient{
+		ScriptKey:   scriptKey,
+		InternalKey: internalKey,
+	}
+
+	amountStr := parts[2]
+	if strings.HasSuffix(amountStr, "bps") {
+		bpsStr := strings.TrimSuffix(amountStr, "bps")
+		bps, err := strconv.ParseUint(bpsStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid share %q: %w",
+				amountStr, err)
+		}
+		rpcRecipient.ShareBps = uint32(bps)
+
+		return rpcRecipient, nil
+	}
+
+	rpcRecipient.Amount, err = strconv.ParseUint(amountStr, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount %q: %w", amountStr, err)
+	}
+
+	return rpcRecipient, nil
+}
+
+func createTemplate(ctx *cli.Context) error {
+	if !ctx.IsSet(templateNameName) || !ctx.IsSet(assetIDName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	assetID, err := hex.DecodeString(ctx.String(assetIDName))
+	if err != nil {
+		return fmt.Errorf("invalid asset ID: %w", err)
+	}
+
+	priority, err := parseParcelPriority(ctx.String(priorityName))
+	if err != nil {
+		return err
+	}
+
+	var recipients []*taprpc.TemplateRecipient
+	for _, addr := range ctx.StringSlice(addrName) {
+		recipients = append(recipients, &taprpc.TemplateRecipient{
+			TapAddr: addr,
+		})
+	}
+	for _, recipient := range ctx.StringSlice(keyRecipientName) {
+		rpcRecipient, err := parseKeyRecipient(recipient)
+		if err != nil {
+			return err
+		}
+		recipients = append(recipients, rpcRecipient)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.CreateTransferTemplate(
+		ctxc, &taprpc.CreateTransferTemplateRequest{
+			Template: &taprpc.TransferTemplate{
+				Name:       ctx.String(templateNameName),
+				AssetId:    assetID,
+				Recipients: recipients,
+				FeePolicy: &taprpc.TemplateFeePolicy{
+					Priority: priority,
+					ConfTargetSeconds: uint64(
+						ctx.Duration(
+							confTargetName,
+						).Seconds(),
+					),
+					FeeBumpIntervalSeconds: uint32(
+						ctx.Duration(
+							feeBumpIntervalName,
+						).Seconds(),
+					),
+					FeeBumpPercent: uint32(
+						ctx.Uint64(feeBumpPercentName),
+					),
+					MaxFeeRateSatPerVbyte: ctx.Uint64(
+						maxFeeRateName,
+					),
+				},
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create template: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listTemplatesCommand = cli.Command{
+	Name:        "list",
+	Usage:       "list transfer templates",
+	Description: "list the transfer templates and their recipients",
+	Action:      listTemplates,
+}
+
+func listTemplates(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListTransferTemplates(
+		ctxc, &taprpc.ListTransferTemplatesRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list templates: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var deleteTemplateCommand = cli.Command{
+	Name:   "delete",
+	Usage:  "delete a transfer template",
+	Action: deleteTemplate,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  templateNameName,
+			Usage: "the name of the template to delete",
+		},
+	},
+}
+
+func deleteTemplate(ctx *cli.Context) error {
+	if !ctx.IsSet(templateNameName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.DeleteTransferTemplate(
+		ctxc, &taprpc.DeleteTransferTemplateRequest{
+			Name: ctx.String(templateNameName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to delete template: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var executeTemplateCommand = cli.Command{
+	Name:  "execute",
+	Usage: "pay all recipients of a transfer template",
+	Description: "pay all recipients of a transfer template in a single " +
+		"transfer; the total amount is split among the recipients " +
+		"with a share",
+	Action: executeTemplate,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  templateNameName,
+			Usage: "the name of the template to execute",
+		},
+		cli.Uint64Flag{
+			Name: totalAmountName,
+			Usage: "the total amount split among the recipients " +
+				"with a share; must not be set if the " +
+				"template only has fixed amounts",
+		},
+	},
+}
+
+func executeTemplate(ctx *cli.Context) error {
+	if !ctx.IsSet(templateNameName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ExecuteTransferTemplate(
+		ctxc, &taprpc.ExecuteTransferTemplateRequest{
+			Name:        ctx.String(templateNameName),
+			TotalAmount: ctx.Uint64(totalAmountName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to execute template: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var parcelQueueCommand = cli.Command{
+	Name:  "queue",
+	Usage: "show the outbound transfer queue",
+	Description: "show the number of queued and active outbound " +
+		"transfers of each priority class",
+	Action: parcelQueueStats,
+}
+
+func parcelQueueStats(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ParcelQueueStats(
+		ctxc, &taprpc.ParcelQueueStatsRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to query transfer queue: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	parcelIDName = "parcel_id"
+
+	disableName = "disable"
+)
+
+var parcelLogCommand = cli.Command{
+	Name:  "parcellog",
+	Usage: "debug a single outbound transfer",
+	Description: "turn on debug logging scoped to a single outbound " +
+		"transfer and export its structured log",
+	Subcommands: []cli.Command{
+		parcelDebugCommand,
+		exportParcelLogCommand,
+	},
+}
+
+var parcelDebugCommand = cli.Command{
+	Name:  "debug",
+	Usage: "toggle debug logging of a single outbound transfer",
+	Description: "enable debug logging scoped to the outbound transfer " +
+		"with the given parcel ID, without changing the global log " +
+		"level",
+	Action: setParcelDebug,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  parcelIDName,
+			Usage: "the parcel ID of the transfer to debug",
+		},
+		cli.BoolFlag{
+			Name:  disableName,
+			Usage: "disable scoped debug logging again",
+		},
+	},
+}
+
+func setParcelDebug(ctx *cli.Context) error {
+	if !ctx.IsSet(parcelIDName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SetParcelDebugLogging(
+		ctxc, &taprpc.SetParcelDebugLoggingRequest{
+			ParcelId: ctx.Uint64(parcelIDName),
+			Enabled:  !ctx.Bool(disableName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to set parcel debug logging: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var exportParcelLogCommand = cli.Command{
+	Name:  "export",
+	Usage: "export the structured log of a single outbound transfer",
+	Description: "export the executed states, backend calls and debug " +
+		"messages of the outbound transfer with the given parcel ID",
+	Action: exportParcelLog,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  parcelIDName,
+			Usage: "the parcel ID of the transfer to export",
+		},
+	},
+}
+
+func exportParcelLog(ctx *cli.Context) error {
+	if !ctx.IsSet(parcelIDName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ExportParcelLog(
+		ctxc, &taprpc.ExportParcelLogRequest{
+			ParcelId: ctx.Uint64(parcelIDName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to export parcel log: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listTransfersCommand = cli.Command{
+	Name:      "transfers",
+	ShortName: "t",
+	Usage:     "list asset transfers",
+	Description: "list outgoing transfers of all assets or a selected " +
+		"asset",
+	Action: listTransfers,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: assetIDName,
+			Usage: "A specific asset ID to list outgoing " +
+				"transfers for",
+		},
+	},
+}
+
+func listTransfers(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &taprpc.ListTransfersRequest{}
+	resp, err := client.ListTransfers(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to list asset transfers: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	anchorTxidName = "anchor_txid"
+)
+
+var listDeliveriesCommand = cli.Command{
+	Name:  "deliveries",
+	Usage: "list proof delivery attempts",
+	Description: "list the attempts of the proof courier to deliver the " +
+		"proofs of outgoing transfers to their receivers",
+	Action: listDeliveries,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: anchorTxidName,
+			Usage: "only list attempts for the transfer with the " +
+				"given anchor transaction ID",
+		},
+		cli.StringFlag{
+			Name: scriptKeyName,
+			Usage: "only list attempts for the receiver with the " +
+				"given script key",
+		},
+	},
+}
+
+func listDeliveries(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &taprpc.ListProofDeliveryAttemptsRequest{}
+	if ctx.IsSet(anchorTxidName) {
+		txid, err := chainhash.NewHashFromStr(
+			ctx.String(anchorTxidName),
+		)
+		if err != nil {
+			return fmt.Errorf("invalid anchor txid: %w", err)
+		}
+		req.AnchorTxHash = txid[:]
+	}
+	if ctx.IsSet(scriptKeyName) {
+		scriptKey, err := hex.DecodeString(ctx.String(scriptKeyName))
+		if err != nil {
+			return fmt.Errorf("invalid script key: %w", err)
+		}
+		req.ScriptKey = scriptKey
+	}
+
+	resp, err := client.ListProofDeliveryAttempts(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to list proof delivery attempts: %w",
+			err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	statementFormatName = "format"
+)
+
+var exportStatementCommand = cli.Command{
+	Name:  "statement",
+	Usage: "export an accounting statement of asset transfers",
+	Description: "export all outgoing transfers and completed incoming " +
+		"address receives as a CSV or JSON lines statement",
+	Action: exportStatement,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  statementFormatName,
+			Usage: "the format of the statement (csv or jsonl)",
+			Value: "csv",
+		},
+	},
+}
+
+func exportStatement(ctx *cli.Context) error {
+	var format taprpc.StatementFormat
+	switch ctx.String(statementFormatName) {
+	case "csv":
+		format = taprpc.StatementFormat_STATEMENT_FORMAT_CSV
+
+	case "jsonl":
+		format = taprpc.StatementFormat_STATEMENT_FORMAT_JSONL
+
+	default:
+		return fmt.Errorf("unknown statement format: %v",
+			ctx.String(statementFormatName))
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ExportTransferStatement(
+		ctxc, &taprpc.ExportTransferStatementRequest{
+			Format: format,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to export statement: %w", err)
+	}
+
+	_, err = os.Stdout.Write(resp.Statement)
+	return err
+}
+
+const freezeReasonName = "reason"
+
+var freezeAssetsCommand = cli.Command{
+	Name:  "freeze",
+	Usage: "freeze asset outputs",
+	Description: "freeze the asset with the given script key at the " +
+		"given anchor outpoint, or all assets at the outpoint if no " +
+		"script key is given, so they can't be spent until they are " +
+		"unfrozen again; no other asset anchored at the same outpoint " +
+		"can be spent while an asset is frozen",
+	Action: freezeAssets,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  outpointName,
+			Usage: "the anchor outpoint of the assets to freeze",
+		},
+		cli.StringFlag{
+			Name:  scriptKeyName,
+			Usage: "the optional script key of the asset to freeze",
+		},
+		cli.StringFlag{
+			Name:  freezeReasonName,
+			Usage: "the reason the assets are frozen for",
+		},
+	},
+}
+
+func freezeAssets(ctx *cli.Context) error {
+	if ctx.String(outpointName) == "" ||
+		ctx.String(freezeReasonName) == "" {
+
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	scriptKey, err := hex.DecodeString(ctx.String(scriptKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid script key: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.FreezeAssetOutputs(
+		ctxc, &taprpc.FreezeAssetOutputsRequest{
+			AnchorOutpoint: ctx.String(outpointName),
+			ScriptKey:      scriptKey,
+			Reason:         ctx.String(freezeReasonName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to freeze assets: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var unfreezeAssetsCommand = cli.Command{
+	Name:  "unfreeze",
+	Usage: "unfreeze asset outputs",
+	Description: "unfreeze the frozen asset with the given script key at " +
+		"the given anchor outpoint, or all frozen assets at the " +
+		"outpoint if no script key is given",
+	Action: unfreezeAssets,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  outpointName,
+			Usage: "the anchor outpoint of the assets to unfreeze",
+		},
+		cli.StringFlag{
+			Name:  scriptKeyName,
+			Usage: "the optional script key of the asset to unfreeze",
+		},
+	},
+}
+
+func unfreezeAssets(ctx *cli.Context) error {
+	if ctx.String(outpointName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	scriptKey, err := hex.DecodeString(ctx.String(scriptKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid script key: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.UnfreezeAssetOutputs(
+		ctxc, &taprpc.UnfreezeAssetOutputsRequest{
+			AnchorOutpoint: ctx.String(outpointName),
+			ScriptKey:      scriptKey,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to unfreeze assets: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listFrozenAssetsCommand = cli.Command{
+	Name:        "frozen",
+	Usage:       "list frozen asset outputs",
+	Description: "list all frozen asset outputs and why they were frozen",
+	Action:      listFrozenAssets,
+}
+
+func listFrozenAssets(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListFrozenAssetOutputs(
+		ctxc, &taprpc.ListFrozenAssetOutputsRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list frozen assets: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const priceAnnotationName = "price"
+
+var annotateLotCommand = cli.Command{
+	Name:  "annotatelot",
+	Usage: "annotate the price of an asset lot",
+	Description: "set the acquisition price annotation of the given " +
+		"unspent asset lot, which is carried over to the change of " +
+		"transfers that spend the lot",
+	ArgsUsage: "txid:vout:asset_id:script_key",
+	Action:    annotateLot,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  priceAnnotationName,
+			Usage: "the annotation of the acquisition price",
+		},
+	},
+}
+
+func annotateLot(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	lotID, err := parseAssetLotID(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.AnnotateAssetLot(
+		ctxc, &taprpc.AnnotateAssetLotRequest{
+			Lot:             lotID,
+			PriceAnnotation: ctx.String(priceAnnotationName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to annotate lot: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var aliasCommand = cli.Command{
+	Name:  "alias",
+	Usage: "manage human-readable aliases of assets",
+	Description: "manage the local registry of human-readable aliases " +
+		"of asset IDs and asset groups",
+	Subcommands: []cli.Command{
+		setAliasCommand,
+		removeAliasCommand,
+		listAliasesCommand,
+		resolveAliasCommand,
+	},
+}
+
+var setAliasCommand = cli.Command{
+	Name:  "set",
+	Usage: "set the alias of an asset ID or asset group",
+	Description: "set the alias of either an asset ID or a group key, " +
+		"replacing any existing alias of the same asset ID or group",
+	ArgsUsage: "name",
+	Action:    setAlias,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID the alias should refer to",
+		},
+		cli.StringFlag{
+			Name:  assetGroupKeyName,
+			Usage: "the group key the alias should refer to",
+		},
+	},
+}
+
+func setAlias(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	req := &taprpc.SetAssetAliasRequest{
+		Name: ctx.Args().First(),
+	}
+	switch {
+	case ctx.IsSet(assetIDName) && ctx.IsSet(assetGroupKeyName):
+		return fmt.Errorf("only the asset_id or group_key can be set")
+
+	case ctx.IsSet(assetIDName):
+		assetID, err := hex.DecodeString(ctx.String(assetIDName))
+		if err != nil {
+			return fmt.Errorf("invalid asset ID: %w", err)
+		}
+		req.Target = &taprpc.SetAssetAliasRequest_AssetId{
+			AssetId: assetID,
+		}
+
+	case ctx.IsSet(assetGroupKeyName):
+		groupKey, err := hex.DecodeString(ctx.String(assetGroupKeyName))
+		if err != nil {
+			return fmt.Errorf("invalid group key: %w", err)
+		}
+		req.Target = &taprpc.SetAssetAliasRequest_GroupKey{
+			GroupKey: groupKey,
+		}
+
+	default:
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SetAssetAlias(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to set alias: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var removeAliasCommand = cli.Command{
+	Name:      "remove",
+	Usage:     "remove an alias",
+	ArgsUsage: "name",
+	Action:    removeAlias,
+}
+
+func removeAlias(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.RemoveAssetAlias(
+		ctxc, &taprpc.RemoveAssetAliasRequest{
+			Name: ctx.Args().First(),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to remove alias: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listAliasesCommand = cli.Command{
+	Name:   "list",
+	Usage:  "list all aliases of the local registry",
+	Action: listAliases,
+}
+
+func listAliases(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListAssetAliases(
+		ctxc, &taprpc.ListAssetAliasesRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list aliases: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var resolveAliasCommand = cli.Command{
+	Name:  "resolve",
+	Usage: "resolve an alias to an asset ID or group key",
+	Description: "resolve an alias through the local registry and, if " +
+		"configured, the remote registry; aliases resolved through " +
+		"the remote registry are marked as unverified",
+	ArgsUsage: "name",
+	Action:    resolveAlias,
+}
+
+func resolveAlias(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ResolveAssetAlias(
+		ctxc, &taprpc.ResolveAssetAliasRequest{
+			Name: ctx.Args().First(),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to resolve alias: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	watchLabelName = "label"
+
+	watchIDName = "id"
+
+	includeSpentName = "include_spent"
+)
+
+var watchCommand = cli.Command{
+	Name:  "watch",
+	Usage: "watch assets owned by external keys",
+	Description: "manage assets that are owned by external keys and are " +
+		"tracked purely for monitoring; watched assets never count " +
+		"towards the balance of the wallet, and an alert is raised " +
+		"once their anchor output is spent",
+	Subcommands: []cli.Command{
+		addWatchedAssetCommand,
+		removeWatchedAssetCommand,
+		listWatchedAssetsCommand,
+	},
+}
+
+var addWatchedAssetCommand = cli.Command{
+	Name:  "add",
+	Usage: "start watching an asset from its proof file",
+	Description: "import the proof file of an asset owned by an " +
+		"external key to watch its anchor output for spends",
+	Action: addWatchedAsset,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: proofPathName,
+			Usage: "the path to the proof file on disk; use the " +
+				"dash character (-) to read from stdin instead",
+		},
+		cli.StringFlag{
+			Name:  watchLabelName,
+			Usage: "an optional label for the watched asset",
+		},
+	},
+}
+
+func addWatchedAsset(ctx *cli.Context) error {
+	if ctx.String(proofPathName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(proofPathName))
+	rawFile, err := readFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read proof file: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.WatchAsset(ctxc, &taprpc.WatchAssetRequest{
+		RawProofFile: rawFile,
+		Label:        ctx.String(watchLabelName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to watch asset: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var removeWatchedAssetCommand = cli.Command{
+	Name:   "remove",
+	Usage:  "stop watching an asset",
+	Action: removeWatchedAsset,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name:  watchIDName,
+			Usage: "the ID of the watched asset",
+		},
+	},
+}
+
+func removeWatchedAsset(ctx *cli.Context) error {
+	if !ctx.IsSet(watchIDName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.UnwatchAsset(ctxc, &taprpc.UnwatchAssetRequest{
+		Id: ctx.Int64(watchIDName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to unwatch asset: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listWatchedAssetsCommand = cli.Command{
+	Name:   "list",
+	Usage:  "list all watched assets",
+	Action: listWatchedAssets,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: includeSpentName,
+			Usage: "include watched assets with an already spent " +
+				"anchor output",
+		},
+	},
+}
+
+func listWatchedAssets(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListWatchedAssets(
+		ctxc, &taprpc.ListWatchedAssetsRequest{
+			IncludeSpent: ctx.Bool(includeSpentName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list watched assets: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listAtRiskAssetsCommand = cli.Command{
+	Name:  "atrisk",
+	Usage: "list assets whose anchor output was spent unexpectedly",
+	Description: "list all assets whose anchor output was spent by a " +
+		"transaction that wasn't created by the daemon; such assets " +
+		"are frozen and can no longer be assumed to be under the " +
+		"control of the wallet",
+	Action: listAtRiskAssets,
+}
+
+func listAtRiskAssets(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListAtRiskAssets(
+		ctxc, &taprpc.ListAtRiskAssetsRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list at risk assets: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	metaName = "asset_meta"
+
+	resolveURIName = "resolve_uri"
+)
+
+var fetchMetaCommand = cli.Command{
+	Name:  "meta",
+	Usage: "fetch asset meta",
+	Description: "fetch the meta bytes for an asset based on the " +
+		"asset_id or meta_hash",
+	Action: fetchMeta,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "asset_id to fetch meta for",
+		},
+		cli.StringFlag{
+			Name:  metaName,
+			Usage: "meta_hash to fetch meta for",
+		},
+		cli.BoolFlag{
+			Name: resolveURIName,
+			Usage: "if the meta references off-chain content, " +
+				"also fetch and verify that content",
+		},
+	},
+}
+
+func fetchMeta(ctx *cli.Context) error {
+	switch {
+	case ctx.IsSet(metaName) && ctx.IsSet(assetIDName):
+		return fmt.Errorf("only the asset_id or meta_hash can be set")
+
+	case !ctx.IsSet(assetIDName) && !ctx.IsSet(metaName):
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &taprpc.FetchAssetMetaRequest{
+		ResolveUri: ctx.Bool(resolveURIName),
+	}
 	if ctx.IsSet(assetIDName) {
 		assetIDHex, err := hex.DecodeString(ctx.String(assetIDName))
 		if err != nil {
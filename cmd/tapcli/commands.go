@@ -3,14 +3,20 @@ package main
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
 
+	tap "github.com/lightninglabs/taproot-assets"
+	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/urfave/cli"
 	"google.golang.org/protobuf/proto"
+	"gopkg.in/macaroon.v2"
 )
 
 func getContext() context.Context {
@@ -131,3 +137,124 @@ func getInfo(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+const (
+	restrictMacaroonPathName = "macaroon_file"
+	restrictSaveToName       = "save_to"
+	readOnlyName             = "read_only"
+	sendAssetIDName          = "send_asset_id"
+	sendMaxAmountName        = "send_max_amount"
+	sendDailyLimitName       = "send_daily_limit"
+)
+
+var restrictMacaroonCommand = cli.Command{
+	Name:  "restrictmacaroon",
+	Usage: "Restrict what a macaroon can be used for.",
+	Description: `
+	Add caveats to an existing macaroon that restrict it to read-only RPCs,
+	to sending specific assets or that limit the amount of each asset that
+	can be sent per call and per UTC day. The caveats are added offline
+	and are enforced by tapd. Restrictions can only ever be added, so the
+	restricted macaroon can be handed out to less trusted parties.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  restrictMacaroonPathName,
+			Usage: "the macaroon to restrict",
+		},
+		cli.StringFlag{
+			Name:  restrictSaveToName,
+			Usage: "the file to write the restricted macaroon to",
+		},
+		cli.BoolFlag{
+			Name:  readOnlyName,
+			Usage: "restrict the macaroon to read-only RPCs",
+		},
+		cli.StringSliceFlag{
+			Name: sendAssetIDName,
+			Usage: "restrict the macaroon to sending the asset " +
+				"with the given ID; can be specified " +
+				"multiple times",
+		},
+		cli.Uint64Flag{
+			Name: sendMaxAmountName,
+			Usage: "the maximum amount of each asset a single " +
+				"send can move",
+		},
+		cli.Uint64Flag{
+			Name: sendDailyLimitName,
+			Usage: "the maximum amount of each asset that can be " +
+				"sent per UTC day",
+		},
+	},
+	Action: restrictMacaroon,
+}
+
+func restrictMacaroon(ctx *cli.Context) error {
+	if ctx.String(restrictMacaroonPathName) == "" ||
+		ctx.String(restrictSaveToName) == "" {
+
+		return cli.ShowCommandHelp(ctx, "restrictmacaroon")
+	}
+
+	var constraints []macaroons.Constraint
+	if ctx.Bool(readOnlyName) {
+		constraints = append(constraints, tap.ReadOnlyConstraint())
+	}
+	if ctx.IsSet(sendAssetIDName) {
+		assetIDs := make([]asset.ID, 0)
+		for _, idStr := range ctx.StringSlice(sendAssetIDName) {
+			idBytes, err := hex.DecodeString(idStr)
+			if err != nil || len(idBytes) != len(asset.ID{}) {
+				return fmt.Errorf("invalid asset ID %v", idStr)
+			}
+
+			var assetID asset.ID
+			copy(assetID[:], idBytes)
+			assetIDs = append(assetIDs, assetID)
+		}
+
+		constraints = append(
+			constraints, tap.SendAssetsConstraint(assetIDs...),
+		)
+	}
+	if ctx.Uint64(sendMaxAmountName) != 0 {
+		constraints = append(constraints, tap.SendMaxAmountConstraint(
+			ctx.Uint64(sendMaxAmountName),
+		))
+	}
+	if ctx.Uint64(sendDailyLimitName) != 0 {
+		constraints = append(constraints, tap.SendDailyLimitConstraint(
+			ctx.Uint64(sendDailyLimitName),
+		))
+	}
+	if len(constraints) == 0 {
+		return fmt.Errorf("at least one restriction must be set")
+	}
+
+	macPath := lncfg.CleanAndExpandPath(
+		ctx.String(restrictMacaroonPathName),
+	)
+	macBytes, err := os.ReadFile(macPath)
+	if err != nil {
+		return fmt.Errorf("unable to read macaroon: %w", err)
+	}
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return fmt.Errorf("unable to decode macaroon: %w", err)
+	}
+
+	restrictedMac, err := macaroons.AddConstraints(mac, constraints...)
+	if err != nil {
+		return fmt.Errorf("unable to restrict macaroon: %w", err)
+	}
+	restrictedBytes, err := restrictedMac.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	return writeToFile(
+		lncfg.CleanAndExpandPath(ctx.String(restrictSaveToName)),
+		restrictedBytes,
+	)
+}
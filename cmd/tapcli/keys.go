@@ -0,0 +1,354 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/urfave/cli"
+)
+
+var keysCommands = []cli.Command{
+	{
+		Name:      "keys",
+		ShortName: "k",
+		Usage:     "Inspect and back up the keys of the daemon.",
+		Category:  "Keys",
+		Subcommands: []cli.Command{
+			listKeyDerivationsCommand,
+			listReusedAnchorKeysCommand,
+			auditScriptKeysCommand,
+			resolveFlaggedScriptKeyCommand,
+			exportScriptKeyDisclosuresCommand,
+			descriptorCommands,
+		},
+	},
+}
+
+const (
+	keyPurposeName = "purpose"
+
+	rawKeyName = "raw_key"
+
+	auditorKeyName = "auditor_key"
+
+	descriptorPathName = "descriptor_file"
+)
+
+var listKeyDerivationsCommand = cli.Command{
+	Name:      "derivations",
+	ShortName: "d",
+	Usage:     "list the key derivation audit log",
+	Description: "list all keys derived for minting and transfers, " +
+		"together with what they were derived for",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: keyPurposeName,
+			Usage: "only list keys derived for the given purpose, " +
+				"one of batch_internal_key, mint_script_key, " +
+				"group_key, change_script_key or " +
+				"anchor_internal_key",
+		},
+		cli.StringFlag{
+			Name:  rawKeyName,
+			Usage: "only list derivations of the given raw key",
+		},
+	},
+	Action: listKeyDerivations,
+}
+
+func listKeyDerivations(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &taprpc.ListKeyDerivationsRequest{}
+	if ctx.IsSet(keyPurposeName) {
+		purposeName := "KEY_PURPOSE_" + strings.ToUpper(
+			ctx.String(keyPurposeName),
+		)
+		purpose, ok := taprpc.KeyPurpose_value[purposeName]
+		if !ok {
+			return fmt.Errorf("unknown key purpose: %v",
+				ctx.String(keyPurposeName))
+		}
+		req.FilterPurpose = taprpc.KeyPurpose(purpose)
+	}
+	if ctx.IsSet(rawKeyName) {
+		rawKey, err := hex.DecodeString(ctx.String(rawKeyName))
+		if err != nil {
+			return fmt.Errorf("invalid raw key: %w", err)
+		}
+		req.FilterRawKey = rawKey
+	}
+
+	resp, err := client.ListKeyDerivations(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to list key derivations: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listReusedAnchorKeysCommand = cli.Command{
+	Name:      "reused",
+	ShortName: "r",
+	Usage:     "list internal keys used for multiple anchor outputs",
+	Description: "list all internal keys that were used for more than " +
+		"one anchor output, which links the anchor outputs on chain",
+	Action: listReusedAnchorKeys,
+}
+
+func listReusedAnchorKeys(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListReusedAnchorKeys(
+		ctxc, &taprpc.ListReusedAnchorKeysRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list reused anchor keys: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var auditScriptKeysCommand = cli.Command{
+	Name:      "audit",
+	ShortName: "a",
+	Usage:     "audit the script keys for reuse",
+	Description: `
+	Scan the transfer log and the proof archive for script keys that are
+	used by more than one unspent asset or by the outputs of more than one
+	transfer, and flag them. No assets are sent to a flagged script key
+	until the flag is resolved. Lists the reused script keys found along
+	with all flags.
+	`,
+	Action: auditScriptKeys,
+}
+
+func auditScriptKeys(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.AuditScriptKeys(
+		ctxc, &taprpc.AuditScriptKeysRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to audit script keys: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var resolveFlaggedScriptKeyCommand = cli.Command{
+	Name:      "resolve",
+	ShortName: "v",
+	Usage:     "resolve the flags of a reused script key",
+	Description: "resolve the open flags of a reused script key, which " +
+		"allows sending assets to it again",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  scriptKeyName,
+			Usage: "the hex encoded 33-byte script key to resolve",
+		},
+	},
+	Action: resolveFlaggedScriptKey,
+}
+
+func resolveFlaggedScriptKey(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if !ctx.IsSet(scriptKeyName) {
+		return fmt.Errorf("%s must be set", scriptKeyName)
+	}
+	scriptKey, err := hex.DecodeString(ctx.String(scriptKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid script key: %w", err)
+	}
+
+	resp, err := client.ResolveFlaggedScriptKey(
+		ctxc, &taprpc.ResolveFlaggedScriptKeyRequest{
+			ScriptKey: scriptKey,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to resolve script key: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var exportScriptKeyDisclosuresCommand = cli.Command{
+	Name:      "disclosures",
+	ShortName: "s",
+	Usage:     "export the script key disclosures of owned assets",
+	Description: `
+	Export the internal key and tweak of the script key of each owned asset
+	output. With these, a third party auditor can re-derive the script keys
+	and audit the holdings of the daemon without being handed any spending
+	keys. If an auditor key is given, the disclosures are encrypted to it.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: assetIDName,
+			Usage: "only export the disclosures of outputs holding " +
+				"the asset with the given ID",
+		},
+		cli.StringFlag{
+			Name: auditorKeyName,
+			Usage: "the hex encoded 33-byte public key of the " +
+				"auditor to encrypt the disclosures to",
+		},
+	},
+	Action: exportScriptKeyDisclosures,
+}
+
+func exportScriptKeyDisclosures(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &taprpc.ExportScriptKeyDisclosuresRequest{}
+	if ctx.IsSet(assetIDName) {
+		assetID, err := hex.DecodeString(ctx.String(assetIDName))
+		if err != nil {
+			return fmt.Errorf("invalid asset ID: %w", err)
+		}
+		req.AssetId = assetID
+	}
+	if ctx.IsSet(auditorKeyName) {
+		auditorKey, err := hex.DecodeString(ctx.String(auditorKeyName))
+		if err != nil {
+			return fmt.Errorf("invalid auditor key: %w", err)
+		}
+		req.AuditorKey = auditorKey
+	}
+
+	resp, err := client.ExportScriptKeyDisclosures(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to export script key disclosures: %w",
+			err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var descriptorCommands = cli.Command{
+	Name:      "descriptor",
+	ShortName: "desc",
+	Usage:     "export and import the asset descriptor of the wallet",
+	Description: `
+	The asset descriptor contains the key derivation scheme and the ranges
+	of keys derived by the daemon, the groups and IDs of its assets and the
+	universe servers to fetch their proofs from. Together with the seed of
+	the backing lnd node, it suffices to recover the assets of the wallet.
+	It doesn't contain any private key material.
+	`,
+	Subcommands: []cli.Command{
+		exportAssetDescriptorCommand,
+		importAssetDescriptorCommand,
+	},
+}
+
+var exportAssetDescriptorCommand = cli.Command{
+	Name:      "export",
+	ShortName: "e",
+	Usage:     "export the asset descriptor of the wallet",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: descriptorPathName,
+			Usage: "(optional) the file to write the raw " +
+				"descriptor to; use the dash character (-) " +
+				"to write the raw binary descriptor to " +
+				"stdout instead of the default JSON format",
+		},
+	},
+	Action: exportAssetDescriptor,
+}
+
+func exportAssetDescriptor(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ExportAssetDescriptor(
+		ctxc, &taprpc.ExportAssetDescriptorRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to export asset descriptor: %w", err)
+	}
+
+	// Write the raw (binary) descriptor to a file (or stdout) instead of
+	// in the JSON format.
+	if ctx.String(descriptorPathName) != "" {
+		filePath := lncfg.CleanAndExpandPath(
+			ctx.String(descriptorPathName),
+		)
+		return writeToFile(filePath, resp.AssetDescriptor)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var importAssetDescriptorCommand = cli.Command{
+	Name:      "import",
+	ShortName: "i",
+	Usage:     "import an asset descriptor into a restored wallet",
+	Description: `
+	Import the asset descriptor of a wallet into this daemon, which must be
+	backed by an lnd node restored from the same seed. The key families of
+	the wallet are advanced past the keys already in use and the universe
+	servers of the descriptor are added to the local federation.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: descriptorPathName,
+			Usage: "the path to the raw descriptor file on " +
+				"disk; use the dash character (-) to read " +
+				"from stdin instead",
+		},
+	},
+	Action: importAssetDescriptor,
+}
+
+func importAssetDescriptor(ctx *cli.Context) error {
+	switch {
+	case ctx.String(descriptorPathName) == "":
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(descriptorPathName))
+	rawDescriptor, err := readFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read descriptor file: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ImportAssetDescriptor(
+		ctxc, &taprpc.ImportAssetDescriptorRequest{
+			AssetDescriptor: rawDescriptor,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to import asset descriptor: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -218,7 +218,7 @@ func extractPathArgs(ctx *cli.Context) (string, string, error) {
 	// determine the correct path to the macaroon when not specified.
 	network := strings.ToLower(ctx.GlobalString("network"))
 	switch network {
-	case "testnet", "regtest", "simnet", "signet":
+	case "testnet", "testnet4", "regtest", "simnet", "signet":
 	default:
 		return "", "", fmt.Errorf("unknown network: %v", network)
 	}
@@ -331,11 +331,13 @@ func main() {
 		debugLevelCommand,
 		profileSubCommand,
 		getInfoCommand,
+		restrictMacaroonCommand,
 	}
 	app.Commands = append(app.Commands, assetsCommands...)
 	app.Commands = append(app.Commands, addrCommands...)
 	app.Commands = append(app.Commands, proofCommands...)
 	app.Commands = append(app.Commands, universeCommands...)
+	app.Commands = append(app.Commands, keysCommands...)
 	app.Commands = append(app.Commands, devCommands...)
 
 	if err := app.Run(os.Args); err != nil {
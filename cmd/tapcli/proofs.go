@@ -25,6 +25,8 @@ var proofCommands = []cli.Command{
 			exportProofCommand,
 			proveOwnershipCommand,
 			verifyOwnershipCommand,
+			proofArchiveStatsCommand,
+			bakeArchiveMacaroonCommand,
 		},
 	},
 }
@@ -35,6 +37,9 @@ const (
 	proofAtDepthName      = "proof_at_depth"
 	withPrevWitnessesName = "latest_proof"
 	withMetaRevealName    = "meta_reveal"
+
+	archiveUserName     = "user"
+	archiveMacaroonName = "save_to"
 )
 
 var verifyProofCommand = cli.Command{
@@ -392,3 +397,83 @@ func writeToFile(fileName string, content []byte) error {
 
 	return nil
 }
+
+var proofArchiveStatsCommand = cli.Command{
+	Name:  "tiers",
+	Usage: "show the size of the proof archive tiers",
+	Description: "show the number and total size of the proofs in the " +
+		"hot and the cold tier of the on-disk proof archive, and " +
+		"the number of damaged proofs found by the proof scrubber",
+	Action: proofArchiveStats,
+}
+
+func proofArchiveStats(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ProofArchiveStats(
+		ctxc, &taprpc.ProofArchiveStatsRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to query proof archive: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var bakeArchiveMacaroonCommand = cli.Command{
+	Name:  "archivemacaroon",
+	Usage: "bake a macaroon for a user of the central proof archive",
+	Description: "bake a macaroon that only grants access to the proofs " +
+		"of the given user in the central proof archive served by " +
+		"this node; the macaroon is used by the nodes of the user " +
+		"through the proofarchive.macaroonpath option",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  archiveUserName,
+			Usage: "the user to scope the macaroon to",
+		},
+		cli.StringFlag{
+			Name: archiveMacaroonName,
+			Usage: "the file to write the binary macaroon to; if " +
+				"not set, the hex encoded macaroon is printed",
+		},
+	},
+	Action: bakeArchiveMacaroon,
+}
+
+func bakeArchiveMacaroon(ctx *cli.Context) error {
+	if ctx.String(archiveUserName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.BakeArchiveMacaroon(
+		ctxc, &taprpc.BakeArchiveMacaroonRequest{
+			User: ctx.String(archiveUserName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to bake macaroon: %w", err)
+	}
+
+	if ctx.String(archiveMacaroonName) != "" {
+		macBytes, err := hex.DecodeString(resp.Macaroon)
+		if err != nil {
+			return fmt.Errorf("invalid macaroon: %w", err)
+		}
+
+		filePath := lncfg.CleanAndExpandPath(
+			ctx.String(archiveMacaroonName),
+		)
+		return writeToFile(filePath, macBytes)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
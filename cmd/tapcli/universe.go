@@ -39,6 +39,7 @@ var universeCommands = []cli.Command{
 			universeSyncCommand,
 			universeFederationCommand,
 			universeInfoCommand,
+			universeSupplyReportCommand,
 			universeStatsCommand,
 		},
 	},
@@ -525,6 +526,8 @@ var universeFederationCommand = cli.Command{
 		universeFederationListCommand,
 		universeFederationAddCommand,
 		universeFederationDelCommand,
+		universeFederationProgressCommand,
+		universeFederationQuarantineCommand,
 	},
 }
 
@@ -649,6 +652,160 @@ func universeFederationDel(ctx *cli.Context) error {
 	return nil
 }
 
+var universeFederationProgressCommand = cli.Command{
+	Name:      "progress",
+	ShortName: "p",
+	Usage:     "show the progress of syncs with remote universes",
+	Description: `
+	Show the progress of the syncs with remote Universe servers, per server
+	and asset. For each asset, the remote root that was last synced to, the
+	number of fetched leaves and the last sync error are shown. The output
+	can be limited to a single server or asset.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: universeHostName,
+			Usage: "the host:port or just host of the remote " +
+				"universe",
+		},
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the universe",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the universe",
+		},
+	},
+	Action: universeFederationProgress,
+}
+
+func universeFederationProgress(ctx *cli.Context) error {
+	universeID, err := parseUniverseID(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SyncProgress(
+		ctxc, &universerpc.SyncProgressRequest{
+			UniverseHost: ctx.String(universeHostName),
+			Id:           universeID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeFederationQuarantineCommand = cli.Command{
+	Name:      "quarantine",
+	ShortName: "q",
+	Usage:     "manage proofs that failed validation during a sync",
+	Description: `
+	Manage the proofs that were fetched from remote Universe servers during
+	a sync, but failed validation. These proofs were not inserted into the
+	local Universe trees.
+	`,
+	Subcommands: []cli.Command{
+		universeQuarantineListCommand,
+		universeQuarantinePurgeCommand,
+	},
+}
+
+var universeQuarantineFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  universeHostName,
+		Usage: "the host:port or just host of the remote universe",
+	},
+	cli.StringFlag{
+		Name:  assetIDName,
+		Usage: "the asset ID of the universe",
+	},
+	cli.StringFlag{
+		Name:  groupKeyName,
+		Usage: "the group key of the universe",
+	},
+}
+
+var universeQuarantineListCommand = cli.Command{
+	Name:      "list",
+	ShortName: "l",
+	Usage:     "list quarantined proofs",
+	Description: `
+	List the quarantined proofs together with the reason they were rejected
+	for. The output can be limited to a single server or asset.
+	`,
+	Flags:  universeQuarantineFlags,
+	Action: universeQuarantineList,
+}
+
+func universeQuarantineList(ctx *cli.Context) error {
+	universeID, err := parseUniverseID(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListQuarantinedProofs(
+		ctxc, &universerpc.ListQuarantinedProofsRequest{
+			UniverseHost: ctx.String(universeHostName),
+			Id:           universeID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeQuarantinePurgeCommand = cli.Command{
+	Name:      "purge",
+	ShortName: "p",
+	Usage:     "purge quarantined proofs",
+	Description: `
+	Remove proofs from the quarantine. Without any flags, all quarantined
+	proofs are removed, otherwise only those of the given server or asset.
+	`,
+	Flags:  universeQuarantineFlags,
+	Action: universeQuarantinePurge,
+}
+
+func universeQuarantinePurge(ctx *cli.Context) error {
+	universeID, err := parseUniverseID(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.PurgeQuarantinedProofs(
+		ctxc, &universerpc.PurgeQuarantinedProofsRequest{
+			UniverseHost: ctx.String(universeHostName),
+			Id:           universeID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var universeInfoCommand = cli.Command{
 	Name:      "info",
 	ShortName: "i",
@@ -673,6 +830,60 @@ func universeInfo(ctx *cli.Context) error {
 	return nil
 }
 
+const (
+	supplyVerifyName = "verify"
+)
+
+var universeSupplyReportCommand = cli.Command{
+	Name:  "supplyreport",
+	Usage: "show a signed report about the total supply of an asset",
+	Description: `
+	Show a report about the total supply of an asset or asset group, signed
+	by the identity key of the node. The supply is re-derived by verifying
+	each issuance proof of the asset's universe against the chain. Unless
+	--verify is set, the latest report of the background supply verifier is
+	shown, if there is one.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the universe to report on",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the universe to report on",
+		},
+		cli.BoolFlag{
+			Name: supplyVerifyName,
+			Usage: "verify the supply anew instead of showing " +
+				"the latest report",
+		},
+	},
+	Action: universeSupplyReport,
+}
+
+func universeSupplyReport(ctx *cli.Context) error {
+	universeID, err := parseUniverseID(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SupplyReport(ctxc, &universerpc.SupplyReportRequest{
+		Id:     universeID,
+		Verify: ctx.Bool(supplyVerifyName),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var universeStatsCommand = cli.Command{
 	Name:      "stats",
 	ShortName: "s",
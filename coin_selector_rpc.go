@@ -0,0 +1,167 @@
+package taprootassets
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightninglabs/taproot-assets/taprpc/coinselectrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// RpcCoinSelectorCfg is the configuration of a connection to an external coin
+// selection service.
+type RpcCoinSelectorCfg struct {
+	// Addr is the host:port of the coin selection service.
+	Addr string
+
+	// TLSCertPath is the path to the TLS certificate of the coin selection
+	// service. If empty, the system's root certificates are used.
+	TLSCertPath string
+
+	// Insecure disables TLS for the connection to the coin selection
+	// service.
+	Insecure bool
+
+	// Timeout is the maximum time to wait for the service to select the
+	// coins of a transfer.
+	Timeout time.Duration
+}
+
+// RpcCoinSelector is an implementation of the tapfreighter.ExternalCoinSelector
+// interface that delegates coin selection to an external service over RPC.
+type RpcCoinSelector struct {
+	cfg *RpcCoinSelectorCfg
+
+	conn coinselectrpc.CoinSelectorClient
+}
+
+// NewRpcCoinSelector creates a new RpcCoinSelector that dials out to the
+// configured coin selection service.
+func NewRpcCoinSelector(cfg *RpcCoinSelectorCfg) (*RpcCoinSelector, error) {
+	var creds credentials.TransportCredentials
+	switch {
+	case cfg.Insecure:
+		creds = insecure.NewCredentials()
+
+	case cfg.TLSCertPath != "":
+		var err error
+		creds, err = credentials.NewClientTLSFromFile(
+			cfg.TLSCertPath, "",
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load coin selector "+
+				"TLS certificate: %w", err)
+		}
+
+	default:
+		creds = credentials.NewTLS(&tls.Config{})
+	}
+
+	rawConn, err := grpc.Dial(
+		cfg.Addr, grpc.WithTransportCredentials(creds),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to coin selector "+
+			"RPC server: %w", err)
+	}
+
+	return &RpcCoinSelector{
+		cfg:  cfg,
+		conn: coinselectrpc.NewCoinSelectorClient(rawConn),
+	}, nil
+}
+
+// SelectCoins sends the eligible commitments and the constraints of a transfer
+// to the external coin selection service and returns the lots it selected.
+//
+// NOTE: This is part of the tapfreighter.ExternalCoinSelector interface.
+func (r *RpcCoinSelector) SelectCoins(ctx context.Context,
+	constraints tapfreighter.CommitmentConstraints,
+	strategy tapfreighter.MultiCommitmentSelectStrategy,
+	eligible []*tapfreighter.AnchoredCommitment) ([]asset.PrevID, error) {
+
+	req := &coinselectrpc.SelectCoinsRequest{
+		MinAmount:     constraints.MinAmt,
+		Strategy:      marshalCoinSelectStrategy(strategy),
+		EligibleCoins: fn.Map(eligible, marshalEligibleCoin),
+	}
+	if constraints.AssetID != nil {
+		req.AssetId = fn.ByteSlice(*constraints.AssetID)
+	}
+	if constraints.GroupKey != nil {
+		req.GroupKey = constraints.GroupKey.SerializeCompressed()
+	}
+
+	ctxt, cancel := context.WithTimeout(ctx, r.cfg.Timeout)
+	defer cancel()
+
+	resp, err := r.conn.SelectCoins(ctxt, req)
+	if err != nil {
+		return nil, err
+	}
+
+	lots := make([]asset.PrevID, 0, len(resp.Selected))
+	for _, rpcLot := range resp.Selected {
+		lot, err := unmarshalAssetLotID(rpcLot)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selected lot: %w", err)
+		}
+
+		lots = append(lots, lot)
+	}
+
+	return lots, nil
+}
+
+// A compile time interface to ensure that RpcCoinSelector implements the
+// tapfreighter.ExternalCoinSelector interface.
+var _ tapfreighter.ExternalCoinSelector = (*RpcCoinSelector)(nil)
+
+// marshalEligibleCoin converts an eligible commitment to the coin that is sent
+// to the external coin selection service.
+func marshalEligibleCoin(
+	c *tapfreighter.AnchoredCommitment) *coinselectrpc.EligibleCoin {
+
+	assetID := c.Asset.ID()
+	scriptKey := c.Asset.ScriptKey.PubKey
+	rpcCoin := &coinselectrpc.EligibleCoin{
+		Lot: &taprpc.AssetLotID{
+			AnchorOutpoint: c.AnchorPoint.String(),
+			AssetId:        assetID[:],
+			ScriptKey:      scriptKey.SerializeCompressed(),
+		},
+		Amount:            c.Asset.Amount,
+		AnchorOutputValue: int64(c.AnchorOutputValue),
+		AnchorBlockHeight: c.AnchorBlockHeight,
+	}
+	if c.Lot != nil {
+		rpcCoin.LotInfo = marshalAssetLot(c.Lot)
+	}
+
+	return rpcCoin
+}
+
+// marshalCoinSelectStrategy converts a coin selection strategy to its RPC
+// counterpart.
+func marshalCoinSelectStrategy(
+	strategy tapfreighter.MultiCommitmentSelectStrategy) taprpc.CoinSelectStrategy {
+
+	switch strategy {
+	case tapfreighter.PreferOldestLot:
+		return taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_OLDEST_LOT
+
+	case tapfreighter.PreferNewestLot:
+		return taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_NEWEST_LOT
+
+	default:
+		return taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_MAX_AMOUNT
+	}
+}
@@ -1,6 +1,7 @@
 package commitment
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"math/rand"
@@ -1169,6 +1170,151 @@ func TestTapCommitmentDeepCopy(t *testing.T) {
 	)
 }
 
+// TestMergeTapCommitments tests that two Taproot Asset commitments can be
+// merged into a new one, and that colliding assets are rejected.
+func TestMergeTapCommitments(t *testing.T) {
+	t.Parallel()
+
+	// We create one asset tree that is split across both commitments, and
+	// one asset tree for each commitment alone.
+	genesis1 := asset.RandGenesis(t, asset.Normal)
+	groupKey1 := asset.RandGroupKey(t, genesis1)
+	asset1a := randAsset(t, genesis1, groupKey1)
+	asset1b := randAsset(t, genesis1, groupKey1)
+
+	genesis2 := asset.RandGenesis(t, asset.Normal)
+	asset2 := randAsset(t, genesis2, nil)
+
+	genesis3 := asset.RandGenesis(t, asset.Collectible)
+	asset3 := randAsset(t, genesis3, nil)
+
+	commitmentA, err := FromAssets(asset1a, asset2)
+	require.NoError(t, err)
+	commitmentB, err := FromAssets(asset1b, asset3)
+	require.NoError(t, err)
+
+	rootA := commitmentA.TapscriptRoot(nil)
+	rootB := commitmentB.TapscriptRoot(nil)
+
+	merged, err := MergeTapCommitments(commitmentA, commitmentB)
+	require.NoError(t, err)
+
+	// The merged commitment is the same as the one created from all
+	// assets directly, and the original commitments are unchanged.
+	expected, err := FromAssets(asset1a, asset1b, asset2, asset3)
+	require.NoError(t, err)
+	require.Equal(
+		t, expected.TapscriptRoot(nil), merged.TapscriptRoot(nil),
+	)
+	require.Len(t, merged.CommittedAssets(), 4)
+	require.Equal(t, rootA, commitmentA.TapscriptRoot(nil))
+	require.Equal(t, rootB, commitmentB.TapscriptRoot(nil))
+
+	// Merging with an empty commitment results in the same commitment.
+	empty, err := NewTapCommitment()
+	require.NoError(t, err)
+	merged, err = MergeTapCommitments(commitmentA, empty)
+	require.NoError(t, err)
+	require.Equal(t, rootA, merged.TapscriptRoot(nil))
+
+	// An asset that is committed to by both commitments is a collision.
+	commitmentC, err := FromAssets(asset1a.Copy())
+	require.NoError(t, err)
+	_, err = MergeTapCommitments(commitmentA, commitmentC)
+	require.ErrorIs(t, err, ErrAssetDuplicateScriptKey)
+
+	// We can't merge commitments we only know the root of.
+	rootOnly := NewTapCommitmentWithRoot(
+		commitmentB.Version, commitmentB.TreeRoot,
+	)
+	_, err = MergeTapCommitments(commitmentA, rootOnly)
+	require.Error(t, err)
+}
+
+// TestExclusionProofCache tests that the proofs returned by the exclusion
+// proof cache are identical to the ones computed by the commitments directly.
+func TestExclusionProofCache(t *testing.T) {
+	t.Parallel()
+
+	// We create two outputs that both commit to assets of the same group
+	// and one of them also to an asset without a group.
+	genesis1 := asset.RandGenesis(t, asset.Normal)
+	groupKey1 := asset.RandGroupKey(t, genesis1)
+	asset1a := randAsset(t, genesis1, groupKey1)
+	asset1b := randAsset(t, genesis1, groupKey1)
+
+	genesis2 := asset.RandGenesis(t, asset.Normal)
+	asset2 := randAsset(t, genesis2, nil)
+
+	commitment0, err := FromAssets(asset1a, asset2)
+	require.NoError(t, err)
+	commitment1, err := FromAssets(asset1b)
+	require.NoError(t, err)
+
+	outputCommitments := map[uint32]*TapCommitment{
+		0: commitment0,
+		1: commitment1,
+	}
+	cache := NewExclusionProofCache(outputCommitments)
+
+	// We request each proof twice to make sure the cached proofs are the
+	// same as the freshly computed ones.
+	for i := 0; i < 2; i++ {
+		for outIdx, tapCommitment := range outputCommitments {
+			root, err := cache.TapscriptRoot(outIdx)
+			require.NoError(t, err)
+			require.Equal(t, tapCommitment.TapscriptRoot(nil), root)
+
+			for _, a := range []*asset.Asset{
+				asset1a, asset1b, asset2,
+			} {
+				tapKey := a.TapCommitmentKey()
+				assetKey := a.AssetCommitmentKey()
+
+				_, expected, err := tapCommitment.Proof(
+					tapKey, assetKey,
+				)
+				require.NoError(t, err)
+
+				proof, err := cache.Proof(
+					outIdx, tapKey, assetKey,
+				)
+				require.NoError(t, err)
+
+				var expectedBuf, buf bytes.Buffer
+				err = expected.Encode(&expectedBuf)
+				require.NoError(t, err)
+				require.NoError(t, proof.Encode(&buf))
+				require.Equal(
+					t, expectedBuf.Bytes(), buf.Bytes(),
+				)
+			}
+		}
+	}
+
+	// The exclusion proof of an asset of the same group is valid.
+	proof, err := cache.Proof(
+		1, asset1a.TapCommitmentKey(), asset1a.AssetCommitmentKey(),
+	)
+	require.NoError(t, err)
+	tapCommitment, err := proof.DeriveByAssetExclusion(
+		asset1a.AssetCommitmentKey(),
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, commitment1.TapscriptRoot(nil),
+		tapCommitment.TapscriptRoot(nil),
+	)
+
+	// Unknown outputs result in an error.
+	_, err = cache.Proof(
+		2, asset2.TapCommitmentKey(), asset2.AssetCommitmentKey(),
+	)
+	require.ErrorIs(t, err, ErrUnknownOutputCommitment)
+	_, err = cache.TapscriptRoot(2)
+	require.ErrorIs(t, err, ErrUnknownOutputCommitment)
+}
+
 // TestTaprootAssetCommitmentScript tests that we're able to properly verify if
 // a given script is a valid Taproot Asset commitment script or not.
 func TestIsTaprootAssetCommitmentScript(t *testing.T) {
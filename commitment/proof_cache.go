@@ -0,0 +1,196 @@
+package commitment
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+)
+
+var (
+	// ErrUnknownOutputCommitment is an error returned when a proof is
+	// requested from an ExclusionProofCache for an output it doesn't know
+	// the Taproot Asset commitment of.
+	ErrUnknownOutputCommitment = errors.New(
+		"proof cache: unknown output commitment",
+	)
+)
+
+// tapProofKey identifies the proof of an asset commitment within the Taproot
+// Asset commitment of an output.
+type tapProofKey struct {
+	outputIndex      uint32
+	tapCommitmentKey [32]byte
+}
+
+// assetProofKey identifies the proof of an asset within an asset commitment of
+// the Taproot Asset commitment of an output.
+type assetProofKey struct {
+	tapProofKey
+	assetCommitmentKey [32]byte
+}
+
+// ExclusionProofCache caches the proofs and digests of the Taproot Asset
+// commitments of all outputs of an anchor transaction. When creating the proofs
+// of an anchor transaction with many outputs, every asset needs an exclusion
+// proof for every other output. Assets of the same ID or group share the
+// outer part of their proofs, and the tapscript root of each output only needs
+// to be computed once, so caching them avoids re-walking the same trees over
+// and over again.
+//
+// NOTE: The commitments must not be modified while the cache is in use.
+type ExclusionProofCache struct {
+	commitments map[uint32]*TapCommitment
+
+	mtx         sync.Mutex
+	tapProofs   map[tapProofKey]*mssmt.Proof
+	assetProofs map[assetProofKey]*mssmt.Proof
+	roots       map[uint32]chainhash.Hash
+}
+
+// NewExclusionProofCache creates a new proof cache for the given Taproot Asset
+// commitments, keyed by the index of the output they are anchored in.
+func NewExclusionProofCache(
+	commitments map[uint32]*TapCommitment) *ExclusionProofCache {
+
+	return &ExclusionProofCache{
+		commitments: commitments,
+		tapProofs:   make(map[tapProofKey]*mssmt.Proof),
+		assetProofs: make(map[assetProofKey]*mssmt.Proof),
+		roots:       make(map[uint32]chainhash.Hash),
+	}
+}
+
+// Commitment returns the Taproot Asset commitment of the given output. If the
+// commitment is not known, the second returned value is false.
+func (c *ExclusionProofCache) Commitment(
+	outputIndex uint32) (*TapCommitment, bool) {
+
+	tapCommitment, ok := c.commitments[outputIndex]
+	return tapCommitment, ok
+}
+
+// commitment returns the Taproot Asset commitment of the given output, which
+// must be capable of computing merkle proofs.
+func (c *ExclusionProofCache) commitment(
+	outputIndex uint32) (*TapCommitment, error) {
+
+	tapCommitment, ok := c.commitments[outputIndex]
+	if !ok || tapCommitment == nil {
+		return nil, fmt.Errorf("%w: output %d",
+			ErrUnknownOutputCommitment, outputIndex)
+	}
+
+	if tapCommitment.assetCommitments == nil || tapCommitment.tree == nil {
+		return nil, fmt.Errorf("missing asset commitments to compute "+
+			"proofs for output %d", outputIndex)
+	}
+
+	return tapCommitment, nil
+}
+
+// TapscriptRoot returns the tapscript root of the Taproot Asset commitment of
+// the given output without a sibling.
+func (c *ExclusionProofCache) TapscriptRoot(
+	outputIndex uint32) (chainhash.Hash, error) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if root, ok := c.roots[outputIndex]; ok {
+		return root, nil
+	}
+
+	tapCommitment, ok := c.commitments[outputIndex]
+	if !ok || tapCommitment == nil {
+		return chainhash.Hash{}, fmt.Errorf("%w: output %d",
+			ErrUnknownOutputCommitment, outputIndex)
+	}
+
+	root := tapCommitment.TapscriptRoot(nil)
+	c.roots[outputIndex] = root
+
+	return root, nil
+}
+
+// Proof computes the full merkle proof for the asset leaf located at
+// `assetCommitmentKey` within the AssetCommitment located at
+// `tapCommitmentKey` of the Taproot Asset commitment of the given output. The
+// returned proof is identical to the one returned by TapCommitment.Proof and
+// is a non-inclusion proof if the asset isn't committed to by the output.
+func (c *ExclusionProofCache) Proof(outputIndex uint32, tapCommitmentKey,
+	assetCommitmentKey [32]byte) (*Proof, error) {
+
+	tapCommitment, err := c.commitment(outputIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	tapKey := tapProofKey{
+		outputIndex:      outputIndex,
+		tapCommitmentKey: tapCommitmentKey,
+	}
+	tapProof, ok := c.tapProofs[tapKey]
+	if !ok {
+		// We compute a full proof once and remember both of its parts.
+		_, fullProof, err := tapCommitment.Proof(
+			tapCommitmentKey, assetCommitmentKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		tapProof = &fullProof.TaprootAssetProof.Proof
+		c.tapProofs[tapKey] = tapProof
+
+		if fullProof.AssetProof != nil {
+			c.assetProofs[assetProofKey{
+				tapProofKey:        tapKey,
+				assetCommitmentKey: assetCommitmentKey,
+			}] = &fullProof.AssetProof.Proof
+		}
+	}
+
+	proof := &Proof{
+		TaprootAssetProof: TaprootAssetProof{
+			Proof:   *tapProof.Copy(),
+			Version: tapCommitment.Version,
+		},
+	}
+
+	// If the corresponding asset commitment doesn't exist, the proof of
+	// the outer tree is all we need.
+	assetCommitment, ok := tapCommitment.assetCommitments[tapCommitmentKey]
+	if !ok {
+		return proof, nil
+	}
+
+	assetKey := assetProofKey{
+		tapProofKey:        tapKey,
+		assetCommitmentKey: assetCommitmentKey,
+	}
+	assetProof, ok := c.assetProofs[assetKey]
+	if !ok {
+		_, assetProof, err = assetCommitment.AssetProof(
+			assetCommitmentKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		c.assetProofs[assetKey] = assetProof
+	}
+
+	proof.AssetProof = &AssetProof{
+		Proof:   *assetProof.Copy(),
+		Version: assetCommitment.Version,
+		AssetID: assetCommitment.AssetID,
+	}
+
+	return proof, nil
+}
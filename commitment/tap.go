@@ -443,3 +443,63 @@ func (c *TapCommitment) Merge(other *TapCommitment) error {
 
 	return nil
 }
+
+// MergeTapCommitments returns a new commitment that commits to all assets of
+// both given commitments, for example because they are destined for the same
+// anchor output. Neither of the given commitments is modified. Unlike Merge,
+// this validates that no asset is committed to by both commitments, as an
+// asset with the same asset commitment key (the same script key and asset ID
+// within the same asset tree) would silently overwrite the other one.
+func MergeTapCommitments(a, b *TapCommitment) (*TapCommitment, error) {
+	// We need the assets of both commitments, which aren't available if
+	// they were constructed with NewTapCommitmentWithRoot.
+	if a == nil || b == nil {
+		return nil, ErrMissingAssetCommitment
+	}
+	if a.assetCommitments == nil || b.assetCommitments == nil {
+		return nil, fmt.Errorf("cannot merge commitments without " +
+			"asset commitments")
+	}
+
+	var assets []*asset.Asset
+	for key, commitmentA := range a.assetCommitments {
+		assets = append(assets, fn.CopyAll(
+			maps.Values(commitmentA.Assets()),
+		)...)
+
+		commitmentB, ok := b.assetCommitments[key]
+		if !ok {
+			continue
+		}
+
+		// Both commitments commit to the same asset tree, so the
+		// assets within them must be of the same type and may not
+		// collide.
+		if commitmentA.AssetType != commitmentB.AssetType {
+			return nil, fmt.Errorf("%w: asset commitment %x",
+				ErrAssetTypeMismatch, key[:])
+		}
+		for assetKey := range commitmentB.Assets() {
+			if _, ok := commitmentA.Asset(assetKey); ok {
+				return nil, fmt.Errorf("%w: asset %x in "+
+					"asset commitment %x",
+					ErrAssetDuplicateScriptKey, assetKey[:],
+					key[:])
+			}
+		}
+	}
+	for _, commitmentB := range b.assetCommitments {
+		assets = append(assets, fn.CopyAll(
+			maps.Values(commitmentB.Assets()),
+		)...)
+	}
+
+	// Creating the commitment from scratch validates that all assets
+	// within the same asset tree agree on their genesis or group.
+	merged, err := FromAssets(assets...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to merge commitments: %w", err)
+	}
+
+	return merged, nil
+}
@@ -7,11 +7,13 @@ import (
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/tapdb"
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightninglabs/taproot-assets/webhook"
 	"github.com/lightningnetwork/lnd"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/signal"
@@ -68,6 +70,12 @@ type DatabaseConfig struct {
 	Multiverse *tapdb.BaseMultiverse
 
 	FederationDB *tapdb.UniverseFederationDB
+
+	KeyAuditLog *tapdb.KeyAuditLog
+
+	// SendLimits tracks the sends counted against the daily send limits
+	// of macaroons.
+	SendLimits *tapdb.SendLimits
 }
 
 // Config is the main config of the Taproot Assets server.
@@ -94,18 +102,95 @@ type Config struct {
 
 	AssetCustodian *tapgarden.Custodian
 
+	// AssetWatcher tracks assets owned by external keys for monitoring
+	// and alerts when their anchor outputs are spent.
+	AssetWatcher *tapgarden.AssetWatcher
+
+	// AnchorSpendMonitor watches the anchor outputs of the wallet and
+	// alerts when one is spent by an unknown transaction.
+	AnchorSpendMonitor *tapgarden.AnchorSpendMonitor
+
 	ChainBridge tapgarden.ChainBridge
 
+	KeyRing tapgarden.KeyRing
+
 	AddrBook *address.Book
 
 	ProofArchive proof.Archiver
 
+	MetaFetcher *proof.MetaFetcher
+
+	IPFSClient *proof.IPFSClient
+
+	// ProofCourierInfo describes the proof courier that delivers the
+	// proofs of outgoing transfers to their receivers.
+	ProofCourierInfo proof.CourierInfo
+
 	AssetWallet tapfreighter.Wallet
 
 	CoinSelect *tapfreighter.CoinSelect
 
+	// OfflineSigner holds the virtual packets that wait for the signature
+	// of an offline signer. This is nil if the daemon doesn't run in
+	// watch-only mode.
+	OfflineSigner *tapfreighter.OfflineSigner
+
+	// RemoteSigner signs the virtual packets of watch-only daemons that
+	// connect to this daemon. This is nil if the remote signer isn't
+	// served.
+	RemoteSigner *tapfreighter.RemoteSigner
+
 	ChainPorter tapfreighter.Porter
 
+	// Airdropper sends assets to a manifest of many recipients in batched
+	// transfers.
+	Airdropper *tapfreighter.Airdropper
+
+	// Templater maintains named transfer templates and sends their
+	// transfers.
+	Templater *tapfreighter.Templater
+
+	// AnchorCoordinator lets separate callers register parcels into named
+	// anchor sessions that are shipped in a single anchor transaction.
+	AnchorCoordinator *tapfreighter.AnchorCoordinator
+
+	WebhookNotifier *webhook.Notifier
+
+	// ProofTiers is the tiered on-disk proof archive. This is nil if
+	// tiering of the proof archive is disabled.
+	ProofTiers *proof.TieredArchiver
+
+	// ProofScrubber periodically checks the proofs of the archives for
+	// damage. This is nil if scrubbing is disabled.
+	ProofScrubber *proof.ProofScrubber
+
+	// ScriptKeyAuditor audits the transfer log and the proof archive for
+	// reused script keys, periodically and on demand.
+	ScriptKeyAuditor *tapfreighter.ScriptKeyAuditor
+
+	// ProofArchiveUsers holds the per-user archives of the central proof
+	// archive served to the nodes of a hosted wallet. This is nil if the
+	// central proof archive isn't served.
+	ProofArchiveUsers *proof.UserArchives
+
+	// SupplyReconciler periodically reconciles the local holdings with the
+	// universe supply. This is nil if the reconciliation is disabled.
+	SupplyReconciler *universe.SupplyReconciler
+
+	// SupplyVerifier verifies the supply of assets against the chain and
+	// signs supply reports, periodically for the selected assets and on
+	// demand for all others.
+	SupplyVerifier *universe.SupplyVerifier
+
+	// BalanceSnapshotter periodically snapshots the confirmed balance of
+	// each asset. This is nil if snapshotting is disabled.
+	BalanceSnapshotter *tapfreighter.BalanceSnapshotter
+
+	// TombstoneSweeper periodically sweeps the anchor outputs that only
+	// carry tombstones back to the wallet. This is nil if sweeping is
+	// disabled.
+	TombstoneSweeper *tapfreighter.TombstoneSweeper
+
 	BaseUniverse *universe.MintingArchive
 
 	UniverseSyncer universe.Syncer
@@ -114,6 +199,23 @@ type Config struct {
 
 	UniverseStats universe.Telemetry
 
+	// AliasResolver resolves human-readable asset aliases through the local
+	// alias store. Embedders can plug in a remote alias registry by
+	// replacing it with a resolver that wraps the same local store.
+	AliasResolver *asset.AliasResolver
+
+	// CounterpartyBook maps the script keys of known receivers of
+	// outbound transfers to their names.
+	CounterpartyBook address.CounterpartyBook
+
+	// ImportedKeys is the set of externally derived script keys that are
+	// treated as our own.
+	ImportedKeys address.ImportedKeyStore
+
+	// Invoices is the storage backend for the invoices created by this
+	// node.
+	Invoices tapgarden.InvoiceStore
+
 	// LogWriter is the root logger that all of the daemon's subloggers are
 	// hooked up to.
 	LogWriter *build.RotatingLogWriter
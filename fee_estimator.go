@@ -0,0 +1,292 @@
+package taprootassets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// DefaultFeeAPITimeout is the default timeout of a single request to
+	// an external fee estimation source.
+	DefaultFeeAPITimeout = 10 * time.Second
+
+	// DefaultFeeAPICacheTTL is the default duration the fee estimates of a
+	// web API are cached for.
+	DefaultFeeAPICacheTTL = time.Minute
+)
+
+// BitcoindFeeEstimatorCfg is the configuration of a fee estimator that queries
+// bitcoind's estimatesmartfee RPC.
+type BitcoindFeeEstimatorCfg struct {
+	// Host is the host:port of bitcoind's RPC server.
+	Host string
+
+	// User is the RPC user of bitcoind.
+	User string
+
+	// Pass is the RPC password of bitcoind.
+	Pass string
+
+	// EstimateMode is the estimate mode of estimatesmartfee, either
+	// ECONOMICAL or CONSERVATIVE.
+	EstimateMode string
+}
+
+// BitcoindFeeEstimator is an implementation of the tapfreighter.FeeEstimator
+// interface that queries bitcoind's estimatesmartfee RPC. Unlike lnd's own
+// bitcoind estimator, it returns an error instead of a static fallback fee
+// rate if bitcoind has no estimate, so other sources can be tried instead.
+type BitcoindFeeEstimator struct {
+	cfg *BitcoindFeeEstimatorCfg
+
+	client *rpcclient.Client
+}
+
+// NewBitcoindFeeEstimator creates a new fee estimator for the configured
+// bitcoind node.
+func NewBitcoindFeeEstimator(
+	cfg *BitcoindFeeEstimatorCfg) (*BitcoindFeeEstimator, error) {
+
+	client, err := rpcclient.New(&rpcclient.ConnConfig{
+		Host:                cfg.Host,
+		User:                cfg.User,
+		Pass:                cfg.Pass,
+		DisableConnectOnNew: true,
+		DisableTLS:          true,
+		HTTPPostMode:        true,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create bitcoind client: %w",
+			err)
+	}
+
+	return &BitcoindFeeEstimator{
+		cfg:    cfg,
+		client: client,
+	}, nil
+}
+
+// estimateSmartFeeResp is the response of bitcoind's estimatesmartfee RPC.
+type estimateSmartFeeResp struct {
+	// FeeRate is the estimated fee rate in BTC/kvB.
+	FeeRate float64 `json:"feerate"`
+
+	// Errors are the errors bitcoind encountered during the estimation.
+	Errors []string `json:"errors"`
+}
+
+// EstimateFee returns a fee estimate for the confirmation target.
+//
+// NOTE: This is part of the tapfreighter.FeeEstimator interface.
+func (b *BitcoindFeeEstimator) EstimateFee(_ context.Context,
+	confTarget uint32) (chainfee.SatPerKWeight, error) {
+
+	target, err := json.Marshal(confTarget)
+	if err != nil {
+		return 0, err
+	}
+	mode, err := json.Marshal(b.cfg.EstimateMode)
+	if err != nil {
+		return 0, err
+	}
+
+	rawResp, err := b.client.RawRequest(
+		"estimatesmartfee", []json.RawMessage{target, mode},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("unable to query estimatesmartfee: %w",
+			err)
+	}
+
+	var resp estimateSmartFeeResp
+	if err := json.Unmarshal(rawResp, &resp); err != nil {
+		return 0, fmt.Errorf("unable to decode estimatesmartfee "+
+			"response: %w", err)
+	}
+	if resp.FeeRate <= 0 {
+		return 0, fmt.Errorf("bitcoind has no estimate for conf "+
+			"target %d: %v", confTarget, resp.Errors)
+	}
+
+	return btcPerKVByteToFeeRate(resp.FeeRate)
+}
+
+// WebAPIFeeEstimatorCfg is the configuration of a fee estimator that queries
+// an external fee API.
+type WebAPIFeeEstimatorCfg struct {
+	// URL is the URL of the fee API. It must return the fee rates in the
+	// format of lnd's fee URL, a JSON object with a fee_by_block_target
+	// map from confirmation target to fee rate in sat/kvB.
+	URL string
+
+	// Timeout is the timeout of a single request to the fee API. If zero,
+	// DefaultFeeAPITimeout is used.
+	Timeout time.Duration
+
+	// CacheTTL is the duration the fee estimates are cached for. If zero,
+	// DefaultFeeAPICacheTTL is used.
+	CacheTTL time.Duration
+}
+
+// WebAPIFeeEstimator is an implementation of the tapfreighter.FeeEstimator
+// interface that queries an external fee API. Unlike lnd's own web API
+// estimator, it returns an error instead of the relay fee floor if the API
+// can't be reached, so other sources can be tried instead.
+type WebAPIFeeEstimator struct {
+	cfg *WebAPIFeeEstimatorCfg
+
+	client *http.Client
+
+	mtx sync.Mutex
+
+	// feeByBlockTarget are the cached fee rates in sat/kvB, keyed by
+	// their confirmation target.
+	feeByBlockTarget map[uint32]uint32
+
+	// fetchedAt is the time the cached fee rates were fetched.
+	fetchedAt time.Time
+}
+
+// NewWebAPIFeeEstimator creates a new fee estimator for the configured fee
+// API.
+func NewWebAPIFeeEstimator(cfg *WebAPIFeeEstimatorCfg) *WebAPIFeeEstimator {
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = DefaultFeeAPITimeout
+	}
+
+	return &WebAPIFeeEstimator{
+		cfg: cfg,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// feeAPIResp is the response of a fee API in the format of lnd's fee URL.
+type feeAPIResp struct {
+	// FeeByBlockTarget maps the confirmation target to the fee rate in
+	// sat/kvB.
+	FeeByBlockTarget map[string]uint32 `json:"fee_by_block_target"`
+}
+
+// EstimateFee returns a fee estimate for the confirmation target. If the API
+// has no estimate for the exact target, the estimate of the next lower target
+// is used, which is the more conservative choice.
+//
+// NOTE: This is part of the tapfreighter.FeeEstimator interface.
+func (w *WebAPIFeeEstimator) EstimateFee(ctx context.Context,
+	confTarget uint32) (chainfee.SatPerKWeight, error) {
+
+	fees, err := w.fees(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// A lower target than the requested one pays more, so we use the
+	// highest of them. Only if there is no lower target, we fall back to
+	// the lowest of the higher targets.
+	var (
+		lower, higher       uint32
+		hasLower, hasHigher bool
+	)
+	for target := range fees {
+		switch {
+		case target <= confTarget:
+			if !hasLower || target > lower {
+				lower, hasLower = target, true
+			}
+
+		case !hasHigher || target < higher:
+			higher, hasHigher = target, true
+		}
+	}
+
+	var bestTarget uint32
+	switch {
+	case hasLower:
+		bestTarget = lower
+
+	case hasHigher:
+		bestTarget = higher
+
+	default:
+		return 0, fmt.Errorf("fee API returned no estimates")
+	}
+
+	satPerKVByte := chainfee.SatPerKVByte(fees[bestTarget])
+	return satPerKVByte.FeePerKWeight(), nil
+}
+
+// fees returns the cached fee rates or fetches them from the API if the cache
+// expired.
+func (w *WebAPIFeeEstimator) fees(
+	ctx context.Context) (map[uint32]uint32, error) {
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	cacheTTL := w.cfg.CacheTTL
+	if cacheTTL == 0 {
+		cacheTTL = DefaultFeeAPICacheTTL
+	}
+	if w.feeByBlockTarget != nil && time.Since(w.fetchedAt) < cacheTTL {
+		return w.feeByBlockTarget, nil
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, w.cfg.URL, nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create fee API request: %w",
+			err)
+	}
+
+	httpResp, err := w.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query fee API: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fee API returned status %v",
+			httpResp.Status)
+	}
+
+	var resp feeAPIResp
+	if err := json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("unable to decode fee API response: %w",
+			err)
+	}
+
+	fees := make(map[uint32]uint32, len(resp.FeeByBlockTarget))
+	for targetStr, fee := range resp.FeeByBlockTarget {
+		target, err := strconv.ParseUint(targetStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid conf target %q: %w",
+				targetStr, err)
+		}
+		fees[uint32(target)] = fee
+	}
+
+	w.feeByBlockTarget = fees
+	w.fetchedAt = time.Now()
+
+	return fees, nil
+}
+
+// A compile-time assertion to ensure BitcoindFeeEstimator and
+// WebAPIFeeEstimator meet the tapfreighter.FeeEstimator interface.
+var (
+	_ tapfreighter.FeeEstimator = (*BitcoindFeeEstimator)(nil)
+	_ tapfreighter.FeeEstimator = (*WebAPIFeeEstimator)(nil)
+)
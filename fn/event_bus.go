@@ -0,0 +1,287 @@
+package fn
+
+import (
+	"fmt"
+	"sync"
+)
+
+const (
+	// DefaultEventBusBufferSize is the default number of events that are
+	// buffered for a subscriber that doesn't accept new events before
+	// further events are dropped.
+	DefaultEventBusBufferSize = 100
+
+	// DefaultEventBusMaxDrops is the default number of consecutive events
+	// that are dropped for a subscriber before it is considered stalled
+	// and evicted from an event bus.
+	DefaultEventBusMaxDrops = 1000
+)
+
+// EventFilter decides whether an event is delivered to a subscriber. It can
+// be used to only subscribe to a topic, for example a single event type.
+type EventFilter[T any] func(T) bool
+
+// DropMarker marks an event that is delivered to a subscriber after the given
+// number of events right before it were dropped for that subscriber.
+type DropMarker[T any] func(event T, numDropped uint64) T
+
+// busSubscriber is a subscriber of an event bus. Events are buffered in the
+// pending channel and forwarded to the receiver by a dedicated goroutine, so
+// the publisher never waits for the receiver.
+type busSubscriber[T any] struct {
+	receiver *EventReceiver[T]
+
+	filter EventFilter[T]
+
+	pending chan T
+
+	// unreported is the number of consecutive events that were dropped
+	// since the last event was buffered.
+	unreported uint64
+
+	// deliverMtx guards unreported and serializes buffering events.
+	deliverMtx sync.Mutex
+
+	stopOnce sync.Once
+	quit     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// deliver buffers the given event for the subscriber without blocking. If the
+// buffer is full, the event is dropped and accounted for instead. If events
+// were dropped before, the event is marked with their number. The number of
+// consecutive drops is returned.
+func (s *busSubscriber[T]) deliver(event T, mark DropMarker[T]) uint64 {
+	s.deliverMtx.Lock()
+	defer s.deliverMtx.Unlock()
+
+	if s.unreported > 0 && mark != nil {
+		event = mark(event, s.unreported)
+	}
+
+	select {
+	case s.pending <- event:
+		s.unreported = 0
+
+	default:
+		s.unreported++
+		s.receiver.numDropped.Add(1)
+	}
+
+	return s.unreported
+}
+
+// forward moves the pending events to the receiver until the subscriber is
+// stopped.
+//
+// NOTE: This method MUST be called as a goroutine.
+func (s *busSubscriber[T]) forward() {
+	defer s.wg.Done()
+
+	for {
+		select {
+		case event := <-s.pending:
+			select {
+			case s.receiver.NewItemCreated.ChanIn() <- event:
+			case <-s.quit:
+				return
+			}
+
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// stop stops forwarding events and, if requested, also stops the receiver.
+// Events that are still pending are dropped.
+func (s *busSubscriber[T]) stop(stopReceiver bool) {
+	s.stopOnce.Do(func() {
+		close(s.quit)
+		s.wg.Wait()
+
+		if stopReceiver {
+			s.receiver.Stop()
+		}
+	})
+}
+
+// EventBus is a typed event bus that delivers published events to all its
+// subscribers whose filter matches the event. Publishing never blocks: each
+// subscriber has a bounded buffer of events that weren't accepted by its
+// receiver yet. Events that don't fit into the buffer are dropped for that
+// subscriber and counted by its receiver. A subscriber for which too many
+// consecutive events were dropped is considered stalled and is evicted from
+// the bus, which also stops its receiver.
+type EventBus[T any] struct {
+	// bufferSize is the number of events buffered for each subscriber.
+	bufferSize int
+
+	// maxDrops is the number of consecutive dropped events after which a
+	// subscriber is evicted. Zero means subscribers are never evicted.
+	maxDrops uint64
+
+	// markDropped is used to mark the first event that is delivered to a
+	// subscriber after events were dropped. If it is nil, events aren't
+	// marked.
+	markDropped DropMarker[T]
+
+	// subscribers are the subscribers of the bus, keyed by the ID of
+	// their receiver.
+	subscribers map[uint64]*busSubscriber[T]
+
+	// subscriberMtx guards the subscribers map.
+	subscriberMtx sync.RWMutex
+}
+
+// EventBusOption is a functional option that modifies an event bus.
+type EventBusOption[T any] func(*EventBus[T])
+
+// WithMaxDrops sets the number of consecutive dropped events after which a
+// subscriber is evicted. Zero means subscribers are never evicted.
+func WithMaxDrops[T any](maxDrops uint64) EventBusOption[T] {
+	return func(b *EventBus[T]) {
+		b.maxDrops = maxDrops
+	}
+}
+
+// WithDropMarker sets the function used to mark the first event delivered to
+// a subscriber after events were dropped for it.
+func WithDropMarker[T any](mark DropMarker[T]) EventBusOption[T] {
+	return func(b *EventBus[T]) {
+		b.markDropped = mark
+	}
+}
+
+// NewEventBus creates a new event bus that buffers up to the given number of
+// events for each subscriber.
+func NewEventBus[T any](bufferSize int,
+	opts ...EventBusOption[T]) *EventBus[T] {
+
+	if bufferSize <= 0 {
+		bufferSize = DefaultEventBusBufferSize
+	}
+
+	b := &EventBus[T]{
+		bufferSize:  bufferSize,
+		maxDrops:    DefaultEventBusMaxDrops,
+		subscribers: make(map[uint64]*busSubscriber[T]),
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+// Subscribe adds the given receiver to the bus. Only events for which the
+// filter returns true are delivered to it, or all events if the filter is nil.
+// Subscribing a receiver again replaces its filter.
+func (b *EventBus[T]) Subscribe(receiver *EventReceiver[T],
+	filter EventFilter[T]) {
+
+	sub := &busSubscriber[T]{
+		receiver: receiver,
+		filter:   filter,
+		pending:  make(chan T, b.bufferSize),
+		quit:     make(chan struct{}),
+	}
+
+	b.subscriberMtx.Lock()
+	oldSub, ok := b.subscribers[receiver.ID()]
+	b.subscribers[receiver.ID()] = sub
+	b.subscriberMtx.Unlock()
+
+	// The old subscriber must not stop the receiver that is now used by
+	// the new one, so we only stop its forwarding goroutine.
+	if ok {
+		oldSub.stop(false)
+	}
+
+	sub.wg.Add(1)
+	go sub.forward()
+}
+
+// Unsubscribe removes the given receiver from the bus and stops it. An error
+// is returned if the receiver isn't subscribed, which is also the case if it
+// was evicted.
+func (b *EventBus[T]) Unsubscribe(receiver *EventReceiver[T]) error {
+	b.subscriberMtx.Lock()
+	sub, ok := b.subscribers[receiver.ID()]
+	delete(b.subscribers, receiver.ID())
+	b.subscriberMtx.Unlock()
+
+	if !ok {
+		return fmt.Errorf("subscriber with ID %d not found",
+			receiver.ID())
+	}
+
+	sub.stop(true)
+
+	return nil
+}
+
+// Publish delivers the given events to all subscribers whose filter matches.
+// Events that a subscriber can't buffer are dropped for it, and subscribers
+// that exceed the maximum number of consecutive drops are evicted.
+func (b *EventBus[T]) Publish(events ...T) {
+	var stalled []*busSubscriber[T]
+
+	b.subscriberMtx.RLock()
+	for _, sub := range b.subscribers {
+		for _, event := range events {
+			if sub.filter != nil && !sub.filter(event) {
+				continue
+			}
+
+			numDrops := sub.deliver(event, b.markDropped)
+			if b.maxDrops > 0 && numDrops > b.maxDrops {
+				stalled = append(stalled, sub)
+				break
+			}
+		}
+	}
+	b.subscriberMtx.RUnlock()
+
+	for _, sub := range stalled {
+		b.evict(sub)
+	}
+}
+
+// evict removes the given stalled subscriber from the bus and stops it.
+func (b *EventBus[T]) evict(sub *busSubscriber[T]) {
+	id := sub.receiver.ID()
+
+	// The subscriber might have been removed or replaced concurrently, in
+	// which case it was already stopped.
+	b.subscriberMtx.Lock()
+	current, ok := b.subscribers[id]
+	if !ok || current != sub {
+		b.subscriberMtx.Unlock()
+		return
+	}
+	delete(b.subscribers, id)
+	b.subscriberMtx.Unlock()
+
+	sub.stop(true)
+}
+
+// NumSubscribers returns the number of subscribers of the bus.
+func (b *EventBus[T]) NumSubscribers() int {
+	b.subscriberMtx.RLock()
+	defer b.subscriberMtx.RUnlock()
+
+	return len(b.subscribers)
+}
+
+// Stop removes all subscribers from the bus and stops them.
+func (b *EventBus[T]) Stop() {
+	b.subscriberMtx.Lock()
+	subscribers := b.subscribers
+	b.subscribers = make(map[uint64]*busSubscriber[T])
+	b.subscriberMtx.Unlock()
+
+	for _, sub := range subscribers {
+		sub.stop(true)
+	}
+}
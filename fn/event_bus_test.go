@@ -0,0 +1,131 @@
+package fn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestEventBus tests that events are delivered to the subscribers whose filter
+// matches and that stalled subscribers are evicted without blocking the
+// publisher.
+func TestEventBus(t *testing.T) {
+	t.Parallel()
+
+	const (
+		bufferSize = 5
+		maxDrops   = 2
+	)
+	bus := NewEventBus(bufferSize, WithMaxDrops[int](maxDrops))
+
+	all := NewEventReceiver[int](DefaultQueueSize)
+	even := NewEventReceiver[int](DefaultQueueSize)
+	stalled := NewEventReceiver[int](DefaultQueueSize)
+
+	bus.Subscribe(all, nil)
+	bus.Subscribe(even, func(event int) bool {
+		return event%2 == 0
+	})
+	bus.Subscribe(stalled, nil)
+	require.Equal(t, 3, bus.NumSubscribers())
+
+	// A receiver whose queue was stopped doesn't accept any events
+	// anymore, so its buffer fills up and further events are dropped.
+	stalled.NewItemCreated.Stop()
+
+	receive := func(receiver *EventReceiver[int]) int {
+		select {
+		case event := <-receiver.NewItemCreated.ChanOut():
+			return event
+		case <-time.After(time.Second):
+			t.Fatalf("event not received")
+			return 0
+		}
+	}
+
+	// Publishing more events than the buffer holds must not block. The
+	// other subscribers keep up, so they receive all their events. One
+	// event might be taken from the buffer of the stalled subscriber, so
+	// we need one more to exceed the maximum number of drops.
+	for i := 0; i < bufferSize+maxDrops+2; i++ {
+		published := make(chan struct{})
+		go func() {
+			defer close(published)
+
+			bus.Publish(i)
+		}()
+
+		select {
+		case <-published:
+		case <-time.After(time.Second):
+			t.Fatalf("publishing blocked on stalled subscriber")
+		}
+
+		require.Equal(t, i, receive(all))
+		if i%2 == 0 {
+			require.Equal(t, i, receive(even))
+		}
+	}
+
+	// The stalled subscriber was evicted, so it can't be removed anymore.
+	require.Greater(t, stalled.NumDropped(), uint64(maxDrops))
+	require.Zero(t, all.NumDropped())
+	require.Equal(t, 2, bus.NumSubscribers())
+	require.ErrorContains(t, bus.Unsubscribe(stalled), "not found")
+
+	require.NoError(t, bus.Unsubscribe(even))
+	require.Equal(t, 1, bus.NumSubscribers())
+
+	bus.Stop()
+	require.Zero(t, bus.NumSubscribers())
+}
+
+// testEvent is a simple event used in tests.
+type testEvent struct {
+	id int
+}
+
+// Timestamp returns the timestamp of the event.
+func (e *testEvent) Timestamp() time.Time {
+	return time.Time{}
+}
+
+// TestEventBusDropAccounting tests that events that don't fit into the buffer
+// of a subscriber are counted and flagged on the next delivered event.
+func TestEventBusDropAccounting(t *testing.T) {
+	t.Parallel()
+
+	receiver := NewEventReceiver[Event](DefaultQueueSize)
+	defer receiver.Stop()
+
+	sub := &busSubscriber[Event]{
+		receiver: receiver,
+		pending:  make(chan Event, 1),
+		quit:     make(chan struct{}),
+	}
+
+	events := make([]*testEvent, 4)
+	for i := range events {
+		events[i] = &testEvent{id: i}
+	}
+
+	// The first event is buffered, the next two are dropped.
+	require.Zero(t, sub.deliver(events[0], MarkDroppedHistory))
+	require.EqualValues(t, 1, sub.deliver(events[1], MarkDroppedHistory))
+	require.EqualValues(t, 2, sub.deliver(events[2], MarkDroppedHistory))
+	require.EqualValues(t, 2, receiver.NumDropped())
+
+	event, numDropped := UnwrapDroppedHistory(<-sub.pending)
+	require.Equal(t, events[0], event)
+	require.Zero(t, numDropped)
+
+	// Once there's space again, the next event is delivered and flagged
+	// with the number of events dropped before it.
+	require.Zero(t, sub.deliver(events[3], MarkDroppedHistory))
+
+	event, numDropped = UnwrapDroppedHistory(<-sub.pending)
+	require.Equal(t, events[3], event)
+	require.EqualValues(t, 2, numDropped)
+	require.EqualValues(t, 2, receiver.NumDropped())
+}
@@ -29,6 +29,10 @@ type EventReceiver[T any] struct {
 
 	// ItemRemoved is sent to when an existing item was removed.
 	ItemRemoved *ConcurrentQueue[T]
+
+	// numDropped is the number of events that were dropped for this
+	// receiver because it didn't keep up with the publisher.
+	numDropped atomic.Uint64
 }
 
 // ID returns the internal process-unique ID of the subscription.
@@ -36,6 +40,12 @@ func (e *EventReceiver[T]) ID() uint64 {
 	return e.id
 }
 
+// NumDropped returns the total number of events that were dropped for this
+// receiver because it didn't keep up with the publisher.
+func (e *EventReceiver[T]) NumDropped() uint64 {
+	return e.numDropped.Load()
+}
+
 // Stop stops the receiver from processing events.
 func (e *EventReceiver[T]) Stop() {
 	e.NewItemCreated.Stop()
@@ -82,6 +92,39 @@ type Event interface {
 	Timestamp() time.Time
 }
 
+// DroppedHistoryEvent wraps an event that is delivered to a subscriber after
+// the events right before it were dropped because the subscriber didn't keep
+// up with the publisher.
+type DroppedHistoryEvent struct {
+	Event
+
+	// NumDropped is the number of events that were dropped right before
+	// the wrapped event.
+	NumDropped uint64
+}
+
+// MarkDroppedHistory wraps the given event to flag that the given number of
+// events before it were dropped. It can be used as the drop marker of an event
+// bus.
+func MarkDroppedHistory(event Event, numDropped uint64) Event {
+	return &DroppedHistoryEvent{
+		Event:      event,
+		NumDropped: numDropped,
+	}
+}
+
+// UnwrapDroppedHistory returns the original event of an event that may be
+// flagged with dropped history, along with the number of events that were
+// dropped right before it.
+func UnwrapDroppedHistory(event Event) (Event, uint64) {
+	dropped, ok := event.(*DroppedHistoryEvent)
+	if !ok {
+		return event, 0
+	}
+
+	return dropped.Event, dropped.NumDropped
+}
+
 // EventDistributor is a struct type that helps to distribute events to multiple
 // subscribers.
 type EventDistributor[T any] struct {
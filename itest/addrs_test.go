@@ -3,7 +3,10 @@ package itest
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"time"
 
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tappsbt"
 	"github.com/lightninglabs/taproot-assets/taprpc"
 	wrpc "github.com/lightninglabs/taproot-assets/taprpc/assetwalletrpc"
@@ -111,6 +114,16 @@ func testAddresses(t *harnessTest) {
 	}, defaultTimeout/2)
 	require.NoError(t.t, err)
 
+	// The accounting statements of both nodes should contain exactly one
+	// fee entry per outbound transfer and one entry per inbound receive.
+	assertStatementEntries(
+		t, t.tapd, tapfreighter.StatementEntryFee, len(rpcAssets),
+	)
+	assertStatementEntries(
+		t, secondTapd, tapfreighter.StatementEntryReceive,
+		len(rpcAssets),
+	)
+
 	// We should now also be able to generate ownership proofs for the
 	// received assets.
 	for idx := range addresses {
@@ -137,6 +150,87 @@ func testAddresses(t *harnessTest) {
 	}
 }
 
+// testAddressDepositExpectation tests that inbound transfers to addresses that
+// were annotated with a deposit expectation are flagged accordingly.
+func testAddressDepositExpectation(t *harnessTest) {
+	rpcAssets := mintAssetsConfirmBatch(
+		t, t.tapd, []*mintrpc.MintAssetRequest{simpleAssets[0]},
+	)
+	genInfo := rpcAssets[0].AssetGenesis
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	secondTapd := setupTapdHarness(
+		t.t, t, t.lndHarness.Bob, t.universeServer,
+		func(params *tapdHarnessParams) {
+			params.startupSyncNode = t.tapd
+			params.startupSyncNumAssets = len(rpcAssets)
+		},
+	)
+	defer func() {
+		require.NoError(t.t, secondTapd.stop(!*noDelete))
+	}()
+
+	// An expectation with an amount different from the address amount
+	// must be rejected.
+	const addrAmt = 100
+	_, err := secondTapd.NewAddr(ctxt, &taprpc.NewAddrRequest{
+		AssetId: genInfo.AssetId,
+		Amt:     addrAmt,
+		DepositExpectation: &taprpc.DepositExpectation{
+			Amt: addrAmt + 1,
+		},
+	})
+	require.ErrorContains(t.t, err, "doesn't match address amount")
+
+	// We create one address that expects a deposit within the next hour,
+	// one whose expectation has already expired and one that isn't
+	// annotated at all.
+	now := time.Now()
+	expectations := []*taprpc.DepositExpectation{{
+		ExpiryUnixSeconds: now.Add(time.Hour).Unix(),
+	}, {
+		Amt:               addrAmt,
+		ExpiryUnixSeconds: now.Add(-time.Hour).Unix(),
+	}, nil}
+	expectedStatus := []taprpc.AddrDepositStatus{
+		taprpc.AddrDepositStatus_ADDR_DEPOSIT_STATUS_EXPECTED,
+		taprpc.AddrDepositStatus_ADDR_DEPOSIT_STATUS_LATE,
+		taprpc.AddrDepositStatus_ADDR_DEPOSIT_STATUS_UNANNOTATED,
+	}
+
+	for idx, expectation := range expectations {
+		addr, err := secondTapd.NewAddr(ctxt, &taprpc.NewAddrRequest{
+			AssetId:            genInfo.AssetId,
+			Amt:                addrAmt,
+			DepositExpectation: expectation,
+		})
+		require.NoError(t.t, err)
+
+		sendAssetsToAddr(t, t.tapd, addr)
+		assertAddrEvent(t.t, secondTapd, addr, 1, statusDetected)
+
+		mineBlocks(t, t.lndHarness, 1, 1)
+		assertAddrEvent(t.t, secondTapd, addr, 1, statusConfirmed)
+
+		sendProof(t, t.tapd, secondTapd, addr.ScriptKey, genInfo)
+		assertNonInteractiveRecvComplete(t, secondTapd, idx+1)
+
+		resp, err := secondTapd.AddrReceives(
+			ctxt, &taprpc.AddrReceivesRequest{
+				FilterAddr: addr.Encoded,
+			},
+		)
+		require.NoError(t.t, err)
+		require.Len(t.t, resp.Events, 1)
+		require.Equal(
+			t.t, expectedStatus[idx], resp.Events[0].DepositStatus,
+		)
+	}
+}
+
 // testMultiAddress tests that we can send assets to multiple addresses at the
 // same time.
 func testMultiAddress(t *harnessTest) {
@@ -307,6 +401,36 @@ func sendProof(t *harnessTest, src, dst *tapdHarness, scriptKey []byte,
 	return importResp
 }
 
+// assertStatementEntries exports the accounting statement of the given node
+// and asserts that it contains the expected number of entries of the given
+// type.
+func assertStatementEntries(t *harnessTest, tapd *tapdHarness,
+	entryType tapfreighter.StatementEntryType, numEntries int) {
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	resp, err := tapd.ExportTransferStatement(
+		ctxt, &taprpc.ExportTransferStatementRequest{
+			Format: taprpc.StatementFormat_STATEMENT_FORMAT_JSONL,
+		},
+	)
+	require.NoError(t.t, err)
+
+	var found int
+	decoder := json.NewDecoder(bytes.NewReader(resp.Statement))
+	for decoder.More() {
+		var entry tapfreighter.StatementEntry
+		require.NoError(t.t, decoder.Decode(&entry))
+
+		if entry.EntryType == entryType {
+			found++
+		}
+	}
+	require.Equal(t.t, numEntries, found)
+}
+
 // sendAssetsToAddr spends the given input asset and sends the amount specified
 // in the address to the Taproot output derived from the address.
 func sendAssetsToAddr(t *harnessTest, sender *tapdHarness,
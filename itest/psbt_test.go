@@ -953,6 +953,120 @@ func testPsbtMultiSend(t *harnessTest) {
 	)
 }
 
+// testPsbtPrecomputedChangeKeys tests that the caller can supply the script key
+// of the change output and the anchor internal key when funding a virtual
+// packet, so all output scripts are known before the packet is funded.
+func testPsbtPrecomputedChangeKeys(t *harnessTest) {
+	rpcAssets := mintAssetsConfirmBatch(
+		t, t.tapd, []*mintrpc.MintAssetRequest{simpleAssets[0]},
+	)
+	mintedAsset := rpcAssets[0]
+	genInfo := mintedAsset.AssetGenesis
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	secondTapd := setupTapdHarness(
+		t.t, t, t.lndHarness.Bob, t.universeServer,
+		func(params *tapdHarnessParams) {
+			params.startupSyncNode = t.tapd
+			params.startupSyncNumAssets = len(rpcAssets)
+		},
+	)
+	defer func() {
+		require.NoError(t.t, secondTapd.stop(!*noDelete))
+	}()
+
+	var (
+		alice    = t.tapd
+		bob      = secondTapd
+		numUnits = mintedAsset.Amount / 2
+	)
+
+	bobAddr, err := bob.NewAddr(ctxt, &taprpc.NewAddrRequest{
+		AssetId: genInfo.AssetId,
+		Amt:     numUnits,
+	})
+	require.NoError(t.t, err)
+
+	// Alice derives the keys of her change output up front.
+	changeScriptKey, err := alice.NextScriptKey(
+		ctxt, &wrpc.NextScriptKeyRequest{
+			KeyFamily: uint32(asset.TaprootAssetsKeyFamily),
+		},
+	)
+	require.NoError(t.t, err)
+	anchorInternalKey, err := alice.NextInternalKey(
+		ctxt, &wrpc.NextInternalKeyRequest{
+			KeyFamily: uint32(asset.TaprootAssetsKeyFamily),
+		},
+	)
+	require.NoError(t.t, err)
+
+	fundResp, err := alice.FundVirtualPsbt(
+		ctxt, &wrpc.FundVirtualPsbtRequest{
+			Template: &wrpc.FundVirtualPsbtRequest_Raw{
+				Raw: &wrpc.TxTemplate{
+					Recipients: map[string]uint64{
+						bobAddr.Encoded: 1,
+					},
+				},
+			},
+			ChangeScriptKey:   changeScriptKey.ScriptKey,
+			AnchorInternalKey: anchorInternalKey.InternalKey,
+		},
+	)
+	require.NoError(t.t, err)
+
+	fundedPacket, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(fundResp.FundedPsbt), false,
+	)
+	require.NoError(t.t, err)
+
+	// The change output must use exactly the keys we supplied.
+	changeOut, err := fundedPacket.SplitRootOutput()
+	require.NoError(t.t, err)
+	require.Equal(
+		t.t, changeScriptKey.ScriptKey.PubKey,
+		schnorr.SerializePubKey(changeOut.ScriptKey.PubKey),
+	)
+	require.Equal(
+		t.t, anchorInternalKey.InternalKey.RawKeyBytes,
+		changeOut.AnchorOutputInternalKey.SerializeCompressed(),
+	)
+
+	signResp, err := alice.SignVirtualPsbt(
+		ctxt, &wrpc.SignVirtualPsbtRequest{
+			FundedPsbt: fundResp.FundedPsbt,
+		},
+	)
+	require.NoError(t.t, err)
+
+	sendResp, err := alice.AnchorVirtualPsbts(
+		ctxt, &wrpc.AnchorVirtualPsbtsRequest{
+			VirtualPsbts: [][]byte{signResp.SignedPsbt},
+		},
+	)
+	require.NoError(t.t, err)
+
+	confirmAndAssertOutboundTransfer(
+		t, alice, sendResp, genInfo.AssetId,
+		[]uint64{numUnits, numUnits}, 0, 1,
+	)
+	_ = sendProof(t, alice, bob, bobAddr.ScriptKey, genInfo)
+	assertNonInteractiveRecvComplete(t, bob, 1)
+
+	// Alice's change is held by the script key she supplied.
+	aliceAssets, err := alice.ListAssets(ctxt, &taprpc.ListAssetRequest{})
+	require.NoError(t.t, err)
+	require.Len(t.t, aliceAssets.Assets, 1)
+	require.Equal(
+		t.t, changeScriptKey.ScriptKey.PubKey,
+		aliceAssets.Assets[0].ScriptKey[1:],
+	)
+}
+
 func deriveKeys(t *testing.T, tapd *tapdHarness) (asset.ScriptKey,
 	keychain.KeyDescriptor) {
 
@@ -41,6 +41,7 @@ func testBasicSendUnidirectional(t *harnessTest) {
 	go func() {
 		defer wg.Done()
 
+		var lastSeqNum uint64
 		broadcastState := tapfreighter.SendStateBroadcast.String()
 		targetEventSelector := func(event *taprpc.SendAssetEvent) bool {
 			switch eventTyped := event.Event.(type) {
@@ -49,9 +50,16 @@ func testBasicSendUnidirectional(t *harnessTest) {
 
 				// Log send state execution.
 				timestamp := time.UnixMicro(ev.Timestamp)
-				t.Logf("Executing send state (%v): %v",
+				t.Logf("Executing send state of parcel %d "+
+					"(%v, seq %d): %v", ev.ParcelId,
 					timestamp.Format(time.RFC3339Nano),
-					ev.SendState)
+					ev.SequenceNum, ev.SendState)
+
+				// The sends are sequential, so the events are
+				// received in the order of their sequence
+				// numbers.
+				require.Greater(t.t, ev.SequenceNum, lastSeqNum)
+				lastSeqNum = ev.SequenceNum
 
 				return ev.SendState == broadcastState
 			}
@@ -788,3 +796,79 @@ func testSendMultipleCoins(t *harnessTest) {
 	}
 	assertNonInteractiveRecvComplete(t, secondTapd, 5)
 }
+
+// testSendFrozenAssets tests that frozen asset outputs can't be sent until they
+// are unfrozen again.
+func testSendFrozenAssets(t *harnessTest) {
+	rpcAssets := mintAssetsConfirmBatch(
+		t, t.tapd, []*mintrpc.MintAssetRequest{simpleAssets[0]},
+	)
+	genInfo := rpcAssets[0].AssetGenesis
+	anchorPoint := rpcAssets[0].ChainAnchor.AnchorOutpoint
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	secondTapd := setupTapdHarness(
+		t.t, t, t.lndHarness.Bob, t.universeServer,
+		func(params *tapdHarnessParams) {
+			params.startupSyncNode = t.tapd
+			params.startupSyncNumAssets = len(rpcAssets)
+		},
+	)
+	defer func() {
+		require.NoError(t.t, secondTapd.stop(!*noDelete))
+	}()
+
+	// We freeze all assets at the anchor outpoint of the minted asset.
+	freezeResp, err := t.tapd.FreezeAssetOutputs(
+		ctxt, &taprpc.FreezeAssetOutputsRequest{
+			AnchorOutpoint: anchorPoint,
+			Reason:         "compliance hold",
+		},
+	)
+	require.NoError(t.t, err)
+	require.Len(t.t, freezeResp.Frozen, 1)
+	require.Equal(
+		t.t, rpcAssets[0].ScriptKey, freezeResp.Frozen[0].ScriptKey,
+	)
+
+	listResp, err := t.tapd.ListFrozenAssetOutputs(
+		ctxt, &taprpc.ListFrozenAssetOutputsRequest{},
+	)
+	require.NoError(t.t, err)
+	require.Len(t.t, listResp.Frozen, 1)
+	require.Equal(t.t, "compliance hold", listResp.Frozen[0].Reason)
+
+	// Sending the frozen asset must fail.
+	const numUnits = 10
+	bobAddr, err := secondTapd.NewAddr(ctxt, &taprpc.NewAddrRequest{
+		AssetId: genInfo.AssetId,
+		Amt:     numUnits,
+	})
+	require.NoError(t.t, err)
+
+	_, err = t.tapd.SendAsset(ctxt, &taprpc.SendAssetRequest{
+		TapAddrs: []string{bobAddr.Encoded},
+	})
+	require.ErrorContains(t.t, err, "failed to find coin(s)")
+
+	// Once unfrozen, the asset can be sent again.
+	unfreezeResp, err := t.tapd.UnfreezeAssetOutputs(
+		ctxt, &taprpc.UnfreezeAssetOutputsRequest{
+			AnchorOutpoint: anchorPoint,
+		},
+	)
+	require.NoError(t.t, err)
+	require.EqualValues(t.t, 1, unfreezeResp.NumUnfrozen)
+
+	sendResp := sendAssetsToAddr(t, t.tapd, bobAddr)
+	confirmAndAssertOutboundTransfer(
+		t, t.tapd, sendResp, genInfo.AssetId,
+		[]uint64{simpleAssets[0].Asset.Amount - numUnits, numUnits},
+		0, 1,
+	)
+	_ = sendProof(t, t.tapd, secondTapd, bobAddr.ScriptKey, genInfo)
+	assertNonInteractiveRecvComplete(t, secondTapd, 1)
+}
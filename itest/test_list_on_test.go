@@ -19,6 +19,10 @@ var testCases = []*testCase{
 		name: "addresses",
 		test: testAddresses,
 	},
+	{
+		name: "address deposit expectation",
+		test: testAddressDepositExpectation,
+	},
 	{
 		name: "multi address",
 		test: testMultiAddress,
@@ -52,6 +56,10 @@ var testCases = []*testCase{
 		name: "send multiple coins",
 		test: testSendMultipleCoins,
 	},
+	{
+		name: "send frozen assets",
+		test: testSendFrozenAssets,
+	},
 	{
 		name: "multi input send non-interactive single ID",
 		test: testMultiInputSendNonInteractiveSingleID,
@@ -120,6 +128,10 @@ var testCases = []*testCase{
 		name: "psbt multi send",
 		test: testPsbtMultiSend,
 	},
+	{
+		name: "psbt precomputed change keys",
+		test: testPsbtPrecomputedChangeKeys,
+	},
 	{
 		name: "universe REST API",
 		test: testUniverseREST,
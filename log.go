@@ -8,6 +8,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightninglabs/taproot-assets/webhook"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/signal"
 )
@@ -99,6 +100,7 @@ func SetupLoggers(root *build.RotatingLogWriter, interceptor signal.Interceptor)
 	AddSubLogger(
 		root, commitment.Subsystem, interceptor, commitment.UseLogger,
 	)
+	AddSubLogger(root, webhook.Subsystem, interceptor, webhook.UseLogger)
 }
 
 // AddSubLogger is a helper method to conveniently create and register the
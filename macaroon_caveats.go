@@ -0,0 +1,399 @@
+package taprootassets
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/perms"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapdb"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
+	"gopkg.in/macaroon.v2"
+)
+
+const (
+	// ReadOnlyCaveat is the name of the custom macaroon caveat that
+	// restricts a macaroon to the RPCs that only require read permissions.
+	ReadOnlyCaveat = "read-only"
+
+	// SendAssetsCaveat is the name of the custom macaroon caveat that
+	// restricts a macaroon to sending the assets with the comma separated
+	// hex encoded IDs of its condition.
+	SendAssetsCaveat = "send-assets"
+
+	// SendMaxAmountCaveat is the name of the custom macaroon caveat that
+	// limits the amount of each asset a single send can move.
+	SendMaxAmountCaveat = "send-max-amount"
+
+	// SendDailyLimitCaveat is the name of the custom macaroon caveat that
+	// limits the total amount of each asset sent per UTC day.
+	SendDailyLimitCaveat = "send-daily-limit"
+)
+
+// unrestrictedSendMethods are the RPCs that send assets without the assets and
+// amounts being known to the RPC layer up front. Macaroons that restrict sends
+// can't be used to call them.
+var unrestrictedSendMethods = map[string]struct{}{
+	"/taprpc.TaprootAssets/StartAirdrop":                {},
+	"/taprpc.TaprootAssets/ResumeAirdrop":               {},
+	"/taprpc.TaprootAssets/ExecuteTransferTemplate":     {},
+	"/taprpc.TaprootAssets/RegisterAnchorSessionParcel": {},
+	"/taprpc.TaprootAssets/SealAnchorSession":           {},
+}
+
+// ReadOnlyConstraint restricts a macaroon to the RPCs that only require read
+// permissions.
+func ReadOnlyConstraint() macaroons.Constraint {
+	return macaroons.CustomConstraint(ReadOnlyCaveat, "")
+}
+
+// SendAssetsConstraint restricts a macaroon to sending the given assets.
+func SendAssetsConstraint(assetIDs ...asset.ID) macaroons.Constraint {
+	ids := make([]string, len(assetIDs))
+	for idx := range assetIDs {
+		ids[idx] = assetIDs[idx].String()
+	}
+
+	return macaroons.CustomConstraint(
+		SendAssetsCaveat, strings.Join(ids, ","),
+	)
+}
+
+// SendMaxAmountConstraint limits the amount of each asset a single send with a
+// macaroon can move.
+func SendMaxAmountConstraint(amount uint64) macaroons.Constraint {
+	return macaroons.CustomConstraint(
+		SendMaxAmountCaveat, strconv.FormatUint(amount, 10),
+	)
+}
+
+// SendDailyLimitConstraint limits the total amount of each asset sent with a
+// macaroon per UTC day.
+func SendDailyLimitConstraint(amount uint64) macaroons.Constraint {
+	return macaroons.CustomConstraint(
+		SendDailyLimitCaveat, strconv.FormatUint(amount, 10),
+	)
+}
+
+// customCaveatChecker returns the checker of all custom caveats tapd supports.
+// The caveats that restrict the RPCs a macaroon can be used for are enforced
+// here, the caveats that depend on the content of a request are enforced by
+// the RPC handlers.
+func customCaveatChecker() macaroons.Checker {
+	checker := func(ctx context.Context, _, outerCondition string) error {
+		if outerCondition != strings.TrimSpace(outerCondition) {
+			return fmt.Errorf("unexpected white space found in " +
+				"caveat condition")
+		}
+
+		name, condition, _ := strings.Cut(outerCondition, " ")
+		switch name {
+		case ArchiveUserCaveat:
+			return proof.ValidateArchiveUser(condition)
+
+		case ReadOnlyCaveat:
+			return checkReadOnlyMethod(ctx)
+
+		case SendAssetsCaveat:
+			if _, err := parseSendAssets(condition); err != nil {
+				return err
+			}
+
+			return checkRestrictedSendMethod(ctx)
+
+		case SendMaxAmountCaveat, SendDailyLimitCaveat:
+			if _, err := parseSendAmount(condition); err != nil {
+				return err
+			}
+
+			return checkRestrictedSendMethod(ctx)
+
+		default:
+			return fmt.Errorf("unknown custom caveat %q", name)
+		}
+	}
+
+	return func() (string, checkers.Func) {
+		return macaroons.CondLndCustom, checker
+	}
+}
+
+// checkReadOnlyMethod makes sure the RPC of the given request context only
+// requires read permissions.
+func checkReadOnlyMethod(ctx context.Context) error {
+	method, ok := grpc.Method(ctx)
+	if !ok {
+		return fmt.Errorf("unable to determine RPC method")
+	}
+
+	ops, ok := perms.RequiredPermissions[method]
+	if !ok {
+		return fmt.Errorf("unknown RPC method %v", method)
+	}
+	for _, op := range ops {
+		if op.Action != "read" {
+			return fmt.Errorf("macaroon is read-only, %v requires "+
+				"%v permission on %v", method, op.Action,
+				op.Entity)
+		}
+	}
+
+	return nil
+}
+
+// checkRestrictedSendMethod makes sure the RPC of the given request context
+// can be called with a macaroon that restricts sends.
+func checkRestrictedSendMethod(ctx context.Context) error {
+	method, ok := grpc.Method(ctx)
+	if !ok {
+		return fmt.Errorf("unable to determine RPC method")
+	}
+
+	if _, ok := unrestrictedSendMethods[method]; ok {
+		return fmt.Errorf("macaroon restricts sends, %v is not "+
+			"allowed", method)
+	}
+
+	return nil
+}
+
+// parseSendAssets parses the condition of a send assets caveat.
+func parseSendAssets(condition string) (map[asset.ID]struct{}, error) {
+	assetIDs := make(map[asset.ID]struct{})
+	if condition == "" {
+		return assetIDs, nil
+	}
+
+	for _, idStr := range strings.Split(condition, ",") {
+		idBytes, err := hex.DecodeString(idStr)
+		if err != nil || len(idBytes) != len(asset.ID{}) {
+			return nil, fmt.Errorf("invalid asset ID %q in %v "+
+				"caveat", idStr, SendAssetsCaveat)
+		}
+
+		var assetID asset.ID
+		copy(assetID[:], idBytes)
+		assetIDs[assetID] = struct{}{}
+	}
+
+	return assetIDs, nil
+}
+
+// parseSendAmount parses the condition of a send amount caveat.
+func parseSendAmount(condition string) (uint64, error) {
+	amount, err := strconv.ParseUint(condition, 10, 64)
+	if err != nil || amount == 0 {
+		return 0, fmt.Errorf("invalid send amount %q in caveat",
+			condition)
+	}
+
+	return amount, nil
+}
+
+// macaroonFromContext returns the macaroon of the given request context, or
+// nil if the request doesn't carry a macaroon, which is only the case if
+// macaroons are disabled.
+func macaroonFromContext(ctx context.Context) (*macaroon.Macaroon, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md["macaroon"]) == 0 {
+		return nil, nil
+	}
+
+	macHex, err := macaroons.RawMacaroonFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	macBytes, err := hex.DecodeString(macHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid macaroon encoding: %w", err)
+	}
+
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return nil, fmt.Errorf("unable to decode macaroon: %w", err)
+	}
+
+	return mac, nil
+}
+
+// customCaveatConditions returns the conditions of all custom caveats with the
+// given name of the macaroon. As caveats can be added by anyone holding a
+// macaroon, there can be more than one.
+func customCaveatConditions(mac *macaroon.Macaroon, name string) []string {
+	if mac == nil {
+		return nil
+	}
+
+	var (
+		caveatName = fmt.Sprintf("%s %s", macaroons.CondLndCustom, name)
+		prefix     = caveatName + " "
+		conditions []string
+	)
+	for _, caveat := range mac.Caveats() {
+		id := string(caveat.Id)
+		switch {
+		case id == caveatName:
+			conditions = append(conditions, "")
+
+		case strings.HasPrefix(id, prefix):
+			conditions = append(
+				conditions, strings.TrimPrefix(id, prefix),
+			)
+		}
+	}
+
+	return conditions
+}
+
+// sendRestrictions are the restrictions the caveats of a macaroon put on the
+// assets sent with it. If a caveat is added more than once, the most
+// restrictive one applies.
+type sendRestrictions struct {
+	// macaroonID identifies the macaroon the daily limit is tracked for.
+	macaroonID string
+
+	// assets are the assets that can be sent. If nil, all assets can be
+	// sent.
+	assets map[asset.ID]struct{}
+
+	// maxAmount is the amount of each asset a single send can move. Zero
+	// means there's no limit.
+	maxAmount uint64
+
+	// dailyLimit is the total amount of each asset that can be sent per
+	// UTC day. Zero means there's no limit.
+	dailyLimit uint64
+}
+
+// sendRestrictionsFromContext returns the send restrictions of the macaroon
+// of the given request context, or nil if sends aren't restricted.
+func sendRestrictionsFromContext(ctx context.Context) (*sendRestrictions,
+	error) {
+
+	mac, err := macaroonFromContext(ctx)
+	if err != nil || mac == nil {
+		return nil, err
+	}
+
+	var (
+		restricted   bool
+		restrictions = &sendRestrictions{
+			macaroonID: hex.EncodeToString(mac.Id()),
+		}
+		assetCaveats = customCaveatConditions(mac, SendAssetsCaveat)
+	)
+	for _, condition := range assetCaveats {
+		assetIDs, err := parseSendAssets(condition)
+		if err != nil {
+			return nil, err
+		}
+
+		// Every caveat further narrows down the assets that can be
+		// sent.
+		if restrictions.assets != nil {
+			for assetID := range restrictions.assets {
+				if _, ok := assetIDs[assetID]; !ok {
+					delete(restrictions.assets, assetID)
+				}
+			}
+		} else {
+			restrictions.assets = assetIDs
+		}
+		restricted = true
+	}
+
+	minAmount := func(name string) (uint64, error) {
+		var limit uint64
+		for _, condition := range customCaveatConditions(mac, name) {
+			amount, err := parseSendAmount(condition)
+			if err != nil {
+				return 0, err
+			}
+
+			if limit == 0 || amount < limit {
+				limit = amount
+			}
+			restricted = true
+		}
+
+		return limit, nil
+	}
+	restrictions.maxAmount, err = minAmount(SendMaxAmountCaveat)
+	if err != nil {
+		return nil, err
+	}
+	restrictions.dailyLimit, err = minAmount(SendDailyLimitCaveat)
+	if err != nil {
+		return nil, err
+	}
+
+	if !restricted {
+		return nil, nil
+	}
+
+	return restrictions, nil
+}
+
+// checkSend makes sure a single send of the given amount of an asset is
+// allowed.
+func (s *sendRestrictions) checkSend(assetID asset.ID, amount uint64) error {
+	if s.assets != nil {
+		if _, ok := s.assets[assetID]; !ok {
+			return fmt.Errorf("macaroon doesn't allow sending "+
+				"asset %v", assetID)
+		}
+	}
+
+	if s.maxAmount != 0 && amount > s.maxAmount {
+		return fmt.Errorf("send of %d units of asset %v exceeds the "+
+			"limit of %d units per send", amount, assetID,
+			s.maxAmount)
+	}
+
+	return nil
+}
+
+// sendReservation is a send that is counted against the daily limit of a
+// macaroon until it is released. A nil reservation belongs to a send that isn't
+// limited, releasing or linking it is a no-op.
+type sendReservation struct {
+	limits *tapdb.SendLimits
+	id     int32
+}
+
+// release gives the reservation back, so its amounts no longer count against
+// the daily limit of the macaroon. It must be called if the send fails.
+func (s *sendReservation) release() {
+	if s == nil {
+		return
+	}
+
+	// The send may have failed because the request was canceled, so we
+	// can't use the request context to release the reservation.
+	if err := s.limits.ReleaseSend(context.Background(), s.id); err != nil {
+		rpcsLog.Errorf("Unable to release send reservation %d: %v",
+			s.id, err)
+	}
+}
+
+// linkTransfer links the reservation to the anchor transaction of the transfer
+// the send was committed to, so it is released if the transfer is canceled.
+func (s *sendReservation) linkTransfer(anchorTxid chainhash.Hash) {
+	if s == nil {
+		return
+	}
+
+	err := s.limits.LinkTransfer(context.Background(), s.id, anchorTxid)
+	if err != nil {
+		rpcsLog.Errorf("Unable to link send reservation %d to "+
+			"transfer %v: %v", s.id, anchorTxid, err)
+	}
+}
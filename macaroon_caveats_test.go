@@ -0,0 +1,178 @@
+package taprootassets
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapdb"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon.v2"
+)
+
+// mockPorter is a porter that fails or accepts all shipments and records the
+// scheduled parcels that are canceled.
+type mockPorter struct {
+	tapfreighter.Porter
+
+	// shipmentErr is the error all shipments fail with, if set.
+	shipmentErr error
+
+	// anchorTx is the anchor transaction of accepted shipments.
+	anchorTx *wire.MsgTx
+
+	canceled []chainhash.Hash
+}
+
+// RequestShipment fails or accepts the given parcel.
+func (m *mockPorter) RequestShipment(
+	tapfreighter.Parcel) (*tapfreighter.OutboundParcel, error) {
+
+	if m.shipmentErr != nil {
+		return nil, m.shipmentErr
+	}
+
+	return &tapfreighter.OutboundParcel{
+		AnchorTx: m.anchorTx,
+	}, nil
+}
+
+// CancelScheduledParcel records the canceled parcel.
+func (m *mockPorter) CancelScheduledParcel(anchorTxid chainhash.Hash) error {
+	m.canceled = append(m.canceled, anchorTxid)
+	return nil
+}
+
+// newSendLimitServer creates an RPC server that ships parcels through the
+// given porter and tracks send limits in a test database.
+func newSendLimitServer(t *testing.T, porter *mockPorter) *rpcServer {
+	db := tapdb.NewTestDB(t)
+	sendLimitDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.SendLimitStore {
+			return db.WithTx(tx)
+		},
+	)
+
+	return &rpcServer{
+		cfg: &Config{
+			ChainPorter: porter,
+			DatabaseConfig: &DatabaseConfig{
+				SendLimits: tapdb.NewSendLimits(
+					sendLimitDB, clock.NewDefaultClock(),
+				),
+			},
+		},
+	}
+}
+
+// limitedSendContext returns a request context that carries a macaroon with
+// the given daily send limit.
+func limitedSendContext(t *testing.T, dailyLimit uint64) context.Context {
+	mac, err := macaroon.New(
+		test.RandBytes(32), test.RandBytes(16), "tapd",
+		macaroon.LatestVersion,
+	)
+	require.NoError(t, err)
+
+	mac, err = macaroons.AddConstraints(
+		mac, SendDailyLimitConstraint(dailyLimit),
+	)
+	require.NoError(t, err)
+
+	macBytes, err := mac.MarshalBinary()
+	require.NoError(t, err)
+
+	return metadata.NewIncomingContext(
+		context.Background(),
+		metadata.Pairs("macaroon", hex.EncodeToString(macBytes)),
+	)
+}
+
+// TestLimitedShipmentReleasedOnFailure tests that a send that fails after it
+// was counted against the daily limit of a macaroon is released again.
+func TestLimitedShipmentReleasedOnFailure(t *testing.T) {
+	t.Parallel()
+
+	porter := &mockPorter{
+		shipmentErr: errors.New("no funds"),
+	}
+	server := newSendLimitServer(t, porter)
+	ctx := limitedSendContext(t, 100)
+
+	var assetID asset.ID
+	test.RandRead(t, assetID[:])
+	sendAmounts := func() map[asset.ID]uint64 {
+		return map[asset.ID]uint64{
+			assetID: 100,
+		}
+	}
+
+	// The failed sends don't count against the limit, so all of them get
+	// to the porter.
+	for i := 0; i < 3; i++ {
+		_, err := server.requestLimitedShipment(ctx, nil, sendAmounts)
+		require.ErrorIs(t, err, porter.shipmentErr)
+	}
+
+	porter.shipmentErr = nil
+	porter.anchorTx = wire.NewMsgTx(2)
+	_, err := server.requestLimitedShipment(ctx, nil, sendAmounts)
+	require.NoError(t, err)
+
+	// Only the successful send counts against the limit.
+	_, err = server.requestLimitedShipment(ctx, nil, sendAmounts)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+// TestLimitedShipmentReleasedOnCancel tests that a scheduled send no longer
+// counts against the daily limit of a macaroon once it is canceled.
+func TestLimitedShipmentReleasedOnCancel(t *testing.T) {
+	t.Parallel()
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxOut(wire.NewTxOut(1000, test.RandBytes(34)))
+	porter := &mockPorter{
+		anchorTx: anchorTx,
+	}
+	server := newSendLimitServer(t, porter)
+	ctx := limitedSendContext(t, 100)
+
+	var assetID asset.ID
+	test.RandRead(t, assetID[:])
+	sendAmounts := func() map[asset.ID]uint64 {
+		return map[asset.ID]uint64{
+			assetID: 60,
+		}
+	}
+
+	_, err := server.requestLimitedShipment(ctx, nil, sendAmounts)
+	require.NoError(t, err)
+
+	_, err = server.requestLimitedShipment(ctx, nil, sendAmounts)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	anchorTxid := anchorTx.TxHash()
+	_, err = server.CancelScheduledTransfer(
+		context.Background(), &taprpc.CancelScheduledTransferRequest{
+			AnchorTxid: anchorTxid.String(),
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []chainhash.Hash{anchorTxid}, porter.canceled)
+
+	_, err = server.requestLimitedShipment(ctx, nil, sendAmounts)
+	require.NoError(t, err)
+}
@@ -0,0 +1,190 @@
+package taprootassets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// mempoolInfoResp is the response of bitcoind's getmempoolinfo RPC.
+type mempoolInfoResp struct {
+	// Size is the number of transactions in the mempool.
+	Size int64 `json:"size"`
+
+	// Bytes is the total virtual size of the mempool transactions.
+	Bytes int64 `json:"bytes"`
+
+	// MempoolMinFee is the lowest fee rate in BTC/kvB that is currently
+	// accepted into the mempool.
+	MempoolMinFee float64 `json:"mempoolminfee"`
+
+	// MinRelayTxFee is the lowest fee rate in BTC/kvB that is relayed.
+	MinRelayTxFee float64 `json:"minrelaytxfee"`
+}
+
+// btcPerKVByteToFeeRate converts a fee rate in BTC/kvB as returned by bitcoind
+// to a fee rate in sat/kw.
+func btcPerKVByteToFeeRate(btcPerKVByte float64) (chainfee.SatPerKWeight,
+	error) {
+
+	satPerKVByte, err := btcutil.NewAmount(btcPerKVByte)
+	if err != nil {
+		return 0, fmt.Errorf("invalid fee rate %v: %w", btcPerKVByte,
+			err)
+	}
+
+	return chainfee.SatPerKVByte(satPerKVByte).FeePerKWeight(), nil
+}
+
+// MempoolInfo returns the size and the minimum fee rates of bitcoind's
+// mempool. bitcoind doesn't report a fee histogram, so it is left empty.
+//
+// NOTE: This is part of the tapgarden.MempoolSource interface.
+func (b *BitcoindFeeEstimator) MempoolInfo(
+	_ context.Context) (*tapgarden.MempoolInfo, error) {
+
+	rawResp, err := b.client.RawRequest("getmempoolinfo", nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query getmempoolinfo: %w",
+			err)
+	}
+
+	var resp mempoolInfoResp
+	if err := json.Unmarshal(rawResp, &resp); err != nil {
+		return nil, fmt.Errorf("unable to decode getmempoolinfo "+
+			"response: %w", err)
+	}
+
+	mempoolMinFee, err := btcPerKVByteToFeeRate(resp.MempoolMinFee)
+	if err != nil {
+		return nil, err
+	}
+	minRelayFee, err := btcPerKVByteToFeeRate(resp.MinRelayTxFee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tapgarden.MempoolInfo{
+		NumTxns:       resp.Size,
+		VSize:         resp.Bytes,
+		MinRelayFee:   minRelayFee,
+		MempoolMinFee: mempoolMinFee,
+	}, nil
+}
+
+// MempoolAPISourceCfg is the configuration of a mempool source that queries an
+// external mempool API.
+type MempoolAPISourceCfg struct {
+	// URL is the URL of the mempool API. It must return the mempool state
+	// in the format of the /api/mempool endpoint of mempool.space, a JSON
+	// object with the count and vsize of the mempool transactions and a
+	// fee_histogram list of [fee rate in sat/vB, vsize] pairs sorted by
+	// descending fee rate.
+	URL string
+
+	// Timeout is the timeout of a single request to the mempool API. If
+	// zero, DefaultFeeAPITimeout is used.
+	Timeout time.Duration
+}
+
+// MempoolAPISource is an implementation of the tapgarden.MempoolSource
+// interface that queries an external mempool API, which, unlike bitcoind,
+// reports the fee histogram of the mempool.
+type MempoolAPISource struct {
+	cfg *MempoolAPISourceCfg
+
+	client *http.Client
+}
+
+// NewMempoolAPISource creates a new mempool source for the configured mempool
+// API.
+func NewMempoolAPISource(cfg *MempoolAPISourceCfg) *MempoolAPISource {
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = DefaultFeeAPITimeout
+	}
+
+	return &MempoolAPISource{
+		cfg: cfg,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// mempoolAPIResp is the response of a mempool API in the format of the
+// /api/mempool endpoint of mempool.space.
+type mempoolAPIResp struct {
+	// Count is the number of transactions in the mempool.
+	Count int64 `json:"count"`
+
+	// VSize is the total virtual size of the mempool transactions.
+	VSize int64 `json:"vsize"`
+
+	// FeeHistogram is a list of [fee rate in sat/vB, vsize] pairs, sorted
+	// by descending fee rate.
+	FeeHistogram [][2]float64 `json:"fee_histogram"`
+}
+
+// MempoolInfo returns the size and the fee histogram of the mempool reported by
+// the mempool API. The API doesn't report the minimum fee rates, so they are
+// left zero.
+//
+// NOTE: This is part of the tapgarden.MempoolSource interface.
+func (m *MempoolAPISource) MempoolInfo(
+	ctx context.Context) (*tapgarden.MempoolInfo, error) {
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, m.cfg.URL, nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create mempool API "+
+			"request: %w", err)
+	}
+
+	httpResp, err := m.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query mempool API: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("mempool API returned status %v",
+			httpResp.Status)
+	}
+
+	var resp mempoolAPIResp
+	if err := json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("unable to decode mempool API "+
+			"response: %w", err)
+	}
+
+	info := &tapgarden.MempoolInfo{
+		NumTxns: resp.Count,
+		VSize:   resp.VSize,
+	}
+	for _, bucket := range resp.FeeHistogram {
+		satPerKVByte := chainfee.SatPerKVByte(bucket[0] * 1000)
+		info.FeeHistogram = append(
+			info.FeeHistogram, tapgarden.FeeHistogramBucket{
+				FeeRate: satPerKVByte.FeePerKWeight(),
+				VSize:   int64(bucket[1]),
+			},
+		)
+	}
+
+	return info, nil
+}
+
+// A compile-time assertion to ensure BitcoindFeeEstimator and MempoolAPISource
+// meet the tapgarden.MempoolSource interface.
+var (
+	_ tapgarden.MempoolSource = (*BitcoindFeeEstimator)(nil)
+	_ tapgarden.MempoolSource = (*MempoolAPISource)(nil)
+)
@@ -3,6 +3,7 @@ package mssmt
 import (
 	"context"
 	"fmt"
+	"io"
 )
 
 // CompactedTree represents a compacted Merkle-Sum Sparse Merkle Tree (MS-SMT).
@@ -392,3 +393,9 @@ func (t *CompactedTree) MerkleProof(ctx context.Context, key [hashSize]byte) (
 
 	return NewProof(proof), nil
 }
+
+// Export writes all leaves of the MS-SMT to the given writer in a streaming,
+// checksummed format that can be loaded with ImportTree.
+func (t *CompactedTree) Export(ctx context.Context, w io.Writer) error {
+	return exportTree(ctx, t.store, w)
+}
@@ -0,0 +1,378 @@
+package mssmt
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+const (
+	// exportVersion is the current version of the tree export format.
+	exportVersion uint8 = 0
+
+	// exportRecordLeaf marks a leaf record within a tree export.
+	exportRecordLeaf uint8 = 1
+
+	// exportRecordEnd marks the final record of a tree export.
+	exportRecordEnd uint8 = 0
+)
+
+var (
+	// exportMagic is the magic prefix of every tree export.
+	exportMagic = [4]byte{'m', 's', 's', 't'}
+
+	// ErrInvalidTreeExport is returned when a tree export is malformed or
+	// doesn't match its checksum or root.
+	ErrInvalidTreeExport = errors.New("mssmt: invalid tree export")
+
+	// ErrTreeNotEmpty is returned when importing a tree into a store that
+	// already contains a non-empty tree.
+	ErrTreeNotEmpty = errors.New("mssmt: tree not empty")
+)
+
+// exportedLeaf is a leaf read from a tree export.
+type exportedLeaf struct {
+	key  [hashSize]byte
+	leaf *LeafNode
+}
+
+// exportTree writes all non-empty leaves of the tree persisted in the given
+// store to the writer in the following streaming format:
+//
+//	magic (4 bytes) || version (1 byte)
+//	for each leaf, in path order:
+//	  record type 1 (1 byte) || key (32 bytes) || sum (8 bytes) ||
+//	  value length (4 bytes) || value
+//	record type 0 (1 byte) || num leaves (8 bytes) ||
+//	root hash (32 bytes) || root sum (8 bytes) || checksum (32 bytes)
+//
+// The checksum is the SHA-256 hash of all preceding bytes of the export.
+func exportTree(ctx context.Context, store TreeStore, w io.Writer) error {
+	hasher := sha256.New()
+	hw := io.MultiWriter(w, hasher)
+
+	writeUint := func(v any) error {
+		return binary.Write(hw, byteOrder, v)
+	}
+
+	err := store.View(ctx, func(tx TreeStoreViewTx) error {
+		if _, err := hw.Write(exportMagic[:]); err != nil {
+			return err
+		}
+		if err := writeUint(exportVersion); err != nil {
+			return err
+		}
+
+		var numLeaves uint64
+		err := walkLeaves(tx, func(key [hashSize]byte, leaf *LeafNode,
+			_, _ int) error {
+
+			numLeaves++
+
+			if err := writeUint(exportRecordLeaf); err != nil {
+				return err
+			}
+			if _, err := hw.Write(key[:]); err != nil {
+				return err
+			}
+			if err := writeUint(leaf.NodeSum()); err != nil {
+				return err
+			}
+			err := writeUint(uint32(len(leaf.Value)))
+			if err != nil {
+				return err
+			}
+			_, err = hw.Write(leaf.Value)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		root, err := tx.RootNode()
+		if err != nil {
+			return err
+		}
+		rootHash := root.NodeHash()
+
+		if err := writeUint(exportRecordEnd); err != nil {
+			return err
+		}
+		if err := writeUint(numLeaves); err != nil {
+			return err
+		}
+		if _, err := hw.Write(rootHash[:]); err != nil {
+			return err
+		}
+		if err := writeUint(root.NodeSum()); err != nil {
+			return err
+		}
+
+		// The checksum itself is only written to the target writer.
+		_, err = w.Write(hasher.Sum(nil))
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to export tree: %w", err)
+	}
+
+	return nil
+}
+
+// readExport reads and validates a full tree export from the reader. The
+// leaves are returned in path order, along with the expected root hash and
+// sum.
+func readExport(r io.Reader) ([]exportedLeaf, NodeHash, uint64, error) {
+	hasher := sha256.New()
+	hr := io.TeeReader(r, hasher)
+
+	fail := func(format string, args ...any) ([]exportedLeaf, NodeHash,
+		uint64, error) {
+
+		return nil, NodeHash{}, 0, fmt.Errorf("%w: %v",
+			ErrInvalidTreeExport, fmt.Sprintf(format, args...))
+	}
+
+	var magic [4]byte
+	if _, err := io.ReadFull(hr, magic[:]); err != nil {
+		return fail("unable to read magic: %v", err)
+	}
+	if magic != exportMagic {
+		return fail("unknown magic %x", magic[:])
+	}
+
+	var version uint8
+	if err := binary.Read(hr, byteOrder, &version); err != nil {
+		return fail("unable to read version: %v", err)
+	}
+	if version != exportVersion {
+		return fail("unknown version %d", version)
+	}
+
+	var (
+		leaves  []exportedLeaf
+		rootSum uint64
+	)
+	for {
+		var recordType uint8
+		if err := binary.Read(hr, byteOrder, &recordType); err != nil {
+			return fail("unable to read record type: %v", err)
+		}
+
+		if recordType == exportRecordEnd {
+			break
+		}
+		if recordType != exportRecordLeaf {
+			return fail("unknown record type %d", recordType)
+		}
+
+		var (
+			key      [hashSize]byte
+			sum      uint64
+			valueLen uint32
+		)
+		if _, err := io.ReadFull(hr, key[:]); err != nil {
+			return fail("unable to read leaf key: %v", err)
+		}
+		if err := binary.Read(hr, byteOrder, &sum); err != nil {
+			return fail("unable to read leaf sum: %v", err)
+		}
+		if err := binary.Read(hr, byteOrder, &valueLen); err != nil {
+			return fail("unable to read leaf value length: %v",
+				err)
+		}
+		if valueLen > maxLeafSize {
+			return fail("leaf value of %d bytes exceeds maximum",
+				valueLen)
+		}
+
+		value := make([]byte, valueLen)
+		if _, err := io.ReadFull(hr, value); err != nil {
+			return fail("unable to read leaf value: %v", err)
+		}
+
+		// The leaves must be strictly ordered by their path, which
+		// also rules out duplicate keys.
+		if len(leaves) > 0 {
+			prevKey := leaves[len(leaves)-1].key
+			if !pathLess(&prevKey, &key) {
+				return fail("leaf %x not in path order",
+					key[:])
+			}
+		}
+
+		leaf := NewLeafNode(value, sum)
+		if leaf.IsEmpty() {
+			return fail("empty leaf %x", key[:])
+		}
+
+		if err := CheckSumOverflowUint64(rootSum, sum); err != nil {
+			return fail("leaf sum overflow: %v", err)
+		}
+		rootSum += sum
+
+		leaves = append(leaves, exportedLeaf{
+			key:  key,
+			leaf: leaf,
+		})
+	}
+
+	var (
+		numLeaves    uint64
+		rootHash     NodeHash
+		expectedSum  uint64
+		checksum     [sha256.Size]byte
+		expectedHash [sha256.Size]byte
+	)
+	if err := binary.Read(hr, byteOrder, &numLeaves); err != nil {
+		return fail("unable to read leaf count: %v", err)
+	}
+	if _, err := io.ReadFull(hr, rootHash[:]); err != nil {
+		return fail("unable to read root hash: %v", err)
+	}
+	if err := binary.Read(hr, byteOrder, &expectedSum); err != nil {
+		return fail("unable to read root sum: %v", err)
+	}
+
+	// The checksum covers everything up to here, so we read it from the
+	// underlying reader.
+	copy(expectedHash[:], hasher.Sum(nil))
+	if _, err := io.ReadFull(r, checksum[:]); err != nil {
+		return fail("unable to read checksum: %v", err)
+	}
+	if !bytes.Equal(checksum[:], expectedHash[:]) {
+		return fail("checksum mismatch")
+	}
+
+	if numLeaves != uint64(len(leaves)) {
+		return fail("expected %d leaves, got %d", numLeaves,
+			len(leaves))
+	}
+	if expectedSum != rootSum {
+		return fail("expected root sum %d, got %d", expectedSum,
+			rootSum)
+	}
+
+	return leaves, rootHash, rootSum, nil
+}
+
+// pathLess returns true if the path to the first key branches off to the left
+// of the path to the second key.
+func pathLess(a, b *[hashSize]byte) bool {
+	for i := 0; i <= lastBitIndex; i++ {
+		bitA, bitB := bitIndex(uint8(i), a), bitIndex(uint8(i), b)
+		if bitA != bitB {
+			return bitA == 0
+		}
+	}
+
+	return false
+}
+
+// buildCompacted creates the compacted subtree at the given height that holds
+// exactly the given leaves, which must be in path order, and returns its top
+// node. If a transaction is passed, all nodes of the subtree are stored.
+func buildCompacted(tx TreeStoreUpdateTx, height int,
+	leaves []exportedLeaf) (Node, error) {
+
+	switch {
+	case len(leaves) == 0:
+		return EmptyTree[height], nil
+
+	// The root is always a branch, every other subtree that only holds a
+	// single leaf is stored as a compacted leaf.
+	case len(leaves) == 1 && height > 0:
+		leaf := NewCompactedLeafNode(
+			height, &leaves[0].key, leaves[0].leaf,
+		)
+		if tx == nil {
+			return leaf, nil
+		}
+		if err := tx.InsertCompactedLeaf(leaf); err != nil {
+			return nil, err
+		}
+
+		return leaf, nil
+	}
+
+	// Since the leaves are in path order, all leaves in the left subtree
+	// come before the leaves in the right subtree.
+	split := sort.Search(len(leaves), func(i int) bool {
+		return bitIndex(uint8(height), &leaves[i].key) == 1
+	})
+
+	left, err := buildCompacted(tx, height+1, leaves[:split])
+	if err != nil {
+		return nil, err
+	}
+	right, err := buildCompacted(tx, height+1, leaves[split:])
+	if err != nil {
+		return nil, err
+	}
+
+	branch := NewBranch(left, right)
+	if tx == nil {
+		return branch, nil
+	}
+	if err := tx.InsertBranch(branch); err != nil {
+		return nil, err
+	}
+
+	return branch, nil
+}
+
+// ImportTree reads a tree export created by Export from the reader and stores
+// it as a CompactedTree in the given store, which must not already hold a
+// non-empty tree. The whole export is read and verified against its checksum
+// and recorded root before anything is written to the store, and the nodes are
+// written directly instead of inserting the leaves one by one.
+func ImportTree(ctx context.Context, r io.Reader,
+	store TreeStore) (*CompactedTree, error) {
+
+	leaves, rootHash, rootSum, err := readExport(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// We first compute the root without touching the store, so a tree
+	// that doesn't match the export is never stored.
+	root, err := buildCompacted(nil, 0, leaves)
+	if err != nil {
+		return nil, err
+	}
+	if root.NodeHash() != rootHash || root.NodeSum() != rootSum {
+		return nil, fmt.Errorf("%w: expected root %v, got %v",
+			ErrInvalidTreeExport, rootHash, root.NodeHash())
+	}
+
+	err = store.Update(ctx, func(tx TreeStoreUpdateTx) error {
+		currentRoot, err := tx.RootNode()
+		if err != nil {
+			return err
+		}
+		if currentRoot.NodeHash() != EmptyTreeRootHash {
+			return ErrTreeNotEmpty
+		}
+
+		if len(leaves) == 0 {
+			return nil
+		}
+
+		root, err := buildCompacted(tx, 0, leaves)
+		if err != nil {
+			return err
+		}
+
+		return tx.UpdateRoot(root.(*BranchNode))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to import tree: %w", err)
+	}
+
+	return NewCompactedTree(store), nil
+}
@@ -0,0 +1,148 @@
+//go:build !race
+
+package mssmt_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTreeExportImport tests that trees of both types can be exported and
+// imported into all stores, resulting in identical trees.
+func TestTreeExportImport(t *testing.T) {
+	t.Parallel()
+
+	leaves := randTree(100)
+
+	for storeName, makeStore := range genTestStores(t) {
+		storeName, makeStore := storeName, makeStore
+		t.Run(storeName, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			for _, makeTree := range []func(
+				mssmt.TreeStore) mssmt.Tree{
+
+				makeFullTree, makeSmolTree,
+			} {
+				store, err := makeStore()
+				require.NoError(t, err)
+				tree := makeTree(store)
+
+				// An empty tree can be exported and imported.
+				var emptyExport bytes.Buffer
+				err = tree.Export(ctx, &emptyExport)
+				require.NoError(t, err)
+
+				emptyStore, err := makeStore()
+				require.NoError(t, err)
+				emptyTree, err := mssmt.ImportTree(
+					ctx, &emptyExport, emptyStore,
+				)
+				require.NoError(t, err)
+
+				emptyRoot, err := emptyTree.Root(ctx)
+				require.NoError(t, err)
+				require.Equal(
+					t, mssmt.EmptyTreeRootHash,
+					emptyRoot.NodeHash(),
+				)
+
+				for _, item := range leaves {
+					_, err := tree.Insert(
+						ctx, item.key, item.leaf,
+					)
+					require.NoError(t, err)
+				}
+
+				var export bytes.Buffer
+				require.NoError(t, tree.Export(ctx, &export))
+				exportBytes := export.Bytes()
+
+				importStore, err := makeStore()
+				require.NoError(t, err)
+				imported, err := mssmt.ImportTree(
+					ctx, bytes.NewReader(exportBytes),
+					importStore,
+				)
+				require.NoError(t, err)
+
+				// The imported tree has the same root, leaves
+				// and proofs as the original one.
+				root, err := tree.Root(ctx)
+				require.NoError(t, err)
+				importedRoot, err := imported.Root(ctx)
+				require.NoError(t, err)
+				require.True(t, mssmt.IsEqualNode(
+					root, importedRoot,
+				))
+
+				testInsertion(t, leaves, imported)
+				testProofEquality(t, tree, imported, leaves)
+
+				// Exporting the imported tree results in the
+				// exact same export.
+				var reExport bytes.Buffer
+				err = imported.Export(ctx, &reExport)
+				require.NoError(t, err)
+				require.Equal(t, exportBytes, reExport.Bytes())
+
+				// The imported tree can be modified like any
+				// other tree.
+				_, err = imported.Delete(ctx, leaves[0].key)
+				require.NoError(t, err)
+				_, err = tree.Delete(ctx, leaves[0].key)
+				require.NoError(t, err)
+				testProofEquality(t, tree, imported, leaves[1:])
+
+				// Importing into a non-empty store fails.
+				_, err = mssmt.ImportTree(
+					ctx, bytes.NewReader(exportBytes),
+					importStore,
+				)
+				require.ErrorIs(t, err, mssmt.ErrTreeNotEmpty)
+			}
+		})
+	}
+}
+
+// TestTreeImportCorrupted tests that corrupted or truncated exports are
+// rejected without writing anything to the store.
+func TestTreeImportCorrupted(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	for _, item := range randTree(10) {
+		_, err := tree.Insert(ctx, item.key, item.leaf)
+		require.NoError(t, err)
+	}
+
+	var export bytes.Buffer
+	require.NoError(t, tree.Export(ctx, &export))
+	exportBytes := export.Bytes()
+
+	for _, offset := range []int{0, 5, 6, 50, len(exportBytes) - 1} {
+		corrupted := append([]byte(nil), exportBytes...)
+		corrupted[offset] ^= 0x01
+
+		store := mssmt.NewDefaultStore()
+		_, err := mssmt.ImportTree(
+			ctx, bytes.NewReader(corrupted), store,
+		)
+		require.ErrorIs(t, err, mssmt.ErrInvalidTreeExport)
+		require.Zero(t, store.NumBranches())
+		require.Zero(t, store.NumCompactedLeaves())
+	}
+
+	store := mssmt.NewDefaultStore()
+	_, err := mssmt.ImportTree(
+		ctx, bytes.NewReader(exportBytes[:len(exportBytes)-10]), store,
+	)
+	require.ErrorIs(t, err, mssmt.ErrInvalidTreeExport)
+	require.Zero(t, store.NumBranches())
+}
@@ -1,6 +1,9 @@
 package mssmt
 
-import "context"
+import (
+	"context"
+	"io"
+)
 
 // Tree is an interface defining an abstract MSSMT tree type.
 type Tree interface {
@@ -30,4 +33,8 @@ type Tree interface {
 	// proof. This is noted by the returned `Proof` containing an empty
 	// leaf.
 	MerkleProof(ctx context.Context, key [hashSize]byte) (*Proof, error)
+
+	// Export writes all leaves of the MS-SMT to the given writer in a
+	// streaming, checksummed format that can be loaded with ImportTree.
+	Export(ctx context.Context, w io.Writer) error
 }
@@ -0,0 +1,331 @@
+package mssmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+const (
+	// MaxShardBits is the maximum number of key bits that can be used to
+	// select the shard of a ShardedTree.
+	MaxShardBits = 16
+)
+
+var (
+	// ErrInvalidShardCount is returned when a ShardedTree is created with
+	// a number of shards that isn't a power of two or exceeds the maximum.
+	ErrInvalidShardCount = errors.New("mssmt: number of shards must be a " +
+		"power of two")
+)
+
+// ShardedTree is a MS-SMT that splits the key space into a number of shards
+// by the first bits of the path of a key. Each shard is its own CompactedTree
+// with its own store, so shards can be written to in parallel and persisted
+// independently. The roots of all shards are combined under a small top tree,
+// which is kept in memory, to form the root of the sharded tree.
+//
+// NOTE: The root of a sharded tree differs from the root of a single tree
+// holding the same leaves.
+type ShardedTree struct {
+	shardBits int
+
+	shards   []*CompactedTree
+	shardMtx []sync.Mutex
+
+	// top is the tree that commits to the roots of all non-empty shards.
+	// The leaf of a shard is keyed by its shard key, its value is the root
+	// hash of the shard and its sum is the root sum of the shard.
+	top    *CompactedTree
+	topMtx sync.RWMutex
+}
+
+// NewShardedTree creates a new sharded tree with one shard per given store.
+// The number of stores must be a power of two. Shards that already contain
+// leaves are loaded into the top tree.
+func NewShardedTree(ctx context.Context,
+	stores []TreeStore) (*ShardedTree, error) {
+
+	numShards := len(stores)
+	if numShards == 0 || numShards&(numShards-1) != 0 ||
+		numShards > 1<<MaxShardBits {
+
+		return nil, fmt.Errorf("%w: got %d shards",
+			ErrInvalidShardCount, numShards)
+	}
+
+	var shardBits int
+	for 1<<shardBits < numShards {
+		shardBits++
+	}
+
+	t := &ShardedTree{
+		shardBits: shardBits,
+		shards:    make([]*CompactedTree, numShards),
+		shardMtx:  make([]sync.Mutex, numShards),
+		top:       NewCompactedTree(NewDefaultStore()),
+	}
+	for idx, store := range stores {
+		t.shards[idx] = NewCompactedTree(store)
+
+		if err := t.updateTop(ctx, idx); err != nil {
+			return nil, fmt.Errorf("unable to load shard %d: %w",
+				idx, err)
+		}
+	}
+
+	return t, nil
+}
+
+// NumShards returns the number of shards of the tree.
+func (t *ShardedTree) NumShards() int {
+	return len(t.shards)
+}
+
+// Shard returns the tree of the shard with the given index.
+//
+// NOTE: Writing to the shard directly doesn't update the root of the sharded
+// tree.
+func (t *ShardedTree) Shard(idx int) *CompactedTree {
+	return t.shards[idx]
+}
+
+// ShardIndex returns the index of the shard that holds the given key.
+func (t *ShardedTree) ShardIndex(key [hashSize]byte) int {
+	var idx int
+	for i := 0; i < t.shardBits; i++ {
+		idx |= int(bitIndex(uint8(i), &key)) << i
+	}
+
+	return idx
+}
+
+// shardKey returns the key of the leaf of the shard with the given index in
+// the top tree.
+func shardKey(idx int) [hashSize]byte {
+	var key [hashSize]byte
+	byteOrder.PutUint16(key[:2], uint16(idx))
+
+	return key
+}
+
+// updateTop updates the leaf of the shard with the given index in the top tree
+// to the current root of the shard.
+//
+// NOTE: The caller must hold the lock of the shard.
+func (t *ShardedTree) updateTop(ctx context.Context, idx int) error {
+	root, err := t.shards[idx].Root(ctx)
+	if err != nil {
+		return err
+	}
+
+	t.topMtx.Lock()
+	defer t.topMtx.Unlock()
+
+	key := shardKey(idx)
+	if root.NodeHash() == EmptyTreeRootHash {
+		_, err = t.top.Delete(ctx, key)
+		return err
+	}
+
+	rootHash := root.NodeHash()
+	_, err = t.top.Insert(
+		ctx, key, NewLeafNode(rootHash[:], root.NodeSum()),
+	)
+
+	return err
+}
+
+// Root returns the root node of the sharded tree.
+func (t *ShardedTree) Root(ctx context.Context) (*BranchNode, error) {
+	t.topMtx.RLock()
+	defer t.topMtx.RUnlock()
+
+	return t.top.Root(ctx)
+}
+
+// Insert inserts a leaf node at the given key within the sharded tree.
+func (t *ShardedTree) Insert(ctx context.Context, key [hashSize]byte,
+	leaf *LeafNode) error {
+
+	return t.setLeaves(ctx, t.ShardIndex(key), []ShardedLeaf{{
+		Key:  key,
+		Leaf: leaf,
+	}})
+}
+
+// Delete deletes the leaf node found at the given key within the sharded
+// tree.
+func (t *ShardedTree) Delete(ctx context.Context, key [hashSize]byte) error {
+	return t.Insert(ctx, key, EmptyLeafNode)
+}
+
+// ShardedLeaf is a leaf together with its key that is inserted into a sharded
+// tree.
+type ShardedLeaf struct {
+	// Key is the key of the leaf.
+	Key [hashSize]byte
+
+	// Leaf is the leaf. An empty leaf deletes the leaf at the key.
+	Leaf *LeafNode
+}
+
+// InsertBatch inserts all given leaves into the sharded tree. The leaves of
+// different shards are inserted in parallel. If the leaves of a shard can't be
+// inserted, the shard is left unchanged, while other shards might already
+// contain their new leaves.
+func (t *ShardedTree) InsertBatch(ctx context.Context,
+	leaves []ShardedLeaf) error {
+
+	shardLeaves := make(map[int][]ShardedLeaf)
+	for _, leaf := range leaves {
+		idx := t.ShardIndex(leaf.Key)
+		shardLeaves[idx] = append(shardLeaves[idx], leaf)
+	}
+
+	shardIdxs := make([]int, 0, len(shardLeaves))
+	for idx := range shardLeaves {
+		shardIdxs = append(shardIdxs, idx)
+	}
+
+	return fn.ParSlice(ctx, shardIdxs, func(ctx context.Context,
+		idx int) error {
+
+		return t.setLeaves(ctx, idx, shardLeaves[idx])
+	})
+}
+
+// setLeaves sets the given leaves in the shard with the given index and
+// updates the top tree. If any leaf can't be set, the previous leaves are
+// restored.
+func (t *ShardedTree) setLeaves(ctx context.Context, idx int,
+	leaves []ShardedLeaf) error {
+
+	t.shardMtx[idx].Lock()
+	defer t.shardMtx[idx].Unlock()
+
+	shard := t.shards[idx]
+
+	// We remember the previous leaves, so we can restore them if the
+	// shard or top tree can't be updated, for example because of a sum
+	// overflow.
+	prevLeaves := make([]ShardedLeaf, 0, len(leaves))
+	restore := func() {
+		for i := len(prevLeaves) - 1; i >= 0; i-- {
+			prev := prevLeaves[i]
+			_, _ = shard.Insert(ctx, prev.Key, prev.Leaf)
+		}
+	}
+
+	for _, leaf := range leaves {
+		prevLeaf, err := shard.Get(ctx, leaf.Key)
+		if err != nil {
+			restore()
+			return err
+		}
+
+		// There's nothing to delete if there's no leaf yet.
+		if prevLeaf.IsEmpty() && leaf.Leaf.IsEmpty() {
+			continue
+		}
+
+		prevLeaves = append(prevLeaves, ShardedLeaf{
+			Key:  leaf.Key,
+			Leaf: prevLeaf,
+		})
+		_, err = shard.Insert(ctx, leaf.Key, leaf.Leaf)
+		if err != nil {
+			restore()
+			return err
+		}
+	}
+
+	if err := t.updateTop(ctx, idx); err != nil {
+		restore()
+		return err
+	}
+
+	return nil
+}
+
+// Get returns the leaf node found at the given key within the sharded tree.
+func (t *ShardedTree) Get(ctx context.Context,
+	key [hashSize]byte) (*LeafNode, error) {
+
+	idx := t.ShardIndex(key)
+
+	t.shardMtx[idx].Lock()
+	defer t.shardMtx[idx].Unlock()
+
+	return t.shards[idx].Get(ctx, key)
+}
+
+// ShardedProof is a merkle proof for a leaf of a sharded tree. It consists of
+// the proof of the leaf within its shard and the proof of the root of the
+// shard within the top tree.
+type ShardedProof struct {
+	// ShardKey is the key of the leaf of the shard in the top tree.
+	ShardKey [hashSize]byte
+
+	// ShardProof is the proof of the leaf within its shard.
+	ShardProof *Proof
+
+	// TopProof is the proof of the shard root within the top tree.
+	TopProof *Proof
+}
+
+// Root returns the root node of the sharded tree obtained by walking up both
+// the shard and the top tree.
+func (p *ShardedProof) Root(key [hashSize]byte, leaf *LeafNode) *BranchNode {
+	shardRoot := p.ShardProof.Root(key, leaf)
+
+	topLeaf := EmptyLeafNode
+	if shardRoot.NodeHash() != EmptyTreeRootHash {
+		rootHash := shardRoot.NodeHash()
+		topLeaf = NewLeafNode(rootHash[:], shardRoot.NodeSum())
+	}
+
+	return p.TopProof.Root(p.ShardKey, topLeaf)
+}
+
+// MerkleProof generates a merkle proof for the leaf node found at the given key
+// within the sharded tree. If a leaf node does not exist at the given key, then
+// the proof should be considered a non-inclusion proof.
+func (t *ShardedTree) MerkleProof(ctx context.Context,
+	key [hashSize]byte) (*ShardedProof, error) {
+
+	idx := t.ShardIndex(key)
+
+	t.shardMtx[idx].Lock()
+	defer t.shardMtx[idx].Unlock()
+
+	shardProof, err := t.shards[idx].MerkleProof(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	t.topMtx.RLock()
+	defer t.topMtx.RUnlock()
+
+	topProof, err := t.top.MerkleProof(ctx, shardKey(idx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ShardedProof{
+		ShardKey:   shardKey(idx),
+		ShardProof: shardProof,
+		TopProof:   topProof,
+	}, nil
+}
+
+// VerifyShardedProof determines whether a merkle proof for the leaf found at
+// the given key is valid for the given root of a sharded tree.
+func VerifyShardedProof(key [hashSize]byte, leaf *LeafNode,
+	proof *ShardedProof, root Node) bool {
+
+	return IsEqualNode(proof.Root(key, leaf), root)
+}
@@ -0,0 +1,221 @@
+//go:build !race
+
+package mssmt_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// newShardedTree creates a new sharded tree with the given number of shards,
+// each backed by a new in-memory store.
+func newShardedTree(t *testing.T, numShards int) (*mssmt.ShardedTree,
+	[]mssmt.TreeStore) {
+
+	stores := make([]mssmt.TreeStore, numShards)
+	for idx := range stores {
+		stores[idx] = mssmt.NewDefaultStore()
+	}
+
+	tree, err := mssmt.NewShardedTree(context.Background(), stores)
+	require.NoError(t, err)
+
+	return tree, stores
+}
+
+// assertShardedProofs asserts that valid inclusion proofs can be generated for
+// all given leaves, and that the proofs are rejected for other leaves.
+func assertShardedProofs(t *testing.T, tree *mssmt.ShardedTree,
+	leaves []treeLeaf) {
+
+	t.Helper()
+
+	ctx := context.Background()
+	root, err := tree.Root(ctx)
+	require.NoError(t, err)
+
+	for _, item := range leaves {
+		leaf, err := tree.Get(ctx, item.key)
+		require.NoError(t, err)
+		require.Equal(t, item.leaf, leaf)
+
+		proof, err := tree.MerkleProof(ctx, item.key)
+		require.NoError(t, err)
+		require.True(t, mssmt.VerifyShardedProof(
+			item.key, item.leaf, proof, root,
+		))
+		require.False(t, mssmt.VerifyShardedProof(
+			item.key, mssmt.EmptyLeafNode, proof, root,
+		))
+	}
+}
+
+// TestShardedTree tests that leaves can be inserted into and deleted from a
+// sharded tree with different numbers of shards, and that proofs for them can
+// be verified.
+func TestShardedTree(t *testing.T) {
+	t.Parallel()
+
+	_, err := mssmt.NewShardedTree(
+		context.Background(), make([]mssmt.TreeStore, 3),
+	)
+	require.ErrorIs(t, err, mssmt.ErrInvalidShardCount)
+
+	leaves := randTree(200)
+
+	var rootSum uint64
+	for _, item := range leaves {
+		rootSum += item.leaf.NodeSum()
+	}
+
+	rootHashes := make(map[mssmt.NodeHash]struct{})
+	for _, numShards := range []int{1, 2, 16, 256} {
+		ctx := context.Background()
+		tree, _ := newShardedTree(t, numShards)
+
+		root, err := tree.Root(ctx)
+		require.NoError(t, err)
+		require.Equal(t, mssmt.EmptyTreeRootHash, root.NodeHash())
+
+		// A non-inclusion proof can be generated for the empty tree.
+		proof, err := tree.MerkleProof(ctx, leaves[0].key)
+		require.NoError(t, err)
+		require.True(t, mssmt.VerifyShardedProof(
+			leaves[0].key, mssmt.EmptyLeafNode, proof, root,
+		))
+
+		for _, item := range leaves {
+			err := tree.Insert(ctx, item.key, item.leaf)
+			require.NoError(t, err)
+		}
+		assertShardedProofs(t, tree, leaves)
+
+		root, err = tree.Root(ctx)
+		require.NoError(t, err)
+		require.Equal(t, rootSum, root.NodeSum())
+		rootHashes[root.NodeHash()] = struct{}{}
+
+		// Deleting all leaves results in an empty tree again.
+		for _, item := range leaves {
+			require.NoError(t, tree.Delete(ctx, item.key))
+		}
+		root, err = tree.Root(ctx)
+		require.NoError(t, err)
+		require.Equal(t, mssmt.EmptyTreeRootHash, root.NodeHash())
+	}
+
+	// The root commits to the number of shards.
+	require.Len(t, rootHashes, 4)
+}
+
+// TestShardedTreeBatch tests that a batch inserted in parallel into a sharded
+// tree results in the same tree as inserting the leaves one by one, and that
+// the tree can be loaded from its shard stores.
+func TestShardedTreeBatch(t *testing.T) {
+	t.Parallel()
+
+	const numShards = 16
+
+	ctx := context.Background()
+	leaves := randTree(500)
+
+	serialTree, _ := newShardedTree(t, numShards)
+	for _, item := range leaves {
+		require.NoError(t, serialTree.Insert(ctx, item.key, item.leaf))
+	}
+
+	batch := make([]mssmt.ShardedLeaf, len(leaves))
+	for idx, item := range leaves {
+		batch[idx] = mssmt.ShardedLeaf{
+			Key:  item.key,
+			Leaf: item.leaf,
+		}
+	}
+
+	batchTree, stores := newShardedTree(t, numShards)
+	require.NoError(t, batchTree.InsertBatch(ctx, batch))
+	assertShardedProofs(t, batchTree, leaves)
+
+	serialRoot, err := serialTree.Root(ctx)
+	require.NoError(t, err)
+	batchRoot, err := batchTree.Root(ctx)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(serialRoot, batchRoot))
+
+	// Every shard only holds the leaves with its key prefix.
+	for _, item := range leaves {
+		idx := batchTree.ShardIndex(item.key)
+		leaf, err := batchTree.Shard(idx).Get(ctx, item.key)
+		require.NoError(t, err)
+		require.Equal(t, item.leaf, leaf)
+	}
+
+	// Loading the tree from its shard stores results in the same root.
+	loadedTree, err := mssmt.NewShardedTree(ctx, stores)
+	require.NoError(t, err)
+	loadedRoot, err := loadedTree.Root(ctx)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(batchRoot, loadedRoot))
+	assertShardedProofs(t, loadedTree, leaves)
+}
+
+// TestShardedTreeOverflow tests that a sharded tree is left unchanged if a
+// leaf would overflow the sum of the tree.
+func TestShardedTreeOverflow(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tree, _ := newShardedTree(t, 4)
+
+	// Find two keys that end up in different shards, so the overflow is
+	// only detected within the top tree.
+	key1 := test.RandHash()
+	key2 := test.RandHash()
+	for tree.ShardIndex(key1) == tree.ShardIndex(key2) {
+		key2 = test.RandHash()
+	}
+
+	leaf1 := mssmt.NewLeafNode([]byte{1}, math.MaxUint64)
+	require.NoError(t, tree.Insert(ctx, key1, leaf1))
+
+	root, err := tree.Root(ctx)
+	require.NoError(t, err)
+
+	leaf2 := mssmt.NewLeafNode([]byte{2}, 1)
+	require.Error(t, tree.Insert(ctx, key2, leaf2))
+
+	leaf, err := tree.Get(ctx, key2)
+	require.NoError(t, err)
+	require.True(t, leaf.IsEmpty())
+
+	newRoot, err := tree.Root(ctx)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(root, newRoot))
+
+	// The same is true for an overflow within a shard in a batch.
+	key3 := test.RandHash()
+	for tree.ShardIndex(key3) != tree.ShardIndex(key1) {
+		key3 = test.RandHash()
+	}
+	err = tree.InsertBatch(ctx, []mssmt.ShardedLeaf{{
+		Key:  key1,
+		Leaf: mssmt.NewLeafNode([]byte{3}, 5),
+	}, {
+		Key:  key3,
+		Leaf: leaf1,
+	}})
+	require.Error(t, err)
+
+	leaf, err = tree.Get(ctx, key1)
+	require.NoError(t, err)
+	require.Equal(t, leaf1, leaf)
+
+	newRoot, err = tree.Root(ctx)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(root, newRoot))
+}
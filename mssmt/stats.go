@@ -0,0 +1,228 @@
+package mssmt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// compressedProofBaseSize is the encoded size of a compressed proof
+	// without any explicit nodes: the uint16 node count followed by the
+	// packed bit vector.
+	compressedProofBaseSize = 2 + MaxTreeLevels/8
+
+	// compressedProofNodeSize is the encoded size of a single explicit
+	// node within a compressed proof: its hash followed by its uint64 sum.
+	compressedProofNodeSize = hashSize + 8
+)
+
+// CompressedProofSize returns the encoded size in bytes of a compressed proof
+// that contains the given number of non-empty nodes.
+func CompressedProofSize(numNodes int) int {
+	return compressedProofBaseSize + numNodes*compressedProofNodeSize
+}
+
+// TreeStats summarizes the shape of a MS-SMT with respect to the size of the
+// merkle proofs it produces for its leaves.
+type TreeStats struct {
+	// NumLeaves is the number of non-empty leaves in the tree.
+	NumLeaves uint64
+
+	// RootSum is the sum of all leaves in the tree.
+	RootSum uint64
+
+	// TotalProofSize is the sum of the encoded sizes of the compressed
+	// proofs of all leaves in the tree.
+	TotalProofSize uint64
+
+	// MaxProofSize is the largest encoded size of a compressed proof of
+	// any leaf in the tree.
+	MaxProofSize int
+
+	// MaxDepth is the depth of the deepest leaf in the tree.
+	MaxDepth int
+
+	// DepthDistribution maps a leaf depth to the number of leaves found at
+	// that depth. The depth of a leaf is the number of key bits that are
+	// needed to tell it apart from all other leaves in the tree, which is
+	// the height at which a compacted tree stores the leaf.
+	DepthDistribution map[int]uint64
+}
+
+// AvgProofSize returns the average encoded size of a compressed proof of a leaf
+// in the tree.
+func (s *TreeStats) AvgProofSize() float64 {
+	if s.NumLeaves == 0 {
+		return 0
+	}
+
+	return float64(s.TotalProofSize) / float64(s.NumLeaves)
+}
+
+// AvgDepth returns the average depth of a leaf in the tree.
+func (s *TreeStats) AvgDepth() float64 {
+	if s.NumLeaves == 0 {
+		return 0
+	}
+
+	var total uint64
+	for depth, count := range s.DepthDistribution {
+		total += uint64(depth) * count
+	}
+
+	return float64(total) / float64(s.NumLeaves)
+}
+
+// String returns a human-readable summary of the tree stats.
+func (s *TreeStats) String() string {
+	depths := make([]int, 0, len(s.DepthDistribution))
+	for depth := range s.DepthDistribution {
+		depths = append(depths, depth)
+	}
+	sort.Ints(depths)
+
+	distribution := make([]string, 0, len(depths))
+	for _, depth := range depths {
+		distribution = append(distribution, fmt.Sprintf(
+			"%d:%d", depth, s.DepthDistribution[depth],
+		))
+	}
+
+	return fmt.Sprintf("leaves: %d, root_sum: %d, avg_proof_size: %.1f, "+
+		"max_proof_size: %d, avg_depth: %.1f, max_depth: %d, "+
+		"depths: [%s]", s.NumLeaves, s.RootSum, s.AvgProofSize(),
+		s.MaxProofSize, s.AvgDepth(), s.MaxDepth,
+		strings.Join(distribution, " "))
+}
+
+// recordLeaf adds a leaf with the given number of non-empty proof nodes and
+// depth to the stats.
+func (s *TreeStats) recordLeaf(numProofNodes, depth int) {
+	proofSize := CompressedProofSize(numProofNodes)
+
+	s.NumLeaves++
+	s.TotalProofSize += uint64(proofSize)
+	s.DepthDistribution[depth]++
+
+	if proofSize > s.MaxProofSize {
+		s.MaxProofSize = proofSize
+	}
+	if depth > s.MaxDepth {
+		s.MaxDepth = depth
+	}
+}
+
+// leafVisitor is a closure that is invoked for every non-empty leaf found when
+// walking a tree. Next to the leaf and its key, it is passed the number of
+// non-empty siblings along the path to the leaf, which is the number of
+// explicit nodes in the compressed proof of the leaf, and the height of the
+// deepest of those siblings, which is the depth of the leaf.
+type leafVisitor = func(key [hashSize]byte, leaf *LeafNode, numSiblings,
+	depth int) error
+
+// walkLeaves walks all non-empty branches of the tree persisted in the store of
+// the given view transaction and invokes the visitor for every non-empty leaf.
+// Leaves are visited in path order, meaning a leaf in the left subtree of a
+// branch is always visited before a leaf in its right subtree. This works for
+// trees stored by both the FullTree and the CompactedTree.
+func walkLeaves(tx TreeStoreViewTx, visit leafVisitor) error {
+	isEmpty := func(height int, node Node) bool {
+		return node.NodeHash() == EmptyTree[height].NodeHash()
+	}
+
+	var walk func(height int, node Node, key [hashSize]byte, numSiblings,
+		depth int) error
+	walk = func(height int, node Node, key [hashSize]byte, numSiblings,
+		depth int) error {
+
+		switch n := node.(type) {
+		// A full tree stores its leaves at the bottom of the tree, so
+		// the path we took is the key of the leaf.
+		case *LeafNode:
+			return visit(key, n, numSiblings, depth)
+
+		case *CompactedLeafNode:
+			return visit(n.Key(), n.LeafNode, numSiblings, depth)
+		}
+
+		left, right, err := tx.GetChildren(height, node.NodeHash())
+		if err != nil {
+			return err
+		}
+
+		childHeight := height + 1
+		children := [][2]Node{{left, right}, {right, left}}
+		for idx, pair := range children {
+			child, sibling := pair[0], pair[1]
+			if isEmpty(childHeight, child) {
+				continue
+			}
+
+			childKey := key
+			if idx == 1 {
+				childKey[height/8] |= byte(1 << (height % 8))
+			}
+
+			childSiblings, childDepth := numSiblings, depth
+			if !isEmpty(childHeight, sibling) {
+				childSiblings++
+				childDepth = childHeight
+			}
+
+			err := walk(
+				childHeight, child, childKey, childSiblings,
+				childDepth,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	root, err := tx.RootNode()
+	if err != nil {
+		return err
+	}
+
+	if isEmpty(0, root) {
+		return nil
+	}
+
+	return walk(0, root, [hashSize]byte{}, 0, 0)
+}
+
+// AnalyzeTree walks all non-empty branches of the MS-SMT persisted in the given
+// store and reports the number of leaves, the sizes of their compressed proofs
+// and their depth distribution. This works for trees stored by both the
+// FullTree and the CompactedTree, which produce identical stats for the same
+// set of leaves.
+func AnalyzeTree(ctx context.Context, store TreeStore) (*TreeStats, error) {
+	var stats *TreeStats
+	err := store.View(ctx, func(tx TreeStoreViewTx) error {
+		stats = &TreeStats{
+			DepthDistribution: make(map[int]uint64),
+		}
+
+		root, err := tx.RootNode()
+		if err != nil {
+			return err
+		}
+		stats.RootSum = root.NodeSum()
+
+		return walkLeaves(tx, func(_ [hashSize]byte, _ *LeafNode,
+			numSiblings, depth int) error {
+
+			stats.recordLeaf(numSiblings, depth)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to analyze tree: %w", err)
+	}
+
+	return stats, nil
+}
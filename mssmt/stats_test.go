@@ -0,0 +1,127 @@
+//go:build !race
+
+package mssmt_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// expectedTreeStats computes the stats of a tree by generating the compressed
+// proof of every leaf.
+func expectedTreeStats(t *testing.T, tree mssmt.Tree,
+	leaves []treeLeaf) *mssmt.TreeStats {
+
+	ctx := context.Background()
+	stats := &mssmt.TreeStats{
+		DepthDistribution: make(map[int]uint64),
+	}
+
+	for _, item := range leaves {
+		proof, err := tree.MerkleProof(ctx, item.key)
+		require.NoError(t, err)
+
+		compressed := proof.Compress()
+
+		var buf bytes.Buffer
+		require.NoError(t, compressed.Encode(&buf))
+		require.Equal(
+			t, mssmt.CompressedProofSize(len(compressed.Nodes)),
+			buf.Len(),
+		)
+
+		// The proof nodes start at the leaf, so the first non-empty
+		// node is the deepest sibling.
+		var depth int
+		for idx, isEmpty := range compressed.Bits {
+			if !isEmpty {
+				depth = mssmt.MaxTreeLevels - idx
+				break
+			}
+		}
+
+		stats.NumLeaves++
+		stats.RootSum += item.leaf.NodeSum()
+		stats.TotalProofSize += uint64(buf.Len())
+		stats.DepthDistribution[depth]++
+		if buf.Len() > stats.MaxProofSize {
+			stats.MaxProofSize = buf.Len()
+		}
+		if depth > stats.MaxDepth {
+			stats.MaxDepth = depth
+		}
+	}
+
+	return stats
+}
+
+// TestAnalyzeTree tests that the tree stats match the compressed proofs of all
+// leaves for both tree types and all stores.
+func TestAnalyzeTree(t *testing.T) {
+	t.Parallel()
+
+	// We use small sums to not overflow the root sum.
+	leaves := randTree(100)
+	for idx := range leaves {
+		leaves[idx].leaf = mssmt.NewLeafNode(
+			leaves[idx].leaf.Value, uint64(idx+1),
+		)
+	}
+
+	for storeName, makeStore := range genTestStores(t) {
+		storeName, makeStore := storeName, makeStore
+		t.Run(storeName, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			for _, makeTree := range []func(
+				mssmt.TreeStore) mssmt.Tree{
+
+				makeFullTree, makeSmolTree,
+			} {
+				store, err := makeStore()
+				require.NoError(t, err)
+				tree := makeTree(store)
+
+				// An empty tree has no leaves.
+				stats, err := mssmt.AnalyzeTree(ctx, store)
+				require.NoError(t, err)
+				require.Zero(t, stats.NumLeaves)
+				require.Zero(t, stats.AvgProofSize())
+
+				// A single leaf has an empty proof.
+				_, err = tree.Insert(
+					ctx, leaves[0].key, leaves[0].leaf,
+				)
+				require.NoError(t, err)
+
+				stats, err = mssmt.AnalyzeTree(ctx, store)
+				require.NoError(t, err)
+				require.EqualValues(t, 1, stats.NumLeaves)
+				require.Equal(
+					t, mssmt.CompressedProofSize(0),
+					stats.MaxProofSize,
+				)
+				require.Zero(t, stats.MaxDepth)
+
+				for _, item := range leaves[1:] {
+					_, err := tree.Insert(
+						ctx, item.key, item.leaf,
+					)
+					require.NoError(t, err)
+				}
+
+				stats, err = mssmt.AnalyzeTree(ctx, store)
+				require.NoError(t, err)
+				require.Equal(
+					t, expectedTreeStats(t, tree, leaves),
+					stats,
+				)
+			}
+		})
+	}
+}
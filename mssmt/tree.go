@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math/bits"
 )
 
@@ -333,6 +334,12 @@ func (t *FullTree) MerkleProof(ctx context.Context, key [hashSize]byte) (
 	return NewProof(proof), nil
 }
 
+// Export writes all leaves of the MS-SMT to the given writer in a streaming,
+// checksummed format that can be loaded with ImportTree.
+func (t *FullTree) Export(ctx context.Context, w io.Writer) error {
+	return exportTree(ctx, t.store, w)
+}
+
 // VerifyMerkleProof determines whether a merkle proof for the leaf found at the
 // given key is valid.
 func VerifyMerkleProof(key [hashSize]byte, leaf *LeafNode, proof *Proof,
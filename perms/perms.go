@@ -36,10 +36,110 @@ var (
 			Entity: "assets",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/ListBalanceHistory": {{
+			Entity: "assets",
+			Action: "read",
+		}},
 		"/taprpc.TaprootAssets/ListTransfers": {{
 			Entity: "assets",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/ListProofDeliveryAttempts": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/ParcelQueueStats": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/SetParcelDebugLogging": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ExportParcelLog": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/ExportTransferStatement": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/FreezeAssetOutputs": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/UnfreezeAssetOutputs": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ListFrozenAssetOutputs": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/WatchAsset": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/UnwatchAsset": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ListWatchedAssets": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/ListAtRiskAssets": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/AnnotateAssetLot": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/SetAssetAlias": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/RemoveAssetAlias": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ListAssetAliases": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/ResolveAssetAlias": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/ListKeyDerivations": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/ListReusedAnchorKeys": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/AuditScriptKeys": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ResolveFlaggedScriptKey": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ExportScriptKeyDisclosures": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/ExportAssetDescriptor": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/ImportAssetDescriptor": {{
+			Entity: "assets",
+			Action: "write",
+		}},
 		"/taprpc.TaprootAssets/QueryAddrs": {{
 			Entity: "addresses",
 			Action: "read",
@@ -52,10 +152,54 @@ var (
 			Entity: "addresses",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/InspectAddr": {{
+			Entity: "addresses",
+			Action: "read",
+		}},
 		"/taprpc.TaprootAssets/AddrReceives": {{
 			Entity: "addresses",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/ExportReceipt": {{
+			Entity: "addresses",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/SetCounterparty": {{
+			Entity: "addresses",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/RemoveCounterparty": {{
+			Entity: "addresses",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ListCounterparties": {{
+			Entity: "addresses",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/ImportScriptKey": {{
+			Entity: "addresses",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ListImportedScriptKeys": {{
+			Entity: "addresses",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/CreateInvoice": {{
+			Entity: "addresses",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/DecodeInvoice": {{
+			Entity: "addresses",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/LookupInvoice": {{
+			Entity: "addresses",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/ListInvoices": {{
+			Entity: "addresses",
+			Action: "read",
+		}},
 		"/taprpc.TaprootAssets/VerifyProof": {{
 			Entity: "proofs",
 			Action: "read",
@@ -68,10 +212,78 @@ var (
 			Entity: "proofs",
 			Action: "read",
 		}},
+		"/taprpc.TaprootAssets/ProofArchiveStats": {{
+			Entity: "proofs",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/PushArchiveProofs": {{
+			Entity: "proofarchive",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/PullArchiveProof": {{
+			Entity: "proofarchive",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/BakeArchiveMacaroon": {{
+			Entity: "daemon",
+			Action: "write",
+		}},
 		"/taprpc.TaprootAssets/SendAsset": {{
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/taprpc.TaprootAssets/ListScheduledTransfers": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/CancelScheduledTransfer": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/OpenAnchorSession": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/RegisterAnchorSessionParcel": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/SealAnchorSession": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/AbortAnchorSession": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/StartAirdrop": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ResumeAirdrop": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ListAirdrops": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/CreateTransferTemplate": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ListTransferTemplates": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/DeleteTransferTemplate": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ExecuteTransferTemplate": {{
+			Entity: "assets",
+			Action: "write",
+		}},
 		"/taprpc.TaprootAssets/FetchAssetMeta": {{
 			Entity: "assets",
 			Action: "read",
@@ -112,6 +324,30 @@ var (
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/assetwalletrpc.AssetWallet/ListAnchorCoSignRequests": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/assetwalletrpc.AssetWallet/SubmitAnchorCoSignature": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/ListVirtualSignRequests": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/assetwalletrpc.AssetWallet/SubmitVirtualSignature": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/remotesignerrpc.RemoteSigner/OpenSession": {{
+			Entity: "remotesigner",
+			Action: "write",
+		}},
+		"/remotesignerrpc.RemoteSigner/SignVirtualPacket": {{
+			Entity: "remotesigner",
+			Action: "write",
+		}},
 		"/mintrpc.Mint/MintAsset": {{
 			Entity: "mint",
 			Action: "write",
@@ -172,6 +408,22 @@ var (
 			Entity: "universe",
 			Action: "write",
 		}},
+		"/universerpc.Universe/SyncProgress": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/ListQuarantinedProofs": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/PurgeQuarantinedProofs": {{
+			Entity: "universe",
+			Action: "write",
+		}},
+		"/universerpc.Universe/SupplyReport": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/UniverseStats": {{
 			Entity: "universe",
 			Action: "read",
@@ -82,6 +82,10 @@ type AnnotatedProof struct {
 	Blob
 
 	*AssetSnapshot
+
+	// DeliverySignature is the signature of the node that delivered the
+	// proof through a courier, if the sender signed it.
+	DeliverySignature *DeliverySignature
 }
 
 // Archiver is the main storage backend the ProofArchiver uses to store and
@@ -129,11 +133,25 @@ type FileArchiver struct {
 	// our files.
 	proofPath string
 
+	// compress is true if the proofs are stored compressed on disk.
+	compress bool
+
 	// eventDistributor is an event distributor that will be used to notify
 	// subscribers about new proofs that are added to the archiver.
 	eventDistributor *fn.EventDistributor[Blob]
 }
 
+// FileArchiverOption is a functional option for the file archiver.
+type FileArchiverOption func(*FileArchiver)
+
+// WithCompressedProofs stores the proofs gzip compressed on disk. This is
+// meant for archives of proofs that are rarely accessed.
+func WithCompressedProofs() FileArchiverOption {
+	return func(f *FileArchiver) {
+		f.compress = true
+	}
+}
+
 // NewFileArchiver creates a new file archive rooted at the passed specified
 // directory.
 //
@@ -141,18 +159,46 @@ type FileArchiver struct {
 //
 // TODO(roasbeef): option to memory map these instead? then don't need to lug
 // around large blobs in user space as much
-func NewFileArchiver(dirName string) (*FileArchiver, error) {
+func NewFileArchiver(dirName string,
+	opts ...FileArchiverOption) (*FileArchiver, error) {
+
 	// First, we'll make sure our main proof directory has already been
 	// created.
 	proofPath := filepath.Join(dirName, ProofDirName)
-	if err := os.Mkdir(proofPath, 0750); err != nil && !os.IsExist(err) {
+	if err := os.MkdirAll(proofPath, 0750); err != nil {
 		return nil, fmt.Errorf("unable to create proof dir: %w", err)
 	}
 
-	return &FileArchiver{
+	f := &FileArchiver{
 		proofPath:        proofPath,
 		eventDistributor: fn.NewEventDistributor[Blob](),
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	return f, nil
+}
+
+// readProofFile reads the proof file at the given path, decompressing it if
+// the archive stores compressed proofs.
+func (f *FileArchiver) readProofFile(proofPath string) (Blob, error) {
+	proofFile, err := os.ReadFile(proofPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if !f.compress {
+		return proofFile, nil
+	}
+
+	proof, err := decompressProof(proofFile, DefaultMaxChunkedProofSize)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decompress proof %s: %w",
+			proofPath, err)
+	}
+
+	return proof, nil
 }
 
 // genProofFilePath generates the full proof file path based on a rootPath and
@@ -191,7 +237,7 @@ func (f *FileArchiver) FetchProof(_ context.Context, id Locator) (Blob, error) {
 			err)
 	}
 
-	proofFile, err := os.ReadFile(proofPath)
+	proofFile, err := f.readProofFile(proofPath)
 	switch {
 	case os.IsNotExist(err):
 		return nil, ErrProofNotFound
@@ -240,7 +286,7 @@ func (f *FileArchiver) FetchProofs(_ context.Context,
 		}
 
 		fullPath := filepath.Join(assetPath, fileName)
-		proofFile, err := os.ReadFile(fullPath)
+		proofFile, err := f.readProofFile(fullPath)
 		if err != nil {
 			return nil, fmt.Errorf("unable to read proof: %w", err)
 		}
@@ -283,7 +329,16 @@ func (f *FileArchiver) ImportProofs(_ context.Context,
 				"%s does not exist", proofPath)
 		}
 
-		err = os.WriteFile(proofPath, proof.Blob, 0666)
+		proofFile := proof.Blob
+		if f.compress {
+			proofFile, err = compressProof(proof.Blob)
+			if err != nil {
+				return fmt.Errorf("unable to compress proof: "+
+					"%w", err)
+			}
+		}
+
+		err = os.WriteFile(proofPath, proofFile, 0666)
 		if err != nil {
 			return fmt.Errorf("unable to store proof: %v", err)
 		}
@@ -294,6 +349,62 @@ func (f *FileArchiver) ImportProofs(_ context.Context,
 	return nil
 }
 
+// RemoveProof removes the proof identified by the given locator from disk. If
+// the proof can't be found, ErrProofNotFound is returned.
+//
+// NOTE: This implements the TierStore interface.
+func (f *FileArchiver) RemoveProof(_ context.Context, id Locator) error {
+	proofPath, err := genProofFilePath(f.proofPath, id)
+	if err != nil {
+		return fmt.Errorf("unable to make proof file path: %w", err)
+	}
+
+	err = os.Remove(proofPath)
+	switch {
+	case os.IsNotExist(err):
+		return ErrProofNotFound
+	case err != nil:
+		return fmt.Errorf("unable to remove proof: %w", err)
+	}
+
+	return nil
+}
+
+// Stats returns the number of proofs stored on disk and their total size.
+//
+// NOTE: This implements the TierStore interface.
+func (f *FileArchiver) Stats(_ context.Context) (*TierStats, error) {
+	var stats TierStats
+	err := filepath.WalkDir(
+		f.proofPath, func(path string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if d.IsDir() ||
+				!strings.HasSuffix(path, TaprootAssetsFileSuffix) {
+
+				return nil
+			}
+
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+
+			stats.NumProofs++
+			stats.SizeBytes += uint64(info.Size())
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to walk proof dir: %w", err)
+	}
+
+	return &stats, nil
+}
+
 // RegisterSubscriber adds a new subscriber for receiving events. The
 // deliverExisting boolean indicates whether already existing items should be
 // sent to the NewItemCreated channel when the subscription is started. An
@@ -334,8 +445,9 @@ func (f *FileArchiver) RemoveSubscriber(
 }
 
 // A compile-time interface to ensure FileArchiver meets the NotifyArchiver
-// interface.
+// and TierStore interfaces.
 var _ NotifyArchiver = (*FileArchiver)(nil)
+var _ TierStore = (*FileArchiver)(nil)
 
 // MultiArchiver is an archive of archives. It contains several archives and
 // attempts to use them either as a look-aside cache, or a write through cache
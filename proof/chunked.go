@@ -0,0 +1,380 @@
+package proof
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+const (
+	// DefaultMaxChunkedProofSize is the default maximum size in bytes of a
+	// proof received in chunks, after decompression.
+	DefaultMaxChunkedProofSize = 64 * 1024 * 1024
+
+	// chunkHeaderSize is the size of the header of a proof chunk that
+	// follows the chunk prefix: the transfer ID, the index of the chunk
+	// and the total number of chunks.
+	chunkHeaderSize = sha256.Size + 2 + 2
+
+	// maxNumChunks is the maximum number of chunks a proof is split into.
+	maxNumChunks = 1<<16 - 1
+
+	// maxCompressionOverhead is a generous upper bound of the number of
+	// bytes the compressed proof can be larger than the proof itself.
+	maxCompressionOverhead = 64 * 1024
+)
+
+// chunkedProofPrefix is the prefix of a mailbox message that carries a chunk
+// of a compressed proof instead of the proof itself.
+var chunkedProofPrefix = []byte("chunked-proof:")
+
+var (
+	// ErrInvalidProofChunk is returned if a received proof chunk can't be
+	// parsed or doesn't belong to the transfer.
+	ErrInvalidProofChunk = errors.New("invalid proof chunk")
+
+	// ErrChunkedProofTooLarge is returned if a proof received in chunks
+	// exceeds the maximum size after decompression.
+	ErrChunkedProofTooLarge = errors.New("chunked proof too large")
+)
+
+// proofChunk is a single chunk of a compressed proof.
+type proofChunk struct {
+	// transferID is the SHA256 hash of the full compressed proof, which
+	// identifies the transfer the chunk belongs to.
+	transferID [sha256.Size]byte
+
+	// index is the index of the chunk within the compressed proof.
+	index uint16
+
+	// total is the total number of chunks of the compressed proof.
+	total uint16
+
+	// data is the part of the compressed proof carried by the chunk.
+	data []byte
+}
+
+// encode encodes the chunk as a mailbox message.
+func (c *proofChunk) encode() []byte {
+	var buf bytes.Buffer
+	buf.Grow(len(chunkedProofPrefix) + chunkHeaderSize + len(c.data))
+
+	buf.Write(chunkedProofPrefix)
+	buf.Write(c.transferID[:])
+	_ = binary.Write(&buf, binary.BigEndian, c.index)
+	_ = binary.Write(&buf, binary.BigEndian, c.total)
+	buf.Write(c.data)
+
+	return buf.Bytes()
+}
+
+// decodeProofChunk decodes a chunk from the given mailbox message, which must
+// start with the chunk prefix.
+func decodeProofChunk(msg []byte) (*proofChunk, error) {
+	msg = msg[len(chunkedProofPrefix):]
+	if len(msg) < chunkHeaderSize {
+		return nil, fmt.Errorf("%w: chunk of %d bytes too short",
+			ErrInvalidProofChunk, len(msg))
+	}
+
+	var chunk proofChunk
+	copy(chunk.transferID[:], msg[:sha256.Size])
+	chunk.index = binary.BigEndian.Uint16(msg[sha256.Size:])
+	chunk.total = binary.BigEndian.Uint16(msg[sha256.Size+2:])
+	chunk.data = msg[chunkHeaderSize:]
+
+	if chunk.index >= chunk.total {
+		return nil, fmt.Errorf("%w: chunk index %d out of range, "+
+			"total %d", ErrInvalidProofChunk, chunk.index,
+			chunk.total)
+	}
+
+	return &chunk, nil
+}
+
+// chunkedUpload is the state of a proof that is being written to a mailbox in
+// chunks.
+type chunkedUpload struct {
+	// transferID is the ID of the transfer.
+	transferID [sha256.Size]byte
+
+	// numWritten is the number of chunks that were written successfully.
+	numWritten int
+}
+
+// chunkedDownload is the state of a proof that is being read from a mailbox in
+// chunks.
+type chunkedDownload struct {
+	// transferID is the ID of the transfer.
+	transferID [sha256.Size]byte
+
+	// chunks are the data of the chunks, nil if a chunk wasn't received
+	// yet.
+	chunks [][]byte
+
+	// numReceived is the number of chunks received so far.
+	numReceived int
+
+	// numBytes is the total size of the chunks received so far.
+	numBytes int64
+}
+
+// ChunkedMailBox is a ProofMailbox that compresses proofs and splits them into
+// chunks that are each written to an underlying mailbox as a separate message,
+// so large proofs don't exceed the message size limit of the mailbox service.
+// Transfers are resumable: if writing a chunk fails, writing the same proof
+// again only writes the remaining chunks, and chunks that were already read
+// are kept if reading is interrupted. Proofs that are sent over the underlying
+// mailbox directly by senders that don't use chunks are still read as is.
+type ChunkedMailBox struct {
+	ProofMailbox
+
+	// chunkSize is the maximum size in bytes of a single chunk. If zero,
+	// outgoing proofs are written as is.
+	chunkSize int
+
+	// maxProofSize is the maximum size in bytes of a proof received in
+	// chunks, after decompression.
+	maxProofSize int64
+
+	// mtx guards the maps below.
+	mtx sync.Mutex
+
+	// uploads are the interrupted uploads, keyed by the stream ID.
+	uploads map[streamID]*chunkedUpload
+
+	// downloads are the incomplete downloads, keyed by the stream ID.
+	downloads map[streamID]*chunkedDownload
+}
+
+// NewChunkedMailBox wraps the given mailbox so that proofs received in chunks
+// can be read. If the chunk size is positive, outgoing proofs are compressed
+// and written in chunks of at most that size as well.
+func NewChunkedMailBox(mailbox ProofMailbox, chunkSize int,
+	maxProofSize int64) *ChunkedMailBox {
+
+	if maxProofSize <= 0 {
+		maxProofSize = DefaultMaxChunkedProofSize
+	}
+
+	return &ChunkedMailBox{
+		ProofMailbox: mailbox,
+		chunkSize:    chunkSize,
+		maxProofSize: maxProofSize,
+		uploads:      make(map[streamID]*chunkedUpload),
+		downloads:    make(map[streamID]*chunkedDownload),
+	}
+}
+
+// compressProof compresses the given proof.
+func compressProof(proof Blob) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(proof); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// decompressProof decompresses the given proof, making sure it doesn't exceed
+// the given maximum size.
+func decompressProof(compressed []byte, maxSize int64) (Blob, error) {
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	proof, err := io.ReadAll(io.LimitReader(r, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(proof)) > maxSize {
+		return nil, fmt.Errorf("%w: exceeds %d bytes",
+			ErrChunkedProofTooLarge, maxSize)
+	}
+
+	return proof, nil
+}
+
+// WriteProof writes the proof to the mailbox specified by the sid. If chunking
+// is enabled, the proof is compressed and written in chunks, skipping the
+// chunks that were already written by an earlier, interrupted attempt.
+func (c *ChunkedMailBox) WriteProof(ctx context.Context, sid streamID,
+	proof Blob) error {
+
+	if c.chunkSize <= 0 {
+		return c.ProofMailbox.WriteProof(ctx, sid, proof)
+	}
+
+	compressed, err := compressProof(proof)
+	if err != nil {
+		return fmt.Errorf("unable to compress proof: %w", err)
+	}
+
+	numChunks := (len(compressed) + c.chunkSize - 1) / c.chunkSize
+	if numChunks > maxNumChunks {
+		return fmt.Errorf("proof of %d compressed bytes exceeds %d "+
+			"chunks of %d bytes", len(compressed), maxNumChunks,
+			c.chunkSize)
+	}
+
+	transferID := sha256.Sum256(compressed)
+
+	c.mtx.Lock()
+	upload, ok := c.uploads[sid]
+	if !ok || upload.transferID != transferID {
+		upload = &chunkedUpload{
+			transferID: transferID,
+		}
+		c.uploads[sid] = upload
+	}
+	startIdx := upload.numWritten
+	c.mtx.Unlock()
+
+	if startIdx > 0 {
+		log.Infof("Resuming proof upload via sid=%x at chunk %d/%d",
+			sid[:], startIdx, numChunks)
+	}
+
+	for idx := startIdx; idx < numChunks; idx++ {
+		end := (idx + 1) * c.chunkSize
+		if end > len(compressed) {
+			end = len(compressed)
+		}
+
+		chunk := &proofChunk{
+			transferID: transferID,
+			index:      uint16(idx),
+			total:      uint16(numChunks),
+			data:       compressed[idx*c.chunkSize : end],
+		}
+		err := c.ProofMailbox.WriteProof(ctx, sid, chunk.encode())
+		if err != nil {
+			return fmt.Errorf("unable to write proof chunk %d/%d: "+
+				"%w", idx, numChunks, err)
+		}
+
+		c.mtx.Lock()
+		upload.numWritten = idx + 1
+		c.mtx.Unlock()
+	}
+
+	c.mtx.Lock()
+	delete(c.uploads, sid)
+	c.mtx.Unlock()
+
+	return nil
+}
+
+// ReadProof reads a proof from the mailbox. If the proof was sent in chunks,
+// this blocks until all chunks were read, and the chunks already read are kept
+// if the read is interrupted.
+func (c *ChunkedMailBox) ReadProof(ctx context.Context,
+	sid streamID) (Blob, error) {
+
+	for {
+		msg, err := c.ProofMailbox.ReadProof(ctx, sid)
+		if err != nil {
+			return nil, err
+		}
+
+		if !bytes.HasPrefix(msg, chunkedProofPrefix) {
+			return msg, nil
+		}
+
+		chunk, err := decodeProofChunk(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		compressed, err := c.addChunk(sid, chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		// We'll keep reading until we have all chunks of the proof.
+		if compressed == nil {
+			continue
+		}
+
+		return decompressProof(compressed, c.maxProofSize)
+	}
+}
+
+// addChunk adds the chunk to the download of the given stream. Once all chunks
+// were received, the full compressed proof is returned.
+func (c *ChunkedMailBox) addChunk(sid streamID,
+	chunk *proofChunk) ([]byte, error) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	// A chunk of a different transfer means the sender started over with
+	// another proof, so the previous download can't be completed anymore.
+	download, ok := c.downloads[sid]
+	if !ok || download.transferID != chunk.transferID ||
+		len(download.chunks) != int(chunk.total) {
+
+		download = &chunkedDownload{
+			transferID: chunk.transferID,
+			chunks:     make([][]byte, chunk.total),
+		}
+		c.downloads[sid] = download
+	}
+
+	if download.chunks[chunk.index] == nil {
+		download.chunks[chunk.index] = chunk.data
+		download.numReceived++
+		download.numBytes += int64(len(chunk.data))
+	}
+
+	// The compressed proof is never larger than the decompressed one by
+	// more than a few bytes of framing, so we can drop downloads early
+	// that clearly exceed the maximum size.
+	if download.numBytes > c.maxProofSize+maxCompressionOverhead {
+		delete(c.downloads, sid)
+
+		return nil, fmt.Errorf("%w: compressed proof exceeds %d bytes",
+			ErrChunkedProofTooLarge, c.maxProofSize)
+	}
+
+	if download.numReceived < len(download.chunks) {
+		return nil, nil
+	}
+
+	delete(c.downloads, sid)
+
+	compressed := bytes.Join(download.chunks, nil)
+	if sha256.Sum256(compressed) != download.transferID {
+		return nil, fmt.Errorf("%w: hash of reassembled proof doesn't "+
+			"match transfer ID", ErrInvalidProofChunk)
+	}
+
+	return compressed, nil
+}
+
+// CleanUp attempts to tear down the mailbox as specified by the passed sid,
+// dropping the state of any interrupted transfer over it.
+func (c *ChunkedMailBox) CleanUp(ctx context.Context, sid streamID) error {
+	c.mtx.Lock()
+	delete(c.uploads, sid)
+	delete(c.downloads, sid)
+	c.mtx.Unlock()
+
+	return c.ProofMailbox.CleanUp(ctx, sid)
+}
+
+// A compile-time assertion to ensure that the ChunkedMailBox meets the
+// ProofMailbox interface.
+var _ ProofMailbox = (*ChunkedMailBox)(nil)
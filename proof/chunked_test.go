@@ -0,0 +1,123 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+var errMailboxUnavailable = errors.New("mailbox unavailable")
+
+// queueMailBox is an in-memory ProofMailbox that queues all messages written
+// to a stream and fails writes and reads on demand.
+type queueMailBox struct {
+	memMailBox
+
+	queues map[streamID][]Blob
+
+	// writesLeft is the number of writes that succeed before writes fail,
+	// negative for no limit.
+	writesLeft int
+}
+
+func (q *queueMailBox) WriteProof(_ context.Context, sid streamID,
+	proof Blob) error {
+
+	q.Lock()
+	defer q.Unlock()
+
+	if q.writesLeft == 0 {
+		return errMailboxUnavailable
+	}
+	q.writesLeft--
+
+	q.queues[sid] = append(q.queues[sid], proof)
+	return nil
+}
+
+func (q *queueMailBox) ReadProof(_ context.Context, sid streamID) (Blob,
+	error) {
+
+	q.Lock()
+	defer q.Unlock()
+
+	if len(q.queues[sid]) == 0 {
+		return nil, errMailboxUnavailable
+	}
+
+	msg := q.queues[sid][0]
+	q.queues[sid] = q.queues[sid][1:]
+	return msg, nil
+}
+
+// TestChunkedMailBox tests that proofs are compressed and sent in chunks that
+// are resumed after an interruption, and that plain proofs can still be read.
+func TestChunkedMailBox(t *testing.T) {
+	t.Parallel()
+
+	const chunkSize = 100
+
+	ctx := context.Background()
+	backend := &queueMailBox{
+		queues:     make(map[streamID][]Blob),
+		writesLeft: 3,
+	}
+	sender := NewChunkedMailBox(backend, chunkSize, 0)
+	receiver := NewChunkedMailBox(backend, 0, 0)
+
+	// The proof consists of random data that doesn't compress well, so it
+	// needs multiple chunks.
+	proof := Blob(test.RandBytes(1000))
+	sid := streamID{0x01}
+
+	// The upload is interrupted after three chunks, and so is the
+	// download once those were read.
+	err := sender.WriteProof(ctx, sid, proof)
+	require.ErrorIs(t, err, errMailboxUnavailable)
+	require.Len(t, backend.queues[sid], 3)
+
+	_, err = receiver.ReadProof(ctx, sid)
+	require.ErrorIs(t, err, errMailboxUnavailable)
+
+	// Writing the proof again only writes the remaining chunks, and the
+	// receiver reassembles the proof from all of them.
+	backend.writesLeft = -1
+	require.NoError(t, sender.WriteProof(ctx, sid, proof))
+	for _, msg := range backend.queues[sid] {
+		require.True(t, bytes.HasPrefix(msg, chunkedProofPrefix))
+		require.LessOrEqual(
+			t, len(msg), len(chunkedProofPrefix)+chunkHeaderSize+
+				chunkSize,
+		)
+	}
+
+	received, err := receiver.ReadProof(ctx, sid)
+	require.NoError(t, err)
+	require.Equal(t, proof, received)
+	require.Empty(t, backend.queues[sid])
+
+	// Compressible proofs are sent in fewer chunks.
+	compressible := Blob(bytes.Repeat([]byte("proof"), 1000))
+	require.NoError(t, sender.WriteProof(ctx, sid, compressible))
+	require.Len(t, backend.queues[sid], 1)
+
+	received, err = receiver.ReadProof(ctx, sid)
+	require.NoError(t, err)
+	require.Equal(t, compressible, received)
+
+	// Proofs that weren't sent in chunks are read as is.
+	require.NoError(t, receiver.WriteProof(ctx, sid, proof))
+	received, err = receiver.ReadProof(ctx, sid)
+	require.NoError(t, err)
+	require.Equal(t, proof, received)
+
+	// Proofs that exceed the maximum size are rejected.
+	smallReceiver := NewChunkedMailBox(backend, 0, 100)
+	require.NoError(t, sender.WriteProof(ctx, sid, compressible))
+	_, err = smallReceiver.ReadProof(ctx, sid)
+	require.ErrorIs(t, err, ErrChunkedProofTooLarge)
+}
@@ -5,14 +5,18 @@ import (
 	"context"
 	"crypto/sha512"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lightning-node-connect/hashmailrpc"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightningnetwork/lnd/keychain"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
@@ -58,9 +62,37 @@ type Courier[Addr any] interface {
 	// locator from the source encapsulated within the specified address.
 	ReceiveProof(context.Context, Addr, Locator) (*AnnotatedProof, error)
 
-	// SetSubscribers sets the set of subscribers that will be notified
-	// of proof courier related events.
-	SetSubscribers(map[uint64]*fn.EventReceiver[fn.Event])
+	// SetEventBus sets the event bus that proof courier related events
+	// are published to.
+	SetEventBus(*fn.EventBus[fn.Event])
+}
+
+// ReceiverProber is implemented by couriers that can check whether a proof can
+// be delivered to a receiver before the transfer is committed to.
+type ReceiverProber[Addr any] interface {
+	// ProbeReceiver returns an error if the courier can't reach the
+	// receiver identified by the Addr type.
+	ProbeReceiver(context.Context, Addr) error
+}
+
+// ErrSettlementDisabled is returned by couriers that support a settlement
+// return channel if it isn't enabled in their config.
+var ErrSettlementDisabled = errors.New("receiver settlement disabled")
+
+// SettlementChannel is implemented by couriers that offer a return channel
+// from the receiver of a proof back to its sender. Once the receiver verified
+// and accepted a delivered proof, it notifies the sender through the same
+// courier the proof was delivered with, so the sender knows the transfer was
+// settled by the receiver.
+type SettlementChannel[Addr any] interface {
+	// NotifySettled notifies the sender of the proof for the receiver
+	// identified by the Addr type that the proof was verified and
+	// accepted.
+	NotifySettled(context.Context, Addr) error
+
+	// WaitSettled blocks until the receiver identified by the Addr type
+	// notified us that it verified and accepted the proof we delivered.
+	WaitSettled(context.Context, Addr) error
 }
 
 // ProofMailbox represents an abstract store-and-forward mailbox that can be
@@ -166,9 +198,19 @@ func (h *HashMailBox) Init(ctx context.Context, sid streamID) error {
 		},
 	}
 
-	_, err := h.client.NewCipherBox(ctx, streamInit)
-	if err != nil && !isErrAlreadyExists(err) {
+	resp, err := h.client.NewCipherBox(ctx, streamInit)
+	switch {
+	case isErrAlreadyExists(err):
+		return nil
+
+	case err != nil:
 		return err
+
+	case resp.GetChallenge() != nil:
+		return fmt.Errorf("%w: sid=%x", ErrMailboxAuthChallenge, sid[:])
+
+	case resp.GetError() != nil:
+		return fmt.Errorf("%w: sid=%x", ErrMailboxAuthFailed, sid[:])
 	}
 
 	return nil
@@ -302,6 +344,15 @@ func deriveReceiverStreamID(recipient Recipient) streamID {
 	return sid
 }
 
+// deriveSettlementStreamID derives the stream ID the receiver uses to notify
+// the sender that it verified and accepted the proof.
+func deriveSettlementStreamID(recipient Recipient) streamID {
+	sid := deriveSenderStreamID(recipient)
+	sid[63] ^= 0x02
+
+	return sid
+}
+
 // Recipient describes the recipient of a proof. The script key is enough to
 // identify a transferred asset in the context of the proof courier. This is
 // because a proof only needs to be delivered via courier if the recipient used
@@ -320,6 +371,17 @@ type Recipient struct {
 	// Amount is the amount of the asset that is being transferred. This is
 	// used for logging purposes only.
 	Amount uint64
+
+	// OutPoint is the anchor outpoint of the transferred asset. This is
+	// used to record delivery attempts only.
+	OutPoint wire.OutPoint
+
+	// EncryptionKey is the key of the recipient that proofs are encrypted
+	// to, which is the internal key of the recipient's anchor output. The
+	// sender only needs to know the public key, while the recipient also
+	// needs the key locator to decrypt proofs. If nil, proofs aren't
+	// encrypted. Only the hashmail courier encrypts proofs.
+	EncryptionKey *keychain.KeyDescriptor
 }
 
 // HashMailCourierCfg is the config for the hashmail proof courier.
@@ -335,6 +397,39 @@ type HashMailCourierCfg struct {
 	// BackoffCfg configures the behaviour of the proof delivery
 	// functionality.
 	BackoffCfg *BackoffCfg
+
+	// MailboxExpiry is the time after its last use an unclaimed mailbox is
+	// removed from the hashmail service.
+	MailboxExpiry time.Duration `long:"mailboxexpiry" description:"The time after its last use a mailbox that was never claimed is removed from the hashmail service. 0 means unclaimed mailboxes are only removed once the transfer completes."`
+
+	// MailboxMaxMessages is the maximum number of messages written to a
+	// single mailbox.
+	MailboxMaxMessages int `long:"mailboxmaxmessages" description:"The maximum number of messages written to a single mailbox. 0 means no limit."`
+
+	// MailboxMaxBytes is the maximum number of bytes written to a single
+	// mailbox.
+	MailboxMaxBytes int64 `long:"mailboxmaxbytes" description:"The maximum number of bytes written to a single mailbox. 0 means no limit."`
+
+	// EncryptProofs indicates whether outgoing proofs are encrypted to the
+	// internal key of the receiver's anchor output. This only applies to
+	// proofs delivered through the hashmail courier, proofs pushed to a
+	// universe courier are never encrypted.
+	EncryptProofs bool `long:"encryptproofs" description:"If set, proofs delivered through the hashmail courier are encrypted to the internal key of the receiver's anchor output, so the hashmail service can't read the transferred assets and amounts. Proofs delivered through a universe courier are not encrypted. Receivers need to support encrypted proofs to receive them."`
+
+	// SignProofs indicates whether outgoing proofs are signed with the
+	// identity key of the sending node.
+	SignProofs bool `long:"signproofs" description:"If set, outgoing proofs are signed with the identity key of the lnd node, so receivers can prove which node sent them a proof. Receivers need to support signed proofs to receive them."`
+
+	// ChunkSize is the maximum size of a single message of a compressed
+	// outgoing proof.
+	ChunkSize int `long:"chunksize" description:"If set, outgoing proofs are compressed and sent in resumable chunks of at most this many bytes. Receivers need to support chunked proofs to receive them. 0 means proofs are sent as a single uncompressed message."`
+
+	// ProofLimits bounds the size of the proof files that are received.
+	ProofLimits FileLimits
+
+	// SettlementTimeout is the maximum time we'll wait for the receiver to
+	// notify us that it verified and accepted a delivered proof.
+	SettlementTimeout time.Duration `long:"settlementtimeout" description:"The maximum time to wait for the receiver of a delivered proof to notify us through the courier that it verified and accepted the proof, after which the transfer output is no longer tracked. Received proofs are also only confirmed to their senders if this is set. 0 disables the settlement return channel."`
 }
 
 // BackoffCfg configures the behaviour of the proof delivery backoff procedure.
@@ -363,33 +458,41 @@ type HashMailCourier struct {
 
 	mailbox ProofMailbox
 
+	// keyDeriver is used to derive the shared keys to decrypt received
+	// proofs with. If nil, encrypted proofs can't be received.
+	keyDeriver SharedKeyDeriver
+
+	// deliverySigner is used to sign outgoing proofs with the identity
+	// key of our node. If nil, outgoing proofs aren't signed.
+	deliverySigner *DeliverySigner
+
 	// deliveryLog is the log that the courier will use to record the
 	// attempted delivery of proofs to the receiver.
 	deliveryLog DeliveryLog
 
-	// subscribers is a map of components that want to be notified on new
-	// events, keyed by their subscription ID.
-	subscribers map[uint64]*fn.EventReceiver[fn.Event]
+	// eventBus is the bus courier events are published to. It is nil
+	// until it is set by the owner of the courier.
+	eventBus *fn.EventBus[fn.Event]
 
-	// subscriberMtx guards the subscribers map and access to the
-	// subscriptionID.
-	subscriberMtx sync.Mutex
+	// eventBusMtx guards the event bus.
+	eventBusMtx sync.Mutex
 }
 
 // NewHashMailCourier implements the Courier interface using the specified
 // ProofMailbox. This instance of the Courier relies on the Taproot Asset
-// address itself as the parametrized address type.
+// address itself as the parametrized address type. The key deriver is used to
+// decrypt received proofs that were encrypted to our key, the delivery signer
+// to sign outgoing proofs if the config asks for it.
 func NewHashMailCourier(cfg *HashMailCourierCfg, mailbox ProofMailbox,
-	deliveryLog DeliveryLog) (*HashMailCourier, error) {
+	deliveryLog DeliveryLog, keyDeriver SharedKeyDeriver,
+	deliverySigner *DeliverySigner) (*HashMailCourier, error) {
 
-	subscribers := make(
-		map[uint64]*fn.EventReceiver[fn.Event],
-	)
 	return &HashMailCourier{
-		cfg:         cfg,
-		mailbox:     mailbox,
-		deliveryLog: deliveryLog,
-		subscribers: subscribers,
+		cfg:            cfg,
+		mailbox:        mailbox,
+		keyDeriver:     keyDeriver,
+		deliverySigner: deliverySigner,
+		deliveryLog:    deliveryLog,
 	}, nil
 }
 
@@ -407,6 +510,35 @@ func (h *HashMailCourier) DeliverProof(ctx context.Context, recipient Recipient,
 	senderStreamID := deriveSenderStreamID(recipient)
 	receiverStreamID := deriveReceiverStreamID(recipient)
 
+	// The signature commits to the plain proof and is encrypted along
+	// with it, so the mailbox service doesn't learn who sent the proof.
+	proofBlob := proof.Blob
+	if h.cfg.SignProofs && h.deliverySigner != nil {
+		sig, err := h.deliverySigner.SignDelivery(
+			ctx, recipient.ScriptKey, proofBlob,
+		)
+		if err != nil {
+			return err
+		}
+
+		proofBlob, err = WrapSignedProof(proofBlob, sig)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Before the proof is handed to the mailbox, we encrypt it to the
+	// receiver's key, so the mailbox service can't read it.
+	if h.cfg.EncryptProofs && recipient.EncryptionKey != nil {
+		encrypted, err := EncryptProof(
+			proofBlob, recipient.EncryptionKey.PubKey,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to encrypt proof: %w", err)
+		}
+		proofBlob = encrypted
+	}
+
 	// Query delivery log to ensure a sensible rate of delivery attempts.
 	timestamps, err := h.deliveryLog.QueryProofDeliveryLog(
 		ctx, proof.Locator,
@@ -437,6 +569,29 @@ func (h *HashMailCourier) DeliverProof(ctx context.Context, recipient Recipient,
 		}
 	}
 
+	// Each attempt is recorded in the delivery log and announced to
+	// subscribers, so operators can see why a delivery doesn't succeed.
+	logAttempt := func(attemptErr error, nextAttempt time.Time) {
+		attempt := &DeliveryAttempt{
+			Locator:     proof.Locator,
+			AnchorPoint: recipient.OutPoint,
+			CourierAddr: h.cfg.Addr,
+			Timestamp:   time.Now().UTC(),
+			NextAttempt: nextAttempt,
+		}
+		if attemptErr != nil {
+			attempt.Error = attemptErr.Error()
+		}
+
+		err := h.deliveryLog.LogDeliveryAttempt(ctx, attempt)
+		if err != nil {
+			log.Warnf("Unable to log proof delivery attempt: %v",
+				err)
+		}
+
+		h.publishSubscriberEvent(NewProofDeliveryAttemptEvent(attempt))
+	}
+
 	// Interact with the hashmail service using a backoff procedure to
 	// ensure that we don't overwhelm the service with delivery attempts.
 	err = h.backoffExec(
@@ -466,7 +621,7 @@ func (h *HashMailCourier) DeliverProof(ctx context.Context, recipient Recipient,
 			log.Infof("Sending receiver proof via sid=%x",
 				senderStreamID)
 			err = h.mailbox.WriteProof(
-				ctx, senderStreamID, proof.Blob,
+				ctx, senderStreamID, proofBlob,
 			)
 			if err != nil {
 				return fmt.Errorf("failed to send proof "+
@@ -489,7 +644,7 @@ func (h *HashMailCourier) DeliverProof(ctx context.Context, recipient Recipient,
 			}
 
 			return nil
-		},
+		}, logAttempt,
 	)
 	if err != nil {
 		return fmt.Errorf("proof backoff delivery attempt has "+
@@ -510,6 +665,21 @@ func (h *HashMailCourier) DeliverProof(ctx context.Context, recipient Recipient,
 	return nil
 }
 
+// ProbeReceiver makes sure the hashmail service is reachable and accepts the
+// mailboxes used to deliver a proof to the receiver, by initializing them
+// ahead of the delivery.
+func (h *HashMailCourier) ProbeReceiver(ctx context.Context,
+	recipient Recipient) error {
+
+	log.Debugf("Probing receiver with script key %x",
+		recipient.ScriptKey.SerializeCompressed())
+
+	return h.initMailboxes(
+		ctx, deriveSenderStreamID(recipient),
+		deriveReceiverStreamID(recipient),
+	)
+}
+
 // initMailboxes initializes the mailboxes for the sender and receiver.
 func (h *HashMailCourier) initMailboxes(ctx context.Context,
 	senderStreamID streamID, receiverStreamID streamID) error {
@@ -577,7 +747,8 @@ func (e *BackoffExecError) Error() string {
 // backoff time delayed strategy. The backoff strategy is used to ensure
 // that we don't spam the hashmail service with proof delivery attempts.
 func (h *HashMailCourier) backoffExec(ctx context.Context,
-	targetFunc func() error) error {
+	targetFunc func() error,
+	attemptHook func(err error, nextAttempt time.Time)) error {
 
 	var (
 		backoff    = h.cfg.BackoffCfg.InitialBackoff
@@ -594,8 +765,18 @@ func (h *HashMailCourier) backoffExec(ctx context.Context,
 		if errExec == nil {
 			// The target function executed successfully, we can
 			// exit the loop.
+			attemptHook(nil, time.Time{})
 			break
 		}
+
+		// Let the hook know when the next attempt is going to happen,
+		// if there is one.
+		var nextAttempt time.Time
+		if i < numTries-1 {
+			nextAttempt = time.Now().UTC().Add(backoff)
+		}
+		attemptHook(errExec, nextAttempt)
+
 		// Store execution error in case this is the last attempt.
 		errExec = fmt.Errorf("error executing backoff procedure: "+
 			"%w", &BackoffExecError{execErr: errExec})
@@ -637,15 +818,15 @@ func (h *HashMailCourier) backoffExec(ctx context.Context,
 	return nil
 }
 
-// publishSubscriberEvent publishes an event to all subscribers.
+// publishSubscriberEvent publishes an event to all subscribers of the event
+// bus, if one is set.
 func (h *HashMailCourier) publishSubscriberEvent(event fn.Event) {
-	// Lock the subscriber mutex to ensure that we don't modify the
-	// subscriber map while we're iterating over it.
-	h.subscriberMtx.Lock()
-	defer h.subscriberMtx.Unlock()
+	h.eventBusMtx.Lock()
+	eventBus := h.eventBus
+	h.eventBusMtx.Unlock()
 
-	for _, sub := range h.subscribers {
-		sub.NewItemCreated.ChanIn() <- event
+	if eventBus != nil {
+		eventBus.Publish(event)
 	}
 }
 
@@ -682,6 +863,31 @@ func (e *ReceiverProofBackoffWaitEvent) Timestamp() time.Time {
 	return e.timestamp
 }
 
+// ProofDeliveryAttemptEvent is an event that is sent to a subscriber each time
+// an attempt to deliver a proof to the receiver finished.
+type ProofDeliveryAttemptEvent struct {
+	// timestamp is the time the event was created.
+	timestamp time.Time
+
+	// Attempt is the outcome of the delivery attempt.
+	Attempt *DeliveryAttempt
+}
+
+// Timestamp returns the timestamp of the event.
+func (e *ProofDeliveryAttemptEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// NewProofDeliveryAttemptEvent creates a new ProofDeliveryAttemptEvent.
+func NewProofDeliveryAttemptEvent(
+	attempt *DeliveryAttempt) *ProofDeliveryAttemptEvent {
+
+	return &ProofDeliveryAttemptEvent{
+		timestamp: time.Now().UTC(),
+		Attempt:   attempt,
+	}
+}
+
 // NewReceiverProofBackoffWaitEvent creates a new ReceiverProofBackoffWaitEvent.
 func NewReceiverProofBackoffWaitEvent(
 	backoff time.Duration, triesCounter int64) *ReceiverProofBackoffWaitEvent {
@@ -712,6 +918,49 @@ func (h *HashMailCourier) ReceiveProof(ctx context.Context, recipient Recipient,
 		return nil, err
 	}
 
+	// If the proof was encrypted to our key, we decrypt it before we
+	// acknowledge it.
+	if IsEncryptedProof(proof) {
+		if h.keyDeriver == nil || recipient.EncryptionKey == nil {
+			return nil, fmt.Errorf("%w: no decryption key for "+
+				"sid=%x", ErrProofDecryption, senderStreamID[:])
+		}
+
+		proof, err = DecryptProof(
+			ctx, proof, h.keyDeriver,
+			recipient.EncryptionKey.KeyLocator,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// If the sender signed the proof, we keep the signature as evidence of
+	// who sent it. An invalid signature doesn't make the proof itself any
+	// less valid, so we only drop the signature in that case.
+	var deliverySig *DeliverySignature
+	if IsSignedProof(proof) {
+		proof, deliverySig, err = UnwrapSignedProof(proof)
+		if err != nil {
+			return nil, err
+		}
+
+		err = deliverySig.Verify(recipient.ScriptKey, proof)
+		if err != nil {
+			log.Warnf("Dropping delivery signature of proof "+
+				"received via sid=%x: %v", senderStreamID[:],
+				err)
+			deliverySig = nil
+		}
+	}
+
+	// We don't acknowledge proofs that exceed our limits, as we'd never
+	// be able to import them anyway.
+	if err := h.cfg.ProofLimits.CheckFile(proof); err != nil {
+		return nil, fmt.Errorf("received proof via sid=%x rejected: %w",
+			senderStreamID[:], err)
+	}
+
 	// Now that we've read the proof, we'll create our mailbox (which might
 	// already exist) to send an ACK back to the sender.
 	receiverStreamID := deriveReceiverStreamID(recipient)
@@ -725,26 +974,130 @@ func (h *HashMailCourier) ReceiveProof(ctx context.Context, recipient Recipient,
 
 	// Finally, we'll return the proof state back to the caller.
 	return &AnnotatedProof{
-		Locator: loc,
-		Blob:    proof,
+		Locator:           loc,
+		Blob:              proof,
+		DeliverySignature: deliverySig,
 	}, nil
 }
 
-// SetSubscribers sets the subscribers for the courier. This method is
-// thread-safe.
-func (h *HashMailCourier) SetSubscribers(
-	subscribers map[uint64]*fn.EventReceiver[fn.Event]) {
+// SetEventBus sets the event bus that courier events are published to. This
+// method is thread-safe.
+func (h *HashMailCourier) SetEventBus(eventBus *fn.EventBus[fn.Event]) {
+	h.eventBusMtx.Lock()
+	defer h.eventBusMtx.Unlock()
 
-	h.subscriberMtx.Lock()
-	defer h.subscriberMtx.Unlock()
-
-	h.subscribers = subscribers
+	h.eventBus = eventBus
 }
 
 // A compile-time assertion to ensure the HashMailCourier meets the
 // proof.Courier interface.
 var _ Courier[Recipient] = (*HashMailCourier)(nil)
 
+// NotifySettled notifies the sender of the proof for the given recipient that
+// the proof was verified and accepted, through a mailbox that is separate from
+// the ones used to deliver the proof.
+func (h *HashMailCourier) NotifySettled(ctx context.Context,
+	recipient Recipient) error {
+
+	if h.cfg.SettlementTimeout == 0 {
+		return ErrSettlementDisabled
+	}
+
+	settlementStreamID := deriveSettlementStreamID(recipient)
+	log.Infof("Notifying sender of settlement via sid=%x",
+		settlementStreamID)
+
+	if err := h.mailbox.Init(ctx, settlementStreamID); err != nil {
+		return fmt.Errorf("failed to init settlement mailbox: %w", err)
+	}
+
+	return h.mailbox.AckProof(ctx, settlementStreamID)
+}
+
+// WaitSettled blocks until the given recipient notified us that it verified
+// and accepted the proof we delivered, or the settlement timeout is reached.
+// The settlement mailbox is cleaned up once the notification was received.
+func (h *HashMailCourier) WaitSettled(ctx context.Context,
+	recipient Recipient) error {
+
+	if h.cfg.SettlementTimeout == 0 {
+		return ErrSettlementDisabled
+	}
+
+	settlementStreamID := deriveSettlementStreamID(recipient)
+	if err := h.mailbox.Init(ctx, settlementStreamID); err != nil {
+		return fmt.Errorf("failed to init settlement mailbox: %w", err)
+	}
+
+	log.Infof("Waiting (%v) for receiver settlement via sid=%x",
+		h.cfg.SettlementTimeout, settlementStreamID)
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, h.cfg.SettlementTimeout)
+	defer cancel()
+	err := h.mailbox.RecvAck(ctxTimeout, settlementStreamID)
+	if err != nil {
+		return fmt.Errorf("failed to receive settlement from "+
+			"receiver within timeout: %w", err)
+	}
+
+	if err := h.mailbox.CleanUp(ctx, settlementStreamID); err != nil {
+		return fmt.Errorf("failed to cleanup settlement mailbox: %w",
+			err)
+	}
+
+	return nil
+}
+
+// A compile-time assertion to ensure the HashMailCourier meets the
+// proof.ReceiverProber interface.
+var _ ReceiverProber[Recipient] = (*HashMailCourier)(nil)
+
+// A compile-time assertion to ensure the HashMailCourier meets the
+// proof.SettlementChannel interface.
+var _ SettlementChannel[Recipient] = (*HashMailCourier)(nil)
+
+// DeliveryAttempt is the outcome of a single attempt of the courier to
+// deliver a proof to its receiver.
+type DeliveryAttempt struct {
+	// Locator is the locator of the delivered proof.
+	Locator Locator
+
+	// AnchorPoint is the anchor outpoint of the transferred asset.
+	AnchorPoint wire.OutPoint
+
+	// CourierAddr is the address of the courier service the proof was
+	// delivered through.
+	CourierAddr string
+
+	// Timestamp is the time the attempt finished.
+	Timestamp time.Time
+
+	// Error is the error the attempt failed with, empty if the proof was
+	// delivered.
+	Error string
+
+	// NextAttempt is the time the next attempt is scheduled for, the zero
+	// time if no further attempt is scheduled.
+	NextAttempt time.Time
+}
+
+// Delivered returns true if the proof was delivered by the attempt.
+func (d *DeliveryAttempt) Delivered() bool {
+	return d.Error == ""
+}
+
+// DeliveryAttemptQuery is used to filter the logged delivery attempts.
+type DeliveryAttemptQuery struct {
+	// AnchorTxid is the ID of the anchor transaction of the transfer the
+	// proofs were delivered for. If nil, attempts of all transfers are
+	// returned.
+	AnchorTxid *chainhash.Hash
+
+	// ScriptKey is the script key of the receiver. If nil, attempts for
+	// all receivers are returned.
+	ScriptKey *btcec.PublicKey
+}
+
 // DeliveryLog is an interface that allows the courier to log the (attempted)
 // delivery of a proof.
 type DeliveryLog interface {
@@ -754,4 +1107,13 @@ type DeliveryLog interface {
 	// QueryProofDeliveryLog returns timestamps which correspond to logged
 	// proof delivery attempts.
 	QueryProofDeliveryLog(context.Context, Locator) ([]time.Time, error)
+
+	// LogDeliveryAttempt logs the outcome of a proof delivery attempt to
+	// disk.
+	LogDeliveryAttempt(context.Context, *DeliveryAttempt) error
+
+	// QueryDeliveryAttempts returns the logged outcomes of the proof
+	// delivery attempts that match the query, oldest first.
+	QueryDeliveryAttempts(context.Context,
+		DeliveryAttemptQuery) ([]*DeliveryAttempt, error)
 }
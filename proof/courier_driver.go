@@ -0,0 +1,145 @@
+package proof
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CourierDriverCfg holds the configuration and dependencies a courier driver
+// can use to create a new proof courier.
+type CourierDriverCfg struct {
+	// Addr is the URI of the courier service. Its scheme determines the
+	// driver that is used to create the courier.
+	Addr *url.URL
+
+	// ReceiverAckTimeout is the maximum time the courier should wait for
+	// the receiver to acknowledge a proof.
+	ReceiverAckTimeout time.Duration
+
+	// BackoffCfg configures the behaviour of the proof delivery backoff
+	// procedure.
+	BackoffCfg *BackoffCfg
+
+	// DeliveryLog is the log the courier can use to record the attempted
+	// delivery of proofs to a receiver.
+	DeliveryLog DeliveryLog
+
+	// KeyDeriver can be used to derive shared keys with the daemon's keys,
+	// for example to decrypt received proofs.
+	KeyDeriver SharedKeyDeriver
+}
+
+// CourierDriver creates proof couriers for courier service URIs of a single
+// scheme. External packages can register a driver to add their own proof
+// transport, similar to how database/sql drivers are registered.
+type CourierDriver struct {
+	// Scheme is the URI scheme of the courier services the driver creates
+	// couriers for.
+	Scheme string
+
+	// New creates a new proof courier for the courier service at the
+	// configured URI.
+	New func(cfg *CourierDriverCfg) (Courier[Recipient], error)
+}
+
+var (
+	// courierDrivers is the set of registered courier drivers, keyed by
+	// their URI scheme.
+	courierDrivers = make(map[string]*CourierDriver)
+
+	// courierDriverMtx guards the courierDrivers map.
+	courierDriverMtx sync.RWMutex
+)
+
+// RegisterCourier registers a courier driver for its URI scheme. This is
+// usually called from the init function of the package that implements the
+// courier. Schemes are case-insensitive. An error is returned if a driver for
+// the scheme is already registered.
+func RegisterCourier(driver *CourierDriver) error {
+	if driver == nil || driver.New == nil {
+		return fmt.Errorf("courier driver must be specified")
+	}
+	if driver.Scheme == "" {
+		return fmt.Errorf("courier driver scheme must be specified")
+	}
+
+	courierDriverMtx.Lock()
+	defer courierDriverMtx.Unlock()
+
+	scheme := strings.ToLower(driver.Scheme)
+	if _, ok := courierDrivers[scheme]; ok {
+		return fmt.Errorf("courier driver for scheme %v already "+
+			"registered", scheme)
+	}
+
+	courierDrivers[scheme] = driver
+
+	return nil
+}
+
+// RegisteredCouriers returns the sorted URI schemes of all registered courier
+// drivers.
+func RegisteredCouriers() []string {
+	courierDriverMtx.RLock()
+	defer courierDriverMtx.RUnlock()
+
+	schemes := make([]string, 0, len(courierDrivers))
+	for scheme := range courierDrivers {
+		schemes = append(schemes, scheme)
+	}
+	sort.Strings(schemes)
+
+	return schemes
+}
+
+// ParseCourierAddr parses a courier service URI and makes sure a courier
+// driver is registered for its scheme.
+func ParseCourierAddr(addr string) (*url.URL, error) {
+	courierAddr, err := url.Parse(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid courier address: %w", err)
+	}
+
+	courierDriverMtx.RLock()
+	_, ok := courierDrivers[courierAddr.Scheme]
+	courierDriverMtx.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("no courier driver registered for "+
+			"scheme %q, registered schemes: %v", courierAddr.Scheme,
+			RegisteredCouriers())
+	}
+
+	return courierAddr, nil
+}
+
+// NewCourier creates a new proof courier for the courier service at the given
+// URI, using the courier driver registered for its scheme. The driver is handed
+// a copy of the given config with the parsed URI as its address.
+func NewCourier(addr string, cfg *CourierDriverCfg) (Courier[Recipient],
+	error) {
+
+	courierAddr, err := ParseCourierAddr(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	courierDriverMtx.RLock()
+	driver := courierDrivers[courierAddr.Scheme]
+	courierDriverMtx.RUnlock()
+
+	driverCfg := *cfg
+	driverCfg.Addr = courierAddr
+
+	courier, err := driver.New(&driverCfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create %v courier: %w",
+			courierAddr.Scheme, err)
+	}
+
+	return courier, nil
+}
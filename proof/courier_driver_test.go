@@ -0,0 +1,76 @@
+package proof
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/stretchr/testify/require"
+)
+
+// mockCourier is a proof courier that records the config it was created with.
+type mockCourier struct {
+	cfg *CourierDriverCfg
+}
+
+func (m *mockCourier) DeliverProof(context.Context, Recipient,
+	*AnnotatedProof) error {
+
+	return nil
+}
+
+func (m *mockCourier) ReceiveProof(context.Context, Recipient,
+	Locator) (*AnnotatedProof, error) {
+
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockCourier) SetEventBus(*fn.EventBus[fn.Event]) {
+}
+
+// TestCourierDriverRegistration tests that proof couriers can be created for
+// the schemes of registered courier drivers only.
+func TestCourierDriverRegistration(t *testing.T) {
+	const scheme = "test-courier"
+
+	require.NoError(t, RegisterCourier(&CourierDriver{
+		Scheme: "Test-Courier",
+		New: func(cfg *CourierDriverCfg) (Courier[Recipient], error) {
+			return &mockCourier{cfg: cfg}, nil
+		},
+	}))
+	t.Cleanup(func() {
+		courierDriverMtx.Lock()
+		delete(courierDrivers, scheme)
+		courierDriverMtx.Unlock()
+	})
+	require.Contains(t, RegisteredCouriers(), scheme)
+
+	// A second driver for the same scheme can't be registered.
+	err := RegisterCourier(&CourierDriver{
+		Scheme: scheme,
+		New: func(*CourierDriverCfg) (Courier[Recipient], error) {
+			return nil, nil
+		},
+	})
+	require.ErrorContains(t, err, "already registered")
+
+	// The driver is handed the parsed address and the dependencies.
+	courier, err := NewCourier(
+		"test-courier://courier.example:1234/proofs",
+		&CourierDriverCfg{ReceiverAckTimeout: time.Minute},
+	)
+	require.NoError(t, err)
+
+	mock, ok := courier.(*mockCourier)
+	require.True(t, ok)
+	require.Equal(t, "courier.example:1234", mock.cfg.Addr.Host)
+	require.Equal(t, "/proofs", mock.cfg.Addr.Path)
+	require.Equal(t, time.Minute, mock.cfg.ReceiverAckTimeout)
+
+	// Addresses with an unknown scheme are rejected.
+	_, err = NewCourier("unknown://courier.example", &CourierDriverCfg{})
+	require.ErrorContains(t, err, "no courier driver registered")
+}
@@ -0,0 +1,90 @@
+package proof
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// HashMailCourierScheme is the scheme that is reported for the
+	// built-in hashmail courier, which isn't created through a registered
+	// courier driver.
+	HashMailCourierScheme = "hashmail"
+)
+
+// CourierInfo describes the proof courier a node uses to deliver the proofs of
+// its outgoing transfers to their receivers.
+type CourierInfo struct {
+	// Scheme is the protocol of the proof courier. It is empty if no
+	// courier is configured.
+	Scheme string
+
+	// Addr is the endpoint of the courier service.
+	Addr string
+
+	// PinsToIPFS is true if outgoing proofs are pinned to IPFS and only
+	// their content IDs are sent through the courier, which means the
+	// receiver needs IPFS access to resolve them.
+	PinsToIPFS bool
+}
+
+// Supported returns nil if proofs can be delivered through the described
+// courier, or an error that explains why they can't.
+func (c *CourierInfo) Supported() error {
+	switch c.Scheme {
+	case "":
+		return fmt.Errorf("no proof courier configured, proofs must " +
+			"be delivered to the receiver manually")
+
+	case HashMailCourierScheme:
+		return nil
+	}
+
+	courierDriverMtx.RLock()
+	_, ok := courierDrivers[c.Scheme]
+	courierDriverMtx.RUnlock()
+
+	if !ok {
+		return fmt.Errorf("no courier driver registered for scheme "+
+			"%q, registered schemes: %v", c.Scheme,
+			RegisteredCouriers())
+	}
+
+	return nil
+}
+
+// EstimateTransferProofSize estimates the size of the proof file that is sent
+// to the receiver of a transfer spending one of the given proofs. The new
+// proof file extends the file of the spent asset by a single transition
+// proof, which we assume to be about the size of its last proof. The largest
+// estimate is returned, since coin selection can pick any of the assets. Zero
+// is returned if no proofs are given.
+func EstimateTransferProofSize(proofs []*AnnotatedProof) (uint64, error) {
+	var maxSize uint64
+	for _, p := range proofs {
+		var f File
+		if err := f.Decode(bytes.NewReader(p.Blob)); err != nil {
+			return 0, fmt.Errorf("unable to decode proof file: %w",
+				err)
+		}
+
+		lastProof, err := f.RawLastProof()
+		if err != nil {
+			return 0, fmt.Errorf("unable to fetch last proof: %w",
+				err)
+		}
+
+		proofLen := uint64(len(lastProof))
+		size := uint64(len(p.Blob)) + tlv.VarIntSize(proofLen) +
+			proofLen + sha256.Size
+
+		if size > maxSize {
+			maxSize = size
+		}
+	}
+
+	return maxSize, nil
+}
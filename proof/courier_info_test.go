@@ -0,0 +1,81 @@
+package proof
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCourierInfoSupported tests that only the hashmail courier and couriers
+// of registered drivers are reported as supported.
+func TestCourierInfoSupported(t *testing.T) {
+	const scheme = "info-courier"
+
+	require.NoError(t, RegisterCourier(&CourierDriver{
+		Scheme: scheme,
+		New: func(*CourierDriverCfg) (Courier[Recipient], error) {
+			return &mockCourier{}, nil
+		},
+	}))
+	t.Cleanup(func() {
+		courierDriverMtx.Lock()
+		delete(courierDrivers, scheme)
+		courierDriverMtx.Unlock()
+	})
+
+	info := &CourierInfo{}
+	require.ErrorContains(t, info.Supported(), "no proof courier")
+
+	info.Scheme = HashMailCourierScheme
+	require.NoError(t, info.Supported())
+
+	info.Scheme = scheme
+	require.NoError(t, info.Supported())
+
+	info.Scheme = "unknown"
+	require.ErrorContains(t, info.Supported(), "no courier driver")
+}
+
+// TestEstimateTransferProofSize tests that the estimated proof size matches
+// the size of a proof file that is extended by a proof of the same size as
+// its last one.
+func TestEstimateTransferProofSize(t *testing.T) {
+	t.Parallel()
+
+	size, err := EstimateTransferProofSize(nil)
+	require.NoError(t, err)
+	require.Zero(t, size)
+
+	amt := uint64(100)
+	genesisProof, _ := genRandomGenesisWithProof(
+		t, asset.Normal, &amt, nil, true, nil, nil,
+	)
+	smallBlob, err := EncodeAsProofFile(&genesisProof)
+	require.NoError(t, err)
+
+	f, err := NewFile(V0, genesisProof, genesisProof)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, f.Encode(&buf))
+	largeBlob := buf.Bytes()
+
+	size, err = EstimateTransferProofSize([]*AnnotatedProof{
+		{Blob: largeBlob}, {Blob: smallBlob},
+	})
+	require.NoError(t, err)
+
+	// Appending the last proof again must result in a file of exactly
+	// the estimated size.
+	require.NoError(t, f.AppendProof(genesisProof))
+	buf.Reset()
+	require.NoError(t, f.Encode(&buf))
+	require.EqualValues(t, buf.Len(), size)
+
+	_, err = EstimateTransferProofSize([]*AnnotatedProof{
+		{Blob: []byte("not a proof")},
+	})
+	require.ErrorContains(t, err, "unable to decode")
+}
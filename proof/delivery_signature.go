@@ -0,0 +1,196 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// signedProofPrefix is the prefix of a courier message that carries a proof
+// together with the signature of the node that sent it.
+var signedProofPrefix = []byte("signed-proof:")
+
+// deliverySignatureTag is the domain separation tag of the message that is
+// signed to prove the delivery of a proof.
+var deliverySignatureTag = []byte("taproot-assets/proof-delivery")
+
+var (
+	// ErrInvalidDeliverySignature is returned if a signed courier message
+	// can't be parsed or its signature doesn't match the proof.
+	ErrInvalidDeliverySignature = errors.New("invalid proof delivery " +
+		"signature")
+)
+
+// NodeKeyLocator is the locator of the identity key of the lnd node. Messages
+// that prove something on behalf of the node are signed with it.
+var NodeKeyLocator = keychain.KeyLocator{
+	Family: keychain.KeyFamilyNodeKey,
+	Index:  0,
+}
+
+// MessageSigner signs arbitrary messages with a key of the backing lnd node.
+// This is implemented by lndclient.SignerClient.
+type MessageSigner interface {
+	// SignMessage signs the sha256 hash of the given message with the key
+	// identified by the given locator.
+	SignMessage(ctx context.Context, msg []byte,
+		locator keychain.KeyLocator,
+		opts ...lndclient.SignMessageOption) ([]byte, error)
+}
+
+// DeliverySignature is the signature of the sending node over a proof it
+// delivered to a recipient through a courier. As it commits to both the proof
+// and the script key of the recipient, the recipient can later prove which
+// node sent it the proof.
+type DeliverySignature struct {
+	// NodeKey is the identity key of the node that sent the proof.
+	NodeKey *btcec.PublicKey
+
+	// Signature is the DER encoded signature of the node key over the
+	// delivery message.
+	Signature []byte
+}
+
+// DeliveryMessage returns the message that is signed to prove the delivery of
+// the given proof to the recipient with the given script key.
+func DeliveryMessage(scriptKey *btcec.PublicKey, proof Blob) []byte {
+	proofHash := sha256.Sum256(proof)
+
+	var msg bytes.Buffer
+	msg.Write(deliverySignatureTag)
+	msg.Write(scriptKey.SerializeCompressed())
+	msg.Write(proofHash[:])
+
+	return msg.Bytes()
+}
+
+// Verify checks that the signature was created by the node key over the
+// delivery of the given proof to the recipient with the given script key.
+func (s *DeliverySignature) Verify(scriptKey *btcec.PublicKey,
+	proof Blob) error {
+
+	sig, err := ecdsa.ParseDERSignature(s.Signature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidDeliverySignature, err)
+	}
+
+	digest := sha256.Sum256(DeliveryMessage(scriptKey, proof))
+	if !sig.Verify(digest[:], s.NodeKey) {
+		return fmt.Errorf("%w: signature doesn't match node key %x",
+			ErrInvalidDeliverySignature,
+			s.NodeKey.SerializeCompressed())
+	}
+
+	return nil
+}
+
+// DeliverySigner signs proofs that are delivered through a courier with the
+// identity key of the backing lnd node.
+type DeliverySigner struct {
+	// Signer is used to sign with the identity key of the node.
+	Signer MessageSigner
+
+	// NodeKey is the identity key of the node.
+	NodeKey *btcec.PublicKey
+}
+
+// SignDelivery signs the delivery of the given proof to the recipient with
+// the given script key.
+func (d *DeliverySigner) SignDelivery(ctx context.Context,
+	scriptKey *btcec.PublicKey, proof Blob) (*DeliverySignature, error) {
+
+	sig, err := d.Signer.SignMessage(
+		ctx, DeliveryMessage(scriptKey, proof), NodeKeyLocator,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign proof delivery: %w", err)
+	}
+
+	deliverySig := &DeliverySignature{
+		NodeKey:   d.NodeKey,
+		Signature: sig,
+	}
+
+	// A signature that doesn't verify would only make the receiver reject
+	// it, so we rather fail early.
+	if err := deliverySig.Verify(scriptKey, proof); err != nil {
+		return nil, fmt.Errorf("signed proof delivery invalid, node "+
+			"key mismatch? %w", err)
+	}
+
+	return deliverySig, nil
+}
+
+// IsSignedProof returns true if the given courier message carries a proof
+// together with a delivery signature.
+func IsSignedProof(msg Blob) bool {
+	return bytes.HasPrefix(msg, signedProofPrefix)
+}
+
+// WrapSignedProof returns a courier message that carries the proof together
+// with the given delivery signature.
+func WrapSignedProof(proof Blob, sig *DeliverySignature) (Blob, error) {
+	if len(sig.Signature) == 0 || len(sig.Signature) > 0xff {
+		return nil, fmt.Errorf("%w: invalid signature length %d",
+			ErrInvalidDeliverySignature, len(sig.Signature))
+	}
+
+	var msg bytes.Buffer
+	msg.Grow(
+		len(signedProofPrefix) + btcec.PubKeyBytesLenCompressed + 1 +
+			len(sig.Signature) + len(proof),
+	)
+	msg.Write(signedProofPrefix)
+	msg.Write(sig.NodeKey.SerializeCompressed())
+	msg.WriteByte(byte(len(sig.Signature)))
+	msg.Write(sig.Signature)
+	msg.Write(proof)
+
+	return msg.Bytes(), nil
+}
+
+// UnwrapSignedProof parses a courier message created by WrapSignedProof and
+// returns the proof and the delivery signature it carries. The signature
+// isn't verified.
+func UnwrapSignedProof(msg Blob) (Blob, *DeliverySignature, error) {
+	if !IsSignedProof(msg) {
+		return nil, nil, fmt.Errorf("%w: not a signed proof",
+			ErrInvalidDeliverySignature)
+	}
+	msg = msg[len(signedProofPrefix):]
+
+	if len(msg) < btcec.PubKeyBytesLenCompressed+1 {
+		return nil, nil, fmt.Errorf("%w: message too short",
+			ErrInvalidDeliverySignature)
+	}
+
+	nodeKey, err := btcec.ParsePubKey(
+		msg[:btcec.PubKeyBytesLenCompressed],
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: invalid node key: %v",
+			ErrInvalidDeliverySignature, err)
+	}
+	msg = msg[btcec.PubKeyBytesLenCompressed:]
+
+	sigLen := int(msg[0])
+	msg = msg[1:]
+	if sigLen == 0 || len(msg) < sigLen {
+		return nil, nil, fmt.Errorf("%w: invalid signature length %d",
+			ErrInvalidDeliverySignature, sigLen)
+	}
+
+	sig := &DeliverySignature{
+		NodeKey:   nodeKey,
+		Signature: append([]byte{}, msg[:sigLen]...),
+	}
+
+	return msg[sigLen:], sig, nil
+}
@@ -0,0 +1,91 @@
+package proof
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// mockMessageSigner signs messages with a single private key, the same way lnd
+// does.
+type mockMessageSigner struct {
+	privKey *btcec.PrivateKey
+}
+
+func (m *mockMessageSigner) SignMessage(_ context.Context, msg []byte,
+	_ keychain.KeyLocator, _ ...lndclient.SignMessageOption) ([]byte,
+	error) {
+
+	digest := sha256.Sum256(msg)
+	return ecdsa.Sign(m.privKey, digest[:]).Serialize(), nil
+}
+
+// TestDeliverySignature tests that signed proofs can be wrapped, unwrapped and
+// verified, and that signatures don't verify for other proofs or recipients.
+func TestDeliverySignature(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	nodeKey := test.RandPrivKey(t)
+	signer := &DeliverySigner{
+		Signer:  &mockMessageSigner{privKey: nodeKey},
+		NodeKey: nodeKey.PubKey(),
+	}
+	scriptKey := test.RandPubKey(t)
+	proof := Blob(test.RandBytes(500))
+
+	sig, err := signer.SignDelivery(ctx, scriptKey, proof)
+	require.NoError(t, err)
+	require.NoError(t, sig.Verify(scriptKey, proof))
+
+	wrapped, err := WrapSignedProof(proof, sig)
+	require.NoError(t, err)
+	require.True(t, IsSignedProof(wrapped))
+	require.False(t, IsSignedProof(proof))
+
+	unwrapped, unwrappedSig, err := UnwrapSignedProof(wrapped)
+	require.NoError(t, err)
+	require.Equal(t, proof, unwrapped)
+	require.True(t, nodeKey.PubKey().IsEqual(unwrappedSig.NodeKey))
+	require.Equal(t, sig.Signature, unwrappedSig.Signature)
+	require.NoError(t, unwrappedSig.Verify(scriptKey, unwrapped))
+
+	// The signature doesn't verify for a different recipient or proof.
+	err = sig.Verify(test.RandPubKey(t), proof)
+	require.ErrorIs(t, err, ErrInvalidDeliverySignature)
+
+	tampered := append(Blob{}, proof...)
+	tampered[0] ^= 0x01
+	err = sig.Verify(scriptKey, tampered)
+	require.ErrorIs(t, err, ErrInvalidDeliverySignature)
+
+	// Neither does it verify if claimed by a different node.
+	forged := &DeliverySignature{
+		NodeKey:   test.RandPubKey(t),
+		Signature: sig.Signature,
+	}
+	require.ErrorIs(
+		t, forged.Verify(scriptKey, proof), ErrInvalidDeliverySignature,
+	)
+
+	// A signer with a mismatched node key fails early.
+	badSigner := &DeliverySigner{
+		Signer:  signer.Signer,
+		NodeKey: test.RandPubKey(t),
+	}
+	_, err = badSigner.SignDelivery(ctx, scriptKey, proof)
+	require.ErrorIs(t, err, ErrInvalidDeliverySignature)
+
+	// Truncated messages are rejected.
+	_, _, err = UnwrapSignedProof(wrapped[:len(signedProofPrefix)+10])
+	require.ErrorIs(t, err, ErrInvalidDeliverySignature)
+	_, _, err = UnwrapSignedProof(proof)
+	require.ErrorIs(t, err, ErrInvalidDeliverySignature)
+}
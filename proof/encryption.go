@@ -0,0 +1,193 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// encryptedProofPrefix is the prefix of a courier message that carries a proof
+// encrypted to the receiver's key instead of the proof itself.
+var encryptedProofPrefix = []byte("encrypted-proof:")
+
+// encryptedDisclosuresPrefix is the prefix of script key disclosures that are
+// encrypted to the key of an auditor.
+var encryptedDisclosuresPrefix = []byte("encrypted-disclosures:")
+
+var (
+	// ErrProofDecryption is returned if an encrypted proof or other
+	// encrypted message can't be decrypted.
+	ErrProofDecryption = errors.New("unable to decrypt proof")
+)
+
+// SharedKeyDeriver derives shared secrets with keys of the local wallet.
+type SharedKeyDeriver interface {
+	// DeriveSharedKey returns a shared secret key by performing
+	// Diffie-Hellman key derivation between the ephemeral public key and
+	// the key specified by the key locator. The shared secret is the
+	// SHA256 hash of the compressed shared point.
+	DeriveSharedKey(ctx context.Context, ephemeralPubKey *btcec.PublicKey,
+		keyLocator *keychain.KeyLocator) ([32]byte, error)
+}
+
+// IsEncryptedProof returns true if the given courier message carries an
+// encrypted proof.
+func IsEncryptedProof(msg Blob) bool {
+	return bytes.HasPrefix(msg, encryptedProofPrefix)
+}
+
+// newProofCipher returns the authenticated cipher for the given shared secret.
+func newProofCipher(sharedKey [32]byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(sharedKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// EncryptProof encrypts the proof to the given public key of the receiver,
+// using ECIES with an ephemeral key and AES-256-GCM. Only the owner of the
+// private key can decrypt the proof, so a courier that transports the
+// encrypted proof learns nothing about the transferred asset.
+func EncryptProof(proof Blob, receiverKey *btcec.PublicKey) (Blob, error) {
+	return encryptToKey(encryptedProofPrefix, proof, receiverKey)
+}
+
+// DecryptProof decrypts a proof that was encrypted to the key of the local
+// wallet with the given key locator.
+func DecryptProof(ctx context.Context, msg Blob, keyDeriver SharedKeyDeriver,
+	keyLocator keychain.KeyLocator) (Blob, error) {
+
+	if !IsEncryptedProof(msg) {
+		return nil, fmt.Errorf("%w: not an encrypted proof",
+			ErrProofDecryption)
+	}
+
+	deriveKey := func(ephemeralKey *btcec.PublicKey) ([32]byte, error) {
+		return keyDeriver.DeriveSharedKey(
+			ctx, ephemeralKey, &keyLocator,
+		)
+	}
+
+	return decryptWithKey(encryptedProofPrefix, msg, deriveKey)
+}
+
+// EncryptDisclosures encrypts serialized script key disclosures to the given
+// public key of an auditor, the same way proofs are encrypted to a receiver.
+func EncryptDisclosures(disclosures []byte,
+	auditorKey *btcec.PublicKey) ([]byte, error) {
+
+	return encryptToKey(
+		encryptedDisclosuresPrefix, disclosures, auditorKey,
+	)
+}
+
+// DecryptDisclosures decrypts script key disclosures that were encrypted to
+// the public key of the given auditor private key.
+func DecryptDisclosures(msg []byte,
+	auditorKey *btcec.PrivateKey) ([]byte, error) {
+
+	if !bytes.HasPrefix(msg, encryptedDisclosuresPrefix) {
+		return nil, fmt.Errorf("%w: not encrypted disclosures",
+			ErrProofDecryption)
+	}
+
+	ecdh := &keychain.PrivKeyECDH{PrivKey: auditorKey}
+
+	return decryptWithKey(encryptedDisclosuresPrefix, msg, ecdh.ECDH)
+}
+
+// encryptToKey encrypts the message to the given public key, using ECIES with
+// an ephemeral key and AES-256-GCM. The encrypted message starts with the
+// given prefix.
+func encryptToKey(prefix, msg []byte, key *btcec.PublicKey) ([]byte, error) {
+	ephemeralKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate ephemeral key: %w",
+			err)
+	}
+
+	// We derive the shared secret the same way lnd does, so the receiver
+	// can have its wallet derive it without exposing the private key.
+	var sharedPoint, receiverPoint btcec.JacobianPoint
+	key.AsJacobian(&receiverPoint)
+	btcec.ScalarMultNonConst(
+		&ephemeralKey.Key, &receiverPoint, &sharedPoint,
+	)
+	sharedPoint.ToAffine()
+	sharedPubKey := btcec.NewPublicKey(&sharedPoint.X, &sharedPoint.Y)
+	sharedKey := sha256.Sum256(sharedPubKey.SerializeCompressed())
+
+	aead, err := newProofCipher(sharedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("unable to generate nonce: %w", err)
+	}
+
+	// The prefix and the ephemeral key are authenticated as well.
+	header := append(
+		append([]byte{}, prefix...),
+		ephemeralKey.PubKey().SerializeCompressed()...,
+	)
+	header = append(header, nonce...)
+
+	return aead.Seal(header, nonce, msg, header), nil
+}
+
+// decryptWithKey decrypts a message that was encrypted with encryptToKey,
+// using the given function to derive the shared secret with the ephemeral key
+// of the message.
+func decryptWithKey(prefix, msg []byte,
+	deriveKey func(*btcec.PublicKey) ([32]byte, error)) ([]byte, error) {
+
+	pubKeyEnd := len(prefix) + btcec.PubKeyBytesLenCompressed
+	if len(msg) < pubKeyEnd {
+		return nil, fmt.Errorf("%w: message too short",
+			ErrProofDecryption)
+	}
+
+	ephemeralKey, err := btcec.ParsePubKey(msg[len(prefix):pubKeyEnd])
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid ephemeral key: %v",
+			ErrProofDecryption, err)
+	}
+
+	sharedKey, err := deriveKey(ephemeralKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive shared key: %w", err)
+	}
+
+	aead, err := newProofCipher(sharedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	headerEnd := pubKeyEnd + aead.NonceSize()
+	if len(msg) < headerEnd {
+		return nil, fmt.Errorf("%w: message too short",
+			ErrProofDecryption)
+	}
+
+	plaintext, err := aead.Open(
+		nil, msg[pubKeyEnd:headerEnd], msg[headerEnd:],
+		msg[:headerEnd],
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProofDecryption, err)
+	}
+
+	return plaintext, nil
+}
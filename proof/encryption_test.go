@@ -0,0 +1,179 @@
+package proof
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// mockKeyDeriver derives shared keys with a single private key, the same way
+// lnd does.
+type mockKeyDeriver struct {
+	privKey *btcec.PrivateKey
+}
+
+func (m *mockKeyDeriver) DeriveSharedKey(_ context.Context,
+	ephemeralPubKey *btcec.PublicKey, _ *keychain.KeyLocator) ([32]byte,
+	error) {
+
+	ecdh := &keychain.PrivKeyECDH{PrivKey: m.privKey}
+	return ecdh.ECDH(ephemeralPubKey)
+}
+
+// TestProofEncryption tests that proofs encrypted to a key can only be
+// decrypted with that key.
+func TestProofEncryption(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	receiverKey := test.RandPrivKey(t)
+	deriver := &mockKeyDeriver{privKey: receiverKey}
+	keyLoc := keychain.KeyLocator{Family: 1, Index: 2}
+
+	proof := Blob(test.RandBytes(500))
+	encrypted, err := EncryptProof(proof, receiverKey.PubKey())
+	require.NoError(t, err)
+	require.True(t, IsEncryptedProof(encrypted))
+	require.False(t, IsEncryptedProof(proof))
+	require.NotContains(t, string(encrypted), string(proof))
+
+	decrypted, err := DecryptProof(ctx, encrypted, deriver, keyLoc)
+	require.NoError(t, err)
+	require.Equal(t, proof, decrypted)
+
+	// Encrypting the same proof twice uses different ephemeral keys.
+	encrypted2, err := EncryptProof(proof, receiverKey.PubKey())
+	require.NoError(t, err)
+	require.NotEqual(t, encrypted, encrypted2)
+
+	// A different key can't decrypt the proof.
+	otherDeriver := &mockKeyDeriver{privKey: test.RandPrivKey(t)}
+	_, err = DecryptProof(ctx, encrypted, otherDeriver, keyLoc)
+	require.ErrorIs(t, err, ErrProofDecryption)
+
+	// Neither can a tampered proof be decrypted.
+	tampered := append(Blob{}, encrypted...)
+	tampered[len(tampered)-1] ^= 0x01
+	_, err = DecryptProof(ctx, tampered, deriver, keyLoc)
+	require.ErrorIs(t, err, ErrProofDecryption)
+
+	_, err = DecryptProof(ctx, encrypted[:40], deriver, keyLoc)
+	require.ErrorIs(t, err, ErrProofDecryption)
+
+	_, err = DecryptProof(ctx, proof, deriver, keyLoc)
+	require.ErrorIs(t, err, ErrProofDecryption)
+}
+
+// TestDisclosureEncryption tests that script key disclosures encrypted to an
+// auditor's key can only be decrypted with that key.
+func TestDisclosureEncryption(t *testing.T) {
+	t.Parallel()
+
+	auditorKey := test.RandPrivKey(t)
+	disclosures := test.RandBytes(300)
+
+	encrypted, err := EncryptDisclosures(disclosures, auditorKey.PubKey())
+	require.NoError(t, err)
+	require.NotContains(t, string(encrypted), string(disclosures))
+
+	decrypted, err := DecryptDisclosures(encrypted, auditorKey)
+	require.NoError(t, err)
+	require.Equal(t, disclosures, decrypted)
+
+	// A different key can't decrypt the disclosures.
+	_, err = DecryptDisclosures(encrypted, test.RandPrivKey(t))
+	require.ErrorIs(t, err, ErrProofDecryption)
+
+	// Encrypted proofs aren't mistaken for disclosures.
+	encryptedProof, err := EncryptProof(disclosures, auditorKey.PubKey())
+	require.NoError(t, err)
+	_, err = DecryptDisclosures(encryptedProof, auditorKey)
+	require.ErrorIs(t, err, ErrProofDecryption)
+}
+
+// mockProofMailbox is a mailbox that holds a single proof and records the
+// acknowledgements sent through it.
+type mockProofMailbox struct {
+	proof Blob
+
+	acks int
+}
+
+func (m *mockProofMailbox) Init(context.Context, streamID) error {
+	return nil
+}
+
+func (m *mockProofMailbox) WriteProof(context.Context, streamID, Blob) error {
+	return nil
+}
+
+func (m *mockProofMailbox) ReadProof(context.Context, streamID) (Blob, error) {
+	return m.proof, nil
+}
+
+func (m *mockProofMailbox) AckProof(context.Context, streamID) error {
+	m.acks++
+	return nil
+}
+
+func (m *mockProofMailbox) RecvAck(context.Context, streamID) error {
+	return nil
+}
+
+func (m *mockProofMailbox) CleanUp(context.Context, streamID) error {
+	return nil
+}
+
+// TestReceiveEncryptedProofWithoutKey tests that an encrypted proof received
+// without a key to decrypt it is rejected and not acknowledged.
+func TestReceiveEncryptedProofWithoutKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	receiverKey := test.RandPrivKey(t)
+	encrypted, err := EncryptProof(
+		test.RandBytes(500), receiverKey.PubKey(),
+	)
+	require.NoError(t, err)
+
+	mailbox := &mockProofMailbox{proof: encrypted}
+	courier, err := NewHashMailCourier(
+		&HashMailCourierCfg{}, mailbox, nil,
+		&mockKeyDeriver{privKey: receiverKey}, nil,
+	)
+	require.NoError(t, err)
+
+	scriptKey := test.RandPubKey(t)
+	recipient := Recipient{
+		ScriptKey: scriptKey,
+		AssetID:   asset.RandID(t),
+	}
+	_, err = courier.ReceiveProof(ctx, recipient, Locator{
+		AssetID:   &recipient.AssetID,
+		ScriptKey: *scriptKey,
+	})
+	require.ErrorIs(t, err, ErrProofDecryption)
+	require.Zero(t, mailbox.acks)
+
+	// Without a key deriver the proof can't be decrypted either, even if
+	// the receiver's key is known.
+	courier, err = NewHashMailCourier(
+		&HashMailCourierCfg{}, mailbox, nil, nil, nil,
+	)
+	require.NoError(t, err)
+
+	recipient.EncryptionKey = &keychain.KeyDescriptor{
+		PubKey: receiverKey.PubKey(),
+	}
+	_, err = courier.ReceiveProof(ctx, recipient, Locator{
+		AssetID:   &recipient.AssetID,
+		ScriptKey: *scriptKey,
+	})
+	require.ErrorIs(t, err, ErrProofDecryption)
+	require.Zero(t, mailbox.acks)
+}
@@ -0,0 +1,105 @@
+package proof
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+)
+
+// FederatedArchiver is an archive that uses a local archive as a cache of a
+// central archive that is shared with other nodes, for example the archive
+// service of a hosted wallet provider. New proofs are written through to the
+// central archive, and proofs that aren't found locally are pulled from it
+// and cached. This allows nodes that don't retain all of their proofs, and
+// nodes that are rebuilt from scratch, to still serve them.
+type FederatedArchiver struct {
+	// cache is the local archive that is used as a cache.
+	cache Archiver
+
+	// central is the central archive that holds all proofs.
+	central Archiver
+}
+
+// NewFederatedArchiver creates a new archive that caches the proofs of the
+// given central archive in the given local archive.
+func NewFederatedArchiver(cache, central Archiver) *FederatedArchiver {
+	return &FederatedArchiver{
+		cache:   cache,
+		central: central,
+	}
+}
+
+// FetchProof fetches a proof for an asset uniquely identified by the passed
+// locator from the cache, falling back to the central archive. A proof that
+// is pulled from the central archive is added to the cache.
+//
+// NOTE: This implements the Archiver interface.
+func (f *FederatedArchiver) FetchProof(ctx context.Context,
+	id Locator) (Blob, error) {
+
+	proof, err := f.cache.FetchProof(ctx, id)
+	if !errors.Is(err, ErrProofNotFound) {
+		return proof, err
+	}
+
+	proof, err = f.central.FetchProof(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Failing to cache the proof isn't fatal, we'll just pull it again
+	// next time.
+	err = f.cache.ImportProofs(ctx, nil, false, &AnnotatedProof{
+		Locator: id,
+		Blob:    proof,
+	})
+	if err != nil {
+		log.Warnf("Unable to cache proof pulled from central "+
+			"archive: %v", err)
+	}
+
+	return proof, nil
+}
+
+// FetchProofs fetches all proofs for assets uniquely identified by the passed
+// asset ID. Only the cache is queried, as the central archive might hold the
+// proofs of other nodes of the same user too.
+//
+// NOTE: This implements the Archiver interface.
+func (f *FederatedArchiver) FetchProofs(ctx context.Context,
+	id asset.ID) ([]*AnnotatedProof, error) {
+
+	return f.cache.FetchProofs(ctx, id)
+}
+
+// ImportProofs stores the proofs in the cache and pushes them to the central
+// archive. The import fails if the central archive can't be reached, so no
+// proof is ever only stored locally.
+//
+// NOTE: This implements the Archiver interface.
+func (f *FederatedArchiver) ImportProofs(ctx context.Context,
+	headerVerifier HeaderVerifier, replace bool,
+	proofs ...*AnnotatedProof) error {
+
+	err := f.cache.ImportProofs(ctx, headerVerifier, replace, proofs...)
+	if err != nil {
+		return err
+	}
+
+	// A proof that is replaced might not be in the central archive yet,
+	// if it was imported before the node joined the federation, so we
+	// always push it as a new proof.
+	err = f.central.ImportProofs(ctx, headerVerifier, false, proofs...)
+	if err != nil {
+		return fmt.Errorf("unable to push proofs to central archive: "+
+			"%w", err)
+	}
+
+	return nil
+}
+
+// A compile-time assertion to make sure FederatedArchiver satisfies the
+// Archiver interface.
+var _ Archiver = (*FederatedArchiver)(nil)
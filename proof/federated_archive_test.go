@@ -0,0 +1,85 @@
+package proof
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFederatedArchiver tests that proofs are written through to the central
+// archive and that proofs missing locally are pulled from it and cached.
+func TestFederatedArchiver(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	users := NewUserArchives(t.TempDir())
+	central, err := users.ForUser("alice")
+	require.NoError(t, err)
+
+	cache, err := NewFileArchiver(t.TempDir())
+	require.NoError(t, err)
+
+	archive := NewFederatedArchiver(cache, central)
+
+	loc := Locator{AssetID: randAssetID(t), ScriptKey: *test.RandPubKey(t)}
+	blob := Blob(test.RandBytes(100))
+	err = archive.ImportProofs(ctx, nil, false, &AnnotatedProof{
+		Locator: loc,
+		Blob:    blob,
+	})
+	require.NoError(t, err)
+
+	// The proof was pushed to the central archive of the user.
+	proof, err := central.FetchProof(ctx, loc)
+	require.NoError(t, err)
+	require.Equal(t, blob, proof)
+
+	// A fresh node of the same user starts with an empty cache, but can
+	// still fetch the proof, which is then cached.
+	freshCache, err := NewFileArchiver(t.TempDir())
+	require.NoError(t, err)
+	freshArchive := NewFederatedArchiver(freshCache, central)
+
+	proof, err = freshArchive.FetchProof(ctx, loc)
+	require.NoError(t, err)
+	require.Equal(t, blob, proof)
+
+	proof, err = freshCache.FetchProof(ctx, loc)
+	require.NoError(t, err)
+	require.Equal(t, blob, proof)
+
+	// Replacing the proof updates the central copy too.
+	newBlob := Blob(test.RandBytes(100))
+	err = freshArchive.ImportProofs(ctx, nil, true, &AnnotatedProof{
+		Locator: loc,
+		Blob:    newBlob,
+	})
+	require.NoError(t, err)
+
+	proof, err = central.FetchProof(ctx, loc)
+	require.NoError(t, err)
+	require.Equal(t, newBlob, proof)
+
+	// Proofs of other users aren't visible.
+	otherCentral, err := users.ForUser("bob")
+	require.NoError(t, err)
+	otherArchive := NewFederatedArchiver(freshCache, otherCentral)
+	_, err = otherArchive.FetchProof(ctx, Locator{
+		AssetID:   randAssetID(t),
+		ScriptKey: loc.ScriptKey,
+	})
+	require.ErrorIs(t, err, ErrProofNotFound)
+
+	_, err = otherCentral.FetchProof(ctx, loc)
+	require.ErrorIs(t, err, ErrProofNotFound)
+
+	// User names are used as directory names, so only a safe subset of
+	// characters is allowed.
+	for _, user := range []string{"", "../alice", "a/b", "al ice"} {
+		_, err := users.ForUser(user)
+		require.Error(t, err, user)
+	}
+}
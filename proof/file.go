@@ -137,6 +137,14 @@ func (f *File) Encode(w io.Writer) error {
 
 // Decode decodes a proof file from `r`.
 func (f *File) Decode(r io.Reader) error {
+	return f.DecodeWithLimits(r, FileLimits{})
+}
+
+// DecodeWithLimits decodes a proof file from `r`, making sure it doesn't
+// exceed the given limits. The limits are checked before any memory for the
+// proofs is allocated, so files from untrusted sources should be decoded with
+// this method.
+func (f *File) DecodeWithLimits(r io.Reader, limits FileLimits) error {
 	var version uint32
 	if err := binary.Read(r, binary.BigEndian, &version); err != nil {
 		return err
@@ -148,6 +156,13 @@ func (f *File) Decode(r io.Reader) error {
 	if err != nil {
 		return err
 	}
+	if err := limits.CheckNumProofs(numProofs); err != nil {
+		return err
+	}
+
+	// We keep track of the number of bytes read so far, so we can reject
+	// an oversized file before allocating memory for the next proof.
+	numBytes := uint64(4 + tlv.VarIntSize(numProofs))
 
 	var prevHash, currentHash, proofHash [sha256.Size]byte
 	f.proofs = make([]*hashedProof, numProofs)
@@ -159,6 +174,17 @@ func (f *File) Decode(r io.Reader) error {
 			return err
 		}
 
+		// The proof length is checked on its own first, so a bogus
+		// length can't overflow the running total.
+		if err := limits.CheckSize(numProofBytes); err != nil {
+			return err
+		}
+		numBytes += tlv.VarIntSize(numProofBytes) + numProofBytes +
+			sha256.Size
+		if err := limits.CheckSize(numBytes); err != nil {
+			return err
+		}
+
 		// Read all bytes that belong to the proof. We don't decode the
 		// proof itself as we usually only need the last proof anyway.
 		proofBytes := make([]byte, numProofBytes)
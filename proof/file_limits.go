@@ -0,0 +1,101 @@
+package proof
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// DefaultMaxFileSize is the default maximum size in bytes of a proof
+	// file that is accepted from an untrusted source.
+	DefaultMaxFileSize = DefaultMaxChunkedProofSize
+
+	// DefaultMaxFileProofs is the default maximum number of state
+	// transitions of a proof file that is accepted from an untrusted
+	// source.
+	DefaultMaxFileProofs = 100_000
+)
+
+var (
+	// ErrProofFileTooLarge is returned if a proof file or proof exceeds the
+	// configured maximum size.
+	ErrProofFileTooLarge = errors.New("proof file too large")
+
+	// ErrTooManyProofs is returned if a proof file contains more state
+	// transitions than the configured maximum.
+	ErrTooManyProofs = errors.New("too many proofs in proof file")
+)
+
+// FileLimits bounds the resources a proof file from an untrusted source may
+// use, so a maliciously long history can't exhaust the memory of the node.
+// The zero value doesn't limit anything.
+type FileLimits struct {
+	// MaxFileSize is the maximum size in bytes of an encoded proof file or
+	// a single encoded proof. 0 means no limit.
+	MaxFileSize uint64
+
+	// MaxNumProofs is the maximum number of state transitions in a proof
+	// file. 0 means no limit.
+	MaxNumProofs uint64
+}
+
+// DefaultFileLimits returns the default proof file limits.
+func DefaultFileLimits() FileLimits {
+	return FileLimits{
+		MaxFileSize:  DefaultMaxFileSize,
+		MaxNumProofs: DefaultMaxFileProofs,
+	}
+}
+
+// CheckSize returns ErrProofFileTooLarge if the given number of bytes exceeds
+// the maximum file size.
+func (l FileLimits) CheckSize(numBytes uint64) error {
+	if l.MaxFileSize != 0 && numBytes > l.MaxFileSize {
+		return fmt.Errorf("%w: %d bytes exceed the maximum of %d bytes",
+			ErrProofFileTooLarge, numBytes, l.MaxFileSize)
+	}
+
+	return nil
+}
+
+// CheckNumProofs returns ErrTooManyProofs if the given number of state
+// transitions exceeds the maximum.
+func (l FileLimits) CheckNumProofs(numProofs uint64) error {
+	if l.MaxNumProofs != 0 && numProofs > l.MaxNumProofs {
+		return fmt.Errorf("%w: %d proofs exceed the maximum of %d",
+			ErrTooManyProofs, numProofs, l.MaxNumProofs)
+	}
+
+	return nil
+}
+
+// CheckFile checks the size of the given encoded proof file and the number of
+// state transitions announced in its header, without decoding the proofs.
+func (l FileLimits) CheckFile(blob Blob) error {
+	if err := l.CheckSize(uint64(len(blob))); err != nil {
+		return err
+	}
+
+	if l.MaxNumProofs == 0 {
+		return nil
+	}
+
+	r := bytes.NewReader(blob)
+
+	var version uint32
+	if err := binary.Read(r, binary.BigEndian, &version); err != nil {
+		return fmt.Errorf("unable to read proof file version: %w", err)
+	}
+
+	var tlvBuf [8]byte
+	numProofs, err := tlv.ReadVarInt(r, &tlvBuf)
+	if err != nil {
+		return fmt.Errorf("unable to read number of proofs: %w", err)
+	}
+
+	return l.CheckNumProofs(numProofs)
+}
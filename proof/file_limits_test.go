@@ -0,0 +1,79 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestFileLimits tests that proof files exceeding the configured limits are
+// rejected with the matching error, both when checking the encoded file and
+// when decoding or verifying it.
+func TestFileLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := loadProofFile(t)
+
+	var buf bytes.Buffer
+	require.NoError(t, f.Encode(&buf))
+	blob := Blob(buf.Bytes())
+	numProofs := uint64(f.NumProofs())
+
+	exactLimits := FileLimits{
+		MaxFileSize:  uint64(len(blob)),
+		MaxNumProofs: numProofs,
+	}
+	tooSmall := FileLimits{
+		MaxFileSize: uint64(len(blob)) - 1,
+	}
+	tooFew := FileLimits{
+		MaxNumProofs: numProofs - 1,
+	}
+
+	// The file fits into exactly matching limits and into no limits at
+	// all.
+	for _, limits := range []FileLimits{{}, exactLimits} {
+		require.NoError(t, limits.CheckFile(blob))
+
+		var decoded File
+		err := decoded.DecodeWithLimits(bytes.NewReader(blob), limits)
+		require.NoError(t, err)
+		require.Equal(t, f.NumProofs(), decoded.NumProofs())
+	}
+
+	// Going over any of the limits by one fails.
+	require.ErrorIs(t, tooSmall.CheckFile(blob), ErrProofFileTooLarge)
+	require.ErrorIs(t, tooFew.CheckFile(blob), ErrTooManyProofs)
+
+	var decoded File
+	err := decoded.DecodeWithLimits(bytes.NewReader(blob), tooSmall)
+	require.ErrorIs(t, err, ErrProofFileTooLarge)
+
+	err = decoded.DecodeWithLimits(bytes.NewReader(blob), tooFew)
+	require.ErrorIs(t, err, ErrTooManyProofs)
+
+	// The verifier pool enforces the limits on encoded and decoded files.
+	pool := NewVerifierPool(1, WithFileLimits(tooFew))
+	_, err = pool.Verify(ctx, bytes.NewReader(blob), MockHeaderVerifier)
+	require.ErrorIs(t, err, ErrTooManyProofs)
+
+	_, err = pool.VerifyFile(ctx, f, MockHeaderVerifier)
+	require.ErrorIs(t, err, ErrTooManyProofs)
+
+	// A file that announces a huge proof is rejected before the memory
+	// for it is allocated.
+	var hugeProof bytes.Buffer
+	require.NoError(t, NewEmptyFile(V0).Encode(&hugeProof))
+	header := hugeProof.Bytes()
+	header[len(header)-1] = 1
+	header = append(header, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff)
+
+	err = decoded.DecodeWithLimits(
+		bytes.NewReader(header), DefaultFileLimits(),
+	)
+	require.ErrorIs(t, err, ErrProofFileTooLarge)
+}
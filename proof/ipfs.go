@@ -0,0 +1,269 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultIPFSTimeout is the default timeout for requests to the IPFS
+	// node.
+	DefaultIPFSTimeout = time.Minute
+
+	// DefaultIPFSMaxBlobSize is the default maximum size in bytes of a
+	// blob that is fetched from IPFS.
+	DefaultIPFSMaxBlobSize = 64 * 1024 * 1024
+
+	// ipfsURIScheme is the URI scheme of content pinned to IPFS.
+	ipfsURIScheme = "ipfs://"
+)
+
+// ipfsProofRefPrefix is the prefix of a mailbox message that references a
+// proof pinned to IPFS instead of carrying the proof itself.
+var ipfsProofRefPrefix = []byte("ipfs-proof:")
+
+// ErrIPFSBlobTooLarge is returned if a blob fetched from IPFS exceeds the
+// configured maximum size.
+var ErrIPFSBlobTooLarge = errors.New("IPFS blob too large")
+
+// IPFSCfg is the config for the connection to an IPFS node.
+type IPFSCfg struct {
+	APIAddr string `long:"apiaddr" description:"The base URL of the HTTP RPC API of the IPFS node used to pin proofs and meta data, for example http://localhost:5001. If not set, IPFS support is disabled."`
+
+	Timeout time.Duration `long:"timeout" description:"The maximum time to wait for a request to the IPFS node to complete."`
+
+	MaxBlobSize int64 `long:"maxblobsize" description:"The maximum size in bytes of a blob fetched from IPFS."`
+}
+
+// IPFSClient is a minimal client of the HTTP RPC API of an IPFS node that can
+// pin and resolve blobs by their content ID.
+type IPFSClient struct {
+	cfg *IPFSCfg
+
+	client *http.Client
+}
+
+// NewIPFSClient creates a new IPFS client from the given config.
+func NewIPFSClient(cfg *IPFSCfg) (*IPFSClient, error) {
+	if _, err := url.Parse(cfg.APIAddr); err != nil {
+		return nil, fmt.Errorf("invalid IPFS API address: %w", err)
+	}
+
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = DefaultIPFSTimeout
+	}
+
+	return &IPFSClient{
+		cfg: cfg,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}, nil
+}
+
+// ipfsAddResponse is the response of the IPFS add API call.
+type ipfsAddResponse struct {
+	Hash string `json:"Hash"`
+}
+
+// Pin adds the given blob to IPFS, pins it and returns its content ID.
+func (c *IPFSClient) Pin(ctx context.Context, blob []byte) (string, error) {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("file", "blob")
+	if err != nil {
+		return "", err
+	}
+	if _, err := part.Write(blob); err != nil {
+		return "", err
+	}
+	if err := form.Close(); err != nil {
+		return "", err
+	}
+
+	resp, err := c.call(
+		ctx, "add", url.Values{
+			"pin":         []string{"true"},
+			"cid-version": []string{"1"},
+		}, &body, form.FormDataContentType(),
+	)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var addResp ipfsAddResponse
+	if err := json.NewDecoder(resp.Body).Decode(&addResp); err != nil {
+		return "", fmt.Errorf("unable to decode IPFS response: %w", err)
+	}
+	if addResp.Hash == "" {
+		return "", fmt.Errorf("IPFS node returned no CID")
+	}
+
+	return addResp.Hash, nil
+}
+
+// Fetch returns the blob with the given content ID.
+func (c *IPFSClient) Fetch(ctx context.Context, cid string) ([]byte, error) {
+	resp, err := c.call(
+		ctx, "cat", url.Values{"arg": []string{cid}}, nil, "",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	maxSize := c.cfg.MaxBlobSize
+	if maxSize <= 0 {
+		maxSize = DefaultIPFSMaxBlobSize
+	}
+
+	blob, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read IPFS blob: %w", err)
+	}
+	if int64(len(blob)) > maxSize {
+		return nil, fmt.Errorf("%w: more than %d bytes",
+			ErrIPFSBlobTooLarge, maxSize)
+	}
+
+	return blob, nil
+}
+
+// call executes the given IPFS API command. The caller must close the body of
+// the returned response.
+func (c *IPFSClient) call(ctx context.Context, command string,
+	params url.Values, body io.Reader, contentType string) (*http.Response,
+	error) {
+
+	apiURL := strings.TrimSuffix(c.cfg.APIAddr, "/") + "/api/v0/" +
+		command + "?" + params.Encode()
+
+	// The IPFS RPC API only accepts POST requests.
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, apiURL, body,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create IPFS request: %w", err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("IPFS %v request failed: %w", command,
+			err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		resp.Body.Close()
+
+		return nil, fmt.Errorf("IPFS %v request failed: %v: %s",
+			command, resp.Status, msg)
+	}
+
+	return resp, nil
+}
+
+// PinURIMeta pins the given content to IPFS and returns a meta reveal of type
+// MetaURI that references it. This allows large collectible content to be
+// committed to by an asset without being part of its proofs.
+func PinURIMeta(ctx context.Context, client *IPFSClient,
+	content []byte) (*MetaReveal, error) {
+
+	cid, err := client.Pin(ctx, content)
+	if err != nil {
+		return nil, fmt.Errorf("unable to pin meta content: %w", err)
+	}
+
+	return NewURIMetaReveal(ipfsURIScheme+cid, sha256.Sum256(content))
+}
+
+// IPFSMailBox is a ProofMailbox that moves the proofs themselves through IPFS
+// and only exchanges references to them over an underlying mailbox. This keeps
+// large proofs out of the mailbox service. Proofs that are sent over the
+// underlying mailbox directly by senders that don't use IPFS are still read
+// as is.
+type IPFSMailBox struct {
+	ProofMailbox
+
+	client *IPFSClient
+
+	pinProofs bool
+}
+
+// NewIPFSMailBox wraps the given mailbox so that proofs referenced by IPFS
+// content IDs can be received. If pinProofs is true, outgoing proofs are also
+// pinned to IPFS and only referenced in the underlying mailbox.
+func NewIPFSMailBox(mailbox ProofMailbox, client *IPFSClient,
+	pinProofs bool) *IPFSMailBox {
+
+	return &IPFSMailBox{
+		ProofMailbox: mailbox,
+		client:       client,
+		pinProofs:    pinProofs,
+	}
+}
+
+// WriteProof writes the proof to the mailbox specified by the sid.
+func (i *IPFSMailBox) WriteProof(ctx context.Context, sid streamID,
+	proof Blob) error {
+
+	if !i.pinProofs {
+		return i.ProofMailbox.WriteProof(ctx, sid, proof)
+	}
+
+	cid, err := i.client.Pin(ctx, proof)
+	if err != nil {
+		return fmt.Errorf("unable to pin proof: %w", err)
+	}
+
+	log.Infof("Pinned proof to IPFS with cid=%v", cid)
+
+	ref := append(append([]byte{}, ipfsProofRefPrefix...), cid...)
+	return i.ProofMailbox.WriteProof(ctx, sid, ref)
+}
+
+// ReadProof reads a proof from the mailbox. If the mailbox only contains a
+// reference to a proof pinned to IPFS, the proof is resolved from there. This
+// is a blocking method.
+func (i *IPFSMailBox) ReadProof(ctx context.Context,
+	sid streamID) (Blob, error) {
+
+	msg, err := i.ProofMailbox.ReadProof(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.HasPrefix(msg, ipfsProofRefPrefix) {
+		return msg, nil
+	}
+
+	cid := string(msg[len(ipfsProofRefPrefix):])
+	log.Infof("Resolving proof from IPFS with cid=%v", cid)
+
+	proof, err := i.client.Fetch(ctx, cid)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch proof from IPFS: %w",
+			err)
+	}
+
+	return proof, nil
+}
+
+// A compile-time assertion to ensure that the IPFSMailBox meets the
+// ProofMailbox interface.
+var _ ProofMailbox = (*IPFSMailBox)(nil)
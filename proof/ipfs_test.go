@@ -0,0 +1,214 @@
+package proof
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockIPFSNode is a minimal in-memory implementation of the add and cat calls
+// of the IPFS HTTP RPC API.
+type mockIPFSNode struct {
+	sync.Mutex
+
+	blobs map[string][]byte
+}
+
+func (m *mockIPFSNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.Lock()
+	defer m.Unlock()
+
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch r.URL.Path {
+	case "/api/v0/add":
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		blob, err := io.ReadAll(file)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		hash := sha256.Sum256(blob)
+		cid := "bafk" + hex.EncodeToString(hash[:8])
+		m.blobs[cid] = blob
+
+		_ = json.NewEncoder(w).Encode(ipfsAddResponse{Hash: cid})
+
+	case "/api/v0/cat":
+		blob, ok := m.blobs[r.URL.Query().Get("arg")]
+		if !ok {
+			http.Error(w, "not found", http.StatusInternalServerError)
+			return
+		}
+
+		_, _ = w.Write(blob)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// memMailBox is a simple in-memory ProofMailbox that stores the last message
+// written to each stream.
+type memMailBox struct {
+	sync.Mutex
+
+	msgs map[streamID]Blob
+
+	cleanedUp []streamID
+}
+
+func (m *memMailBox) Init(context.Context, streamID) error {
+	return nil
+}
+
+func (m *memMailBox) WriteProof(_ context.Context, sid streamID,
+	proof Blob) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.msgs[sid] = proof
+	return nil
+}
+
+func (m *memMailBox) ReadProof(_ context.Context, sid streamID) (Blob, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.msgs[sid], nil
+}
+
+func (m *memMailBox) AckProof(context.Context, streamID) error {
+	return nil
+}
+
+func (m *memMailBox) RecvAck(context.Context, streamID) error {
+	return nil
+}
+
+func (m *memMailBox) CleanUp(_ context.Context, sid streamID) error {
+	m.Lock()
+	defer m.Unlock()
+
+	delete(m.msgs, sid)
+	m.cleanedUp = append(m.cleanedUp, sid)
+	return nil
+}
+
+// TestIPFSMailBox tests that proofs are moved through IPFS and only referenced
+// in the underlying mailbox, and that plain proofs can still be read.
+func TestIPFSMailBox(t *testing.T) {
+	t.Parallel()
+
+	node := &mockIPFSNode{blobs: make(map[string][]byte)}
+	server := httptest.NewServer(node)
+	defer server.Close()
+
+	client, err := NewIPFSClient(&IPFSCfg{APIAddr: server.URL})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	inner := &memMailBox{msgs: make(map[streamID]Blob)}
+	sender := NewIPFSMailBox(inner, client, true)
+	receiver := NewIPFSMailBox(inner, client, false)
+
+	var sid streamID
+	copy(sid[:], "ipfs-test-stream")
+
+	// A pinned proof is only referenced in the underlying mailbox and is
+	// resolved on the receiving side.
+	proofBlob := Blob("pretend this is a large proof file")
+	require.NoError(t, sender.WriteProof(ctx, sid, proofBlob))
+	require.NotEqual(t, proofBlob, inner.msgs[sid])
+	require.Len(t, node.blobs, 1)
+
+	received, err := receiver.ReadProof(ctx, sid)
+	require.NoError(t, err)
+	require.Equal(t, proofBlob, received)
+
+	// A proof written without IPFS is read as is.
+	require.NoError(t, receiver.WriteProof(ctx, sid, proofBlob))
+	require.Equal(t, proofBlob, inner.msgs[sid])
+
+	received, err = receiver.ReadProof(ctx, sid)
+	require.NoError(t, err)
+	require.Equal(t, proofBlob, received)
+
+	// A reference to an unknown CID can't be resolved.
+	inner.msgs[sid] = append(
+		append(Blob{}, ipfsProofRefPrefix...), "bafkunknown"...,
+	)
+	_, err = receiver.ReadProof(ctx, sid)
+	require.ErrorContains(t, err, "unable to fetch proof from IPFS")
+
+	// Blobs above the maximum size are rejected.
+	limited, err := NewIPFSClient(&IPFSCfg{
+		APIAddr:     server.URL,
+		MaxBlobSize: 4,
+	})
+	require.NoError(t, err)
+	cid, err := client.Pin(ctx, proofBlob)
+	require.NoError(t, err)
+	_, err = limited.Fetch(ctx, cid)
+	require.ErrorIs(t, err, ErrIPFSBlobTooLarge)
+}
+
+// TestPinURIMeta tests that meta content pinned to IPFS can be resolved and
+// verified by the meta fetcher.
+func TestPinURIMeta(t *testing.T) {
+	t.Parallel()
+
+	node := &mockIPFSNode{blobs: make(map[string][]byte)}
+	server := httptest.NewServer(node)
+	defer server.Close()
+
+	client, err := NewIPFSClient(&IPFSCfg{APIAddr: server.URL})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	content := []byte("large collectible content")
+	meta, err := PinURIMeta(ctx, client, content)
+	require.NoError(t, err)
+
+	uriMeta, err := meta.URIMeta()
+	require.NoError(t, err)
+	require.Equal(t, sha256.Sum256(content), uriMeta.ContentHash)
+
+	// The gateway of the mock node serves the pinned content.
+	gateway := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			node.Lock()
+			defer node.Unlock()
+
+			cid := r.URL.Path[len("/ipfs/"):]
+			_, _ = w.Write(node.blobs[cid])
+		},
+	))
+	defer gateway.Close()
+
+	fetcher, err := NewMetaFetcher(&MetaFetcherCfg{
+		IPFSGateway: gateway.URL,
+	}, t.TempDir())
+	require.NoError(t, err)
+
+	fetched, err := fetcher.Fetch(ctx, meta)
+	require.NoError(t, err)
+	require.Equal(t, content, fetched.Content)
+}
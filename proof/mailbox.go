@@ -0,0 +1,385 @@
+package proof
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultMailboxExpiry is the default time after its last use an
+	// unclaimed mailbox is removed from the courier server.
+	DefaultMailboxExpiry = 7 * 24 * time.Hour
+
+	// DefaultMailboxClaimedExpiry is the default time after a mailbox was
+	// claimed it is removed from the courier server, if the other party
+	// didn't remove it already.
+	DefaultMailboxClaimedExpiry = time.Hour
+
+	// DefaultMailboxMaxMessages is the default maximum number of messages
+	// we write to a single mailbox.
+	DefaultMailboxMaxMessages = 100
+
+	// DefaultMailboxMaxBytes is the default maximum number of bytes we
+	// write to a single mailbox.
+	DefaultMailboxMaxBytes = 64 * 1024 * 1024
+)
+
+var (
+	// ErrMailboxQuotaExceeded is returned if writing a message to a
+	// mailbox would exceed its quota.
+	ErrMailboxQuotaExceeded = errors.New("mailbox quota exceeded")
+
+	// ErrMailboxAuthChallenge is returned if the courier server asks us to
+	// refresh the authentication of a mailbox.
+	ErrMailboxAuthChallenge = errors.New("mailbox authentication " +
+		"challenge received")
+
+	// ErrMailboxAuthFailed is returned if the courier server rejected the
+	// authentication of a mailbox.
+	ErrMailboxAuthFailed = errors.New("mailbox authentication failed")
+)
+
+// isErrNotFound returns true if the passed error is the "not found" error
+// returned by the hash mail server if a stream doesn't exist (anymore).
+func isErrNotFound(err error) bool {
+	statusCode, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+
+	return statusCode.Code() == codes.NotFound
+}
+
+// MailboxManagerCfg is the config for the mailbox manager.
+type MailboxManagerCfg struct {
+	// Expiry is the time after its last use an unclaimed mailbox is
+	// expired. Zero means unclaimed mailboxes never expire.
+	Expiry time.Duration
+
+	// ClaimedExpiry is the time after a mailbox was claimed it is
+	// expired.
+	ClaimedExpiry time.Duration
+
+	// MaxMessages is the maximum number of messages written to a single
+	// mailbox. Zero means no limit.
+	MaxMessages int
+
+	// MaxBytes is the maximum number of bytes written to a single
+	// mailbox. Zero means no limit.
+	MaxBytes int64
+
+	// Clock is the clock used to determine when mailboxes expire.
+	Clock clock.Clock
+}
+
+// MailboxInfo describes a mailbox created on the courier server.
+type MailboxInfo struct {
+	// StreamID is the ID of the mailbox's stream.
+	StreamID [64]byte
+
+	// CreatedAt is the time the mailbox was created.
+	CreatedAt time.Time
+
+	// LastUsed is the time the mailbox was last initialized, written to or
+	// read from.
+	LastUsed time.Time
+
+	// ClaimedAt is the time the message of the mailbox was received by
+	// the other party. It is the zero time if the mailbox is unclaimed.
+	ClaimedAt time.Time
+
+	// NumMessages is the number of messages written to the mailbox.
+	NumMessages int
+
+	// NumBytes is the number of bytes written to the mailbox.
+	NumBytes int64
+
+	// numWaiters is the number of reads that currently wait for a message
+	// of the mailbox. A mailbox with waiters never expires.
+	numWaiters int
+}
+
+// Claimed returns true if the message of the mailbox was received.
+func (m *MailboxInfo) Claimed() bool {
+	return !m.ClaimedAt.IsZero()
+}
+
+// MailboxManager is a ProofMailbox that keeps track of the mailboxes that are
+// created on the courier server through it. It enforces a quota on each of
+// them, and removes mailboxes from the server once they were claimed or
+// weren't used for a while, so a long-running node doesn't leak mailboxes on
+// the courier server if a transfer is never completed.
+type MailboxManager struct {
+	ProofMailbox
+
+	cfg *MailboxManagerCfg
+
+	// mtx guards the map below.
+	mtx sync.Mutex
+
+	// mailboxes are the mailboxes we created, keyed by their stream ID.
+	mailboxes map[streamID]*MailboxInfo
+}
+
+// NewMailboxManager creates a new mailbox manager that manages the mailboxes
+// of the given underlying mailbox.
+func NewMailboxManager(mailbox ProofMailbox,
+	cfg *MailboxManagerCfg) *MailboxManager {
+
+	return &MailboxManager{
+		ProofMailbox: mailbox,
+		cfg:          cfg,
+		mailboxes:    make(map[streamID]*MailboxInfo),
+	}
+}
+
+// Init creates a mailbox given the specified stream ID. Before the mailbox is
+// created, all expired mailboxes are removed from the courier server.
+func (m *MailboxManager) Init(ctx context.Context, sid streamID) error {
+	if _, err := m.ExpireMailboxes(ctx); err != nil {
+		log.Warnf("Unable to expire mailboxes: %v", err)
+	}
+
+	if err := m.ProofMailbox.Init(ctx, sid); err != nil {
+		return err
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	now := m.cfg.Clock.Now()
+	info, ok := m.mailboxes[sid]
+	if !ok {
+		info = &MailboxInfo{
+			StreamID:  sid,
+			CreatedAt: now,
+		}
+		m.mailboxes[sid] = info
+	}
+	info.LastUsed = now
+
+	return nil
+}
+
+// checkQuota returns ErrMailboxQuotaExceeded if writing a message of the given
+// size to the mailbox would exceed the quota of the mailbox.
+func (m *MailboxManager) checkQuota(sid streamID, numBytes int) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	// Mailboxes that weren't created through us aren't managed.
+	info, ok := m.mailboxes[sid]
+	if !ok {
+		return nil
+	}
+
+	newBytes := info.NumBytes + int64(numBytes)
+	switch {
+	case m.cfg.MaxMessages > 0 && info.NumMessages >= m.cfg.MaxMessages:
+		return fmt.Errorf("%w: mailbox %x already has %d messages",
+			ErrMailboxQuotaExceeded, sid[:], info.NumMessages)
+
+	case m.cfg.MaxBytes > 0 && newBytes > m.cfg.MaxBytes:
+		return fmt.Errorf("%w: writing %d bytes to mailbox %x exceeds "+
+			"the limit of %d bytes", ErrMailboxQuotaExceeded,
+			numBytes, sid[:], m.cfg.MaxBytes)
+	}
+
+	return nil
+}
+
+// recordWrite accounts for a message of the given size that was written to the
+// mailbox.
+func (m *MailboxManager) recordWrite(sid streamID, numBytes int) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	info, ok := m.mailboxes[sid]
+	if !ok {
+		return
+	}
+
+	info.NumMessages++
+	info.NumBytes += int64(numBytes)
+	info.LastUsed = m.cfg.Clock.Now()
+}
+
+// WriteProof writes the proof to the mailbox specified by the sid, if the
+// proof doesn't exceed the quota of the mailbox.
+func (m *MailboxManager) WriteProof(ctx context.Context, sid streamID,
+	proof Blob) error {
+
+	if err := m.checkQuota(sid, len(proof)); err != nil {
+		return err
+	}
+
+	if err := m.ProofMailbox.WriteProof(ctx, sid, proof); err != nil {
+		return err
+	}
+	m.recordWrite(sid, len(proof))
+
+	return nil
+}
+
+// AckProof sends an ACK from the receiver to the sender that a proof has been
+// received, if the ACK doesn't exceed the quota of the mailbox.
+func (m *MailboxManager) AckProof(ctx context.Context, sid streamID) error {
+	if err := m.checkQuota(sid, len(ackMsg)); err != nil {
+		return err
+	}
+
+	if err := m.ProofMailbox.AckProof(ctx, sid); err != nil {
+		return err
+	}
+	m.recordWrite(sid, len(ackMsg))
+
+	return nil
+}
+
+// wait marks the mailbox as being read from until the returned function is
+// called. On success, the mailbox is marked as claimed.
+func (m *MailboxManager) wait(sid streamID) func(success bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	info, ok := m.mailboxes[sid]
+	if !ok {
+		return func(bool) {}
+	}
+	info.numWaiters++
+
+	return func(success bool) {
+		m.mtx.Lock()
+		defer m.mtx.Unlock()
+
+		now := m.cfg.Clock.Now()
+		info.numWaiters--
+		info.LastUsed = now
+		if success && !info.Claimed() {
+			info.ClaimedAt = now
+		}
+	}
+}
+
+// ReadProof reads a proof from the mailbox. This is a blocking method. Once
+// the proof was read, the mailbox is claimed.
+func (m *MailboxManager) ReadProof(ctx context.Context,
+	sid streamID) (Blob, error) {
+
+	done := m.wait(sid)
+
+	proof, err := m.ProofMailbox.ReadProof(ctx, sid)
+	done(err == nil)
+
+	return proof, err
+}
+
+// RecvAck waits for the sender to receive the ack from the receiver. Once the
+// ACK was received, the mailbox is claimed.
+func (m *MailboxManager) RecvAck(ctx context.Context, sid streamID) error {
+	done := m.wait(sid)
+
+	err := m.ProofMailbox.RecvAck(ctx, sid)
+	done(err == nil)
+
+	return err
+}
+
+// CleanUp removes the mailbox specified by the passed sid from the courier
+// server and stops tracking it.
+func (m *MailboxManager) CleanUp(ctx context.Context, sid streamID) error {
+	if err := m.ProofMailbox.CleanUp(ctx, sid); err != nil {
+		return err
+	}
+
+	m.mtx.Lock()
+	delete(m.mailboxes, sid)
+	m.mtx.Unlock()
+
+	return nil
+}
+
+// ListMailboxes returns the mailboxes that are currently tracked, ordered by
+// their creation time.
+func (m *MailboxManager) ListMailboxes() []MailboxInfo {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	mailboxes := make([]MailboxInfo, 0, len(m.mailboxes))
+	for _, info := range m.mailboxes {
+		mailboxes = append(mailboxes, *info)
+	}
+	sort.Slice(mailboxes, func(i, j int) bool {
+		return mailboxes[i].CreatedAt.Before(mailboxes[j].CreatedAt)
+	})
+
+	return mailboxes
+}
+
+// ExpireMailbox removes the mailbox with the given stream ID from the courier
+// server, regardless of whether it is expired. A mailbox that was already
+// removed by the other party is expired as well.
+func (m *MailboxManager) ExpireMailbox(ctx context.Context,
+	sid [64]byte) error {
+
+	err := m.CleanUp(ctx, sid)
+	if isErrNotFound(err) {
+		m.mtx.Lock()
+		delete(m.mailboxes, sid)
+		m.mtx.Unlock()
+
+		return nil
+	}
+
+	return err
+}
+
+// ExpireMailboxes removes all mailboxes from the courier server that were
+// claimed or weren't used for longer than their expiry. Mailboxes that are
+// currently being read from are never expired. The number of expired
+// mailboxes is returned.
+func (m *MailboxManager) ExpireMailboxes(ctx context.Context) (int, error) {
+	m.mtx.Lock()
+	now := m.cfg.Clock.Now()
+	var expired []streamID
+	for sid, info := range m.mailboxes {
+		switch {
+		case info.numWaiters > 0:
+			continue
+
+		case info.Claimed():
+			if now.Sub(info.ClaimedAt) >= m.cfg.ClaimedExpiry {
+				expired = append(expired, sid)
+			}
+
+		case m.cfg.Expiry > 0:
+			if now.Sub(info.LastUsed) >= m.cfg.Expiry {
+				expired = append(expired, sid)
+			}
+		}
+	}
+	m.mtx.Unlock()
+
+	for idx, sid := range expired {
+		log.Debugf("Expiring mailbox with sid=%x", sid[:])
+
+		if err := m.ExpireMailbox(ctx, sid); err != nil {
+			return idx, fmt.Errorf("unable to expire mailbox "+
+				"%x: %w", sid[:], err)
+		}
+	}
+
+	return len(expired), nil
+}
+
+// A compile-time assertion to ensure that the MailboxManager meets the
+// ProofMailbox interface.
+var _ ProofMailbox = (*MailboxManager)(nil)
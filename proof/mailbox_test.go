@@ -0,0 +1,92 @@
+package proof
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMailboxManager tests that the mailbox manager enforces the quota of its
+// mailboxes and expires them once they were claimed or weren't used for a
+// while.
+func TestMailboxManager(t *testing.T) {
+	t.Parallel()
+
+	const (
+		expiry        = time.Hour
+		claimedExpiry = time.Minute
+	)
+
+	ctx := context.Background()
+	backend := &memMailBox{
+		msgs: make(map[streamID]Blob),
+	}
+	testClock := clock.NewTestClock(time.Unix(1_000_000, 0))
+	manager := NewMailboxManager(backend, &MailboxManagerCfg{
+		Expiry:        expiry,
+		ClaimedExpiry: claimedExpiry,
+		MaxMessages:   2,
+		MaxBytes:      10,
+		Clock:         testClock,
+	})
+
+	sidA := streamID{0x01}
+	sidB := streamID{0x02}
+	require.NoError(t, manager.Init(ctx, sidA))
+
+	testClock.SetTime(testClock.Now().Add(time.Second))
+	require.NoError(t, manager.Init(ctx, sidB))
+
+	mailboxes := manager.ListMailboxes()
+	require.Len(t, mailboxes, 2)
+	require.Equal(t, [64]byte(sidA), mailboxes[0].StreamID)
+	require.Equal(t, [64]byte(sidB), mailboxes[1].StreamID)
+
+	// Messages are only written as long as they fit into the quota.
+	require.NoError(t, manager.WriteProof(ctx, sidA, Blob("proof")))
+	err := manager.WriteProof(ctx, sidA, Blob("too large"))
+	require.ErrorIs(t, err, ErrMailboxQuotaExceeded)
+
+	require.NoError(t, manager.WriteProof(ctx, sidA, Blob("proof")))
+	err = manager.WriteProof(ctx, sidA, Blob("p"))
+	require.ErrorIs(t, err, ErrMailboxQuotaExceeded)
+
+	mailboxes = manager.ListMailboxes()
+	require.Equal(t, 2, mailboxes[0].NumMessages)
+	require.EqualValues(t, 10, mailboxes[0].NumBytes)
+
+	// Reading the proof claims the mailbox, which is then expired after
+	// the claimed expiry.
+	proof, err := manager.ReadProof(ctx, sidA)
+	require.NoError(t, err)
+	require.Equal(t, Blob("proof"), proof)
+	require.True(t, manager.ListMailboxes()[0].Claimed())
+
+	numExpired, err := manager.ExpireMailboxes(ctx)
+	require.NoError(t, err)
+	require.Zero(t, numExpired)
+
+	testClock.SetTime(testClock.Now().Add(claimedExpiry))
+	numExpired, err = manager.ExpireMailboxes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, numExpired)
+	require.Equal(t, []streamID{sidA}, backend.cleanedUp)
+
+	// The unclaimed mailbox is expired once it wasn't used for the expiry,
+	// which happens automatically when a new mailbox is created.
+	testClock.SetTime(testClock.Now().Add(expiry))
+	sidC := streamID{0x03}
+	require.NoError(t, manager.Init(ctx, sidC))
+	require.Equal(t, []streamID{sidA, sidB}, backend.cleanedUp)
+
+	mailboxes = manager.ListMailboxes()
+	require.Len(t, mailboxes, 1)
+	require.Equal(t, [64]byte(sidC), mailboxes[0].StreamID)
+
+	// Mailboxes can also be expired explicitly.
+	require.NoError(t, manager.ExpireMailbox(ctx, sidC))
+	require.Empty(t, manager.ListMailboxes())
+}
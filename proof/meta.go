@@ -3,7 +3,9 @@ package proof
 import (
 	"bytes"
 	"crypto/sha256"
+	"fmt"
 	"io"
+	"net/url"
 
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightningnetwork/lnd/tlv"
@@ -16,6 +18,11 @@ const (
 	// MetaOpaque signals that the meta data is simply a set of opaque
 	// bytes without any specific interpretation.
 	MetaOpaque MetaType = 1
+
+	// MetaURI signals that the meta data is a reference to content that is
+	// stored off-chain. The data is a TLV encoded URIMeta that commits to
+	// the hash of the referenced content.
+	MetaURI MetaType = 2
 )
 
 // MetaReveal is an optional TLV type that can be added to the proof of a
@@ -73,3 +80,110 @@ func (m *MetaReveal) Decode(r io.Reader) error {
 	}
 	return stream.Decode(r)
 }
+
+// URIMeta is the meta data of assets that reference content stored off-chain,
+// for example large collectible content. The content itself isn't part of the
+// proof, only its URI and a hash committing to it are.
+type URIMeta struct {
+	// URI is the location of the content. Supported schemes are http,
+	// https and ipfs.
+	URI string
+
+	// ContentHash is the sha256 hash of the referenced content.
+	ContentHash [sha256.Size]byte
+}
+
+// NewURIMetaReveal creates a new meta reveal of type MetaURI that references
+// the given content.
+func NewURIMetaReveal(uri string,
+	contentHash [sha256.Size]byte) (*MetaReveal, error) {
+
+	uriMeta := &URIMeta{
+		URI:         uri,
+		ContentHash: contentHash,
+	}
+	if err := uriMeta.Validate(); err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := uriMeta.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return &MetaReveal{
+		Type: MetaURI,
+		Data: b.Bytes(),
+	}, nil
+}
+
+// Validate makes sure the URI is well-formed and uses a supported scheme.
+func (u *URIMeta) Validate() error {
+	parsed, err := url.Parse(u.URI)
+	if err != nil {
+		return fmt.Errorf("invalid meta URI: %w", err)
+	}
+
+	switch parsed.Scheme {
+	case "http", "https", "ipfs":
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported meta URI scheme: %v",
+			parsed.Scheme)
+	}
+}
+
+// EncodeRecords returns the TLV encode records for the URI meta.
+func (u *URIMeta) EncodeRecords() []tlv.Record {
+	uri := []byte(u.URI)
+	return []tlv.Record{
+		URIMetaURIRecord(&uri),
+		URIMetaContentHashRecord(&u.ContentHash),
+	}
+}
+
+// Encode encodes the URI meta to the given writer.
+func (u *URIMeta) Encode(w io.Writer) error {
+	stream, err := tlv.NewStream(u.EncodeRecords()...)
+	if err != nil {
+		return err
+	}
+	return stream.Encode(w)
+}
+
+// Decode decodes the URI meta from the given reader.
+func (u *URIMeta) Decode(r io.Reader) error {
+	var uri []byte
+	stream, err := tlv.NewStream(
+		URIMetaURIRecord(&uri),
+		URIMetaContentHashRecord(&u.ContentHash),
+	)
+	if err != nil {
+		return err
+	}
+	if err := stream.Decode(r); err != nil {
+		return err
+	}
+
+	u.URI = string(uri)
+	return nil
+}
+
+// URIMeta decodes the data of a meta reveal of type MetaURI.
+func (m *MetaReveal) URIMeta() (*URIMeta, error) {
+	if m.Type != MetaURI {
+		return nil, fmt.Errorf("meta is of type %d, not a URI", m.Type)
+	}
+
+	var uriMeta URIMeta
+	if err := uriMeta.Decode(bytes.NewReader(m.Data)); err != nil {
+		return nil, fmt.Errorf("unable to decode URI meta: %w", err)
+	}
+
+	if err := uriMeta.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &uriMeta, nil
+}
@@ -0,0 +1,346 @@
+package proof
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// MetaCacheDirName is the name of the directory we'll use to cache
+	// fetched URI meta content within.
+	MetaCacheDirName = "meta"
+
+	// metaContentSuffix is the file suffix of cached meta content.
+	metaContentSuffix = ".metacontent"
+
+	// metaContentTypeSuffix is the file suffix of the file that stores the
+	// content type of cached meta content.
+	metaContentTypeSuffix = ".contenttype"
+
+	// DefaultMetaFetchTimeout is the default timeout for fetching the
+	// content referenced by URI meta data.
+	DefaultMetaFetchTimeout = 30 * time.Second
+
+	// DefaultMaxMetaContentSize is the default maximum size in bytes of
+	// content referenced by URI meta data.
+	DefaultMaxMetaContentSize = 16 * 1024 * 1024
+
+	// DefaultIPFSGateway is the default HTTP gateway used to resolve ipfs
+	// URIs.
+	DefaultIPFSGateway = "https://ipfs.io"
+)
+
+var (
+	// ErrMetaContentTooLarge is returned if the content referenced by URI
+	// meta data exceeds the configured maximum size.
+	ErrMetaContentTooLarge = errors.New("meta content too large")
+
+	// ErrMetaContentHashMismatch is returned if the content referenced by
+	// URI meta data doesn't match the hash it is committed to.
+	ErrMetaContentHashMismatch = errors.New("meta content hash mismatch")
+
+	// ErrMetaContentTypeNotAllowed is returned if the content referenced
+	// by URI meta data has a content type that isn't allowed.
+	ErrMetaContentTypeNotAllowed = errors.New("meta content type not " +
+		"allowed")
+)
+
+// MetaFetcherCfg is the config for fetching the content referenced by URI
+// meta data.
+type MetaFetcherCfg struct {
+	IPFSGateway string `long:"ipfsgateway" description:"The HTTP gateway used to resolve ipfs:// meta URIs"`
+
+	FetchTimeout time.Duration `long:"fetchtimeout" description:"The maximum time to wait for referenced meta content to be fetched."`
+
+	MaxContentSize int64 `long:"maxcontentsize" description:"The maximum size in bytes of referenced meta content."`
+
+	AllowedContentTypes []string `long:"allowedcontenttype" description:"A MIME type (for example image/png) that referenced meta content may have. Can be specified multiple times. If not set, all content types are allowed."`
+}
+
+// URIContent is the verified content referenced by URI meta data.
+type URIContent struct {
+	// URI is the URI the content is referenced by.
+	URI string
+
+	// ContentType is the MIME type of the content.
+	ContentType string
+
+	// Content is the raw content.
+	Content []byte
+}
+
+// MetaFetcher fetches the content referenced by URI meta data, verifies it
+// against the hash commitment and caches it on disk.
+type MetaFetcher struct {
+	cfg *MetaFetcherCfg
+
+	cachePath string
+
+	client *http.Client
+}
+
+// NewMetaFetcher creates a new meta fetcher that caches fetched content in a
+// sub directory of the given directory.
+func NewMetaFetcher(cfg *MetaFetcherCfg, dirName string) (*MetaFetcher,
+	error) {
+
+	cachePath := filepath.Join(dirName, MetaCacheDirName)
+	if err := os.Mkdir(cachePath, 0750); err != nil && !os.IsExist(err) {
+		return nil, fmt.Errorf("unable to create meta cache dir: %w",
+			err)
+	}
+
+	timeout := cfg.FetchTimeout
+	if timeout == 0 {
+		timeout = DefaultMetaFetchTimeout
+	}
+
+	return &MetaFetcher{
+		cfg:       cfg,
+		cachePath: cachePath,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}, nil
+}
+
+// Fetch returns the verified content referenced by the given meta reveal,
+// which must be of type MetaURI. Content that was fetched before is served
+// from the local cache.
+func (m *MetaFetcher) Fetch(ctx context.Context,
+	meta *MetaReveal) (*URIContent, error) {
+
+	uriMeta, err := meta.URIMeta()
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := m.fetchCached(uriMeta)
+	switch {
+	case err == nil:
+		return content, nil
+
+	case !os.IsNotExist(err):
+		log.Warnf("Ignoring invalid cached content of meta URI %v: %v",
+			uriMeta.URI, err)
+	}
+
+	content, err = m.fetchRemote(ctx, uriMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.storeCached(uriMeta, content); err != nil {
+		return nil, fmt.Errorf("unable to cache meta content: %w", err)
+	}
+
+	return content, nil
+}
+
+// cacheFilePath returns the path of the cache file with the given suffix for
+// the content of the given URI meta.
+func (m *MetaFetcher) cacheFilePath(uriMeta *URIMeta, suffix string) string {
+	fileName := hex.EncodeToString(uriMeta.ContentHash[:]) + suffix
+	return filepath.Join(m.cachePath, fileName)
+}
+
+// fetchCached attempts to read the content of the given URI meta from the
+// local cache.
+func (m *MetaFetcher) fetchCached(uriMeta *URIMeta) (*URIContent, error) {
+	content, err := os.ReadFile(
+		m.cacheFilePath(uriMeta, metaContentSuffix),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType, err := os.ReadFile(
+		m.cacheFilePath(uriMeta, metaContentTypeSuffix),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The cache is keyed by the content hash, so we just need to make sure
+	// the file wasn't tampered with. The content type is checked again as
+	// the set of allowed types might have changed in the meantime.
+	if err := m.verify(uriMeta, content, string(contentType)); err != nil {
+		return nil, err
+	}
+
+	return &URIContent{
+		URI:         uriMeta.URI,
+		ContentType: string(contentType),
+		Content:     content,
+	}, nil
+}
+
+// storeCached writes the verified content to the local cache.
+func (m *MetaFetcher) storeCached(uriMeta *URIMeta,
+	content *URIContent) error {
+
+	err := writeFileAtomic(
+		m.cacheFilePath(uriMeta, metaContentTypeSuffix),
+		[]byte(content.ContentType),
+	)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(
+		m.cacheFilePath(uriMeta, metaContentSuffix), content.Content,
+	)
+}
+
+// fetchRemote downloads the content of the given URI meta and verifies it.
+func (m *MetaFetcher) fetchRemote(ctx context.Context,
+	uriMeta *URIMeta) (*URIContent, error) {
+
+	fetchURL, err := m.resolveURI(uriMeta.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, fetchURL, nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %w", err)
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch meta content: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch meta content: %v",
+			resp.Status)
+	}
+
+	maxSize := m.maxContentSize()
+	if resp.ContentLength > maxSize {
+		return nil, fmt.Errorf("%w: %d bytes", ErrMetaContentTooLarge,
+			resp.ContentLength)
+	}
+
+	// We read one byte more than allowed to find out whether the content
+	// was too large, as the content length header is optional.
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read meta content: %w", err)
+	}
+	if int64(len(content)) > maxSize {
+		return nil, fmt.Errorf("%w: more than %d bytes",
+			ErrMetaContentTooLarge, maxSize)
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = http.DetectContentType(content)
+	}
+
+	if err := m.verify(uriMeta, content, contentType); err != nil {
+		return nil, err
+	}
+
+	return &URIContent{
+		URI:         uriMeta.URI,
+		ContentType: contentType,
+		Content:     content,
+	}, nil
+}
+
+// verify makes sure the content matches the hash commitment of the URI meta
+// and is of an allowed type and size.
+func (m *MetaFetcher) verify(uriMeta *URIMeta, content []byte,
+	contentType string) error {
+
+	if int64(len(content)) > m.maxContentSize() {
+		return fmt.Errorf("%w: %d bytes", ErrMetaContentTooLarge,
+			len(content))
+	}
+
+	if sha256.Sum256(content) != uriMeta.ContentHash {
+		return ErrMetaContentHashMismatch
+	}
+
+	if len(m.cfg.AllowedContentTypes) == 0 {
+		return nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrMetaContentTypeNotAllowed, err)
+	}
+
+	for _, allowed := range m.cfg.AllowedContentTypes {
+		if strings.EqualFold(mediaType, allowed) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %v", ErrMetaContentTypeNotAllowed, mediaType)
+}
+
+// maxContentSize returns the configured maximum content size or the default
+// if none was configured.
+func (m *MetaFetcher) maxContentSize() int64 {
+	if m.cfg.MaxContentSize > 0 {
+		return m.cfg.MaxContentSize
+	}
+
+	return DefaultMaxMetaContentSize
+}
+
+// resolveURI turns the given meta URI into the HTTP URL the content can be
+// fetched from. IPFS URIs are resolved through the configured gateway.
+func (m *MetaFetcher) resolveURI(uri string) (string, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("invalid meta URI: %w", err)
+	}
+
+	switch parsed.Scheme {
+	case "http", "https":
+		return uri, nil
+
+	case "ipfs":
+		gateway := m.cfg.IPFSGateway
+		if gateway == "" {
+			gateway = DefaultIPFSGateway
+		}
+
+		// An ipfs URI has the form ipfs://<cid>/<path>, which maps
+		// onto <gateway>/ipfs/<cid>/<path>.
+		return strings.TrimSuffix(gateway, "/") + "/ipfs/" +
+			parsed.Host + parsed.EscapedPath(), nil
+
+	default:
+		return "", fmt.Errorf("unsupported meta URI scheme: %v",
+			parsed.Scheme)
+	}
+}
+
+// writeFileAtomic writes the given data to a temporary file first and then
+// renames it to the final path, so readers never see partially written files.
+func writeFileAtomic(path string, data []byte) error {
+	tempPath := path + ".tmp"
+	if err := os.WriteFile(tempPath, data, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tempPath, path)
+}
@@ -0,0 +1,130 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestURIMetaEncoding tests that URI meta data survives a round trip through
+// a meta reveal and that unsupported URIs are rejected.
+func TestURIMetaEncoding(t *testing.T) {
+	t.Parallel()
+
+	contentHash := sha256.Sum256([]byte("content"))
+	meta, err := NewURIMetaReveal("ipfs://bafybeigdyrzt/5.png", contentHash)
+	require.NoError(t, err)
+	require.Equal(t, MetaURI, meta.Type)
+
+	uriMeta, err := meta.URIMeta()
+	require.NoError(t, err)
+	require.Equal(t, "ipfs://bafybeigdyrzt/5.png", uriMeta.URI)
+	require.Equal(t, contentHash, uriMeta.ContentHash)
+
+	_, err = NewURIMetaReveal("file:///etc/passwd", contentHash)
+	require.ErrorContains(t, err, "unsupported meta URI scheme")
+
+	opaque := &MetaReveal{Type: MetaOpaque, Data: []byte("foo")}
+	_, err = opaque.URIMeta()
+	require.Error(t, err)
+}
+
+// TestMetaFetcher tests that referenced meta content is fetched, verified
+// against its hash commitment and served from the cache afterwards.
+func TestMetaFetcher(t *testing.T) {
+	t.Parallel()
+
+	content := []byte("\x89PNG\r\n\x1a\nnot really an image")
+	contentHash := sha256.Sum256(content)
+
+	var numRequests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			numRequests.Add(1)
+
+			switch r.URL.Path {
+			case "/image.png", "/ipfs/bafybeigdyrzt/image.png":
+				w.Header().Set("Content-Type", "image/png")
+				_, _ = w.Write(content)
+
+			case "/text":
+				w.Header().Set("Content-Type", "text/plain")
+				_, _ = w.Write(content)
+
+			case "/large":
+				_, _ = w.Write(bytes.Repeat(content, 10))
+
+			default:
+				http.NotFound(w, r)
+			}
+		},
+	))
+	defer server.Close()
+
+	ctx := context.Background()
+	newMeta := func(uri string) *MetaReveal {
+		meta, err := NewURIMetaReveal(uri, contentHash)
+		require.NoError(t, err)
+
+		return meta
+	}
+
+	fetcher, err := NewMetaFetcher(&MetaFetcherCfg{
+		IPFSGateway:         server.URL,
+		MaxContentSize:      int64(len(content)),
+		AllowedContentTypes: []string{"image/png"},
+	}, t.TempDir())
+	require.NoError(t, err)
+
+	// The content is fetched and verified.
+	fetched, err := fetcher.Fetch(ctx, newMeta(server.URL+"/image.png"))
+	require.NoError(t, err)
+	require.Equal(t, content, fetched.Content)
+	require.Equal(t, "image/png", fetched.ContentType)
+	require.EqualValues(t, 1, numRequests.Load())
+
+	// A second fetch is served from the cache.
+	_, err = fetcher.Fetch(ctx, newMeta(server.URL+"/image.png"))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, numRequests.Load())
+
+	// IPFS URIs are resolved through the gateway. The content is already
+	// cached under its hash though.
+	ipfsURI := "ipfs://bafybeigdyrzt/image.png"
+	fetched, err = fetcher.Fetch(ctx, newMeta(ipfsURI))
+	require.NoError(t, err)
+	require.Equal(t, content, fetched.Content)
+	require.EqualValues(t, 1, numRequests.Load())
+
+	uncached, err := NewMetaFetcher(fetcher.cfg, t.TempDir())
+	require.NoError(t, err)
+	_, err = uncached.Fetch(ctx, newMeta(ipfsURI))
+	require.NoError(t, err)
+	require.EqualValues(t, 2, numRequests.Load())
+
+	// Content that doesn't match the hash, is too large or has a content
+	// type that isn't allowed is rejected.
+	uncached, err = NewMetaFetcher(fetcher.cfg, t.TempDir())
+	require.NoError(t, err)
+
+	otherHash := sha256.Sum256([]byte("other"))
+	otherMeta, err := NewURIMetaReveal(server.URL+"/text", otherHash)
+	require.NoError(t, err)
+	_, err = uncached.Fetch(ctx, otherMeta)
+	require.ErrorIs(t, err, ErrMetaContentHashMismatch)
+
+	_, err = uncached.Fetch(ctx, newMeta(server.URL+"/text"))
+	require.ErrorIs(t, err, ErrMetaContentTypeNotAllowed)
+
+	_, err = uncached.Fetch(ctx, newMeta(server.URL+"/large"))
+	require.ErrorIs(t, err, ErrMetaContentTooLarge)
+
+	_, err = uncached.Fetch(ctx, newMeta(server.URL+"/missing"))
+	require.ErrorContains(t, err, "404")
+}
@@ -40,6 +40,9 @@ const (
 
 	MetaRevealEncodingType tlv.Type = 0
 	MetaRevealDataType     tlv.Type = 1
+
+	URIMetaURIType         tlv.Type = 0
+	URIMetaContentHashType tlv.Type = 2
 )
 
 func PrevOutRecord(prevOut *wire.OutPoint) tlv.Record {
@@ -286,3 +289,11 @@ func MetaRevealTypeRecord(metaType *MetaType) tlv.Record {
 func MetaRevealDataRecord(data *[]byte) tlv.Record {
 	return tlv.MakePrimitiveRecord(MetaRevealDataType, data)
 }
+
+func URIMetaURIRecord(uri *[]byte) tlv.Record {
+	return tlv.MakePrimitiveRecord(URIMetaURIType, uri)
+}
+
+func URIMetaContentHashRecord(hash *[32]byte) tlv.Record {
+	return tlv.MakePrimitiveRecord(URIMetaContentHashType, hash)
+}
@@ -0,0 +1,454 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+const (
+	// DefaultScrubInterval is the default interval in which all proofs of
+	// the archive are scrubbed.
+	DefaultScrubInterval = 24 * time.Hour
+
+	// DefaultScrubVerifySampleSize is the default number of proofs that
+	// are verified end-to-end in each scrub pass.
+	DefaultScrubVerifySampleSize = 10
+
+	// defaultScrubTimeout is the timeout of a single scrub pass.
+	defaultScrubTimeout = time.Hour
+)
+
+// ScrubFailure describes why a proof failed to be scrubbed.
+type ScrubFailure uint8
+
+const (
+	// ScrubProofMissing means the proof couldn't be found in the archive.
+	ScrubProofMissing ScrubFailure = iota
+
+	// ScrubProofCorrupted means the proof file couldn't be decoded or its
+	// checksums didn't match.
+	ScrubProofCorrupted
+
+	// ScrubProofInvalid means the proof file was decoded but failed the
+	// end-to-end verification.
+	ScrubProofInvalid
+)
+
+// String returns a human-readable version of the scrub failure.
+func (f ScrubFailure) String() string {
+	switch f {
+	case ScrubProofMissing:
+		return "missing"
+
+	case ScrubProofCorrupted:
+		return "corrupted"
+
+	case ScrubProofInvalid:
+		return "invalid"
+
+	default:
+		return fmt.Sprintf("<unknown>(%d)", f)
+	}
+}
+
+// ScrubArchive is an archive whose proofs are scrubbed.
+type ScrubArchive struct {
+	// Name identifies the archive in events and logs.
+	Name string
+
+	// Archive is the archive the proofs are fetched from.
+	Archive Archiver
+}
+
+// ScrubStats are the metrics of the scrubber since the daemon was started.
+type ScrubStats struct {
+	// NumPasses is the number of completed scrub passes.
+	NumPasses uint64
+
+	// LastPassAt is the time the last scrub pass completed.
+	LastPassAt time.Time
+
+	// NumChecked is the number of proofs that were checked for
+	// decodability, counting each archive separately.
+	NumChecked uint64
+
+	// NumVerified is the number of proofs that were verified end-to-end.
+	NumVerified uint64
+
+	// NumMissing is the number of proofs that weren't found in an archive.
+	NumMissing uint64
+
+	// NumCorrupted is the number of proofs that couldn't be decoded.
+	NumCorrupted uint64
+
+	// NumInvalid is the number of proofs that failed the end-to-end
+	// verification.
+	NumInvalid uint64
+}
+
+// ProofScrubberConfig is the configuration of the proof scrubber.
+type ProofScrubberConfig struct {
+	// Archives are the archives whose proofs are scrubbed. Each proof is
+	// fetched from each archive, so a damaged copy is detected even if
+	// another archive still holds an intact one.
+	Archives []ScrubArchive
+
+	// ProofLocators returns the locators of all proofs that are scrubbed.
+	ProofLocators func(ctx context.Context) ([]Locator, error)
+
+	// Verifier is used to verify the sampled proofs end-to-end.
+	Verifier Verifier
+
+	// HeaderVerifier is used to verify the block headers of the sampled
+	// proofs.
+	HeaderVerifier HeaderVerifier
+
+	// Limits bounds the size of the proof files that are decoded.
+	Limits FileLimits
+
+	// Interval is the interval in which all proofs are scrubbed.
+	Interval time.Duration
+
+	// VerifySampleSize is the number of randomly sampled proofs that are
+	// verified end-to-end in each pass. Zero disables the verification.
+	VerifySampleSize int
+}
+
+// ProofScrubber periodically re-reads all proofs of the archives, makes sure
+// they can still be decoded and their checksums match, and verifies a random
+// sample of them end-to-end. This detects damaged proofs before they're
+// needed to spend an asset. For every damaged proof a ProofScrubEvent is
+// published.
+type ProofScrubber struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *ProofScrubberConfig
+
+	// scrubMtx makes sure only a single scrub pass runs at a time. It
+	// also guards rand.
+	scrubMtx sync.Mutex
+
+	rand *rand.Rand
+
+	stats ScrubStats
+
+	// statsMtx guards stats.
+	statsMtx sync.Mutex
+
+	// eventBus delivers the events of the scrubber to all subscribers.
+	eventBus *fn.EventBus[fn.Event]
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewProofScrubber creates a new proof scrubber from the given config.
+func NewProofScrubber(cfg *ProofScrubberConfig) *ProofScrubber {
+	return &ProofScrubber{
+		cfg:  cfg,
+		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+		eventBus: fn.NewEventBus(
+			fn.DefaultEventBusBufferSize,
+			fn.WithDropMarker(fn.MarkDroppedHistory),
+		),
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts the periodic scrubbing.
+func (s *ProofScrubber) Start() error {
+	s.startOnce.Do(func() {
+		log.Infof("Starting proof scrubber")
+
+		s.wg.Add(1)
+		go s.scrubPeriodically()
+	})
+
+	return nil
+}
+
+// Stop stops the periodic scrubbing.
+func (s *ProofScrubber) Stop() error {
+	s.stopOnce.Do(func() {
+		log.Infof("Stopping proof scrubber")
+
+		close(s.quit)
+		s.wg.Wait()
+
+		s.eventBus.Stop()
+	})
+
+	return nil
+}
+
+// scrubPeriodically scrubs the proofs in the configured interval.
+//
+// NOTE: This method MUST be called as a goroutine.
+func (s *ProofScrubber) scrubPeriodically() {
+	defer s.wg.Done()
+
+	interval := s.cfg.Interval
+	if interval == 0 {
+		interval = DefaultScrubInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-s.quit:
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(
+			context.Background(), defaultScrubTimeout,
+		)
+		err := s.Scrub(ctx)
+		cancel()
+		if err != nil {
+			log.Errorf("Unable to scrub proofs: %v", err)
+		}
+	}
+}
+
+// Scrub checks all proofs of the archives once. Damaged proofs are reported
+// through events and the scrub stats, an error is only returned if the scrub
+// pass itself failed.
+func (s *ProofScrubber) Scrub(ctx context.Context) error {
+	s.scrubMtx.Lock()
+	defer s.scrubMtx.Unlock()
+
+	locators, err := s.cfg.ProofLocators(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to list proofs: %w", err)
+	}
+
+	// The proofs to verify end-to-end are sampled upfront, so we only
+	// need to keep their blobs around instead of all of them.
+	var sampled map[[32]byte]struct{}
+	if s.cfg.VerifySampleSize > 0 && s.cfg.Verifier != nil {
+		sampled = s.sample(locators)
+	}
+
+	var (
+		stats  ScrubStats
+		intact = make(map[[32]byte]Blob, len(sampled))
+	)
+	for _, loc := range locators {
+		locHash := loc.Hash()
+
+		for _, archive := range s.cfg.Archives {
+			blob, err := archive.Archive.FetchProof(ctx, loc)
+			switch {
+			case errors.Is(err, ErrProofNotFound):
+				stats.NumMissing++
+				s.report(
+					archive.Name, loc, ScrubProofMissing,
+					err,
+				)
+				continue
+
+			// Other errors might be temporary, so we don't report
+			// the proof as damaged.
+			case err != nil:
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+
+				log.Warnf("Unable to fetch proof %x from %v "+
+					"archive: %v", locHash[:], archive.Name,
+					err)
+				continue
+			}
+
+			stats.NumChecked++
+
+			var f File
+			err = f.DecodeWithLimits(
+				bytes.NewReader(blob), s.cfg.Limits,
+			)
+			if err != nil {
+				stats.NumCorrupted++
+				s.report(
+					archive.Name, loc, ScrubProofCorrupted,
+					err,
+				)
+				continue
+			}
+
+			// A single intact copy of each sampled proof is
+			// enough for the verification.
+			if _, ok := sampled[locHash]; ok {
+				intact[locHash] = blob
+			}
+		}
+	}
+
+	for _, loc := range locators {
+		locHash := loc.Hash()
+		blob, ok := intact[locHash]
+		if !ok {
+			continue
+		}
+		delete(intact, locHash)
+
+		stats.NumVerified++
+		_, err := s.cfg.Verifier.Verify(
+			ctx, bytes.NewReader(blob), s.cfg.HeaderVerifier,
+		)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			stats.NumInvalid++
+			s.report("", loc, ScrubProofInvalid, err)
+		}
+	}
+
+	log.Debugf("Scrubbed %d proofs, verified %d: missing=%d, "+
+		"corrupted=%d, invalid=%d", stats.NumChecked,
+		stats.NumVerified, stats.NumMissing, stats.NumCorrupted,
+		stats.NumInvalid)
+
+	s.statsMtx.Lock()
+	s.stats.NumPasses++
+	s.stats.LastPassAt = time.Now().UTC()
+	s.stats.NumChecked += stats.NumChecked
+	s.stats.NumVerified += stats.NumVerified
+	s.stats.NumMissing += stats.NumMissing
+	s.stats.NumCorrupted += stats.NumCorrupted
+	s.stats.NumInvalid += stats.NumInvalid
+	s.statsMtx.Unlock()
+
+	return nil
+}
+
+// sample returns the hashes of up to VerifySampleSize randomly chosen proof
+// locators.
+//
+// NOTE: The scrubMtx must be held when calling this method.
+func (s *ProofScrubber) sample(locators []Locator) map[[32]byte]struct{} {
+	candidates := make([][32]byte, 0, len(locators))
+	seen := make(map[[32]byte]struct{}, len(locators))
+	for _, loc := range locators {
+		locHash := loc.Hash()
+		if _, ok := seen[locHash]; ok {
+			continue
+		}
+
+		seen[locHash] = struct{}{}
+		candidates = append(candidates, locHash)
+	}
+
+	s.rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+
+	if len(candidates) > s.cfg.VerifySampleSize {
+		candidates = candidates[:s.cfg.VerifySampleSize]
+	}
+
+	sampled := make(map[[32]byte]struct{}, len(candidates))
+	for _, locHash := range candidates {
+		sampled[locHash] = struct{}{}
+	}
+
+	return sampled
+}
+
+// report logs the damaged proof and publishes an event for it.
+func (s *ProofScrubber) report(archive string, loc Locator,
+	failure ScrubFailure, err error) {
+
+	source := "all archives"
+	if archive != "" {
+		source = fmt.Sprintf("%v archive", archive)
+	}
+
+	locHash := loc.Hash()
+	log.Errorf("Proof %x of asset with script key %x is %v in %v: %v",
+		locHash[:], loc.ScriptKey.SerializeCompressed(), failure,
+		source, err)
+
+	s.eventBus.Publish(NewProofScrubEvent(archive, loc, failure, err))
+}
+
+// Stats returns the metrics of the scrubber since the daemon was started.
+func (s *ProofScrubber) Stats() ScrubStats {
+	s.statsMtx.Lock()
+	defer s.statsMtx.Unlock()
+
+	return s.stats
+}
+
+// RegisterSubscriber adds a new subscriber to the set of subscribers that will
+// be notified about damaged proofs.
+func (s *ProofScrubber) RegisterSubscriber(
+	receiver *fn.EventReceiver[fn.Event], _ bool, _ bool) error {
+
+	s.eventBus.Subscribe(receiver, nil)
+
+	return nil
+}
+
+// RemoveSubscriber removes a subscriber from the set of subscribers that will
+// be notified about damaged proofs.
+func (s *ProofScrubber) RemoveSubscriber(
+	subscriber *fn.EventReceiver[fn.Event]) error {
+
+	return s.eventBus.Unsubscribe(subscriber)
+}
+
+// A compile-time assertion to make sure ProofScrubber satisfies the
+// fn.EventPublisher interface.
+var _ fn.EventPublisher[fn.Event, bool] = (*ProofScrubber)(nil)
+
+// ProofScrubEvent is an alert which indicates that the scrubber found a
+// damaged proof.
+type ProofScrubEvent struct {
+	// timestamp is the time the event was created.
+	timestamp time.Time
+
+	// Archive is the name of the archive the damaged proof was fetched
+	// from. It is empty if the end-to-end verification failed, since the
+	// proof isn't verified per archive.
+	Archive string
+
+	// Locator identifies the damaged proof.
+	Locator Locator
+
+	// Failure describes how the proof is damaged.
+	Failure ScrubFailure
+
+	// Err is the error the proof failed with.
+	Err error
+}
+
+// Timestamp returns the timestamp of the event.
+func (e *ProofScrubEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// NewProofScrubEvent creates a new ProofScrubEvent.
+func NewProofScrubEvent(archive string, loc Locator, failure ScrubFailure,
+	err error) *ProofScrubEvent {
+
+	return &ProofScrubEvent{
+		timestamp: time.Now().UTC(),
+		Archive:   archive,
+		Locator:   loc,
+		Failure:   failure,
+		Err:       err,
+	}
+}
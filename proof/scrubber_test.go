@@ -0,0 +1,156 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// rejectingVerifier is a verifier that rejects a single proof file.
+type rejectingVerifier struct {
+	invalid Blob
+}
+
+func (r *rejectingVerifier) Verify(_ context.Context, blobReader io.Reader,
+	_ HeaderVerifier) (*AssetSnapshot, error) {
+
+	blob, err := io.ReadAll(blobReader)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.Equal(blob, r.invalid) {
+		return nil, fmt.Errorf("invalid proof")
+	}
+
+	return &AssetSnapshot{}, nil
+}
+
+// TestProofScrubber tests that missing, corrupted and invalid proofs are
+// detected and reported through events and the scrub stats.
+func TestProofScrubber(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	primary, err := NewFileArchiver(t.TempDir())
+	require.NoError(t, err)
+	replica, err := NewFileArchiver(t.TempDir())
+	require.NoError(t, err)
+
+	newProof := func() *AnnotatedProof {
+		amt := uint64(100)
+		genesisProof, _ := genRandomGenesisWithProof(
+			t, asset.Normal, &amt, nil, true, nil, nil,
+		)
+		blob, err := EncodeAsProofFile(&genesisProof)
+		require.NoError(t, err)
+
+		assetID := genesisProof.Asset.ID()
+		return &AnnotatedProof{
+			Locator: Locator{
+				AssetID:   &assetID,
+				ScriptKey: *genesisProof.Asset.ScriptKey.PubKey,
+			},
+			Blob: blob,
+		}
+	}
+	intact, missing, corrupted, invalid := newProof(), newProof(),
+		newProof(), newProof()
+
+	// The primary archive holds all proofs, the replica is missing one
+	// and holds a corrupted copy of another.
+	err = primary.ImportProofs(
+		ctx, nil, false, intact, missing, corrupted, invalid,
+	)
+	require.NoError(t, err)
+	err = replica.ImportProofs(ctx, nil, false, intact, &AnnotatedProof{
+		Locator: corrupted.Locator,
+		Blob:    test.RandBytes(100),
+	}, invalid)
+	require.NoError(t, err)
+
+	scrubber := NewProofScrubber(&ProofScrubberConfig{
+		Archives: []ScrubArchive{
+			{Name: "primary", Archive: primary},
+			{Name: "replica", Archive: replica},
+		},
+		ProofLocators: func(context.Context) ([]Locator, error) {
+			return []Locator{
+				intact.Locator, missing.Locator,
+				corrupted.Locator, invalid.Locator,
+			}, nil
+		},
+		Verifier:         &rejectingVerifier{invalid: invalid.Blob},
+		HeaderVerifier:   MockHeaderVerifier,
+		Limits:           DefaultFileLimits(),
+		VerifySampleSize: 4,
+	})
+	t.Cleanup(func() {
+		require.NoError(t, scrubber.Stop())
+	})
+
+	sub := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
+	require.NoError(t, scrubber.RegisterSubscriber(sub, false, false))
+
+	require.NoError(t, scrubber.Scrub(ctx))
+
+	stats := scrubber.Stats()
+	require.EqualValues(t, 1, stats.NumPasses)
+	require.False(t, stats.LastPassAt.IsZero())
+	require.EqualValues(t, 7, stats.NumChecked)
+	require.EqualValues(t, 4, stats.NumVerified)
+	require.EqualValues(t, 1, stats.NumMissing)
+	require.EqualValues(t, 1, stats.NumCorrupted)
+	require.EqualValues(t, 1, stats.NumInvalid)
+
+	// Events are published in the order the damage was found.
+	requireEvent := func(archive string, loc Locator,
+		failure ScrubFailure) {
+
+		var event fn.Event
+		select {
+		case event = <-sub.NewItemCreated.ChanOut():
+		case <-time.After(time.Second):
+			t.Fatalf("no scrub event received")
+		}
+
+		scrubEvent, ok := event.(*ProofScrubEvent)
+		require.True(t, ok)
+		require.Equal(t, archive, scrubEvent.Archive)
+		require.Equal(t, loc.Hash(), scrubEvent.Locator.Hash())
+		require.Equal(t, failure, scrubEvent.Failure)
+		require.Error(t, scrubEvent.Err)
+	}
+	requireEvent("replica", missing.Locator, ScrubProofMissing)
+	requireEvent("replica", corrupted.Locator, ScrubProofCorrupted)
+	requireEvent("", invalid.Locator, ScrubProofInvalid)
+
+	// Once the damage is repaired, a second pass doesn't find anything.
+	require.NoError(t, replica.ImportProofs(ctx, nil, false, missing))
+	require.NoError(t, replica.ImportProofs(ctx, nil, true, corrupted))
+	scrubber.cfg.Verifier = &rejectingVerifier{}
+
+	require.NoError(t, scrubber.Scrub(ctx))
+
+	stats = scrubber.Stats()
+	require.EqualValues(t, 2, stats.NumPasses)
+	require.EqualValues(t, 15, stats.NumChecked)
+	require.EqualValues(t, 1, stats.NumMissing)
+	require.EqualValues(t, 1, stats.NumCorrupted)
+	require.EqualValues(t, 1, stats.NumInvalid)
+
+	select {
+	case event := <-sub.NewItemCreated.ChanOut():
+		t.Fatalf("unexpected event: %v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
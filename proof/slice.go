@@ -0,0 +1,89 @@
+package proof
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+// OwnershipSlice returns a trimmed copy of the proof file that only contains
+// what is needed to prove the ownership of the asset by the given script key,
+// starting from the asset's genesis:
+//   - All state transitions after the last one that assigned the asset to the
+//     script key are dropped.
+//   - The ownership challenge witnesses of all earlier owners are dropped, as
+//     they aren't needed to verify the state transitions.
+//   - The nested proof files of any merged inputs are trimmed the same way.
+//
+// The split commitments and exclusion proofs of a transition can't be dropped,
+// as they are required to verify the split siblings weren't inflated and that
+// the asset isn't committed to any other output of the anchor transaction. If
+// the asset was never owned by the script key, ErrNoProofAvailable is
+// returned.
+func (f *File) OwnershipSlice(scriptKey *btcec.PublicKey) (*File, error) {
+	_, lastIdx, err := f.LocateProof(func(p *Proof) bool {
+		return p.Asset.ScriptKey.PubKey != nil &&
+			p.Asset.ScriptKey.PubKey.IsEqual(scriptKey)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to locate proof for script key "+
+			"%x: %w", scriptKey.SerializeCompressed(), err)
+	}
+
+	proofs := make([]Proof, lastIdx+1)
+	for idx := range proofs {
+		p, err := f.ProofAt(uint32(idx))
+		if err != nil {
+			return nil, err
+		}
+
+		// The challenge witness of the last transition proves the
+		// ownership of the script key itself, so we keep it.
+		if idx < len(proofs)-1 {
+			p.ChallengeWitness = nil
+		}
+
+		for inputIdx := range p.AdditionalInputs {
+			inputFile := &p.AdditionalInputs[inputIdx]
+			slicedInput, err := inputFile.lastOwnerSlice()
+			if err != nil {
+				return nil, fmt.Errorf("unable to slice "+
+					"additional input %d of proof %d: %w",
+					inputIdx, idx, err)
+			}
+			p.AdditionalInputs[inputIdx] = *slicedInput
+		}
+
+		proofs[idx] = *p
+	}
+
+	return NewFile(f.Version, proofs...)
+}
+
+// lastOwnerSlice returns the ownership slice of the proof file for the script
+// key of its last state transition. The challenge witness of the last
+// transition is dropped as well, since nested files only prove the spent
+// input, not the ownership of its script key.
+func (f *File) lastOwnerSlice() (*File, error) {
+	lastProof, err := f.LastProof()
+	if err != nil {
+		return nil, err
+	}
+
+	sliced, err := f.OwnershipSlice(lastProof.Asset.ScriptKey.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	lastProof, err = sliced.LastProof()
+	if err != nil {
+		return nil, err
+	}
+	lastProof.ChallengeWitness = nil
+
+	if err := sliced.ReplaceLastProof(*lastProof); err != nil {
+		return nil, err
+	}
+
+	return sliced, nil
+}
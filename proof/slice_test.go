@@ -0,0 +1,68 @@
+package proof
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOwnershipSlice tests that the ownership slice of a proof file only
+// contains the transitions up to the given script key and still verifies.
+func TestOwnershipSlice(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := loadProofFile(t)
+	require.Equal(t, 3, f.NumProofs())
+
+	// We add challenge witnesses to the first two proofs to make sure only
+	// the one of the last sliced transition is kept.
+	challengeWitness := wire.TxWitness{[]byte("challenge")}
+	for idx := uint32(0); idx < 2; idx++ {
+		p, err := f.ProofAt(idx)
+		require.NoError(t, err)
+
+		p.ChallengeWitness = challengeWitness
+		require.NoError(t, f.ReplaceProofAt(idx, *p))
+	}
+
+	middleProof, err := f.ProofAt(1)
+	require.NoError(t, err)
+
+	sliced, err := f.OwnershipSlice(middleProof.Asset.ScriptKey.PubKey)
+	require.NoError(t, err)
+	require.Equal(t, 2, sliced.NumProofs())
+
+	genesisProof, err := sliced.ProofAt(0)
+	require.NoError(t, err)
+	require.Nil(t, genesisProof.ChallengeWitness)
+
+	lastProof, err := sliced.LastProof()
+	require.NoError(t, err)
+	require.Equal(t, challengeWitness, lastProof.ChallengeWitness)
+	require.Equal(
+		t, middleProof.AnchorTx.TxHash(), lastProof.AnchorTx.TxHash(),
+	)
+
+	snapshot, err := sliced.Verify(ctx, MockHeaderVerifier)
+	require.NoError(t, err)
+	require.True(t, middleProof.Asset.DeepEqual(snapshot.Asset))
+
+	// Slicing for the current owner keeps all transitions.
+	currentProof, err := f.LastProof()
+	require.NoError(t, err)
+
+	sliced, err = f.OwnershipSlice(currentProof.Asset.ScriptKey.PubKey)
+	require.NoError(t, err)
+	require.Equal(t, f.NumProofs(), sliced.NumProofs())
+
+	_, err = sliced.Verify(ctx, MockHeaderVerifier)
+	require.NoError(t, err)
+
+	// A script key that never owned the asset has no slice.
+	_, err = f.OwnershipSlice(test.RandPubKey(t))
+	require.ErrorIs(t, err, ErrNoProofAvailable)
+}
@@ -0,0 +1,343 @@
+package proof
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+)
+
+const (
+	// DefaultTierSweepInterval is the default interval in which proofs of
+	// spent outputs are moved from the hot to the cold tier.
+	DefaultTierSweepInterval = time.Hour
+
+	// defaultTierTimeout is the timeout of a single sweep of the tiers.
+	defaultTierTimeout = 10 * time.Minute
+)
+
+// TierStats are the size metrics of a single tier of the proof archive.
+type TierStats struct {
+	// NumProofs is the number of proofs stored in the tier.
+	NumProofs uint64
+
+	// SizeBytes is the total size in bytes the proofs take up in the tier.
+	SizeBytes uint64
+}
+
+// TierStore is an archive that can be used as a tier of a TieredArchiver.
+// Besides the local file archive, this can for example be a remote object
+// store.
+type TierStore interface {
+	Archiver
+
+	// RemoveProof removes the proof identified by the given locator. If
+	// the proof can't be found, ErrProofNotFound is returned.
+	RemoveProof(ctx context.Context, id Locator) error
+
+	// Stats returns the size metrics of the store.
+	Stats(ctx context.Context) (*TierStats, error)
+}
+
+// TieredArchiverConfig is the configuration of a tiered archiver.
+type TieredArchiverConfig struct {
+	// Hot is the store that holds the proofs of unspent outputs and all
+	// newly imported proofs.
+	Hot TierStore
+
+	// Cold is the store the proofs of spent outputs are moved to.
+	Cold TierStore
+
+	// SpentProofs returns the locators of the proofs of all spent outputs.
+	// These are moved to the cold store on each sweep.
+	SpentProofs func(ctx context.Context) ([]Locator, error)
+
+	// SweepInterval is the interval in which proofs of spent outputs are
+	// moved to the cold store.
+	SweepInterval time.Duration
+}
+
+// TieredArchiver is an archive that keeps the proofs of unspent outputs in a
+// hot store, while the proofs of spent, historical outputs are moved to a
+// cold store in the background. Proofs are transparently fetched from the
+// cold store if they aren't found in the hot store.
+type TieredArchiver struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *TieredArchiverConfig
+
+	// moveMtx makes sure proofs aren't replaced while they're moved
+	// between the tiers.
+	moveMtx sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewTieredArchiver creates a new tiered archiver from the given config.
+func NewTieredArchiver(cfg *TieredArchiverConfig) *TieredArchiver {
+	return &TieredArchiver{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts the background sweeps of proofs of spent outputs to the cold
+// store.
+func (t *TieredArchiver) Start() error {
+	t.startOnce.Do(func() {
+		log.Infof("Starting tiered proof archive")
+
+		t.wg.Add(1)
+		go t.sweepTiers()
+	})
+
+	return nil
+}
+
+// Stop stops the background sweeps.
+func (t *TieredArchiver) Stop() error {
+	t.stopOnce.Do(func() {
+		log.Infof("Stopping tiered proof archive")
+
+		close(t.quit)
+		t.wg.Wait()
+	})
+
+	return nil
+}
+
+// sweepTiers periodically moves the proofs of spent outputs to the cold store.
+//
+// NOTE: This method MUST be called as a goroutine.
+func (t *TieredArchiver) sweepTiers() {
+	defer t.wg.Done()
+
+	interval := t.cfg.SweepInterval
+	if interval == 0 {
+		interval = DefaultTierSweepInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		ctx, cancel := context.WithTimeout(
+			context.Background(), defaultTierTimeout,
+		)
+		if err := t.SweepSpent(ctx); err != nil {
+			log.Errorf("Unable to move spent proofs to cold "+
+				"store: %v", err)
+		}
+		cancel()
+
+		select {
+		case <-ticker.C:
+		case <-t.quit:
+			return
+		}
+	}
+}
+
+// SweepSpent moves the proofs of all spent outputs to the cold store.
+func (t *TieredArchiver) SweepSpent(ctx context.Context) error {
+	locators, err := t.cfg.SpentProofs(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch spent proofs: %w", err)
+	}
+
+	numMoved, err := t.DemoteProofs(ctx, locators...)
+	if err != nil {
+		return err
+	}
+
+	hot, cold, err := t.Stats(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Moved %d spent proofs to cold store, hot tier: %d "+
+		"proofs (%d bytes), cold tier: %d proofs (%d bytes)", numMoved,
+		hot.NumProofs, hot.SizeBytes, cold.NumProofs, cold.SizeBytes)
+
+	return nil
+}
+
+// DemoteProofs moves the proofs identified by the given locators from the hot
+// to the cold store. Proofs that aren't in the hot store are skipped. The
+// number of moved proofs is returned.
+func (t *TieredArchiver) DemoteProofs(ctx context.Context,
+	locators ...Locator) (int, error) {
+
+	t.moveMtx.Lock()
+	defer t.moveMtx.Unlock()
+
+	var numMoved int
+	for _, loc := range locators {
+		proof, err := t.cfg.Hot.FetchProof(ctx, loc)
+		switch {
+		case errors.Is(err, ErrProofNotFound):
+			continue
+
+		case err != nil:
+			return numMoved, err
+		}
+
+		// We only remove the proof from the hot store once it is
+		// safely stored in the cold store.
+		err = t.cfg.Cold.ImportProofs(ctx, nil, false, &AnnotatedProof{
+			Locator: loc,
+			Blob:    proof,
+		})
+		if err != nil {
+			return numMoved, fmt.Errorf("unable to store proof in "+
+				"cold store: %w", err)
+		}
+
+		err = t.cfg.Hot.RemoveProof(ctx, loc)
+		if err != nil && !errors.Is(err, ErrProofNotFound) {
+			return numMoved, fmt.Errorf("unable to remove proof "+
+				"from hot store: %w", err)
+		}
+
+		numMoved++
+	}
+
+	return numMoved, nil
+}
+
+// Stats returns the size metrics of the hot and the cold store.
+func (t *TieredArchiver) Stats(ctx context.Context) (*TierStats, *TierStats,
+	error) {
+
+	hot, err := t.cfg.Hot.Stats(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to query hot store: %w",
+			err)
+	}
+
+	cold, err := t.cfg.Cold.Stats(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to query cold store: %w",
+			err)
+	}
+
+	return hot, cold, nil
+}
+
+// FetchProof fetches a proof for an asset uniquely identified by the passed
+// locator from the hot store, falling back to the cold store.
+//
+// NOTE: This implements the Archiver interface.
+func (t *TieredArchiver) FetchProof(ctx context.Context,
+	id Locator) (Blob, error) {
+
+	proof, err := t.cfg.Hot.FetchProof(ctx, id)
+	if !errors.Is(err, ErrProofNotFound) {
+		return proof, err
+	}
+
+	return t.cfg.Cold.FetchProof(ctx, id)
+}
+
+// FetchProofs fetches all proofs for assets uniquely identified by the passed
+// asset ID from both stores.
+//
+// NOTE: This implements the Archiver interface.
+func (t *TieredArchiver) FetchProofs(ctx context.Context,
+	id asset.ID) ([]*AnnotatedProof, error) {
+
+	hotProofs, hotErr := t.cfg.Hot.FetchProofs(ctx, id)
+	coldProofs, coldErr := t.cfg.Cold.FetchProofs(ctx, id)
+
+	// As the proofs of an asset might all live in one of the stores, we
+	// only fail if neither store knows about the asset.
+	if hotErr != nil && coldErr != nil {
+		return nil, hotErr
+	}
+
+	// A proof that is being moved might be in both stores for a moment,
+	// in which case the one in the hot store wins.
+	known := make(map[[32]byte]struct{}, len(hotProofs))
+	proofs := make([]*AnnotatedProof, 0, len(hotProofs)+len(coldProofs))
+	for _, proof := range hotProofs {
+		if proof == nil {
+			continue
+		}
+
+		known[proof.Locator.Hash()] = struct{}{}
+		proofs = append(proofs, proof)
+	}
+	for _, proof := range coldProofs {
+		if proof == nil {
+			continue
+		}
+
+		if _, ok := known[proof.Locator.Hash()]; ok {
+			continue
+		}
+		proofs = append(proofs, proof)
+	}
+
+	return proofs, nil
+}
+
+// ImportProofs stores new proofs in the hot store. Proofs that are replaced
+// are updated in the store that currently holds them.
+//
+// NOTE: This implements the Archiver interface.
+func (t *TieredArchiver) ImportProofs(ctx context.Context,
+	headerVerifier HeaderVerifier, replace bool,
+	proofs ...*AnnotatedProof) error {
+
+	if !replace {
+		return t.cfg.Hot.ImportProofs(
+			ctx, headerVerifier, replace, proofs...,
+		)
+	}
+
+	t.moveMtx.Lock()
+	defer t.moveMtx.Unlock()
+
+	var hotProofs, coldProofs []*AnnotatedProof
+	for _, proof := range proofs {
+		_, err := t.cfg.Hot.FetchProof(ctx, proof.Locator)
+		switch {
+		case errors.Is(err, ErrProofNotFound):
+			coldProofs = append(coldProofs, proof)
+
+		case err != nil:
+			return err
+
+		default:
+			hotProofs = append(hotProofs, proof)
+		}
+	}
+
+	if len(hotProofs) > 0 {
+		err := t.cfg.Hot.ImportProofs(
+			ctx, headerVerifier, replace, hotProofs...,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	if len(coldProofs) > 0 {
+		err := t.cfg.Cold.ImportProofs(
+			ctx, headerVerifier, replace, coldProofs...,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// A compile-time assertion to make sure TieredArchiver satisfies the Archiver
+// interface.
+var _ Archiver = (*TieredArchiver)(nil)
@@ -0,0 +1,99 @@
+package proof
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTieredArchiver tests that proofs of spent outputs are moved to the cold
+// store and can still be fetched transparently.
+func TestTieredArchiver(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	hot, err := NewFileArchiver(t.TempDir())
+	require.NoError(t, err)
+	cold, err := NewFileArchiver(t.TempDir(), WithCompressedProofs())
+	require.NoError(t, err)
+
+	assetID := randAssetID(t)
+	unspent := Locator{AssetID: assetID, ScriptKey: *test.RandPubKey(t)}
+	spent := Locator{AssetID: assetID, ScriptKey: *test.RandPubKey(t)}
+	unspentProof := Blob(test.RandBytes(100))
+	spentProof := Blob(test.RandBytes(100))
+
+	archive := NewTieredArchiver(&TieredArchiverConfig{
+		Hot:  hot,
+		Cold: cold,
+		SpentProofs: func(context.Context) ([]Locator, error) {
+			return []Locator{spent}, nil
+		},
+	})
+
+	err = archive.ImportProofs(ctx, nil, false, &AnnotatedProof{
+		Locator: unspent,
+		Blob:    unspentProof,
+	}, &AnnotatedProof{
+		Locator: spent,
+		Blob:    spentProof,
+	})
+	require.NoError(t, err)
+
+	hotStats, coldStats, err := archive.Stats(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, hotStats.NumProofs)
+	require.EqualValues(t, 200, hotStats.SizeBytes)
+	require.Zero(t, coldStats.NumProofs)
+
+	// Sweeping moves the spent proof to the cold store, a second sweep
+	// doesn't move anything.
+	require.NoError(t, archive.SweepSpent(ctx))
+	numMoved, err := archive.DemoteProofs(ctx, spent)
+	require.NoError(t, err)
+	require.Zero(t, numMoved)
+
+	hotStats, coldStats, err = archive.Stats(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, hotStats.NumProofs)
+	require.EqualValues(t, 1, coldStats.NumProofs)
+
+	_, err = hot.FetchProof(ctx, spent)
+	require.ErrorIs(t, err, ErrProofNotFound)
+
+	// Both proofs can still be fetched through the tiered archive, the
+	// cold one is decompressed transparently.
+	proof, err := archive.FetchProof(ctx, spent)
+	require.NoError(t, err)
+	require.Equal(t, spentProof, proof)
+
+	proof, err = archive.FetchProof(ctx, unspent)
+	require.NoError(t, err)
+	require.Equal(t, unspentProof, proof)
+
+	proofs, err := archive.FetchProofs(ctx, *assetID)
+	require.NoError(t, err)
+	require.Len(t, proofs, 2)
+
+	// Replacing a cold proof updates it in the cold store.
+	newProof := Blob(test.RandBytes(100))
+	err = archive.ImportProofs(ctx, nil, true, &AnnotatedProof{
+		Locator: spent,
+		Blob:    newProof,
+	})
+	require.NoError(t, err)
+
+	proof, err = cold.FetchProof(ctx, spent)
+	require.NoError(t, err)
+	require.Equal(t, newProof, proof)
+
+	// Unknown proofs are reported as such.
+	_, err = archive.FetchProof(ctx, Locator{
+		AssetID:   assetID,
+		ScriptKey: *test.RandPubKey(t),
+	})
+	require.ErrorIs(t, err, ErrProofNotFound)
+}
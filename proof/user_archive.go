@@ -0,0 +1,77 @@
+package proof
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sync"
+)
+
+// maxArchiveUserLen is the maximum length of the name of a user of a central
+// proof archive.
+const maxArchiveUserLen = 64
+
+// archiveUserPattern matches valid names of users of a central proof archive.
+// As the name is used as a directory name, we only allow a safe subset of
+// characters.
+var archiveUserPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// ValidateArchiveUser makes sure the given name can be used as the name of a
+// user of a central proof archive.
+func ValidateArchiveUser(user string) error {
+	switch {
+	case len(user) > maxArchiveUserLen:
+		return fmt.Errorf("archive user name too long: %d characters, "+
+			"max %d", len(user), maxArchiveUserLen)
+
+	case !archiveUserPattern.MatchString(user):
+		return fmt.Errorf("invalid archive user name %q, only "+
+			"letters, digits, '_' and '-' are allowed", user)
+	}
+
+	return nil
+}
+
+// UserArchives is the storage of a central proof archive that is shared by the
+// nodes of many users, for example the signer nodes of a hosted wallet. The
+// proofs of each user are kept in a separate file archive, so a user can only
+// ever read back the proofs it pushed itself.
+type UserArchives struct {
+	baseDir string
+
+	mtx      sync.Mutex
+	archives map[string]*FileArchiver
+}
+
+// NewUserArchives creates a new set of per-user archives below the given base
+// directory.
+func NewUserArchives(baseDir string) *UserArchives {
+	return &UserArchives{
+		baseDir:  baseDir,
+		archives: make(map[string]*FileArchiver),
+	}
+}
+
+// ForUser returns the archive of the given user, creating it if the user
+// didn't push any proofs yet.
+func (u *UserArchives) ForUser(user string) (*FileArchiver, error) {
+	if err := ValidateArchiveUser(user); err != nil {
+		return nil, err
+	}
+
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	if archive, ok := u.archives[user]; ok {
+		return archive, nil
+	}
+
+	archive, err := NewFileArchiver(filepath.Join(u.baseDir, user))
+	if err != nil {
+		return nil, fmt.Errorf("unable to open archive of user %v: %w",
+			user, err)
+	}
+	u.archives[user] = archive
+
+	return archive, nil
+}
@@ -30,6 +30,8 @@ type Verifier interface {
 // BaseVerifier implements a simple verifier that loads the entire proof file
 // into memory and then verifies it all at once.
 type BaseVerifier struct {
+	// Limits bounds the size of the proof files that are verified.
+	Limits FileLimits
 }
 
 // Verify takes the passed serialized proof file, and returns a nil
@@ -39,7 +41,7 @@ func (b *BaseVerifier) Verify(ctx context.Context, blobReader io.Reader,
 	headerVerifier HeaderVerifier) (*AssetSnapshot, error) {
 
 	var proofFile File
-	err := proofFile.Decode(blobReader)
+	err := proofFile.DecodeWithLimits(blobReader, b.Limits)
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse proof: %w", err)
 	}
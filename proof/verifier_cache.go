@@ -0,0 +1,138 @@
+package proof
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/neutrino/cache"
+	"github.com/lightninglabs/neutrino/cache/lru"
+)
+
+const (
+	// DefaultVerifyCacheSize is the default number of verified state
+	// transitions that are cached.
+	DefaultVerifyCacheSize = 10_000
+)
+
+// verifiedTransition is the cached result of a verified state transition.
+type verifiedTransition struct {
+	// blockHeader is the header of the block the transition's anchor
+	// transaction was mined in.
+	blockHeader wire.BlockHeader
+
+	// blockHeight is the height of the block above.
+	blockHeight uint32
+
+	// snapshot is the result of the verification.
+	snapshot *AssetSnapshot
+}
+
+// Size returns the size of the cached transition. Since we scale the cache by
+// the number of items and not the total memory size, we can simply return 1
+// here to count each transition as 1 item.
+func (v *verifiedTransition) Size() (uint64, error) {
+	return 1, nil
+}
+
+// verifyCache caches the results of verified state transitions, keyed by the
+// chained hash of the proof file prefix that ends with the transition. Because
+// the validity of a transition only depends on the transition itself and the
+// previous one, a cached prefix stays valid when new transitions are appended
+// to the file.
+type verifyCache struct {
+	cache *lru.Cache[[sha256.Size]byte, *verifiedTransition]
+}
+
+// newVerifyCache creates a new cache for the given number of transitions.
+func newVerifyCache(numEntries int) *verifyCache {
+	return &verifyCache{
+		cache: lru.NewCache[[sha256.Size]byte, *verifiedTransition](
+			uint64(numEntries),
+		),
+	}
+}
+
+// verifiedPrefix returns the number of transitions at the start of the given
+// file that were verified before, along with the snapshot of the last of them.
+// The block headers of the cached transitions are checked again, to make sure
+// none of them were re-organized out of the chain since.
+func (c *verifyCache) verifiedPrefix(f *File,
+	headerVerifier HeaderVerifier) (int, *AssetSnapshot, error) {
+
+	var (
+		numVerified int
+		last        *verifiedTransition
+	)
+	for _, p := range f.proofs {
+		transition, err := c.cache.Get(p.hash)
+		if errors.Is(err, cache.ErrElementNotFound) {
+			break
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+
+		err = headerVerifier(
+			transition.blockHeader, transition.blockHeight,
+		)
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to validate proof "+
+				"block header: %w", err)
+		}
+
+		numVerified++
+		last = transition
+	}
+
+	if last == nil {
+		return 0, nil, nil
+	}
+
+	snapshot, err := copySnapshot(last.snapshot)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return numVerified, snapshot, nil
+}
+
+// add caches the result of the verified transition at the given index of the
+// file.
+func (c *verifyCache) add(f *File, idx int, p *Proof,
+	snapshot *AssetSnapshot) error {
+
+	cachedSnapshot, err := copySnapshot(snapshot)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.cache.Put(f.proofs[idx].hash, &verifiedTransition{
+		blockHeader: p.BlockHeader,
+		blockHeight: p.BlockHeight,
+		snapshot:    cachedSnapshot,
+	})
+
+	return err
+}
+
+// copySnapshot returns a deep copy of the given snapshot, so cached snapshots
+// can't be modified by the callers they are returned to.
+func copySnapshot(snapshot *AssetSnapshot) (*AssetSnapshot, error) {
+	snapshotCopy := *snapshot
+	snapshotCopy.Asset = snapshot.Asset.Copy()
+	if snapshot.AnchorTx != nil {
+		snapshotCopy.AnchorTx = snapshot.AnchorTx.Copy()
+	}
+	if snapshot.ScriptRoot != nil {
+		scriptRoot, err := snapshot.ScriptRoot.Copy()
+		if err != nil {
+			return nil, fmt.Errorf("unable to copy script root: %w",
+				err)
+		}
+		snapshotCopy.ScriptRoot = scriptRoot
+	}
+
+	return &snapshotCopy, nil
+}
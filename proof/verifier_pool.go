@@ -0,0 +1,214 @@
+package proof
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"runtime"
+
+	"github.com/btcsuite/btcd/wire"
+	"golang.org/x/sync/errgroup"
+)
+
+// VerifierPool is a Verifier that verifies the state transitions of proof
+// files on a bounded number of workers. The transitions of a single file are
+// verified in parallel, and the workers are shared by all files that are
+// verified at the same time, so the number of CPU-heavy verifications in
+// flight never exceeds the worker count.
+type VerifierPool struct {
+	// workers is a semaphore that holds one entry per busy worker.
+	workers chan struct{}
+
+	// cache is the optional cache of verified transitions.
+	cache *verifyCache
+
+	// limits bounds the size of the proof files that are verified.
+	limits FileLimits
+}
+
+// VerifierPoolOption is a functional option for the verifier pool.
+type VerifierPoolOption func(*VerifierPool)
+
+// WithVerifyCache caches the results of up to the given number of verified
+// state transitions. Re-verifying a proof file that only had new transitions
+// appended to it then only verifies the new transitions. The block headers of
+// the cached transitions are still checked on every verification.
+func WithVerifyCache(numEntries int) VerifierPoolOption {
+	return func(v *VerifierPool) {
+		if numEntries > 0 {
+			v.cache = newVerifyCache(numEntries)
+		}
+	}
+}
+
+// WithFileLimits rejects proof files that exceed the given limits before they
+// are decoded or verified.
+func WithFileLimits(limits FileLimits) VerifierPoolOption {
+	return func(v *VerifierPool) {
+		v.limits = limits
+	}
+}
+
+// NewVerifierPool creates a new verifier pool with the given number of
+// workers. If the number of workers is not positive, one worker per CPU is
+// used.
+func NewVerifierPool(numWorkers int,
+	opts ...VerifierPoolOption) *VerifierPool {
+
+	if numWorkers <= 0 {
+		numWorkers = runtime.NumCPU()
+	}
+
+	v := &VerifierPool{
+		workers: make(chan struct{}, numWorkers),
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// NumWorkers returns the number of workers of the pool.
+func (v *VerifierPool) NumWorkers() int {
+	return cap(v.workers)
+}
+
+// Verify takes the passed serialized proof file, and returns a nil error if the
+// proof file is valid. A valid file should return an AssetSnapshot of the
+// final state transition of the file.
+//
+// NOTE: This is part of the Verifier interface.
+func (v *VerifierPool) Verify(ctx context.Context, blobReader io.Reader,
+	headerVerifier HeaderVerifier) (*AssetSnapshot, error) {
+
+	var proofFile File
+	err := proofFile.DecodeWithLimits(blobReader, v.limits)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse proof: %w", err)
+	}
+
+	return v.VerifyFile(ctx, &proofFile, headerVerifier)
+}
+
+// VerifyFile verifies a full proof file starting from the asset's genesis, like
+// File.Verify. Each state transition only depends on the asset and outpoint of
+// the previous transition, which are part of the previous proof itself, so all
+// transitions are verified in parallel. The file is only valid if every
+// transition is valid. If the pool has a cache, the transitions of a prefix of
+// the file that was verified before are skipped.
+func (v *VerifierPool) VerifyFile(ctx context.Context, f *File,
+	headerVerifier HeaderVerifier) (*AssetSnapshot, error) {
+
+	// Files that were decoded elsewhere still need to honor the limits.
+	err := v.limits.CheckNumProofs(uint64(f.NumProofs()))
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		numCached      int
+		cachedSnapshot *AssetSnapshot
+	)
+	if v.cache != nil {
+		numCached, cachedSnapshot, err = v.cache.verifiedPrefix(
+			f, headerVerifier,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	proofs := make([]*Proof, f.NumProofs())
+	for idx := numCached; idx < len(proofs); idx++ {
+		decodedProof, err := f.ProofAt(uint32(idx))
+		if err != nil {
+			return nil, err
+		}
+		proofs[idx] = decodedProof
+	}
+
+	errGroup, ctx := errgroup.WithContext(ctx)
+	results := make([]*AssetSnapshot, len(proofs))
+	for idx := numCached; idx < len(proofs); idx++ {
+		idx := idx
+
+		var prev *AssetSnapshot
+		switch {
+		case idx == numCached:
+			prev = cachedSnapshot
+
+		case idx > 0:
+			prev = unverifiedSnapshot(proofs[idx-1])
+		}
+
+		errGroup.Go(func() error {
+			result, err := v.VerifyProof(
+				ctx, proofs[idx], prev, headerVerifier,
+			)
+			if err != nil {
+				return fmt.Errorf("invalid proof %d: %w", idx,
+					err)
+			}
+			results[idx] = result
+
+			return nil
+		})
+	}
+	if err := errGroup.Wait(); err != nil {
+		return nil, err
+	}
+
+	// The whole file was verified before, so the cached snapshot is the
+	// final state of the file.
+	if numCached == len(proofs) {
+		return cachedSnapshot, nil
+	}
+
+	if v.cache != nil {
+		for idx := numCached; idx < len(proofs); idx++ {
+			err := v.cache.add(f, idx, proofs[idx], results[idx])
+			if err != nil {
+				return nil, fmt.Errorf("unable to cache "+
+					"verified proof %d: %w", idx, err)
+			}
+		}
+	}
+
+	return results[len(results)-1], nil
+}
+
+// VerifyProof verifies a single state transition on one of the workers of the
+// pool, blocking until a worker is free.
+func (v *VerifierPool) VerifyProof(ctx context.Context, p *Proof,
+	prev *AssetSnapshot, headerVerifier HeaderVerifier) (*AssetSnapshot,
+	error) {
+
+	select {
+	case v.workers <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() {
+		<-v.workers
+	}()
+
+	return p.Verify(ctx, prev, headerVerifier)
+}
+
+// unverifiedSnapshot returns the parts of the snapshot of the given proof that
+// the verification of the next state transition depends on, without verifying
+// the proof itself.
+func unverifiedSnapshot(p *Proof) *AssetSnapshot {
+	return &AssetSnapshot{
+		Asset: &p.Asset,
+		OutPoint: wire.OutPoint{
+			Hash:  p.AnchorTx.TxHash(),
+			Index: p.InclusionProof.OutputIndex,
+		},
+	}
+}
+
+// A compile-time assertion to ensure VerifierPool meets the Verifier
+// interface.
+var _ Verifier = (*VerifierPool)(nil)
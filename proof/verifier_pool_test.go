@@ -0,0 +1,198 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// loadProofFile loads the proof file test vector.
+func loadProofFile(t *testing.T) *File {
+	proofHex, err := os.ReadFile(proofFileHexFileName)
+	require.NoError(t, err)
+
+	proofBytes, err := hex.DecodeString(
+		strings.Trim(string(proofHex), "\n"),
+	)
+	require.NoError(t, err)
+
+	f := &File{}
+	require.NoError(t, f.Decode(bytes.NewReader(proofBytes)))
+
+	return f
+}
+
+// TestVerifierPool tests that the verifier pool verifies proof files like the
+// sequential verification does.
+func TestVerifierPool(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := loadProofFile(t)
+	pool := NewVerifierPool(2)
+
+	expected, err := f.Verify(ctx, MockHeaderVerifier)
+	require.NoError(t, err)
+
+	snapshot, err := pool.VerifyFile(ctx, f, MockHeaderVerifier)
+	require.NoError(t, err)
+	require.Equal(t, expected.OutPoint, snapshot.OutPoint)
+	require.Equal(t, expected.Asset, snapshot.Asset)
+	require.Equal(t, expected.ScriptRoot, snapshot.ScriptRoot)
+
+	// A single invalid transition invalidates the whole file.
+	invalidProof, err := f.ProofAt(1)
+	require.NoError(t, err)
+	invalidProof.Asset.Amount++
+	require.NoError(t, f.ReplaceProofAt(1, *invalidProof))
+
+	_, err = f.Verify(ctx, MockHeaderVerifier)
+	require.Error(t, err)
+
+	_, err = pool.VerifyFile(ctx, f, MockHeaderVerifier)
+	require.ErrorContains(t, err, "invalid proof")
+}
+
+// TestVerifierPoolBounded tests that the workers of the pool are shared by all
+// files that are verified at the same time.
+func TestVerifierPoolBounded(t *testing.T) {
+	t.Parallel()
+
+	const numWorkers = 2
+
+	var (
+		mtx       sync.Mutex
+		active    int
+		maxActive int
+	)
+	headerVerifier := func(wire.BlockHeader, uint32) error {
+		mtx.Lock()
+		active++
+		if active > maxActive {
+			maxActive = active
+		}
+		mtx.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mtx.Lock()
+		active--
+		mtx.Unlock()
+
+		return nil
+	}
+
+	ctx := context.Background()
+	pool := NewVerifierPool(numWorkers)
+	require.Equal(t, numWorkers, pool.NumWorkers())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		f := loadProofFile(t)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := pool.VerifyFile(ctx, f, headerVerifier)
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, numWorkers, maxActive)
+
+	// Without a free worker, the verification gives up once the context
+	// is canceled.
+	for i := 0; i < numWorkers; i++ {
+		pool.workers <- struct{}{}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	_, err := pool.VerifyFile(ctx, loadProofFile(t), MockHeaderVerifier)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+// TestVerifierPoolCache tests that re-verifying a proof file with a new
+// transition appended only verifies the new transition.
+func TestVerifierPoolCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fullFile := loadProofFile(t)
+	pool := NewVerifierPool(2, WithVerifyCache(DefaultVerifyCacheSize))
+
+	expected, err := fullFile.Verify(ctx, MockHeaderVerifier)
+	require.NoError(t, err)
+
+	// We start out with a file that only has the first two transitions.
+	f := &File{}
+	for idx := uint32(0); idx < 2; idx++ {
+		p, err := fullFile.ProofAt(idx)
+		require.NoError(t, err)
+		require.NoError(t, f.AppendProof(*p))
+	}
+
+	numCached, _, err := pool.cache.verifiedPrefix(f, MockHeaderVerifier)
+	require.NoError(t, err)
+	require.Zero(t, numCached)
+
+	_, err = pool.VerifyFile(ctx, f, MockHeaderVerifier)
+	require.NoError(t, err)
+
+	numCached, _, err = pool.cache.verifiedPrefix(f, MockHeaderVerifier)
+	require.NoError(t, err)
+	require.Equal(t, 2, numCached)
+
+	// Once the last transition is appended, only the new transition is
+	// verified, starting from the cached snapshot.
+	lastProof, err := fullFile.ProofAt(2)
+	require.NoError(t, err)
+	require.NoError(t, f.AppendProof(*lastProof))
+
+	numCached, _, err = pool.cache.verifiedPrefix(f, MockHeaderVerifier)
+	require.NoError(t, err)
+	require.Equal(t, 2, numCached)
+
+	snapshot, err := pool.VerifyFile(ctx, f, MockHeaderVerifier)
+	require.NoError(t, err)
+	require.Equal(t, expected.OutPoint, snapshot.OutPoint)
+	require.Equal(t, expected.Asset, snapshot.Asset)
+
+	// A fully cached file returns a copy of the snapshot of its last
+	// transition.
+	snapshot, err = pool.VerifyFile(ctx, f, MockHeaderVerifier)
+	require.NoError(t, err)
+	require.Equal(t, expected.OutPoint, snapshot.OutPoint)
+	require.True(t, expected.Asset.DeepEqual(snapshot.Asset))
+
+	// The block headers of cached transitions are still checked, so a
+	// re-organized transition is detected.
+	errReorg := errors.New("block not in chain")
+	_, err = pool.VerifyFile(
+		ctx, f, func(wire.BlockHeader, uint32) error {
+			return errReorg
+		},
+	)
+	require.ErrorIs(t, err, errReorg)
+
+	// Changing a cached transition changes the hash of all following
+	// prefixes, so the changed file is verified again.
+	invalidProof, err := f.ProofAt(1)
+	require.NoError(t, err)
+	invalidProof.Asset.Amount++
+	require.NoError(t, f.ReplaceProofAt(1, *invalidProof))
+
+	_, err = pool.VerifyFile(ctx, f, MockHeaderVerifier)
+	require.ErrorContains(t, err, "invalid proof")
+}
@@ -0,0 +1,253 @@
+package taprootassets
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon.v2"
+)
+
+// ArchiveUserCaveat is the name of the custom macaroon caveat that scopes a
+// macaroon to a single user of the central proof archive.
+const ArchiveUserCaveat = "proof-archive-user"
+
+// archiveMacaroonOps are the permissions of a macaroon that is scoped to a
+// user of the central proof archive.
+var archiveMacaroonOps = []bakery.Op{{
+	Entity: "proofarchive",
+	Action: "read",
+}, {
+	Entity: "proofarchive",
+	Action: "write",
+}}
+
+// archiveUserFromContext returns the archive user the macaroon of the given
+// request context is scoped to. As caveats can be added by anyone holding a
+// macaroon, all archive user caveats must name the same user.
+func archiveUserFromContext(ctx context.Context) (string, error) {
+	mac, err := macaroonFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		user        string
+		caveatUsers = customCaveatConditions(mac, ArchiveUserCaveat)
+	)
+	for _, caveatUser := range caveatUsers {
+		if user != "" && caveatUser != user {
+			return "", fmt.Errorf("macaroon is scoped to " +
+				"multiple archive users")
+		}
+		user = caveatUser
+	}
+
+	if user == "" {
+		return "", fmt.Errorf("macaroon is not scoped to an archive " +
+			"user")
+	}
+
+	return user, proof.ValidateArchiveUser(user)
+}
+
+// RemoteProofArchive is a proof archive that is backed by the central proof
+// archive served by another node. All proofs are pushed to and pulled from the
+// archive of the user the macaroon of the connection is scoped to.
+type RemoteProofArchive struct {
+	client taprpc.TaprootAssetsClient
+}
+
+// NewRemoteProofArchive connects to the central proof archive served at the
+// given host, authenticating with the user scoped macaroon at the given path.
+//
+// NOTE: The TLS certificate path argument (tlsCertPath) is optional. If unset,
+// then the system's TLS trust store is used.
+func NewRemoteProofArchive(host, tlsCertPath,
+	macaroonPath string) (*RemoteProofArchive, error) {
+
+	creds := credentials.NewTLS(&tls.Config{})
+	if tlsCertPath != "" {
+		var err error
+		creds, err = credentials.NewClientTLSFromFile(tlsCertPath, "")
+		if err != nil {
+			return nil, fmt.Errorf("unable to read TLS cert: %w",
+				err)
+		}
+	}
+
+	macBytes, err := os.ReadFile(macaroonPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read macaroon: %w", err)
+	}
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return nil, fmt.Errorf("unable to decode macaroon: %w", err)
+	}
+	macCred, err := macaroons.NewMacaroonCredential(mac)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.Dial(
+		host, grpc.WithTransportCredentials(creds),
+		grpc.WithPerRPCCredentials(macCred),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(lnrpc.MaxGrpcMsgSize),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to central proof "+
+			"archive: %w", err)
+	}
+
+	return &RemoteProofArchive{
+		client: taprpc.NewTaprootAssetsClient(conn),
+	}, nil
+}
+
+// FetchProof pulls a proof for an asset uniquely identified by the passed
+// locator from the central archive.
+//
+// NOTE: This implements the proof.Archiver interface.
+func (r *RemoteProofArchive) FetchProof(ctx context.Context,
+	id proof.Locator) (proof.Blob, error) {
+
+	if id.AssetID == nil {
+		return nil, proof.ErrInvalidLocatorID
+	}
+
+	req := &taprpc.PullArchiveProofRequest{
+		AssetId:   id.AssetID[:],
+		ScriptKey: id.ScriptKey.SerializeCompressed(),
+	}
+	if id.GroupKey != nil {
+		req.GroupKey = id.GroupKey.SerializeCompressed()
+	}
+
+	resp, err := r.client.PullArchiveProof(ctx, req)
+	switch {
+	case status.Code(err) == codes.NotFound:
+		return nil, proof.ErrProofNotFound
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to pull proof: %w", err)
+	}
+
+	return resp.RawProofFile, nil
+}
+
+// FetchProofs isn't supported by the central archive, as it's only used as
+// the backing store of a local cache.
+//
+// NOTE: This implements the proof.Archiver interface.
+func (r *RemoteProofArchive) FetchProofs(context.Context,
+	asset.ID) ([]*proof.AnnotatedProof, error) {
+
+	return nil, fmt.Errorf("listing proofs of the central proof " +
+		"archive is not supported")
+}
+
+// ImportProofs pushes the proofs to the central archive. Proofs that are
+// already stored are replaced.
+//
+// NOTE: This implements the proof.Archiver interface.
+func (r *RemoteProofArchive) ImportProofs(ctx context.Context,
+	_ proof.HeaderVerifier, _ bool, proofs ...*proof.AnnotatedProof) error {
+
+	req := &taprpc.PushArchiveProofsRequest{
+		Proofs: make([]*taprpc.ArchivedProof, 0, len(proofs)),
+	}
+	for _, p := range proofs {
+		if p.AssetID == nil {
+			return proof.ErrInvalidLocatorID
+		}
+
+		archived := &taprpc.ArchivedProof{
+			AssetId:      p.AssetID[:],
+			ScriptKey:    p.ScriptKey.SerializeCompressed(),
+			RawProofFile: p.Blob,
+		}
+		if p.GroupKey != nil {
+			archived.GroupKey = p.GroupKey.SerializeCompressed()
+		}
+		req.Proofs = append(req.Proofs, archived)
+	}
+
+	_, err := r.client.PushArchiveProofs(ctx, req)
+	return err
+}
+
+// A compile-time assertion to make sure RemoteProofArchive satisfies the
+// proof.Archiver interface.
+var _ proof.Archiver = (*RemoteProofArchive)(nil)
+
+// unmarshalArchiveLocator parses the locator of a proof in the central proof
+// archive.
+func unmarshalArchiveLocator(assetIDBytes, groupKeyBytes,
+	scriptKeyBytes []byte) (*proof.Locator, error) {
+
+	if len(assetIDBytes) != len(asset.ID{}) {
+		return nil, fmt.Errorf("asset ID must be 32 bytes")
+	}
+	var assetID asset.ID
+	copy(assetID[:], assetIDBytes)
+
+	scriptKey, err := btcec.ParsePubKey(scriptKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script key: %w", err)
+	}
+
+	loc := &proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *scriptKey,
+	}
+	if len(groupKeyBytes) > 0 {
+		loc.GroupKey, err = btcec.ParsePubKey(groupKeyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group key: %w", err)
+		}
+	}
+
+	return loc, nil
+}
+
+// checkArchivedProof makes sure the given proof file decodes and ends in the
+// asset identified by the given locator, so a user can't store garbage under
+// the locator of another proof.
+func checkArchivedProof(loc *proof.Locator, blob proof.Blob) error {
+	file := proof.NewEmptyFile(proof.V0)
+	if err := file.Decode(bytes.NewReader(blob)); err != nil {
+		return fmt.Errorf("unable to decode proof file: %w", err)
+	}
+
+	lastProof, err := file.LastProof()
+	if err != nil {
+		return fmt.Errorf("unable to fetch last proof: %w", err)
+	}
+
+	lastAsset := lastProof.Asset
+	switch {
+	case lastAsset.ID() != *loc.AssetID:
+		return fmt.Errorf("proof is for asset %v, not %v",
+			lastAsset.ID(), loc.AssetID)
+
+	case !lastAsset.ScriptKey.PubKey.IsEqual(&loc.ScriptKey):
+		return fmt.Errorf("proof is for a different script key")
+	}
+
+	return nil
+}
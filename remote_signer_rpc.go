@@ -0,0 +1,312 @@
+package taprootassets
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/taprpc/remotesignerrpc"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon.v2"
+)
+
+// sessionExpiryMargin is the time before the expiry of a signing session at
+// which a new session is opened, so a request doesn't race the expiry.
+const sessionExpiryMargin = time.Minute
+
+// RpcVirtualPacketSignerCfg is the configuration of a connection to a remote
+// signer.
+type RpcVirtualPacketSignerCfg struct {
+	// Host is the host:port of the remote signer.
+	Host string
+
+	// TLSCertPath is the path to the TLS certificate of the remote signer.
+	// If empty, the system's root certificates are used.
+	TLSCertPath string
+
+	// MacaroonPath is the path to the macaroon that authenticates the
+	// daemon with the remote signer.
+	MacaroonPath string
+
+	// ClientName is the name the daemon opens its signing sessions with.
+	ClientName string
+
+	// Timeout is the maximum time to wait for the remote signer to sign a
+	// virtual packet.
+	Timeout time.Duration
+}
+
+// RpcVirtualPacketSigner is an implementation of the
+// tapfreighter.VirtualPacketSigner interface that sends the virtual packets of
+// a watch-only daemon to a remote signer over RPC.
+type RpcVirtualPacketSigner struct {
+	cfg *RpcVirtualPacketSignerCfg
+
+	conn remotesignerrpc.RemoteSignerClient
+
+	mtx           sync.Mutex
+	sessionID     []byte
+	sessionExpiry time.Time
+}
+
+// NewRpcVirtualPacketSigner creates a new RpcVirtualPacketSigner that dials out
+// to the configured remote signer.
+func NewRpcVirtualPacketSigner(
+	cfg *RpcVirtualPacketSignerCfg) (*RpcVirtualPacketSigner, error) {
+
+	creds := credentials.NewTLS(&tls.Config{})
+	if cfg.TLSCertPath != "" {
+		var err error
+		creds, err = credentials.NewClientTLSFromFile(
+			cfg.TLSCertPath, "",
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load remote signer "+
+				"TLS certificate: %w", err)
+		}
+	}
+
+	macBytes, err := os.ReadFile(cfg.MacaroonPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read macaroon: %w", err)
+	}
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return nil, fmt.Errorf("unable to decode macaroon: %w", err)
+	}
+	macCred, err := macaroons.NewMacaroonCredential(mac)
+	if err != nil {
+		return nil, err
+	}
+
+	rawConn, err := grpc.Dial(
+		cfg.Host, grpc.WithTransportCredentials(creds),
+		grpc.WithPerRPCCredentials(macCred),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(lnrpc.MaxGrpcMsgSize),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to remote signer: "+
+			"%w", err)
+	}
+
+	return &RpcVirtualPacketSigner{
+		cfg:  cfg,
+		conn: remotesignerrpc.NewRemoteSignerClient(rawConn),
+	}, nil
+}
+
+// session returns the ID of the current signing session, opening a new one if
+// there is none yet or it's about to expire.
+func (r *RpcVirtualPacketSigner) session(ctx context.Context,
+	forceNew bool) ([]byte, error) {
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	expiresSoon := time.Now().Add(sessionExpiryMargin).After(
+		r.sessionExpiry,
+	)
+	if r.sessionID != nil && !expiresSoon && !forceNew {
+		return r.sessionID, nil
+	}
+
+	resp, err := r.conn.OpenSession(
+		ctx, &remotesignerrpc.OpenSessionRequest{
+			ClientName: r.cfg.ClientName,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open signing session: %w",
+			err)
+	}
+
+	r.sessionID = resp.SessionId
+	r.sessionExpiry = time.Unix(resp.ExpiryTimestamp, 0)
+
+	return r.sessionID, nil
+}
+
+// SignVirtualPacket sends the virtual packet to the remote signer and returns
+// the signed packet.
+//
+// NOTE: This is part of the tapfreighter.VirtualPacketSigner interface.
+func (r *RpcVirtualPacketSigner) SignVirtualPacket(ctx context.Context,
+	vPkt *tappsbt.VPacket) (*tappsbt.VPacket, error) {
+
+	summary, err := tapfreighter.SummarizeVirtualPacket(vPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := vPkt.Serialize(&buf); err != nil {
+		return nil, fmt.Errorf("unable to serialize virtual packet: %w",
+			err)
+	}
+
+	ctxt, cancel := context.WithTimeout(ctx, r.cfg.Timeout)
+	defer cancel()
+
+	req := &remotesignerrpc.SignVirtualPacketRequest{
+		VirtualPsbt: buf.Bytes(),
+		Summary:     marshalPacketSummary(summary),
+	}
+
+	// If the remote signer forgot our session, for example because it was
+	// restarted, we open a new one and try again.
+	var resp *remotesignerrpc.SignVirtualPacketResponse
+	for _, forceNew := range []bool{false, true} {
+		req.SessionId, err = r.session(ctxt, forceNew)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err = r.conn.SignVirtualPacket(ctxt, req)
+		if status.Code(err) != codes.Unauthenticated {
+			break
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("remote signer failed to sign virtual "+
+			"packet: %w", err)
+	}
+
+	return tappsbt.NewFromRawBytes(
+		bytes.NewReader(resp.SignedVirtualPsbt), false,
+	)
+}
+
+// A compile time interface to ensure that RpcVirtualPacketSigner implements
+// the tapfreighter.VirtualPacketSigner interface.
+var _ tapfreighter.VirtualPacketSigner = (*RpcVirtualPacketSigner)(nil)
+
+// marshalPacketSummary converts the summary of a virtual packet to its RPC
+// counterpart.
+func marshalPacketSummary(
+	summary *tapfreighter.PacketSummary) *remotesignerrpc.PacketSummary {
+
+	rpcSummary := &remotesignerrpc.PacketSummary{
+		Inputs: make(
+			[]*remotesignerrpc.PacketInput, len(summary.Inputs),
+		),
+		Outputs: make(
+			[]*remotesignerrpc.PacketOutput, len(summary.Outputs),
+		),
+	}
+
+	for idx, in := range summary.Inputs {
+		rpcSummary.Inputs[idx] = &remotesignerrpc.PacketInput{
+			AssetId:        fn.ByteSlice(in.AssetID),
+			Amount:         in.Amount,
+			ScriptKey:      in.ScriptKey.SerializeCompressed(),
+			AnchorOutpoint: in.AnchorPoint.String(),
+		}
+	}
+
+	for idx, out := range summary.Outputs {
+		rpcSummary.Outputs[idx] = &remotesignerrpc.PacketOutput{
+			AssetId:           fn.ByteSlice(out.AssetID),
+			Amount:            out.Amount,
+			ScriptKey:         out.ScriptKey.SerializeCompressed(),
+			AnchorOutputIndex: out.AnchorOutputIndex,
+			SplitRoot:         out.SplitRoot,
+		}
+	}
+
+	return rpcSummary
+}
+
+// unmarshalPacketSummary parses the summary of a virtual packet from its RPC
+// counterpart.
+func unmarshalPacketSummary(
+	rpcSummary *remotesignerrpc.PacketSummary) (*tapfreighter.PacketSummary,
+	error) {
+
+	if rpcSummary == nil {
+		return nil, fmt.Errorf("packet summary must be set")
+	}
+
+	parseAssetID := func(idBytes []byte) (asset.ID, error) {
+		var assetID asset.ID
+		if len(idBytes) != len(assetID) {
+			return assetID, fmt.Errorf("asset ID must be 32 bytes")
+		}
+		copy(assetID[:], idBytes)
+
+		return assetID, nil
+	}
+
+	summary := &tapfreighter.PacketSummary{
+		Inputs: make(
+			[]tapfreighter.PacketInputSummary,
+			len(rpcSummary.Inputs),
+		),
+		Outputs: make(
+			[]tapfreighter.PacketOutputSummary,
+			len(rpcSummary.Outputs),
+		),
+	}
+
+	for idx, in := range rpcSummary.Inputs {
+		assetID, err := parseAssetID(in.AssetId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input %d: %w", idx, err)
+		}
+		scriptKey, err := btcec.ParsePubKey(in.ScriptKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input %d script key: "+
+				"%w", idx, err)
+		}
+		anchorPoint, err := UnmarshalOutpoint(in.AnchorOutpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input %d anchor "+
+				"outpoint: %w", idx, err)
+		}
+
+		summary.Inputs[idx] = tapfreighter.PacketInputSummary{
+			AssetID:     assetID,
+			Amount:      in.Amount,
+			ScriptKey:   scriptKey,
+			AnchorPoint: *anchorPoint,
+		}
+	}
+
+	for idx, out := range rpcSummary.Outputs {
+		assetID, err := parseAssetID(out.AssetId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid output %d: %w", idx,
+				err)
+		}
+		scriptKey, err := btcec.ParsePubKey(out.ScriptKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid output %d script key: "+
+				"%w", idx, err)
+		}
+
+		summary.Outputs[idx] = tapfreighter.PacketOutputSummary{
+			AssetID:           assetID,
+			Amount:            out.Amount,
+			ScriptKey:         scriptKey,
+			AnchorOutputIndex: out.AnchorOutputIndex,
+			SplitRoot:         out.SplitRoot,
+		}
+	}
+
+	return summary, nil
+}
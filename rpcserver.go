@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -16,10 +17,12 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/neutrino/cache/lru"
 	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -35,14 +38,20 @@ import (
 	"github.com/lightninglabs/taproot-assets/taprpc"
 	wrpc "github.com/lightninglabs/taproot-assets/taprpc/assetwalletrpc"
 	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
+	"github.com/lightninglabs/taproot-assets/taprpc/remotesignerrpc"
 	"github.com/lightninglabs/taproot-assets/taprpc/tapdevrpc"
 	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	"github.com/lightninglabs/taproot-assets/tapscript"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/lightningnetwork/lnd/signal"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -79,15 +88,24 @@ type rpcServer struct {
 	mintrpc.UnimplementedMintServer
 	unirpc.UnimplementedUniverseServer
 	tapdevrpc.UnimplementedTapDevServer
+	remotesignerrpc.UnimplementedRemoteSignerServer
 
 	interceptor signal.Interceptor
 
 	interceptorChain *rpcperms.InterceptorChain
 
+	// macaroonService is used to bake macaroons. This is nil if macaroons
+	// are disabled.
+	macaroonService *lndclient.MacaroonService
+
 	cfg *Config
 
 	blockTimestampCache *lru.Cache[uint32, cacheableTimestamp]
 
+	// anchorCoSigner holds the anchor transactions that wait for an
+	// external party to co-sign their anchor inputs.
+	anchorCoSigner *tapfreighter.ExternalCoSigner
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 }
@@ -95,14 +113,19 @@ type rpcServer struct {
 // newRPCServer creates a new RPC sever from the set of input dependencies.
 func newRPCServer(interceptor signal.Interceptor,
 	interceptorChain *rpcperms.InterceptorChain,
+	macaroonService *lndclient.MacaroonService,
 	cfg *Config) (*rpcServer, error) {
 
 	return &rpcServer{
 		interceptor:      interceptor,
 		interceptorChain: interceptorChain,
+		macaroonService:  macaroonService,
 		blockTimestampCache: lru.NewCache[uint32, cacheableTimestamp](
 			maxNumBlocksInCache,
 		),
+		anchorCoSigner: tapfreighter.NewExternalCoSigner(
+			tapfreighter.DefaultCoSignTimeout,
+		),
 		quit: make(chan struct{}),
 		cfg:  cfg,
 	}, nil
@@ -146,6 +169,7 @@ func (r *rpcServer) RegisterWithGrpcServer(grpcServer *grpc.Server) error {
 	mintrpc.RegisterMintServer(grpcServer, r)
 	unirpc.RegisterUniverseServer(grpcServer, r)
 	tapdevrpc.RegisterGrpcServer(grpcServer, r)
+	remotesignerrpc.RegisterRemoteSignerServer(grpcServer, r)
 	return nil
 }
 
@@ -351,11 +375,44 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 		seedling.GroupAnchor = &req.Asset.GroupAnchor
 	}
 
+	if req.Asset.PinMetaToIpfs && req.Asset.AssetMeta == nil {
+		return nil, fmt.Errorf("asset meta must be set to pin it to " +
+			"IPFS")
+	}
+
 	if req.Asset.AssetMeta != nil {
 		seedling.Meta = &proof.MetaReveal{
 			Type: proof.MetaType(req.Asset.AssetMeta.Type),
 			Data: req.Asset.AssetMeta.Data,
 		}
+
+		// If requested, we'll move the content to IPFS and only commit
+		// to a reference of it.
+		if req.Asset.PinMetaToIpfs {
+			if r.cfg.IPFSClient == nil {
+				return nil, fmt.Errorf("IPFS is not configured")
+			}
+
+			uriMeta, err := proof.PinURIMeta(
+				ctx, r.cfg.IPFSClient, seedling.Meta.Data,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			rpcsLog.Infof("[MintAsset]: pinned meta of asset %v to "+
+				"IPFS", req.Asset.Name)
+
+			seedling.Meta = uriMeta
+		}
+
+		// URI meta data must be well-formed, otherwise the referenced
+		// content can never be resolved.
+		if seedling.Meta.Type == proof.MetaURI {
+			if _, err := seedling.Meta.URIMeta(); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	updates, err := r.cfg.AssetMinter.QueueNewSeedling(seedling)
@@ -733,6 +790,15 @@ func (r *rpcServer) listBalancesByAsset(ctx context.Context,
 			},
 			AssetType: taprpc.AssetType(balance.Type),
 			Balance:   balance.Balance,
+			Alias: r.lookupAssetAlias(
+				ctx, func(aliases asset.AliasRegistry) (
+					*asset.Alias, error) {
+
+					return aliases.AssetAlias(
+						ctx, balance.ID, nil,
+					)
+				},
+			),
 		}
 	}
 
@@ -764,10 +830,22 @@ func (r *rpcServer) listBalancesByGroupKey(ctx context.Context,
 		}
 
 		groupKeyString := hex.EncodeToString(groupKey)
-		resp.AssetGroupBalances[groupKeyString] = &taprpc.AssetGroupBalance{
+		rpcBalance := &taprpc.AssetGroupBalance{
 			GroupKey: groupKey,
 			Balance:  balance.Balance,
 		}
+		if balance.GroupKey != nil {
+			rpcBalance.Alias = r.lookupAssetAlias(
+				ctx, func(aliases asset.AliasRegistry) (
+					*asset.Alias, error) {
+
+					return aliases.GroupAlias(
+						ctx, balance.GroupKey,
+					)
+				},
+			)
+		}
+		resp.AssetGroupBalances[groupKeyString] = rpcBalance
 	}
 
 	return resp, nil
@@ -908,6 +986,54 @@ func (r *rpcServer) ListBalances(ctx context.Context,
 	}
 }
 
+// ListBalanceHistory lists the confirmed balance of each asset as of a given
+// block height or time, as recorded by the most recent balance snapshot.
+func (r *rpcServer) ListBalanceHistory(ctx context.Context,
+	in *taprpc.ListBalanceHistoryRequest) (
+	*taprpc.ListBalanceHistoryResponse, error) {
+
+	if in.Timestamp < 0 {
+		return nil, fmt.Errorf("timestamp must not be negative")
+	}
+
+	query := tapfreighter.BalanceSnapshotQuery{
+		MaxHeight: in.Height,
+	}
+	if in.Timestamp != 0 {
+		query.MaxTime = time.Unix(in.Timestamp, 0)
+	}
+	if len(in.AssetId) != 0 {
+		query.AssetID = &asset.ID{}
+		if len(in.AssetId) != len(query.AssetID) {
+			return nil, fmt.Errorf("invalid asset ID length")
+		}
+
+		copy(query.AssetID[:], in.AssetId)
+	}
+
+	snapshots, err := r.cfg.AssetStore.QueryBalanceSnapshots(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query balance snapshots: %w",
+			err)
+	}
+
+	resp := &taprpc.ListBalanceHistoryResponse{
+		Snapshots: make([]*taprpc.BalanceSnapshot, len(snapshots)),
+	}
+	for idx := range snapshots {
+		snapshot := snapshots[idx]
+		resp.Snapshots[idx] = &taprpc.BalanceSnapshot{
+			AssetId:   snapshot.AssetID[:],
+			Height:    snapshot.Height,
+			Timestamp: snapshot.TakenAt.Unix(),
+			Balance:   snapshot.Balance,
+			UtxoCount: snapshot.UTXOCount,
+		}
+	}
+
+	return resp, nil
+}
+
 // ListTransfers lists all asset transfers managed by this deamon.
 func (r *rpcServer) ListTransfers(ctx context.Context,
 	in *taprpc.ListTransfersRequest) (*taprpc.ListTransfersResponse,
@@ -928,674 +1054,3437 @@ func (r *rpcServer) ListTransfers(ctx context.Context,
 			return nil, fmt.Errorf("failed to marshal parcel: %w",
 				err)
 		}
+
+		r.annotateTransfer(ctx, resp.Transfers[idx])
 	}
 
 	return resp, nil
 }
 
-// QueryAddrs queries the set of Taproot Asset addresses stored in the database.
-func (r *rpcServer) QueryAddrs(ctx context.Context,
-	in *taprpc.QueryAddrRequest) (*taprpc.QueryAddrResponse, error) {
+// ListProofDeliveryAttempts lists the attempts of the proof courier to deliver
+// the proofs of outbound transfers to their receivers.
+func (r *rpcServer) ListProofDeliveryAttempts(ctx context.Context,
+	in *taprpc.ListProofDeliveryAttemptsRequest) (
+	*taprpc.ListProofDeliveryAttemptsResponse, error) {
 
-	query := address.QueryParams{
-		Limit:  in.Limit,
-		Offset: in.Offset,
+	var query proof.DeliveryAttemptQuery
+	if len(in.AnchorTxHash) > 0 {
+		txHash, err := chainhash.NewHash(in.AnchorTxHash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid anchor tx hash: %w", err)
+		}
+		query.AnchorTxid = txHash
+	}
+	if len(in.ScriptKey) > 0 {
+		scriptKey, err := parseUserKey(in.ScriptKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid script key: %w", err)
+		}
+		query.ScriptKey = scriptKey
 	}
 
-	// The unix time of 0 (1970-01-01) is not the same as an empty Time
-	// struct (0000-00-00). For our query to succeed, we need to set the
-	// time values the way the address book expects them.
-	if in.CreatedBefore > 0 {
-		query.CreatedBefore = time.Unix(in.CreatedBefore, 0)
+	attempts, err := r.cfg.AssetStore.QueryDeliveryAttempts(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query proof delivery "+
+			"attempts: %w", err)
 	}
-	if in.CreatedAfter > 0 {
-		query.CreatedAfter = time.Unix(in.CreatedAfter, 0)
+
+	resp := &taprpc.ListProofDeliveryAttemptsResponse{
+		Attempts: make([]*taprpc.ProofDeliveryAttempt, len(attempts)),
+	}
+	for idx, attempt := range attempts {
+		resp.Attempts[idx] = marshalProofDeliveryAttempt(attempt)
 	}
 
-	rpcsLog.Debugf("[QueryAddrs]: addr query params: %v",
-		spew.Sdump(query))
+	return resp, nil
+}
 
-	dbAddrs, err := r.cfg.AddrBook.ListAddrs(ctx, query)
-	if err != nil {
-		return nil, fmt.Errorf("unable to query addrs: %w", err)
+// marshalProofDeliveryAttempt marshals a proof delivery attempt into its RPC
+// counterpart.
+func marshalProofDeliveryAttempt(
+	attempt *proof.DeliveryAttempt) *taprpc.ProofDeliveryAttempt {
+
+	rpcAttempt := &taprpc.ProofDeliveryAttempt{
+		AnchorPoint: attempt.AnchorPoint.String(),
+		ScriptKey:   attempt.Locator.ScriptKey.SerializeCompressed(),
+		CourierAddr: attempt.CourierAddr,
+		Timestamp:   attempt.Timestamp.Unix(),
+		Delivered:   attempt.Delivered(),
+		Error:       attempt.Error,
+	}
+	if attempt.Locator.AssetID != nil {
+		rpcAttempt.AssetId = fn.ByteSlice(*attempt.Locator.AssetID)
+	}
+	if !attempt.NextAttempt.IsZero() {
+		rpcAttempt.NextAttemptTimestamp = attempt.NextAttempt.Unix()
 	}
 
-	// TODO(roasbeef): just stop storing the hrp in the addr?
-	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	return rpcAttempt
+}
 
-	addrs := make([]*taprpc.Addr, len(dbAddrs))
-	for i, dbAddr := range dbAddrs {
-		dbAddr.ChainParams = &tapParams
+// ParcelQueueStats returns the number of queued and active outbound transfers
+// of each priority class.
+func (r *rpcServer) ParcelQueueStats(_ context.Context,
+	_ *taprpc.ParcelQueueStatsRequest) (*taprpc.ParcelQueueStatsResponse,
+	error) {
 
-		addrs[i], err = marshalAddr(dbAddr.Tap, r.cfg.TapAddrBook)
+	stats := r.cfg.ChainPorter.QueueStats()
+
+	resp := &taprpc.ParcelQueueStatsResponse{}
+	for _, priority := range []tapfreighter.ParcelPriority{
+		tapfreighter.PriorityUrgent, tapfreighter.PriorityNormal,
+		tapfreighter.PriorityBatchable,
+	} {
+		rpcPriority, err := marshalParcelPriority(priority)
 		if err != nil {
-			return nil, fmt.Errorf("unable to marshal addr: %w",
-				err)
+			return nil, err
 		}
-	}
 
-	rpcsLog.Debugf("[QueryAddrs]: returning %v addrs", len(addrs))
+		resp.Queues = append(resp.Queues, &taprpc.ParcelQueueDepth{
+			Priority: rpcPriority,
+			Queued:   uint32(stats[priority].Queued),
+			Active:   uint32(stats[priority].Active),
+		})
+	}
 
-	return &taprpc.QueryAddrResponse{
-		Addrs: addrs,
-	}, nil
+	return resp, nil
 }
 
-// NewAddr makes a new address from the set of request params.
-func (r *rpcServer) NewAddr(ctx context.Context,
-	in *taprpc.NewAddrRequest) (*taprpc.Addr, error) {
-
-	var err error
+// SetParcelDebugLogging enables or disables debug logging scoped to a single
+// outbound transfer, without changing the global log level.
+func (r *rpcServer) SetParcelDebugLogging(_ context.Context,
+	req *taprpc.SetParcelDebugLoggingRequest) (
+	*taprpc.SetParcelDebugLoggingResponse, error) {
 
-	if len(in.AssetId) != 32 {
-		return nil, fmt.Errorf("invalid asset id length")
+	if req.ParcelId == 0 {
+		return nil, fmt.Errorf("parcel ID must be set")
 	}
 
-	var assetID asset.ID
-	copy(assetID[:], in.AssetId)
+	r.cfg.ChainPorter.SetParcelDebug(req.ParcelId, req.Enabled)
 
-	rpcsLog.Infof("[NewAddr]: making new addr: asset_id=%x, amt=%v",
-		assetID[:], in.Amt)
+	return &taprpc.SetParcelDebugLoggingResponse{}, nil
+}
 
-	err = r.checkBalanceOverflow(ctx, &assetID, nil, in.Amt)
-	if err != nil {
-		return nil, err
-	}
+// ExportParcelLog exports the structured log of a single outbound transfer.
+func (r *rpcServer) ExportParcelLog(_ context.Context,
+	req *taprpc.ExportParcelLogRequest) (*taprpc.ExportParcelLogResponse,
+	error) {
 
-	// Was there a tapscript sibling preimage specified?
-	tapscriptSibling, _, err := commitment.MaybeDecodeTapscriptPreimage(
-		in.TapscriptSibling,
-	)
+	bundle, err := r.cfg.ChainPorter.ExportParcelLog(req.ParcelId)
 	if err != nil {
-		return nil, fmt.Errorf("invalid tapscript sibling: %w", err)
+		return nil, fmt.Errorf("unable to export parcel log: %w", err)
 	}
 
-	var addr *address.AddrWithKeyInfo
-	switch {
-	// No key was specified, we'll let the address book derive them.
-	case in.ScriptKey == nil && in.InternalKey == nil:
-		// Now that we have all the params, we'll try to add a new
-		// address to the addr book.
-		addr, err = r.cfg.AddrBook.NewAddress(
-			ctx, assetID, in.Amt, tapscriptSibling,
-		)
+	resp := &taprpc.ExportParcelLogResponse{
+		ParcelId:       bundle.ParcelID,
+		DebugEnabled:   bundle.DebugEnabled,
+		Finished:       bundle.Finished,
+		DroppedEntries: bundle.DroppedEntries,
+		Entries: make(
+			[]*taprpc.ParcelLogEntry, len(bundle.Entries),
+		),
+	}
+	for idx, entry := range bundle.Entries {
+		resp.Entries[idx], err = marshalParcelLogEntry(entry)
 		if err != nil {
-			return nil, fmt.Errorf("unable to make new addr: %w",
-				err)
+			return nil, err
 		}
+	}
 
-	// Only the script key was specified.
-	case in.ScriptKey != nil && in.InternalKey == nil:
-		return nil, fmt.Errorf("internal key must also be specified " +
-			"if script key is specified")
+	return resp, nil
+}
 
-	// Only the internal key was specified.
-	case in.ScriptKey == nil && in.InternalKey != nil:
-		return nil, fmt.Errorf("script key must also be specified " +
-			"if internal key is specified")
+// marshalParcelLogEntry converts a parcel log entry into its RPC counterpart.
+func marshalParcelLogEntry(
+	entry tapfreighter.ParcelLogEntry) (*taprpc.ParcelLogEntry, error) {
+
+	var entryType taprpc.ParcelLogEntryType
+	switch entry.Type {
+	case tapfreighter.ParcelLogState:
+		entryType = taprpc.ParcelLogEntryType_PARCEL_LOG_ENTRY_STATE
+
+	case tapfreighter.ParcelLogBackendCall:
+		entryType = taprpc.
+			ParcelLogEntryType_PARCEL_LOG_ENTRY_BACKEND_CALL
+
+	case tapfreighter.ParcelLogMessage:
+		entryType = taprpc.ParcelLogEntryType_PARCEL_LOG_ENTRY_MESSAGE
 
-	// Both the script and internal keys were specified.
 	default:
-		scriptKey, err := UnmarshalScriptKey(in.ScriptKey)
-		if err != nil {
-			return nil, fmt.Errorf("unable to decode script key: "+
-				"%w", err)
-		}
+		return nil, fmt.Errorf("unknown parcel log entry type: %v",
+			entry.Type)
+	}
+
+	return &taprpc.ParcelLogEntry{
+		Timestamp:  entry.Time.UnixMicro(),
+		Type:       entryType,
+		SendState:  entry.State.String(),
+		Name:       entry.Name,
+		Message:    entry.Message,
+		DurationUs: entry.Duration.Microseconds(),
+		Error:      entry.Err,
+	}, nil
+}
 
-		rpcsLog.Debugf("Decoded script key %x (internal %x, tweak %x)",
-			scriptKey.PubKey.SerializeCompressed(),
-			scriptKey.RawKey.PubKey.SerializeCompressed(),
-			scriptKey.Tweak[:])
+// unmarshalParcelPriority parses the RPC parcel priority into the native
+// counterpart.
+func unmarshalParcelPriority(
+	rpcPriority taprpc.ParcelPriority) (tapfreighter.ParcelPriority, error) {
 
-		internalKey, err := UnmarshalKeyDescriptor(in.InternalKey)
-		if err != nil {
-			return nil, fmt.Errorf("unable to decode internal "+
-				"key: %w", err)
-		}
+	switch rpcPriority {
+	case taprpc.ParcelPriority_PARCEL_PRIORITY_NORMAL:
+		return tapfreighter.PriorityNormal, nil
 
-		// Now that we have all the params, we'll try to add a new
-		// address to the addr book.
-		addr, err = r.cfg.AddrBook.NewAddressWithKeys(
-			ctx, assetID, in.Amt, *scriptKey, internalKey,
-			tapscriptSibling,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to make new addr: %w",
-				err)
-		}
-	}
+	case taprpc.ParcelPriority_PARCEL_PRIORITY_URGENT:
+		return tapfreighter.PriorityUrgent, nil
 
-	// With our addr obtained, we'll marshal it as an RPC message then send
-	// off the response.
-	rpcAddr, err := marshalAddr(addr.Tap, r.cfg.TapAddrBook)
-	if err != nil {
-		return nil, fmt.Errorf("unable to marshal addr: %w", err)
-	}
+	case taprpc.ParcelPriority_PARCEL_PRIORITY_BATCHABLE:
+		return tapfreighter.PriorityBatchable, nil
 
-	return rpcAddr, nil
+	default:
+		return 0, fmt.Errorf("unknown parcel priority <%d>",
+			rpcPriority)
+	}
 }
 
-// DecodeAddr decode a Taproot Asset address into a partial asset message that
-// represents the asset it wants to receive.
-func (r *rpcServer) DecodeAddr(_ context.Context,
-	in *taprpc.DecodeAddrRequest) (*taprpc.Addr, error) {
+// marshalParcelPriority turns the parcel priority into the RPC counterpart.
+func marshalParcelPriority(
+	priority tapfreighter.ParcelPriority) (taprpc.ParcelPriority, error) {
 
-	if len(in.Addr) == 0 {
-		return nil, fmt.Errorf("must specify an addr")
-	}
+	switch priority {
+	case tapfreighter.PriorityNormal:
+		return taprpc.ParcelPriority_PARCEL_PRIORITY_NORMAL, nil
 
-	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	case tapfreighter.PriorityUrgent:
+		return taprpc.ParcelPriority_PARCEL_PRIORITY_URGENT, nil
 
-	addr, err := address.DecodeAddress(in.Addr, &tapParams)
-	if err != nil {
-		return nil, fmt.Errorf("unable to decode addr: %w", err)
-	}
+	case tapfreighter.PriorityBatchable:
+		return taprpc.ParcelPriority_PARCEL_PRIORITY_BATCHABLE, nil
 
-	rpcAddr, err := marshalAddr(addr, r.cfg.TapAddrBook)
-	if err != nil {
-		return nil, fmt.Errorf("unable to marshal addr: %w", err)
+	default:
+		return 0, fmt.Errorf("unknown parcel priority <%d>", priority)
 	}
-
-	return rpcAddr, nil
 }
 
-// VerifyProof attempts to verify a given proof file that claims to be anchored
-// at the specified genesis point.
-func (r *rpcServer) VerifyProof(ctx context.Context,
-	in *taprpc.ProofFile) (*taprpc.VerifyProofResponse, error) {
+// ExportTransferStatement exports all outbound asset transfers and completed
+// inbound address receives as an accounting statement.
+func (r *rpcServer) ExportTransferStatement(ctx context.Context,
+	in *taprpc.ExportTransferStatementRequest) (
+	*taprpc.ExportTransferStatementResponse, error) {
 
-	if len(in.RawProof) == 0 {
-		return nil, fmt.Errorf("proof file must be specified")
+	var format tapfreighter.StatementFormat
+	switch in.Format {
+	case taprpc.StatementFormat_STATEMENT_FORMAT_CSV:
+		format = tapfreighter.StatementFormatCSV
+
+	case taprpc.StatementFormat_STATEMENT_FORMAT_JSONL:
+		format = tapfreighter.StatementFormatJSONL
+
+	default:
+		return nil, fmt.Errorf("unknown statement format: %v",
+			in.Format)
 	}
 
-	var proofFile proof.File
-	err := proofFile.Decode(bytes.NewReader(in.RawProof))
+	parcels, err := r.cfg.AssetStore.QueryParcels(ctx, false)
 	if err != nil {
-		return nil, fmt.Errorf("unable to decode proof file: %w", err)
+		return nil, fmt.Errorf("failed to query parcels: %w", err)
 	}
 
-	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
-	_, err = proofFile.Verify(ctx, headerVerifier)
+	completed := address.StatusCompleted
+	receives, err := r.cfg.AddrBook.QueryEvents(
+		ctx, address.EventQueryParams{
+			StatusFrom: &completed,
+			StatusTo:   &completed,
+		},
+	)
 	if err != nil {
-		// We don't want to fail the RPC request because of a proof
-		// verification error, but we do want to log it for easier
-		// debugging.
-		rpcsLog.Errorf("Proof verification failed with err: %v", err)
+		return nil, fmt.Errorf("error querying events: %w", err)
 	}
-	valid := err == nil
 
-	decodedProof, err := r.marshalProofFile(ctx, proofFile, 0, false, false)
+	var statement bytes.Buffer
+	err = tapfreighter.WriteStatement(
+		&statement, format, parcels, receives, r.transferLabeler(ctx),
+	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to marshal proof: %w", err)
+		return nil, fmt.Errorf("unable to write statement: %w", err)
 	}
 
-	return &taprpc.VerifyProofResponse{
-		Valid:        valid,
-		DecodedProof: decodedProof,
+	return &taprpc.ExportTransferStatementResponse{
+		Statement: statement.Bytes(),
 	}, nil
 }
 
-// DecodeProof attempts to decode a given proof file that claims to be anchored
-// at the specified genesis point.
-func (r *rpcServer) DecodeProof(ctx context.Context,
-	in *taprpc.DecodeProofRequest) (*taprpc.DecodeProofResponse, error) {
+// transferLabeler returns a statement labeler that labels each transfer with
+// the names of the counterparties in the counterparty book that received its
+// outputs, so the statement can be matched against the accounts of the
+// counterparties.
+func (r *rpcServer) transferLabeler(
+	ctx context.Context) tapfreighter.TransferLabeler {
 
-	if len(in.RawProof) == 0 {
-		return nil, fmt.Errorf("proof file must be specified")
-	}
+	return func(parcel *tapfreighter.OutboundParcel) string {
+		var (
+			names []string
+			seen  = make(map[string]struct{})
+		)
+		for _, out := range parcel.Outputs {
+			if out.ScriptKeyLocal || out.ScriptKey.PubKey == nil {
+				continue
+			}
 
-	var proofFile proof.File
-	if err := proofFile.Decode(bytes.NewReader(in.RawProof)); err != nil {
-		return nil, fmt.Errorf("unable to decode proof file: %w", err)
+			name := r.lookupCounterpartyName(
+				ctx, out.ScriptKey.PubKey.SerializeCompressed(),
+			)
+			if _, ok := seen[name]; ok || name == "" {
+				continue
+			}
+
+			seen[name] = struct{}{}
+			names = append(names, name)
+		}
+
+		return strings.Join(names, ", ")
 	}
+}
 
-	latestProofIndex := uint32(proofFile.NumProofs() - 1)
+// FreezeAssetOutputs freezes asset outputs so they are skipped by coin
+// selection.
+func (r *rpcServer) FreezeAssetOutputs(ctx context.Context,
+	in *taprpc.FreezeAssetOutputsRequest) (
+	*taprpc.FreezeAssetOutputsResponse, error) {
 
-	if in.ProofAtDepth > latestProofIndex {
-		return nil, fmt.Errorf("invalid depth %d is greater than "+
-			"latest proof index of %d", in.ProofAtDepth,
-			latestProofIndex)
+	if in.Reason == "" {
+		return nil, fmt.Errorf("a reason for freezing must be given")
 	}
 
-	// Default to latest proof.
-	depth := latestProofIndex - in.ProofAtDepth
+	anchorPoint, scriptKey, err := unmarshalAssetOutput(
+		in.AnchorOutpoint, in.ScriptKey,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-	decodedProof, err := r.marshalProofFile(
-		ctx, proofFile, depth, in.WithPrevWitnesses, in.WithMetaReveal,
+	frozen, err := r.cfg.AssetStore.FreezeAssetOutputs(
+		ctx, *anchorPoint, scriptKey, in.Reason,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to marshal proof: %w", err)
+		return nil, fmt.Errorf("unable to freeze asset outputs: %w", err)
 	}
 
-	return &taprpc.DecodeProofResponse{
-		DecodedProof: decodedProof,
+	rpcsLog.Infof("Froze %d asset outputs at %v, reason: %v", len(frozen),
+		anchorPoint, in.Reason)
+
+	return &taprpc.FreezeAssetOutputsResponse{
+		Frozen: fn.Map(frozen, marshalFrozenAssetOutput),
 	}, nil
 }
 
-// marshalProofFile turns a proof file into an RPC DecodedProof.
-func (r *rpcServer) marshalProofFile(ctx context.Context, proofFile proof.File,
-	depth uint32, withPrevWitnesses,
-	withMetaReveal bool) (*taprpc.DecodedProof, error) {
+// UnfreezeAssetOutputs unfreezes previously frozen asset outputs.
+func (r *rpcServer) UnfreezeAssetOutputs(ctx context.Context,
+	in *taprpc.UnfreezeAssetOutputsRequest) (
+	*taprpc.UnfreezeAssetOutputsResponse, error) {
 
-	decodedProof, err := proofFile.ProofAt(depth)
+	anchorPoint, scriptKey, err := unmarshalAssetOutput(
+		in.AnchorOutpoint, in.ScriptKey,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	var (
-		rpcMeta        *taprpc.AssetMeta
-		anchorOutpoint = wire.OutPoint{
-			Hash:  decodedProof.AnchorTx.TxHash(),
-			Index: decodedProof.InclusionProof.OutputIndex,
-		}
-		txMerkleProof  = decodedProof.TxMerkleProof
-		inclusionProof = decodedProof.InclusionProof
-		splitRootProof = decodedProof.SplitRootProof
+	numUnfrozen, err := r.cfg.AssetStore.UnfreezeAssetOutputs(
+		ctx, *anchorPoint, scriptKey,
 	)
-
-	var txMerkleProofBuf bytes.Buffer
-	if err := txMerkleProof.Encode(&txMerkleProofBuf); err != nil {
-		return nil, fmt.Errorf("unable to encode serialized Bitcoin "+
-			"merkle proof: %w", err)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unfreeze asset outputs: %w",
+			err)
 	}
 
-	var inclusionProofBuf bytes.Buffer
-	if err := inclusionProof.Encode(&inclusionProofBuf); err != nil {
-		return nil, fmt.Errorf("unable to encode inclusion proof: %w",
+	rpcsLog.Infof("Unfroze %d asset outputs at %v", numUnfrozen,
+		anchorPoint)
+
+	return &taprpc.UnfreezeAssetOutputsResponse{
+		NumUnfrozen: uint32(numUnfrozen),
+	}, nil
+}
+
+// ListFrozenAssetOutputs lists all frozen asset outputs.
+func (r *rpcServer) ListFrozenAssetOutputs(ctx context.Context,
+	_ *taprpc.ListFrozenAssetOutputsRequest) (
+	*taprpc.ListFrozenAssetOutputsResponse, error) {
+
+	frozen, err := r.cfg.AssetStore.ListFrozenAssetOutputs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list frozen asset outputs: %w",
 			err)
 	}
 
-	if inclusionProof.CommitmentProof == nil {
-		return nil, fmt.Errorf("inclusion proof is missing " +
-			"commitment proof")
+	return &taprpc.ListFrozenAssetOutputsResponse{
+		Frozen: fn.Map(frozen, marshalFrozenAssetOutput),
+	}, nil
+}
+
+// WatchAsset imports the proof file of an asset owned by an external key to
+// track it purely for monitoring.
+func (r *rpcServer) WatchAsset(ctx context.Context,
+	in *taprpc.WatchAssetRequest) (*taprpc.WatchAssetResponse, error) {
+
+	if len(in.RawProofFile) == 0 {
+		return nil, fmt.Errorf("proof file must be specified")
 	}
-	tsSibling, tsHash, err := commitment.MaybeEncodeTapscriptPreimage(
-		inclusionProof.CommitmentProof.TapSiblingPreimage,
+
+	watched, err := r.cfg.AssetWatcher.WatchAsset(
+		ctx, in.RawProofFile, in.Label,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("error encoding tapscript sibling: %w",
-			err)
+		return nil, fmt.Errorf("unable to watch asset: %w", err)
 	}
 
-	tapProof, err := inclusionProof.CommitmentProof.DeriveByAssetInclusion(
-		&decodedProof.Asset,
+	return &taprpc.WatchAssetResponse{
+		WatchedAsset: marshalWatchedAsset(watched),
+	}, nil
+}
+
+// UnwatchAsset stops watching an asset.
+func (r *rpcServer) UnwatchAsset(ctx context.Context,
+	in *taprpc.UnwatchAssetRequest) (*taprpc.UnwatchAssetResponse, error) {
+
+	if err := r.cfg.AssetWatcher.UnwatchAsset(ctx, in.Id); err != nil {
+		return nil, fmt.Errorf("unable to unwatch asset: %w", err)
+	}
+
+	return &taprpc.UnwatchAssetResponse{}, nil
+}
+
+// ListWatchedAssets lists the assets that are watched for monitoring.
+func (r *rpcServer) ListWatchedAssets(ctx context.Context,
+	in *taprpc.ListWatchedAssetsRequest) (*taprpc.ListWatchedAssetsResponse,
+	error) {
+
+	watched, err := r.cfg.AssetWatcher.ListWatchedAssets(
+		ctx, in.IncludeSpent,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("error deriving inclusion proof: %w",
-			err)
+		return nil, fmt.Errorf("unable to list watched assets: %w", err)
 	}
-	merkleRoot := tapProof.TapscriptRoot(tsHash)
 
-	var exclusionProofs [][]byte
-	for _, exclusionProof := range decodedProof.ExclusionProofs {
-		var exclusionProofBuf bytes.Buffer
-		err := exclusionProof.Encode(&exclusionProofBuf)
-		if err != nil {
-			return nil, fmt.Errorf("unable to encode exclusion "+
-				"proofs: %w", err)
-		}
-		exclusionProofs = append(
-			exclusionProofs, exclusionProofBuf.Bytes(),
-		)
-	}
+	return &taprpc.ListWatchedAssetsResponse{
+		WatchedAssets: fn.Map(watched, marshalWatchedAsset),
+	}, nil
+}
 
-	var splitRootProofBuf bytes.Buffer
-	if splitRootProof != nil {
-		err := splitRootProof.Encode(&splitRootProofBuf)
-		if err != nil {
-			return nil, fmt.Errorf("unable to encode split root "+
-				"proof: %w", err)
-		}
+// ListAtRiskAssets lists the assets whose anchor output was spent by an
+// unknown transaction.
+func (r *rpcServer) ListAtRiskAssets(ctx context.Context,
+	_ *taprpc.ListAtRiskAssetsRequest) (*taprpc.ListAtRiskAssetsResponse,
+	error) {
+
+	atRisk, err := r.cfg.AnchorSpendMonitor.ListAtRiskAssets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list at risk assets: %w", err)
 	}
 
-	rpcAsset, err := r.marshalChainAsset(ctx, &tapdb.ChainAsset{
-		Asset:                  &decodedProof.Asset,
-		AnchorTx:               &decodedProof.AnchorTx,
-		AnchorTxid:             decodedProof.AnchorTx.TxHash(),
-		AnchorBlockHash:        decodedProof.BlockHeader.BlockHash(),
-		AnchorBlockHeight:      decodedProof.BlockHeight,
-		AnchorOutpoint:         anchorOutpoint,
-		AnchorInternalKey:      decodedProof.InclusionProof.InternalKey,
-		AnchorMerkleRoot:       merkleRoot[:],
-		AnchorTapscriptSibling: tsSibling,
-	}, withPrevWitnesses)
+	return &taprpc.ListAtRiskAssetsResponse{
+		AtRiskAssets: fn.Map(atRisk, marshalAtRiskAsset),
+	}, nil
+}
+
+// AnnotateAssetLot sets the price annotation of the lot of an unspent asset
+// UTXO.
+func (r *rpcServer) AnnotateAssetLot(ctx context.Context,
+	in *taprpc.AnnotateAssetLotRequest) (*taprpc.AnnotateAssetLotResponse,
+	error) {
+
+	lot, err := unmarshalAssetLotID(in.Lot)
 	if err != nil {
 		return nil, err
 	}
 
-	if withMetaReveal {
-		metaHash := rpcAsset.AssetGenesis.MetaHash
-		if len(metaHash) == 0 {
-			return nil, fmt.Errorf("asset does not contain meta " +
-				"data")
+	err = r.cfg.AssetStore.SetLotPriceAnnotation(
+		ctx, lot, in.PriceAnnotation,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to annotate lot: %w", err)
+	}
+
+	return &taprpc.AnnotateAssetLotResponse{}, nil
+}
+
+// SetAssetAlias sets a human-readable alias for an asset ID or asset group in
+// the local alias registry.
+func (r *rpcServer) SetAssetAlias(ctx context.Context,
+	in *taprpc.SetAssetAliasRequest) (*taprpc.SetAssetAliasResponse,
+	error) {
+
+	alias := &asset.Alias{
+		Name:   asset.NormalizeAliasName(in.Name),
+		Source: asset.AliasSourceLocal,
+	}
+
+	switch {
+	case len(in.GetAssetId()) > 0:
+		if len(in.GetAssetId()) != sha256.Size {
+			return nil, fmt.Errorf("asset ID must be 32 bytes")
 		}
 
-		rpcMeta, err = r.FetchAssetMeta(
-			ctx, &taprpc.FetchAssetMetaRequest{
-				Asset: &taprpc.FetchAssetMetaRequest_MetaHash{
-					MetaHash: metaHash,
-				},
-			},
-		)
+		var assetID asset.ID
+		copy(assetID[:], in.GetAssetId())
+		alias.AssetID = &assetID
+
+	case len(in.GetGroupKey()) > 0:
+		groupKey, err := btcec.ParsePubKey(in.GetGroupKey())
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("invalid group key: %w", err)
 		}
+		alias.GroupKey = groupKey
+
+	default:
+		return nil, fmt.Errorf("either asset ID or group key must be " +
+			"set")
 	}
 
-	return &taprpc.DecodedProof{
-		ProofAtDepth:        depth,
-		NumberOfProofs:      uint32(proofFile.NumProofs()),
-		Asset:               rpcAsset,
-		MetaReveal:          rpcMeta,
-		TxMerkleProof:       txMerkleProofBuf.Bytes(),
-		InclusionProof:      inclusionProofBuf.Bytes(),
-		ExclusionProofs:     exclusionProofs,
-		SplitRootProof:      splitRootProofBuf.Bytes(),
-		NumAdditionalInputs: uint32(len(decodedProof.AdditionalInputs)),
-		ChallengeWitness:    decodedProof.ChallengeWitness,
+	err := r.cfg.AliasResolver.Local().SetAlias(ctx, alias)
+	if err != nil {
+		return nil, fmt.Errorf("unable to set alias: %w", err)
+	}
+
+	return &taprpc.SetAssetAliasResponse{
+		Alias: marshalAssetAlias(alias),
 	}, nil
 }
 
-// ExportProof exports the latest raw proof file anchored at the specified
-// script_key.
-func (r *rpcServer) ExportProof(ctx context.Context,
-	in *taprpc.ExportProofRequest) (*taprpc.ProofFile, error) {
-
-	if len(in.ScriptKey) == 0 {
-		return nil, fmt.Errorf("a valid script key must be specified")
-	}
+// RemoveAssetAlias removes an alias from the local alias registry.
+func (r *rpcServer) RemoveAssetAlias(ctx context.Context,
+	in *taprpc.RemoveAssetAliasRequest) (*taprpc.RemoveAssetAliasResponse,
+	error) {
 
-	scriptKey, err := parseUserKey(in.ScriptKey)
+	err := r.cfg.AliasResolver.Local().DeleteAlias(
+		ctx, asset.NormalizeAliasName(in.Name),
+	)
 	if err != nil {
-		return nil, fmt.Errorf("invalid script key: %w", err)
+		return nil, fmt.Errorf("unable to remove alias: %w", err)
 	}
 
-	if len(in.AssetId) != 32 {
-		return nil, fmt.Errorf("asset ID must be 32 bytes")
-	}
+	return &taprpc.RemoveAssetAliasResponse{}, nil
+}
 
-	var assetID asset.ID
-	copy(assetID[:], in.AssetId)
+// ListAssetAliases lists all aliases of the local alias registry.
+func (r *rpcServer) ListAssetAliases(ctx context.Context,
+	_ *taprpc.ListAssetAliasesRequest) (*taprpc.ListAssetAliasesResponse,
+	error) {
 
-	proofBlob, err := r.cfg.ProofArchive.FetchProof(ctx, proof.Locator{
-		AssetID:   &assetID,
-		ScriptKey: *scriptKey,
-	})
+	aliases, err := r.cfg.AliasResolver.Local().ListAliases(ctx)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to list aliases: %w", err)
 	}
 
-	return &taprpc.ProofFile{
-		RawProof: proofBlob,
+	return &taprpc.ListAssetAliasesResponse{
+		Aliases: fn.Map(aliases, marshalAssetAlias),
 	}, nil
 }
 
-// ImportProof attempts to import a proof file into the daemon. If successful, a
-// new asset will be inserted on disk, spendable using the specified target
-// script key, and internal key.
-func (r *rpcServer) ImportProof(ctx context.Context,
-	in *tapdevrpc.ImportProofRequest) (*tapdevrpc.ImportProofResponse, error) {
-
-	// We'll perform some basic input validation before we move forward.
-	if len(in.ProofFile) == 0 {
-		return nil, fmt.Errorf("proof file must be specified")
-	}
-
-	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+// ResolveAssetAlias resolves an alias to the asset ID or group key it refers
+// to, through the local alias registry first and the remote registry second.
+func (r *rpcServer) ResolveAssetAlias(ctx context.Context,
+	in *taprpc.ResolveAssetAliasRequest) (*taprpc.AssetAlias, error) {
 
-	// Now that we know the proof file is at least present, we'll attempt
-	// to import it into the main archive.
-	err := r.cfg.ProofArchive.ImportProofs(
-		ctx, headerVerifier, false,
-		&proof.AnnotatedProof{Blob: in.ProofFile},
-	)
+	alias, err := r.cfg.AliasResolver.ResolveAlias(ctx, in.Name)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to resolve alias: %w", err)
 	}
 
-	return &tapdevrpc.ImportProofResponse{}, nil
+	return marshalAssetAlias(alias), nil
 }
 
-// AddrReceives lists all receives for incoming asset transfers for addresses
-// that were created previously.
-func (r *rpcServer) AddrReceives(ctx context.Context,
-	in *taprpc.AddrReceivesRequest) (*taprpc.AddrReceivesResponse,
-	error) {
+// lookupAssetAlias returns the RPC alias returned by the given lookup function
+// or nil if no alias is known. Aliases are informational only, so a failed
+// lookup is logged instead of failing the whole call.
+func (r *rpcServer) lookupAssetAlias(ctx context.Context,
+	lookup func(asset.AliasRegistry) (*asset.Alias,
+		error)) *taprpc.AssetAlias {
 
-	var sqlQuery address.EventQueryParams
+	if r.cfg.AliasResolver == nil {
+		return nil
+	}
 
-	if len(in.FilterAddr) > 0 {
-		tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	alias, err := lookup(r.cfg.AliasResolver)
+	switch {
+	case errors.Is(err, asset.ErrAliasNotFound):
+		return nil
 
-		addr, err := address.DecodeAddress(in.FilterAddr, &tapParams)
-		if err != nil {
-			return nil, fmt.Errorf("unable to decode addr: %w", err)
-		}
+	case err != nil:
+		rpcsLog.Warnf("Unable to look up asset alias: %v", err)
+		return nil
+	}
 
-		// Now that we've decoded the address, we'll check to make sure
-		// that we can fetch the genesis for this address. Otherwise,
-		// that means we don't know anything about what it should look
-		// like on chain (the genesis is required to derive the taproot
-		// output key).
-		assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(
-			ctx, addr.AssetID,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unknown asset=%x: %w",
-				addr.AssetID[:], err)
-		}
+	return marshalAssetAlias(alias)
+}
 
-		rpcsLog.Tracef("Listing receives for group: %v",
-			spew.Sdump(assetGroup))
+// annotateTransfer adds the known aliases of the spent assets to the inputs
+// and the names of known counterparties to the remote outputs of the given
+// transfer.
+func (r *rpcServer) annotateTransfer(ctx context.Context,
+	transfer *taprpc.AssetTransfer) {
 
-		addr.AttachGenesis(*assetGroup.Genesis)
+	for _, in := range transfer.Inputs {
+		var assetID asset.ID
+		copy(assetID[:], in.AssetId)
 
-		if assetGroup.GroupKey != nil {
-			addr.AttachGroupSig(assetGroup.GroupKey.Sig)
-		}
+		in.Alias = r.lookupAssetAlias(
+			ctx, func(aliases asset.AliasRegistry) (*asset.Alias,
+				error) {
 
-		taprootOutputKey, err := addr.TaprootOutputKey()
-		if err != nil {
-			return nil, fmt.Errorf("error deriving Taproot key: %w",
-				err)
+				return aliases.AssetAlias(ctx, assetID, nil)
+			},
+		)
+	}
+
+	for _, out := range transfer.Outputs {
+		if out.ScriptKeyIsLocal {
+			continue
 		}
 
-		sqlQuery.AddrTaprootOutputKey = schnorr.SerializePubKey(
-			taprootOutputKey,
+		out.CounterpartyName = r.lookupCounterpartyName(
+			ctx, out.ScriptKey,
 		)
 	}
+}
 
-	if in.FilterStatus != taprpc.AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN {
-		status, err := unmarshalAddrEventStatus(in.FilterStatus)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing status: %w", err)
-		}
+// lookupCounterpartyName returns the name of the counterparty that receives
+// assets on the given serialized script key, or an empty string if the script
+// key isn't in the counterparty book. Like aliases, counterparty names are
+// informational only, so a failed lookup is logged instead of failing the
+// whole call.
+func (r *rpcServer) lookupCounterpartyName(ctx context.Context,
+	scriptKeyBytes []byte) string {
 
-		sqlQuery.StatusFrom = &status
-		sqlQuery.StatusTo = &status
+	if r.cfg.CounterpartyBook == nil {
+		return ""
 	}
 
-	events, err := r.cfg.AddrBook.QueryEvents(ctx, sqlQuery)
+	scriptKey, err := btcec.ParsePubKey(scriptKeyBytes)
 	if err != nil {
-		return nil, fmt.Errorf("error querying events: %w", err)
+		rpcsLog.Warnf("Unable to parse script key %x: %v",
+			scriptKeyBytes, err)
+		return ""
 	}
 
-	resp := &taprpc.AddrReceivesResponse{
-		Events: make([]*taprpc.AddrEvent, len(events)),
+	counterparty, err := r.cfg.CounterpartyBook.CounterpartyByScriptKey(
+		ctx, scriptKey,
+	)
+	switch {
+	case errors.Is(err, address.ErrCounterpartyNotFound):
+		return ""
+
+	case err != nil:
+		rpcsLog.Warnf("Unable to look up counterparty: %v", err)
+		return ""
 	}
 
-	for idx, event := range events {
-		resp.Events[idx], err = marshalAddrEvent(
-			event, r.cfg.TapAddrBook,
+	return counterparty.Name
+}
+
+// ListKeyDerivations lists the audit log of all keys that were derived from the
+// backing lnd node for minting and transfers.
+func (r *rpcServer) ListKeyDerivations(ctx context.Context,
+	req *taprpc.ListKeyDerivationsRequest) (
+	*taprpc.ListKeyDerivationsResponse, error) {
+
+	var filter tapgarden.KeyDerivationFilter
+	if req.FilterPurpose != taprpc.KeyPurpose_KEY_PURPOSE_UNKNOWN {
+		purpose, err := unmarshalKeyPurpose(req.FilterPurpose)
+		if err != nil {
+			return nil, err
+		}
+		filter.Purpose = &purpose
+	}
+	if len(req.FilterRawKey) > 0 {
+		rawKey, err := btcec.ParsePubKey(req.FilterRawKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid raw key: %w", err)
+		}
+		filter.RawKey = rawKey.SerializeCompressed()
+	}
+
+	derivations, err := r.cfg.KeyAuditLog.QueryKeyDerivations(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcDerivations, err := fn.MapErr(derivations, marshalKeyDerivation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taprpc.ListKeyDerivationsResponse{
+		Derivations: rpcDerivations,
+	}, nil
+}
+
+// ListReusedAnchorKeys lists all internal keys that were used for more than one
+// anchor output, together with the anchor outputs.
+func (r *rpcServer) ListReusedAnchorKeys(ctx context.Context,
+	_ *taprpc.ListReusedAnchorKeysRequest) (
+	*taprpc.ListReusedAnchorKeysResponse, error) {
+
+	usages, err := r.cfg.AssetStore.ListReusedAnchorKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &taprpc.ListReusedAnchorKeysResponse{
+		ReusedKeys: make([]*taprpc.ReusedAnchorKey, len(usages)),
+	}
+	for idx, usage := range usages {
+		resp.ReusedKeys[idx] = &taprpc.ReusedAnchorKey{
+			InternalKey: marshalKeyDescriptor(usage.InternalKey),
+			Local: r.cfg.KeyRing.IsLocalKey(
+				ctx, usage.InternalKey,
+			),
+			AnchorOutpoints: fn.Map(
+				usage.AnchorPoints,
+				func(op wire.OutPoint) string {
+					return op.String()
+				},
+			),
+		}
+	}
+
+	return resp, nil
+}
+
+// AuditScriptKeys scans the transfer log and the proof archive for reused
+// script keys, flags them and returns the reuses found along with all flags.
+func (r *rpcServer) AuditScriptKeys(ctx context.Context,
+	_ *taprpc.AuditScriptKeysRequest) (*taprpc.AuditScriptKeysResponse,
+	error) {
+
+	if r.cfg.ScriptKeyAuditor == nil {
+		return nil, fmt.Errorf("script key auditor not available")
+	}
+
+	reuses, err := r.cfg.ScriptKeyAuditor.Audit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	flags, err := r.cfg.AssetStore.ListFlaggedScriptKeys(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &taprpc.AuditScriptKeysResponse{
+		ReusedKeys:  make([]*taprpc.ScriptKeyReuse, len(reuses)),
+		FlaggedKeys: make([]*taprpc.FlaggedScriptKey, len(flags)),
+	}
+	for idx, reuse := range reuses {
+		conflict, err := marshalScriptKeyConflict(reuse.Conflict)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.ReusedKeys[idx] = &taprpc.ScriptKeyReuse{
+			ScriptKey: reuse.ScriptKey.SerializeCompressed(),
+			Conflict:  conflict,
+			AnchorOutpoints: fn.Map(
+				reuse.AnchorPoints,
+				func(op wire.OutPoint) string {
+					return op.String()
+				},
+			),
+		}
+	}
+	for idx, flag := range flags {
+		conflict, err := marshalScriptKeyConflict(flag.Conflict)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.FlaggedKeys[idx] = &taprpc.FlaggedScriptKey{
+			ScriptKey:      flag.ScriptKey.SerializeCompressed(),
+			Conflict:       conflict,
+			NumOccurrences: uint32(flag.NumOccurrences),
+			FlaggedAt:      flag.FlaggedAt.Unix(),
+		}
+		if flag.Resolved() {
+			resolvedAt := flag.ResolvedAt.Unix()
+			resp.FlaggedKeys[idx].ResolvedAt = resolvedAt
+		}
+	}
+
+	return resp, nil
+}
+
+// marshalScriptKeyConflict turns a script key conflict into its RPC
+// counterpart.
+func marshalScriptKeyConflict(
+	conflict tapfreighter.ScriptKeyConflict) (taprpc.ScriptKeyConflict,
+	error) {
+
+	switch conflict {
+	case tapfreighter.ScriptKeyConflictUnspent:
+		return taprpc.ScriptKeyConflict_SCRIPT_KEY_CONFLICT_UNSPENT, nil
+
+	case tapfreighter.ScriptKeyConflictTransfers:
+		return taprpc.ScriptKeyConflict_SCRIPT_KEY_CONFLICT_TRANSFERS,
+			nil
+
+	case tapfreighter.ScriptKeyConflictProof:
+		return taprpc.ScriptKeyConflict_SCRIPT_KEY_CONFLICT_PROOF, nil
+
+	default:
+		return 0, fmt.Errorf("unknown script key conflict <%d>",
+			conflict)
+	}
+}
+
+// ResolveFlaggedScriptKey resolves the open flags of a reused script key,
+// which allows sending assets to it again.
+func (r *rpcServer) ResolveFlaggedScriptKey(ctx context.Context,
+	req *taprpc.ResolveFlaggedScriptKeyRequest) (
+	*taprpc.ResolveFlaggedScriptKeyResponse, error) {
+
+	scriptKey, err := btcec.ParsePubKey(req.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script key: %w", err)
+	}
+
+	err = r.cfg.AssetStore.ResolveFlaggedScriptKey(ctx, scriptKey)
+	switch {
+	case errors.Is(err, tapfreighter.ErrScriptKeyNotFlagged):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, err
+	}
+
+	return &taprpc.ResolveFlaggedScriptKeyResponse{}, nil
+}
+
+// ExportScriptKeyDisclosures exports the internal key and tweak of the script
+// key of each owned asset output, optionally encrypted to the key of an
+// auditor.
+func (r *rpcServer) ExportScriptKeyDisclosures(ctx context.Context,
+	req *taprpc.ExportScriptKeyDisclosuresRequest) (
+	*taprpc.ExportScriptKeyDisclosuresResponse, error) {
+
+	var assetID *asset.ID
+	if len(req.AssetId) > 0 {
+		if len(req.AssetId) != sha256.Size {
+			return nil, fmt.Errorf("asset ID must be 32 bytes")
+		}
+
+		var id asset.ID
+		copy(id[:], req.AssetId)
+		assetID = &id
+	}
+
+	var auditorKey *btcec.PublicKey
+	if len(req.AuditorKey) > 0 {
+		var err error
+		auditorKey, err = btcec.ParsePubKey(req.AuditorKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid auditor key: %w", err)
+		}
+	}
+
+	assets, err := r.cfg.AssetStore.FetchAllAssets(ctx, false, true, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read chain assets: %w", err)
+	}
+
+	resp := &taprpc.ExportScriptKeyDisclosuresResponse{}
+	for _, a := range assets {
+		if assetID != nil && a.ID() != *assetID {
+			continue
+		}
+
+		disclosure, err := asset.NewScriptKeyDisclosure(
+			a.Asset, a.AnchorOutpoint,
+		)
+		if err != nil {
+			rpcsLog.Warnf("Unable to disclose script key of asset "+
+				"output %v: %v", a.AnchorOutpoint, err)
+			continue
+		}
+
+		resp.Disclosures = append(
+			resp.Disclosures, marshalScriptKeyDisclosure(disclosure),
+		)
+	}
+
+	if auditorKey == nil {
+		return resp, nil
+	}
+
+	disclosureBytes, err := proto.Marshal(resp)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize disclosures: %w",
+			err)
+	}
+
+	encrypted, err := proof.EncryptDisclosures(disclosureBytes, auditorKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encrypt disclosures: %w", err)
+	}
+
+	return &taprpc.ExportScriptKeyDisclosuresResponse{
+		EncryptedDisclosures: encrypted,
+	}, nil
+}
+
+// ExportAssetDescriptor exports the asset descriptor of the wallet, which
+// together with the seed of the backing lnd node suffices to recover the
+// assets of the wallet.
+func (r *rpcServer) ExportAssetDescriptor(ctx context.Context,
+	_ *taprpc.ExportAssetDescriptorRequest) (
+	*taprpc.ExportAssetDescriptorResponse, error) {
+
+	// We include spent assets as well, so groups we can still issue into
+	// are covered even if we don't hold any of their assets anymore.
+	chainAssets, err := r.cfg.AssetStore.FetchAllAssets(
+		ctx, true, true, nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read chain assets: %w", err)
+	}
+	assets := fn.Map(chainAssets, func(a *tapdb.ChainAsset) *asset.Asset {
+		return a.Asset
+	})
+
+	derivations, err := r.cfg.KeyAuditLog.QueryKeyDerivations(
+		ctx, tapgarden.KeyDerivationFilter{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query key derivations: %w",
+			err)
+	}
+
+	uniServers, err := r.cfg.FederationDB.UniverseServers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list universe servers: %w",
+			err)
+	}
+	hosts := fn.Map(uniServers, func(s universe.ServerAddr) string {
+		return s.HostStr()
+	})
+
+	desc := tapgarden.NewAssetDescriptor(
+		&r.cfg.ChainParams, assets, derivations, hosts,
+	)
+
+	var b bytes.Buffer
+	if err := desc.Encode(&b); err != nil {
+		return nil, fmt.Errorf("unable to encode descriptor: %w", err)
+	}
+
+	return &taprpc.ExportAssetDescriptorResponse{
+		AssetDescriptor: b.Bytes(),
+		Decoded:         marshalAssetDescriptor(desc),
+	}, nil
+}
+
+// ImportAssetDescriptor imports an asset descriptor into a wallet that was
+// restored from the same seed.
+func (r *rpcServer) ImportAssetDescriptor(ctx context.Context,
+	req *taprpc.ImportAssetDescriptorRequest) (
+	*taprpc.ImportAssetDescriptorResponse, error) {
+
+	var desc tapgarden.AssetDescriptor
+	err := desc.Decode(bytes.NewReader(req.AssetDescriptor))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode descriptor: %w", err)
+	}
+	if err := desc.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid descriptor: %w", err)
+	}
+
+	chainParams := r.cfg.ChainParams
+	if desc.ChainHash != *chainParams.GenesisHash {
+		return nil, fmt.Errorf("descriptor is for a different network "+
+			"than %v", chainParams.Name)
+	}
+	if desc.CoinType != chainParams.HDCoinType {
+		return nil, fmt.Errorf("descriptor coin type %d doesn't match "+
+			"coin type %d of %v", desc.CoinType,
+			chainParams.HDCoinType, chainParams.Name)
+	}
+
+	err = tapgarden.RestoreDescriptorKeys(ctx, r.cfg.KeyRing, &desc)
+	if err != nil {
+		return nil, err
+	}
+
+	uniServers, err := r.cfg.FederationDB.UniverseServers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list universe servers: %w",
+			err)
+	}
+	knownHosts := make(map[string]struct{}, len(uniServers))
+	for idx := range uniServers {
+		knownHosts[uniServers[idx].HostStr()] = struct{}{}
+	}
+
+	// The universe servers of the descriptor may not be reachable while
+	// the wallet is restored, so unlike AddFederationServer, we don't
+	// check the connection before adding them.
+	var newServers []universe.ServerAddr
+	for _, host := range desc.UniverseServers {
+		if _, ok := knownHosts[host]; ok {
+			continue
+		}
+		knownHosts[host] = struct{}{}
+
+		newServers = append(
+			newServers, universe.NewServerAddrFromStr(host),
+		)
+	}
+	if len(newServers) > 0 {
+		err := r.cfg.UniverseFederation.AddServer(newServers...)
+		if err != nil {
+			return nil, fmt.Errorf("unable to add universe "+
+				"servers: %w", err)
+		}
+	}
+
+	return &taprpc.ImportAssetDescriptorResponse{
+		Decoded: marshalAssetDescriptor(&desc),
+		AddedUniverseServers: fn.Map(
+			newServers, func(s universe.ServerAddr) string {
+				return s.HostStr()
+			},
+		),
+	}, nil
+}
+
+// marshalScriptKeyDisclosure turns a script key disclosure into its RPC
+// counterpart.
+func marshalScriptKeyDisclosure(
+	d *asset.ScriptKeyDisclosure) *taprpc.ScriptKeyDisclosure {
+
+	return &taprpc.ScriptKeyDisclosure{
+		AssetId:        fn.ByteSlice(d.AssetID),
+		Amount:         d.Amount,
+		AnchorOutpoint: d.AnchorPoint.String(),
+		ScriptKey: &taprpc.ScriptKey{
+			PubKey:   schnorr.SerializePubKey(d.ScriptKey),
+			KeyDesc:  marshalKeyDescriptor(d.InternalKey),
+			TapTweak: d.Tweak,
+		},
+	}
+}
+
+// marshalAssetDescriptor turns an asset descriptor into its RPC counterpart.
+func marshalAssetDescriptor(
+	d *tapgarden.AssetDescriptor) *taprpc.AssetDescriptor {
+
+	rpcDesc := &taprpc.AssetDescriptor{
+		Version:         uint32(d.Version),
+		ChainHash:       d.ChainHash[:],
+		Purpose:         d.Purpose,
+		CoinType:        d.CoinType,
+		UniverseServers: d.UniverseServers,
+	}
+
+	for _, keyRange := range d.KeyRanges {
+		rpcDesc.KeyRanges = append(
+			rpcDesc.KeyRanges, &taprpc.DescriptorKeyRange{
+				KeyFamily: uint32(keyRange.Family),
+				MaxIndex:  keyRange.MaxIndex,
+			},
+		)
+	}
+
+	for _, group := range d.Groups {
+		rpcDesc.Groups = append(rpcDesc.Groups, &taprpc.DescriptorGroup{
+			TweakedGroupKey: group.GroupKey.SerializeCompressed(),
+			RawGroupKey:     marshalKeyDescriptor(group.RawKey),
+		})
+	}
+
+	for _, a := range d.Assets {
+		assetID := a.Genesis.ID()
+		rpcAsset := &taprpc.DescriptorAsset{
+			AssetGenesis: &taprpc.GenesisInfo{
+				GenesisPoint: a.Genesis.FirstPrevOut.String(),
+				Name:         a.Genesis.Tag,
+				MetaHash:     a.Genesis.MetaHash[:],
+				AssetId:      assetID[:],
+				OutputIndex:  a.Genesis.OutputIndex,
+			},
+			AssetType: taprpc.AssetType(a.Genesis.Type),
+		}
+		if a.GroupKey != nil {
+			rpcAsset.GroupKey = a.GroupKey.SerializeCompressed()
+		}
+
+		rpcDesc.Assets = append(rpcDesc.Assets, rpcAsset)
+	}
+
+	return rpcDesc
+}
+
+// unmarshalKeyPurpose parses the RPC key purpose into the native counterpart.
+func unmarshalKeyPurpose(
+	rpcPurpose taprpc.KeyPurpose) (tapgarden.KeyPurpose, error) {
+
+	switch rpcPurpose {
+	case taprpc.KeyPurpose_KEY_PURPOSE_BATCH_INTERNAL_KEY:
+		return tapgarden.KeyPurposeBatchInternalKey, nil
+
+	case taprpc.KeyPurpose_KEY_PURPOSE_MINT_SCRIPT_KEY:
+		return tapgarden.KeyPurposeMintScriptKey, nil
+
+	case taprpc.KeyPurpose_KEY_PURPOSE_GROUP_KEY:
+		return tapgarden.KeyPurposeGroupKey, nil
+
+	case taprpc.KeyPurpose_KEY_PURPOSE_CHANGE_SCRIPT_KEY:
+		return tapgarden.KeyPurposeChangeScriptKey, nil
+
+	case taprpc.KeyPurpose_KEY_PURPOSE_ANCHOR_INTERNAL_KEY:
+		return tapgarden.KeyPurposeAnchorInternalKey, nil
+
+	default:
+		return 0, fmt.Errorf("unknown key purpose <%d>", rpcPurpose)
+	}
+}
+
+// marshalKeyDerivation turns an entry of the key derivation audit log into its
+// RPC counterpart.
+func marshalKeyDerivation(
+	d *tapgarden.KeyDerivation) (*taprpc.KeyDerivation, error) {
+
+	var purpose taprpc.KeyPurpose
+	switch d.Purpose {
+	case tapgarden.KeyPurposeBatchInternalKey:
+		purpose = taprpc.KeyPurpose_KEY_PURPOSE_BATCH_INTERNAL_KEY
+
+	case tapgarden.KeyPurposeMintScriptKey:
+		purpose = taprpc.KeyPurpose_KEY_PURPOSE_MINT_SCRIPT_KEY
+
+	case tapgarden.KeyPurposeGroupKey:
+		purpose = taprpc.KeyPurpose_KEY_PURPOSE_GROUP_KEY
+
+	case tapgarden.KeyPurposeChangeScriptKey:
+		purpose = taprpc.KeyPurpose_KEY_PURPOSE_CHANGE_SCRIPT_KEY
+
+	case tapgarden.KeyPurposeAnchorInternalKey:
+		purpose = taprpc.KeyPurpose_KEY_PURPOSE_ANCHOR_INTERNAL_KEY
+
+	default:
+		return nil, fmt.Errorf("unknown key purpose <%d>", d.Purpose)
+	}
+
+	return &taprpc.KeyDerivation{
+		Purpose:   purpose,
+		Key:       marshalKeyDescriptor(d.Key),
+		Object:    d.Object,
+		DerivedAt: d.DerivedAt.Unix(),
+	}, nil
+}
+
+// unmarshalAssetOutput parses the anchor outpoint and optional script key that
+// identify one or all asset outputs at an outpoint.
+func unmarshalAssetOutput(outpoint string,
+	scriptKeyBytes []byte) (*wire.OutPoint, *btcec.PublicKey, error) {
+
+	anchorPoint, err := UnmarshalOutpoint(outpoint)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid anchor outpoint: %w", err)
+	}
+
+	if len(scriptKeyBytes) == 0 {
+		return anchorPoint, nil, nil
+	}
+
+	scriptKey, err := parseUserKey(scriptKeyBytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid script key: %w", err)
+	}
+
+	return anchorPoint, scriptKey, nil
+}
+
+// unmarshalAssetLotID parses the RPC identifier of an asset lot.
+func unmarshalAssetLotID(rpcLot *taprpc.AssetLotID) (asset.PrevID, error) {
+	if rpcLot == nil {
+		return asset.PrevID{}, fmt.Errorf("lot must be specified")
+	}
+
+	anchorPoint, err := UnmarshalOutpoint(rpcLot.AnchorOutpoint)
+	if err != nil {
+		return asset.PrevID{}, fmt.Errorf("invalid anchor outpoint: %w",
+			err)
+	}
+
+	if len(rpcLot.AssetId) != 32 {
+		return asset.PrevID{}, fmt.Errorf("invalid asset id length")
+	}
+
+	// We need the full script key including its parity to identify the
+	// lot.
+	scriptKey, err := btcec.ParsePubKey(rpcLot.ScriptKey)
+	if err != nil {
+		return asset.PrevID{}, fmt.Errorf("invalid script key: %w", err)
+	}
+
+	lot := asset.PrevID{
+		OutPoint:  *anchorPoint,
+		ScriptKey: asset.ToSerialized(scriptKey),
+	}
+	copy(lot.ID[:], rpcLot.AssetId)
+
+	return lot, nil
+}
+
+// marshalAssetAlias converts an asset alias to its RPC counterpart.
+func marshalAssetAlias(alias *asset.Alias) *taprpc.AssetAlias {
+	rpcAlias := &taprpc.AssetAlias{
+		Name:       alias.Name,
+		Unverified: alias.Unverified(),
+	}
+
+	if alias.AssetID != nil {
+		rpcAlias.AssetId = fn.ByteSlice(*alias.AssetID)
+	}
+	if alias.GroupKey != nil {
+		rpcAlias.GroupKey = alias.GroupKey.SerializeCompressed()
+	}
+
+	switch alias.Source {
+	case asset.AliasSourceRemote:
+		rpcAlias.Source = taprpc.AssetAliasSource_ASSET_ALIAS_SOURCE_REMOTE
+
+	default:
+		rpcAlias.Source = taprpc.AssetAliasSource_ASSET_ALIAS_SOURCE_LOCAL
+	}
+
+	return rpcAlias
+}
+
+// marshalAssetLot converts an asset lot to its RPC counterpart.
+func marshalAssetLot(lot *tapfreighter.AssetLot) *taprpc.AssetLot {
+	rpcLot := &taprpc.AssetLot{
+		PriceAnnotation: lot.PriceAnnotation,
+	}
+	if !lot.AcquiredAt.IsZero() {
+		rpcLot.AcquiredAt = lot.AcquiredAt.Unix()
+	}
+	if lot.SourceTransfer != nil {
+		rpcLot.SourceTransferTxid = lot.SourceTransfer.String()
+	}
+
+	return rpcLot
+}
+
+// unmarshalCoinSelectStrategy parses the RPC coin selection strategy. Nil is
+// returned for the default strategy.
+func unmarshalCoinSelectStrategy(
+	rpcStrategy taprpc.CoinSelectStrategy) (
+	*tapfreighter.MultiCommitmentSelectStrategy, error) {
+
+	var strategy tapfreighter.MultiCommitmentSelectStrategy
+	switch rpcStrategy {
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_DEFAULT:
+		return nil, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_MAX_AMOUNT:
+		strategy = tapfreighter.PreferMaxAmount
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_OLDEST_LOT:
+		strategy = tapfreighter.PreferOldestLot
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_NEWEST_LOT:
+		strategy = tapfreighter.PreferNewestLot
+
+	default:
+		return nil, fmt.Errorf("unknown coin select strategy <%d>",
+			rpcStrategy)
+	}
+
+	return &strategy, nil
+}
+
+// marshalFrozenAssetOutput converts a frozen asset output to its RPC
+// counterpart.
+func marshalFrozenAssetOutput(
+	f tapdb.FrozenAssetOutput) *taprpc.FrozenAssetOutput {
+
+	return &taprpc.FrozenAssetOutput{
+		AnchorOutpoint: f.AnchorPoint.String(),
+		ScriptKey:      f.ScriptKey.SerializeCompressed(),
+		Reason:         f.Reason,
+		FrozenAt:       f.FrozenAt.Unix(),
+	}
+}
+
+// marshalWatchedAsset converts a watched asset to its RPC counterpart.
+func marshalWatchedAsset(w *tapgarden.WatchedAsset) *taprpc.WatchedAsset {
+	rpcWatched := &taprpc.WatchedAsset{
+		Id:             w.ID,
+		Label:          w.Label,
+		AssetId:        fn.ByteSlice(w.AssetID),
+		ScriptKey:      w.ScriptKey.SerializeCompressed(),
+		Amount:         w.Amount,
+		AnchorOutpoint: w.AnchorPoint.String(),
+		AnchorHeight:   w.AnchorHeight,
+		CreatedAt:      w.CreatedAt.Unix(),
+	}
+	if w.GroupKey != nil {
+		rpcWatched.GroupKey = w.GroupKey.SerializeCompressed()
+	}
+	if w.Spent() {
+		rpcWatched.SpendingTxid = w.SpendingTxid.String()
+		rpcWatched.SpendHeight = w.SpendHeight
+	}
+
+	return rpcWatched
+}
+
+// marshalAtRiskAsset converts an at risk asset to its RPC counterpart.
+func marshalAtRiskAsset(a *tapgarden.AtRiskAsset) *taprpc.AtRiskAsset {
+	return &taprpc.AtRiskAsset{
+		AssetId:        fn.ByteSlice(a.AssetID),
+		ScriptKey:      a.ScriptKey.SerializeCompressed(),
+		Amount:         a.Amount,
+		AnchorOutpoint: a.AnchorPoint.String(),
+		SpendingTxid:   a.SpendingTxid.String(),
+		SpendHeight:    a.SpendHeight,
+		DetectedAt:     a.DetectedAt.Unix(),
+	}
+}
+
+// QueryAddrs queries the set of Taproot Asset addresses stored in the database.
+func (r *rpcServer) QueryAddrs(ctx context.Context,
+	in *taprpc.QueryAddrRequest) (*taprpc.QueryAddrResponse, error) {
+
+	query := address.QueryParams{
+		Limit:  in.Limit,
+		Offset: in.Offset,
+	}
+
+	// The unix time of 0 (1970-01-01) is not the same as an empty Time
+	// struct (0000-00-00). For our query to succeed, we need to set the
+	// time values the way the address book expects them.
+	if in.CreatedBefore > 0 {
+		query.CreatedBefore = time.Unix(in.CreatedBefore, 0)
+	}
+	if in.CreatedAfter > 0 {
+		query.CreatedAfter = time.Unix(in.CreatedAfter, 0)
+	}
+
+	rpcsLog.Debugf("[QueryAddrs]: addr query params: %v",
+		spew.Sdump(query))
+
+	dbAddrs, err := r.cfg.AddrBook.ListAddrs(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query addrs: %w", err)
+	}
+
+	// TODO(roasbeef): just stop storing the hrp in the addr?
+	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+
+	addrs := make([]*taprpc.Addr, len(dbAddrs))
+	for i, dbAddr := range dbAddrs {
+		dbAddr.ChainParams = &tapParams
+
+		addrs[i], err = marshalAddr(dbAddr.Tap, r.cfg.TapAddrBook)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal addr: %w",
+				err)
+		}
+	}
+
+	rpcsLog.Debugf("[QueryAddrs]: returning %v addrs", len(addrs))
+
+	return &taprpc.QueryAddrResponse{
+		Addrs: addrs,
+	}, nil
+}
+
+// NewAddr makes a new address from the set of request params.
+func (r *rpcServer) NewAddr(ctx context.Context,
+	in *taprpc.NewAddrRequest) (*taprpc.Addr, error) {
+
+	var err error
+
+	if len(in.AssetId) != 32 {
+		return nil, fmt.Errorf("invalid asset id length")
+	}
+
+	var assetID asset.ID
+	copy(assetID[:], in.AssetId)
+
+	rpcsLog.Infof("[NewAddr]: making new addr: asset_id=%x, amt=%v",
+		assetID[:], in.Amt)
+
+	err = r.checkBalanceOverflow(ctx, &assetID, nil, in.Amt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Was there a tapscript sibling preimage specified?
+	tapscriptSibling, _, err := commitment.MaybeDecodeTapscriptPreimage(
+		in.TapscriptSibling,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tapscript sibling: %w", err)
+	}
+
+	// Was the address annotated with a deposit expectation?
+	var addrOpts []address.NewAddrOption
+	if in.DepositExpectation != nil {
+		expectation := address.DepositExpectation{
+			Amount: in.DepositExpectation.Amt,
+		}
+		if in.DepositExpectation.ExpiryUnixSeconds < 0 {
+			return nil, fmt.Errorf("invalid deposit expiry: %d",
+				in.DepositExpectation.ExpiryUnixSeconds)
+		}
+		if in.DepositExpectation.ExpiryUnixSeconds > 0 {
+			expectation.Expiry = time.Unix(
+				in.DepositExpectation.ExpiryUnixSeconds, 0,
+			)
+		}
+
+		addrOpts = append(
+			addrOpts, address.WithDepositExpectation(expectation),
+		)
+	}
+
+	var addr *address.AddrWithKeyInfo
+	switch {
+	// No key was specified, we'll let the address book derive them.
+	case in.ScriptKey == nil && in.InternalKey == nil:
+		// Now that we have all the params, we'll try to add a new
+		// address to the addr book.
+		addr, err = r.cfg.AddrBook.NewAddress(
+			ctx, assetID, in.Amt, tapscriptSibling, addrOpts...,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to make new addr: %w",
+				err)
+		}
+
+	// Only the script key was specified.
+	case in.ScriptKey != nil && in.InternalKey == nil:
+		return nil, fmt.Errorf("internal key must also be specified " +
+			"if script key is specified")
+
+	// Only the internal key was specified.
+	case in.ScriptKey == nil && in.InternalKey != nil:
+		return nil, fmt.Errorf("script key must also be specified " +
+			"if internal key is specified")
+
+	// Both the script and internal keys were specified.
+	default:
+		scriptKey, err := UnmarshalScriptKey(in.ScriptKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode script key: "+
+				"%w", err)
+		}
+
+		rpcsLog.Debugf("Decoded script key %x (internal %x, tweak %x)",
+			scriptKey.PubKey.SerializeCompressed(),
+			scriptKey.RawKey.PubKey.SerializeCompressed(),
+			scriptKey.Tweak[:])
+
+		internalKey, err := UnmarshalKeyDescriptor(in.InternalKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode internal "+
+				"key: %w", err)
+		}
+
+		// Now that we have all the params, we'll try to add a new
+		// address to the addr book.
+		addr, err = r.cfg.AddrBook.NewAddressWithKeys(
+			ctx, assetID, in.Amt, *scriptKey, internalKey,
+			tapscriptSibling, addrOpts...,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to make new addr: %w",
+				err)
+		}
+	}
+
+	// With our addr obtained, we'll marshal it as an RPC message then send
+	// off the response.
+	rpcAddr, err := marshalAddr(addr.Tap, r.cfg.TapAddrBook)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal addr: %w", err)
+	}
+
+	return rpcAddr, nil
+}
+
+// DecodeAddr decode a Taproot Asset address into a partial asset message that
+// represents the asset it wants to receive.
+func (r *rpcServer) DecodeAddr(_ context.Context,
+	in *taprpc.DecodeAddrRequest) (*taprpc.Addr, error) {
+
+	if len(in.Addr) == 0 {
+		return nil, fmt.Errorf("must specify an addr")
+	}
+
+	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+
+	addr, err := address.DecodeAddress(in.Addr, &tapParams)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode addr: %w", err)
+	}
+
+	rpcAddr, err := marshalAddr(addr, r.cfg.TapAddrBook)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal addr: %w", err)
+	}
+
+	return rpcAddr, nil
+}
+
+// InspectAddr decodes a Taproot Asset address and reports how the proofs of a
+// transfer to it would be delivered: which proof courier would be used, whether
+// this node supports it and the expected size of the proof.
+func (r *rpcServer) InspectAddr(ctx context.Context,
+	in *taprpc.InspectAddrRequest) (*taprpc.InspectAddrResponse, error) {
+
+	rpcAddr, err := r.DecodeAddr(ctx, &taprpc.DecodeAddrRequest{
+		Addr: in.Addr,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	courierInfo := r.cfg.ProofCourierInfo
+	resp := &taprpc.InspectAddrResponse{
+		Addr:              rpcAddr,
+		CourierScheme:     courierInfo.Scheme,
+		CourierAddr:       courierInfo.Addr,
+		CourierSupported:  true,
+		ReceiverNeedsIpfs: courierInfo.PinsToIPFS,
+	}
+	if err := courierInfo.Supported(); err != nil {
+		resp.CourierSupported = false
+		resp.UnsupportedReason = err.Error()
+	}
+
+	// The proof sent to the receiver extends the proof of one of our
+	// assets, so we estimate its size from the proofs we have.
+	var assetID asset.ID
+	copy(assetID[:], rpcAddr.AssetId)
+
+	proofs, err := r.cfg.ProofArchive.FetchProofs(ctx, assetID)
+	switch {
+	case errors.Is(err, proof.ErrProofNotFound):
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch proofs: %w", err)
+
+	default:
+		resp.ExpectedProofSize, err = proof.EstimateTransferProofSize(
+			proofs,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to estimate proof "+
+				"size: %w", err)
+		}
+	}
+
+	return resp, nil
+}
+
+// VerifyProof attempts to verify a given proof file that claims to be anchored
+// at the specified genesis point.
+func (r *rpcServer) VerifyProof(ctx context.Context,
+	in *taprpc.ProofFile) (*taprpc.VerifyProofResponse, error) {
+
+	if len(in.RawProof) == 0 {
+		return nil, fmt.Errorf("proof file must be specified")
+	}
+
+	var proofFile proof.File
+	err := proofFile.Decode(bytes.NewReader(in.RawProof))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode proof file: %w", err)
+	}
+
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	_, err = proofFile.Verify(ctx, headerVerifier)
+	if err != nil {
+		// We don't want to fail the RPC request because of a proof
+		// verification error, but we do want to log it for easier
+		// debugging.
+		rpcsLog.Errorf("Proof verification failed with err: %v", err)
+	}
+	valid := err == nil
+
+	decodedProof, err := r.marshalProofFile(ctx, proofFile, 0, false, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal proof: %w", err)
+	}
+
+	return &taprpc.VerifyProofResponse{
+		Valid:        valid,
+		DecodedProof: decodedProof,
+	}, nil
+}
+
+// DecodeProof attempts to decode a given proof file that claims to be anchored
+// at the specified genesis point.
+func (r *rpcServer) DecodeProof(ctx context.Context,
+	in *taprpc.DecodeProofRequest) (*taprpc.DecodeProofResponse, error) {
+
+	if len(in.RawProof) == 0 {
+		return nil, fmt.Errorf("proof file must be specified")
+	}
+
+	var proofFile proof.File
+	if err := proofFile.Decode(bytes.NewReader(in.RawProof)); err != nil {
+		return nil, fmt.Errorf("unable to decode proof file: %w", err)
+	}
+
+	latestProofIndex := uint32(proofFile.NumProofs() - 1)
+
+	if in.ProofAtDepth > latestProofIndex {
+		return nil, fmt.Errorf("invalid depth %d is greater than "+
+			"latest proof index of %d", in.ProofAtDepth,
+			latestProofIndex)
+	}
+
+	// Default to latest proof.
+	depth := latestProofIndex - in.ProofAtDepth
+
+	decodedProof, err := r.marshalProofFile(
+		ctx, proofFile, depth, in.WithPrevWitnesses, in.WithMetaReveal,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal proof: %w", err)
+	}
+
+	return &taprpc.DecodeProofResponse{
+		DecodedProof: decodedProof,
+	}, nil
+}
+
+// marshalProofFile turns a proof file into an RPC DecodedProof.
+func (r *rpcServer) marshalProofFile(ctx context.Context, proofFile proof.File,
+	depth uint32, withPrevWitnesses,
+	withMetaReveal bool) (*taprpc.DecodedProof, error) {
+
+	decodedProof, err := proofFile.ProofAt(depth)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		rpcMeta        *taprpc.AssetMeta
+		anchorOutpoint = wire.OutPoint{
+			Hash:  decodedProof.AnchorTx.TxHash(),
+			Index: decodedProof.InclusionProof.OutputIndex,
+		}
+		txMerkleProof  = decodedProof.TxMerkleProof
+		inclusionProof = decodedProof.InclusionProof
+		splitRootProof = decodedProof.SplitRootProof
+	)
+
+	var txMerkleProofBuf bytes.Buffer
+	if err := txMerkleProof.Encode(&txMerkleProofBuf); err != nil {
+		return nil, fmt.Errorf("unable to encode serialized Bitcoin "+
+			"merkle proof: %w", err)
+	}
+
+	var inclusionProofBuf bytes.Buffer
+	if err := inclusionProof.Encode(&inclusionProofBuf); err != nil {
+		return nil, fmt.Errorf("unable to encode inclusion proof: %w",
+			err)
+	}
+
+	if inclusionProof.CommitmentProof == nil {
+		return nil, fmt.Errorf("inclusion proof is missing " +
+			"commitment proof")
+	}
+	tsSibling, tsHash, err := commitment.MaybeEncodeTapscriptPreimage(
+		inclusionProof.CommitmentProof.TapSiblingPreimage,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding tapscript sibling: %w",
+			err)
+	}
+
+	tapProof, err := inclusionProof.CommitmentProof.DeriveByAssetInclusion(
+		&decodedProof.Asset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving inclusion proof: %w",
+			err)
+	}
+	merkleRoot := tapProof.TapscriptRoot(tsHash)
+
+	var exclusionProofs [][]byte
+	for _, exclusionProof := range decodedProof.ExclusionProofs {
+		var exclusionProofBuf bytes.Buffer
+		err := exclusionProof.Encode(&exclusionProofBuf)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode exclusion "+
+				"proofs: %w", err)
+		}
+		exclusionProofs = append(
+			exclusionProofs, exclusionProofBuf.Bytes(),
+		)
+	}
+
+	var splitRootProofBuf bytes.Buffer
+	if splitRootProof != nil {
+		err := splitRootProof.Encode(&splitRootProofBuf)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode split root "+
+				"proof: %w", err)
+		}
+	}
+
+	rpcAsset, err := r.marshalChainAsset(ctx, &tapdb.ChainAsset{
+		Asset:                  &decodedProof.Asset,
+		AnchorTx:               &decodedProof.AnchorTx,
+		AnchorTxid:             decodedProof.AnchorTx.TxHash(),
+		AnchorBlockHash:        decodedProof.BlockHeader.BlockHash(),
+		AnchorBlockHeight:      decodedProof.BlockHeight,
+		AnchorOutpoint:         anchorOutpoint,
+		AnchorInternalKey:      decodedProof.InclusionProof.InternalKey,
+		AnchorMerkleRoot:       merkleRoot[:],
+		AnchorTapscriptSibling: tsSibling,
+	}, withPrevWitnesses)
+	if err != nil {
+		return nil, err
+	}
+
+	if withMetaReveal {
+		metaHash := rpcAsset.AssetGenesis.MetaHash
+		if len(metaHash) == 0 {
+			return nil, fmt.Errorf("asset does not contain meta " +
+				"data")
+		}
+
+		rpcMeta, err = r.FetchAssetMeta(
+			ctx, &taprpc.FetchAssetMetaRequest{
+				Asset: &taprpc.FetchAssetMetaRequest_MetaHash{
+					MetaHash: metaHash,
+				},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &taprpc.DecodedProof{
+		ProofAtDepth:        depth,
+		NumberOfProofs:      uint32(proofFile.NumProofs()),
+		Asset:               rpcAsset,
+		MetaReveal:          rpcMeta,
+		TxMerkleProof:       txMerkleProofBuf.Bytes(),
+		InclusionProof:      inclusionProofBuf.Bytes(),
+		ExclusionProofs:     exclusionProofs,
+		SplitRootProof:      splitRootProofBuf.Bytes(),
+		NumAdditionalInputs: uint32(len(decodedProof.AdditionalInputs)),
+		ChallengeWitness:    decodedProof.ChallengeWitness,
+	}, nil
+}
+
+// ExportProof exports the latest raw proof file anchored at the specified
+// script_key.
+func (r *rpcServer) ExportProof(ctx context.Context,
+	in *taprpc.ExportProofRequest) (*taprpc.ProofFile, error) {
+
+	if len(in.ScriptKey) == 0 {
+		return nil, fmt.Errorf("a valid script key must be specified")
+	}
+
+	scriptKey, err := parseUserKey(in.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script key: %w", err)
+	}
+
+	if len(in.AssetId) != 32 {
+		return nil, fmt.Errorf("asset ID must be 32 bytes")
+	}
+
+	var assetID asset.ID
+	copy(assetID[:], in.AssetId)
+
+	proofBlob, err := r.cfg.ProofArchive.FetchProof(ctx, proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *scriptKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &taprpc.ProofFile{
+		RawProof: proofBlob,
+	}, nil
+}
+
+// PushArchiveProofs stores proofs in the archive of the user the macaroon of
+// the caller is scoped to.
+func (r *rpcServer) PushArchiveProofs(ctx context.Context,
+	in *taprpc.PushArchiveProofsRequest) (*taprpc.PushArchiveProofsResponse,
+	error) {
+
+	archive, err := r.userProofArchive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proofs := make([]*proof.AnnotatedProof, 0, len(in.Proofs))
+	for idx, archived := range in.Proofs {
+		loc, err := unmarshalArchiveLocator(
+			archived.AssetId, archived.GroupKey, archived.ScriptKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof %d: %w", idx, err)
+		}
+
+		err = checkArchivedProof(loc, archived.RawProofFile)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof %d: %w", idx, err)
+		}
+
+		proofs = append(proofs, &proof.AnnotatedProof{
+			Locator: *loc,
+			Blob:    archived.RawProofFile,
+		})
+	}
+
+	err = archive.ImportProofs(ctx, nil, false, proofs...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store proofs: %w", err)
+	}
+
+	return &taprpc.PushArchiveProofsResponse{}, nil
+}
+
+// PullArchiveProof fetches a proof from the archive of the user the macaroon of
+// the caller is scoped to.
+func (r *rpcServer) PullArchiveProof(ctx context.Context,
+	in *taprpc.PullArchiveProofRequest) (*taprpc.ArchivedProof, error) {
+
+	archive, err := r.userProofArchive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := unmarshalArchiveLocator(
+		in.AssetId, in.GroupKey, in.ScriptKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	blob, err := archive.FetchProof(ctx, *loc)
+	switch {
+	// The not found status allows the remote archive to tell a missing
+	// proof apart from a failure.
+	case errors.Is(err, proof.ErrProofNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, err
+	}
+
+	return &taprpc.ArchivedProof{
+		AssetId:      in.AssetId,
+		GroupKey:     in.GroupKey,
+		ScriptKey:    in.ScriptKey,
+		RawProofFile: blob,
+	}, nil
+}
+
+// userProofArchive returns the archive of the user the macaroon of the given
+// request context is scoped to.
+func (r *rpcServer) userProofArchive(
+	ctx context.Context) (*proof.FileArchiver, error) {
+
+	if r.cfg.ProofArchiveUsers == nil {
+		return nil, fmt.Errorf("central proof archive not enabled")
+	}
+
+	user, err := archiveUserFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return r.cfg.ProofArchiveUsers.ForUser(user)
+}
+
+// BakeArchiveMacaroon bakes a macaroon that only grants access to the archive
+// of the given user in the central proof archive.
+func (r *rpcServer) BakeArchiveMacaroon(ctx context.Context,
+	in *taprpc.BakeArchiveMacaroonRequest) (
+	*taprpc.BakeArchiveMacaroonResponse, error) {
+
+	if r.cfg.ProofArchiveUsers == nil {
+		return nil, fmt.Errorf("central proof archive not enabled")
+	}
+	if r.macaroonService == nil {
+		return nil, fmt.Errorf("macaroons are disabled")
+	}
+
+	if err := proof.ValidateArchiveUser(in.User); err != nil {
+		return nil, err
+	}
+
+	bakedMac, err := r.macaroonService.NewMacaroon(
+		ctx, macaroons.DefaultRootKeyID, archiveMacaroonOps...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to bake macaroon: %w", err)
+	}
+
+	mac, err := macaroons.AddConstraints(
+		bakedMac.M(), macaroons.CustomConstraint(
+			ArchiveUserCaveat, in.User,
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to scope macaroon: %w", err)
+	}
+
+	macBytes, err := mac.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[BakeArchiveMacaroon]: baked proof archive macaroon "+
+		"for user %v", in.User)
+
+	return &taprpc.BakeArchiveMacaroonResponse{
+		Macaroon: hex.EncodeToString(macBytes),
+	}, nil
+}
+
+// ProofArchiveStats returns the number and total size of the proofs in the hot
+// and the cold tier of the on-disk proof archive, and the metrics of the proof
+// scrubber.
+func (r *rpcServer) ProofArchiveStats(ctx context.Context,
+	_ *taprpc.ProofArchiveStatsRequest) (*taprpc.ProofArchiveStatsResponse,
+	error) {
+
+	if r.cfg.ProofTiers == nil && r.cfg.ProofScrubber == nil {
+		return nil, fmt.Errorf("neither tiering nor scrubbing of the " +
+			"proof archive is enabled")
+	}
+
+	var resp taprpc.ProofArchiveStatsResponse
+	if r.cfg.ProofTiers != nil {
+		hot, cold, err := r.cfg.ProofTiers.Stats(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Hot = &taprpc.ProofTierStats{
+			NumProofs: hot.NumProofs,
+			SizeBytes: hot.SizeBytes,
+		}
+		resp.Cold = &taprpc.ProofTierStats{
+			NumProofs: cold.NumProofs,
+			SizeBytes: cold.SizeBytes,
+		}
+	}
+
+	if r.cfg.ProofScrubber != nil {
+		stats := r.cfg.ProofScrubber.Stats()
+
+		var lastPassAt int64
+		if !stats.LastPassAt.IsZero() {
+			lastPassAt = stats.LastPassAt.Unix()
+		}
+
+		resp.Scrub = &taprpc.ProofScrubStats{
+			NumPasses:    stats.NumPasses,
+			LastPassAt:   lastPassAt,
+			NumChecked:   stats.NumChecked,
+			NumVerified:  stats.NumVerified,
+			NumMissing:   stats.NumMissing,
+			NumCorrupted: stats.NumCorrupted,
+			NumInvalid:   stats.NumInvalid,
+		}
+	}
+
+	return &resp, nil
+}
+
+// ImportProof attempts to import a proof file into the daemon. If successful, a
+// new asset will be inserted on disk, spendable using the specified target
+// script key, and internal key.
+func (r *rpcServer) ImportProof(ctx context.Context,
+	in *tapdevrpc.ImportProofRequest) (*tapdevrpc.ImportProofResponse, error) {
+
+	// We'll perform some basic input validation before we move forward.
+	if len(in.ProofFile) == 0 {
+		return nil, fmt.Errorf("proof file must be specified")
+	}
+
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+
+	// Now that we know the proof file is at least present, we'll attempt
+	// to import it into the main archive.
+	err := r.cfg.ProofArchive.ImportProofs(
+		ctx, headerVerifier, false,
+		&proof.AnnotatedProof{Blob: in.ProofFile},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tapdevrpc.ImportProofResponse{}, nil
+}
+
+// addrTaprootOutputKey decodes the given address and derives the Taproot output
+// key that inbound transfers to it are sent to.
+func (r *rpcServer) addrTaprootOutputKey(ctx context.Context,
+	encodedAddr string) (*btcec.PublicKey, error) {
+
+	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+
+	addr, err := address.DecodeAddress(encodedAddr, &tapParams)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode addr: %w", err)
+	}
+
+	// Now that we've decoded the address, we'll check to make sure that we
+	// can fetch the genesis for this address. Otherwise, that means we
+	// don't know anything about what it should look like on chain (the
+	// genesis is required to derive the taproot output key).
+	assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(
+		ctx, addr.AssetID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unknown asset=%x: %w", addr.AssetID[:],
+			err)
+	}
+
+	rpcsLog.Tracef("Deriving Taproot key for group: %v",
+		spew.Sdump(assetGroup))
+
+	addr.AttachGenesis(*assetGroup.Genesis)
+
+	if assetGroup.GroupKey != nil {
+		addr.AttachGroupSig(assetGroup.GroupKey.Sig)
+	}
+
+	taprootOutputKey, err := addr.TaprootOutputKey()
+	if err != nil {
+		return nil, fmt.Errorf("error deriving Taproot key: %w", err)
+	}
+
+	return taprootOutputKey, nil
+}
+
+// AddrReceives lists all receives for incoming asset transfers for addresses
+// that were created previously.
+func (r *rpcServer) AddrReceives(ctx context.Context,
+	in *taprpc.AddrReceivesRequest) (*taprpc.AddrReceivesResponse,
+	error) {
+
+	var sqlQuery address.EventQueryParams
+
+	if len(in.FilterAddr) > 0 {
+		taprootOutputKey, err := r.addrTaprootOutputKey(
+			ctx, in.FilterAddr,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		sqlQuery.AddrTaprootOutputKey = schnorr.SerializePubKey(
+			taprootOutputKey,
+		)
+	}
+
+	if in.FilterStatus != taprpc.AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN {
+		status, err := unmarshalAddrEventStatus(in.FilterStatus)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing status: %w", err)
+		}
+
+		sqlQuery.StatusFrom = &status
+		sqlQuery.StatusTo = &status
+	}
+
+	events, err := r.cfg.AddrBook.QueryEvents(ctx, sqlQuery)
+	if err != nil {
+		return nil, fmt.Errorf("error querying events: %w", err)
+	}
+
+	resp := &taprpc.AddrReceivesResponse{
+		Events: make([]*taprpc.AddrEvent, len(events)),
+	}
+
+	for idx, event := range events {
+		resp.Events[idx], err = marshalAddrEvent(
+			event, r.cfg.TapAddrBook,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling event: %w",
+				err)
+		}
+	}
+
+	return resp, nil
+}
+
+// FundVirtualPsbt selects inputs from the available asset commitments to fund
+// a virtual transaction matching the template.
+func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
+	in *wrpc.FundVirtualPsbtRequest) (*wrpc.FundVirtualPsbtResponse,
+	error) {
+
+	// The caller can supply the keys of the funded packet, so all output
+	// scripts can be computed before funding.
+	var fundOpts []tapfreighter.FundOption
+	if in.ChangeScriptKey != nil {
+		changeScriptKey, err := UnmarshalScriptKey(in.ChangeScriptKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode change script "+
+				"key: %w", err)
+		}
+		fundOpts = append(
+			fundOpts, tapfreighter.WithChangeScriptKey(
+				*changeScriptKey,
+			),
+		)
+	}
+	if in.AnchorInternalKey != nil {
+		internalKey, err := UnmarshalKeyDescriptor(in.AnchorInternalKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode anchor "+
+				"internal key: %w", err)
+		}
+		fundOpts = append(
+			fundOpts,
+			tapfreighter.WithAnchorInternalKey(internalKey),
+		)
+	}
+
+	if in.SortAnchorOutputs {
+		fundOpts = append(
+			fundOpts, tapfreighter.WithSortedAnchorOutputs(),
+		)
+	}
+
+	var fundedVPkt *tapfreighter.FundedVPacket
+	switch {
+	case in.GetPsbt() != nil:
+		vPkt, err := tappsbt.NewFromRawBytes(
+			bytes.NewReader(in.GetPsbt()), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode psbt: %w", err)
+		}
+
+		// Extract the recipient information from the packet. This
+		// basically assembles the asset ID we want to send to and the
+		// sum of all output amounts.
+		desc, err := tapscript.DescribeRecipients(
+			ctx, vPkt, r.cfg.TapAddrBook,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to describe packet "+
+				"recipients: %w", err)
+		}
+
+		fundedVPkt, err = r.cfg.AssetWallet.FundPacket(
+			ctx, desc, vPkt, fundOpts...,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error funding packet: %w", err)
+		}
+
+	case in.GetRaw() != nil:
+		raw := in.GetRaw()
+		if len(raw.Inputs) > 0 {
+			return nil, fmt.Errorf("template inputs not yet " +
+				"supported")
+		}
+		if len(raw.Recipients) > 1 {
+			return nil, fmt.Errorf("only one recipient supported")
+		}
+
+		var (
+			tapParams = address.ParamsForChain(
+				r.cfg.ChainParams.Name,
+			)
+			addr        *address.Tap
+			outputIndex uint64
+			err         error
+		)
+		for a, idx := range raw.Recipients {
+			addr, err = address.DecodeAddress(a, &tapParams)
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode "+
+					"addr: %w", err)
+			}
+			outputIndex = idx
+		}
+
+		if addr == nil {
+			return nil, fmt.Errorf("no recipients specified")
+		}
+
+		// The recipient's output index is only used if the anchor
+		// outputs aren't sorted anyway.
+		if !in.SortAnchorOutputs {
+			if outputIndex > math.MaxUint32 {
+				return nil, fmt.Errorf("invalid output index "+
+					"%d", outputIndex)
+			}
+
+			fundOpts = append(
+				fundOpts,
+				tapfreighter.WithRecipientOutputIndexes(
+					uint32(outputIndex),
+				),
+			)
+		}
+
+		fundedVPkt, err = r.cfg.AssetWallet.FundAddressSend(
+			ctx, []*address.Tap{addr}, fundOpts...,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error funding address send: "+
+				"%w", err)
+		}
+
+	default:
+		return nil, fmt.Errorf("either PSBT or raw template must be " +
+			"specified")
+	}
+
+	var b bytes.Buffer
+	if err := fundedVPkt.VPacket.Serialize(&b); err != nil {
+		return nil, fmt.Errorf("error serializing packet: %w", err)
+	}
+
+	return &wrpc.FundVirtualPsbtResponse{
+		FundedPsbt:        b.Bytes(),
+		ChangeOutputIndex: 0,
+	}, nil
+}
+
+// SignVirtualPsbt signs the inputs of a virtual transaction and prepares the
+// commitments of the inputs and outputs.
+func (r *rpcServer) SignVirtualPsbt(ctx context.Context,
+	in *wrpc.SignVirtualPsbtRequest) (*wrpc.SignVirtualPsbtResponse,
+	error) {
+
+	vPkt, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(in.FundedPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding packet: %w", err)
+	}
+
+	signedInputs, err := r.cfg.AssetWallet.SignVirtualPacket(
+		vPkt, tapfreighter.WithSignContext(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error signing packet: %w", err)
+	}
+
+	var b bytes.Buffer
+	if err := vPkt.Serialize(&b); err != nil {
+		return nil, fmt.Errorf("error serializing packet: %w", err)
+	}
+
+	return &wrpc.SignVirtualPsbtResponse{
+		SignedPsbt:   b.Bytes(),
+		SignedInputs: signedInputs,
+	}, nil
+}
+
+// AnchorVirtualPsbts merges and then commits multiple virtual transactions in
+// a single BTC level anchor transaction.
+//
+// TODO(guggero): Actually implement accepting and merging multiple
+// transactions.
+func (r *rpcServer) AnchorVirtualPsbts(ctx context.Context,
+	in *wrpc.AnchorVirtualPsbtsRequest) (*taprpc.SendAssetResponse,
+	error) {
+
+	if len(in.VirtualPsbts) > 1 {
+		return nil, fmt.Errorf("only one virtual PSBT supported")
+	}
+
+	vPacket, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(in.VirtualPsbts[0]), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding packet: %w", err)
+	}
+
+	if len(vPacket.Inputs) != 1 {
+		return nil, fmt.Errorf("only one input is currently supported")
+	}
+
+	inputAsset := vPacket.Inputs[0].Asset()
+	prevID := vPacket.Inputs[0].PrevID
+	inputCommitment, err := r.cfg.AssetStore.FetchCommitment(
+		ctx, inputAsset.ID(), prevID.OutPoint, inputAsset.GroupKey,
+		&inputAsset.ScriptKey, true,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching input commitment: %w",
+			err)
+	}
+
+	rpcsLog.Debugf("Selected commitment for anchor point %v, requesting "+
+		"delivery", inputCommitment.AnchorPoint)
+
+	preSignedParcel := tapfreighter.NewPreSignedParcel(
+		vPacket, inputCommitment.Commitment,
+	)
+	if in.ExternalCoSigner {
+		preSignedParcel.SetAnchorCoSigner(r.anchorCoSigner)
+	}
+
+	sendAmounts := func() map[asset.ID]uint64 {
+		return r.vPacketSendAmounts(ctx, vPacket)
+	}
+	resp, err := r.requestLimitedShipment(
+		ctx, preSignedParcel, sendAmounts,
+	)
+	if status.Code(err) == codes.PermissionDenied {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error requesting delivery: %w", err)
+	}
+
+	parcel, err := marshalOutboundParcel(resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+	r.annotateTransfer(ctx, parcel)
+
+	return &taprpc.SendAssetResponse{
+		Transfer: parcel,
+	}, nil
+}
+
+// NextInternalKey derives the next internal key for the given key family and
+// stores it as an internal key in the database to make sure it is identified
+// as a local key later on when importing proofs. While an internal key can
+// also be used as the internal key of a script key, it is recommended to use
+// the NextScriptKey RPC instead, to make sure the tweaked Taproot output key
+// is also recognized as a local key.
+func (r *rpcServer) NextInternalKey(ctx context.Context,
+	req *wrpc.NextInternalKeyRequest) (*wrpc.NextInternalKeyResponse,
+	error) {
+
+	// Due to how we detect local keys, we need to make sure that the key
+	// family is not zero.
+	if req.KeyFamily == 0 {
+		return nil, fmt.Errorf("key family must be set to a non-zero " +
+			"value")
+	}
+
+	keyDesc, err := r.cfg.AddrBook.NextInternalKey(ctx, keychain.KeyFamily(
+		req.KeyFamily,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("error inserting internal key: %w", err)
+	}
+
+	return &wrpc.NextInternalKeyResponse{
+		InternalKey: marshalKeyDescriptor(keyDesc),
+	}, nil
+}
+
+// NextScriptKey derives the next script key (and its corresponding internal
+// key) and stores them both in the database to make sure they are identified
+// as local keys later on when importing proofs.
+func (r *rpcServer) NextScriptKey(ctx context.Context,
+	req *wrpc.NextScriptKeyRequest) (*wrpc.NextScriptKeyResponse,
+	error) {
+
+	// Due to how we detect local keys, we need to make sure that the key
+	// family is not zero.
+	if req.KeyFamily == 0 {
+		return nil, fmt.Errorf("key family must be set to a non-zero " +
+			"value")
+	}
+
+	scriptKey, err := r.cfg.AddrBook.NextScriptKey(ctx, keychain.KeyFamily(
+		req.KeyFamily,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("error inserting internal key: %w", err)
+	}
+
+	return &wrpc.NextScriptKeyResponse{
+		ScriptKey: marshalScriptKey(scriptKey),
+	}, nil
+}
+
+// marshalAddr turns an address into its RPC counterpart.
+func marshalAddr(addr *address.Tap,
+	db address.Storage) (*taprpc.Addr, error) {
+
+	addrStr, err := addr.EncodeAddress()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode addr: %w", err)
+	}
+
+	// We can only derive the taproot output if we already know the genesis
+	// for this asset, as that's required to make the template asset that
+	// will be committed to in the tapscript tree.
+	var taprootOutputKey []byte
+	assetGroup, err := db.QueryAssetGroup(
+		context.Background(), addr.AssetID,
+	)
+	if err == nil {
+		addr.AttachGenesis(*assetGroup.Genesis)
+
+		if assetGroup.GroupKey != nil {
+			addr.AttachGroupSig(assetGroup.GroupKey.Sig)
+		}
+
+		outputKey, err := addr.TaprootOutputKey()
+		if err != nil {
+			return nil, fmt.Errorf("error deriving Taproot "+
+				"output key: %w", err)
+		}
+
+		taprootOutputKey = schnorr.SerializePubKey(outputKey)
+	}
+
+	siblingBytes, _, err := commitment.MaybeEncodeTapscriptPreimage(
+		addr.TapscriptSibling,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding tapscript sibling: %w",
+			err)
+	}
+
+	id := addr.AssetID
+	rpcAddr := &taprpc.Addr{
+		Encoded:          addrStr,
+		AssetId:          id[:],
+		Amount:           addr.Amount,
+		ScriptKey:        addr.ScriptKey.SerializeCompressed(),
+		InternalKey:      addr.InternalKey.SerializeCompressed(),
+		TapscriptSibling: siblingBytes,
+		TaprootOutputKey: taprootOutputKey,
+		AssetType:        taprpc.AssetType(addr.AssetType()),
+	}
+
+	if addr.GroupKey != nil {
+		rpcAddr.GroupKey = addr.GroupKey.SerializeCompressed()
+	}
+
+	return rpcAddr, nil
+}
+
+// marshalAddrEvent turns an address event into its RPC counterpart.
+func marshalAddrEvent(event *address.Event,
+	db address.Storage) (*taprpc.AddrEvent, error) {
+
+	rpcAddr, err := marshalAddr(event.Addr.Tap, db)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling addr: %w", err)
+	}
+
+	rpcStatus, err := marshalAddrEventStatus(event.Status)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling status: %w", err)
+	}
+
+	depositStatus, err := marshalAddrDepositStatus(event.DepositStatus)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling deposit status: %w",
+			err)
+	}
+
+	return &taprpc.AddrEvent{
+		CreationTimeUnixSeconds: uint64(event.CreationTime.Unix()),
+		Addr:                    rpcAddr,
+		Status:                  rpcStatus,
+		Outpoint:                event.Outpoint.String(),
+		UtxoAmtSat:              uint64(event.Amt),
+		ConfirmationHeight:      event.ConfirmationHeight,
+		HasProof:                event.HasProof,
+		DepositStatus:           depositStatus,
+	}, nil
+}
+
+// unmarshalAddrEventStatus parses the RPC address event status into the native
+// counterpart.
+func unmarshalAddrEventStatus(
+	rpcStatus taprpc.AddrEventStatus) (address.Status, error) {
+
+	switch rpcStatus {
+	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_DETECTED:
+		return address.StatusTransactionDetected, nil
+
+	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED:
+		return address.StatusTransactionConfirmed, nil
+
+	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED:
+		return address.StatusProofReceived, nil
+
+	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED:
+		return address.StatusCompleted, nil
+
+	default:
+		return 0, fmt.Errorf("unknown address event status <%d>",
+			rpcStatus)
+	}
+}
+
+// marshalAddrEventStatus turns the address event status into the RPC
+// counterpart.
+func marshalAddrEventStatus(status address.Status) (taprpc.AddrEventStatus,
+	error) {
+
+	switch status {
+	case address.StatusTransactionDetected:
+		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_DETECTED,
+			nil
+
+	case address.StatusTransactionConfirmed:
+		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED,
+			nil
+
+	case address.StatusProofReceived:
+		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED,
+			nil
+
+	case address.StatusCompleted:
+		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED, nil
+
+	default:
+		return 0, fmt.Errorf("unknown address event status <%d>",
+			status)
+	}
+}
+
+// ExportReceipt creates a receipt for a completed inbound transfer that is
+// signed by the identity key of the backing lnd node.
+func (r *rpcServer) ExportReceipt(ctx context.Context,
+	in *taprpc.ExportReceiptRequest) (*taprpc.TransferReceipt, error) {
+
+	if in.Addr == "" || in.Outpoint == "" {
+		return nil, fmt.Errorf("address and outpoint must be specified")
+	}
+
+	outpoint, err := UnmarshalOutpoint(in.Outpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid outpoint: %w", err)
+	}
+
+	taprootOutputKey, err := r.addrTaprootOutputKey(ctx, in.Addr)
+	if err != nil {
+		return nil, err
+	}
+
+	completed := address.StatusCompleted
+	events, err := r.cfg.AddrBook.QueryEvents(
+		ctx, address.EventQueryParams{
+			AddrTaprootOutputKey: schnorr.SerializePubKey(
+				taprootOutputKey,
+			),
+			StatusFrom: &completed,
+			StatusTo:   &completed,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error querying events: %w", err)
+	}
+
+	var event *address.Event
+	for idx := range events {
+		if events[idx].Outpoint == *outpoint {
+			event = events[idx]
+			break
+		}
+	}
+	if event == nil {
+		return nil, fmt.Errorf("no completed inbound transfer to addr "+
+			"with outpoint %v", outpoint)
+	}
+
+	proofBlob, err := r.cfg.ProofArchive.FetchProof(ctx, proof.Locator{
+		AssetID:   &event.Addr.AssetID,
+		ScriptKey: event.Addr.ScriptKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch proof: %w", err)
+	}
+
+	proofFile := proof.NewEmptyFile(proof.V0)
+	err = proofFile.Decode(bytes.NewReader(proofBlob))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode proof: %w", err)
+	}
+
+	lastProof, err := proofFile.LastProof()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch last proof: %w", err)
+	}
+
+	nodeKey, err := btcec.ParsePubKey(r.cfg.Lnd.NodePubkey[:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid node key: %w", err)
+	}
+
+	receipt, err := tapgarden.NewReceipt(event, lastProof, nodeKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create receipt: %w", err)
+	}
+
+	err = tapgarden.SignReceipt(ctx, r.cfg.Lnd.Signer, receipt)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalReceipt(receipt)
+}
+
+// marshalReceipt turns a signed receipt into its RPC counterpart.
+func marshalReceipt(receipt *tapgarden.Receipt) (*taprpc.TransferReceipt,
+	error) {
+
+	var b bytes.Buffer
+	if err := receipt.Encode(&b); err != nil {
+		return nil, fmt.Errorf("unable to encode receipt: %w", err)
+	}
+
+	return &taprpc.TransferReceipt{
+		Receipt:    b.Bytes(),
+		AssetId:    receipt.AssetID[:],
+		Amount:     receipt.Amount,
+		AnchorTxid: receipt.AnchorTxid.String(),
+		ScriptKey:  receipt.ScriptKey.SerializeCompressed(),
+		BlockHash:  receipt.BlockHash.String(),
+		NodeKey:    receipt.NodeKey.SerializeCompressed(),
+	}, nil
+}
+
+// SetCounterparty adds a script key to the counterparty book under the given
+// name, or renames it if it is already known.
+func (r *rpcServer) SetCounterparty(ctx context.Context,
+	in *taprpc.SetCounterpartyRequest) (*taprpc.SetCounterpartyResponse,
+	error) {
+
+	counterparty := &address.Counterparty{
+		Name: strings.TrimSpace(in.Name),
+	}
+	switch {
+	case len(in.GetScriptKey()) > 0:
+		scriptKey, err := btcec.ParsePubKey(in.GetScriptKey())
+		if err != nil {
+			return nil, fmt.Errorf("invalid script key: %w", err)
+		}
+		counterparty.ScriptKey = scriptKey
+
+	case in.GetTapAddr() != "":
+		tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+		addr, err := address.DecodeAddress(in.GetTapAddr(), &tapParams)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode addr: %w", err)
+		}
+		counterparty.ScriptKey = &addr.ScriptKey
+		counterparty.TapAddr = in.GetTapAddr()
+
+	default:
+		return nil, fmt.Errorf("either script key or addr must be " +
+			"specified")
+	}
+
+	err := r.cfg.CounterpartyBook.SetCounterparty(ctx, counterparty)
+	if err != nil {
+		return nil, fmt.Errorf("unable to set counterparty: %w", err)
+	}
+
+	return &taprpc.SetCounterpartyResponse{
+		Counterparty: marshalCounterparty(counterparty),
+	}, nil
+}
+
+// RemoveCounterparty removes all script keys of a counterparty from the
+// counterparty book.
+func (r *rpcServer) RemoveCounterparty(ctx context.Context,
+	in *taprpc.RemoveCounterpartyRequest) (
+	*taprpc.RemoveCounterpartyResponse, error) {
+
+	err := r.cfg.CounterpartyBook.DeleteCounterparty(
+		ctx, strings.TrimSpace(in.Name),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to remove counterparty: %w",
+			err)
+	}
+
+	return &taprpc.RemoveCounterpartyResponse{}, nil
+}
+
+// ListCounterparties lists all script keys of the counterparty book.
+func (r *rpcServer) ListCounterparties(ctx context.Context,
+	_ *taprpc.ListCounterpartiesRequest) (
+	*taprpc.ListCounterpartiesResponse, error) {
+
+	counterparties, err := r.cfg.CounterpartyBook.ListCounterparties(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list counterparties: %w",
+			err)
+	}
+
+	return &taprpc.ListCounterpartiesResponse{
+		Counterparties: fn.Map(counterparties, marshalCounterparty),
+	}, nil
+}
+
+// ImportScriptKey imports an externally derived script key together with its
+// internal key and tweak, so assets sent to it are recognized as our own.
+func (r *rpcServer) ImportScriptKey(ctx context.Context,
+	in *taprpc.ImportScriptKeyRequest) (*taprpc.ImportScriptKeyResponse,
+	error) {
+
+	if in.ScriptKey == nil {
+		return nil, fmt.Errorf("script key must be specified")
+	}
+
+	scriptKey, err := UnmarshalScriptKey(in.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode script key: %w", err)
+	}
+	if scriptKey.TweakedScriptKey == nil {
+		return nil, fmt.Errorf("key descriptor of the internal key " +
+			"must be specified")
+	}
+
+	err = r.cfg.ImportedKeys.ImportScriptKey(
+		ctx, &address.ImportedScriptKey{
+			ScriptKey: *scriptKey,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import script key: %w", err)
+	}
+
+	// We fetch the key back, as a key that was imported before keeps its
+	// original import time.
+	imported, err := r.cfg.ImportedKeys.FetchImportedScriptKey(
+		ctx, scriptKey.PubKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch imported script key: "+
+			"%w", err)
+	}
+
+	return &taprpc.ImportScriptKeyResponse{
+		ImportedKey: marshalImportedScriptKey(imported),
+	}, nil
+}
+
+// ListImportedScriptKeys lists all imported script keys.
+func (r *rpcServer) ListImportedScriptKeys(ctx context.Context,
+	_ *taprpc.ListImportedScriptKeysRequest) (
+	*taprpc.ListImportedScriptKeysResponse, error) {
+
+	keys, err := r.cfg.ImportedKeys.ListImportedScriptKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list imported script keys: "+
+			"%w", err)
+	}
+
+	return &taprpc.ListImportedScriptKeysResponse{
+		ImportedKeys: fn.Map(keys, marshalImportedScriptKey),
+	}, nil
+}
+
+// marshalImportedScriptKey turns an imported script key into its RPC
+// counterpart.
+func marshalImportedScriptKey(
+	key *address.ImportedScriptKey) *taprpc.ImportedScriptKey {
+
+	return &taprpc.ImportedScriptKey{
+		ScriptKey:  marshalScriptKey(key.ScriptKey),
+		ImportedAt: key.ImportedAt.Unix(),
+	}
+}
+
+// CreateInvoice creates a new address for the requested asset and amount and
+// returns an invoice for it that is signed by the identity key of the backing
+// lnd node.
+func (r *rpcServer) CreateInvoice(ctx context.Context,
+	in *taprpc.CreateInvoiceRequest) (*taprpc.LocalInvoice, error) {
+
+	if len(in.AssetId) != 32 {
+		return nil, fmt.Errorf("invalid asset id length")
+	}
+	if in.ExpirySeconds < 0 {
+		return nil, fmt.Errorf("invalid invoice expiry: %d",
+			in.ExpirySeconds)
+	}
+
+	var assetID asset.ID
+	copy(assetID[:], in.AssetId)
+
+	err := r.checkBalanceOverflow(ctx, &assetID, nil, in.Amt)
+	if err != nil {
+		return nil, err
+	}
+
+	expiry := time.Duration(in.ExpirySeconds) * time.Second
+	if expiry == 0 {
+		expiry = tapgarden.DefaultInvoiceExpiry
+	}
+
+	nodeKey, err := btcec.ParsePubKey(r.cfg.Lnd.NodePubkey[:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid node key: %w", err)
+	}
+
+	// We annotate the address with the expectation of the invoice, so
+	// late payments are flagged on the inbound transfer.
+	now := time.Now()
+	addr, err := r.cfg.AddrBook.NewAddress(
+		ctx, assetID, in.Amt, nil, address.WithDepositExpectation(
+			address.DepositExpectation{
+				Amount: in.Amt,
+				Expiry: now.Add(expiry),
+			},
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to make new addr: %w", err)
+	}
+
+	invoice, err := tapgarden.NewInvoice(
+		addr.Tap, in.Memo, expiry, nodeKey, now,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create invoice: %w", err)
+	}
+
+	err = tapgarden.SignInvoice(ctx, r.cfg.Lnd.Signer, invoice)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.cfg.Invoices.AddInvoice(ctx, invoice, &addr.TaprootOutputKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store invoice: %w", err)
+	}
+
+	rpcsLog.Infof("[CreateInvoice]: created invoice %v for asset_id=%v, "+
+		"amt=%d", invoice.ID, assetID, in.Amt)
+
+	return r.marshalLocalInvoice(&tapgarden.StoredInvoice{
+		Invoice: invoice,
+	})
+}
+
+// DecodeInvoice decodes an invoice and verifies its signature and address.
+func (r *rpcServer) DecodeInvoice(_ context.Context,
+	in *taprpc.DecodeInvoiceRequest) (*taprpc.Invoice, error) {
+
+	invoice, err := tapgarden.DecodeInvoiceString(in.Invoice)
+	if err != nil {
+		return nil, err
+	}
+
+	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	if err := tapgarden.VerifyInvoice(invoice, &tapParams); err != nil {
+		return nil, fmt.Errorf("invalid invoice: %w", err)
+	}
+
+	return r.marshalInvoice(invoice)
+}
+
+// LookupInvoice returns an invoice created by this node, together with its
+// settlement state.
+func (r *rpcServer) LookupInvoice(ctx context.Context,
+	in *taprpc.LookupInvoiceRequest) (*taprpc.LocalInvoice, error) {
+
+	var id tapgarden.InvoiceID
+	if len(in.InvoiceId) != len(id) {
+		return nil, fmt.Errorf("invalid invoice id length")
+	}
+	copy(id[:], in.InvoiceId)
+
+	invoice, err := r.cfg.Invoices.FetchInvoice(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch invoice: %w", err)
+	}
+
+	return r.marshalLocalInvoice(invoice)
+}
+
+// ListInvoices lists all invoices created by this node, together with their
+// settlement state.
+func (r *rpcServer) ListInvoices(ctx context.Context,
+	_ *taprpc.ListInvoicesRequest) (*taprpc.ListInvoicesResponse, error) {
+
+	invoices, err := r.cfg.Invoices.ListInvoices(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list invoices: %w", err)
+	}
+
+	rpcInvoices, err := fn.MapErr(invoices, r.marshalLocalInvoice)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taprpc.ListInvoicesResponse{
+		Invoices: rpcInvoices,
+	}, nil
+}
+
+// marshalInvoice turns a signed invoice into its RPC counterpart.
+func (r *rpcServer) marshalInvoice(
+	invoice *tapgarden.Invoice) (*taprpc.Invoice, error) {
+
+	encoded, err := invoice.EncodeString()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode invoice: %w", err)
+	}
+
+	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	addr, err := invoice.Address(&tapParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taprpc.Invoice{
+		Invoice:   encoded,
+		InvoiceId: invoice.ID[:],
+		Addr:      invoice.Addr,
+		AssetId:   addr.AssetID[:],
+		Amount:    invoice.Amount,
+		CreatedAt: invoice.CreatedAt.Unix(),
+		ExpiresAt: invoice.ExpiresAt().Unix(),
+		Memo:      invoice.Memo,
+		NodeKey:   invoice.NodeKey.SerializeCompressed(),
+	}, nil
+}
+
+// marshalLocalInvoice turns a stored invoice into its RPC counterpart.
+func (r *rpcServer) marshalLocalInvoice(
+	invoice *tapgarden.StoredInvoice) (*taprpc.LocalInvoice, error) {
+
+	rpcInvoice, err := r.marshalInvoice(invoice.Invoice)
+	if err != nil {
+		return nil, err
+	}
+
+	localInvoice := &taprpc.LocalInvoice{
+		Invoice: rpcInvoice,
+	}
+	switch invoice.State(time.Now()) {
+	case tapgarden.InvoiceStateOpen:
+		localInvoice.State = taprpc.InvoiceState_INVOICE_STATE_OPEN
+
+	case tapgarden.InvoiceStateSettled:
+		localInvoice.State = taprpc.InvoiceState_INVOICE_STATE_SETTLED
+		localInvoice.SettledAt = invoice.SettledAt.Unix()
+		localInvoice.SettleOutpoint = invoice.SettleOutpoint.String()
+
+	case tapgarden.InvoiceStateExpired:
+		localInvoice.State = taprpc.InvoiceState_INVOICE_STATE_EXPIRED
+	}
+
+	return localInvoice, nil
+}
+
+// marshalCounterparty turns a counterparty into its RPC counterpart.
+func marshalCounterparty(
+	counterparty *address.Counterparty) *taprpc.Counterparty {
+
+	return &taprpc.Counterparty{
+		Name:      counterparty.Name,
+		ScriptKey: counterparty.ScriptKey.SerializeCompressed(),
+		TapAddr:   counterparty.TapAddr,
+	}
+}
+
+// marshalAddrDepositStatus turns the address deposit status into the RPC
+// counterpart.
+func marshalAddrDepositStatus(
+	status address.DepositStatus) (taprpc.AddrDepositStatus, error) {
+
+	switch status {
+	case address.DepositUnannotated:
+		return taprpc.AddrDepositStatus_ADDR_DEPOSIT_STATUS_UNANNOTATED,
+			nil
+
+	case address.DepositExpected:
+		return taprpc.AddrDepositStatus_ADDR_DEPOSIT_STATUS_EXPECTED, nil
+
+	case address.DepositLate:
+		return taprpc.AddrDepositStatus_ADDR_DEPOSIT_STATUS_LATE, nil
+
+	default:
+		return 0, fmt.Errorf("unknown address deposit status <%d>",
+			status)
+	}
+}
+
+// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt to
+// complete an asset send. The method returns information w.r.t the on chain
+// send, as well as the proof file information the receiver needs to fully
+// receive the asset.
+func (r *rpcServer) SendAsset(ctx context.Context,
+	in *taprpc.SendAssetRequest) (*taprpc.SendAssetResponse, error) {
+
+	if len(in.TapAddrs) == 0 {
+		return nil, fmt.Errorf("at least one addr is required")
+	}
+
+	var (
+		tapParams = address.ParamsForChain(r.cfg.ChainParams.Name)
+		tapAddrs  = make([]*address.Tap, len(in.TapAddrs))
+		err       error
+	)
+	for idx := range in.TapAddrs {
+		if len(in.TapAddrs[idx]) == 0 {
+			return nil, fmt.Errorf("addr %d must be specified", idx)
+		}
+
+		tapAddrs[idx], err = address.DecodeAddress(
+			in.TapAddrs[idx], &tapParams,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling event: %w",
+			return nil, err
+		}
+
+		// Ensure all addrs are of the same asset ID. Within a single
+		// transfer (=a single virtual packet), we expect only to have
+		// inputs and outputs of the same asset ID. Multiple assets can
+		// be moved in a single BTC level anchor output, but the
+		// expectation is that they would be in separate virtual
+		// packets, one for each asset ID. They would then be merged
+		// into the same anchor output in the wallet's
+		// AnchorVirtualTransactions call.
+		//
+		// TODO(guggero): Support creating multiple virtual packets, one
+		// for each asset ID when the user wants to send multiple asset
+		// IDs at the same time without going through the PSBT flow.
+		//
+		// TODO(guggero): Revisit after we have a way to send fungible
+		// assets with different IDs to an address (non-interactive).
+		if idx > 0 {
+			if tapAddrs[idx].AssetID != tapAddrs[0].AssetID {
+				return nil, fmt.Errorf("all addrs must be of "+
+					"the same asset ID %v",
+					tapAddrs[0].AssetID)
+			}
+		}
+	}
+
+	priority, err := unmarshalParcelPriority(in.Priority)
+	if err != nil {
+		return nil, err
+	}
+
+	strategy, err := unmarshalCoinSelectStrategy(in.CoinSelectStrategy)
+	if err != nil {
+		return nil, err
+	}
+	partialSendMode, err := unmarshalPartialSendMode(in.PartialSendMode)
+	if err != nil {
+		return nil, err
+	}
+	spendLots := make([]asset.PrevID, len(in.SpendLots))
+	for idx := range in.SpendLots {
+		spendLots[idx], err = unmarshalAssetLotID(in.SpendLots[idx])
+		if err != nil {
+			return nil, fmt.Errorf("invalid spend lot %d: %w", idx,
 				err)
 		}
 	}
 
-	return resp, nil
-}
-
-// FundVirtualPsbt selects inputs from the available asset commitments to fund
-// a virtual transaction matching the template.
-func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
-	in *wrpc.FundVirtualPsbtRequest) (*wrpc.FundVirtualPsbtResponse,
-	error) {
+	addrParcel := tapfreighter.NewAddressParcel(tapAddrs...)
+	addrParcel.SetPriority(priority)
+	addrParcel.SetPartialSendMode(partialSendMode)
+	if strategy != nil {
+		addrParcel.SetSelectStrategy(*strategy)
+	}
+	if len(spendLots) > 0 {
+		addrParcel.SetLots(spendLots...)
+	}
+	addrParcel.SetAnchorFundingConstraints(
+		tapfreighter.AnchorFundingConstraints{
+			MinConfs:  in.AnchorMinConfs,
+			MaxInputs: in.AnchorMaxInputs,
+		},
+	)
+	if in.ConfDeadlineUnixSeconds != 0 {
+		deadline := time.Unix(in.ConfDeadlineUnixSeconds, 0)
+		if !deadline.After(time.Now()) {
+			return nil, fmt.Errorf("confirmation deadline must be " +
+				"in the future")
+		}
 
-	var fundedVPkt *tapfreighter.FundedVPacket
-	switch {
-	case in.GetPsbt() != nil:
-		vPkt, err := tappsbt.NewFromRawBytes(
-			bytes.NewReader(in.GetPsbt()), false,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to decode psbt: %w", err)
+		maxFeeRate := chainfee.SatPerKVByte(
+			in.MaxFeeRateSatPerVbyte * 1000,
+		).FeePerKWeight()
+		addrParcel.SetConfDeadline(deadline, tapfreighter.FeeEscalation{
+			Interval: time.Duration(
+				in.FeeBumpIntervalSeconds,
+			) * time.Second,
+			IncreasePercent: in.FeeBumpPercent,
+			MaxFeeRate:      maxFeeRate,
+		})
+	}
+	if in.BroadcastAfterHeight != 0 || in.BroadcastAfterUnixSeconds != 0 {
+		trigger := tapfreighter.BroadcastTrigger{
+			Height: in.BroadcastAfterHeight,
+		}
+		if in.BroadcastAfterUnixSeconds != 0 {
+			trigger.Time = time.Unix(
+				in.BroadcastAfterUnixSeconds, 0,
+			)
 		}
+		addrParcel.SetBroadcastTrigger(trigger)
+	}
+	if in.AnchorLockTime != nil {
+		var lockTime tapfreighter.AnchorLockTime
+		if in.AnchorLockTime.OverrideLockTime {
+			lockTime.LockTime = &in.AnchorLockTime.LockTime
+		}
+		if in.AnchorLockTime.OverrideSequence {
+			lockTime.Sequence = &in.AnchorLockTime.Sequence
+		}
+		addrParcel.SetAnchorLockTime(lockTime)
+	}
 
-		// Extract the recipient information from the packet. This
-		// basically assembles the asset ID we want to send to and the
-		// sum of all output amounts.
-		desc, err := tapscript.DescribeRecipients(
-			ctx, vPkt, r.cfg.TapAddrBook,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to describe packet "+
-				"recipients: %w", err)
+	sendAmounts := func() map[asset.ID]uint64 {
+		amounts := make(map[asset.ID]uint64)
+		for _, tapAddr := range tapAddrs {
+			amounts[tapAddr.AssetID] += tapAddr.Amount
 		}
 
-		fundedVPkt, err = r.cfg.AssetWallet.FundPacket(
-			ctx, desc, vPkt,
+		return amounts
+	}
+	resp, err := r.requestLimitedShipment(ctx, addrParcel, sendAmounts)
+
+	// A proposed partial send isn't a failure, the caller decides whether
+	// to send to the fulfilled addresses only.
+	var proposal *tapfreighter.PartialSendProposal
+	if errors.As(err, &proposal) {
+		return &taprpc.SendAssetResponse{
+			PartialSend: marshalPartialSend(
+				in.TapAddrs, proposal.PartialSend, true,
+			),
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	parcel, err := marshalOutboundParcel(resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+	r.annotateTransfer(ctx, parcel)
+
+	sendResp := &taprpc.SendAssetResponse{
+		Transfer: parcel,
+	}
+	if resp.PartialSend != nil {
+		sendResp.PartialSend = marshalPartialSend(
+			in.TapAddrs, resp.PartialSend, false,
 		)
-		if err != nil {
-			return nil, fmt.Errorf("error funding packet: %w", err)
-		}
+	}
 
-	case in.GetRaw() != nil:
-		raw := in.GetRaw()
-		if len(raw.Inputs) > 0 {
-			return nil, fmt.Errorf("template inputs not yet " +
-				"supported")
-		}
-		if len(raw.Recipients) > 1 {
-			return nil, fmt.Errorf("only one recipient supported")
-		}
+	return sendResp, nil
+}
 
-		var (
-			tapParams = address.ParamsForChain(
-				r.cfg.ChainParams.Name,
+// reserveSend enforces the send restrictions of the macaroon of the given
+// request context on a send of the amounts returned by the given function,
+// which is only called if sends are restricted. The amounts are counted
+// against the daily limit of the macaroon until the returned reservation is
+// released, which must happen if the send fails. The returned reservation is
+// nil if the send isn't limited.
+func (r *rpcServer) reserveSend(ctx context.Context,
+	sendAmounts func() map[asset.ID]uint64) (*sendReservation, error) {
+
+	restrictions, err := sendRestrictionsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if restrictions == nil {
+		return nil, nil
+	}
+
+	amounts := sendAmounts()
+	for assetID, amount := range amounts {
+		err := restrictions.checkSend(assetID, amount)
+		if err != nil {
+			return nil, status.Error(
+				codes.PermissionDenied, err.Error(),
 			)
-			addr *address.Tap
-			err  error
-		)
-		for a := range raw.Recipients {
-			addr, err = address.DecodeAddress(a, &tapParams)
-			if err != nil {
-				return nil, fmt.Errorf("unable to decode "+
-					"addr: %w", err)
-			}
 		}
+	}
 
-		if addr == nil {
-			return nil, fmt.Errorf("no recipients specified")
+	if restrictions.dailyLimit == 0 {
+		return nil, nil
+	}
+
+	sendLimits := r.cfg.SendLimits
+	reservationID, err := sendLimits.ReserveSend(
+		ctx, restrictions.macaroonID, amounts, restrictions.dailyLimit,
+	)
+	switch {
+	case errors.Is(err, tapdb.ErrDailySendLimit):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to reserve send: %w", err)
+	}
+
+	return &sendReservation{
+		limits: sendLimits,
+		id:     reservationID,
+	}, nil
+}
+
+// requestLimitedShipment requests the shipment of the given parcel after
+// enforcing the send restrictions of the macaroon of the given request context
+// on it, see reserveSend. If the shipment fails, the send no longer counts
+// against the daily limit of the macaroon. Otherwise, it is linked to the
+// anchor transaction of the transfer, so it stops counting against the limit
+// if the transfer is canceled.
+func (r *rpcServer) requestLimitedShipment(ctx context.Context,
+	parcel tapfreighter.Parcel,
+	sendAmounts func() map[asset.ID]uint64) (*tapfreighter.OutboundParcel,
+	error) {
+
+	reservation, err := r.reserveSend(ctx, sendAmounts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(parcel)
+	if err != nil {
+		reservation.release()
+		return nil, err
+	}
+	reservation.linkTransfer(resp.AnchorTx.TxHash())
+
+	return resp, nil
+}
+
+// vPacketSendAmounts returns the amount of each asset the given virtual packet
+// sends to script keys that aren't controlled by this node. The assets of all
+// inputs are included, so they're checked against the assets a macaroon is
+// allowed to send, even if they're only sent back to us.
+func (r *rpcServer) vPacketSendAmounts(ctx context.Context,
+	vPkt *tappsbt.VPacket) map[asset.ID]uint64 {
+
+	amounts := make(map[asset.ID]uint64)
+	for _, vIn := range vPkt.Inputs {
+		amounts[vIn.Asset().ID()] = 0
+	}
+
+	for _, vOut := range vPkt.Outputs {
+		// The script key of the PSBT output only carries the key
+		// derivation info, the asset itself is authoritative.
+		if vOut.Asset == nil {
+			continue
 		}
 
-		fundedVPkt, err = r.cfg.AssetWallet.FundAddressSend(ctx, addr)
-		if err != nil {
-			return nil, fmt.Errorf("error funding address send: "+
-				"%w", err)
+		outputKey := vOut.Asset.ScriptKey.PubKey
+		if tapfreighter.IsLocalScriptKey(
+			ctx, r.cfg.KeyRing, vOut.ScriptKey, outputKey,
+		) {
+
+			continue
 		}
 
+		amounts[vOut.Asset.ID()] += vOut.Asset.Amount
+	}
+
+	return amounts
+}
+
+// unmarshalPartialSendMode turns the RPC partial send mode into the native
+// counterpart.
+func unmarshalPartialSendMode(
+	rpcMode taprpc.PartialSendMode) (tapfreighter.PartialSendMode, error) {
+
+	switch rpcMode {
+	case taprpc.PartialSendMode_PARTIAL_SEND_DISABLED:
+		return tapfreighter.PartialSendDisabled, nil
+
+	case taprpc.PartialSendMode_PARTIAL_SEND_PROPOSE:
+		return tapfreighter.PartialSendPropose, nil
+
+	case taprpc.PartialSendMode_PARTIAL_SEND_EXECUTE:
+		return tapfreighter.PartialSendExecute, nil
+
 	default:
-		return nil, fmt.Errorf("either PSBT or raw template must be " +
-			"specified")
+		return 0, fmt.Errorf("unknown partial send mode <%d>", rpcMode)
 	}
+}
 
-	var b bytes.Buffer
-	if err := fundedVPkt.VPacket.Serialize(&b); err != nil {
-		return nil, fmt.Errorf("error serializing packet: %w", err)
+// marshalPartialSend turns a partial send into the RPC counterpart. The
+// fulfilled addresses are always the first ones of the request, so the
+// encoded addresses of the request are reused.
+func marshalPartialSend(encodedAddrs []string,
+	partialSend *tapfreighter.PartialSend,
+	proposed bool) *taprpc.PartialSend {
+
+	numFulfilled := len(partialSend.Fulfilled)
+
+	return &taprpc.PartialSend{
+		FulfilledTapAddrs:   encodedAddrs[:numFulfilled],
+		UnfulfilledTapAddrs: encodedAddrs[numFulfilled:],
+		FulfilledAmount:     partialSend.FulfilledAmount(),
+		ShortfallAmount:     partialSend.Shortfall(),
+		Proposed:            proposed,
 	}
+}
 
-	return &wrpc.FundVirtualPsbtResponse{
-		FundedPsbt:        b.Bytes(),
-		ChangeOutputIndex: 0,
+// ListScheduledTransfers lists the transfers whose anchor transaction is held
+// back until their broadcast trigger is reached.
+func (r *rpcServer) ListScheduledTransfers(_ context.Context,
+	_ *taprpc.ListScheduledTransfersRequest) (
+	*taprpc.ListScheduledTransfersResponse, error) {
+
+	scheduled := r.cfg.ChainPorter.ScheduledParcels()
+
+	rpcTransfers := make([]*taprpc.ScheduledTransfer, len(scheduled))
+	for idx, parcel := range scheduled {
+		rpcTransfers[idx] = &taprpc.ScheduledTransfer{
+			ParcelId:             parcel.ParcelID,
+			AnchorTxid:           parcel.AnchorTxid.String(),
+			BroadcastAfterHeight: parcel.Trigger.Height,
+			ScheduledAt:          parcel.ScheduledAt.Unix(),
+		}
+		if !parcel.Trigger.Time.IsZero() {
+			rpcTransfers[idx].BroadcastAfterUnixSeconds =
+				parcel.Trigger.Time.Unix()
+		}
+	}
+
+	return &taprpc.ListScheduledTransfersResponse{
+		Transfers: rpcTransfers,
 	}, nil
 }
 
-// SignVirtualPsbt signs the inputs of a virtual transaction and prepares the
-// commitments of the inputs and outputs.
-func (r *rpcServer) SignVirtualPsbt(_ context.Context,
-	in *wrpc.SignVirtualPsbtRequest) (*wrpc.SignVirtualPsbtResponse,
-	error) {
+// CancelScheduledTransfer cancels a scheduled transfer before its anchor
+// transaction is broadcast.
+func (r *rpcServer) CancelScheduledTransfer(ctx context.Context,
+	in *taprpc.CancelScheduledTransferRequest) (
+	*taprpc.CancelScheduledTransferResponse, error) {
 
-	vPkt, err := tappsbt.NewFromRawBytes(
-		bytes.NewReader(in.FundedPsbt), false,
-	)
+	anchorTxid, err := chainhash.NewHashFromStr(in.AnchorTxid)
 	if err != nil {
-		return nil, fmt.Errorf("error decoding packet: %w", err)
+		return nil, fmt.Errorf("invalid anchor txid: %w", err)
 	}
 
-	signedInputs, err := r.cfg.AssetWallet.SignVirtualPacket(vPkt)
+	err = r.cfg.ChainPorter.CancelScheduledParcel(*anchorTxid)
 	if err != nil {
-		return nil, fmt.Errorf("error signing packet: %w", err)
+		return nil, fmt.Errorf("unable to cancel scheduled transfer: "+
+			"%w", err)
 	}
 
-	var b bytes.Buffer
-	if err := vPkt.Serialize(&b); err != nil {
-		return nil, fmt.Errorf("error serializing packet: %w", err)
+	// The canceled transfer no longer counts against the daily send
+	// limits of the macaroons it was sent with.
+	released, err := r.cfg.SendLimits.ReleaseTransfer(ctx, *anchorTxid)
+	if err != nil {
+		return nil, fmt.Errorf("unable to release send limits of "+
+			"canceled transfer: %w", err)
+	}
+	if released > 0 {
+		rpcsLog.Debugf("Released %d limited sends of canceled "+
+			"transfer %v", released, anchorTxid)
 	}
 
-	return &wrpc.SignVirtualPsbtResponse{
-		SignedPsbt:   b.Bytes(),
-		SignedInputs: signedInputs,
-	}, nil
+	return &taprpc.CancelScheduledTransferResponse{}, nil
 }
 
-// AnchorVirtualPsbts merges and then commits multiple virtual transactions in
-// a single BTC level anchor transaction.
-//
-// TODO(guggero): Actually implement accepting and merging multiple
-// transactions.
-func (r *rpcServer) AnchorVirtualPsbts(ctx context.Context,
-	in *wrpc.AnchorVirtualPsbtsRequest) (*taprpc.SendAssetResponse,
+// OpenAnchorSession opens a named anchor session. Separate callers can
+// register parcels into the session, which are all sent in a single anchor
+// transaction once the session is sealed.
+func (r *rpcServer) OpenAnchorSession(_ context.Context,
+	in *taprpc.OpenAnchorSessionRequest) (*taprpc.OpenAnchorSessionResponse,
 	error) {
 
-	if len(in.VirtualPsbts) > 1 {
-		return nil, fmt.Errorf("only one virtual PSBT supported")
+	if in.SessionName == "" {
+		return nil, fmt.Errorf("session name must be set")
 	}
 
-	vPacket, err := tappsbt.NewFromRawBytes(
-		bytes.NewReader(in.VirtualPsbts[0]), false,
-	)
+	err := r.cfg.AnchorCoordinator.OpenSession(in.SessionName)
 	if err != nil {
-		return nil, fmt.Errorf("error decoding packet: %w", err)
+		return nil, err
 	}
 
-	if len(vPacket.Inputs) != 1 {
-		return nil, fmt.Errorf("only one input is currently supported")
+	return &taprpc.OpenAnchorSessionResponse{}, nil
+}
+
+// RegisterAnchorSessionParcel registers a parcel that sends to the given
+// addresses in an open anchor session. The call blocks until the session is
+// sealed and returns the caller's view of the shared transfer. If the call is
+// canceled, the parcel stays registered and is sent once the session is sealed.
+func (r *rpcServer) RegisterAnchorSessionParcel(ctx context.Context,
+	in *taprpc.RegisterAnchorSessionParcelRequest) (
+	*taprpc.RegisterAnchorSessionParcelResponse, error) {
+
+	if len(in.TapAddrs) == 0 {
+		return nil, fmt.Errorf("at least one addr is required")
 	}
 
-	inputAsset := vPacket.Inputs[0].Asset()
-	prevID := vPacket.Inputs[0].PrevID
-	inputCommitment, err := r.cfg.AssetStore.FetchCommitment(
-		ctx, inputAsset.ID(), prevID.OutPoint, inputAsset.GroupKey,
-		&inputAsset.ScriptKey, true,
+	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	tapAddrs := make([]*address.Tap, len(in.TapAddrs))
+	for idx := range in.TapAddrs {
+		addr, err := address.DecodeAddress(in.TapAddrs[idx], &tapParams)
+		if err != nil {
+			return nil, err
+		}
+		tapAddrs[idx] = addr
+	}
+
+	sessionParcel, err := r.cfg.AnchorCoordinator.Register(
+		in.SessionName, tapAddrs...,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching input commitment: %w",
+		return nil, err
+	}
+
+	resp, err := sessionParcel.Wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parcel, err := marshalOutboundParcel(resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
 			err)
 	}
+	r.annotateTransfer(ctx, parcel)
 
-	rpcsLog.Debugf("Selected commitment for anchor point %v, requesting "+
-		"delivery", inputCommitment.AnchorPoint)
+	return &taprpc.RegisterAnchorSessionParcelResponse{
+		Transfer: parcel,
+	}, nil
+}
 
-	resp, err := r.cfg.ChainPorter.RequestShipment(
-		tapfreighter.NewPreSignedParcel(
-			vPacket, inputCommitment.Commitment,
-		),
-	)
+// SealAnchorSession closes an anchor session and sends the parcels of all
+// callers in a single anchor transaction.
+func (r *rpcServer) SealAnchorSession(ctx context.Context,
+	in *taprpc.SealAnchorSessionRequest) (*taprpc.SealAnchorSessionResponse,
+	error) {
+
+	priority, err := unmarshalParcelPriority(in.Priority)
 	if err != nil {
-		return nil, fmt.Errorf("error requesting delivery: %w", err)
+		return nil, err
+	}
+
+	resp, err := r.cfg.AnchorCoordinator.Seal(in.SessionName, priority)
+	if err != nil {
+		return nil, err
 	}
 
 	parcel, err := marshalOutboundParcel(resp)
@@ -1603,270 +4492,453 @@ func (r *rpcServer) AnchorVirtualPsbts(ctx context.Context,
 		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
 			err)
 	}
+	r.annotateTransfer(ctx, parcel)
 
-	return &taprpc.SendAssetResponse{
+	return &taprpc.SealAnchorSessionResponse{
 		Transfer: parcel,
 	}, nil
 }
 
-// NextInternalKey derives the next internal key for the given key family and
-// stores it as an internal key in the database to make sure it is identified
-// as a local key later on when importing proofs. While an internal key can
-// also be used as the internal key of a script key, it is recommended to use
-// the NextScriptKey RPC instead, to make sure the tweaked Taproot output key
-// is also recognized as a local key.
-func (r *rpcServer) NextInternalKey(ctx context.Context,
-	req *wrpc.NextInternalKeyRequest) (*wrpc.NextInternalKeyResponse,
-	error) {
+// AbortAnchorSession aborts an open anchor session. None of its parcels are
+// sent and all callers that registered a parcel receive an error.
+func (r *rpcServer) AbortAnchorSession(_ context.Context,
+	in *taprpc.AbortAnchorSessionRequest) (
+	*taprpc.AbortAnchorSessionResponse, error) {
 
-	// Due to how we detect local keys, we need to make sure that the key
-	// family is not zero.
-	if req.KeyFamily == 0 {
-		return nil, fmt.Errorf("key family must be set to a non-zero " +
-			"value")
+	err := r.cfg.AnchorCoordinator.Abort(in.SessionName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taprpc.AbortAnchorSessionResponse{}, nil
+}
+
+// StartAirdrop sends assets to a manifest of many recipients in batched
+// transfers.
+func (r *rpcServer) StartAirdrop(ctx context.Context,
+	in *taprpc.StartAirdropRequest) (*taprpc.StartAirdropResponse, error) {
+
+	entries, err := tapfreighter.ParseAirdropManifest(
+		strings.NewReader(in.ManifestCsv),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the manifest should only be validated, we return the batches
+	// the recipients would be sent in, without storing the airdrop.
+	if in.ValidateOnly {
+		recipients, err := r.cfg.Airdropper.ValidateManifest(entries)
+		if err != nil {
+			return nil, err
+		}
+
+		maxPerTx := in.MaxRecipientsPerTx
+		if maxPerTx == 0 {
+			maxPerTx = tapfreighter.DefaultAirdropMaxRecipientsPerTx
+		}
+		batches := tapfreighter.PlanAirdropBatches(recipients, maxPerTx)
+
+		return &taprpc.StartAirdropResponse{
+			Airdrop: marshalAirdrop(&tapfreighter.Airdrop{
+				Label:              in.Label,
+				MaxRecipientsPerTx: maxPerTx,
+				Recipients:         recipients,
+			}, true),
+			Batches: marshalAirdropBatches(batches),
+		}, nil
 	}
 
-	keyDesc, err := r.cfg.AddrBook.NextInternalKey(ctx, keychain.KeyFamily(
-		req.KeyFamily,
-	))
+	airdrop, batches, err := r.cfg.Airdropper.StartAirdrop(
+		ctx, in.Label, entries, in.MaxRecipientsPerTx,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("error inserting internal key: %w", err)
+		return nil, fmt.Errorf("unable to start airdrop: %w", err)
 	}
 
-	return &wrpc.NextInternalKeyResponse{
-		InternalKey: marshalKeyDescriptor(keyDesc),
+	return &taprpc.StartAirdropResponse{
+		Airdrop: marshalAirdrop(airdrop, true),
+		Batches: marshalAirdropBatches(batches),
 	}, nil
 }
 
-// NextScriptKey derives the next script key (and its corresponding internal
-// key) and stores them both in the database to make sure they are identified
-// as local keys later on when importing proofs.
-func (r *rpcServer) NextScriptKey(ctx context.Context,
-	req *wrpc.NextScriptKeyRequest) (*wrpc.NextScriptKeyResponse,
+// ResumeAirdrop retries the pending and failed recipients of an airdrop.
+func (r *rpcServer) ResumeAirdrop(ctx context.Context,
+	in *taprpc.ResumeAirdropRequest) (*taprpc.ResumeAirdropResponse,
 	error) {
 
-	// Due to how we detect local keys, we need to make sure that the key
-	// family is not zero.
-	if req.KeyFamily == 0 {
-		return nil, fmt.Errorf("key family must be set to a non-zero " +
-			"value")
-	}
-
-	scriptKey, err := r.cfg.AddrBook.NextScriptKey(ctx, keychain.KeyFamily(
-		req.KeyFamily,
-	))
+	airdrop, batches, err := r.cfg.Airdropper.ResumeAirdrop(
+		ctx, in.AirdropId,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("error inserting internal key: %w", err)
+		return nil, fmt.Errorf("unable to resume airdrop: %w", err)
 	}
 
-	return &wrpc.NextScriptKeyResponse{
-		ScriptKey: marshalScriptKey(scriptKey),
+	return &taprpc.ResumeAirdropResponse{
+		Airdrop: marshalAirdrop(airdrop, true),
+		Batches: marshalAirdropBatches(batches),
 	}, nil
 }
 
-// marshalAddr turns an address into its RPC counterpart.
-func marshalAddr(addr *address.Tap,
-	db address.Storage) (*taprpc.Addr, error) {
+// ListAirdrops lists the airdrops and the status of their recipients.
+func (r *rpcServer) ListAirdrops(ctx context.Context,
+	in *taprpc.ListAirdropsRequest) (*taprpc.ListAirdropsResponse, error) {
 
-	addrStr, err := addr.EncodeAddress()
+	var (
+		airdrops []*tapfreighter.Airdrop
+		err      error
+	)
+	if in.AirdropId != 0 {
+		var airdrop *tapfreighter.Airdrop
+		airdrop, err = r.cfg.Airdropper.FetchAirdrop(ctx, in.AirdropId)
+		airdrops = []*tapfreighter.Airdrop{airdrop}
+	} else {
+		airdrops, err = r.cfg.Airdropper.ListAirdrops(ctx)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("unable to encode addr: %w", err)
+		return nil, fmt.Errorf("unable to list airdrops: %w", err)
 	}
 
-	// We can only derive the taproot output if we already know the genesis
-	// for this asset, as that's required to make the template asset that
-	// will be committed to in the tapscript tree.
-	var taprootOutputKey []byte
-	assetGroup, err := db.QueryAssetGroup(
-		context.Background(), addr.AssetID,
-	)
-	if err == nil {
-		addr.AttachGenesis(*assetGroup.Genesis)
+	resp := &taprpc.ListAirdropsResponse{
+		Airdrops: make([]*taprpc.Airdrop, len(airdrops)),
+	}
+	for idx, airdrop := range airdrops {
+		resp.Airdrops[idx] = marshalAirdrop(
+			airdrop, in.IncludeRecipients,
+		)
+	}
 
-		if assetGroup.GroupKey != nil {
-			addr.AttachGroupSig(assetGroup.GroupKey.Sig)
-		}
+	return resp, nil
+}
 
-		outputKey, err := addr.TaprootOutputKey()
-		if err != nil {
-			return nil, fmt.Errorf("error deriving Taproot "+
-				"output key: %w", err)
-		}
+// marshalAirdropRecipientStatus converts an airdrop recipient status into its
+// RPC counterpart.
+func marshalAirdropRecipientStatus(
+	s tapfreighter.AirdropRecipientStatus) taprpc.AirdropRecipientStatus {
 
-		taprootOutputKey = schnorr.SerializePubKey(outputKey)
+	switch s {
+	case tapfreighter.AirdropRecipientInFlight:
+		return taprpc.AirdropRecipientStatus_AIRDROP_IN_FLIGHT
+
+	case tapfreighter.AirdropRecipientSent:
+		return taprpc.AirdropRecipientStatus_AIRDROP_SENT
+
+	case tapfreighter.AirdropRecipientFailed:
+		return taprpc.AirdropRecipientStatus_AIRDROP_FAILED
+
+	default:
+		return taprpc.AirdropRecipientStatus_AIRDROP_PENDING
 	}
+}
 
-	siblingBytes, _, err := commitment.MaybeEncodeTapscriptPreimage(
-		addr.TapscriptSibling,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error encoding tapscript sibling: %w",
-			err)
+// marshalAirdrop converts an airdrop into its RPC counterpart. The recipients
+// are only included if includeRecipients is true.
+func marshalAirdrop(airdrop *tapfreighter.Airdrop,
+	includeRecipients bool) *taprpc.Airdrop {
+
+	rpcAirdrop := &taprpc.Airdrop{
+		Id:                 airdrop.ID,
+		Label:              airdrop.Label,
+		MaxRecipientsPerTx: airdrop.MaxRecipientsPerTx,
+	}
+	if !airdrop.CreatedAt.IsZero() {
+		rpcAirdrop.CreatedAt = airdrop.CreatedAt.Unix()
 	}
 
-	id := addr.AssetID
-	rpcAddr := &taprpc.Addr{
-		Encoded:          addrStr,
-		AssetId:          id[:],
-		Amount:           addr.Amount,
-		ScriptKey:        addr.ScriptKey.SerializeCompressed(),
-		InternalKey:      addr.InternalKey.SerializeCompressed(),
-		TapscriptSibling: siblingBytes,
-		TaprootOutputKey: taprootOutputKey,
-		AssetType:        taprpc.AssetType(addr.AssetType()),
+	for _, recipient := range airdrop.Recipients {
+		switch recipient.Status {
+		case tapfreighter.AirdropRecipientPending:
+			rpcAirdrop.NumPending++
+
+		case tapfreighter.AirdropRecipientInFlight:
+			rpcAirdrop.NumInFlight++
+
+		case tapfreighter.AirdropRecipientSent:
+			rpcAirdrop.NumSent++
+
+		case tapfreighter.AirdropRecipientFailed:
+			rpcAirdrop.NumFailed++
+		}
+
+		if !includeRecipients {
+			continue
+		}
+
+		rpcRecipient := &taprpc.AirdropRecipient{
+			Index:   recipient.Index,
+			TapAddr: recipient.Addr,
+			AssetId: fn.ByteSlice(recipient.AssetID),
+			Amount:  recipient.Amount,
+			Error:   recipient.Err,
+			Status: marshalAirdropRecipientStatus(
+				recipient.Status,
+			),
+		}
+		if recipient.AnchorTxid != nil {
+			rpcRecipient.AnchorTxid = recipient.AnchorTxid.String()
+		}
+		if !recipient.UpdatedAt.IsZero() {
+			rpcRecipient.UpdatedAt = recipient.UpdatedAt.Unix()
+		}
+
+		rpcAirdrop.Recipients = append(
+			rpcAirdrop.Recipients, rpcRecipient,
+		)
 	}
 
-	if addr.GroupKey != nil {
-		rpcAddr.GroupKey = addr.GroupKey.SerializeCompressed()
+	return rpcAirdrop
+}
+
+// marshalAirdropBatches converts the batches of an airdrop into their RPC
+// counterpart.
+func marshalAirdropBatches(
+	batches []*tapfreighter.AirdropBatch) []*taprpc.AirdropBatch {
+
+	rpcBatches := make([]*taprpc.AirdropBatch, len(batches))
+	for idx, batch := range batches {
+		rpcBatches[idx] = &taprpc.AirdropBatch{
+			AssetId: fn.ByteSlice(batch.AssetID),
+			RecipientIndexes: fn.Map(
+				batch.Recipients,
+				func(r *tapfreighter.AirdropRecipient) uint32 {
+					return r.Index
+				},
+			),
+		}
 	}
 
-	return rpcAddr, nil
+	return rpcBatches
 }
 
-// marshalAddrEvent turns an address event into its RPC counterpart.
-func marshalAddrEvent(event *address.Event,
-	db address.Storage) (*taprpc.AddrEvent, error) {
+// CreateTransferTemplate stores a named set of recipients of an asset that
+// can be paid repeatedly with a single call.
+func (r *rpcServer) CreateTransferTemplate(ctx context.Context,
+	in *taprpc.CreateTransferTemplateRequest) (
+	*taprpc.CreateTransferTemplateResponse, error) {
 
-	rpcAddr, err := marshalAddr(event.Addr.Tap, db)
+	if in.Template == nil {
+		return nil, fmt.Errorf("template must be set")
+	}
+
+	tmpl, err := unmarshalTransferTemplate(in.Template)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling addr: %w", err)
+		return nil, err
 	}
 
-	rpcStatus, err := marshalAddrEventStatus(event.Status)
+	if err := r.cfg.Templater.CreateTemplate(ctx, tmpl); err != nil {
+		return nil, err
+	}
+
+	rpcTemplate, err := marshalTransferTemplate(tmpl)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling status: %w", err)
+		return nil, err
 	}
 
-	return &taprpc.AddrEvent{
-		CreationTimeUnixSeconds: uint64(event.CreationTime.Unix()),
-		Addr:                    rpcAddr,
-		Status:                  rpcStatus,
-		Outpoint:                event.Outpoint.String(),
-		UtxoAmtSat:              uint64(event.Amt),
-		ConfirmationHeight:      event.ConfirmationHeight,
-		HasProof:                event.HasProof,
+	return &taprpc.CreateTransferTemplateResponse{
+		Template: rpcTemplate,
 	}, nil
 }
 
-// unmarshalAddrEventStatus parses the RPC address event status into the native
-// counterpart.
-func unmarshalAddrEventStatus(
-	rpcStatus taprpc.AddrEventStatus) (address.Status, error) {
+// ListTransferTemplates lists the stored transfer templates.
+func (r *rpcServer) ListTransferTemplates(ctx context.Context,
+	_ *taprpc.ListTransferTemplatesRequest) (
+	*taprpc.ListTransferTemplatesResponse, error) {
 
-	switch rpcStatus {
-	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_DETECTED:
-		return address.StatusTransactionDetected, nil
+	templates, err := r.cfg.Templater.ListTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED:
-		return address.StatusTransactionConfirmed, nil
+	resp := &taprpc.ListTransferTemplatesResponse{
+		Templates: make([]*taprpc.TransferTemplate, len(templates)),
+	}
+	for idx, tmpl := range templates {
+		resp.Templates[idx], err = marshalTransferTemplate(tmpl)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED:
-		return address.StatusProofReceived, nil
+	return resp, nil
+}
 
-	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED:
-		return address.StatusCompleted, nil
+// DeleteTransferTemplate deletes a transfer template.
+func (r *rpcServer) DeleteTransferTemplate(ctx context.Context,
+	in *taprpc.DeleteTransferTemplateRequest) (
+	*taprpc.DeleteTransferTemplateResponse, error) {
 
-	default:
-		return 0, fmt.Errorf("unknown address event status <%d>",
-			rpcStatus)
+	if in.Name == "" {
+		return nil, fmt.Errorf("template name must be set")
 	}
-}
 
-// marshalAddrEventStatus turns the address event status into the RPC
-// counterpart.
-func marshalAddrEventStatus(status address.Status) (taprpc.AddrEventStatus,
-	error) {
+	err := r.cfg.Templater.DeleteTemplate(ctx, in.Name)
+	if err != nil {
+		return nil, err
+	}
 
-	switch status {
-	case address.StatusTransactionDetected:
-		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_DETECTED,
-			nil
+	return &taprpc.DeleteTransferTemplateResponse{}, nil
+}
 
-	case address.StatusTransactionConfirmed:
-		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED,
-			nil
+// ExecuteTransferTemplate pays all recipients of a transfer template in a
+// single transfer.
+func (r *rpcServer) ExecuteTransferTemplate(ctx context.Context,
+	in *taprpc.ExecuteTransferTemplateRequest) (*taprpc.SendAssetResponse,
+	error) {
 
-	case address.StatusProofReceived:
-		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED,
-			nil
+	if in.Name == "" {
+		return nil, fmt.Errorf("template name must be set")
+	}
 
-	case address.StatusCompleted:
-		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED, nil
+	resp, err := r.cfg.Templater.ExecuteTemplate(
+		ctx, in.Name, in.TotalAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-	default:
-		return 0, fmt.Errorf("unknown address event status <%d>",
-			status)
+	parcel, err := marshalOutboundParcel(resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
 	}
+	r.annotateTransfer(ctx, parcel)
+
+	return &taprpc.SendAssetResponse{
+		Transfer: parcel,
+	}, nil
 }
 
-// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt to
-// complete an asset send. The method returns information w.r.t the on chain
-// send, as well as the proof file information the receiver needs to fully
-// receive the asset.
-func (r *rpcServer) SendAsset(_ context.Context,
-	in *taprpc.SendAssetRequest) (*taprpc.SendAssetResponse, error) {
+// unmarshalTransferTemplate parses a transfer template from its RPC
+// counterpart.
+func unmarshalTransferTemplate(
+	rpcTemplate *taprpc.TransferTemplate) (*tapfreighter.TransferTemplate,
+	error) {
 
-	if len(in.TapAddrs) == 0 {
-		return nil, fmt.Errorf("at least one addr is required")
+	if len(rpcTemplate.AssetId) != sha256.Size {
+		return nil, fmt.Errorf("asset ID must be 32 bytes")
 	}
 
 	var (
-		tapParams = address.ParamsForChain(r.cfg.ChainParams.Name)
-		tapAddrs  = make([]*address.Tap, len(in.TapAddrs))
-		err       error
-	)
-	for idx := range in.TapAddrs {
-		if len(in.TapAddrs[idx]) == 0 {
-			return nil, fmt.Errorf("addr %d must be specified", idx)
+		policy = rpcTemplate.FeePolicy
+		tmpl   = &tapfreighter.TransferTemplate{
+			Name: rpcTemplate.Name,
 		}
+	)
+	copy(tmpl.AssetID[:], rpcTemplate.AssetId)
 
-		tapAddrs[idx], err = address.DecodeAddress(
-			in.TapAddrs[idx], &tapParams,
-		)
+	if policy != nil {
+		priority, err := unmarshalParcelPriority(policy.Priority)
 		if err != nil {
 			return nil, err
 		}
 
-		// Ensure all addrs are of the same asset ID. Within a single
-		// transfer (=a single virtual packet), we expect only to have
-		// inputs and outputs of the same asset ID. Multiple assets can
-		// be moved in a single BTC level anchor output, but the
-		// expectation is that they would be in separate virtual
-		// packets, one for each asset ID. They would then be merged
-		// into the same anchor output in the wallet's
-		// AnchorVirtualTransactions call.
-		//
-		// TODO(guggero): Support creating multiple virtual packets, one
-		// for each asset ID when the user wants to send multiple asset
-		// IDs at the same time without going through the PSBT flow.
-		//
-		// TODO(guggero): Revisit after we have a way to send fungible
-		// assets with different IDs to an address (non-interactive).
-		if idx > 0 {
-			if tapAddrs[idx].AssetID != tapAddrs[0].AssetID {
-				return nil, fmt.Errorf("all addrs must be of "+
-					"the same asset ID %v",
-					tapAddrs[0].AssetID)
+		maxFeeRate := chainfee.SatPerKVByte(
+			policy.MaxFeeRateSatPerVbyte * 1000,
+		).FeePerKWeight()
+		tmpl.FeePolicy = tapfreighter.TemplateFeePolicy{
+			Priority: priority,
+			ConfTarget: time.Duration(
+				policy.ConfTargetSeconds,
+			) * time.Second,
+			Escalation: tapfreighter.FeeEscalation{
+				Interval: time.Duration(
+					policy.FeeBumpIntervalSeconds,
+				) * time.Second,
+				IncreasePercent: policy.FeeBumpPercent,
+				MaxFeeRate:      maxFeeRate,
+			},
+		}
+	}
+
+	for idx, rpcRecipient := range rpcTemplate.Recipients {
+		recipient := &tapfreighter.TemplateRecipient{
+			Index:    uint32(idx),
+			Addr:     rpcRecipient.TapAddr,
+			Amount:   rpcRecipient.Amount,
+			ShareBps: rpcRecipient.ShareBps,
+		}
+
+		var err error
+		if len(rpcRecipient.ScriptKey) > 0 {
+			recipient.ScriptKey, err = btcec.ParsePubKey(
+				rpcRecipient.ScriptKey,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("recipient %d: invalid "+
+					"script key: %w", idx, err)
+			}
+		}
+		if len(rpcRecipient.InternalKey) > 0 {
+			recipient.InternalKey, err = btcec.ParsePubKey(
+				rpcRecipient.InternalKey,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("recipient %d: invalid "+
+					"internal key: %w", idx, err)
 			}
 		}
+
+		tmpl.Recipients = append(tmpl.Recipients, recipient)
 	}
 
-	resp, err := r.cfg.ChainPorter.RequestShipment(
-		tapfreighter.NewAddressParcel(tapAddrs...),
+	return tmpl, nil
+}
+
+// marshalTransferTemplate converts a transfer template into its RPC
+// counterpart.
+func marshalTransferTemplate(
+	tmpl *tapfreighter.TransferTemplate) (*taprpc.TransferTemplate, error) {
+
+	var (
+		policy     = tmpl.FeePolicy
+		escalation = policy.Escalation
+		maxFee     = escalation.MaxFeeRate.FeePerKVByte() / 1000
 	)
+	priority, err := marshalParcelPriority(policy.Priority)
 	if err != nil {
 		return nil, err
 	}
 
-	parcel, err := marshalOutboundParcel(resp)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
-			err)
+	rpcTemplate := &taprpc.TransferTemplate{
+		Name:    tmpl.Name,
+		AssetId: fn.ByteSlice(tmpl.AssetID),
+		FeePolicy: &taprpc.TemplateFeePolicy{
+			Priority: priority,
+			ConfTargetSeconds: uint64(
+				policy.ConfTarget / time.Second,
+			),
+			FeeBumpIntervalSeconds: uint32(
+				escalation.Interval / time.Second,
+			),
+			FeeBumpPercent:        escalation.IncreasePercent,
+			MaxFeeRateSatPerVbyte: uint64(maxFee),
+		},
+	}
+	if !tmpl.CreatedAt.IsZero() {
+		rpcTemplate.CreatedAt = tmpl.CreatedAt.Unix()
+	}
+
+	for _, recipient := range tmpl.Recipients {
+		rpcRecipient := &taprpc.TemplateRecipient{
+			TapAddr:  recipient.Addr,
+			Amount:   recipient.Amount,
+			ShareBps: recipient.ShareBps,
+		}
+		if recipient.ScriptKey != nil {
+			rpcRecipient.ScriptKey =
+				recipient.ScriptKey.SerializeCompressed()
+		}
+		if recipient.InternalKey != nil {
+			rpcRecipient.InternalKey =
+				recipient.InternalKey.SerializeCompressed()
+		}
+
+		rpcTemplate.Recipients = append(
+			rpcTemplate.Recipients, rpcRecipient,
+		)
 	}
 
-	return &taprpc.SendAssetResponse{
-		Transfer: parcel,
-	}, nil
+	return rpcTemplate, nil
 }
 
 // marshalOutboundParcel turns a pending parcel into its RPC counterpart.
@@ -1883,6 +4955,9 @@ func marshalOutboundParcel(
 			ScriptKey:   in.ScriptKey[:],
 			Amount:      in.Amount,
 		}
+		if in.Lot != nil {
+			rpcInputs[idx].Lot = marshalAssetLot(in.Lot)
+		}
 	}
 
 	rpcOutputs := make(
@@ -1925,18 +5000,60 @@ func marshalOutboundParcel(
 			NewProofBlob:        out.ProofSuffix,
 			SplitCommitRootHash: splitCommitRoot,
 			OutputType:          rpcOutType,
+			SettledByReceiver:   out.SettledByReceiver(),
 		}
+		if out.SettledByReceiver() {
+			rpcOutputs[idx].ReceiverSettledTimestamp =
+				out.ReceiverSettledAt.Unix()
+		}
+	}
+
+	changeKeyPolicy, err := marshalChangeKeyPolicy(parcel.ChangeKeyPolicy)
+	if err != nil {
+		return nil, err
 	}
 
 	anchorTxHash := parcel.AnchorTx.TxHash()
-	return &taprpc.AssetTransfer{
+	rpcTransfer := &taprpc.AssetTransfer{
 		TransferTimestamp:  parcel.TransferTime.Unix(),
 		AnchorTxHash:       anchorTxHash[:],
 		AnchorTxHeightHint: parcel.AnchorTxHeightHint,
 		AnchorTxChainFees:  parcel.ChainFees,
 		Inputs:             rpcInputs,
 		Outputs:            rpcOutputs,
-	}, nil
+		SettledByReceiver:  parcel.SettledByReceivers(),
+		ChangeKeyPolicy:    changeKeyPolicy,
+	}
+	if trigger := parcel.BroadcastTrigger; trigger != nil {
+		rpcTransfer.BroadcastAfterHeight = trigger.Height
+		if !trigger.Time.IsZero() {
+			rpcTransfer.BroadcastAfterUnixSeconds =
+				trigger.Time.Unix()
+		}
+	}
+
+	return rpcTransfer, nil
+}
+
+// marshalChangeKeyPolicy turns the change key policy into the RPC
+// counterpart.
+func marshalChangeKeyPolicy(
+	policy tapfreighter.ChangeKeyPolicy) (taprpc.ChangeKeyPolicy, error) {
+
+	switch policy {
+	case tapfreighter.ChangeKeyRotate:
+		return taprpc.ChangeKeyPolicy_CHANGE_KEY_POLICY_ROTATE, nil
+
+	case tapfreighter.ChangeKeyStable:
+		return taprpc.ChangeKeyPolicy_CHANGE_KEY_POLICY_STABLE, nil
+
+	case tapfreighter.ChangeKeySeparateAccount:
+		return taprpc.ChangeKeyPolicy_CHANGE_KEY_POLICY_SEPARATE_ACCOUNT,
+			nil
+
+	default:
+		return 0, fmt.Errorf("unknown change key policy: %v", policy)
+	}
 }
 
 // marshalOutputType turns the transfer output type into the RPC counterpart.
@@ -1986,6 +5103,9 @@ func (r *rpcServer) SubscribeSendAssetEventNtfns(
 		// The event will be mapped to the RPC event type and
 		// sent over the stream.
 		case event := <-eventSubscriber.NewItemCreated.ChanOut():
+			// Events that we didn't receive in time were dropped,
+			// which is flagged on the next event we receive.
+			event, numDropped := fn.UnwrapDroppedHistory(event)
 
 			rpcEvent, err := marshallSendAssetEvent(event)
 			if err != nil {
@@ -1993,6 +5113,7 @@ func (r *rpcServer) SubscribeSendAssetEventNtfns(
 					"ChainPorter event into RPC event: "+
 					"%w", err)
 			}
+			rpcEvent.NumDroppedEvents = numDropped
 
 			err = ntfnStream.Send(rpcEvent)
 			if err != nil {
@@ -2029,8 +5150,10 @@ func marshallSendAssetEvent(
 	case *tapfreighter.ExecuteSendStateEvent:
 		eventRpc := &taprpc.SendAssetEvent_ExecuteSendStateEvent{
 			ExecuteSendStateEvent: &taprpc.ExecuteSendStateEvent{
-				Timestamp: event.Timestamp().UnixMicro(),
-				SendState: event.SendState.String(),
+				Timestamp:   event.Timestamp().UnixMicro(),
+				SendState:   event.SendState.String(),
+				SequenceNum: event.SeqNum,
+				ParcelId:    event.ParcelID,
 			},
 		}
 		return &taprpc.SendAssetEvent{
@@ -2049,6 +5172,66 @@ func marshallSendAssetEvent(
 			Event: &eventRpc,
 		}, nil
 
+	case *proof.ProofDeliveryAttemptEvent:
+		attemptEvent := &taprpc.ProofDeliveryAttemptEvent{
+			Timestamp: event.Timestamp().UnixMicro(),
+			Attempt:   marshalProofDeliveryAttempt(event.Attempt),
+		}
+		eventRpc := taprpc.SendAssetEvent_ProofDeliveryAttemptEvent{
+			ProofDeliveryAttemptEvent: attemptEvent,
+		}
+		return &taprpc.SendAssetEvent{
+			Event: &eventRpc,
+		}, nil
+
+	case *tapfreighter.BackendBreakerEvent:
+		breakerEvent := &taprpc.BackendBreakerEvent{
+			Timestamp: event.Timestamp().UnixMicro(),
+			Tripped:   event.Tripped,
+		}
+		if event.Err != nil {
+			breakerEvent.LastError = event.Err.Error()
+		}
+		eventRpc := taprpc.SendAssetEvent_BackendBreakerEvent{
+			BackendBreakerEvent: breakerEvent,
+		}
+		return &taprpc.SendAssetEvent{
+			Event: &eventRpc,
+		}, nil
+
+	case *tapfreighter.ConfDeadlineExceededEvent:
+		deadlineEvent := &taprpc.ConfDeadlineExceededEvent{
+			Timestamp:           event.Timestamp().UnixMicro(),
+			ParcelId:            event.ParcelID,
+			AnchorTxid:          event.AnchorTxHash.String(),
+			DeadlineUnixSeconds: event.Deadline.Unix(),
+			FeeRateSatPerVbyte: uint64(
+				event.FeeRate.FeePerKVByte() / 1000,
+			),
+		}
+		eventRpc := taprpc.SendAssetEvent_ConfDeadlineExceededEvent{
+			ConfDeadlineExceededEvent: deadlineEvent,
+		}
+		return &taprpc.SendAssetEvent{
+			Event: &eventRpc,
+		}, nil
+
+	case *tapfreighter.TransferCounterpartyEvent:
+		counterpartyEvent := &taprpc.TransferCounterpartyEvent{
+			Timestamp:        event.Timestamp().UnixMicro(),
+			ParcelId:         event.ParcelID,
+			OutputIndex:      uint32(event.OutputIndex),
+			ScriptKey:        event.ScriptKey.SerializeCompressed(),
+			CounterpartyName: event.Name,
+			Known:            event.Known(),
+		}
+		eventRpc := taprpc.SendAssetEvent_TransferCounterpartyEvent{
+			TransferCounterpartyEvent: counterpartyEvent,
+		}
+		return &taprpc.SendAssetEvent{
+			Event: &eventRpc,
+		}, nil
+
 	default:
 		return nil, fmt.Errorf("unknown event type: %T", eventInterface)
 	}
@@ -2326,11 +5509,32 @@ func (r *rpcServer) FetchAssetMeta(ctx context.Context,
 	}
 
 	metaHash := assetMeta.MetaHash()
-	return &taprpc.AssetMeta{
+	rpcMeta := &taprpc.AssetMeta{
 		Data:     assetMeta.Data,
 		Type:     taprpc.AssetMetaType(assetMeta.Type),
 		MetaHash: metaHash[:],
-	}, nil
+	}
+
+	if !in.ResolveUri || assetMeta.Type != proof.MetaURI {
+		return rpcMeta, nil
+	}
+
+	if r.cfg.MetaFetcher == nil {
+		return nil, fmt.Errorf("meta fetching is not configured")
+	}
+
+	content, err := r.cfg.MetaFetcher.Fetch(ctx, assetMeta)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve meta URI: %w", err)
+	}
+
+	rpcMeta.UriContent = &taprpc.URIMetaContent{
+		Uri:         content.URI,
+		ContentType: content.ContentType,
+		Content:     content.Content,
+	}
+
+	return rpcMeta, nil
 }
 
 func marshalUniID(id universe.Identifier) *unirpc.ID {
@@ -3069,6 +6273,200 @@ func (r *rpcServer) DeleteFederationServer(ctx context.Context,
 	return &unirpc.DeleteFederationServerResponse{}, nil
 }
 
+// marshalSyncProgress marshals the checkpoint of a universe sync into the RPC
+// counterpart.
+func marshalSyncProgress(
+	checkpoint *universe.SyncCheckpoint) *unirpc.UniverseSyncProgress {
+
+	return &unirpc.UniverseSyncProgress{
+		UniverseHost:  checkpoint.ServerHost,
+		Id:            marshalUniID(checkpoint.ID),
+		RemoteRoot:    marshalMssmtNode(checkpoint.Root),
+		LeavesFetched: checkpoint.LeavesFetched,
+		LeavesTotal:   checkpoint.LeavesTotal,
+		Complete:      checkpoint.Complete,
+		LastError:     checkpoint.LastError,
+		UpdatedAt:     checkpoint.UpdatedAt.Unix(),
+	}
+}
+
+// SyncProgress returns the progress of the syncs of the local Universe server
+// with remote servers, per server and asset.
+func (r *rpcServer) SyncProgress(ctx context.Context,
+	in *unirpc.SyncProgressRequest) (*unirpc.SyncProgressResponse, error) {
+
+	query := universe.SyncCheckpointQuery{
+		ServerHost: in.UniverseHost,
+	}
+	if in.Id != nil {
+		uniID, err := unmarshalUniID(in.Id)
+		if err != nil {
+			return nil, err
+		}
+		query.ID = &uniID
+	}
+
+	checkpoints, err := r.cfg.FederationDB.QuerySyncCheckpoints(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.SyncProgressResponse{
+		Progress: fn.Map(checkpoints, marshalSyncProgress),
+	}, nil
+}
+
+// unmarshalQuarantineQuery parses the filters of a quarantine RPC request.
+func unmarshalQuarantineQuery(host string,
+	rpcID *unirpc.ID) (universe.QuarantineQuery, error) {
+
+	query := universe.QuarantineQuery{
+		ServerHost: host,
+	}
+	if rpcID != nil {
+		uniID, err := unmarshalUniID(rpcID)
+		if err != nil {
+			return query, err
+		}
+		query.UniverseID = &uniID
+	}
+
+	return query, nil
+}
+
+// marshalQuarantinedProof marshals a quarantined proof into the RPC
+// counterpart.
+func marshalQuarantinedProof(
+	p *universe.QuarantinedProof) *unirpc.QuarantinedProof {
+
+	return &unirpc.QuarantinedProof{
+		UniverseHost:  p.ServerHost,
+		Id:            marshalUniID(p.UniverseID),
+		LeafKey:       marshalLeafKey(p.Key),
+		RawProof:      p.Proof,
+		Reason:        p.Reason,
+		QuarantinedAt: p.QuarantinedAt.Unix(),
+	}
+}
+
+// ListQuarantinedProofs lists the proofs that were fetched from remote
+// Universe servers during a sync, but failed validation.
+func (r *rpcServer) ListQuarantinedProofs(ctx context.Context,
+	in *unirpc.ListQuarantinedProofsRequest,
+) (*unirpc.ListQuarantinedProofsResponse, error) {
+
+	query, err := unmarshalQuarantineQuery(in.UniverseHost, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	proofs, err := r.cfg.FederationDB.QueryQuarantinedProofs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.ListQuarantinedProofsResponse{
+		Proofs: fn.Map(proofs, marshalQuarantinedProof),
+	}, nil
+}
+
+// PurgeQuarantinedProofs removes proofs from the quarantine, either all of
+// them or only those of a given server or asset.
+func (r *rpcServer) PurgeQuarantinedProofs(ctx context.Context,
+	in *unirpc.PurgeQuarantinedProofsRequest,
+) (*unirpc.PurgeQuarantinedProofsResponse, error) {
+
+	query, err := unmarshalQuarantineQuery(in.UniverseHost, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	numPurged, err := r.cfg.FederationDB.PurgeQuarantinedProofs(
+		ctx, query,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.PurgeQuarantinedProofsResponse{
+		NumPurged: uint64(numPurged),
+	}, nil
+}
+
+// marshalSupplyReport marshals a signed supply report into the RPC
+// counterpart.
+func marshalSupplyReport(
+	report *universe.SupplyReport) (*unirpc.SupplyReport, error) {
+
+	msg, err := report.Message()
+	if err != nil {
+		return nil, err
+	}
+
+	invalidLeaves := make(
+		[]*unirpc.InvalidSupplyLeaf, 0, len(report.InvalidLeaves),
+	)
+	for _, leaf := range report.InvalidLeaves {
+		var outpoint string
+		if leaf.OutPoint != (wire.OutPoint{}) {
+			outpoint = leaf.OutPoint.String()
+		}
+
+		invalidLeaves = append(invalidLeaves, &unirpc.InvalidSupplyLeaf{
+			Outpoint: outpoint,
+			Amount:   leaf.Amt,
+			Reason:   leaf.Reason,
+		})
+	}
+
+	return &unirpc.SupplyReport{
+		Id:             marshalUniID(report.ID),
+		RootHash:       fn.ByteSlice(report.RootHash),
+		RootSum:        report.RootSum,
+		VerifiedSupply: report.VerifiedSupply,
+		NumLeaves:      report.NumLeaves,
+		InvalidLeaves:  invalidLeaves,
+		Consistent:     report.Consistent(),
+		Timestamp:      report.Timestamp.Unix(),
+		NodeKey:        report.NodeKey.SerializeCompressed(),
+		SignedMessage:  msg,
+		Signature:      report.Signature,
+	}, nil
+}
+
+// SupplyReport returns a report about the total supply of an asset or asset
+// group, signed by the identity key of the node.
+func (r *rpcServer) SupplyReport(ctx context.Context,
+	in *unirpc.SupplyReportRequest) (*unirpc.SupplyReportResponse, error) {
+
+	if in.Id == nil {
+		return nil, fmt.Errorf("universe ID must be set")
+	}
+
+	uniID, err := unmarshalUniID(in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	report, ok := r.cfg.SupplyVerifier.LatestReport(uniID)
+	if in.Verify || !ok {
+		report, err = r.cfg.SupplyVerifier.VerifySupply(ctx, uniID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to verify supply: %w",
+				err)
+		}
+	}
+
+	rpcReport, err := marshalSupplyReport(report)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.SupplyReportResponse{
+		Report: rpcReport,
+	}, nil
+}
+
 // ProveAssetOwnership creates an ownership proof embedded in an asset
 // transition proof. That ownership proof is a signed virtual transaction
 // spending the asset with a valid witness to prove the prover owns the keys
@@ -3363,3 +6761,216 @@ func (r *rpcServer) RemoveUTXOLease(ctx context.Context,
 
 	return &wrpc.RemoveUTXOLeaseResponse{}, nil
 }
+
+// ListAnchorCoSignRequests lists the anchor transactions of transfers that wait
+// for an external party to co-sign the anchor inputs the connected lnd node
+// can't sign for alone.
+func (r *rpcServer) ListAnchorCoSignRequests(_ context.Context,
+	_ *wrpc.ListAnchorCoSignRequestsRequest) (
+	*wrpc.ListAnchorCoSignRequestsResponse, error) {
+
+	pkts, err := r.anchorCoSigner.PendingPsbts()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch pending anchor "+
+			"transactions: %w", err)
+	}
+
+	resp := &wrpc.ListAnchorCoSignRequestsResponse{
+		AnchorPsbts: make([][]byte, 0, len(pkts)),
+	}
+	for _, pkt := range pkts {
+		var b bytes.Buffer
+		if err := pkt.Serialize(&b); err != nil {
+			return nil, fmt.Errorf("unable to serialize anchor "+
+				"psbt: %w", err)
+		}
+		resp.AnchorPsbts = append(resp.AnchorPsbts, b.Bytes())
+	}
+
+	return resp, nil
+}
+
+// SubmitAnchorCoSignature submits the co-signed version of an anchor
+// transaction, which continues the transfer that waits for it.
+func (r *rpcServer) SubmitAnchorCoSignature(_ context.Context,
+	in *wrpc.SubmitAnchorCoSignatureRequest) (
+	*wrpc.SubmitAnchorCoSignatureResponse, error) {
+
+	signedPkt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(in.SignedPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode psbt: %w", err)
+	}
+
+	if err := r.anchorCoSigner.SubmitCoSignedPsbt(signedPkt); err != nil {
+		return nil, err
+	}
+
+	return &wrpc.SubmitAnchorCoSignatureResponse{}, nil
+}
+
+// ListVirtualSignRequests lists the virtual transactions of transfers that wait
+// for an offline signer to sign them.
+func (r *rpcServer) ListVirtualSignRequests(_ context.Context,
+	_ *wrpc.ListVirtualSignRequestsRequest) (
+	*wrpc.ListVirtualSignRequestsResponse, error) {
+
+	if r.cfg.OfflineSigner == nil {
+		return nil, fmt.Errorf("daemon is not running in watch-only " +
+			"mode")
+	}
+
+	vPkts, err := r.cfg.OfflineSigner.PendingPackets()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch pending virtual "+
+			"transactions: %w", err)
+	}
+
+	resp := &wrpc.ListVirtualSignRequestsResponse{
+		VirtualPsbts: make([][]byte, 0, len(vPkts)),
+	}
+	for _, vPkt := range vPkts {
+		var b bytes.Buffer
+		if err := vPkt.Serialize(&b); err != nil {
+			return nil, fmt.Errorf("unable to serialize virtual "+
+				"psbt: %w", err)
+		}
+		resp.VirtualPsbts = append(resp.VirtualPsbts, b.Bytes())
+	}
+
+	return resp, nil
+}
+
+// SubmitVirtualSignature submits the signed version of a virtual transaction,
+// which continues the transfer that waits for it.
+func (r *rpcServer) SubmitVirtualSignature(_ context.Context,
+	in *wrpc.SubmitVirtualSignatureRequest) (
+	*wrpc.SubmitVirtualSignatureResponse, error) {
+
+	if r.cfg.OfflineSigner == nil {
+		return nil, fmt.Errorf("daemon is not running in watch-only " +
+			"mode")
+	}
+
+	signedPkt, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(in.SignedVirtualPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode virtual psbt: %w", err)
+	}
+
+	err = r.cfg.OfflineSigner.SubmitSignedPacket(signedPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wrpc.SubmitVirtualSignatureResponse{}, nil
+}
+
+// maxSignClientNameLen is the maximum length of the name a watch-only daemon
+// opens a signing session with.
+const maxSignClientNameLen = 64
+
+// remoteSignAuthID returns the ID of the macaroon the request of the given
+// context is authenticated with, which binds signing sessions to the macaroon
+// they were opened with.
+func remoteSignAuthID(ctx context.Context) (string, error) {
+	mac, err := macaroonFromContext(ctx)
+	if err != nil || mac == nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(mac.Id()), nil
+}
+
+// OpenSession authenticates a watch-only daemon with the remote signer and
+// opens a signing session.
+func (r *rpcServer) OpenSession(ctx context.Context,
+	in *remotesignerrpc.OpenSessionRequest) (
+	*remotesignerrpc.OpenSessionResponse, error) {
+
+	if r.cfg.RemoteSigner == nil {
+		return nil, fmt.Errorf("remote signer not enabled")
+	}
+
+	if in.ClientName == "" || len(in.ClientName) > maxSignClientNameLen {
+		return nil, fmt.Errorf("client name must be between 1 and %d "+
+			"characters", maxSignClientNameLen)
+	}
+
+	authID, err := remoteSignAuthID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	session, err := r.cfg.RemoteSigner.OpenSession(in.ClientName, authID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open session: %w", err)
+	}
+
+	return &remotesignerrpc.OpenSessionResponse{
+		SessionId:       fn.ByteSlice(session.ID),
+		ExpiryTimestamp: session.ExpiresAt.Unix(),
+	}, nil
+}
+
+// SignVirtualPacket signs all inputs of the given virtual packet for a
+// watch-only daemon, if the packet matches its summary and is accepted by all
+// signature policies.
+func (r *rpcServer) SignVirtualPacket(ctx context.Context,
+	in *remotesignerrpc.SignVirtualPacketRequest) (
+	*remotesignerrpc.SignVirtualPacketResponse, error) {
+
+	if r.cfg.RemoteSigner == nil {
+		return nil, fmt.Errorf("remote signer not enabled")
+	}
+
+	var sessionID tapfreighter.SignSessionID
+	if len(in.SessionId) != len(sessionID) {
+		return nil, status.Error(
+			codes.Unauthenticated, "invalid session ID",
+		)
+	}
+	copy(sessionID[:], in.SessionId)
+
+	authID, err := remoteSignAuthID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	vPkt, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(in.VirtualPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode virtual psbt: %w", err)
+	}
+
+	summary, err := unmarshalPacketSummary(in.Summary)
+	if err != nil {
+		return nil, fmt.Errorf("invalid packet summary: %w", err)
+	}
+
+	err = r.cfg.RemoteSigner.SignVirtualPacket(
+		ctx, sessionID, authID, vPkt, summary,
+	)
+	switch {
+	// The watch-only daemon opens a new session if its session is
+	// unknown.
+	case errors.Is(err, tapfreighter.ErrSignSessionUnknown):
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+
+	case err != nil:
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := vPkt.Serialize(&buf); err != nil {
+		return nil, fmt.Errorf("unable to serialize virtual psbt: %w",
+			err)
+	}
+
+	return &remotesignerrpc.SignVirtualPacketResponse{
+		SignedVirtualPsbt: buf.Bytes(),
+	}, nil
+}
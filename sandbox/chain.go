@@ -0,0 +1,603 @@
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// DefaultFeeRate is the fee rate the simulated chain returns for every
+	// confirmation target.
+	DefaultFeeRate = chainfee.FeePerKwFloor
+
+	// blockInterval is the time between the timestamps of two simulated
+	// blocks.
+	blockInterval = 10 * time.Minute
+)
+
+// txLocation is the position of a confirmed transaction in the chain.
+type txLocation struct {
+	// height is the height of the block that contains the transaction.
+	height uint32
+
+	// index is the index of the transaction within the block.
+	index uint32
+}
+
+// confRequest is a registered intent to be notified about the confirmation of
+// a transaction.
+type confRequest struct {
+	// numConfs is the number of confirmations the transaction needs to
+	// have before the notification is sent.
+	numConfs uint32
+
+	// includeBlock is true if the block that contains the transaction
+	// should be part of the notification.
+	includeBlock bool
+
+	// event is the event the notification is sent over.
+	event *chainntnfs.ConfirmationEvent
+}
+
+// SimChain is an in-memory tapgarden.ChainBridge. Transactions are published
+// to a mempool and only confirm once a block is mined explicitly with
+// MineBlock. The blocks have valid merkle roots, so proofs created against the
+// simulated chain verify, but neither proof of work nor transaction scripts
+// are checked. The simulated chain never re-organizes.
+type SimChain struct {
+	mtx sync.Mutex
+
+	// params are the chain parameters of the simulated chain.
+	params *chaincfg.Params
+
+	// blocks are the blocks of the chain, indexed by their height.
+	blocks []*wire.MsgBlock
+
+	// blockHeights maps the hash of each block to its height.
+	blockHeights map[chainhash.Hash]uint32
+
+	// mempool are the published transactions that aren't confirmed yet.
+	mempool []*wire.MsgTx
+
+	// confirmed is the location of each confirmed transaction.
+	confirmed map[chainhash.Hash]txLocation
+
+	// spent maps each outpoint spent by a published transaction to the
+	// spending transaction.
+	spent map[wire.OutPoint]chainhash.Hash
+
+	// confRequests are the pending confirmation notifications, keyed by
+	// the transaction they are for.
+	confRequests map[chainhash.Hash][]*confRequest
+
+	// spendRequests are the pending spend notifications, keyed by the
+	// outpoint they are for.
+	spendRequests map[wire.OutPoint][]*chainntnfs.SpendEvent
+
+	// epochSubscribers receive the height of each new block.
+	epochSubscribers []*epochSubscriber
+
+	// feeRate is the fee rate that is returned for every confirmation
+	// target.
+	feeRate chainfee.SatPerKWeight
+
+	// feeHistogram is the fee histogram that is reported for the mempool.
+	feeHistogram []tapgarden.FeeHistogramBucket
+}
+
+// epochSubscriber is a registered intent to be notified about new blocks.
+type epochSubscriber struct {
+	ctx    context.Context
+	epochs chan int32
+}
+
+// NewSimChain creates a new simulated chain that starts with the genesis block
+// of the given chain parameters.
+func NewSimChain(params *chaincfg.Params) *SimChain {
+	genesis := params.GenesisBlock
+
+	return &SimChain{
+		params:       params,
+		blocks:       []*wire.MsgBlock{genesis},
+		blockHeights: map[chainhash.Hash]uint32{genesis.BlockHash(): 0},
+		confirmed:    make(map[chainhash.Hash]txLocation),
+		spent:        make(map[wire.OutPoint]chainhash.Hash),
+		confRequests: make(map[chainhash.Hash][]*confRequest),
+		spendRequests: make(
+			map[wire.OutPoint][]*chainntnfs.SpendEvent,
+		),
+		feeRate: DefaultFeeRate,
+	}
+}
+
+// SetFeeRate sets the fee rate that is returned for every confirmation target.
+func (c *SimChain) SetFeeRate(feeRate chainfee.SatPerKWeight) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.feeRate = feeRate
+}
+
+// SetFeeHistogram sets the fee histogram that is reported for the mempool. The
+// simulated chain doesn't know the fees of its transactions, so the histogram
+// doesn't need to match the mempool.
+func (c *SimChain) SetFeeHistogram(histogram []tapgarden.FeeHistogramBucket) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.feeHistogram = histogram
+}
+
+// MempoolTxns returns the published transactions that aren't confirmed yet.
+func (c *SimChain) MempoolTxns() []*wire.MsgTx {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	txns := make([]*wire.MsgTx, len(c.mempool))
+	copy(txns, c.mempool)
+
+	return txns
+}
+
+// MineBlock mines a new block that confirms all transactions in the mempool
+// and sends out the notifications of all confirmations and the new block.
+func (c *SimChain) MineBlock() (*wire.MsgBlock, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	height := uint32(len(c.blocks))
+	prevHeader := c.blocks[height-1].Header
+
+	// Each block starts with a coinbase transaction that commits to the
+	// block height, which makes sure no two coinbase transactions are
+	// equal.
+	coinbaseScript, err := txscript.NewScriptBuilder().
+		AddInt64(int64(height)).
+		Script()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create coinbase script: %w",
+			err)
+	}
+	coinbase := wire.NewMsgTx(2)
+	coinbase.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: wire.MaxPrevOutIndex},
+		SignatureScript:  coinbaseScript,
+		Sequence:         wire.MaxTxInSequenceNum,
+	})
+	coinbase.AddTxOut(&wire.TxOut{
+		Value:    0,
+		PkScript: []byte{txscript.OP_TRUE},
+	})
+
+	txns := append([]*wire.MsgTx{coinbase}, c.mempool...)
+	utilTxns := make([]*btcutil.Tx, len(txns))
+	for idx := range txns {
+		utilTxns[idx] = btcutil.NewTx(txns[idx])
+	}
+	merkleTree := blockchain.BuildMerkleTreeStore(utilTxns, false)
+	merkleRoot := merkleTree[len(merkleTree)-1]
+
+	block := &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Version:    prevHeader.Version,
+			PrevBlock:  prevHeader.BlockHash(),
+			MerkleRoot: *merkleRoot,
+			Timestamp:  prevHeader.Timestamp.Add(blockInterval),
+			Bits:       c.params.PowLimitBits,
+		},
+		Transactions: txns,
+	}
+
+	blockHash := block.BlockHash()
+	c.blocks = append(c.blocks, block)
+	c.blockHeights[blockHash] = height
+	for idx, tx := range txns {
+		c.confirmed[tx.TxHash()] = txLocation{
+			height: height,
+			index:  uint32(idx),
+		}
+	}
+	c.mempool = nil
+
+	c.notifyConfs()
+	c.notifySpends()
+	c.notifyEpochs(int32(height))
+
+	return block, nil
+}
+
+// notifyConfs sends out all confirmation notifications that have reached
+// their number of confirmations.
+//
+// NOTE: The mutex must be held when calling this method.
+func (c *SimChain) notifyConfs() {
+	for txid, reqs := range c.confRequests {
+		var remaining []*confRequest
+		for _, req := range reqs {
+			if !c.notifyConf(txid, req) {
+				remaining = append(remaining, req)
+			}
+		}
+
+		if len(remaining) == 0 {
+			delete(c.confRequests, txid)
+			continue
+		}
+		c.confRequests[txid] = remaining
+	}
+}
+
+// notifyConf sends out the confirmation notification of the given request if
+// the transaction has the requested number of confirmations. True is returned
+// if the notification was sent.
+//
+// NOTE: The mutex must be held when calling this method.
+func (c *SimChain) notifyConf(txid chainhash.Hash, req *confRequest) bool {
+	loc, ok := c.confirmed[txid]
+	if !ok {
+		return false
+	}
+
+	tipHeight := uint32(len(c.blocks) - 1)
+	if tipHeight-loc.height+1 < req.numConfs {
+		return false
+	}
+
+	block := c.blocks[loc.height]
+	blockHash := block.BlockHash()
+	conf := &chainntnfs.TxConfirmation{
+		BlockHash:   &blockHash,
+		BlockHeight: loc.height,
+		TxIndex:     loc.index,
+		Tx:          block.Transactions[loc.index].Copy(),
+	}
+	if req.includeBlock {
+		conf.Block = block
+	}
+
+	// The confirmation channel is buffered and only ever receives a single
+	// notification, so this never blocks.
+	req.event.Confirmed <- conf
+
+	return true
+}
+
+// notifySpends sends out the spend notifications of all outpoints that are
+// spent by a confirmed transaction.
+//
+// NOTE: The mutex must be held when calling this method.
+func (c *SimChain) notifySpends() {
+	for outpoint, events := range c.spendRequests {
+		if !c.notifySpend(outpoint, events) {
+			continue
+		}
+
+		delete(c.spendRequests, outpoint)
+	}
+}
+
+// notifySpend sends out the spend notification to all given events if the
+// outpoint is spent by a confirmed transaction. True is returned if the
+// notification was sent.
+//
+// NOTE: The mutex must be held when calling this method.
+func (c *SimChain) notifySpend(outpoint wire.OutPoint,
+	events []*chainntnfs.SpendEvent) bool {
+
+	spenderHash, ok := c.spent[outpoint]
+	if !ok {
+		return false
+	}
+	loc, ok := c.confirmed[spenderHash]
+	if !ok {
+		return false
+	}
+
+	spendingTx := c.blocks[loc.height].Transactions[loc.index]
+	var inputIndex uint32
+	for idx, txIn := range spendingTx.TxIn {
+		if txIn.PreviousOutPoint == outpoint {
+			inputIndex = uint32(idx)
+			break
+		}
+	}
+
+	for _, event := range events {
+		spentOutPoint := outpoint
+		txHash := spenderHash
+
+		// The spend channel is buffered and only ever receives a
+		// single notification, so this never blocks.
+		event.Spend <- &chainntnfs.SpendDetail{
+			SpentOutPoint:     &spentOutPoint,
+			SpenderTxHash:     &txHash,
+			SpendingTx:        spendingTx.Copy(),
+			SpenderInputIndex: inputIndex,
+			SpendingHeight:    int32(loc.height),
+		}
+	}
+
+	return true
+}
+
+// notifyEpochs sends the given height to all block epoch subscribers.
+//
+// NOTE: The mutex must be held when calling this method.
+func (c *SimChain) notifyEpochs(height int32) {
+	var active []*epochSubscriber
+	for _, sub := range c.epochSubscribers {
+		if sub.ctx.Err() != nil {
+			continue
+		}
+		active = append(active, sub)
+
+		// We don't want a slow subscriber to block mining, so each
+		// epoch is delivered in its own goroutine.
+		go func(sub *epochSubscriber) {
+			select {
+			case sub.epochs <- height:
+			case <-sub.ctx.Done():
+			}
+		}(sub)
+	}
+	c.epochSubscribers = active
+}
+
+// RegisterConfirmationsNtfn registers an intent to be notified once txid
+// reaches numConfs confirmations.
+//
+// NOTE: This is part of the tapgarden.ChainBridge interface.
+func (c *SimChain) RegisterConfirmationsNtfn(_ context.Context,
+	txid *chainhash.Hash, _ []byte, numConfs, _ uint32, includeBlock bool,
+	_ chan struct{}) (*chainntnfs.ConfirmationEvent, chan error, error) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if numConfs == 0 {
+		numConfs = 1
+	}
+
+	req := &confRequest{
+		numConfs:     numConfs,
+		includeBlock: includeBlock,
+	}
+	req.event = chainntnfs.NewConfirmationEvent(numConfs, func() {
+		c.mtx.Lock()
+		defer c.mtx.Unlock()
+
+		reqs := c.confRequests[*txid]
+		for idx := range reqs {
+			if reqs[idx] == req {
+				c.confRequests[*txid] = append(
+					reqs[:idx], reqs[idx+1:]...,
+				)
+				break
+			}
+		}
+	})
+
+	if !c.notifyConf(*txid, req) {
+		c.confRequests[*txid] = append(c.confRequests[*txid], req)
+	}
+
+	return req.event, make(chan error, 1), nil
+}
+
+// RegisterSpendNtfn registers an intent to be notified once the given outpoint
+// is spent by a confirmed transaction.
+//
+// NOTE: This is part of the tapgarden.ChainBridge interface.
+func (c *SimChain) RegisterSpendNtfn(_ context.Context,
+	outpoint *wire.OutPoint, _ []byte,
+	_ uint32) (*chainntnfs.SpendEvent, chan error, error) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	op := *outpoint
+	event := &chainntnfs.SpendEvent{
+		Spend: make(chan *chainntnfs.SpendDetail, 1),
+	}
+	event.Cancel = func() {
+		c.mtx.Lock()
+		defer c.mtx.Unlock()
+
+		events := c.spendRequests[op]
+		for idx := range events {
+			if events[idx] == event {
+				c.spendRequests[op] = append(
+					events[:idx], events[idx+1:]...,
+				)
+				break
+			}
+		}
+	}
+
+	if !c.notifySpend(op, []*chainntnfs.SpendEvent{event}) {
+		c.spendRequests[op] = append(c.spendRequests[op], event)
+	}
+
+	return event, make(chan error, 1), nil
+}
+
+// RegisterBlockEpochNtfn registers an intent to be notified of each new block
+// connected to the main chain.
+//
+// NOTE: This is part of the tapgarden.ChainBridge interface.
+func (c *SimChain) RegisterBlockEpochNtfn(
+	ctx context.Context) (chan int32, chan error, error) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	sub := &epochSubscriber{
+		ctx:    ctx,
+		epochs: make(chan int32, 1),
+	}
+	c.epochSubscribers = append(c.epochSubscribers, sub)
+
+	// Like a real chain backend, we start with the current best block.
+	sub.epochs <- int32(len(c.blocks) - 1)
+
+	return sub.epochs, make(chan error, 1), nil
+}
+
+// GetBlock returns a chain block given its hash.
+//
+// NOTE: This is part of the tapgarden.ChainBridge interface.
+func (c *SimChain) GetBlock(_ context.Context,
+	hash chainhash.Hash) (*wire.MsgBlock, error) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	height, ok := c.blockHeights[hash]
+	if !ok {
+		return nil, fmt.Errorf("block %v not found", hash)
+	}
+
+	return c.blocks[height], nil
+}
+
+// GetBlockHash returns the hash of the block in the best blockchain at the
+// given height.
+//
+// NOTE: This is part of the tapgarden.ChainBridge interface.
+func (c *SimChain) GetBlockHash(_ context.Context,
+	height int64) (chainhash.Hash, error) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if height < 0 || height >= int64(len(c.blocks)) {
+		return chainhash.Hash{}, fmt.Errorf("no block at height %d",
+			height)
+	}
+
+	return c.blocks[height].BlockHash(), nil
+}
+
+// VerifyBlock returns an error if a block (with given header and height) is not
+// present on-chain. It also checks to ensure that block height corresponds to
+// the given block header.
+//
+// NOTE: This is part of the tapgarden.ChainBridge interface.
+func (c *SimChain) VerifyBlock(_ context.Context, header wire.BlockHeader,
+	height uint32) error {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	blockHash := header.BlockHash()
+	knownHeight, ok := c.blockHeights[blockHash]
+	if !ok {
+		return fmt.Errorf("block %v not found", blockHash)
+	}
+	if knownHeight != height {
+		return fmt.Errorf("block %v is at height %d, not %d",
+			blockHash, knownHeight, height)
+	}
+
+	return nil
+}
+
+// CurrentHeight return the current height of the main chain.
+//
+// NOTE: This is part of the tapgarden.ChainBridge interface.
+func (c *SimChain) CurrentHeight(_ context.Context) (uint32, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return uint32(len(c.blocks) - 1), nil
+}
+
+// PublishTransaction adds the transaction to the mempool. Publishing a
+// transaction again is a no-op, but a transaction that spends an outpoint that
+// is already spent by a different transaction is rejected.
+//
+// NOTE: This is part of the tapgarden.ChainBridge interface.
+func (c *SimChain) PublishTransaction(_ context.Context, tx *wire.MsgTx) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	txid := tx.TxHash()
+	if _, ok := c.confirmed[txid]; ok {
+		return nil
+	}
+	for _, mempoolTx := range c.mempool {
+		if mempoolTx.TxHash() == txid {
+			return nil
+		}
+	}
+
+	for _, txIn := range tx.TxIn {
+		spender, ok := c.spent[txIn.PreviousOutPoint]
+		if ok {
+			return fmt.Errorf("tx %v double spends %v, already "+
+				"spent by %v", txid, txIn.PreviousOutPoint,
+				spender)
+		}
+	}
+	for _, txIn := range tx.TxIn {
+		c.spent[txIn.PreviousOutPoint] = txid
+	}
+
+	c.mempool = append(c.mempool, tx.Copy())
+
+	return nil
+}
+
+// EstimateFee returns a fee estimate for the confirmation target.
+//
+// NOTE: This is part of the tapgarden.ChainBridge interface.
+func (c *SimChain) EstimateFee(_ context.Context,
+	_ uint32) (chainfee.SatPerKWeight, error) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.feeRate, nil
+}
+
+// MempoolInfo returns the size of the mempool together with the configured fee
+// histogram. The relay fee is the relay fee floor.
+//
+// NOTE: This is part of the tapgarden.ChainBridge interface.
+func (c *SimChain) MempoolInfo(_ context.Context) (*tapgarden.MempoolInfo,
+	error) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	info := &tapgarden.MempoolInfo{
+		NumTxns:     int64(len(c.mempool)),
+		MinRelayFee: chainfee.FeePerKwFloor,
+		FeeHistogram: append(
+			[]tapgarden.FeeHistogramBucket(nil), c.feeHistogram...,
+		),
+	}
+	for _, tx := range c.mempool {
+		weight := blockchain.GetTransactionWeight(btcutil.NewTx(tx))
+		info.VSize += (weight + blockchain.WitnessScaleFactor - 1) /
+			blockchain.WitnessScaleFactor
+	}
+
+	return info, nil
+}
+
+// A compile-time assertion to ensure SimChain meets the tapgarden.ChainBridge
+// interface.
+var _ tapgarden.ChainBridge = (*SimChain)(nil)
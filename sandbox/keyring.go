@@ -0,0 +1,236 @@
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// KeyRing is an in-memory tapgarden.KeyRing that creates a new random key for
+// every key locator. It keeps the private keys of all derived keys, so the
+// sandbox can create valid signatures with them.
+type KeyRing struct {
+	mtx sync.Mutex
+
+	// nextIndex is the index of the next key that is derived per key
+	// family.
+	nextIndex map[keychain.KeyFamily]uint32
+
+	// keys are the derived keys, keyed by their key locator.
+	keys map[keychain.KeyLocator]*btcec.PrivateKey
+
+	// pubKeys are the derived keys, keyed by their public key.
+	pubKeys map[asset.SerializedKey]*btcec.PrivateKey
+}
+
+// NewKeyRing creates a new, empty key ring.
+func NewKeyRing() *KeyRing {
+	return &KeyRing{
+		nextIndex: make(map[keychain.KeyFamily]uint32),
+		keys:      make(map[keychain.KeyLocator]*btcec.PrivateKey),
+		pubKeys:   make(map[asset.SerializedKey]*btcec.PrivateKey),
+	}
+}
+
+// DeriveNextKey derives the next key within the given key family.
+//
+// NOTE: This is part of the tapgarden.KeyRing interface.
+func (k *KeyRing) DeriveNextKey(_ context.Context,
+	family keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	k.mtx.Lock()
+	defer k.mtx.Unlock()
+
+	loc := keychain.KeyLocator{
+		Family: family,
+		Index:  k.nextIndex[family],
+	}
+	k.nextIndex[family]++
+
+	return k.deriveKey(loc)
+}
+
+// DeriveKey derives the key of the given key locator.
+//
+// NOTE: This is part of the tapgarden.KeyRing interface.
+func (k *KeyRing) DeriveKey(_ context.Context,
+	loc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	k.mtx.Lock()
+	defer k.mtx.Unlock()
+
+	return k.deriveKey(loc)
+}
+
+// deriveKey returns the key of the given key locator, creating it if it
+// doesn't exist yet.
+//
+// NOTE: The mutex must be held when calling this method.
+func (k *KeyRing) deriveKey(
+	loc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	privKey, ok := k.keys[loc]
+	if !ok {
+		var err error
+		privKey, err = btcec.NewPrivateKey()
+		if err != nil {
+			return keychain.KeyDescriptor{}, fmt.Errorf("unable "+
+				"to create key: %w", err)
+		}
+
+		k.keys[loc] = privKey
+		k.pubKeys[asset.ToSerialized(privKey.PubKey())] = privKey
+	}
+
+	return keychain.KeyDescriptor{
+		KeyLocator: loc,
+		PubKey:     privKey.PubKey(),
+	}, nil
+}
+
+// IsLocalKey returns true if the key was derived by this key ring.
+//
+// NOTE: This is part of the tapgarden.KeyRing interface.
+func (k *KeyRing) IsLocalKey(_ context.Context,
+	keyDesc keychain.KeyDescriptor) bool {
+
+	k.mtx.Lock()
+	defer k.mtx.Unlock()
+
+	if keyDesc.PubKey == nil {
+		_, ok := k.keys[keyDesc.KeyLocator]
+		return ok
+	}
+
+	_, ok := k.pubKeys[asset.ToSerialized(keyDesc.PubKey)]
+	return ok
+}
+
+// PrivKey returns the private key of the given key descriptor, which is looked
+// up by its public key or, if that isn't set, by its key locator.
+func (k *KeyRing) PrivKey(
+	keyDesc keychain.KeyDescriptor) (*btcec.PrivateKey, error) {
+
+	k.mtx.Lock()
+	defer k.mtx.Unlock()
+
+	if keyDesc.PubKey == nil {
+		privKey, ok := k.keys[keyDesc.KeyLocator]
+		if !ok {
+			return nil, fmt.Errorf("unknown key locator %v",
+				keyDesc.KeyLocator)
+		}
+
+		return privKey, nil
+	}
+
+	privKey, ok := k.pubKeys[asset.ToSerialized(keyDesc.PubKey)]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %x",
+			keyDesc.PubKey.SerializeCompressed())
+	}
+
+	return privKey, nil
+}
+
+// privKeyXOnly returns the private key of the given x-only public key.
+func (k *KeyRing) privKeyXOnly(xOnlyKey []byte) (*btcec.PrivateKey, bool) {
+	k.mtx.Lock()
+	defer k.mtx.Unlock()
+
+	for _, privKey := range k.pubKeys {
+		if string(schnorr.SerializePubKey(privKey.PubKey())) ==
+			string(xOnlyKey) {
+
+			return privKey, true
+		}
+	}
+
+	return nil, false
+}
+
+// Signer is a tapscript.Signer that signs virtual transactions with the keys of
+// a KeyRing.
+type Signer struct {
+	keyRing *KeyRing
+}
+
+// NewSigner creates a new signer that uses the keys of the given key ring.
+func NewSigner(keyRing *KeyRing) *Signer {
+	return &Signer{
+		keyRing: keyRing,
+	}
+}
+
+// SignVirtualTx generates a signature according to the passed signing
+// descriptor and virtual TX.
+//
+// NOTE: This is part of the tapscript.Signer interface.
+func (s *Signer) SignVirtualTx(signDesc *lndclient.SignDescriptor,
+	tx *wire.MsgTx, prevOut *wire.TxOut) (*schnorr.Signature, error) {
+
+	privKey, err := s.keyRing.PrivKey(signDesc.KeyDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	return tapscript.NewMockSigner(privKey).SignVirtualTx(
+		signDesc, tx, prevOut,
+	)
+}
+
+// noopWatcher is a proof.Watcher that doesn't watch anything, since the
+// simulated chain never re-organizes.
+type noopWatcher struct{}
+
+// WatchProofs adds new proofs to the re-org watcher for their anchor
+// transaction to be watched until it reaches a safe confirmation depth.
+//
+// NOTE: This is part of the proof.Watcher interface.
+func (n *noopWatcher) WatchProofs([]*proof.Proof, proof.UpdateCallback) error {
+	return nil
+}
+
+// MaybeWatch inspects the given proof file for any proofs that are not yet
+// buried sufficiently deep and adds them to the re-org watcher.
+//
+// NOTE: This is part of the proof.Watcher interface.
+func (n *noopWatcher) MaybeWatch(*proof.File, proof.UpdateCallback) error {
+	return nil
+}
+
+// ShouldWatch returns true if the proof is for a block that is not yet
+// sufficiently deep to be considered safe.
+//
+// NOTE: This is part of the proof.Watcher interface.
+func (n *noopWatcher) ShouldWatch(*proof.Proof) bool {
+	return false
+}
+
+// DefaultUpdateCallback returns the default implementation for the update
+// callback that is called when a proof is updated.
+//
+// NOTE: This is part of the proof.Watcher interface.
+func (n *noopWatcher) DefaultUpdateCallback() proof.UpdateCallback {
+	return func([]*proof.Proof) error {
+		return nil
+	}
+}
+
+// A compile-time assertion to ensure the sandbox types meet the interfaces
+// they implement.
+var (
+	_ tapgarden.KeyRing = (*KeyRing)(nil)
+	_ tapscript.Signer  = (*Signer)(nil)
+	_ proof.Watcher     = (*noopWatcher)(nil)
+)
@@ -0,0 +1,624 @@
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+)
+
+const (
+	// DefaultLoadBlockInterval is the interval at which blocks are mined
+	// during a load test, if no interval is configured.
+	DefaultLoadBlockInterval = 100 * time.Millisecond
+)
+
+// LoadConfig configures a load test of the chain porter of a sandbox.
+type LoadConfig struct {
+	// NumParcels is the number of parcels that are sent.
+	NumParcels int
+
+	// ParcelsPerSecond is the rate at which new parcels are requested,
+	// independent of how fast earlier parcels complete. If zero, all
+	// parcels are requested at once.
+	ParcelsPerSecond float64
+
+	// OutputsPerParcel is the number of receiver addresses each parcel
+	// pays. A change output is added to each parcel on top.
+	OutputsPerParcel int
+
+	// PassiveAssetsPerAnchor is the number of other assets that are
+	// committed to the same anchor output as the asset of each parcel, and
+	// that the parcel therefore re-anchors.
+	PassiveAssetsPerAnchor int
+
+	// BlockInterval is the interval at which a block is mined while the
+	// mempool isn't empty. If zero, DefaultLoadBlockInterval is used.
+	BlockInterval time.Duration
+}
+
+// validate returns an error if the load test config is invalid.
+func (c *LoadConfig) validate() error {
+	switch {
+	case c.NumParcels < 1:
+		return fmt.Errorf("at least one parcel must be sent")
+
+	case c.ParcelsPerSecond < 0:
+		return fmt.Errorf("invalid parcel rate %v", c.ParcelsPerSecond)
+
+	case c.OutputsPerParcel < 1:
+		return fmt.Errorf("each parcel needs at least one output")
+
+	case c.PassiveAssetsPerAnchor < 0:
+		return fmt.Errorf("invalid number of passive assets %d",
+			c.PassiveAssetsPerAnchor)
+
+	case c.BlockInterval < 0:
+		return fmt.Errorf("invalid block interval %v", c.BlockInterval)
+	}
+
+	return nil
+}
+
+// LatencyStats summarizes a set of latency samples.
+type LatencyStats struct {
+	// Samples is the number of samples.
+	Samples int
+
+	// P50 is the median latency.
+	P50 time.Duration
+
+	// P90 is the 90th percentile latency.
+	P90 time.Duration
+
+	// P99 is the 99th percentile latency.
+	P99 time.Duration
+
+	// Max is the highest latency.
+	Max time.Duration
+}
+
+// newLatencyStats computes the nearest-rank percentiles of the given samples.
+func newLatencyStats(samples []time.Duration) LatencyStats {
+	if len(samples) == 0 {
+		return LatencyStats{}
+	}
+
+	sorted := make([]time.Duration, len(samples))
+	copy(sorted, samples)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	percentile := func(p float64) time.Duration {
+		rank := int(math.Ceil(p * float64(len(sorted))))
+		return sorted[rank-1]
+	}
+
+	return LatencyStats{
+		Samples: len(sorted),
+		P50:     percentile(0.5),
+		P90:     percentile(0.9),
+		P99:     percentile(0.99),
+		Max:     sorted[len(sorted)-1],
+	}
+}
+
+// LoadReport is the result of a load test.
+type LoadReport struct {
+	// NumParcels is the number of parcels that were sent and confirmed.
+	NumParcels int
+
+	// Duration is the time from the first parcel request until the
+	// delivery of the last parcel was confirmed.
+	Duration time.Duration
+
+	// QueueWait is the time from a parcel request until the porter
+	// started executing the first state of the parcel.
+	QueueWait LatencyStats
+
+	// States is the latency of each state of the send state machine, from
+	// the start of the state until the start of the next state. The last
+	// state is measured until the delivery of the parcel was confirmed.
+	States map[tapfreighter.SendState]LatencyStats
+
+	// EndToEnd is the time from a parcel request until the delivery of
+	// the parcel was confirmed.
+	EndToEnd LatencyStats
+}
+
+// Throughput returns the number of parcels that were completed per second.
+func (r *LoadReport) Throughput() float64 {
+	if r.Duration == 0 {
+		return 0
+	}
+
+	return float64(r.NumParcels) / r.Duration.Seconds()
+}
+
+// String returns a table of the latencies of the report.
+func (r *LoadReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d parcels in %v (%.2f parcels/s)\n", r.NumParcels,
+		r.Duration, r.Throughput())
+
+	row := func(name string, stats LatencyStats) {
+		fmt.Fprintf(&b, "%-34s %6d %10v %10v %10v %10v\n", name,
+			stats.Samples, stats.P50, stats.P90, stats.P99,
+			stats.Max)
+	}
+	fmt.Fprintf(&b, "%-34s %6s %10s %10s %10s %10s\n", "stage", "n",
+		"p50", "p90", "p99", "max")
+
+	row("Queued", r.QueueWait)
+
+	states := make([]tapfreighter.SendState, 0, len(r.States))
+	for state := range r.States {
+		states = append(states, state)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i] < states[j]
+	})
+	for _, state := range states {
+		row(state.String(), r.States[state])
+	}
+
+	row("EndToEnd", r.EndToEnd)
+
+	return b.String()
+}
+
+// sendResult is the outcome of a single parcel request of a load test.
+type sendResult struct {
+	// requested is the time the parcel was requested.
+	requested time.Time
+
+	// parcelID is the ID the porter assigned to the parcel.
+	parcelID uint64
+
+	// anchorTxid is the hash of the anchor transaction of the parcel.
+	anchorTxid chainhash.Hash
+
+	// err is the error the request failed with, if any.
+	err error
+}
+
+// RunLoad sends parcels through the chain porter of the sandbox at the rate
+// of the given config and reports the latencies of the individual states of
+// the send state machine. The assets of all parcels are minted before the
+// first parcel is requested, and blocks are mined in the background, so the
+// latencies only include the work of the porter and its backends. The sandbox
+// must be started.
+func (s *Sandbox) RunLoad(ctx context.Context,
+	cfg LoadConfig) (*LoadReport, error) {
+
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	blockInterval := cfg.BlockInterval
+	if blockInterval == 0 {
+		blockInterval = DefaultLoadBlockInterval
+	}
+
+	parcelAddrs := make([][]*address.Tap, cfg.NumParcels)
+	for idx := range parcelAddrs {
+		addrs, err := s.prepareLoadParcel(ctx, idx, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("unable to prepare parcel %d: "+
+				"%w", idx, err)
+		}
+		parcelAddrs[idx] = addrs
+	}
+
+	// The state events of the porter tell us when each parcel entered
+	// each state.
+	events := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
+	err := s.ChainPorter.RegisterSubscriber(events, false, false)
+	if err != nil {
+		return nil, err
+	}
+	collector := newStateCollector()
+
+	loadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+		_ = s.ChainPorter.RemoveSubscriber(events)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		collector.collect(loadCtx, events)
+	}()
+
+	mineErrs := make(chan error, 1)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.mineBlocks(loadCtx, blockInterval, mineErrs)
+	}()
+
+	// Parcels are requested at a fixed rate, so the porter is loaded the
+	// same way regardless of how long the previous parcels take.
+	var interval time.Duration
+	if cfg.ParcelsPerSecond > 0 {
+		interval = time.Duration(
+			float64(time.Second) / cfg.ParcelsPerSecond,
+		)
+	}
+	start := time.Now()
+	results := make(chan sendResult, cfg.NumParcels)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for idx := range parcelAddrs {
+			next := start.Add(time.Duration(idx) * interval)
+			wait := time.Until(next)
+			select {
+			case <-time.After(wait):
+			case <-loadCtx.Done():
+				return
+			}
+
+			wg.Add(1)
+			go func(addrs []*address.Tap) {
+				defer wg.Done()
+				results <- s.sendLoadParcel(addrs)
+			}(parcelAddrs[idx])
+		}
+	}()
+
+	// We're done once every parcel was requested, its delivery was
+	// confirmed and we received the event of its final state. The events
+	// are delivered asynchronously, so they can lag behind the delivery.
+	sent := make([]sendResult, 0, cfg.NumParcels)
+	done := func() bool {
+		return len(sent) == cfg.NumParcels &&
+			s.deliveries.allConfirmed(sent) &&
+			collector.allCompleted(sent)
+	}
+	for !done() {
+		select {
+		case result := <-results:
+			if result.err != nil {
+				return nil, fmt.Errorf("unable to send "+
+					"parcel: %w", result.err)
+			}
+			sent = append(sent, result)
+
+		case <-s.deliveries.updates:
+
+		case <-collector.updates:
+
+		case err := <-mineErrs:
+			return nil, fmt.Errorf("unable to mine block: %w", err)
+
+		case err := <-s.errChan:
+			return nil, fmt.Errorf("chain porter failed: %w", err)
+
+		case <-ctx.Done():
+			return nil, fmt.Errorf("load test incomplete, %d "+
+				"of %d parcels sent: %w", len(sent),
+				cfg.NumParcels, ctx.Err())
+		}
+	}
+
+	cancel()
+	wg.Wait()
+
+	return s.loadReport(sent, collector, start), nil
+}
+
+// prepareLoadParcel mints the assets of a single parcel of a load test and
+// creates the receiver addresses the parcel pays.
+func (s *Sandbox) prepareLoadParcel(ctx context.Context, parcelIdx int,
+	cfg LoadConfig) ([]*address.Tap, error) {
+
+	// The first asset is sent, leaving one unit of change. All other
+	// assets in the same anchor output are passive.
+	reqs := make([]mintRequest, 1+cfg.PassiveAssetsPerAnchor)
+	for idx := range reqs {
+		reqs[idx] = mintRequest{
+			assetType: asset.Normal,
+			tag:       fmt.Sprintf("load-%d-%d", parcelIdx, idx),
+			amount:    1,
+		}
+	}
+	reqs[0].amount = uint64(cfg.OutputsPerParcel) + 1
+
+	assets, err := s.mintAssets(ctx, reqs...)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]*address.Tap, cfg.OutputsPerParcel)
+	for idx := range addrs {
+		addrs[idx], err = s.NewReceiverAddr(assets[0].Genesis, 1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return addrs, nil
+}
+
+// sendLoadParcel requests a parcel to the given addresses. It returns once the
+// anchor transaction of the parcel is published.
+func (s *Sandbox) sendLoadParcel(addrs []*address.Tap) sendResult {
+	result := sendResult{
+		requested: time.Now(),
+	}
+
+	parcel := tapfreighter.NewAddressParcel(addrs...)
+	outboundParcel, err := s.ChainPorter.RequestShipment(parcel)
+	if err != nil {
+		result.err = err
+		return result
+	}
+
+	result.parcelID = parcel.ParcelID()
+	result.anchorTxid = outboundParcel.AnchorTx.TxHash()
+
+	return result
+}
+
+// mineBlocks mines a block at the given interval whenever there are
+// unconfirmed transactions, until the context is canceled.
+func (s *Sandbox) mineBlocks(ctx context.Context, interval time.Duration,
+	errs chan<- error) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if len(s.Chain.MempoolTxns()) == 0 {
+				continue
+			}
+
+			if _, err := s.MineBlock(); err != nil {
+				errs <- err
+				return
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// loadReport computes the report of a load test from the results of the
+// parcel requests, the state events of the parcels and the time their
+// delivery was confirmed.
+func (s *Sandbox) loadReport(sent []sendResult, collector *stateCollector,
+	start time.Time) *LoadReport {
+
+	var (
+		queueWait  []time.Duration
+		endToEnd   []time.Duration
+		stateTimes = make(map[tapfreighter.SendState][]time.Duration)
+		end        = start
+	)
+	for _, result := range sent {
+		confirmed, _ := s.deliveries.confirmedAt(result.anchorTxid)
+		if confirmed.After(end) {
+			end = confirmed
+		}
+		endToEnd = append(endToEnd, confirmed.Sub(result.requested))
+
+		events := collector.events(result.parcelID)
+		if len(events) == 0 {
+			continue
+		}
+		queueWait = append(
+			queueWait, events[0].Timestamp().Sub(result.requested),
+		)
+
+		for idx, event := range events {
+			stateEnd := confirmed
+			if idx+1 < len(events) {
+				stateEnd = events[idx+1].Timestamp()
+			}
+
+			stateTimes[event.SendState] = append(
+				stateTimes[event.SendState],
+				stateEnd.Sub(event.Timestamp()),
+			)
+		}
+	}
+
+	states := make(map[tapfreighter.SendState]LatencyStats, len(stateTimes))
+	for state, samples := range stateTimes {
+		states[state] = newLatencyStats(samples)
+	}
+
+	return &LoadReport{
+		NumParcels: len(sent),
+		Duration:   end.Sub(start),
+		QueueWait:  newLatencyStats(queueWait),
+		States:     states,
+		EndToEnd:   newLatencyStats(endToEnd),
+	}
+}
+
+// stateCollector collects the send state events of the chain porter, grouped
+// by parcel.
+type stateCollector struct {
+	mtx sync.Mutex
+
+	// parcels holds the state events of each parcel, ordered by their
+	// sequence number.
+	parcels map[uint64][]*tapfreighter.ExecuteSendStateEvent
+
+	// updates is signaled whenever an event was collected.
+	updates chan struct{}
+}
+
+// newStateCollector creates a new, empty state collector.
+func newStateCollector() *stateCollector {
+	return &stateCollector{
+		parcels: make(
+			map[uint64][]*tapfreighter.ExecuteSendStateEvent,
+		),
+		updates: make(chan struct{}, 1),
+	}
+}
+
+// collect adds the send state events received by the given receiver until the
+// context is canceled.
+func (c *stateCollector) collect(ctx context.Context,
+	receiver *fn.EventReceiver[fn.Event]) {
+
+	for {
+		select {
+		case event := <-receiver.NewItemCreated.ChanOut():
+			event, _ = fn.UnwrapDroppedHistory(event)
+			e, ok := event.(*tapfreighter.ExecuteSendStateEvent)
+			if !ok {
+				continue
+			}
+
+			c.mtx.Lock()
+			c.parcels[e.ParcelID] = append(c.parcels[e.ParcelID], e)
+			c.mtx.Unlock()
+
+			select {
+			case c.updates <- struct{}{}:
+			default:
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// events returns the state events of the given parcel, ordered by their
+// sequence number.
+func (c *stateCollector) events(
+	parcelID uint64) []*tapfreighter.ExecuteSendStateEvent {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	events := c.parcels[parcelID]
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].SeqNum < events[j].SeqNum
+	})
+
+	return events
+}
+
+// allCompleted returns true if the event of the final state of all given
+// parcels was collected.
+func (c *stateCollector) allCompleted(sent []sendResult) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	for _, result := range sent {
+		completed := false
+		for _, event := range c.parcels[result.parcelID] {
+			final := tapfreighter.SendStateReceiverProofTransfer
+			if event.SendState == final {
+				completed = true
+				break
+			}
+		}
+
+		if !completed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// deliveryRecorder is a tapfreighter.DeliveryLog that records when the
+// delivery of each parcel was confirmed.
+type deliveryRecorder struct {
+	tapfreighter.DeliveryLog
+
+	mtx sync.Mutex
+
+	// confirmed is the time the delivery of each parcel was confirmed,
+	// keyed by the hash of its anchor transaction.
+	confirmed map[chainhash.Hash]time.Time
+
+	// updates is signaled whenever a delivery was confirmed.
+	updates chan struct{}
+}
+
+// newDeliveryRecorder creates a new delivery recorder that confirms the
+// deliveries in the given delivery log.
+func newDeliveryRecorder(
+	deliveryLog tapfreighter.DeliveryLog) *deliveryRecorder {
+
+	return &deliveryRecorder{
+		DeliveryLog: deliveryLog,
+		confirmed:   make(map[chainhash.Hash]time.Time),
+		updates:     make(chan struct{}, 1),
+	}
+}
+
+// ConfirmParcelDelivery confirms the delivery in the underlying delivery log
+// and records the time of the confirmation.
+//
+// NOTE: This is part of the tapfreighter.DeliveryLog interface.
+func (d *deliveryRecorder) ConfirmParcelDelivery(ctx context.Context,
+	event *tapfreighter.AssetConfirmEvent) error {
+
+	err := d.DeliveryLog.ConfirmParcelDelivery(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	d.mtx.Lock()
+	d.confirmed[event.AnchorTXID] = time.Now()
+	d.mtx.Unlock()
+
+	select {
+	case d.updates <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// confirmedAt returns the time the delivery of the parcel with the given
+// anchor transaction was confirmed.
+func (d *deliveryRecorder) confirmedAt(
+	anchorTxid chainhash.Hash) (time.Time, bool) {
+
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	confirmed, ok := d.confirmed[anchorTxid]
+	return confirmed, ok
+}
+
+// allConfirmed returns true if the delivery of all given parcels was
+// confirmed.
+func (d *deliveryRecorder) allConfirmed(sent []sendResult) bool {
+	for _, result := range sent {
+		if _, ok := d.confirmedAt(result.anchorTxid); !ok {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,477 @@
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	tap "github.com/lightninglabs/taproot-assets"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapdb"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+const (
+	// dbFileName is the name of the sandbox database file.
+	dbFileName = "sandbox.db"
+
+	// pollInterval is the interval at which the sandbox polls for the
+	// completion of a transfer.
+	pollInterval = 50 * time.Millisecond
+)
+
+// Sandbox runs the full send state machine of the ChainPorter against a
+// simulated chain and wallet. Transfers produce a real anchor transaction and
+// real proofs that verify against the simulated chain, without touching any
+// chain backend or lnd node. This allows downstream systems to exercise their
+// integration with asset transfers in tests, without setting up a regtest
+// network.
+//
+// The sandbox uses the regtest chain parameters. Assets and transfers are
+// stored in a sqlite database in the directory the sandbox is created with.
+type Sandbox struct {
+	// Chain is the simulated chain transfers are anchored in. Blocks are
+	// only mined with MineBlock.
+	Chain *SimChain
+
+	// Wallet is the simulated BTC wallet that funds and signs the anchor
+	// transactions.
+	Wallet *SimWallet
+
+	// KeyRing holds all keys of the sandbox.
+	KeyRing *KeyRing
+
+	// ChainParams are the Taproot Asset chain parameters of the sandbox.
+	ChainParams *address.ChainParams
+
+	// AssetStore is the database of the assets and transfers of the
+	// sandbox.
+	AssetStore *tapdb.AssetStore
+
+	// AddrBook is the database of the keys of the sandbox.
+	AddrBook *tapdb.TapAddressBook
+
+	// ProofFiles is the archive of all proof files of the sandbox.
+	ProofFiles *proof.FileArchiver
+
+	// AssetWallet is the asset wallet that funds and signs the virtual
+	// transactions.
+	AssetWallet *tapfreighter.AssetWallet
+
+	// ChainPorter is the porter that executes transfers.
+	ChainPorter *tapfreighter.ChainPorter
+
+	// db is the sandbox database.
+	db *tapdb.SqliteStore
+
+	// proofArchive imports proofs into both the file archive and the
+	// asset store, after verifying them.
+	proofArchive *proof.MultiArchiver
+
+	// deliveries records when the delivery of each parcel is confirmed.
+	deliveries *deliveryRecorder
+
+	// errChan receives the errors of the chain porter.
+	errChan chan error
+}
+
+// New creates a new sandbox that stores its database and proof files in the
+// given directory.
+func New(dir string) (*Sandbox, error) {
+	db, err := tapdb.NewSqliteStore(&tapdb.SqliteConfig{
+		DatabaseFileName: filepath.Join(dir, dbFileName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to open database: %w", err)
+	}
+
+	defaultClock := clock.NewDefaultClock()
+	assetDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.ActiveAssetsStore {
+			return db.WithTx(tx)
+		},
+	)
+	addrBookDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.AddrBook {
+			return db.WithTx(tx)
+		},
+	)
+
+	chainParams := &address.RegressionNetTap
+	addrBook := tapdb.NewTapAddressBook(
+		addrBookDB, chainParams, defaultClock,
+	)
+	assetStore := tapdb.NewAssetStore(assetDB, defaultClock)
+
+	proofFiles, err := proof.NewFileArchiver(dir)
+	if err != nil {
+		_ = db.DB.Close()
+		return nil, fmt.Errorf("unable to open proof archive: %w", err)
+	}
+	proofArchive := proof.NewMultiArchiver(
+		&proof.BaseVerifier{}, tapdb.DefaultStoreTimeout, assetStore,
+		proofFiles,
+	)
+
+	keyRing := NewKeyRing()
+	signer := NewSigner(keyRing)
+	chain := NewSimChain(chainParams.Params)
+	wallet := NewSimWallet(keyRing, chainParams.Params)
+
+	assetWallet := tapfreighter.NewAssetWallet(&tapfreighter.WalletConfig{
+		CoinSelector: tapfreighter.NewCoinSelect(assetStore),
+		AssetProofs:  proofArchive,
+		AddrBook:     addrBook,
+		KeyRing:      keyRing,
+		Signer:       signer,
+		TxValidator:  &tap.ValidatorV0{},
+		Wallet:       wallet,
+		ChainParams:  chainParams,
+	})
+
+	deliveries := newDeliveryRecorder(assetStore)
+	errChan := make(chan error, 1)
+	chainPorter := tapfreighter.NewChainPorter(
+		&tapfreighter.ChainPorterConfig{
+			Signer:           signer,
+			TxValidator:      &tap.ValidatorV0{},
+			TransferLog:      assetStore,
+			PendingParcels:   assetStore,
+			DeliveryLog:      deliveries,
+			ConfirmedParcels: assetStore,
+			ParcelRequests:   assetStore,
+			ChainBridge:      chain,
+			Wallet:           wallet,
+			KeyRing:          keyRing,
+			AssetWallet:      assetWallet,
+			AssetProofs:      proofFiles,
+			ProofWatcher:     &noopWatcher{},
+			ErrChan:          errChan,
+		},
+	)
+
+	return &Sandbox{
+		Chain:        chain,
+		Wallet:       wallet,
+		KeyRing:      keyRing,
+		ChainParams:  chainParams,
+		AssetStore:   assetStore,
+		AddrBook:     addrBook,
+		ProofFiles:   proofFiles,
+		AssetWallet:  assetWallet,
+		ChainPorter:  chainPorter,
+		db:           db,
+		proofArchive: proofArchive,
+		deliveries:   deliveries,
+		errChan:      errChan,
+	}, nil
+}
+
+// Start starts the chain porter of the sandbox.
+func (s *Sandbox) Start() error {
+	return s.ChainPorter.Start()
+}
+
+// Stop stops the chain porter and closes the database of the sandbox.
+func (s *Sandbox) Stop() error {
+	if err := s.ChainPorter.Stop(); err != nil {
+		return err
+	}
+
+	return s.db.DB.Close()
+}
+
+// HeaderVerifier returns a header verifier that checks block headers against
+// the simulated chain.
+func (s *Sandbox) HeaderVerifier(ctx context.Context) proof.HeaderVerifier {
+	return tapgarden.GenHeaderVerifier(ctx, s.Chain)
+}
+
+// MintAsset mints a new asset with the given genesis parameters to a new
+// script key of the sandbox. The minting transaction is confirmed in a new
+// block and the genesis proof is imported, so the asset can be sent right
+// away.
+func (s *Sandbox) MintAsset(ctx context.Context, assetType asset.Type,
+	tag string, amount uint64) (*asset.Asset, error) {
+
+	assets, err := s.mintAssets(ctx, mintRequest{
+		assetType: assetType,
+		tag:       tag,
+		amount:    amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return assets[0], nil
+}
+
+// mintRequest describes a single asset that is minted by mintAssets.
+type mintRequest struct {
+	assetType asset.Type
+	tag       string
+	amount    uint64
+}
+
+// mintAssets mints the requested assets into a single anchor output, each to
+// a new script key of the sandbox. The minting transaction is confirmed in a
+// new block and the genesis proofs are imported, so the assets can be sent
+// right away.
+func (s *Sandbox) mintAssets(ctx context.Context,
+	reqs ...mintRequest) ([]*asset.Asset, error) {
+
+	var genesisTxid chainhash.Hash
+	if _, err := rand.Read(genesisTxid[:]); err != nil {
+		return nil, fmt.Errorf("unable to create genesis point: %w",
+			err)
+	}
+	genesisPoint := wire.OutPoint{Hash: genesisTxid}
+
+	internalKeyDesc, err := s.KeyRing.DeriveNextKey(
+		ctx, asset.TaprootAssetsKeyFamily,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	newAssets := make([]*asset.Asset, len(reqs))
+	for idx, req := range reqs {
+		scriptKeyDesc, err := s.KeyRing.DeriveNextKey(
+			ctx, asset.TaprootAssetsKeyFamily,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		newAssets[idx], err = asset.New(
+			asset.Genesis{
+				FirstPrevOut: genesisPoint,
+				Tag:          req.tag,
+				OutputIndex:  0,
+				Type:         req.assetType,
+			}, req.amount, 0, 0,
+			asset.NewScriptKeyBip86(scriptKeyDesc), nil,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create asset: %w",
+				err)
+		}
+	}
+
+	tapCommitment, err := commitment.FromAssets(newAssets...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create commitment: %w", err)
+	}
+	pkScript, err := tapscript.PayToAddrScript(
+		*internalKeyDesc.PubKey, nil, *tapCommitment,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create anchor script: %w",
+			err)
+	}
+
+	mintTx := wire.NewMsgTx(2)
+	mintTx.AddTxIn(&wire.TxIn{PreviousOutPoint: genesisPoint})
+	mintTx.AddTxOut(&wire.TxOut{
+		Value:    int64(tapscript.DummyAmtSats),
+		PkScript: pkScript,
+	})
+	if err := s.Chain.PublishTransaction(ctx, mintTx); err != nil {
+		return nil, err
+	}
+
+	block, err := s.Chain.MineBlock()
+	if err != nil {
+		return nil, err
+	}
+	height, err := s.Chain.CurrentHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mintTxid := mintTx.TxHash()
+	txIndex := -1
+	for idx, tx := range block.Transactions {
+		if tx.TxHash() == mintTxid {
+			txIndex = idx
+			break
+		}
+	}
+	if txIndex == -1 {
+		return nil, fmt.Errorf("minting tx %v not mined", mintTxid)
+	}
+
+	headerVerifier := s.HeaderVerifier(ctx)
+	mintProofs, err := proof.NewMintingBlobs(&proof.MintParams{
+		BaseProofParams: proof.BaseProofParams{
+			Block:            block,
+			BlockHeight:      height,
+			Tx:               mintTx,
+			TxIndex:          txIndex,
+			OutputIndex:      0,
+			InternalKey:      internalKeyDesc.PubKey,
+			TaprootAssetRoot: tapCommitment,
+		},
+		GenesisPoint: genesisPoint,
+	}, headerVerifier)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create minting proof: %w",
+			err)
+	}
+
+	// The keys need to be known with their key locators before the proofs
+	// are imported, otherwise the asset wallet can't sign for the assets.
+	err = s.AddrBook.InsertInternalKey(ctx, internalKeyDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, newAsset := range newAssets {
+		scriptKey := newAsset.ScriptKey
+		mintProof, ok := mintProofs[asset.ToSerialized(
+			scriptKey.PubKey,
+		)]
+		if !ok {
+			return nil, fmt.Errorf("minting proof not found")
+		}
+		proofBlob, err := proof.EncodeAsProofFile(mintProof)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.AddrBook.InsertScriptKey(ctx, scriptKey)
+		if err != nil {
+			return nil, err
+		}
+
+		assetID := newAsset.ID()
+		err = s.proofArchive.ImportProofs(
+			ctx, headerVerifier, false, &proof.AnnotatedProof{
+				Locator: proof.Locator{
+					AssetID:   &assetID,
+					ScriptKey: *scriptKey.PubKey,
+				},
+				Blob: proofBlob,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to import minting "+
+				"proof: %w", err)
+		}
+	}
+
+	return newAssets, nil
+}
+
+// NewReceiverAddr creates a new address for the given asset and amount that
+// belongs to a receiver outside of the sandbox.
+func (s *Sandbox) NewReceiverAddr(genesis asset.Genesis,
+	amount uint64) (*address.Tap, error) {
+
+	rawScriptKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	internalKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	// Like a real receiver, we use a BIP-0086 script key.
+	scriptKey := asset.NewScriptKeyBip86(keychain.KeyDescriptor{
+		PubKey: rawScriptKey.PubKey(),
+	})
+
+	return address.New(
+		genesis, nil, nil, *scriptKey.PubKey, *internalKey.PubKey(),
+		amount, nil, s.ChainParams,
+	)
+}
+
+// Send requests a transfer to the given addresses. It returns once the anchor
+// transaction is published to the simulated chain.
+func (s *Sandbox) Send(ctx context.Context,
+	addrs ...*address.Tap) (*tapfreighter.OutboundParcel, error) {
+
+	return s.ChainPorter.RequestShipment(
+		tapfreighter.NewAddressParcel(addrs...),
+	)
+}
+
+// MineBlock mines a new block that confirms all published transactions.
+func (s *Sandbox) MineBlock() (*wire.MsgBlock, error) {
+	return s.Chain.MineBlock()
+}
+
+// WaitForTransfer waits until the transfer with the given anchor transaction
+// is complete, which requires a block to be mined that confirms the anchor
+// transaction.
+func (s *Sandbox) WaitForTransfer(ctx context.Context,
+	anchorTxid chainhash.Hash) error {
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		parcels, err := s.AssetStore.PendingParcels(ctx)
+		if err != nil {
+			return err
+		}
+
+		pending := false
+		for _, parcel := range parcels {
+			if parcel.AnchorTx.TxHash() == anchorTxid {
+				pending = true
+				break
+			}
+		}
+		if !pending {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+
+		case err := <-s.errChan:
+			return fmt.Errorf("chain porter failed: %w", err)
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// FetchProofFile returns the proof file of the asset with the given ID and
+// script key.
+func (s *Sandbox) FetchProofFile(ctx context.Context, assetID asset.ID,
+	scriptKey *btcec.PublicKey) (*proof.File, error) {
+
+	blob, err := s.ProofFiles.FetchProof(ctx, proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *scriptKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	proofFile := proof.NewEmptyFile(proof.V0)
+	if err := proofFile.Decode(bytes.NewReader(blob)); err != nil {
+		return nil, fmt.Errorf("unable to decode proof file: %w", err)
+	}
+
+	return proofFile, nil
+}
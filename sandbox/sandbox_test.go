@@ -0,0 +1,159 @@
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// defaultTimeout is the timeout of a single transfer in the tests.
+	defaultTimeout = 30 * time.Second
+)
+
+// TestSandboxTransfer tests that a transfer runs through the full send state
+// machine against the simulated chain and produces valid proofs.
+func TestSandboxTransfer(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	sb, err := New(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, sb.Start())
+	t.Cleanup(func() {
+		require.NoError(t, sb.Stop())
+	})
+
+	const (
+		mintAmt = 1000
+		sendAmt = 400
+	)
+	mintedAsset, err := sb.MintAsset(ctx, asset.Normal, "sandbox", mintAmt)
+	require.NoError(t, err)
+
+	addr, err := sb.NewReceiverAddr(mintedAsset.Genesis, sendAmt)
+	require.NoError(t, err)
+
+	parcel, err := sb.Send(ctx, addr)
+	require.NoError(t, err)
+
+	// The anchor transaction only confirms once a block is mined.
+	anchorTxid := parcel.AnchorTx.TxHash()
+	mempool := sb.Chain.MempoolTxns()
+	require.Len(t, mempool, 1)
+	require.Equal(t, anchorTxid, mempool[0].TxHash())
+
+	_, err = sb.MineBlock()
+	require.NoError(t, err)
+	require.NoError(t, sb.WaitForTransfer(ctx, anchorTxid))
+
+	// Both the receiver's and the change output's proof must verify
+	// against the simulated chain.
+	require.Len(t, parcel.Outputs, 2)
+	var changeAmt uint64
+	for _, out := range parcel.Outputs {
+		proofFile, err := sb.FetchProofFile(
+			ctx, mintedAsset.ID(), out.ScriptKey.PubKey,
+		)
+		require.NoError(t, err)
+		require.Equal(t, 2, proofFile.NumProofs())
+
+		snapshot, err := proofFile.Verify(ctx, sb.HeaderVerifier(ctx))
+		require.NoError(t, err)
+		require.Equal(t, anchorTxid, snapshot.AnchorTx.TxHash())
+		require.Equal(t, out.Amount, snapshot.Asset.Amount)
+
+		if out.ScriptKeyLocal {
+			changeAmt = out.Amount
+		}
+	}
+	require.EqualValues(t, mintAmt-sendAmt, changeAmt)
+
+	// The proofs can be rebuilt from the stored transfer and match the
+	// ones created during the transfer.
+	regenProofs, err := sb.ChainPorter.RegenerateTransferProofs(
+		ctx, anchorTxid,
+	)
+	require.NoError(t, err)
+	require.Len(t, regenProofs, len(parcel.Outputs))
+	for _, regenProof := range regenProofs {
+		proofFile, err := sb.FetchProofFile(
+			ctx, mintedAsset.ID(), &regenProof.Locator.ScriptKey,
+		)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, proofFile.Encode(&buf))
+		require.Equal(t, buf.Bytes(), []byte(regenProof.Blob))
+	}
+
+	// The change is available for another transfer.
+	addr, err = sb.NewReceiverAddr(mintedAsset.Genesis, changeAmt)
+	require.NoError(t, err)
+
+	parcel, err = sb.Send(ctx, addr)
+	require.NoError(t, err)
+
+	_, err = sb.MineBlock()
+	require.NoError(t, err)
+	require.NoError(t, sb.WaitForTransfer(ctx, parcel.AnchorTx.TxHash()))
+}
+
+// TestSandboxLoad tests that a load test sends all parcels through the send
+// state machine and reports the latency of each state.
+func TestSandboxLoad(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	sb, err := New(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, sb.Start())
+	t.Cleanup(func() {
+		require.NoError(t, sb.Stop())
+	})
+
+	cfg := LoadConfig{
+		NumParcels:             4,
+		ParcelsPerSecond:       20,
+		OutputsPerParcel:       2,
+		PassiveAssetsPerAnchor: 2,
+		BlockInterval:          20 * time.Millisecond,
+	}
+	report, err := sb.RunLoad(ctx, cfg)
+	require.NoError(t, err)
+	t.Log(report)
+
+	require.Equal(t, cfg.NumParcels, report.NumParcels)
+	require.Equal(t, cfg.NumParcels, report.EndToEnd.Samples)
+	require.Equal(t, cfg.NumParcels, report.QueueWait.Samples)
+	require.Positive(t, report.Throughput())
+
+	states := []tapfreighter.SendState{
+		tapfreighter.SendStateVirtualCommitmentSelect,
+		tapfreighter.SendStateVirtualSign,
+		tapfreighter.SendStateAnchorSign,
+		tapfreighter.SendStateLogCommit,
+		tapfreighter.SendStateBroadcast,
+		tapfreighter.SendStateWaitTxConf,
+		tapfreighter.SendStateStoreProofs,
+		tapfreighter.SendStateReceiverProofTransfer,
+	}
+	for _, state := range states {
+		stats := report.States[state]
+		require.Equal(t, cfg.NumParcels, stats.Samples, state.String())
+		require.LessOrEqual(t, stats.P50, stats.Max)
+	}
+
+	// An invalid config is rejected before anything is minted.
+	_, err = sb.RunLoad(ctx, LoadConfig{NumParcels: 1})
+	require.Error(t, err)
+}
@@ -0,0 +1,273 @@
+package sandbox
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// walletKeyFamily is the key family of the keys the simulated wallet
+	// uses for its own BTC inputs and change outputs.
+	walletKeyFamily = keychain.KeyFamily(86)
+
+	// simCoinValue is the value of each coin the simulated wallet funds a
+	// transaction with.
+	simCoinValue = btcutil.SatoshiPerBitcoin
+)
+
+// SimWallet is an in-memory tapfreighter.WalletAnchor. It has an unlimited
+// balance: each funding request is served from a new coin that only exists in
+// the simulated wallet. Inputs are signed with the keys of the wallet's key
+// ring, which also holds the asset level keys of the sandbox, so both the
+// wallet's own inputs and the anchor inputs of assets get valid key spend
+// signatures.
+type SimWallet struct {
+	mtx sync.Mutex
+
+	// keyRing holds the keys the wallet signs with.
+	keyRing *KeyRing
+
+	// params are the chain parameters of the wallet.
+	params *chaincfg.Params
+
+	// importedKeys are the Taproot output keys imported into the wallet.
+	importedKeys map[string]*btcec.PublicKey
+}
+
+// NewSimWallet creates a new simulated wallet that signs with the keys of the
+// given key ring.
+func NewSimWallet(keyRing *KeyRing, params *chaincfg.Params) *SimWallet {
+	return &SimWallet{
+		keyRing:      keyRing,
+		params:       params,
+		importedKeys: make(map[string]*btcec.PublicKey),
+	}
+}
+
+// FundPsbt adds a new coin of the wallet as input to the target PSBT packet
+// and adds a P2TR change output for the amount that isn't spent on outputs and
+// fees.
+//
+// NOTE: This is part of the tapgarden.WalletAnchor interface.
+func (w *SimWallet) FundPsbt(ctx context.Context, packet *psbt.Packet,
+	_ uint32, feeRate chainfee.SatPerKWeight) (tapgarden.FundedPsbt,
+	error) {
+
+	coinKey, err := w.keyRing.DeriveNextKey(ctx, walletKeyFamily)
+	if err != nil {
+		return tapgarden.FundedPsbt{}, err
+	}
+	changeKey, err := w.keyRing.DeriveNextKey(ctx, walletKeyFamily)
+	if err != nil {
+		return tapgarden.FundedPsbt{}, err
+	}
+
+	coinScript, err := bip86Script(coinKey.PubKey)
+	if err != nil {
+		return tapgarden.FundedPsbt{}, err
+	}
+	changeScript, err := bip86Script(changeKey.PubKey)
+	if err != nil {
+		return tapgarden.FundedPsbt{}, err
+	}
+
+	var coinTxid [32]byte
+	if _, err := rand.Read(coinTxid[:]); err != nil {
+		return tapgarden.FundedPsbt{}, fmt.Errorf("unable to create "+
+			"coin: %w", err)
+	}
+	coin := wire.OutPoint{Hash: coinTxid}
+
+	pkt := packet.UnsignedTx
+	pkt.AddTxIn(&wire.TxIn{PreviousOutPoint: coin})
+	packet.Inputs = append(packet.Inputs, psbt.PInput{
+		WitnessUtxo: &wire.TxOut{
+			Value:    simCoinValue,
+			PkScript: coinScript,
+		},
+		TaprootInternalKey: schnorr.SerializePubKey(coinKey.PubKey),
+	})
+
+	var (
+		weightEstimator input.TxWeightEstimator
+		outputAmt       int64
+	)
+	for range pkt.TxIn {
+		weightEstimator.AddTaprootKeySpendInput(txscript.SigHashDefault)
+	}
+	for _, txOut := range pkt.TxOut {
+		weightEstimator.AddP2TROutput()
+		outputAmt += txOut.Value
+	}
+	weightEstimator.AddP2TROutput()
+	fee := int64(feeRate.FeeForWeight(int64(weightEstimator.Weight())))
+
+	changeIndex := len(pkt.TxOut)
+	pkt.AddTxOut(&wire.TxOut{
+		Value:    simCoinValue - outputAmt - fee,
+		PkScript: changeScript,
+	})
+	packet.Outputs = append(packet.Outputs, psbt.POutput{
+		TaprootInternalKey: schnorr.SerializePubKey(changeKey.PubKey),
+	})
+
+	return tapgarden.FundedPsbt{
+		Pkt:               packet,
+		ChangeOutputIndex: int32(changeIndex),
+		ChainFees:         fee,
+		LockedUTXOs:       []wire.OutPoint{coin},
+	}, nil
+}
+
+// SignPsbt adds a key spend signature to all inputs the wallet has the key for
+// and skips all other inputs.
+//
+// NOTE: This is part of the tapfreighter.WalletAnchor interface.
+func (w *SimWallet) SignPsbt(_ context.Context,
+	packet *psbt.Packet) (*psbt.Packet, error) {
+
+	prevOutFetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for idx, txIn := range packet.UnsignedTx.TxIn {
+		witnessUtxo := packet.Inputs[idx].WitnessUtxo
+		if witnessUtxo == nil {
+			return nil, fmt.Errorf("input %d is missing witness "+
+				"utxo", idx)
+		}
+		prevOutFetcher.AddPrevOut(txIn.PreviousOutPoint, witnessUtxo)
+	}
+	sigHashes := txscript.NewTxSigHashes(packet.UnsignedTx, prevOutFetcher)
+
+	for idx := range packet.Inputs {
+		pIn := &packet.Inputs[idx]
+		if len(pIn.FinalScriptWitness) > 0 ||
+			len(pIn.TaprootKeySpendSig) > 0 {
+
+			continue
+		}
+
+		privKey, ok := w.keyRing.privKeyXOnly(pIn.TaprootInternalKey)
+		if !ok {
+			continue
+		}
+
+		sig, err := txscript.RawTxInTaprootSignature(
+			packet.UnsignedTx, sigHashes, idx,
+			pIn.WitnessUtxo.Value, pIn.WitnessUtxo.PkScript,
+			pIn.TaprootMerkleRoot, pIn.SighashType, privKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to sign input %d: %w",
+				idx, err)
+		}
+		pIn.TaprootKeySpendSig = sig
+	}
+
+	return packet, nil
+}
+
+// SignAndFinalizePsbt fully signs and finalizes the target PSBT packet.
+//
+// NOTE: This is part of the tapgarden.WalletAnchor interface.
+func (w *SimWallet) SignAndFinalizePsbt(ctx context.Context,
+	packet *psbt.Packet) (*psbt.Packet, error) {
+
+	signedPkt, err := w.SignPsbt(ctx, packet)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := psbt.MaybeFinalizeAll(signedPkt); err != nil {
+		return nil, fmt.Errorf("unable to finalize psbt: %w", err)
+	}
+
+	return signedPkt, nil
+}
+
+// ImportTaprootOutput imports a new public key into the wallet, as a P2TR
+// output.
+//
+// NOTE: This is part of the tapgarden.WalletAnchor interface.
+func (w *SimWallet) ImportTaprootOutput(_ context.Context,
+	pubKey *btcec.PublicKey) (btcutil.Address, error) {
+
+	addr, err := btcutil.NewAddressTaproot(
+		schnorr.SerializePubKey(pubKey), w.params,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	w.importedKeys[addr.EncodeAddress()] = pubKey
+
+	return addr, nil
+}
+
+// UnlockInput unlocks the set of target inputs after a batch is abandoned.
+//
+// NOTE: This is part of the tapgarden.WalletAnchor interface.
+func (w *SimWallet) UnlockInput(context.Context) error {
+	return nil
+}
+
+// ListUnspentImportScripts lists all UTXOs of the imported Taproot scripts.
+// The simulated wallet doesn't track the UTXOs of imported keys.
+//
+// NOTE: This is part of the tapgarden.WalletAnchor interface.
+func (w *SimWallet) ListUnspentImportScripts(
+	context.Context) ([]*lnwallet.Utxo, error) {
+
+	return nil, nil
+}
+
+// ListTransactions returns all known transactions of the wallet. The simulated
+// wallet doesn't track any transactions.
+//
+// NOTE: This is part of the tapgarden.WalletAnchor interface.
+func (w *SimWallet) ListTransactions(context.Context, int32, int32,
+	string) ([]lndclient.Transaction, error) {
+
+	return nil, nil
+}
+
+// SubscribeTransactions creates a uni-directional stream of newly discovered
+// transactions relevant to the wallet. The simulated wallet never discovers
+// any transactions.
+//
+// NOTE: This is part of the tapgarden.WalletAnchor interface.
+func (w *SimWallet) SubscribeTransactions(
+	context.Context) (<-chan lndclient.Transaction, <-chan error, error) {
+
+	return make(chan lndclient.Transaction), make(chan error), nil
+}
+
+// bip86Script returns the BIP-0086 P2TR output script of the given key.
+func bip86Script(internalKey *btcec.PublicKey) ([]byte, error) {
+	return tapscript.PayToTaprootScript(
+		txscript.ComputeTaprootKeyNoScript(internalKey),
+	)
+}
+
+// A compile-time assertion to ensure SimWallet meets the
+// tapfreighter.WalletAnchor interface.
+var _ tapfreighter.WalletAnchor = (*SimWallet)(nil)
@@ -85,6 +85,7 @@ func (s *Server) initialize(interceptorChain *rpcperms.InterceptorChain) error {
 				MacaroonPath:     s.cfg.MacaroonPath,
 				Checkers: []macaroons.Checker{
 					macaroons.IPLockChecker,
+					customCaveatChecker(),
 				},
 				RequiredPerms: perms.RequiredPermissions,
 			},
@@ -126,7 +127,8 @@ func (s *Server) initialize(interceptorChain *rpcperms.InterceptorChain) error {
 	// exported by the rpcServer.
 	var err error
 	s.rpcServer, err = newRPCServer(
-		s.cfg.SignalInterceptor, interceptorChain, s.cfg,
+		s.cfg.SignalInterceptor, interceptorChain, s.macaroonService,
+		s.cfg,
 	)
 	if err != nil {
 		return fmt.Errorf("unable to create rpc server: %v", err)
@@ -137,11 +139,26 @@ func (s *Server) initialize(interceptorChain *rpcperms.InterceptorChain) error {
 		return fmt.Errorf("unable to start asset minter: %v", err)
 	}
 
+	// We start the webhook notifier before the custodian and the porter,
+	// so it doesn't miss any of their events.
+	if err := s.cfg.WebhookNotifier.Start(); err != nil {
+		return fmt.Errorf("unable to start webhook notifier: %v", err)
+	}
+
 	// Next, we'll start the asset custodian.
 	if err := s.cfg.AssetCustodian.Start(); err != nil {
 		return fmt.Errorf("unable to start asset custodian: %v", err)
 	}
 
+	if err := s.cfg.AssetWatcher.Start(); err != nil {
+		return fmt.Errorf("unable to start asset watcher: %v", err)
+	}
+
+	if err := s.cfg.AnchorSpendMonitor.Start(); err != nil {
+		return fmt.Errorf("unable to start anchor spend monitor: %v",
+			err)
+	}
+
 	if err := s.cfg.ReOrgWatcher.Start(); err != nil {
 		return fmt.Errorf("unable to start re-org watcher: %v", err)
 	}
@@ -150,6 +167,56 @@ func (s *Server) initialize(interceptorChain *rpcperms.InterceptorChain) error {
 		return fmt.Errorf("unable to start chain porter: %v", err)
 	}
 
+	if err := s.cfg.Airdropper.Start(); err != nil {
+		return fmt.Errorf("unable to start airdropper: %v", err)
+	}
+
+	if s.cfg.ProofTiers != nil {
+		if err := s.cfg.ProofTiers.Start(); err != nil {
+			return fmt.Errorf("unable to start tiered proof "+
+				"archive: %v", err)
+		}
+	}
+
+	if s.cfg.ProofScrubber != nil {
+		if err := s.cfg.ProofScrubber.Start(); err != nil {
+			return fmt.Errorf("unable to start proof scrubber: %v",
+				err)
+		}
+	}
+
+	if s.cfg.ScriptKeyAuditor != nil {
+		if err := s.cfg.ScriptKeyAuditor.Start(); err != nil {
+			return fmt.Errorf("unable to start script key "+
+				"auditor: %v", err)
+		}
+	}
+
+	if s.cfg.SupplyReconciler != nil {
+		if err := s.cfg.SupplyReconciler.Start(); err != nil {
+			return fmt.Errorf("unable to start supply "+
+				"reconciler: %v", err)
+		}
+	}
+
+	if err := s.cfg.SupplyVerifier.Start(); err != nil {
+		return fmt.Errorf("unable to start supply verifier: %v", err)
+	}
+
+	if s.cfg.BalanceSnapshotter != nil {
+		if err := s.cfg.BalanceSnapshotter.Start(); err != nil {
+			return fmt.Errorf("unable to start balance "+
+				"snapshotter: %v", err)
+		}
+	}
+
+	if s.cfg.TombstoneSweeper != nil {
+		if err := s.cfg.TombstoneSweeper.Start(); err != nil {
+			return fmt.Errorf("unable to start tombstone "+
+				"sweeper: %v", err)
+		}
+	}
+
 	if err := s.cfg.UniverseFederation.Start(); err != nil {
 		return fmt.Errorf("unable to start universe "+
 			"federation: %v", err)
@@ -514,6 +581,9 @@ func (s *Server) Stop() error {
 	if err := s.rpcServer.Stop(); err != nil {
 		return err
 	}
+	if err := s.cfg.WebhookNotifier.Stop(); err != nil {
+		return err
+	}
 	if err := s.cfg.AssetMinter.Stop(); err != nil {
 		return err
 	}
@@ -521,14 +591,66 @@ func (s *Server) Stop() error {
 		return err
 	}
 
+	if err := s.cfg.AssetWatcher.Stop(); err != nil {
+		return err
+	}
+
+	if err := s.cfg.AnchorSpendMonitor.Stop(); err != nil {
+		return err
+	}
+
 	if err := s.cfg.ReOrgWatcher.Stop(); err != nil {
 		return err
 	}
 
+	if err := s.cfg.Airdropper.Stop(); err != nil {
+		return err
+	}
+
 	if err := s.cfg.ChainPorter.Stop(); err != nil {
 		return err
 	}
 
+	if s.cfg.ProofTiers != nil {
+		if err := s.cfg.ProofTiers.Stop(); err != nil {
+			return err
+		}
+	}
+
+	if s.cfg.ProofScrubber != nil {
+		if err := s.cfg.ProofScrubber.Stop(); err != nil {
+			return err
+		}
+	}
+
+	if s.cfg.ScriptKeyAuditor != nil {
+		if err := s.cfg.ScriptKeyAuditor.Stop(); err != nil {
+			return err
+		}
+	}
+
+	if s.cfg.SupplyReconciler != nil {
+		if err := s.cfg.SupplyReconciler.Stop(); err != nil {
+			return err
+		}
+	}
+
+	if err := s.cfg.SupplyVerifier.Stop(); err != nil {
+		return err
+	}
+
+	if s.cfg.BalanceSnapshotter != nil {
+		if err := s.cfg.BalanceSnapshotter.Stop(); err != nil {
+			return err
+		}
+	}
+
+	if s.cfg.TombstoneSweeper != nil {
+		if err := s.cfg.TombstoneSweeper.Stop(); err != nil {
+			return err
+		}
+	}
+
 	if err := s.cfg.UniverseFederation.Start(); err != nil {
 		return err
 	}
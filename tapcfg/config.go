@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
 	"os/user"
 	"path/filepath"
@@ -13,6 +14,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btclog"
@@ -20,13 +22,22 @@ import (
 	"github.com/jessevdk/go-flags"
 	"github.com/lightninglabs/lndclient"
 	tap "github.com/lightninglabs/taproot-assets"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/tapdb"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightninglabs/taproot-assets/webhook"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/cert"
+	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/verrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/lightningnetwork/lnd/tor"
 	"golang.org/x/net/http2"
@@ -76,6 +87,54 @@ const (
 	// DatabaseBackendPostgres is the name of the Postgres database backend.
 	DatabaseBackendPostgres = "postgres"
 
+	// proofCourierModeIPFS is the proof courier mode that pins outgoing
+	// proofs to IPFS.
+	proofCourierModeIPFS = "ipfs"
+
+	// defaultReceiverProbeMode is the default mode of probing the
+	// receivers of outbound transfers.
+	defaultReceiverProbeMode = "disabled"
+
+	// defaultAnchorKeyReuseMode is the default way of treating outbound
+	// transfers that reuse a wallet internal key for an anchor output.
+	defaultAnchorKeyReuseMode = "warn"
+
+	// defaultCoinSelectStrategy is the default coin selection strategy of
+	// outbound transfers.
+	defaultCoinSelectStrategy = "max_amount"
+
+	// defaultChangeKeyPolicy is the default policy the script keys of asset
+	// change outputs are derived with.
+	defaultChangeKeyPolicy = "rotate"
+
+	// defaultExternalCoinSelectTimeout is the default maximum time we'll
+	// wait for an external coin selection service to select the coins of
+	// a transfer.
+	defaultExternalCoinSelectTimeout = 30 * time.Second
+
+	// defaultBitcoindEstimateMode is the default estimate mode of
+	// bitcoind's estimatesmartfee RPC.
+	defaultBitcoindEstimateMode = "CONSERVATIVE"
+
+	// feeSourceLnd, feeSourceBitcoind and feeSourceWebAPI are the names of
+	// the sources of fee estimates.
+	feeSourceLnd      = "lnd"
+	feeSourceBitcoind = "bitcoind"
+	feeSourceWebAPI   = "webapi"
+
+	// defaultColdProofDirName is the name of the directory within the
+	// network directory that holds the cold store of the proof archive.
+	defaultColdProofDirName = "cold"
+
+	// defaultProofArchiveDirName is the name of the directory within the
+	// network directory that holds the per-user archives of the central
+	// proof archive.
+	defaultProofArchiveDirName = "proofarchive"
+
+	// defaultRemoteSignerClientName is the default name a watch-only
+	// daemon opens its signing sessions with.
+	defaultRemoteSignerClientName = "tapd"
+
 	// defaultProofTransferBackoffResetWait is the default amount of time
 	// we'll wait before resetting the backoff of a proof transfer.
 	defaultProofTransferBackoffResetWait = 10 * time.Minute
@@ -172,7 +231,7 @@ var (
 // ChainConfig houses the configuration options that govern which chain/network
 // we operate on.
 type ChainConfig struct {
-	Network string `long:"network" description:"network to run on" choice:"regtest" choice:"testnet" choice:"simnet" choice:"signet"`
+	Network string `long:"network" description:"network to run on" choice:"regtest" choice:"testnet" choice:"testnet4" choice:"simnet" choice:"signet"`
 
 	SigNetChallenge string `long:"signetchallenge" description:"Connect to a custom signet network defined by this challenge instead of using the global default signet test network -- Can be specified multiple times"`
 }
@@ -236,6 +295,95 @@ type UniverseConfig struct {
 	AcceptRemoteProofs bool `long:"accept-remote-proofs" description:"If true, then if the Universe server is on a public interface, valid proof from remote parties will be accepted"`
 
 	FederationServers []string `long:"federationserver" description:"The host:port of a Universe server peer with. These servers will be added as the default set of federation servers. Can be specified multiple times."`
+
+	SupplyReconcileInterval time.Duration `long:"supplyreconcileinterval" description:"Amount of time to wait between reconciliations of the local holdings of each asset with the total supply reported by the sum of its universe root. Mismatches are logged and posted to the webhook endpoints. 0 disables the reconciliation."`
+
+	SupplyVerifyAssets []string `long:"supplyverifyasset" description:"The hex encoded asset ID or compressed group key of an asset whose total supply should periodically be re-derived by verifying each of its universe issuance proofs against the chain. The latest signed supply report can be queried over RPC. Can be specified multiple times."`
+
+	SupplyVerifyInterval time.Duration `long:"supplyverifyinterval" description:"Amount of time to wait between verifications of the supply of the assets selected with supplyverifyasset."`
+}
+
+// ExternalCoinSelectConfig is the config of an external coin selection service
+// that the selection of the inputs of outbound transfers is delegated to.
+type ExternalCoinSelectConfig struct {
+	Addr string `long:"addr" description:"The host:port of an external gRPC service implementing the coinselectrpc.CoinSelector service. If set, the coins that are eligible to fund an outbound transfer are sent to this service, which selects the coins to spend instead of the configured coinselectstrategy."`
+
+	TLSCertPath string `long:"tlscertpath" description:"Path to the TLS certificate of the coin selection service. If not set, the system's root certificates are used."`
+
+	Insecure bool `long:"insecure" description:"Connect to the coin selection service without TLS. Should only be used for services on the same host."`
+
+	Timeout time.Duration `long:"timeout" description:"The maximum time to wait for the coin selection service to select the coins of a transfer."`
+}
+
+// FeeEstimatorConfig is the config of the sources of the fee estimates of
+// anchor transactions.
+type FeeEstimatorConfig struct {
+	Sources []string `long:"source" description:"A source of fee estimates, one of lnd, bitcoind or webapi. The sources are queried in the given order and the first estimate within the sanity bounds is used. Can be specified multiple times. Defaults to lnd."`
+
+	MinFeeRate uint64 `long:"minfeerate" description:"The lowest fee estimate in sat/vB that is accepted from a source. Defaults to the minimum relay fee rate."`
+
+	MaxFeeRate uint64 `long:"maxfeerate" description:"The highest fee estimate in sat/vB that is accepted from a source. Higher estimates are considered bogus and the next source is queried instead. 0 means no limit."`
+
+	BitcoindHost string `long:"bitcoind.host" description:"The host:port of the RPC server of the bitcoind node used as the bitcoind fee source."`
+
+	BitcoindUser string `long:"bitcoind.user" description:"The RPC user of the bitcoind node."`
+
+	BitcoindPass string `long:"bitcoind.pass" description:"The RPC password of the bitcoind node."`
+
+	BitcoindEstimateMode string `long:"bitcoind.estimatemode" choice:"ECONOMICAL" choice:"CONSERVATIVE" description:"The estimate mode of bitcoind's estimatesmartfee RPC."`
+
+	WebAPIURL string `long:"webapi.url" description:"The URL of the external fee API used as the webapi fee source. It must return the fee rates in the format of lnd's feeurl."`
+
+	WebAPITimeout time.Duration `long:"webapi.timeout" description:"The timeout of a single request to the external fee API."`
+
+	MempoolSource string `long:"mempoolsource" choice:"bitcoind" choice:"webapi" description:"The source of the mempool state that is used to time fee bumps and the release of batchable transfers, as lnd doesn't expose its mempool. bitcoind reports the mempool size and minimum fee rates of the node configured through bitcoind.host, webapi reports the mempool size and fee histogram from mempoolapi.url. If not set, the mempool state isn't used."`
+
+	MempoolAPIURL string `long:"mempoolapi.url" description:"The URL of the external mempool API used as the webapi mempool source. It must return the mempool state in the format of the /api/mempool endpoint of mempool.space."`
+}
+
+// ProofTieringConfig is the config of the tiering of the on-disk proof archive
+// into a hot and a cold store.
+type ProofTieringConfig struct {
+	Enable bool `long:"enable" description:"Keep only the proofs of unspent asset outputs in the on-disk proof archive and move the proofs of spent outputs to a compressed cold store. Proofs in the cold store are still fetched transparently."`
+
+	ColdDir string `long:"colddir" description:"The directory of the cold store, for example on a slower or network mounted disk. Defaults to the cold directory within the network directory."`
+
+	SweepInterval time.Duration `long:"sweepinterval" description:"A duration (1m, 2h, etc) that governs how frequently the proofs of spent outputs are moved to the cold store."`
+}
+
+// ProofArchiveConfig is the config of the central proof archive that is
+// shared by the nodes of a hosted wallet.
+type ProofArchiveConfig struct {
+	Serve bool `long:"serve" description:"Serve a central proof archive that other nodes push their proofs to and pull them from. Every node authenticates with a macaroon baked through the BakeArchiveMacaroon RPC, which scopes it to the archive of a single user."`
+
+	Dir string `long:"dir" description:"The directory of the served central proof archive. Defaults to the proofarchive directory within the network directory."`
+
+	Host string `long:"host" description:"The host:port of the central proof archive to use. If set, all proofs are pushed to the central archive and the local proof archive only acts as a cache of it."`
+
+	TLSCertPath string `long:"tlscertpath" description:"Path to the TLS certificate of the central proof archive. If not set, the system's root certificates are used."`
+
+	MacaroonPath string `long:"macaroonpath" description:"Path to the user scoped macaroon used to authenticate with the central proof archive."`
+}
+
+// RemoteSignerConfig is the config of the remote signing protocol, through
+// which a watch-only daemon has its virtual packets signed by a daemon running
+// on a separate host.
+type RemoteSignerConfig struct {
+	Serve bool `long:"serve" description:"Serve the RemoteSigner RPC service, which signs the virtual packets of watch-only daemons with the keys of the connected lnd node. Clients authenticate with a macaroon that has the remotesigner:write permission."`
+
+	SessionLifetime time.Duration `long:"sessionlifetime" description:"The time (1m, 2h, etc) a signing session opened by a watch-only daemon stays valid."`
+
+	AllowedAssetIDs []string `long:"allowedassetid" description:"Only sign virtual packets that exclusively spend the asset with the given ID. Can be specified multiple times. If not set, packets of all assets are signed."`
+
+	MaxSendAmount uint64 `long:"maxsendamount" description:"The maximum amount of an asset a single virtual packet may send to script keys that aren't controlled by this daemon. 0 means no limit."`
+
+	Host string `long:"host" description:"The host:port of the remote signer that signs the virtual packets of this daemon in watch-only mode. If set, virtual packets are sent to the remote signer instead of being exported through the ListVirtualSignRequests RPC."`
+
+	TLSCertPath string `long:"tlscertpath" description:"Path to the TLS certificate of the remote signer. If not set, the system's root certificates are used."`
+
+	MacaroonPath string `long:"macaroonpath" description:"Path to the macaroon used to authenticate with the remote signer."`
+
+	ClientName string `long:"clientname" description:"The name this daemon opens its signing sessions with, which is logged by the remote signer."`
 }
 
 // Config is the main config for the tapd cli command.
@@ -259,9 +407,82 @@ type Config struct {
 
 	ReOrgSafeDepth int32 `long:"reorgsafedepth" description:"The number of confirmations we'll wait for before considering a transaction safely buried in the chain."`
 
+	MinAnchorConfs      uint32   `long:"minanchorconfs" description:"The number of confirmations the anchor transaction of an asset needs before the asset can be selected as an input of a transfer. 0 means no minimum is enforced."`
+	AssetMinAnchorConfs []string `long:"assetminanchorconfs" description:"Overrides minanchorconfs for a single asset, in the form <asset_id>:<confs>. Can be specified multiple times."`
+
+	SpendUnconfirmedChange bool `long:"spendunconfirmedchange" description:"If set, the asset change of the node's own transfers can be spent before their anchor transactions confirm, which chains the anchor transactions. The proofs of a chained transfer are assembled once all anchor transactions of the chain are confirmed. Only change that is the single asset in its anchor output can be spent. Assets that require anchor confirmations through minanchorconfs are not affected."`
+
+	AssetSpendAllowlist []string `long:"assetspendallowlist" description:"Restricts outbound transfers of an asset to the listed recipients, in the form <asset_id>:<recipient>. The recipient is either a hex encoded script key or a glob pattern that is matched against Taproot Asset addresses. Transfers to the node's own script keys are always allowed. Can be specified multiple times, assets without an entry are unrestricted."`
+
+	AnchorDustThreshold    uint64 `long:"anchordustthreshold" description:"The value in satoshis that new anchor outputs of outbound transfers are created with. Must be at least the dust limit of a taproot output. 0 means the default of 1000 satoshis is used."`
+	AnchorChangeToExisting bool   `long:"anchorchangetoexisting" description:"Spend an existing owned anchor output of the sent asset as an additional input of outbound transfers that have change, so the change and the re-committed contents of that output share a single new anchor output. This reduces the UTXO set footprint of frequent senders."`
+
+	CoinSelectStrategy string `long:"coinselectstrategy" choice:"max_amount" choice:"oldest_lot" choice:"newest_lot" description:"The default coin selection strategy of outbound transfers that don't specify one. max_amount spends the largest coins first, oldest_lot spends the earliest acquired lots first (FIFO) and newest_lot the latest acquired lots first (LIFO). Only max_amount is affected by anchorchangetoexisting."`
+
+	ChangeKeyPolicy string `long:"changekeypolicy" choice:"rotate" choice:"stable" choice:"separate_account" description:"The script keys asset change outputs are sent to. rotate derives a new key for every transfer, stable reuses a single change key, which simplifies recovery but links all transfers, and separate_account derives a new key for every transfer from a dedicated change key family, so change can be recovered independently. The policy is recorded with every transfer."`
+
+	ChangeSplitOutputs   uint32 `long:"changesplitoutputs" description:"The number of outputs the asset change of outbound transfers is split into. Each change output gets a randomized amount, its own script key and its own anchor output, which makes amount-based analysis of transfers harder at the cost of additional anchor outputs. 0 or 1 disables splitting."`
+	ChangeSplitMinAmount uint64 `long:"changesplitminamount" description:"The minimum asset amount of each change output if change splitting is enabled. Change that is too small for every output to get this amount is split into fewer outputs."`
+
+	WatchOnly            bool          `long:"watchonly" description:"Run in watch-only mode, where the daemon tracks assets and builds transfers, but doesn't sign the virtual transactions of transfers with the connected lnd node. Instead, they are exported to an offline signer through the ListVirtualSignRequests RPC and continue once the signed virtual packet is submitted through SubmitVirtualSignature."`
+	WatchOnlySignTimeout time.Duration `long:"watchonlysigntimeout" description:"The time (1m, 2h, etc) the offline signer has to submit a signed virtual packet in watch-only mode, before the transfer fails."`
+
+	ParcelWorkers           int           `long:"parcelworkers" description:"The number of normal and batchable outbound transfers that are signed and broadcast concurrently."`
+	UrgentParcelWorkers     int           `long:"urgentparcelworkers" description:"The number of additional workers dedicated to urgent outbound transfers."`
+	ParcelBatchInterval     time.Duration `long:"parcelbatchinterval" description:"A duration (1m, 2h, etc) that governs how frequently held back batchable outbound transfers are started. 0 means batchable transfers are started like normal transfers, after all pending normal transfers."`
+	ParcelBatchDeferFeeRate uint64        `long:"parcelbatchdeferfeerate" description:"The mempool fee rate in sat/vB above which the start of held back batchable outbound transfers is deferred to the next batch interval, up to three intervals in a row. Requires feeestimator.mempoolsource to be set. 0 means batches are never deferred."`
+
+	MaxInFlightParcels  int   `long:"maxinflightparcels" description:"The maximum number of outbound transfers that are in flight at once, from their acceptance until their proofs were delivered. Once it is reached, new transfer requests wait until another transfer completes. Transfers resumed after a restart are always accepted. 0 means unlimited."`
+	MaxPassiveProofs    int   `long:"maxpassiveproofs" description:"The maximum number of passive asset proofs that all outbound transfers in flight hold in memory together. A transfer waits before it is funded until its passive assets fit into this budget, and fails if they exceed the whole budget. 0 means unlimited."`
+	MaxParcelProofBytes int64 `long:"maxparcelproofbytes" description:"The maximum number of proof bytes a single outbound transfer may buffer while its proofs are assembled and delivered. Transfers that would exceed it fail before they are funded. 0 means unlimited."`
+
+	BackendFailureThreshold int `long:"backendfailurethreshold" description:"The number of consecutive failed chain backend or wallet calls of outbound transfers after which no new transfers are started until the backend recovered. Transfers that were already committed to disk are retried instead of failed while the backend is unavailable. 0 disables this circuit breaker."`
+
+	ReceiverProbeMode    string        `long:"receiverprobemode" choice:"disabled" choice:"warn" choice:"strict" description:"Whether the proof courier is used to check that the receivers of an outbound transfer are reachable before the anchor transaction is funded and broadcast. In warn mode unreachable receivers are only logged, in strict mode the transfer is aborted."`
+	ReceiverProbeTimeout time.Duration `long:"receiverprobetimeout" description:"The maximum time to wait for a single receiver to be probed."`
+
+	AnchorKeyReuse string `long:"anchorkeyreuse" choice:"allow" choice:"warn" choice:"refuse" description:"How outbound transfers are treated that would create an anchor output with a wallet internal key that was already used for another anchor output. Reusing internal keys links the anchor outputs on chain. In warn mode the reuse is only logged, in refuse mode the transfer is aborted before it is funded."`
+
+	StrictCounterparties bool `long:"strictcounterparties" description:"Log a warning for every output of an outbound transfer that is sent to a script key that isn't in the counterparty book. The transfer itself isn't affected."`
+
+	VerifyProofsBeforeBroadcast bool `long:"verifyproofsbeforebroadcast" description:"Assemble the final proofs of each outbound transfer against a dry run block and verify them before the anchor transaction is broadcast. This catches invalid proofs before the transfer can't be undone anymore, at the cost of verifying the input proofs of each transfer."`
+
+	BalanceSnapshotInterval time.Duration `long:"balancesnapshotinterval" description:"Amount of time to wait between snapshots of the confirmed balance of each asset, which are used to answer historical balance queries. 0 disables snapshotting."`
+
+	TombstoneSweepInterval  time.Duration `long:"tombstonesweepinterval" description:"Amount of time to wait between sweeps of anchor outputs that only carry tombstones, the zero value asset outputs left behind by sends of the full amount of an asset. Their BTC value is sent back to the wallet. 0 disables sweeping."`
+	TombstoneSweepMaxInputs int           `long:"tombstonesweepmaxinputs" description:"The maximum number of anchor outputs that are swept in a single transaction."`
+
+	ProofVerifyWorkers   int `long:"proofverifyworkers" description:"The number of proof state transitions that are verified concurrently, shared by proof imports, proofs received through the proof courier and universe registrations. 0 means one worker per CPU."`
+	ProofVerifyCacheSize int `long:"proofverifycachesize" description:"The number of verified proof state transitions that are cached, so re-verifying a proof file with new transitions appended only verifies the new ones. 0 disables the cache."`
+
+	MaxProofFileSize    uint64 `long:"maxprooffilesize" description:"The maximum size in bytes of a proof file that is imported, received through the proof courier or fetched during a universe sync. Larger proofs are rejected before they are decoded. 0 means no limit."`
+	MaxProofTransitions uint64 `long:"maxprooftransitions" description:"The maximum number of state transitions of a proof file that is imported or received through the proof courier. Files with longer histories are rejected before they are decoded. 0 means no limit."`
+
+	ProofScrubInterval     time.Duration `long:"proofscrubinterval" description:"Amount of time to wait between scrub passes, which re-read the proofs of all unspent assets from the database and the on-disk archive to detect missing or corrupted proofs before they are needed for a spend. 0 disables scrubbing."`
+	ProofScrubVerifySample int           `long:"proofscrubverifysample" description:"The number of randomly sampled proofs that are verified end-to-end in each scrub pass. 0 only checks that the proofs can be decoded."`
+
+	ScriptKeyAuditInterval time.Duration `long:"scriptkeyauditinterval" description:"Amount of time to wait between audits of the transfer log and the proof archive for script keys used by more than one unspent asset or transfer. Reused script keys are flagged, and no assets are sent to a flagged script key until the flag is resolved. 0 only audits on demand."`
+
 	// The following options are used to configure the proof courier.
-	ProofCourierMode string                    `long:"proofcouriermode" choice:"hashmail" description:"Type of proof courier to use."`
+	ProofCourierMode string                    `long:"proofcouriermode" choice:"hashmail" choice:"ipfs" description:"Type of proof courier to use. The ipfs mode pins outgoing proofs to IPFS and only exchanges their content IDs through the hashmail service."`
 	HashMailCourier  *proof.HashMailCourierCfg `group:"proofcourier" namespace:"hashmailcourier"`
+	ProofCourierAddr string                    `long:"proofcourieraddr" description:"The URI of a proof courier service whose scheme is handled by a courier driver that was registered with proof.RegisterCourier by a package compiled into the daemon. If set, this courier is used instead of the hashmail courier and proofcouriermode is ignored."`
+
+	IPFS *proof.IPFSCfg `group:"ipfs" namespace:"ipfs"`
+
+	MetaFetcher *proof.MetaFetcherCfg `group:"metafetcher" namespace:"metafetcher"`
+
+	Webhook *webhook.Cfg `group:"webhook" namespace:"webhook"`
+
+	ExternalCoinSelect *ExternalCoinSelectConfig `group:"externalcoinselect" namespace:"externalcoinselect"`
+
+	ProofTiering *ProofTieringConfig `group:"prooftiering" namespace:"prooftiering"`
+
+	ProofArchive *ProofArchiveConfig `group:"proofarchive" namespace:"proofarchive"`
+
+	RemoteSigner *RemoteSignerConfig `group:"remotesigner" namespace:"remotesigner"`
+
+	FeeEstimator *FeeEstimatorConfig `group:"feeestimator" namespace:"feeestimator"`
 
 	ChainConf *ChainConfig
 	RpcConf   *RpcConfig
@@ -278,6 +499,21 @@ type Config struct {
 	// hooked up to.
 	LogWriter *build.RotatingLogWriter
 
+	// FaultHooks are optional fault injection hooks of the send pipeline
+	// that can be set by integrators that embed the daemon. They are only
+	// honored if the daemon is built with the faultinject build tag.
+	FaultHooks *tapfreighter.FaultHooks
+
+	// StateHooks are hooks that run before or after the send states of
+	// outbound transfers, which can be set by integrators that embed the
+	// daemon, for example to run compliance checks before a broadcast.
+	StateHooks *tapfreighter.StateHooks
+
+	// CompletionHandler is called after the delivery of each outbound
+	// transfer was confirmed, which can be set by integrators that embed
+	// the daemon to record transfers in their own accounting system.
+	CompletionHandler tapfreighter.CompletionHandler
+
 	// networkDir is the path to the directory of the currently active
 	// network. This path will hold the files related to each different
 	// network.
@@ -331,6 +567,18 @@ func DefaultConfig() Config {
 		LogWriter:            build.NewRotatingLogWriter(),
 		BatchMintingInterval: defaultBatchMintingInterval,
 		ReOrgSafeDepth:       defaultReOrgSafeDepth,
+		ParcelWorkers:        tapfreighter.DefaultParcelWorkers,
+		UrgentParcelWorkers:  tapfreighter.DefaultUrgentParcelWorkers,
+		ParcelBatchInterval:  tapfreighter.DefaultParcelBatchInterval,
+		ProofVerifyCacheSize: proof.DefaultVerifyCacheSize,
+		MaxProofFileSize:     proof.DefaultMaxFileSize,
+		MaxProofTransitions:  proof.DefaultMaxFileProofs,
+		ReceiverProbeMode:    defaultReceiverProbeMode,
+		AnchorKeyReuse:       defaultAnchorKeyReuseMode,
+		CoinSelectStrategy:   defaultCoinSelectStrategy,
+		ChangeKeyPolicy:      defaultChangeKeyPolicy,
+		WatchOnlySignTimeout: tapfreighter.DefaultOfflineSignTimeout,
+		ReceiverProbeTimeout: tapfreighter.DefaultReceiverProbeTimeout,
 		HashMailCourier: &proof.HashMailCourierCfg{
 			Addr:               defaultHashMailAddr,
 			ReceiverAckTimeout: defaultProofTransferReceiverAckTimeout,
@@ -340,10 +588,50 @@ func DefaultConfig() Config {
 				InitialBackoff:   defaultProofTransferInitialBackoff,
 				MaxBackoff:       defaultProofTransferMaxBackoff,
 			},
+			MailboxExpiry:      proof.DefaultMailboxExpiry,
+			MailboxMaxMessages: proof.DefaultMailboxMaxMessages,
+			MailboxMaxBytes:    proof.DefaultMailboxMaxBytes,
+		},
+		IPFS: &proof.IPFSCfg{
+			Timeout:     proof.DefaultIPFSTimeout,
+			MaxBlobSize: proof.DefaultIPFSMaxBlobSize,
+		},
+		MetaFetcher: &proof.MetaFetcherCfg{
+			IPFSGateway:    proof.DefaultIPFSGateway,
+			FetchTimeout:   proof.DefaultMetaFetchTimeout,
+			MaxContentSize: proof.DefaultMaxMetaContentSize,
+		},
+		Webhook: &webhook.Cfg{
+			MaxAttempts:    webhook.DefaultMaxAttempts,
+			InitialBackoff: webhook.DefaultInitialBackoff,
+			MaxBackoff:     webhook.DefaultMaxBackoff,
+			Timeout:        webhook.DefaultTimeout,
 		},
+		ExternalCoinSelect: &ExternalCoinSelectConfig{
+			Timeout: defaultExternalCoinSelectTimeout,
+		},
+		ProofTiering: &ProofTieringConfig{
+			SweepInterval: proof.DefaultTierSweepInterval,
+		},
+		ProofArchive: &ProofArchiveConfig{},
+		RemoteSigner: &RemoteSignerConfig{
+			SessionLifetime: tapfreighter.DefaultSignSessionLifetime,
+			ClientName:      defaultRemoteSignerClientName,
+		},
+		FeeEstimator: &FeeEstimatorConfig{
+			BitcoindEstimateMode: defaultBitcoindEstimateMode,
+			WebAPITimeout:        tap.DefaultFeeAPITimeout,
+		},
+		BackendFailureThreshold: tapfreighter.DefaultBackendFailureThreshold,
+		BalanceSnapshotInterval: tapfreighter.DefaultBalanceSnapshotInterval,
+		TombstoneSweepMaxInputs: tapfreighter.DefaultMaxSweepInputs,
+		ProofScrubInterval:      proof.DefaultScrubInterval,
+		ProofScrubVerifySample:  proof.DefaultScrubVerifySampleSize,
+		ScriptKeyAuditInterval:  tapfreighter.DefaultScriptKeyAuditInterval,
 		Universe: &UniverseConfig{
-			SyncInterval:       defaultUniverseSyncInterval,
-			AcceptRemoteProofs: defaultAcceptRemoteProofs,
+			SyncInterval:            defaultUniverseSyncInterval,
+			AcceptRemoteProofs:      defaultAcceptRemoteProofs,
+			SupplyReconcileInterval: universe.DefaultSupplyReconcileInterval,
 		},
 	}
 }
@@ -527,6 +815,8 @@ func ValidateConfig(cfg Config, cfgLogger btclog.Logger) (*Config, error) {
 	switch cfg.ChainConf.Network {
 	case "testnet":
 		cfg.ActiveNetParams = chaincfg.TestNet3Params
+	case "testnet4":
+		cfg.ActiveNetParams = address.TestNet4Params
 	case "regtest":
 		cfg.ActiveNetParams = chaincfg.RegressionNetParams
 	case "simnet":
@@ -585,12 +875,233 @@ func ValidateConfig(cfg Config, cfgLogger btclog.Logger) (*Config, error) {
 		}
 	}
 
+	// The porter needs at least one worker of each pool to make progress.
+	if cfg.ParcelWorkers < 1 || cfg.UrgentParcelWorkers < 1 {
+		return nil, mkErr("parcelworkers and urgentparcelworkers must " +
+			"be at least 1")
+	}
+	if cfg.ParcelBatchInterval < 0 {
+		return nil, mkErr("parcelbatchinterval must not be negative")
+	}
+	if cfg.MaxInFlightParcels < 0 || cfg.MaxPassiveProofs < 0 ||
+		cfg.MaxParcelProofBytes < 0 {
+
+		return nil, mkErr("maxinflightparcels, maxpassiveproofs and " +
+			"maxparcelproofbytes must not be negative")
+	}
+	if cfg.BackendFailureThreshold < 0 {
+		return nil, mkErr("backendfailurethreshold must not be " +
+			"negative")
+	}
+	if cfg.BalanceSnapshotInterval < 0 {
+		return nil, mkErr("balancesnapshotinterval must not be " +
+			"negative")
+	}
+	if cfg.TombstoneSweepInterval < 0 {
+		return nil, mkErr("tombstonesweepinterval must not be " +
+			"negative")
+	}
+	if cfg.TombstoneSweepMaxInputs < 0 {
+		return nil, mkErr("tombstonesweepmaxinputs must not be " +
+			"negative")
+	}
+	if cfg.ProofScrubInterval < 0 {
+		return nil, mkErr("proofscrubinterval must not be negative")
+	}
+	if cfg.ScriptKeyAuditInterval < 0 {
+		return nil, mkErr("scriptkeyauditinterval must not be " +
+			"negative")
+	}
+	if cfg.ProofScrubVerifySample < 0 {
+		return nil, mkErr("proofscrubverifysample must not be " +
+			"negative")
+	}
+	if cfg.ProofVerifyWorkers < 0 {
+		return nil, mkErr("proofverifyworkers must not be negative")
+	}
+	taprootDustLimit := lnwallet.DustLimitForSize(input.P2TRSize)
+	if cfg.AnchorDustThreshold != 0 &&
+		btcutil.Amount(cfg.AnchorDustThreshold) < taprootDustLimit {
+
+		return nil, mkErr("anchordustthreshold must be at least %d "+
+			"satoshis", taprootDustLimit)
+	}
+	if cfg.ReceiverProbeTimeout < 0 {
+		return nil, mkErr("receiverprobetimeout must not be negative")
+	}
+	if cfg.WatchOnly && cfg.WatchOnlySignTimeout <= 0 {
+		return nil, mkErr("watchonlysigntimeout must be positive")
+	}
+	if cfg.ProofVerifyCacheSize < 0 {
+		return nil, mkErr("proofverifycachesize must not be negative")
+	}
+	if cfg.HashMailCourier != nil {
+		switch {
+		case cfg.HashMailCourier.MailboxExpiry < 0:
+			return nil, mkErr("hashmailcourier.mailboxexpiry must " +
+				"not be negative")
+
+		case cfg.HashMailCourier.MailboxMaxMessages < 0:
+			return nil, mkErr("hashmailcourier.mailboxmaxmessages " +
+				"must not be negative")
+
+		case cfg.HashMailCourier.MailboxMaxBytes < 0:
+			return nil, mkErr("hashmailcourier.mailboxmaxbytes " +
+				"must not be negative")
+
+		case cfg.HashMailCourier.ChunkSize < 0:
+			return nil, mkErr("hashmailcourier.chunksize must not " +
+				"be negative")
+
+		case cfg.HashMailCourier.SettlementTimeout < 0:
+			return nil, mkErr("hashmailcourier.settlementtimeout " +
+				"must not be negative")
+		}
+	}
+
+	if cfg.Webhook != nil {
+		for _, rawURL := range cfg.Webhook.URLs {
+			u, err := url.Parse(rawURL)
+			if err != nil || (u.Scheme != "http" &&
+				u.Scheme != "https") || u.Host == "" {
+
+				return nil, mkErr("webhook.url %v must be an "+
+					"http or https URL", rawURL)
+			}
+		}
+
+		switch {
+		case cfg.Webhook.MaxAttempts < 0:
+			return nil, mkErr("webhook.maxattempts must not be " +
+				"negative")
+
+		case cfg.Webhook.InitialBackoff < 0:
+			return nil, mkErr("webhook.initialbackoff must not " +
+				"be negative")
+
+		case cfg.Webhook.MaxBackoff < 0:
+			return nil, mkErr("webhook.maxbackoff must not be " +
+				"negative")
+
+		case cfg.Webhook.Timeout < 0:
+			return nil, mkErr("webhook.timeout must not be " +
+				"negative")
+		}
+	}
+
+	if cfg.ExternalCoinSelect != nil && cfg.ExternalCoinSelect.Addr != "" {
+		if cfg.ExternalCoinSelect.Timeout <= 0 {
+			return nil, mkErr("externalcoinselect.timeout must be " +
+				"positive")
+		}
+
+		if cfg.ExternalCoinSelect.Insecure &&
+			cfg.ExternalCoinSelect.TLSCertPath != "" {
+
+			return nil, mkErr("externalcoinselect.insecure and " +
+				"externalcoinselect.tlscertpath are mutually " +
+				"exclusive")
+		}
+	}
+
+	if cfg.Universe.SupplyReconcileInterval < 0 {
+		return nil, mkErr("universe.supplyreconcileinterval must not " +
+			"be negative")
+	}
+
+	if cfg.Universe.SupplyVerifyInterval < 0 {
+		return nil, mkErr("universe.supplyverifyinterval must not be " +
+			"negative")
+	}
+
+	if cfg.ProofTiering != nil && cfg.ProofTiering.Enable &&
+		cfg.ProofTiering.SweepInterval <= 0 {
+
+		return nil, mkErr("prooftiering.sweepinterval must be positive")
+	}
+
+	// Only a user scoped macaroon grants access to a central proof
+	// archive.
+	if cfg.ProofArchive != nil && cfg.ProofArchive.Host != "" &&
+		cfg.ProofArchive.MacaroonPath == "" {
+
+		return nil, mkErr("proofarchive.host requires " +
+			"proofarchive.macaroonpath to be set")
+	}
+
+	// A remote signer is only used in watch-only mode, and a daemon that
+	// doesn't sign itself can't sign for others.
+	if err := cfg.RemoteSigner.validate(cfg.WatchOnly); err != nil {
+		return nil, mkErr("invalid remote signer config: %v", err)
+	}
+
+	// Make sure all fee sources are known and configured.
+	if err := cfg.FeeEstimator.validate(); err != nil {
+		return nil, mkErr("invalid fee estimator config: %v", err)
+	}
+
+	// Make sure the per-asset confirmation overrides can be parsed.
+	if _, err := cfg.anchorConfPolicy(); err != nil {
+		return nil, mkErr("invalid assetminanchorconfs: %v", err)
+	}
+
+	// Make sure the spend allowlist entries can be parsed.
+	if _, err := cfg.spendPolicy(); err != nil {
+		return nil, mkErr("invalid assetspendallowlist: %v", err)
+	}
+
+	// Make sure the assets selected for supply verification can be parsed.
+	if _, err := cfg.supplyVerifyAssets(); err != nil {
+		return nil, mkErr("invalid universe.supplyverifyasset: %v", err)
+	}
+
+	// A custom proof courier needs a registered driver for its scheme.
+	if cfg.ProofCourierAddr != "" {
+		_, err := proof.ParseCourierAddr(cfg.ProofCourierAddr)
+		if err != nil {
+			return nil, mkErr("invalid proofcourieraddr: %v", err)
+		}
+	}
+
+	// Pinning proofs to IPFS requires an IPFS node to talk to.
+	if cfg.ProofCourierMode == proofCourierModeIPFS &&
+		(cfg.IPFS == nil || cfg.IPFS.APIAddr == "") {
+
+		return nil, mkErr("the ipfs proof courier mode requires " +
+			"ipfs.apiaddr to be set")
+	}
+
 	// We'll now construct the network directory which will be where we
 	// store all the data specific to this chain/network.
 	cfg.networkDir = filepath.Join(
-		cfg.DataDir, lncfg.NormalizeNetwork(cfg.ActiveNetParams.Name),
+		cfg.DataDir, normalizeNetwork(cfg.ActiveNetParams.Name),
 	)
 
+	// The cold store of the proof archive lives next to the hot one,
+	// unless a different directory was set.
+	if cfg.ProofTiering != nil && cfg.ProofTiering.Enable {
+		if cfg.ProofTiering.ColdDir == "" {
+			cfg.ProofTiering.ColdDir = filepath.Join(
+				cfg.networkDir, defaultColdProofDirName,
+			)
+		}
+		cfg.ProofTiering.ColdDir = CleanAndExpandPath(
+			cfg.ProofTiering.ColdDir,
+		)
+	}
+
+	// The same goes for the directory of a served central proof archive.
+	if cfg.ProofArchive != nil && cfg.ProofArchive.Serve {
+		if cfg.ProofArchive.Dir == "" {
+			cfg.ProofArchive.Dir = filepath.Join(
+				cfg.networkDir, defaultProofArchiveDirName,
+			)
+		}
+		cfg.ProofArchive.Dir = CleanAndExpandPath(
+			cfg.ProofArchive.Dir,
+		)
+	}
+
 	// We'll also update the database file location as well, if it wasn't
 	// set.
 	if cfg.Sqlite.DatabaseFileName == defaultSqliteDatabasePath {
@@ -663,7 +1174,7 @@ func ValidateConfig(cfg Config, cfgLogger btclog.Logger) (*Config, error) {
 	// Append the network type to the log directory so it is "namespaced"
 	// per network in the same fashion as the data directory.
 	cfg.LogDir = filepath.Join(
-		cfg.LogDir, lncfg.NormalizeNetwork(cfg.ActiveNetParams.Name),
+		cfg.LogDir, normalizeNetwork(cfg.ActiveNetParams.Name),
 	)
 
 	// A log writer must be passed in, otherwise we can't function and would
@@ -1010,6 +1521,29 @@ func CleanAndExpandPath(path string) string {
 	return filepath.Clean(os.ExpandEnv(path))
 }
 
+// normalizeNetwork returns the name of the given network used to create file
+// paths. Testnet3 uses the name "testnet", but testnet4 keeps its own name, so
+// the two networks never share any data.
+func normalizeNetwork(network string) string {
+	if network == address.TestNet4Params.Name {
+		return network
+	}
+
+	return lncfg.NormalizeNetwork(network)
+}
+
+// lndNetwork returns the network the lnd client expects the connected lnd node
+// to run on. The lnd client doesn't know about testnet4, whose parameters
+// only differ from the ones of testnet3 in the genesis block, network magic
+// and seeds, so it connects to lnd as a testnet node.
+func lndNetwork(network string) lndclient.Network {
+	if network == address.TestNet4Params.Name {
+		return lndclient.NetworkTestnet
+	}
+
+	return lndclient.Network(network)
+}
+
 // getLnd returns an instance of the lnd services proxy.
 func getLnd(network string, cfg *LndConfig,
 	interceptor signal.Interceptor) (*lndclient.GrpcLndServices, error) {
@@ -1037,7 +1571,7 @@ func getLnd(network string, cfg *LndConfig,
 
 	return lndclient.NewLndServices(&lndclient.LndServicesConfig{
 		LndAddress:            cfg.Host,
-		Network:               lndclient.Network(network),
+		Network:               lndNetwork(network),
 		CustomMacaroonPath:    cfg.MacaroonPath,
 		TLSPath:               cfg.TLSPath,
 		CheckVersion:          minimalCompatibleVersion,
@@ -1046,3 +1580,413 @@ func getLnd(network string, cfg *LndConfig,
 		CallerCtx:             ctxc,
 	})
 }
+
+// anchorConfPolicy returns the policy of how many anchor confirmations assets
+// need before they can be spent, or nil if no minimum is configured.
+func (c *Config) anchorConfPolicy() (*tapfreighter.AnchorConfPolicy, error) {
+	policy := &tapfreighter.AnchorConfPolicy{
+		DefaultMinConfs: c.MinAnchorConfs,
+		AssetMinConfs:   make(map[asset.ID]uint32),
+	}
+
+	for _, entry := range c.AssetMinAnchorConfs {
+		idStr, confsStr, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("expected <asset_id>:<confs>, "+
+				"got %v", entry)
+		}
+
+		idBytes, err := hex.DecodeString(idStr)
+		if err != nil || len(idBytes) != len(asset.ID{}) {
+			return nil, fmt.Errorf("invalid asset ID: %v", idStr)
+		}
+
+		confs, err := strconv.ParseUint(confsStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number of confirmations "+
+				"for asset %v: %w", idStr, err)
+		}
+
+		var id asset.ID
+		copy(id[:], idBytes)
+		policy.AssetMinConfs[id] = uint32(confs)
+	}
+
+	if policy.DefaultMinConfs == 0 && len(policy.AssetMinConfs) == 0 {
+		return nil, nil
+	}
+
+	return policy, nil
+}
+
+// validate makes sure the remote signer is only served by a daemon that signs
+// itself and only used by a watch-only daemon.
+func (c *RemoteSignerConfig) validate(watchOnly bool) error {
+	if c == nil {
+		return nil
+	}
+
+	switch {
+	case c.Serve && watchOnly:
+		return fmt.Errorf("serve can't be combined with watchonly")
+
+	case c.Serve && c.SessionLifetime <= 0:
+		return fmt.Errorf("sessionlifetime must be positive")
+
+	case c.Host != "" && !watchOnly:
+		return fmt.Errorf("host requires watchonly to be set")
+
+	case c.Host != "" && c.MacaroonPath == "":
+		return fmt.Errorf("host requires macaroonpath to be set")
+	}
+
+	_, err := c.signPolicies(nil)
+	return err
+}
+
+// signPolicies returns the policies every virtual packet must pass before it
+// is signed by the remote signer.
+func (c *RemoteSignerConfig) signPolicies(
+	keyRing tapfreighter.KeyRing) ([]tapfreighter.SignPolicy, error) {
+
+	var policies []tapfreighter.SignPolicy
+	if len(c.AllowedAssetIDs) > 0 {
+		ids := make([]asset.ID, 0, len(c.AllowedAssetIDs))
+		for _, idStr := range c.AllowedAssetIDs {
+			idBytes, err := hex.DecodeString(idStr)
+			if err != nil || len(idBytes) != len(asset.ID{}) {
+				return nil, fmt.Errorf("invalid asset ID: %v",
+					idStr)
+			}
+
+			var id asset.ID
+			copy(id[:], idBytes)
+			ids = append(ids, id)
+		}
+
+		policies = append(
+			policies, tapfreighter.AllowedAssetsPolicy(ids...),
+		)
+	}
+
+	if c.MaxSendAmount > 0 {
+		policies = append(policies, tapfreighter.MaxSendAmountPolicy(
+			c.MaxSendAmount, keyRing,
+		))
+	}
+
+	return policies, nil
+}
+
+// validate makes sure all fee sources are known and configured.
+func (c *FeeEstimatorConfig) validate() error {
+	if c == nil {
+		return nil
+	}
+
+	for _, source := range c.Sources {
+		switch source {
+		case feeSourceLnd:
+
+		case feeSourceBitcoind:
+			if c.BitcoindHost == "" {
+				return fmt.Errorf("the %v fee source requires "+
+					"bitcoind.host to be set", source)
+			}
+
+		case feeSourceWebAPI:
+			if c.WebAPIURL == "" {
+				return fmt.Errorf("the %v fee source requires "+
+					"webapi.url to be set", source)
+			}
+
+		default:
+			return fmt.Errorf("unknown fee source: %v", source)
+		}
+	}
+
+	if c.MaxFeeRate != 0 && c.MaxFeeRate < c.MinFeeRate {
+		return fmt.Errorf("maxfeerate must not be below minfeerate")
+	}
+
+	switch {
+	case c.MempoolSource == feeSourceBitcoind && c.BitcoindHost == "":
+		return fmt.Errorf("the %v mempool source requires "+
+			"bitcoind.host to be set", c.MempoolSource)
+
+	case c.MempoolSource == feeSourceWebAPI && c.MempoolAPIURL == "":
+		return fmt.Errorf("the %v mempool source requires "+
+			"mempoolapi.url to be set", c.MempoolSource)
+	}
+
+	return nil
+}
+
+// feeEstimator returns the fee estimator of anchor transactions that queries
+// the configured sources in order, or nil if the fee estimates of the given
+// lnd chain bridge should be used as they are.
+func (c *Config) feeEstimator(
+	lnd tapfreighter.FeeEstimator) (tapfreighter.FeeEstimator, error) {
+
+	feeCfg := c.FeeEstimator
+	if feeCfg == nil {
+		return nil, nil
+	}
+
+	sources := feeCfg.Sources
+	if len(sources) == 0 {
+		sources = []string{feeSourceLnd}
+	}
+
+	// Without any bounds, a single lnd source is the same as using the
+	// chain bridge directly.
+	noBounds := feeCfg.MinFeeRate == 0 && feeCfg.MaxFeeRate == 0
+	if noBounds && len(sources) == 1 && sources[0] == feeSourceLnd {
+		return nil, nil
+	}
+
+	estimators := make([]tapfreighter.NamedFeeEstimator, 0, len(sources))
+	for _, source := range sources {
+		var estimator tapfreighter.FeeEstimator
+		switch source {
+		case feeSourceLnd:
+			estimator = lnd
+
+		case feeSourceBitcoind:
+			bitcoindCfg := &tap.BitcoindFeeEstimatorCfg{
+				Host:         feeCfg.BitcoindHost,
+				User:         feeCfg.BitcoindUser,
+				Pass:         feeCfg.BitcoindPass,
+				EstimateMode: feeCfg.BitcoindEstimateMode,
+			}
+
+			var err error
+			estimator, err = tap.NewBitcoindFeeEstimator(bitcoindCfg)
+			if err != nil {
+				return nil, err
+			}
+
+		case feeSourceWebAPI:
+			estimator = tap.NewWebAPIFeeEstimator(
+				&tap.WebAPIFeeEstimatorCfg{
+					URL:     feeCfg.WebAPIURL,
+					Timeout: feeCfg.WebAPITimeout,
+				},
+			)
+
+		default:
+			return nil, fmt.Errorf("unknown fee source: %v", source)
+		}
+
+		estimators = append(estimators, tapfreighter.NamedFeeEstimator{
+			FeeEstimator: estimator,
+			Name:         source,
+		})
+	}
+
+	bounds := tapfreighter.FeeRateBounds{
+		Min: chainfee.SatPerKVByte(
+			feeCfg.MinFeeRate * 1000,
+		).FeePerKWeight(),
+		Max: chainfee.SatPerKVByte(
+			feeCfg.MaxFeeRate * 1000,
+		).FeePerKWeight(),
+	}
+
+	return tapfreighter.NewFallbackFeeEstimator(
+		bounds, estimators...,
+	), nil
+}
+
+// mempoolSource returns the configured source of the mempool state, or nil if
+// the mempool state isn't used.
+func (c *Config) mempoolSource() (tapgarden.MempoolSource, error) {
+	feeCfg := c.FeeEstimator
+	if feeCfg == nil {
+		return nil, nil
+	}
+
+	switch feeCfg.MempoolSource {
+	case "":
+		return nil, nil
+
+	case feeSourceBitcoind:
+		return tap.NewBitcoindFeeEstimator(&tap.BitcoindFeeEstimatorCfg{
+			Host:         feeCfg.BitcoindHost,
+			User:         feeCfg.BitcoindUser,
+			Pass:         feeCfg.BitcoindPass,
+			EstimateMode: feeCfg.BitcoindEstimateMode,
+		})
+
+	case feeSourceWebAPI:
+		return tap.NewMempoolAPISource(&tap.MempoolAPISourceCfg{
+			URL:     feeCfg.MempoolAPIURL,
+			Timeout: feeCfg.WebAPITimeout,
+		}), nil
+
+	default:
+		return nil, fmt.Errorf("unknown mempool source: %v",
+			feeCfg.MempoolSource)
+	}
+}
+
+// receiverProbeMode returns the configured mode of probing the receivers of
+// outbound transfers.
+func (c *Config) receiverProbeMode() tapfreighter.ReceiverProbeMode {
+	switch c.ReceiverProbeMode {
+	case tapfreighter.ReceiverProbeWarn.String():
+		return tapfreighter.ReceiverProbeWarn
+
+	case tapfreighter.ReceiverProbeStrict.String():
+		return tapfreighter.ReceiverProbeStrict
+
+	default:
+		return tapfreighter.ReceiverProbeDisabled
+	}
+}
+
+// anchorKeyReuseMode returns the configured way of treating outbound transfers
+// that reuse a wallet internal key for an anchor output.
+func (c *Config) anchorKeyReuseMode() tapfreighter.AnchorKeyReuseMode {
+	switch c.AnchorKeyReuse {
+	case tapfreighter.AnchorKeyReuseAllowed.String():
+		return tapfreighter.AnchorKeyReuseAllowed
+
+	case tapfreighter.AnchorKeyReuseRefuse.String():
+		return tapfreighter.AnchorKeyReuseRefuse
+
+	default:
+		return tapfreighter.AnchorKeyReuseWarn
+	}
+}
+
+// coinSelectStrategy returns the configured default coin selection strategy of
+// outbound transfers.
+func (c *Config) coinSelectStrategy() tapfreighter.MultiCommitmentSelectStrategy {
+	switch c.CoinSelectStrategy {
+	case tapfreighter.PreferOldestLot.String():
+		return tapfreighter.PreferOldestLot
+
+	case tapfreighter.PreferNewestLot.String():
+		return tapfreighter.PreferNewestLot
+
+	default:
+		return tapfreighter.PreferMaxAmount
+	}
+}
+
+// changeKeyPolicy returns the configured policy the script keys of asset
+// change outputs are derived with.
+func (c *Config) changeKeyPolicy() tapfreighter.ChangeKeyPolicy {
+	switch c.ChangeKeyPolicy {
+	case tapfreighter.ChangeKeyStable.String():
+		return tapfreighter.ChangeKeyStable
+
+	case tapfreighter.ChangeKeySeparateAccount.String():
+		return tapfreighter.ChangeKeySeparateAccount
+
+	default:
+		return tapfreighter.ChangeKeyRotate
+	}
+}
+
+// spendPolicy returns the policy that restricts the recipients of outbound
+// transfers of individual assets, or nil if no asset is restricted.
+func (c *Config) spendPolicy() (*tapfreighter.SpendPolicy, error) {
+	if len(c.AssetSpendAllowlist) == 0 {
+		return nil, nil
+	}
+
+	var (
+		ids          = make(map[asset.ID]struct{})
+		scriptKeys   = make(map[asset.ID][]*btcec.PublicKey)
+		addrPatterns = make(map[asset.ID][]string)
+	)
+	for _, entry := range c.AssetSpendAllowlist {
+		idStr, recipient, ok := strings.Cut(entry, ":")
+		if !ok || recipient == "" {
+			return nil, fmt.Errorf("expected <asset_id>:<recipient>, "+
+				"got %v", entry)
+		}
+
+		idBytes, err := hex.DecodeString(idStr)
+		if err != nil || len(idBytes) != len(asset.ID{}) {
+			return nil, fmt.Errorf("invalid asset ID: %v", idStr)
+		}
+
+		var id asset.ID
+		copy(id[:], idBytes)
+		ids[id] = struct{}{}
+
+		// Anything that parses as a public key is a script key, any
+		// other recipient is an address pattern.
+		keyBytes, err := hex.DecodeString(recipient)
+		if err == nil {
+			scriptKey, err := btcec.ParsePubKey(keyBytes)
+			if err == nil {
+				scriptKeys[id] = append(scriptKeys[id], scriptKey)
+				continue
+			}
+		}
+
+		addrPatterns[id] = append(addrPatterns[id], recipient)
+	}
+
+	policy := &tapfreighter.SpendPolicy{
+		Allowlists: make(map[asset.ID]*tapfreighter.SpendAllowlist),
+	}
+	for id := range ids {
+		allowlist, err := tapfreighter.NewSpendAllowlist(
+			scriptKeys[id], addrPatterns[id],
+		)
+		if err != nil {
+			return nil, err
+		}
+		policy.Allowlists[id] = allowlist
+	}
+
+	return policy, nil
+}
+
+// proofLimits returns the limits of proof files from untrusted sources.
+func (c *Config) proofLimits() proof.FileLimits {
+	return proof.FileLimits{
+		MaxFileSize:  c.MaxProofFileSize,
+		MaxNumProofs: c.MaxProofTransitions,
+	}
+}
+
+// supplyVerifyAssets returns the universe IDs of the assets and asset groups
+// whose supply is verified periodically.
+func (c *Config) supplyVerifyAssets() ([]universe.Identifier, error) {
+	var ids []universe.Identifier
+	for _, entry := range c.Universe.SupplyVerifyAssets {
+		entryBytes, err := hex.DecodeString(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex: %v", entry)
+		}
+
+		switch len(entryBytes) {
+		case len(asset.ID{}):
+			var id asset.ID
+			copy(id[:], entryBytes)
+			ids = append(ids, universe.Identifier{AssetID: id})
+
+		case btcec.PubKeyBytesLenCompressed:
+			groupKey, err := btcec.ParsePubKey(entryBytes)
+			if err != nil {
+				return nil, fmt.Errorf("invalid group key %v: "+
+					"%w", entry, err)
+			}
+			ids = append(ids, universe.Identifier{
+				GroupKey: groupKey,
+			})
+
+		default:
+			return nil, fmt.Errorf("expected asset ID or group "+
+				"key, got %v", entry)
+		}
+	}
+
+	return ids, nil
+}
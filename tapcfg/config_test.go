@@ -0,0 +1,40 @@
+package tapcfg
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/btcsuite/btclog"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/stretchr/testify/require"
+)
+
+// TestValidateConfigTestNet4 tests that the daemon config can be started on
+// testnet4 and connects to lnd as a testnet node.
+func TestValidateConfigTestNet4(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.TapdDir = t.TempDir()
+	cfg.ChainConf.Network = address.TestNet4Params.Name
+
+	validCfg, err := ValidateConfig(cfg, btclog.Disabled)
+	require.NoError(t, err)
+
+	require.Equal(
+		t, address.TestNet4Params.Name, validCfg.ActiveNetParams.Name,
+	)
+
+	// The data of testnet4 is kept apart from the one of testnet3.
+	require.Equal(
+		t, filepath.Join(validCfg.DataDir, "testnet4"),
+		validCfg.networkDir,
+	)
+	require.Equal(
+		t, address.TestNet4Tap.TapHRP,
+		address.ParamsForChain(validCfg.ActiveNetParams.Name).TapHRP,
+	)
+	require.Equal(
+		t, lndclient.NetworkTestnet,
+		lndNetwork(validCfg.ChainConf.Network),
+	)
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	prand "math/rand"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btclog"
 	"github.com/lightninglabs/lndclient"
 	tap "github.com/lightninglabs/taproot-assets"
@@ -18,8 +20,10 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightninglabs/taproot-assets/webhook"
 	"github.com/lightningnetwork/lnd"
 	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/lightningnetwork/lnd/ticker"
 )
@@ -92,9 +96,79 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		addrBookDB, &tapChainParams, defaultClock,
 	)
 
+	keyDerivationDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.KeyDerivationStore {
+			return db.WithTx(tx)
+		},
+	)
+	keyAuditLog := tapdb.NewKeyAuditLog(keyDerivationDB)
+
+	aliasDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.AssetAliasStore {
+			return db.WithTx(tx)
+		},
+	)
+	assetAliases := tapdb.NewAssetAliases(aliasDB, defaultClock)
+
+	counterpartyDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.CounterpartyStore {
+			return db.WithTx(tx)
+		},
+	)
+	counterparties := tapdb.NewCounterparties(counterpartyDB, defaultClock)
+
+	sendLimitDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.SendLimitStore {
+			return db.WithTx(tx)
+		},
+	)
+	sendLimits := tapdb.NewSendLimits(sendLimitDB, defaultClock)
+
+	importedKeyDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.ImportedKeyStore {
+			return db.WithTx(tx)
+		},
+	)
+	importedKeys := tapdb.NewImportedScriptKeys(importedKeyDB, defaultClock)
+
+	invoiceDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.InvoiceStore {
+			return db.WithTx(tx)
+		},
+	)
+	invoices := tapdb.NewInvoices(invoiceDB, defaultClock)
+
+	watchedAssetDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.WatchedAssetStore {
+			return db.WithTx(tx)
+		},
+	)
+	watchedAssets := tapdb.NewWatchedAssets(watchedAssetDB)
+
+	airdropDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.AirdropStore {
+			return db.WithTx(tx)
+		},
+	)
+	airdrops := tapdb.NewAirdrops(airdropDB, defaultClock)
+
+	templateDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.TemplateStore {
+			return db.WithTx(tx)
+		},
+	)
+	transferTemplates := tapdb.NewTransferTemplates(
+		templateDB, defaultClock,
+	)
+
 	keyRing := tap.NewLndRpcKeyRing(lndServices)
 	walletAnchor := tap.NewLndRpcWalletAnchor(lndServices)
-	chainBridge := tap.NewLndRpcChainBridge(lndServices)
+	mempoolSource, err := cfg.mempoolSource()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create mempool source: %w",
+			err)
+	}
+	chainBridge := tap.NewLndRpcChainBridge(lndServices, mempoolSource)
 
 	addrBook := address.NewBook(address.BookConfig{
 		Store:        tapdbAddrBook,
@@ -117,6 +191,21 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	)
 	multiverse := tapdb.NewBaseMultiverse(multiverseDB)
 
+	// Before any tree is used, we replay or roll back all batched tree
+	// updates that were interrupted by a crash.
+	treeWalDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.TreeWalStore {
+			return db.WithTx(tx)
+		},
+	)
+	err = tapdb.RecoverTreeWal(
+		context.Background(), treeWalDB, defaultClock,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to recover tree write-ahead "+
+			"log: %w", err)
+	}
+
 	uniStatsDB := tapdb.NewTransactionExecutor(
 		db, func(tx *sql.Tx) tapdb.UniverseStatsStore {
 			return db.WithTx(tx)
@@ -127,6 +216,16 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	headerVerifier := tapgarden.GenHeaderVerifier(
 		context.Background(), chainBridge,
 	)
+
+	// All proofs we import, receive through the proof courier or register
+	// in the universe share the same pool of verification workers and the
+	// same limits.
+	proofLimits := cfg.proofLimits()
+	proofVerifier := proof.NewVerifierPool(
+		cfg.ProofVerifyWorkers,
+		proof.WithVerifyCache(cfg.ProofVerifyCacheSize),
+		proof.WithFileLimits(proofLimits),
+	)
 	uniCfg := universe.MintingArchiveConfig{
 		NewBaseTree: func(id universe.Identifier) universe.BaseBackend {
 			return tapdb.NewBaseUniverseTree(
@@ -134,6 +233,8 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			)
 		},
 		HeaderVerifier: headerVerifier,
+		ProofVerifier:  proofVerifier,
+		ProofLimits:    proofLimits,
 		Multiverse:     multiverse,
 		UniverseStats:  universeStats,
 	}
@@ -151,13 +252,110 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	if err != nil {
 		return nil, fmt.Errorf("unable to open disk archive: %v", err)
 	}
+
+	// If tiering is enabled, the proofs of spent outputs are moved from
+	// the on-disk archive to a compressed cold store.
+	var (
+		proofTiers  *proof.TieredArchiver
+		diskArchive proof.Archiver = proofFileStore
+	)
+	if cfg.ProofTiering != nil && cfg.ProofTiering.Enable {
+		coldStore, err := proof.NewFileArchiver(
+			cfg.ProofTiering.ColdDir, proof.WithCompressedProofs(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open cold proof "+
+				"archive: %v", err)
+		}
+
+		proofTiers = proof.NewTieredArchiver(&proof.TieredArchiverConfig{
+			Hot:           proofFileStore,
+			Cold:          coldStore,
+			SpentProofs:   spentProofLocators(assetStore),
+			SweepInterval: cfg.ProofTiering.SweepInterval,
+		})
+		diskArchive = proofTiers
+	}
+
+	// With a central proof archive, the on-disk archive only acts as a
+	// cache and all proofs are pushed to the central archive.
+	if cfg.ProofArchive != nil && cfg.ProofArchive.Host != "" {
+		remoteArchive, err := tap.NewRemoteProofArchive(
+			cfg.ProofArchive.Host, cfg.ProofArchive.TLSCertPath,
+			cfg.ProofArchive.MacaroonPath,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to connect to central "+
+				"proof archive: %v", err)
+		}
+
+		diskArchive = proof.NewFederatedArchiver(
+			diskArchive, remoteArchive,
+		)
+	}
+
+	var proofArchiveUsers *proof.UserArchives
+	if cfg.ProofArchive != nil && cfg.ProofArchive.Serve {
+		proofArchiveUsers = proof.NewUserArchives(cfg.ProofArchive.Dir)
+	}
+
 	proofArchive := proof.NewMultiArchiver(
-		&proof.BaseVerifier{}, tapdb.DefaultStoreTimeout,
-		assetStore, proofFileStore,
+		proofVerifier, tapdb.DefaultStoreTimeout, assetStore,
+		diskArchive,
+	)
+
+	metaFetcher, err := proof.NewMetaFetcher(
+		cfg.MetaFetcher, cfg.networkDir,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create meta fetcher: %v", err)
+	}
+
+	var ipfsClient *proof.IPFSClient
+	if cfg.IPFS != nil && cfg.IPFS.APIAddr != "" {
+		ipfsClient, err = proof.NewIPFSClient(cfg.IPFS)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create IPFS client: "+
+				"%v", err)
+		}
+	}
+
+	var (
+		proofCourier     proof.Courier[proof.Recipient]
+		proofCourierInfo proof.CourierInfo
 	)
+	switch {
+	// A courier of an externally registered driver replaces the hashmail
+	// courier.
+	case cfg.ProofCourierAddr != "":
+		driverCfg := &proof.CourierDriverCfg{
+			DeliveryLog: assetStore,
+			KeyDeriver:  lndServices.Signer,
+		}
+		if cfg.HashMailCourier != nil {
+			driverCfg.ReceiverAckTimeout =
+				cfg.HashMailCourier.ReceiverAckTimeout
+			driverCfg.BackoffCfg = cfg.HashMailCourier.BackoffCfg
+		}
 
-	var hashMailCourier proof.Courier[proof.Recipient]
-	if cfg.HashMailCourier != nil {
+		proofCourier, err = proof.NewCourier(
+			cfg.ProofCourierAddr, driverCfg,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to make proof courier: "+
+				"%v", err)
+		}
+
+		courierAddr, err := proof.ParseCourierAddr(cfg.ProofCourierAddr)
+		if err != nil {
+			return nil, err
+		}
+		proofCourierInfo = proof.CourierInfo{
+			Scheme: courierAddr.Scheme,
+			Addr:   cfg.ProofCourierAddr,
+		}
+
+	case cfg.HashMailCourier != nil:
 		hashMailBox, err := proof.NewHashMailBox(
 			cfg.HashMailCourier.Addr,
 			cfg.HashMailCourier.TlsCertPath,
@@ -167,13 +365,68 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 				err)
 		}
 
-		hashMailCourier, err = proof.NewHashMailCourier(
-			cfg.HashMailCourier, hashMailBox, assetStore,
+		// All mailboxes we create on the hashmail service are tracked,
+		// so they can be removed once they were claimed or expired.
+		hashMailCfg := cfg.HashMailCourier
+		hashMailCfg.ProofLimits = proofLimits
+		var mailbox proof.ProofMailbox = proof.NewMailboxManager(
+			hashMailBox, &proof.MailboxManagerCfg{
+				Expiry:        hashMailCfg.MailboxExpiry,
+				ClaimedExpiry: proof.DefaultMailboxClaimedExpiry,
+				MaxMessages:   hashMailCfg.MailboxMaxMessages,
+				MaxBytes:      hashMailCfg.MailboxMaxBytes,
+				Clock:         defaultClock,
+			},
+		)
+
+		// Proofs sent in chunks can always be received, outgoing proofs
+		// are only chunked if a chunk size is configured.
+		maxChunkedProofSize := int64(proof.DefaultMaxChunkedProofSize)
+		if cfg.MaxProofFileSize != 0 {
+			maxChunkedProofSize = int64(cfg.MaxProofFileSize)
+		}
+		mailbox = proof.NewChunkedMailBox(
+			mailbox, hashMailCfg.ChunkSize, maxChunkedProofSize,
+		)
+
+		// If we have access to IPFS, we're able to receive proofs that
+		// were pinned there. We only pin our own outgoing proofs if
+		// the IPFS courier mode is active though, as receivers without
+		// IPFS access wouldn't be able to resolve them.
+		if ipfsClient != nil {
+			mailbox = proof.NewIPFSMailBox(
+				mailbox, ipfsClient,
+				cfg.ProofCourierMode == proofCourierModeIPFS,
+			)
+		}
+
+		// Outgoing proofs are signed with the identity key of the
+		// lnd node, if the hashmail config asks for it.
+		nodeKey, err := btcec.ParsePubKey(lndServices.NodePubkey[:])
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse node key: %w",
+				err)
+		}
+		deliverySigner := &proof.DeliverySigner{
+			Signer:  lndServices.Signer,
+			NodeKey: nodeKey,
+		}
+
+		proofCourier, err = proof.NewHashMailCourier(
+			cfg.HashMailCourier, mailbox, assetStore,
+			lndServices.Signer, deliverySigner,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to make hashmail "+
 				"courier: %v", err)
 		}
+
+		proofCourierInfo = proof.CourierInfo{
+			Scheme: proof.HashMailCourierScheme,
+			Addr:   cfg.HashMailCourier.Addr,
+			PinsToIPFS: ipfsClient != nil &&
+				cfg.ProofCourierMode == proofCourierModeIPFS,
+		}
 	}
 
 	reOrgWatcher := tapgarden.NewReOrgWatcher(&tapgarden.ReOrgWatcherConfig{
@@ -207,6 +460,8 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		LocalDiffEngine:     baseUni,
 		NewRemoteDiffEngine: tap.NewRpcUniverseDiff,
 		LocalRegistrar:      baseUni,
+		SyncCheckpoints:     federationDB,
+		ProofQuarantine:     federationDB,
 	})
 
 	federationMembers := cfg.Universe.FederationServers
@@ -240,18 +495,333 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	)
 
 	virtualTxSigner := tap.NewLndRpcVirtualTxSigner(lndServices)
-	coinSelect := tapfreighter.NewCoinSelect(assetStore)
+	var coinSelectOpts []tapfreighter.CoinSelectOption
+	confPolicy, err := cfg.anchorConfPolicy()
+	if err != nil {
+		return nil, err
+	}
+	if confPolicy != nil {
+		coinSelectOpts = append(
+			coinSelectOpts, tapfreighter.WithAnchorConfPolicy(
+				chainBridge, confPolicy,
+			),
+		)
+	}
+	if cfg.SpendUnconfirmedChange {
+		coinSelectOpts = append(
+			coinSelectOpts, tapfreighter.WithUnconfirmedChange(
+				assetStore,
+			),
+		)
+	}
+	if cfg.ExternalCoinSelect != nil && cfg.ExternalCoinSelect.Addr != "" {
+		externalSelector, err := tap.NewRpcCoinSelector(
+			&tap.RpcCoinSelectorCfg{
+				Addr:        cfg.ExternalCoinSelect.Addr,
+				TLSCertPath: cfg.ExternalCoinSelect.TLSCertPath,
+				Insecure:    cfg.ExternalCoinSelect.Insecure,
+				Timeout:     cfg.ExternalCoinSelect.Timeout,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		cfgLogger.Infof("Delegating coin selection to external "+
+			"service at %v", cfg.ExternalCoinSelect.Addr)
+
+		coinSelectOpts = append(
+			coinSelectOpts, tapfreighter.WithExternalCoinSelector(
+				externalSelector,
+			),
+		)
+	}
+	coinSelect := tapfreighter.NewCoinSelect(assetStore, coinSelectOpts...)
+
+	spendPolicy, err := cfg.spendPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	// In watch-only mode, the virtual transactions of transfers are signed
+	// by a remote or offline signer instead of the connected lnd node.
+	var (
+		offlineSigner *tapfreighter.OfflineSigner
+		virtualSigner tapfreighter.VirtualPacketSigner
+	)
+	switch {
+	case cfg.WatchOnly && cfg.RemoteSigner != nil &&
+		cfg.RemoteSigner.Host != "":
+
+		remoteSigner, err := tap.NewRpcVirtualPacketSigner(
+			&tap.RpcVirtualPacketSignerCfg{
+				Host:         cfg.RemoteSigner.Host,
+				TLSCertPath:  cfg.RemoteSigner.TLSCertPath,
+				MacaroonPath: cfg.RemoteSigner.MacaroonPath,
+				ClientName:   cfg.RemoteSigner.ClientName,
+				Timeout:      cfg.WatchOnlySignTimeout,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		cfgLogger.Infof("Signing virtual packets with remote signer "+
+			"at %v", cfg.RemoteSigner.Host)
+
+		virtualSigner = remoteSigner
+
+	case cfg.WatchOnly:
+		offlineSigner = tapfreighter.NewOfflineSigner(
+			cfg.WatchOnlySignTimeout,
+		)
+		virtualSigner = offlineSigner
+	}
+
+	anchorOutputValue := btcutil.Amount(cfg.AnchorDustThreshold)
 	assetWallet := tapfreighter.NewAssetWallet(&tapfreighter.WalletConfig{
-		CoinSelector: coinSelect,
-		AssetProofs:  proofArchive,
-		AddrBook:     tapdbAddrBook,
-		KeyRing:      keyRing,
-		Signer:       virtualTxSigner,
-		TxValidator:  &tap.ValidatorV0{},
-		Wallet:       walletAnchor,
-		ChainParams:  &tapChainParams,
+		CoinSelector:           coinSelect,
+		AssetProofs:            proofArchive,
+		AddrBook:               tapdbAddrBook,
+		KeyRing:                keyRing,
+		KeyAuditLog:            keyAuditLog,
+		Signer:                 virtualTxSigner,
+		TxValidator:            &tap.ValidatorV0{},
+		VirtualSigner:          virtualSigner,
+		Wallet:                 walletAnchor,
+		ChainParams:            &tapChainParams,
+		SpendPolicy:            spendPolicy,
+		AnchorOutputValue:      anchorOutputValue,
+		AnchorChangeToExisting: cfg.AnchorChangeToExisting,
+		SelectStrategy:         cfg.coinSelectStrategy(),
+		ChangeKeyPolicy:        cfg.changeKeyPolicy(),
+		ChangeSplit: tapfreighter.ChangeSplitConfig{
+			NumOutputs: cfg.ChangeSplitOutputs,
+			MinAmount:  cfg.ChangeSplitMinAmount,
+		},
+	})
+
+	// Sign the virtual packets of watch-only daemons if we're configured
+	// to act as their remote signer.
+	var remoteSigner *tapfreighter.RemoteSigner
+	if cfg.RemoteSigner != nil && cfg.RemoteSigner.Serve {
+		policies, err := cfg.RemoteSigner.signPolicies(keyRing)
+		if err != nil {
+			return nil, err
+		}
+
+		lifetime := cfg.RemoteSigner.SessionLifetime
+		remoteSigner = tapfreighter.NewRemoteSigner(
+			&tapfreighter.RemoteSignerConfig{
+				Signer:          assetWallet,
+				Policies:        policies,
+				SessionLifetime: lifetime,
+				Clock:           defaultClock,
+			},
+		)
+	}
+
+	assetCustodian := tapgarden.NewCustodian(&tapgarden.CustodianConfig{
+		ChainParams:   &tapChainParams,
+		WalletAnchor:  walletAnchor,
+		ChainBridge:   chainBridge,
+		AddrBook:      addrBook,
+		ProofArchive:  proofArchive,
+		ProofNotifier: assetStore,
+		ErrChan:       mainErrChan,
+		ProofCourier:  proofCourier,
+		ProofWatcher:  reOrgWatcher,
+		Invoices:      invoices,
+	})
+
+	feeEstimator, err := cfg.feeEstimator(chainBridge)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create fee estimator: %w",
+			err)
+	}
+
+	batchDeferFeeRate := chainfee.SatPerKVByte(
+		cfg.ParcelBatchDeferFeeRate * 1000,
+	).FeePerKWeight()
+
+	chainPorter := tapfreighter.NewChainPorter(
+		&tapfreighter.ChainPorterConfig{
+			Signer:                      virtualTxSigner,
+			TxValidator:                 &tap.ValidatorV0{},
+			TransferLog:                 assetStore,
+			PendingParcels:              assetStore,
+			DeliveryLog:                 assetStore,
+			ConfirmedParcels:            assetStore,
+			ParcelRequests:              assetStore,
+			ChainBridge:                 chainBridge,
+			FeeEstimator:                feeEstimator,
+			Wallet:                      walletAnchor,
+			KeyRing:                     keyRing,
+			AssetWallet:                 assetWallet,
+			AssetProofs:                 proofFileStore,
+			ProofCourier:                proofCourier,
+			ProofWatcher:                reOrgWatcher,
+			NumParcelWorkers:            cfg.ParcelWorkers,
+			NumUrgentParcelWorkers:      cfg.UrgentParcelWorkers,
+			ParcelBatchInterval:         cfg.ParcelBatchInterval,
+			BatchDeferFeeRate:           batchDeferFeeRate,
+			MaxInFlightParcels:          cfg.MaxInFlightParcels,
+			MaxPassiveProofs:            cfg.MaxPassiveProofs,
+			MaxParcelProofBytes:         cfg.MaxParcelProofBytes,
+			BackendFailureThreshold:     cfg.BackendFailureThreshold,
+			ReceiverProbeMode:           cfg.receiverProbeMode(),
+			ReceiverProbeTimeout:        cfg.ReceiverProbeTimeout,
+			AnchorKeyLog:                assetStore,
+			AnchorKeyReuseMode:          cfg.anchorKeyReuseMode(),
+			ScriptKeyAuditLog:           assetStore,
+			CounterpartyBook:            counterparties,
+			StrictCounterparties:        cfg.StrictCounterparties,
+			ImportedKeys:                importedKeys,
+			FaultHooks:                  cfg.FaultHooks,
+			StateHooks:                  cfg.StateHooks,
+			CompletionHandler:           cfg.CompletionHandler,
+			FeeBumper:                   walletAnchor,
+			VerifyProofsBeforeBroadcast: cfg.VerifyProofsBeforeBroadcast,
+			ErrChan:                     mainErrChan,
+		},
+	)
+
+	airdropper := tapfreighter.NewAirdropper(&tapfreighter.AirdropperConfig{
+		Store:       airdrops,
+		Porter:      chainPorter,
+		ChainParams: &tapChainParams,
+	})
+
+	templater := tapfreighter.NewTemplater(&tapfreighter.TemplaterConfig{
+		Store:       transferTemplates,
+		Porter:      chainPorter,
+		AssetGroups: tapdbAddrBook,
+		ChainParams: &tapChainParams,
+	})
+
+	anchorCoordinator := tapfreighter.NewAnchorCoordinator(chainPorter)
+
+	assetWatcher := tapgarden.NewAssetWatcher(&tapgarden.AssetWatcherConfig{
+		Store:       watchedAssets,
+		ChainBridge: chainBridge,
 	})
 
+	anchorSpendMonitor := tapgarden.NewAnchorSpendMonitor(
+		&tapgarden.AnchorSpendMonitorConfig{
+			Store:       assetStore,
+			ChainBridge: chainBridge,
+		},
+	)
+
+	webhookCfg := &webhook.NotifierConfig{
+		Cfg:           cfg.Webhook,
+		SendEvents:    chainPorter,
+		ReceiveEvents: assetCustodian,
+		WatchEvents:   assetWatcher,
+		AnchorEvents:  anchorSpendMonitor,
+	}
+
+	// If enabled, the proofs of all unspent assets are periodically
+	// re-read from both archives, and damaged proofs are posted as webhook
+	// alerts.
+	var proofScrubber *proof.ProofScrubber
+	if cfg.ProofScrubInterval > 0 {
+		proofScrubber = proof.NewProofScrubber(
+			&proof.ProofScrubberConfig{
+				Archives: []proof.ScrubArchive{{
+					Name:    "database",
+					Archive: assetStore,
+				}, {
+					Name:    "disk",
+					Archive: diskArchive,
+				}},
+				ProofLocators: unspentProofLocators(
+					assetStore,
+				),
+				Verifier:         proofVerifier,
+				HeaderVerifier:   headerVerifier,
+				Limits:           proofLimits,
+				Interval:         cfg.ProofScrubInterval,
+				VerifySampleSize: cfg.ProofScrubVerifySample,
+			},
+		)
+		webhookCfg.ScrubEvents = proofScrubber
+	}
+
+	// Reused script keys are flagged by the script key auditor, which
+	// blocks transfers to them until the flag is resolved.
+	scriptKeyAuditor := tapfreighter.NewScriptKeyAuditor(
+		&tapfreighter.ScriptKeyAuditorConfig{
+			AuditLog:      assetStore,
+			ProofArchive:  diskArchive,
+			UnspentProofs: anchoredProofLocators(assetStore),
+			Interval:      cfg.ScriptKeyAuditInterval,
+		},
+	)
+
+	// If enabled, the local holdings are periodically reconciled with the
+	// universe supply, and mismatches are posted as webhook alerts.
+	var supplyReconciler *universe.SupplyReconciler
+	if cfg.Universe.SupplyReconcileInterval > 0 {
+		supplyReconciler = universe.NewSupplyReconciler(
+			&universe.SupplyReconcilerConfig{
+				Universe: baseUni,
+				Holdings: localHoldings(assetStore),
+				Interval: cfg.Universe.SupplyReconcileInterval,
+			},
+		)
+		webhookCfg.SupplyEvents = supplyReconciler
+	}
+
+	// The supply of the selected assets is periodically verified against
+	// the chain, the supply of any other asset only on demand.
+	supplyVerifyAssets, err := cfg.supplyVerifyAssets()
+	if err != nil {
+		return nil, err
+	}
+	nodeKey, err := btcec.ParsePubKey(lndServices.NodePubkey[:])
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse node key: %w", err)
+	}
+	supplyVerifier := universe.NewSupplyVerifier(
+		&universe.SupplyVerifierConfig{
+			Universe:       baseUni,
+			HeaderVerifier: headerVerifier,
+			Signer:         lndServices.Signer,
+			NodeKey:        nodeKey,
+			Assets:         supplyVerifyAssets,
+			Interval:       cfg.Universe.SupplyVerifyInterval,
+		},
+	)
+
+	webhookNotifier := webhook.NewNotifier(webhookCfg)
+
+	var balanceSnapshotter *tapfreighter.BalanceSnapshotter
+	if cfg.BalanceSnapshotInterval > 0 {
+		balanceSnapshotter = tapfreighter.NewBalanceSnapshotter(
+			&tapfreighter.BalanceSnapshotterConfig{
+				Store:       assetStore,
+				ChainBridge: chainBridge,
+				Interval:    cfg.BalanceSnapshotInterval,
+			},
+		)
+	}
+
+	var tombstoneSweeper *tapfreighter.TombstoneSweeper
+	if cfg.TombstoneSweepInterval > 0 {
+		tombstoneSweeper = tapfreighter.NewTombstoneSweeper(
+			&tapfreighter.TombstoneSweeperConfig{
+				Store:       assetStore,
+				Wallet:      walletAnchor,
+				AddrSource:  walletAnchor,
+				ChainBridge: chainBridge,
+				ChainParams: &tapChainParams,
+				Interval:    cfg.TombstoneSweepInterval,
+				MaxInputs:   cfg.TombstoneSweepMaxInputs,
+			},
+		)
+	}
+
 	return &tap.Config{
 		DebugLevel:                 cfg.DebugLevel,
 		RuntimeID:                  runtimeID,
@@ -265,6 +835,7 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 				ChainBridge: chainBridge,
 				Log:         assetMintingStore,
 				KeyRing:     keyRing,
+				KeyAuditLog: keyAuditLog,
 				GenSigner: tap.NewLndRpcGenSigner(
 					lndServices,
 				),
@@ -276,43 +847,41 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			ProofUpdates: proofArchive,
 			ErrChan:      mainErrChan,
 		}),
-		AssetCustodian: tapgarden.NewCustodian(
-			&tapgarden.CustodianConfig{
-				ChainParams:   &tapChainParams,
-				WalletAnchor:  walletAnchor,
-				ChainBridge:   chainBridge,
-				AddrBook:      addrBook,
-				ProofArchive:  proofArchive,
-				ProofNotifier: assetStore,
-				ErrChan:       mainErrChan,
-				ProofCourier:  hashMailCourier,
-				ProofWatcher:  reOrgWatcher,
-			},
-		),
-		ChainBridge:  chainBridge,
-		AddrBook:     addrBook,
-		ProofArchive: proofArchive,
-		AssetWallet:  assetWallet,
-		CoinSelect:   coinSelect,
-		ChainPorter: tapfreighter.NewChainPorter(
-			&tapfreighter.ChainPorterConfig{
-				Signer:       virtualTxSigner,
-				TxValidator:  &tap.ValidatorV0{},
-				ExportLog:    assetStore,
-				ChainBridge:  chainBridge,
-				Wallet:       walletAnchor,
-				KeyRing:      keyRing,
-				AssetWallet:  assetWallet,
-				AssetProofs:  proofFileStore,
-				ProofCourier: hashMailCourier,
-				ProofWatcher: reOrgWatcher,
-				ErrChan:      mainErrChan,
-			},
-		),
+		AssetCustodian:     assetCustodian,
+		AssetWatcher:       assetWatcher,
+		AnchorSpendMonitor: anchorSpendMonitor,
+		ChainBridge:        chainBridge,
+		KeyRing:            keyRing,
+		AddrBook:           addrBook,
+		ProofArchive:       proofArchive,
+		MetaFetcher:        metaFetcher,
+		IPFSClient:         ipfsClient,
+		ProofCourierInfo:   proofCourierInfo,
+		AssetWallet:        assetWallet,
+		CoinSelect:         coinSelect,
+		ChainPorter:        chainPorter,
+		Airdropper:         airdropper,
+		Templater:          templater,
+		AnchorCoordinator:  anchorCoordinator,
+		WebhookNotifier:    webhookNotifier,
+		ProofTiers:         proofTiers,
+		ProofScrubber:      proofScrubber,
+		ScriptKeyAuditor:   scriptKeyAuditor,
+		ProofArchiveUsers:  proofArchiveUsers,
+		SupplyReconciler:   supplyReconciler,
+		SupplyVerifier:     supplyVerifier,
+		BalanceSnapshotter: balanceSnapshotter,
+		TombstoneSweeper:   tombstoneSweeper,
 		BaseUniverse:       baseUni,
 		UniverseSyncer:     universeSyncer,
 		UniverseFederation: universeFederation,
 		UniverseStats:      universeStats,
+		AliasResolver:      asset.NewAliasResolver(assetAliases, nil),
+		CounterpartyBook:   counterparties,
+		ImportedKeys:       importedKeys,
+		Invoices:           invoices,
+		OfflineSigner:      offlineSigner,
+		RemoteSigner:       remoteSigner,
 		LogWriter:          cfg.LogWriter,
 		DatabaseConfig: &tap.DatabaseConfig{
 			RootKeyStore: tapdb.NewRootKeyStore(rksDB),
@@ -321,6 +890,8 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			TapAddrBook:  tapdbAddrBook,
 			Multiverse:   multiverse,
 			FederationDB: federationDB,
+			KeyAuditLog:  keyAuditLog,
+			SendLimits:   sendLimits,
 		},
 	}, nil
 }
@@ -406,3 +977,128 @@ func CreateSubServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
 
 	return tap.NewServer(serverCfg), nil
 }
+
+// spentProofLocators returns a function that lists the proof locators of all
+// spent assets in the given asset store.
+func spentProofLocators(
+	assetStore *tapdb.AssetStore) func(context.Context) ([]proof.Locator,
+	error) {
+
+	return func(ctx context.Context) ([]proof.Locator, error) {
+		assets, err := assetStore.FetchAllAssets(ctx, true, false, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var locators []proof.Locator
+		for _, a := range assets {
+			if !a.IsSpent {
+				continue
+			}
+
+			assetID := a.ID()
+			locators = append(locators, proof.Locator{
+				AssetID:   &assetID,
+				ScriptKey: *a.ScriptKey.PubKey,
+			})
+		}
+
+		return locators, nil
+	}
+}
+
+// unspentProofLocators returns a function that lists the proof locators of
+// all unspent assets in the given asset store, including leased ones.
+func unspentProofLocators(
+	assetStore *tapdb.AssetStore) func(context.Context) ([]proof.Locator,
+	error) {
+
+	return func(ctx context.Context) ([]proof.Locator, error) {
+		assets, err := assetStore.FetchAllAssets(ctx, false, true, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		locators := make([]proof.Locator, len(assets))
+		for idx, a := range assets {
+			assetID := a.ID()
+			locators[idx] = proof.Locator{
+				AssetID:   &assetID,
+				ScriptKey: *a.ScriptKey.PubKey,
+			}
+		}
+
+		return locators, nil
+	}
+}
+
+// anchoredProofLocators returns a function that lists the proof locators of
+// all unspent assets in the given asset store along with their anchor outputs,
+// including leased ones.
+func anchoredProofLocators(
+	assetStore *tapdb.AssetStore) func(context.Context) (
+	[]tapfreighter.AnchoredLocator, error) {
+
+	return func(ctx context.Context) ([]tapfreighter.AnchoredLocator,
+		error) {
+
+		assets, err := assetStore.FetchAllAssets(ctx, false, true, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		locators := make([]tapfreighter.AnchoredLocator, len(assets))
+		for idx, a := range assets {
+			assetID := a.ID()
+			locators[idx] = tapfreighter.AnchoredLocator{
+				Locator: proof.Locator{
+					AssetID:   &assetID,
+					ScriptKey: *a.ScriptKey.PubKey,
+				},
+				AnchorPoint: a.AnchorOutpoint,
+			}
+		}
+
+		return locators, nil
+	}
+}
+
+// localHoldings returns a function that sums up the unspent assets in the
+// given asset store per universe, which is the asset group for grouped assets
+// and the asset ID otherwise.
+func localHoldings(
+	assetStore *tapdb.AssetStore) func(context.Context) ([]universe.Holding,
+	error) {
+
+	return func(ctx context.Context) ([]universe.Holding, error) {
+		assets, err := assetStore.FetchAllAssets(ctx, false, true, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			holdings []universe.Holding
+			index    = make(map[[32]byte]int)
+		)
+		for _, a := range assets {
+			id := universe.Identifier{
+				AssetID: a.ID(),
+			}
+			if a.GroupKey != nil {
+				id.GroupKey = &a.GroupKey.GroupPubKey
+			}
+
+			idx, ok := index[id.Bytes()]
+			if !ok {
+				idx = len(holdings)
+				index[id.Bytes()] = idx
+				holdings = append(holdings, universe.Holding{
+					ID: id,
+				})
+			}
+			holdings[idx].Balance += a.Amount
+		}
+
+		return holdings, nil
+	}
+}
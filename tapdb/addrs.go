@@ -58,6 +58,10 @@ type (
 	// and its corresponding address.
 	AddrEventID = sqlc.QueryEventIDsRow
 
+	// NewAddrDepositExpectation is a type alias for the params to create
+	// or update the deposit expectation of an address.
+	NewAddrDepositExpectation = sqlc.UpsertAddrDepositExpectationParams
+
 	// Genesis is a type alias for fetching the genesis asset information.
 	Genesis = sqlc.FetchGenesisByIDRow
 
@@ -119,6 +123,11 @@ type AddrBook interface {
 	// key.
 	FetchAddrEvent(ctx context.Context, id int32) (AddrEvent, error)
 
+	// UpsertAddrDepositExpectation inserts a new or updates an existing
+	// deposit expectation of an address.
+	UpsertAddrDepositExpectation(ctx context.Context,
+		arg NewAddrDepositExpectation) error
+
 	// QueryEventIDs returns a list of event IDs and their corresponding
 	// address IDs that match the given query parameters.
 	QueryEventIDs(ctx context.Context, query AddrEventQuery) ([]AddrEventID,
@@ -278,6 +287,28 @@ func (t *TapAddressBook) InsertAddrs(ctx context.Context,
 				return fmt.Errorf("unable to insert addr: %w",
 					err)
 			}
+
+			if addr.Expectation == nil {
+				continue
+			}
+
+			err = db.UpsertAddrDepositExpectation(
+				ctx, NewAddrDepositExpectation{
+					TaprootOutputKey: schnorr.SerializePubKey(
+						&addr.TaprootOutputKey,
+					),
+					ExpectedAmount: int64(
+						addr.Expectation.Amount,
+					),
+					Expiry: sqlOptTime(
+						addr.Expectation.Expiry,
+					),
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to insert deposit "+
+					"expectation: %w", err)
+			}
 		}
 
 		return nil
@@ -436,6 +467,9 @@ func (t *TapAddressBook) QueryAddrs(ctx context.Context,
 				TaprootOutputKey: *taprootOutputKey,
 				CreationTime:     addr.CreationTime.UTC(),
 				ManagedAfter:     addr.ManagedFrom.Time.UTC(),
+				Expectation: parseDepositExpectation(
+					addr.ExpectedAmount, addr.DepositExpiry,
+				),
 			})
 		}
 
@@ -571,9 +605,32 @@ func fetchAddr(ctx context.Context, db AddrBook, params *address.ChainParams,
 		InternalKeyDesc:  internalKeyDesc,
 		TaprootOutputKey: *taprootOutputKey,
 		CreationTime:     dbAddr.CreationTime.UTC(),
+		Expectation: parseDepositExpectation(
+			dbAddr.ExpectedAmount, dbAddr.DepositExpiry,
+		),
 	}, nil
 }
 
+// parseDepositExpectation maps the optional deposit expectation columns of an
+// address to the native type. If the address wasn't annotated with an
+// expectation, nil is returned.
+func parseDepositExpectation(amount sql.NullInt64,
+	expiry sql.NullTime) *address.DepositExpectation {
+
+	if !amount.Valid {
+		return nil
+	}
+
+	expectation := &address.DepositExpectation{
+		Amount: uint64(amount.Int64),
+	}
+	if expiry.Valid {
+		expectation.Expiry = expiry.Time.UTC()
+	}
+
+	return expectation
+}
+
 // SetAddrManaged sets an address as being managed by the internal
 // wallet.
 func (t *TapAddressBook) SetAddrManaged(ctx context.Context,
@@ -836,9 +893,19 @@ func fetchEvent(ctx context.Context, db AddrBook, eventID int32,
 		Index: uint32(dbEvent.OutputIndex),
 	}
 
+	// Unless a status was already stored with the event, we classify the
+	// transfer by the time it was detected.
+	creationTime := dbEvent.CreationTime.UTC()
+	depositStatus := addr.Expectation.Check(creationTime)
+	if dbEvent.DepositStatus.Valid {
+		depositStatus = address.DepositStatus(
+			dbEvent.DepositStatus.Int16,
+		)
+	}
+
 	return &address.Event{
 		ID:                 eventID,
-		CreationTime:       dbEvent.CreationTime.UTC(),
+		CreationTime:       creationTime,
 		Addr:               addr,
 		Status:             address.Status(dbEvent.Status),
 		Outpoint:           op,
@@ -846,6 +913,7 @@ func fetchEvent(ctx context.Context, db AddrBook, eventID int32,
 		InternalKey:        internalKey,
 		ConfirmationHeight: uint32(dbEvent.ConfirmationHeight.Int32),
 		HasProof:           dbEvent.AssetProofID.Valid,
+		DepositStatus:      depositStatus,
 	}, nil
 }
 
@@ -864,7 +932,7 @@ func (t *TapAddressBook) CompleteEvent(ctx context.Context,
 			return fmt.Errorf("error fetching asset proof: %w", err)
 		}
 
-		_, err = db.UpsertAddrEvent(ctx, UpsertAddrEvent{
+		upsert := UpsertAddrEvent{
 			TaprootOutputKey: schnorr.SerializePubKey(
 				&event.Addr.TaprootOutputKey,
 			),
@@ -873,7 +941,15 @@ func (t *TapAddressBook) CompleteEvent(ctx context.Context,
 			ChainTxnOutputIndex: int32(anchorPoint.Index),
 			AssetProofID:        sqlInt32(proofData.ProofID),
 			AssetID:             sqlInt32(proofData.AssetID),
-		})
+		}
+
+		// Only annotated addresses have a deposit status worth
+		// persisting.
+		if event.Addr.Expectation != nil {
+			upsert.DepositStatus = sqlInt16(event.DepositStatus)
+		}
+
+		_, err = db.UpsertAddrEvent(ctx, upsert)
 		return err
 	})
 }
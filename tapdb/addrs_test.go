@@ -457,6 +457,119 @@ func TestAddrEventCreation(t *testing.T) {
 	}
 }
 
+// TestAddrDepositExpectation tests that the deposit expectation of an address
+// is persisted and that events are flagged according to it.
+func TestAddrDepositExpectation(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	addrBook, db := newAddrBook(t, testClock)
+
+	ctx := context.Background()
+
+	// We create one address that expects a deposit before a future expiry,
+	// one that has already expired and one without any expectation. Until
+	// the proof is imported, only the lateness can be told.
+	now := testClock.Now()
+	testCases := []struct {
+		name           string
+		expiry         time.Time
+		annotated      bool
+		expectedStatus address.DepositStatus
+	}{{
+		name:           "on time",
+		expiry:         now.Add(time.Hour).UTC(),
+		annotated:      true,
+		expectedStatus: address.DepositExpected,
+	}, {
+		name:           "late",
+		expiry:         now.Add(-time.Hour).UTC(),
+		annotated:      true,
+		expectedStatus: address.DepositLate,
+	}, {
+		name:           "no expiry",
+		annotated:      true,
+		expectedStatus: address.DepositExpected,
+	}, {
+		name:           "unannotated",
+		expectedStatus: address.DepositUnannotated,
+	}}
+
+	addrs := make([]address.AddrWithKeyInfo, len(testCases))
+	for idx, tc := range testCases {
+		addr, assetGen, assetGroup := address.RandAddr(t, chainParams)
+		if tc.annotated {
+			addr.Expectation = &address.DepositExpectation{
+				Amount: addr.Amount,
+				Expiry: tc.expiry,
+			}
+		}
+		addrs[idx] = *addr
+
+		var writeTxOpts AddrBookTxOptions
+		err := addrBook.db.ExecTx(
+			ctx, &writeTxOpts,
+			insertFullAssetGen(ctx, assetGen, assetGroup),
+		)
+		require.NoError(t, err, tc.name)
+
+		err = addrBook.InsertAddrs(ctx, *addr)
+		require.NoError(t, err, tc.name)
+
+		dbAddr, err := addrBook.AddrByTaprootOutput(
+			ctx, &addr.TaprootOutputKey,
+		)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, addr.Expectation, dbAddr.Expectation, tc.name)
+
+		txn := randWalletTx()
+		event, err := addrBook.GetOrCreateEvent(
+			ctx, address.StatusTransactionDetected, dbAddr, txn, 0,
+		)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expectedStatus, event.DepositStatus, tc.name)
+
+		// Once the proof was imported, the final status is stored
+		// with the event and must not be overwritten by a later
+		// update of the event. We store a status that differs from
+		// the one we'd compute to make sure the stored one is used.
+		if !tc.annotated {
+			continue
+		}
+
+		storedStatus := address.DepositLate
+		if tc.expectedStatus == address.DepositLate {
+			storedStatus = address.DepositExpected
+		}
+
+		txHash := txn.Tx.TxHash()
+		_, err = db.UpsertAddrEvent(ctx, UpsertAddrEvent{
+			TaprootOutputKey: schnorr.SerializePubKey(
+				&addr.TaprootOutputKey,
+			),
+			Status:        int16(address.StatusCompleted),
+			Txid:          txHash[:],
+			DepositStatus: sqlInt16(storedStatus),
+		})
+		require.NoError(t, err, tc.name)
+
+		confirmTx(txn)
+		event, err = addrBook.GetOrCreateEvent(
+			ctx, address.StatusTransactionConfirmed, dbAddr, txn, 0,
+		)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, storedStatus, event.DepositStatus, tc.name)
+	}
+
+	// The expectations must also be returned when listing addresses.
+	dbAddrs, err := addrBook.QueryAddrs(ctx, address.QueryParams{})
+	require.NoError(t, err)
+	require.Len(t, dbAddrs, len(addrs))
+	for idx := range addrs {
+		require.Equal(t, addrs[idx].Expectation, dbAddrs[idx].Expectation)
+	}
+}
+
 // TestAddressEventQuery tests that we're able to properly retrieve rows based
 // on various combinations of the query parameters.
 func TestAddressEventQuery(t *testing.T) {
@@ -0,0 +1,299 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+type (
+	// NewAirdrop is used to insert a new airdrop.
+	NewAirdrop = sqlc.InsertAirdropParams
+
+	// NewAirdropRecipient is used to insert a new airdrop recipient.
+	NewAirdropRecipient = sqlc.InsertAirdropRecipientParams
+
+	// AirdropRecipientUpdate is used to update the status of an airdrop
+	// recipient.
+	AirdropRecipientUpdate = sqlc.UpdateAirdropRecipientStatusParams
+
+	// AirdropRow is a single airdrop.
+	AirdropRow = sqlc.Airdrop
+
+	// AirdropRecipientRow is a single airdrop recipient.
+	AirdropRecipientRow = sqlc.AirdropRecipient
+)
+
+// AirdropStore is the set of queries needed to maintain the airdrops.
+type AirdropStore interface {
+	// InsertAirdrop inserts a new airdrop and returns its primary key.
+	InsertAirdrop(ctx context.Context, arg NewAirdrop) (int32, error)
+
+	// InsertAirdropRecipient inserts a new recipient of an airdrop.
+	InsertAirdropRecipient(ctx context.Context,
+		arg NewAirdropRecipient) error
+
+	// QueryAirdrops returns the airdrop with the given ID, or all airdrops
+	// if the ID is NULL, ordered by their ID.
+	QueryAirdrops(ctx context.Context,
+		airdropID sql.NullInt32) ([]AirdropRow, error)
+
+	// QueryAirdropRecipients returns the recipients of the given airdrop,
+	// ordered by their position in the manifest.
+	QueryAirdropRecipients(ctx context.Context,
+		airdropID int32) ([]AirdropRecipientRow, error)
+
+	// UpdateAirdropRecipientStatus updates the status of a recipient of an
+	// airdrop.
+	UpdateAirdropRecipientStatus(ctx context.Context,
+		arg AirdropRecipientUpdate) error
+}
+
+// AirdropTxOptions is the database tx object for the airdrop store.
+type AirdropTxOptions struct {
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (a *AirdropTxOptions) ReadOnly() bool {
+	return a.readOnly
+}
+
+// NewAirdropReadTx returns a new read tx for the airdrop store.
+func NewAirdropReadTx() AirdropTxOptions {
+	return AirdropTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedAirdropStore allows for batched DB transactions for the airdrop
+// store.
+type BatchedAirdropStore interface {
+	AirdropStore
+
+	BatchedTx[AirdropStore]
+}
+
+// Airdrops is a database backed implementation of the
+// tapfreighter.AirdropStore interface.
+type Airdrops struct {
+	db BatchedAirdropStore
+
+	clock clock.Clock
+}
+
+// NewAirdrops creates a new airdrop store backed by the given database.
+func NewAirdrops(db BatchedAirdropStore, clock clock.Clock) *Airdrops {
+	return &Airdrops{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// CreateAirdrop stores a new airdrop with all its recipients and returns its
+// ID.
+//
+// NOTE: This is part of the tapfreighter.AirdropStore interface.
+func (a *Airdrops) CreateAirdrop(ctx context.Context,
+	airdrop *tapfreighter.Airdrop) (int64, error) {
+
+	now := a.clock.Now().UTC()
+
+	var (
+		writeTx AirdropTxOptions
+		id      int32
+	)
+	dbErr := a.db.ExecTx(ctx, &writeTx, func(q AirdropStore) error {
+		var err error
+		id, err = q.InsertAirdrop(ctx, NewAirdrop{
+			Label:              airdrop.Label,
+			MaxRecipientsPerTx: int32(airdrop.MaxRecipientsPerTx),
+			CreatedAt:          now,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert airdrop: %w", err)
+		}
+
+		for _, r := range airdrop.Recipients {
+			err := q.InsertAirdropRecipient(
+				ctx, NewAirdropRecipient{
+					AirdropID:      id,
+					RecipientIndex: int32(r.Index),
+					TapAddr:        r.Addr,
+					AssetID:        r.AssetID[:],
+					Amount:         int64(r.Amount),
+					Status:         int16(r.Status),
+					ErrorMsg:       r.Err,
+					UpdatedAt:      now,
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to insert airdrop "+
+					"recipient: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return 0, dbErr
+	}
+
+	airdrop.CreatedAt = now
+	for _, recipient := range airdrop.Recipients {
+		recipient.UpdatedAt = now
+	}
+
+	return int64(id), nil
+}
+
+// FetchAirdrop returns the airdrop with the given ID. If no such airdrop
+// exists, tapfreighter.ErrAirdropNotFound is returned.
+//
+// NOTE: This is part of the tapfreighter.AirdropStore interface.
+func (a *Airdrops) FetchAirdrop(ctx context.Context,
+	id int64) (*tapfreighter.Airdrop, error) {
+
+	airdrops, err := a.queryAirdrops(ctx, sqlInt32(id))
+	if err != nil {
+		return nil, err
+	}
+	if len(airdrops) == 0 {
+		return nil, fmt.Errorf("%w: %d",
+			tapfreighter.ErrAirdropNotFound, id)
+	}
+
+	return airdrops[0], nil
+}
+
+// ListAirdrops returns all airdrops, ordered by their ID.
+//
+// NOTE: This is part of the tapfreighter.AirdropStore interface.
+func (a *Airdrops) ListAirdrops(
+	ctx context.Context) ([]*tapfreighter.Airdrop, error) {
+
+	return a.queryAirdrops(ctx, sql.NullInt32{})
+}
+
+// queryAirdrops returns the airdrop with the given ID, or all airdrops if the
+// ID is NULL, together with their recipients.
+func (a *Airdrops) queryAirdrops(ctx context.Context,
+	id sql.NullInt32) ([]*tapfreighter.Airdrop, error) {
+
+	var (
+		readTx   = NewAirdropReadTx()
+		airdrops []*tapfreighter.Airdrop
+	)
+	dbErr := a.db.ExecTx(ctx, &readTx, func(q AirdropStore) error {
+		airdrops = nil
+
+		rows, err := q.QueryAirdrops(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			recipientRows, err := q.QueryAirdropRecipients(
+				ctx, row.ID,
+			)
+			if err != nil {
+				return err
+			}
+
+			maxPerTx := uint32(row.MaxRecipientsPerTx)
+			airdrop := &tapfreighter.Airdrop{
+				ID:                 int64(row.ID),
+				Label:              row.Label,
+				MaxRecipientsPerTx: maxPerTx,
+				CreatedAt:          row.CreatedAt.UTC(),
+				Recipients: make(
+					[]*tapfreighter.AirdropRecipient,
+					len(recipientRows),
+				),
+			}
+			for idx, r := range recipientRows {
+				airdrop.Recipients[idx] = parseAirdropRecipient(
+					r,
+				)
+			}
+
+			airdrops = append(airdrops, airdrop)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query airdrops: %w", dbErr)
+	}
+
+	return airdrops, nil
+}
+
+// UpdateAirdropRecipients sets the status, anchor transaction and error of the
+// recipients of the given airdrop.
+//
+// NOTE: This is part of the tapfreighter.AirdropStore interface.
+func (a *Airdrops) UpdateAirdropRecipients(ctx context.Context,
+	airdropID int64, recipients []*tapfreighter.AirdropRecipient) error {
+
+	var writeTx AirdropTxOptions
+	return a.db.ExecTx(ctx, &writeTx, func(q AirdropStore) error {
+		for _, r := range recipients {
+			var anchorTxid []byte
+			if r.AnchorTxid != nil {
+				anchorTxid = r.AnchorTxid[:]
+			}
+
+			err := q.UpdateAirdropRecipientStatus(
+				ctx, AirdropRecipientUpdate{
+					AirdropID:      int32(airdropID),
+					RecipientIndex: int32(r.Index),
+					Status:         int16(r.Status),
+					AnchorTxid:     anchorTxid,
+					ErrorMsg:       r.Err,
+					UpdatedAt:      r.UpdatedAt.UTC(),
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to update airdrop "+
+					"recipient: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// parseAirdropRecipient parses an airdrop recipient from its database row.
+func parseAirdropRecipient(
+	r AirdropRecipientRow) *tapfreighter.AirdropRecipient {
+
+	recipient := &tapfreighter.AirdropRecipient{
+		Index:     uint32(r.RecipientIndex),
+		Addr:      r.TapAddr,
+		Amount:    uint64(r.Amount),
+		Status:    tapfreighter.AirdropRecipientStatus(r.Status),
+		Err:       r.ErrorMsg,
+		UpdatedAt: r.UpdatedAt.UTC(),
+	}
+	copy(recipient.AssetID[:], r.AssetID)
+
+	if len(r.AnchorTxid) > 0 {
+		var txid chainhash.Hash
+		copy(txid[:], r.AnchorTxid)
+		recipient.AnchorTxid = &txid
+	}
+
+	return recipient
+}
+
+// A compile-time assertion to ensure that Airdrops meets the
+// tapfreighter.AirdropStore interface.
+var _ tapfreighter.AirdropStore = (*Airdrops)(nil)
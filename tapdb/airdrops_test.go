@@ -0,0 +1,100 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAirdrops tests that airdrops can be created, fetched and listed and
+// that the status of their recipients can be updated.
+func TestAirdrops(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	airdropDB := NewTransactionExecutor(
+		db, func(tx *sql.Tx) AirdropStore {
+			return db.WithTx(tx)
+		},
+	)
+	testClock := clock.NewTestClock(time.Unix(1_700_000_000, 0))
+	store := NewAirdrops(airdropDB, testClock)
+	ctx := context.Background()
+
+	_, err := store.FetchAirdrop(ctx, 1)
+	require.ErrorIs(t, err, tapfreighter.ErrAirdropNotFound)
+
+	assetID := asset.ID(test.RandHash())
+	newAirdrop := func(label string, n int) *tapfreighter.Airdrop {
+		airdrop := &tapfreighter.Airdrop{
+			Label:              label,
+			MaxRecipientsPerTx: 10,
+		}
+		for i := 0; i < n; i++ {
+			recipient := &tapfreighter.AirdropRecipient{
+				Index:   uint32(i),
+				Addr:    label,
+				AssetID: assetID,
+				Amount:  uint64(i+1) * 100,
+			}
+			airdrop.Recipients = append(
+				airdrop.Recipients, recipient,
+			)
+		}
+
+		return airdrop
+	}
+
+	launch := newAirdrop("launch", 3)
+	launch.ID, err = store.CreateAirdrop(ctx, launch)
+	require.NoError(t, err)
+
+	rewards := newAirdrop("rewards", 1)
+	rewards.ID, err = store.CreateAirdrop(ctx, rewards)
+	require.NoError(t, err)
+
+	dbLaunch, err := store.FetchAirdrop(ctx, launch.ID)
+	require.NoError(t, err)
+	require.Equal(t, launch, dbLaunch)
+
+	airdrops, err := store.ListAirdrops(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*tapfreighter.Airdrop{launch, rewards}, airdrops)
+
+	// Update the status of two of the recipients.
+	txid := test.RandHash()
+	sent := *launch.Recipients[0]
+	sent.Status = tapfreighter.AirdropRecipientSent
+	sent.AnchorTxid = &txid
+	sent.UpdatedAt = testClock.Now().Add(time.Minute).UTC()
+
+	failed := *launch.Recipients[2]
+	failed.Status = tapfreighter.AirdropRecipientFailed
+	failed.Err = "insufficient funds"
+	failed.UpdatedAt = sent.UpdatedAt
+
+	err = store.UpdateAirdropRecipients(
+		ctx, launch.ID, []*tapfreighter.AirdropRecipient{
+			&sent, &failed,
+		},
+	)
+	require.NoError(t, err)
+
+	dbLaunch, err = store.FetchAirdrop(ctx, launch.ID)
+	require.NoError(t, err)
+	require.Equal(t, &sent, dbLaunch.Recipients[0])
+	require.Equal(t, launch.Recipients[1], dbLaunch.Recipients[1])
+	require.Equal(t, &failed, dbLaunch.Recipients[2])
+
+	// The recipients of other airdrops are untouched.
+	dbRewards, err := store.FetchAirdrop(ctx, rewards.ID)
+	require.NoError(t, err)
+	require.Equal(t, rewards, dbRewards)
+}
@@ -0,0 +1,121 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// FetchAnchorKeyUsage returns the outpoints of all anchor outputs that were
+// created with the given internal key.
+//
+// NOTE: This is part of the tapfreighter.AnchorKeyLog interface.
+func (a *AssetStore) FetchAnchorKeyUsage(ctx context.Context,
+	internalKey *btcec.PublicKey) ([]wire.OutPoint, error) {
+
+	var (
+		readOpts = NewAssetStoreReadTx()
+		rawKey   = internalKey.SerializeCompressed()
+		rows     [][]byte
+	)
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		var err error
+		rows, err = q.QueryAnchorKeyOutpoints(ctx, rawKey)
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query anchor key usage: %w",
+			dbErr)
+	}
+
+	anchorPoints := make([]wire.OutPoint, len(rows))
+	for i, row := range rows {
+		err := readOutPoint(
+			bytes.NewReader(row), 0, 0, &anchorPoints[i],
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode outpoint: %w",
+				err)
+		}
+	}
+
+	return anchorPoints, nil
+}
+
+// ListReusedAnchorKeys returns all internal keys that were used for more than
+// one anchor output.
+//
+// NOTE: This is part of the tapfreighter.AnchorKeyLog interface.
+func (a *AssetStore) ListReusedAnchorKeys(
+	ctx context.Context) ([]tapfreighter.AnchorKeyUsage, error) {
+
+	var (
+		readOpts = NewAssetStoreReadTx()
+		rows     []ReusedAnchorKeyRow
+	)
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		var err error
+		rows, err = q.QueryReusedAnchorKeys(ctx)
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query reused anchor keys: %w",
+			dbErr)
+	}
+
+	// The rows are ordered by key, so all anchor outputs of a key are
+	// next to each other.
+	var usages []tapfreighter.AnchorKeyUsage
+	for _, row := range rows {
+		var anchorPoint wire.OutPoint
+		err := readOutPoint(
+			bytes.NewReader(row.Outpoint), 0, 0, &anchorPoint,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode outpoint: %w",
+				err)
+		}
+
+		numUsages := len(usages)
+		if numUsages > 0 {
+			last := &usages[numUsages-1]
+			lastKey := last.InternalKey.PubKey.SerializeCompressed()
+			if bytes.Equal(lastKey, row.RawKey) {
+				last.AnchorPoints = append(
+					last.AnchorPoints, anchorPoint,
+				)
+				continue
+			}
+		}
+
+		internalKey, err := btcec.ParsePubKey(row.RawKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse internal "+
+				"key: %w", err)
+		}
+
+		usages = append(usages, tapfreighter.AnchorKeyUsage{
+			InternalKey: keychain.KeyDescriptor{
+				KeyLocator: keychain.KeyLocator{
+					Family: keychain.KeyFamily(
+						row.KeyFamily,
+					),
+					Index: uint32(row.KeyIndex),
+				},
+				PubKey: internalKey,
+			},
+			AnchorPoints: []wire.OutPoint{anchorPoint},
+		})
+	}
+
+	return usages, nil
+}
+
+// A compile-time assertion to make sure AssetStore satisfies the
+// tapfreighter.AnchorKeyLog interface.
+var _ tapfreighter.AnchorKeyLog = (*AssetStore)(nil)
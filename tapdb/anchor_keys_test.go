@@ -0,0 +1,100 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAnchorKeyUsage tests that the anchor outputs created with an internal
+// key are found, and that only keys used for multiple anchor outputs are
+// reported as reused.
+func TestAnchorKeyUsage(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, db := newAssetStore(t)
+	ctx := context.Background()
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{PreviousOutPoint: test.RandOp(t)})
+	var anchorTxBuf bytes.Buffer
+	require.NoError(t, anchorTx.Serialize(&anchorTxBuf))
+
+	txid := anchorTx.TxHash()
+	txnID, err := db.UpsertChainTx(ctx, ChainTxParams{
+		Txid:  txid[:],
+		RawTx: anchorTxBuf.Bytes(),
+	})
+	require.NoError(t, err)
+
+	reusedKey := test.RandPubKey(t)
+	singleKey := test.RandPubKey(t)
+	for idx, key := range []InternalKey{{
+		RawKey:    reusedKey.SerializeCompressed(),
+		KeyFamily: 212,
+		KeyIndex:  3,
+	}, {
+		RawKey:    singleKey.SerializeCompressed(),
+		KeyFamily: 212,
+		KeyIndex:  4,
+	}} {
+		_, err := db.UpsertInternalKey(ctx, key)
+		require.NoError(t, err, "key %d", idx)
+	}
+
+	// The reused key anchors the first and last output, the other key
+	// only the second one.
+	anchorPoints := make([]wire.OutPoint, 3)
+	for idx := range anchorPoints {
+		anchorPoints[idx] = wire.OutPoint{
+			Hash:  txid,
+			Index: uint32(idx),
+		}
+
+		rawKey := reusedKey.SerializeCompressed()
+		if idx == 1 {
+			rawKey = singleKey.SerializeCompressed()
+		}
+
+		anchorPoint, err := encodeOutpoint(anchorPoints[idx])
+		require.NoError(t, err)
+		_, err = db.UpsertManagedUTXO(ctx, RawManagedUTXO{
+			RawKey:           rawKey,
+			Outpoint:         anchorPoint,
+			AmtSats:          1_000,
+			TaprootAssetRoot: test.RandBytes(32),
+			MerkleRoot:       test.RandBytes(32),
+			TxnID:            txnID,
+		})
+		require.NoError(t, err)
+	}
+
+	usage, err := assetsStore.FetchAnchorKeyUsage(ctx, reusedKey)
+	require.NoError(t, err)
+	require.Equal(
+		t, []wire.OutPoint{anchorPoints[0], anchorPoints[2]}, usage,
+	)
+
+	usage, err = assetsStore.FetchAnchorKeyUsage(ctx, singleKey)
+	require.NoError(t, err)
+	require.Equal(t, []wire.OutPoint{anchorPoints[1]}, usage)
+
+	usage, err = assetsStore.FetchAnchorKeyUsage(ctx, test.RandPubKey(t))
+	require.NoError(t, err)
+	require.Empty(t, usage)
+
+	reused, err := assetsStore.ListReusedAnchorKeys(ctx)
+	require.NoError(t, err)
+	require.Len(t, reused, 1)
+	require.True(t, reusedKey.IsEqual(reused[0].InternalKey.PubKey))
+	require.EqualValues(t, 212, reused[0].InternalKey.Family)
+	require.EqualValues(t, 3, reused[0].InternalKey.Index)
+	require.Equal(
+		t, []wire.OutPoint{anchorPoints[0], anchorPoints[2]},
+		reused[0].AnchorPoints,
+	)
+}
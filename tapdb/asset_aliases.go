@@ -0,0 +1,262 @@
+package tapdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+type (
+	// NewAssetAlias is used to insert or update an asset alias.
+	NewAssetAlias = sqlc.UpsertAssetAliasParams
+
+	// AssetAliasTarget is used to delete the alias of an asset ID or group
+	// key.
+	AssetAliasTarget = sqlc.DeleteAssetAliasesByTargetParams
+
+	// AssetAliasQuery is used to query asset aliases.
+	AssetAliasQuery = sqlc.QueryAssetAliasesParams
+
+	// AssetAliasRow is a single asset alias.
+	AssetAliasRow = sqlc.AssetAlias
+)
+
+// AssetAliasStore is the set of queries needed to maintain the asset aliases.
+type AssetAliasStore interface {
+	// UpsertAssetAlias inserts a new alias or updates the target of an
+	// existing one.
+	UpsertAssetAlias(ctx context.Context, arg NewAssetAlias) error
+
+	// DeleteAssetAlias deletes the alias with the given name and returns
+	// the number of deleted aliases.
+	DeleteAssetAlias(ctx context.Context, alias string) (int64, error)
+
+	// DeleteAssetAliasesByTarget deletes the aliases of the given asset ID
+	// or group key.
+	DeleteAssetAliasesByTarget(ctx context.Context,
+		arg AssetAliasTarget) error
+
+	// QueryAssetAliases returns all aliases that match the given query,
+	// ordered by name.
+	QueryAssetAliases(ctx context.Context,
+		arg AssetAliasQuery) ([]AssetAliasRow, error)
+}
+
+// AssetAliasTxOptions is the database tx object for the asset alias store.
+type AssetAliasTxOptions struct {
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (a *AssetAliasTxOptions) ReadOnly() bool {
+	return a.readOnly
+}
+
+// NewAssetAliasReadTx returns a new read tx for the asset alias store.
+func NewAssetAliasReadTx() AssetAliasTxOptions {
+	return AssetAliasTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedAssetAliasStore allows for batched DB transactions for the asset
+// alias store.
+type BatchedAssetAliasStore interface {
+	AssetAliasStore
+
+	BatchedTx[AssetAliasStore]
+}
+
+// AssetAliases is a database backed implementation of the asset.AliasStore
+// interface, which is used as the local alias registry.
+type AssetAliases struct {
+	db BatchedAssetAliasStore
+
+	clock clock.Clock
+}
+
+// NewAssetAliases creates a new asset alias store backed by the given
+// database.
+func NewAssetAliases(db BatchedAssetAliasStore,
+	clock clock.Clock) *AssetAliases {
+
+	return &AssetAliases{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// SetAlias adds the given alias, or updates the asset ID or group key it
+// refers to if it already exists. Any other alias of the same asset ID or
+// group key is replaced.
+//
+// NOTE: This is part of the asset.AliasStore interface.
+func (a *AssetAliases) SetAlias(ctx context.Context, alias *asset.Alias) error {
+	if err := alias.Validate(); err != nil {
+		return err
+	}
+
+	var target AssetAliasTarget
+	if alias.AssetID != nil {
+		target.AssetID = fn.ByteSlice(*alias.AssetID)
+	}
+	if alias.GroupKey != nil {
+		target.GroupKey = alias.GroupKey.SerializeCompressed()
+	}
+
+	var writeTx AssetAliasTxOptions
+	return a.db.ExecTx(ctx, &writeTx, func(q AssetAliasStore) error {
+		err := q.DeleteAssetAliasesByTarget(ctx, target)
+		if err != nil {
+			return fmt.Errorf("unable to delete previous alias: %w",
+				err)
+		}
+
+		return q.UpsertAssetAlias(ctx, NewAssetAlias{
+			Alias:     alias.Name,
+			AssetID:   target.AssetID,
+			GroupKey:  target.GroupKey,
+			CreatedAt: a.clock.Now().UTC(),
+		})
+	})
+}
+
+// DeleteAlias removes the alias with the given normalized name.
+//
+// NOTE: This is part of the asset.AliasStore interface.
+func (a *AssetAliases) DeleteAlias(ctx context.Context, name string) error {
+	var writeTx AssetAliasTxOptions
+	return a.db.ExecTx(ctx, &writeTx, func(q AssetAliasStore) error {
+		numDeleted, err := q.DeleteAssetAlias(ctx, name)
+		if err != nil {
+			return err
+		}
+		if numDeleted == 0 {
+			return fmt.Errorf("%w: %s", asset.ErrAliasNotFound, name)
+		}
+
+		return nil
+	})
+}
+
+// ListAliases returns all aliases of the store, ordered by name.
+//
+// NOTE: This is part of the asset.AliasStore interface.
+func (a *AssetAliases) ListAliases(ctx context.Context) ([]*asset.Alias,
+	error) {
+
+	return a.queryAliases(ctx, AssetAliasQuery{})
+}
+
+// ResolveAlias returns the alias with the given normalized name.
+//
+// NOTE: This is part of the asset.AliasRegistry interface.
+func (a *AssetAliases) ResolveAlias(ctx context.Context,
+	name string) (*asset.Alias, error) {
+
+	return a.fetchAlias(ctx, AssetAliasQuery{
+		Alias: sqlStr(name),
+	})
+}
+
+// AssetAlias returns the alias of the asset with the given ID, or the alias
+// of its group if the asset ID has no alias.
+//
+// NOTE: This is part of the asset.AliasRegistry interface.
+func (a *AssetAliases) AssetAlias(ctx context.Context, id asset.ID,
+	groupKey *btcec.PublicKey) (*asset.Alias, error) {
+
+	alias, err := a.fetchAlias(ctx, AssetAliasQuery{
+		AssetID: fn.ByteSlice(id),
+	})
+	if err == nil || groupKey == nil {
+		return alias, err
+	}
+
+	return a.GroupAlias(ctx, groupKey)
+}
+
+// GroupAlias returns the alias of the asset group with the given group key.
+//
+// NOTE: This is part of the asset.AliasRegistry interface.
+func (a *AssetAliases) GroupAlias(ctx context.Context,
+	groupKey *btcec.PublicKey) (*asset.Alias, error) {
+
+	return a.fetchAlias(ctx, AssetAliasQuery{
+		GroupKey: groupKey.SerializeCompressed(),
+	})
+}
+
+// fetchAlias returns the single alias that matches the given query.
+func (a *AssetAliases) fetchAlias(ctx context.Context,
+	query AssetAliasQuery) (*asset.Alias, error) {
+
+	aliases, err := a.queryAliases(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(aliases) == 0 {
+		return nil, asset.ErrAliasNotFound
+	}
+
+	return aliases[0], nil
+}
+
+// queryAliases returns all aliases that match the given query.
+func (a *AssetAliases) queryAliases(ctx context.Context,
+	query AssetAliasQuery) ([]*asset.Alias, error) {
+
+	var aliases []*asset.Alias
+	readTx := NewAssetAliasReadTx()
+	dbErr := a.db.ExecTx(ctx, &readTx, func(q AssetAliasStore) error {
+		rows, err := q.QueryAssetAliases(ctx, query)
+		if err != nil {
+			return err
+		}
+
+		aliases, err = fn.MapErr(rows, parseAssetAlias)
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query asset aliases: %w",
+			dbErr)
+	}
+
+	return aliases, nil
+}
+
+// parseAssetAlias parses an asset alias from the database.
+func parseAssetAlias(r AssetAliasRow) (*asset.Alias, error) {
+	alias := &asset.Alias{
+		Name:   r.Alias,
+		Source: asset.AliasSourceLocal,
+	}
+
+	if len(r.AssetID) > 0 {
+		var id asset.ID
+		copy(id[:], r.AssetID)
+		alias.AssetID = &id
+	}
+
+	if len(r.GroupKey) > 0 {
+		groupKey, err := btcec.ParsePubKey(r.GroupKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse group key of "+
+				"alias %s: %w", r.Alias, err)
+		}
+		alias.GroupKey = groupKey
+	}
+
+	return alias, nil
+}
+
+// A compile-time assertion to ensure that AssetAliases meets the
+// asset.AliasStore interface.
+var _ asset.AliasStore = (*AssetAliases)(nil)
@@ -0,0 +1,113 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAssetAliases tests that aliases of asset IDs and group keys can be set,
+// resolved, replaced and deleted.
+func TestAssetAliases(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	aliasDB := NewTransactionExecutor(
+		db, func(tx *sql.Tx) AssetAliasStore {
+			return db.WithTx(tx)
+		},
+	)
+	aliases := NewAssetAliases(aliasDB, clock.NewDefaultClock())
+	ctx := context.Background()
+
+	// The store starts out empty.
+	allAliases, err := aliases.ListAliases(ctx)
+	require.NoError(t, err)
+	require.Empty(t, allAliases)
+
+	_, err = aliases.ResolveAlias(ctx, "usd")
+	require.ErrorIs(t, err, asset.ErrAliasNotFound)
+
+	assetID := asset.RandID(t)
+	groupKey := test.RandPubKey(t)
+
+	// Invalid aliases are rejected.
+	err = aliases.SetAlias(ctx, &asset.Alias{
+		Name:     "usd",
+		AssetID:  &assetID,
+		GroupKey: groupKey,
+	})
+	require.ErrorIs(t, err, asset.ErrInvalidAlias)
+	err = aliases.SetAlias(ctx, &asset.Alias{
+		Name:    "USD",
+		AssetID: &assetID,
+	})
+	require.ErrorIs(t, err, asset.ErrInvalidAlias)
+
+	// We add an alias for an asset ID and one for a group key.
+	require.NoError(t, aliases.SetAlias(ctx, &asset.Alias{
+		Name:    "usd",
+		AssetID: &assetID,
+	}))
+	require.NoError(t, aliases.SetAlias(ctx, &asset.Alias{
+		Name:     "eur",
+		GroupKey: groupKey,
+	}))
+
+	alias, err := aliases.ResolveAlias(ctx, "usd")
+	require.NoError(t, err)
+	require.Equal(t, assetID, *alias.AssetID)
+	require.Nil(t, alias.GroupKey)
+	require.False(t, alias.Unverified())
+
+	alias, err = aliases.GroupAlias(ctx, groupKey)
+	require.NoError(t, err)
+	require.Equal(t, "eur", alias.Name)
+	require.True(t, groupKey.IsEqual(alias.GroupKey))
+
+	// An asset ID without an alias falls back to the alias of its group.
+	alias, err = aliases.AssetAlias(ctx, asset.RandID(t), groupKey)
+	require.NoError(t, err)
+	require.Equal(t, "eur", alias.Name)
+
+	_, err = aliases.AssetAlias(ctx, asset.RandID(t), nil)
+	require.ErrorIs(t, err, asset.ErrAliasNotFound)
+
+	// Setting a new alias for the same asset ID replaces the old one, and
+	// setting an existing alias moves it to the new target.
+	require.NoError(t, aliases.SetAlias(ctx, &asset.Alias{
+		Name:    "usdt",
+		AssetID: &assetID,
+	}))
+	_, err = aliases.ResolveAlias(ctx, "usd")
+	require.ErrorIs(t, err, asset.ErrAliasNotFound)
+
+	otherID := asset.RandID(t)
+	require.NoError(t, aliases.SetAlias(ctx, &asset.Alias{
+		Name:    "eur",
+		AssetID: &otherID,
+	}))
+	_, err = aliases.GroupAlias(ctx, groupKey)
+	require.ErrorIs(t, err, asset.ErrAliasNotFound)
+
+	allAliases, err = aliases.ListAliases(ctx)
+	require.NoError(t, err)
+	require.Len(t, allAliases, 2)
+	require.Equal(t, "eur", allAliases[0].Name)
+	require.Equal(t, otherID, *allAliases[0].AssetID)
+	require.Equal(t, "usdt", allAliases[1].Name)
+	require.Equal(t, assetID, *allAliases[1].AssetID)
+
+	// Finally, aliases can be deleted.
+	require.NoError(t, aliases.DeleteAlias(ctx, "usdt"))
+	err = aliases.DeleteAlias(ctx, "usdt")
+	require.ErrorIs(t, err, asset.ErrAliasNotFound)
+
+	_, err = aliases.AssetAlias(ctx, assetID, nil)
+	require.ErrorIs(t, err, asset.ErrAliasNotFound)
+}
@@ -0,0 +1,127 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+)
+
+// ErrLotNotFound is returned if no unspent asset UTXO exists for a lot.
+var ErrLotNotFound = errors.New("no unspent asset UTXO found for lot")
+
+// fetchAssetLot fetches the lot of the asset UTXO identified by the given
+// query. If the lot isn't tracked, nil is returned.
+func fetchAssetLot(ctx context.Context, q ActiveAssetsStore,
+	lotID AssetLotQuery) (*tapfreighter.AssetLot, error) {
+
+	dbLot, err := q.FetchAssetLot(ctx, lotID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch asset lot: %w", err)
+	}
+
+	lot := &tapfreighter.AssetLot{
+		PriceAnnotation: dbLot.PriceAnnotation.String,
+	}
+	if dbLot.AcquiredAt.Valid {
+		lot.AcquiredAt = dbLot.AcquiredAt.Time.UTC()
+	}
+	if len(dbLot.SourceTxid) > 0 {
+		lot.SourceTransfer, err = chainhash.NewHash(dbLot.SourceTxid)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode source txid: "+
+				"%w", err)
+		}
+	}
+
+	return lot, nil
+}
+
+// insertAssetLot inserts a new lot for the asset UTXO identified by the given
+// lot ID. An unknown acquisition time is stored as NULL. Existing lots are not
+// modified.
+func insertAssetLot(ctx context.Context, q UpsertAssetStore,
+	lotID AssetLotQuery, acquiredAt time.Time,
+	sourceTransferID sql.NullInt32, priceAnnotation string) error {
+
+	err := q.InsertAssetLot(ctx, NewAssetLot{
+		AnchorPoint: lotID.AnchorPoint,
+		AssetID:     lotID.AssetID,
+		ScriptKey:   lotID.ScriptKey,
+		AcquiredAt: sql.NullTime{
+			Time:  acquiredAt.UTC(),
+			Valid: !acquiredAt.IsZero(),
+		},
+		SourceTransferID: sourceTransferID,
+		PriceAnnotation:  sqlStr(priceAnnotation),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to insert asset lot: %w", err)
+	}
+
+	return nil
+}
+
+// oldestLot returns the lot with the earliest acquisition time. A lot with an
+// unknown acquisition time is considered older than any other lot, and nil is
+// returned if none of the lots is tracked.
+func oldestLot(lots []*tapfreighter.AssetLot) *tapfreighter.AssetLot {
+	var oldest *tapfreighter.AssetLot
+	for _, lot := range lots {
+		if lot == nil {
+			continue
+		}
+
+		if oldest == nil || lot.AcquiredAt.Before(oldest.AcquiredAt) {
+			oldest = lot
+		}
+	}
+
+	return oldest
+}
+
+// SetLotPriceAnnotation sets the price annotation of the lot of the asset UTXO
+// identified by the given previous input ID. If the lot isn't tracked yet, it
+// is created with an unknown acquisition time.
+func (a *AssetStore) SetLotPriceAnnotation(ctx context.Context,
+	prevID asset.PrevID, annotation string) error {
+
+	anchorPoint, err := encodeOutpoint(prevID.OutPoint)
+	if err != nil {
+		return fmt.Errorf("unable to encode outpoint: %w", err)
+	}
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		// We only annotate lots of unspent assets we own.
+		dbAssets, err := q.QueryAssets(ctx, QueryAssetFilters{
+			AssetIDFilter:    prevID.ID[:],
+			AnchorPoint:      anchorPoint,
+			TweakedScriptKey: prevID.ScriptKey.CopyBytes(),
+			Spent:            sqlBool(false),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to query assets: %w", err)
+		}
+		if len(dbAssets) == 0 {
+			return fmt.Errorf("asset %v at %v: %w", prevID.ID,
+				prevID.OutPoint, ErrLotNotFound)
+		}
+
+		return q.UpsertAssetLotAnnotation(ctx, AssetLotAnnotation{
+			AnchorPoint:     anchorPoint,
+			AssetID:         prevID.ID[:],
+			ScriptKey:       prevID.ScriptKey.CopyBytes(),
+			PriceAnnotation: sqlStr(annotation),
+		})
+	})
+}
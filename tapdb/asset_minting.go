@@ -6,6 +6,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
@@ -104,6 +105,10 @@ type (
 	// AssetSprout is used to fetch the set of assets from disk.
 	AssetSprout = sqlc.FetchAssetsForBatchRow
 
+	// BatchAssetAnchor is the anchor point of an asset created by a
+	// batch.
+	BatchAssetAnchor = sqlc.FetchBatchAssetAnchorsRow
+
 	// MintingBatchInit is used to create a new minting batch.
 	MintingBatchInit = sqlc.NewMintingBatchParams
 
@@ -203,6 +208,11 @@ type PendingAssetStore interface {
 	FetchAssetsForBatch(ctx context.Context, rawKey []byte) ([]AssetSprout,
 		error)
 
+	// FetchBatchAssetAnchors fetches the anchor point, asset ID and script
+	// key of all the assets created by a particular batch.
+	FetchBatchAssetAnchors(ctx context.Context,
+		rawKey []byte) ([]BatchAssetAnchor, error)
+
 	// UpsertAssetProof inserts a new or updates an existing asset proof on
 	// disk.
 	//
@@ -1131,6 +1141,25 @@ func (a *AssetMintingStore) MarkBatchConfirmed(ctx context.Context,
 			return fmt.Errorf("unable to confirm chain tx: %w", err)
 		}
 
+		// The minted assets are acquired with the confirmation, so we
+		// start tracking their lots.
+		batchAnchors, err := q.FetchBatchAssetAnchors(ctx, rawBatchKey)
+		if err != nil {
+			return fmt.Errorf("unable to fetch batch assets: %w",
+				err)
+		}
+		acquiredAt := time.Now()
+		for _, anchor := range batchAnchors {
+			err := insertAssetLot(ctx, q, AssetLotQuery{
+				AnchorPoint: anchor.AnchorPoint,
+				AssetID:     anchor.AssetID,
+				ScriptKey:   anchor.TweakedScriptKey,
+			}, acquiredAt, sql.NullInt32{}, "")
+			if err != nil {
+				return err
+			}
+		}
+
 		// As a final act, we'll now insert the proof files for each of
 		// the assets that were fully confirmed with this block.
 		for scriptKey, proofBlob := range mintingProofs {
@@ -697,9 +697,22 @@ func TestCommitBatchChainActions(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, numSeedlings, len(assets))
 
-	// All the assets returned should have the genesis prev ID set up.
+	// All the assets returned should have the genesis prev ID set up. As
+	// they were acquired with the confirmation, their lots are tracked.
 	for _, dbAsset := range assets {
 		require.True(t, dbAsset.HasGenesisWitness())
+
+		anchorPoint, err := encodeOutpoint(dbAsset.AnchorOutpoint)
+		require.NoError(t, err)
+		assetID := dbAsset.ID()
+		lot, err := fetchAssetLot(ctx, db, AssetLotQuery{
+			AnchorPoint: anchorPoint,
+			AssetID:     assetID[:],
+			ScriptKey:   dbAsset.ScriptKey.PubKey.SerializeCompressed(),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, lot)
+		require.False(t, lot.AcquiredAt.IsZero())
 	}
 
 	// Now that the batch has been committed on disk, we should be able to
@@ -76,6 +76,10 @@ type UpsertAssetStore interface {
 	// updated asset's database ID is returned.
 	SetAssetSpent(ctx context.Context, arg SetAssetSpentParams) (int32,
 		error)
+
+	// InsertAssetLot inserts the lot of an asset UTXO, if it doesn't exist
+	// yet.
+	InsertAssetLot(ctx context.Context, arg NewAssetLot) error
 }
 
 // upsertGenesis imports a new genesis point into the database or returns the
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -14,6 +15,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/fn"
@@ -50,6 +52,10 @@ type (
 	// its asset ID.
 	AssetProofByIDRow = sqlc.FetchAssetProofsByAssetIDRow
 
+	// AssetProofKey identifies an asset we have a proof for by its asset ID
+	// and script key.
+	AssetProofKey = sqlc.FetchAssetProofKeysRow
+
 	// PrevInput stores the full input information including the prev out,
 	// and also the witness information itself.
 	PrevInput = sqlc.InsertAssetWitnessParams
@@ -131,6 +137,36 @@ type (
 
 	// ReAnchorParams wraps the params needed to re-anchor a passive asset.
 	ReAnchorParams = sqlc.ReAnchorPassiveAssetsParams
+
+	// FreezeParams wraps the params needed to freeze an asset output.
+	FreezeParams = sqlc.FreezeAssetOutputParams
+
+	// UnfreezeParams wraps the params needed to unfreeze asset outputs.
+	UnfreezeParams = sqlc.UnfreezeAssetOutputsParams
+
+	// NewParcelRequest wraps the params needed to insert a new parcel
+	// request.
+	NewParcelRequest = sqlc.InsertParcelRequestParams
+
+	// NewParcelRequestAddr wraps the params needed to insert a destination
+	// address of a parcel request.
+	NewParcelRequestAddr = sqlc.InsertParcelRequestAddrParams
+
+	// ParcelRequestRow is a destination address of a parcel request along
+	// with the request itself.
+	ParcelRequestRow = sqlc.QueryParcelRequestsRow
+
+	// NewBroadcastTrigger wraps the params needed to insert the broadcast
+	// trigger of a scheduled transfer.
+	NewBroadcastTrigger = sqlc.InsertTransferBroadcastTriggerParams
+
+	// BroadcastTriggerRow is the broadcast trigger of a scheduled
+	// transfer.
+	BroadcastTriggerRow = sqlc.FetchTransferBroadcastTriggerRow
+
+	// TransferParcelVersion wraps the params needed to update the parcel
+	// version of a pending transfer.
+	TransferParcelVersion = sqlc.UpdateTransferParcelVersionParams
 )
 
 // ActiveAssetsStore is a sub-set of the main sqlc.Querier interface that
@@ -159,6 +195,11 @@ type ActiveAssetsStore interface {
 	// FetchGroupedAssets fetches all assets with non-nil group keys.
 	FetchGroupedAssets(context.Context) ([]RawGroupedAsset, error)
 
+	// FetchGroupAssetIDs fetches the asset IDs of all tranches of the
+	// asset group with the given tweaked group key.
+	FetchGroupAssetIDs(ctx context.Context,
+		groupKey []byte) ([][]byte, error)
+
 	// FetchAssetProofs fetches all the asset proofs we have stored on
 	// disk.
 	FetchAssetProofs(ctx context.Context) ([]AssetProof, error)
@@ -173,6 +214,10 @@ type ActiveAssetsStore interface {
 	FetchAssetProofsByAssetID(ctx context.Context,
 		assetID []byte) ([]AssetProofByIDRow, error)
 
+	// FetchAssetProofKeys fetches the asset ID and script key of all
+	// assets we have a proof stored for, without the proofs themselves.
+	FetchAssetProofKeys(ctx context.Context) ([]AssetProofKey, error)
+
 	// UpsertChainTx inserts a new or updates an existing chain tx into the
 	// DB.
 	UpsertChainTx(ctx context.Context, arg ChainTxParams) (int32, error)
@@ -226,6 +271,19 @@ type ActiveAssetsStore interface {
 	// DeleteExpiredUTXOLeases deletes all expired UTXO leases.
 	DeleteExpiredUTXOLeases(ctx context.Context, now sql.NullTime) error
 
+	// FreezeAssetOutput freezes the asset output identified by the passed
+	// serialized outpoint and script key.
+	FreezeAssetOutput(ctx context.Context, arg FreezeParams) error
+
+	// UnfreezeAssetOutputs unfreezes the asset outputs at the passed
+	// serialized outpoint, optionally limited to a single script key.
+	UnfreezeAssetOutputs(ctx context.Context, arg UnfreezeParams) (int64,
+		error)
+
+	// QueryFrozenAssetOutputs returns all frozen asset outputs.
+	QueryFrozenAssetOutputs(ctx context.Context) ([]sqlc.FrozenAssetOutput,
+		error)
+
 	// ConfirmChainAnchorTx marks a new anchor transaction that was
 	// previously unconfirmed as confirmed.
 	ConfirmChainAnchorTx(ctx context.Context, arg AnchorTxConf) error
@@ -271,6 +329,17 @@ type ActiveAssetsStore interface {
 	QueryReceiverProofTransferAttempt(ctx context.Context,
 		proofLocatorHash []byte) ([]time.Time, error)
 
+	// InsertProofDeliveryAttempt logs the outcome of a proof delivery
+	// attempt in the proof courier outbox.
+	InsertProofDeliveryAttempt(ctx context.Context,
+		arg NewProofDeliveryAttempt) error
+
+	// QueryProofDeliveryAttempts returns the logged outcomes of the proof
+	// delivery attempts that match the query, oldest first.
+	QueryProofDeliveryAttempts(ctx context.Context,
+		arg ProofDeliveryAttemptQuery) ([]ProofDeliveryAttemptRow,
+		error)
+
 	// InsertPassiveAsset inserts a new row which includes the data
 	// necessary to re-anchor a passive asset.
 	InsertPassiveAsset(ctx context.Context, arg NewPassiveAsset) error
@@ -284,6 +353,64 @@ type ActiveAssetsStore interface {
 	// the passed params.
 	ReAnchorPassiveAssets(ctx context.Context, arg ReAnchorParams) error
 
+	// InsertParcelRequest inserts a new parcel request and returns its
+	// primary key.
+	InsertParcelRequest(ctx context.Context,
+		arg NewParcelRequest) (int32, error)
+
+	// InsertParcelRequestAddr inserts a destination address of a parcel
+	// request.
+	InsertParcelRequestAddr(ctx context.Context,
+		arg NewParcelRequestAddr) error
+
+	// QueryParcelRequests returns all parcel requests, one row per
+	// destination address.
+	QueryParcelRequests(ctx context.Context) ([]ParcelRequestRow, error)
+
+	// DeleteParcelRequest deletes the parcel request with the given ID.
+	DeleteParcelRequest(ctx context.Context, requestID []byte) error
+
+	// ReleaseOrphanedUTXOLeases deletes all leases of the given owner on
+	// managed UTXOs that aren't spent by an unconfirmed transfer.
+	ReleaseOrphanedUTXOLeases(ctx context.Context, leaseOwner []byte) error
+
+	// InsertTransferBroadcastTrigger inserts the broadcast trigger of a
+	// scheduled transfer.
+	InsertTransferBroadcastTrigger(ctx context.Context,
+		arg NewBroadcastTrigger) error
+
+	// FetchTransferBroadcastTrigger fetches the broadcast trigger of the
+	// given transfer.
+	FetchTransferBroadcastTrigger(ctx context.Context,
+		transferID int32) (BroadcastTriggerRow, error)
+
+	// DeleteTransferBroadcastTrigger deletes the broadcast trigger of the
+	// given transfer.
+	DeleteTransferBroadcastTrigger(ctx context.Context,
+		transferID int32) error
+
+	// UpdateTransferParcelVersion updates the parcel version of the
+	// transfer with the given anchor txid.
+	UpdateTransferParcelVersion(ctx context.Context,
+		arg TransferParcelVersion) error
+
+	// DeletePassiveAssets deletes the passive assets re-anchored by the
+	// given transfer.
+	DeletePassiveAssets(ctx context.Context, transferID int32) error
+
+	// DeleteAssetTransferInputs deletes the inputs of the given transfer.
+	DeleteAssetTransferInputs(ctx context.Context, transferID int32) error
+
+	// DeleteAssetTransferOutputs deletes the outputs of the given
+	// transfer.
+	DeleteAssetTransferOutputs(ctx context.Context, transferID int32) error
+
+	// DeleteAssetTransfer deletes the given transfer.
+	DeleteAssetTransfer(ctx context.Context, id int32) error
+
+	// DeleteChainTx deletes the chain transaction with the given txid.
+	DeleteChainTx(ctx context.Context, txid []byte) error
+
 	// FetchAssetMetaByHash fetches the asset meta for a given meta hash.
 	//
 	// TODO(roasbeef): split into MetaStore?
@@ -293,10 +420,225 @@ type ActiveAssetsStore interface {
 	// FetchAssetMetaForAsset fetches the asset meta for a given asset.
 	FetchAssetMetaForAsset(ctx context.Context,
 		assetID []byte) (sqlc.FetchAssetMetaForAssetRow, error)
+
+	// FetchAssetLot fetches the lot of an asset UTXO.
+	FetchAssetLot(ctx context.Context, arg AssetLotQuery) (AssetLotRow,
+		error)
+
+	// ReAnchorAssetLot moves the lot of a passive asset to its new anchor
+	// point.
+	ReAnchorAssetLot(ctx context.Context, arg ReAnchorLotParams) error
+
+	// UpsertAssetLotAnnotation sets the price annotation of the lot of an
+	// asset UTXO.
+	UpsertAssetLotAnnotation(ctx context.Context,
+		arg AssetLotAnnotation) error
+
+	// SetTransferOutputSettled marks the transfer output with the given
+	// anchor point and script key as settled by its receiver.
+	SetTransferOutputSettled(ctx context.Context,
+		arg TransferOutputSettlement) error
+
+	// QueryConfirmedAssetBalances sums up the unspent assets anchored in
+	// transactions confirmed at or below the given height per asset ID.
+	QueryConfirmedAssetBalances(ctx context.Context,
+		maxHeight sql.NullInt32) ([]ConfirmedBalanceRow, error)
+
+	// UpsertBalanceSnapshot inserts or replaces the balance snapshot of an
+	// asset at a given height.
+	UpsertBalanceSnapshot(ctx context.Context,
+		arg NewBalanceSnapshot) error
+
+	// QueryBalanceSnapshots returns the most recent balance snapshot of
+	// each asset that matches the query.
+	QueryBalanceSnapshots(ctx context.Context,
+		arg BalanceSnapshotQuery) ([]BalanceSnapshotRow, error)
+
+	// FetchAssetByAnchor fetches the primary key of the asset with the
+	// given ID and script key that is anchored at the given outpoint.
+	FetchAssetByAnchor(ctx context.Context,
+		arg AssetByAnchorQuery) (int32, error)
+
+	// InsertDuplicateProofReceipt records the receipt of a proof for an
+	// asset that was already imported.
+	InsertDuplicateProofReceipt(ctx context.Context,
+		arg NewDuplicateProofReceipt) error
+
+	// QueryDuplicateProofReceipts returns all recorded duplicate proof
+	// receipts, optionally filtered by anchor point.
+	QueryDuplicateProofReceipts(ctx context.Context,
+		anchorPoint []byte) ([]DuplicateProofReceiptRow, error)
+
+	// InsertProofDeliverySignature records the signature of the node that
+	// delivered a proof.
+	InsertProofDeliverySignature(ctx context.Context,
+		arg NewProofDeliverySignature) error
+
+	// QueryProofDeliverySignatures returns all recorded proof delivery
+	// signatures, optionally filtered by anchor point.
+	QueryProofDeliverySignatures(ctx context.Context,
+		anchorPoint []byte) ([]ProofDeliverySignatureRow, error)
+
+	// QueryOwnedAnchors returns the anchor outputs that anchor at least
+	// one unspent asset that isn't marked as at risk.
+	QueryOwnedAnchors(ctx context.Context) ([]OwnedAnchorRow, error)
+
+	// InsertAtRiskAsset marks an asset as at risk because its anchor
+	// output was spent by an unknown transaction.
+	InsertAtRiskAsset(ctx context.Context, arg NewAtRiskAsset) error
+
+	// QueryAtRiskAssets returns all assets that are marked as at risk.
+	QueryAtRiskAssets(ctx context.Context) ([]AtRiskAssetRow, error)
+
+	// QueryTombstoneAnchors returns the anchor outputs that only commit to
+	// tombstone asset outputs and weren't swept yet.
+	QueryTombstoneAnchors(ctx context.Context,
+		numsKey []byte) ([]TombstoneAnchorRow, error)
+
+	// InsertTombstoneSweep records that an anchor output only committing
+	// to tombstones was swept.
+	InsertTombstoneSweep(ctx context.Context, arg NewTombstoneSweep) error
+
+	// QueryAnchorKeyOutpoints returns the outpoints of all anchor outputs
+	// that were created with the given internal key.
+	QueryAnchorKeyOutpoints(ctx context.Context,
+		rawKey []byte) ([][]byte, error)
+
+	// QueryReusedAnchorKeys returns the anchor outputs of all internal
+	// keys that were used for more than one anchor output.
+	QueryReusedAnchorKeys(ctx context.Context) ([]ReusedAnchorKeyRow,
+		error)
+
+	// QueryDuplicateUnspentScriptKeys returns the anchor outputs of all
+	// script keys that are used by more than one unspent asset.
+	QueryDuplicateUnspentScriptKeys(
+		ctx context.Context) ([]DuplicateScriptKeyRow, error)
+
+	// QueryDuplicateTransferScriptKeys returns the anchor outputs of all
+	// script keys that are used by the outputs of more than one transfer.
+	QueryDuplicateTransferScriptKeys(
+		ctx context.Context) ([]DuplicateTransferScriptKeyRow, error)
+
+	// UpsertFlaggedScriptKey flags a script key for the given conflict,
+	// reopening the flag if it was already resolved.
+	UpsertFlaggedScriptKey(ctx context.Context,
+		arg NewFlaggedScriptKey) error
+
+	// QueryFlaggedScriptKeys returns the flags of the given script key,
+	// or all flags if the script key is nil.
+	QueryFlaggedScriptKeys(ctx context.Context,
+		scriptKey []byte) ([]FlaggedScriptKeyRow, error)
+
+	// ResolveFlaggedScriptKey resolves all open flags of a script key and
+	// returns the number of resolved flags.
+	ResolveFlaggedScriptKey(ctx context.Context,
+		arg FlaggedScriptKeyResolution) (int64, error)
 }
 
 type InsertRecvProofTxAttemptParams = sqlc.InsertReceiverProofTransferAttemptParams
 
+// NewProofDeliveryAttempt wraps the params needed to log a proof delivery
+// attempt.
+type NewProofDeliveryAttempt = sqlc.InsertProofDeliveryAttemptParams
+
+// ProofDeliveryAttemptQuery wraps the params needed to query the proof
+// courier outbox.
+type ProofDeliveryAttemptQuery = sqlc.QueryProofDeliveryAttemptsParams
+
+// ProofDeliveryAttemptRow is a logged proof delivery attempt.
+type ProofDeliveryAttemptRow = sqlc.QueryProofDeliveryAttemptsRow
+
+// NewAssetLot wraps the params needed to insert the lot of an asset UTXO.
+type NewAssetLot = sqlc.InsertAssetLotParams
+
+// AssetLotQuery wraps the params needed to fetch the lot of an asset UTXO.
+type AssetLotQuery = sqlc.FetchAssetLotParams
+
+// AssetLotRow is the lot of an asset UTXO.
+type AssetLotRow = sqlc.FetchAssetLotRow
+
+// ReAnchorLotParams wraps the params needed to move the lot of a passive
+// asset to its new anchor point.
+type ReAnchorLotParams = sqlc.ReAnchorAssetLotParams
+
+// AssetLotAnnotation wraps the params needed to set the price annotation of
+// the lot of an asset UTXO.
+type AssetLotAnnotation = sqlc.UpsertAssetLotAnnotationParams
+
+// TransferOutputSettlement wraps the params needed to mark a transfer output
+// as settled by its receiver.
+type TransferOutputSettlement = sqlc.SetTransferOutputSettledParams
+
+// ConfirmedBalanceRow is the confirmed balance of an asset at a given height.
+type ConfirmedBalanceRow = sqlc.QueryConfirmedAssetBalancesRow
+
+// NewBalanceSnapshot wraps the params needed to store a balance snapshot.
+type NewBalanceSnapshot = sqlc.UpsertBalanceSnapshotParams
+
+// BalanceSnapshotQuery wraps the params needed to query balance snapshots.
+type BalanceSnapshotQuery = sqlc.QueryBalanceSnapshotsParams
+
+// BalanceSnapshotRow is a stored balance snapshot.
+type BalanceSnapshotRow = sqlc.QueryBalanceSnapshotsRow
+
+// AssetByAnchorQuery wraps the params needed to fetch an asset by its anchor
+// point, asset ID and script key.
+type AssetByAnchorQuery = sqlc.FetchAssetByAnchorParams
+
+// NewDuplicateProofReceipt wraps the params needed to record the receipt of a
+// duplicate proof.
+type NewDuplicateProofReceipt = sqlc.InsertDuplicateProofReceiptParams
+
+// DuplicateProofReceiptRow is a recorded duplicate proof receipt.
+type DuplicateProofReceiptRow = sqlc.DuplicateProofReceipt
+
+// NewProofDeliverySignature wraps the params needed to record the signature of
+// the node that delivered a proof.
+type NewProofDeliverySignature = sqlc.InsertProofDeliverySignatureParams
+
+// ProofDeliverySignatureRow is a recorded proof delivery signature.
+type ProofDeliverySignatureRow = sqlc.ProofDeliverySignature
+
+// OwnedAnchorRow is an anchor output of the local wallet with its anchor
+// transaction.
+type OwnedAnchorRow = sqlc.QueryOwnedAnchorsRow
+
+// NewAtRiskAsset wraps the params needed to mark an asset as at risk.
+type NewAtRiskAsset = sqlc.InsertAtRiskAssetParams
+
+// AtRiskAssetRow is an asset that is marked as at risk.
+type AtRiskAssetRow = sqlc.AtRiskAsset
+
+// TombstoneAnchorRow is an anchor output that only commits to tombstones,
+// along with its internal key.
+type TombstoneAnchorRow = sqlc.QueryTombstoneAnchorsRow
+
+// NewTombstoneSweep wraps the params needed to record the sweep of an anchor
+// output that only commits to tombstones.
+type NewTombstoneSweep = sqlc.InsertTombstoneSweepParams
+
+// ReusedAnchorKeyRow is an anchor output along with its internal key, which
+// was used for more than one anchor output.
+type ReusedAnchorKeyRow = sqlc.QueryReusedAnchorKeysRow
+
+// DuplicateScriptKeyRow is the anchor output of an unspent asset whose script
+// key is used by more than one unspent asset.
+type DuplicateScriptKeyRow = sqlc.QueryDuplicateUnspentScriptKeysRow
+
+// DuplicateTransferScriptKeyRow is the anchor output of a transfer output
+// whose script key is used by the outputs of more than one transfer.
+type DuplicateTransferScriptKeyRow = sqlc.QueryDuplicateTransferScriptKeysRow
+
+// NewFlaggedScriptKey wraps the params needed to flag a script key.
+type NewFlaggedScriptKey = sqlc.UpsertFlaggedScriptKeyParams
+
+// FlaggedScriptKeyRow is a stored flag of a script key.
+type FlaggedScriptKeyRow = sqlc.QueryFlaggedScriptKeysRow
+
+// FlaggedScriptKeyResolution wraps the params needed to resolve the flags of
+// a script key.
+type FlaggedScriptKeyResolution = sqlc.ResolveFlaggedScriptKeyParams
+
 // AssetBalance holds a balance query result for a particular asset or all
 // assets tracked by this daemon.
 type AssetBalance struct {
@@ -426,6 +768,27 @@ type ManagedUTXO struct {
 	TapscriptSibling []byte
 }
 
+// AssetUTXO is an unspent asset output along with the state of its anchor
+// output and the local availability of its proof.
+type AssetUTXO struct {
+	// ChainAsset is the asset itself, including the anchor outpoint,
+	// internal key, confirmation height and lease of its anchor output.
+	*ChainAsset
+
+	// Leased indicates whether the anchor output is currently leased and
+	// therefore not available for coin selection.
+	Leased bool
+
+	// CoResidents are the other unspent assets committed to the same
+	// anchor output. These are carried along as passive assets when the
+	// asset is spent.
+	CoResidents []asset.PrevID
+
+	// HasProof indicates whether the proof file of the asset is stored
+	// locally. Proofs are only stored after they were verified.
+	HasProof bool
+}
+
 // AssetHumanReadable is a subset of the base asset struct that only includes
 // human-readable asset fields.
 type AssetHumanReadable struct {
@@ -729,6 +1092,10 @@ func (a *AssetStore) dbAssetsToChainAssets(dbAssets []ConfirmedAsset,
 			anchorBlockHash = *anchorHash
 		}
 
+		// The block height is NULL and therefore zero as long as the
+		// anchor transaction is unconfirmed.
+		anchorBlockHeight := uint32(sprout.AnchorBlockHeight.Int32)
+
 		var anchorOutpoint wire.OutPoint
 		err = readOutPoint(
 			bytes.NewReader(sprout.AnchorOutpoint), 0, 0,
@@ -753,6 +1120,7 @@ func (a *AssetStore) dbAssetsToChainAssets(dbAssets []ConfirmedAsset,
 			AnchorTx:               anchorTx,
 			AnchorTxid:             anchorTx.TxHash(),
 			AnchorBlockHash:        anchorBlockHash,
+			AnchorBlockHeight:      anchorBlockHeight,
 			AnchorOutpoint:         anchorOutpoint,
 			AnchorInternalKey:      anchorInternalKey,
 			AnchorMerkleRoot:       sprout.AnchorMerkleRoot,
@@ -1132,6 +1500,105 @@ func (a *AssetStore) FetchManagedUTXOs(ctx context.Context) (
 	return managedUtxos, nil
 }
 
+// ListAssetUTXOs returns all unspent assets matching the given query,
+// including leased ones, together with the lease status of their anchor
+// output, the other assets committed to the same anchor output and whether
+// their proof is available locally.
+func (a *AssetStore) ListAssetUTXOs(ctx context.Context,
+	query *AssetQueryFilters) ([]*AssetUTXO, error) {
+
+	// The co-residents of an asset don't need to match the query, so we
+	// always fetch all unspent assets to group them by anchor output.
+	allAssets, err := a.FetchAllAssets(ctx, false, true, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := allAssets
+	if query != nil {
+		assets, err = a.FetchAllAssets(ctx, false, true, query)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var proofKeys []AssetProofKey
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		proofKeys, err = q.FetchAssetProofKeys(ctx)
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to fetch proof keys: %w", dbErr)
+	}
+
+	// Proofs are stored per asset, which is identified by its asset ID and
+	// script key.
+	type proofKey struct {
+		id        asset.ID
+		scriptKey asset.SerializedKey
+	}
+	haveProof := make(map[proofKey]struct{}, len(proofKeys))
+	for _, k := range proofKeys {
+		scriptKey, err := btcec.ParsePubKey(k.ScriptKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse script key: %w",
+				err)
+		}
+
+		var id asset.ID
+		copy(id[:], k.AssetID)
+
+		haveProof[proofKey{
+			id:        id,
+			scriptKey: asset.ToSerialized(scriptKey),
+		}] = struct{}{}
+	}
+
+	anchored := make(map[wire.OutPoint][]asset.PrevID)
+	for _, chainAsset := range allAssets {
+		anchored[chainAsset.AnchorOutpoint] = append(
+			anchored[chainAsset.AnchorOutpoint],
+			chainAssetPrevID(chainAsset),
+		)
+	}
+
+	utxos := make([]*AssetUTXO, len(assets))
+	for i, chainAsset := range assets {
+		prevID := chainAssetPrevID(chainAsset)
+
+		var coResidents []asset.PrevID
+		for _, other := range anchored[chainAsset.AnchorOutpoint] {
+			if other != prevID {
+				coResidents = append(coResidents, other)
+			}
+		}
+
+		_, hasProof := haveProof[proofKey{
+			id:        prevID.ID,
+			scriptKey: prevID.ScriptKey,
+		}]
+
+		utxos[i] = &AssetUTXO{
+			ChainAsset:  chainAsset,
+			Leased:      chainAsset.AnchorLeaseExpiry != nil,
+			CoResidents: coResidents,
+			HasProof:    hasProof,
+		}
+	}
+
+	return utxos, nil
+}
+
+// chainAssetPrevID returns the previous input ID that spends the given asset.
+func chainAssetPrevID(chainAsset *ChainAsset) asset.PrevID {
+	return asset.PrevID{
+		OutPoint:  chainAsset.AnchorOutpoint,
+		ID:        chainAsset.ID(),
+		ScriptKey: asset.ToSerialized(chainAsset.ScriptKey.PubKey),
+	}
+}
+
 // FetchAssetProofs returns the latest proof file for either the set of target
 // assets, or all assets if no script keys for an asset are passed in.
 //
@@ -1451,9 +1918,20 @@ func (a *AssetStore) importAssetFromProof(ctx context.Context,
 		return fmt.Errorf("unable to insert asset witness: %w", err)
 	}
 
+	// The asset was acquired just now, so we start tracking its lot.
+	assetID := newAsset.ID()
+	scriptKeyBytes := newAsset.ScriptKey.PubKey.SerializeCompressed()
+	err = insertAssetLot(ctx, db, AssetLotQuery{
+		AnchorPoint: anchorPoint,
+		AssetID:     assetID[:],
+		ScriptKey:   scriptKeyBytes,
+	}, a.clock.Now(), sql.NullInt32{}, "")
+	if err != nil {
+		return err
+	}
+
 	// As a final step, we'll insert the proof file we used to generate all
 	// the above information.
-	scriptKeyBytes := newAsset.ScriptKey.PubKey.SerializeCompressed()
 	return db.UpsertAssetProof(ctx, ProofUpdate{
 		TweakedScriptKey: scriptKeyBytes,
 		ProofFile:        proof.Blob,
@@ -1500,14 +1978,20 @@ func (a *AssetStore) upsertAssetProof(ctx context.Context,
 // ImportProofs attempts to store fully populated proofs on disk. The previous
 // outpoint of the first state transition will be used as the Genesis point.
 // The final resting place of the asset will be used as the script key itself.
+// Unless replace is set, proofs of assets that were already imported are only
+// recorded as duplicate receipts and don't credit the asset again.
 //
 // NOTE: This implements the proof.ArchiveBackend interface.
 func (a *AssetStore) ImportProofs(ctx context.Context,
 	headerVerifier proof.HeaderVerifier, replace bool,
 	proofs ...*proof.AnnotatedProof) error {
 
-	var writeTxOpts AssetStoreTxOptions
+	var (
+		writeTxOpts AssetStoreTxOptions
+		newProofs   []*proof.AnnotatedProof
+	)
 	err := a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		newProofs = make([]*proof.AnnotatedProof, 0, len(proofs))
 		for _, p := range proofs {
 			if replace {
 				err := a.upsertAssetProof(ctx, q, p)
@@ -1515,13 +1999,37 @@ func (a *AssetStore) ImportProofs(ctx context.Context,
 					return fmt.Errorf("unable to upsert "+
 						"asset proof: %w", err)
 				}
-			} else {
-				err := a.importAssetFromProof(ctx, q, p)
-				if err != nil {
-					return fmt.Errorf("unable to import "+
-						"asset: %w", err)
-				}
+
+				newProofs = append(newProofs, p)
+				continue
+			}
+
+			// The signature of the sending node is kept even for
+			// duplicates, as each of them proves a delivery.
+			err := a.recordDeliverySignature(ctx, q, p)
+			if err != nil {
+				return err
+			}
+
+			// The same proof might be delivered more than once,
+			// for example by different couriers or by a courier
+			// after a manual import. We only credit the asset
+			// once and record the receipt of any duplicate.
+			duplicate, err := a.recordDuplicateProof(ctx, q, p)
+			if err != nil {
+				return err
+			}
+			if duplicate {
+				continue
+			}
+
+			err = a.importAssetFromProof(ctx, q, p)
+			if err != nil {
+				return fmt.Errorf("unable to import "+
+					"asset: %w", err)
 			}
+
+			newProofs = append(newProofs, p)
 		}
 
 		return nil
@@ -1532,10 +2040,16 @@ func (a *AssetStore) ImportProofs(ctx context.Context,
 
 	// Notify any event subscribers that there are new proofs. We do this
 	// outside of the transaction to avoid the subscribers trying to look up
-	// the proofs before they are committed.
-	proofBlobs := fn.Map(proofs, func(p *proof.AnnotatedProof) proof.Blob {
-		return p.Blob
-	})
+	// the proofs before they are committed. Duplicate proofs were already
+	// announced when they were first imported.
+	if len(newProofs) == 0 {
+		return nil
+	}
+	proofBlobs := fn.Map(
+		newProofs, func(p *proof.AnnotatedProof) proof.Blob {
+			return p.Blob
+		},
+	)
 	a.eventDistributor.NotifySubscribers(proofBlobs...)
 
 	return nil
@@ -1622,9 +2136,11 @@ func (a *AssetStore) FetchCommitment(ctx context.Context, id asset.ID,
 
 	// The caller decides whether the asset output should've been leased
 	// before or not. If mustBeLeased is false, then the state of the lease
-	// is not checked.
+	// is not checked. A commitment that must be leased is about to be
+	// spent, so it must not have been frozen in the meantime.
 	if mustBeLeased {
 		filter.Leased = sqlBool(true)
+		filter.ExcludeFrozen = sqlBool(true)
 	}
 
 	commitments, err := a.queryCommitments(ctx, filter)
@@ -1657,9 +2173,11 @@ func (a *AssetStore) ListEligibleCoins(ctx context.Context,
 		CommitmentConstraints: constraints,
 	})
 
-	// We only want to select unspent and non-leased commitments.
+	// We only want to select unspent, non-leased and non-frozen
+	// commitments.
 	assetFilter.Spent = sqlBool(false)
 	assetFilter.Leased = sqlBool(false)
+	assetFilter.ExcludeFrozen = sqlBool(true)
 
 	return a.queryCommitments(ctx, assetFilter)
 }
@@ -1740,6 +2258,187 @@ func (a *AssetStore) DeleteExpiredLeases(ctx context.Context) error {
 	})
 }
 
+// FrozenAssetOutput is an asset output that was frozen by an administrator,
+// for example because of a compliance hold. Neither the frozen asset nor any
+// other asset anchored at the same outpoint can be selected for spending until
+// the asset output is unfrozen again.
+type FrozenAssetOutput struct {
+	// AnchorPoint is the outpoint the frozen asset is anchored at.
+	AnchorPoint wire.OutPoint
+
+	// ScriptKey is the script key of the frozen asset.
+	ScriptKey *btcec.PublicKey
+
+	// Reason is the reason the asset output was frozen for.
+	Reason string
+
+	// FrozenAt is the time the asset output was frozen.
+	FrozenAt time.Time
+}
+
+// FreezeAssetOutputs freezes the unspent asset with the given script key that
+// is anchored at the given outpoint. If no script key is given, all unspent
+// assets anchored at the outpoint are frozen. Freezing an already frozen asset
+// output only updates the reason.
+func (a *AssetStore) FreezeAssetOutputs(ctx context.Context,
+	anchorPoint wire.OutPoint, scriptKey *btcec.PublicKey,
+	reason string) ([]FrozenAssetOutput, error) {
+
+	anchorPointBytes, err := encodeOutpoint(anchorPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := QueryAssetFilters{
+		AnchorPoint: anchorPointBytes,
+		Spent:       sqlBool(false),
+		Now: sql.NullTime{
+			Time:  a.clock.Now().UTC(),
+			Valid: true,
+		},
+	}
+	if scriptKey != nil {
+		filter.TweakedScriptKey = scriptKey.SerializeCompressed()
+	}
+
+	var (
+		frozen      []FrozenAssetOutput
+		frozenAt    = a.clock.Now().UTC()
+		writeTxOpts AssetStoreTxOptions
+	)
+	dbErr := a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		frozen = nil
+
+		dbAssets, err := q.QueryAssets(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("unable to query assets: %w", err)
+		}
+		if len(dbAssets) == 0 {
+			return fmt.Errorf("no unspent assets found at %v",
+				anchorPoint)
+		}
+
+		for _, dbAsset := range dbAssets {
+			assetScriptKey, err := btcec.ParsePubKey(
+				dbAsset.TweakedScriptKey,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to parse script "+
+					"key: %w", err)
+			}
+
+			err = q.FreezeAssetOutput(ctx, FreezeParams{
+				Outpoint:  anchorPointBytes,
+				ScriptKey: dbAsset.TweakedScriptKey,
+				Reason:    reason,
+				FrozenAt:  frozenAt,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to freeze asset "+
+					"output: %w", err)
+			}
+
+			frozen = append(frozen, FrozenAssetOutput{
+				AnchorPoint: anchorPoint,
+				ScriptKey:   assetScriptKey,
+				Reason:      reason,
+				FrozenAt:    frozenAt,
+			})
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return frozen, nil
+}
+
+// UnfreezeAssetOutputs unfreezes the frozen asset output with the given script
+// key at the given outpoint, or all frozen asset outputs at the outpoint if no
+// script key is given. The number of unfrozen asset outputs is returned.
+func (a *AssetStore) UnfreezeAssetOutputs(ctx context.Context,
+	anchorPoint wire.OutPoint, scriptKey *btcec.PublicKey) (int64, error) {
+
+	anchorPointBytes, err := encodeOutpoint(anchorPoint)
+	if err != nil {
+		return 0, err
+	}
+
+	params := UnfreezeParams{
+		Outpoint: anchorPointBytes,
+	}
+	if scriptKey != nil {
+		params.ScriptKey = scriptKey.SerializeCompressed()
+	}
+
+	var (
+		numUnfrozen int64
+		writeTxOpts AssetStoreTxOptions
+	)
+	dbErr := a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		numUnfrozen, err = q.UnfreezeAssetOutputs(ctx, params)
+		if err != nil {
+			return err
+		}
+		if numUnfrozen == 0 {
+			return fmt.Errorf("no frozen asset outputs found at %v",
+				anchorPoint)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return 0, dbErr
+	}
+
+	return numUnfrozen, nil
+}
+
+// ListFrozenAssetOutputs returns all frozen asset outputs in the order they
+// were frozen in.
+func (a *AssetStore) ListFrozenAssetOutputs(
+	ctx context.Context) ([]FrozenAssetOutput, error) {
+
+	var (
+		dbFrozen []sqlc.FrozenAssetOutput
+		err      error
+	)
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		dbFrozen, err = q.QueryFrozenAssetOutputs(ctx)
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query frozen asset "+
+			"outputs: %w", dbErr)
+	}
+
+	frozen := make([]FrozenAssetOutput, len(dbFrozen))
+	for i, dbOutput := range dbFrozen {
+		err := readOutPoint(
+			bytes.NewReader(dbOutput.Outpoint), 0, 0,
+			&frozen[i].AnchorPoint,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode outpoint: %w",
+				err)
+		}
+
+		frozen[i].ScriptKey, err = btcec.ParsePubKey(dbOutput.ScriptKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse script key: %w",
+				err)
+		}
+
+		frozen[i].Reason = dbOutput.Reason
+		frozen[i].FrozenAt = dbOutput.FrozenAt.UTC()
+	}
+
+	return frozen, nil
+}
+
 // queryCommitments queries the database for commitments matching the passed
 // filter.
 func (a *AssetStore) queryCommitments(ctx context.Context,
@@ -1748,6 +2447,7 @@ func (a *AssetStore) queryCommitments(ctx context.Context,
 
 	var (
 		matchingAssets      []*ChainAsset
+		matchingLots        []*tapfreighter.AssetLot
 		chainAnchorToAssets = make(map[wire.OutPoint][]*ChainAsset)
 		anchorPoints        = make(map[wire.OutPoint]AnchorPoint)
 		err                 error
@@ -1774,6 +2474,9 @@ func (a *AssetStore) queryCommitments(ctx context.Context,
 		// To obtain this, we'll first do another query to fetch all
 		// the _other_ assets that are anchored at the anchor point for
 		// each of the assets above.
+		matchingLots = make(
+			[]*tapfreighter.AssetLot, len(matchingAssets),
+		)
 		for idx := range matchingAssets {
 			matchingAsset := matchingAssets[idx]
 			anchorPoint := matchingAsset.AnchorOutpoint
@@ -1783,6 +2486,20 @@ func (a *AssetStore) queryCommitments(ctx context.Context,
 			if err != nil {
 				return err
 			}
+
+			// We also fetch the lot of the asset, so it can be
+			// used for lot based coin selection.
+			assetID := matchingAsset.ID()
+			scriptKey := matchingAsset.ScriptKey.PubKey
+			lotID := AssetLotQuery{
+				AnchorPoint: anchorPointBytes,
+				AssetID:     assetID[:],
+				ScriptKey:   scriptKey.SerializeCompressed(),
+			}
+			matchingLots[idx], err = fetchAssetLot(ctx, q, lotID)
+			if err != nil {
+				return err
+			}
 			outpointQuery := QueryAssetFilters{
 				AnchorPoint: anchorPointBytes,
 				Now: sql.NullTime{
@@ -1872,6 +2589,7 @@ func (a *AssetStore) queryCommitments(ctx context.Context,
 		selectedAssets[i] = &tapfreighter.AnchoredCommitment{
 			AnchorPoint:       anchorPoint,
 			AnchorOutputValue: btcutil.Amount(anchorUTXO.AmtSats),
+			AnchorBlockHeight: matchingAsset.AnchorBlockHeight,
 			InternalKey: keychain.KeyDescriptor{
 				PubKey: internalKey,
 				KeyLocator: keychain.KeyLocator{
@@ -1884,6 +2602,7 @@ func (a *AssetStore) queryCommitments(ctx context.Context,
 			TapscriptSibling: tapscriptSibling,
 			Asset:            matchingAsset.Asset,
 			Commitment:       anchorPointToCommitment[anchorPoint],
+			Lot:              matchingLots[i],
 		}
 	}
 
@@ -1930,6 +2649,8 @@ func (a *AssetStore) LogPendingParcel(ctx context.Context,
 			HeightHint:       int32(spend.AnchorTxHeightHint),
 			AnchorTxid:       newAnchorTXID[:],
 			TransferTimeUnix: spend.TransferTime,
+			ChangeKeyPolicy:  int16(spend.ChangeKeyPolicy),
+			ParcelVersion:    int16(spend.Version),
 		})
 		if err != nil {
 			return fmt.Errorf("unable to insert asset transfer: "+
@@ -1960,9 +2681,36 @@ func (a *AssetStore) LogPendingParcel(ctx context.Context,
 			}
 		}
 
-		return nil
-	})
-}
+		// A scheduled parcel keeps its broadcast trigger, so it's held
+		// back again after a restart.
+		if spend.BroadcastTrigger != nil {
+			trigger := spend.BroadcastTrigger
+			err = q.InsertTransferBroadcastTrigger(
+				ctx, NewBroadcastTrigger{
+					TransferID: transferID,
+					MinHeight:  int32(trigger.Height),
+					MinTime:    sqlOptTime(trigger.Time),
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to insert broadcast "+
+					"trigger: %w", err)
+			}
+		}
+
+		// The request the parcel was created from is removed in the
+		// same transaction, so it's never re-driven after a restart.
+		if spend.RequestID != nil {
+			err = q.DeleteParcelRequest(ctx, spend.RequestID[:])
+			if err != nil {
+				return fmt.Errorf("unable to delete parcel "+
+					"request: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
 
 // insertAssetTransferInput inserts a new asset transfer input into the DB.
 func insertAssetTransferInput(ctx context.Context, q ActiveAssetsStore,
@@ -2032,6 +2780,15 @@ func fetchAssetTransferInputs(ctx context.Context, q ActiveAssetsStore,
 				"%w", err)
 		}
 		inputs[idx].ScriptKey = asset.ToSerialized(parsedScriptKey)
+
+		inputs[idx].Lot, err = fetchAssetLot(ctx, q, AssetLotQuery{
+			AnchorPoint: dbInput.AnchorPoint,
+			AssetID:     dbInput.AssetID,
+			ScriptKey:   dbInput.ScriptKey,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return inputs, nil
@@ -2263,6 +3020,10 @@ func fetchAssetTransferOutputs(ctx context.Context, q ActiveAssetsStore,
 			ProofSuffix: dbOut.ProofSuffix,
 			Type:        tappsbt.VOutputType(dbOut.OutputType),
 		}
+		if dbOut.ReceiverSettledAt.Valid {
+			outputs[idx].ReceiverSettledAt =
+				dbOut.ReceiverSettledAt.Time.UTC()
+		}
 
 		err = readOutPoint(
 			bytes.NewReader(dbOut.AnchorOutpoint), 0, 0,
@@ -2387,6 +3148,106 @@ func (a *AssetStore) QueryProofDeliveryLog(ctx context.Context,
 	return timestamps, err
 }
 
+// LogDeliveryAttempt logs the outcome of a proof delivery attempt to disk.
+func (a *AssetStore) LogDeliveryAttempt(ctx context.Context,
+	attempt *proof.DeliveryAttempt) error {
+
+	locator := attempt.Locator
+	locatorHash := locator.Hash()
+	scriptKey := locator.ScriptKey.SerializeCompressed()
+
+	var assetID []byte
+	if locator.AssetID != nil {
+		assetID = locator.AssetID[:]
+	}
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		err := q.InsertProofDeliveryAttempt(ctx, NewProofDeliveryAttempt{
+			ProofLocatorHash:  locatorHash[:],
+			AnchorTxid:        attempt.AnchorPoint.Hash[:],
+			AnchorOutputIndex: int32(attempt.AnchorPoint.Index),
+			ScriptKey:         scriptKey,
+			AssetID:           assetID,
+			CourierAddr:       attempt.CourierAddr,
+			AttemptTime:       attempt.Timestamp.UTC(),
+			ErrorMsg:          sqlStr(attempt.Error),
+			NextAttemptTime:   sqlOptTime(attempt.NextAttempt),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert proof delivery "+
+				"attempt: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// QueryDeliveryAttempts returns the logged outcomes of the proof delivery
+// attempts that match the query, oldest first.
+func (a *AssetStore) QueryDeliveryAttempts(ctx context.Context,
+	query proof.DeliveryAttemptQuery) ([]*proof.DeliveryAttempt, error) {
+
+	var dbQuery ProofDeliveryAttemptQuery
+	if query.AnchorTxid != nil {
+		dbQuery.AnchorTxid = query.AnchorTxid[:]
+	}
+	if query.ScriptKey != nil {
+		dbQuery.ScriptKey = query.ScriptKey.SerializeCompressed()
+	}
+
+	var attempts []*proof.DeliveryAttempt
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		rows, err := q.QueryProofDeliveryAttempts(ctx, dbQuery)
+		if err != nil {
+			return fmt.Errorf("unable to query proof delivery "+
+				"attempts: %w", err)
+		}
+
+		attempts = make([]*proof.DeliveryAttempt, 0, len(rows))
+		for _, row := range rows {
+			scriptKey, err := btcec.ParsePubKey(row.ScriptKey)
+			if err != nil {
+				return fmt.Errorf("unable to parse script "+
+					"key: %w", err)
+			}
+
+			attempt := &proof.DeliveryAttempt{
+				Locator: proof.Locator{
+					ScriptKey: *scriptKey,
+				},
+				AnchorPoint: wire.OutPoint{
+					Index: uint32(row.AnchorOutputIndex),
+				},
+				CourierAddr: row.CourierAddr,
+				Timestamp:   row.AttemptTime.UTC(),
+				Error:       row.ErrorMsg.String,
+			}
+			copy(attempt.AnchorPoint.Hash[:], row.AnchorTxid)
+
+			if len(row.AssetID) > 0 {
+				var assetID asset.ID
+				copy(assetID[:], row.AssetID)
+				attempt.Locator.AssetID = &assetID
+			}
+			if row.NextAttemptTime.Valid {
+				attempt.NextAttempt =
+					row.NextAttemptTime.Time.UTC()
+			}
+
+			attempts = append(attempts, attempt)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return attempts, nil
+}
+
 // ConfirmParcelDelivery marks a spend event on disk as confirmed. This updates
 // the on-chain reference information on disk to point to this new spend.
 func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
@@ -2406,6 +3267,10 @@ func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
 			return fmt.Errorf("unable to query asset transfers: %w",
 				err)
 		}
+		if len(assetTransfers) == 0 {
+			return fmt.Errorf("no transfer found for anchor tx %v",
+				conf.AnchorTXID)
+		}
 		assetTransfer := assetTransfers[0]
 
 		// Next, we'll mark all input assets as spent. But we need to
@@ -2418,9 +3283,22 @@ func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
 
 		// We'll keep around the IDs of the assets that we set to being
 		// spent. We'll need one of them as our template to create the
-		// new assets.
+		// new assets. The lots of the inputs are used to derive the
+		// lots of the change outputs.
 		spentAssetIDs := make([]int32, len(inputs))
+		inputLots := make([]*tapfreighter.AssetLot, len(inputs))
 		for idx := range inputs {
+			inputLots[idx], err = fetchAssetLot(
+				ctx, q, AssetLotQuery{
+					AnchorPoint: inputs[idx].AnchorPoint,
+					AssetID:     inputs[idx].AssetID,
+					ScriptKey:   inputs[idx].ScriptKey,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
 			spentAssetIDs[idx], err = q.SetAssetSpent(
 				ctx, SetAssetSpentParams{
 					ScriptKey:  inputs[idx].ScriptKey,
@@ -2528,6 +3406,23 @@ func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
 			if err != nil {
 				return err
 			}
+
+			// Assets that stay with us inherit the acquisition
+			// time and price of the oldest lot that was spent, so
+			// the cost basis is carried over to the change.
+			lot := oldestLot(inputLots)
+			if isTombstone || lot == nil {
+				continue
+			}
+			err = insertAssetLot(ctx, q, AssetLotQuery{
+				AnchorPoint: out.AnchorOutpoint,
+				AssetID:     inputs[0].AssetID,
+				ScriptKey:   out.ScriptKeyBytes,
+			}, lot.AcquiredAt, sqlInt32(assetTransfer.ID),
+				lot.PriceAnnotation)
+			if err != nil {
+				return err
+			}
 		}
 
 		// Before we confirm the anchor TX, let's re-anchor the passive
@@ -2582,6 +3477,38 @@ func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
 	return nil
 }
 
+// MarkReceiverSettled records that the receiver of the transfer output with
+// the given anchor point and script key verified and accepted the output's
+// proof. An output that was already marked as settled keeps its original
+// settlement time.
+func (a *AssetStore) MarkReceiverSettled(ctx context.Context,
+	anchorPoint wire.OutPoint, scriptKey *btcec.PublicKey,
+	settledAt time.Time) error {
+
+	anchorPointBytes, err := encodeOutpoint(anchorPoint)
+	if err != nil {
+		return err
+	}
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		err := q.SetTransferOutputSettled(ctx, TransferOutputSettlement{
+			SettledAt: sql.NullTime{
+				Time:  settledAt.UTC(),
+				Valid: true,
+			},
+			AnchorOutpoint: anchorPointBytes,
+			ScriptKey:      scriptKey.SerializeCompressed(),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to mark transfer output as "+
+				"settled: %w", err)
+		}
+
+		return nil
+	})
+}
+
 // reAnchorPassiveAssets re-anchors all passive assets that were anchored by
 // the given transfer output.
 func (a *AssetStore) reAnchorPassiveAssets(ctx context.Context,
@@ -2651,6 +3578,19 @@ func (a *AssetStore) reAnchorPassiveAssets(ctx context.Context,
 				"proof file: %w", err)
 		}
 
+		// The lot of the asset moves to the new anchor point as well,
+		// which we need to do before the anchor UTXO is updated.
+		err = q.ReAnchorAssetLot(ctx, ReAnchorLotParams{
+			NewAnchorUtxoID: passiveAsset.NewAnchorUtxo,
+			GenesisID:       passiveAsset.GenesisID,
+			ScriptKey:       passiveAsset.ScriptKey,
+			AssetRowID:      passiveAsset.AssetID,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to re-anchor passive "+
+				"asset lot: %w", err)
+		}
+
 		// And finally, update the anchor UTXO of the asset in question.
 		err = q.ReAnchorPassiveAssets(ctx, ReAnchorParams{
 			NewAnchorUtxoID: sqlInt32(passiveAsset.NewAnchorUtxo),
@@ -2674,6 +3614,24 @@ func (a *AssetStore) PendingParcels(
 	return a.QueryParcels(ctx, true)
 }
 
+// UpdateParcelVersion records that the pending parcel with the given anchor
+// txid was migrated to the given version.
+//
+// NOTE: This is part of the tapfreighter.PendingParcelStore interface.
+func (a *AssetStore) UpdateParcelVersion(ctx context.Context,
+	anchorTxid chainhash.Hash, version tapfreighter.ParcelVersion) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		return q.UpdateTransferParcelVersion(
+			ctx, TransferParcelVersion{
+				ParcelVersion: int16(version),
+				AnchorTxid:    anchorTxid[:],
+			},
+		)
+	})
+}
+
 // QueryParcels returns the set of confirmed or unconfirmed parcels.
 func (a *AssetStore) QueryParcels(ctx context.Context,
 	pending bool) ([]*tapfreighter.OutboundParcel, error) {
@@ -2692,62 +3650,246 @@ func (a *AssetStore) QueryParcels(ctx context.Context,
 		}
 
 		for idx := range dbTransfers {
-			dbT := dbTransfers[idx]
-
-			inputs, err := fetchAssetTransferInputs(ctx, q, dbT.ID)
-			if err != nil {
-				return fmt.Errorf("unable to fetch transfer "+
-					"inputs: %w", err)
-			}
-
-			outputs, err := fetchAssetTransferOutputs(
-				ctx, q, dbT.ID,
+			transfer, _, err := fetchOutboundParcel(
+				ctx, q, dbTransfers[idx],
 			)
 			if err != nil {
-				return fmt.Errorf("unable to fetch transfer "+
-					"outputs: %w", err)
+				return err
 			}
+			transfers = append(transfers, transfer)
+		}
 
-			// We know that the anchor transaction is the same for
-			// each output, we can just fetch the first.
-			if len(outputs) == 0 {
-				return fmt.Errorf("no outputs for transfer")
-			}
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
 
-			anchorTXID := outputs[0].Anchor.OutPoint.Hash[:]
-			dbAnchorTx, err := q.FetchChainTx(ctx, anchorTXID)
-			if err != nil {
-				return fmt.Errorf("unable to fetch chain tx: "+
-					"%w", err)
-			}
+	return transfers, nil
+}
 
-			anchorTx := wire.NewMsgTx(2)
-			err = anchorTx.Deserialize(bytes.NewReader(
-				dbAnchorTx.RawTx,
-			))
-			if err != nil {
-				return fmt.Errorf("unable to deserialize "+
-					"anchor tx: %w", err)
-			}
+// ConfirmedParcel returns the parcel with the given anchor txid and the
+// location of its anchor transaction in the chain. If the parcel is unknown or
+// not confirmed yet, tapfreighter.ErrParcelNotConfirmed is returned.
+//
+// NOTE: This is part of the tapfreighter.ConfirmedParcelStore interface.
+func (a *AssetStore) ConfirmedParcel(ctx context.Context,
+	anchorTxid chainhash.Hash) (*tapfreighter.OutboundParcel,
+	*tapfreighter.ParcelConfirmation, error) {
 
-			transfer := &tapfreighter.OutboundParcel{
-				AnchorTx:           anchorTx,
-				AnchorTxHeightHint: uint32(dbT.HeightHint),
-				TransferTime:       dbT.TransferTimeUnix.UTC(),
-				ChainFees:          dbAnchorTx.ChainFees,
-				Inputs:             inputs,
-				Outputs:            outputs,
-			}
-			transfers = append(transfers, transfer)
+	var (
+		parcel *tapfreighter.OutboundParcel
+		conf   *tapfreighter.ParcelConfirmation
+	)
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		dbTransfers, err := q.QueryAssetTransfers(ctx, TransferQuery{
+			AnchorTxHash: anchorTxid[:],
+		})
+		if err != nil {
+			return fmt.Errorf("unable to query asset transfers: %w",
+				err)
+		}
+		if len(dbTransfers) == 0 {
+			return tapfreighter.ErrParcelNotConfirmed
 		}
 
+		var dbAnchorTx ChainTx
+		parcel, dbAnchorTx, err = fetchOutboundParcel(
+			ctx, q, dbTransfers[0],
+		)
+		if err != nil {
+			return err
+		}
+
+		if !dbAnchorTx.BlockHeight.Valid ||
+			len(dbAnchorTx.BlockHash) != chainhash.HashSize {
+
+			return tapfreighter.ErrParcelNotConfirmed
+		}
+
+		conf = &tapfreighter.ParcelConfirmation{
+			BlockHeight: extractSqlInt32[uint32](
+				dbAnchorTx.BlockHeight,
+			),
+			TxIndex: extractSqlInt32[uint32](dbAnchorTx.TxIndex),
+		}
+		copy(conf.BlockHash[:], dbAnchorTx.BlockHash)
+
 		return nil
 	})
 	if dbErr != nil {
-		return nil, dbErr
+		return nil, nil, dbErr
 	}
 
-	return transfers, nil
+	return parcel, conf, nil
+}
+
+// fetchOutboundParcel fetches the inputs, outputs and anchor transaction of
+// the given transfer and assembles them into an outbound parcel. The stored
+// anchor transaction is returned as well.
+func fetchOutboundParcel(ctx context.Context, q ActiveAssetsStore,
+	dbT AssetTransferRow) (*tapfreighter.OutboundParcel, ChainTx, error) {
+
+	inputs, err := fetchAssetTransferInputs(ctx, q, dbT.ID)
+	if err != nil {
+		return nil, ChainTx{}, fmt.Errorf("unable to fetch transfer "+
+			"inputs: %w", err)
+	}
+
+	outputs, err := fetchAssetTransferOutputs(ctx, q, dbT.ID)
+	if err != nil {
+		return nil, ChainTx{}, fmt.Errorf("unable to fetch transfer "+
+			"outputs: %w", err)
+	}
+
+	// We know that the anchor transaction is the same for each output, we
+	// can just fetch the first.
+	if len(outputs) == 0 {
+		return nil, ChainTx{}, fmt.Errorf("no outputs for transfer")
+	}
+
+	anchorTXID := outputs[0].Anchor.OutPoint.Hash[:]
+	dbAnchorTx, err := q.FetchChainTx(ctx, anchorTXID)
+	if err != nil {
+		return nil, ChainTx{}, fmt.Errorf("unable to fetch chain tx: "+
+			"%w", err)
+	}
+
+	anchorTx := wire.NewMsgTx(2)
+	err = anchorTx.Deserialize(bytes.NewReader(dbAnchorTx.RawTx))
+	if err != nil {
+		return nil, ChainTx{}, fmt.Errorf("unable to deserialize "+
+			"anchor tx: %w", err)
+	}
+
+	trigger, err := fetchBroadcastTrigger(ctx, q, dbT.ID)
+	if err != nil {
+		return nil, ChainTx{}, err
+	}
+
+	return &tapfreighter.OutboundParcel{
+		AnchorTx:           anchorTx,
+		AnchorTxHeightHint: uint32(dbT.HeightHint),
+		TransferTime:       dbT.TransferTimeUnix.UTC(),
+		ChainFees:          dbAnchorTx.ChainFees,
+		Inputs:             inputs,
+		Outputs:            outputs,
+		BroadcastTrigger:   trigger,
+		ChangeKeyPolicy: tapfreighter.ChangeKeyPolicy(
+			dbT.ChangeKeyPolicy,
+		),
+		Version: tapfreighter.ParcelVersion(dbT.ParcelVersion),
+	}, dbAnchorTx, nil
+}
+
+// fetchBroadcastTrigger fetches the broadcast trigger of the given transfer, or
+// nil if the transfer wasn't scheduled.
+func fetchBroadcastTrigger(ctx context.Context, q ActiveAssetsStore,
+	transferID int32) (*tapfreighter.BroadcastTrigger, error) {
+
+	dbTrigger, err := q.FetchTransferBroadcastTrigger(ctx, transferID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch broadcast trigger: %w",
+			err)
+	}
+
+	trigger := &tapfreighter.BroadcastTrigger{
+		Height: uint32(dbTrigger.MinHeight),
+	}
+	if dbTrigger.MinTime.Valid {
+		trigger.Time = dbTrigger.MinTime.Time.UTC()
+	}
+
+	return trigger, nil
+}
+
+// CancelPendingParcel removes the pending parcel with the given anchor txid,
+// whose anchor transaction was never broadcast, and releases the leases of
+// its inputs.
+//
+// NOTE: This is part of the tapfreighter.TransferLog interface.
+func (a *AssetStore) CancelPendingParcel(ctx context.Context,
+	anchorTxid chainhash.Hash) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		dbTransfers, err := q.QueryAssetTransfers(ctx, TransferQuery{
+			UnconfOnly:   true,
+			AnchorTxHash: anchorTxid[:],
+		})
+		if err != nil {
+			return fmt.Errorf("unable to query transfer: %w", err)
+		}
+		if len(dbTransfers) != 1 {
+			return fmt.Errorf("no pending transfer with anchor "+
+				"txid %v", anchorTxid)
+		}
+		transferID := dbTransfers[0].ID
+
+		// The inputs are no longer spent by the transfer, so they can
+		// be selected again right away.
+		inputs, err := q.FetchTransferInputs(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to fetch transfer inputs: "+
+				"%w", err)
+		}
+		for _, input := range inputs {
+			err := q.DeleteUTXOLease(ctx, input.AnchorPoint)
+			if err != nil {
+				return fmt.Errorf("unable to release input "+
+					"lease: %w", err)
+			}
+		}
+
+		// The new anchor outputs are only referenced by the outputs
+		// and passive assets of the transfer, so they're removed
+		// after those.
+		outputs, err := q.FetchTransferOutputs(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to fetch transfer outputs: "+
+				"%w", err)
+		}
+
+		err = q.DeletePassiveAssets(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to delete passive assets: "+
+				"%w", err)
+		}
+		err = q.DeleteAssetTransferOutputs(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to delete transfer outputs: "+
+				"%w", err)
+		}
+		for _, output := range outputs {
+			err := q.DeleteManagedUTXO(ctx, output.AnchorOutpoint)
+			if err != nil {
+				return fmt.Errorf("unable to delete anchor "+
+					"output: %w", err)
+			}
+		}
+
+		err = q.DeleteAssetTransferInputs(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to delete transfer inputs: "+
+				"%w", err)
+		}
+		err = q.DeleteTransferBroadcastTrigger(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to delete broadcast "+
+				"trigger: %w", err)
+		}
+		err = q.DeleteAssetTransfer(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to delete transfer: %w", err)
+		}
+
+		return q.DeleteChainTx(ctx, anchorTxid[:])
+	})
 }
 
 // ErrAssetMetaNotFound is returned when an asset meta is not found in the
@@ -2814,6 +3956,145 @@ func (a *AssetStore) FetchAssetMetaByHash(ctx context.Context,
 	return assetMeta, nil
 }
 
+// LogParcelRequest persists a newly accepted parcel request.
+//
+// NOTE: This is part of the tapfreighter.ParcelRequestLog interface.
+func (a *AssetStore) LogParcelRequest(ctx context.Context,
+	req *tapfreighter.ParcelRequest) error {
+
+	// We encode the addresses outside the DB transaction below.
+	tapAddrs, err := fn.MapErr(
+		req.DestAddrs, func(addr *address.Tap) (string, error) {
+			return addr.EncodeAddress()
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to encode address: %w", err)
+	}
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		requestID, err := q.InsertParcelRequest(ctx, NewParcelRequest{
+			RequestID: req.ID[:],
+			Priority:  int16(req.Priority),
+			CreatedAt: req.CreatedAt.UTC(),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert parcel request: "+
+				"%w", err)
+		}
+
+		for idx, tapAddr := range tapAddrs {
+			addr := NewParcelRequestAddr{
+				RequestID: requestID,
+				AddrIndex: int32(idx),
+				TapAddr:   tapAddr,
+			}
+			err := q.InsertParcelRequestAddr(ctx, addr)
+			if err != nil {
+				return fmt.Errorf("unable to insert parcel "+
+					"request address: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// PendingParcelRequests returns all persisted parcel requests in the order
+// they were accepted in.
+//
+// NOTE: This is part of the tapfreighter.ParcelRequestLog interface.
+func (a *AssetStore) PendingParcelRequests(
+	ctx context.Context) ([]*tapfreighter.ParcelRequest, error) {
+
+	var rows []ParcelRequestRow
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		var err error
+		rows, err = q.QueryParcelRequests(ctx)
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query parcel requests: %w",
+			dbErr)
+	}
+
+	// Each row holds a single address, the rows of a request are next to
+	// each other.
+	var requests []*tapfreighter.ParcelRequest
+	for _, row := range rows {
+		tapAddr, err := decodeTapAddr(row.TapAddr)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode address of "+
+				"parcel request %x: %w", row.RequestID, err)
+		}
+
+		if len(requests) > 0 {
+			lastReq := requests[len(requests)-1]
+			if bytes.Equal(lastReq.ID[:], row.RequestID) {
+				lastReq.DestAddrs = append(
+					lastReq.DestAddrs, tapAddr,
+				)
+				continue
+			}
+		}
+
+		req := &tapfreighter.ParcelRequest{
+			DestAddrs: []*address.Tap{tapAddr},
+			Priority:  tapfreighter.ParcelPriority(row.Priority),
+			CreatedAt: row.CreatedAt.UTC(),
+		}
+		copy(req.ID[:], row.RequestID)
+
+		requests = append(requests, req)
+	}
+
+	return requests, nil
+}
+
+// decodeTapAddr decodes a bech32m encoded Taproot Asset address of any of the
+// known networks.
+func decodeTapAddr(tapAddr string) (*address.Tap, error) {
+	oneIndex := strings.LastIndexByte(tapAddr, '1')
+	if oneIndex <= 0 {
+		return nil, address.ErrInvalidBech32m
+	}
+
+	net, err := address.Net(tapAddr[:oneIndex])
+	if err != nil {
+		return nil, err
+	}
+
+	return address.DecodeAddress(tapAddr, net)
+}
+
+// DeleteParcelRequest removes a parcel request. Deleting a request that
+// doesn't exist is not an error.
+//
+// NOTE: This is part of the tapfreighter.ParcelRequestLog interface.
+func (a *AssetStore) DeleteParcelRequest(ctx context.Context,
+	id [32]byte) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		return q.DeleteParcelRequest(ctx, id[:])
+	})
+}
+
+// ReleaseOrphanedLeases releases all asset coin leases of the given owner
+// that aren't held by a pending parcel.
+//
+// NOTE: This is part of the tapfreighter.ParcelRequestLog interface.
+func (a *AssetStore) ReleaseOrphanedLeases(ctx context.Context,
+	leaseOwner [32]byte) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		return q.ReleaseOrphanedUTXOLeases(ctx, leaseOwner[:])
+	})
+}
+
 // A compile-time constraint to ensure that AssetStore meets the
 // proof.NotifyArchiver interface.
 var _ proof.NotifyArchiver = (*AssetStore)(nil)
@@ -2825,3 +4106,7 @@ var _ tapfreighter.CoinLister = (*AssetStore)(nil)
 // A compile-time constraint to ensure that AssetStore meets the
 // tapfreighter.ExportLog interface.
 var _ tapfreighter.ExportLog = (*AssetStore)(nil)
+
+// A compile-time constraint to ensure that AssetStore meets the
+// tapfreighter.ParcelRequestLog interface.
+var _ tapfreighter.ParcelRequestLog = (*AssetStore)(nil)
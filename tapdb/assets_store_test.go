@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"database/sql"
+	"errors"
 	"math/rand"
 	"sort"
 	"testing"
@@ -13,6 +15,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/fn"
@@ -192,12 +195,14 @@ func randAsset(t *testing.T, genOpts ...assetGenOpt) *asset.Asset {
 					),
 				},
 				TxWitness: test.RandTxWitnesses(t),
-				// For simplicity, we just use the base asset
-				// itself as the "anchor" asset in the split
-				// commitment.
-				SplitCommitment: commitment.RandSplitCommit(
-					t, *newAsset,
-				),
+			}
+
+			// For simplicity, we just use the base asset itself as
+			// the "anchor" asset in the split commitment. A
+			// tombstone can't be split, so it doesn't get one.
+			if newAsset.Amount != 0 {
+				witnesses[i].SplitCommitment =
+					commitment.RandSplitCommit(t, *newAsset)
 			}
 		}
 	}
@@ -350,6 +355,53 @@ func TestImportAssetProof(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, initialBlob, []byte(currentBlob))
 
+	// Receiving the same proof again, for example from a second courier,
+	// must not credit the asset a second time. Only the receipt of the
+	// duplicate is recorded, together with the signature of the node that
+	// delivered it.
+	duplicateBlob := bytes.Repeat([]byte{0x42}, 100)
+	deliverySig := &proof.DeliverySignature{
+		NodeKey:   test.RandPubKey(t),
+		Signature: bytes.Repeat([]byte{0x30}, 70),
+	}
+	testProof.Blob = duplicateBlob
+	testProof.DeliverySignature = deliverySig
+	require.NoError(t, assetStore.ImportProofs(
+		ctxb, proof.MockHeaderVerifier, false, testProof,
+	))
+	testProof.Blob = initialBlob
+	testProof.DeliverySignature = nil
+
+	assets, err = assetStore.FetchAllAssets(ctxb, false, false, nil)
+	require.NoError(t, err)
+	require.Len(t, assets, 1)
+
+	currentBlob, err = assetStore.FetchProof(ctxb, proof.Locator{
+		ScriptKey: *testAsset.ScriptKey.PubKey,
+	})
+	require.NoError(t, err)
+	require.Equal(t, initialBlob, []byte(currentBlob))
+
+	receipts, err := assetStore.QueryDuplicateProofReceipts(
+		ctxb, &anchorPoint,
+	)
+	require.NoError(t, err)
+	require.Len(t, receipts, 1)
+	require.Equal(t, anchorPoint, receipts[0].AnchorPoint)
+	require.Equal(t, assetID, receipts[0].AssetID)
+	require.True(t, testAsset.ScriptKey.PubKey.IsEqual(
+		receipts[0].ScriptKey,
+	))
+	require.Equal(t, sha256.Sum256(duplicateBlob), receipts[0].ProofHash)
+
+	sigs, err := assetStore.QueryProofDeliverySignatures(ctxb, &anchorPoint)
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.Equal(t, assetID, sigs[0].AssetID)
+	require.Equal(t, sha256.Sum256(duplicateBlob), sigs[0].ProofHash)
+	require.True(t, deliverySig.NodeKey.IsEqual(sigs[0].Signature.NodeKey))
+	require.Equal(t, deliverySig.Signature, sigs[0].Signature.Signature)
+
 	// We should also be able to fetch the created asset above based on
 	// either the asset ID, or key group via the main coin selection
 	// routine.
@@ -365,6 +417,10 @@ func TestImportAssetProof(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, selectedAssets, 1)
 	assertAssetEqual(t, testAsset, selectedAssets[0].Asset)
+	require.Equal(
+		t, testProof.AnchorBlockHeight,
+		selectedAssets[0].AnchorBlockHeight,
+	)
 
 	// We'll now attempt to overwrite the proof with one that has different
 	// block information (simulating a re-org).
@@ -873,6 +929,600 @@ func TestUTXOLeases(t *testing.T) {
 			require.Equal(t, [32]byte{}, a.AnchorLeaseOwner)
 		}
 	}
+
+	// Releasing the orphaned leases of an owner only affects the leases of
+	// that owner.
+	otherOwner := fn.ToArray[[32]byte](test.RandBytes(32))
+	leaseExpiry = time.Now().Add(time.Hour).UTC()
+	err = assetsStore.LeaseCoins(
+		ctx, leaseOwner, leaseExpiry, assetGen.anchorPoints[0],
+	)
+	require.NoError(t, err)
+	err = assetsStore.LeaseCoins(
+		ctx, otherOwner, leaseExpiry, assetGen.anchorPoints[1],
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, assetsStore.ReleaseOrphanedLeases(ctx, leaseOwner))
+
+	selectedAssets, err = assetsStore.FetchAllAssets(
+		ctx, false, false, nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, selectedAssets, 2)
+	for idx := range selectedAssets {
+		require.Equal(
+			t, assetGen.anchorPoints[0],
+			selectedAssets[idx].AnchorOutpoint,
+		)
+	}
+}
+
+// TestListAssetUTXOs tests that the asset UTXO listing reports the anchor
+// information, lease status, co-residents and proof availability of each
+// unspent asset.
+func TestListAssetUTXOs(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, db := newAssetStore(t)
+	ctx := context.Background()
+
+	// We'll generate 3 unspent assets, two of them sharing the same anchor
+	// output, and one spent asset that shouldn't be listed.
+	const numAssets = 3
+	assetGen := newAssetGenerator(t, numAssets+1, 0)
+	assetGen.genAssets(t, assetsStore, []assetDesc{
+		{
+			assetGen:    assetGen.assetGens[0],
+			anchorPoint: assetGen.anchorPoints[0],
+			leasedUntil: time.Now().Add(time.Hour),
+			amt:         16,
+		},
+		{
+			assetGen:    assetGen.assetGens[1],
+			anchorPoint: assetGen.anchorPoints[0],
+			amt:         10,
+		},
+		{
+			assetGen:    assetGen.assetGens[2],
+			anchorPoint: assetGen.anchorPoints[1],
+			amt:         6,
+		},
+		{
+			assetGen:    assetGen.assetGens[3],
+			anchorPoint: assetGen.anchorPoints[2],
+			amt:         3,
+			spent:       true,
+		},
+	})
+
+	// We remove the proof of the asset that is anchored alone to simulate
+	// a lost proof.
+	alone := assetGen.assetGens[2]
+	alone.FirstPrevOut = assetGen.anchorPoints[1]
+	aloneID := alone.ID()
+	rawDB, ok := db.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result,
+			error)
+	})
+	require.True(t, ok)
+	_, err := rawDB.ExecContext(ctx, `
+		DELETE FROM asset_proofs WHERE asset_id IN (
+		    SELECT assets.asset_id
+		    FROM assets
+		    JOIN genesis_assets gen
+		        ON assets.genesis_id = gen.gen_asset_id
+		    WHERE gen.asset_id = $1
+		)`, aloneID[:],
+	)
+	require.NoError(t, err)
+
+	utxos, err := assetsStore.ListAssetUTXOs(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, utxos, numAssets)
+
+	for _, utxo := range utxos {
+		anchorPoint := utxo.AnchorOutpoint
+		require.Equal(
+			t, assetGen.anchorPointsToHeights[anchorPoint],
+			utxo.AnchorBlockHeight,
+		)
+		require.NotNil(t, utxo.AnchorInternalKey)
+
+		switch anchorPoint {
+		// The first two assets share the leased anchor output, so each
+		// of them is the co-resident of the other.
+		case assetGen.anchorPoints[0]:
+			require.True(t, utxo.Leased)
+			require.True(t, utxo.HasProof)
+			require.Len(t, utxo.CoResidents, 1)
+
+			coResident := utxo.CoResidents[0]
+			require.Equal(t, anchorPoint, coResident.OutPoint)
+			require.NotEqual(t, utxo.ID(), coResident.ID)
+
+		case assetGen.anchorPoints[1]:
+			require.False(t, utxo.Leased)
+			require.False(t, utxo.HasProof)
+			require.Empty(t, utxo.CoResidents)
+			require.Equal(t, aloneID, utxo.ID())
+
+		default:
+			t.Fatalf("unexpected anchor point %v", anchorPoint)
+		}
+	}
+
+	// Filtering the listing still reports the co-residents that don't
+	// match the filter.
+	utxos, err = assetsStore.ListAssetUTXOs(ctx, &AssetQueryFilters{
+		CommitmentConstraints: tapfreighter.CommitmentConstraints{
+			MinAmt: 12,
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, utxos, 1)
+	require.EqualValues(t, 16, utxos[0].Amount)
+	require.Len(t, utxos[0].CoResidents, 1)
+}
+
+// randTapAddr returns a random Taproot Asset address.
+func randTapAddr(t *testing.T) *address.Tap {
+	addr, _, _ := address.RandAddr(t, chainParams)
+	return addr.Tap
+}
+
+// TestParcelRequests tests that accepted parcel requests can be persisted,
+// listed in order and deleted again.
+func TestParcelRequests(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	reqs := []*tapfreighter.ParcelRequest{{
+		ID: fn.ToArray[[32]byte](test.RandBytes(32)),
+		DestAddrs: []*address.Tap{
+			randTapAddr(t),
+			randTapAddr(t),
+		},
+		Priority:  tapfreighter.PriorityUrgent,
+		CreatedAt: time.Now().UTC(),
+	}, {
+		ID: fn.ToArray[[32]byte](test.RandBytes(32)),
+		DestAddrs: []*address.Tap{
+			randTapAddr(t),
+		},
+		Priority:  tapfreighter.PriorityBatchable,
+		CreatedAt: time.Now().UTC(),
+	}}
+	for _, req := range reqs {
+		require.NoError(t, assetsStore.LogParcelRequest(ctx, req))
+	}
+
+	// Request IDs are unique.
+	require.Error(t, assetsStore.LogParcelRequest(ctx, reqs[0]))
+
+	dbReqs, err := assetsStore.PendingParcelRequests(ctx)
+	require.NoError(t, err)
+	require.Len(t, dbReqs, len(reqs))
+	for idx, req := range reqs {
+		dbReq := dbReqs[idx]
+		require.Equal(t, req.ID, dbReq.ID)
+		require.Equal(t, req.Priority, dbReq.Priority)
+		require.Equal(
+			t, req.CreatedAt.Unix(), dbReq.CreatedAt.Unix(),
+		)
+
+		require.Len(t, dbReq.DestAddrs, len(req.DestAddrs))
+		for addrIdx, addr := range req.DestAddrs {
+			require.Equal(
+				t, addr.String(),
+				dbReq.DestAddrs[addrIdx].String(),
+			)
+		}
+	}
+
+	// Deleting a request removes it along with its addresses, deleting it
+	// again is a no-op.
+	require.NoError(t, assetsStore.DeleteParcelRequest(ctx, reqs[0].ID))
+	require.NoError(t, assetsStore.DeleteParcelRequest(ctx, reqs[0].ID))
+
+	dbReqs, err = assetsStore.PendingParcelRequests(ctx)
+	require.NoError(t, err)
+	require.Len(t, dbReqs, 1)
+	require.Equal(t, reqs[1].ID, dbReqs[0].ID)
+}
+
+// TestCancelPendingParcel tests that canceling a pending parcel releases the
+// lease of its input, so the input asset can be selected again.
+func TestCancelPendingParcel(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	assetGen := newAssetGenerator(t, 1, 1)
+	assetGen.genAssets(t, assetsStore, []assetDesc{{
+		assetGen:    assetGen.assetGens[0],
+		anchorPoint: assetGen.anchorPoints[0],
+		amt:         10,
+	}})
+
+	requireUnleased := func(numAssets int) {
+		t.Helper()
+
+		unleased, err := assetsStore.FetchAllAssets(
+			ctx, false, false, nil,
+		)
+		require.NoError(t, err)
+		require.Len(t, unleased, numAssets)
+	}
+	requireUnleased(1)
+
+	parcel, _ := newTestParcel(
+		t, assetsStore, assetGen.anchorPoints[0],
+	)
+	parcel.BroadcastTrigger = &tapfreighter.BroadcastTrigger{
+		Height: 100,
+	}
+	err := assetsStore.LogPendingParcel(
+		ctx, parcel, fn.ToArray[[32]byte](test.RandBytes(32)),
+		time.Now().Add(time.Hour),
+	)
+	require.NoError(t, err)
+	requireUnleased(0)
+
+	err = assetsStore.CancelPendingParcel(ctx, parcel.AnchorTx.TxHash())
+	require.NoError(t, err)
+	requireUnleased(1)
+
+	pending, err := assetsStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
+
+// TestUpdateParcelVersion tests that the version of a pending parcel is
+// persisted and can be updated once the parcel was migrated.
+func TestUpdateParcelVersion(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	assetGen := newAssetGenerator(t, 1, 1)
+	assetGen.genAssets(t, assetsStore, []assetDesc{{
+		assetGen:    assetGen.assetGens[0],
+		anchorPoint: assetGen.anchorPoints[0],
+		amt:         10,
+	}})
+
+	// A parcel persisted before the version was recorded is version 0.
+	parcel, _ := newTestParcel(
+		t, assetsStore, assetGen.anchorPoints[0],
+	)
+	parcel.Version = tapfreighter.ParcelV0
+	err := assetsStore.LogPendingParcel(
+		ctx, parcel, fn.ToArray[[32]byte](test.RandBytes(32)),
+		time.Now().Add(time.Hour),
+	)
+	require.NoError(t, err)
+
+	pending, err := assetsStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, tapfreighter.ParcelV0, pending[0].Version)
+
+	err = assetsStore.UpdateParcelVersion(
+		ctx, parcel.AnchorTx.TxHash(), tapfreighter.LatestParcelVersion,
+	)
+	require.NoError(t, err)
+
+	pending, err = assetsStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(
+		t, tapfreighter.LatestParcelVersion, pending[0].Version,
+	)
+}
+
+// TestProofDeliveryAttempts tests that the outcomes of proof delivery attempts
+// can be logged and queried by transfer and receiver.
+func TestProofDeliveryAttempts(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	assetID := asset.RandID(t)
+	anchorPoint := test.RandOp(t)
+	otherPoint := test.RandOp(t)
+	scriptKey := test.RandPubKey(t)
+
+	now := time.Now().UTC()
+	attempts := []*proof.DeliveryAttempt{{
+		Locator: proof.Locator{
+			AssetID:   &assetID,
+			ScriptKey: *scriptKey,
+		},
+		AnchorPoint: anchorPoint,
+		CourierAddr: "hashmail://localhost:10029",
+		Timestamp:   now,
+		Error:       "mailbox unreachable",
+		NextAttempt: now.Add(time.Minute),
+	}, {
+		Locator: proof.Locator{
+			AssetID:   &assetID,
+			ScriptKey: *scriptKey,
+		},
+		AnchorPoint: anchorPoint,
+		CourierAddr: "hashmail://localhost:10029",
+		Timestamp:   now.Add(time.Minute),
+	}, {
+		Locator: proof.Locator{
+			ScriptKey: *test.RandPubKey(t),
+		},
+		AnchorPoint: otherPoint,
+		CourierAddr: "hashmail://localhost:10029",
+		Timestamp:   now.Add(2 * time.Minute),
+		Error:       "no ACK received",
+	}}
+	for _, attempt := range attempts {
+		require.NoError(t, assetsStore.LogDeliveryAttempt(ctx, attempt))
+	}
+
+	requireAttempts := func(query proof.DeliveryAttemptQuery,
+		expected ...*proof.DeliveryAttempt) {
+
+		t.Helper()
+
+		dbAttempts, err := assetsStore.QueryDeliveryAttempts(ctx, query)
+		require.NoError(t, err)
+		require.Len(t, dbAttempts, len(expected))
+
+		for idx, attempt := range expected {
+			dbAttempt := dbAttempts[idx]
+			require.Equal(
+				t, attempt.Locator.Hash(),
+				dbAttempt.Locator.Hash(),
+			)
+			require.Equal(
+				t, attempt.AnchorPoint, dbAttempt.AnchorPoint,
+			)
+			require.Equal(
+				t, attempt.CourierAddr, dbAttempt.CourierAddr,
+			)
+			require.Equal(
+				t, attempt.Timestamp.Unix(),
+				dbAttempt.Timestamp.Unix(),
+			)
+			require.Equal(t, attempt.Error, dbAttempt.Error)
+			require.Equal(
+				t, attempt.Delivered(), dbAttempt.Delivered(),
+			)
+			require.Equal(
+				t, attempt.NextAttempt.IsZero(),
+				dbAttempt.NextAttempt.IsZero(),
+			)
+			if !attempt.NextAttempt.IsZero() {
+				require.Equal(
+					t, attempt.NextAttempt.Unix(),
+					dbAttempt.NextAttempt.Unix(),
+				)
+			}
+		}
+	}
+
+	// Without a filter, all attempts are returned, oldest first.
+	requireAttempts(proof.DeliveryAttemptQuery{}, attempts...)
+
+	// The attempts can be filtered by transfer and by receiver.
+	requireAttempts(
+		proof.DeliveryAttemptQuery{
+			AnchorTxid: &anchorPoint.Hash,
+		}, attempts[0], attempts[1],
+	)
+	requireAttempts(
+		proof.DeliveryAttemptQuery{
+			AnchorTxid: &otherPoint.Hash,
+			ScriptKey:  scriptKey,
+		},
+	)
+	requireAttempts(
+		proof.DeliveryAttemptQuery{
+			ScriptKey: &attempts[2].Locator.ScriptKey,
+		}, attempts[2],
+	)
+}
+
+// TestReceiverSettlement tests that transfer outputs can be marked as settled
+// by their receiver exactly once.
+func TestReceiverSettlement(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	assetGen := newAssetGenerator(t, 1, 0)
+	assetGen.genAssets(t, assetsStore, []assetDesc{{
+		assetGen:    assetGen.assetGens[0],
+		anchorPoint: assetGen.anchorPoints[0],
+		amt:         10,
+	}})
+
+	parcel, _ := newTestParcel(t, assetsStore, assetGen.anchorPoints[0])
+	parcel.Outputs[0].ScriptKeyLocal = false
+	require.NoError(t, assetsStore.LogPendingParcel(
+		ctx, parcel, fn.ToArray[[32]byte](test.RandBytes(32)),
+		time.Now().Add(time.Hour),
+	))
+
+	fetchOutput := func() tapfreighter.TransferOutput {
+		t.Helper()
+
+		parcels, err := assetsStore.PendingParcels(ctx)
+		require.NoError(t, err)
+		require.Len(t, parcels, 1)
+		require.Len(t, parcels[0].Outputs, 1)
+
+		return parcels[0].Outputs[0]
+	}
+
+	// A freshly logged output isn't settled yet.
+	out := fetchOutput()
+	require.False(t, out.SettledByReceiver())
+
+	// Marking an unknown output doesn't affect our output.
+	settledAt := time.Now().UTC()
+	err := assetsStore.MarkReceiverSettled(
+		ctx, out.Anchor.OutPoint, test.RandPubKey(t), settledAt,
+	)
+	require.NoError(t, err)
+
+	out = fetchOutput()
+	require.False(t, out.SettledByReceiver())
+
+	err = assetsStore.MarkReceiverSettled(
+		ctx, out.Anchor.OutPoint, out.ScriptKey.PubKey, settledAt,
+	)
+	require.NoError(t, err)
+
+	out = fetchOutput()
+	require.True(t, out.SettledByReceiver())
+	require.Equal(t, settledAt.Unix(), out.ReceiverSettledAt.Unix())
+
+	// A repeated settlement doesn't overwrite the original time.
+	err = assetsStore.MarkReceiverSettled(
+		ctx, out.Anchor.OutPoint, out.ScriptKey.PubKey,
+		settledAt.Add(time.Hour),
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, settledAt.Unix(), fetchOutput().ReceiverSettledAt.Unix(),
+	)
+}
+
+// TestFrozenAssetOutputs tests that frozen asset outputs, and other assets
+// anchored at the same outpoint, are skipped by coin selection until they are
+// unfrozen again.
+func TestFrozenAssetOutputs(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	// We'll generate 3 assets, two of them sharing the same anchor
+	// transaction.
+	assetGen := newAssetGenerator(t, 3, 3)
+	assetGen.genAssets(t, assetsStore, []assetDesc{
+		{
+			assetGen:    assetGen.assetGens[0],
+			anchorPoint: assetGen.anchorPoints[0],
+
+			amt: 16,
+		},
+		{
+			assetGen:    assetGen.assetGens[1],
+			anchorPoint: assetGen.anchorPoints[0],
+
+			amt: 10,
+		},
+		{
+			assetGen:    assetGen.assetGens[2],
+			anchorPoint: assetGen.anchorPoints[1],
+
+			amt: 6,
+		},
+	})
+
+	allAssets, err := assetsStore.FetchAllAssets(ctx, false, false, nil)
+	require.NoError(t, err)
+	require.Len(t, allAssets, 3)
+
+	// The asset IDs are bound to the anchor points, so we'll identify the
+	// assets by their unique amounts instead.
+	assetByAmt := make(map[uint64]*ChainAsset)
+	for _, a := range allAssets {
+		assetByAmt[a.Amount] = a
+	}
+	selectable := func(amt uint64) bool {
+		id := assetByAmt[amt].ID()
+		coins, err := assetsStore.ListEligibleCoins(
+			ctx, tapfreighter.CommitmentConstraints{
+				AssetID: &id,
+				MinAmt:  1,
+			},
+		)
+		if errors.Is(err, tapfreighter.ErrMatchingAssetsNotFound) {
+			return false
+		}
+		require.NoError(t, err)
+
+		return len(coins) > 0
+	}
+
+	// Freezing the second asset also makes the first one unselectable,
+	// as it is anchored at the same outpoint.
+	frozenAsset := assetByAmt[10]
+	frozenKey := frozenAsset.ScriptKey.PubKey
+	frozen, err := assetsStore.FreezeAssetOutputs(
+		ctx, assetGen.anchorPoints[0], frozenKey, "compliance hold",
+	)
+	require.NoError(t, err)
+	require.Len(t, frozen, 1)
+	require.True(t, frozenKey.IsEqual(frozen[0].ScriptKey))
+
+	require.False(t, selectable(16))
+	require.False(t, selectable(10))
+	require.True(t, selectable(6))
+
+	// The frozen asset can still be looked up if it isn't about to be
+	// spent, for example to prove ownership of it.
+	_, err = assetsStore.FetchCommitment(
+		ctx, frozenAsset.ID(), assetGen.anchorPoints[0], nil,
+		&frozenAsset.ScriptKey, false,
+	)
+	require.NoError(t, err)
+
+	// Freezing a whole outpoint freezes all assets anchored there.
+	// Freezing it again only updates the reason.
+	_, err = assetsStore.FreezeAssetOutputs(
+		ctx, assetGen.anchorPoints[1], nil, "incident",
+	)
+	require.NoError(t, err)
+	_, err = assetsStore.FreezeAssetOutputs(
+		ctx, assetGen.anchorPoints[1], nil, "incident response",
+	)
+	require.NoError(t, err)
+	require.False(t, selectable(6))
+
+	frozenList, err := assetsStore.ListFrozenAssetOutputs(ctx)
+	require.NoError(t, err)
+	require.Len(t, frozenList, 2)
+	require.Equal(t, assetGen.anchorPoints[0], frozenList[0].AnchorPoint)
+	require.Equal(t, "compliance hold", frozenList[0].Reason)
+	require.Equal(t, assetGen.anchorPoints[1], frozenList[1].AnchorPoint)
+	require.Equal(t, "incident response", frozenList[1].Reason)
+
+	// Assets that don't exist can't be frozen.
+	_, err = assetsStore.FreezeAssetOutputs(
+		ctx, test.RandOp(t), nil, "unknown",
+	)
+	require.ErrorContains(t, err, "no unspent assets found")
+
+	// Once unfrozen, the assets can be selected again.
+	numUnfrozen, err := assetsStore.UnfreezeAssetOutputs(
+		ctx, assetGen.anchorPoints[0], nil,
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, numUnfrozen)
+	require.True(t, selectable(16))
+	require.True(t, selectable(10))
+	require.False(t, selectable(6))
+
+	_, err = assetsStore.UnfreezeAssetOutputs(
+		ctx, assetGen.anchorPoints[0], nil,
+	)
+	require.ErrorContains(t, err, "no frozen asset outputs found")
 }
 
 // TestSelectCommitment tests that the coin selection logic can properly select
@@ -1189,6 +1839,7 @@ func TestAssetExportLog(t *testing.T) {
 		AnchorTx:           newAnchorTx,
 		AnchorTxHeightHint: heightHint,
 		ChainFees:          chainFees,
+		ChangeKeyPolicy:    tapfreighter.ChangeKeySeparateAccount,
 		// We'll actually modify only one of the assets. This simulates
 		// us create a split of the asset to send to another party.
 		Inputs: []tapfreighter.TransferInput{{
@@ -1268,10 +1919,27 @@ func TestAssetExportLog(t *testing.T) {
 			ProofSuffix: senderBlob,
 		}},
 	}
+	// The parcel was created from a persisted request, which must be
+	// removed when logging the parcel.
+	parcelReq := &tapfreighter.ParcelRequest{
+		ID:        fn.ToArray[[32]byte](test.RandBytes(32)),
+		DestAddrs: []*address.Tap{randTapAddr(t)},
+		CreatedAt: time.Now(),
+	}
+	require.NoError(t, assetsStore.LogParcelRequest(ctx, parcelReq))
+	spendDelta.RequestID = &parcelReq.ID
+
 	require.NoError(t, assetsStore.LogPendingParcel(
 		ctx, spendDelta, leaseOwner, leaseExpiry,
 	))
 
+	parcelReqs, err := assetsStore.PendingParcelRequests(ctx)
+	require.NoError(t, err)
+	require.Empty(t, parcelReqs)
+
+	// The request ID isn't part of the stored parcel.
+	spendDelta.RequestID = nil
+
 	assetID := inputAsset.ID()
 	proofs := map[asset.SerializedKey]*proof.AnnotatedProof{
 		asset.ToSerialized(newScriptKey.PubKey): {
@@ -1342,8 +2010,28 @@ func TestAssetExportLog(t *testing.T) {
 	parcels, err := assetsStore.PendingParcels(ctx)
 	require.NoError(t, err)
 	require.Equal(t, 1, len(parcels))
+
+	// The input was imported from a proof, so its lot is tracked.
+	inputLot := parcels[0].Inputs[0].Lot
+	require.NotNil(t, inputLot)
+	require.False(t, inputLot.AcquiredAt.IsZero())
+	require.Nil(t, inputLot.SourceTransfer)
+	spendDelta.Inputs[0].Lot = inputLot
 	require.Equal(t, spendDelta, parcels[0])
 
+	// We annotate the price of the input lot, which should be carried over
+	// to the change.
+	const priceAnnotation = "21 sats/unit"
+	err = assetsStore.SetLotPriceAnnotation(
+		ctx, spendDelta.Inputs[0].PrevID, priceAnnotation,
+	)
+	require.NoError(t, err)
+
+	// The parcel isn't confirmed yet, so it can't be looked up as a
+	// confirmed parcel.
+	_, _, err = assetsStore.ConfirmedParcel(ctx, anchorTxHash)
+	require.ErrorIs(t, err, tapfreighter.ErrParcelNotConfirmed)
+
 	// With the asset delta committed and verified, we'll now mark the
 	// delta as being confirmed on chain.
 	fakeBlockHash := chainhash.Hash(sha256.Sum256([]byte("fake")))
@@ -1408,6 +2096,28 @@ func TestAssetExportLog(t *testing.T) {
 	require.True(t, mutationFound)
 	require.True(t, inputLeased)
 
+	// The change inherits the lot of the spent asset and references the
+	// transfer that created it.
+	changeAnchor, err := encodeOutpoint(firstOutputAnchor.OutPoint)
+	require.NoError(t, err)
+	inputID := inputAsset.ID()
+	changeLot, err := fetchAssetLot(ctx, db, AssetLotQuery{
+		AnchorPoint: changeAnchor,
+		AssetID:     inputID[:],
+		ScriptKey:   newScriptKey.PubKey.SerializeCompressed(),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, changeLot)
+	require.True(t, inputLot.AcquiredAt.Equal(changeLot.AcquiredAt))
+	require.Equal(t, priceAnnotation, changeLot.PriceAnnotation)
+	require.Equal(t, anchorTxHash, *changeLot.SourceTransfer)
+
+	// The spent lot can't be annotated anymore.
+	err = assetsStore.SetLotPriceAnnotation(
+		ctx, spendDelta.Inputs[0].PrevID, priceAnnotation,
+	)
+	require.ErrorIs(t, err, ErrLotNotFound)
+
 	// As a final check for the asset, we'll fetch its blob to ensure it's
 	// been updated on disk.
 	diskSenderBlob, err := db.FetchAssetProof(
@@ -1434,6 +2144,174 @@ func TestAssetExportLog(t *testing.T) {
 	parcels, err = assetsStore.PendingParcels(ctx)
 	require.NoError(t, err)
 	require.Equal(t, 0, len(parcels))
+
+	// Instead, the parcel can be looked up along with its confirmation.
+	confParcel, conf, err := assetsStore.ConfirmedParcel(ctx, anchorTxHash)
+	require.NoError(t, err)
+	require.Equal(t, anchorTxHash, confParcel.AnchorTx.TxHash())
+	require.Len(t, confParcel.Outputs, len(spendDelta.Outputs))
+	require.Equal(t, &tapfreighter.ParcelConfirmation{
+		BlockHash:   fakeBlockHash,
+		BlockHeight: uint32(blockHeight),
+		TxIndex:     uint32(txIndex),
+	}, conf)
+
+	_, _, err = assetsStore.ConfirmedParcel(ctx, chainhash.Hash{})
+	require.ErrorIs(t, err, tapfreighter.ErrParcelNotConfirmed)
+}
+
+// exportLogFixture holds the assets a single export log under test can spend.
+type exportLogFixture struct {
+	assetsStore *AssetStore
+	assetGen    *assetGenerator
+	numParcels  int
+}
+
+// TestExportLogSuite runs the export log test suite of the tapfreighter
+// package against the AssetStore.
+func TestExportLogSuite(t *testing.T) {
+	t.Parallel()
+
+	// Each export log is created with enough assets for all parcels a
+	// single sub test of the suite creates.
+	const maxParcels = 2
+
+	fixtures := make(map[tapfreighter.ExportLog]*exportLogFixture)
+	tapfreighter.RunExportLogTests(t, tapfreighter.ExportLogHarness{
+		NewLog: func(t *testing.T) tapfreighter.ExportLog {
+			_, assetsStore, _ := newAssetStore(t)
+
+			assetGen := newAssetGenerator(t, maxParcels, 1)
+			descs := make([]assetDesc, maxParcels)
+			for idx := range descs {
+				descs[idx] = assetDesc{
+					assetGen:    assetGen.assetGens[idx],
+					anchorPoint: assetGen.anchorPoints[idx],
+					amt:         10,
+				}
+			}
+			assetGen.genAssets(t, assetsStore, descs)
+
+			fixtures[assetsStore] = &exportLogFixture{
+				assetsStore: assetsStore,
+				assetGen:    assetGen,
+			}
+
+			return assetsStore
+		},
+		NewParcel: func(t *testing.T, exportLog tapfreighter.ExportLog) (
+			*tapfreighter.OutboundParcel,
+			*tapfreighter.AssetConfirmEvent) {
+
+			fixture := fixtures[exportLog]
+			require.Less(t, fixture.numParcels, maxParcels)
+
+			idx := fixture.numParcels
+			fixture.numParcels++
+
+			return newTestParcel(
+				t, fixture.assetsStore,
+				fixture.assetGen.anchorPoints[idx],
+			)
+		},
+	})
+}
+
+// newTestParcel creates a parcel that sends the full amount of the asset that
+// is anchored at the given outpoint to a new local script key, along with the
+// event that confirms the parcel.
+func newTestParcel(t *testing.T, assetsStore *AssetStore,
+	anchorPoint wire.OutPoint) (*tapfreighter.OutboundParcel,
+	*tapfreighter.AssetConfirmEvent) {
+
+	ctx := context.Background()
+	allAssets, err := assetsStore.FetchAllAssets(ctx, false, true, nil)
+	require.NoError(t, err)
+
+	var inputAsset *asset.Asset
+	for _, a := range allAssets {
+		if a.AnchorOutpoint == anchorPoint {
+			inputAsset = a.Asset
+		}
+	}
+	require.NotNil(t, inputAsset)
+
+	assetID := inputAsset.ID()
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{PreviousOutPoint: anchorPoint})
+	anchorTx.AddTxOut(&wire.TxOut{
+		PkScript: bytes.Repeat([]byte{0x01}, 34),
+		Value:    1000,
+	})
+
+	newScriptKey := asset.NewScriptKeyBip86(keychain.KeyDescriptor{
+		PubKey: test.RandPubKey(t),
+		KeyLocator: keychain.KeyLocator{
+			Family: test.RandInt[keychain.KeyFamily](),
+			Index:  uint32(test.RandInt[int32]()),
+		},
+	})
+	proofBlob := test.RandBytes(100)
+
+	parcel := &tapfreighter.OutboundParcel{
+		AnchorTx:           anchorTx,
+		AnchorTxHeightHint: 1450,
+		ChainFees:          100,
+		Inputs: []tapfreighter.TransferInput{{
+			PrevID: asset.PrevID{
+				OutPoint: anchorPoint,
+				ID:       assetID,
+				ScriptKey: asset.ToSerialized(
+					inputAsset.ScriptKey.PubKey,
+				),
+			},
+			Amount: inputAsset.Amount,
+		}},
+		Outputs: []tapfreighter.TransferOutput{{
+			Anchor: tapfreighter.Anchor{
+				Value: 1000,
+				OutPoint: wire.OutPoint{
+					Hash:  anchorTx.TxHash(),
+					Index: 0,
+				},
+				InternalKey: keychain.KeyDescriptor{
+					PubKey: test.RandPubKey(t),
+				},
+				TaprootAssetRoot: bytes.Repeat([]byte{0x1}, 32),
+				MerkleRoot:       bytes.Repeat([]byte{0x1}, 32),
+			},
+			ScriptKey:      newScriptKey,
+			ScriptKeyLocal: true,
+			Amount:         inputAsset.Amount,
+			WitnessData: []asset.Witness{{
+				PrevID:    &asset.PrevID{},
+				TxWitness: [][]byte{{0x01}},
+			}},
+			SplitCommitmentRoot: mssmt.NewComputedNode(
+				sha256.Sum256(proofBlob), inputAsset.Amount,
+			),
+			ProofSuffix: proofBlob,
+		}},
+	}
+
+	confEvent := &tapfreighter.AssetConfirmEvent{
+		AnchorTXID:  anchorTx.TxHash(),
+		BlockHash:   test.RandHash(),
+		BlockHeight: 100,
+		TxIndex:     1,
+		FinalProofs: map[asset.SerializedKey]*proof.AnnotatedProof{
+			asset.ToSerialized(newScriptKey.PubKey): {
+				Locator: proof.Locator{
+					AssetID:   &assetID,
+					ScriptKey: *newScriptKey.PubKey,
+				},
+				Blob: proofBlob,
+			},
+		},
+	}
+
+	return parcel, confEvent
 }
 
 // TestAssetGroupSigUpsert tests that if you try to insert another asset
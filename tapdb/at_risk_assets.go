@@ -0,0 +1,243 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+)
+
+// ListOwnedAnchors returns all anchor outputs that anchor at least one unspent
+// asset that isn't already marked as at risk.
+//
+// NOTE: This is part of the tapgarden.OwnedAnchorStore interface.
+func (a *AssetStore) ListOwnedAnchors(
+	ctx context.Context) ([]tapgarden.OwnedAnchor, error) {
+
+	var (
+		readOpts = NewAssetStoreReadTx()
+		rows     []OwnedAnchorRow
+	)
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		var err error
+		rows, err = q.QueryOwnedAnchors(ctx)
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query owned anchors: %w",
+			dbErr)
+	}
+
+	anchors := make([]tapgarden.OwnedAnchor, len(rows))
+	for i, row := range rows {
+		err := readOutPoint(
+			bytes.NewReader(row.Outpoint), 0, 0,
+			&anchors[i].AnchorPoint,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode outpoint: %w",
+				err)
+		}
+
+		var anchorTx wire.MsgTx
+		err = anchorTx.Deserialize(bytes.NewReader(row.RawTx))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode anchor tx: %w",
+				err)
+		}
+
+		outputIndex := anchors[i].AnchorPoint.Index
+		if int(outputIndex) >= len(anchorTx.TxOut) {
+			return nil, fmt.Errorf("anchor output %v not found in "+
+				"anchor tx", anchors[i].AnchorPoint)
+		}
+
+		anchors[i].PkScript = anchorTx.TxOut[outputIndex].PkScript
+		anchors[i].HeightHint = extractSqlInt32[uint32](row.BlockHeight)
+	}
+
+	return anchors, nil
+}
+
+// IsTransferAnchor returns true if the transaction with the given ID is the
+// anchor transaction of an outbound transfer of this daemon.
+//
+// NOTE: This is part of the tapgarden.OwnedAnchorStore interface.
+func (a *AssetStore) IsTransferAnchor(ctx context.Context,
+	txid chainhash.Hash) (bool, error) {
+
+	var (
+		readOpts  = NewAssetStoreReadTx()
+		transfers []AssetTransferRow
+	)
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		var err error
+		transfers, err = q.QueryAssetTransfers(ctx, TransferQuery{
+			AnchorTxHash: txid[:],
+		})
+		return err
+	})
+	if dbErr != nil {
+		return false, fmt.Errorf("unable to query transfers: %w", dbErr)
+	}
+
+	return len(transfers) > 0, nil
+}
+
+// MarkAnchorAtRisk marks all unspent assets anchored at the given outpoint as
+// at risk because of an unknown spend, and returns them. The asset outputs are
+// also frozen, so they are no longer selected for spending.
+//
+// NOTE: This is part of the tapgarden.OwnedAnchorStore interface.
+func (a *AssetStore) MarkAnchorAtRisk(ctx context.Context,
+	anchorPoint wire.OutPoint, spendingTxid chainhash.Hash,
+	spendHeight uint32) ([]*tapgarden.AtRiskAsset, error) {
+
+	anchorPointBytes, err := encodeOutpoint(anchorPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	detectedAt := a.clock.Now().UTC()
+	filter := QueryAssetFilters{
+		AnchorPoint: anchorPointBytes,
+		Spent:       sqlBool(false),
+		Now: sql.NullTime{
+			Time:  detectedAt,
+			Valid: true,
+		},
+	}
+	reason := fmt.Sprintf("anchor output spent by unknown transaction %v",
+		spendingTxid)
+
+	var (
+		atRisk      []*tapgarden.AtRiskAsset
+		writeTxOpts AssetStoreTxOptions
+	)
+	dbErr := a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		atRisk = nil
+
+		dbAssets, err := q.QueryAssets(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("unable to query assets: %w", err)
+		}
+
+		for _, dbAsset := range dbAssets {
+			err := q.InsertAtRiskAsset(ctx, NewAtRiskAsset{
+				AnchorPoint:  anchorPointBytes,
+				AssetID:      dbAsset.AssetID,
+				ScriptKey:    dbAsset.TweakedScriptKey,
+				Amount:       dbAsset.Amount,
+				SpendingTxid: spendingTxid[:],
+				SpendHeight:  int32(spendHeight),
+				DetectedAt:   detectedAt,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to insert at risk "+
+					"asset: %w", err)
+			}
+
+			err = q.FreezeAssetOutput(ctx, FreezeParams{
+				Outpoint:  anchorPointBytes,
+				ScriptKey: dbAsset.TweakedScriptKey,
+				Reason:    reason,
+				FrozenAt:  detectedAt,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to freeze asset "+
+					"output: %w", err)
+			}
+
+			scriptKey, err := btcec.ParsePubKey(
+				dbAsset.TweakedScriptKey,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to parse script "+
+					"key: %w", err)
+			}
+
+			atRiskAsset := &tapgarden.AtRiskAsset{
+				AnchorPoint:  anchorPoint,
+				ScriptKey:    scriptKey,
+				Amount:       uint64(dbAsset.Amount),
+				SpendingTxid: spendingTxid,
+				SpendHeight:  spendHeight,
+				DetectedAt:   detectedAt,
+			}
+			copy(atRiskAsset.AssetID[:], dbAsset.AssetID)
+			atRisk = append(atRisk, atRiskAsset)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to mark assets at risk: %w",
+			dbErr)
+	}
+
+	return atRisk, nil
+}
+
+// ListAtRiskAssets returns all assets that are marked as at risk, ordered by
+// the time the spend was detected.
+//
+// NOTE: This is part of the tapgarden.OwnedAnchorStore interface.
+func (a *AssetStore) ListAtRiskAssets(
+	ctx context.Context) ([]*tapgarden.AtRiskAsset, error) {
+
+	var (
+		readOpts = NewAssetStoreReadTx()
+		atRisk   []*tapgarden.AtRiskAsset
+	)
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		rows, err := q.QueryAtRiskAssets(ctx)
+		if err != nil {
+			return err
+		}
+
+		atRisk, err = fn.MapErr(rows, parseAtRiskAsset)
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query at risk assets: %w",
+			dbErr)
+	}
+
+	return atRisk, nil
+}
+
+// parseAtRiskAsset parses an at risk asset from its database row.
+func parseAtRiskAsset(r AtRiskAssetRow) (*tapgarden.AtRiskAsset, error) {
+	var anchorPoint wire.OutPoint
+	err := readOutPoint(bytes.NewReader(r.AnchorPoint), 0, 0, &anchorPoint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode outpoint: %w", err)
+	}
+
+	scriptKey, err := btcec.ParsePubKey(r.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse script key: %w", err)
+	}
+
+	atRisk := &tapgarden.AtRiskAsset{
+		AnchorPoint: anchorPoint,
+		ScriptKey:   scriptKey,
+		Amount:      uint64(r.Amount),
+		SpendHeight: uint32(r.SpendHeight),
+		DetectedAt:  r.DetectedAt.UTC(),
+	}
+	copy(atRisk.AssetID[:], r.AssetID)
+	copy(atRisk.SpendingTxid[:], r.SpendingTxid)
+
+	return atRisk, nil
+}
+
+// A compile-time assertion to ensure that AssetStore meets the
+// tapgarden.OwnedAnchorStore interface.
+var _ tapgarden.OwnedAnchorStore = (*AssetStore)(nil)
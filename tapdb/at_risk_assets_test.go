@@ -0,0 +1,99 @@
+package tapdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAtRiskAssets tests that the assets of an anchor output that was spent by
+// an unknown transaction are marked as at risk, frozen and no longer listed as
+// owned anchors.
+func TestAtRiskAssets(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	// We'll generate 3 assets, two of them sharing the same anchor
+	// transaction.
+	assetGen := newAssetGenerator(t, 3, 3)
+	assetGen.genAssets(t, assetsStore, []assetDesc{
+		{
+			assetGen:    assetGen.assetGens[0],
+			anchorPoint: assetGen.anchorPoints[0],
+
+			amt: 16,
+		},
+		{
+			assetGen:    assetGen.assetGens[1],
+			anchorPoint: assetGen.anchorPoints[0],
+
+			amt: 10,
+		},
+		{
+			assetGen:    assetGen.assetGens[2],
+			anchorPoint: assetGen.anchorPoints[1],
+
+			amt: 6,
+		},
+	})
+
+	owned, err := assetsStore.ListOwnedAnchors(ctx)
+	require.NoError(t, err)
+	require.Len(t, owned, 2)
+	for idx, anchor := range owned {
+		anchorPoint := assetGen.anchorPoints[idx]
+		anchorTx := assetGen.anchorPointsToTx[anchorPoint]
+
+		require.Equal(t, anchorPoint, anchor.AnchorPoint)
+		require.Equal(t, anchorTx.TxOut[0].PkScript, anchor.PkScript)
+		require.Equal(
+			t, assetGen.anchorPointsToHeights[anchorPoint],
+			anchor.HeightHint,
+		)
+	}
+
+	// A transaction that isn't the anchor of a transfer is unknown.
+	spendingTxid := test.RandHash()
+	ownTransfer, err := assetsStore.IsTransferAnchor(ctx, spendingTxid)
+	require.NoError(t, err)
+	require.False(t, ownTransfer)
+
+	// Once the first anchor output is spent by the unknown transaction,
+	// both of its assets are at risk.
+	spentAnchor := assetGen.anchorPoints[0]
+	atRisk, err := assetsStore.MarkAnchorAtRisk(
+		ctx, spentAnchor, spendingTxid, 600,
+	)
+	require.NoError(t, err)
+	require.Len(t, atRisk, 2)
+
+	listed, err := assetsStore.ListAtRiskAssets(ctx)
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	for _, a := range listed {
+		require.Equal(t, spentAnchor, a.AnchorPoint)
+		require.Equal(t, spendingTxid, a.SpendingTxid)
+		require.EqualValues(t, 600, a.SpendHeight)
+		require.Contains(t, []uint64{16, 10}, a.Amount)
+	}
+
+	// The at risk assets are frozen, so they're no longer selected for
+	// spending.
+	frozen, err := assetsStore.ListFrozenAssetOutputs(ctx)
+	require.NoError(t, err)
+	require.Len(t, frozen, 2)
+	for _, f := range frozen {
+		require.Equal(t, spentAnchor, f.AnchorPoint)
+		require.Contains(t, f.Reason, spendingTxid.String())
+	}
+
+	// The spent anchor output is no longer watched.
+	owned, err = assetsStore.ListOwnedAnchors(ctx)
+	require.NoError(t, err)
+	require.Len(t, owned, 1)
+	require.Equal(t, assetGen.anchorPoints[1], owned[0].AnchorPoint)
+}
@@ -0,0 +1,185 @@
+package tapdb
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+)
+
+// maxSnapshotTime is used as the time limit of balance snapshot queries that
+// don't restrict the time.
+var maxSnapshotTime = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// SnapshotBalances snapshots the confirmed balance of all assets at the given
+// block height. Only balances that changed since the previous snapshot are
+// stored, and the stored snapshots are returned. Assets that were fully spent
+// since the previous snapshot are stored with a zero balance.
+func (a *AssetStore) SnapshotBalances(ctx context.Context,
+	height uint32) ([]tapfreighter.BalanceSnapshot, error) {
+
+	takenAt := a.clock.Now().UTC()
+
+	var snapshots []tapfreighter.BalanceSnapshot
+	var writeTxOpts AssetStoreTxOptions
+	dbErr := a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		snapshots = nil
+
+		balances, err := q.QueryConfirmedAssetBalances(
+			ctx, sqlInt32(height),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to query confirmed asset "+
+				"balances: %w", err)
+		}
+
+		previous, err := q.QueryBalanceSnapshots(
+			ctx, BalanceSnapshotQuery{
+				MaxHeight:  int32(height),
+				MaxTakenAt: maxSnapshotTime,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to query previous balance "+
+				"snapshots: %w", err)
+		}
+
+		latest := make(map[asset.ID]BalanceSnapshotRow, len(previous))
+		for _, row := range previous {
+			var id asset.ID
+			copy(id[:], row.AssetID)
+			latest[id] = row
+		}
+
+		// We start with the current balances, then add a zero balance
+		// for every asset that had a balance in its previous snapshot
+		// but is now fully spent.
+		current := make([]NewBalanceSnapshot, 0, len(balances))
+		seen := make(map[asset.ID]struct{}, len(balances))
+		for _, row := range balances {
+			var id asset.ID
+			copy(id[:], row.AssetID)
+			seen[id] = struct{}{}
+
+			current = append(current, NewBalanceSnapshot{
+				AssetID:   id[:],
+				Height:    int32(height),
+				TakenAt:   takenAt,
+				Balance:   row.Balance,
+				UtxoCount: int32(row.UtxoCount),
+			})
+		}
+		for id, row := range latest {
+			if _, ok := seen[id]; ok || row.Balance == 0 {
+				continue
+			}
+
+			id := id
+			current = append(current, NewBalanceSnapshot{
+				AssetID: id[:],
+				Height:  int32(height),
+				TakenAt: takenAt,
+			})
+		}
+
+		for _, snapshot := range current {
+			var id asset.ID
+			copy(id[:], snapshot.AssetID)
+
+			prev, ok := latest[id]
+			if ok && prev.Balance == snapshot.Balance &&
+				prev.UtxoCount == snapshot.UtxoCount {
+
+				continue
+			}
+
+			err := q.UpsertBalanceSnapshot(ctx, snapshot)
+			if err != nil {
+				return fmt.Errorf("unable to store balance "+
+					"snapshot of asset %v: %w", id, err)
+			}
+
+			snapshots = append(snapshots, balanceSnapshotFromDb(
+				BalanceSnapshotRow(snapshot),
+			))
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return snapshots, nil
+}
+
+// QueryBalanceSnapshots returns the most recent snapshot of each asset that
+// matches the query. Assets with a zero balance at that point are omitted.
+func (a *AssetStore) QueryBalanceSnapshots(ctx context.Context,
+	query tapfreighter.BalanceSnapshotQuery) ([]tapfreighter.BalanceSnapshot,
+	error) {
+
+	dbQuery := BalanceSnapshotQuery{
+		MaxHeight:  math.MaxInt32,
+		MaxTakenAt: maxSnapshotTime,
+	}
+	if query.MaxHeight != 0 {
+		dbQuery.MaxHeight = int32(query.MaxHeight)
+	}
+	if !query.MaxTime.IsZero() {
+		dbQuery.MaxTakenAt = query.MaxTime.UTC()
+	}
+	if query.AssetID != nil {
+		dbQuery.AssetIDFilter = query.AssetID[:]
+	}
+
+	var snapshots []tapfreighter.BalanceSnapshot
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		snapshots = nil
+
+		rows, err := q.QueryBalanceSnapshots(ctx, dbQuery)
+		if err != nil {
+			return fmt.Errorf("unable to query balance snapshots: "+
+				"%w", err)
+		}
+
+		for _, row := range rows {
+			if row.Balance == 0 {
+				continue
+			}
+
+			snapshots = append(snapshots, balanceSnapshotFromDb(row))
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return snapshots, nil
+}
+
+// balanceSnapshotFromDb converts a stored balance snapshot to its wallet
+// representation.
+func balanceSnapshotFromDb(
+	row BalanceSnapshotRow) tapfreighter.BalanceSnapshot {
+
+	snapshot := tapfreighter.BalanceSnapshot{
+		Height:    uint32(row.Height),
+		TakenAt:   row.TakenAt.UTC(),
+		Balance:   uint64(row.Balance),
+		UTXOCount: uint32(row.UtxoCount),
+	}
+	copy(snapshot.AssetID[:], row.AssetID)
+
+	return snapshot
+}
+
+// A compile-time assertion to make sure AssetStore satisfies the
+// tapfreighter.BalanceSnapshotStore interface.
+var _ tapfreighter.BalanceSnapshotStore = (*AssetStore)(nil)
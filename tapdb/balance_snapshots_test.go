@@ -0,0 +1,164 @@
+package tapdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// assertSnapshotBalances asserts that the given snapshots contain exactly the
+// given balances.
+func assertSnapshotBalances(t *testing.T,
+	snapshots []tapfreighter.BalanceSnapshot,
+	balances map[asset.ID]uint64) {
+
+	require.Len(t, snapshots, len(balances))
+	for _, snapshot := range snapshots {
+		balance, ok := balances[snapshot.AssetID]
+		require.True(t, ok)
+		require.Equal(t, balance, snapshot.Balance)
+	}
+}
+
+// TestBalanceSnapshots tests that balance snapshots only store changed
+// balances and that historical balances can be queried by height and time.
+func TestBalanceSnapshots(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, db := newAssetStore(t)
+	testClock := assetsStore.clock.(*clock.TestClock)
+	ctx := context.Background()
+
+	// The first anchor transaction is confirmed at height 500, the second
+	// one at height 501.
+	assetGen := newAssetGenerator(t, 3, 0)
+	assetGen.genAssets(t, assetsStore, []assetDesc{
+		{
+			assetGen:    assetGen.assetGens[0],
+			anchorPoint: assetGen.anchorPoints[0],
+			amt:         16,
+		},
+		{
+			assetGen:    assetGen.assetGens[1],
+			anchorPoint: assetGen.anchorPoints[0],
+			amt:         10,
+		},
+		{
+			assetGen:    assetGen.assetGens[2],
+			anchorPoint: assetGen.anchorPoints[1],
+			amt:         4,
+		},
+	})
+
+	var (
+		id0 = *assetGen.bindAssetID(0, assetGen.anchorPoints[0])
+		id1 = *assetGen.bindAssetID(1, assetGen.anchorPoints[0])
+		id2 = *assetGen.bindAssetID(2, assetGen.anchorPoints[1])
+
+		start = time.Unix(1_700_000_000, 0)
+	)
+
+	// At height 500, only the assets of the first anchor transaction are
+	// confirmed.
+	testClock.SetTime(start)
+	snapshots, err := assetsStore.SnapshotBalances(ctx, 500)
+	require.NoError(t, err)
+	assertSnapshotBalances(t, snapshots, map[asset.ID]uint64{
+		id0: 16,
+		id1: 10,
+	})
+
+	// At height 501, only the asset of the second anchor transaction is
+	// new, the other balances are unchanged.
+	testClock.SetTime(start.Add(time.Hour))
+	snapshots, err = assetsStore.SnapshotBalances(ctx, 501)
+	require.NoError(t, err)
+	assertSnapshotBalances(t, snapshots, map[asset.ID]uint64{id2: 4})
+	require.EqualValues(t, 501, snapshots[0].Height)
+	require.EqualValues(t, 1, snapshots[0].UTXOCount)
+
+	// Nothing changed since then, so no new snapshot is stored.
+	testClock.SetTime(start.Add(2 * time.Hour))
+	snapshots, err = assetsStore.SnapshotBalances(ctx, 502)
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+
+	// We now spend the second asset, which is stored as a zero balance.
+	allAssets, err := assetsStore.FetchAllAssets(ctx, false, true, nil)
+	require.NoError(t, err)
+	for _, a := range allAssets {
+		if a.ID() != id1 {
+			continue
+		}
+
+		_, err := db.SetAssetSpent(ctx, SetAssetSpentParams{
+			ScriptKey:  a.ScriptKey.PubKey.SerializeCompressed(),
+			GenAssetID: id1[:],
+		})
+		require.NoError(t, err)
+	}
+
+	testClock.SetTime(start.Add(3 * time.Hour))
+	snapshots, err = assetsStore.SnapshotBalances(ctx, 503)
+	require.NoError(t, err)
+	assertSnapshotBalances(t, snapshots, map[asset.ID]uint64{id1: 0})
+
+	// The current balances don't include the spent asset anymore.
+	snapshots, err = assetsStore.QueryBalanceSnapshots(
+		ctx, tapfreighter.BalanceSnapshotQuery{},
+	)
+	require.NoError(t, err)
+	assertSnapshotBalances(t, snapshots, map[asset.ID]uint64{
+		id0: 16,
+		id2: 4,
+	})
+
+	// Querying historical balances by height or time returns the balance
+	// of the most recent snapshot before that point.
+	snapshots, err = assetsStore.QueryBalanceSnapshots(
+		ctx, tapfreighter.BalanceSnapshotQuery{
+			MaxHeight: 500,
+		},
+	)
+	require.NoError(t, err)
+	assertSnapshotBalances(t, snapshots, map[asset.ID]uint64{
+		id0: 16,
+		id1: 10,
+	})
+
+	snapshots, err = assetsStore.QueryBalanceSnapshots(
+		ctx, tapfreighter.BalanceSnapshotQuery{
+			MaxTime: start.Add(150 * time.Minute),
+		},
+	)
+	require.NoError(t, err)
+	assertSnapshotBalances(t, snapshots, map[asset.ID]uint64{
+		id0: 16,
+		id1: 10,
+		id2: 4,
+	})
+
+	// Balances before the first snapshot are unknown.
+	snapshots, err = assetsStore.QueryBalanceSnapshots(
+		ctx, tapfreighter.BalanceSnapshotQuery{
+			MaxHeight: 499,
+		},
+	)
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+
+	// Finally, the query can be restricted to a single asset.
+	snapshots, err = assetsStore.QueryBalanceSnapshots(
+		ctx, tapfreighter.BalanceSnapshotQuery{
+			AssetID:   &id1,
+			MaxHeight: 502,
+		},
+	)
+	require.NoError(t, err)
+	assertSnapshotBalances(t, snapshots, map[asset.ID]uint64{id1: 10})
+}
@@ -0,0 +1,201 @@
+package tapdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+type (
+	// NewCounterparty is used to insert or update a counterparty.
+	NewCounterparty = sqlc.UpsertCounterpartyParams
+
+	// CounterpartyQuery is used to query counterparties.
+	CounterpartyQuery = sqlc.QueryCounterpartiesParams
+
+	// CounterpartyRow is a single script key of a counterparty.
+	CounterpartyRow = sqlc.Counterparty
+)
+
+// CounterpartyStore is the set of queries needed to maintain the counterparty
+// book.
+type CounterpartyStore interface {
+	// UpsertCounterparty inserts a new counterparty script key or renames
+	// an existing one.
+	UpsertCounterparty(ctx context.Context, arg NewCounterparty) error
+
+	// DeleteCounterparty deletes all script keys of the counterparty with
+	// the given name and returns the number of deleted script keys.
+	DeleteCounterparty(ctx context.Context, name string) (int64, error)
+
+	// QueryCounterparties returns all counterparty script keys that match
+	// the given query, ordered by name.
+	QueryCounterparties(ctx context.Context,
+		arg CounterpartyQuery) ([]CounterpartyRow, error)
+}
+
+// CounterpartyTxOptions is the database tx object for the counterparty store.
+type CounterpartyTxOptions struct {
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (c *CounterpartyTxOptions) ReadOnly() bool {
+	return c.readOnly
+}
+
+// NewCounterpartyReadTx returns a new read tx for the counterparty store.
+func NewCounterpartyReadTx() CounterpartyTxOptions {
+	return CounterpartyTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedCounterpartyStore allows for batched DB transactions for the
+// counterparty store.
+type BatchedCounterpartyStore interface {
+	CounterpartyStore
+
+	BatchedTx[CounterpartyStore]
+}
+
+// Counterparties is a database backed implementation of the
+// address.CounterpartyBook interface.
+type Counterparties struct {
+	db BatchedCounterpartyStore
+
+	clock clock.Clock
+}
+
+// NewCounterparties creates a new counterparty book backed by the given
+// database.
+func NewCounterparties(db BatchedCounterpartyStore,
+	clock clock.Clock) *Counterparties {
+
+	return &Counterparties{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// CounterpartyByScriptKey returns the counterparty that receives assets on the
+// given script key.
+//
+// NOTE: This is part of the address.CounterpartyBook interface.
+func (c *Counterparties) CounterpartyByScriptKey(ctx context.Context,
+	scriptKey *btcec.PublicKey) (*address.Counterparty, error) {
+
+	counterparties, err := c.queryCounterparties(ctx, CounterpartyQuery{
+		ScriptKey: scriptKey.SerializeCompressed(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(counterparties) == 0 {
+		return nil, address.ErrCounterpartyNotFound
+	}
+
+	return counterparties[0], nil
+}
+
+// SetCounterparty adds the given counterparty, or renames it if its script key
+// is already known.
+//
+// NOTE: This is part of the address.CounterpartyBook interface.
+func (c *Counterparties) SetCounterparty(ctx context.Context,
+	counterparty *address.Counterparty) error {
+
+	if err := counterparty.Validate(); err != nil {
+		return err
+	}
+
+	var writeTx CounterpartyTxOptions
+	return c.db.ExecTx(ctx, &writeTx, func(q CounterpartyStore) error {
+		return q.UpsertCounterparty(ctx, NewCounterparty{
+			Name:      counterparty.Name,
+			ScriptKey: counterparty.ScriptKey.SerializeCompressed(),
+			TapAddr:   sqlStr(counterparty.TapAddr),
+			CreatedAt: c.clock.Now().UTC(),
+		})
+	})
+}
+
+// DeleteCounterparty removes all script keys of the counterparty with the
+// given name.
+//
+// NOTE: This is part of the address.CounterpartyBook interface.
+func (c *Counterparties) DeleteCounterparty(ctx context.Context,
+	name string) error {
+
+	var writeTx CounterpartyTxOptions
+	return c.db.ExecTx(ctx, &writeTx, func(q CounterpartyStore) error {
+		numDeleted, err := q.DeleteCounterparty(ctx, name)
+		if err != nil {
+			return err
+		}
+		if numDeleted == 0 {
+			return fmt.Errorf("%w: %s",
+				address.ErrCounterpartyNotFound, name)
+		}
+
+		return nil
+	})
+}
+
+// ListCounterparties returns all counterparties of the book, ordered by name.
+//
+// NOTE: This is part of the address.CounterpartyBook interface.
+func (c *Counterparties) ListCounterparties(
+	ctx context.Context) ([]*address.Counterparty, error) {
+
+	return c.queryCounterparties(ctx, CounterpartyQuery{})
+}
+
+// queryCounterparties returns all counterparties that match the given query.
+func (c *Counterparties) queryCounterparties(ctx context.Context,
+	query CounterpartyQuery) ([]*address.Counterparty, error) {
+
+	var counterparties []*address.Counterparty
+	readTx := NewCounterpartyReadTx()
+	dbErr := c.db.ExecTx(ctx, &readTx, func(q CounterpartyStore) error {
+		rows, err := q.QueryCounterparties(ctx, query)
+		if err != nil {
+			return err
+		}
+
+		counterparties, err = fn.MapErr(rows, parseCounterparty)
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query counterparties: %w",
+			dbErr)
+	}
+
+	return counterparties, nil
+}
+
+// parseCounterparty parses a counterparty script key from the database.
+func parseCounterparty(r CounterpartyRow) (*address.Counterparty, error) {
+	scriptKey, err := btcec.ParsePubKey(r.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse script key of "+
+			"counterparty %s: %w", r.Name, err)
+	}
+
+	return &address.Counterparty{
+		Name:      r.Name,
+		ScriptKey: scriptKey,
+		TapAddr:   r.TapAddr.String,
+	}, nil
+}
+
+// A compile-time assertion to ensure that Counterparties meets the
+// address.CounterpartyBook interface.
+var _ address.CounterpartyBook = (*Counterparties)(nil)
@@ -0,0 +1,103 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCounterparties tests that the script keys of counterparties can be
+// added, resolved, renamed and deleted.
+func TestCounterparties(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	counterpartyDB := NewTransactionExecutor(
+		db, func(tx *sql.Tx) CounterpartyStore {
+			return db.WithTx(tx)
+		},
+	)
+	book := NewCounterparties(counterpartyDB, clock.NewDefaultClock())
+	ctx := context.Background()
+
+	// The book starts out empty.
+	counterparties, err := book.ListCounterparties(ctx)
+	require.NoError(t, err)
+	require.Empty(t, counterparties)
+
+	aliceKey1 := test.RandPubKey(t)
+	aliceKey2 := test.RandPubKey(t)
+	bobKey := test.RandPubKey(t)
+
+	_, err = book.CounterpartyByScriptKey(ctx, aliceKey1)
+	require.ErrorIs(t, err, address.ErrCounterpartyNotFound)
+
+	// Invalid counterparties are rejected.
+	err = book.SetCounterparty(ctx, &address.Counterparty{
+		Name: "alice",
+	})
+	require.ErrorIs(t, err, address.ErrInvalidCounterparty)
+	err = book.SetCounterparty(ctx, &address.Counterparty{
+		Name:      " alice",
+		ScriptKey: aliceKey1,
+	})
+	require.ErrorIs(t, err, address.ErrInvalidCounterparty)
+
+	// A counterparty can have several script keys.
+	require.NoError(t, book.SetCounterparty(ctx, &address.Counterparty{
+		Name:      "alice",
+		ScriptKey: aliceKey1,
+		TapAddr:   "taprt1alice",
+	}))
+	require.NoError(t, book.SetCounterparty(ctx, &address.Counterparty{
+		Name:      "alice",
+		ScriptKey: aliceKey2,
+	}))
+	require.NoError(t, book.SetCounterparty(ctx, &address.Counterparty{
+		Name:      "bob",
+		ScriptKey: bobKey,
+	}))
+
+	counterparty, err := book.CounterpartyByScriptKey(ctx, aliceKey1)
+	require.NoError(t, err)
+	require.Equal(t, "alice", counterparty.Name)
+	require.Equal(t, "taprt1alice", counterparty.TapAddr)
+	require.True(t, aliceKey1.IsEqual(counterparty.ScriptKey))
+
+	counterparty, err = book.CounterpartyByScriptKey(ctx, aliceKey2)
+	require.NoError(t, err)
+	require.Equal(t, "alice", counterparty.Name)
+	require.Empty(t, counterparty.TapAddr)
+
+	// Setting a known script key again renames it.
+	require.NoError(t, book.SetCounterparty(ctx, &address.Counterparty{
+		Name:      "bob",
+		ScriptKey: aliceKey2,
+	}))
+
+	counterparties, err = book.ListCounterparties(ctx)
+	require.NoError(t, err)
+	require.Len(t, counterparties, 3)
+	require.Equal(t, "alice", counterparties[0].Name)
+	require.Equal(t, "bob", counterparties[1].Name)
+	require.Equal(t, "bob", counterparties[2].Name)
+
+	// Deleting a counterparty removes all of its script keys.
+	require.NoError(t, book.DeleteCounterparty(ctx, "bob"))
+	err = book.DeleteCounterparty(ctx, "bob")
+	require.ErrorIs(t, err, address.ErrCounterpartyNotFound)
+
+	_, err = book.CounterpartyByScriptKey(ctx, aliceKey2)
+	require.ErrorIs(t, err, address.ErrCounterpartyNotFound)
+	_, err = book.CounterpartyByScriptKey(ctx, bobKey)
+	require.ErrorIs(t, err, address.ErrCounterpartyNotFound)
+
+	counterparties, err = book.ListCounterparties(ctx)
+	require.NoError(t, err)
+	require.Len(t, counterparties, 1)
+}
@@ -0,0 +1,160 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+// ProofDeliverySignature records the signature of the node that delivered a
+// proof to us through a courier.
+type ProofDeliverySignature struct {
+	// AnchorPoint is the outpoint the received asset is anchored at.
+	AnchorPoint wire.OutPoint
+
+	// AssetID is the ID of the received asset.
+	AssetID asset.ID
+
+	// ScriptKey is the script key of the received asset.
+	ScriptKey *btcec.PublicKey
+
+	// ProofHash is the sha256 hash of the received proof file.
+	ProofHash [sha256.Size]byte
+
+	// Signature is the verified signature of the sending node.
+	Signature proof.DeliverySignature
+
+	// ReceivedAt is the time the proof was received.
+	ReceivedAt time.Time
+}
+
+// recordDeliverySignature records the delivery signature of the given proof,
+// if it carries one.
+func (a *AssetStore) recordDeliverySignature(ctx context.Context,
+	db ActiveAssetsStore, p *proof.AnnotatedProof) error {
+
+	if p.DeliverySignature == nil {
+		return nil
+	}
+
+	anchorPoint := wire.OutPoint{
+		Hash:  p.AnchorTx.TxHash(),
+		Index: p.OutputIndex,
+	}
+	anchorPointBytes, err := encodeOutpoint(anchorPoint)
+	if err != nil {
+		return fmt.Errorf("unable to encode outpoint: %w", err)
+	}
+
+	assetID := p.Asset.ID()
+	proofHash := sha256.Sum256(p.Blob)
+	sig := p.DeliverySignature
+	err = db.InsertProofDeliverySignature(ctx, NewProofDeliverySignature{
+		AnchorPoint:   anchorPointBytes,
+		AssetID:       assetID[:],
+		ScriptKey:     p.Asset.ScriptKey.PubKey.SerializeCompressed(),
+		ProofHash:     proofHash[:],
+		SenderNodeKey: sig.NodeKey.SerializeCompressed(),
+		Signature:     sig.Signature,
+		ReceivedAt:    a.clock.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to record proof delivery signature: "+
+			"%w", err)
+	}
+
+	return nil
+}
+
+// QueryProofDeliverySignatures returns the recorded signatures of the nodes
+// that delivered proofs to us, ordered by the time they were received at. If
+// an anchor point is given, only signatures for assets anchored at it are
+// returned.
+func (a *AssetStore) QueryProofDeliverySignatures(ctx context.Context,
+	anchorPoint *wire.OutPoint) ([]*ProofDeliverySignature, error) {
+
+	var anchorPointFilter []byte
+	if anchorPoint != nil {
+		var err error
+		anchorPointFilter, err = encodeOutpoint(*anchorPoint)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode outpoint: %w",
+				err)
+		}
+	}
+
+	var (
+		readOpts   = NewAssetStoreReadTx()
+		signatures []*ProofDeliverySignature
+	)
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		rows, err := q.QueryProofDeliverySignatures(
+			ctx, anchorPointFilter,
+		)
+		if err != nil {
+			return err
+		}
+
+		signatures = make([]*ProofDeliverySignature, 0, len(rows))
+		for _, row := range rows {
+			sig, err := parseProofDeliverySignature(row)
+			if err != nil {
+				return err
+			}
+			signatures = append(signatures, sig)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query proof delivery "+
+			"signatures: %w", dbErr)
+	}
+
+	return signatures, nil
+}
+
+// parseProofDeliverySignature parses a recorded proof delivery signature.
+func parseProofDeliverySignature(
+	row ProofDeliverySignatureRow) (*ProofDeliverySignature, error) {
+
+	var anchorPoint wire.OutPoint
+	err := readOutPoint(
+		bytes.NewReader(row.AnchorPoint), 0, 0, &anchorPoint,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode outpoint: %w", err)
+	}
+
+	scriptKey, err := btcec.ParsePubKey(row.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse script key: %w", err)
+	}
+
+	nodeKey, err := btcec.ParsePubKey(row.SenderNodeKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sender node key: %w",
+			err)
+	}
+
+	sig := &ProofDeliverySignature{
+		AnchorPoint: anchorPoint,
+		ScriptKey:   scriptKey,
+		Signature: proof.DeliverySignature{
+			NodeKey:   nodeKey,
+			Signature: row.Signature,
+		},
+		ReceivedAt: row.ReceivedAt.UTC(),
+	}
+	copy(sig.AssetID[:], row.AssetID)
+	copy(sig.ProofHash[:], row.ProofHash)
+
+	return sig, nil
+}
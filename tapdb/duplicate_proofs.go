@@ -0,0 +1,160 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+// DuplicateProofReceipt records that a proof was received for an asset output
+// that was already credited to the wallet. The proof itself wasn't imported
+// again.
+type DuplicateProofReceipt struct {
+	// AnchorPoint is the outpoint the received asset is anchored at.
+	AnchorPoint wire.OutPoint
+
+	// AssetID is the ID of the received asset.
+	AssetID asset.ID
+
+	// ScriptKey is the script key of the received asset.
+	ScriptKey *btcec.PublicKey
+
+	// ProofHash is the sha256 hash of the received proof file.
+	ProofHash [sha256.Size]byte
+
+	// ReceivedAt is the time the duplicate proof was received.
+	ReceivedAt time.Time
+}
+
+// recordDuplicateProof checks whether the asset of the given proof was already
+// imported. If it was, the receipt of the proof is recorded and true is
+// returned, so the proof isn't imported and credited a second time.
+func (a *AssetStore) recordDuplicateProof(ctx context.Context,
+	db ActiveAssetsStore, p *proof.AnnotatedProof) (bool, error) {
+
+	anchorPoint := wire.OutPoint{
+		Hash:  p.AnchorTx.TxHash(),
+		Index: p.OutputIndex,
+	}
+	anchorPointBytes, err := encodeOutpoint(anchorPoint)
+	if err != nil {
+		return false, fmt.Errorf("unable to encode outpoint: %w", err)
+	}
+
+	assetID := p.Asset.ID()
+	scriptKeyBytes := p.Asset.ScriptKey.PubKey.SerializeCompressed()
+	_, err = db.FetchAssetByAnchor(ctx, AssetByAnchorQuery{
+		Outpoint:         anchorPointBytes,
+		AssetID:          assetID[:],
+		TweakedScriptKey: scriptKeyBytes,
+	})
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return false, nil
+
+	case err != nil:
+		return false, fmt.Errorf("unable to fetch asset: %w", err)
+	}
+
+	proofHash := sha256.Sum256(p.Blob)
+	err = db.InsertDuplicateProofReceipt(ctx, NewDuplicateProofReceipt{
+		AnchorPoint: anchorPointBytes,
+		AssetID:     assetID[:],
+		ScriptKey:   scriptKeyBytes,
+		ProofHash:   proofHash[:],
+		ReceivedAt:  a.clock.Now().UTC(),
+	})
+	if err != nil {
+		return false, fmt.Errorf("unable to record duplicate proof: %w",
+			err)
+	}
+
+	log.Infof("Skipping import of duplicate proof for asset %v at %v, "+
+		"proof_hash=%x", assetID, anchorPoint, proofHash[:])
+
+	return true, nil
+}
+
+// QueryDuplicateProofReceipts returns the recorded receipts of duplicate
+// proofs, ordered by the time they were received at. If an anchor point is
+// given, only receipts for assets anchored at it are returned.
+func (a *AssetStore) QueryDuplicateProofReceipts(ctx context.Context,
+	anchorPoint *wire.OutPoint) ([]*DuplicateProofReceipt, error) {
+
+	var anchorPointFilter []byte
+	if anchorPoint != nil {
+		var err error
+		anchorPointFilter, err = encodeOutpoint(*anchorPoint)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode outpoint: %w",
+				err)
+		}
+	}
+
+	var (
+		readOpts = NewAssetStoreReadTx()
+		receipts []*DuplicateProofReceipt
+	)
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		rows, err := q.QueryDuplicateProofReceipts(
+			ctx, anchorPointFilter,
+		)
+		if err != nil {
+			return err
+		}
+
+		receipts = make([]*DuplicateProofReceipt, 0, len(rows))
+		for _, row := range rows {
+			receipt, err := parseDuplicateProofReceipt(row)
+			if err != nil {
+				return err
+			}
+			receipts = append(receipts, receipt)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query duplicate proof "+
+			"receipts: %w", dbErr)
+	}
+
+	return receipts, nil
+}
+
+// parseDuplicateProofReceipt parses a recorded duplicate proof receipt.
+func parseDuplicateProofReceipt(
+	row DuplicateProofReceiptRow) (*DuplicateProofReceipt, error) {
+
+	var anchorPoint wire.OutPoint
+	err := readOutPoint(
+		bytes.NewReader(row.AnchorPoint), 0, 0, &anchorPoint,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode outpoint: %w", err)
+	}
+
+	scriptKey, err := btcec.ParsePubKey(row.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse script key: %w", err)
+	}
+
+	receipt := &DuplicateProofReceipt{
+		AnchorPoint: anchorPoint,
+		ScriptKey:   scriptKey,
+		ReceivedAt:  row.ReceivedAt.UTC(),
+	}
+	copy(receipt.AssetID[:], row.AssetID)
+	copy(receipt.ProofHash[:], row.ProofHash)
+
+	return receipt, nil
+}
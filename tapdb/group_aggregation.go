@@ -0,0 +1,199 @@
+package tapdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+)
+
+// AssetGroupSummary aggregates the unspent assets of an asset group across
+// all of its tranches.
+type AssetGroupSummary struct {
+	// GroupKey is the tweaked group key of the asset group.
+	GroupKey *btcec.PublicKey
+
+	// Balance is the total unspent balance of the group, including the
+	// assets in leased anchor outputs.
+	Balance uint64
+
+	// LeasedBalance is the part of the balance that is anchored in
+	// currently leased outputs and therefore not available for coin
+	// selection.
+	LeasedBalance uint64
+
+	// TrancheBalances is the unspent balance of each tranche of the group,
+	// keyed by the asset ID of the tranche.
+	TrancheBalances map[asset.ID]uint64
+
+	// UTXOs are the unspent assets of the group.
+	UTXOs []*AssetUTXO
+}
+
+// GroupTransfer is an outbound transfer that spent assets of an asset group.
+type GroupTransfer struct {
+	*tapfreighter.OutboundParcel
+
+	// Tranches are the asset IDs of the tranches of the group that were
+	// spent by the transfer.
+	Tranches []asset.ID
+
+	// InputAmount is the total amount of the group's assets spent by the
+	// transfer.
+	InputAmount uint64
+
+	// SentAmount is the total amount of the outputs that aren't owned by
+	// this daemon.
+	SentAmount uint64
+
+	// ChangeAmount is the total amount of the outputs that are owned by
+	// this daemon.
+	ChangeAmount uint64
+}
+
+// QueryGroupSummaries aggregates the unspent assets of all asset groups, or
+// only of the group with the given tweaked group key if it is set. Assets
+// without a group key are skipped.
+func (a *AssetStore) QueryGroupSummaries(ctx context.Context,
+	groupKey *btcec.PublicKey) (map[asset.SerializedKey]*AssetGroupSummary,
+	error) {
+
+	var query *AssetQueryFilters
+	if groupKey != nil {
+		constraints := tapfreighter.CommitmentConstraints{
+			GroupKey: groupKey,
+		}
+		query = &AssetQueryFilters{
+			CommitmentConstraints: constraints,
+		}
+	}
+
+	utxos, err := a.ListAssetUTXOs(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list asset UTXOs: %w", err)
+	}
+
+	summaries := make(map[asset.SerializedKey]*AssetGroupSummary)
+	for _, utxo := range utxos {
+		if utxo.GroupKey == nil {
+			continue
+		}
+
+		utxoGroupKey := utxo.GroupKey.GroupPubKey
+		serializedKey := asset.ToSerialized(&utxoGroupKey)
+
+		summary, ok := summaries[serializedKey]
+		if !ok {
+			summary = &AssetGroupSummary{
+				GroupKey:        &utxoGroupKey,
+				TrancheBalances: make(map[asset.ID]uint64),
+			}
+			summaries[serializedKey] = summary
+		}
+
+		summary.Balance += utxo.Amount
+		if utxo.Leased {
+			summary.LeasedBalance += utxo.Amount
+		}
+		summary.TrancheBalances[utxo.ID()] += utxo.Amount
+		summary.UTXOs = append(summary.UTXOs, utxo)
+	}
+
+	return summaries, nil
+}
+
+// QueryGroupTransfers returns all outbound transfers, both pending and
+// confirmed, that spent assets of any tranche of the asset group with the
+// given tweaked group key.
+//
+// NOTE: All active inputs of a transfer are of the same asset group, so the
+// amounts of all its outputs are attributed to the group.
+func (a *AssetStore) QueryGroupTransfers(ctx context.Context,
+	groupKey *btcec.PublicKey) ([]*GroupTransfer, error) {
+
+	var transfers []*GroupTransfer
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		dbIDs, err := q.FetchGroupAssetIDs(
+			ctx, groupKey.SerializeCompressed(),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch group asset IDs: %w",
+				err)
+		}
+
+		tranches := make(map[asset.ID]struct{}, len(dbIDs))
+		for _, dbID := range dbIDs {
+			var id asset.ID
+			copy(id[:], dbID)
+			tranches[id] = struct{}{}
+		}
+
+		dbTransfers, err := q.QueryAssetTransfers(ctx, TransferQuery{})
+		if err != nil {
+			return fmt.Errorf("unable to query transfers: %w", err)
+		}
+
+		for idx := range dbTransfers {
+			parcel, _, err := fetchOutboundParcel(
+				ctx, q, dbTransfers[idx],
+			)
+			if err != nil {
+				return err
+			}
+
+			transfer := newGroupTransfer(parcel, tranches)
+			if transfer != nil {
+				transfers = append(transfers, transfer)
+			}
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return transfers, nil
+}
+
+// newGroupTransfer aggregates the amounts of the given parcel for the asset
+// group with the given tranches. Nil is returned if the parcel didn't spend
+// any of the tranches.
+func newGroupTransfer(parcel *tapfreighter.OutboundParcel,
+	tranches map[asset.ID]struct{}) *GroupTransfer {
+
+	transfer := &GroupTransfer{
+		OutboundParcel: parcel,
+	}
+
+	spentTranches := make(map[asset.ID]struct{})
+	for _, input := range parcel.Inputs {
+		if _, ok := tranches[input.ID]; !ok {
+			continue
+		}
+
+		transfer.InputAmount += input.Amount
+		if _, ok := spentTranches[input.ID]; !ok {
+			spentTranches[input.ID] = struct{}{}
+			transfer.Tranches = append(transfer.Tranches, input.ID)
+		}
+	}
+
+	if len(transfer.Tranches) == 0 {
+		return nil
+	}
+
+	for _, output := range parcel.Outputs {
+		if output.ScriptKeyLocal {
+			transfer.ChangeAmount += output.Amount
+		} else {
+			transfer.SentAmount += output.Amount
+		}
+	}
+
+	return transfer
+}
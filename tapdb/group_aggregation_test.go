@@ -0,0 +1,129 @@
+package tapdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGroupAggregation tests that balances, UTXOs and transfers are correctly
+// aggregated across the tranches of an asset group.
+func TestGroupAggregation(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	// We create two tranches of the first group, one of them leased, a
+	// single tranche of the second group and an asset without a group.
+	assetGen := newAssetGenerator(t, 4, 2)
+
+	reissueGen := assetGen.assetGens[0]
+	reissueGen.FirstPrevOut = assetGen.anchorPoints[0]
+
+	assetGen.genAssets(t, assetsStore, []assetDesc{
+		{
+			assetGen:    assetGen.assetGens[0],
+			anchorPoint: assetGen.anchorPoints[0],
+			keyGroup:    assetGen.groupKeys[0],
+			leasedUntil: time.Now().Add(time.Hour),
+			amt:         40,
+		},
+		{
+			assetGen:       assetGen.assetGens[1],
+			groupAnchorGen: &reissueGen,
+			anchorPoint:    assetGen.anchorPoints[1],
+			keyGroup:       assetGen.groupKeys[0],
+			amt:            20,
+		},
+		{
+			assetGen:    assetGen.assetGens[2],
+			anchorPoint: assetGen.anchorPoints[2],
+			keyGroup:    assetGen.groupKeys[1],
+			amt:         10,
+		},
+		{
+			assetGen:    assetGen.assetGens[3],
+			anchorPoint: assetGen.anchorPoints[3],
+			noGroupKey:  true,
+			amt:         8,
+		},
+	})
+
+	allAssets, err := assetsStore.FetchAllAssets(ctx, false, true, nil)
+	require.NoError(t, err)
+
+	anchoredAssets := make(map[wire.OutPoint]*ChainAsset)
+	for _, chainAsset := range allAssets {
+		anchoredAssets[chainAsset.AnchorOutpoint] = chainAsset
+	}
+
+	var (
+		firstAsset     = anchoredAssets[assetGen.anchorPoints[0]]
+		secondAsset    = anchoredAssets[assetGen.anchorPoints[1]]
+		thirdAsset     = anchoredAssets[assetGen.anchorPoints[2]]
+		firstGroupKey  = &firstAsset.GroupKey.GroupPubKey
+		secondGroupKey = &thirdAsset.GroupKey.GroupPubKey
+		firstTranche   = firstAsset.ID()
+		secondTranche  = secondAsset.ID()
+	)
+
+	// The asset without a group isn't part of any summary.
+	summaries, err := assetsStore.QueryGroupSummaries(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, summaries, 2)
+
+	summary := summaries[asset.ToSerialized(firstGroupKey)]
+	require.NotNil(t, summary)
+	require.True(t, summary.GroupKey.IsEqual(firstGroupKey))
+	require.EqualValues(t, 60, summary.Balance)
+	require.EqualValues(t, 40, summary.LeasedBalance)
+	require.Equal(t, map[asset.ID]uint64{
+		firstTranche:  40,
+		secondTranche: 20,
+	}, summary.TrancheBalances)
+	require.Len(t, summary.UTXOs, 2)
+
+	summary = summaries[asset.ToSerialized(secondGroupKey)]
+	require.NotNil(t, summary)
+	require.EqualValues(t, 10, summary.Balance)
+	require.Zero(t, summary.LeasedBalance)
+	require.Len(t, summary.UTXOs, 1)
+
+	// Filtering by group key only returns the summary of that group.
+	summaries, err = assetsStore.QueryGroupSummaries(ctx, secondGroupKey)
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	require.Contains(t, summaries, asset.ToSerialized(secondGroupKey))
+
+	// Now we spend the second tranche of the first group. The transfer
+	// should only show up in the history of the first group.
+	parcel, _ := newTestParcel(t, assetsStore, assetGen.anchorPoints[1])
+	require.NoError(t, assetsStore.LogPendingParcel(
+		ctx, parcel, fn.ToArray[[32]byte](test.RandBytes(32)),
+		time.Now().Add(time.Hour),
+	))
+
+	transfers, err := assetsStore.QueryGroupTransfers(ctx, firstGroupKey)
+	require.NoError(t, err)
+	require.Len(t, transfers, 1)
+
+	transfer := transfers[0]
+	require.Equal(
+		t, parcel.AnchorTx.TxHash(), transfer.AnchorTx.TxHash(),
+	)
+	require.Equal(t, []asset.ID{secondTranche}, transfer.Tranches)
+	require.EqualValues(t, 20, transfer.InputAmount)
+	require.EqualValues(t, 20, transfer.ChangeAmount)
+	require.Zero(t, transfer.SentAmount)
+
+	transfers, err = assetsStore.QueryGroupTransfers(ctx, secondGroupKey)
+	require.NoError(t, err)
+	require.Empty(t, transfers)
+}
@@ -0,0 +1,259 @@
+package tapdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+type (
+	// NewImportedScriptKey is used to mark a script key as imported.
+	NewImportedScriptKey = sqlc.InsertImportedScriptKeyParams
+
+	// ScriptKeyTweak is used to set the internal key and tweak of a
+	// script key.
+	ScriptKeyTweak = sqlc.SetScriptKeyTweakParams
+
+	// ImportedScriptKeyRow is a single imported script key.
+	ImportedScriptKeyRow = sqlc.QueryImportedScriptKeysRow
+)
+
+// ImportedKeyStore is the set of queries needed to maintain the imported
+// script keys.
+type ImportedKeyStore interface {
+	// UpsertInternalKey inserts a new or updates an existing internal key
+	// into the database.
+	UpsertInternalKey(ctx context.Context, arg InternalKey) (int32, error)
+
+	// UpsertScriptKey inserts a new script key on disk into the DB.
+	UpsertScriptKey(context.Context, NewScriptKey) (int32, error)
+
+	// SetScriptKeyTweak sets the internal key and tweak of a script key.
+	SetScriptKeyTweak(ctx context.Context, arg ScriptKeyTweak) error
+
+	// InsertImportedScriptKey marks a script key as imported. A script key
+	// that is already imported keeps its original import time.
+	InsertImportedScriptKey(ctx context.Context,
+		arg NewImportedScriptKey) error
+
+	// QueryImportedScriptKeys returns the imported script key with the
+	// given tweaked key, or all imported script keys if the key is nil,
+	// ordered by their import time.
+	QueryImportedScriptKeys(ctx context.Context,
+		tweakedScriptKey []byte) ([]ImportedScriptKeyRow, error)
+}
+
+// ImportedKeyTxOptions is the database tx object for the imported key store.
+type ImportedKeyTxOptions struct {
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (i *ImportedKeyTxOptions) ReadOnly() bool {
+	return i.readOnly
+}
+
+// NewImportedKeyReadTx returns a new read tx for the imported key store.
+func NewImportedKeyReadTx() ImportedKeyTxOptions {
+	return ImportedKeyTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedImportedKeyStore allows for batched DB transactions for the imported
+// key store.
+type BatchedImportedKeyStore interface {
+	ImportedKeyStore
+
+	BatchedTx[ImportedKeyStore]
+}
+
+// ImportedScriptKeys is a database backed implementation of the
+// address.ImportedKeyStore interface.
+type ImportedScriptKeys struct {
+	db BatchedImportedKeyStore
+
+	clock clock.Clock
+}
+
+// NewImportedScriptKeys creates a new imported script key store backed by the
+// given database.
+func NewImportedScriptKeys(db BatchedImportedKeyStore,
+	clock clock.Clock) *ImportedScriptKeys {
+
+	return &ImportedScriptKeys{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// ImportScriptKey adds the given script key to the set of imported script
+// keys. If assets were already received on the script key before it was
+// imported, its internal key and tweak are updated to the imported ones.
+//
+// NOTE: This is part of the address.ImportedKeyStore interface.
+func (i *ImportedScriptKeys) ImportScriptKey(ctx context.Context,
+	key *address.ImportedScriptKey) error {
+
+	if err := key.Validate(); err != nil {
+		return err
+	}
+
+	var (
+		writeTx   ImportedKeyTxOptions
+		now       = i.clock.Now().UTC()
+		scriptKey = key.ScriptKey
+	)
+	dbErr := i.db.ExecTx(ctx, &writeTx, func(q ImportedKeyStore) error {
+		rawKey := scriptKey.RawKey
+		internalKeyID, err := q.UpsertInternalKey(ctx, InternalKey{
+			RawKey:    rawKey.PubKey.SerializeCompressed(),
+			KeyFamily: int32(rawKey.Family),
+			KeyIndex:  int32(rawKey.Index),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert internal key: %w",
+				err)
+		}
+
+		tweakedKey := scriptKey.PubKey.SerializeCompressed()
+		scriptKeyID, err := q.UpsertScriptKey(ctx, NewScriptKey{
+			InternalKeyID:    internalKeyID,
+			TweakedScriptKey: tweakedKey,
+			Tweak:            scriptKey.Tweak,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert script key: %w",
+				err)
+		}
+
+		// A script key we received assets on without knowing its
+		// internal key is stored with the script key itself as the
+		// internal key, so we always overwrite it.
+		err = q.SetScriptKeyTweak(ctx, ScriptKeyTweak{
+			ScriptKeyID:   scriptKeyID,
+			InternalKeyID: internalKeyID,
+			Tweak:         scriptKey.Tweak,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to set script key tweak: %w",
+				err)
+		}
+
+		return q.InsertImportedScriptKey(ctx, NewImportedScriptKey{
+			ScriptKeyID: scriptKeyID,
+			ImportedAt:  now,
+		})
+	})
+	if dbErr != nil {
+		return fmt.Errorf("unable to import script key: %w", dbErr)
+	}
+
+	return nil
+}
+
+// FetchImportedScriptKey returns the imported script key with the given
+// tweaked key. Script keys are committed to in their x-only form, so the key
+// is matched regardless of its parity.
+//
+// NOTE: This is part of the address.ImportedKeyStore interface.
+func (i *ImportedScriptKeys) FetchImportedScriptKey(ctx context.Context,
+	scriptKey *btcec.PublicKey) (*address.ImportedScriptKey, error) {
+
+	xOnlyKey := schnorr.SerializePubKey(scriptKey)
+	for _, parity := range []byte{0x02, 0x03} {
+		keys, err := i.queryImportedKeys(
+			ctx, append([]byte{parity}, xOnlyKey...),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(keys) > 0 {
+			return keys[0], nil
+		}
+	}
+
+	return nil, address.ErrImportedKeyNotFound
+}
+
+// ListImportedScriptKeys returns all imported script keys, ordered by the time
+// they were imported.
+//
+// NOTE: This is part of the address.ImportedKeyStore interface.
+func (i *ImportedScriptKeys) ListImportedScriptKeys(
+	ctx context.Context) ([]*address.ImportedScriptKey, error) {
+
+	return i.queryImportedKeys(ctx, nil)
+}
+
+// queryImportedKeys returns the imported script key with the given tweaked
+// key, or all imported script keys if the key is nil.
+func (i *ImportedScriptKeys) queryImportedKeys(ctx context.Context,
+	tweakedKey []byte) ([]*address.ImportedScriptKey, error) {
+
+	var keys []*address.ImportedScriptKey
+	readTx := NewImportedKeyReadTx()
+	dbErr := i.db.ExecTx(ctx, &readTx, func(q ImportedKeyStore) error {
+		rows, err := q.QueryImportedScriptKeys(ctx, tweakedKey)
+		if err != nil {
+			return err
+		}
+
+		keys, err = fn.MapErr(rows, parseImportedScriptKey)
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query imported script "+
+			"keys: %w", dbErr)
+	}
+
+	return keys, nil
+}
+
+// parseImportedScriptKey parses an imported script key from the database.
+func parseImportedScriptKey(
+	r ImportedScriptKeyRow) (*address.ImportedScriptKey, error) {
+
+	tweakedKey, err := btcec.ParsePubKey(r.TweakedScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse script key: %w", err)
+	}
+	rawKey, err := btcec.ParsePubKey(r.RawKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse internal key: %w", err)
+	}
+
+	return &address.ImportedScriptKey{
+		ScriptKey: asset.ScriptKey{
+			PubKey: tweakedKey,
+			TweakedScriptKey: &asset.TweakedScriptKey{
+				RawKey: keychain.KeyDescriptor{
+					PubKey: rawKey,
+					KeyLocator: keychain.KeyLocator{
+						Family: keychain.KeyFamily(
+							r.KeyFamily,
+						),
+						Index: uint32(r.KeyIndex),
+					},
+				},
+				Tweak: r.Tweak,
+			},
+		},
+		ImportedAt: r.ImportedAt.UTC(),
+	}, nil
+}
+
+// A compile-time assertion to ensure that ImportedScriptKeys meets the
+// address.ImportedKeyStore interface.
+var _ address.ImportedKeyStore = (*ImportedScriptKeys)(nil)
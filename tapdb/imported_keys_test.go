@@ -0,0 +1,122 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestImportedScriptKeys tests that externally derived script keys can be
+// imported, fetched by their tweaked key and listed.
+func TestImportedScriptKeys(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	importedKeyDB := NewTransactionExecutor(
+		db, func(tx *sql.Tx) ImportedKeyStore {
+			return db.WithTx(tx)
+		},
+	)
+	testClock := clock.NewTestClock(time.Unix(1_700_000_000, 0))
+	store := NewImportedScriptKeys(importedKeyDB, testClock)
+	ctx := context.Background()
+
+	keys, err := store.ListImportedScriptKeys(ctx)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	rawKey := test.RandPubKey(t)
+	tweak := test.RandBytes(32)
+	tweakedKey := txscript.ComputeTaprootOutputKey(rawKey, tweak)
+
+	_, err = store.FetchImportedScriptKey(ctx, tweakedKey)
+	require.ErrorIs(t, err, address.ErrImportedKeyNotFound)
+
+	// A script key that doesn't match its internal key and tweak is
+	// rejected.
+	err = store.ImportScriptKey(ctx, &address.ImportedScriptKey{
+		ScriptKey: asset.ScriptKey{
+			PubKey: test.RandPubKey(t),
+			TweakedScriptKey: &asset.TweakedScriptKey{
+				RawKey: keychain.KeyDescriptor{
+					PubKey: rawKey,
+				},
+				Tweak: tweak,
+			},
+		},
+	})
+	require.ErrorIs(t, err, asset.ErrScriptKeyMismatch)
+
+	// Script keys are often only known in their x-only form, so we import
+	// the tweaked key with even parity.
+	xOnlyKey, err := schnorr.ParsePubKey(
+		schnorr.SerializePubKey(tweakedKey),
+	)
+	require.NoError(t, err)
+
+	imported := &address.ImportedScriptKey{
+		ScriptKey: asset.ScriptKey{
+			PubKey: xOnlyKey,
+			TweakedScriptKey: &asset.TweakedScriptKey{
+				RawKey: keychain.KeyDescriptor{
+					PubKey: rawKey,
+					KeyLocator: keychain.KeyLocator{
+						Family: 42,
+						Index:  7,
+					},
+				},
+				Tweak: tweak,
+			},
+		},
+	}
+	require.NoError(t, store.ImportScriptKey(ctx, imported))
+
+	// The stored key has the parity of the derived key and can be fetched
+	// with either parity.
+	dbKey, err := store.FetchImportedScriptKey(ctx, xOnlyKey)
+	require.NoError(t, err)
+	require.True(t, dbKey.ScriptKey.PubKey.IsEqual(tweakedKey))
+	require.Equal(t, imported.ScriptKey.TweakedScriptKey,
+		dbKey.ScriptKey.TweakedScriptKey)
+	require.Equal(t, testClock.Now().UTC(), dbKey.ImportedAt)
+
+	_, err = store.FetchImportedScriptKey(ctx, tweakedKey)
+	require.NoError(t, err)
+
+	// Importing a key twice keeps the original import time.
+	testClock.SetTime(testClock.Now().Add(time.Hour))
+	require.NoError(t, store.ImportScriptKey(ctx, imported))
+
+	// A key without a tweak is a BIP-0086 key.
+	bip86RawKey := test.RandPubKey(t)
+	bip86 := &address.ImportedScriptKey{
+		ScriptKey: asset.ScriptKey{
+			PubKey: txscript.ComputeTaprootKeyNoScript(bip86RawKey),
+			TweakedScriptKey: &asset.TweakedScriptKey{
+				RawKey: keychain.KeyDescriptor{
+					PubKey: bip86RawKey,
+				},
+			},
+		},
+	}
+	require.NoError(t, store.ImportScriptKey(ctx, bip86))
+
+	keys, err = store.ListImportedScriptKeys(ctx)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	require.Equal(t, dbKey, keys[0])
+	require.True(
+		t, keys[1].ScriptKey.PubKey.IsEqual(bip86.ScriptKey.PubKey),
+	)
+	require.Empty(t, keys[1].ScriptKey.Tweak)
+}
@@ -0,0 +1,236 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+type (
+	// NewInvoice is used to insert a new invoice.
+	NewInvoice = sqlc.InsertInvoiceParams
+
+	// InvoiceSettlement is used to mark an invoice as settled.
+	InvoiceSettlement = sqlc.SettleInvoiceParams
+
+	// InvoiceRow is a single stored invoice.
+	InvoiceRow = sqlc.QueryInvoicesRow
+)
+
+// InvoiceStore is the set of queries needed to maintain the invoices of the
+// local node.
+type InvoiceStore interface {
+	// InsertInvoice inserts a new invoice for the address with the given
+	// taproot output key.
+	InsertInvoice(ctx context.Context, arg NewInvoice) error
+
+	// QueryInvoices returns the invoice with the given ID, or all invoices
+	// if the ID is nil, ordered by their creation time.
+	QueryInvoices(ctx context.Context, invoiceID []byte) ([]InvoiceRow,
+		error)
+
+	// SettleInvoice marks the unsettled invoice of the address with the
+	// given taproot output key as settled and returns the number of
+	// settled invoices.
+	SettleInvoice(ctx context.Context, arg InvoiceSettlement) (int64,
+		error)
+}
+
+// InvoiceTxOptions is the database tx object for the invoice store.
+type InvoiceTxOptions struct {
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (i *InvoiceTxOptions) ReadOnly() bool {
+	return i.readOnly
+}
+
+// NewInvoiceReadTx returns a new read tx for the invoice store.
+func NewInvoiceReadTx() InvoiceTxOptions {
+	return InvoiceTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedInvoiceStore allows for batched DB transactions for the invoice
+// store.
+type BatchedInvoiceStore interface {
+	InvoiceStore
+
+	BatchedTx[InvoiceStore]
+}
+
+// Invoices is a database backed implementation of the
+// tapgarden.InvoiceStore interface.
+type Invoices struct {
+	db BatchedInvoiceStore
+
+	clock clock.Clock
+}
+
+// NewInvoices creates a new invoice store backed by the given database.
+func NewInvoices(db BatchedInvoiceStore, clock clock.Clock) *Invoices {
+	return &Invoices{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// AddInvoice stores a new signed invoice for the local address with the given
+// taproot output key.
+//
+// NOTE: This is part of the tapgarden.InvoiceStore interface.
+func (i *Invoices) AddInvoice(ctx context.Context,
+	invoice *tapgarden.Invoice, taprootOutputKey *btcec.PublicKey) error {
+
+	var b bytes.Buffer
+	if err := invoice.Encode(&b); err != nil {
+		return fmt.Errorf("unable to encode invoice: %w", err)
+	}
+
+	var writeTx InvoiceTxOptions
+	dbErr := i.db.ExecTx(ctx, &writeTx, func(q InvoiceStore) error {
+		return q.InsertInvoice(ctx, NewInvoice{
+			TaprootOutputKey: schnorr.SerializePubKey(
+				taprootOutputKey,
+			),
+			InvoiceID: invoice.ID[:],
+			Invoice:   b.Bytes(),
+			CreatedAt: invoice.CreatedAt.UTC(),
+		})
+	})
+	if dbErr != nil {
+		return fmt.Errorf("unable to insert invoice: %w", dbErr)
+	}
+
+	return nil
+}
+
+// FetchInvoice returns the invoice with the given ID or
+// tapgarden.ErrInvoiceNotFound if it isn't known.
+//
+// NOTE: This is part of the tapgarden.InvoiceStore interface.
+func (i *Invoices) FetchInvoice(ctx context.Context,
+	id tapgarden.InvoiceID) (*tapgarden.StoredInvoice, error) {
+
+	invoices, err := i.queryInvoices(ctx, id[:])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(invoices) == 0 {
+		return nil, tapgarden.ErrInvoiceNotFound
+	}
+
+	return invoices[0], nil
+}
+
+// ListInvoices returns all invoices, ordered by their creation time.
+//
+// NOTE: This is part of the tapgarden.InvoiceStore interface.
+func (i *Invoices) ListInvoices(
+	ctx context.Context) ([]*tapgarden.StoredInvoice, error) {
+
+	return i.queryInvoices(ctx, nil)
+}
+
+// SettleInvoice marks the unsettled invoice of the address with the given
+// taproot output key as settled by the given outpoint. It returns false if
+// there is no such invoice.
+//
+// NOTE: This is part of the tapgarden.InvoiceStore interface.
+func (i *Invoices) SettleInvoice(ctx context.Context,
+	taprootOutputKey *btcec.PublicKey, outpoint wire.OutPoint,
+	settledAt time.Time) (bool, error) {
+
+	settleOutpoint, err := encodeOutpoint(outpoint)
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		writeTx InvoiceTxOptions
+		settled int64
+	)
+	dbErr := i.db.ExecTx(ctx, &writeTx, func(q InvoiceStore) error {
+		var err error
+		settled, err = q.SettleInvoice(ctx, InvoiceSettlement{
+			TaprootOutputKey: schnorr.SerializePubKey(
+				taprootOutputKey,
+			),
+			SettledAt:      sqlOptTime(settledAt.UTC()),
+			SettleOutpoint: settleOutpoint,
+		})
+		return err
+	})
+	if dbErr != nil {
+		return false, fmt.Errorf("unable to settle invoice: %w", dbErr)
+	}
+
+	return settled > 0, nil
+}
+
+// queryInvoices returns the invoice with the given ID, or all invoices if the
+// ID is nil.
+func (i *Invoices) queryInvoices(ctx context.Context,
+	id []byte) ([]*tapgarden.StoredInvoice, error) {
+
+	var invoices []*tapgarden.StoredInvoice
+	readTx := NewInvoiceReadTx()
+	dbErr := i.db.ExecTx(ctx, &readTx, func(q InvoiceStore) error {
+		rows, err := q.QueryInvoices(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		invoices, err = fn.MapErr(rows, parseInvoice)
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query invoices: %w", dbErr)
+	}
+
+	return invoices, nil
+}
+
+// parseInvoice parses a stored invoice from the database.
+func parseInvoice(r InvoiceRow) (*tapgarden.StoredInvoice, error) {
+	var invoice tapgarden.Invoice
+	if err := invoice.Decode(bytes.NewReader(r.Invoice)); err != nil {
+		return nil, fmt.Errorf("unable to decode invoice: %w", err)
+	}
+
+	stored := &tapgarden.StoredInvoice{
+		Invoice: &invoice,
+	}
+	if r.SettledAt.Valid {
+		stored.SettledAt = r.SettledAt.Time.UTC()
+
+		err := readOutPoint(
+			bytes.NewReader(r.SettleOutpoint), 0, 0,
+			&stored.SettleOutpoint,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read settle "+
+				"outpoint: %w", err)
+		}
+	}
+
+	return stored, nil
+}
+
+// A compile-time assertion to ensure that Invoices meets the
+// tapgarden.InvoiceStore interface.
+var _ tapgarden.InvoiceStore = (*Invoices)(nil)
@@ -0,0 +1,130 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestInvoices tests that invoices can be stored for local addresses, fetched
+// by their ID and settled exactly once.
+func TestInvoices(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	testClock := clock.NewTestClock(time.Unix(1_700_000_000, 0))
+	addrBook := NewTapAddressBook(
+		NewTransactionExecutor(db, func(tx *sql.Tx) AddrBook {
+			return db.WithTx(tx)
+		}), chainParams, testClock,
+	)
+	invoiceDB := NewTransactionExecutor(
+		db, func(tx *sql.Tx) InvoiceStore {
+			return db.WithTx(tx)
+		},
+	)
+	store := NewInvoices(invoiceDB, testClock)
+	ctx := context.Background()
+
+	invoices, err := store.ListInvoices(ctx)
+	require.NoError(t, err)
+	require.Empty(t, invoices)
+
+	// Every invoice gets its own address, so we create two of them.
+	var writeTxOpts AddrBookTxOptions
+	addrs := make([]address.AddrWithKeyInfo, 2)
+	for i := range addrs {
+		addr, assetGen, assetGroup := address.RandAddr(t, chainParams)
+		err := addrBook.db.ExecTx(
+			ctx, &writeTxOpts,
+			insertFullAssetGen(ctx, assetGen, assetGroup),
+		)
+		require.NoError(t, err)
+
+		addrs[i] = *addr
+	}
+	require.NoError(t, addrBook.InsertAddrs(ctx, addrs...))
+
+	nodeKey := test.RandPubKey(t)
+	newInvoice := func(addr address.AddrWithKeyInfo,
+		createdAt time.Time) *tapgarden.Invoice {
+
+		invoice, err := tapgarden.NewInvoice(
+			addr.Tap, "memo", time.Minute, nodeKey, createdAt,
+		)
+		require.NoError(t, err)
+		invoice.Signature = test.RandBytes(64)
+
+		require.NoError(t, store.AddInvoice(
+			ctx, invoice, &addr.TaprootOutputKey,
+		))
+
+		return invoice
+	}
+
+	now := testClock.Now()
+	invoice1 := newInvoice(addrs[0], now)
+	invoice2 := newInvoice(addrs[1], now.Add(time.Second))
+
+	// A second invoice for the same address is rejected.
+	invoice, err := tapgarden.NewInvoice(
+		addrs[0].Tap, "", 0, nodeKey, now,
+	)
+	require.NoError(t, err)
+	require.Error(t, store.AddInvoice(
+		ctx, invoice, &addrs[0].TaprootOutputKey,
+	))
+
+	invoices, err = store.ListInvoices(ctx)
+	require.NoError(t, err)
+	require.Len(t, invoices, 2)
+	require.Equal(t, invoice1, invoices[0].Invoice)
+	require.Equal(t, invoice2, invoices[1].Invoice)
+
+	stored, err := store.FetchInvoice(ctx, invoice2.ID)
+	require.NoError(t, err)
+	require.Equal(t, invoice2, stored.Invoice)
+	require.Equal(t, tapgarden.InvoiceStateOpen, stored.State(now))
+
+	_, err = store.FetchInvoice(ctx, tapgarden.InvoiceID{})
+	require.ErrorIs(t, err, tapgarden.ErrInvoiceNotFound)
+
+	// Settling the invoice of an address records the outpoint, but only
+	// the first time.
+	settlePoint := test.RandOp(t)
+	settledAt := now.Add(time.Hour)
+	settled, err := store.SettleInvoice(
+		ctx, &addrs[1].TaprootOutputKey, settlePoint, settledAt,
+	)
+	require.NoError(t, err)
+	require.True(t, settled)
+
+	settled, err = store.SettleInvoice(
+		ctx, &addrs[1].TaprootOutputKey, test.RandOp(t), settledAt,
+	)
+	require.NoError(t, err)
+	require.False(t, settled)
+
+	stored, err = store.FetchInvoice(ctx, invoice2.ID)
+	require.NoError(t, err)
+	require.Equal(t, settlePoint, stored.SettleOutpoint)
+	require.Equal(t, settledAt.Unix(), stored.SettledAt.Unix())
+	require.Equal(
+		t, tapgarden.InvoiceStateSettled, stored.State(settledAt),
+	)
+
+	// The other invoice is unaffected and expired by now.
+	stored, err = store.FetchInvoice(ctx, invoice1.ID)
+	require.NoError(t, err)
+	require.True(t, stored.SettledAt.IsZero())
+	require.Equal(
+		t, tapgarden.InvoiceStateExpired, stored.State(settledAt),
+	)
+}
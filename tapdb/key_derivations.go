@@ -0,0 +1,173 @@
+package tapdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+type (
+	// NewKeyDerivation is used to insert a new entry into the key
+	// derivation audit log.
+	NewKeyDerivation = sqlc.InsertKeyDerivationParams
+
+	// KeyDerivationQuery is used to query the key derivation audit log.
+	KeyDerivationQuery = sqlc.QueryKeyDerivationsParams
+
+	// KeyDerivationRow is a single entry of the key derivation audit log.
+	KeyDerivationRow = sqlc.QueryKeyDerivationsRow
+)
+
+// KeyDerivationStore is the set of queries needed to maintain the key
+// derivation audit log.
+type KeyDerivationStore interface {
+	// UpsertInternalKey inserts a new or updates an existing internal key
+	// into the database and returns the primary key.
+	UpsertInternalKey(ctx context.Context, arg InternalKey) (int32, error)
+
+	// InsertKeyDerivation inserts a new entry into the key derivation
+	// audit log.
+	InsertKeyDerivation(ctx context.Context, arg NewKeyDerivation) error
+
+	// QueryKeyDerivations returns all entries of the key derivation audit
+	// log that match the given query.
+	QueryKeyDerivations(ctx context.Context,
+		arg KeyDerivationQuery) ([]KeyDerivationRow, error)
+}
+
+// KeyDerivationTxOptions is the database tx object for the key derivation
+// store.
+type KeyDerivationTxOptions struct {
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (k *KeyDerivationTxOptions) ReadOnly() bool {
+	return k.readOnly
+}
+
+// NewKeyDerivationReadTx returns a new read tx for the key derivation store.
+func NewKeyDerivationReadTx() KeyDerivationTxOptions {
+	return KeyDerivationTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedKeyDerivationStore allows for batched DB transactions for the key
+// derivation store.
+type BatchedKeyDerivationStore interface {
+	KeyDerivationStore
+
+	BatchedTx[KeyDerivationStore]
+}
+
+// KeyAuditLog is a database backed implementation of the tapgarden.KeyAuditLog
+// interface.
+type KeyAuditLog struct {
+	db BatchedKeyDerivationStore
+}
+
+// NewKeyAuditLog creates a new key derivation audit log backed by the given
+// database.
+func NewKeyAuditLog(db BatchedKeyDerivationStore) *KeyAuditLog {
+	return &KeyAuditLog{
+		db: db,
+	}
+}
+
+// LogKeyDerivation adds the given derivation to the audit log.
+//
+// NOTE: This is part of the tapgarden.KeyAuditLog interface.
+func (k *KeyAuditLog) LogKeyDerivation(ctx context.Context,
+	derivation *tapgarden.KeyDerivation) error {
+
+	if derivation.Key.PubKey == nil {
+		return fmt.Errorf("derived key missing")
+	}
+
+	var writeTx KeyDerivationTxOptions
+	return k.db.ExecTx(ctx, &writeTx, func(q KeyDerivationStore) error {
+		keyID, err := q.UpsertInternalKey(ctx, InternalKey{
+			RawKey:    derivation.Key.PubKey.SerializeCompressed(),
+			KeyFamily: int32(derivation.Key.Family),
+			KeyIndex:  int32(derivation.Key.Index),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert internal key: %w",
+				err)
+		}
+
+		return q.InsertKeyDerivation(ctx, NewKeyDerivation{
+			InternalKeyID: keyID,
+			Purpose:       int16(derivation.Purpose),
+			Object:        derivation.Object,
+			DerivedAt:     derivation.DerivedAt.UTC(),
+		})
+	})
+}
+
+// QueryKeyDerivations returns all derivations in the audit log that match the
+// given filter, ordered by the time they were derived at.
+//
+// NOTE: This is part of the tapgarden.KeyAuditLog interface.
+func (k *KeyAuditLog) QueryKeyDerivations(ctx context.Context,
+	filter tapgarden.KeyDerivationFilter) ([]*tapgarden.KeyDerivation,
+	error) {
+
+	query := KeyDerivationQuery{
+		RawKey: filter.RawKey,
+	}
+	if filter.Purpose != nil {
+		query.Purpose = sqlInt16(*filter.Purpose)
+	}
+
+	var derivations []*tapgarden.KeyDerivation
+	readTx := NewKeyDerivationReadTx()
+	dbErr := k.db.ExecTx(ctx, &readTx, func(q KeyDerivationStore) error {
+		rows, err := q.QueryKeyDerivations(ctx, query)
+		if err != nil {
+			return err
+		}
+
+		derivations, err = fn.MapErr(rows, parseKeyDerivation)
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query key derivations: %w",
+			dbErr)
+	}
+
+	return derivations, nil
+}
+
+// parseKeyDerivation parses an entry of the key derivation audit log.
+func parseKeyDerivation(r KeyDerivationRow) (*tapgarden.KeyDerivation, error) {
+	rawKey, err := btcec.ParsePubKey(r.RawKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse key: %w", err)
+	}
+
+	return &tapgarden.KeyDerivation{
+		Purpose: tapgarden.KeyPurpose(r.Purpose),
+		Key: keychain.KeyDescriptor{
+			PubKey: rawKey,
+			KeyLocator: keychain.KeyLocator{
+				Family: keychain.KeyFamily(r.KeyFamily),
+				Index:  uint32(r.KeyIndex),
+			},
+		},
+		Object:    r.Object,
+		DerivedAt: r.DerivedAt.UTC(),
+	}, nil
+}
+
+// A compile-time assertion to ensure that KeyAuditLog meets the
+// tapgarden.KeyAuditLog interface.
+var _ tapgarden.KeyAuditLog = (*KeyAuditLog)(nil)
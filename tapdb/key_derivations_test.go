@@ -0,0 +1,105 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestKeyAuditLog tests that key derivations can be logged and queried by
+// purpose and raw key.
+func TestKeyAuditLog(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	keyDB := NewTransactionExecutor(
+		db, func(tx *sql.Tx) KeyDerivationStore {
+			return db.WithTx(tx)
+		},
+	)
+	auditLog := NewKeyAuditLog(keyDB)
+	ctx := context.Background()
+
+	// The log starts out empty.
+	derivations, err := auditLog.QueryKeyDerivations(
+		ctx, tapgarden.KeyDerivationFilter{},
+	)
+	require.NoError(t, err)
+	require.Empty(t, derivations)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	newDerivation := func(purpose tapgarden.KeyPurpose, index uint32,
+		object string) *tapgarden.KeyDerivation {
+
+		return &tapgarden.KeyDerivation{
+			Purpose: purpose,
+			Key: keychain.KeyDescriptor{
+				PubKey: test.RandPubKey(t),
+				KeyLocator: keychain.KeyLocator{
+					Family: asset.TaprootAssetsKeyFamily,
+					Index:  index,
+				},
+			},
+			Object:    object,
+			DerivedAt: now.Add(time.Duration(index) * time.Second),
+		}
+	}
+
+	batchKey := newDerivation(
+		tapgarden.KeyPurposeBatchInternalKey, 1, "minting batch",
+	)
+	scriptKey := newDerivation(
+		tapgarden.KeyPurposeMintScriptKey, 2, "asset foo",
+	)
+	changeKey := newDerivation(
+		tapgarden.KeyPurposeChangeScriptKey, 3, "asset bar",
+	)
+	for _, d := range []*tapgarden.KeyDerivation{
+		changeKey, batchKey, scriptKey,
+	} {
+		require.NoError(t, auditLog.LogKeyDerivation(ctx, d))
+	}
+
+	// Without a filter, all derivations are returned in the order they
+	// were derived in.
+	derivations, err = auditLog.QueryKeyDerivations(
+		ctx, tapgarden.KeyDerivationFilter{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*tapgarden.KeyDerivation{
+		batchKey, scriptKey, changeKey,
+	}, derivations)
+
+	// We can filter by purpose.
+	purpose := tapgarden.KeyPurposeMintScriptKey
+	derivations, err = auditLog.QueryKeyDerivations(
+		ctx, tapgarden.KeyDerivationFilter{
+			Purpose: &purpose,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*tapgarden.KeyDerivation{scriptKey}, derivations)
+
+	// And by raw key. A key that is logged twice, for example because it
+	// was reused, shows up twice.
+	reused := *changeKey
+	reused.Object = "asset baz"
+	require.NoError(t, auditLog.LogKeyDerivation(ctx, &reused))
+
+	derivations, err = auditLog.QueryKeyDerivations(
+		ctx, tapgarden.KeyDerivationFilter{
+			RawKey: changeKey.Key.PubKey.SerializeCompressed(),
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*tapgarden.KeyDerivation{
+		changeKey, &reused,
+	}, derivations)
+}
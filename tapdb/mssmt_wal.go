@@ -0,0 +1,557 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+const (
+	// treeWalPending is the state of a logged batch that hasn't been fully
+	// applied yet.
+	treeWalPending int16 = 0
+
+	// treeWalApplied is the state of a batch that was fully applied.
+	treeWalApplied int16 = 1
+
+	// treeWalRolledBack is the state of a batch that failed to apply and
+	// was rolled back.
+	treeWalRolledBack int16 = 2
+
+	// treeWalChunkSize is the maximum number of batch entries that are
+	// applied within a single database transaction.
+	treeWalChunkSize = 100
+)
+
+var (
+	// ErrTreeWalPending is returned when a new batch is applied to a tree
+	// that still has a pending batch, which must be recovered first.
+	ErrTreeWalPending = errors.New("tree has a pending batch")
+
+	// ErrTreeBatchRolledBack is returned when a batch failed to apply and
+	// was rolled back.
+	ErrTreeBatchRolledBack = errors.New("tree batch rolled back")
+
+	// ErrTreeRootMismatch is returned when the stored root of a tree
+	// doesn't match the root recorded in its write-ahead log.
+	ErrTreeRootMismatch = errors.New("tree root doesn't match " +
+		"write-ahead log")
+)
+
+type (
+	// NewTreeWalBatch is a type alias for the params to log a new batch.
+	NewTreeWalBatch = sqlc.InsertTreeWalBatchParams
+
+	// NewTreeWalEntry is a type alias for the params to log a new batch
+	// entry.
+	NewTreeWalEntry = sqlc.InsertTreeWalEntryParams
+
+	// TreeWalBatch is a type alias for a logged batch.
+	TreeWalBatch = sqlc.MssmtWalBatch
+
+	// TreeWalEntry is a type alias for a logged batch entry.
+	TreeWalEntry = sqlc.MssmtWalEntry
+
+	// FinishedTreeWalBatch is a type alias for the params to mark a batch
+	// as applied or rolled back.
+	FinishedTreeWalBatch = sqlc.FinishTreeWalBatchParams
+
+	// TreeWalPrune is a type alias for the params to delete old batches.
+	TreeWalPrune = sqlc.DeleteTreeWalBatchesParams
+)
+
+// TreeWalStore is a sub-set of the main sqlc.Querier interface that contains
+// the methods needed to manipulate stored MSSMT trees through a write-ahead
+// log.
+type TreeWalStore interface {
+	TreeStore
+
+	// InsertTreeWalBatch logs a new batch and returns its ID.
+	InsertTreeWalBatch(ctx context.Context, arg NewTreeWalBatch) (int32,
+		error)
+
+	// InsertTreeWalEntry logs a new entry of a batch.
+	InsertTreeWalEntry(ctx context.Context, arg NewTreeWalEntry) error
+
+	// FetchLatestTreeWalBatch fetches the batch with the highest
+	// generation of the given namespace.
+	FetchLatestTreeWalBatch(ctx context.Context,
+		namespace string) (TreeWalBatch, error)
+
+	// FetchTreeWalEntries fetches all entries of a batch, in order.
+	FetchTreeWalEntries(ctx context.Context,
+		batchID int32) ([]TreeWalEntry, error)
+
+	// FetchTreeWalNamespaces fetches all namespaces that have logged
+	// batches.
+	FetchTreeWalNamespaces(ctx context.Context) ([]string, error)
+
+	// FinishTreeWalBatch marks a batch as applied or rolled back.
+	FinishTreeWalBatch(ctx context.Context, arg FinishedTreeWalBatch) error
+
+	// DeleteTreeWalBatches deletes all batches of a namespace below the
+	// given generation.
+	DeleteTreeWalBatches(ctx context.Context, arg TreeWalPrune) error
+}
+
+// BatchedTreeWalStore is a version of the TreeWalStore that's capable of
+// batched database operations.
+type BatchedTreeWalStore interface {
+	TreeWalStore
+
+	BatchedTx[TreeWalStore]
+}
+
+// TreeBatchEntry is a single leaf update within a batch.
+type TreeBatchEntry struct {
+	// Key is the key of the leaf.
+	Key [32]byte
+
+	// Leaf is the new leaf. An empty leaf deletes the leaf at the key.
+	Leaf *mssmt.LeafNode
+}
+
+// GenerationalTreeStore is a persistent MS-SMT store that applies batches of
+// leaf updates through a write-ahead log. Each batch creates a new generation
+// of the tree. A batch is logged, together with the leaves it replaces, before
+// it is applied in several smaller database transactions, so a batch that was
+// interrupted by a crash can be replayed, or rolled back if it can't be
+// applied, on startup.
+//
+// NOTE: Batches are applied to a CompactedTree, so the namespace must not be
+// used with a FullTree.
+type GenerationalTreeStore struct {
+	db        BatchedTreeWalStore
+	namespace string
+	clock     clock.Clock
+}
+
+// NewGenerationalTreeStore creates a new GenerationalTreeStore for the tree of
+// the given namespace.
+func NewGenerationalTreeStore(db BatchedTreeWalStore, namespace string,
+	clock clock.Clock) *GenerationalTreeStore {
+
+	return &GenerationalTreeStore{
+		db:        db,
+		namespace: namespace,
+		clock:     clock,
+	}
+}
+
+var _ mssmt.TreeStore = (*GenerationalTreeStore)(nil)
+
+// Update updates the persistent tree in the passed-in update closure using the
+// update transaction.
+//
+// NOTE: Updates made this way bypass the write-ahead log and are only
+// consistent with it if they are made while no batch is pending.
+func (g *GenerationalTreeStore) Update(ctx context.Context,
+	update func(tx mssmt.TreeStoreUpdateTx) error) error {
+
+	var writeTxOpts TreeStoreTxOptions
+	return g.db.ExecTx(ctx, &writeTxOpts, func(dbTx TreeWalStore) error {
+		return newTreeStoreWrapperTx(dbTx, g.namespace).Update(
+			ctx, update,
+		)
+	})
+}
+
+// View gives a view of the persistent tree in the passed view closure using
+// the view transaction.
+func (g *GenerationalTreeStore) View(ctx context.Context,
+	view func(tx mssmt.TreeStoreViewTx) error) error {
+
+	readTxOpts := NewTreeStoreReadTx()
+	return g.db.ExecTx(ctx, &readTxOpts, func(dbTx TreeWalStore) error {
+		return newTreeStoreWrapperTx(dbTx, g.namespace).View(
+			ctx, view,
+		)
+	})
+}
+
+// Generation returns the generation of the tree, which is the number of
+// batches that were logged for it.
+func (g *GenerationalTreeStore) Generation(ctx context.Context) (uint64,
+	error) {
+
+	latest, err := g.fetchLatestBatch(ctx)
+	switch {
+	case err != nil:
+		return 0, fmt.Errorf("unable to fetch tree generation: %w", err)
+
+	case latest == nil:
+		return 0, nil
+	}
+
+	return uint64(latest.Generation), nil
+}
+
+// ApplyBatch logs the given batch of leaf updates and then applies it to the
+// tree. The new generation of the tree is returned. If the batch can't be
+// applied, it is rolled back and ErrTreeBatchRolledBack is returned.
+func (g *GenerationalTreeStore) ApplyBatch(ctx context.Context,
+	entries []TreeBatchEntry) (uint64, error) {
+
+	batch, err := g.logBatch(ctx, entries)
+	if err != nil {
+		return 0, fmt.Errorf("unable to log tree batch: %w", err)
+	}
+
+	if err := g.applyBatch(ctx, batch); err != nil {
+		return 0, err
+	}
+
+	return uint64(batch.Generation), nil
+}
+
+// Recover replays a batch that was interrupted before it was fully applied,
+// or rolls it back if it can't be applied. It then verifies that the stored
+// root of the tree matches the root recorded in the write-ahead log. This
+// should be called on startup, before any new batches are applied.
+func (g *GenerationalTreeStore) Recover(ctx context.Context) error {
+	latest, err := g.fetchLatestBatch(ctx)
+	switch {
+	case err != nil:
+		return fmt.Errorf("unable to fetch latest tree batch: %w", err)
+
+	case latest == nil:
+		return nil
+	}
+
+	if latest.State == treeWalPending {
+		log.Infof("Replaying interrupted batch of generation %d of "+
+			"tree %v", latest.Generation, g.namespace)
+
+		err := g.applyBatch(ctx, *latest)
+		switch {
+		case errors.Is(err, ErrTreeBatchRolledBack):
+			log.Warnf("Interrupted batch of tree %v was rolled "+
+				"back: %v", g.namespace, err)
+
+		case err != nil:
+			return err
+		}
+
+		latest, err = g.fetchLatestBatch(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to fetch latest tree batch: "+
+				"%w", err)
+		}
+	}
+
+	root, err := mssmt.NewCompactedTree(g).Root(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch tree root: %w", err)
+	}
+
+	rootHash := root.NodeHash()
+	if !bytes.Equal(rootHash[:], latest.RootHash) ||
+		root.NodeSum() != uint64(latest.RootSum.Int64) {
+
+		return fmt.Errorf("%w: tree %v has root %v, expected %x",
+			ErrTreeRootMismatch, g.namespace, rootHash,
+			latest.RootHash)
+	}
+
+	return nil
+}
+
+// fetchLatestBatch returns the batch with the highest generation of the tree,
+// or nil if no batch was logged yet.
+func (g *GenerationalTreeStore) fetchLatestBatch(
+	ctx context.Context) (*TreeWalBatch, error) {
+
+	var latest *TreeWalBatch
+	readTxOpts := NewTreeStoreReadTx()
+	dbErr := g.db.ExecTx(ctx, &readTxOpts, func(q TreeWalStore) error {
+		batch, err := q.FetchLatestTreeWalBatch(ctx, g.namespace)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		latest = &batch
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return latest, nil
+}
+
+// logBatch logs the given batch, along with the leaves it replaces, as the
+// next generation of the tree.
+func (g *GenerationalTreeStore) logBatch(ctx context.Context,
+	entries []TreeBatchEntry) (TreeWalBatch, error) {
+
+	var batch TreeWalBatch
+	var writeTxOpts TreeStoreTxOptions
+	dbErr := g.db.ExecTx(ctx, &writeTxOpts, func(q TreeWalStore) error {
+		var generation int64
+		latest, err := q.FetchLatestTreeWalBatch(ctx, g.namespace)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+
+		case err != nil:
+			return err
+
+		case latest.State == treeWalPending:
+			return ErrTreeWalPending
+
+		default:
+			generation = latest.Generation
+		}
+
+		tree := mssmt.NewCompactedTree(
+			newTreeStoreWrapperTx(q, g.namespace),
+		)
+		root, err := tree.Root(ctx)
+		if err != nil {
+			return err
+		}
+		rootHash := root.NodeHash()
+
+		batch = TreeWalBatch{
+			Namespace:    g.namespace,
+			Generation:   generation + 1,
+			PrevRootHash: rootHash[:],
+			PrevRootSum:  int64(root.NodeSum()),
+			State:        treeWalPending,
+			CreatedAt:    g.clock.Now().UTC(),
+		}
+		batch.BatchID, err = q.InsertTreeWalBatch(ctx, NewTreeWalBatch{
+			Namespace:    batch.Namespace,
+			Generation:   batch.Generation,
+			PrevRootHash: batch.PrevRootHash,
+			PrevRootSum:  batch.PrevRootSum,
+			State:        batch.State,
+			CreatedAt:    batch.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		for idx, entry := range entries {
+			key := entry.Key
+			prevLeaf, err := tree.Get(ctx, key)
+			if err != nil {
+				return err
+			}
+
+			err = q.InsertTreeWalEntry(ctx, NewTreeWalEntry{
+				BatchID:    batch.BatchID,
+				EntryIndex: int32(idx),
+				LeafKey:    key[:],
+				Value:      entry.Leaf.Value,
+				Sum:        int64(entry.Leaf.NodeSum()),
+				PrevValue:  prevLeaf.Value,
+				PrevSum:    int64(prevLeaf.NodeSum()),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return TreeWalBatch{}, dbErr
+	}
+
+	return batch, nil
+}
+
+// applyBatch applies the entries of the given logged batch to the tree and
+// marks the batch as applied. If the batch can't be applied, it is rolled back
+// by restoring the leaves it replaced.
+func (g *GenerationalTreeStore) applyBatch(ctx context.Context,
+	batch TreeWalBatch) error {
+
+	var entries []TreeWalEntry
+	readTxOpts := NewTreeStoreReadTx()
+	dbErr := g.db.ExecTx(ctx, &readTxOpts, func(q TreeWalStore) error {
+		var err error
+		entries, err = q.FetchTreeWalEntries(ctx, batch.BatchID)
+		return err
+	})
+	if dbErr != nil {
+		return fmt.Errorf("unable to fetch tree batch entries: %w",
+			dbErr)
+	}
+
+	// Applying the entries is idempotent, so it doesn't matter whether
+	// some of them were already applied before we were interrupted.
+	state := treeWalApplied
+	applyErr := g.setLeaves(ctx, entries, func(e TreeWalEntry) (
+		[]byte, int64) {
+
+		return e.Value, e.Sum
+	})
+	if applyErr != nil {
+		// To roll back, we restore the previous leaves in reverse
+		// order, so the leaf that was stored before the batch wins if
+		// the batch contains the same key multiple times.
+		reversed := make([]TreeWalEntry, len(entries))
+		for idx := range entries {
+			reversed[len(entries)-1-idx] = entries[idx]
+		}
+
+		err := g.setLeaves(ctx, reversed, func(e TreeWalEntry) (
+			[]byte, int64) {
+
+			return e.PrevValue, e.PrevSum
+		})
+		if err != nil {
+			return fmt.Errorf("unable to roll back tree batch "+
+				"(apply error: %v): %w", applyErr, err)
+		}
+
+		state = treeWalRolledBack
+	}
+
+	root, err := mssmt.NewCompactedTree(g).Root(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch tree root: %w", err)
+	}
+	rootHash := root.NodeHash()
+
+	if state == treeWalRolledBack &&
+		!bytes.Equal(rootHash[:], batch.PrevRootHash) {
+
+		return fmt.Errorf("%w: rolled back tree %v has root %v, "+
+			"expected %x", ErrTreeRootMismatch, g.namespace,
+			rootHash, batch.PrevRootHash)
+	}
+
+	var writeTxOpts TreeStoreTxOptions
+	dbErr = g.db.ExecTx(ctx, &writeTxOpts, func(q TreeWalStore) error {
+		err := q.FinishTreeWalBatch(ctx, FinishedTreeWalBatch{
+			BatchID:  batch.BatchID,
+			State:    state,
+			RootHash: rootHash[:],
+			RootSum: sql.NullInt64{
+				Int64: int64(root.NodeSum()),
+				Valid: true,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		// Only the latest generation is needed to verify the tree, so
+		// we prune all older ones.
+		return q.DeleteTreeWalBatches(ctx, TreeWalPrune{
+			Namespace:        g.namespace,
+			BeforeGeneration: batch.Generation,
+		})
+	})
+	if dbErr != nil {
+		return fmt.Errorf("unable to finish tree batch: %w", dbErr)
+	}
+
+	if applyErr != nil {
+		return fmt.Errorf("%w: %v", ErrTreeBatchRolledBack, applyErr)
+	}
+
+	return nil
+}
+
+// setLeaves stores the leaves returned by the given closure for the keys of
+// the given entries, in chunks of at most treeWalChunkSize entries per database
+// transaction.
+func (g *GenerationalTreeStore) setLeaves(ctx context.Context,
+	entries []TreeWalEntry,
+	leafFn func(TreeWalEntry) ([]byte, int64)) error {
+
+	for start := 0; start < len(entries); start += treeWalChunkSize {
+		end := start + treeWalChunkSize
+		if end > len(entries) {
+			end = len(entries)
+		}
+
+		txBody := func(q TreeWalStore) error {
+			tree := mssmt.NewCompactedTree(
+				newTreeStoreWrapperTx(q, g.namespace),
+			)
+
+			for _, entry := range entries[start:end] {
+				key, err := newKey(entry.LeafKey)
+				if err != nil {
+					return err
+				}
+
+				value, sum := leafFn(entry)
+				leaf := mssmt.NewLeafNode(value, uint64(sum))
+				if !leaf.IsEmpty() {
+					_, err = tree.Insert(ctx, key, leaf)
+					if err != nil {
+						return err
+					}
+
+					continue
+				}
+
+				// We only delete leaves that exist, so an
+				// entry can be applied more than once.
+				existing, err := tree.Get(ctx, key)
+				if err != nil {
+					return err
+				}
+				if existing.IsEmpty() {
+					continue
+				}
+
+				if _, err := tree.Delete(ctx, key); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+
+		var writeTxOpts TreeStoreTxOptions
+		if err := g.db.ExecTx(ctx, &writeTxOpts, txBody); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RecoverTreeWal recovers the trees of all namespaces that have batches in the
+// write-ahead log, replaying or rolling back any interrupted batch and
+// verifying their stored roots.
+func RecoverTreeWal(ctx context.Context, db BatchedTreeWalStore,
+	clock clock.Clock) error {
+
+	var namespaces []string
+	readTxOpts := NewTreeStoreReadTx()
+	dbErr := db.ExecTx(ctx, &readTxOpts, func(q TreeWalStore) error {
+		var err error
+		namespaces, err = q.FetchTreeWalNamespaces(ctx)
+		return err
+	})
+	if dbErr != nil {
+		return fmt.Errorf("unable to fetch tree namespaces: %w", dbErr)
+	}
+
+	for _, namespace := range namespaces {
+		store := NewGenerationalTreeStore(db, namespace, clock)
+		if err := store.Recover(ctx); err != nil {
+			return fmt.Errorf("unable to recover tree %v: %w",
+				namespace, err)
+		}
+	}
+
+	return nil
+}
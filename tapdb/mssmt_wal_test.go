@@ -0,0 +1,201 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// newGenerationalTreeStore makes a new instance of the GenerationalTreeStore
+// backed by sqlite by default.
+func newGenerationalTreeStore(t *testing.T,
+	namespace string) (*GenerationalTreeStore, BatchedTreeWalStore) {
+
+	db := NewTestDB(t)
+
+	txCreator := func(tx *sql.Tx) TreeWalStore {
+		return db.WithTx(tx)
+	}
+
+	walDB := NewTransactionExecutor(db, txCreator)
+
+	return NewGenerationalTreeStore(
+		walDB, namespace, clock.NewDefaultClock(),
+	), walDB
+}
+
+// randTreeBatch creates a batch of random leaves.
+func randTreeBatch(t *testing.T, numLeaves int) []TreeBatchEntry {
+	entries := make([]TreeBatchEntry, numLeaves)
+	for idx := range entries {
+		entries[idx] = TreeBatchEntry{
+			Key: test.RandHash(),
+			Leaf: mssmt.NewLeafNode(
+				test.RandBytes(32), uint64(idx+1),
+			),
+		}
+	}
+
+	return entries
+}
+
+// assertTreeMatches asserts that the tree of the store has the same root as an
+// in-memory tree with the given entries applied.
+func assertTreeMatches(t *testing.T, store *GenerationalTreeStore,
+	batches ...[]TreeBatchEntry) {
+
+	t.Helper()
+
+	ctx := context.Background()
+	expected := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	for _, batch := range batches {
+		for _, entry := range batch {
+			var err error
+			if entry.Leaf.IsEmpty() {
+				_, err = expected.Delete(ctx, entry.Key)
+			} else {
+				_, err = expected.Insert(
+					ctx, entry.Key, entry.Leaf,
+				)
+			}
+			require.NoError(t, err)
+		}
+	}
+
+	expectedRoot, err := expected.Root(ctx)
+	require.NoError(t, err)
+
+	root, err := mssmt.NewCompactedTree(store).Root(ctx)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(expectedRoot, root))
+}
+
+// TestGenerationalTreeStoreBatches tests that batches are applied as new
+// generations of the tree, and that failing batches are rolled back.
+func TestGenerationalTreeStoreBatches(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store, _ := newGenerationalTreeStore(t, "wal")
+
+	generation, err := store.Generation(ctx)
+	require.NoError(t, err)
+	require.Zero(t, generation)
+
+	// The first batch spans several database transactions.
+	batch1 := randTreeBatch(t, treeWalChunkSize*2+10)
+	generation, err = store.ApplyBatch(ctx, batch1)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, generation)
+	assertTreeMatches(t, store, batch1)
+
+	// The second batch updates and deletes some of the leaves, and
+	// deletes a leaf that doesn't exist.
+	batch2 := []TreeBatchEntry{{
+		Key:  batch1[0].Key,
+		Leaf: mssmt.NewLeafNode([]byte{1}, 1000),
+	}, {
+		Key:  batch1[1].Key,
+		Leaf: mssmt.EmptyLeafNode,
+	}, {
+		Key:  test.RandHash(),
+		Leaf: mssmt.EmptyLeafNode,
+	}}
+	generation, err = store.ApplyBatch(ctx, batch2)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, generation)
+	assertTreeMatches(t, store, batch1, batch2)
+
+	// A batch that overflows the root sum is rolled back, even though
+	// its first entries were already applied.
+	batch3 := []TreeBatchEntry{{
+		Key:  batch1[2].Key,
+		Leaf: mssmt.NewLeafNode([]byte{2}, 1),
+	}, {
+		Key:  test.RandHash(),
+		Leaf: mssmt.NewLeafNode([]byte{3}, math.MaxUint64),
+	}}
+	_, err = store.ApplyBatch(ctx, batch3)
+	require.ErrorIs(t, err, ErrTreeBatchRolledBack)
+	assertTreeMatches(t, store, batch1, batch2)
+
+	generation, err = store.Generation(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, generation)
+
+	// The tree is consistent with the log.
+	require.NoError(t, store.Recover(ctx))
+
+	// Finally, updating the tree behind the back of the log is detected.
+	_, err = mssmt.NewCompactedTree(store).Insert(
+		ctx, test.RandHash(), mssmt.NewLeafNode([]byte{4}, 4),
+	)
+	require.NoError(t, err)
+	require.ErrorIs(t, store.Recover(ctx), ErrTreeRootMismatch)
+}
+
+// TestGenerationalTreeStoreRecover tests that batches that were interrupted
+// while being applied are replayed or rolled back on recovery.
+func TestGenerationalTreeStoreRecover(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store, walDB := newGenerationalTreeStore(t, "wal")
+
+	batch1 := randTreeBatch(t, 10)
+	_, err := store.ApplyBatch(ctx, batch1)
+	require.NoError(t, err)
+
+	// interruptBatch logs the given batch and only applies its first
+	// entry, as if we crashed while applying it.
+	interruptBatch := func(entries []TreeBatchEntry) {
+		batch, err := store.logBatch(ctx, entries)
+		require.NoError(t, err)
+
+		entry := entries[0]
+		_, err = mssmt.NewCompactedTree(store).Insert(
+			ctx, entry.Key, entry.Leaf,
+		)
+		require.NoError(t, err)
+
+		// No other batch can be applied while one is pending.
+		_, err = store.ApplyBatch(ctx, randTreeBatch(t, 1))
+		require.ErrorIs(t, err, ErrTreeWalPending)
+
+		generation, err := store.Generation(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, batch.Generation, generation)
+	}
+
+	// An interrupted batch is replayed on recovery.
+	batch2 := randTreeBatch(t, treeWalChunkSize+1)
+	interruptBatch(batch2)
+	require.NoError(t, RecoverTreeWal(ctx, walDB, clock.NewDefaultClock()))
+	assertTreeMatches(t, store, batch1, batch2)
+
+	// An interrupted batch that can't be applied is rolled back on
+	// recovery.
+	batch3 := []TreeBatchEntry{{
+		Key:  batch1[0].Key,
+		Leaf: mssmt.NewLeafNode([]byte{1}, 1),
+	}, {
+		Key:  test.RandHash(),
+		Leaf: mssmt.NewLeafNode([]byte{2}, math.MaxUint64),
+	}}
+	interruptBatch(batch3)
+	require.NoError(t, RecoverTreeWal(ctx, walDB, clock.NewDefaultClock()))
+	assertTreeMatches(t, store, batch1, batch2)
+
+	// New batches can be applied after recovery.
+	batch4 := randTreeBatch(t, 5)
+	generation, err := store.ApplyBatch(ctx, batch4)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, generation)
+	assertTreeMatches(t, store, batch1, batch2, batch4)
+}
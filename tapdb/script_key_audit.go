@@ -0,0 +1,311 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+)
+
+// scriptKeyOutpoint is a script key along with one of the anchor outputs it
+// was found in.
+type scriptKeyOutpoint struct {
+	scriptKey []byte
+	outpoint  []byte
+}
+
+// groupScriptKeyReuse groups the given rows, which must be ordered by script
+// key, into one reuse per script key. Tombstones all share the NUMS key, so
+// their reuse is expected and skipped.
+func groupScriptKeyReuse(rows []scriptKeyOutpoint,
+	conflict tapfreighter.ScriptKeyConflict) ([]tapfreighter.ScriptKeyReuse,
+	error) {
+
+	numsKey := asset.NUMSPubKey.SerializeCompressed()
+
+	var (
+		reuses  []tapfreighter.ScriptKeyReuse
+		lastKey []byte
+	)
+	for _, row := range rows {
+		if bytes.Equal(row.scriptKey, numsKey) {
+			continue
+		}
+
+		var anchorPoint wire.OutPoint
+		err := readOutPoint(
+			bytes.NewReader(row.outpoint), 0, 0, &anchorPoint,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode outpoint: %w",
+				err)
+		}
+
+		if len(reuses) > 0 && bytes.Equal(lastKey, row.scriptKey) {
+			last := &reuses[len(reuses)-1]
+			last.AnchorPoints = append(
+				last.AnchorPoints, anchorPoint,
+			)
+			continue
+		}
+
+		scriptKey, err := btcec.ParsePubKey(row.scriptKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse script key: %w",
+				err)
+		}
+
+		lastKey = row.scriptKey
+		reuses = append(reuses, tapfreighter.ScriptKeyReuse{
+			ScriptKey:    scriptKey,
+			Conflict:     conflict,
+			AnchorPoints: []wire.OutPoint{anchorPoint},
+		})
+	}
+
+	return reuses, nil
+}
+
+// ListScriptKeyReuse returns all script keys that are used by more than one
+// unspent asset or by the outputs of more than one transfer.
+//
+// NOTE: This is part of the tapfreighter.ScriptKeyAuditLog interface.
+func (a *AssetStore) ListScriptKeyReuse(
+	ctx context.Context) ([]tapfreighter.ScriptKeyReuse, error) {
+
+	var (
+		readOpts     = NewAssetStoreReadTx()
+		unspentRows  []scriptKeyOutpoint
+		transferRows []scriptKeyOutpoint
+	)
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		unspent, err := q.QueryDuplicateUnspentScriptKeys(ctx)
+		if err != nil {
+			return err
+		}
+		for _, row := range unspent {
+			unspentRows = append(unspentRows, scriptKeyOutpoint{
+				scriptKey: row.TweakedScriptKey,
+				outpoint:  row.Outpoint,
+			})
+		}
+
+		transfers, err := q.QueryDuplicateTransferScriptKeys(ctx)
+		if err != nil {
+			return err
+		}
+		for _, row := range transfers {
+			transferRows = append(transferRows, scriptKeyOutpoint{
+				scriptKey: row.TweakedScriptKey,
+				outpoint:  row.Outpoint,
+			})
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query reused script keys: %w",
+			dbErr)
+	}
+
+	reuses, err := groupScriptKeyReuse(
+		unspentRows, tapfreighter.ScriptKeyConflictUnspent,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	transferReuses, err := groupScriptKeyReuse(
+		transferRows, tapfreighter.ScriptKeyConflictTransfers,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(reuses, transferReuses...), nil
+}
+
+// FlagScriptKeys flags the given reused script keys and returns the flags
+// that were opened. A resolved flag is only reopened if the script key was
+// found in more anchor outputs than when it was resolved.
+//
+// NOTE: This is part of the tapfreighter.ScriptKeyAuditLog interface.
+func (a *AssetStore) FlagScriptKeys(ctx context.Context,
+	reuses ...tapfreighter.ScriptKeyReuse) ([]tapfreighter.FlaggedScriptKey,
+	error) {
+
+	var (
+		writeTxOpts AssetStoreTxOptions
+		now         = a.clock.Now().UTC()
+		opened      []tapfreighter.FlaggedScriptKey
+	)
+	dbErr := a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		opened = nil
+		for _, reuse := range reuses {
+			scriptKey := reuse.ScriptKey.SerializeCompressed()
+			rows, err := q.QueryFlaggedScriptKeys(ctx, scriptKey)
+			if err != nil {
+				return err
+			}
+
+			var existing *FlaggedScriptKeyRow
+			for idx := range rows {
+				conflict := tapfreighter.ScriptKeyConflict(
+					rows[idx].Conflict,
+				)
+				if conflict == reuse.Conflict {
+					existing = &rows[idx]
+				}
+			}
+
+			flag := tapfreighter.FlaggedScriptKey{
+				ScriptKey:      reuse.ScriptKey,
+				Conflict:       reuse.Conflict,
+				NumOccurrences: len(reuse.AnchorPoints),
+				FlaggedAt:      now,
+			}
+
+			numKnown := 0
+			if existing != nil {
+				numKnown = int(existing.NumOccurrences)
+			}
+
+			switch {
+			// A new flag is opened.
+			case existing == nil:
+
+			// A resolved flag is only reopened if the reuse grew.
+			case existing.ResolvedAt.Valid:
+				if flag.NumOccurrences <= numKnown {
+					continue
+				}
+
+			// An open flag keeps the time it was opened at, only
+			// the number of occurrences is updated.
+			default:
+				if flag.NumOccurrences == numKnown {
+					continue
+				}
+				flag.FlaggedAt = existing.FlaggedAt.UTC()
+			}
+
+			err = q.UpsertFlaggedScriptKey(ctx, NewFlaggedScriptKey{
+				TweakedScriptKey: scriptKey,
+				Conflict:         int16(flag.Conflict),
+				NumOccurrences:   int32(flag.NumOccurrences),
+				FlaggedAt:        flag.FlaggedAt,
+			})
+			if err != nil {
+				return err
+			}
+
+			if existing == nil || existing.ResolvedAt.Valid {
+				opened = append(opened, flag)
+			}
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to flag script keys: %w", dbErr)
+	}
+
+	return opened, nil
+}
+
+// ListFlaggedScriptKeys returns all flags of the given script key, or all
+// flags if the script key is nil.
+//
+// NOTE: This is part of the tapfreighter.ScriptKeyAuditLog interface.
+func (a *AssetStore) ListFlaggedScriptKeys(ctx context.Context,
+	scriptKey *btcec.PublicKey) ([]tapfreighter.FlaggedScriptKey, error) {
+
+	var keyFilter []byte
+	if scriptKey != nil {
+		keyFilter = scriptKey.SerializeCompressed()
+	}
+
+	var (
+		readOpts = NewAssetStoreReadTx()
+		rows     []FlaggedScriptKeyRow
+	)
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		var err error
+		rows, err = q.QueryFlaggedScriptKeys(ctx, keyFilter)
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query flagged script keys: "+
+			"%w", dbErr)
+	}
+
+	flags := make([]tapfreighter.FlaggedScriptKey, len(rows))
+	for idx, row := range rows {
+		flaggedKey, err := btcec.ParsePubKey(row.TweakedScriptKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse script key: %w",
+				err)
+		}
+
+		flags[idx] = tapfreighter.FlaggedScriptKey{
+			ScriptKey: flaggedKey,
+			Conflict: tapfreighter.ScriptKeyConflict(
+				row.Conflict,
+			),
+			NumOccurrences: int(row.NumOccurrences),
+			FlaggedAt:      row.FlaggedAt.UTC(),
+		}
+		if row.ResolvedAt.Valid {
+			flags[idx].ResolvedAt = row.ResolvedAt.Time.UTC()
+		}
+	}
+
+	return flags, nil
+}
+
+// ResolveFlaggedScriptKey resolves all open flags of the given script key. If
+// there are none, tapfreighter.ErrScriptKeyNotFlagged is returned.
+//
+// NOTE: This is part of the tapfreighter.ScriptKeyAuditLog interface.
+func (a *AssetStore) ResolveFlaggedScriptKey(ctx context.Context,
+	scriptKey *btcec.PublicKey) error {
+
+	var (
+		writeTxOpts AssetStoreTxOptions
+		rawKey      = scriptKey.SerializeCompressed()
+		resolvedAt  = a.clock.Now().UTC()
+		numResolved int64
+	)
+	dbErr := a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		var err error
+		numResolved, err = q.ResolveFlaggedScriptKey(
+			ctx, FlaggedScriptKeyResolution{
+				TweakedScriptKey: rawKey,
+				ResolvedAt: sql.NullTime{
+					Time:  resolvedAt,
+					Valid: true,
+				},
+			},
+		)
+		return err
+	})
+	if dbErr != nil {
+		return fmt.Errorf("unable to resolve flagged script key: %w",
+			dbErr)
+	}
+
+	if numResolved == 0 {
+		return tapfreighter.ErrScriptKeyNotFlagged
+	}
+
+	return nil
+}
+
+// A compile-time assertion to make sure AssetStore satisfies the
+// tapfreighter.ScriptKeyAuditLog interface.
+var _ tapfreighter.ScriptKeyAuditLog = (*AssetStore)(nil)
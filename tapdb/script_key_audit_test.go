@@ -0,0 +1,118 @@
+package tapdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/stretchr/testify/require"
+)
+
+// TestScriptKeyAudit tests that script keys used by more than one unspent
+// asset are found, and that their flags are only reopened after they were
+// resolved if the reuse grew.
+func TestScriptKeyAudit(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	// The reused script key is used by two unspent assets and a spent
+	// one, which doesn't count. Tombstones all share the NUMS key, which
+	// isn't reported either.
+	reusedKey := asset.NewScriptKey(test.RandPubKey(t))
+	singleKey := asset.NewScriptKey(test.RandPubKey(t))
+	assetGen := newAssetGenerator(t, 4, 0)
+	assetGen.genAssets(t, assetsStore, []assetDesc{{
+		assetGen:    assetGen.assetGens[0],
+		anchorPoint: assetGen.anchorPoints[0],
+		scriptKey:   &reusedKey,
+		amt:         10,
+	}, {
+		assetGen:    assetGen.assetGens[1],
+		anchorPoint: assetGen.anchorPoints[1],
+		scriptKey:   &reusedKey,
+		amt:         20,
+	}, {
+		assetGen:    assetGen.assetGens[2],
+		anchorPoint: assetGen.anchorPoints[2],
+		scriptKey:   &reusedKey,
+		amt:         30,
+		spent:       true,
+	}, {
+		assetGen:    assetGen.assetGens[3],
+		anchorPoint: assetGen.anchorPoints[3],
+		scriptKey:   &singleKey,
+		amt:         40,
+	}, {
+		assetGen:    assetGen.assetGens[0],
+		anchorPoint: assetGen.anchorPoints[2],
+	}, {
+		assetGen:    assetGen.assetGens[1],
+		anchorPoint: assetGen.anchorPoints[3],
+	}})
+
+	reuses, err := assetsStore.ListScriptKeyReuse(ctx)
+	require.NoError(t, err)
+	require.Len(t, reuses, 1)
+	require.True(t, reusedKey.PubKey.IsEqual(reuses[0].ScriptKey))
+	require.Equal(
+		t, tapfreighter.ScriptKeyConflictUnspent, reuses[0].Conflict,
+	)
+	require.Equal(t, []wire.OutPoint{
+		assetGen.anchorPoints[0], assetGen.anchorPoints[1],
+	}, reuses[0].AnchorPoints)
+
+	// Flagging the reuse opens a flag, flagging it again doesn't.
+	opened, err := assetsStore.FlagScriptKeys(ctx, reuses...)
+	require.NoError(t, err)
+	require.Len(t, opened, 1)
+
+	opened, err = assetsStore.FlagScriptKeys(ctx, reuses...)
+	require.NoError(t, err)
+	require.Empty(t, opened)
+
+	flags, err := assetsStore.ListFlaggedScriptKeys(ctx, reusedKey.PubKey)
+	require.NoError(t, err)
+	require.Len(t, flags, 1)
+	require.False(t, flags[0].Resolved())
+	require.Equal(t, 2, flags[0].NumOccurrences)
+
+	flags, err = assetsStore.ListFlaggedScriptKeys(ctx, singleKey.PubKey)
+	require.NoError(t, err)
+	require.Empty(t, flags)
+
+	// Once resolved, the flag is only reopened if the script key is found
+	// in more anchor outputs.
+	err = assetsStore.ResolveFlaggedScriptKey(ctx, reusedKey.PubKey)
+	require.NoError(t, err)
+
+	err = assetsStore.ResolveFlaggedScriptKey(ctx, reusedKey.PubKey)
+	require.ErrorIs(t, err, tapfreighter.ErrScriptKeyNotFlagged)
+
+	opened, err = assetsStore.FlagScriptKeys(ctx, reuses...)
+	require.NoError(t, err)
+	require.Empty(t, opened)
+
+	flags, err = assetsStore.ListFlaggedScriptKeys(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, flags, 1)
+	require.True(t, flags[0].Resolved())
+
+	grownReuse := reuses[0]
+	grownReuse.AnchorPoints = append(
+		grownReuse.AnchorPoints, test.RandOp(t),
+	)
+	opened, err = assetsStore.FlagScriptKeys(ctx, grownReuse)
+	require.NoError(t, err)
+	require.Len(t, opened, 1)
+	require.Equal(t, 3, opened[0].NumOccurrences)
+
+	flags, err = assetsStore.ListFlaggedScriptKeys(ctx, reusedKey.PubKey)
+	require.NoError(t, err)
+	require.Len(t, flags, 1)
+	require.False(t, flags[0].Resolved())
+}
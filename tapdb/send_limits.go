@@ -0,0 +1,252 @@
+package tapdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+type (
+	// NewSendReservation is used to insert a new send that is counted
+	// against the daily limit of a macaroon.
+	NewSendReservation = sqlc.InsertSendReservationParams
+
+	// NewSendReservationAmount is used to insert the amount of an asset a
+	// reserved send moves.
+	NewSendReservationAmount = sqlc.InsertSendReservationAmountParams
+
+	// SendReservationSumQuery is used to sum up the amount of an asset
+	// sent with a macaroon on a day.
+	SendReservationSumQuery = sqlc.SumReservedSendsParams
+
+	// SendReservationAnchor is used to link a reserved send to the anchor
+	// transaction of its transfer.
+	SendReservationAnchor = sqlc.SetSendReservationAnchorParams
+)
+
+// ErrDailySendLimit is returned if a send would exceed the daily send limit of
+// a macaroon.
+var ErrDailySendLimit = errors.New("daily send limit exceeded")
+
+// SendLimitStore is the set of queries needed to track the sends counted
+// against the daily send limits of macaroons.
+type SendLimitStore interface {
+	// InsertSendReservation inserts a new reserved send and returns its
+	// primary key.
+	InsertSendReservation(ctx context.Context,
+		arg NewSendReservation) (int32, error)
+
+	// InsertSendReservationAmount inserts the amount of an asset a
+	// reserved send moves.
+	InsertSendReservationAmount(ctx context.Context,
+		arg NewSendReservationAmount) error
+
+	// SumReservedSends returns the total amount of an asset reserved by
+	// the sends of a macaroon on a day.
+	SumReservedSends(ctx context.Context,
+		arg SendReservationSumQuery) (int64, error)
+
+	// SetSendReservationAnchor links a reserved send to the anchor
+	// transaction of its transfer.
+	SetSendReservationAnchor(ctx context.Context,
+		arg SendReservationAnchor) error
+
+	// DeleteSendReservation deletes the reserved send with the given
+	// primary key and returns the number of deleted sends.
+	DeleteSendReservation(ctx context.Context, id int32) (int64, error)
+
+	// DeleteTransferSendReservations deletes the reserved sends linked to
+	// the given anchor transaction and returns the number of deleted
+	// sends.
+	DeleteTransferSendReservations(ctx context.Context,
+		anchorTxid []byte) (int64, error)
+
+	// DeleteSendReservationsBefore deletes the reserved sends made before
+	// the given day.
+	DeleteSendReservationsBefore(ctx context.Context, sendDay int64) error
+}
+
+// SendLimitTxOptions is the database tx object for the send limit store.
+type SendLimitTxOptions struct {
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (s *SendLimitTxOptions) ReadOnly() bool {
+	return s.readOnly
+}
+
+// BatchedSendLimitStore allows for batched DB transactions for the send limit
+// store.
+type BatchedSendLimitStore interface {
+	SendLimitStore
+
+	BatchedTx[SendLimitStore]
+}
+
+// SendLimits is a database backed tracker of the sends counted against the
+// daily send limits of macaroons. The sends are counted per UTC day, so the
+// limits reset at midnight UTC.
+type SendLimits struct {
+	db BatchedSendLimitStore
+
+	clock clock.Clock
+
+	// reserveMtx serializes the reservations, so two concurrent sends
+	// can't both pass the check against the same daily limit.
+	reserveMtx sync.Mutex
+}
+
+// NewSendLimits creates a new send limit tracker backed by the given
+// database.
+func NewSendLimits(db BatchedSendLimitStore, clock clock.Clock) *SendLimits {
+	return &SendLimits{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// sendDay returns the UTC day of the given time, counted in days since the
+// unix epoch.
+func sendDay(t time.Time) int64 {
+	return t.Unix() / int64(24*time.Hour/time.Second)
+}
+
+// ReserveSend counts a send of the given amounts with the macaroon of the
+// given ID against its daily limit and returns the ID of the reservation. If
+// the send would exceed the limit for any of the assets, nothing is reserved
+// and ErrDailySendLimit is returned. The sends of earlier days are pruned.
+func (s *SendLimits) ReserveSend(ctx context.Context, macaroonID string,
+	amounts map[asset.ID]uint64, dailyLimit uint64) (int32, error) {
+
+	s.reserveMtx.Lock()
+	defer s.reserveMtx.Unlock()
+
+	var (
+		writeTx       SendLimitTxOptions
+		now           = s.clock.Now().UTC()
+		day           = sendDay(now)
+		reservationID int32
+	)
+	dbErr := s.db.ExecTx(ctx, &writeTx, func(q SendLimitStore) error {
+		err := q.DeleteSendReservationsBefore(ctx, day)
+		if err != nil {
+			return fmt.Errorf("unable to prune sends: %w", err)
+		}
+
+		for assetID, amount := range amounts {
+			assetID := assetID
+			total, err := q.SumReservedSends(
+				ctx, SendReservationSumQuery{
+					MacaroonID: macaroonID,
+					SendDay:    day,
+					AssetID:    assetID[:],
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to sum sends: %w",
+					err)
+			}
+
+			if uint64(total)+amount > dailyLimit {
+				return fmt.Errorf("%w: send of %d units of "+
+					"asset %v exceeds the daily limit of "+
+					"%d units, %d units were sent today",
+					ErrDailySendLimit, amount, assetID,
+					dailyLimit, total)
+			}
+		}
+
+		reservationID, err = q.InsertSendReservation(
+			ctx, NewSendReservation{
+				MacaroonID: macaroonID,
+				SendDay:    day,
+				ReservedAt: now,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to insert send: %w", err)
+		}
+
+		for assetID, amount := range amounts {
+			assetID := assetID
+			err := q.InsertSendReservationAmount(
+				ctx, NewSendReservationAmount{
+					ReservationID: reservationID,
+					AssetID:       assetID[:],
+					Amount:        int64(amount),
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to insert send "+
+					"amount: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return 0, dbErr
+	}
+
+	return reservationID, nil
+}
+
+// LinkTransfer links the reserved send with the given ID to the anchor
+// transaction of the transfer it was committed to, so it can be released if
+// the transfer is canceled.
+func (s *SendLimits) LinkTransfer(ctx context.Context, reservationID int32,
+	anchorTxid chainhash.Hash) error {
+
+	var writeTx SendLimitTxOptions
+	return s.db.ExecTx(ctx, &writeTx, func(q SendLimitStore) error {
+		return q.SetSendReservationAnchor(ctx, SendReservationAnchor{
+			ID:         reservationID,
+			AnchorTxid: anchorTxid[:],
+		})
+	})
+}
+
+// ReleaseSend releases the reserved send with the given ID, so its amounts no
+// longer count against the daily limit of its macaroon.
+func (s *SendLimits) ReleaseSend(ctx context.Context,
+	reservationID int32) error {
+
+	var writeTx SendLimitTxOptions
+	return s.db.ExecTx(ctx, &writeTx, func(q SendLimitStore) error {
+		_, err := q.DeleteSendReservation(ctx, reservationID)
+		return err
+	})
+}
+
+// ReleaseTransfer releases the reserved sends linked to the given anchor
+// transaction and returns the number of released sends.
+func (s *SendLimits) ReleaseTransfer(ctx context.Context,
+	anchorTxid chainhash.Hash) (int64, error) {
+
+	var (
+		writeTx  SendLimitTxOptions
+		released int64
+	)
+	dbErr := s.db.ExecTx(ctx, &writeTx, func(q SendLimitStore) error {
+		var err error
+		released, err = q.DeleteTransferSendReservations(
+			ctx, anchorTxid[:],
+		)
+		return err
+	})
+	if dbErr != nil {
+		return 0, dbErr
+	}
+
+	return released, nil
+}
@@ -0,0 +1,150 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSendLimits creates a new send limit tracker backed by a test
+// database.
+func newTestSendLimits(t *testing.T, clock clock.Clock) *SendLimits {
+	db := NewTestDB(t)
+	sendLimitDB := NewTransactionExecutor(
+		db, func(tx *sql.Tx) SendLimitStore {
+			return db.WithTx(tx)
+		},
+	)
+
+	return NewSendLimits(sendLimitDB, clock)
+}
+
+// TestSendLimits tests that the sends of a macaroon are counted against its
+// daily limit per asset and UTC day, and that they survive a restart.
+func TestSendLimits(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	testClock := clock.NewTestClock(start)
+	limits := newTestSendLimits(t, testClock)
+	ctx := context.Background()
+
+	var assetA, assetB asset.ID
+	test.RandRead(t, assetA[:])
+	test.RandRead(t, assetB[:])
+
+	_, err := limits.ReserveSend(ctx, "mac1", map[asset.ID]uint64{
+		assetA: 60,
+		assetB: 10,
+	}, 100)
+	require.NoError(t, err)
+
+	// A send that would exceed the limit of one of its assets is rejected
+	// as a whole.
+	_, err = limits.ReserveSend(ctx, "mac1", map[asset.ID]uint64{
+		assetA: 50,
+		assetB: 10,
+	}, 100)
+	require.ErrorIs(t, err, ErrDailySendLimit)
+
+	_, err = limits.ReserveSend(ctx, "mac1", map[asset.ID]uint64{
+		assetA: 40,
+		assetB: 90,
+	}, 100)
+	require.NoError(t, err)
+
+	// Other macaroons have their own limit.
+	_, err = limits.ReserveSend(ctx, "mac2", map[asset.ID]uint64{
+		assetA: 100,
+	}, 100)
+	require.NoError(t, err)
+
+	// The sends are persisted, so a new tracker on the same database
+	// still counts them.
+	restarted := NewSendLimits(limits.db, testClock)
+	_, err = restarted.ReserveSend(ctx, "mac1", map[asset.ID]uint64{
+		assetA: 1,
+	}, 100)
+	require.ErrorIs(t, err, ErrDailySendLimit)
+
+	// The limit resets on the next UTC day.
+	testClock.SetTime(start.Add(14 * time.Hour))
+	_, err = restarted.ReserveSend(ctx, "mac1", map[asset.ID]uint64{
+		assetA: 100,
+	}, 100)
+	require.NoError(t, err)
+}
+
+// TestSendLimitsReleaseSend tests that the amounts of a released send no
+// longer count against the daily limit.
+func TestSendLimitsReleaseSend(t *testing.T) {
+	t.Parallel()
+
+	limits := newTestSendLimits(t, clock.NewDefaultClock())
+	ctx := context.Background()
+
+	var assetID asset.ID
+	test.RandRead(t, assetID[:])
+	amounts := map[asset.ID]uint64{
+		assetID: 100,
+	}
+
+	reservationID, err := limits.ReserveSend(ctx, "mac", amounts, 100)
+	require.NoError(t, err)
+
+	_, err = limits.ReserveSend(ctx, "mac", amounts, 100)
+	require.ErrorIs(t, err, ErrDailySendLimit)
+
+	require.NoError(t, limits.ReleaseSend(ctx, reservationID))
+
+	_, err = limits.ReserveSend(ctx, "mac", amounts, 100)
+	require.NoError(t, err)
+}
+
+// TestSendLimitsReleaseTransfer tests that the sends linked to the anchor
+// transaction of a transfer are released together.
+func TestSendLimitsReleaseTransfer(t *testing.T) {
+	t.Parallel()
+
+	limits := newTestSendLimits(t, clock.NewDefaultClock())
+	ctx := context.Background()
+
+	var assetID asset.ID
+	test.RandRead(t, assetID[:])
+	amounts := map[asset.ID]uint64{
+		assetID: 50,
+	}
+
+	anchorTxid := chainhash.Hash{1}
+	for i := 0; i < 2; i++ {
+		reservationID, err := limits.ReserveSend(
+			ctx, "mac", amounts, 100,
+		)
+		require.NoError(t, err)
+		require.NoError(t, limits.LinkTransfer(
+			ctx, reservationID, anchorTxid,
+		))
+	}
+
+	_, err := limits.ReserveSend(ctx, "mac", amounts, 100)
+	require.ErrorIs(t, err, ErrDailySendLimit)
+
+	// Releasing an unknown transfer doesn't release anything.
+	released, err := limits.ReleaseTransfer(ctx, chainhash.Hash{2})
+	require.NoError(t, err)
+	require.Zero(t, released)
+
+	released, err = limits.ReleaseTransfer(ctx, anchorTxid)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, released)
+
+	_, err = limits.ReserveSend(ctx, "mac", amounts, 100)
+	require.NoError(t, err)
+}
@@ -22,7 +22,9 @@ SELECT
     raw_script_keys.key_index AS script_key_index,
     taproot_keys.raw_key AS raw_taproot_key,
     taproot_keys.key_family AS taproot_key_family,
-    taproot_keys.key_index AS taproot_key_index
+    taproot_keys.key_index AS taproot_key_index,
+    addr_deposit_expectations.expected_amount AS expected_amount,
+    addr_deposit_expectations.expiry AS deposit_expiry
 FROM addrs
 JOIN script_keys
   ON addrs.script_key_id = script_keys.script_key_id
@@ -30,6 +32,8 @@ JOIN internal_keys raw_script_keys
   ON script_keys.internal_key_id = raw_script_keys.key_id
 JOIN internal_keys taproot_keys
   ON addrs.taproot_key_id = taproot_keys.key_id
+LEFT JOIN addr_deposit_expectations
+  ON addrs.id = addr_deposit_expectations.addr_id
 WHERE taproot_output_key = $1
 `
 
@@ -51,6 +55,8 @@ type FetchAddrByTaprootOutputKeyRow struct {
 	RawTaprootKey    []byte
 	TaprootKeyFamily int32
 	TaprootKeyIndex  int32
+	ExpectedAmount   sql.NullInt64
+	DepositExpiry    sql.NullTime
 }
 
 func (q *Queries) FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutputKey []byte) (FetchAddrByTaprootOutputKeyRow, error) {
@@ -74,13 +80,15 @@ func (q *Queries) FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutput
 		&i.RawTaprootKey,
 		&i.TaprootKeyFamily,
 		&i.TaprootKeyIndex,
+		&i.ExpectedAmount,
+		&i.DepositExpiry,
 	)
 	return i, err
 }
 
 const fetchAddrEvent = `-- name: FetchAddrEvent :one
 SELECT
-    creation_time, status, asset_proof_id, asset_id,
+    creation_time, status, asset_proof_id, asset_id, deposit_status,
     chain_txns.txid as txid,
     chain_txns.block_height as confirmation_height,
     chain_txn_output_index as output_index,
@@ -102,6 +110,7 @@ type FetchAddrEventRow struct {
 	Status             int16
 	AssetProofID       sql.NullInt32
 	AssetID            sql.NullInt32
+	DepositStatus      sql.NullInt16
 	Txid               []byte
 	ConfirmationHeight sql.NullInt32
 	OutputIndex        int32
@@ -118,6 +127,7 @@ func (q *Queries) FetchAddrEvent(ctx context.Context, id int32) (FetchAddrEventR
 		&i.Status,
 		&i.AssetProofID,
 		&i.AssetID,
+		&i.DepositStatus,
 		&i.Txid,
 		&i.ConfirmationHeight,
 		&i.OutputIndex,
@@ -139,7 +149,9 @@ SELECT
     raw_script_keys.key_index AS script_key_index,
     taproot_keys.raw_key AS raw_taproot_key, 
     taproot_keys.key_family AS taproot_key_family,
-    taproot_keys.key_index AS taproot_key_index
+    taproot_keys.key_index AS taproot_key_index,
+    addr_deposit_expectations.expected_amount AS expected_amount,
+    addr_deposit_expectations.expiry AS deposit_expiry
 FROM addrs
 JOIN script_keys
     ON addrs.script_key_id = script_keys.script_key_id
@@ -147,6 +159,8 @@ JOIN internal_keys raw_script_keys
     ON script_keys.internal_key_id = raw_script_keys.key_id
 JOIN internal_keys taproot_keys
     ON addrs.taproot_key_id = taproot_keys.key_id
+LEFT JOIN addr_deposit_expectations
+    ON addrs.id = addr_deposit_expectations.addr_id
 WHERE creation_time >= $1
     AND creation_time <= $2
     AND ($3 = false OR
@@ -181,6 +195,8 @@ type FetchAddrsRow struct {
 	RawTaprootKey    []byte
 	TaprootKeyFamily int32
 	TaprootKeyIndex  int32
+	ExpectedAmount   sql.NullInt64
+	DepositExpiry    sql.NullTime
 }
 
 func (q *Queries) FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]FetchAddrsRow, error) {
@@ -216,6 +232,8 @@ func (q *Queries) FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]Fetch
 			&i.RawTaprootKey,
 			&i.TaprootKeyFamily,
 			&i.TaprootKeyIndex,
+			&i.ExpectedAmount,
+			&i.DepositExpiry,
 		); err != nil {
 			return nil, err
 		}
@@ -335,6 +353,33 @@ func (q *Queries) SetAddrManaged(ctx context.Context, arg SetAddrManagedParams)
 	return err
 }
 
+const upsertAddrDepositExpectation = `-- name: UpsertAddrDepositExpectation :exec
+WITH target_addr(addr_id) AS (
+    SELECT id
+    FROM addrs
+    WHERE addrs.taproot_output_key = $1
+)
+INSERT INTO addr_deposit_expectations (
+    addr_id, expected_amount, expiry
+) VALUES (
+    (SELECT addr_id FROM target_addr), $2, $3
+)
+ON CONFLICT (addr_id)
+    DO UPDATE SET expected_amount = EXCLUDED.expected_amount,
+                  expiry = EXCLUDED.expiry
+`
+
+type UpsertAddrDepositExpectationParams struct {
+	TaprootOutputKey []byte
+	ExpectedAmount   int64
+	Expiry           sql.NullTime
+}
+
+func (q *Queries) UpsertAddrDepositExpectation(ctx context.Context, arg UpsertAddrDepositExpectationParams) error {
+	_, err := q.db.ExecContext(ctx, upsertAddrDepositExpectation, arg.TaprootOutputKey, arg.ExpectedAmount, arg.Expiry)
+	return err
+}
+
 const upsertAddrEvent = `-- name: UpsertAddrEvent :one
 WITH target_addr(addr_id) AS (
     SELECT id
@@ -347,15 +392,16 @@ WITH target_addr(addr_id) AS (
 )
 INSERT INTO addr_events (
     creation_time, addr_id, status, chain_txn_id, chain_txn_output_index,
-    managed_utxo_id, asset_proof_id, asset_id
+    managed_utxo_id, asset_proof_id, asset_id, deposit_status
 ) VALUES (
     $3, (SELECT addr_id FROM target_addr), $4,
-    (SELECT txn_id FROM target_chain_txn), $5, $6, $7, $8
+    (SELECT txn_id FROM target_chain_txn), $5, $6, $7, $8, $9
 )
 ON CONFLICT (addr_id, chain_txn_id, chain_txn_output_index)
     DO UPDATE SET status = EXCLUDED.status,
                   asset_proof_id = COALESCE(EXCLUDED.asset_proof_id, addr_events.asset_proof_id),
-                  asset_id = COALESCE(EXCLUDED.asset_id, addr_events.asset_id)
+                  asset_id = COALESCE(EXCLUDED.asset_id, addr_events.asset_id),
+                  deposit_status = COALESCE(EXCLUDED.deposit_status, addr_events.deposit_status)
 RETURNING id
 `
 
@@ -368,6 +414,7 @@ type UpsertAddrEventParams struct {
 	ManagedUtxoID       int32
 	AssetProofID        sql.NullInt32
 	AssetID             sql.NullInt32
+	DepositStatus       sql.NullInt16
 }
 
 func (q *Queries) UpsertAddrEvent(ctx context.Context, arg UpsertAddrEventParams) (int32, error) {
@@ -380,6 +427,7 @@ func (q *Queries) UpsertAddrEvent(ctx context.Context, arg UpsertAddrEventParams
 		arg.ManagedUtxoID,
 		arg.AssetProofID,
 		arg.AssetID,
+		arg.DepositStatus,
 	)
 	var id int32
 	err := row.Scan(&id)
@@ -540,6 +540,45 @@ func (q *Queries) FetchAssetProof(ctx context.Context, tweakedScriptKey []byte)
 	return i, err
 }
 
+const fetchAssetProofKeys = `-- name: FetchAssetProofKeys :many
+SELECT genesis_assets.asset_id, script_keys.tweaked_script_key AS script_key
+FROM asset_proofs
+JOIN assets
+    ON assets.asset_id = asset_proofs.asset_id
+JOIN script_keys
+    ON assets.script_key_id = script_keys.script_key_id
+JOIN genesis_assets
+    ON assets.genesis_id = genesis_assets.gen_asset_id
+`
+
+type FetchAssetProofKeysRow struct {
+	AssetID   []byte
+	ScriptKey []byte
+}
+
+func (q *Queries) FetchAssetProofKeys(ctx context.Context) ([]FetchAssetProofKeysRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchAssetProofKeys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchAssetProofKeysRow
+	for rows.Next() {
+		var i FetchAssetProofKeysRow
+		if err := rows.Scan(&i.AssetID, &i.ScriptKey); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchAssetProofs = `-- name: FetchAssetProofs :many
 WITH asset_info AS (
     SELECT assets.asset_id, script_keys.tweaked_script_key
@@ -996,6 +1035,37 @@ func (q *Queries) FetchGenesisPointByAnchorTx(ctx context.Context, anchorTxID sq
 	return i, err
 }
 
+const fetchGroupAssetIDs = `-- name: FetchGroupAssetIDs :many
+SELECT genesis_assets.asset_id
+FROM genesis_assets
+JOIN key_group_info_view
+    ON genesis_assets.gen_asset_id = key_group_info_view.gen_asset_id
+WHERE key_group_info_view.tweaked_group_key = $1
+`
+
+func (q *Queries) FetchGroupAssetIDs(ctx context.Context, groupKey []byte) ([][]byte, error) {
+	rows, err := q.db.QueryContext(ctx, fetchGroupAssetIDs, groupKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items [][]byte
+	for rows.Next() {
+		var asset_id []byte
+		if err := rows.Scan(&asset_id); err != nil {
+			return nil, err
+		}
+		items = append(items, asset_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchGroupByGenesis = `-- name: FetchGroupByGenesis :one
 SELECT
     key_group_info_view.tweaked_group_key AS tweaked_group_key,
@@ -1517,6 +1587,33 @@ func (q *Queries) FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]
 	return items, nil
 }
 
+const freezeAssetOutput = `-- name: FreezeAssetOutput :exec
+INSERT INTO frozen_asset_outputs (
+    outpoint, script_key, reason, frozen_at
+) VALUES (
+    $1, $2, $3, $4
+) ON CONFLICT (outpoint, script_key)
+    -- If the asset output is already frozen, only the reason is updated.
+    DO UPDATE SET reason = EXCLUDED.reason
+`
+
+type FreezeAssetOutputParams struct {
+	Outpoint  []byte
+	ScriptKey []byte
+	Reason    string
+	FrozenAt  time.Time
+}
+
+func (q *Queries) FreezeAssetOutput(ctx context.Context, arg FreezeAssetOutputParams) error {
+	_, err := q.db.ExecContext(ctx, freezeAssetOutput,
+		arg.Outpoint,
+		arg.ScriptKey,
+		arg.Reason,
+		arg.FrozenAt,
+	)
+	return err
+}
+
 const genesisAssets = `-- name: GenesisAssets :many
 SELECT gen_asset_id, asset_id, asset_tag, meta_data_id, output_index, asset_type, genesis_point_id 
 FROM genesis_assets
@@ -1923,7 +2020,15 @@ WHERE (
     assets.amount >= COALESCE($7, assets.amount) AND
     assets.spent = COALESCE($8, assets.spent) AND
     (key_group_info_view.tweaked_group_key = $9 OR
-      $9 IS NULL)
+      $9 IS NULL) AND
+    -- An asset can't be spent if any asset anchored at the same outpoint is
+    -- frozen, as that asset would be moved as well.
+    (COALESCE($10, false) = false OR
+      NOT EXISTS (
+        SELECT 1
+        FROM frozen_asset_outputs
+        WHERE frozen_asset_outputs.outpoint = utxos.outpoint
+      ))
 )
 `
 
@@ -1937,6 +2042,7 @@ type QueryAssetsParams struct {
 	MinAmt           sql.NullInt64
 	Spent            sql.NullBool
 	KeyGroupFilter   []byte
+	ExcludeFrozen    interface{}
 }
 
 type QueryAssetsRow struct {
@@ -1998,6 +2104,7 @@ func (q *Queries) QueryAssets(ctx context.Context, arg QueryAssetsParams) ([]Que
 		arg.MinAmt,
 		arg.Spent,
 		arg.KeyGroupFilter,
+		arg.ExcludeFrozen,
 	)
 	if err != nil {
 		return nil, err
@@ -2058,6 +2165,61 @@ func (q *Queries) QueryAssets(ctx context.Context, arg QueryAssetsParams) ([]Que
 	return items, nil
 }
 
+const queryFrozenAssetOutputs = `-- name: QueryFrozenAssetOutputs :many
+SELECT id, outpoint, script_key, reason, frozen_at
+FROM frozen_asset_outputs
+ORDER BY id
+`
+
+func (q *Queries) QueryFrozenAssetOutputs(ctx context.Context) ([]FrozenAssetOutput, error) {
+	rows, err := q.db.QueryContext(ctx, queryFrozenAssetOutputs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FrozenAssetOutput
+	for rows.Next() {
+		var i FrozenAssetOutput
+		if err := rows.Scan(
+			&i.ID,
+			&i.Outpoint,
+			&i.ScriptKey,
+			&i.Reason,
+			&i.FrozenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const releaseOrphanedUTXOLeases = `-- name: ReleaseOrphanedUTXOLeases :exec
+UPDATE managed_utxos
+SET lease_owner = NULL, lease_expiry = NULL
+WHERE lease_owner = $1 AND
+      outpoint NOT IN (
+          SELECT inputs.anchor_point
+          FROM asset_transfer_inputs inputs
+          JOIN asset_transfers transfers
+              ON inputs.transfer_id = transfers.id
+          JOIN chain_txns txns
+              ON transfers.anchor_txn_id = txns.txn_id
+          WHERE txns.block_hash IS NULL
+      )
+`
+
+func (q *Queries) ReleaseOrphanedUTXOLeases(ctx context.Context, leaseOwner []byte) error {
+	_, err := q.db.ExecContext(ctx, releaseOrphanedUTXOLeases, leaseOwner)
+	return err
+}
+
 const setAssetSpent = `-- name: SetAssetSpent :one
 WITH target_asset(asset_id) AS (
     SELECT assets.asset_id
@@ -2090,6 +2252,26 @@ func (q *Queries) SetAssetSpent(ctx context.Context, arg SetAssetSpentParams) (i
 	return asset_id, err
 }
 
+const unfreezeAssetOutputs = `-- name: UnfreezeAssetOutputs :execrows
+DELETE FROM frozen_asset_outputs
+WHERE outpoint = $1 AND
+    (script_key = $2 OR
+      $2 IS NULL)
+`
+
+type UnfreezeAssetOutputsParams struct {
+	Outpoint  []byte
+	ScriptKey []byte
+}
+
+func (q *Queries) UnfreezeAssetOutputs(ctx context.Context, arg UnfreezeAssetOutputsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, unfreezeAssetOutputs, arg.Outpoint, arg.ScriptKey)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateBatchGenesisTx = `-- name: UpdateBatchGenesisTx :exec
 WITH target_batch AS (
     SELECT batch_id
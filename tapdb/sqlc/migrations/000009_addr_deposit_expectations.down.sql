@@ -0,0 +1,2 @@
+ALTER TABLE addr_events DROP COLUMN deposit_status;
+DROP TABLE IF EXISTS addr_deposit_expectations;
@@ -0,0 +1,22 @@
+-- addr_deposit_expectations stores the optional deposit expectation an address
+-- was annotated with when it was created. This allows the custodian to flag
+-- inbound transfers that arrive after the expiry of the expectation.
+CREATE TABLE IF NOT EXISTS addr_deposit_expectations (
+    id INTEGER PRIMARY KEY,
+
+    -- addr_id is the reference to the address the expectation is for.
+    addr_id INTEGER NOT NULL UNIQUE REFERENCES addrs(id),
+
+    -- expected_amount is the asset amount the address is expected to
+    -- receive.
+    expected_amount BIGINT NOT NULL,
+
+    -- expiry is the time after which a deposit to the address is considered
+    -- late. If no expiry is set, this field will be NULL.
+    expiry TIMESTAMP
+);
+
+-- deposit_status is the result of comparing the inbound transfer of an address
+-- event against the deposit expectation of its address. This will only be set
+-- once the inbound transfer is completed.
+ALTER TABLE addr_events ADD COLUMN deposit_status SMALLINT;
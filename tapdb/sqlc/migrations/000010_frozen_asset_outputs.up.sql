@@ -0,0 +1,22 @@
+-- frozen_asset_outputs stores asset outputs that were frozen by an
+-- administrator, for example because of a compliance hold. As spending the
+-- anchor output of a frozen asset would also move the frozen asset, no asset
+-- anchored at the same outpoint can be selected for spending while an entry
+-- for the outpoint exists.
+CREATE TABLE IF NOT EXISTS frozen_asset_outputs (
+    id INTEGER PRIMARY KEY,
+
+    -- outpoint is the anchor outpoint of the frozen asset.
+    outpoint BLOB NOT NULL,
+
+    -- script_key is the tweaked script key of the frozen asset.
+    script_key BLOB NOT NULL,
+
+    -- reason is the reason the asset output was frozen for.
+    reason TEXT NOT NULL,
+
+    -- frozen_at is the time the asset output was frozen.
+    frozen_at TIMESTAMP NOT NULL,
+
+    UNIQUE(outpoint, script_key)
+);
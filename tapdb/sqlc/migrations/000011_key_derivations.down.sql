@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS key_derivations_internal_key_id_idx;
+DROP TABLE IF EXISTS key_derivations;
@@ -0,0 +1,22 @@
+-- key_derivations is the audit log of all keys derived from the key ring of
+-- the backing lnd node for minting and transfers. Each entry records what the
+-- key was derived for, so keys can later be mapped to on-chain artifacts.
+CREATE TABLE IF NOT EXISTS key_derivations (
+    id INTEGER PRIMARY KEY,
+
+    -- internal_key_id references the derived key and its locator.
+    internal_key_id INTEGER NOT NULL REFERENCES internal_keys(key_id),
+
+    -- purpose is what the key is used for, for example a script key of a
+    -- newly minted asset or the internal key of an anchor output.
+    purpose SMALLINT NOT NULL,
+
+    -- object is a description of the object the key was derived for.
+    object TEXT NOT NULL,
+
+    -- derived_at is the time the key was derived.
+    derived_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS key_derivations_internal_key_id_idx
+    ON key_derivations(internal_key_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS parcel_request_addrs;
+DROP TABLE IF EXISTS parcel_requests;
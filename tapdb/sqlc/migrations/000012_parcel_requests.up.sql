@@ -0,0 +1,32 @@
+-- parcel_requests stores the outbound transfer requests that were accepted by
+-- the chain porter but not yet committed to disk as a transfer. They are
+-- removed in the same database transaction that logs the resulting transfer,
+-- so requests found on startup never lead to a duplicate send and can safely
+-- be re-driven from scratch.
+CREATE TABLE IF NOT EXISTS parcel_requests (
+    id INTEGER PRIMARY KEY,
+
+    -- request_id is the unique ID assigned to the request at intake.
+    request_id BLOB NOT NULL UNIQUE CHECK(length(request_id) = 32),
+
+    -- priority is the priority class the request is scheduled with.
+    priority SMALLINT NOT NULL,
+
+    -- created_at is the time the request was accepted.
+    created_at TIMESTAMP NOT NULL
+);
+
+-- parcel_request_addrs stores the destination addresses of a parcel request.
+CREATE TABLE IF NOT EXISTS parcel_request_addrs (
+    id INTEGER PRIMARY KEY,
+
+    request_id INTEGER NOT NULL REFERENCES parcel_requests(id) ON DELETE CASCADE,
+
+    -- addr_index is the position of the address in the request.
+    addr_index INTEGER NOT NULL,
+
+    -- tap_addr is the bech32m encoded Taproot Asset address.
+    tap_addr TEXT NOT NULL,
+
+    UNIQUE(request_id, addr_index)
+);
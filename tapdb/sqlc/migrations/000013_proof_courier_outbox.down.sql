@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS proof_courier_outbox_anchor_txid_idx;
+DROP TABLE IF EXISTS proof_courier_outbox;
@@ -0,0 +1,39 @@
+-- proof_courier_outbox logs the outcome of every attempt of the proof courier
+-- to deliver the proof of a transfer output to its receiver, so operators can
+-- inspect why a receiver didn't get their proof yet.
+CREATE TABLE IF NOT EXISTS proof_courier_outbox (
+    id INTEGER PRIMARY KEY,
+
+    -- proof_locator_hash is the hash of the locator of the delivered proof.
+    proof_locator_hash BLOB NOT NULL,
+
+    -- anchor_txid is the ID of the anchor transaction of the transfer.
+    anchor_txid BLOB NOT NULL CHECK(length(anchor_txid) = 32),
+
+    -- anchor_output_index is the index of the anchor output of the transfer
+    -- output.
+    anchor_output_index INTEGER NOT NULL,
+
+    -- script_key is the script key of the receiver.
+    script_key BLOB NOT NULL,
+
+    -- asset_id is the ID of the transferred asset, if known.
+    asset_id BLOB CHECK(length(asset_id) = 32),
+
+    -- courier_addr is the address of the courier service the proof was
+    -- delivered through.
+    courier_addr TEXT NOT NULL,
+
+    -- attempt_time is the time the attempt finished.
+    attempt_time TIMESTAMP NOT NULL,
+
+    -- error_msg is the error the attempt failed with, NULL if the proof was
+    -- delivered.
+    error_msg TEXT,
+
+    -- next_attempt_time is the time the next attempt is scheduled for, NULL
+    -- if no further attempt is scheduled.
+    next_attempt_time TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS proof_courier_outbox_anchor_txid_idx
+    ON proof_courier_outbox (anchor_txid);
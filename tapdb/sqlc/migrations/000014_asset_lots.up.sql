@@ -0,0 +1,29 @@
+-- asset_lots stores the acquisition metadata of the asset UTXOs (lots) of our
+-- wallet for cost basis tracking. A lot is identified by its anchor point,
+-- asset ID and script key, just like the inputs of a transfer, so the lots a
+-- transfer consumed can be looked up from its inputs.
+CREATE TABLE IF NOT EXISTS asset_lots (
+    lot_id INTEGER PRIMARY KEY,
+
+    -- anchor_point is the outpoint the asset is anchored at.
+    anchor_point BLOB NOT NULL,
+
+    -- asset_id is the ID of the asset.
+    asset_id BLOB NOT NULL CHECK(length(asset_id) = 32),
+
+    -- script_key is the script key of the asset.
+    script_key BLOB NOT NULL CHECK(length(script_key) = 33),
+
+    -- acquired_at is the time the lot was acquired, NULL if unknown.
+    acquired_at TIMESTAMP,
+
+    -- source_transfer_id is the outbound transfer that created the lot as
+    -- change, NULL if the lot was minted or received.
+    source_transfer_id INTEGER REFERENCES asset_transfers(id),
+
+    -- price_annotation is an optional, user supplied annotation of the price
+    -- the lot was acquired at.
+    price_annotation TEXT,
+
+    UNIQUE(anchor_point, asset_id, script_key)
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS asset_balance_snapshots_taken_at_idx;
+DROP TABLE IF EXISTS asset_balance_snapshots;
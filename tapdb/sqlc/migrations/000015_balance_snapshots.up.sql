@@ -0,0 +1,26 @@
+-- asset_balance_snapshots stores the confirmed balance of each asset of our
+-- wallet as it was at a given block height. Snapshots are taken periodically,
+-- so historical balances can be queried without replaying all transfers.
+CREATE TABLE IF NOT EXISTS asset_balance_snapshots (
+    snapshot_id INTEGER PRIMARY KEY,
+
+    -- asset_id is the ID of the asset.
+    asset_id BLOB NOT NULL CHECK(length(asset_id) = 32),
+
+    -- height is the block height the snapshot was taken at.
+    height INTEGER NOT NULL,
+
+    -- taken_at is the time the snapshot was taken.
+    taken_at TIMESTAMP NOT NULL,
+
+    -- balance is the total amount of the confirmed, unspent asset UTXOs.
+    balance BIGINT NOT NULL,
+
+    -- utxo_count is the number of confirmed, unspent asset UTXOs.
+    utxo_count INTEGER NOT NULL,
+
+    UNIQUE(asset_id, height)
+);
+
+CREATE INDEX IF NOT EXISTS asset_balance_snapshots_taken_at_idx
+    ON asset_balance_snapshots(taken_at);
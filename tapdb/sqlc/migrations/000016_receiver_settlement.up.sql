@@ -0,0 +1,4 @@
+-- receiver_settled_at is the time the receiver of a transfer output notified
+-- us through the proof courier that it verified and accepted the proof of the
+-- output. NULL means no such notification was received (yet).
+ALTER TABLE asset_transfer_outputs ADD COLUMN receiver_settled_at TIMESTAMP;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS mssmt_wal_entries;
+DROP TABLE IF EXISTS mssmt_wal_batches;
@@ -0,0 +1,58 @@
+-- mssmt_wal_batches is the write-ahead log of batched updates to persistent
+-- MS-SMT trees. Each batch is logged before it is applied, so a batch that was
+-- interrupted by a crash can be replayed or rolled back on startup.
+CREATE TABLE IF NOT EXISTS mssmt_wal_batches (
+    batch_id INTEGER PRIMARY KEY,
+
+    -- namespace is the namespace of the tree the batch updates.
+    namespace VARCHAR NOT NULL,
+
+    -- generation is the generation of the tree that the batch creates. The
+    -- generations of a tree are consecutive, starting at 1.
+    generation BIGINT NOT NULL,
+
+    -- prev_root_hash and prev_root_sum describe the root of the tree before
+    -- the batch was applied.
+    prev_root_hash BLOB NOT NULL,
+    prev_root_sum BIGINT NOT NULL,
+
+    -- root_hash and root_sum describe the root of the tree after the batch
+    -- was applied or rolled back. They are NULL while the batch is pending.
+    root_hash BLOB,
+    root_sum BIGINT,
+
+    -- state is the state of the batch: 0 is pending, 1 is applied and 2 is
+    -- rolled back.
+    state SMALLINT NOT NULL,
+
+    -- created_at is the time the batch was logged.
+    created_at TIMESTAMP NOT NULL,
+
+    UNIQUE(namespace, generation)
+);
+
+-- mssmt_wal_entries stores the leaf updates of a batch, along with the leaf
+-- that was stored at the same key before the batch, which is needed to roll
+-- the batch back.
+CREATE TABLE IF NOT EXISTS mssmt_wal_entries (
+    batch_id INTEGER NOT NULL REFERENCES mssmt_wal_batches(batch_id)
+        ON DELETE CASCADE,
+
+    -- entry_index is the position of the entry within the batch.
+    entry_index INTEGER NOT NULL,
+
+    -- leaf_key is the key of the updated leaf.
+    leaf_key BLOB NOT NULL CHECK(length(leaf_key) = 32),
+
+    -- value and sum describe the new leaf. An empty leaf deletes the leaf
+    -- at the key.
+    value BLOB,
+    sum BIGINT NOT NULL,
+
+    -- prev_value and prev_sum describe the leaf before the batch. An empty
+    -- leaf means there was no leaf at the key.
+    prev_value BLOB,
+    prev_sum BIGINT NOT NULL,
+
+    PRIMARY KEY(batch_id, entry_index)
+);
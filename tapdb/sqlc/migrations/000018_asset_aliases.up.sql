@@ -0,0 +1,22 @@
+-- asset_aliases maps stable, human-readable aliases to either an asset ID or
+-- the tweaked group key of an asset group. Each asset ID and group key has at
+-- most one alias.
+CREATE TABLE IF NOT EXISTS asset_aliases (
+    alias_id INTEGER PRIMARY KEY,
+
+    -- alias is the normalized name of the alias.
+    alias VARCHAR NOT NULL UNIQUE,
+
+    -- asset_id is the ID of the asset the alias refers to, or NULL if it
+    -- refers to an asset group.
+    asset_id BLOB UNIQUE CHECK(length(asset_id) = 32),
+
+    -- group_key is the tweaked group key of the asset group the alias
+    -- refers to, or NULL if it refers to an asset ID.
+    group_key BLOB UNIQUE CHECK(length(group_key) = 33),
+
+    -- created_at is the time the alias was last set.
+    created_at TIMESTAMP NOT NULL,
+
+    CHECK((asset_id IS NULL) != (group_key IS NULL))
+);
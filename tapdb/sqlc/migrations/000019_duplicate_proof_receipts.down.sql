@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS duplicate_proof_receipts_anchor_point_idx;
+DROP TABLE IF EXISTS duplicate_proof_receipts;
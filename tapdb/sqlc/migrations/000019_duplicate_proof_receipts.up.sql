@@ -0,0 +1,24 @@
+-- duplicate_proof_receipts is an audit log of proofs that were received for
+-- an asset output that was already credited to the wallet, for example
+-- because the same proof was delivered by multiple couriers or imported
+-- manually as well. Such proofs aren't imported a second time.
+CREATE TABLE IF NOT EXISTS duplicate_proof_receipts (
+    id INTEGER PRIMARY KEY,
+
+    -- anchor_point is the outpoint the received asset is anchored at.
+    anchor_point BLOB NOT NULL,
+
+    -- asset_id is the ID of the received asset.
+    asset_id BLOB NOT NULL CHECK(length(asset_id) = 32),
+
+    -- script_key is the script key of the received asset.
+    script_key BLOB NOT NULL CHECK(length(script_key) = 33),
+
+    -- proof_hash is the sha256 hash of the received proof file.
+    proof_hash BLOB NOT NULL CHECK(length(proof_hash) = 32),
+
+    -- received_at is the time the duplicate proof was received.
+    received_at TIMESTAMP NOT NULL
+);
+CREATE INDEX IF NOT EXISTS duplicate_proof_receipts_anchor_point_idx
+    ON duplicate_proof_receipts(anchor_point);
@@ -0,0 +1,40 @@
+-- watched_assets are assets owned by external keys that are tracked purely
+-- for monitoring, for example the collateral of a counterparty. They are kept
+-- separate from the assets table, so they are never part of the spendable
+-- balance or coin selection.
+CREATE TABLE IF NOT EXISTS watched_assets (
+    id INTEGER PRIMARY KEY,
+
+    -- label is a user defined description of the watched asset.
+    label TEXT NOT NULL,
+
+    asset_id BLOB NOT NULL CHECK(length(asset_id) = 32),
+
+    -- group_key is the tweaked group key of the asset, if it has one.
+    group_key BLOB CHECK(length(group_key) = 33),
+
+    script_key BLOB NOT NULL CHECK(length(script_key) = 33),
+
+    amount BIGINT NOT NULL,
+
+    -- anchor_point is the outpoint the asset is anchored at.
+    anchor_point BLOB NOT NULL,
+
+    -- anchor_pk_script is the pkScript of the anchor output, which is
+    -- needed to watch the output for spends.
+    anchor_pk_script BLOB NOT NULL,
+
+    anchor_height INTEGER NOT NULL,
+
+    proof_file BLOB NOT NULL,
+
+    -- spending_txid is the ID of the transaction that spent the anchor
+    -- output, or NULL if the output is unspent.
+    spending_txid BLOB CHECK(length(spending_txid) = 32),
+
+    spend_height INTEGER,
+
+    created_at TIMESTAMP NOT NULL,
+
+    UNIQUE(anchor_point, asset_id, script_key)
+);
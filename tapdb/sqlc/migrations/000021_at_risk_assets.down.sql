@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS at_risk_assets_anchor_point_idx;
+DROP TABLE IF EXISTS at_risk_assets;
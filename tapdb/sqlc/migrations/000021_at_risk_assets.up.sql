@@ -0,0 +1,31 @@
+-- at_risk_assets stores owned assets whose anchor output was spent by a
+-- transaction that wasn't created by this daemon, for example because a key
+-- was compromised or an external co-signer moved the output. Entries are only
+-- inserted when such a spend is detected and are never removed automatically.
+CREATE TABLE IF NOT EXISTS at_risk_assets (
+    id INTEGER PRIMARY KEY,
+
+    -- anchor_point is the spent anchor outpoint of the asset.
+    anchor_point BLOB NOT NULL,
+
+    asset_id BLOB NOT NULL CHECK(length(asset_id) = 32),
+
+    -- script_key is the tweaked script key of the asset.
+    script_key BLOB NOT NULL CHECK(length(script_key) = 33),
+
+    amount BIGINT NOT NULL,
+
+    -- spending_txid is the ID of the unknown transaction that spent the
+    -- anchor output.
+    spending_txid BLOB NOT NULL CHECK(length(spending_txid) = 32),
+
+    spend_height INTEGER NOT NULL,
+
+    -- detected_at is the time the spend of the anchor output was detected.
+    detected_at TIMESTAMP NOT NULL,
+
+    UNIQUE(anchor_point, script_key)
+);
+
+CREATE INDEX IF NOT EXISTS at_risk_assets_anchor_point_idx
+    ON at_risk_assets(anchor_point);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS airdrop_recipients;
+DROP TABLE IF EXISTS airdrops;
@@ -0,0 +1,46 @@
+-- airdrops stores the sends of one or more assets to a manifest of many
+-- recipients, which are split into multiple batched transfers.
+CREATE TABLE IF NOT EXISTS airdrops (
+    id INTEGER PRIMARY KEY,
+
+    -- label is a user defined description of the airdrop.
+    label TEXT NOT NULL,
+
+    -- max_recipients_per_tx is the maximum number of recipients that are
+    -- paid by a single anchor transaction.
+    max_recipients_per_tx INTEGER NOT NULL,
+
+    created_at TIMESTAMP NOT NULL
+);
+
+-- airdrop_recipients stores the recipients of an airdrop and the status of
+-- their send.
+CREATE TABLE IF NOT EXISTS airdrop_recipients (
+    id INTEGER PRIMARY KEY,
+
+    airdrop_id INTEGER NOT NULL REFERENCES airdrops(id) ON DELETE CASCADE,
+
+    -- recipient_index is the position of the recipient in the manifest.
+    recipient_index INTEGER NOT NULL,
+
+    -- tap_addr is the bech32m encoded Taproot Asset address.
+    tap_addr TEXT NOT NULL,
+
+    asset_id BLOB NOT NULL CHECK(length(asset_id) = 32),
+
+    amount BIGINT NOT NULL,
+
+    -- status is the status of the send to the recipient.
+    status SMALLINT NOT NULL,
+
+    -- anchor_txid is the ID of the anchor transaction that paid the
+    -- recipient, or NULL if it wasn't paid yet.
+    anchor_txid BLOB CHECK(length(anchor_txid) = 32),
+
+    -- error_msg is the error the last send attempt failed with.
+    error_msg TEXT NOT NULL,
+
+    updated_at TIMESTAMP NOT NULL,
+
+    UNIQUE(airdrop_id, recipient_index)
+);
@@ -0,0 +1,16 @@
+-- transfer_broadcast_triggers holds the earliest point at which the anchor
+-- transaction of a scheduled transfer may be broadcast. Transfers without an
+-- entry are broadcast as soon as they're committed.
+CREATE TABLE IF NOT EXISTS transfer_broadcast_triggers (
+    id INTEGER PRIMARY KEY,
+
+    transfer_id INTEGER NOT NULL UNIQUE REFERENCES asset_transfers(id),
+
+    -- min_height is the earliest block height at which the anchor
+    -- transaction is broadcast. Zero means there is no height constraint.
+    min_height INTEGER NOT NULL,
+
+    -- min_time is the earliest time at which the anchor transaction is
+    -- broadcast. NULL means there is no time constraint.
+    min_time TIMESTAMP
+);
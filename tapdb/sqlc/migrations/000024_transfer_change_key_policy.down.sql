@@ -0,0 +1 @@
+ALTER TABLE asset_transfers DROP COLUMN change_key_policy;
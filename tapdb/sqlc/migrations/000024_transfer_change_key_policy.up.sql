@@ -0,0 +1,5 @@
+-- change_key_policy is the policy the script key of the change output of a
+-- transfer was derived with. Transfers created before the policy was recorded
+-- always rotated their change keys, which is the default policy 0.
+ALTER TABLE asset_transfers
+    ADD COLUMN change_key_policy SMALLINT NOT NULL DEFAULT 0;
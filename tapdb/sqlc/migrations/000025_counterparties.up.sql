@@ -0,0 +1,21 @@
+-- counterparties maps the script keys of known receivers of outbound
+-- transfers to human-readable names. A counterparty that uses several script
+-- keys has one row per script key, all with the same name.
+CREATE TABLE IF NOT EXISTS counterparties (
+    counterparty_id INTEGER PRIMARY KEY,
+
+    -- name is the human-readable name of the counterparty.
+    name VARCHAR NOT NULL,
+
+    -- script_key is the script key the counterparty receives assets on.
+    script_key BLOB NOT NULL UNIQUE CHECK(length(script_key) = 33),
+
+    -- tap_addr is the encoded address the script key was taken from, if
+    -- the counterparty was added by address.
+    tap_addr VARCHAR,
+
+    -- created_at is the time the counterparty was last set.
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS counterparties_name_idx ON counterparties(name);
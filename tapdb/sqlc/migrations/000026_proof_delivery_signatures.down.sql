@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS proof_delivery_signatures_anchor_point_idx;
+DROP TABLE IF EXISTS proof_delivery_signatures;
@@ -0,0 +1,30 @@
+-- proof_delivery_signatures stores the signatures of the nodes that delivered
+-- received proofs through a proof courier. A signature commits to the proof
+-- and the script key of the receiver, so it proves which node sent the proof.
+CREATE TABLE IF NOT EXISTS proof_delivery_signatures (
+    id INTEGER PRIMARY KEY,
+
+    -- anchor_point is the outpoint the received asset is anchored at.
+    anchor_point BLOB NOT NULL,
+
+    -- asset_id is the ID of the received asset.
+    asset_id BLOB NOT NULL CHECK(length(asset_id) = 32),
+
+    -- script_key is the script key of the received asset.
+    script_key BLOB NOT NULL CHECK(length(script_key) = 33),
+
+    -- proof_hash is the sha256 hash of the received proof file.
+    proof_hash BLOB NOT NULL CHECK(length(proof_hash) = 32),
+
+    -- sender_node_key is the identity key of the node that sent the proof.
+    sender_node_key BLOB NOT NULL CHECK(length(sender_node_key) = 33),
+
+    -- signature is the DER encoded signature of the sender over the
+    -- delivery.
+    signature BLOB NOT NULL,
+
+    -- received_at is the time the proof was received.
+    received_at TIMESTAMP NOT NULL
+);
+CREATE INDEX IF NOT EXISTS proof_delivery_signatures_anchor_point_idx
+    ON proof_delivery_signatures(anchor_point);
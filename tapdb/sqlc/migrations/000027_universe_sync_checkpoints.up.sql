@@ -0,0 +1,45 @@
+-- universe_sync_checkpoints records the progress of syncing a single universe
+-- with a remote universe server. Interrupted syncs are resumed from the
+-- checkpoint instead of being restarted from scratch.
+CREATE TABLE IF NOT EXISTS universe_sync_checkpoints (
+    id INTEGER PRIMARY KEY,
+
+    -- server_host is the host of the remote universe server.
+    server_host TEXT NOT NULL,
+
+    -- universe_id is the identifier of the synced universe, which is
+    -- either the asset ID or the hash of the group key.
+    universe_id BLOB NOT NULL CHECK(length(universe_id) = 32),
+
+    -- asset_id is the asset ID of the synced universe.
+    asset_id BLOB CHECK(length(asset_id) = 32),
+
+    -- group_key is the x-only group key of the synced universe, if it is
+    -- a grouped asset.
+    group_key BLOB CHECK(length(group_key) = 32),
+
+    -- root_hash and root_sum make up the remote universe root that is
+    -- synced to.
+    root_hash BLOB NOT NULL CHECK(length(root_hash) = 32),
+
+    root_sum BIGINT NOT NULL,
+
+    -- leaves_fetched is the number of leaves that were fetched from the
+    -- remote server while syncing to the root.
+    leaves_fetched BIGINT NOT NULL,
+
+    -- leaves_total is the number of leaves that were missing locally when
+    -- the sync to the root started.
+    leaves_total BIGINT NOT NULL,
+
+    -- complete is true once all missing leaves were fetched.
+    complete BOOLEAN NOT NULL,
+
+    -- last_error is the error the last sync attempt failed with, if any.
+    last_error TEXT,
+
+    -- updated_at is the time the checkpoint was last updated.
+    updated_at TIMESTAMP NOT NULL,
+
+    UNIQUE(server_host, universe_id)
+);
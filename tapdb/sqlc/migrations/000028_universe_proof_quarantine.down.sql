@@ -0,0 +1 @@
+DROP TABLE IF EXISTS universe_proof_quarantine;
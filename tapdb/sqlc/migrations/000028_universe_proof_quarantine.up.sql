@@ -0,0 +1,41 @@
+-- universe_proof_quarantine holds proofs that were fetched from remote
+-- universe servers during a sync but failed validation. They are kept out of
+-- the local universe trees, so invalid data isn't propagated further.
+CREATE TABLE IF NOT EXISTS universe_proof_quarantine (
+    id INTEGER PRIMARY KEY,
+
+    -- server_host is the host of the remote universe server the proof was
+    -- fetched from.
+    server_host TEXT NOT NULL,
+
+    -- universe_id is the identifier of the universe the proof was fetched
+    -- for, which is either the asset ID or the hash of the group key.
+    universe_id BLOB NOT NULL CHECK(length(universe_id) = 32),
+
+    -- asset_id is the asset ID of the universe.
+    asset_id BLOB CHECK(length(asset_id) = 32),
+
+    -- group_key is the x-only group key of the universe, if it is a
+    -- grouped asset.
+    group_key BLOB CHECK(length(group_key) = 32),
+
+    -- leaf_key is the universe key of the leaf the proof was fetched for.
+    leaf_key BLOB NOT NULL CHECK(length(leaf_key) = 32),
+
+    -- minting_point and script_key make up the leaf the proof was fetched
+    -- for.
+    minting_point BLOB NOT NULL,
+
+    script_key BLOB NOT NULL CHECK(length(script_key) = 33),
+
+    -- proof is the rejected issuance proof.
+    proof BLOB,
+
+    -- reason is the reason the proof was rejected for.
+    reason TEXT NOT NULL,
+
+    -- quarantined_at is the time the proof was last quarantined.
+    quarantined_at TIMESTAMP NOT NULL,
+
+    UNIQUE(server_host, universe_id, leaf_key)
+);
@@ -0,0 +1,15 @@
+-- tombstone_sweeps records the anchor outputs that only committed to
+-- tombstone asset outputs and were swept back to the wallet. Swept anchor
+-- outputs are no longer considered for sweeping.
+CREATE TABLE IF NOT EXISTS tombstone_sweeps (
+    id INTEGER PRIMARY KEY,
+
+    -- anchor_point is the outpoint of the swept anchor output.
+    anchor_point BLOB NOT NULL UNIQUE,
+
+    -- sweep_txid is the ID of the transaction that swept the anchor output.
+    sweep_txid BLOB NOT NULL CHECK(length(sweep_txid) = 32),
+
+    -- swept_at is the time the sweep transaction was published.
+    swept_at TIMESTAMP NOT NULL
+);
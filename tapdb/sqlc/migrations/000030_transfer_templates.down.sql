@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS transfer_template_recipients;
+DROP TABLE IF EXISTS transfer_templates;
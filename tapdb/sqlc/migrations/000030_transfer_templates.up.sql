@@ -0,0 +1,61 @@
+-- transfer_templates stores named sets of recipients of an asset that can be
+-- paid repeatedly with a single call.
+CREATE TABLE IF NOT EXISTS transfer_templates (
+    id INTEGER PRIMARY KEY,
+
+    -- name is the unique user defined name of the template.
+    name TEXT NOT NULL UNIQUE,
+
+    asset_id BLOB NOT NULL CHECK(length(asset_id) = 32),
+
+    -- priority is the priority class the transfers are sent with.
+    priority SMALLINT NOT NULL,
+
+    -- conf_target_seconds is the time after the execution of the template
+    -- the transfer should be confirmed by, or zero if it has no deadline.
+    conf_target_seconds BIGINT NOT NULL,
+
+    -- fee_bump_interval_seconds is the time between two fee bumps.
+    fee_bump_interval_seconds BIGINT NOT NULL,
+
+    -- fee_bump_percent is the percentage the fee rate is raised by with
+    -- each bump.
+    fee_bump_percent INTEGER NOT NULL,
+
+    -- max_fee_rate is the fee rate in sat/kw that is never exceeded by a
+    -- bump, or zero if it isn't capped.
+    max_fee_rate BIGINT NOT NULL,
+
+    created_at TIMESTAMP NOT NULL
+);
+
+-- transfer_template_recipients stores the recipients of a transfer template.
+-- A recipient is either paid to a fixed address, or to a script key and
+-- internal key with a fixed amount or a share of the total amount.
+CREATE TABLE IF NOT EXISTS transfer_template_recipients (
+    id INTEGER PRIMARY KEY,
+
+    template_id INTEGER NOT NULL
+        REFERENCES transfer_templates(id) ON DELETE CASCADE,
+
+    -- recipient_index is the position of the recipient in the template.
+    recipient_index INTEGER NOT NULL,
+
+    -- tap_addr is the bech32m encoded Taproot Asset address, or NULL if
+    -- the recipient is given by its keys.
+    tap_addr TEXT,
+
+    script_key BLOB CHECK(length(script_key) = 33),
+
+    internal_key BLOB CHECK(length(internal_key) = 33),
+
+    -- amount is the fixed amount the recipient receives, or zero if it
+    -- receives a share.
+    amount BIGINT NOT NULL,
+
+    -- share_bps is the share of the total amount the recipient receives in
+    -- basis points, or zero if it receives a fixed amount.
+    share_bps INTEGER NOT NULL,
+
+    UNIQUE(template_id, recipient_index)
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS imported_script_keys;
@@ -0,0 +1,10 @@
+-- imported_script_keys stores the script keys that were derived outside of the
+-- wallet and imported, so assets sent to them are treated as our own.
+CREATE TABLE IF NOT EXISTS imported_script_keys (
+    id INTEGER PRIMARY KEY,
+
+    script_key_id INTEGER NOT NULL UNIQUE
+        REFERENCES script_keys(script_key_id),
+
+    imported_at TIMESTAMP NOT NULL
+);
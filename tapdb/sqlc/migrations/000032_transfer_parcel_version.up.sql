@@ -0,0 +1,5 @@
+-- parcel_version is the version of the encoding a pending transfer was
+-- persisted with. Transfers persisted before the version was recorded are
+-- version 0 and are migrated when they're resumed.
+ALTER TABLE asset_transfers
+    ADD COLUMN parcel_version SMALLINT NOT NULL DEFAULT 0;
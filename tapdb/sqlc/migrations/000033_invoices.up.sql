@@ -0,0 +1,26 @@
+-- invoices stores the invoices the node created to request a payment to one
+-- of its addresses, and whether they were settled.
+CREATE TABLE IF NOT EXISTS invoices (
+    id INTEGER PRIMARY KEY,
+
+    -- invoice_id is the random identifier of the invoice.
+    invoice_id BLOB NOT NULL UNIQUE CHECK(length(invoice_id) = 32),
+
+    -- addr_id points to the address the payment should be sent to. Each
+    -- invoice gets its own address, so a payment settles a single invoice.
+    addr_id INTEGER NOT NULL UNIQUE REFERENCES addrs(id),
+
+    -- invoice is the serialized, signed invoice.
+    invoice BLOB NOT NULL,
+
+    -- created_at is the time the invoice was created.
+    created_at TIMESTAMP NOT NULL,
+
+    -- settled_at is the time the payment of the invoice was received, or
+    -- NULL if the invoice wasn't settled yet.
+    settled_at TIMESTAMP,
+
+    -- settle_outpoint is the outpoint the payment of the invoice was
+    -- received in, or NULL if the invoice wasn't settled yet.
+    settle_outpoint BLOB
+);
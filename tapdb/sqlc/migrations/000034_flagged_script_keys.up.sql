@@ -0,0 +1,25 @@
+-- flagged_script_keys stores the script keys the script key audit found to be
+-- used more than once. No assets are sent to a script key while it has an
+-- unresolved flag.
+CREATE TABLE IF NOT EXISTS flagged_script_keys (
+    id INTEGER PRIMARY KEY,
+
+    -- tweaked_script_key is the script key that was flagged.
+    tweaked_script_key BLOB NOT NULL CHECK(length(tweaked_script_key) = 33),
+
+    -- conflict is the kind of reuse the script key was flagged for.
+    conflict SMALLINT NOT NULL,
+
+    -- num_occurrences is the number of outputs the script key was found in
+    -- when it was last flagged.
+    num_occurrences INTEGER NOT NULL,
+
+    -- flagged_at is the time the script key was flagged.
+    flagged_at TIMESTAMP NOT NULL,
+
+    -- resolved_at is the time the flag was resolved, or NULL if the flag
+    -- wasn't resolved yet.
+    resolved_at TIMESTAMP,
+
+    UNIQUE(tweaked_script_key, conflict)
+);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS macaroon_send_reservations_anchor_txid_idx;
+DROP INDEX IF EXISTS macaroon_send_reservations_day_idx;
+DROP TABLE IF EXISTS macaroon_send_amounts;
+DROP TABLE IF EXISTS macaroon_send_reservations;
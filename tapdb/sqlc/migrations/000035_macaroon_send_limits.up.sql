@@ -0,0 +1,38 @@
+-- macaroon_send_reservations stores the sends that are counted against the
+-- daily send limit of a macaroon, keyed by the macaroon and the day they were
+-- made on.
+CREATE TABLE IF NOT EXISTS macaroon_send_reservations (
+    id INTEGER PRIMARY KEY,
+
+    -- macaroon_id is the hex encoded ID of the macaroon the send was made
+    -- with.
+    macaroon_id TEXT NOT NULL,
+
+    -- send_day is the UTC day the send was made on, counted in days since
+    -- the unix epoch.
+    send_day BIGINT NOT NULL,
+
+    -- anchor_txid is the ID of the anchor transaction of the send, or NULL
+    -- if the send wasn't committed to a transfer yet.
+    anchor_txid BLOB CHECK(length(anchor_txid) = 32),
+
+    reserved_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS macaroon_send_reservations_day_idx
+    ON macaroon_send_reservations (macaroon_id, send_day);
+
+CREATE INDEX IF NOT EXISTS macaroon_send_reservations_anchor_txid_idx
+    ON macaroon_send_reservations (anchor_txid);
+
+-- macaroon_send_amounts stores the amount of each asset a reserved send moves.
+CREATE TABLE IF NOT EXISTS macaroon_send_amounts (
+    reservation_id INTEGER NOT NULL
+        REFERENCES macaroon_send_reservations(id) ON DELETE CASCADE,
+
+    asset_id BLOB NOT NULL CHECK(length(asset_id) = 32),
+
+    amount BIGINT NOT NULL,
+
+    UNIQUE(reservation_id, asset_id)
+);
@@ -24,6 +24,13 @@ type Addr struct {
 	ManagedFrom      sql.NullTime
 }
 
+type AddrDepositExpectation struct {
+	ID             int32
+	AddrID         int32
+	ExpectedAmount int64
+	Expiry         sql.NullTime
+}
+
 type AddrEvent struct {
 	ID                  int32
 	CreationTime        time.Time
@@ -34,6 +41,27 @@ type AddrEvent struct {
 	ManagedUtxoID       int32
 	AssetProofID        sql.NullInt32
 	AssetID             sql.NullInt32
+	DepositStatus       sql.NullInt16
+}
+
+type Airdrop struct {
+	ID                 int32
+	Label              string
+	MaxRecipientsPerTx int32
+	CreatedAt          time.Time
+}
+
+type AirdropRecipient struct {
+	ID             int32
+	AirdropID      int32
+	RecipientIndex int32
+	TapAddr        string
+	AssetID        []byte
+	Amount         int64
+	Status         int16
+	AnchorTxid     []byte
+	ErrorMsg       string
+	UpdatedAt      time.Time
 }
 
 type Asset struct {
@@ -52,6 +80,14 @@ type Asset struct {
 	Spent                    bool
 }
 
+type AssetAlias struct {
+	AliasID   int32
+	Alias     string
+	AssetID   []byte
+	GroupKey  []byte
+	CreatedAt time.Time
+}
+
 type AssetGroup struct {
 	GroupID         int32
 	TweakedGroupKey []byte
@@ -66,6 +102,25 @@ type AssetGroupSig struct {
 	GroupKeyID int32
 }
 
+type AssetBalanceSnapshot struct {
+	SnapshotID int32
+	AssetID    []byte
+	Height     int32
+	TakenAt    time.Time
+	Balance    int64
+	UtxoCount  int32
+}
+
+type AssetLot struct {
+	LotID            int32
+	AnchorPoint      []byte
+	AssetID          []byte
+	ScriptKey        []byte
+	AcquiredAt       sql.NullTime
+	SourceTransferID sql.NullInt32
+	PriceAnnotation  sql.NullString
+}
+
 type AssetMintingBatch struct {
 	BatchID           int32
 	BatchState        int16
@@ -99,6 +154,8 @@ type AssetTransfer struct {
 	HeightHint       int32
 	AnchorTxnID      int32
 	TransferTimeUnix time.Time
+	ChangeKeyPolicy  int16
+	ParcelVersion    int16
 }
 
 type AssetTransferInput struct {
@@ -123,6 +180,7 @@ type AssetTransferOutput struct {
 	ProofSuffix              []byte
 	NumPassiveAssets         int32
 	OutputType               int16
+	ReceiverSettledAt        sql.NullTime
 }
 
 type AssetWitness struct {
@@ -142,6 +200,17 @@ type AssetsMetum struct {
 	MetaDataType sql.NullInt16
 }
 
+type AtRiskAsset struct {
+	ID           int32
+	AnchorPoint  []byte
+	AssetID      []byte
+	ScriptKey    []byte
+	Amount       int64
+	SpendingTxid []byte
+	SpendHeight  int32
+	DetectedAt   time.Time
+}
+
 type ChainTxn struct {
 	TxnID       int32
 	Txid        []byte
@@ -152,6 +221,40 @@ type ChainTxn struct {
 	TxIndex     sql.NullInt32
 }
 
+type Counterparty struct {
+	CounterpartyID int32
+	Name           string
+	ScriptKey      []byte
+	TapAddr        sql.NullString
+	CreatedAt      time.Time
+}
+
+type DuplicateProofReceipt struct {
+	ID          int32
+	AnchorPoint []byte
+	AssetID     []byte
+	ScriptKey   []byte
+	ProofHash   []byte
+	ReceivedAt  time.Time
+}
+
+type FlaggedScriptKey struct {
+	ID               int32
+	TweakedScriptKey []byte
+	Conflict         int16
+	NumOccurrences   int32
+	FlaggedAt        time.Time
+	ResolvedAt       sql.NullTime
+}
+
+type FrozenAssetOutput struct {
+	ID        int32
+	Outpoint  []byte
+	ScriptKey []byte
+	Reason    string
+	FrozenAt  time.Time
+}
+
 type GenesisAsset struct {
 	GenAssetID     int32
 	AssetID        []byte
@@ -179,6 +282,12 @@ type GenesisPoint struct {
 	AnchorTxID sql.NullInt32
 }
 
+type ImportedScriptKey struct {
+	ID          int32
+	ScriptKeyID int32
+	ImportedAt  time.Time
+}
+
 type InternalKey struct {
 	KeyID     int32
 	RawKey    []byte
@@ -186,6 +295,24 @@ type InternalKey struct {
 	KeyIndex  int32
 }
 
+type Invoice struct {
+	ID             int32
+	InvoiceID      []byte
+	AddrID         int32
+	Invoice        []byte
+	CreatedAt      time.Time
+	SettledAt      sql.NullTime
+	SettleOutpoint []byte
+}
+
+type KeyDerivation struct {
+	ID            int32
+	InternalKeyID int32
+	Purpose       int16
+	Object        string
+	DerivedAt     time.Time
+}
+
 type KeyGroupInfoView struct {
 	SigID           int32
 	GenAssetID      int32
@@ -201,6 +328,20 @@ type Macaroon struct {
 	RootKey []byte
 }
 
+type MacaroonSendAmount struct {
+	ReservationID int32
+	AssetID       []byte
+	Amount        int64
+}
+
+type MacaroonSendReservation struct {
+	ID         int32
+	MacaroonID string
+	SendDay    int64
+	AnchorTxid []byte
+	ReservedAt time.Time
+}
+
 type ManagedUtxo struct {
 	UtxoID           int32
 	Outpoint         []byte
@@ -229,6 +370,42 @@ type MssmtRoot struct {
 	RootHash  []byte
 }
 
+type MssmtWalBatch struct {
+	BatchID      int32
+	Namespace    string
+	Generation   int64
+	PrevRootHash []byte
+	PrevRootSum  int64
+	RootHash     []byte
+	RootSum      sql.NullInt64
+	State        int16
+	CreatedAt    time.Time
+}
+
+type MssmtWalEntry struct {
+	BatchID    int32
+	EntryIndex int32
+	LeafKey    []byte
+	Value      []byte
+	Sum        int64
+	PrevValue  []byte
+	PrevSum    int64
+}
+
+type ParcelRequest struct {
+	ID        int32
+	RequestID []byte
+	Priority  int16
+	CreatedAt time.Time
+}
+
+type ParcelRequestAddr struct {
+	ID        int32
+	RequestID int32
+	AddrIndex int32
+	TapAddr   string
+}
+
 type PassiveAsset struct {
 	PassiveID       int32
 	TransferID      int32
@@ -239,6 +416,30 @@ type PassiveAsset struct {
 	NewProof        []byte
 }
 
+type ProofDeliverySignature struct {
+	ID            int32
+	AnchorPoint   []byte
+	AssetID       []byte
+	ScriptKey     []byte
+	ProofHash     []byte
+	SenderNodeKey []byte
+	Signature     []byte
+	ReceivedAt    time.Time
+}
+
+type ProofCourierOutbox struct {
+	ID                int32
+	ProofLocatorHash  []byte
+	AnchorTxid        []byte
+	AnchorOutputIndex int32
+	ScriptKey         []byte
+	AssetID           []byte
+	CourierAddr       string
+	AttemptTime       time.Time
+	ErrorMsg          sql.NullString
+	NextAttemptTime   sql.NullTime
+}
+
 type ReceiverProofTransferAttempt struct {
 	ProofLocatorHash []byte
 	TimeUnix         time.Time
@@ -251,6 +452,43 @@ type ScriptKey struct {
 	Tweak            []byte
 }
 
+type TombstoneSweep struct {
+	ID          int32
+	AnchorPoint []byte
+	SweepTxid   []byte
+	SweptAt     time.Time
+}
+
+type TransferBroadcastTrigger struct {
+	ID         int32
+	TransferID int32
+	MinHeight  int32
+	MinTime    sql.NullTime
+}
+
+type TransferTemplate struct {
+	ID                     int32
+	Name                   string
+	AssetID                []byte
+	Priority               int16
+	ConfTargetSeconds      int64
+	FeeBumpIntervalSeconds int64
+	FeeBumpPercent         int32
+	MaxFeeRate             int64
+	CreatedAt              time.Time
+}
+
+type TransferTemplateRecipient struct {
+	ID             int32
+	TemplateID     int32
+	RecipientIndex int32
+	TapAddr        sql.NullString
+	ScriptKey      []byte
+	InternalKey    []byte
+	Amount         int64
+	ShareBps       int32
+}
+
 type UniverseEvent struct {
 	EventID        int32
 	EventType      string
@@ -269,6 +507,20 @@ type UniverseLeafe struct {
 	LeafNodeNamespace string
 }
 
+type UniverseProofQuarantine struct {
+	ID            int32
+	ServerHost    string
+	UniverseID    []byte
+	AssetID       []byte
+	GroupKey      []byte
+	LeafKey       []byte
+	MintingPoint  []byte
+	ScriptKey     []byte
+	Proof         []byte
+	Reason        string
+	QuarantinedAt time.Time
+}
+
 type UniverseRoot struct {
 	ID            int32
 	NamespaceRoot string
@@ -289,3 +541,34 @@ type UniverseStat struct {
 	GroupKey         []byte
 	NamespaceRoot    string
 }
+
+type UniverseSyncCheckpoint struct {
+	ID            int32
+	ServerHost    string
+	UniverseID    []byte
+	AssetID       []byte
+	GroupKey      []byte
+	RootHash      []byte
+	RootSum       int64
+	LeavesFetched int64
+	LeavesTotal   int64
+	Complete      bool
+	LastError     sql.NullString
+	UpdatedAt     time.Time
+}
+
+type WatchedAsset struct {
+	ID             int32
+	Label          string
+	AssetID        []byte
+	GroupKey       []byte
+	ScriptKey      []byte
+	Amount         int64
+	AnchorPoint    []byte
+	AnchorPkScript []byte
+	AnchorHeight   int32
+	ProofFile      []byte
+	SpendingTxid   []byte
+	SpendHeight    sql.NullInt32
+	CreatedAt      time.Time
+}
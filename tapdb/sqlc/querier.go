@@ -23,24 +23,44 @@ type Querier interface {
 	ConfirmChainAnchorTx(ctx context.Context, arg ConfirmChainAnchorTxParams) error
 	ConfirmChainTx(ctx context.Context, arg ConfirmChainTxParams) error
 	DeleteAllNodes(ctx context.Context, namespace string) (int64, error)
+	DeleteAssetAlias(ctx context.Context, alias string) (int64, error)
+	DeleteAssetAliasesByTarget(ctx context.Context, arg DeleteAssetAliasesByTargetParams) error
+	DeleteAssetTransfer(ctx context.Context, id int32) error
+	DeleteAssetTransferInputs(ctx context.Context, transferID int32) error
+	DeleteAssetTransferOutputs(ctx context.Context, transferID int32) error
 	DeleteAssetWitnesses(ctx context.Context, assetID int32) error
+	DeleteChainTx(ctx context.Context, txid []byte) error
+	DeleteCounterparty(ctx context.Context, name string) (int64, error)
 	DeleteExpiredUTXOLeases(ctx context.Context, now sql.NullTime) error
 	DeleteManagedUTXO(ctx context.Context, outpoint []byte) error
 	DeleteNode(ctx context.Context, arg DeleteNodeParams) (int64, error)
+	DeleteParcelRequest(ctx context.Context, requestID []byte) error
+	DeletePassiveAssets(ctx context.Context, transferID int32) error
+	DeleteQuarantinedProofs(ctx context.Context, arg DeleteQuarantinedProofsParams) (int64, error)
 	DeleteRoot(ctx context.Context, namespace string) (int64, error)
+	DeleteSendReservation(ctx context.Context, id int32) (int64, error)
+	DeleteSendReservationsBefore(ctx context.Context, sendDay int64) error
+	DeleteTransferBroadcastTrigger(ctx context.Context, transferID int32) error
+	DeleteTransferSendReservations(ctx context.Context, anchorTxid []byte) (int64, error)
+	DeleteTransferTemplate(ctx context.Context, name string) (int64, error)
+	DeleteTreeWalBatches(ctx context.Context, arg DeleteTreeWalBatchesParams) error
 	DeleteUTXOLease(ctx context.Context, outpoint []byte) error
 	DeleteUniverseEvents(ctx context.Context, namespaceRoot string) error
 	DeleteUniverseLeaves(ctx context.Context, namespace string) error
 	DeleteUniverseRoot(ctx context.Context, namespaceRoot string) error
 	DeleteUniverseServer(ctx context.Context, arg DeleteUniverseServerParams) error
+	DeleteWatchedAsset(ctx context.Context, id int32) (int64, error)
 	FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutputKey []byte) (FetchAddrByTaprootOutputKeyRow, error)
 	FetchAddrEvent(ctx context.Context, id int32) (FetchAddrEventRow, error)
 	FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]FetchAddrsRow, error)
 	FetchAllNodes(ctx context.Context) ([]MssmtNode, error)
+	FetchAssetByAnchor(ctx context.Context, arg FetchAssetByAnchorParams) (int32, error)
+	FetchAssetLot(ctx context.Context, arg FetchAssetLotParams) (FetchAssetLotRow, error)
 	FetchAssetMeta(ctx context.Context, metaID int32) (FetchAssetMetaRow, error)
 	FetchAssetMetaByHash(ctx context.Context, metaDataHash []byte) (FetchAssetMetaByHashRow, error)
 	FetchAssetMetaForAsset(ctx context.Context, assetID []byte) (FetchAssetMetaForAssetRow, error)
 	FetchAssetProof(ctx context.Context, tweakedScriptKey []byte) (FetchAssetProofRow, error)
+	FetchAssetProofKeys(ctx context.Context) ([]FetchAssetProofKeysRow, error)
 	FetchAssetProofs(ctx context.Context) ([]FetchAssetProofsRow, error)
 	FetchAssetProofsByAssetID(ctx context.Context, assetID []byte) ([]FetchAssetProofsByAssetIDRow, error)
 	FetchAssetWitnesses(ctx context.Context, assetID sql.NullInt32) ([]FetchAssetWitnessesRow, error)
@@ -50,6 +70,7 @@ type Querier interface {
 	// doesn't have a group key. See the comment in fetchAssetSprouts for a work
 	// around that needs to be used with this query until a sqlc bug is fixed.
 	FetchAssetsForBatch(ctx context.Context, rawKey []byte) ([]FetchAssetsForBatchRow, error)
+	FetchBatchAssetAnchors(ctx context.Context, rawKey []byte) ([]FetchBatchAssetAnchorsRow, error)
 	FetchChainTx(ctx context.Context, txid []byte) (ChainTxn, error)
 	FetchChildren(ctx context.Context, arg FetchChildrenParams) ([]FetchChildrenRow, error)
 	FetchChildrenSelfJoin(ctx context.Context, arg FetchChildrenSelfJoinParams) ([]FetchChildrenSelfJoinRow, error)
@@ -57,10 +78,12 @@ type Querier interface {
 	FetchGenesisByID(ctx context.Context, genAssetID int32) (FetchGenesisByIDRow, error)
 	FetchGenesisID(ctx context.Context, arg FetchGenesisIDParams) (int32, error)
 	FetchGenesisPointByAnchorTx(ctx context.Context, anchorTxID sql.NullInt32) (GenesisPoint, error)
+	FetchGroupAssetIDs(ctx context.Context, groupKey []byte) ([][]byte, error)
 	FetchGroupByGenesis(ctx context.Context, genesisID int32) (FetchGroupByGenesisRow, error)
 	// Sort and limit to return the genesis ID for initial genesis of the group.
 	FetchGroupByGroupKey(ctx context.Context, groupKey []byte) (FetchGroupByGroupKeyRow, error)
 	FetchGroupedAssets(ctx context.Context) ([]FetchGroupedAssetsRow, error)
+	FetchLatestTreeWalBatch(ctx context.Context, namespace string) (MssmtWalBatch, error)
 	FetchManagedUTXO(ctx context.Context, arg FetchManagedUTXOParams) (FetchManagedUTXORow, error)
 	FetchManagedUTXOs(ctx context.Context) ([]FetchManagedUTXOsRow, error)
 	FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMintingBatchRow, error)
@@ -71,33 +94,63 @@ type Querier interface {
 	FetchSeedlingByID(ctx context.Context, seedlingID int32) (AssetSeedling, error)
 	FetchSeedlingID(ctx context.Context, arg FetchSeedlingIDParams) (int32, error)
 	FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]FetchSeedlingsForBatchRow, error)
+	FetchTransferBroadcastTrigger(ctx context.Context, transferID int32) (FetchTransferBroadcastTriggerRow, error)
 	FetchTransferInputs(ctx context.Context, transferID int32) ([]FetchTransferInputsRow, error)
 	FetchTransferOutputs(ctx context.Context, transferID int32) ([]FetchTransferOutputsRow, error)
+	FetchTreeWalEntries(ctx context.Context, batchID int32) ([]MssmtWalEntry, error)
+	FetchTreeWalNamespaces(ctx context.Context) ([]string, error)
 	FetchUniverseKeys(ctx context.Context, namespace string) ([]FetchUniverseKeysRow, error)
 	FetchUniverseRoot(ctx context.Context, namespace string) (FetchUniverseRootRow, error)
+	FinishTreeWalBatch(ctx context.Context, arg FinishTreeWalBatchParams) error
+	FreezeAssetOutput(ctx context.Context, arg FreezeAssetOutputParams) error
 	GenesisAssets(ctx context.Context) ([]GenesisAsset, error)
 	GenesisPoints(ctx context.Context) ([]GenesisPoint, error)
 	GetRootKey(ctx context.Context, id []byte) (Macaroon, error)
 	InsertAddr(ctx context.Context, arg InsertAddrParams) (int32, error)
+	InsertAirdrop(ctx context.Context, arg InsertAirdropParams) (int32, error)
+	InsertAirdropRecipient(ctx context.Context, arg InsertAirdropRecipientParams) error
+	InsertAssetLot(ctx context.Context, arg InsertAssetLotParams) error
 	InsertAssetSeedling(ctx context.Context, arg InsertAssetSeedlingParams) error
 	InsertAssetSeedlingIntoBatch(ctx context.Context, arg InsertAssetSeedlingIntoBatchParams) error
 	InsertAssetTransfer(ctx context.Context, arg InsertAssetTransferParams) (int32, error)
 	InsertAssetTransferInput(ctx context.Context, arg InsertAssetTransferInputParams) error
 	InsertAssetTransferOutput(ctx context.Context, arg InsertAssetTransferOutputParams) error
 	InsertAssetWitness(ctx context.Context, arg InsertAssetWitnessParams) error
+	InsertAtRiskAsset(ctx context.Context, arg InsertAtRiskAssetParams) error
 	InsertBranch(ctx context.Context, arg InsertBranchParams) error
 	InsertCompactedLeaf(ctx context.Context, arg InsertCompactedLeafParams) error
+	InsertDuplicateProofReceipt(ctx context.Context, arg InsertDuplicateProofReceiptParams) error
+	InsertImportedScriptKey(ctx context.Context, arg InsertImportedScriptKeyParams) error
+	InsertInvoice(ctx context.Context, arg InsertInvoiceParams) error
+	InsertKeyDerivation(ctx context.Context, arg InsertKeyDerivationParams) error
 	InsertLeaf(ctx context.Context, arg InsertLeafParams) error
 	InsertNewAsset(ctx context.Context, arg InsertNewAssetParams) (int32, error)
 	InsertNewProofEvent(ctx context.Context, arg InsertNewProofEventParams) error
 	InsertNewSyncEvent(ctx context.Context, arg InsertNewSyncEventParams) error
+	InsertParcelRequest(ctx context.Context, arg InsertParcelRequestParams) (int32, error)
+	InsertParcelRequestAddr(ctx context.Context, arg InsertParcelRequestAddrParams) error
 	InsertPassiveAsset(ctx context.Context, arg InsertPassiveAssetParams) error
+	InsertProofDeliveryAttempt(ctx context.Context, arg InsertProofDeliveryAttemptParams) error
+	InsertProofDeliverySignature(ctx context.Context, arg InsertProofDeliverySignatureParams) error
 	InsertReceiverProofTransferAttempt(ctx context.Context, arg InsertReceiverProofTransferAttemptParams) error
 	InsertRootKey(ctx context.Context, arg InsertRootKeyParams) error
+	InsertSendReservation(ctx context.Context, arg InsertSendReservationParams) (int32, error)
+	InsertSendReservationAmount(ctx context.Context, arg InsertSendReservationAmountParams) error
+	InsertTombstoneSweep(ctx context.Context, arg InsertTombstoneSweepParams) error
+	InsertTransferBroadcastTrigger(ctx context.Context, arg InsertTransferBroadcastTriggerParams) error
+	InsertTransferTemplate(ctx context.Context, arg InsertTransferTemplateParams) (int32, error)
+	InsertTransferTemplateRecipient(ctx context.Context, arg InsertTransferTemplateRecipientParams) error
+	InsertTreeWalBatch(ctx context.Context, arg InsertTreeWalBatchParams) (int32, error)
+	InsertTreeWalEntry(ctx context.Context, arg InsertTreeWalEntryParams) error
 	InsertUniverseServer(ctx context.Context, arg InsertUniverseServerParams) error
 	ListUniverseServers(ctx context.Context) ([]UniverseServer, error)
 	LogServerSync(ctx context.Context, arg LogServerSyncParams) error
+	MarkWatchedAssetsSpent(ctx context.Context, arg MarkWatchedAssetsSpentParams) error
 	NewMintingBatch(ctx context.Context, arg NewMintingBatchParams) error
+	QueryAirdropRecipients(ctx context.Context, airdropID int32) ([]AirdropRecipient, error)
+	QueryAirdrops(ctx context.Context, airdropID sql.NullInt32) ([]Airdrop, error)
+	QueryAnchorKeyOutpoints(ctx context.Context, rawKey []byte) ([][]byte, error)
+	QueryAssetAliases(ctx context.Context, arg QueryAssetAliasesParams) ([]AssetAlias, error)
 	// We use a LEFT JOIN here as not every asset has a group key, so this'll
 	// generate rows that have NULL values for the group key fields if an asset
 	// doesn't have a group key. See the comment in fetchAssetSprouts for a work
@@ -120,36 +173,79 @@ type Querier interface {
 	// make the entire statement evaluate to true, if none of these extra args are
 	// specified.
 	QueryAssets(ctx context.Context, arg QueryAssetsParams) ([]QueryAssetsRow, error)
+	QueryAtRiskAssets(ctx context.Context) ([]AtRiskAsset, error)
+	QueryBalanceSnapshots(ctx context.Context, arg QueryBalanceSnapshotsParams) ([]QueryBalanceSnapshotsRow, error)
+	QueryConfirmedAssetBalances(ctx context.Context, maxHeight sql.NullInt32) ([]QueryConfirmedAssetBalancesRow, error)
+	QueryCounterparties(ctx context.Context, arg QueryCounterpartiesParams) ([]Counterparty, error)
+	QueryDuplicateProofReceipts(ctx context.Context, anchorPoint []byte) ([]DuplicateProofReceipt, error)
+	QueryDuplicateTransferScriptKeys(ctx context.Context) ([]QueryDuplicateTransferScriptKeysRow, error)
+	QueryDuplicateUnspentScriptKeys(ctx context.Context) ([]QueryDuplicateUnspentScriptKeysRow, error)
 	QueryEventIDs(ctx context.Context, arg QueryEventIDsParams) ([]QueryEventIDsRow, error)
+	QueryFlaggedScriptKeys(ctx context.Context, scriptKey []byte) ([]QueryFlaggedScriptKeysRow, error)
+	QueryFrozenAssetOutputs(ctx context.Context) ([]FrozenAssetOutput, error)
+	QueryImportedScriptKeys(ctx context.Context, tweakedScriptKey []byte) ([]QueryImportedScriptKeysRow, error)
+	QueryInvoices(ctx context.Context, invoiceID []byte) ([]QueryInvoicesRow, error)
+	QueryKeyDerivations(ctx context.Context, arg QueryKeyDerivationsParams) ([]QueryKeyDerivationsRow, error)
+	QueryOwnedAnchors(ctx context.Context) ([]QueryOwnedAnchorsRow, error)
+	QueryParcelRequests(ctx context.Context) ([]QueryParcelRequestsRow, error)
 	QueryPassiveAssets(ctx context.Context, transferID int32) ([]QueryPassiveAssetsRow, error)
+	QueryProofDeliveryAttempts(ctx context.Context, arg QueryProofDeliveryAttemptsParams) ([]QueryProofDeliveryAttemptsRow, error)
+	QueryProofDeliverySignatures(ctx context.Context, anchorPoint []byte) ([]ProofDeliverySignature, error)
+	QueryQuarantinedProofs(ctx context.Context, arg QueryQuarantinedProofsParams) ([]UniverseProofQuarantine, error)
 	QueryReceiverProofTransferAttempt(ctx context.Context, proofLocatorHash []byte) ([]time.Time, error)
+	QueryReusedAnchorKeys(ctx context.Context) ([]QueryReusedAnchorKeysRow, error)
+	QuerySyncCheckpoints(ctx context.Context, arg QuerySyncCheckpointsParams) ([]UniverseSyncCheckpoint, error)
+	QueryTombstoneAnchors(ctx context.Context, numsKey []byte) ([]QueryTombstoneAnchorsRow, error)
+	QueryTransferTemplateRecipients(ctx context.Context, templateID int32) ([]TransferTemplateRecipient, error)
+	QueryTransferTemplates(ctx context.Context, name sql.NullString) ([]TransferTemplate, error)
 	// TODO(roasbeef): use the universe id instead for the grouping? so namespace
 	// root, simplifies queries
 	QueryUniverseAssetStats(ctx context.Context, arg QueryUniverseAssetStatsParams) ([]QueryUniverseAssetStatsRow, error)
 	QueryUniverseLeaves(ctx context.Context, arg QueryUniverseLeavesParams) ([]QueryUniverseLeavesRow, error)
 	QueryUniverseStats(ctx context.Context) (QueryUniverseStatsRow, error)
+	QueryWatchedAssets(ctx context.Context, includeSpent bool) ([]WatchedAsset, error)
+	ReAnchorAssetLot(ctx context.Context, arg ReAnchorAssetLotParams) error
 	ReAnchorPassiveAssets(ctx context.Context, arg ReAnchorPassiveAssetsParams) error
+	ReleaseOrphanedUTXOLeases(ctx context.Context, leaseOwner []byte) error
+	ResolveFlaggedScriptKey(ctx context.Context, arg ResolveFlaggedScriptKeyParams) (int64, error)
 	SetAddrManaged(ctx context.Context, arg SetAddrManagedParams) error
 	SetAssetSpent(ctx context.Context, arg SetAssetSpentParams) (int32, error)
+	SetScriptKeyTweak(ctx context.Context, arg SetScriptKeyTweakParams) error
+	SetSendReservationAnchor(ctx context.Context, arg SetSendReservationAnchorParams) error
+	SetTransferOutputSettled(ctx context.Context, arg SetTransferOutputSettledParams) error
+	SettleInvoice(ctx context.Context, arg SettleInvoiceParams) (int64, error)
+	SumReservedSends(ctx context.Context, arg SumReservedSendsParams) (int64, error)
 	UniverseLeaves(ctx context.Context) ([]UniverseLeafe, error)
 	UniverseRoots(ctx context.Context) ([]UniverseRootsRow, error)
+	UnfreezeAssetOutputs(ctx context.Context, arg UnfreezeAssetOutputsParams) (int64, error)
+	UpdateAirdropRecipientStatus(ctx context.Context, arg UpdateAirdropRecipientStatusParams) error
 	UpdateBatchGenesisTx(ctx context.Context, arg UpdateBatchGenesisTxParams) error
 	UpdateMintingBatchState(ctx context.Context, arg UpdateMintingBatchStateParams) error
+	UpdateTransferParcelVersion(ctx context.Context, arg UpdateTransferParcelVersionParams) error
 	UpdateUTXOLease(ctx context.Context, arg UpdateUTXOLeaseParams) error
+	UpsertAddrDepositExpectation(ctx context.Context, arg UpsertAddrDepositExpectationParams) error
 	UpsertAddrEvent(ctx context.Context, arg UpsertAddrEventParams) (int32, error)
+	UpsertAssetAlias(ctx context.Context, arg UpsertAssetAliasParams) error
 	UpsertAssetGroupKey(ctx context.Context, arg UpsertAssetGroupKeyParams) (int32, error)
 	UpsertAssetGroupSig(ctx context.Context, arg UpsertAssetGroupSigParams) (int32, error)
+	UpsertAssetLotAnnotation(ctx context.Context, arg UpsertAssetLotAnnotationParams) error
 	UpsertAssetMeta(ctx context.Context, arg UpsertAssetMetaParams) (int32, error)
 	UpsertAssetProof(ctx context.Context, arg UpsertAssetProofParams) error
+	UpsertBalanceSnapshot(ctx context.Context, arg UpsertBalanceSnapshotParams) error
 	UpsertChainTx(ctx context.Context, arg UpsertChainTxParams) (int32, error)
+	UpsertCounterparty(ctx context.Context, arg UpsertCounterpartyParams) error
+	UpsertFlaggedScriptKey(ctx context.Context, arg UpsertFlaggedScriptKeyParams) error
 	UpsertGenesisAsset(ctx context.Context, arg UpsertGenesisAssetParams) (int32, error)
 	UpsertGenesisPoint(ctx context.Context, prevOut []byte) (int32, error)
 	UpsertInternalKey(ctx context.Context, arg UpsertInternalKeyParams) (int32, error)
 	UpsertManagedUTXO(ctx context.Context, arg UpsertManagedUTXOParams) (int32, error)
+	UpsertQuarantinedProof(ctx context.Context, arg UpsertQuarantinedProofParams) error
 	UpsertRootNode(ctx context.Context, arg UpsertRootNodeParams) error
 	UpsertScriptKey(ctx context.Context, arg UpsertScriptKeyParams) (int32, error)
+	UpsertSyncCheckpoint(ctx context.Context, arg UpsertSyncCheckpointParams) error
 	UpsertUniverseLeaf(ctx context.Context, arg UpsertUniverseLeafParams) error
 	UpsertUniverseRoot(ctx context.Context, arg UpsertUniverseRootParams) (int32, error)
+	UpsertWatchedAsset(ctx context.Context, arg UpsertWatchedAssetParams) (int32, error)
 }
 
 var _ Querier = (*Queries)(nil)
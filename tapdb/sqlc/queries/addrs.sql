@@ -15,7 +15,9 @@ SELECT
     raw_script_keys.key_index AS script_key_index,
     taproot_keys.raw_key AS raw_taproot_key, 
     taproot_keys.key_family AS taproot_key_family,
-    taproot_keys.key_index AS taproot_key_index
+    taproot_keys.key_index AS taproot_key_index,
+    addr_deposit_expectations.expected_amount AS expected_amount,
+    addr_deposit_expectations.expiry AS deposit_expiry
 FROM addrs
 JOIN script_keys
     ON addrs.script_key_id = script_keys.script_key_id
@@ -23,6 +25,8 @@ JOIN internal_keys raw_script_keys
     ON script_keys.internal_key_id = raw_script_keys.key_id
 JOIN internal_keys taproot_keys
     ON addrs.taproot_key_id = taproot_keys.key_id
+LEFT JOIN addr_deposit_expectations
+    ON addrs.id = addr_deposit_expectations.addr_id
 WHERE creation_time >= @created_after
     AND creation_time <= @created_before
     AND (@unmanaged_only = false OR
@@ -41,7 +45,9 @@ SELECT
     raw_script_keys.key_index AS script_key_index,
     taproot_keys.raw_key AS raw_taproot_key,
     taproot_keys.key_family AS taproot_key_family,
-    taproot_keys.key_index AS taproot_key_index
+    taproot_keys.key_index AS taproot_key_index,
+    addr_deposit_expectations.expected_amount AS expected_amount,
+    addr_deposit_expectations.expiry AS deposit_expiry
 FROM addrs
 JOIN script_keys
   ON addrs.script_key_id = script_keys.script_key_id
@@ -49,6 +55,8 @@ JOIN internal_keys raw_script_keys
   ON script_keys.internal_key_id = raw_script_keys.key_id
 JOIN internal_keys taproot_keys
   ON addrs.taproot_key_id = taproot_keys.key_id
+LEFT JOIN addr_deposit_expectations
+  ON addrs.id = addr_deposit_expectations.addr_id
 WHERE taproot_output_key = $1;
 
 -- name: SetAddrManaged :exec
@@ -73,20 +81,21 @@ WITH target_addr(addr_id) AS (
 )
 INSERT INTO addr_events (
     creation_time, addr_id, status, chain_txn_id, chain_txn_output_index,
-    managed_utxo_id, asset_proof_id, asset_id
+    managed_utxo_id, asset_proof_id, asset_id, deposit_status
 ) VALUES (
     $3, (SELECT addr_id FROM target_addr), $4,
-    (SELECT txn_id FROM target_chain_txn), $5, $6, $7, $8
+    (SELECT txn_id FROM target_chain_txn), $5, $6, $7, $8, $9
 )
 ON CONFLICT (addr_id, chain_txn_id, chain_txn_output_index)
     DO UPDATE SET status = EXCLUDED.status,
                   asset_proof_id = COALESCE(EXCLUDED.asset_proof_id, addr_events.asset_proof_id),
-                  asset_id = COALESCE(EXCLUDED.asset_id, addr_events.asset_id)
+                  asset_id = COALESCE(EXCLUDED.asset_id, addr_events.asset_id),
+                  deposit_status = COALESCE(EXCLUDED.deposit_status, addr_events.deposit_status)
 RETURNING id;
 
 -- name: FetchAddrEvent :one
 SELECT
-    creation_time, status, asset_proof_id, asset_id,
+    creation_time, status, asset_proof_id, asset_id, deposit_status,
     chain_txns.txid as txid,
     chain_txns.block_height as confirmation_height,
     chain_txn_output_index as output_index,
@@ -112,3 +121,19 @@ WHERE addr_events.status >= @status_from
   AND addr_events.status <= @status_to
   AND COALESCE(@addr_taproot_key, addrs.taproot_output_key) = addrs.taproot_output_key
 ORDER by addr_events.creation_time;
+
+-- name: UpsertAddrDepositExpectation :exec
+WITH target_addr(addr_id) AS (
+    SELECT id
+    FROM addrs
+    WHERE addrs.taproot_output_key = $1
+)
+INSERT INTO addr_deposit_expectations (
+    addr_id, expected_amount, expiry
+) VALUES (
+    (SELECT addr_id FROM target_addr), $2, $3
+)
+ON CONFLICT (addr_id)
+    DO UPDATE SET expected_amount = EXCLUDED.expected_amount,
+                  expiry = EXCLUDED.expiry;
+
@@ -0,0 +1,32 @@
+-- name: InsertAirdrop :one
+INSERT INTO airdrops (
+    label, max_recipients_per_tx, created_at
+) VALUES (
+    $1, $2, $3
+)
+RETURNING id;
+
+-- name: InsertAirdropRecipient :exec
+INSERT INTO airdrop_recipients (
+    airdrop_id, recipient_index, tap_addr, asset_id, amount, status,
+    anchor_txid, error_msg, updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+);
+
+-- name: QueryAirdrops :many
+SELECT *
+FROM airdrops
+WHERE id = sqlc.narg('airdrop_id') OR sqlc.narg('airdrop_id') IS NULL
+ORDER BY id;
+
+-- name: QueryAirdropRecipients :many
+SELECT *
+FROM airdrop_recipients
+WHERE airdrop_id = $1
+ORDER BY recipient_index;
+
+-- name: UpdateAirdropRecipientStatus :exec
+UPDATE airdrop_recipients
+SET status = $3, anchor_txid = $4, error_msg = $5, updated_at = $6
+WHERE airdrop_id = $1 AND recipient_index = $2;
@@ -0,0 +1,30 @@
+-- name: UpsertAssetAlias :exec
+INSERT INTO asset_aliases (
+    alias, asset_id, group_key, created_at
+) VALUES (
+    $1, $2, $3, $4
+) ON CONFLICT (alias)
+    DO UPDATE SET
+        asset_id = EXCLUDED.asset_id,
+        group_key = EXCLUDED.group_key,
+        created_at = EXCLUDED.created_at;
+
+-- name: DeleteAssetAlias :execrows
+DELETE FROM asset_aliases
+WHERE alias = $1;
+
+-- name: DeleteAssetAliasesByTarget :exec
+DELETE FROM asset_aliases
+WHERE asset_id = sqlc.narg('asset_id') OR
+      group_key = sqlc.narg('group_key');
+
+-- name: QueryAssetAliases :many
+SELECT alias_id, alias, asset_id, group_key, created_at
+FROM asset_aliases
+WHERE (alias = sqlc.narg('alias') OR
+       sqlc.narg('alias') IS NULL) AND
+      (asset_id = sqlc.narg('asset_id') OR
+       sqlc.narg('asset_id') IS NULL) AND
+      (group_key = sqlc.narg('group_key') OR
+       sqlc.narg('group_key') IS NULL)
+ORDER BY alias;
@@ -0,0 +1,20 @@
+-- name: QueryAnchorKeyOutpoints :many
+SELECT utxos.outpoint
+FROM managed_utxos utxos
+JOIN internal_keys keys
+    ON utxos.internal_key_id = keys.key_id
+WHERE keys.raw_key = $1
+ORDER BY utxos.utxo_id;
+
+-- name: QueryReusedAnchorKeys :many
+SELECT keys.raw_key, keys.key_family, keys.key_index, utxos.outpoint
+FROM managed_utxos utxos
+JOIN internal_keys keys
+    ON utxos.internal_key_id = keys.key_id
+WHERE utxos.internal_key_id IN (
+    SELECT internal_key_id
+    FROM managed_utxos
+    GROUP BY internal_key_id
+    HAVING COUNT(*) > 1
+)
+ORDER BY keys.key_id, utxos.utxo_id;
@@ -337,6 +337,13 @@ WHERE (
 ORDER BY key_group_info_view.sig_id
 LIMIT 1;
 
+-- name: FetchGroupAssetIDs :many
+SELECT genesis_assets.asset_id
+FROM genesis_assets
+JOIN key_group_info_view
+    ON genesis_assets.gen_asset_id = key_group_info_view.gen_asset_id
+WHERE key_group_info_view.tweaked_group_key = @group_key;
+
 -- name: FetchGroupByGenesis :one
 SELECT
     key_group_info_view.tweaked_group_key AS tweaked_group_key,
@@ -424,7 +431,15 @@ WHERE (
     assets.amount >= COALESCE(sqlc.narg('min_amt'), assets.amount) AND
     assets.spent = COALESCE(sqlc.narg('spent'), assets.spent) AND
     (key_group_info_view.tweaked_group_key = sqlc.narg('key_group_filter') OR
-      sqlc.narg('key_group_filter') IS NULL)
+      sqlc.narg('key_group_filter') IS NULL) AND
+    -- An asset can't be spent if any asset anchored at the same outpoint is
+    -- frozen, as that asset would be moved as well.
+    (COALESCE(sqlc.narg('exclude_frozen'), false) = false OR
+      NOT EXISTS (
+        SELECT 1
+        FROM frozen_asset_outputs
+        WHERE frozen_asset_outputs.outpoint = utxos.outpoint
+      ))
 );
 
 -- name: AllAssets :many
@@ -640,6 +655,16 @@ INSERT INTO asset_proofs (
     -- This is not a NOP, we always overwrite the proof with the new one.
     DO UPDATE SET proof_file = EXCLUDED.proof_file;
 
+-- name: FetchAssetProofKeys :many
+SELECT genesis_assets.asset_id, script_keys.tweaked_script_key AS script_key
+FROM asset_proofs
+JOIN assets
+    ON assets.asset_id = asset_proofs.asset_id
+JOIN script_keys
+    ON assets.script_key_id = script_keys.script_key_id
+JOIN genesis_assets
+    ON assets.genesis_id = genesis_assets.gen_asset_id;
+
 -- name: FetchAssetProofs :many
 WITH asset_info AS (
     SELECT assets.asset_id, script_keys.tweaked_script_key
@@ -721,6 +746,20 @@ WHERE lease_owner IS NOT NULL AND
       lease_expiry IS NOT NULL AND
       lease_expiry < @now;
 
+-- name: ReleaseOrphanedUTXOLeases :exec
+UPDATE managed_utxos
+SET lease_owner = NULL, lease_expiry = NULL
+WHERE lease_owner = @lease_owner AND
+      outpoint NOT IN (
+          SELECT inputs.anchor_point
+          FROM asset_transfer_inputs inputs
+          JOIN asset_transfers transfers
+              ON inputs.transfer_id = transfers.id
+          JOIN chain_txns txns
+              ON transfers.anchor_txn_id = txns.txn_id
+          WHERE txns.block_hash IS NULL
+      );
+
 -- name: ConfirmChainAnchorTx :exec
 UPDATE chain_txns
 SET block_height = $2, block_hash = $3, tx_index = $4
@@ -784,3 +823,23 @@ FROM genesis_assets assets
 JOIN assets_meta
     ON assets.meta_data_id = assets_meta.meta_id
 WHERE assets.asset_id = $1;
+
+-- name: FreezeAssetOutput :exec
+INSERT INTO frozen_asset_outputs (
+    outpoint, script_key, reason, frozen_at
+) VALUES (
+    $1, $2, $3, $4
+) ON CONFLICT (outpoint, script_key)
+    -- If the asset output is already frozen, only the reason is updated.
+    DO UPDATE SET reason = EXCLUDED.reason;
+
+-- name: UnfreezeAssetOutputs :execrows
+DELETE FROM frozen_asset_outputs
+WHERE outpoint = @outpoint AND
+    (script_key = sqlc.narg('script_key') OR
+      sqlc.narg('script_key') IS NULL);
+
+-- name: QueryFrozenAssetOutputs :many
+SELECT *
+FROM frozen_asset_outputs
+ORDER BY id;
@@ -0,0 +1,30 @@
+-- name: QueryOwnedAnchors :many
+SELECT utxos.outpoint, txns.raw_tx, txns.block_height
+FROM managed_utxos utxos
+JOIN chain_txns txns
+    ON utxos.txn_id = txns.txn_id
+WHERE EXISTS (
+    SELECT 1
+    FROM assets
+    WHERE assets.anchor_utxo_id = utxos.utxo_id AND assets.spent = FALSE
+) AND NOT EXISTS (
+    SELECT 1
+    FROM at_risk_assets
+    WHERE at_risk_assets.anchor_point = utxos.outpoint
+)
+ORDER BY utxos.utxo_id;
+
+-- name: InsertAtRiskAsset :exec
+INSERT INTO at_risk_assets (
+    anchor_point, asset_id, script_key, amount, spending_txid, spend_height,
+    detected_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) ON CONFLICT (anchor_point, script_key)
+    -- The first detected spend of the anchor output is kept.
+    DO NOTHING;
+
+-- name: QueryAtRiskAssets :many
+SELECT *
+FROM at_risk_assets
+ORDER BY detected_at, id;
@@ -0,0 +1,43 @@
+-- name: QueryConfirmedAssetBalances :many
+SELECT
+    genesis_assets.asset_id, SUM(assets.amount) AS balance,
+    COUNT(*) AS utxo_count
+FROM assets
+JOIN genesis_assets
+    ON assets.genesis_id = genesis_assets.gen_asset_id
+JOIN managed_utxos utxos
+    ON assets.anchor_utxo_id = utxos.utxo_id
+JOIN chain_txns txns
+    ON utxos.txn_id = txns.txn_id
+WHERE assets.spent = FALSE
+    AND txns.block_height > 0
+    AND txns.block_height <= @max_height
+GROUP BY genesis_assets.asset_id;
+
+-- name: UpsertBalanceSnapshot :exec
+INSERT INTO asset_balance_snapshots (
+    asset_id, height, taken_at, balance, utxo_count
+) VALUES (
+    $1, $2, $3, $4, $5
+) ON CONFLICT (asset_id, height)
+    DO UPDATE SET taken_at = EXCLUDED.taken_at,
+                  balance = EXCLUDED.balance,
+                  utxo_count = EXCLUDED.utxo_count;
+
+-- name: QueryBalanceSnapshots :many
+SELECT
+    snapshots.asset_id, snapshots.height, snapshots.taken_at,
+    snapshots.balance, snapshots.utxo_count
+FROM asset_balance_snapshots snapshots
+JOIN (
+    SELECT asset_id, MAX(height) AS height
+    FROM asset_balance_snapshots
+    WHERE height <= @max_height
+        AND taken_at <= @max_taken_at
+        AND (asset_id = sqlc.narg('asset_id_filter') OR
+            sqlc.narg('asset_id_filter') IS NULL)
+    GROUP BY asset_id
+) latest
+    ON snapshots.asset_id = latest.asset_id
+        AND snapshots.height = latest.height
+ORDER BY snapshots.asset_id;
@@ -0,0 +1,23 @@
+-- name: UpsertCounterparty :exec
+INSERT INTO counterparties (
+    name, script_key, tap_addr, created_at
+) VALUES (
+    $1, $2, $3, $4
+) ON CONFLICT (script_key)
+    DO UPDATE SET
+        name = EXCLUDED.name,
+        tap_addr = EXCLUDED.tap_addr,
+        created_at = EXCLUDED.created_at;
+
+-- name: DeleteCounterparty :execrows
+DELETE FROM counterparties
+WHERE name = $1;
+
+-- name: QueryCounterparties :many
+SELECT counterparty_id, name, script_key, tap_addr, created_at
+FROM counterparties
+WHERE (name = sqlc.narg('name') OR
+       sqlc.narg('name') IS NULL) AND
+      (script_key = sqlc.narg('script_key') OR
+       sqlc.narg('script_key') IS NULL)
+ORDER BY name, counterparty_id;
@@ -0,0 +1,26 @@
+-- name: SetScriptKeyTweak :exec
+UPDATE script_keys
+SET internal_key_id = $2, tweak = $3
+WHERE script_key_id = $1;
+
+-- name: InsertImportedScriptKey :exec
+INSERT INTO imported_script_keys (
+    script_key_id, imported_at
+) VALUES (
+    $1, $2
+) ON CONFLICT (script_key_id)
+    -- Importing a script key twice keeps the original import time.
+    DO NOTHING;
+
+-- name: QueryImportedScriptKeys :many
+SELECT script_keys.tweaked_script_key, script_keys.tweak,
+       internal_keys.raw_key, internal_keys.key_family,
+       internal_keys.key_index, imported_script_keys.imported_at
+FROM imported_script_keys
+JOIN script_keys
+  ON imported_script_keys.script_key_id = script_keys.script_key_id
+JOIN internal_keys
+  ON script_keys.internal_key_id = internal_keys.key_id
+WHERE script_keys.tweaked_script_key = sqlc.narg('tweaked_script_key') OR
+      sqlc.narg('tweaked_script_key') IS NULL
+ORDER BY imported_script_keys.imported_at, imported_script_keys.id;
@@ -0,0 +1,29 @@
+-- name: InsertInvoice :exec
+WITH target_addr(addr_id) AS (
+    SELECT id
+    FROM addrs
+    WHERE addrs.taproot_output_key = $1
+)
+INSERT INTO invoices (
+    invoice_id, addr_id, invoice, created_at
+) VALUES (
+    $2, (SELECT addr_id FROM target_addr), $3, $4
+);
+
+-- name: QueryInvoices :many
+SELECT invoice, settled_at, settle_outpoint
+FROM invoices
+WHERE invoice_id = sqlc.narg('invoice_id') OR
+      sqlc.narg('invoice_id') IS NULL
+ORDER BY created_at, id;
+
+-- name: SettleInvoice :execrows
+WITH target_addr(addr_id) AS (
+    SELECT id
+    FROM addrs
+    WHERE addrs.taproot_output_key = $1
+)
+UPDATE invoices
+SET settled_at = $2, settle_outpoint = $3
+WHERE addr_id = (SELECT addr_id FROM target_addr)
+    AND settled_at IS NULL;
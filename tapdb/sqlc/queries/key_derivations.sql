@@ -0,0 +1,20 @@
+-- name: InsertKeyDerivation :exec
+INSERT INTO key_derivations (
+    internal_key_id, purpose, object, derived_at
+) VALUES (
+    $1, $2, $3, $4
+);
+
+-- name: QueryKeyDerivations :many
+SELECT
+    internal_keys.raw_key, internal_keys.key_family, internal_keys.key_index,
+    key_derivations.purpose, key_derivations.object,
+    key_derivations.derived_at
+FROM key_derivations
+JOIN internal_keys
+    ON key_derivations.internal_key_id = internal_keys.key_id
+WHERE (key_derivations.purpose = sqlc.narg('purpose') OR
+       sqlc.narg('purpose') IS NULL) AND
+      (internal_keys.raw_key = sqlc.narg('raw_key') OR
+       sqlc.narg('raw_key') IS NULL)
+ORDER BY key_derivations.derived_at, key_derivations.id;
@@ -0,0 +1,64 @@
+-- name: InsertAssetLot :exec
+INSERT INTO asset_lots (
+    anchor_point, asset_id, script_key, acquired_at, source_transfer_id,
+    price_annotation
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) ON CONFLICT (anchor_point, asset_id, script_key)
+    DO NOTHING;
+
+-- name: FetchAssetLot :one
+SELECT
+    lots.acquired_at, lots.price_annotation, txns.txid AS source_txid
+FROM asset_lots lots
+LEFT JOIN asset_transfers transfers
+    ON lots.source_transfer_id = transfers.id
+LEFT JOIN chain_txns txns
+    ON transfers.anchor_txn_id = txns.txn_id
+WHERE lots.anchor_point = $1
+    AND lots.asset_id = $2
+    AND lots.script_key = $3;
+
+-- name: UpsertAssetLotAnnotation :exec
+INSERT INTO asset_lots (
+    anchor_point, asset_id, script_key, price_annotation
+) VALUES (
+    $1, $2, $3, $4
+) ON CONFLICT (anchor_point, asset_id, script_key)
+    DO UPDATE SET price_annotation = EXCLUDED.price_annotation;
+
+-- name: ReAnchorAssetLot :exec
+UPDATE asset_lots
+SET anchor_point = (
+    SELECT outpoint
+    FROM managed_utxos
+    WHERE utxo_id = @new_anchor_utxo_id
+)
+WHERE asset_id = @genesis_id
+    AND script_key = @script_key
+    AND anchor_point = (
+        SELECT utxos.outpoint
+        FROM assets
+        JOIN managed_utxos utxos
+            ON assets.anchor_utxo_id = utxos.utxo_id
+        WHERE assets.asset_id = @asset_row_id
+    );
+
+-- name: FetchBatchAssetAnchors :many
+SELECT
+    utxos.outpoint AS anchor_point, genesis_assets.asset_id,
+    script_keys.tweaked_script_key
+FROM assets
+JOIN genesis_assets
+    ON assets.genesis_id = genesis_assets.gen_asset_id
+JOIN genesis_points points
+    ON genesis_assets.genesis_point_id = points.genesis_id
+JOIN asset_minting_batches batches
+    ON batches.genesis_id = points.genesis_id
+JOIN internal_keys keys
+    ON batches.batch_id = keys.key_id
+JOIN script_keys
+    ON assets.script_key_id = script_keys.script_key_id
+JOIN managed_utxos utxos
+    ON assets.anchor_utxo_id = utxos.utxo_id
+WHERE keys.raw_key = $1;
@@ -0,0 +1,40 @@
+-- name: InsertTreeWalBatch :one
+INSERT INTO mssmt_wal_batches (
+    namespace, generation, prev_root_hash, prev_root_sum, state, created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING batch_id;
+
+-- name: InsertTreeWalEntry :exec
+INSERT INTO mssmt_wal_entries (
+    batch_id, entry_index, leaf_key, value, sum, prev_value, prev_sum
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: FetchLatestTreeWalBatch :one
+SELECT *
+FROM mssmt_wal_batches
+WHERE namespace = $1
+ORDER BY generation DESC
+LIMIT 1;
+
+-- name: FetchTreeWalEntries :many
+SELECT *
+FROM mssmt_wal_entries
+WHERE batch_id = $1
+ORDER BY entry_index;
+
+-- name: FinishTreeWalBatch :exec
+UPDATE mssmt_wal_batches
+SET state = $2, root_hash = $3, root_sum = $4
+WHERE batch_id = $1;
+
+-- name: DeleteTreeWalBatches :exec
+DELETE FROM mssmt_wal_batches
+WHERE namespace = @namespace AND generation < @before_generation;
+
+-- name: FetchTreeWalNamespaces :many
+SELECT DISTINCT namespace
+FROM mssmt_wal_batches
+ORDER BY namespace;
@@ -0,0 +1,43 @@
+-- name: FetchAssetByAnchor :one
+SELECT assets.asset_id
+FROM assets
+JOIN genesis_assets
+    ON assets.genesis_id = genesis_assets.gen_asset_id
+JOIN script_keys
+    ON assets.script_key_id = script_keys.script_key_id
+JOIN managed_utxos utxos
+    ON assets.anchor_utxo_id = utxos.utxo_id
+WHERE utxos.outpoint = $1
+    AND genesis_assets.asset_id = $2
+    AND script_keys.tweaked_script_key = $3
+LIMIT 1;
+
+-- name: InsertDuplicateProofReceipt :exec
+INSERT INTO duplicate_proof_receipts (
+    anchor_point, asset_id, script_key, proof_hash, received_at
+) VALUES (
+    $1, $2, $3, $4, $5
+);
+
+-- name: QueryDuplicateProofReceipts :many
+SELECT id, anchor_point, asset_id, script_key, proof_hash, received_at
+FROM duplicate_proof_receipts
+WHERE (anchor_point = sqlc.narg('anchor_point') OR
+       sqlc.narg('anchor_point') IS NULL)
+ORDER BY received_at, id;
+
+-- name: InsertProofDeliverySignature :exec
+INSERT INTO proof_delivery_signatures (
+    anchor_point, asset_id, script_key, proof_hash, sender_node_key,
+    signature, received_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: QueryProofDeliverySignatures :many
+SELECT id, anchor_point, asset_id, script_key, proof_hash, sender_node_key,
+    signature, received_at
+FROM proof_delivery_signatures
+WHERE (anchor_point = sqlc.narg('anchor_point') OR
+       sqlc.narg('anchor_point') IS NULL)
+ORDER BY received_at, id;
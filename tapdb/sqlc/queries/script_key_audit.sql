@@ -0,0 +1,52 @@
+-- name: QueryDuplicateUnspentScriptKeys :many
+SELECT script_keys.tweaked_script_key, utxos.outpoint
+FROM assets
+JOIN script_keys
+    ON assets.script_key_id = script_keys.script_key_id
+JOIN managed_utxos utxos
+    ON assets.anchor_utxo_id = utxos.utxo_id
+WHERE assets.spent = FALSE AND assets.script_key_id IN (
+    SELECT script_key_id
+    FROM assets
+    WHERE spent = FALSE
+    GROUP BY script_key_id
+    HAVING COUNT(*) > 1
+)
+ORDER BY script_keys.script_key_id, utxos.utxo_id;
+
+-- name: QueryDuplicateTransferScriptKeys :many
+SELECT script_keys.tweaked_script_key, utxos.outpoint
+FROM asset_transfer_outputs outputs
+JOIN script_keys
+    ON outputs.script_key = script_keys.script_key_id
+JOIN managed_utxos utxos
+    ON outputs.anchor_utxo = utxos.utxo_id
+WHERE outputs.script_key IN (
+    SELECT script_key
+    FROM asset_transfer_outputs
+    GROUP BY script_key
+    HAVING COUNT(DISTINCT transfer_id) > 1
+)
+ORDER BY script_keys.script_key_id, outputs.output_id;
+
+-- name: UpsertFlaggedScriptKey :exec
+INSERT INTO flagged_script_keys (
+    tweaked_script_key, conflict, num_occurrences, flagged_at
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (tweaked_script_key, conflict)
+    DO UPDATE SET num_occurrences = EXCLUDED.num_occurrences,
+        flagged_at = EXCLUDED.flagged_at, resolved_at = NULL;
+
+-- name: QueryFlaggedScriptKeys :many
+SELECT tweaked_script_key, conflict, num_occurrences, flagged_at, resolved_at
+FROM flagged_script_keys
+WHERE tweaked_script_key = sqlc.narg('script_key') OR
+      sqlc.narg('script_key') IS NULL
+ORDER BY flagged_at, id;
+
+-- name: ResolveFlaggedScriptKey :execrows
+UPDATE flagged_script_keys
+SET resolved_at = $2
+WHERE tweaked_script_key = $1 AND resolved_at IS NULL;
@@ -0,0 +1,40 @@
+-- name: InsertSendReservation :one
+INSERT INTO macaroon_send_reservations (
+    macaroon_id, send_day, reserved_at
+) VALUES (
+    $1, $2, $3
+)
+RETURNING id;
+
+-- name: InsertSendReservationAmount :exec
+INSERT INTO macaroon_send_amounts (
+    reservation_id, asset_id, amount
+) VALUES (
+    $1, $2, $3
+);
+
+-- name: SumReservedSends :one
+SELECT CAST(COALESCE(SUM(amounts.amount), 0) AS BIGINT) AS total
+FROM macaroon_send_amounts amounts
+JOIN macaroon_send_reservations reservations
+    ON amounts.reservation_id = reservations.id
+WHERE reservations.macaroon_id = $1 AND
+      reservations.send_day = $2 AND
+      amounts.asset_id = $3;
+
+-- name: SetSendReservationAnchor :exec
+UPDATE macaroon_send_reservations
+SET anchor_txid = $2
+WHERE id = $1;
+
+-- name: DeleteSendReservation :execrows
+DELETE FROM macaroon_send_reservations
+WHERE id = $1;
+
+-- name: DeleteTransferSendReservations :execrows
+DELETE FROM macaroon_send_reservations
+WHERE anchor_txid = $1;
+
+-- name: DeleteSendReservationsBefore :exec
+DELETE FROM macaroon_send_reservations
+WHERE send_day < $1;
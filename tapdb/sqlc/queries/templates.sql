@@ -0,0 +1,32 @@
+-- name: InsertTransferTemplate :one
+INSERT INTO transfer_templates (
+    name, asset_id, priority, conf_target_seconds, fee_bump_interval_seconds,
+    fee_bump_percent, max_fee_rate, created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING id;
+
+-- name: InsertTransferTemplateRecipient :exec
+INSERT INTO transfer_template_recipients (
+    template_id, recipient_index, tap_addr, script_key, internal_key, amount,
+    share_bps
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: QueryTransferTemplates :many
+SELECT *
+FROM transfer_templates
+WHERE name = sqlc.narg('name') OR sqlc.narg('name') IS NULL
+ORDER BY name;
+
+-- name: QueryTransferTemplateRecipients :many
+SELECT *
+FROM transfer_template_recipients
+WHERE template_id = $1
+ORDER BY recipient_index;
+
+-- name: DeleteTransferTemplate :execrows
+DELETE FROM transfer_templates
+WHERE name = $1;
@@ -0,0 +1,45 @@
+-- name: QueryTombstoneAnchors :many
+SELECT utxos.outpoint, utxos.amt_sats, utxos.merkle_root, keys.raw_key,
+    keys.key_family, keys.key_index
+FROM managed_utxos utxos
+JOIN internal_keys keys
+    ON utxos.internal_key_id = keys.key_id
+WHERE EXISTS (
+    SELECT 1
+    FROM assets
+    JOIN script_keys
+        ON assets.script_key_id = script_keys.script_key_id
+    WHERE assets.anchor_utxo_id = utxos.utxo_id AND assets.amount = 0 AND
+        script_keys.tweaked_script_key = @nums_key
+) AND NOT EXISTS (
+    -- Any other asset, even if it is spent, means the anchor output carries
+    -- more than tombstones or was already spent by a transfer.
+    SELECT 1
+    FROM assets
+    JOIN script_keys
+        ON assets.script_key_id = script_keys.script_key_id
+    WHERE assets.anchor_utxo_id = utxos.utxo_id AND (
+        assets.amount != 0 OR script_keys.tweaked_script_key != @nums_key
+    )
+) AND NOT EXISTS (
+    SELECT 1
+    FROM asset_transfer_inputs inputs
+    WHERE inputs.anchor_point = utxos.outpoint
+) AND NOT EXISTS (
+    SELECT 1
+    FROM tombstone_sweeps sweeps
+    WHERE sweeps.anchor_point = utxos.outpoint
+) AND NOT EXISTS (
+    -- Sweeping a frozen anchor output would move the frozen tombstone.
+    SELECT 1
+    FROM frozen_asset_outputs frozen
+    WHERE frozen.outpoint = utxos.outpoint
+)
+ORDER BY utxos.utxo_id;
+
+-- name: InsertTombstoneSweep :exec
+INSERT INTO tombstone_sweeps (
+    anchor_point, sweep_txid, swept_at
+) VALUES (
+    $1, $2, $3
+);
@@ -5,9 +5,11 @@ WITH target_txn(txn_id) AS (
     WHERE txid = @anchor_txid
 )
 INSERT INTO asset_transfers (
-    height_hint, anchor_txn_id, transfer_time_unix
+    height_hint, anchor_txn_id, transfer_time_unix, change_key_policy,
+    parcel_version
 ) VALUES (
-    @height_hint, (SELECT txn_id FROM target_txn), @transfer_time_unix
+    @height_hint, (SELECT txn_id FROM target_txn), @transfer_time_unix,
+    @change_key_policy, @parcel_version
 ) RETURNING id;
 
 -- name: InsertAssetTransferInput :exec
@@ -29,7 +31,8 @@ INSERT INTO asset_transfer_outputs (
 
 -- name: QueryAssetTransfers :many
 SELECT
-    id, height_hint, txns.txid, transfer_time_unix
+    id, height_hint, txns.txid, transfer_time_unix, change_key_policy,
+    parcel_version
 FROM asset_transfers transfers
 JOIN chain_txns txns
     ON transfers.anchor_txn_id = txns.txn_id
@@ -54,7 +57,7 @@ ORDER BY input_id;
 SELECT
     output_id, proof_suffix, amount, serialized_witnesses, script_key_local,
     split_commitment_root_hash, split_commitment_root_value, num_passive_assets,
-    output_type,
+    output_type, receiver_settled_at,
     utxos.utxo_id AS anchor_utxo_id,
     utxos.outpoint AS anchor_outpoint,
     utxos.amt_sats AS anchor_value,
@@ -82,6 +85,19 @@ JOIN internal_keys utxo_internal_keys
 WHERE transfer_id = $1
 ORDER BY output_id;
 
+-- name: SetTransferOutputSettled :exec
+UPDATE asset_transfer_outputs
+SET receiver_settled_at = @settled_at
+WHERE anchor_utxo IN (
+    SELECT utxo_id
+    FROM managed_utxos
+    WHERE outpoint = @anchor_outpoint
+) AND script_key IN (
+    SELECT script_key_id
+    FROM script_keys
+    WHERE tweaked_script_key = @script_key
+) AND receiver_settled_at IS NULL;
+
 -- name: ApplyPendingOutput :one
 WITH spent_asset AS (
     SELECT genesis_id, version, asset_group_sig_id, script_version, lock_time,
@@ -127,6 +143,25 @@ FROM receiver_proof_transfer_attempts
 WHERE proof_locator_hash = $1
 ORDER BY time_unix DESC;
 
+-- name: InsertProofDeliveryAttempt :exec
+INSERT INTO proof_courier_outbox (
+    proof_locator_hash, anchor_txid, anchor_output_index, script_key,
+    asset_id, courier_addr, attempt_time, error_msg, next_attempt_time
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+);
+
+-- name: QueryProofDeliveryAttempts :many
+SELECT
+    anchor_txid, anchor_output_index, script_key, asset_id, courier_addr,
+    attempt_time, error_msg, next_attempt_time
+FROM proof_courier_outbox
+WHERE (anchor_txid = sqlc.narg('anchor_txid') OR
+    sqlc.narg('anchor_txid') IS NULL)
+AND (script_key = sqlc.narg('script_key') OR
+    sqlc.narg('script_key') IS NULL)
+ORDER BY id;
+
 -- name: InsertPassiveAsset :exec
 WITH target_asset(asset_id) AS (
     SELECT assets.asset_id
@@ -159,3 +194,76 @@ FROM passive_assets as passive
     JOIN genesis_assets
         ON assets.genesis_id = genesis_assets.gen_asset_id
 WHERE passive.transfer_id = @transfer_id;
+
+-- name: InsertParcelRequest :one
+INSERT INTO parcel_requests (
+    request_id, priority, created_at
+) VALUES (
+    $1, $2, $3
+)
+RETURNING id;
+
+-- name: InsertParcelRequestAddr :exec
+INSERT INTO parcel_request_addrs (
+    request_id, addr_index, tap_addr
+) VALUES (
+    $1, $2, $3
+);
+
+-- name: QueryParcelRequests :many
+SELECT
+    parcel_requests.request_id, parcel_requests.priority,
+    parcel_requests.created_at, addrs.tap_addr
+FROM parcel_requests
+JOIN parcel_request_addrs addrs
+    ON parcel_requests.id = addrs.request_id
+ORDER BY parcel_requests.id, addrs.addr_index;
+
+-- name: DeleteParcelRequest :exec
+DELETE FROM parcel_requests
+WHERE request_id = @request_id;
+
+-- name: InsertTransferBroadcastTrigger :exec
+INSERT INTO transfer_broadcast_triggers (
+    transfer_id, min_height, min_time
+) VALUES (
+    $1, $2, $3
+);
+
+-- name: FetchTransferBroadcastTrigger :one
+SELECT min_height, min_time
+FROM transfer_broadcast_triggers
+WHERE transfer_id = $1;
+
+-- name: DeleteTransferBroadcastTrigger :exec
+DELETE FROM transfer_broadcast_triggers
+WHERE transfer_id = $1;
+
+-- name: DeletePassiveAssets :exec
+DELETE FROM passive_assets
+WHERE transfer_id = $1;
+
+-- name: DeleteAssetTransferInputs :exec
+DELETE FROM asset_transfer_inputs
+WHERE transfer_id = $1;
+
+-- name: DeleteAssetTransferOutputs :exec
+DELETE FROM asset_transfer_outputs
+WHERE transfer_id = $1;
+
+-- name: DeleteAssetTransfer :exec
+DELETE FROM asset_transfers
+WHERE id = $1;
+
+-- name: DeleteChainTx :exec
+DELETE FROM chain_txns
+WHERE txid = $1;
+
+-- name: UpdateTransferParcelVersion :exec
+UPDATE asset_transfers
+SET parcel_version = @parcel_version
+WHERE anchor_txn_id = (
+    SELECT txn_id
+    FROM chain_txns
+    WHERE txid = @anchor_txid
+);
@@ -256,3 +256,62 @@ WHERE event_type IN ('SYNC', 'NEW_PROOF') AND
       event_timestamp >= @start_time AND event_timestamp <= @end_time
 GROUP BY day
 ORDER BY day;
+
+-- name: UpsertSyncCheckpoint :exec
+INSERT INTO universe_sync_checkpoints (
+    server_host, universe_id, asset_id, group_key, root_hash, root_sum,
+    leaves_fetched, leaves_total, complete, last_error, updated_at
+) VALUES (
+    @server_host, @universe_id, @asset_id, @group_key, @root_hash, @root_sum,
+    @leaves_fetched, @leaves_total, @complete, @last_error, @updated_at
+) ON CONFLICT (server_host, universe_id)
+    DO UPDATE SET
+        asset_id = EXCLUDED.asset_id,
+        group_key = EXCLUDED.group_key,
+        root_hash = EXCLUDED.root_hash,
+        root_sum = EXCLUDED.root_sum,
+        leaves_fetched = EXCLUDED.leaves_fetched,
+        leaves_total = EXCLUDED.leaves_total,
+        complete = EXCLUDED.complete,
+        last_error = EXCLUDED.last_error,
+        updated_at = EXCLUDED.updated_at;
+
+-- name: QuerySyncCheckpoints :many
+SELECT id, server_host, universe_id, asset_id, group_key, root_hash, root_sum,
+    leaves_fetched, leaves_total, complete, last_error, updated_at
+FROM universe_sync_checkpoints
+WHERE (server_host = sqlc.narg('server_host') OR
+       sqlc.narg('server_host') IS NULL) AND
+      (universe_id = sqlc.narg('universe_id') OR
+       sqlc.narg('universe_id') IS NULL)
+ORDER BY server_host, universe_id;
+
+-- name: UpsertQuarantinedProof :exec
+INSERT INTO universe_proof_quarantine (
+    server_host, universe_id, asset_id, group_key, leaf_key, minting_point,
+    script_key, proof, reason, quarantined_at
+) VALUES (
+    @server_host, @universe_id, @asset_id, @group_key, @leaf_key,
+    @minting_point, @script_key, @proof, @reason, @quarantined_at
+) ON CONFLICT (server_host, universe_id, leaf_key)
+    DO UPDATE SET
+        proof = EXCLUDED.proof,
+        reason = EXCLUDED.reason,
+        quarantined_at = EXCLUDED.quarantined_at;
+
+-- name: QueryQuarantinedProofs :many
+SELECT id, server_host, universe_id, asset_id, group_key, leaf_key,
+    minting_point, script_key, proof, reason, quarantined_at
+FROM universe_proof_quarantine
+WHERE (server_host = sqlc.narg('server_host') OR
+       sqlc.narg('server_host') IS NULL) AND
+      (universe_id = sqlc.narg('universe_id') OR
+       sqlc.narg('universe_id') IS NULL)
+ORDER BY quarantined_at, id;
+
+-- name: DeleteQuarantinedProofs :execrows
+DELETE FROM universe_proof_quarantine
+WHERE (server_host = sqlc.narg('server_host') OR
+       sqlc.narg('server_host') IS NULL) AND
+      (universe_id = sqlc.narg('universe_id') OR
+       sqlc.narg('universe_id') IS NULL);
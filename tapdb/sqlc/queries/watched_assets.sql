@@ -0,0 +1,27 @@
+-- name: UpsertWatchedAsset :one
+INSERT INTO watched_assets (
+    label, asset_id, group_key, script_key, amount, anchor_point,
+    anchor_pk_script, anchor_height, proof_file, created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+)
+ON CONFLICT (anchor_point, asset_id, script_key)
+    -- The label and proof file of an asset that is watched again are
+    -- updated.
+    DO UPDATE SET label = EXCLUDED.label, proof_file = EXCLUDED.proof_file
+RETURNING id;
+
+-- name: QueryWatchedAssets :many
+SELECT *
+FROM watched_assets
+WHERE (spending_txid IS NULL OR @include_spent = TRUE)
+ORDER BY created_at, id;
+
+-- name: MarkWatchedAssetsSpent :exec
+UPDATE watched_assets
+SET spending_txid = $2, spend_height = $3
+WHERE anchor_point = $1 AND spending_txid IS NULL;
+
+-- name: DeleteWatchedAsset :execrows
+DELETE FROM watched_assets
+WHERE id = $1;
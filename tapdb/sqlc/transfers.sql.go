@@ -60,6 +60,36 @@ func (q *Queries) ApplyPendingOutput(ctx context.Context, arg ApplyPendingOutput
 	return asset_id, err
 }
 
+const deleteAssetTransfer = `-- name: DeleteAssetTransfer :exec
+DELETE FROM asset_transfers
+WHERE id = $1
+`
+
+func (q *Queries) DeleteAssetTransfer(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, deleteAssetTransfer, id)
+	return err
+}
+
+const deleteAssetTransferInputs = `-- name: DeleteAssetTransferInputs :exec
+DELETE FROM asset_transfer_inputs
+WHERE transfer_id = $1
+`
+
+func (q *Queries) DeleteAssetTransferInputs(ctx context.Context, transferID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteAssetTransferInputs, transferID)
+	return err
+}
+
+const deleteAssetTransferOutputs = `-- name: DeleteAssetTransferOutputs :exec
+DELETE FROM asset_transfer_outputs
+WHERE transfer_id = $1
+`
+
+func (q *Queries) DeleteAssetTransferOutputs(ctx context.Context, transferID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteAssetTransferOutputs, transferID)
+	return err
+}
+
 const deleteAssetWitnesses = `-- name: DeleteAssetWitnesses :exec
 DELETE FROM asset_witnesses
 WHERE asset_id = $1
@@ -70,6 +100,64 @@ func (q *Queries) DeleteAssetWitnesses(ctx context.Context, assetID int32) error
 	return err
 }
 
+const deleteChainTx = `-- name: DeleteChainTx :exec
+DELETE FROM chain_txns
+WHERE txid = $1
+`
+
+func (q *Queries) DeleteChainTx(ctx context.Context, txid []byte) error {
+	_, err := q.db.ExecContext(ctx, deleteChainTx, txid)
+	return err
+}
+
+const deleteParcelRequest = `-- name: DeleteParcelRequest :exec
+DELETE FROM parcel_requests
+WHERE request_id = $1
+`
+
+func (q *Queries) DeleteParcelRequest(ctx context.Context, requestID []byte) error {
+	_, err := q.db.ExecContext(ctx, deleteParcelRequest, requestID)
+	return err
+}
+
+const deletePassiveAssets = `-- name: DeletePassiveAssets :exec
+DELETE FROM passive_assets
+WHERE transfer_id = $1
+`
+
+func (q *Queries) DeletePassiveAssets(ctx context.Context, transferID int32) error {
+	_, err := q.db.ExecContext(ctx, deletePassiveAssets, transferID)
+	return err
+}
+
+const deleteTransferBroadcastTrigger = `-- name: DeleteTransferBroadcastTrigger :exec
+DELETE FROM transfer_broadcast_triggers
+WHERE transfer_id = $1
+`
+
+func (q *Queries) DeleteTransferBroadcastTrigger(ctx context.Context, transferID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteTransferBroadcastTrigger, transferID)
+	return err
+}
+
+const fetchTransferBroadcastTrigger = `-- name: FetchTransferBroadcastTrigger :one
+SELECT min_height, min_time
+FROM transfer_broadcast_triggers
+WHERE transfer_id = $1
+`
+
+type FetchTransferBroadcastTriggerRow struct {
+	MinHeight int32
+	MinTime   sql.NullTime
+}
+
+func (q *Queries) FetchTransferBroadcastTrigger(ctx context.Context, transferID int32) (FetchTransferBroadcastTriggerRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchTransferBroadcastTrigger, transferID)
+	var i FetchTransferBroadcastTriggerRow
+	err := row.Scan(&i.MinHeight, &i.MinTime)
+	return i, err
+}
+
 const fetchTransferInputs = `-- name: FetchTransferInputs :many
 SELECT input_id, anchor_point, asset_id, script_key, amount
 FROM asset_transfer_inputs inputs
@@ -118,7 +206,7 @@ const fetchTransferOutputs = `-- name: FetchTransferOutputs :many
 SELECT
     output_id, proof_suffix, amount, serialized_witnesses, script_key_local,
     split_commitment_root_hash, split_commitment_root_value, num_passive_assets,
-    output_type,
+    output_type, receiver_settled_at,
     utxos.utxo_id AS anchor_utxo_id,
     utxos.outpoint AS anchor_outpoint,
     utxos.amt_sats AS anchor_value,
@@ -157,6 +245,7 @@ type FetchTransferOutputsRow struct {
 	SplitCommitmentRootValue sql.NullInt64
 	NumPassiveAssets         int32
 	OutputType               int16
+	ReceiverSettledAt        sql.NullTime
 	AnchorUtxoID             int32
 	AnchorOutpoint           []byte
 	AnchorValue              int64
@@ -193,6 +282,7 @@ func (q *Queries) FetchTransferOutputs(ctx context.Context, transferID int32) ([
 			&i.SplitCommitmentRootValue,
 			&i.NumPassiveAssets,
 			&i.OutputType,
+			&i.ReceiverSettledAt,
 			&i.AnchorUtxoID,
 			&i.AnchorOutpoint,
 			&i.AnchorValue,
@@ -229,9 +319,11 @@ WITH target_txn(txn_id) AS (
     WHERE txid = $3
 )
 INSERT INTO asset_transfers (
-    height_hint, anchor_txn_id, transfer_time_unix
+    height_hint, anchor_txn_id, transfer_time_unix, change_key_policy,
+    parcel_version
 ) VALUES (
-    $1, (SELECT txn_id FROM target_txn), $2
+    $1, (SELECT txn_id FROM target_txn), $2,
+    $4, $5
 ) RETURNING id
 `
 
@@ -239,10 +331,18 @@ type InsertAssetTransferParams struct {
 	HeightHint       int32
 	TransferTimeUnix time.Time
 	AnchorTxid       []byte
+	ChangeKeyPolicy  int16
+	ParcelVersion    int16
 }
 
 func (q *Queries) InsertAssetTransfer(ctx context.Context, arg InsertAssetTransferParams) (int32, error) {
-	row := q.db.QueryRowContext(ctx, insertAssetTransfer, arg.HeightHint, arg.TransferTimeUnix, arg.AnchorTxid)
+	row := q.db.QueryRowContext(ctx, insertAssetTransfer,
+		arg.HeightHint,
+		arg.TransferTimeUnix,
+		arg.AnchorTxid,
+		arg.ChangeKeyPolicy,
+		arg.ParcelVersion,
+	)
 	var id int32
 	err := row.Scan(&id)
 	return id, err
@@ -317,6 +417,47 @@ func (q *Queries) InsertAssetTransferOutput(ctx context.Context, arg InsertAsset
 	return err
 }
 
+const insertParcelRequest = `-- name: InsertParcelRequest :one
+INSERT INTO parcel_requests (
+    request_id, priority, created_at
+) VALUES (
+    $1, $2, $3
+)
+RETURNING id
+`
+
+type InsertParcelRequestParams struct {
+	RequestID []byte
+	Priority  int16
+	CreatedAt time.Time
+}
+
+func (q *Queries) InsertParcelRequest(ctx context.Context, arg InsertParcelRequestParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, insertParcelRequest, arg.RequestID, arg.Priority, arg.CreatedAt)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertParcelRequestAddr = `-- name: InsertParcelRequestAddr :exec
+INSERT INTO parcel_request_addrs (
+    request_id, addr_index, tap_addr
+) VALUES (
+    $1, $2, $3
+)
+`
+
+type InsertParcelRequestAddrParams struct {
+	RequestID int32
+	AddrIndex int32
+	TapAddr   string
+}
+
+func (q *Queries) InsertParcelRequestAddr(ctx context.Context, arg InsertParcelRequestAddrParams) error {
+	_, err := q.db.ExecContext(ctx, insertParcelRequestAddr, arg.RequestID, arg.AddrIndex, arg.TapAddr)
+	return err
+}
+
 const insertPassiveAsset = `-- name: InsertPassiveAsset :exec
 WITH target_asset(asset_id) AS (
     SELECT assets.asset_id
@@ -363,6 +504,42 @@ func (q *Queries) InsertPassiveAsset(ctx context.Context, arg InsertPassiveAsset
 	return err
 }
 
+const insertProofDeliveryAttempt = `-- name: InsertProofDeliveryAttempt :exec
+INSERT INTO proof_courier_outbox (
+    proof_locator_hash, anchor_txid, anchor_output_index, script_key,
+    asset_id, courier_addr, attempt_time, error_msg, next_attempt_time
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+)
+`
+
+type InsertProofDeliveryAttemptParams struct {
+	ProofLocatorHash  []byte
+	AnchorTxid        []byte
+	AnchorOutputIndex int32
+	ScriptKey         []byte
+	AssetID           []byte
+	CourierAddr       string
+	AttemptTime       time.Time
+	ErrorMsg          sql.NullString
+	NextAttemptTime   sql.NullTime
+}
+
+func (q *Queries) InsertProofDeliveryAttempt(ctx context.Context, arg InsertProofDeliveryAttemptParams) error {
+	_, err := q.db.ExecContext(ctx, insertProofDeliveryAttempt,
+		arg.ProofLocatorHash,
+		arg.AnchorTxid,
+		arg.AnchorOutputIndex,
+		arg.ScriptKey,
+		arg.AssetID,
+		arg.CourierAddr,
+		arg.AttemptTime,
+		arg.ErrorMsg,
+		arg.NextAttemptTime,
+	)
+	return err
+}
+
 const insertReceiverProofTransferAttempt = `-- name: InsertReceiverProofTransferAttempt :exec
 INSERT INTO receiver_proof_transfer_attempts (
     proof_locator_hash, time_unix
@@ -381,9 +558,29 @@ func (q *Queries) InsertReceiverProofTransferAttempt(ctx context.Context, arg In
 	return err
 }
 
+const insertTransferBroadcastTrigger = `-- name: InsertTransferBroadcastTrigger :exec
+INSERT INTO transfer_broadcast_triggers (
+    transfer_id, min_height, min_time
+) VALUES (
+    $1, $2, $3
+)
+`
+
+type InsertTransferBroadcastTriggerParams struct {
+	TransferID int32
+	MinHeight  int32
+	MinTime    sql.NullTime
+}
+
+func (q *Queries) InsertTransferBroadcastTrigger(ctx context.Context, arg InsertTransferBroadcastTriggerParams) error {
+	_, err := q.db.ExecContext(ctx, insertTransferBroadcastTrigger, arg.TransferID, arg.MinHeight, arg.MinTime)
+	return err
+}
+
 const queryAssetTransfers = `-- name: QueryAssetTransfers :many
 SELECT
-    id, height_hint, txns.txid, transfer_time_unix
+    id, height_hint, txns.txid, transfer_time_unix, change_key_policy,
+    parcel_version
 FROM asset_transfers transfers
 JOIN chain_txns txns
     ON transfers.anchor_txn_id = txns.txn_id
@@ -405,6 +602,8 @@ type QueryAssetTransfersRow struct {
 	HeightHint       int32
 	Txid             []byte
 	TransferTimeUnix time.Time
+	ChangeKeyPolicy  int16
+	ParcelVersion    int16
 }
 
 // We'll use this clause to filter out for only transfers that are
@@ -425,6 +624,53 @@ func (q *Queries) QueryAssetTransfers(ctx context.Context, arg QueryAssetTransfe
 			&i.HeightHint,
 			&i.Txid,
 			&i.TransferTimeUnix,
+			&i.ChangeKeyPolicy,
+			&i.ParcelVersion,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const queryParcelRequests = `-- name: QueryParcelRequests :many
+SELECT
+    parcel_requests.request_id, parcel_requests.priority,
+    parcel_requests.created_at, addrs.tap_addr
+FROM parcel_requests
+JOIN parcel_request_addrs addrs
+    ON parcel_requests.id = addrs.request_id
+ORDER BY parcel_requests.id, addrs.addr_index
+`
+
+type QueryParcelRequestsRow struct {
+	RequestID []byte
+	Priority  int16
+	CreatedAt time.Time
+	TapAddr   string
+}
+
+func (q *Queries) QueryParcelRequests(ctx context.Context) ([]QueryParcelRequestsRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryParcelRequests)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueryParcelRequestsRow
+	for rows.Next() {
+		var i QueryParcelRequestsRow
+		if err := rows.Scan(
+			&i.RequestID,
+			&i.Priority,
+			&i.CreatedAt,
+			&i.TapAddr,
 		); err != nil {
 			return nil, err
 		}
@@ -490,6 +736,66 @@ func (q *Queries) QueryPassiveAssets(ctx context.Context, transferID int32) ([]Q
 	return items, nil
 }
 
+const queryProofDeliveryAttempts = `-- name: QueryProofDeliveryAttempts :many
+SELECT
+    anchor_txid, anchor_output_index, script_key, asset_id, courier_addr,
+    attempt_time, error_msg, next_attempt_time
+FROM proof_courier_outbox
+WHERE (anchor_txid = $1 OR
+    $1 IS NULL)
+AND (script_key = $2 OR
+    $2 IS NULL)
+ORDER BY id
+`
+
+type QueryProofDeliveryAttemptsParams struct {
+	AnchorTxid []byte
+	ScriptKey  []byte
+}
+
+type QueryProofDeliveryAttemptsRow struct {
+	AnchorTxid        []byte
+	AnchorOutputIndex int32
+	ScriptKey         []byte
+	AssetID           []byte
+	CourierAddr       string
+	AttemptTime       time.Time
+	ErrorMsg          sql.NullString
+	NextAttemptTime   sql.NullTime
+}
+
+func (q *Queries) QueryProofDeliveryAttempts(ctx context.Context, arg QueryProofDeliveryAttemptsParams) ([]QueryProofDeliveryAttemptsRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryProofDeliveryAttempts, arg.AnchorTxid, arg.ScriptKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueryProofDeliveryAttemptsRow
+	for rows.Next() {
+		var i QueryProofDeliveryAttemptsRow
+		if err := rows.Scan(
+			&i.AnchorTxid,
+			&i.AnchorOutputIndex,
+			&i.ScriptKey,
+			&i.AssetID,
+			&i.CourierAddr,
+			&i.AttemptTime,
+			&i.ErrorMsg,
+			&i.NextAttemptTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const queryReceiverProofTransferAttempt = `-- name: QueryReceiverProofTransferAttempt :many
 SELECT time_unix
 FROM receiver_proof_transfer_attempts
@@ -535,3 +841,48 @@ func (q *Queries) ReAnchorPassiveAssets(ctx context.Context, arg ReAnchorPassive
 	_, err := q.db.ExecContext(ctx, reAnchorPassiveAssets, arg.NewAnchorUtxoID, arg.AssetID)
 	return err
 }
+
+const setTransferOutputSettled = `-- name: SetTransferOutputSettled :exec
+UPDATE asset_transfer_outputs
+SET receiver_settled_at = $1
+WHERE anchor_utxo IN (
+    SELECT utxo_id
+    FROM managed_utxos
+    WHERE outpoint = $2
+) AND script_key IN (
+    SELECT script_key_id
+    FROM script_keys
+    WHERE tweaked_script_key = $3
+) AND receiver_settled_at IS NULL
+`
+
+type SetTransferOutputSettledParams struct {
+	SettledAt      sql.NullTime
+	AnchorOutpoint []byte
+	ScriptKey      []byte
+}
+
+func (q *Queries) SetTransferOutputSettled(ctx context.Context, arg SetTransferOutputSettledParams) error {
+	_, err := q.db.ExecContext(ctx, setTransferOutputSettled, arg.SettledAt, arg.AnchorOutpoint, arg.ScriptKey)
+	return err
+}
+
+const updateTransferParcelVersion = `-- name: UpdateTransferParcelVersion :exec
+UPDATE asset_transfers
+SET parcel_version = $1
+WHERE anchor_txn_id = (
+    SELECT txn_id
+    FROM chain_txns
+    WHERE txid = $2
+)
+`
+
+type UpdateTransferParcelVersionParams struct {
+	ParcelVersion int16
+	AnchorTxid    []byte
+}
+
+func (q *Queries) UpdateTransferParcelVersion(ctx context.Context, arg UpdateTransferParcelVersionParams) error {
+	_, err := q.db.ExecContext(ctx, updateTransferParcelVersion, arg.ParcelVersion, arg.AnchorTxid)
+	return err
+}
@@ -11,6 +11,27 @@ import (
 	"time"
 )
 
+const deleteQuarantinedProofs = `-- name: DeleteQuarantinedProofs :execrows
+DELETE FROM universe_proof_quarantine
+WHERE (server_host = $1 OR
+       $1 IS NULL) AND
+      (universe_id = $2 OR
+       $2 IS NULL)
+`
+
+type DeleteQuarantinedProofsParams struct {
+	ServerHost sql.NullString
+	UniverseID []byte
+}
+
+func (q *Queries) DeleteQuarantinedProofs(ctx context.Context, arg DeleteQuarantinedProofsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteQuarantinedProofs, arg.ServerHost, arg.UniverseID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteUniverseEvents = `-- name: DeleteUniverseEvents :exec
 WITH root_id AS (
     SELECT id
@@ -369,6 +390,109 @@ func (q *Queries) QueryAssetStatsPerDaySqlite(ctx context.Context, arg QueryAsse
 	return items, nil
 }
 
+const queryQuarantinedProofs = `-- name: QueryQuarantinedProofs :many
+SELECT id, server_host, universe_id, asset_id, group_key, leaf_key,
+    minting_point, script_key, proof, reason, quarantined_at
+FROM universe_proof_quarantine
+WHERE (server_host = $1 OR
+       $1 IS NULL) AND
+      (universe_id = $2 OR
+       $2 IS NULL)
+ORDER BY quarantined_at, id
+`
+
+type QueryQuarantinedProofsParams struct {
+	ServerHost sql.NullString
+	UniverseID []byte
+}
+
+func (q *Queries) QueryQuarantinedProofs(ctx context.Context, arg QueryQuarantinedProofsParams) ([]UniverseProofQuarantine, error) {
+	rows, err := q.db.QueryContext(ctx, queryQuarantinedProofs, arg.ServerHost, arg.UniverseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UniverseProofQuarantine
+	for rows.Next() {
+		var i UniverseProofQuarantine
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServerHost,
+			&i.UniverseID,
+			&i.AssetID,
+			&i.GroupKey,
+			&i.LeafKey,
+			&i.MintingPoint,
+			&i.ScriptKey,
+			&i.Proof,
+			&i.Reason,
+			&i.QuarantinedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const querySyncCheckpoints = `-- name: QuerySyncCheckpoints :many
+SELECT id, server_host, universe_id, asset_id, group_key, root_hash, root_sum,
+    leaves_fetched, leaves_total, complete, last_error, updated_at
+FROM universe_sync_checkpoints
+WHERE (server_host = $1 OR
+       $1 IS NULL) AND
+      (universe_id = $2 OR
+       $2 IS NULL)
+ORDER BY server_host, universe_id
+`
+
+type QuerySyncCheckpointsParams struct {
+	ServerHost sql.NullString
+	UniverseID []byte
+}
+
+func (q *Queries) QuerySyncCheckpoints(ctx context.Context, arg QuerySyncCheckpointsParams) ([]UniverseSyncCheckpoint, error) {
+	rows, err := q.db.QueryContext(ctx, querySyncCheckpoints, arg.ServerHost, arg.UniverseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UniverseSyncCheckpoint
+	for rows.Next() {
+		var i UniverseSyncCheckpoint
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServerHost,
+			&i.UniverseID,
+			&i.AssetID,
+			&i.GroupKey,
+			&i.RootHash,
+			&i.RootSum,
+			&i.LeavesFetched,
+			&i.LeavesTotal,
+			&i.Complete,
+			&i.LastError,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const queryUniverseAssetStats = `-- name: QueryUniverseAssetStats :many
 
 WITH asset_supply AS (
@@ -666,6 +790,100 @@ func (q *Queries) UniverseRoots(ctx context.Context) ([]UniverseRootsRow, error)
 	return items, nil
 }
 
+const upsertQuarantinedProof = `-- name: UpsertQuarantinedProof :exec
+INSERT INTO universe_proof_quarantine (
+    server_host, universe_id, asset_id, group_key, leaf_key, minting_point,
+    script_key, proof, reason, quarantined_at
+) VALUES (
+    $1, $2, $3, $4, $5,
+    $6, $7, $8, $9, $10
+) ON CONFLICT (server_host, universe_id, leaf_key)
+    DO UPDATE SET
+        proof = EXCLUDED.proof,
+        reason = EXCLUDED.reason,
+        quarantined_at = EXCLUDED.quarantined_at
+`
+
+type UpsertQuarantinedProofParams struct {
+	ServerHost    string
+	UniverseID    []byte
+	AssetID       []byte
+	GroupKey      []byte
+	LeafKey       []byte
+	MintingPoint  []byte
+	ScriptKey     []byte
+	Proof         []byte
+	Reason        string
+	QuarantinedAt time.Time
+}
+
+func (q *Queries) UpsertQuarantinedProof(ctx context.Context, arg UpsertQuarantinedProofParams) error {
+	_, err := q.db.ExecContext(ctx, upsertQuarantinedProof,
+		arg.ServerHost,
+		arg.UniverseID,
+		arg.AssetID,
+		arg.GroupKey,
+		arg.LeafKey,
+		arg.MintingPoint,
+		arg.ScriptKey,
+		arg.Proof,
+		arg.Reason,
+		arg.QuarantinedAt,
+	)
+	return err
+}
+
+const upsertSyncCheckpoint = `-- name: UpsertSyncCheckpoint :exec
+INSERT INTO universe_sync_checkpoints (
+    server_host, universe_id, asset_id, group_key, root_hash, root_sum,
+    leaves_fetched, leaves_total, complete, last_error, updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6,
+    $7, $8, $9, $10, $11
+) ON CONFLICT (server_host, universe_id)
+    DO UPDATE SET
+        asset_id = EXCLUDED.asset_id,
+        group_key = EXCLUDED.group_key,
+        root_hash = EXCLUDED.root_hash,
+        root_sum = EXCLUDED.root_sum,
+        leaves_fetched = EXCLUDED.leaves_fetched,
+        leaves_total = EXCLUDED.leaves_total,
+        complete = EXCLUDED.complete,
+        last_error = EXCLUDED.last_error,
+        updated_at = EXCLUDED.updated_at
+`
+
+type UpsertSyncCheckpointParams struct {
+	ServerHost    string
+	UniverseID    []byte
+	AssetID       []byte
+	GroupKey      []byte
+	RootHash      []byte
+	RootSum       int64
+	LeavesFetched int64
+	LeavesTotal   int64
+	Complete      bool
+	LastError     sql.NullString
+	UpdatedAt     time.Time
+}
+
+func (q *Queries) UpsertSyncCheckpoint(ctx context.Context, arg UpsertSyncCheckpointParams) error {
+	_, err := q.db.ExecContext(ctx, upsertSyncCheckpoint,
+		arg.ServerHost,
+		arg.UniverseID,
+		arg.AssetID,
+		arg.GroupKey,
+		arg.RootHash,
+		arg.RootSum,
+		arg.LeavesFetched,
+		arg.LeavesTotal,
+		arg.Complete,
+		arg.LastError,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
 const upsertUniverseLeaf = `-- name: UpsertUniverseLeaf :exec
 INSERT INTO universe_leaves (
     asset_genesis_id, script_key_bytes, universe_root_id, leaf_node_key, 
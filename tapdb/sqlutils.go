@@ -64,6 +64,19 @@ func sqlStr(s string) sql.NullString {
 	}
 }
 
+// sqlOptTime turns a time into the NullTime that sql/sqlc uses when a time
+// can be permitted to be NULL. A zero time is mapped to NULL.
+func sqlOptTime(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{
+		Time:  t.UTC(),
+		Valid: true,
+	}
+}
+
 // extractSqlInt32 turns a NullInt32 into a numerical type. This can be useful
 // when reading directly from the database, as this function handles extracting
 // the inner value from the "option"-like struct.
@@ -0,0 +1,351 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+type (
+	// NewTransferTemplate is used to insert a new transfer template.
+	NewTransferTemplate = sqlc.InsertTransferTemplateParams
+
+	// NewTemplateRecipient is used to insert a new recipient of a
+	// transfer template.
+	NewTemplateRecipient = sqlc.InsertTransferTemplateRecipientParams
+
+	// TransferTemplateRow is a single transfer template.
+	TransferTemplateRow = sqlc.TransferTemplate
+
+	// TransferTemplateRecipientRow is a single transfer template
+	// recipient.
+	TransferTemplateRecipientRow = sqlc.TransferTemplateRecipient
+)
+
+// TemplateStore is the set of queries needed to maintain the transfer
+// templates.
+type TemplateStore interface {
+	// InsertTransferTemplate inserts a new transfer template and returns
+	// its primary key.
+	InsertTransferTemplate(ctx context.Context,
+		arg NewTransferTemplate) (int32, error)
+
+	// InsertTransferTemplateRecipient inserts a new recipient of a
+	// transfer template.
+	InsertTransferTemplateRecipient(ctx context.Context,
+		arg NewTemplateRecipient) error
+
+	// QueryTransferTemplates returns the transfer template with the given
+	// name, or all templates if the name is NULL, ordered by their name.
+	QueryTransferTemplates(ctx context.Context,
+		name sql.NullString) ([]TransferTemplateRow, error)
+
+	// QueryTransferTemplateRecipients returns the recipients of the given
+	// transfer template, ordered by their position in the template.
+	QueryTransferTemplateRecipients(ctx context.Context,
+		templateID int32) ([]TransferTemplateRecipientRow, error)
+
+	// DeleteTransferTemplate deletes the transfer template with the given
+	// name together with its recipients and returns the number of deleted
+	// templates.
+	DeleteTransferTemplate(ctx context.Context, name string) (int64,
+		error)
+}
+
+// TransferTemplateTxOptions is the database tx object for the transfer
+// template store.
+type TransferTemplateTxOptions struct {
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (t *TransferTemplateTxOptions) ReadOnly() bool {
+	return t.readOnly
+}
+
+// NewTransferTemplateReadTx returns a new read tx for the transfer template
+// store.
+func NewTransferTemplateReadTx() TransferTemplateTxOptions {
+	return TransferTemplateTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedTemplateStore allows for batched DB transactions for the
+// transfer template store.
+type BatchedTemplateStore interface {
+	TemplateStore
+
+	BatchedTx[TemplateStore]
+}
+
+// TransferTemplates is a database backed implementation of the
+// tapfreighter.TemplateStore interface.
+type TransferTemplates struct {
+	db BatchedTemplateStore
+
+	clock clock.Clock
+}
+
+// NewTransferTemplates creates a new transfer template store backed by the
+// given database.
+func NewTransferTemplates(db BatchedTemplateStore,
+	clock clock.Clock) *TransferTemplates {
+
+	return &TransferTemplates{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// CreateTemplate stores a new transfer template with all its recipients and
+// returns its ID. If a template with the same name exists,
+// tapfreighter.ErrTemplateExists is returned.
+//
+// NOTE: This is part of the tapfreighter.TemplateStore interface.
+func (t *TransferTemplates) CreateTemplate(ctx context.Context,
+	tmpl *tapfreighter.TransferTemplate) (int64, error) {
+
+	var (
+		now        = t.clock.Now().UTC()
+		policy     = tmpl.FeePolicy
+		escalation = policy.Escalation
+	)
+
+	var (
+		writeTx TransferTemplateTxOptions
+		id      int32
+	)
+	dbErr := t.db.ExecTx(ctx, &writeTx, func(q TemplateStore) error {
+		var err error
+		id, err = q.InsertTransferTemplate(ctx, NewTransferTemplate{
+			Name:     tmpl.Name,
+			AssetID:  tmpl.AssetID[:],
+			Priority: int16(policy.Priority),
+			ConfTargetSeconds: int64(
+				policy.ConfTarget / time.Second,
+			),
+			FeeBumpIntervalSeconds: int64(
+				escalation.Interval / time.Second,
+			),
+			FeeBumpPercent: int32(escalation.IncreasePercent),
+			MaxFeeRate:     int64(escalation.MaxFeeRate),
+			CreatedAt:      now,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert transfer "+
+				"template: %w", err)
+		}
+
+		for _, r := range tmpl.Recipients {
+			recipient := NewTemplateRecipient{
+				TemplateID:     id,
+				RecipientIndex: int32(r.Index),
+				TapAddr:        sqlStr(r.Addr),
+				Amount:         int64(r.Amount),
+				ShareBps:       int32(r.ShareBps),
+			}
+			if r.ScriptKey != nil {
+				recipient.ScriptKey =
+					r.ScriptKey.SerializeCompressed()
+			}
+			if r.InternalKey != nil {
+				recipient.InternalKey =
+					r.InternalKey.SerializeCompressed()
+			}
+
+			err := q.InsertTransferTemplateRecipient(ctx, recipient)
+			if err != nil {
+				return fmt.Errorf("unable to insert transfer "+
+					"template recipient: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		var uniqueConstraintErr *ErrSqlUniqueConstraintViolation
+		if errors.As(dbErr, &uniqueConstraintErr) {
+			return 0, fmt.Errorf("%w: %s",
+				tapfreighter.ErrTemplateExists, tmpl.Name)
+		}
+
+		return 0, dbErr
+	}
+
+	tmpl.CreatedAt = now
+
+	return int64(id), nil
+}
+
+// FetchTemplate returns the transfer template with the given name. If no such
+// template exists, tapfreighter.ErrTemplateNotFound is returned.
+//
+// NOTE: This is part of the tapfreighter.TemplateStore interface.
+func (t *TransferTemplates) FetchTemplate(ctx context.Context,
+	name string) (*tapfreighter.TransferTemplate, error) {
+
+	templates, err := t.queryTemplates(ctx, sqlStr(name))
+	if err != nil {
+		return nil, err
+	}
+	if len(templates) == 0 {
+		return nil, fmt.Errorf("%w: %s",
+			tapfreighter.ErrTemplateNotFound, name)
+	}
+
+	return templates[0], nil
+}
+
+// ListTemplates returns all transfer templates, ordered by their name.
+//
+// NOTE: This is part of the tapfreighter.TemplateStore interface.
+func (t *TransferTemplates) ListTemplates(
+	ctx context.Context) ([]*tapfreighter.TransferTemplate, error) {
+
+	return t.queryTemplates(ctx, sql.NullString{})
+}
+
+// DeleteTemplate deletes the transfer template with the given name. If no
+// such template exists, tapfreighter.ErrTemplateNotFound is returned.
+//
+// NOTE: This is part of the tapfreighter.TemplateStore interface.
+func (t *TransferTemplates) DeleteTemplate(ctx context.Context,
+	name string) error {
+
+	var writeTx TransferTemplateTxOptions
+	return t.db.ExecTx(ctx, &writeTx, func(q TemplateStore) error {
+		numDeleted, err := q.DeleteTransferTemplate(ctx, name)
+		if err != nil {
+			return fmt.Errorf("unable to delete transfer "+
+				"template: %w", err)
+		}
+		if numDeleted == 0 {
+			return fmt.Errorf("%w: %s",
+				tapfreighter.ErrTemplateNotFound, name)
+		}
+
+		return nil
+	})
+}
+
+// queryTemplates returns the transfer template with the given name, or all
+// templates if the name is NULL, together with their recipients.
+func (t *TransferTemplates) queryTemplates(ctx context.Context,
+	name sql.NullString) ([]*tapfreighter.TransferTemplate, error) {
+
+	var (
+		readTx    = NewTransferTemplateReadTx()
+		templates []*tapfreighter.TransferTemplate
+	)
+	dbErr := t.db.ExecTx(ctx, &readTx, func(q TemplateStore) error {
+		templates = nil
+
+		rows, err := q.QueryTransferTemplates(ctx, name)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			recipientRows, err := q.QueryTransferTemplateRecipients(
+				ctx, row.ID,
+			)
+			if err != nil {
+				return err
+			}
+
+			tmpl, err := parseTransferTemplate(row, recipientRows)
+			if err != nil {
+				return err
+			}
+
+			templates = append(templates, tmpl)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query transfer templates: %w",
+			dbErr)
+	}
+
+	return templates, nil
+}
+
+// parseTransferTemplate parses a transfer template from its database rows.
+func parseTransferTemplate(row TransferTemplateRow,
+	recipientRows []TransferTemplateRecipientRow) (
+	*tapfreighter.TransferTemplate, error) {
+
+	tmpl := &tapfreighter.TransferTemplate{
+		ID:   int64(row.ID),
+		Name: row.Name,
+		FeePolicy: tapfreighter.TemplateFeePolicy{
+			Priority: tapfreighter.ParcelPriority(row.Priority),
+			ConfTarget: time.Duration(
+				row.ConfTargetSeconds,
+			) * time.Second,
+			Escalation: tapfreighter.FeeEscalation{
+				Interval: time.Duration(
+					row.FeeBumpIntervalSeconds,
+				) * time.Second,
+				IncreasePercent: uint32(row.FeeBumpPercent),
+				MaxFeeRate: chainfee.SatPerKWeight(
+					row.MaxFeeRate,
+				),
+			},
+		},
+		CreatedAt: row.CreatedAt.UTC(),
+		Recipients: make(
+			[]*tapfreighter.TemplateRecipient, len(recipientRows),
+		),
+	}
+	copy(tmpl.AssetID[:], row.AssetID)
+
+	for idx, r := range recipientRows {
+		recipient := &tapfreighter.TemplateRecipient{
+			Index:    uint32(r.RecipientIndex),
+			Addr:     r.TapAddr.String,
+			Amount:   uint64(r.Amount),
+			ShareBps: uint32(r.ShareBps),
+		}
+
+		var err error
+		if len(r.ScriptKey) > 0 {
+			recipient.ScriptKey, err = btcec.ParsePubKey(
+				r.ScriptKey,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse "+
+					"script key: %w", err)
+			}
+		}
+		if len(r.InternalKey) > 0 {
+			recipient.InternalKey, err = btcec.ParsePubKey(
+				r.InternalKey,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse "+
+					"internal key: %w", err)
+			}
+		}
+
+		tmpl.Recipients[idx] = recipient
+	}
+
+	return tmpl, nil
+}
+
+// A compile-time assertion to ensure that TransferTemplates meets the
+// tapfreighter.TemplateStore interface.
+var _ tapfreighter.TemplateStore = (*TransferTemplates)(nil)
@@ -0,0 +1,105 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTransferTemplates tests that transfer templates can be created,
+// fetched, listed and deleted.
+func TestTransferTemplates(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	templateDB := NewTransactionExecutor(
+		db, func(tx *sql.Tx) TemplateStore {
+			return db.WithTx(tx)
+		},
+	)
+	testClock := clock.NewTestClock(time.Unix(1_700_000_000, 0))
+	store := NewTransferTemplates(templateDB, testClock)
+	ctx := context.Background()
+
+	_, err := store.FetchTemplate(ctx, "payroll")
+	require.ErrorIs(t, err, tapfreighter.ErrTemplateNotFound)
+
+	payroll := &tapfreighter.TransferTemplate{
+		Name:    "payroll",
+		AssetID: asset.ID(test.RandHash()),
+		Recipients: []*tapfreighter.TemplateRecipient{{
+			Index: 0,
+			Addr:  "taptb1addr",
+		}, {
+			Index:       1,
+			ScriptKey:   test.RandPubKey(t),
+			InternalKey: test.RandPubKey(t),
+			Amount:      500,
+		}, {
+			Index:       2,
+			ScriptKey:   test.RandPubKey(t),
+			InternalKey: test.RandPubKey(t),
+			ShareBps:    tapfreighter.TemplateShareTotal,
+		}},
+		FeePolicy: tapfreighter.TemplateFeePolicy{
+			Priority:   tapfreighter.PriorityBatchable,
+			ConfTarget: time.Hour,
+			Escalation: tapfreighter.FeeEscalation{
+				Interval:        10 * time.Minute,
+				IncreasePercent: 20,
+				MaxFeeRate:      5000,
+			},
+		},
+	}
+	payroll.ID, err = store.CreateTemplate(ctx, payroll)
+	require.NoError(t, err)
+
+	grants := &tapfreighter.TransferTemplate{
+		Name:    "grants",
+		AssetID: payroll.AssetID,
+		Recipients: []*tapfreighter.TemplateRecipient{{
+			Index: 0,
+			Addr:  "taptb1other",
+		}},
+	}
+	grants.ID, err = store.CreateTemplate(ctx, grants)
+	require.NoError(t, err)
+
+	// Template names are unique.
+	_, err = store.CreateTemplate(ctx, &tapfreighter.TransferTemplate{
+		Name:       "payroll",
+		AssetID:    payroll.AssetID,
+		Recipients: grants.Recipients,
+	})
+	require.ErrorIs(t, err, tapfreighter.ErrTemplateExists)
+
+	dbPayroll, err := store.FetchTemplate(ctx, "payroll")
+	require.NoError(t, err)
+	require.Equal(t, payroll, dbPayroll)
+
+	templates, err := store.ListTemplates(ctx)
+	require.NoError(t, err)
+	require.Equal(
+		t, []*tapfreighter.TransferTemplate{grants, payroll}, templates,
+	)
+
+	// Deleting a template also deletes its recipients, so a template with
+	// the same name can be created again.
+	require.NoError(t, store.DeleteTemplate(ctx, "payroll"))
+	err = store.DeleteTemplate(ctx, "payroll")
+	require.ErrorIs(t, err, tapfreighter.ErrTemplateNotFound)
+
+	payroll.ID, err = store.CreateTemplate(ctx, payroll)
+	require.NoError(t, err)
+
+	dbPayroll, err = store.FetchTemplate(ctx, "payroll")
+	require.NoError(t, err)
+	require.Equal(t, payroll, dbPayroll)
+}
@@ -0,0 +1,101 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// ListTombstoneAnchors returns all anchor outputs that only commit to
+// tombstone asset outputs and weren't swept yet.
+//
+// NOTE: This is part of the tapfreighter.TombstoneStore interface.
+func (a *AssetStore) ListTombstoneAnchors(
+	ctx context.Context) ([]tapfreighter.TombstoneAnchor, error) {
+
+	var (
+		readOpts = NewAssetStoreReadTx()
+		rows     []TombstoneAnchorRow
+	)
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		var err error
+		rows, err = q.QueryTombstoneAnchors(ctx, asset.NUMSBytes)
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query tombstone anchors: %w",
+			dbErr)
+	}
+
+	anchors := make([]tapfreighter.TombstoneAnchor, len(rows))
+	for i, row := range rows {
+		err := readOutPoint(
+			bytes.NewReader(row.Outpoint), 0, 0,
+			&anchors[i].OutPoint,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode outpoint: %w",
+				err)
+		}
+
+		internalKey, err := btcec.ParsePubKey(row.RawKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse internal "+
+				"key: %w", err)
+		}
+
+		anchors[i].Value = btcutil.Amount(row.AmtSats)
+		anchors[i].InternalKey = keychain.KeyDescriptor{
+			KeyLocator: keychain.KeyLocator{
+				Family: keychain.KeyFamily(row.KeyFamily),
+				Index:  uint32(row.KeyIndex),
+			},
+			PubKey: internalKey,
+		}
+		anchors[i].MerkleRoot = row.MerkleRoot
+	}
+
+	return anchors, nil
+}
+
+// LogTombstoneSweep records that the anchor outputs spent by the given sweep
+// transaction were swept, so they aren't swept again.
+//
+// NOTE: This is part of the tapfreighter.TombstoneStore interface.
+func (a *AssetStore) LogTombstoneSweep(ctx context.Context,
+	sweepTx *wire.MsgTx, sweptAt time.Time) error {
+
+	sweepTxid := sweepTx.TxHash()
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		for _, txIn := range sweepTx.TxIn {
+			anchorPoint, err := encodeOutpoint(
+				txIn.PreviousOutPoint,
+			)
+			if err != nil {
+				return err
+			}
+
+			err = q.InsertTombstoneSweep(ctx, NewTombstoneSweep{
+				AnchorPoint: anchorPoint,
+				SweepTxid:   sweepTxid[:],
+				SweptAt:     sweptAt.UTC(),
+			})
+			if err != nil {
+				return fmt.Errorf("unable to insert tombstone "+
+					"sweep: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,116 @@
+package tapdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTombstoneSweeps tests that only anchor outputs that exclusively commit
+// to tombstones are listed for sweeping, and that they're no longer listed
+// once their sweep was logged.
+func TestTombstoneSweeps(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	// The first anchor only carries a tombstone, the second one a
+	// tombstone next to a normal asset and the third one a normal asset.
+	assetGen := newAssetGenerator(t, 4, 1)
+	assetGen.genAssets(t, assetsStore, []assetDesc{
+		{
+			assetGen:    assetGen.assetGens[0],
+			anchorPoint: assetGen.anchorPoints[0],
+			noGroupKey:  true,
+			spent:       true,
+		},
+		{
+			assetGen:    assetGen.assetGens[1],
+			anchorPoint: assetGen.anchorPoints[1],
+			noGroupKey:  true,
+			spent:       true,
+		},
+		{
+			assetGen:    assetGen.assetGens[2],
+			anchorPoint: assetGen.anchorPoints[1],
+			noGroupKey:  true,
+			amt:         5,
+		},
+		{
+			assetGen:    assetGen.assetGens[3],
+			anchorPoint: assetGen.anchorPoints[2],
+			noGroupKey:  true,
+			amt:         7,
+		},
+	})
+
+	tombstoneAnchor := assetGen.anchorPoints[0]
+	anchorTx := assetGen.anchorPointsToTx[tombstoneAnchor]
+
+	anchors, err := assetsStore.ListTombstoneAnchors(ctx)
+	require.NoError(t, err)
+	require.Len(t, anchors, 1)
+	require.Equal(t, tombstoneAnchor, anchors[0].OutPoint)
+	require.EqualValues(t, anchorTx.TxOut[0].Value, anchors[0].Value)
+	require.NotNil(t, anchors[0].InternalKey.PubKey)
+	require.NotEmpty(t, anchors[0].MerkleRoot)
+
+	// Once the sweep of the anchor is logged, it's no longer listed.
+	sweepTx := wire.NewMsgTx(2)
+	sweepTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: tombstoneAnchor,
+	})
+	require.NoError(t, assetsStore.LogTombstoneSweep(
+		ctx, sweepTx, time.Now(),
+	))
+
+	anchors, err = assetsStore.ListTombstoneAnchors(ctx)
+	require.NoError(t, err)
+	require.Empty(t, anchors)
+}
+
+// TestTombstoneSweepsSkipFrozen tests that anchor outputs that only commit to
+// tombstones aren't listed for sweeping while they're frozen.
+func TestTombstoneSweepsSkipFrozen(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	assetGen := newAssetGenerator(t, 2, 1)
+	assetGen.genAssets(t, assetsStore, []assetDesc{
+		{
+			assetGen:    assetGen.assetGens[0],
+			anchorPoint: assetGen.anchorPoints[0],
+			noGroupKey:  true,
+		},
+		{
+			assetGen:    assetGen.assetGens[1],
+			anchorPoint: assetGen.anchorPoints[1],
+			noGroupKey:  true,
+		},
+	})
+
+	frozenAnchor := assetGen.anchorPoints[0]
+	_, err := assetsStore.FreezeAssetOutputs(
+		ctx, frozenAnchor, nil, "compliance hold",
+	)
+	require.NoError(t, err)
+
+	anchors, err := assetsStore.ListTombstoneAnchors(ctx)
+	require.NoError(t, err)
+	require.Len(t, anchors, 1)
+	require.Equal(t, assetGen.anchorPoints[1], anchors[0].OutPoint)
+
+	// Once the anchor is unfrozen, it's listed again.
+	_, err = assetsStore.UnfreezeAssetOutputs(ctx, frozenAnchor, nil)
+	require.NoError(t, err)
+
+	anchors, err = assetsStore.ListTombstoneAnchors(ctx)
+	require.NoError(t, err)
+	require.Len(t, anchors, 2)
+}
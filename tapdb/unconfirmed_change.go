@@ -0,0 +1,226 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+)
+
+// ListUnconfirmedChange returns the local outputs of broadcast but unconfirmed
+// transfers that satisfy the given constraints and aren't leased or spent by
+// another transfer yet. Only outputs that are the single asset committed to in
+// their anchor output are returned, as the commitment of the anchor output is
+// reconstructed from the proof suffix of the output.
+//
+// NOTE: This is part of the tapfreighter.UnconfirmedChangeLister interface.
+func (a *AssetStore) ListUnconfirmedChange(ctx context.Context,
+	constraints tapfreighter.CommitmentConstraints) (
+	[]*tapfreighter.AnchoredCommitment, error) {
+
+	var (
+		readOpts = NewAssetStoreReadTx()
+		now      = a.clock.Now().UTC()
+		change   []*tapfreighter.AnchoredCommitment
+	)
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		change = nil
+
+		dbTransfers, err := q.QueryAssetTransfers(ctx, TransferQuery{
+			UnconfOnly: true,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to query asset transfers: %w",
+				err)
+		}
+
+		parcels := make(
+			[]*tapfreighter.OutboundParcel, len(dbTransfers),
+		)
+		spent := make(map[wire.OutPoint]struct{})
+		for idx := range dbTransfers {
+			parcels[idx], _, err = fetchOutboundParcel(
+				ctx, q, dbTransfers[idx],
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, input := range parcels[idx].Inputs {
+				spent[input.OutPoint] = struct{}{}
+			}
+		}
+
+		frozenOutputs, err := q.QueryFrozenAssetOutputs(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to query frozen asset "+
+				"outputs: %w", err)
+		}
+		frozen := make(map[string]struct{}, len(frozenOutputs))
+		for _, frozenOutput := range frozenOutputs {
+			frozen[string(frozenOutput.Outpoint)] = struct{}{}
+		}
+
+		for _, out := range changeCandidates(parcels, spent) {
+			anchorPoint := out.Anchor.OutPoint
+			anchorPointBytes, err := encodeOutpoint(anchorPoint)
+			if err != nil {
+				return err
+			}
+			if _, ok := frozen[string(anchorPointBytes)]; ok {
+				continue
+			}
+
+			anchorUTXO, err := q.FetchManagedUTXO(ctx, UtxoQuery{
+				Outpoint: anchorPointBytes,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to fetch managed "+
+					"UTXO %v: %w", anchorPoint, err)
+			}
+			if len(anchorUTXO.LeaseOwner) > 0 &&
+				anchorUTXO.LeaseExpiry.Valid &&
+				anchorUTXO.LeaseExpiry.Time.After(now) {
+
+				continue
+			}
+
+			c, err := unconfirmedChangeCommitment(out, constraints)
+			if err != nil {
+				log.Warnf("Unable to use unconfirmed change "+
+					"at %v: %v", anchorPoint, err)
+				continue
+			}
+			if c != nil {
+				change = append(change, c)
+			}
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to list unconfirmed change: %w",
+			dbErr)
+	}
+
+	return change, nil
+}
+
+// changeCandidates returns the local outputs of the given unconfirmed parcels
+// that might be spent as unconfirmed change. An output is a candidate if it
+// isn't spent yet and is the only asset committed to in its anchor output.
+func changeCandidates(parcels []*tapfreighter.OutboundParcel,
+	spent map[wire.OutPoint]struct{}) []*tapfreighter.TransferOutput {
+
+	var candidates []*tapfreighter.TransferOutput
+	for _, parcel := range parcels {
+		// A scheduled parcel might not be broadcast for a long time, or
+		// might be canceled, so we don't chain onto it.
+		if parcel.BroadcastTrigger != nil {
+			continue
+		}
+
+		numAnchored := make(map[wire.OutPoint]int)
+		for _, out := range parcel.Outputs {
+			numAnchored[out.Anchor.OutPoint]++
+		}
+
+		for idx := range parcel.Outputs {
+			out := &parcel.Outputs[idx]
+			anchorPoint := out.Anchor.OutPoint
+
+			_, isSpent := spent[anchorPoint]
+			switch {
+			case isSpent, numAnchored[anchorPoint] != 1:
+				continue
+
+			case !out.ScriptKeyLocal, out.Amount == 0:
+				continue
+
+			case out.Anchor.NumPassiveAssets > 0,
+				out.Type == tappsbt.TypePassiveAssetsOnly:
+
+				continue
+			}
+
+			candidates = append(candidates, out)
+		}
+	}
+
+	return candidates
+}
+
+// unconfirmedChangeCommitment reconstructs the anchored commitment of the
+// given unconfirmed transfer output from its proof suffix. Nil is returned if
+// the asset of the output doesn't satisfy the given constraints.
+func unconfirmedChangeCommitment(out *tapfreighter.TransferOutput,
+	constraints tapfreighter.CommitmentConstraints) (
+	*tapfreighter.AnchoredCommitment, error) {
+
+	var proofSuffix proof.Proof
+	err := proofSuffix.Decode(bytes.NewReader(out.ProofSuffix))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode proof suffix: %w", err)
+	}
+
+	// The proof only contains the script public key, so we use the script
+	// key of the output that also contains the key derivation info needed
+	// to sign for the asset.
+	changeAsset := proofSuffix.Asset.Copy()
+	changeAsset.ScriptKey = out.ScriptKey
+
+	assetID := changeAsset.ID()
+	groupKey := changeAsset.GroupKey
+	switch {
+	case constraints.AssetID != nil && *constraints.AssetID != assetID:
+		return nil, nil
+
+	case constraints.GroupKey != nil && (groupKey == nil ||
+		!groupKey.GroupPubKey.IsEqual(constraints.GroupKey)):
+
+		return nil, nil
+
+	case changeAsset.Amount < constraints.MinAmt:
+		return nil, nil
+	}
+
+	// The asset is the only one committed to in the anchor output, so the
+	// commitment we create from it must match the stored root.
+	tapCommitment, err := commitment.FromAssets(changeAsset)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create commitment: %w", err)
+	}
+	taprootAssetRoot := tapCommitment.TapscriptRoot(nil)
+	if !bytes.Equal(taprootAssetRoot[:], out.Anchor.TaprootAssetRoot) {
+		return nil, fmt.Errorf("commitment root %x doesn't match "+
+			"anchor root %x", taprootAssetRoot[:],
+			out.Anchor.TaprootAssetRoot)
+	}
+
+	tapscriptSibling, _, err := commitment.MaybeDecodeTapscriptPreimage(
+		out.Anchor.TapscriptSibling,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode tapscript sibling: "+
+			"%w", err)
+	}
+
+	return &tapfreighter.AnchoredCommitment{
+		AnchorPoint:       out.Anchor.OutPoint,
+		AnchorOutputValue: out.Anchor.Value,
+		InternalKey:       out.Anchor.InternalKey,
+		TapscriptSibling:  tapscriptSibling,
+		Commitment:        tapCommitment,
+		Asset:             changeAsset,
+		UnconfirmedProof:  out.ProofSuffix,
+	}, nil
+}
+
+// A compile-time assertion to make sure AssetStore satisfies the
+// tapfreighter.UnconfirmedChangeLister interface.
+var _ tapfreighter.UnconfirmedChangeLister = (*AssetStore)(nil)
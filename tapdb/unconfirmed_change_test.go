@@ -0,0 +1,153 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/stretchr/testify/require"
+)
+
+// TestListUnconfirmedChange tests that the local outputs of unconfirmed
+// transfers are listed as unconfirmed change until they are leased or spent by
+// another transfer.
+func TestListUnconfirmedChange(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	assetGen := newAssetGenerator(t, 2, 0)
+	assetGen.genAssets(t, assetsStore, []assetDesc{{
+		assetGen:    assetGen.assetGens[0],
+		anchorPoint: assetGen.anchorPoints[0],
+		amt:         10,
+	}, {
+		assetGen:    assetGen.assetGens[1],
+		anchorPoint: assetGen.anchorPoints[1],
+		amt:         20,
+	}})
+
+	allAssets, err := assetsStore.FetchAllAssets(ctx, false, true, nil)
+	require.NoError(t, err)
+	var inputAsset *asset.Asset
+	for _, a := range allAssets {
+		if a.AnchorOutpoint == assetGen.anchorPoints[0] {
+			inputAsset = a.Asset
+		}
+	}
+	require.NotNil(t, inputAsset)
+
+	// The parcel sends the full amount to a new local script key, so its
+	// proof suffix must commit to the asset with that key.
+	parcel, _ := newTestParcel(t, assetsStore, assetGen.anchorPoints[0])
+	out := &parcel.Outputs[0]
+	changeAsset := inputAsset.Copy()
+	changeAsset.ScriptKey = out.ScriptKey
+
+	tapCommitment, err := commitment.FromAssets(changeAsset)
+	require.NoError(t, err)
+	taprootAssetRoot := tapCommitment.TapscriptRoot(nil)
+	out.Anchor.TaprootAssetRoot = taprootAssetRoot[:]
+	out.Anchor.MerkleRoot = taprootAssetRoot[:]
+
+	proofSuffix := proof.Proof{
+		PrevOut:  assetGen.anchorPoints[0],
+		AnchorTx: *parcel.AnchorTx,
+		Asset:    *changeAsset,
+		InclusionProof: proof.TaprootProof{
+			InternalKey: out.Anchor.InternalKey.PubKey,
+		},
+	}
+	var suffixBuf bytes.Buffer
+	require.NoError(t, proofSuffix.Encode(&suffixBuf))
+	out.ProofSuffix = suffixBuf.Bytes()
+
+	require.NoError(t, assetsStore.LogPendingParcel(
+		ctx, parcel, fn.ToArray[[32]byte](test.RandBytes(32)),
+		time.Now().Add(time.Hour),
+	))
+
+	assetID := inputAsset.ID()
+	constraints := tapfreighter.CommitmentConstraints{
+		AssetID: &assetID,
+		MinAmt:  1,
+	}
+	changePoint := out.Anchor.OutPoint
+	requireChange := func(expected bool) {
+		t.Helper()
+
+		change, err := assetsStore.ListUnconfirmedChange(
+			ctx, constraints,
+		)
+		require.NoError(t, err)
+		if !expected {
+			require.Empty(t, change)
+			return
+		}
+
+		require.Len(t, change, 1)
+		require.Equal(t, changePoint, change[0].AnchorPoint)
+		require.Zero(t, change[0].AnchorBlockHeight)
+		require.EqualValues(t, 10, change[0].Asset.Amount)
+		changeKey := change[0].Asset.ScriptKey
+		require.Equal(t, out.ScriptKey.PubKey, changeKey.PubKey)
+		require.NotNil(t, changeKey.TweakedScriptKey)
+		require.Equal(t, out.ProofSuffix, change[0].UnconfirmedProof)
+		require.Equal(
+			t, taprootAssetRoot,
+			change[0].Commitment.TapscriptRoot(nil),
+		)
+	}
+	requireChange(true)
+
+	// Change of another asset or below the minimum amount isn't listed.
+	otherID := assetGen.assetGens[1].ID()
+	constraints.AssetID = &otherID
+	requireChange(false)
+
+	constraints.AssetID = &assetID
+	constraints.MinAmt = 11
+	requireChange(false)
+
+	// Leased change isn't listed until the lease is released.
+	constraints.MinAmt = 1
+	require.NoError(t, assetsStore.LeaseCoins(
+		ctx, fn.ToArray[[32]byte](test.RandBytes(32)),
+		time.Now().Add(time.Hour), changePoint,
+	))
+	requireChange(false)
+
+	require.NoError(t, assetsStore.ReleaseCoins(ctx, changePoint))
+	requireChange(true)
+
+	// Once a transfer spends the change, it isn't listed anymore, even
+	// after the lease of that transfer is released.
+	child, _ := newTestParcel(t, assetsStore, assetGen.anchorPoints[1])
+	child.Inputs = append(child.Inputs, tapfreighter.TransferInput{
+		PrevID: asset.PrevID{
+			OutPoint:  changePoint,
+			ID:        assetID,
+			ScriptKey: asset.ToSerialized(out.ScriptKey.PubKey),
+		},
+		Amount: 10,
+	})
+	child.Outputs[0].ScriptKeyLocal = false
+	child.AnchorTx.AddTxIn(&wire.TxIn{PreviousOutPoint: changePoint})
+	child.Outputs[0].Anchor.OutPoint.Hash = child.AnchorTx.TxHash()
+	require.NoError(t, assetsStore.LogPendingParcel(
+		ctx, child, fn.ToArray[[32]byte](test.RandBytes(32)),
+		time.Now().Add(time.Hour),
+	))
+
+	require.NoError(t, assetsStore.ReleaseCoins(ctx, changePoint))
+	requireChange(false)
+}
@@ -170,20 +170,21 @@ func (b *BaseUniverseTree) RootNode(ctx context.Context) (mssmt.Node, string,
 	), universeRoot.AssetName, nil
 }
 
-// treeStoreWrapperTx is a wrapper around the BaseUniverseStore that allows us
-// to re-use the internal transaction with the transaction SMT store.
+// treeStoreWrapperTx is a wrapper around a TreeStore, such as the
+// BaseUniverseStore, that allows us to re-use the internal transaction with
+// the transaction SMT store.
 type treeStoreWrapperTx struct {
-	universeTx BaseUniverseStore
-	namespace  string
+	dbTx      TreeStore
+	namespace string
 }
 
 // newTreeStoreWrapperTx makes a new wrapper tx.
-func newTreeStoreWrapperTx(universeTx BaseUniverseStore,
+func newTreeStoreWrapperTx(dbTx TreeStore,
 	namespace string) *treeStoreWrapperTx {
 
 	return &treeStoreWrapperTx{
-		universeTx: universeTx,
-		namespace:  namespace,
+		dbTx:      dbTx,
+		namespace: namespace,
 	}
 }
 
@@ -193,7 +194,7 @@ func (t *treeStoreWrapperTx) Update(ctx context.Context,
 
 	updateTx := &taprootAssetTreeStoreTx{
 		ctx:       ctx,
-		dbTx:      t.universeTx,
+		dbTx:      t.dbTx,
 		namespace: t.namespace,
 	}
 
@@ -206,7 +207,7 @@ func (t *treeStoreWrapperTx) View(ctx context.Context,
 
 	viewTx := &taprootAssetTreeStoreTx{
 		ctx:       ctx,
-		dbTx:      t.universeTx,
+		dbTx:      t.dbTx,
 		namespace: t.namespace,
 	}
 
@@ -1,11 +1,18 @@
 package tapdb
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/clock"
@@ -17,6 +24,27 @@ type (
 
 	// DelUniverseServer is used to delete a universe server.
 	DelUniverseServer = sqlc.DeleteUniverseServerParams
+
+	// NewSyncCheckpoint is used to insert or update a sync checkpoint.
+	NewSyncCheckpoint = sqlc.UpsertSyncCheckpointParams
+
+	// SyncCheckpointQuery is used to query sync checkpoints.
+	SyncCheckpointQuery = sqlc.QuerySyncCheckpointsParams
+
+	// SyncCheckpointRow is a sync checkpoint of a single universe.
+	SyncCheckpointRow = sqlc.UniverseSyncCheckpoint
+
+	// NewQuarantinedProof is used to quarantine a proof.
+	NewQuarantinedProof = sqlc.UpsertQuarantinedProofParams
+
+	// QuarantineQuery is used to query quarantined proofs.
+	QuarantineQuery = sqlc.QueryQuarantinedProofsParams
+
+	// QuarantinePurge is used to purge quarantined proofs.
+	QuarantinePurge = sqlc.DeleteQuarantinedProofsParams
+
+	// QuarantinedProofRow is a quarantined proof.
+	QuarantinedProofRow = sqlc.UniverseProofQuarantine
 )
 
 // UniverseServerStore is used to manage the set of Universe servers as part
@@ -33,6 +61,30 @@ type UniverseServerStore interface {
 
 	// ListUniverseServers returns the total set of all universe servers.
 	ListUniverseServers(ctx context.Context) ([]sqlc.UniverseServer, error)
+
+	// UpsertSyncCheckpoint inserts or replaces the checkpoint of the sync
+	// of a universe with a remote server.
+	UpsertSyncCheckpoint(ctx context.Context, arg NewSyncCheckpoint) error
+
+	// QuerySyncCheckpoints returns all sync checkpoints that match the
+	// given query.
+	QuerySyncCheckpoints(ctx context.Context,
+		arg SyncCheckpointQuery) ([]SyncCheckpointRow, error)
+
+	// UpsertQuarantinedProof inserts a quarantined proof or replaces the
+	// earlier entry for the same server and leaf.
+	UpsertQuarantinedProof(ctx context.Context,
+		arg NewQuarantinedProof) error
+
+	// QueryQuarantinedProofs returns all quarantined proofs that match the
+	// given query.
+	QueryQuarantinedProofs(ctx context.Context,
+		arg QuarantineQuery) ([]QuarantinedProofRow, error)
+
+	// DeleteQuarantinedProofs deletes all quarantined proofs that match
+	// the given query and returns the number of deleted proofs.
+	DeleteQuarantinedProofs(ctx context.Context,
+		arg QuarantinePurge) (int64, error)
 }
 
 // UniverseFederationOptions is the database tx object for the universe server store.
@@ -174,4 +226,264 @@ func (u *UniverseFederationDB) LogNewSyncs(ctx context.Context,
 	})
 }
 
+// UpsertSyncCheckpoint inserts or replaces the checkpoint of the sync of a
+// universe with a remote server.
+//
+// NOTE: This is part of the universe.SyncCheckpointStore interface.
+func (u *UniverseFederationDB) UpsertSyncCheckpoint(ctx context.Context,
+	checkpoint *universe.SyncCheckpoint) error {
+
+	uniID, assetID, groupKey := encodeUniverseID(checkpoint.ID)
+	rootHash := checkpoint.Root.NodeHash()
+
+	var writeTx UniverseFederationOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniverseServerStore) error {
+		return db.UpsertSyncCheckpoint(ctx, NewSyncCheckpoint{
+			ServerHost:    checkpoint.ServerHost,
+			UniverseID:    uniID,
+			AssetID:       assetID,
+			GroupKey:      groupKey,
+			RootHash:      rootHash[:],
+			RootSum:       int64(checkpoint.Root.NodeSum()),
+			LeavesFetched: int64(checkpoint.LeavesFetched),
+			LeavesTotal:   int64(checkpoint.LeavesTotal),
+			Complete:      checkpoint.Complete,
+			LastError:     sqlStr(checkpoint.LastError),
+			UpdatedAt:     checkpoint.UpdatedAt.UTC(),
+		})
+	})
+}
+
+// QuerySyncCheckpoints returns all sync checkpoints that match the given
+// query, ordered by server and universe.
+//
+// NOTE: This is part of the universe.SyncCheckpointStore interface.
+func (u *UniverseFederationDB) QuerySyncCheckpoints(ctx context.Context,
+	q universe.SyncCheckpointQuery) ([]*universe.SyncCheckpoint, error) {
+
+	query := SyncCheckpointQuery{
+		ServerHost: sqlStr(q.ServerHost),
+	}
+	if q.ID != nil {
+		uniID := q.ID.Bytes()
+		query.UniverseID = uniID[:]
+	}
+
+	var checkpoints []*universe.SyncCheckpoint
+	readTx := NewUniverseFederationReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(db UniverseServerStore) error {
+		rows, err := db.QuerySyncCheckpoints(ctx, query)
+		if err != nil {
+			return err
+		}
+
+		checkpoints, err = fn.MapErr(rows, parseSyncCheckpoint)
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query sync checkpoints: %w",
+			dbErr)
+	}
+
+	return checkpoints, nil
+}
+
+// parseSyncCheckpoint parses a sync checkpoint from the database.
+func parseSyncCheckpoint(
+	r SyncCheckpointRow) (*universe.SyncCheckpoint, error) {
+
+	id, err := decodeUniverseID(r.AssetID, r.GroupKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sync checkpoint: %w",
+			err)
+	}
+
+	var rootHash mssmt.NodeHash
+	copy(rootHash[:], r.RootHash)
+
+	return &universe.SyncCheckpoint{
+		ServerHost: r.ServerHost,
+		ID:         id,
+		Root: mssmt.NewComputedBranch(
+			rootHash, uint64(r.RootSum),
+		),
+		LeavesFetched: uint64(r.LeavesFetched),
+		LeavesTotal:   uint64(r.LeavesTotal),
+		Complete:      r.Complete,
+		LastError:     r.LastError.String,
+		UpdatedAt:     r.UpdatedAt.UTC(),
+	}, nil
+}
+
+// QuarantineProof adds the given proof to the quarantine. A proof that is
+// quarantined again for the same server and leaf replaces the earlier entry.
+//
+// NOTE: This is part of the universe.ProofQuarantine interface.
+func (u *UniverseFederationDB) QuarantineProof(ctx context.Context,
+	p *universe.QuarantinedProof) error {
+
+	uniID, assetID, groupKey := encodeUniverseID(p.UniverseID)
+	leafKey := p.Key.UniverseKey()
+	scriptKey := p.Key.ScriptKey.PubKey.SerializeCompressed()
+	mintingPoint, err := encodeOutpoint(p.Key.MintingOutpoint)
+	if err != nil {
+		return fmt.Errorf("unable to encode minting point: %w", err)
+	}
+
+	var writeTx UniverseFederationOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniverseServerStore) error {
+		return db.UpsertQuarantinedProof(ctx, NewQuarantinedProof{
+			ServerHost:    p.ServerHost,
+			UniverseID:    uniID,
+			AssetID:       assetID,
+			GroupKey:      groupKey,
+			LeafKey:       leafKey[:],
+			MintingPoint:  mintingPoint,
+			ScriptKey:     scriptKey,
+			Proof:         p.Proof,
+			Reason:        p.Reason,
+			QuarantinedAt: p.QuarantinedAt.UTC(),
+		})
+	})
+}
+
+// QueryQuarantinedProofs returns all quarantined proofs that match the given
+// query, ordered by the time they were quarantined at.
+//
+// NOTE: This is part of the universe.ProofQuarantine interface.
+func (u *UniverseFederationDB) QueryQuarantinedProofs(ctx context.Context,
+	q universe.QuarantineQuery) ([]*universe.QuarantinedProof, error) {
+
+	query := QuarantineQuery{
+		ServerHost: sqlStr(q.ServerHost),
+	}
+	if q.UniverseID != nil {
+		uniID := q.UniverseID.Bytes()
+		query.UniverseID = uniID[:]
+	}
+
+	var proofs []*universe.QuarantinedProof
+	readTx := NewUniverseFederationReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(db UniverseServerStore) error {
+		rows, err := db.QueryQuarantinedProofs(ctx, query)
+		if err != nil {
+			return err
+		}
+
+		proofs, err = fn.MapErr(rows, parseQuarantinedProof)
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query quarantined proofs: "+
+			"%w", dbErr)
+	}
+
+	return proofs, nil
+}
+
+// PurgeQuarantinedProofs removes all quarantined proofs that match the given
+// query and returns the number of removed proofs.
+//
+// NOTE: This is part of the universe.ProofQuarantine interface.
+func (u *UniverseFederationDB) PurgeQuarantinedProofs(ctx context.Context,
+	q universe.QuarantineQuery) (int64, error) {
+
+	purge := QuarantinePurge{
+		ServerHost: sqlStr(q.ServerHost),
+	}
+	if q.UniverseID != nil {
+		uniID := q.UniverseID.Bytes()
+		purge.UniverseID = uniID[:]
+	}
+
+	var (
+		writeTx   UniverseFederationOptions
+		numPurged int64
+	)
+	err := u.db.ExecTx(ctx, &writeTx, func(db UniverseServerStore) error {
+		var err error
+		numPurged, err = db.DeleteQuarantinedProofs(ctx, purge)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("unable to purge quarantined proofs: %w",
+			err)
+	}
+
+	return numPurged, nil
+}
+
+// parseQuarantinedProof parses a quarantined proof from the database.
+func parseQuarantinedProof(
+	r QuarantinedProofRow) (*universe.QuarantinedProof, error) {
+
+	id, err := decodeUniverseID(r.AssetID, r.GroupKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse quarantined proof: %w",
+			err)
+	}
+
+	var mintingPoint wire.OutPoint
+	err = readOutPoint(
+		bytes.NewReader(r.MintingPoint), 0, 0, &mintingPoint,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode minting point: %w",
+			err)
+	}
+
+	scriptPubKey, err := btcec.ParsePubKey(r.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse script key: %w", err)
+	}
+	scriptKey := asset.NewScriptKey(scriptPubKey)
+
+	return &universe.QuarantinedProof{
+		ID:         int64(r.ID),
+		ServerHost: r.ServerHost,
+		UniverseID: id,
+		Key: universe.BaseKey{
+			MintingOutpoint: mintingPoint,
+			ScriptKey:       &scriptKey,
+		},
+		Proof:         r.Proof,
+		Reason:        r.Reason,
+		QuarantinedAt: r.QuarantinedAt.UTC(),
+	}, nil
+}
+
+// encodeUniverseID returns the universe ID, asset ID and x-only group key of
+// the given universe identifier as they are stored in the database.
+func encodeUniverseID(id universe.Identifier) ([]byte, []byte, []byte) {
+	var groupKey []byte
+	if id.GroupKey != nil {
+		groupKey = schnorr.SerializePubKey(id.GroupKey)
+	}
+
+	uniID := id.Bytes()
+	return uniID[:], id.AssetID[:], groupKey
+}
+
+// decodeUniverseID parses a universe identifier from the asset ID and x-only
+// group key stored in the database.
+func decodeUniverseID(assetID, groupKey []byte) (universe.Identifier, error) {
+	var id universe.Identifier
+	copy(id.AssetID[:], assetID)
+
+	if len(groupKey) != 0 {
+		key, err := schnorr.ParsePubKey(groupKey)
+		if err != nil {
+			return id, fmt.Errorf("unable to parse group key: %w",
+				err)
+		}
+		id.GroupKey = key
+	}
+
+	return id, nil
+}
+
 var _ universe.FederationLog = (*UniverseFederationDB)(nil)
+
+var _ universe.SyncCheckpointStore = (*UniverseFederationDB)(nil)
+
+var _ universe.ProofQuarantine = (*UniverseFederationDB)(nil)
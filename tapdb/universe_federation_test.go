@@ -7,6 +7,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/clock"
@@ -95,3 +98,215 @@ func TestUniverseFederationCRUD(t *testing.T) {
 	err = fedDB.LogNewSyncs(ctx, addrToUpdate)
 	require.NoError(t, err)
 }
+
+// TestUniverseSyncCheckpoints tests that the progress of universe syncs can be
+// stored and queried per server and universe.
+func TestUniverseSyncCheckpoints(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	fedDB, _ := newTestFederationDb(t, testClock)
+
+	ctx := context.Background()
+
+	checkpoints, err := fedDB.QuerySyncCheckpoints(
+		ctx, universe.SyncCheckpointQuery{},
+	)
+	require.NoError(t, err)
+	require.Empty(t, checkpoints)
+
+	assetID := universe.Identifier{
+		AssetID: asset.RandID(t),
+	}
+	groupID := universe.Identifier{
+		GroupKey: test.RandPubKey(t),
+	}
+	root := mssmt.NewComputedBranch(mssmt.NodeHash{1, 2, 3}, 100)
+
+	const host1, host2 = "localhost:10001", "localhost:10002"
+	checkpoint := &universe.SyncCheckpoint{
+		ServerHost:    host1,
+		ID:            assetID,
+		Root:          root,
+		LeavesFetched: 50,
+		LeavesTotal:   120,
+		LastError:     "connection reset",
+		UpdatedAt:     testClock.Now(),
+	}
+	require.NoError(t, fedDB.UpsertSyncCheckpoint(ctx, checkpoint))
+	require.NoError(t, fedDB.UpsertSyncCheckpoint(
+		ctx, &universe.SyncCheckpoint{
+			ServerHost: host1,
+			ID:         groupID,
+			Root:       root,
+			Complete:   true,
+			UpdatedAt:  testClock.Now(),
+		},
+	))
+	require.NoError(t, fedDB.UpsertSyncCheckpoint(
+		ctx, &universe.SyncCheckpoint{
+			ServerHost: host2,
+			ID:         assetID,
+			Root:       root,
+			UpdatedAt:  testClock.Now(),
+		},
+	))
+
+	// All checkpoints of a server can be queried.
+	checkpoints, err = fedDB.QuerySyncCheckpoints(
+		ctx, universe.SyncCheckpointQuery{
+			ServerHost: host1,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, checkpoints, 2)
+
+	// As can the checkpoint of a single universe.
+	checkpoints, err = fedDB.QuerySyncCheckpoints(
+		ctx, universe.SyncCheckpointQuery{
+			ServerHost: host1,
+			ID:         &groupID,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, checkpoints, 1)
+	require.True(t, groupID.GroupKey.IsEqual(checkpoints[0].ID.GroupKey))
+	require.True(t, checkpoints[0].Complete)
+	require.True(t, checkpoints[0].MatchesRoot(root))
+
+	// Updating a checkpoint replaces it.
+	checkpoint.LeavesFetched = 120
+	checkpoint.Complete = true
+	checkpoint.LastError = ""
+	require.NoError(t, fedDB.UpsertSyncCheckpoint(ctx, checkpoint))
+
+	checkpoints, err = fedDB.QuerySyncCheckpoints(
+		ctx, universe.SyncCheckpointQuery{
+			ID: &assetID,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, checkpoints, 2)
+	require.Equal(t, host1, checkpoints[0].ServerHost)
+	require.Equal(t, assetID.AssetID, checkpoints[0].ID.AssetID)
+	require.EqualValues(t, 120, checkpoints[0].LeavesFetched)
+	require.EqualValues(t, 120, checkpoints[0].LeavesTotal)
+	require.True(t, checkpoints[0].Complete)
+	require.Empty(t, checkpoints[0].LastError)
+	require.Equal(t, host2, checkpoints[1].ServerHost)
+	require.False(t, checkpoints[1].Complete)
+}
+
+// TestUniverseProofQuarantine tests that proofs that failed validation can be
+// quarantined, inspected and purged.
+func TestUniverseProofQuarantine(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	fedDB, _ := newTestFederationDb(t, testClock)
+
+	ctx := context.Background()
+
+	assetID := universe.Identifier{
+		AssetID: asset.RandID(t),
+	}
+	groupID := universe.Identifier{
+		GroupKey: test.RandPubKey(t),
+	}
+	randKey := func() universe.BaseKey {
+		scriptKey := asset.RandScriptKey(t)
+		return universe.BaseKey{
+			MintingOutpoint: test.RandOp(t),
+			ScriptKey:       &scriptKey,
+		}
+	}
+
+	const host1, host2 = "localhost:10001", "localhost:10002"
+	key := randKey()
+	badProof := &universe.QuarantinedProof{
+		ServerHost:    host1,
+		UniverseID:    assetID,
+		Key:           key,
+		Proof:         test.RandBytes(100),
+		Reason:        "invalid universe proof: outpoint mismatch",
+		QuarantinedAt: testClock.Now(),
+	}
+	require.NoError(t, fedDB.QuarantineProof(ctx, badProof))
+
+	// Quarantining the same leaf again replaces the earlier entry.
+	badProof.Reason = "invalid universe proof: script key mismatch"
+	require.NoError(t, fedDB.QuarantineProof(ctx, badProof))
+
+	require.NoError(t, fedDB.QuarantineProof(
+		ctx, &universe.QuarantinedProof{
+			ServerHost:    host1,
+			UniverseID:    groupID,
+			Key:           randKey(),
+			Reason:        "not part of the remote root",
+			QuarantinedAt: testClock.Now().Add(time.Second),
+		},
+	))
+	require.NoError(t, fedDB.QuarantineProof(
+		ctx, &universe.QuarantinedProof{
+			ServerHost:    host2,
+			UniverseID:    assetID,
+			Key:           randKey(),
+			Reason:        "invalid universe proof",
+			QuarantinedAt: testClock.Now().Add(2 * time.Second),
+		},
+	))
+
+	proofs, err := fedDB.QueryQuarantinedProofs(
+		ctx, universe.QuarantineQuery{},
+	)
+	require.NoError(t, err)
+	require.Len(t, proofs, 3)
+
+	proofs, err = fedDB.QueryQuarantinedProofs(
+		ctx, universe.QuarantineQuery{
+			ServerHost: host1,
+			UniverseID: &assetID,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, proofs, 1)
+	require.Equal(t, assetID.AssetID, proofs[0].UniverseID.AssetID)
+	require.Equal(t, key.MintingOutpoint, proofs[0].Key.MintingOutpoint)
+	require.True(t, key.ScriptKey.PubKey.IsEqual(
+		proofs[0].Key.ScriptKey.PubKey,
+	))
+	require.Equal(t, badProof.Proof, proofs[0].Proof)
+	require.Equal(t, badProof.Reason, proofs[0].Reason)
+
+	proofs, err = fedDB.QueryQuarantinedProofs(
+		ctx, universe.QuarantineQuery{
+			UniverseID: &groupID,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, proofs, 1)
+	require.True(t, groupID.GroupKey.IsEqual(proofs[0].UniverseID.GroupKey))
+	require.Empty(t, proofs[0].Proof)
+
+	// Purging the proofs of a server leaves the others in place.
+	numPurged, err := fedDB.PurgeQuarantinedProofs(
+		ctx, universe.QuarantineQuery{
+			ServerHost: host1,
+		},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, numPurged)
+
+	proofs, err = fedDB.QueryQuarantinedProofs(
+		ctx, universe.QuarantineQuery{},
+	)
+	require.NoError(t, err)
+	require.Len(t, proofs, 1)
+	require.Equal(t, host2, proofs[0].ServerHost)
+
+	numPurged, err = fedDB.PurgeQuarantinedProofs(
+		ctx, universe.QuarantineQuery{},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, numPurged)
+}
@@ -0,0 +1,286 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+)
+
+type (
+	// NewWatchedAsset is used to insert a new or update an existing watched
+	// asset.
+	NewWatchedAsset = sqlc.UpsertWatchedAssetParams
+
+	// WatchedAssetSpend is used to mark the watched assets of an anchor
+	// output as spent.
+	WatchedAssetSpend = sqlc.MarkWatchedAssetsSpentParams
+
+	// WatchedAssetRow is a single watched asset.
+	WatchedAssetRow = sqlc.WatchedAsset
+)
+
+// WatchedAssetStore is the set of queries needed to maintain the watched
+// assets.
+type WatchedAssetStore interface {
+	// FetchAssetByAnchor returns the primary key of the asset of the local
+	// wallet with the given asset ID and script key that is anchored at
+	// the given outpoint.
+	FetchAssetByAnchor(ctx context.Context,
+		arg AssetByAnchorQuery) (int32, error)
+
+	// UpsertWatchedAsset inserts a new or updates an existing watched
+	// asset and returns its primary key.
+	UpsertWatchedAsset(ctx context.Context, arg NewWatchedAsset) (int32,
+		error)
+
+	// QueryWatchedAssets returns the watched assets, ordered by the time
+	// they were first watched.
+	QueryWatchedAssets(ctx context.Context,
+		includeSpent bool) ([]WatchedAssetRow, error)
+
+	// MarkWatchedAssetsSpent marks the unspent watched assets of an anchor
+	// output as spent.
+	MarkWatchedAssetsSpent(ctx context.Context,
+		arg WatchedAssetSpend) error
+
+	// DeleteWatchedAsset deletes the watched asset with the given primary
+	// key and returns the number of deleted assets.
+	DeleteWatchedAsset(ctx context.Context, id int32) (int64, error)
+}
+
+// WatchedAssetTxOptions is the database tx object for the watched asset
+// store.
+type WatchedAssetTxOptions struct {
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (w *WatchedAssetTxOptions) ReadOnly() bool {
+	return w.readOnly
+}
+
+// NewWatchedAssetReadTx returns a new read tx for the watched asset store.
+func NewWatchedAssetReadTx() WatchedAssetTxOptions {
+	return WatchedAssetTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedWatchedAssetStore allows for batched DB transactions for the watched
+// asset store.
+type BatchedWatchedAssetStore interface {
+	WatchedAssetStore
+
+	BatchedTx[WatchedAssetStore]
+}
+
+// WatchedAssets is a database backed implementation of the
+// tapgarden.WatchedAssetStore interface.
+type WatchedAssets struct {
+	db BatchedWatchedAssetStore
+}
+
+// NewWatchedAssets creates a new watched asset store backed by the given
+// database.
+func NewWatchedAssets(db BatchedWatchedAssetStore) *WatchedAssets {
+	return &WatchedAssets{
+		db: db,
+	}
+}
+
+// AddWatchedAsset adds a new watched asset and returns its ID. If the asset is
+// already watched, its label and proof file are updated. If the asset is owned
+// by the local wallet, tapgarden.ErrWatchedAssetOwned is returned.
+//
+// NOTE: This is part of the tapgarden.WatchedAssetStore interface.
+func (w *WatchedAssets) AddWatchedAsset(ctx context.Context,
+	watched *tapgarden.WatchedAsset) (int64, error) {
+
+	anchorPoint, err := encodeOutpoint(watched.AnchorPoint)
+	if err != nil {
+		return 0, fmt.Errorf("unable to encode outpoint: %w", err)
+	}
+
+	var groupKey []byte
+	if watched.GroupKey != nil {
+		groupKey = watched.GroupKey.SerializeCompressed()
+	}
+	scriptKey := watched.ScriptKey.SerializeCompressed()
+
+	var (
+		writeTx WatchedAssetTxOptions
+		id      int32
+	)
+	dbErr := w.db.ExecTx(ctx, &writeTx, func(q WatchedAssetStore) error {
+		// Assets of the local wallet are already tracked with their
+		// full balance, so they can't be watched in addition.
+		_, err := q.FetchAssetByAnchor(ctx, AssetByAnchorQuery{
+			Outpoint:         anchorPoint,
+			AssetID:          watched.AssetID[:],
+			TweakedScriptKey: scriptKey,
+		})
+		switch {
+		case err == nil:
+			return tapgarden.ErrWatchedAssetOwned
+
+		case !errors.Is(err, sql.ErrNoRows):
+			return fmt.Errorf("unable to fetch asset: %w", err)
+		}
+
+		id, err = q.UpsertWatchedAsset(ctx, NewWatchedAsset{
+			Label:          watched.Label,
+			AssetID:        watched.AssetID[:],
+			GroupKey:       groupKey,
+			ScriptKey:      scriptKey,
+			Amount:         int64(watched.Amount),
+			AnchorPoint:    anchorPoint,
+			AnchorPkScript: watched.AnchorPkScript,
+			AnchorHeight:   int32(watched.AnchorHeight),
+			ProofFile:      watched.ProofFile,
+			CreatedAt:      watched.CreatedAt.UTC(),
+		})
+		return err
+	})
+	if dbErr != nil {
+		return 0, fmt.Errorf("unable to add watched asset: %w", dbErr)
+	}
+
+	return int64(id), nil
+}
+
+// ListWatchedAssets returns all watched assets, ordered by the time they were
+// first watched. Assets with a spent anchor output are only included if
+// includeSpent is true.
+//
+// NOTE: This is part of the tapgarden.WatchedAssetStore interface.
+func (w *WatchedAssets) ListWatchedAssets(ctx context.Context,
+	includeSpent bool) ([]*tapgarden.WatchedAsset, error) {
+
+	var (
+		readTx  = NewWatchedAssetReadTx()
+		watched []*tapgarden.WatchedAsset
+	)
+	dbErr := w.db.ExecTx(ctx, &readTx, func(q WatchedAssetStore) error {
+		rows, err := q.QueryWatchedAssets(ctx, includeSpent)
+		if err != nil {
+			return err
+		}
+
+		watched, err = fn.MapErr(rows, parseWatchedAsset)
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to list watched assets: %w",
+			dbErr)
+	}
+
+	return watched, nil
+}
+
+// MarkWatchedAssetsSpent marks all watched assets anchored at the given
+// outpoint as spent by the given transaction.
+//
+// NOTE: This is part of the tapgarden.WatchedAssetStore interface.
+func (w *WatchedAssets) MarkWatchedAssetsSpent(ctx context.Context,
+	anchorPoint wire.OutPoint, spendingTxid chainhash.Hash,
+	spendHeight uint32) error {
+
+	anchorPointBytes, err := encodeOutpoint(anchorPoint)
+	if err != nil {
+		return fmt.Errorf("unable to encode outpoint: %w", err)
+	}
+
+	var writeTx WatchedAssetTxOptions
+	return w.db.ExecTx(ctx, &writeTx, func(q WatchedAssetStore) error {
+		return q.MarkWatchedAssetsSpent(ctx, WatchedAssetSpend{
+			AnchorPoint:  anchorPointBytes,
+			SpendingTxid: spendingTxid[:],
+			SpendHeight:  sqlInt32(spendHeight),
+		})
+	})
+}
+
+// RemoveWatchedAsset stops watching the asset with the given ID. If no such
+// asset exists, tapgarden.ErrWatchedAssetNotFound is returned.
+//
+// NOTE: This is part of the tapgarden.WatchedAssetStore interface.
+func (w *WatchedAssets) RemoveWatchedAsset(ctx context.Context,
+	id int64) error {
+
+	var writeTx WatchedAssetTxOptions
+	return w.db.ExecTx(ctx, &writeTx, func(q WatchedAssetStore) error {
+		numDeleted, err := q.DeleteWatchedAsset(ctx, int32(id))
+		if err != nil {
+			return err
+		}
+
+		if numDeleted == 0 {
+			return tapgarden.ErrWatchedAssetNotFound
+		}
+
+		return nil
+	})
+}
+
+// parseWatchedAsset parses a watched asset from its database row.
+func parseWatchedAsset(r WatchedAssetRow) (*tapgarden.WatchedAsset, error) {
+	var anchorPoint wire.OutPoint
+	err := readOutPoint(bytes.NewReader(r.AnchorPoint), 0, 0, &anchorPoint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode outpoint: %w", err)
+	}
+
+	scriptKey, err := btcec.ParsePubKey(r.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse script key: %w", err)
+	}
+
+	watched := &tapgarden.WatchedAsset{
+		ID:             int64(r.ID),
+		Label:          r.Label,
+		ScriptKey:      scriptKey,
+		Amount:         uint64(r.Amount),
+		AnchorPoint:    anchorPoint,
+		AnchorPkScript: r.AnchorPkScript,
+		AnchorHeight:   uint32(r.AnchorHeight),
+		ProofFile:      r.ProofFile,
+		CreatedAt:      r.CreatedAt.UTC(),
+	}
+	copy(watched.AssetID[:], r.AssetID)
+
+	if len(r.GroupKey) != 0 {
+		watched.GroupKey, err = btcec.ParsePubKey(r.GroupKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse group key: %w",
+				err)
+		}
+	}
+
+	if len(r.SpendingTxid) != 0 {
+		spendingTxid, err := chainhash.NewHash(r.SpendingTxid)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse spending "+
+				"txid: %w", err)
+		}
+
+		watched.SpendingTxid = spendingTxid
+		watched.SpendHeight = uint32(r.SpendHeight.Int32)
+	}
+
+	return watched, nil
+}
+
+// A compile-time assertion to ensure that WatchedAssets meets the
+// tapgarden.WatchedAssetStore interface.
+var _ tapgarden.WatchedAssetStore = (*WatchedAssets)(nil)
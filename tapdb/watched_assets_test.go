@@ -0,0 +1,111 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWatchedAssets tests that watched assets can be added, marked as spent
+// and removed.
+func TestWatchedAssets(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+	watchedDB := NewTransactionExecutor(
+		db, func(tx *sql.Tx) WatchedAssetStore {
+			return db.WithTx(tx)
+		},
+	)
+	store := NewWatchedAssets(watchedDB)
+	ctx := context.Background()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	newWatchedAsset := func(label string,
+		idx uint32) *tapgarden.WatchedAsset {
+
+		createdAt := now.Add(time.Duration(idx) * time.Second)
+		return &tapgarden.WatchedAsset{
+			Label:     label,
+			AssetID:   asset.ID(test.RandHash()),
+			GroupKey:  test.RandPubKey(t),
+			ScriptKey: test.RandPubKey(t),
+			Amount:    uint64(idx) * 1000,
+			AnchorPoint: wire.OutPoint{
+				Hash:  test.RandHash(),
+				Index: idx,
+			},
+			AnchorPkScript: test.RandBytes(34),
+			AnchorHeight:   100 + idx,
+			ProofFile:      test.RandBytes(100),
+			CreatedAt:      createdAt,
+		}
+	}
+
+	collateral := newWatchedAsset("collateral", 1)
+	collateral.GroupKey = nil
+	reserve := newWatchedAsset("reserve", 2)
+
+	for _, watched := range []*tapgarden.WatchedAsset{
+		collateral, reserve,
+	} {
+		id, err := store.AddWatchedAsset(ctx, watched)
+		require.NoError(t, err)
+		watched.ID = id
+	}
+
+	watched, err := store.ListWatchedAssets(ctx, false)
+	require.NoError(t, err)
+	require.Equal(
+		t, []*tapgarden.WatchedAsset{collateral, reserve}, watched,
+	)
+
+	// Watching the same asset again only updates its label and proof.
+	updated := *collateral
+	updated.Label = "counterparty collateral"
+	updated.ProofFile = test.RandBytes(100)
+	id, err := store.AddWatchedAsset(ctx, &updated)
+	require.NoError(t, err)
+	require.Equal(t, collateral.ID, id)
+
+	watched, err = store.ListWatchedAssets(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, watched, 2)
+	require.Equal(t, &updated, watched[0])
+
+	// Once the anchor output of the reserve is spent, it's only listed if
+	// spent assets are included.
+	spendingTxid := test.RandHash()
+	err = store.MarkWatchedAssetsSpent(
+		ctx, reserve.AnchorPoint, spendingTxid, 200,
+	)
+	require.NoError(t, err)
+
+	watched, err = store.ListWatchedAssets(ctx, false)
+	require.NoError(t, err)
+	require.Equal(t, []*tapgarden.WatchedAsset{&updated}, watched)
+
+	watched, err = store.ListWatchedAssets(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, watched, 2)
+	require.True(t, watched[1].Spent())
+	require.Equal(t, spendingTxid, *watched[1].SpendingTxid)
+	require.EqualValues(t, 200, watched[1].SpendHeight)
+
+	// A removed asset is no longer watched, and can't be removed twice.
+	require.NoError(t, store.RemoveWatchedAsset(ctx, collateral.ID))
+	err = store.RemoveWatchedAsset(ctx, collateral.ID)
+	require.ErrorIs(t, err, tapgarden.ErrWatchedAssetNotFound)
+
+	watched, err = store.ListWatchedAssets(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, watched, 1)
+	require.Equal(t, reserve.ID, watched[0].ID)
+}
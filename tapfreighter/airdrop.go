@@ -0,0 +1,633 @@
+package tapfreighter
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+)
+
+const (
+	// DefaultAirdropMaxRecipientsPerTx is the default maximum number of
+	// recipients that are paid by a single anchor transaction. As the proof
+	// of every recipient contains an exclusion proof for every other
+	// anchor output of the transaction, the total proof size grows
+	// quadratically with the number of recipients per transaction.
+	DefaultAirdropMaxRecipientsPerTx = 25
+
+	// maxManifestErrors is the maximum number of invalid manifest entries
+	// that are reported in a single validation error.
+	maxManifestErrors = 10
+)
+
+var (
+	// ErrAirdropNotFound is returned when an airdrop doesn't exist.
+	ErrAirdropNotFound = errors.New("airdrop not found")
+
+	// ErrAirdropRunning is returned when an airdrop is resumed while its
+	// sends are still in progress.
+	ErrAirdropRunning = errors.New("airdrop is already running")
+)
+
+// AirdropRecipientStatus is the status of the send to a single recipient of
+// an airdrop.
+type AirdropRecipientStatus uint8
+
+const (
+	// AirdropRecipientPending means the recipient wasn't paid yet.
+	AirdropRecipientPending AirdropRecipientStatus = 0
+
+	// AirdropRecipientInFlight means the transfer to the recipient was
+	// handed to the porter, but it didn't report the outcome yet. If the
+	// daemon is restarted in this state, the transfer may still be
+	// completed by the porter, so the recipient is never retried
+	// automatically.
+	AirdropRecipientInFlight AirdropRecipientStatus = 1
+
+	// AirdropRecipientSent means the anchor transaction that pays the
+	// recipient was broadcast.
+	AirdropRecipientSent AirdropRecipientStatus = 2
+
+	// AirdropRecipientFailed means the transfer to the recipient failed and
+	// can be retried by resuming the airdrop.
+	AirdropRecipientFailed AirdropRecipientStatus = 3
+)
+
+// String returns a human-readable version of the recipient status.
+func (s AirdropRecipientStatus) String() string {
+	switch s {
+	case AirdropRecipientPending:
+		return "pending"
+
+	case AirdropRecipientInFlight:
+		return "in_flight"
+
+	case AirdropRecipientSent:
+		return "sent"
+
+	case AirdropRecipientFailed:
+		return "failed"
+
+	default:
+		return fmt.Sprintf("<unknown_status(%d)>", s)
+	}
+}
+
+// AirdropManifestEntry is a single entry of an airdrop manifest.
+type AirdropManifestEntry struct {
+	// Line is the line of the entry in the manifest, used to report
+	// invalid entries.
+	Line int
+
+	// Addr is the encoded Taproot Asset address of the recipient.
+	Addr string
+
+	// Amount is the amount the recipient should receive. It must match
+	// the amount of the address.
+	Amount uint64
+}
+
+// ParseAirdropManifest parses an airdrop manifest in CSV format with one
+// address,amount pair per line. An optional header line starting with
+// "address" and lines starting with '#' are skipped.
+func ParseAirdropManifest(r io.Reader) ([]AirdropManifestEntry, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	var entries []AirdropManifestEntry
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid manifest: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		addr := strings.TrimSpace(record[0])
+		amountStr := strings.TrimSpace(record[1])
+
+		if len(entries) == 0 && strings.EqualFold(addr, "address") {
+			continue
+		}
+
+		amount, err := strconv.ParseUint(amountStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount %q on line "+
+				"%d: %w", amountStr, line, err)
+		}
+
+		entries = append(entries, AirdropManifestEntry{
+			Line:   line,
+			Addr:   addr,
+			Amount: amount,
+		})
+	}
+
+	return entries, nil
+}
+
+// AirdropRecipient is a single recipient of an airdrop.
+type AirdropRecipient struct {
+	// Index is the position of the recipient in the manifest.
+	Index uint32
+
+	// Addr is the encoded Taproot Asset address of the recipient.
+	Addr string
+
+	// AssetID is the ID of the asset the recipient receives.
+	AssetID asset.ID
+
+	// Amount is the amount the recipient receives.
+	Amount uint64
+
+	// Status is the status of the send to the recipient.
+	Status AirdropRecipientStatus
+
+	// AnchorTxid is the ID of the anchor transaction that paid the
+	// recipient, or nil if it wasn't paid yet.
+	AnchorTxid *chainhash.Hash
+
+	// Err is the error the last send attempt failed with.
+	Err string
+
+	// UpdatedAt is the time the status was last updated.
+	UpdatedAt time.Time
+}
+
+// Airdrop is a send of one or more assets to many recipients, which is split
+// into multiple batched transfers.
+type Airdrop struct {
+	// ID is the database ID of the airdrop.
+	ID int64
+
+	// Label is a user defined description of the airdrop.
+	Label string
+
+	// MaxRecipientsPerTx is the maximum number of recipients that are paid
+	// by a single anchor transaction.
+	MaxRecipientsPerTx uint32
+
+	// Recipients are the recipients of the airdrop, in manifest order.
+	Recipients []*AirdropRecipient
+
+	// CreatedAt is the time the airdrop was created.
+	CreatedAt time.Time
+}
+
+// AirdropBatch is a set of recipients of the same asset that are paid by a
+// single anchor transaction.
+type AirdropBatch struct {
+	// AssetID is the ID of the asset sent in the batch.
+	AssetID asset.ID
+
+	// Recipients are the recipients paid by the batch.
+	Recipients []*AirdropRecipient
+}
+
+// PlanAirdropBatches splits the given recipients into batches, each of which
+// is sent with a single anchor transaction. A transfer can only send a single
+// asset ID, so the recipients are first grouped by asset ID. Each group is
+// then split into the least number of batches with at most maxPerTx
+// recipients, with the recipients spread evenly across them.
+func PlanAirdropBatches(recipients []*AirdropRecipient,
+	maxPerTx uint32) []*AirdropBatch {
+
+	if maxPerTx == 0 {
+		maxPerTx = DefaultAirdropMaxRecipientsPerTx
+	}
+
+	var (
+		assetIDs []asset.ID
+		groups   = make(map[asset.ID][]*AirdropRecipient)
+	)
+	for _, recipient := range recipients {
+		if _, ok := groups[recipient.AssetID]; !ok {
+			assetIDs = append(assetIDs, recipient.AssetID)
+		}
+		groups[recipient.AssetID] = append(
+			groups[recipient.AssetID], recipient,
+		)
+	}
+
+	var batches []*AirdropBatch
+	for _, assetID := range assetIDs {
+		group := groups[assetID]
+
+		numBatches := (len(group) + int(maxPerTx) - 1) / int(maxPerTx)
+		for i := 0; i < numBatches; i++ {
+			start := i * len(group) / numBatches
+			end := (i + 1) * len(group) / numBatches
+
+			batches = append(batches, &AirdropBatch{
+				AssetID:    assetID,
+				Recipients: group[start:end],
+			})
+		}
+	}
+
+	return batches
+}
+
+// AirdropStore is the persistent store of airdrops and the status of their
+// recipients.
+type AirdropStore interface {
+	// CreateAirdrop stores a new airdrop with all its recipients and
+	// returns its ID.
+	CreateAirdrop(ctx context.Context, airdrop *Airdrop) (int64, error)
+
+	// FetchAirdrop returns the airdrop with the given ID. If no such
+	// airdrop exists, ErrAirdropNotFound is returned.
+	FetchAirdrop(ctx context.Context, id int64) (*Airdrop, error)
+
+	// ListAirdrops returns all airdrops, ordered by their ID.
+	ListAirdrops(ctx context.Context) ([]*Airdrop, error)
+
+	// UpdateAirdropRecipients sets the status, anchor transaction and
+	// error of the recipients of the given airdrop.
+	UpdateAirdropRecipients(ctx context.Context, airdropID int64,
+		recipients []*AirdropRecipient) error
+}
+
+// AirdropperConfig is the configuration of the airdropper.
+type AirdropperConfig struct {
+	// Store is the persistent store of airdrops.
+	Store AirdropStore
+
+	// Porter is used to send the batches of an airdrop.
+	Porter Porter
+
+	// ChainParams are the chain parameters the recipient addresses must
+	// be encoded for.
+	ChainParams *address.ChainParams
+}
+
+// Airdropper sends assets to a manifest of many recipients. The manifest is
+// validated up front, split into batched transfers and the status of every
+// recipient is persisted, so an airdrop that partially failed can be resumed
+// without paying any recipient twice.
+type Airdropper struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *AirdropperConfig
+
+	// running is the set of airdrops whose sends are in progress.
+	running map[int64]struct{}
+
+	// runningMtx guards the running set.
+	runningMtx sync.Mutex
+
+	*fn.ContextGuard
+}
+
+// NewAirdropper creates a new airdropper from the given config.
+func NewAirdropper(cfg *AirdropperConfig) *Airdropper {
+	return &Airdropper{
+		cfg:     cfg,
+		running: make(map[int64]struct{}),
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: tapgarden.DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts the airdropper. Interrupted airdrops aren't resumed
+// automatically, as their in-flight recipients first need to be reconciled
+// with the transfers of the porter.
+func (a *Airdropper) Start() error {
+	a.startOnce.Do(func() {
+		log.Infof("Starting airdropper")
+	})
+
+	return nil
+}
+
+// Stop stops the airdropper and waits for the running batches to return.
+func (a *Airdropper) Stop() error {
+	a.stopOnce.Do(func() {
+		log.Infof("Stopping airdropper")
+
+		close(a.Quit)
+		a.Wg.Wait()
+	})
+
+	return nil
+}
+
+// ValidateManifest decodes and validates all entries of the given manifest and
+// returns the resulting recipients. All invalid entries are reported at once,
+// so the manifest can be fixed before any asset is sent.
+func (a *Airdropper) ValidateManifest(
+	entries []AirdropManifestEntry) ([]*AirdropRecipient, error) {
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("manifest has no recipients")
+	}
+
+	var (
+		recipients = make([]*AirdropRecipient, 0, len(entries))
+		seen       = make(map[string]int, len(entries))
+		invalid    []string
+	)
+	for idx, entry := range entries {
+		reason := ""
+		tapAddr, err := address.DecodeAddress(
+			entry.Addr, a.cfg.ChainParams,
+		)
+		switch {
+		case err != nil:
+			reason = fmt.Sprintf("invalid address: %v", err)
+
+		case tapAddr.Amount != entry.Amount:
+			reason = fmt.Sprintf("amount %d doesn't match address "+
+				"amount %d", entry.Amount, tapAddr.Amount)
+		}
+
+		var encoded string
+		if reason == "" {
+			encoded, err = tapAddr.EncodeAddress()
+			if err != nil {
+				reason = fmt.Sprintf("unable to encode "+
+					"address: %v", err)
+			}
+		}
+		if prevLine, ok := seen[encoded]; reason == "" && ok {
+			reason = fmt.Sprintf("duplicate of line %d", prevLine)
+		}
+
+		if reason != "" {
+			invalid = append(invalid, fmt.Sprintf("line %d: %s",
+				entry.Line, reason))
+			continue
+		}
+
+		seen[encoded] = entry.Line
+		recipients = append(recipients, &AirdropRecipient{
+			Index:   uint32(idx),
+			Addr:    encoded,
+			AssetID: tapAddr.AssetID,
+			Amount:  tapAddr.Amount,
+			Status:  AirdropRecipientPending,
+		})
+	}
+
+	if len(invalid) > 0 {
+		numInvalid := len(invalid)
+		if numInvalid > maxManifestErrors {
+			invalid = append(
+				invalid[:maxManifestErrors],
+				fmt.Sprintf("and %d more", numInvalid-
+					maxManifestErrors),
+			)
+		}
+
+		return nil, fmt.Errorf("%d invalid manifest entries: %s",
+			numInvalid, strings.Join(invalid, "; "))
+	}
+
+	return recipients, nil
+}
+
+// StartAirdrop validates the given manifest, stores the airdrop and starts
+// sending its batches in the background. The stored airdrop and the batches
+// it is sent in are returned.
+func (a *Airdropper) StartAirdrop(ctx context.Context, label string,
+	entries []AirdropManifestEntry, maxPerTx uint32) (*Airdrop,
+	[]*AirdropBatch, error) {
+
+	recipients, err := a.ValidateManifest(entries)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if maxPerTx == 0 {
+		maxPerTx = DefaultAirdropMaxRecipientsPerTx
+	}
+
+	airdrop := &Airdrop{
+		Label:              label,
+		MaxRecipientsPerTx: maxPerTx,
+		Recipients:         recipients,
+		CreatedAt:          time.Now(),
+	}
+	airdrop.ID, err = a.cfg.Store.CreateAirdrop(ctx, airdrop)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to store airdrop: %w", err)
+	}
+
+	log.Infof("Starting airdrop %d to %d recipients", airdrop.ID,
+		len(recipients))
+
+	batches, err := a.launch(airdrop)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return airdrop, batches, nil
+}
+
+// ResumeAirdrop retries the pending and failed recipients of the airdrop with
+// the given ID. Recipients that are in flight aren't retried, as their
+// transfer may still be completed by the porter. The airdrop as it was before
+// it was resumed and the batches the recipients are sent in are returned.
+func (a *Airdropper) ResumeAirdrop(ctx context.Context,
+	id int64) (*Airdrop, []*AirdropBatch, error) {
+
+	airdrop, err := a.cfg.Store.FetchAirdrop(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.Infof("Resuming airdrop %d", airdrop.ID)
+
+	batches, err := a.launch(airdrop)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return airdrop, batches, nil
+}
+
+// FetchAirdrop returns the airdrop with the given ID.
+func (a *Airdropper) FetchAirdrop(ctx context.Context,
+	id int64) (*Airdrop, error) {
+
+	return a.cfg.Store.FetchAirdrop(ctx, id)
+}
+
+// ListAirdrops returns all airdrops.
+func (a *Airdropper) ListAirdrops(ctx context.Context) ([]*Airdrop, error) {
+	return a.cfg.Store.ListAirdrops(ctx)
+}
+
+// launch starts sending the pending and failed recipients of the given airdrop
+// in the background and returns the batches they are sent in.
+func (a *Airdropper) launch(airdrop *Airdrop) ([]*AirdropBatch, error) {
+	a.runningMtx.Lock()
+	defer a.runningMtx.Unlock()
+
+	if _, ok := a.running[airdrop.ID]; ok {
+		return nil, fmt.Errorf("%w: airdrop %d", ErrAirdropRunning,
+			airdrop.ID)
+	}
+
+	// The batches are sent with copies of the recipients, so the status
+	// updates don't race with the caller reading the airdrop.
+	var retry []*AirdropRecipient
+	for _, recipient := range airdrop.Recipients {
+		if recipient.Status != AirdropRecipientPending &&
+			recipient.Status != AirdropRecipientFailed {
+
+			continue
+		}
+
+		recipientCopy := *recipient
+		retry = append(retry, &recipientCopy)
+	}
+	if len(retry) == 0 {
+		return nil, nil
+	}
+
+	batches := PlanAirdropBatches(retry, airdrop.MaxRecipientsPerTx)
+	a.running[airdrop.ID] = struct{}{}
+
+	a.Wg.Add(1)
+	go func() {
+		defer a.Wg.Done()
+		defer func() {
+			a.runningMtx.Lock()
+			delete(a.running, airdrop.ID)
+			a.runningMtx.Unlock()
+		}()
+
+		for idx, batch := range batches {
+			select {
+			case <-a.Quit:
+				return
+			default:
+			}
+
+			log.Infof("Sending batch %d/%d of airdrop %d to %d "+
+				"recipients", idx+1, len(batches), airdrop.ID,
+				len(batch.Recipients))
+
+			a.sendBatch(airdrop.ID, batch)
+		}
+
+		log.Infof("Finished sending airdrop %d", airdrop.ID)
+	}()
+
+	return batches, nil
+}
+
+// sendBatch sends a single batch of an airdrop and persists the outcome for
+// each of its recipients. A failed batch doesn't stop the airdrop, its
+// recipients can be retried by resuming the airdrop.
+func (a *Airdropper) sendBatch(airdropID int64, batch *AirdropBatch) {
+	ctx, cancel := a.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	setStatus := func(status AirdropRecipientStatus,
+		txid *chainhash.Hash, sendErr error) error {
+
+		now := time.Now()
+		for _, recipient := range batch.Recipients {
+			recipient.Status = status
+			recipient.AnchorTxid = txid
+			recipient.Err = ""
+			if sendErr != nil {
+				recipient.Err = sendErr.Error()
+			}
+			recipient.UpdatedAt = now
+		}
+
+		return a.cfg.Store.UpdateAirdropRecipients(
+			ctx, airdropID, batch.Recipients,
+		)
+	}
+
+	// The recipients are marked as in flight before the parcel is handed
+	// to the porter, so they're never sent twice if we're interrupted.
+	err := setStatus(AirdropRecipientInFlight, nil, nil)
+	if err != nil {
+		log.Errorf("Unable to update airdrop %d: %v", airdropID, err)
+		return
+	}
+
+	tapAddrs := make([]*address.Tap, len(batch.Recipients))
+	for idx, recipient := range batch.Recipients {
+		tapAddrs[idx], err = address.DecodeAddress(
+			recipient.Addr, a.cfg.ChainParams,
+		)
+		if err != nil {
+			break
+		}
+	}
+
+	if err != nil {
+		log.Errorf("Unable to decode airdrop %d address: %v",
+			airdropID, err)
+
+		err = setStatus(AirdropRecipientFailed, nil, err)
+		if err != nil {
+			log.Errorf("Unable to update airdrop %d: %v",
+				airdropID, err)
+		}
+		return
+	}
+
+	// The porter only returns once the anchor transaction is broadcast,
+	// which may take a while, so we don't block our shutdown on it. If
+	// we're shutting down, the outcome of the parcel is unknown, so the
+	// recipients are left in flight.
+	type shipmentResult struct {
+		outbound *OutboundParcel
+		err      error
+	}
+	resultChan := make(chan shipmentResult, 1)
+	go func() {
+		outbound, err := a.cfg.Porter.RequestShipment(
+			NewAddressParcel(tapAddrs...),
+		)
+		resultChan <- shipmentResult{
+			outbound: outbound,
+			err:      err,
+		}
+	}()
+
+	var result shipmentResult
+	select {
+	case result = <-resultChan:
+	case <-a.Quit:
+		return
+	}
+
+	if result.err != nil {
+		log.Errorf("Unable to send batch of airdrop %d: %v",
+			airdropID, result.err)
+
+		err = setStatus(AirdropRecipientFailed, nil, result.err)
+	} else {
+		txid := result.outbound.AnchorTx.TxHash()
+		err = setStatus(AirdropRecipientSent, &txid, nil)
+	}
+	if err != nil {
+		log.Errorf("Unable to update airdrop %d: %v", airdropID, err)
+	}
+}
@@ -0,0 +1,89 @@
+package tapfreighter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseAirdropManifest tests that airdrop manifests are parsed correctly
+// and that invalid entries are rejected.
+func TestParseAirdropManifest(t *testing.T) {
+	t.Parallel()
+
+	manifest := `address,amount
+# The first recipient.
+taptb1aaa, 100
+taptb1bbb,200
+`
+	entries, err := ParseAirdropManifest(strings.NewReader(manifest))
+	require.NoError(t, err)
+	require.Equal(t, []AirdropManifestEntry{{
+		Line:   3,
+		Addr:   "taptb1aaa",
+		Amount: 100,
+	}, {
+		Line:   4,
+		Addr:   "taptb1bbb",
+		Amount: 200,
+	}}, entries)
+
+	_, err = ParseAirdropManifest(strings.NewReader("taptb1aaa,-1\n"))
+	require.ErrorContains(t, err, "invalid amount")
+
+	_, err = ParseAirdropManifest(strings.NewReader("taptb1aaa\n"))
+	require.ErrorContains(t, err, "invalid manifest")
+}
+
+// TestPlanAirdropBatches tests that the recipients of an airdrop are grouped
+// by asset ID and spread evenly across the least number of batches.
+func TestPlanAirdropBatches(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id1 = asset.ID{1}
+		id2 = asset.ID{2}
+
+		recipients []*AirdropRecipient
+	)
+	for i := 0; i < 12; i++ {
+		assetID := id1
+		if i%4 == 3 {
+			assetID = id2
+		}
+
+		recipients = append(recipients, &AirdropRecipient{
+			Index:   uint32(i),
+			AssetID: assetID,
+		})
+	}
+
+	// Nine recipients of the first asset are split into two batches of
+	// four and five, the three recipients of the second asset fit into a
+	// single batch.
+	batches := PlanAirdropBatches(recipients, 5)
+	require.Len(t, batches, 3)
+
+	require.Equal(t, id1, batches[0].AssetID)
+	require.Len(t, batches[0].Recipients, 4)
+	require.Equal(t, id1, batches[1].AssetID)
+	require.Len(t, batches[1].Recipients, 5)
+	require.Equal(t, id2, batches[2].AssetID)
+	require.Len(t, batches[2].Recipients, 3)
+
+	// The recipients keep their manifest order within each asset.
+	var indexes []uint32
+	for _, batch := range batches[:2] {
+		for _, recipient := range batch.Recipients {
+			indexes = append(indexes, recipient.Index)
+		}
+	}
+	require.Equal(t, []uint32{0, 1, 2, 4, 5, 6, 8, 9, 10}, indexes)
+
+	// Without a limit, the default is used.
+	batches = PlanAirdropBatches(recipients, 0)
+	require.Len(t, batches, 2)
+	require.Empty(t, PlanAirdropBatches(nil, 5))
+}
@@ -0,0 +1,161 @@
+package tapfreighter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+const (
+	// DefaultCoSignTimeout is the default time an external co-signer has to
+	// submit the co-signed anchor transaction.
+	DefaultCoSignTimeout = 10 * time.Minute
+)
+
+// AnchorCoSigner produces the BTC level witnesses of anchor inputs that are
+// co-owned with external parties, for example assets held in a channel funding
+// output or a pool account. The wallet can't sign for such inputs alone, so
+// they are expected to not carry any BIP-0032 derivation information of a
+// local key. The asset level witnesses of the inputs are still produced
+// locally.
+type AnchorCoSigner interface {
+	// CoSignAnchorPsbt receives the anchor transaction after the wallet
+	// signed all inputs it can sign for, and returns it with the witnesses
+	// of the remaining inputs added. The unsigned transaction must not be
+	// changed, since the asset commitments in its outputs are already
+	// final.
+	CoSignAnchorPsbt(ctx context.Context,
+		pkt *psbt.Packet) (*psbt.Packet, error)
+}
+
+// coSignRequest is an anchor transaction that waits for its co-signature.
+type coSignRequest struct {
+	// pkt is the anchor transaction signed by the wallet.
+	pkt *psbt.Packet
+
+	// signedPkt receives the co-signed anchor transaction.
+	signedPkt chan *psbt.Packet
+}
+
+// ExternalCoSigner is an AnchorCoSigner that performs a PSBT round-trip with an
+// external party. Anchor transactions that need a co-signature are held until
+// the co-signed PSBT is submitted through SubmitCoSignedPsbt, or the timeout
+// expires.
+type ExternalCoSigner struct {
+	mtx sync.Mutex
+
+	// timeout is the time the external party has to submit the co-signed
+	// PSBT.
+	timeout time.Duration
+
+	// pending are the anchor transactions that wait for their
+	// co-signature, keyed by their unsigned transaction ID.
+	pending map[chainhash.Hash]*coSignRequest
+}
+
+// NewExternalCoSigner creates a new external co-signer that waits for up to
+// the given timeout for each co-signature.
+func NewExternalCoSigner(timeout time.Duration) *ExternalCoSigner {
+	return &ExternalCoSigner{
+		timeout: timeout,
+		pending: make(map[chainhash.Hash]*coSignRequest),
+	}
+}
+
+// CoSignAnchorPsbt holds the anchor transaction until its co-signed version is
+// submitted.
+//
+// NOTE: This is part of the AnchorCoSigner interface.
+func (e *ExternalCoSigner) CoSignAnchorPsbt(ctx context.Context,
+	pkt *psbt.Packet) (*psbt.Packet, error) {
+
+	txid := pkt.UnsignedTx.TxHash()
+	req := &coSignRequest{
+		pkt:       pkt,
+		signedPkt: make(chan *psbt.Packet, 1),
+	}
+
+	e.mtx.Lock()
+	if _, ok := e.pending[txid]; ok {
+		e.mtx.Unlock()
+		return nil, fmt.Errorf("anchor tx %v already waits for "+
+			"co-signature", txid)
+	}
+	e.pending[txid] = req
+	e.mtx.Unlock()
+
+	defer func() {
+		e.mtx.Lock()
+		delete(e.pending, txid)
+		e.mtx.Unlock()
+	}()
+
+	log.Infof("Waiting for co-signature of anchor tx %v", txid)
+
+	select {
+	case signedPkt := <-req.signedPkt:
+		return signedPkt, nil
+
+	case <-time.After(e.timeout):
+		return nil, fmt.Errorf("timed out waiting for co-signature "+
+			"of anchor tx %v", txid)
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// PendingPsbts returns copies of all anchor transactions that wait for their
+// co-signature.
+func (e *ExternalCoSigner) PendingPsbts() ([]*psbt.Packet, error) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	pkts := make([]*psbt.Packet, 0, len(e.pending))
+	for _, req := range e.pending {
+		pkt, err := copyPsbt(req.pkt)
+		if err != nil {
+			return nil, err
+		}
+		pkts = append(pkts, pkt)
+	}
+
+	return pkts, nil
+}
+
+// SubmitCoSignedPsbt hands the co-signed version of a pending anchor
+// transaction back to the transfer that waits for it.
+func (e *ExternalCoSigner) SubmitCoSignedPsbt(signedPkt *psbt.Packet) error {
+	txid := signedPkt.UnsignedTx.TxHash()
+
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	req, ok := e.pending[txid]
+	if !ok {
+		return fmt.Errorf("no anchor tx %v waits for co-signature",
+			txid)
+	}
+
+	// Because the unsigned transaction ID matches, the co-signer can only
+	// have added witness data, but the PSBT must still describe all
+	// inputs.
+	if len(signedPkt.Inputs) != len(req.pkt.Inputs) {
+		return fmt.Errorf("co-signed anchor tx %v has %d PSBT inputs, "+
+			"expected %d", txid, len(signedPkt.Inputs),
+			len(req.pkt.Inputs))
+	}
+
+	delete(e.pending, txid)
+	req.signedPkt <- signedPkt
+
+	return nil
+}
+
+// A compile-time assertion to ensure ExternalCoSigner meets the AnchorCoSigner
+// interface.
+var _ AnchorCoSigner = (*ExternalCoSigner)(nil)
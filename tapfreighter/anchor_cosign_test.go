@@ -0,0 +1,108 @@
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// newAnchorPsbt creates a PSBT that spends a random outpoint.
+func newAnchorPsbt(t *testing.T) *psbt.Packet {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{PreviousOutPoint: test.RandOp(t)})
+	tx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: []byte{0x01}})
+
+	pkt, err := psbt.NewFromUnsignedTx(tx)
+	require.NoError(t, err)
+
+	return pkt
+}
+
+// TestExternalCoSigner tests the PSBT round-trip with an external co-signer.
+func TestExternalCoSigner(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	coSigner := NewExternalCoSigner(time.Minute)
+	pkt := newAnchorPsbt(t)
+
+	type result struct {
+		pkt *psbt.Packet
+		err error
+	}
+	resultChan := make(chan result, 1)
+	go func() {
+		signedPkt, err := coSignAnchorPsbt(ctx, coSigner, pkt)
+		resultChan <- result{signedPkt, err}
+	}()
+
+	var pending []*psbt.Packet
+	require.Eventually(t, func() bool {
+		var err error
+		pending, err = coSigner.PendingPsbts()
+		require.NoError(t, err)
+
+		return len(pending) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// A PSBT for a different transaction is rejected, and so is one that
+	// doesn't describe all inputs.
+	err := coSigner.SubmitCoSignedPsbt(newAnchorPsbt(t))
+	require.ErrorContains(t, err, "waits for co-signature")
+
+	incomplete := *pending[0]
+	incomplete.Inputs = nil
+	err = coSigner.SubmitCoSignedPsbt(&incomplete)
+	require.ErrorContains(t, err, "has 0 PSBT inputs, expected 1")
+
+	// The co-signed PSBT is handed back to the waiting transfer.
+	signedPkt := pending[0]
+	signedPkt.Inputs[0].TaprootKeySpendSig = test.RandBytes(64)
+	require.NoError(t, coSigner.SubmitCoSignedPsbt(signedPkt))
+
+	res := <-resultChan
+	require.NoError(t, res.err)
+	require.Equal(
+		t, signedPkt.Inputs[0].TaprootKeySpendSig,
+		res.pkt.Inputs[0].TaprootKeySpendSig,
+	)
+
+	pending, err = coSigner.PendingPsbts()
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	// Without a submission, the transfer fails after the timeout.
+	coSigner = NewExternalCoSigner(10 * time.Millisecond)
+	_, err = coSignAnchorPsbt(ctx, coSigner, pkt)
+	require.ErrorContains(t, err, "timed out")
+}
+
+// modifyingCoSigner is a co-signer that changes the anchor transaction.
+type modifyingCoSigner struct{}
+
+func (m *modifyingCoSigner) CoSignAnchorPsbt(_ context.Context,
+	pkt *psbt.Packet) (*psbt.Packet, error) {
+
+	pkt.UnsignedTx.TxOut[0].Value++
+	return pkt, nil
+}
+
+// TestCoSignAnchorPsbtModified tests that a co-signer can't change the anchor
+// transaction.
+func TestCoSignAnchorPsbtModified(t *testing.T) {
+	t.Parallel()
+
+	pkt := newAnchorPsbt(t)
+	_, err := coSignAnchorPsbt(
+		context.Background(), &modifyingCoSigner{}, pkt,
+	)
+	require.ErrorContains(t, err, "co-signer modified anchor tx")
+
+	// The original packet is untouched.
+	require.EqualValues(t, 1000, pkt.UnsignedTx.TxOut[0].Value)
+}
@@ -0,0 +1,163 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// ErrAnchorKeyReuse is returned if a transfer would create an anchor output
+// with a wallet internal key that was already used for another anchor output
+// and reuse is refused.
+var ErrAnchorKeyReuse = errors.New("anchor internal key reused")
+
+// AnchorKeyReuseMode determines how the porter treats transfers that would
+// reuse a wallet internal key for a new anchor output. Reusing internal keys
+// links the anchor outputs on chain, which degrades privacy and makes it
+// harder to reason about the exclusion proofs of the outputs.
+type AnchorKeyReuseMode uint8
+
+const (
+	// AnchorKeyReuseAllowed means anchor internal keys aren't checked for
+	// reuse.
+	AnchorKeyReuseAllowed AnchorKeyReuseMode = iota
+
+	// AnchorKeyReuseWarn means reused anchor internal keys only result in
+	// a warning.
+	AnchorKeyReuseWarn
+
+	// AnchorKeyReuseRefuse means transfers that reuse an anchor internal
+	// key are aborted.
+	AnchorKeyReuseRefuse
+)
+
+// String returns a human-readable version of the reuse mode.
+func (m AnchorKeyReuseMode) String() string {
+	switch m {
+	case AnchorKeyReuseAllowed:
+		return "allow"
+
+	case AnchorKeyReuseWarn:
+		return "warn"
+
+	case AnchorKeyReuseRefuse:
+		return "refuse"
+
+	default:
+		return fmt.Sprintf("<unknown>(%d)", m)
+	}
+}
+
+// AnchorKeyUsage is an internal key along with the anchor outputs that were
+// created with it.
+type AnchorKeyUsage struct {
+	// InternalKey is the internal key of the anchor outputs.
+	InternalKey keychain.KeyDescriptor
+
+	// AnchorPoints are the outpoints of the anchor outputs that were
+	// created with the internal key.
+	AnchorPoints []wire.OutPoint
+}
+
+// AnchorKeyLog keeps track of the internal keys that were used for the anchor
+// outputs of the daemon.
+type AnchorKeyLog interface {
+	// FetchAnchorKeyUsage returns the outpoints of all anchor outputs that
+	// were created with the given internal key.
+	FetchAnchorKeyUsage(ctx context.Context,
+		internalKey *btcec.PublicKey) ([]wire.OutPoint, error)
+
+	// ListReusedAnchorKeys returns all internal keys that were used for
+	// more than one anchor output.
+	ListReusedAnchorKeys(ctx context.Context) ([]AnchorKeyUsage, error)
+}
+
+// checkAnchorKeyReuse makes sure the wallet internal keys of the new anchor
+// outputs of the virtual packet weren't used for another anchor output
+// before, or for another anchor output of the same transfer. Depending on the
+// reuse mode, a reused key either aborts the transfer or is only logged. Since
+// the anchor transaction isn't funded yet, aborting doesn't commit any funds.
+func (p *ChainPorter) checkAnchorKeyReuse(ctx context.Context,
+	vPacket *tappsbt.VPacket) error {
+
+	if p.cfg.AnchorKeyReuseMode == AnchorKeyReuseAllowed ||
+		p.cfg.AnchorKeyLog == nil {
+
+		return nil
+	}
+
+	// Multiple virtual outputs can share an anchor output, which isn't a
+	// reuse of its internal key.
+	var (
+		anchorIdxs []uint32
+		anchorKeys = make(map[uint32]keychain.KeyDescriptor)
+	)
+	for _, vOut := range vPacket.Outputs {
+		if _, ok := anchorKeys[vOut.AnchorOutputIndex]; ok {
+			continue
+		}
+
+		// Only anchor outputs with a derivation path can be derived
+		// by our wallet.
+		keyDesc, err := vOut.AnchorKeyToDesc()
+		if err != nil {
+			continue
+		}
+
+		if !p.cfg.KeyRing.IsLocalKey(ctx, keyDesc) {
+			continue
+		}
+
+		anchorKeys[vOut.AnchorOutputIndex] = keyDesc
+		anchorIdxs = append(anchorIdxs, vOut.AnchorOutputIndex)
+	}
+
+	usedKeys := make(map[asset.SerializedKey]uint32)
+	for _, anchorIdx := range anchorIdxs {
+		keyDesc := anchorKeys[anchorIdx]
+		key := asset.ToSerialized(keyDesc.PubKey)
+
+		var reuseErr error
+		if otherIdx, ok := usedKeys[key]; ok {
+			reuseErr = fmt.Errorf("%w: internal key %x of anchor "+
+				"output %d is also used for anchor output %d",
+				ErrAnchorKeyReuse, key[:], anchorIdx, otherIdx)
+		}
+		usedKeys[key] = anchorIdx
+
+		if reuseErr == nil {
+			anchorPoints, err := p.cfg.AnchorKeyLog.
+				FetchAnchorKeyUsage(ctx, keyDesc.PubKey)
+			if err != nil {
+				return fmt.Errorf("unable to fetch anchor key "+
+					"usage: %w", err)
+			}
+
+			if len(anchorPoints) > 0 {
+				reuseErr = fmt.Errorf("%w: internal key %x of "+
+					"anchor output %d was already used "+
+					"for %v", ErrAnchorKeyReuse, key[:],
+					anchorIdx, anchorPoints)
+			}
+		}
+
+		if reuseErr == nil {
+			continue
+		}
+
+		if p.cfg.AnchorKeyReuseMode == AnchorKeyReuseRefuse {
+			return reuseErr
+		}
+
+		log.Warnf("Transfer reuses anchor internal key, which "+
+			"degrades privacy: %v", reuseErr)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAnchorKeyLog is an anchor key log that knows the anchor outputs of a
+// fixed set of internal keys.
+type mockAnchorKeyLog struct {
+	usages map[asset.SerializedKey][]wire.OutPoint
+}
+
+func (m *mockAnchorKeyLog) FetchAnchorKeyUsage(_ context.Context,
+	internalKey *btcec.PublicKey) ([]wire.OutPoint, error) {
+
+	return m.usages[asset.ToSerialized(internalKey)], nil
+}
+
+func (m *mockAnchorKeyLog) ListReusedAnchorKeys(
+	context.Context) ([]AnchorKeyUsage, error) {
+
+	return nil, nil
+}
+
+// TestCheckAnchorKeyReuse tests that reused wallet internal keys of anchor
+// outputs are detected, and that they only abort the transfer if reuse is
+// refused.
+func TestCheckAnchorKeyReuse(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	freshKey := test.PubToKeyDesc(test.RandPubKey(t))
+	usedKey := test.PubToKeyDesc(test.RandPubKey(t))
+	remoteKey := test.RandPubKey(t)
+
+	keyLog := &mockAnchorKeyLog{
+		usages: map[asset.SerializedKey][]wire.OutPoint{
+			asset.ToSerialized(usedKey.PubKey): {test.RandOp(t)},
+			asset.ToSerialized(remoteKey):      {test.RandOp(t)},
+		},
+	}
+
+	newOutput := func(anchorIdx uint32,
+		keyDesc keychain.KeyDescriptor) *tappsbt.VOutput {
+
+		bip32Derivation, _ := tappsbt.Bip32DerivationFromKeyDesc(
+			keyDesc, chaincfg.RegressionNetParams.HDCoinType,
+		)
+
+		return &tappsbt.VOutput{
+			AnchorOutputIndex:       anchorIdx,
+			AnchorOutputInternalKey: keyDesc.PubKey,
+			AnchorOutputBip32Derivation: []*psbt.Bip32Derivation{
+				bip32Derivation,
+			},
+		}
+	}
+
+	newPorter := func(mode AnchorKeyReuseMode) *ChainPorter {
+		return &ChainPorter{
+			cfg: &ChainPorterConfig{
+				KeyRing:            tapgarden.NewMockKeyRing(),
+				AnchorKeyLog:       keyLog,
+				AnchorKeyReuseMode: mode,
+			},
+		}
+	}
+
+	// Outputs sharing an anchor output don't reuse its key, and keys
+	// without a derivation path aren't ours, so they aren't checked.
+	vPacket := &tappsbt.VPacket{
+		Outputs: []*tappsbt.VOutput{
+			newOutput(0, freshKey), newOutput(0, freshKey), {
+				AnchorOutputIndex:       1,
+				AnchorOutputInternalKey: remoteKey,
+			},
+		},
+	}
+	porter := newPorter(AnchorKeyReuseRefuse)
+	require.NoError(t, porter.checkAnchorKeyReuse(ctx, vPacket))
+
+	// Using the same key for another anchor output of the transfer is a
+	// reuse.
+	vPacket.Outputs = append(vPacket.Outputs, newOutput(2, freshKey))
+	err := porter.checkAnchorKeyReuse(ctx, vPacket)
+	require.ErrorIs(t, err, ErrAnchorKeyReuse)
+	require.ErrorContains(t, err, "also used for anchor output 0")
+
+	// A key that was used for a previous anchor output is a reuse too.
+	vPacket.Outputs = []*tappsbt.VOutput{newOutput(0, usedKey)}
+	err = porter.checkAnchorKeyReuse(ctx, vPacket)
+	require.ErrorIs(t, err, ErrAnchorKeyReuse)
+	require.ErrorContains(t, err, "was already used")
+
+	// In warn mode or if reuse is allowed, the transfer continues.
+	porter = newPorter(AnchorKeyReuseWarn)
+	require.NoError(t, porter.checkAnchorKeyReuse(ctx, vPacket))
+
+	porter = newPorter(AnchorKeyReuseAllowed)
+	require.NoError(t, porter.checkAnchorKeyReuse(ctx, vPacket))
+}
@@ -0,0 +1,230 @@
+package tapfreighter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+)
+
+var (
+	// ErrAnchorLockTimeNotEnforced is returned if an anchor transaction has
+	// a lock time, but all its inputs have a final sequence number, which
+	// disables the lock time.
+	ErrAnchorLockTimeNotEnforced = errors.New("anchor lock time not " +
+		"enforced")
+
+	// ErrAssetLockTimeViolated is returned if the lock time or sequence
+	// numbers of an anchor transaction don't satisfy the lock times of the
+	// assets it spends.
+	ErrAssetLockTimeViolated = errors.New("asset lock time violated")
+)
+
+// AnchorLockTime determines the lock time of an anchor transaction and the
+// sequence numbers of its inputs. By default, the lock time is set to the
+// current height of the main chain to discourage fee sniping.
+type AnchorLockTime struct {
+	// LockTime, if set, is the explicit lock time of the anchor
+	// transaction, which replaces the anti-fee-sniping default.
+	LockTime *uint32
+
+	// Sequence, if set, is the explicit sequence number of all inputs of
+	// the anchor transaction. If not set, the sequence numbers chosen by
+	// the wallet are kept, except that final sequence numbers are lowered
+	// by one if the transaction has a lock time, so it is enforced.
+	Sequence *uint32
+}
+
+// apply sets the lock time and the input sequence numbers of the given anchor
+// transaction. If no explicit lock time is set, the given best height is used,
+// unless it is zero.
+func (l *AnchorLockTime) apply(tx *wire.MsgTx, bestHeight uint32) error {
+	switch {
+	case l.LockTime != nil:
+		tx.LockTime = *l.LockTime
+
+	case bestHeight > 0:
+		tx.LockTime = bestHeight
+	}
+
+	enforced := false
+	for _, txIn := range tx.TxIn {
+		switch {
+		case l.Sequence != nil:
+			txIn.Sequence = *l.Sequence
+
+		case tx.LockTime > 0 &&
+			txIn.Sequence == wire.MaxTxInSequenceNum:
+
+			txIn.Sequence = wire.MaxTxInSequenceNum - 1
+		}
+
+		if txIn.Sequence != wire.MaxTxInSequenceNum {
+			enforced = true
+		}
+	}
+
+	if tx.LockTime > 0 && !enforced {
+		return fmt.Errorf("%w: lock time %d with final input sequence "+
+			"numbers", ErrAnchorLockTimeNotEnforced, tx.LockTime)
+	}
+
+	return nil
+}
+
+// checkAnchorLockTimeReachable makes sure an anchor transaction with the given
+// explicit lock time can be mined once it is broadcast. A block height lock
+// time above the current height requires a broadcast trigger at or above the
+// lock time, otherwise the broadcast would be rejected.
+func checkAnchorLockTimeReachable(lockTime AnchorLockTime, bestHeight uint32,
+	trigger *BroadcastTrigger) error {
+
+	if lockTime.LockTime == nil {
+		return nil
+	}
+
+	height := *lockTime.LockTime
+	if height >= txscript.LockTimeThreshold || height <= bestHeight {
+		return nil
+	}
+
+	if trigger != nil && trigger.Height >= height {
+		return nil
+	}
+
+	return fmt.Errorf("anchor lock time %d is above current height %d, "+
+		"a broadcast trigger at or above the lock time is required",
+		height, bestHeight)
+}
+
+// checkAssetLockTimes makes sure the lock time and the input sequence numbers
+// of the given anchor transaction satisfy the lock times of all assets it
+// spends. The virtual witnesses of the assets commit to their lock times, so
+// the asset transfer is only valid once the anchor transaction can't be
+// mined before the assets are unlocked.
+func checkAssetLockTimes(tx *wire.MsgTx, vPkts []*tappsbt.VPacket) error {
+	sequences := make(map[wire.OutPoint]uint32, len(tx.TxIn))
+	for _, txIn := range tx.TxIn {
+		sequences[txIn.PreviousOutPoint] = txIn.Sequence
+	}
+
+	for _, vPkt := range vPkts {
+		for _, vIn := range vPkt.Inputs {
+			inputAsset := vIn.Asset()
+			if inputAsset == nil {
+				continue
+			}
+
+			outpoint := vIn.PrevID.OutPoint
+			sequence, ok := sequences[outpoint]
+			if !ok {
+				return fmt.Errorf("anchor input %v of asset "+
+					"%v not found in anchor tx", outpoint,
+					inputAsset.ID())
+			}
+
+			err := checkLockTime(
+				tx.LockTime, sequence, inputAsset.LockTime,
+			)
+			if err != nil {
+				return fmt.Errorf("%w: asset %v: %v",
+					ErrAssetLockTimeViolated,
+					inputAsset.ID(), err)
+			}
+
+			err = checkRelativeLockTime(
+				tx.Version, sequence,
+				inputAsset.RelativeLockTime,
+			)
+			if err != nil {
+				return fmt.Errorf("%w: asset %v: %v",
+					ErrAssetLockTimeViolated,
+					inputAsset.ID(), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkLockTime returns an error if a transaction with the given lock time
+// spending an input with the given sequence number could be mined before the
+// given asset lock time, following the rules of OP_CHECKLOCKTIMEVERIFY.
+func checkLockTime(txLockTime, sequence uint32, assetLockTime uint64) error {
+	if assetLockTime == 0 {
+		return nil
+	}
+
+	if assetLockTime > uint64(^uint32(0)) {
+		return fmt.Errorf("invalid lock time %d", assetLockTime)
+	}
+
+	// Both lock times need to be of the same type, either a block height
+	// or a timestamp.
+	lockTime := uint32(assetLockTime)
+	isHeight := lockTime < txscript.LockTimeThreshold
+	if isHeight != (txLockTime < txscript.LockTimeThreshold) {
+		return fmt.Errorf("lock time %d isn't of the same type as "+
+			"asset lock time %d", txLockTime, lockTime)
+	}
+
+	if txLockTime < lockTime {
+		return fmt.Errorf("lock time %d is before asset lock time %d",
+			txLockTime, lockTime)
+	}
+
+	if sequence == wire.MaxTxInSequenceNum {
+		return fmt.Errorf("lock time disabled by final sequence number")
+	}
+
+	return nil
+}
+
+// checkRelativeLockTime returns an error if an input with the given sequence
+// number could be spent before the given asset relative lock time, following
+// the rules of OP_CHECKSEQUENCEVERIFY.
+func checkRelativeLockTime(txVersion int32, sequence uint32,
+	assetRelativeLockTime uint64) error {
+
+	if assetRelativeLockTime == 0 {
+		return nil
+	}
+
+	if assetRelativeLockTime > uint64(^uint32(0)) {
+		return fmt.Errorf("invalid relative lock time %d",
+			assetRelativeLockTime)
+	}
+
+	relativeLockTime := uint32(assetRelativeLockTime)
+	if relativeLockTime&wire.SequenceLockTimeDisabled != 0 {
+		return nil
+	}
+
+	if txVersion < 2 {
+		return fmt.Errorf("relative lock time requires tx version 2")
+	}
+
+	if sequence&wire.SequenceLockTimeDisabled != 0 {
+		return fmt.Errorf("relative lock time disabled by sequence "+
+			"number %d", sequence)
+	}
+
+	// Both relative lock times need to be of the same type, either a
+	// number of blocks or a multiple of 512 seconds.
+	const typeMask = wire.SequenceLockTimeIsSeconds
+	if sequence&typeMask != relativeLockTime&typeMask {
+		return fmt.Errorf("sequence number %d isn't of the same type "+
+			"as asset relative lock time %d", sequence,
+			relativeLockTime)
+	}
+
+	const valueMask = wire.SequenceLockTimeMask
+	if sequence&valueMask < relativeLockTime&valueMask {
+		return fmt.Errorf("sequence number %d is below asset relative "+
+			"lock time %d", sequence, relativeLockTime)
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// newLockTimeTestTx creates an anchor transaction with the given input
+// sequence numbers.
+func newLockTimeTestTx(sequences ...uint32) *wire.MsgTx {
+	tx := wire.NewMsgTx(2)
+	for _, sequence := range sequences {
+		tx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: wire.OutPoint{Hash: test.RandHash()},
+			Sequence:         sequence,
+		})
+	}
+
+	return tx
+}
+
+// TestAnchorLockTimeApply tests that the anti-fee-sniping lock time is set by
+// default and that explicit lock times and sequence numbers override it.
+func TestAnchorLockTimeApply(t *testing.T) {
+	t.Parallel()
+
+	var (
+		lockTime uint32 = 500
+		zero     uint32
+		sequence uint32 = 10
+		final           = wire.MaxTxInSequenceNum
+	)
+
+	// By default, the current height is used and final sequence numbers
+	// are lowered so the lock time is enforced.
+	tx := newLockTimeTestTx(final, 5)
+	require.NoError(t, (&AnchorLockTime{}).apply(tx, 800))
+	require.EqualValues(t, 800, tx.LockTime)
+	require.Equal(t, final-1, tx.TxIn[0].Sequence)
+	require.EqualValues(t, 5, tx.TxIn[1].Sequence)
+
+	// Without a height, the transaction is left untouched.
+	tx = newLockTimeTestTx(final)
+	require.NoError(t, (&AnchorLockTime{}).apply(tx, 0))
+	require.Zero(t, tx.LockTime)
+	require.Equal(t, final, tx.TxIn[0].Sequence)
+
+	// An explicit lock time replaces the current height.
+	tx = newLockTimeTestTx(final)
+	explicit := &AnchorLockTime{LockTime: &lockTime, Sequence: &sequence}
+	require.NoError(t, explicit.apply(tx, 800))
+	require.Equal(t, lockTime, tx.LockTime)
+	require.Equal(t, sequence, tx.TxIn[0].Sequence)
+
+	// An explicit zero lock time disables anti-fee-sniping.
+	tx = newLockTimeTestTx(final)
+	require.NoError(t, (&AnchorLockTime{LockTime: &zero}).apply(tx, 800))
+	require.Zero(t, tx.LockTime)
+	require.Equal(t, final, tx.TxIn[0].Sequence)
+
+	// A lock time with only final sequence numbers isn't enforced.
+	tx = newLockTimeTestTx(5)
+	disabled := &AnchorLockTime{Sequence: &final}
+	require.ErrorIs(
+		t, disabled.apply(tx, 800), ErrAnchorLockTimeNotEnforced,
+	)
+}
+
+// TestCheckAnchorLockTimeReachable tests that an explicit lock time above the
+// current height requires a broadcast trigger.
+func TestCheckAnchorLockTimeReachable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		height    uint32 = 900
+		timestamp uint32 = 1_700_000_000
+	)
+
+	require.NoError(t, checkAnchorLockTimeReachable(
+		AnchorLockTime{}, 800, nil,
+	))
+	require.NoError(t, checkAnchorLockTimeReachable(
+		AnchorLockTime{LockTime: &timestamp}, 800, nil,
+	))
+	require.NoError(t, checkAnchorLockTimeReachable(
+		AnchorLockTime{LockTime: &height}, 900, nil,
+	))
+	require.NoError(t, checkAnchorLockTimeReachable(
+		AnchorLockTime{LockTime: &height}, 800,
+		&BroadcastTrigger{Height: 900},
+	))
+	require.Error(t, checkAnchorLockTimeReachable(
+		AnchorLockTime{LockTime: &height}, 800, nil,
+	))
+	require.Error(t, checkAnchorLockTimeReachable(
+		AnchorLockTime{LockTime: &height}, 800,
+		&BroadcastTrigger{Height: 899},
+	))
+}
+
+// TestCheckAssetLockTimes tests that the lock time and sequence numbers of an
+// anchor transaction are checked against the lock times of the spent assets.
+func TestCheckAssetLockTimes(t *testing.T) {
+	t.Parallel()
+
+	const (
+		final     = wire.MaxTxInSequenceNum
+		timestamp = 1_700_000_000
+		seconds   = wire.SequenceLockTimeIsSeconds
+		disabled  = wire.SequenceLockTimeDisabled
+	)
+
+	testCases := []struct {
+		name          string
+		txLockTime    uint32
+		sequence      uint32
+		assetLockTime uint64
+		assetRelative uint64
+		valid         bool
+	}{{
+		name:     "no asset lock times",
+		sequence: final,
+		valid:    true,
+	}, {
+		name:          "lock time reached",
+		txLockTime:    800,
+		sequence:      final - 1,
+		assetLockTime: 800,
+		valid:         true,
+	}, {
+		name:          "lock time not reached",
+		txLockTime:    799,
+		sequence:      final - 1,
+		assetLockTime: 800,
+	}, {
+		name:          "lock time of other type",
+		txLockTime:    800,
+		sequence:      final - 1,
+		assetLockTime: timestamp,
+	}, {
+		name:          "lock time disabled by sequence",
+		txLockTime:    800,
+		sequence:      final,
+		assetLockTime: 800,
+	}, {
+		name:          "relative lock time reached",
+		sequence:      144,
+		assetRelative: 144,
+		valid:         true,
+	}, {
+		name:          "relative lock time not reached",
+		sequence:      143,
+		assetRelative: 144,
+	}, {
+		name:          "relative lock time of other type",
+		sequence:      144 | seconds,
+		assetRelative: 144,
+	}, {
+		name:          "relative lock time in seconds reached",
+		sequence:      10 | seconds,
+		assetRelative: 10 | seconds,
+		valid:         true,
+	}, {
+		name:          "relative lock time disabled by sequence",
+		sequence:      144 | disabled,
+		assetRelative: 144,
+	}, {
+		name:          "asset relative lock time disabled",
+		sequence:      final,
+		assetRelative: 144 | disabled,
+		valid:         true,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkLockTime(
+				tc.txLockTime, tc.sequence, tc.assetLockTime,
+			)
+			if err == nil {
+				err = checkRelativeLockTime(
+					2, tc.sequence, tc.assetRelative,
+				)
+			}
+
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	// Relative lock times are only enforced for version 2 transactions.
+	require.Error(t, checkRelativeLockTime(1, 144, 144))
+}
@@ -0,0 +1,302 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+)
+
+var (
+	// ErrAnchorSessionExists is returned if an anchor session with the
+	// same name is already open.
+	ErrAnchorSessionExists = errors.New("anchor session already exists")
+
+	// ErrAnchorSessionNotFound is returned if no open anchor session with
+	// the given name exists.
+	ErrAnchorSessionNotFound = errors.New("anchor session not found")
+
+	// ErrAnchorSessionAborted is returned to the callers of an anchor
+	// session that was aborted before it was sealed.
+	ErrAnchorSessionAborted = errors.New("anchor session aborted")
+)
+
+// SessionParcel is a parcel a caller registered in an anchor session. Once the
+// session is sealed, the caller receives its own view of the shared transfer.
+type SessionParcel struct {
+	// destAddrs are the addresses the caller sends to.
+	destAddrs []*address.Tap
+
+	// respChan receives the caller's view of the shared transfer.
+	respChan chan *OutboundParcel
+
+	// errChan receives the error if the shared transfer failed.
+	errChan chan error
+}
+
+// Wait blocks until the anchor session the parcel was registered in is sealed
+// and the shared transfer was committed. The returned parcel only contains the
+// caller's own outputs and its share of the chain fees. As the inputs are
+// selected for the whole session, all inputs are part of every view.
+func (s *SessionParcel) Wait(ctx context.Context) (*OutboundParcel, error) {
+	select {
+	case parcel := <-s.respChan:
+		return parcel, nil
+
+	case err := <-s.errChan:
+		return nil, err
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// anchorSession is a named set of parcels that are anchored in a single
+// transaction.
+type anchorSession struct {
+	// assetID is the ID of the asset all parcels of the session send.
+	assetID *asset.ID
+
+	// parcels are the registered parcels, in the order of registration.
+	parcels []*SessionParcel
+
+	// scriptKeys are the script keys of all receivers of the session.
+	scriptKeys map[asset.SerializedKey]struct{}
+}
+
+// AnchorCoordinator lets separate callers register parcels into named anchor
+// sessions. Once a session is sealed, the parcels of all callers are committed
+// to a single anchor transaction atomically, so either all or none of them are
+// sent, and the chain fees are split between the callers in proportion to the
+// number of outputs they added.
+//
+// NOTE: As the chain porter can only anchor a single virtual transaction for
+// now, all parcels of a session must send the same asset.
+type AnchorCoordinator struct {
+	porter Porter
+
+	// mtx guards the map below.
+	mtx sync.Mutex
+
+	// sessions are the open sessions, keyed by their name.
+	sessions map[string]*anchorSession
+}
+
+// NewAnchorCoordinator creates a new anchor coordinator that ships sealed
+// sessions through the given porter.
+func NewAnchorCoordinator(porter Porter) *AnchorCoordinator {
+	return &AnchorCoordinator{
+		porter:   porter,
+		sessions: make(map[string]*anchorSession),
+	}
+}
+
+// OpenSession opens a new anchor session with the given name.
+func (c *AnchorCoordinator) OpenSession(name string) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if _, ok := c.sessions[name]; ok {
+		return fmt.Errorf("%w: %v", ErrAnchorSessionExists, name)
+	}
+
+	c.sessions[name] = &anchorSession{
+		scriptKeys: make(map[asset.SerializedKey]struct{}),
+	}
+
+	return nil
+}
+
+// Register registers a parcel that sends to the given addresses in the open
+// anchor session with the given name.
+func (c *AnchorCoordinator) Register(name string,
+	destAddrs ...*address.Tap) (*SessionParcel, error) {
+
+	if len(destAddrs) == 0 {
+		return nil, fmt.Errorf("at least one address must be " +
+			"specified")
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	session, ok := c.sessions[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrAnchorSessionNotFound, name)
+	}
+
+	assetID := session.assetID
+	if assetID == nil {
+		assetID = &destAddrs[0].AssetID
+	}
+
+	newKeys := make(map[asset.SerializedKey]struct{}, len(destAddrs))
+	for _, addr := range destAddrs {
+		if addr.AssetID != *assetID {
+			return nil, fmt.Errorf("address sends asset %v, but "+
+				"session %v sends asset %v", addr.AssetID,
+				name, *assetID)
+		}
+
+		// Proofs are delivered by script key, so every receiver of
+		// the session needs its own script key.
+		key := asset.ToSerialized(&addr.ScriptKey)
+		_, existing := session.scriptKeys[key]
+		if _, dup := newKeys[key]; existing || dup {
+			return nil, fmt.Errorf("script key %x is already a "+
+				"receiver in session %v", key[:], name)
+		}
+		newKeys[key] = struct{}{}
+	}
+
+	for key := range newKeys {
+		session.scriptKeys[key] = struct{}{}
+	}
+	session.assetID = assetID
+
+	parcel := &SessionParcel{
+		destAddrs: destAddrs,
+		respChan:  make(chan *OutboundParcel, 1),
+		errChan:   make(chan error, 1),
+	}
+	session.parcels = append(session.parcels, parcel)
+
+	return parcel, nil
+}
+
+// removeSession removes the open session with the given name.
+func (c *AnchorCoordinator) removeSession(name string) (*anchorSession,
+	error) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	session, ok := c.sessions[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrAnchorSessionNotFound, name)
+	}
+	delete(c.sessions, name)
+
+	return session, nil
+}
+
+// Abort aborts the open anchor session with the given name. All callers that
+// registered a parcel receive ErrAnchorSessionAborted.
+func (c *AnchorCoordinator) Abort(name string) error {
+	session, err := c.removeSession(name)
+	if err != nil {
+		return err
+	}
+
+	for _, parcel := range session.parcels {
+		parcel.errChan <- fmt.Errorf("%w: %v", ErrAnchorSessionAborted,
+			name)
+	}
+
+	return nil
+}
+
+// Seal closes the anchor session with the given name and ships the parcels of
+// all callers in a single anchor transaction with the given priority. Every
+// caller receives its own view of the transfer, and the full transfer is
+// returned. If the transfer fails, all callers receive the error.
+func (c *AnchorCoordinator) Seal(name string,
+	priority ParcelPriority) (*OutboundParcel, error) {
+
+	session, err := c.removeSession(name)
+	if err != nil {
+		return nil, err
+	}
+
+	fail := func(err error) (*OutboundParcel, error) {
+		for _, parcel := range session.parcels {
+			parcel.errChan <- err
+		}
+
+		return nil, err
+	}
+
+	if len(session.parcels) == 0 {
+		return nil, fmt.Errorf("anchor session %v has no parcels",
+			name)
+	}
+
+	var destAddrs []*address.Tap
+	for _, parcel := range session.parcels {
+		destAddrs = append(destAddrs, parcel.destAddrs...)
+	}
+
+	log.Infof("Sealing anchor session %v with %d parcels sending to %d "+
+		"addresses", name, len(session.parcels), len(destAddrs))
+
+	addrParcel := NewAddressParcel(destAddrs...)
+	addrParcel.SetPriority(priority)
+
+	transfer, err := c.porter.RequestShipment(addrParcel)
+	if err != nil {
+		return fail(fmt.Errorf("unable to ship anchor session %v: %w",
+			name, err))
+	}
+
+	fees := splitChainFees(transfer.ChainFees, session.parcels)
+	for idx, parcel := range session.parcels {
+		parcel.respChan <- sessionView(transfer, parcel, fees[idx])
+	}
+
+	return transfer, nil
+}
+
+// splitChainFees splits the chain fees between the parcels in proportion to the
+// number of outputs they added. Any remainder is assigned to the parcels that
+// registered first, one satoshi each.
+func splitChainFees(chainFees int64, parcels []*SessionParcel) []int64 {
+	var numOutputs int64
+	for _, parcel := range parcels {
+		numOutputs += int64(len(parcel.destAddrs))
+	}
+
+	fees := make([]int64, len(parcels))
+	var assigned int64
+	for idx, parcel := range parcels {
+		fees[idx] = chainFees * int64(len(parcel.destAddrs)) /
+			numOutputs
+		assigned += fees[idx]
+	}
+
+	for idx := 0; assigned < chainFees; idx++ {
+		fees[idx%len(fees)]++
+		assigned++
+	}
+
+	return fees
+}
+
+// sessionView returns the view of the shared transfer for the given parcel,
+// which only contains the outputs sending to the parcel's addresses.
+func sessionView(transfer *OutboundParcel, parcel *SessionParcel,
+	chainFees int64) *OutboundParcel {
+
+	scriptKeys := make(map[asset.SerializedKey]struct{})
+	for _, addr := range parcel.destAddrs {
+		scriptKeys[asset.ToSerialized(&addr.ScriptKey)] = struct{}{}
+	}
+
+	view := &OutboundParcel{
+		AnchorTx:           transfer.AnchorTx,
+		AnchorTxHeightHint: transfer.AnchorTxHeightHint,
+		TransferTime:       transfer.TransferTime,
+		ChainFees:          chainFees,
+		Inputs:             transfer.Inputs,
+	}
+	for _, out := range transfer.Outputs {
+		key := asset.ToSerialized(out.ScriptKey.PubKey)
+		if _, ok := scriptKeys[key]; ok {
+			view.Outputs = append(view.Outputs, out)
+		}
+	}
+
+	return view
+}
@@ -0,0 +1,169 @@
+package tapfreighter
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSessionPorter is a porter that records the parcels it is asked to ship
+// and returns a transfer with one output per address.
+type mockSessionPorter struct {
+	Porter
+
+	t *testing.T
+
+	parcels []*AddressParcel
+	fail    bool
+}
+
+func (m *mockSessionPorter) RequestShipment(req Parcel) (*OutboundParcel,
+	error) {
+
+	addrParcel, ok := req.(*AddressParcel)
+	if !ok {
+		return nil, fmt.Errorf("unexpected parcel %T", req)
+	}
+	m.parcels = append(m.parcels, addrParcel)
+
+	if m.fail {
+		return nil, fmt.Errorf("shipment failed")
+	}
+
+	transfer := &OutboundParcel{
+		AnchorTxHeightHint: 100,
+		ChainFees:          1000,
+		Inputs:             []TransferInput{{Amount: 10}},
+		Outputs: []TransferOutput{{
+			ScriptKey: asset.RandScriptKey(m.t),
+			Type:      tappsbt.TypeSplitRoot,
+		}},
+	}
+	for _, addr := range addrParcel.destAddrs {
+		transfer.Outputs = append(transfer.Outputs, TransferOutput{
+			ScriptKey: asset.NewScriptKey(&addr.ScriptKey),
+			Amount:    addr.Amount,
+		})
+	}
+
+	return transfer, nil
+}
+
+// randSessionAddr returns an address that sends the given asset to a random
+// script key.
+func randSessionAddr(t *testing.T, assetID asset.ID,
+	amount uint64) *address.Tap {
+
+	return &address.Tap{
+		AssetID:   assetID,
+		ScriptKey: *test.RandPubKey(t),
+		Amount:    amount,
+	}
+}
+
+// TestAnchorSession tests that the parcels registered in an anchor session are
+// shipped in a single transfer and that every caller receives its own view.
+func TestAnchorSession(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	porter := &mockSessionPorter{t: t}
+	coordinator := NewAnchorCoordinator(porter)
+
+	assetID := asset.RandID(t)
+	require.NoError(t, coordinator.OpenSession("batch"))
+	require.ErrorIs(
+		t, coordinator.OpenSession("batch"), ErrAnchorSessionExists,
+	)
+
+	_, err := coordinator.Register(
+		"unknown", randSessionAddr(t, assetID, 1),
+	)
+	require.ErrorIs(t, err, ErrAnchorSessionNotFound)
+
+	addrA := randSessionAddr(t, assetID, 1)
+	parcelA, err := coordinator.Register("batch", addrA)
+	require.NoError(t, err)
+
+	addrB1 := randSessionAddr(t, assetID, 2)
+	addrB2 := randSessionAddr(t, assetID, 3)
+	parcelB, err := coordinator.Register("batch", addrB1, addrB2)
+	require.NoError(t, err)
+
+	// Parcels that send another asset or reuse a script key of the session
+	// are rejected.
+	_, err = coordinator.Register(
+		"batch", randSessionAddr(t, asset.RandID(t), 1),
+	)
+	require.ErrorContains(t, err, "sends asset")
+
+	dupAddr := randSessionAddr(t, assetID, 4)
+	dupAddr.ScriptKey = addrA.ScriptKey
+	_, err = coordinator.Register("batch", dupAddr)
+	require.ErrorContains(t, err, "already a receiver")
+
+	transfer, err := coordinator.Seal("batch", PriorityUrgent)
+	require.NoError(t, err)
+	require.Len(t, transfer.Outputs, 4)
+
+	// All addresses were shipped in a single parcel.
+	require.Len(t, porter.parcels, 1)
+	require.Equal(
+		t, []*address.Tap{addrA, addrB1, addrB2},
+		porter.parcels[0].destAddrs,
+	)
+	require.Equal(t, PriorityUrgent, porter.parcels[0].priority)
+
+	// The fees are split by the number of outputs, with the remainder
+	// going to the first caller.
+	viewA, err := parcelA.Wait(ctx)
+	require.NoError(t, err)
+	require.Len(t, viewA.Outputs, 1)
+	require.Equal(t, addrA.Amount, viewA.Outputs[0].Amount)
+	require.EqualValues(t, 334, viewA.ChainFees)
+	require.Equal(t, transfer.Inputs, viewA.Inputs)
+
+	viewB, err := parcelB.Wait(ctx)
+	require.NoError(t, err)
+	require.Len(t, viewB.Outputs, 2)
+	require.Equal(t, addrB1.Amount, viewB.Outputs[0].Amount)
+	require.Equal(t, addrB2.Amount, viewB.Outputs[1].Amount)
+	require.EqualValues(t, 666, viewB.ChainFees)
+
+	// Once sealed, the session is closed.
+	_, err = coordinator.Register("batch", randSessionAddr(t, assetID, 1))
+	require.ErrorIs(t, err, ErrAnchorSessionNotFound)
+	_, err = coordinator.Seal("batch", PriorityNormal)
+	require.ErrorIs(t, err, ErrAnchorSessionNotFound)
+
+	// The callers of an aborted session are notified.
+	require.NoError(t, coordinator.OpenSession("aborted"))
+	parcel, err := coordinator.Register(
+		"aborted", randSessionAddr(t, assetID, 1),
+	)
+	require.NoError(t, err)
+	require.NoError(t, coordinator.Abort("aborted"))
+	_, err = parcel.Wait(ctx)
+	require.ErrorIs(t, err, ErrAnchorSessionAborted)
+
+	// If the shipment fails, all callers receive the error.
+	porter.fail = true
+	require.NoError(t, coordinator.OpenSession("failed"))
+	parcel, err = coordinator.Register(
+		"failed", randSessionAddr(t, assetID, 1),
+	)
+	require.NoError(t, err)
+	_, err = coordinator.Seal("failed", PriorityNormal)
+	require.ErrorContains(t, err, "shipment failed")
+	_, err = parcel.Wait(ctx)
+	require.ErrorContains(t, err, "shipment failed")
+}
@@ -0,0 +1,404 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// DefaultBackendFailureThreshold is the default number of consecutive
+	// failed chain backend or wallet calls after which the porter stops
+	// starting new parcels.
+	DefaultBackendFailureThreshold = 5
+
+	// DefaultBackendRetryInitialBackoff is the default initial time the
+	// porter waits before it retries a failed chain backend or wallet
+	// call.
+	DefaultBackendRetryInitialBackoff = 5 * time.Second
+
+	// DefaultBackendRetryMaxBackoff is the default maximum time the porter
+	// waits between retries of a failed chain backend or wallet call.
+	DefaultBackendRetryMaxBackoff = 5 * time.Minute
+)
+
+// BackendError is returned for a failed call to the chain backend or wallet of
+// the porter.
+type BackendError struct {
+	// Err is the error returned by the backend.
+	Err error
+}
+
+// Error returns the error message of the backend error.
+func (e *BackendError) Error() string {
+	return fmt.Sprintf("backend call failed: %v", e.Err)
+}
+
+// Unwrap returns the error returned by the backend.
+func (e *BackendError) Unwrap() error {
+	return e.Err
+}
+
+// backendBreaker is a circuit breaker that tracks the calls to the chain
+// backend and wallet of the porter. Once a number of consecutive calls failed,
+// the breaker trips and the backend is probed with an exponential backoff
+// until it recovers, which resets the breaker.
+type backendBreaker struct {
+	// threshold is the number of consecutive failures that trip the
+	// breaker.
+	threshold int
+
+	// initialBackoff is the initial time to wait between backend probes.
+	initialBackoff time.Duration
+
+	// maxBackoff is the maximum time to wait between backend probes.
+	maxBackoff time.Duration
+
+	// probe checks whether the backend is available again.
+	probe func(context.Context) error
+
+	// stateChanges is signaled whenever the breaker trips or resets.
+	stateChanges chan struct{}
+
+	// quit is closed when the breaker should stop probing the backend.
+	quit <-chan struct{}
+
+	// wg tracks the probing goroutine.
+	wg *sync.WaitGroup
+
+	// mtx guards the fields below.
+	mtx sync.Mutex
+
+	// failures is the number of consecutive failed backend calls.
+	failures int
+
+	// lastErr is the error of the most recent failed backend call.
+	lastErr error
+
+	// tripped is true while the breaker is tripped.
+	tripped bool
+
+	// reset is closed once a tripped breaker is reset.
+	reset chan struct{}
+}
+
+// newBackendBreaker creates a new backend breaker that trips after the given
+// number of consecutive failures and probes the backend with the given probe
+// until it recovers.
+func newBackendBreaker(threshold int, initialBackoff, maxBackoff time.Duration,
+	probe func(context.Context) error, quit <-chan struct{},
+	wg *sync.WaitGroup) *backendBreaker {
+
+	if initialBackoff == 0 {
+		initialBackoff = DefaultBackendRetryInitialBackoff
+	}
+	if maxBackoff == 0 {
+		maxBackoff = DefaultBackendRetryMaxBackoff
+	}
+
+	return &backendBreaker{
+		threshold:      threshold,
+		initialBackoff: initialBackoff,
+		maxBackoff:     maxBackoff,
+		probe:          probe,
+		stateChanges:   make(chan struct{}, 1),
+		quit:           quit,
+		wg:             wg,
+	}
+}
+
+// observe records the outcome of a backend call. Failures are wrapped in a
+// BackendError. A nil breaker returns the error unchanged.
+func (b *backendBreaker) observe(err error) error {
+	if b == nil {
+		return err
+	}
+
+	switch {
+	case err == nil:
+		b.recordSuccess()
+		return nil
+
+	// A call that was canceled by us doesn't say anything about the
+	// state of the backend.
+	case errors.Is(err, context.Canceled):
+		return err
+	}
+
+	b.recordFailure(err)
+
+	return &BackendError{Err: err}
+}
+
+// recordSuccess resets the failure count and the breaker, if it was tripped.
+func (b *backendBreaker) recordSuccess() {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.failures = 0
+	if !b.tripped {
+		return
+	}
+
+	log.Infof("Chain backend recovered, resuming parcel intake")
+
+	b.tripped = false
+	close(b.reset)
+	b.signalStateChange()
+}
+
+// recordFailure counts a failed backend call and trips the breaker once the
+// threshold of consecutive failures is reached.
+func (b *backendBreaker) recordFailure(err error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.failures++
+	b.lastErr = err
+	if b.tripped || b.failures < b.threshold {
+		return
+	}
+
+	log.Warnf("Chain backend failed %d consecutive times, pausing "+
+		"parcel intake: %v", b.failures, err)
+
+	b.tripped = true
+	b.reset = make(chan struct{})
+	b.signalStateChange()
+
+	// There's no point in probing the backend if we're shutting down.
+	select {
+	case <-b.quit:
+		return
+	default:
+	}
+
+	b.wg.Add(1)
+	go b.probeBackend()
+}
+
+// signalStateChange notifies the porter that the breaker tripped or reset.
+//
+// NOTE: The mutex must be held when calling this method.
+func (b *backendBreaker) signalStateChange() {
+	select {
+	case b.stateChanges <- struct{}{}:
+	default:
+	}
+}
+
+// probeBackend probes the backend with an exponential backoff until it
+// recovers.
+//
+// NOTE: This method MUST be called as a goroutine.
+func (b *backendBreaker) probeBackend() {
+	defer b.wg.Done()
+
+	backoff := b.initialBackoff
+	for {
+		select {
+		case <-time.After(backoff):
+		case <-b.quit:
+			return
+		}
+
+		// The breaker might have been reset by a successful call in
+		// the meantime.
+		if !b.isTripped() {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(
+			context.Background(), b.maxBackoff,
+		)
+		err := b.observe(b.probe(ctx))
+		cancel()
+		if err == nil {
+			return
+		}
+
+		backoff *= 2
+		if backoff > b.maxBackoff {
+			backoff = b.maxBackoff
+		}
+
+		log.Debugf("Chain backend still unavailable, probing again "+
+			"in %v: %v", backoff, err)
+	}
+}
+
+// state returns whether the breaker is tripped and the error of the most
+// recent failed backend call.
+func (b *backendBreaker) state() (bool, error) {
+	if b == nil {
+		return false, nil
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	return b.tripped, b.lastErr
+}
+
+// isTripped returns true if the breaker is tripped. A nil breaker is never
+// tripped.
+func (b *backendBreaker) isTripped() bool {
+	tripped, _ := b.state()
+	return tripped
+}
+
+// stateChanged returns a channel that is signaled whenever the breaker trips
+// or resets. A nil breaker returns a nil channel.
+func (b *backendBreaker) stateChanged() <-chan struct{} {
+	if b == nil {
+		return nil
+	}
+
+	return b.stateChanges
+}
+
+// retryReady returns a channel that is closed once a failed backend call can
+// be retried. If the breaker is tripped, that is once the breaker is reset.
+// Otherwise, the call is retried after the initial backoff.
+func (b *backendBreaker) retryReady() <-chan struct{} {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if b.tripped {
+		return b.reset
+	}
+
+	ready := make(chan struct{})
+	time.AfterFunc(b.initialBackoff, func() {
+		close(ready)
+	})
+
+	return ready
+}
+
+// breakerChainBridge is a ChainBridge that reports the outcome of the calls
+// made by the porter to a backend breaker.
+type breakerChainBridge struct {
+	ChainBridge
+
+	breaker *backendBreaker
+}
+
+// RegisterConfirmationsNtfn registers an intent to be notified once txid
+// reaches numConfs confirmations.
+func (c *breakerChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
+	txid *chainhash.Hash, pkScript []byte, numConfs, heightHint uint32,
+	includeBlock bool,
+	reOrgChan chan struct{}) (*chainntnfs.ConfirmationEvent, chan error,
+	error) {
+
+	confEvent, errChan, err := c.ChainBridge.RegisterConfirmationsNtfn(
+		ctx, txid, pkScript, numConfs, heightHint, includeBlock,
+		reOrgChan,
+	)
+
+	return confEvent, errChan, c.breaker.observe(err)
+}
+
+// RegisterSpendNtfn registers an intent to be notified once the given outpoint
+// is spent by a confirmed transaction.
+func (c *breakerChainBridge) RegisterSpendNtfn(ctx context.Context,
+	outpoint *wire.OutPoint, pkScript []byte,
+	heightHint uint32) (*chainntnfs.SpendEvent, chan error, error) {
+
+	spendEvent, errChan, err := c.ChainBridge.RegisterSpendNtfn(
+		ctx, outpoint, pkScript, heightHint,
+	)
+
+	return spendEvent, errChan, c.breaker.observe(err)
+}
+
+// RegisterBlockEpochNtfn registers an intent to be notified of each new block
+// connected to the main chain.
+func (c *breakerChainBridge) RegisterBlockEpochNtfn(
+	ctx context.Context) (chan int32, chan error, error) {
+
+	epochs, errChan, err := c.ChainBridge.RegisterBlockEpochNtfn(ctx)
+
+	return epochs, errChan, c.breaker.observe(err)
+}
+
+// GetBlock returns a chain block given its hash.
+func (c *breakerChainBridge) GetBlock(ctx context.Context,
+	hash chainhash.Hash) (*wire.MsgBlock, error) {
+
+	block, err := c.ChainBridge.GetBlock(ctx, hash)
+
+	return block, c.breaker.observe(err)
+}
+
+// GetBlockHash returns the hash of the block in the best blockchain at the
+// given height.
+func (c *breakerChainBridge) GetBlockHash(ctx context.Context,
+	height int64) (chainhash.Hash, error) {
+
+	hash, err := c.ChainBridge.GetBlockHash(ctx, height)
+
+	return hash, c.breaker.observe(err)
+}
+
+// VerifyBlock returns an error if a block (with given header and height) is not
+// present on-chain.
+func (c *breakerChainBridge) VerifyBlock(ctx context.Context,
+	header wire.BlockHeader, height uint32) error {
+
+	return c.breaker.observe(
+		c.ChainBridge.VerifyBlock(ctx, header, height),
+	)
+}
+
+// CurrentHeight return the current height of the main chain.
+func (c *breakerChainBridge) CurrentHeight(ctx context.Context) (uint32,
+	error) {
+
+	height, err := c.ChainBridge.CurrentHeight(ctx)
+
+	return height, c.breaker.observe(err)
+}
+
+// PublishTransaction attempts to publish a new transaction to the network.
+func (c *breakerChainBridge) PublishTransaction(ctx context.Context,
+	tx *wire.MsgTx) error {
+
+	return c.breaker.observe(c.ChainBridge.PublishTransaction(ctx, tx))
+}
+
+// EstimateFee returns a fee estimate for the confirmation target.
+func (c *breakerChainBridge) EstimateFee(ctx context.Context,
+	confTarget uint32) (chainfee.SatPerKWeight, error) {
+
+	feeRate, err := c.ChainBridge.EstimateFee(ctx, confTarget)
+
+	return feeRate, c.breaker.observe(err)
+}
+
+// breakerWallet is a WalletAnchor that reports the outcome of the calls made
+// by the porter to a backend breaker.
+type breakerWallet struct {
+	WalletAnchor
+
+	breaker *backendBreaker
+}
+
+// ImportTaprootOutput imports a new taproot output key into the wallet.
+func (w *breakerWallet) ImportTaprootOutput(ctx context.Context,
+	pub *btcec.PublicKey) (btcutil.Address, error) {
+
+	addr, err := w.WalletAnchor.ImportTaprootOutput(ctx, pub)
+
+	return addr, w.breaker.observe(err)
+}
@@ -0,0 +1,90 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBackendBreaker tests that the backend breaker trips after the threshold
+// of consecutive failures and is reset once the backend recovered.
+func TestBackendBreaker(t *testing.T) {
+	t.Parallel()
+
+	var (
+		quit        = make(chan struct{})
+		wg          sync.WaitGroup
+		backendDown atomic.Bool
+		errBackend  = errors.New("backend down")
+	)
+	defer func() {
+		close(quit)
+		wg.Wait()
+	}()
+
+	backendDown.Store(true)
+	probe := func(context.Context) error {
+		if backendDown.Load() {
+			return errBackend
+		}
+
+		return nil
+	}
+	breaker := newBackendBreaker(
+		3, time.Millisecond, 10*time.Millisecond, probe, quit, &wg,
+	)
+
+	// Failures are wrapped, so they can be recognized as backend errors.
+	err := breaker.observe(errBackend)
+	var backendErr *BackendError
+	require.ErrorAs(t, err, &backendErr)
+	require.ErrorIs(t, err, errBackend)
+
+	// Canceled calls and successes don't count towards the threshold.
+	require.ErrorIs(
+		t, breaker.observe(context.Canceled), context.Canceled,
+	)
+	require.NoError(t, breaker.observe(nil))
+
+	_ = breaker.observe(errBackend)
+	_ = breaker.observe(errBackend)
+	require.False(t, breaker.isTripped())
+
+	// The third consecutive failure trips the breaker.
+	_ = breaker.observe(errBackend)
+	_, err = fn.RecvOrTimeout(breaker.stateChanged(), time.Second)
+	require.NoError(t, err)
+
+	tripped, lastErr := breaker.state()
+	require.True(t, tripped)
+	require.ErrorIs(t, lastErr, errBackend)
+
+	// Retries wait until the backend recovered, which is detected by the
+	// probe.
+	retryReady := breaker.retryReady()
+	select {
+	case <-retryReady:
+		t.Fatalf("retry ready while backend is down")
+
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	backendDown.Store(false)
+	_, err = fn.RecvOrTimeout(retryReady, time.Second)
+	require.NoError(t, err)
+	_, err = fn.RecvOrTimeout(breaker.stateChanged(), time.Second)
+	require.NoError(t, err)
+	require.False(t, breaker.isTripped())
+
+	// A nil breaker passes errors through and is never tripped.
+	var nilBreaker *backendBreaker
+	require.Equal(t, errBackend, nilBreaker.observe(errBackend))
+	require.False(t, nilBreaker.isTripped())
+	require.Nil(t, nilBreaker.stateChanged())
+}
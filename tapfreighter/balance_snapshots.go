@@ -0,0 +1,185 @@
+package tapfreighter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+)
+
+const (
+	// DefaultBalanceSnapshotInterval is the default interval in which the
+	// balance of each asset is snapshotted.
+	DefaultBalanceSnapshotInterval = time.Hour
+
+	// defaultSnapshotTimeout is the timeout of a single snapshot.
+	defaultSnapshotTimeout = time.Minute
+)
+
+// BalanceSnapshot is the confirmed balance of an asset as it was at a given
+// block height.
+type BalanceSnapshot struct {
+	// AssetID is the ID of the asset.
+	AssetID asset.ID
+
+	// Height is the block height the snapshot was taken at.
+	Height uint32
+
+	// TakenAt is the time the snapshot was taken.
+	TakenAt time.Time
+
+	// Balance is the total amount of the confirmed, unspent asset UTXOs.
+	Balance uint64
+
+	// UTXOCount is the number of confirmed, unspent asset UTXOs.
+	UTXOCount uint32
+}
+
+// BalanceSnapshotQuery selects the snapshots that describe the balances as of
+// a given block height or time.
+type BalanceSnapshotQuery struct {
+	// AssetID, if set, restricts the query to a single asset.
+	AssetID *asset.ID
+
+	// MaxHeight is the block height the balances are queried for. If zero,
+	// the height isn't restricted.
+	MaxHeight uint32
+
+	// MaxTime is the time the balances are queried for. If zero, the time
+	// isn't restricted.
+	MaxTime time.Time
+}
+
+// BalanceSnapshotStore stores and queries balance snapshots.
+type BalanceSnapshotStore interface {
+	// SnapshotBalances snapshots the confirmed balance of all assets at
+	// the given block height. Only balances that changed since the
+	// previous snapshot are stored, and the stored snapshots are
+	// returned.
+	SnapshotBalances(ctx context.Context,
+		height uint32) ([]BalanceSnapshot, error)
+
+	// QueryBalanceSnapshots returns the most recent snapshot of each asset
+	// that matches the query. Assets with a zero balance at that point are
+	// omitted.
+	QueryBalanceSnapshots(ctx context.Context,
+		query BalanceSnapshotQuery) ([]BalanceSnapshot, error)
+}
+
+// BalanceSnapshotterConfig is the configuration of the balance snapshotter.
+type BalanceSnapshotterConfig struct {
+	// Store is the store the snapshots are written to.
+	Store BalanceSnapshotStore
+
+	// ChainBridge is used to fetch the current block height.
+	ChainBridge tapgarden.ChainBridge
+
+	// Interval is the interval in which the balances are snapshotted.
+	Interval time.Duration
+}
+
+// BalanceSnapshotter periodically snapshots the confirmed balance of each
+// asset, so historical balances can be queried without replaying all
+// transfers.
+type BalanceSnapshotter struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *BalanceSnapshotterConfig
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewBalanceSnapshotter creates a new balance snapshotter from the given
+// config.
+func NewBalanceSnapshotter(
+	cfg *BalanceSnapshotterConfig) *BalanceSnapshotter {
+
+	return &BalanceSnapshotter{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts the periodic snapshotting.
+func (b *BalanceSnapshotter) Start() error {
+	b.startOnce.Do(func() {
+		log.Infof("Starting balance snapshotter")
+
+		b.wg.Add(1)
+		go b.snapshotPeriodically()
+	})
+
+	return nil
+}
+
+// Stop stops the periodic snapshotting.
+func (b *BalanceSnapshotter) Stop() error {
+	b.stopOnce.Do(func() {
+		log.Infof("Stopping balance snapshotter")
+
+		close(b.quit)
+		b.wg.Wait()
+	})
+
+	return nil
+}
+
+// snapshotPeriodically snapshots the balances in the configured interval.
+//
+// NOTE: This method MUST be called as a goroutine.
+func (b *BalanceSnapshotter) snapshotPeriodically() {
+	defer b.wg.Done()
+
+	interval := b.cfg.Interval
+	if interval == 0 {
+		interval = DefaultBalanceSnapshotInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-b.quit:
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(
+			context.Background(), defaultSnapshotTimeout,
+		)
+		_, err := b.Snapshot(ctx)
+		cancel()
+		if err != nil {
+			log.Errorf("Unable to snapshot balances: %v", err)
+		}
+	}
+}
+
+// Snapshot snapshots the confirmed balance of all assets at the current block
+// height once.
+func (b *BalanceSnapshotter) Snapshot(
+	ctx context.Context) ([]BalanceSnapshot, error) {
+
+	height, err := b.cfg.ChainBridge.CurrentHeight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch current height: %w",
+			err)
+	}
+
+	snapshots, err := b.cfg.Store.SnapshotBalances(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store balance snapshots: %w",
+			err)
+	}
+
+	log.Debugf("Snapshotted balances at height %d, %d balances changed",
+		height, len(snapshots))
+
+	return snapshots, nil
+}
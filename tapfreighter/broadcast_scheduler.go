@@ -0,0 +1,217 @@
+package tapfreighter
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+const (
+	// broadcastTriggerInterval is the interval at which the broadcast
+	// triggers of scheduled parcels are checked.
+	broadcastTriggerInterval = 10 * time.Second
+)
+
+var (
+	// ErrScheduledParcelNotFound is returned when a scheduled parcel is
+	// looked up that isn't held back by the porter.
+	ErrScheduledParcelNotFound = errors.New("scheduled parcel not found")
+
+	// ErrParcelCanceled is returned for a scheduled parcel that was
+	// canceled before its anchor transaction was broadcast.
+	ErrParcelCanceled = errors.New("parcel canceled before broadcast")
+)
+
+// BroadcastTrigger is the earliest point at which the anchor transaction of a
+// parcel may be broadcast. If both a height and a time are set, the anchor
+// transaction is only broadcast once both are reached.
+type BroadcastTrigger struct {
+	// Height is the earliest block height of the main chain at which the
+	// anchor transaction is broadcast. Zero means there is no height
+	// constraint.
+	Height uint32
+
+	// Time is the earliest time at which the anchor transaction is
+	// broadcast. The zero time means there is no time constraint.
+	Time time.Time
+}
+
+// Reached returns true if the trigger is reached at the given height of the
+// main chain and the given time.
+func (b *BroadcastTrigger) Reached(height uint32, now time.Time) bool {
+	if height < b.Height {
+		return false
+	}
+
+	return b.Time.IsZero() || !now.Before(b.Time)
+}
+
+// String returns a human-readable description of the trigger.
+func (b *BroadcastTrigger) String() string {
+	switch {
+	case b.Height > 0 && !b.Time.IsZero():
+		return fmt.Sprintf("height %d and time %v", b.Height, b.Time)
+
+	case b.Height > 0:
+		return fmt.Sprintf("height %d", b.Height)
+
+	default:
+		return fmt.Sprintf("time %v", b.Time)
+	}
+}
+
+// ScheduledParcel is a parcel that is signed and committed to disk, but whose
+// anchor transaction is held back until its broadcast trigger is reached.
+type ScheduledParcel struct {
+	// ParcelID is the identifier the porter assigned to the parcel.
+	ParcelID uint64
+
+	// AnchorTxid is the ID of the anchor transaction of the parcel.
+	AnchorTxid chainhash.Hash
+
+	// Trigger is the earliest point at which the anchor transaction is
+	// broadcast.
+	Trigger BroadcastTrigger
+
+	// ScheduledAt is the time the parcel was committed to disk.
+	ScheduledAt time.Time
+}
+
+// scheduledParcel is a parcel held back by the broadcast scheduler.
+type scheduledParcel struct {
+	ScheduledParcel
+
+	// canceling is set while the parcel is being canceled, which prevents
+	// its anchor transaction from being broadcast in the meantime.
+	canceling bool
+
+	// canceled is closed once the parcel was canceled.
+	canceled chan struct{}
+}
+
+// broadcastScheduler holds the parcels whose anchor transaction is held back
+// until their broadcast trigger is reached. A parcel either leaves the
+// scheduler to be broadcast or is canceled, never both.
+type broadcastScheduler struct {
+	mtx sync.Mutex
+
+	// parcels are the held back parcels, keyed by their anchor txid.
+	parcels map[chainhash.Hash]*scheduledParcel
+}
+
+// newBroadcastScheduler creates a new, empty broadcast scheduler.
+func newBroadcastScheduler() *broadcastScheduler {
+	return &broadcastScheduler{
+		parcels: make(map[chainhash.Hash]*scheduledParcel),
+	}
+}
+
+// schedule adds the given parcel to the scheduler. The returned channel is
+// closed once the parcel is canceled.
+func (s *broadcastScheduler) schedule(
+	parcel ScheduledParcel) <-chan struct{} {
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	scheduled := &scheduledParcel{
+		ScheduledParcel: parcel,
+		canceled:        make(chan struct{}),
+	}
+	s.parcels[parcel.AnchorTxid] = scheduled
+
+	return scheduled.canceled
+}
+
+// claim removes the parcel with the given anchor txid from the scheduler so
+// its anchor transaction can be broadcast. False is returned if the parcel is
+// being canceled.
+func (s *broadcastScheduler) claim(anchorTxid chainhash.Hash) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	scheduled, ok := s.parcels[anchorTxid]
+	if !ok || scheduled.canceling {
+		return false
+	}
+
+	delete(s.parcels, anchorTxid)
+
+	return true
+}
+
+// remove removes the parcel with the given anchor txid from the scheduler,
+// unless it is being canceled.
+func (s *broadcastScheduler) remove(anchorTxid chainhash.Hash) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	scheduled, ok := s.parcels[anchorTxid]
+	if ok && !scheduled.canceling {
+		delete(s.parcels, anchorTxid)
+	}
+}
+
+// startCancel marks the parcel with the given anchor txid as being canceled,
+// which prevents its anchor transaction from being broadcast until
+// finishCancel is called.
+func (s *broadcastScheduler) startCancel(anchorTxid chainhash.Hash) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	scheduled, ok := s.parcels[anchorTxid]
+	if !ok {
+		return fmt.Errorf("%w: %v", ErrScheduledParcelNotFound,
+			anchorTxid)
+	}
+	if scheduled.canceling {
+		return fmt.Errorf("parcel %v is already being canceled",
+			anchorTxid)
+	}
+
+	scheduled.canceling = true
+
+	return nil
+}
+
+// finishCancel completes the cancellation of the parcel with the given anchor
+// txid. If canceling failed with the given error, the parcel stays scheduled.
+func (s *broadcastScheduler) finishCancel(anchorTxid chainhash.Hash,
+	err error) {
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	scheduled, ok := s.parcels[anchorTxid]
+	if !ok {
+		return
+	}
+
+	if err != nil {
+		scheduled.canceling = false
+		return
+	}
+
+	delete(s.parcels, anchorTxid)
+	close(scheduled.canceled)
+}
+
+// list returns all scheduled parcels, ordered by their parcel ID.
+func (s *broadcastScheduler) list() []ScheduledParcel {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	parcels := make([]ScheduledParcel, 0, len(s.parcels))
+	for _, scheduled := range s.parcels {
+		parcels = append(parcels, scheduled.ScheduledParcel)
+	}
+	sort.Slice(parcels, func(i, j int) bool {
+		return parcels[i].ParcelID < parcels[j].ParcelID
+	})
+
+	return parcels
+}
@@ -0,0 +1,82 @@
+package tapfreighter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBroadcastTrigger tests that a broadcast trigger is only reached once
+// both its height and its time are reached.
+func TestBroadcastTrigger(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+
+	heightOnly := &BroadcastTrigger{Height: 100}
+	require.False(t, heightOnly.Reached(99, now))
+	require.True(t, heightOnly.Reached(100, now))
+
+	timeOnly := &BroadcastTrigger{Time: now}
+	require.False(t, timeOnly.Reached(0, now.Add(-time.Second)))
+	require.True(t, timeOnly.Reached(0, now))
+
+	both := &BroadcastTrigger{Height: 100, Time: now}
+	require.False(t, both.Reached(100, now.Add(-time.Second)))
+	require.False(t, both.Reached(99, now))
+	require.True(t, both.Reached(101, now.Add(time.Second)))
+}
+
+// TestBroadcastScheduler tests that a scheduled parcel is either claimed for
+// broadcast or canceled, but never both.
+func TestBroadcastScheduler(t *testing.T) {
+	t.Parallel()
+
+	s := newBroadcastScheduler()
+
+	parcel1 := ScheduledParcel{ParcelID: 2, AnchorTxid: test.RandHash()}
+	parcel2 := ScheduledParcel{ParcelID: 1, AnchorTxid: test.RandHash()}
+	canceled1 := s.schedule(parcel1)
+	canceled2 := s.schedule(parcel2)
+
+	require.Equal(t, []ScheduledParcel{parcel2, parcel1}, s.list())
+
+	err := s.startCancel(test.RandHash())
+	require.ErrorIs(t, err, ErrScheduledParcelNotFound)
+
+	// A parcel that is being canceled can't be claimed for broadcast,
+	// until canceling it fails.
+	require.NoError(t, s.startCancel(parcel1.AnchorTxid))
+	require.Error(t, s.startCancel(parcel1.AnchorTxid))
+	require.False(t, s.claim(parcel1.AnchorTxid))
+
+	s.finishCancel(parcel1.AnchorTxid, errors.New("db failure"))
+	require.True(t, s.claim(parcel1.AnchorTxid))
+
+	// A claimed parcel can no longer be canceled.
+	err = s.startCancel(parcel1.AnchorTxid)
+	require.ErrorIs(t, err, ErrScheduledParcelNotFound)
+
+	select {
+	case <-canceled1:
+		t.Fatalf("claimed parcel canceled")
+	default:
+	}
+
+	// Removing a parcel that is being canceled has no effect, so the
+	// cancellation is still completed.
+	require.NoError(t, s.startCancel(parcel2.AnchorTxid))
+	s.remove(parcel2.AnchorTxid)
+	s.finishCancel(parcel2.AnchorTxid, nil)
+
+	select {
+	case <-canceled2:
+	default:
+		t.Fatalf("parcel not canceled")
+	}
+	require.False(t, s.claim(parcel2.AnchorTxid))
+	require.Empty(t, s.list())
+}
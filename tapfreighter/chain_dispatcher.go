@@ -0,0 +1,329 @@
+package tapfreighter
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+const (
+	// epochResubscribeInterval is the time we wait before we attempt to
+	// re-subscribe to block epochs after the subscription failed.
+	epochResubscribeInterval = 5 * time.Second
+)
+
+// confWatcher is a registered request to be notified once a transaction
+// confirms.
+type confWatcher struct {
+	// txid is the hash of the transaction to watch.
+	txid chainhash.Hash
+
+	// nextHeight is the height of the next block that needs to be checked
+	// for the transaction.
+	nextHeight uint32
+
+	// confChan receives the confirmation of the transaction.
+	confChan chan *chainntnfs.TxConfirmation
+
+	// done is closed once the confirmation is no longer needed.
+	done <-chan struct{}
+}
+
+// chainDispatcher multiplexes the chain notifications of all parcels onto a
+// single block epoch subscription. It caches the current height and checks
+// each new block for the anchor transactions of all parcels that wait for a
+// confirmation, so concurrent parcels don't each hold their own notification
+// stream with the chain backend.
+type chainDispatcher struct {
+	chainBridge ChainBridge
+
+	// height is the current height of the main chain, zero if it isn't
+	// known yet.
+	height atomic.Uint32
+
+	// registrations receives new watchers.
+	registrations chan *confWatcher
+
+	// watchers are the registered watchers, keyed by the transaction they
+	// watch. This is only accessed by the dispatcher goroutine.
+	watchers map[chainhash.Hash][]*confWatcher
+}
+
+// newChainDispatcher creates a new chain dispatcher for the given chain
+// backend.
+func newChainDispatcher(chainBridge ChainBridge) *chainDispatcher {
+	return &chainDispatcher{
+		chainBridge:   chainBridge,
+		registrations: make(chan *confWatcher),
+		watchers:      make(map[chainhash.Hash][]*confWatcher),
+	}
+}
+
+// CurrentHeight returns the current height of the main chain. The cached height
+// of the block epoch subscription is used if it is known.
+func (d *chainDispatcher) CurrentHeight(ctx context.Context) (uint32, error) {
+	if height := d.height.Load(); height > 0 {
+		return height, nil
+	}
+
+	return d.chainBridge.CurrentHeight(ctx)
+}
+
+// RegisterConf registers a watcher for the given transaction, which is
+// notified once the transaction confirms in a block at or after the height
+// hint. The watcher is removed once the context is canceled.
+func (d *chainDispatcher) RegisterConf(ctx context.Context,
+	txid chainhash.Hash,
+	heightHint uint32) (<-chan *chainntnfs.TxConfirmation, error) {
+
+	watcher := &confWatcher{
+		txid:       txid,
+		nextHeight: heightHint,
+		confChan:   make(chan *chainntnfs.TxConfirmation, 1),
+		done:       ctx.Done(),
+	}
+
+	select {
+	case d.registrations <- watcher:
+	case <-ctx.Done():
+		return nil, fmt.Errorf("unable to register for confirmation "+
+			"of %v: %w", txid, ctx.Err())
+	}
+
+	return watcher.confChan, nil
+}
+
+// LookupConf scans the blocks from the height hint up to the current height for
+// the given transaction and returns its confirmation. Nil is returned if the
+// transaction isn't confirmed yet. Unlike RegisterConf, this doesn't wait for
+// new blocks.
+func (d *chainDispatcher) LookupConf(ctx context.Context, txid chainhash.Hash,
+	heightHint uint32) (*chainntnfs.TxConfirmation, error) {
+
+	tip, err := d.CurrentHeight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch current height: %w",
+			err)
+	}
+
+	for height := heightHint; height <= tip; height++ {
+		blockHash, block, err := d.fetchBlock(ctx, height)
+		if err != nil {
+			return nil, err
+		}
+
+		for idx, tx := range block.Transactions {
+			if tx.TxHash() != txid {
+				continue
+			}
+
+			return &chainntnfs.TxConfirmation{
+				BlockHash:   &blockHash,
+				BlockHeight: height,
+				TxIndex:     uint32(idx),
+				Tx:          tx,
+				Block:       block,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// run is the main goroutine of the dispatcher. It must be run exactly once and
+// returns once the context is canceled.
+func (d *chainDispatcher) run(ctx context.Context) {
+	var (
+		epochs  chan int32
+		errChan chan error
+		retry   <-chan time.Time
+	)
+	subscribe := func() {
+		var err error
+		epochs, errChan, err = d.chainBridge.RegisterBlockEpochNtfn(ctx)
+		if err != nil {
+			log.Errorf("Unable to subscribe to block epochs, "+
+				"retrying in %v: %v", epochResubscribeInterval,
+				err)
+
+			epochs, errChan = nil, nil
+			retry = time.After(epochResubscribeInterval)
+		}
+	}
+	subscribe()
+
+	for {
+		select {
+		case height, ok := <-epochs:
+			if !ok {
+				epochs, errChan = nil, nil
+				retry = time.After(epochResubscribeInterval)
+				continue
+			}
+			d.newHeight(ctx, uint32(height))
+
+		case err := <-errChan:
+			log.Errorf("Block epoch subscription failed, "+
+				"retrying in %v: %v", epochResubscribeInterval,
+				err)
+
+			epochs, errChan = nil, nil
+			retry = time.After(epochResubscribeInterval)
+
+		case <-retry:
+			retry = nil
+			subscribe()
+
+		case watcher := <-d.registrations:
+			d.watchers[watcher.txid] = append(
+				d.watchers[watcher.txid], watcher,
+			)
+			d.catchUp(ctx)
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// newHeight processes a new block at the given height.
+func (d *chainDispatcher) newHeight(ctx context.Context, height uint32) {
+	// If the new block is at or below the previous tip, there was a
+	// re-org, so all blocks from the new height on need to be checked
+	// again.
+	if height <= d.height.Load() {
+		for _, watchers := range d.watchers {
+			for _, watcher := range watchers {
+				if watcher.nextHeight > height {
+					watcher.nextHeight = height
+				}
+			}
+		}
+	}
+
+	d.height.Store(height)
+	d.catchUp(ctx)
+}
+
+// catchUp checks all blocks up to the current height that weren't checked yet
+// for the transactions of the watchers.
+func (d *chainDispatcher) catchUp(ctx context.Context) {
+	d.pruneWatchers()
+
+	tip := d.height.Load()
+	if tip == 0 || len(d.watchers) == 0 {
+		return
+	}
+
+	startHeight := tip + 1
+	for _, watchers := range d.watchers {
+		for _, watcher := range watchers {
+			if watcher.nextHeight < startHeight {
+				startHeight = watcher.nextHeight
+			}
+		}
+	}
+
+	for height := startHeight; height <= tip; height++ {
+		if len(d.watchers) == 0 {
+			return
+		}
+
+		// If we can't fetch the block, we'll try again once the next
+		// block arrives or a watcher is registered.
+		if err := d.checkBlock(ctx, height); err != nil {
+			log.Errorf("Unable to check block at height %d for "+
+				"confirmations: %v", height, err)
+			return
+		}
+	}
+}
+
+// checkBlock checks the block at the given height for the transactions of all
+// watchers that didn't check the block yet, and notifies the watchers of the
+// transactions it contains.
+func (d *chainDispatcher) checkBlock(ctx context.Context, height uint32) error {
+	blockHash, block, err := d.fetchBlock(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	for idx, tx := range block.Transactions {
+		txid := tx.TxHash()
+
+		var remaining []*confWatcher
+		for _, watcher := range d.watchers[txid] {
+			if watcher.nextHeight > height {
+				remaining = append(remaining, watcher)
+				continue
+			}
+
+			watcher.confChan <- &chainntnfs.TxConfirmation{
+				BlockHash:   &blockHash,
+				BlockHeight: height,
+				TxIndex:     uint32(idx),
+				Tx:          tx,
+				Block:       block,
+			}
+		}
+
+		if len(remaining) == 0 {
+			delete(d.watchers, txid)
+		} else {
+			d.watchers[txid] = remaining
+		}
+	}
+
+	for _, watchers := range d.watchers {
+		for _, watcher := range watchers {
+			if watcher.nextHeight <= height {
+				watcher.nextHeight = height + 1
+			}
+		}
+	}
+
+	return nil
+}
+
+// fetchBlock fetches the block at the given height from the chain backend.
+func (d *chainDispatcher) fetchBlock(ctx context.Context,
+	height uint32) (chainhash.Hash, *wire.MsgBlock, error) {
+
+	blockHash, err := d.chainBridge.GetBlockHash(ctx, int64(height))
+	if err != nil {
+		return chainhash.Hash{}, nil, fmt.Errorf("unable to fetch "+
+			"block hash: %w", err)
+	}
+	block, err := d.chainBridge.GetBlock(ctx, blockHash)
+	if err != nil {
+		return chainhash.Hash{}, nil, fmt.Errorf("unable to fetch "+
+			"block %v: %w", blockHash, err)
+	}
+
+	return blockHash, block, nil
+}
+
+// pruneWatchers removes all watchers whose confirmation is no longer needed.
+func (d *chainDispatcher) pruneWatchers() {
+	for txid, watchers := range d.watchers {
+		var remaining []*confWatcher
+		for _, watcher := range watchers {
+			select {
+			case <-watcher.done:
+			default:
+				remaining = append(remaining, watcher)
+			}
+		}
+
+		if len(remaining) == 0 {
+			delete(d.watchers, txid)
+		} else {
+			d.watchers[txid] = remaining
+		}
+	}
+}
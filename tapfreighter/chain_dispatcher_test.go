@@ -0,0 +1,224 @@
+package tapfreighter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeChain is a chain bridge that serves the blocks it was given.
+type fakeChain struct {
+	*tapgarden.MockChainBridge
+
+	mtx    sync.Mutex
+	blocks map[uint32]*wire.MsgBlock
+}
+
+func newFakeChain() *fakeChain {
+	return &fakeChain{
+		MockChainBridge: tapgarden.NewMockChainBridge(),
+		blocks:          make(map[uint32]*wire.MsgBlock),
+	}
+}
+
+// setBlock sets the block at the given height to one with the given
+// transactions.
+func (f *fakeChain) setBlock(height uint32, txs ...*wire.MsgTx) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	// The nonce makes sure blocks at different heights have distinct
+	// hashes.
+	f.blocks[height] = &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Nonce: height,
+		},
+		Transactions: txs,
+	}
+}
+
+func (f *fakeChain) GetBlockHash(_ context.Context,
+	height int64) (chainhash.Hash, error) {
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	block, ok := f.blocks[uint32(height)]
+	if !ok {
+		return chainhash.Hash{}, fmt.Errorf("no block at height %d",
+			height)
+	}
+
+	return block.BlockHash(), nil
+}
+
+func (f *fakeChain) GetBlock(_ context.Context,
+	hash chainhash.Hash) (*wire.MsgBlock, error) {
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	for _, block := range f.blocks {
+		if block.BlockHash() == hash {
+			return block, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown block %v", hash)
+}
+
+// newTestTx returns a distinct transaction for the given seed.
+func newTestTx(seed byte) *wire.MsgTx {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxOut(&wire.TxOut{
+		Value:    int64(seed),
+		PkScript: []byte{seed},
+	})
+
+	return tx
+}
+
+// requireConf asserts that a confirmation of the transaction at the given
+// height is received.
+func requireConf(t *testing.T, confChan <-chan *chainntnfs.TxConfirmation,
+	tx *wire.MsgTx, height, txIndex uint32) {
+
+	t.Helper()
+
+	select {
+	case conf := <-confChan:
+		require.Equal(t, tx.TxHash(), conf.Tx.TxHash())
+		require.Equal(t, height, conf.BlockHeight)
+		require.Equal(t, txIndex, conf.TxIndex)
+		require.Equal(t, conf.Block.BlockHash(), *conf.BlockHash)
+
+	case <-time.After(time.Second):
+		t.Fatalf("no confirmation of %v received", tx.TxHash())
+	}
+}
+
+// TestChainDispatcher tests that the chain dispatcher caches the current
+// height and notifies all watchers of a transaction from a single block epoch
+// subscription, including confirmations before the registration and after a
+// re-org.
+func TestChainDispatcher(t *testing.T) {
+	chain := newFakeChain()
+	dispatcher := newChainDispatcher(chain)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		dispatcher.run(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	<-chain.BlockEpochSignal
+
+	// Before the first block arrives, the height is fetched from the
+	// chain backend.
+	height, err := dispatcher.CurrentHeight(ctx)
+	require.NoError(t, err)
+	require.Zero(t, height)
+
+	txA, txB, txC := newTestTx(1), newTestTx(2), newTestTx(3)
+	for h := uint32(95); h <= 100; h++ {
+		chain.setBlock(h)
+	}
+	chain.setBlock(98, newTestTx(4), txA)
+	chain.NewBlocks <- 100
+
+	require.Eventually(t, func() bool {
+		height, err := dispatcher.CurrentHeight(ctx)
+		return err == nil && height == 100
+	}, time.Second, 10*time.Millisecond)
+
+	// A transaction that confirmed before the registration is found by
+	// scanning from the height hint.
+	confA, err := dispatcher.RegisterConf(ctx, txA.TxHash(), 95)
+	require.NoError(t, err)
+	requireConf(t, confA, txA, 98, 1)
+
+	// Two parcels waiting for the same transaction are both notified.
+	confB1, err := dispatcher.RegisterConf(ctx, txB.TxHash(), 100)
+	require.NoError(t, err)
+	confB2, err := dispatcher.RegisterConf(ctx, txB.TxHash(), 100)
+	require.NoError(t, err)
+
+	// A watcher whose context is canceled isn't notified anymore.
+	cancelCtx, cancelWatcher := context.WithCancel(ctx)
+	confCanceled, err := dispatcher.RegisterConf(
+		cancelCtx, txB.TxHash(), 100,
+	)
+	require.NoError(t, err)
+	cancelWatcher()
+
+	confC, err := dispatcher.RegisterConf(ctx, txC.TxHash(), 100)
+	require.NoError(t, err)
+
+	chain.setBlock(101, newTestTx(5), txB)
+	chain.NewBlocks <- 101
+
+	requireConf(t, confB1, txB, 101, 1)
+	requireConf(t, confB2, txB, 101, 1)
+	require.Empty(t, confCanceled)
+	require.Empty(t, confC)
+
+	// After a re-org replaces the block at height 101, the new block is
+	// checked as well.
+	chain.setBlock(101, txC)
+	chain.NewBlocks <- 101
+
+	requireConf(t, confC, txC, 101, 0)
+}
+
+// TestChainDispatcherLookupConf tests that a confirmation is looked up in the
+// blocks from the height hint up to the current height without waiting for new
+// blocks.
+func TestChainDispatcherLookupConf(t *testing.T) {
+	ctx := context.Background()
+	chain := newFakeChain()
+	dispatcher := newChainDispatcher(chain)
+
+	txA, txB := newTestTx(1), newTestTx(2)
+	for h := uint32(95); h <= 100; h++ {
+		chain.setBlock(h)
+	}
+	chain.setBlock(97, newTestTx(3), txA)
+	dispatcher.height.Store(100)
+
+	conf, err := dispatcher.LookupConf(ctx, txA.TxHash(), 95)
+	require.NoError(t, err)
+	require.NotNil(t, conf)
+	require.Equal(t, txA.TxHash(), conf.Tx.TxHash())
+	require.EqualValues(t, 97, conf.BlockHeight)
+	require.EqualValues(t, 1, conf.TxIndex)
+	require.Equal(t, conf.Block.BlockHash(), *conf.BlockHash)
+
+	// A transaction that confirmed before the height hint or not at all
+	// isn't found.
+	conf, err = dispatcher.LookupConf(ctx, txA.TxHash(), 98)
+	require.NoError(t, err)
+	require.Nil(t, conf)
+
+	conf, err = dispatcher.LookupConf(ctx, txB.TxHash(), 95)
+	require.NoError(t, err)
+	require.Nil(t, conf)
+
+	// A block that can't be fetched fails the lookup.
+	_, err = dispatcher.LookupConf(ctx, txB.TxHash(), 90)
+	require.Error(t, err)
+}
@@ -3,16 +3,21 @@ package tapfreighter
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/proof"
@@ -20,6 +25,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/tappsbt"
 	"github.com/lightninglabs/taproot-assets/tapscript"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 // ChainPorterConfig is the main config for the chain porter.
@@ -32,12 +38,34 @@ type ChainPorterConfig struct {
 	// transaction we create.
 	TxValidator tapscript.TxValidator
 
-	// ExportLog is used to log information about pending parcels to disk.
-	ExportLog ExportLog
+	// TransferLog is used to log new pending parcels to disk.
+	TransferLog TransferLog
+
+	// PendingParcels is used to look up the pending parcels that need to
+	// be resumed on startup. It must reflect the parcels logged through
+	// the TransferLog and confirmed through the DeliveryLog.
+	PendingParcels PendingParcelStore
+
+	// DeliveryLog is used to mark pending parcels as confirmed on disk.
+	DeliveryLog DeliveryLog
+
+	// ConfirmedParcels is used to look up confirmed parcels when their
+	// proofs are regenerated. If nil, proofs can't be regenerated.
+	ConfirmedParcels ConfirmedParcelStore
+
+	// ParcelRequests is used to persist accepted address parcels until
+	// they are committed to disk by the TransferLog, which must remove
+	// the request in the same transaction. If nil, parcels that weren't
+	// committed yet are lost on restart.
+	ParcelRequests ParcelRequestLog
 
 	// ChainBridge is our bridge to the chain we operate on.
 	ChainBridge ChainBridge
 
+	// FeeEstimator is used to estimate the fee rate of anchor
+	// transactions. If nil, the fee estimates of the ChainBridge are used.
+	FeeEstimator FeeEstimator
+
 	// Wallet is used to fund+sign PSBTs for the transfer transaction.
 	Wallet WalletAnchor
 
@@ -63,6 +91,128 @@ type ChainPorterConfig struct {
 	// to be confirmed safely with a minimum number of confirmations.
 	ProofWatcher proof.Watcher
 
+	// NumParcelWorkers is the number of normal and batchable parcels that
+	// are signed and broadcast concurrently. If zero,
+	// DefaultParcelWorkers is used.
+	NumParcelWorkers int
+
+	// NumUrgentParcelWorkers is the number of workers dedicated to urgent
+	// parcels. If zero, DefaultUrgentParcelWorkers is used.
+	NumUrgentParcelWorkers int
+
+	// ParcelBatchInterval is the interval at which held back batchable
+	// parcels are released to the workers. If zero, batchable parcels are
+	// treated like normal parcels that are scheduled after all other
+	// normal parcels.
+	ParcelBatchInterval time.Duration
+
+	// BatchDeferFeeRate is the mempool fee rate above which the release of
+	// held back batchable parcels is deferred to the next batch interval,
+	// up to maxBatchDeferrals times in a row. The mempool fee rate is
+	// the lowest fee rate of the next block if the chain bridge reports a
+	// fee histogram, or the minimum fee rate of the mempool otherwise. If
+	// zero, or if the chain bridge doesn't report the mempool state,
+	// batches are never deferred.
+	BatchDeferFeeRate chainfee.SatPerKWeight
+
+	// MaxInFlightParcels is the maximum number of parcels that are in
+	// flight at once, from their acceptance until their proofs were
+	// delivered. Once it is reached, RequestShipment blocks until another
+	// parcel completes. Parcels resumed after a restart are always
+	// accepted. If zero, the number isn't limited.
+	MaxInFlightParcels int
+
+	// MaxPassiveProofs is the maximum number of passive asset proofs that
+	// all parcels in flight hold in memory together. A parcel waits before
+	// it is funded until its passive assets fit into the budget, and fails
+	// if they exceed the whole budget. If zero, the number isn't limited.
+	MaxPassiveProofs int
+
+	// MaxParcelProofBytes is the maximum number of proof bytes a single
+	// parcel may buffer while its proofs are assembled and delivered.
+	// Parcels that would exceed it fail before they are funded. If zero,
+	// the size isn't limited.
+	MaxParcelProofBytes int64
+
+	// ReceiverProbeMode determines whether the receivers of a transfer are
+	// probed through the proof courier before the anchor transaction is
+	// funded.
+	ReceiverProbeMode ReceiverProbeMode
+
+	// ReceiverProbeTimeout is the maximum time a single receiver probe may
+	// take. If zero, DefaultReceiverProbeTimeout is used.
+	ReceiverProbeTimeout time.Duration
+
+	// AnchorKeyLog is used to look up the anchor outputs that were already
+	// created with an internal key. If nil, anchor internal keys aren't
+	// checked for reuse.
+	AnchorKeyLog AnchorKeyLog
+
+	// AnchorKeyReuseMode determines whether transfers that reuse a wallet
+	// internal key for a new anchor output are refused or only logged.
+	AnchorKeyReuseMode AnchorKeyReuseMode
+
+	// CounterpartyBook is used to resolve the names of the counterparties
+	// the outputs of a transfer are sent to. If nil, counterparties aren't
+	// resolved.
+	CounterpartyBook address.CounterpartyBook
+
+	// StrictCounterparties, if set, makes the porter log a warning for
+	// every output of a transfer that is sent to a script key that isn't
+	// in the counterparty book.
+	StrictCounterparties bool
+
+	// StateHooks are the hooks that run before or after the send states
+	// of each parcel. If nil, no hooks are run.
+	StateHooks *StateHooks
+
+	// ScriptKeyAuditLog is used to refuse transfers to script keys that
+	// were flagged for reuse by the script key audit. If nil, script keys
+	// aren't checked.
+	ScriptKeyAuditLog ScriptKeyAuditLog
+
+	// CompletionHandler is called synchronously after the delivery of
+	// each parcel was confirmed on disk, before the parcel is reported as
+	// complete. If nil, no handler is called.
+	CompletionHandler CompletionHandler
+
+	// FaultHooks are optional fault injection hooks of the send pipeline,
+	// which are only honored if built with the faultinject build tag.
+	FaultHooks *FaultHooks
+
+	// ImportedKeys is used to recognize outputs sent to externally derived
+	// script keys that were imported as our own. If nil, only script keys
+	// derived by the lnd node are recognized.
+	ImportedKeys address.ImportedKeyStore
+
+	// BackendFailureThreshold is the number of consecutive failed chain
+	// backend or wallet calls after which the porter stops starting new
+	// parcels and probes the backend until it recovers. Committed parcels
+	// that fail because of the backend are retried instead of failed. If
+	// zero, backend failures aren't tracked.
+	BackendFailureThreshold int
+
+	// BackendRetryInitialBackoff is the initial time to wait before a
+	// failed backend call is retried. If zero,
+	// DefaultBackendRetryInitialBackoff is used.
+	BackendRetryInitialBackoff time.Duration
+
+	// BackendRetryMaxBackoff is the maximum time to wait between probes
+	// of an unavailable backend. If zero, DefaultBackendRetryMaxBackoff is
+	// used.
+	BackendRetryMaxBackoff time.Duration
+
+	// FeeBumper is used to raise the fee of unconfirmed anchor
+	// transactions of parcels with a confirmation deadline. If nil, the
+	// fee of such parcels isn't bumped, but missed deadlines are still
+	// reported.
+	FeeBumper FeeBumper
+
+	// VerifyProofsBeforeBroadcast, if set, makes the porter assemble the
+	// final proofs of each transfer against a dry run block and verify
+	// them before the anchor transaction is committed and broadcast.
+	VerifyProofsBeforeBroadcast bool
+
 	// ErrChan is the main error channel the custodian will report back
 	// critical errors to the main server.
 	ErrChan chan<- error
@@ -79,15 +229,59 @@ type ChainPorter struct {
 
 	cfg *ChainPorterConfig
 
+	// chainBridge and wallet are the chain backend and wallet of the
+	// config, which report their calls to the breaker if one is set.
+	chainBridge ChainBridge
+	wallet      WalletAnchor
+
+	// feeEstimator estimates the fee rate of anchor transactions. It is
+	// either the configured fee estimator or the chain bridge.
+	feeEstimator FeeEstimator
+
+	// breaker is the optional circuit breaker that pauses the start of
+	// new parcels while the backend is unavailable.
+	breaker *backendBreaker
+
 	exportReqs chan Parcel
 
-	// subscribers is a map of components that want to be notified on new
-	// events, keyed by their subscription ID.
-	subscribers map[uint64]*fn.EventReceiver[fn.Event]
+	// queue holds the parcels that wait for a free worker.
+	queue *parcelQueue
+
+	// budget limits the parcels in flight and the passive asset proofs
+	// they hold in memory.
+	budget *parcelBudget
+
+	// workerDone is signaled whenever a worker is freed up, so the next
+	// queued parcel can be started.
+	workerDone chan struct{}
+
+	// eventBus delivers the events of the porter and its proof courier to
+	// all subscribers.
+	eventBus *fn.EventBus[fn.Event]
+
+	// chainDispatcher multiplexes the chain notifications of all parcels
+	// onto a single block epoch subscription.
+	chainDispatcher *chainDispatcher
+
+	// parcelSeq is the identifier that was assigned to the most recently
+	// received parcel.
+	parcelSeq atomic.Uint64
+
+	// eventSeq is the sequence number of the most recently created send
+	// state event.
+	eventSeq atomic.Uint64
 
-	// subscriberMtx guards the subscribers map and access to the
-	// subscriptionID.
-	subscriberMtx sync.Mutex
+	// parcelLogs holds the structured logs of the parcels and the parcels
+	// that have scoped debug logging enabled.
+	parcelLogs *parcelLogs
+
+	// scheduler holds the committed parcels whose anchor transaction is
+	// held back until their broadcast trigger is reached.
+	scheduler *broadcastScheduler
+
+	// storedProofs signals parcels that spend the unconfirmed change of
+	// other parcels once the proofs of those were stored.
+	storedProofs *storedProofSignals
 
 	*fn.ContextGuard
 }
@@ -95,18 +289,77 @@ type ChainPorter struct {
 // NewChainPorter creates a new instance of the ChainPorter given a valid
 // config.
 func NewChainPorter(cfg *ChainPorterConfig) *ChainPorter {
-	subscribers := make(
-		map[uint64]*fn.EventReceiver[fn.Event],
-	)
-	return &ChainPorter{
+	numWorkers := cfg.NumParcelWorkers
+	if numWorkers == 0 {
+		numWorkers = DefaultParcelWorkers
+	}
+	numUrgentWorkers := cfg.NumUrgentParcelWorkers
+	if numUrgentWorkers == 0 {
+		numUrgentWorkers = DefaultUrgentParcelWorkers
+	}
+
+	p := &ChainPorter{
 		cfg:         cfg,
+		chainBridge: cfg.ChainBridge,
+		wallet:      cfg.Wallet,
 		exportReqs:  make(chan Parcel),
-		subscribers: subscribers,
+		queue: newParcelQueue(
+			numWorkers, numUrgentWorkers,
+			cfg.ParcelBatchInterval > 0,
+		),
+		workerDone: make(chan struct{}, 1),
+		budget: newParcelBudget(
+			cfg.MaxInFlightParcels, cfg.MaxPassiveProofs,
+		),
+		eventBus: fn.NewEventBus(
+			fn.DefaultEventBusBufferSize,
+			fn.WithDropMarker(fn.MarkDroppedHistory),
+		),
+		parcelLogs:   newParcelLogs(),
+		scheduler:    newBroadcastScheduler(),
+		storedProofs: newStoredProofSignals(),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: tapgarden.DefaultTimeout,
 			Quit:           make(chan struct{}),
 		},
 	}
+
+	// If backend failures are tracked, all calls the porter makes to the
+	// chain backend and wallet are reported to the breaker. The backend
+	// is probed by querying the current height.
+	if cfg.BackendFailureThreshold > 0 {
+		p.breaker = newBackendBreaker(
+			cfg.BackendFailureThreshold,
+			cfg.BackendRetryInitialBackoff,
+			cfg.BackendRetryMaxBackoff,
+			func(ctx context.Context) error {
+				_, err := cfg.ChainBridge.CurrentHeight(ctx)
+				return err
+			}, p.Quit, &p.Wg,
+		)
+		p.chainBridge = &breakerChainBridge{
+			ChainBridge: cfg.ChainBridge,
+			breaker:     p.breaker,
+		}
+		p.wallet = &breakerWallet{
+			WalletAnchor: cfg.Wallet,
+			breaker:      p.breaker,
+		}
+	}
+	p.chainDispatcher = newChainDispatcher(p.chainBridge)
+
+	p.feeEstimator = cfg.FeeEstimator
+	if p.feeEstimator == nil {
+		p.feeEstimator = p.chainBridge
+	}
+
+	// The proof courier publishes its events to the subscribers of the
+	// porter.
+	if cfg.ProofCourier != nil {
+		cfg.ProofCourier.SetEventBus(p.eventBus)
+	}
+
+	return p
 }
 
 // Start kicks off the chain porter and any goroutines it needs to carry out
@@ -120,12 +373,24 @@ func (p *ChainPorter) Start() error {
 		p.Wg.Add(1)
 		go p.assetsPorter()
 
+		// Start the dispatcher that shares a single block epoch
+		// subscription between all parcels.
+		p.Wg.Add(1)
+		go func() {
+			defer p.Wg.Done()
+
+			ctx, cancel := p.WithCtxQuitNoTimeout()
+			defer cancel()
+
+			p.chainDispatcher.run(ctx)
+		}()
+
 		// Identify any pending parcels that need to be resumed and add
 		// them to the exportReqs channel so they can be processed by
 		// the main porter goroutine.
 		ctx, cancel := p.WithCtxQuit()
 		defer cancel()
-		outboundParcels, err := p.cfg.ExportLog.PendingParcels(ctx)
+		outboundParcels, err := p.cfg.PendingParcels.PendingParcels(ctx)
 		if err != nil {
 			startErr = err
 			return
@@ -135,15 +400,32 @@ func (p *ChainPorter) Start() error {
 		// by converting the outbound parcels into pending parcels.
 		for idx := range outboundParcels {
 			outboundParcel := outboundParcels[idx]
+			anchorTxid := outboundParcel.AnchorTx.TxHash()
+
+			// Parcels persisted by a previous version are migrated
+			// before they're resumed. A parcel we can't migrate
+			// stays pending, so it can be resumed by a compatible
+			// version.
+			err := p.migratePendingParcel(ctx, outboundParcel)
+			if err != nil {
+				log.Errorf("Unable to resume delivery for "+
+					"anchor_txid=%v: %v", anchorTxid, err)
+				continue
+			}
+
 			log.Infof("Attempting to resume delivery for "+
-				"anchor_txid=%v",
-				outboundParcel.AnchorTx.TxHash().String())
+				"anchor_txid=%v", anchorTxid.String())
 
 			// At this point the asset porter should be running.
 			// It should therefore pick up the pending parcels from
 			// the channel and attempt to deliver them.
 			p.exportReqs <- NewPendingParcel(outboundParcel)
 		}
+
+		if err := p.resumeParcelRequests(ctx); err != nil {
+			startErr = err
+			return
+		}
 	})
 
 	return startErr
@@ -151,27 +433,35 @@ func (p *ChainPorter) Start() error {
 
 // Stop signals that the chain porter should gracefully stop.
 func (p *ChainPorter) Stop() error {
-	var stopErr error
 	p.stopOnce.Do(func() {
 		close(p.Quit)
 		p.Wg.Wait()
 
 		// Remove all subscribers.
-		for _, sub := range p.subscribers {
-			err := p.RemoveSubscriber(sub)
-			if err != nil {
-				stopErr = err
-				break
-			}
-		}
+		p.eventBus.Stop()
 	})
 
-	return stopErr
+	return nil
 }
 
 // RequestShipment is the main external entry point to the porter. This request
-// a new transfer take place.
+// a new transfer take place. If a ParcelRequestLog is configured, address
+// parcels are persisted before they're accepted, so they're re-driven after a
+// restart even if the caller didn't receive a response.
 func (p *ChainPorter) RequestShipment(req Parcel) (*OutboundParcel, error) {
+	// We only accept the parcel once it fits into the budget of parcels
+	// in flight, which pushes back on callers while the porter is busy.
+	share, err := p.budget.reserveParcel(p.Quit)
+	if err != nil {
+		return nil, err
+	}
+	req.kit().budget = share
+
+	if err := p.logParcelRequest(req); err != nil {
+		p.budget.release(share)
+		return nil, err
+	}
+
 	if !fn.SendOrQuit(p.exportReqs, req, p.Quit) {
 		return nil, fmt.Errorf("ChainPorter shutting down")
 	}
@@ -188,24 +478,222 @@ func (p *ChainPorter) RequestShipment(req Parcel) (*OutboundParcel, error) {
 	}
 }
 
+// migratePendingParcel migrates the given pending parcel to the latest parcel
+// version and persists the new version.
+func (p *ChainPorter) migratePendingParcel(ctx context.Context,
+	parcel *OutboundParcel) error {
+
+	fromVersion := parcel.Version
+	migrated, err := MigrateParcel(parcel)
+	if err != nil {
+		return err
+	}
+	if !migrated {
+		return nil
+	}
+
+	anchorTxid := parcel.AnchorTx.TxHash()
+	err = p.cfg.PendingParcels.UpdateParcelVersion(
+		ctx, anchorTxid, parcel.Version,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to update parcel version: %w", err)
+	}
+
+	log.Infof("Migrated pending parcel anchor_txid=%v from version %d "+
+		"to %d", anchorTxid, fromVersion, parcel.Version)
+
+	return nil
+}
+
+// logParcelRequest persists the given parcel if it is an address parcel that
+// wasn't persisted yet, so it is re-driven after a restart.
+func (p *ChainPorter) logParcelRequest(req Parcel) error {
+	addrParcel, ok := req.(*AddressParcel)
+	if !ok || p.cfg.ParcelRequests == nil ||
+		addrParcel.requestID != nil {
+
+		return nil
+	}
+
+	var requestID [32]byte
+	if _, err := rand.Read(requestID[:]); err != nil {
+		return fmt.Errorf("unable to generate request ID: %w", err)
+	}
+
+	ctx, cancel := p.WithCtxQuit()
+	defer cancel()
+	err := p.cfg.ParcelRequests.LogParcelRequest(ctx, &ParcelRequest{
+		ID:        requestID,
+		DestAddrs: addrParcel.destAddrs,
+		Priority:  addrParcel.priority,
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to log parcel request: %w", err)
+	}
+
+	addrParcel.requestID = &requestID
+
+	return nil
+}
+
+// resumeParcelRequests re-drives all persisted parcel requests that weren't
+// committed to disk before the last shutdown. Requests that were committed are
+// removed together with logging the parcel, so they are never sent twice.
+func (p *ChainPorter) resumeParcelRequests(ctx context.Context) error {
+	if p.cfg.ParcelRequests == nil {
+		return nil
+	}
+
+	requests, err := p.cfg.ParcelRequests.PendingParcelRequests(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch parcel requests: %w", err)
+	}
+	if len(requests) == 0 {
+		return nil
+	}
+
+	// The coins selected for the interrupted requests are still leased,
+	// so we release them before re-driving the requests from scratch. No
+	// other parcel can hold a lease of the porter at this point, except
+	// for the pending parcels which are kept. BTC outputs leased in lnd
+	// for the interrupted requests stay locked until their lease expires,
+	// the wallet funds the re-driven requests from other outputs.
+	err = p.cfg.ParcelRequests.ReleaseOrphanedLeases(
+		ctx, defaultWalletLeaseIdentifier,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to release orphaned leases: %w", err)
+	}
+
+	for idx := range requests {
+		req := requests[idx]
+		log.Infof("Re-driving parcel request %x to %d addrs", req.ID[:],
+			len(req.DestAddrs))
+
+		// Nobody waits for the outcome of the re-driven parcel, the
+		// response channels are buffered so the porter never blocks.
+		parcel := NewAddressParcel(req.DestAddrs...)
+		parcel.SetPriority(req.Priority)
+		parcel.requestID = &req.ID
+
+		p.exportReqs <- parcel
+	}
+
+	return nil
+}
+
+// deleteParcelRequest removes the persisted request of a parcel that failed
+// before it was committed to disk.
+func (p *ChainPorter) deleteParcelRequest(kit *parcelKit) {
+	if p.cfg.ParcelRequests == nil || kit.requestID == nil {
+		return
+	}
+
+	ctx, cancel := p.WithCtxQuit()
+	defer cancel()
+	err := p.cfg.ParcelRequests.DeleteParcelRequest(ctx, *kit.requestID)
+	if err != nil {
+		log.Errorf("Unable to delete parcel request %x: %v",
+			kit.requestID[:], err)
+	}
+}
+
+// QueueStats returns the queue depth metrics of each parcel priority class.
+func (p *ChainPorter) QueueStats() map[ParcelPriority]ParcelQueueStats {
+	return p.queue.stats()
+}
+
+// SetParcelDebug enables or disables debug logging scoped to the parcel with
+// the given ID, without changing the global log level. The parcel ID can also
+// be one that the porter hasn't received yet.
+func (p *ChainPorter) SetParcelDebug(parcelID uint64, enabled bool) {
+	log.Infof("Setting scoped debug logging of parcel %d to %v",
+		parcelID, enabled)
+
+	p.parcelLogs.setDebug(parcelID, enabled)
+}
+
+// ExportParcelLog returns the structured log of the parcel with the given ID,
+// consisting of the executed states, the backend calls and, if scoped debug
+// logging is enabled, the debug messages of the parcel.
+func (p *ChainPorter) ExportParcelLog(parcelID uint64) (*ParcelLogBundle,
+	error) {
+
+	return p.parcelLogs.export(parcelID)
+}
+
 // assetsPorter is the main goroutine of the ChainPorter. This takes in incoming
 // requests, and attempt to complete a transfer. A response is sent back to the
 // caller if a transfer can be completed. Otherwise, an error is returned.
 func (p *ChainPorter) assetsPorter() {
 	defer p.Wg.Done()
 
+	var (
+		batchTicks     <-chan time.Time
+		batchDeferrals int
+	)
+	if p.cfg.ParcelBatchInterval > 0 {
+		batchTicker := time.NewTicker(p.cfg.ParcelBatchInterval)
+		defer batchTicker.Stop()
+
+		batchTicks = batchTicker.C
+	}
+
 	for {
+		// While the backend is unavailable, new parcels are queued but
+		// not started, so they don't fail one by one.
+		if !p.breaker.isTripped() {
+			p.startQueuedParcels()
+		}
+
 		select {
 		case req := <-p.exportReqs:
-			// The request either has a destination address we want
-			// to send to, or a send package is already initialized.
-			sendPkg := req.pkg()
+			parcelID := p.parcelSeq.Add(1)
+			req.kit().parcelID = parcelID
+			p.parcelLogs.start(parcelID)
+
+			// Parcels that are resumed after a restart didn't go
+			// through RequestShipment, so they are added to the
+			// budget here, together with the passive asset proofs
+			// they already hold.
+			if req.kit().budget == nil {
+				var numPassive int
+				pending, ok := req.(*PendingParcel)
+				if ok {
+					outPkg := pending.outboundPkg
+					numPassive = len(outPkg.PassiveAssets)
+				}
+				req.kit().budget = p.budget.forceReserveParcel(
+					numPassive,
+				)
+			}
 
-			// Advance the state machine for this package as far as
-			// possible in its own goroutine. The status will be
-			// reported through the different channels of the send
-			// package.
-			go p.advanceState(sendPkg, req.kit())
+			priority := req.kit().priority
+			p.parcelLogs.debugf(parcelID, "Queueing new parcel %d "+
+				"with priority %v", parcelID, priority)
+
+			p.queue.push(req)
+
+		case <-p.workerDone:
+
+		case <-batchTicks:
+			if p.deferBatch(batchDeferrals) {
+				batchDeferrals++
+				break
+			}
+			batchDeferrals = 0
+
+			log.Debugf("Releasing batchable parcels")
+
+			p.queue.releaseBatch()
+
+		case <-p.breaker.stateChanged():
+			tripped, err := p.breaker.state()
+			p.publishSubscriberEvent(
+				NewBackendBreakerEvent(tripped, err),
+			)
 
 		case <-p.Quit:
 			return
@@ -213,15 +701,73 @@ func (p *ChainPorter) assetsPorter() {
 	}
 }
 
-// advanceState advances the state machine.
+// startQueuedParcels starts as many queued parcels as there are free workers
+// for, in the order of their priority.
+func (p *ChainPorter) startQueuedParcels() {
+	for {
+		req, slot, ok := p.queue.next()
+		if !ok {
+			return
+		}
+
+		// The request either has a destination address we want to send
+		// to, or a send package is already initialized.
+		sendPkg := req.pkg()
+		sendPkg.ParcelID = req.kit().parcelID
+		sendPkg.budget = req.kit().budget
+
+		// Advance the state machine for this package as far as possible
+		// in its own goroutine. The status will be reported through the
+		// different channels of the send package.
+		go p.advanceState(sendPkg, req.kit(), slot)
+	}
+}
+
+// releaseWorker frees up the worker of the given slot and notifies the main
+// porter goroutine.
+func (p *ChainPorter) releaseWorker(slot parcelSlot) {
+	p.queue.done(slot)
+
+	select {
+	case p.workerDone <- struct{}{}:
+	default:
+	}
+}
+
+// advanceState advances the state machine. The worker of the given slot is
+// held until the transfer transaction is broadcast, waiting for the
+// confirmation doesn't block other parcels.
 //
 // NOTE: This method MUST be called as a goroutine.
-func (p *ChainPorter) advanceState(pkg *sendPackage, kit *parcelKit) {
+func (p *ChainPorter) advanceState(pkg *sendPackage, kit *parcelKit,
+	slot parcelSlot) {
+
+	var releaseOnce sync.Once
+	releaseWorker := func() {
+		releaseOnce.Do(func() {
+			p.releaseWorker(slot)
+		})
+	}
+	defer releaseWorker()
+	defer p.parcelLogs.finish(pkg.ParcelID)
+
+	// Once the state machine is complete, the parcel's share of the budget
+	// is released after its proofs were delivered in the background.
+	defer func() {
+		if pkg.SendState < SendStateComplete {
+			p.budget.release(pkg.budget)
+		}
+	}()
+
 	// Continue state transitions whilst state complete has not yet
 	// been reached.
 	for pkg.SendState < SendStateComplete {
-		log.Infof("ChainPorter executing state: %v",
-			pkg.SendState)
+		if pkg.SendState >= SendStateWaitTxConf {
+			releaseWorker()
+		}
+
+		log.Infof("ChainPorter executing state of parcel %d: %v",
+			pkg.ParcelID, pkg.SendState)
 
 		// Before we attempt a state transition, make sure that
 		// we aren't trying to shut down.
@@ -232,8 +778,43 @@ func (p *ChainPorter) advanceState(pkg *sendPackage, kit *parcelKit) {
 		default:
 		}
 
-		updatedPkg, err := p.stateStep(*pkg)
+		// A scheduled parcel is held back after it was committed to
+		// disk until its broadcast trigger is reached, without
+		// occupying a worker.
+		if pkg.SendState == SendStateBroadcast &&
+			pkg.OutboundPkg.BroadcastTrigger != nil {
+
+			releaseWorker()
+			if err := p.waitForBroadcastTrigger(pkg); err != nil {
+				kit.errChan <- err
+				log.Errorf("Scheduled parcel %d not "+
+					"broadcast: %v", pkg.ParcelID, err)
+				return
+			}
+		}
+
+		stateStart := time.Now()
+		p.parcelLogs.stateStarted(pkg.ParcelID, pkg.SendState)
+
+		updatedPkg, err := p.executeState(*pkg)
+		p.parcelLogs.stateFinished(
+			pkg.ParcelID, pkg.SendState, stateStart, err,
+		)
+		if err != nil && p.retryBackendFailure(pkg, err) {
+			continue
+		}
 		if err != nil {
+			// A parcel that failed before it was committed to
+			// disk is given up on, unless we failed because of a
+			// shutdown, in which case it is re-driven on restart.
+			select {
+			case <-p.Quit:
+			default:
+				if pkg.SendState <= SendStateLogCommit {
+					p.deleteParcelRequest(kit)
+				}
+			}
+
 			kit.errChan <- err
 			log.Errorf("Error evaluating state (%v): %v",
 				pkg.SendState, err)
@@ -244,6 +825,146 @@ func (p *ChainPorter) advanceState(pkg *sendPackage, kit *parcelKit) {
 	}
 }
 
+// executeState executes the current send state of the given package, wrapped
+// by the registered state hooks. An error of a hook that runs after the state
+// fails the parcel just like an error of the state itself, even though the
+// state was already executed.
+func (p *ChainPorter) executeState(pkg sendPackage) (*sendPackage, error) {
+	ctx, cancel := p.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	state := pkg.SendState
+	err := p.cfg.StateHooks.run(ctx, pkg.hookInfo(state, HookBefore))
+	if err != nil {
+		return nil, err
+	}
+
+	updatedPkg, err := p.stateStep(pkg)
+	if err != nil || updatedPkg == nil {
+		return updatedPkg, err
+	}
+
+	err = p.cfg.StateHooks.run(ctx, updatedPkg.hookInfo(state, HookAfter))
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedPkg, nil
+}
+
+// waitForBroadcastTrigger holds back the broadcast of the anchor transaction of
+// the given committed parcel until its broadcast trigger is reached. Until
+// then, the parcel can be canceled, in which case ErrParcelCanceled is
+// returned.
+func (p *ChainPorter) waitForBroadcastTrigger(pkg *sendPackage) error {
+	outboundPkg := pkg.OutboundPkg
+	trigger := *outboundPkg.BroadcastTrigger
+	anchorTxid := outboundPkg.AnchorTx.TxHash()
+
+	canceled := p.scheduler.schedule(ScheduledParcel{
+		ParcelID:    pkg.ParcelID,
+		AnchorTxid:  anchorTxid,
+		Trigger:     trigger,
+		ScheduledAt: outboundPkg.TransferTime,
+	})
+	defer p.scheduler.remove(anchorTxid)
+
+	log.Infof("Holding back broadcast of parcel %d with anchor_txid=%v "+
+		"until %v", pkg.ParcelID, anchorTxid, &trigger)
+
+	ticker := time.NewTicker(broadcastTriggerInterval)
+	defer ticker.Stop()
+
+	for {
+		var (
+			height uint32
+			err    error
+		)
+		if trigger.Height > 0 {
+			ctx, cancel := p.WithCtxQuit()
+			height, err = p.chainDispatcher.CurrentHeight(ctx)
+			cancel()
+		}
+
+		switch {
+		case err != nil:
+			log.Warnf("Unable to check broadcast trigger of "+
+				"parcel %d: %v", pkg.ParcelID, err)
+
+		case trigger.Reached(height, time.Now()) &&
+			p.scheduler.claim(anchorTxid):
+
+			log.Infof("Broadcast trigger of parcel %d reached",
+				pkg.ParcelID)
+
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+
+		case <-canceled:
+			return ErrParcelCanceled
+
+		case <-p.Quit:
+			return fmt.Errorf("ChainPorter shutting down")
+		}
+	}
+}
+
+// ScheduledParcels returns the committed parcels whose anchor transaction is
+// held back until their broadcast trigger is reached.
+func (p *ChainPorter) ScheduledParcels() []ScheduledParcel {
+	return p.scheduler.list()
+}
+
+// CancelScheduledParcel cancels the scheduled parcel with the given anchor
+// txid before its anchor transaction is broadcast. The parcel is removed from
+// disk and the leases of its asset inputs are released. The BTC inputs of the
+// anchor transaction stay leased by the wallet until their lease expires.
+func (p *ChainPorter) CancelScheduledParcel(anchorTxid chainhash.Hash) error {
+	if err := p.scheduler.startCancel(anchorTxid); err != nil {
+		return err
+	}
+
+	ctx, cancel := p.WithCtxQuit()
+	defer cancel()
+
+	err := p.cfg.TransferLog.CancelPendingParcel(ctx, anchorTxid)
+	p.scheduler.finishCancel(anchorTxid, err)
+	if err != nil {
+		return fmt.Errorf("unable to cancel parcel: %w", err)
+	}
+
+	log.Infof("Canceled scheduled parcel with anchor_txid=%v", anchorTxid)
+
+	return nil
+}
+
+// retryBackendFailure returns true if the state step of the given package that
+// failed with the given error should be retried, after waiting until the
+// backend can be retried. Only backend failures of parcels that were already
+// committed to disk are retried, as the earlier states aren't safe to repeat.
+func (p *ChainPorter) retryBackendFailure(pkg *sendPackage, err error) bool {
+	var backendErr *BackendError
+	if p.breaker == nil || pkg.SendState < SendStateLogCommit ||
+		!errors.As(err, &backendErr) {
+
+		return false
+	}
+
+	log.Warnf("Backend unavailable in state %v of parcel %d, waiting to "+
+		"retry: %v", pkg.SendState, pkg.ParcelID, err)
+
+	select {
+	case <-p.breaker.retryReady():
+		return true
+
+	case <-p.Quit:
+		return false
+	}
+}
+
 // waitForTransferTxConf waits for the confirmation of the final transaction
 // within the delta. Once confirmed, the parcel will be marked as delivered on
 // chain, with the goroutine cleaning up its state.
@@ -254,45 +975,170 @@ func (p *ChainPorter) waitForTransferTxConf(pkg *sendPackage) error {
 	log.Infof("Waiting for confirmation of transfer_txid=%v", txHash)
 
 	confCtx, confCancel := p.WithCtxQuitNoTimeout()
-	confNtfn, errChan, err := p.cfg.ChainBridge.RegisterConfirmationsNtfn(
-		confCtx, &txHash, outboundPkg.AnchorTx.TxOut[0].PkScript, 1,
-		outboundPkg.AnchorTxHeightHint, true, nil,
+	defer confCancel()
+
+	start := time.Now()
+	confChan, errChan, err := p.registerTransferTxConf(confCtx, pkg)
+	p.parcelLogs.backendCall(
+		pkg.ParcelID, "RegisterConfirmationsNtfn", start, err,
 	)
 	if err != nil {
 		return fmt.Errorf("unable to register for package tx conf: %w",
 			err)
 	}
 
-	// Launch a goroutine that'll notify us when the transaction confirms.
-	defer confCancel()
+	// If the parcel has a confirmation deadline, we raise the fee of the
+	// anchor transaction according to its escalation schedule until the
+	// deadline is reached.
+	var (
+		deadline    *ConfDeadline
+		escalator   *feeEscalator
+		bumpTicks   <-chan time.Time
+		deadlineHit <-chan time.Time
+	)
+	if pkg.Parcel != nil {
+		deadline = pkg.Parcel.kit().confDeadline
+	}
+	if deadline != nil {
+		deadlineTimer := time.NewTimer(time.Until(deadline.Deadline))
+		defer deadlineTimer.Stop()
+		deadlineHit = deadlineTimer.C
+
+		if p.cfg.FeeBumper != nil {
+			escalator = p.newFeeEscalator(confCtx, pkg, deadline)
+		}
+	}
+	if escalator != nil {
+		bumpTicker := time.NewTicker(deadline.Escalation.interval())
+		defer bumpTicker.Stop()
+		bumpTicks = bumpTicker.C
+	}
 
 	var confEvent *chainntnfs.TxConfirmation
-	select {
-	case confEvent = <-confNtfn.Confirmed:
-		log.Debugf("Got chain confirmation: %v", confEvent.Tx.TxHash())
-		pkg.TransferTxConfEvent = confEvent
-		pkg.SendState = SendStateStoreProofs
+	for confEvent == nil {
+		select {
+		case confEvent = <-confChan:
+			if p.cfg.FaultHooks.dropConfEvent(pkg.ParcelID) {
+				confEvent = nil
+				continue
+			}
 
-	case err := <-errChan:
-		return fmt.Errorf("error whilst waiting for package tx "+
-			"confirmation: %w", err)
+			p.parcelLogs.debugf(pkg.ParcelID, "Got chain "+
+				"confirmation: %v", confEvent.Tx.TxHash())
+			pkg.TransferTxConfEvent = confEvent
+			pkg.SendState = SendStateStoreProofs
 
-	case <-confCtx.Done():
-		log.Debugf("Skipping TX confirmation, context done")
+		case err := <-errChan:
+			return fmt.Errorf("error whilst waiting for package tx "+
+				"confirmation: %w", err)
 
-	case <-p.Quit:
-		log.Debugf("Skipping TX confirmation, exiting")
-		return nil
-	}
+		case <-bumpTicks:
+			mempool := p.mempoolInfo(confCtx)
+			escalator.bump(
+				confCtx, p.cfg.FeeBumper, mempool, pkg.ParcelID,
+			)
+
+		// Once the deadline is missed, we stop bumping the fee but
+		// keep waiting for the confirmation, so the transfer can
+		// still be completed.
+		case <-deadlineHit:
+			var feeRate chainfee.SatPerKWeight
+			if escalator != nil {
+				feeRate = escalator.feeRate
+			}
+
+			log.Warnf("Anchor transaction %v of parcel %d not "+
+				"confirmed by deadline %v", txHash,
+				pkg.ParcelID, deadline.Deadline)
 
-	if confEvent == nil {
-		return fmt.Errorf("got empty package tx confirmation event " +
-			"in batch")
+			p.publishSubscriberEvent(NewConfDeadlineExceededEvent(
+				pkg.ParcelID, txHash, deadline.Deadline,
+				feeRate,
+			))
+			bumpTicks = nil
+			deadlineHit = nil
+
+		case <-confCtx.Done():
+			log.Debugf("Skipping TX confirmation, context done")
+			return fmt.Errorf("got empty package tx confirmation " +
+				"event in batch")
+
+		case <-p.Quit:
+			log.Debugf("Skipping TX confirmation, exiting")
+			return nil
+		}
 	}
 
 	return nil
 }
 
+// registerTransferTxConf registers for the confirmation of the anchor
+// transaction of the package. The confirmation is watched by the shared chain
+// dispatcher, unless the parcel has no height hint, in which case we let the
+// chain backend find the transaction instead of scanning the whole chain.
+func (p *ChainPorter) registerTransferTxConf(ctx context.Context,
+	pkg *sendPackage) (<-chan *chainntnfs.TxConfirmation, <-chan error,
+	error) {
+
+	outboundPkg := pkg.OutboundPkg
+	txHash := outboundPkg.AnchorTx.TxHash()
+
+	if outboundPkg.AnchorTxHeightHint == 0 {
+		chainBridge := p.chainBridge
+		confNtfn, errChan, err := chainBridge.RegisterConfirmationsNtfn(
+			ctx, &txHash, outboundPkg.AnchorTx.TxOut[0].PkScript, 1,
+			outboundPkg.AnchorTxHeightHint, true, nil,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return confNtfn.Confirmed, errChan, nil
+	}
+
+	confChan, err := p.chainDispatcher.RegisterConf(
+		ctx, txHash, outboundPkg.AnchorTxHeightHint,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return confChan, nil, nil
+}
+
+// lookupTransferTxConf returns the confirmation of the anchor transaction of
+// the package if it is already confirmed, or nil otherwise. Without a height
+// hint, we don't scan the whole chain and instead assume the transaction isn't
+// confirmed yet.
+func (p *ChainPorter) lookupTransferTxConf(ctx context.Context,
+	pkg *sendPackage) (*chainntnfs.TxConfirmation, error) {
+
+	outboundPkg := pkg.OutboundPkg
+	if outboundPkg.AnchorTxHeightHint == 0 {
+		return nil, nil
+	}
+
+	txHash := outboundPkg.AnchorTx.TxHash()
+
+	start := time.Now()
+	confEvent, err := p.chainDispatcher.LookupConf(
+		ctx, txHash, outboundPkg.AnchorTxHeightHint,
+	)
+	p.parcelLogs.backendCall(pkg.ParcelID, "LookupConf", start, err)
+	if err != nil {
+		return nil, fmt.Errorf("unable to look up confirmation of "+
+			"transfer_txid=%v: %w", txHash, err)
+	}
+
+	if confEvent != nil {
+		log.Infof("Resumed transfer_txid=%v already confirmed at "+
+			"height %d, skipping broadcast", txHash,
+			confEvent.BlockHeight)
+	}
+
+	return confEvent, nil
+}
+
 // storeProofs writes the updated sender and receiver proof files to the proof
 // archive.
 func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
@@ -303,11 +1149,18 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 	ctx, cancel := p.CtxBlocking()
 	defer cancel()
 
+	// If the parcel spends the change of parcels that weren't confirmed
+	// when it was funded, their proof files are only complete once they
+	// are confirmed as well.
+	if err := p.waitForParentParcels(sendPkg); err != nil {
+		return err
+	}
+
 	parcel := sendPkg.OutboundPkg
 	confEvent := sendPkg.TransferTxConfEvent
 
 	// Use callback to verify that block header exists on chain.
-	headerVerifier := tapgarden.GenHeaderVerifier(ctx, p.cfg.ChainBridge)
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, p.chainBridge)
 
 	// Generate updated passive asset proof files.
 	passiveAssetProofFiles := make(
@@ -363,6 +1216,8 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 		log.Debugf("Not updating proofs as there are no active " +
 			"transfers")
 
+		p.storedProofs.markStored(parcel.AnchorTx.TxHash())
+
 		sendPkg.SendState = SendStateReceiverProofTransfer
 		return nil
 	}
@@ -371,7 +1226,6 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 		map[asset.SerializedKey]*proof.AnnotatedProof,
 		len(parcel.Outputs),
 	)
-	firstInput := parcel.Inputs[0]
 	for idx := range parcel.Outputs {
 		out := parcel.Outputs[idx]
 
@@ -382,70 +1236,11 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 			continue
 		}
 
-		// First, we'll decode the outputs' proof suffix.
-		var proofSuffix proof.Proof
-		err := proofSuffix.Decode(bytes.NewReader(out.ProofSuffix))
-		if err != nil {
-			return fmt.Errorf("error decoding proof suffix %d: %w",
-				idx, err)
-		}
-
-		// The suffix doesn't contain any information about the
-		// confirmed block yet, so we'll add that now.
-		err = proofSuffix.UpdateTransitionProof(&proof.BaseProofParams{
-			Block:       confEvent.Block,
-			BlockHeight: confEvent.BlockHeight,
-			Tx:          confEvent.Tx,
-			TxIndex:     int(confEvent.TxIndex),
-		})
-		if err != nil {
-			return fmt.Errorf("error updating transition proof "+
-				"%d: %w", idx, err)
-		}
-
-		// The suffix is complete, so we need to fetch the input proof
-		// in order to append the suffix to it.
-		inputProofFile, err := p.fetchInputProof(ctx, firstInput)
+		outputProof, proofSuffix, err := p.buildOutputProof(
+			ctx, parcel, idx, confEvent,
+		)
 		if err != nil {
-			return fmt.Errorf("error fetching input proof: %w", err)
-		}
-
-		// Are there more inputs? Then this is a merge, and we need to
-		// add those additional files to the suffix as well.
-		for idx := 1; idx < len(parcel.Inputs); idx++ {
-			additionalInputProofFile, err := p.fetchInputProof(
-				ctx, parcel.Inputs[idx],
-			)
-			if err != nil {
-				return fmt.Errorf("error fetching input "+
-					"proof %d: %w", idx, err)
-			}
-
-			proofSuffix.AdditionalInputs = append(
-				proofSuffix.AdditionalInputs,
-				*additionalInputProofFile,
-			)
-		}
-
-		// With the proof suffix updated, we can append the proof, then
-		// encode it to get the final proof file.
-		var outputProofBuf bytes.Buffer
-		if err := inputProofFile.AppendProof(proofSuffix); err != nil {
-			return fmt.Errorf("error appending proof: %w", err)
-		}
-		if err := inputProofFile.Encode(&outputProofBuf); err != nil {
-			return fmt.Errorf("error encoding proof: %w", err)
-		}
-
-		// Now we just need to identify the new proof correctly before
-		// adding it to the proof archive.
-		outputProofLocator := proof.Locator{
-			AssetID:   &firstInput.ID,
-			ScriptKey: *out.ScriptKey.PubKey,
-		}
-		outputProof := &proof.AnnotatedProof{
-			Locator: outputProofLocator,
-			Blob:    outputProofBuf.Bytes(),
+			return err
 		}
 		serializedScriptKey := asset.ToSerialized(out.ScriptKey.PubKey)
 		sendPkg.FinalProofs[serializedScriptKey] = outputProof
@@ -460,9 +1255,6 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 			return fmt.Errorf("error importing proof: %w", err)
 		}
 
-		log.Debugf("Updated proofs for output %d (new_len=%d)",
-			idx, inputProofFile.NumProofs())
-
 		// The proof is created after a single confirmation. To make
 		// sure we notice if the anchor transaction is re-organized out
 		// of the chain, we give the proof to the re-org watcher and
@@ -473,7 +1265,7 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 		// proof is necessary anyway.
 		if out.ScriptKey.TweakedScriptKey != nil && out.ScriptKeyLocal {
 			err := p.cfg.ProofWatcher.WatchProofs(
-				[]*proof.Proof{&proofSuffix},
+				[]*proof.Proof{proofSuffix},
 				p.cfg.ProofWatcher.DefaultUpdateCallback(),
 			)
 			if err != nil {
@@ -483,10 +1275,99 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 		}
 	}
 
+	// All proofs are stored locally now, so the parcels that spend our
+	// unconfirmed change can append their proofs to them, regardless of
+	// whether our proofs are delivered to the receivers yet.
+	p.storedProofs.markStored(parcel.AnchorTx.TxHash())
+
 	sendPkg.SendState = SendStateReceiverProofTransfer
 	return nil
 }
 
+// buildOutputProof builds the final proof file of the output with the given
+// index of the parcel, by appending the output's proof suffix, updated with the
+// confirmation of the anchor transaction, to the proof file of the first input.
+// The updated proof suffix is returned as well.
+func (p *ChainPorter) buildOutputProof(ctx context.Context,
+	parcel *OutboundParcel, idx int,
+	confEvent *chainntnfs.TxConfirmation) (*proof.AnnotatedProof,
+	*proof.Proof, error) {
+
+	out := parcel.Outputs[idx]
+	firstInput := parcel.Inputs[0]
+
+	// First, we'll decode the outputs' proof suffix.
+	var proofSuffix proof.Proof
+	err := proofSuffix.Decode(bytes.NewReader(out.ProofSuffix))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error decoding proof suffix %d: "+
+			"%w", idx, err)
+	}
+
+	// The suffix doesn't contain any information about the confirmed
+	// block yet, so we'll add that now.
+	err = proofSuffix.UpdateTransitionProof(&proof.BaseProofParams{
+		Block:       confEvent.Block,
+		BlockHeight: confEvent.BlockHeight,
+		Tx:          confEvent.Tx,
+		TxIndex:     int(confEvent.TxIndex),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error updating transition proof "+
+			"%d: %w", idx, err)
+	}
+
+	// The suffix is complete, so we need to fetch the input proof in order
+	// to append the suffix to it.
+	inputProofFile, err := p.fetchInputProof(ctx, firstInput)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error fetching input proof: %w",
+			err)
+	}
+
+	// Are there more inputs? Then this is a merge, and we need to add
+	// those additional files to the suffix as well.
+	for idx := 1; idx < len(parcel.Inputs); idx++ {
+		additionalInputProofFile, err := p.fetchInputProof(
+			ctx, parcel.Inputs[idx],
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error fetching input "+
+				"proof %d: %w", idx, err)
+		}
+
+		proofSuffix.AdditionalInputs = append(
+			proofSuffix.AdditionalInputs,
+			*additionalInputProofFile,
+		)
+	}
+
+	// With the proof suffix updated, we can append the proof, then encode
+	// it to get the final proof file.
+	var outputProofBuf bytes.Buffer
+	if err := inputProofFile.AppendProof(proofSuffix); err != nil {
+		return nil, nil, fmt.Errorf("error appending proof: %w", err)
+	}
+	if err := inputProofFile.Encode(&outputProofBuf); err != nil {
+		return nil, nil, fmt.Errorf("error encoding proof: %w", err)
+	}
+
+	log.Debugf("Updated proofs for output %d (new_len=%d)", idx,
+		inputProofFile.NumProofs())
+
+	// Now we just need to identify the new proof correctly before adding
+	// it to the proof archive.
+	outputProof := &proof.AnnotatedProof{
+		Locator: proof.Locator{
+			AssetID:   &firstInput.ID,
+			ScriptKey: *out.ScriptKey.PubKey,
+		},
+		Blob: outputProofBuf.Bytes(),
+	}
+
+	return outputProof, &proofSuffix, nil
+}
+
 // fetchInputProof fetches a proof for the given input from the proof archive.
 func (p *ChainPorter) fetchInputProof(ctx context.Context,
 	input TransferInput) (*proof.File, error) {
@@ -607,10 +1488,19 @@ func (p *ChainPorter) transferReceiverProof(pkg *sendPackage) error {
 		log.Debugf("Attempting to deliver proof for script key %x",
 			key.SerializeCompressed())
 
+		if err := p.cfg.FaultHooks.delayCourier(ctx); err != nil {
+			return err
+		}
+
+		// Proofs are encrypted to the internal key of the receiver's
+		// anchor output, which, unlike the tweaked script key, the
+		// receiver's wallet can derive a shared secret with.
 		recipient := proof.Recipient{
-			ScriptKey: key,
-			AssetID:   *receiverProof.AssetID,
-			Amount:    out.Amount,
+			ScriptKey:     key,
+			AssetID:       *receiverProof.AssetID,
+			Amount:        out.Amount,
+			OutPoint:      out.Anchor.OutPoint,
+			EncryptionKey: &out.Anchor.InternalKey,
 		}
 		err := p.cfg.ProofCourier.DeliverProof(
 			ctx, recipient, receiverProof,
@@ -627,6 +1517,8 @@ func (p *ChainPorter) transferReceiverProof(pkg *sendPackage) error {
 			return fmt.Errorf("error delivering proof: %w", err)
 		}
 
+		p.awaitReceiverSettlement(recipient)
+
 		return nil
 	}
 
@@ -664,19 +1556,28 @@ func (p *ChainPorter) transferReceiverProof(pkg *sendPackage) error {
 
 	// At this point we have the confirmation signal, so we can mark the
 	// parcel delivery as completed in the database.
-	err := p.cfg.ExportLog.ConfirmParcelDelivery(ctx, &AssetConfirmEvent{
+	confirmEvent := &AssetConfirmEvent{
 		AnchorTXID:             pkg.OutboundPkg.AnchorTx.TxHash(),
 		BlockHash:              *pkg.TransferTxConfEvent.BlockHash,
 		BlockHeight:            int32(pkg.TransferTxConfEvent.BlockHeight),
 		TxIndex:                int32(pkg.TransferTxConfEvent.TxIndex),
 		FinalProofs:            pkg.FinalProofs,
 		PassiveAssetProofFiles: passiveAssetProofFiles,
-	})
+	}
+	err := p.cfg.DeliveryLog.ConfirmParcelDelivery(ctx, confirmEvent)
 	if err != nil {
 		return fmt.Errorf("unable to log parcel delivery "+
 			"confirmation: %w", err)
 	}
 
+	// The parcel isn't pending anymore, so no parcel that spends its change
+	// waits for its proofs to be stored from now on.
+	p.storedProofs.forget(confirmEvent.AnchorTXID)
+
+	// Now that the delivery is on disk, external systems can record the
+	// completed transfer.
+	p.notifyCompletion(ctx, pkg, confirmEvent)
+
 	pkg.SendState = SendStateComplete
 	return nil
 }
@@ -714,7 +1615,7 @@ func (p *ChainPorter) importLocalAddresses(ctx context.Context,
 		// import the new anchor output into the wallet so it watches
 		// it for spends and also takes account of the BTC we used in
 		// the transfer.
-		_, err = p.cfg.Wallet.ImportTaprootOutput(ctx, anchorOutputKey)
+		_, err = p.wallet.ImportTaprootOutput(ctx, anchorOutputKey)
 		switch {
 		case err == nil:
 			break
@@ -733,12 +1634,12 @@ func (p *ChainPorter) importLocalAddresses(ctx context.Context,
 	return nil
 }
 
-// createDummyOutput creates a new Bitcoin transaction output that is later
-// used to embed a Taproot Asset commitment.
-func createDummyOutput() *wire.TxOut {
+// createDummyOutput creates a new Bitcoin transaction output with the given
+// value that is later used to embed a Taproot Asset commitment.
+func createDummyOutput(value btcutil.Amount) *wire.TxOut {
 	// The dummy PkScript is the same size as an encoded P2TR output.
 	newOutput := wire.TxOut{
-		Value:    int64(tapscript.DummyAmtSats),
+		Value:    int64(value),
 		PkScript: make([]byte, 34),
 	}
 	return &newOutput
@@ -749,9 +1650,18 @@ func createDummyOutput() *wire.TxOut {
 func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 	// Notify subscribers that the state machine is about to execute a
 	// state.
-	stateEvent := NewExecuteSendStateEvent(currentPkg.SendState)
+	stateEvent := NewExecuteSendStateEvent(
+		p.eventSeq.Add(1), currentPkg.ParcelID, currentPkg.SendState,
+	)
 	p.publishSubscriberEvent(stateEvent)
 
+	err := p.cfg.FaultHooks.failAtState(
+		currentPkg.ParcelID, currentPkg.SendState,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	switch currentPkg.SendState {
 	// At this point we have the initial package information populated, so
 	// we'll perform coin selection to see if the send request is even
@@ -768,16 +1678,17 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 			return nil, fmt.Errorf("unable to cast parcel to " +
 				"address parcel")
 		}
-		fundSendRes, err := p.cfg.AssetWallet.FundAddressSend(
-			ctx, addrParcel.destAddrs...,
+		fundSendRes, partialSend, err := p.fundAddressParcel(
+			ctx, currentPkg.ParcelID, addrParcel,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to fund address send: "+
-				"%w", err)
+			return nil, err
 		}
 
 		currentPkg.VirtualPacket = fundSendRes.VPacket
 		currentPkg.InputCommitments = fundSendRes.InputCommitments
+		currentPkg.PartialSend = partialSend
+		currentPkg.ChangeKeyPolicy = fundSendRes.ChangeKeyPolicy
 
 		currentPkg.SendState = SendStateVirtualSign
 
@@ -791,10 +1702,32 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		log.Infof("Generating Taproot Asset witnesses for send to: %x",
 			receiverScriptKey.SerializeCompressed())
 
+		ctx, cancel := p.WithCtxQuitNoTimeout()
+		defer cancel()
+
+		// Inputs the caller provided a witness for aren't signed by
+		// us, but their witnesses are still validated.
+		signOpts := []SignVirtualPacketOption{WithSignContext(ctx)}
+		if currentPkg.Parcel != nil {
+			witnesses := currentPkg.Parcel.kit().inputWitnesses
+			if len(witnesses) > 0 {
+				signOpts = append(
+					signOpts, WithInputWitnesses(witnesses),
+				)
+			}
+		}
+
 		// Now we'll use the signer to sign all the inputs for the new
 		// Taproot Asset leaves. The witness data for each input will be
-		// assigned for us.
-		_, err := p.cfg.AssetWallet.SignVirtualPacket(vPacket)
+		// assigned for us. In watch-only mode, this waits for the
+		// offline signer.
+		start := time.Now()
+		_, err := p.cfg.AssetWallet.SignVirtualPacket(
+			vPacket, signOpts...,
+		)
+		p.parcelLogs.backendCall(
+			currentPkg.ParcelID, "SignVirtualPacket", start, err,
+		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to sign and commit "+
 				"virtual packet: %w", err)
@@ -811,12 +1744,45 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		ctx, cancel := p.WithCtxQuitNoTimeout()
 		defer cancel()
 
+		// Report who the remote outputs of the transfer are sent to.
+		counterpartyEvents := p.resolveCounterparties(
+			ctx, currentPkg.ParcelID, currentPkg.VirtualPacket,
+		)
+		for _, event := range counterpartyEvents {
+			p.publishSubscriberEvent(event)
+		}
+
+		// Before any funds are committed to the transfer, we make sure
+		// the receivers can actually be reached to deliver the proofs.
+		err := p.probeReceivers(ctx, currentPkg.VirtualPacket)
+		if err != nil {
+			return nil, err
+		}
+
+		// We also make sure we don't link the new anchor outputs to
+		// previous ones by reusing their internal keys.
+		err = p.checkAnchorKeyReuse(ctx, currentPkg.VirtualPacket)
+		if err != nil {
+			return nil, err
+		}
+
+		// Script keys that were found to be used more than once are
+		// blocked until their flag is resolved.
+		err = p.checkFlaggedScriptKeys(ctx, currentPkg.VirtualPacket)
+		if err != nil {
+			return nil, err
+		}
+
 		// Submit the template PSBT to the wallet for funding.
 		//
 		// TODO(roasbeef): unlock the input UTXOs of things fail
-		feeRate, err := p.cfg.ChainBridge.EstimateFee(
+		start := time.Now()
+		feeRate, err := p.feeEstimator.EstimateFee(
 			ctx, tapscript.SendConfTarget,
 		)
+		p.parcelLogs.backendCall(
+			currentPkg.ParcelID, "EstimateFee", start, err,
+		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to estimate fee: %w",
 				err)
@@ -837,12 +1803,22 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 
 		currentPkg.PassiveAssets, err = wallet.SignPassiveAssets(
 			vPacket, currentPkg.InputCommitments,
+			WithSignContext(ctx),
 		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to sign passive "+
 				"assets: %w", err)
 		}
 
+		// The passive asset proofs are held until the parcel
+		// completes, so we wait for them to fit into the budget and
+		// make sure the parcel's proofs don't get too large before we
+		// fund the anchor transaction.
+		err = p.reserveProofBudget(ctx, &currentPkg)
+		if err != nil {
+			return nil, err
+		}
+
 		var passiveVPackets []*tappsbt.VPacket
 		for _, passiveAsset := range currentPkg.PassiveAssets {
 			passiveVPackets = append(
@@ -850,14 +1826,55 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 			)
 		}
 
+		var (
+			coSigner    AnchorCoSigner
+			constraints AnchorFundingConstraints
+			lockTime    AnchorLockTime
+			trigger     *BroadcastTrigger
+		)
+		if currentPkg.Parcel != nil {
+			kit := currentPkg.Parcel.kit()
+			coSigner = kit.coSigner
+			constraints = kit.fundingConstraints
+			lockTime = kit.anchorLockTime
+			trigger = kit.broadcastTrigger
+		}
+
+		// The current height is the default lock time of the anchor
+		// transaction, which discourages fee sniping.
+		start = time.Now()
+		bestHeight, err := p.chainDispatcher.CurrentHeight(ctx)
+		p.parcelLogs.backendCall(
+			currentPkg.ParcelID, "CurrentHeight", start, err,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get current "+
+				"height: %w", err)
+		}
+		err = checkAnchorLockTimeReachable(
+			lockTime, bestHeight, trigger,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		start = time.Now()
 		anchorTx, err := wallet.AnchorVirtualTransactions(
 			ctx, &AnchorVTxnsParams{
 				FeeRate:            feeRate,
 				VPkts:              []*tappsbt.VPacket{vPacket},
 				InputCommitments:   currentPkg.InputCommitments,
 				PassiveAssetsVPkts: passiveVPackets,
+				CoSigner:           coSigner,
+				FundingConstraints: constraints,
+				LockTime:           lockTime,
+				BestHeight:         bestHeight,
 			},
 		)
+		p.parcelLogs.backendCall(
+			currentPkg.ParcelID, "AnchorVirtualTransactions", start,
+			err,
+		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to anchor virtual "+
 				"transactions: %w", err)
@@ -869,6 +1886,29 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		// finalization.
 		currentPkg.AnchorTx = anchorTx
 
+		// Any cached exclusion proofs were created for the previous
+		// output commitments.
+		currentPkg.proofCache = nil
+
+		currentPkg.SendState = SendStateLogCommit
+		if p.cfg.VerifyProofsBeforeBroadcast {
+			currentPkg.SendState = SendStateVerifyProofs
+		}
+
+		return &currentPkg, nil
+
+	// In this optional state, we make sure the proofs of the signed
+	// transfer are valid before we reach the point of no return.
+	case SendStateVerifyProofs:
+		ctx, cancel := p.WithCtxQuitNoTimeout()
+		defer cancel()
+
+		err := p.verifyDryRunProofs(ctx, &currentPkg)
+		if err != nil {
+			return nil, fmt.Errorf("unable to verify proofs before "+
+				"broadcast: %w", err)
+		}
+
 		currentPkg.SendState = SendStateLogCommit
 
 		return &currentPkg, nil
@@ -881,7 +1921,11 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		// height to pass as a height hint.
 		ctx, cancel := p.WithCtxQuit()
 		defer cancel()
-		currentHeight, err := p.cfg.ChainBridge.CurrentHeight(ctx)
+		start := time.Now()
+		currentHeight, err := p.chainDispatcher.CurrentHeight(ctx)
+		p.parcelLogs.backendCall(
+			currentPkg.ParcelID, "CurrentHeight", start, err,
+		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to get current height: "+
 				"%v", err)
@@ -895,16 +1939,23 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		}
 		currentPkg.OutboundPkg = parcel
 
+		// The persisted request is removed together with logging the
+		// parcel, so it's never re-driven once the parcel is on disk.
+		if currentPkg.Parcel != nil {
+			parcel.RequestID = currentPkg.Parcel.kit().requestID
+			parcel.BroadcastTrigger =
+				currentPkg.Parcel.kit().broadcastTrigger
+		}
+
 		// We now need to find out if this is a transfer to ourselves
 		// (e.g. a change output) or an outbound transfer. A key being
 		// local means the lnd node connected to this daemon knows how
-		// to derive the key.
+		// to derive the key or that it was imported.
 		for idx := range parcel.Outputs {
 			out := &parcel.Outputs[idx]
-			key := out.ScriptKey
-			if key.TweakedScriptKey != nil &&
-				p.cfg.KeyRing.IsLocalKey(ctx, key.RawKey) {
-
+			key, isLocal := p.localScriptKey(ctx, out.ScriptKey)
+			if isLocal {
+				out.ScriptKey = key
 				out.ScriptKeyLocal = true
 			}
 		}
@@ -915,7 +1966,7 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 
 		log.Infof("Committing pending parcel to disk")
 
-		err = p.cfg.ExportLog.LogPendingParcel(
+		err = p.cfg.TransferLog.LogPendingParcel(
 			ctx, parcel, defaultWalletLeaseIdentifier,
 			time.Now().Add(defaultBroadcastCoinLeaseDuration),
 		)
@@ -924,6 +1975,12 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 				"disk: %v", err)
 		}
 
+		// The caller of a scheduled parcel is notified once it is
+		// committed, as the broadcast may only happen much later.
+		if parcel.BroadcastTrigger != nil {
+			currentPkg.deliverTxBroadcastResp()
+		}
+
 		// We've logged the state transition to disk, so now we can
 		// move onto the broadcast phase.
 		currentPkg.SendState = SendStateBroadcast
@@ -942,21 +1999,47 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 				"addresses: %w", err)
 		}
 
+		// A parcel resumed after a restart may have been broadcast
+		// and confirmed while we were down. Publishing it again would
+		// only fail, so we go straight on to store the proofs.
+		if currentPkg.Parcel == nil {
+			confEvent, err := p.lookupTransferTxConf(
+				ctx, &currentPkg,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			if confEvent != nil {
+				currentPkg.TransferTxConfEvent = confEvent
+				currentPkg.SendState = SendStateStoreProofs
+				return &currentPkg, nil
+			}
+		}
+
 		log.Infof("Broadcasting new transfer tx, txid=%v",
 			currentPkg.OutboundPkg.AnchorTx.TxHash())
 
 		// With the public key imported, we can now broadcast to the
 		// network.
-		err = p.cfg.ChainBridge.PublishTransaction(
+		start := time.Now()
+		err = p.chainBridge.PublishTransaction(
 			ctx, currentPkg.OutboundPkg.AnchorTx,
 		)
+		p.parcelLogs.backendCall(
+			currentPkg.ParcelID, "PublishTransaction", start, err,
+		)
 		if err != nil {
 			return nil, err
 		}
 
 		// With the transaction broadcast, we'll deliver a
-		// notification via the transaction broadcast response channel.
-		currentPkg.deliverTxBroadcastResp()
+		// notification via the transaction broadcast response channel,
+		// unless the parcel was scheduled and its caller was already
+		// notified.
+		if currentPkg.OutboundPkg.BroadcastTrigger == nil {
+			currentPkg.deliverTxBroadcastResp()
+		}
 
 		// Set send state to the next state to evaluate.
 		currentPkg.SendState = SendStateWaitTxConf
@@ -986,6 +2069,8 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		go func() {
 			defer p.Wg.Done()
 
+			defer p.budget.release(currentPkg.budget)
+
 			err := p.transferReceiverProof(&currentPkg)
 			if err != nil {
 				log.Errorf("unable to transfer receiver "+
@@ -1009,15 +2094,7 @@ func (p *ChainPorter) RegisterSubscriber(
 	receiver *fn.EventReceiver[fn.Event],
 	deliverExisting bool, deliverFrom bool) error {
 
-	p.subscriberMtx.Lock()
-	defer p.subscriberMtx.Unlock()
-
-	p.subscribers[receiver.ID()] = receiver
-
-	// If we have a proof courier, we'll also update its subscribers.
-	if p.cfg.ProofCourier != nil {
-		p.cfg.ProofCourier.SetSubscribers(p.subscribers)
-	}
+	p.eventBus.Subscribe(receiver, nil)
 
 	return nil
 }
@@ -1027,48 +2104,64 @@ func (p *ChainPorter) RegisterSubscriber(
 func (p *ChainPorter) RemoveSubscriber(
 	subscriber *fn.EventReceiver[fn.Event]) error {
 
-	p.subscriberMtx.Lock()
-	defer p.subscriberMtx.Unlock()
+	return p.eventBus.Unsubscribe(subscriber)
+}
 
-	_, ok := p.subscribers[subscriber.ID()]
-	if !ok {
-		return fmt.Errorf("subscriber with ID %d not found",
-			subscriber.ID())
-	}
+// publishSubscriberEvent publishes an event to all subscribers. This never
+// blocks, events are dropped for subscribers that don't keep up instead.
+func (p *ChainPorter) publishSubscriberEvent(event fn.Event) {
+	p.eventBus.Publish(event)
+}
 
-	subscriber.Stop()
-	delete(p.subscribers, subscriber.ID())
+// A compile-time assertion to make sure ChainPorter satisfies the
+// fn.EventPublisher interface.
+var _ fn.EventPublisher[fn.Event, bool] = (*ChainPorter)(nil)
 
-	// If we have a proof courier, we'll also update its subscribers.
-	if p.cfg.ProofCourier != nil {
-		p.cfg.ProofCourier.SetSubscribers(p.subscribers)
-	}
+// BackendBreakerEvent is an event which indicates that the porter paused or
+// resumed starting new parcels because its chain backend or wallet became
+// unavailable or recovered.
+type BackendBreakerEvent struct {
+	// timestamp is the time the event was created.
+	timestamp time.Time
 
-	return nil
+	// Tripped is true if the backend became unavailable and false if it
+	// recovered.
+	Tripped bool
+
+	// Err is the error of the most recent failed backend call.
+	Err error
 }
 
-// publishSubscriberEvent publishes an event to all subscribers.
-func (p *ChainPorter) publishSubscriberEvent(event fn.Event) {
-	// Lock the subscriber mutex to ensure that we don't modify the
-	// subscriber map while we're iterating over it.
-	p.subscriberMtx.Lock()
-	defer p.subscriberMtx.Unlock()
+// Timestamp returns the timestamp of the event.
+func (e *BackendBreakerEvent) Timestamp() time.Time {
+	return e.timestamp
+}
 
-	for _, sub := range p.subscribers {
-		sub.NewItemCreated.ChanIn() <- event
+// NewBackendBreakerEvent creates a new BackendBreakerEvent.
+func NewBackendBreakerEvent(tripped bool, err error) *BackendBreakerEvent {
+	return &BackendBreakerEvent{
+		timestamp: time.Now().UTC(),
+		Tripped:   tripped,
+		Err:       err,
 	}
 }
 
-// A compile-time assertion to make sure ChainPorter satisfies the
-// fn.EventPublisher interface.
-var _ fn.EventPublisher[fn.Event, bool] = (*ChainPorter)(nil)
-
 // ExecuteSendStateEvent is an event which is sent to the ChainPorter's event
 // subscribers before a state is executed.
 type ExecuteSendStateEvent struct {
 	// timestamp is the time the event was created.
 	timestamp time.Time
 
+	// SeqNum is the sequence number of the event. Sequence numbers are
+	// strictly increasing across the states of all parcels, so events can
+	// be ordered even if their timestamps are equal or they are received
+	// out of order.
+	SeqNum uint64
+
+	// ParcelID is the identifier of the parcel whose state is about to be
+	// executed.
+	ParcelID uint64
+
 	// SendState is the state that is about to be executed.
 	SendState SendState
 }
@@ -1079,9 +2172,13 @@ func (e *ExecuteSendStateEvent) Timestamp() time.Time {
 }
 
 // NewExecuteSendStateEvent creates a new ExecuteSendStateEvent.
-func NewExecuteSendStateEvent(state SendState) *ExecuteSendStateEvent {
+func NewExecuteSendStateEvent(seqNum, parcelID uint64,
+	state SendState) *ExecuteSendStateEvent {
+
 	return &ExecuteSendStateEvent{
 		timestamp: time.Now().UTC(),
+		SeqNum:    seqNum,
+		ParcelID:  parcelID,
 		SendState: state,
 	}
 }
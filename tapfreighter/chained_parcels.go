@@ -0,0 +1,164 @@
+package tapfreighter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// storedProofSignals signals parcels that are chained onto the anchor
+// transactions of other parcels once the proofs of those were stored in the
+// local proof archive.
+type storedProofSignals struct {
+	mtx sync.Mutex
+
+	// signals holds a channel per anchor txid that is closed once the
+	// proofs of the parcel with that anchor transaction are stored.
+	signals map[chainhash.Hash]chan struct{}
+}
+
+// newStoredProofSignals creates a new, empty set of stored proof signals.
+func newStoredProofSignals() *storedProofSignals {
+	return &storedProofSignals{
+		signals: make(map[chainhash.Hash]chan struct{}),
+	}
+}
+
+// signalLocked returns the signal of the given anchor txid, creating it if it
+// doesn't exist yet.
+//
+// NOTE: The mutex must be held when calling this method.
+func (s *storedProofSignals) signalLocked(
+	anchorTxid chainhash.Hash) chan struct{} {
+
+	signal, ok := s.signals[anchorTxid]
+	if !ok {
+		signal = make(chan struct{})
+		s.signals[anchorTxid] = signal
+	}
+
+	return signal
+}
+
+// stored returns a channel that is closed once the proofs of the parcel with
+// the given anchor txid are stored.
+func (s *storedProofSignals) stored(
+	anchorTxid chainhash.Hash) <-chan struct{} {
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.signalLocked(anchorTxid)
+}
+
+// markStored signals that the proofs of the parcel with the given anchor txid
+// are stored.
+func (s *storedProofSignals) markStored(anchorTxid chainhash.Hash) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	// The proofs are stored again if the parcel is resumed, in which case
+	// the signal was already sent.
+	signal := s.signalLocked(anchorTxid)
+	select {
+	case <-signal:
+	default:
+		close(signal)
+	}
+}
+
+// forget removes the signal of the given anchor txid. This must only be called
+// once the parcel is no longer pending, as no parcel waits for it after that.
+func (s *storedProofSignals) forget(anchorTxid chainhash.Hash) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	delete(s.signals, anchorTxid)
+}
+
+// unconfirmedParents returns the anchor txids of all pending parcels whose
+// outputs are spent by the given inputs. Those are the parcels whose anchor
+// transactions a parcel with the given inputs is chained onto.
+func (p *ChainPorter) unconfirmedParents(ctx context.Context,
+	inputs []TransferInput) ([]chainhash.Hash, error) {
+
+	spentTxids := make(map[chainhash.Hash]struct{}, len(inputs))
+	for idx := range inputs {
+		spentTxids[inputs[idx].OutPoint.Hash] = struct{}{}
+	}
+
+	pendingParcels, err := p.cfg.PendingParcels.PendingParcels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query pending parcels: %w",
+			err)
+	}
+
+	var parents []chainhash.Hash
+	for _, parcel := range pendingParcels {
+		anchorTxid := parcel.AnchorTx.TxHash()
+		if _, ok := spentTxids[anchorTxid]; ok {
+			parents = append(parents, anchorTxid)
+		}
+	}
+
+	return parents, nil
+}
+
+// waitForParentParcels waits until the anchor transactions of all parcels
+// whose unconfirmed change is spent by the given package are confirmed and
+// their proofs are stored in the local proof archive. Only then are the proof
+// files of the spent change complete, so the proofs of the package can be
+// appended to them. The delivery of the proofs of the parent parcels to their
+// receivers isn't waited for.
+func (p *ChainPorter) waitForParentParcels(pkg *sendPackage) error {
+	ctx, cancel := p.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	inputs := pkg.OutboundPkg.Inputs
+	parents, err := p.unconfirmedParents(ctx, inputs)
+	if err != nil {
+		return err
+	}
+	if len(parents) == 0 {
+		return nil
+	}
+
+	// We take the signals of the parents before we check once more which
+	// of them are still pending. A parent stores its proofs before it
+	// stops being pending, so a parent that isn't pending anymore doesn't
+	// need to be waited for, while any parent that still is will close the
+	// signal we hold.
+	signals := make(map[chainhash.Hash]<-chan struct{}, len(parents))
+	for _, parent := range parents {
+		signals[parent] = p.storedProofs.stored(parent)
+	}
+
+	parents, err = p.unconfirmedParents(ctx, inputs)
+	if err != nil {
+		return err
+	}
+	if len(parents) == 0 {
+		return nil
+	}
+
+	p.parcelLogs.debugf(pkg.ParcelID, "Waiting for the proofs of parent "+
+		"parcels %v to be stored before storing proofs", parents)
+
+	for _, parent := range parents {
+		signal, ok := signals[parent]
+		if !ok {
+			signal = p.storedProofs.stored(parent)
+		}
+
+		select {
+		case <-signal:
+
+		case <-p.Quit:
+			return fmt.Errorf("ChainPorter shutting down")
+		}
+	}
+
+	return nil
+}
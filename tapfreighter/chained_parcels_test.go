@@ -0,0 +1,192 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// mockPendingParcels is a PendingParcelStore and DeliveryLog that keeps the
+// pending parcels in memory.
+type mockPendingParcels struct {
+	PendingParcelStore
+	DeliveryLog
+
+	mtx     sync.Mutex
+	pending []*OutboundParcel
+}
+
+// PendingParcels returns the parcels whose delivery wasn't confirmed yet.
+func (m *mockPendingParcels) PendingParcels(
+	context.Context) ([]*OutboundParcel, error) {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return fn.CopySlice(m.pending), nil
+}
+
+// ConfirmParcelDelivery removes the confirmed parcel from the pending ones.
+func (m *mockPendingParcels) ConfirmParcelDelivery(_ context.Context,
+	event *AssetConfirmEvent) error {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	pending := m.pending[:0]
+	for _, parcel := range m.pending {
+		if parcel.AnchorTx.TxHash() != event.AnchorTXID {
+			pending = append(pending, parcel)
+		}
+	}
+	m.pending = pending
+
+	return nil
+}
+
+// failingCourier is a proof courier that fails to deliver any proof.
+type failingCourier struct{}
+
+// DeliverProof always fails.
+func (f *failingCourier) DeliverProof(context.Context, proof.Recipient,
+	*proof.AnnotatedProof) error {
+
+	return errors.New("courier unavailable")
+}
+
+// ReceiveProof always fails.
+func (f *failingCourier) ReceiveProof(context.Context, proof.Recipient,
+	proof.Locator) (*proof.AnnotatedProof, error) {
+
+	return nil, errors.New("courier unavailable")
+}
+
+// SetEventBus ignores the event bus.
+func (f *failingCourier) SetEventBus(*fn.EventBus[fn.Event]) {}
+
+// TestWaitForParentParcels tests that a parcel that spends the unconfirmed
+// change of a parent parcel waits until the proofs of the parent are stored,
+// but not for the proofs of the parent to be delivered to its receivers.
+func TestWaitForParentParcels(t *testing.T) {
+	t.Parallel()
+
+	archive, err := proof.NewFileArchiver(t.TempDir())
+	require.NoError(t, err)
+
+	// The parent sends to a remote receiver and keeps change in its second
+	// anchor output, which the child spends.
+	parentTx := wire.NewMsgTx(2)
+	parentTx.AddTxIn(&wire.TxIn{PreviousOutPoint: test.RandOp(t)})
+	parentTx.AddTxOut(&wire.TxOut{Value: 1_000})
+	parentTx.AddTxOut(&wire.TxOut{Value: 1_000})
+
+	receiverKey := asset.NewScriptKey(test.RandPubKey(t))
+	assetID := asset.RandID(t)
+	parent := &sendPackage{
+		ParcelID: 1,
+		OutboundPkg: &OutboundParcel{
+			AnchorTx: parentTx,
+			Outputs: []TransferOutput{{
+				Anchor: Anchor{
+					InternalKey: keychain.KeyDescriptor{
+						PubKey: test.RandPubKey(t),
+					},
+				},
+				ScriptKey: receiverKey,
+				Amount:    5,
+			}},
+		},
+		FinalProofs: map[asset.SerializedKey]*proof.AnnotatedProof{
+			asset.ToSerialized(receiverKey.PubKey): {
+				Locator: proof.Locator{
+					AssetID:   &assetID,
+					ScriptKey: *receiverKey.PubKey,
+				},
+			},
+		},
+	}
+
+	parcels := &mockPendingParcels{
+		pending: []*OutboundParcel{parent.OutboundPkg},
+	}
+	porter := NewChainPorter(&ChainPorterConfig{
+		PendingParcels: parcels,
+		DeliveryLog:    parcels,
+		AssetProofs:    archive,
+		ProofCourier:   &failingCourier{},
+	})
+	t.Cleanup(func() {
+		close(porter.Quit)
+	})
+
+	changeOutpoint := wire.OutPoint{Hash: parentTx.TxHash(), Index: 1}
+	newChild := func(parcelID uint64) *sendPackage {
+		return &sendPackage{
+			ParcelID: parcelID,
+			OutboundPkg: &OutboundParcel{
+				Inputs: []TransferInput{{
+					PrevID: asset.PrevID{
+						OutPoint: changeOutpoint,
+					},
+				}},
+			},
+		}
+	}
+	waitForParents := func(child *sendPackage) chan error {
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- porter.waitForParentParcels(child)
+		}()
+
+		return errChan
+	}
+
+	// As long as the proofs of the parent aren't stored, the child waits.
+	firstChild := waitForParents(newChild(2))
+	select {
+	case err := <-firstChild:
+		t.Fatalf("child didn't wait for parent: %v", err)
+
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Once the parent stored its proofs, the child no longer waits, even
+	// though the delivery of the proofs of the parent fails and the parent
+	// remains pending.
+	require.NoError(t, porter.storeProofs(parent))
+	require.ErrorContains(
+		t, porter.transferReceiverProof(parent), "courier unavailable",
+	)
+
+	pending, err := parcels.PendingParcels(context.Background())
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	select {
+	case err := <-firstChild:
+		require.NoError(t, err)
+
+	case <-time.After(time.Second):
+		t.Fatalf("child still waits for parent")
+	}
+
+	// A child that spends the change of the parent later on doesn't wait
+	// either.
+	select {
+	case err := <-waitForParents(newChild(3)):
+		require.NoError(t, err)
+
+	case <-time.After(time.Second):
+		t.Fatalf("child still waits for parent")
+	}
+}
@@ -0,0 +1,188 @@
+package tapfreighter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// ChangeKeyPolicy determines the script keys asset change outputs are sent
+// to. It balances the simplicity of recovering change against the privacy of
+// not linking transfers through their change.
+type ChangeKeyPolicy uint8
+
+const (
+	// ChangeKeyRotate derives a new script key for the change of every
+	// transfer from the main key family.
+	ChangeKeyRotate ChangeKeyPolicy = iota
+
+	// ChangeKeyStable sends the change of all transfers to the same
+	// script key, the first key of the change key family. This makes
+	// recovering change trivial, but links all transfers through it.
+	ChangeKeyStable
+
+	// ChangeKeySeparateAccount derives a new script key for the change of
+	// every transfer from the dedicated change key family, so change keys
+	// can be scanned for independently of all other keys.
+	ChangeKeySeparateAccount
+)
+
+// stableChangeKeyLocator is the locator of the stable change key. The first
+// key of the change key family is reserved for it.
+var stableChangeKeyLocator = keychain.KeyLocator{
+	Family: asset.TaprootAssetsChangeKeyFamily,
+	Index:  0,
+}
+
+// String returns a human-readable name of the change key policy.
+func (p ChangeKeyPolicy) String() string {
+	switch p {
+	case ChangeKeyRotate:
+		return "rotate"
+
+	case ChangeKeyStable:
+		return "stable"
+
+	case ChangeKeySeparateAccount:
+		return "separate_account"
+
+	default:
+		return fmt.Sprintf("<unknown(%d)>", p)
+	}
+}
+
+// deriveChangeScriptKey derives the script key of the change output of the
+// given virtual packet according to the change key policy of the wallet. The
+// policy that was actually applied is returned along with the key.
+func (f *AssetWallet) deriveChangeScriptKey(ctx context.Context,
+	assetID asset.ID, vPkt *tappsbt.VPacket,
+	inputCommitments tappsbt.InputCommitments,
+	changeOut *tappsbt.VOutput) (asset.ScriptKey, ChangeKeyPolicy,
+	error) {
+
+	return f.deriveChangeKey(
+		ctx, f.cfg.ChangeKeyPolicy, assetID, vPkt, inputCommitments,
+		changeOut,
+	)
+}
+
+// deriveChangeKey derives the script key of the given change output according
+// to the given change key policy. The policy that was actually applied is
+// returned along with the key.
+func (f *AssetWallet) deriveChangeKey(ctx context.Context,
+	policy ChangeKeyPolicy, assetID asset.ID, vPkt *tappsbt.VPacket,
+	inputCommitments tappsbt.InputCommitments,
+	changeOut *tappsbt.VOutput) (asset.ScriptKey, ChangeKeyPolicy,
+	error) {
+
+	object := fmt.Sprintf("asset %v, anchor output %d", assetID,
+		changeOut.AnchorOutputIndex)
+
+	switch policy {
+	case ChangeKeyRotate:
+		keyDesc, err := tapgarden.DeriveAuditedKey(
+			ctx, f.cfg.KeyRing, f.cfg.KeyAuditLog,
+			asset.TaprootAssetsKeyFamily,
+			tapgarden.KeyPurposeChangeScriptKey, object,
+		)
+		if err != nil {
+			return asset.ScriptKey{}, 0, err
+		}
+
+		return asset.NewScriptKeyBip86(keyDesc), policy, nil
+
+	case ChangeKeyStable:
+		keyDesc, err := f.cfg.KeyRing.DeriveKey(
+			ctx, stableChangeKeyLocator,
+		)
+		if err != nil {
+			return asset.ScriptKey{}, 0, fmt.Errorf("unable to "+
+				"derive stable change key: %w", err)
+		}
+		scriptKey := asset.NewScriptKeyBip86(keyDesc)
+
+		// An asset can only be committed to once per script key in
+		// the same anchor output. If a passive asset that is carried
+		// along already sits at the stable key, we fall back to a
+		// fresh key from the change account for this transfer.
+		collides, err := hasPassiveAsset(
+			inputCommitments, vPkt, assetID, scriptKey,
+		)
+		if err != nil {
+			return asset.ScriptKey{}, 0, err
+		}
+		if !collides {
+			return scriptKey, policy, nil
+		}
+
+		log.Infof("Stable change key already used by passive asset "+
+			"%v, deriving a fresh change key", assetID)
+
+		fallthrough
+
+	case ChangeKeySeparateAccount:
+		keyDesc, err := tapgarden.DeriveAuditedKey(
+			ctx, f.cfg.KeyRing, f.cfg.KeyAuditLog,
+			asset.TaprootAssetsChangeKeyFamily,
+			tapgarden.KeyPurposeChangeScriptKey, object,
+		)
+		if err != nil {
+			return asset.ScriptKey{}, 0, err
+		}
+
+		// The first key of the change account is reserved for the
+		// stable change key, so we skip it.
+		if keyDesc.KeyLocator == stableChangeKeyLocator {
+			keyDesc, err = tapgarden.DeriveAuditedKey(
+				ctx, f.cfg.KeyRing, f.cfg.KeyAuditLog,
+				asset.TaprootAssetsChangeKeyFamily,
+				tapgarden.KeyPurposeChangeScriptKey, object,
+			)
+			if err != nil {
+				return asset.ScriptKey{}, 0, err
+			}
+		}
+
+		return asset.NewScriptKeyBip86(keyDesc),
+			ChangeKeySeparateAccount, nil
+
+	default:
+		return asset.ScriptKey{}, 0, fmt.Errorf("unknown change key "+
+			"policy: %v", policy)
+	}
+}
+
+// hasPassiveAsset returns true if any of the input commitments carries a
+// passive asset, which isn't spent by the virtual packet, with the given asset
+// ID and script key.
+func hasPassiveAsset(inputCommitments tappsbt.InputCommitments,
+	vPkt *tappsbt.VPacket, assetID asset.ID,
+	scriptKey asset.ScriptKey) (bool, error) {
+
+	for idx := range inputCommitments {
+		passiveCommitments, err := removeActiveCommitments(
+			inputCommitments[idx], vPkt,
+		)
+		if err != nil {
+			return false, err
+		}
+
+		for _, assetCommitment := range passiveCommitments {
+			for _, passive := range assetCommitment.Assets() {
+				if passive.ID() == assetID &&
+					passive.ScriptKey.PubKey.IsEqual(
+						scriptKey.PubKey,
+					) {
+
+					return true, nil
+				}
+			}
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,147 @@
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// familyKeyRing is a key ring that derives deterministic keys and tracks the
+// next index of every key family separately.
+type familyKeyRing struct {
+	KeyRing
+
+	nextIndex map[keychain.KeyFamily]uint32
+}
+
+// keyForLocator returns a deterministic key for the given locator.
+func keyForLocator(loc keychain.KeyLocator) keychain.KeyDescriptor {
+	var secret [32]byte
+	secret[0] = byte(loc.Family)
+	secret[1] = byte(loc.Family >> 8)
+	secret[2] = byte(loc.Index)
+	secret[31] = 1
+
+	_, pubKey := btcec.PrivKeyFromBytes(secret[:])
+
+	return keychain.KeyDescriptor{
+		KeyLocator: loc,
+		PubKey:     pubKey,
+	}
+}
+
+func (f *familyKeyRing) DeriveNextKey(_ context.Context,
+	family keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	index := f.nextIndex[family]
+	f.nextIndex[family]++
+
+	return keyForLocator(keychain.KeyLocator{
+		Family: family,
+		Index:  index,
+	}), nil
+}
+
+func (f *familyKeyRing) DeriveKey(_ context.Context,
+	loc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	return keyForLocator(loc), nil
+}
+
+// TestDeriveChangeScriptKey tests that change script keys are derived
+// according to the change key policy.
+func TestDeriveChangeScriptKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	changeOut := &tappsbt.VOutput{}
+
+	activeAsset := asset.RandAsset(t, asset.Normal)
+	activeAsset.Amount = 10
+	assetID := activeAsset.ID()
+	vPkt := &tappsbt.VPacket{
+		ChainParams: &address.RegressionNetTap,
+	}
+	vPkt.SetInputAsset(0, activeAsset, nil)
+
+	inputCommitment, err := commitment.FromAssets(activeAsset)
+	require.NoError(t, err)
+	inputCommitments := tappsbt.InputCommitments{0: inputCommitment}
+
+	newWallet := func(policy ChangeKeyPolicy) *AssetWallet {
+		return NewAssetWallet(&WalletConfig{
+			KeyRing: &familyKeyRing{
+				nextIndex: make(map[keychain.KeyFamily]uint32),
+			},
+			ChangeKeyPolicy: policy,
+		})
+	}
+
+	derive := func(wallet *AssetWallet,
+		inputs tappsbt.InputCommitments) (keychain.KeyLocator,
+		ChangeKeyPolicy) {
+
+		scriptKey, policy, err := wallet.deriveChangeScriptKey(
+			ctx, assetID, vPkt, inputs, changeOut,
+		)
+		require.NoError(t, err)
+
+		return scriptKey.RawKey.KeyLocator, policy
+	}
+
+	// Rotated keys come from the main key family.
+	wallet := newWallet(ChangeKeyRotate)
+	first, policy := derive(wallet, inputCommitments)
+	second, _ := derive(wallet, inputCommitments)
+	require.Equal(t, ChangeKeyRotate, policy)
+	require.EqualValues(t, asset.TaprootAssetsKeyFamily, first.Family)
+	require.NotEqual(t, first, second)
+
+	// Keys of the separate account come from the change key family, but
+	// never use the index reserved for the stable change key.
+	wallet = newWallet(ChangeKeySeparateAccount)
+	first, policy = derive(wallet, inputCommitments)
+	second, _ = derive(wallet, inputCommitments)
+	require.Equal(t, ChangeKeySeparateAccount, policy)
+	require.EqualValues(
+		t, asset.TaprootAssetsChangeKeyFamily, first.Family,
+	)
+	require.NotEqual(t, stableChangeKeyLocator, first)
+	require.NotEqual(t, first, second)
+
+	// The stable change key is the same for every transfer.
+	wallet = newWallet(ChangeKeyStable)
+	first, policy = derive(wallet, inputCommitments)
+	second, _ = derive(wallet, inputCommitments)
+	require.Equal(t, ChangeKeyStable, policy)
+	require.Equal(t, stableChangeKeyLocator, first)
+	require.Equal(t, first, second)
+
+	// If a passive asset of the same ID already sits at the stable key,
+	// a fresh key of the separate account is used instead.
+	passiveAsset := activeAsset.Copy()
+	passiveAsset.Amount = 5
+	passiveAsset.ScriptKey = asset.NewScriptKeyBip86(
+		keyForLocator(stableChangeKeyLocator),
+	)
+	collidingCommitment, err := commitment.FromAssets(
+		activeAsset, passiveAsset,
+	)
+	require.NoError(t, err)
+
+	fallback, policy := derive(wallet, tappsbt.InputCommitments{
+		0: collidingCommitment,
+	})
+	require.Equal(t, ChangeKeySeparateAccount, policy)
+	require.NotEqual(t, stableChangeKeyLocator, fallback)
+	require.EqualValues(
+		t, asset.TaprootAssetsChangeKeyFamily, fallback.Family,
+	)
+}
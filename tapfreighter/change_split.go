@@ -0,0 +1,131 @@
+package tapfreighter
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+)
+
+// ChangeSplitConfig determines whether and how the asset change of a transfer
+// is split into multiple outputs. Each change output gets a randomized amount,
+// its own script key and its own anchor output, so the amount of the change
+// can't be told apart from the amounts of the recipients as easily.
+type ChangeSplitConfig struct {
+	// NumOutputs is the number of outputs the change is split into. Values
+	// below two disable splitting.
+	NumOutputs uint32
+
+	// MinAmount is the minimum amount of each change output. If the change
+	// is too small for every output to get at least this amount, it is
+	// split into fewer outputs.
+	MinAmount uint64
+}
+
+// splitChangeAmounts splits the given change amount into up to NumOutputs
+// randomized amounts of at least MinAmount each. The amounts always add up to
+// the change amount.
+func (c ChangeSplitConfig) splitChangeAmounts(change uint64) ([]uint64,
+	error) {
+
+	minAmount := c.MinAmount
+	if minAmount == 0 {
+		minAmount = 1
+	}
+
+	numOutputs := uint64(c.NumOutputs)
+	if maxOutputs := change / minAmount; numOutputs > maxOutputs {
+		numOutputs = maxOutputs
+	}
+	if numOutputs < 2 {
+		return []uint64{change}, nil
+	}
+
+	// Every output gets the minimum amount, the rest is distributed by
+	// cutting it at random points.
+	remainder := change - numOutputs*minAmount
+	upperBound := new(big.Int).SetUint64(remainder)
+	upperBound.Add(upperBound, big.NewInt(1))
+
+	cuts := make([]uint64, numOutputs-1, numOutputs+1)
+	for idx := range cuts {
+		cut, err := rand.Int(rand.Reader, upperBound)
+		if err != nil {
+			return nil, fmt.Errorf("unable to draw change split: "+
+				"%w", err)
+		}
+		cuts[idx] = cut.Uint64()
+	}
+	cuts = append(cuts, 0, remainder)
+	sort.Slice(cuts, func(i, j int) bool {
+		return cuts[i] < cuts[j]
+	})
+
+	amounts := make([]uint64, numOutputs)
+	for idx := range amounts {
+		amounts[idx] = minAmount + cuts[idx+1] - cuts[idx]
+	}
+
+	return amounts, nil
+}
+
+// splitChange splits the change of the given virtual packet into multiple
+// outputs according to the change split config of the wallet. The change
+// output keeps the first amount and each additional amount is sent to a new
+// script key in a new anchor output.
+func (f *AssetWallet) splitChange(ctx context.Context, assetID asset.ID,
+	vPkt *tappsbt.VPacket, inputCommitments tappsbt.InputCommitments,
+	changeOut *tappsbt.VOutput) error {
+
+	amounts, err := f.cfg.ChangeSplit.splitChangeAmounts(changeOut.Amount)
+	if err != nil {
+		return err
+	}
+	if len(amounts) < 2 {
+		return nil
+	}
+
+	var nextAnchorIdx uint32
+	for _, vOut := range vPkt.Outputs {
+		if vOut.AnchorOutputIndex >= nextAnchorIdx {
+			nextAnchorIdx = vOut.AnchorOutputIndex + 1
+		}
+	}
+
+	// Sending all change outputs to the stable change key would link
+	// them, so the additional outputs use keys of the change account
+	// instead.
+	policy := f.cfg.ChangeKeyPolicy
+	if policy == ChangeKeyStable {
+		policy = ChangeKeySeparateAccount
+	}
+
+	changeOut.Amount = amounts[0]
+	for _, amount := range amounts[1:] {
+		splitOut := &tappsbt.VOutput{
+			Type:              tappsbt.TypeSimple,
+			Amount:            amount,
+			Interactive:       changeOut.Interactive,
+			AnchorOutputIndex: nextAnchorIdx,
+		}
+
+		splitOut.ScriptKey, _, err = f.deriveChangeKey(
+			ctx, policy, assetID, vPkt, inputCommitments, splitOut,
+		)
+		if err != nil {
+			return err
+		}
+
+		vPkt.Outputs = append(vPkt.Outputs, splitOut)
+		nextAnchorIdx++
+	}
+
+	log.Debugf("Split change of asset %v into %d outputs", assetID,
+		len(amounts))
+
+	return nil
+}
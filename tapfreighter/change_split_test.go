@@ -0,0 +1,127 @@
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSplitChangeAmounts tests that change is split into the configured
+// number of amounts that respect the minimum amount and add up to the change.
+func TestSplitChangeAmounts(t *testing.T) {
+	t.Parallel()
+
+	sum := func(amounts []uint64) uint64 {
+		var total uint64
+		for _, amount := range amounts {
+			total += amount
+		}
+
+		return total
+	}
+
+	// Without splitting, the change stays in a single output.
+	amounts, err := ChangeSplitConfig{}.splitChangeAmounts(1_000)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1_000}, amounts)
+
+	cfg := ChangeSplitConfig{
+		NumOutputs: 4,
+		MinAmount:  100,
+	}
+	for i := 0; i < 100; i++ {
+		amounts, err = cfg.splitChangeAmounts(1_000)
+		require.NoError(t, err)
+		require.Len(t, amounts, 4)
+		require.EqualValues(t, 1_000, sum(amounts))
+		for _, amount := range amounts {
+			require.GreaterOrEqual(t, amount, cfg.MinAmount)
+		}
+	}
+
+	// Change that is too small for all outputs is split into fewer
+	// outputs, or not at all.
+	amounts, err = cfg.splitChangeAmounts(250)
+	require.NoError(t, err)
+	require.Len(t, amounts, 2)
+	require.EqualValues(t, 250, sum(amounts))
+
+	amounts, err = cfg.splitChangeAmounts(150)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{150}, amounts)
+
+	// Change of exactly the minimum amounts leaves nothing to randomize.
+	amounts, err = cfg.splitChangeAmounts(400)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{100, 100, 100, 100}, amounts)
+}
+
+// TestSplitChange tests that the additional change outputs are sent to new
+// script keys in new anchor outputs, and that they never use the stable
+// change key.
+func TestSplitChange(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	activeAsset := asset.RandAsset(t, asset.Normal)
+	assetID := activeAsset.ID()
+
+	wallet := NewAssetWallet(&WalletConfig{
+		KeyRing: &familyKeyRing{
+			nextIndex: make(map[keychain.KeyFamily]uint32),
+		},
+		ChangeKeyPolicy: ChangeKeyStable,
+		ChangeSplit: ChangeSplitConfig{
+			NumOutputs: 3,
+			MinAmount:  10,
+		},
+	})
+
+	changeOut := &tappsbt.VOutput{
+		Type:              tappsbt.TypeSplitRoot,
+		Amount:            600,
+		AnchorOutputIndex: 0,
+		ScriptKey: asset.NewScriptKeyBip86(
+			keyForLocator(stableChangeKeyLocator),
+		),
+	}
+	vPkt := &tappsbt.VPacket{
+		Outputs: []*tappsbt.VOutput{changeOut, {
+			Type:              tappsbt.TypeSimple,
+			Amount:            400,
+			AnchorOutputIndex: 2,
+			ScriptKey:         asset.RandScriptKey(t),
+		}},
+		ChainParams: &address.RegressionNetTap,
+	}
+
+	err := wallet.splitChange(ctx, assetID, vPkt, nil, changeOut)
+	require.NoError(t, err)
+	require.Len(t, vPkt.Outputs, 4)
+
+	total := changeOut.Amount
+	for idx, splitOut := range vPkt.Outputs[2:] {
+		require.Equal(t, tappsbt.TypeSimple, splitOut.Type)
+		require.EqualValues(t, 3+idx, splitOut.AnchorOutputIndex)
+		require.GreaterOrEqual(t, splitOut.Amount, uint64(10))
+
+		keyLoc := splitOut.ScriptKey.RawKey.KeyLocator
+		require.EqualValues(
+			t, asset.TaprootAssetsChangeKeyFamily, keyLoc.Family,
+		)
+		require.NotEqual(t, stableChangeKeyLocator, keyLoc)
+
+		total += splitOut.Amount
+	}
+	require.EqualValues(t, 600, total)
+	require.NotEqual(
+		t, vPkt.Outputs[2].ScriptKey.RawKey.KeyLocator,
+		vPkt.Outputs[3].ScriptKey.RawKey.KeyLocator,
+	)
+}
@@ -0,0 +1,106 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+)
+
+// TransferCounterpartyEvent is an event which reports the counterparty an
+// output of a parcel is sent to. It is published once for every output with a
+// remote script key, before the anchor transaction is funded.
+type TransferCounterpartyEvent struct {
+	// timestamp is the time the event was created.
+	timestamp time.Time
+
+	// ParcelID is the identifier of the parcel the output belongs to.
+	ParcelID uint64
+
+	// OutputIndex is the index of the output in the virtual packet.
+	OutputIndex int
+
+	// ScriptKey is the script key the output is sent to.
+	ScriptKey *btcec.PublicKey
+
+	// Name is the name of the counterparty the script key belongs to. It
+	// is empty if the counterparty is unknown.
+	Name string
+}
+
+// Timestamp returns the timestamp of the event.
+func (e *TransferCounterpartyEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// Known returns true if the output is sent to a known counterparty.
+func (e *TransferCounterpartyEvent) Known() bool {
+	return e.Name != ""
+}
+
+// NewTransferCounterpartyEvent creates a new TransferCounterpartyEvent.
+func NewTransferCounterpartyEvent(parcelID uint64, outputIndex int,
+	scriptKey *btcec.PublicKey, name string) *TransferCounterpartyEvent {
+
+	return &TransferCounterpartyEvent{
+		timestamp:   time.Now().UTC(),
+		ParcelID:    parcelID,
+		OutputIndex: outputIndex,
+		ScriptKey:   scriptKey,
+		Name:        name,
+	}
+}
+
+// resolveCounterparties looks up the counterparties of all outputs of the
+// virtual packet that are sent to remote script keys and returns an event for
+// each of them. In strict mode, a warning is logged for every output that is
+// sent to an unknown counterparty. The counterparty book is informational
+// only, so it never aborts the transfer.
+func (p *ChainPorter) resolveCounterparties(ctx context.Context,
+	parcelID uint64,
+	vPacket *tappsbt.VPacket) []*TransferCounterpartyEvent {
+
+	book := p.cfg.CounterpartyBook
+	if book == nil {
+		return nil
+	}
+
+	var events []*TransferCounterpartyEvent
+	for idx, vOut := range vPacket.Outputs {
+		// Outputs to our own script keys, such as change, don't have a
+		// counterparty.
+		key := vOut.ScriptKey
+		if _, isLocal := p.localScriptKey(ctx, key); isLocal {
+			continue
+		}
+
+		var name string
+		counterparty, err := book.CounterpartyByScriptKey(
+			ctx, key.PubKey,
+		)
+		switch {
+		case err == nil:
+			name = counterparty.Name
+
+		case !errors.Is(err, address.ErrCounterpartyNotFound):
+			log.Errorf("Unable to look up counterparty of output "+
+				"%d (script_key=%x): %v", idx,
+				key.PubKey.SerializeCompressed(), err)
+
+		case p.cfg.StrictCounterparties:
+			log.Warnf("Parcel %d sends %d units in output %d to "+
+				"unknown counterparty (script_key=%x)",
+				parcelID, vOut.Amount, idx,
+				key.PubKey.SerializeCompressed())
+		}
+
+		events = append(events, NewTransferCounterpartyEvent(
+			parcelID, idx, key.PubKey, name,
+		))
+	}
+
+	return events
+}
@@ -0,0 +1,90 @@
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/stretchr/testify/require"
+)
+
+// mockCounterpartyBook is a counterparty book backed by a map.
+type mockCounterpartyBook struct {
+	address.CounterpartyBook
+
+	names map[asset.SerializedKey]string
+}
+
+func (m *mockCounterpartyBook) CounterpartyByScriptKey(_ context.Context,
+	scriptKey *btcec.PublicKey) (*address.Counterparty, error) {
+
+	name, ok := m.names[asset.ToSerialized(scriptKey)]
+	if !ok {
+		return nil, address.ErrCounterpartyNotFound
+	}
+
+	return &address.Counterparty{
+		Name:      name,
+		ScriptKey: scriptKey,
+	}, nil
+}
+
+// TestResolveCounterparties tests that the counterparties of all remote
+// outputs of a transfer are resolved.
+func TestResolveCounterparties(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	localKey := asset.NewScriptKeyBip86(test.PubToKeyDesc(
+		test.RandPubKey(t),
+	))
+	knownKey := asset.NewScriptKey(test.RandPubKey(t))
+	unknownKey := asset.NewScriptKey(test.RandPubKey(t))
+
+	vPacket := &tappsbt.VPacket{
+		Outputs: []*tappsbt.VOutput{{
+			ScriptKey: localKey,
+		}, {
+			ScriptKey: knownKey,
+		}, {
+			ScriptKey: unknownKey,
+		}},
+	}
+
+	book := &mockCounterpartyBook{
+		names: map[asset.SerializedKey]string{
+			asset.ToSerialized(knownKey.PubKey): "alice",
+		},
+	}
+	porter := &ChainPorter{
+		cfg: &ChainPorterConfig{
+			KeyRing:              tapgarden.NewMockKeyRing(),
+			CounterpartyBook:     book,
+			StrictCounterparties: true,
+		},
+	}
+
+	// The local output is skipped, the unknown counterparty is reported
+	// without a name.
+	events := porter.resolveCounterparties(ctx, 7, vPacket)
+	require.Len(t, events, 2)
+
+	require.EqualValues(t, 7, events[0].ParcelID)
+	require.Equal(t, 1, events[0].OutputIndex)
+	require.True(t, knownKey.PubKey.IsEqual(events[0].ScriptKey))
+	require.Equal(t, "alice", events[0].Name)
+	require.True(t, events[0].Known())
+
+	require.Equal(t, 2, events[1].OutputIndex)
+	require.True(t, unknownKey.PubKey.IsEqual(events[1].ScriptKey))
+	require.False(t, events[1].Known())
+
+	// Without a counterparty book, nothing is resolved.
+	porter.cfg.CounterpartyBook = nil
+	require.Empty(t, porter.resolveCounterparties(ctx, 7, vPacket))
+}
@@ -0,0 +1,176 @@
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// ExportLogHarness creates the export log implementation under test, along
+// with parcels that are valid for it.
+type ExportLogHarness struct {
+	// NewLog returns a new, empty export log.
+	NewLog func(t *testing.T) ExportLog
+
+	// NewParcel returns a new parcel that spends assets known to the given
+	// export log, along with the event that confirms the parcel. The
+	// parcel must have at least one local output that the event has a
+	// proof for.
+	NewParcel func(t *testing.T, exportLog ExportLog) (*OutboundParcel,
+		*AssetConfirmEvent)
+}
+
+// RunExportLogTests runs the test suite that checks that an export log meets
+// the contract of the TransferLog, PendingParcelStore and DeliveryLog
+// interfaces the ChainPorter relies on. Integrators that back the export log
+// with their own database should run it against their implementation.
+func RunExportLogTests(t *testing.T, h ExportLogHarness) {
+	t.Run("empty log", func(t *testing.T) {
+		exportLog := h.NewLog(t)
+		requirePendingParcels(t, exportLog)
+	})
+
+	t.Run("log and confirm", func(t *testing.T) {
+		exportLog := h.NewLog(t)
+		ctx := context.Background()
+
+		parcel, confEvent := h.NewParcel(t, exportLog)
+		logParcel(t, exportLog, parcel)
+		requirePendingParcels(t, exportLog, parcel)
+
+		err := exportLog.ConfirmParcelDelivery(ctx, confEvent)
+		require.NoError(t, err)
+		requirePendingParcels(t, exportLog)
+	})
+
+	t.Run("confirm is independent per parcel", func(t *testing.T) {
+		exportLog := h.NewLog(t)
+		ctx := context.Background()
+
+		parcel1, confEvent1 := h.NewParcel(t, exportLog)
+		parcel2, _ := h.NewParcel(t, exportLog)
+		logParcel(t, exportLog, parcel1)
+		logParcel(t, exportLog, parcel2)
+		requirePendingParcels(t, exportLog, parcel1, parcel2)
+
+		err := exportLog.ConfirmParcelDelivery(ctx, confEvent1)
+		require.NoError(t, err)
+		requirePendingParcels(t, exportLog, parcel2)
+	})
+
+	t.Run("broadcast trigger is kept", func(t *testing.T) {
+		exportLog := h.NewLog(t)
+		ctx := context.Background()
+
+		parcel, _ := h.NewParcel(t, exportLog)
+		parcel.BroadcastTrigger = &BroadcastTrigger{
+			Height: 100,
+			Time:   time.Unix(1_700_000_000, 0).UTC(),
+		}
+		logParcel(t, exportLog, parcel)
+
+		pending, err := exportLog.PendingParcels(ctx)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		require.Equal(
+			t, parcel.BroadcastTrigger, pending[0].BroadcastTrigger,
+		)
+	})
+
+	t.Run("cancel pending parcel", func(t *testing.T) {
+		exportLog := h.NewLog(t)
+		ctx := context.Background()
+
+		parcel1, _ := h.NewParcel(t, exportLog)
+		parcel2, _ := h.NewParcel(t, exportLog)
+		logParcel(t, exportLog, parcel1)
+		logParcel(t, exportLog, parcel2)
+
+		err := exportLog.CancelPendingParcel(ctx, test.RandHash())
+		require.Error(t, err)
+		requirePendingParcels(t, exportLog, parcel1, parcel2)
+
+		anchorTxid := parcel1.AnchorTx.TxHash()
+		err = exportLog.CancelPendingParcel(ctx, anchorTxid)
+		require.NoError(t, err)
+		requirePendingParcels(t, exportLog, parcel2)
+
+		// A canceled parcel can't be canceled again.
+		err = exportLog.CancelPendingParcel(ctx, anchorTxid)
+		require.Error(t, err)
+		requirePendingParcels(t, exportLog, parcel2)
+	})
+
+	t.Run("failed log has no effect", func(t *testing.T) {
+		exportLog := h.NewLog(t)
+		parcel, _ := h.NewParcel(t, exportLog)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := exportLog.LogPendingParcel(
+			ctx, parcel, fn.ToArray[[32]byte](test.RandBytes(32)),
+			time.Now().Add(time.Hour),
+		)
+		require.Error(t, err)
+		requirePendingParcels(t, exportLog)
+	})
+
+	t.Run("failed confirm has no effect", func(t *testing.T) {
+		exportLog := h.NewLog(t)
+		ctx := context.Background()
+
+		parcel, confEvent := h.NewParcel(t, exportLog)
+		logParcel(t, exportLog, parcel)
+
+		// Confirming a parcel that was never logged fails.
+		unknownEvent := *confEvent
+		unknownEvent.AnchorTXID = test.RandHash()
+		err := exportLog.ConfirmParcelDelivery(ctx, &unknownEvent)
+		require.Error(t, err)
+		requirePendingParcels(t, exportLog, parcel)
+
+		// Confirming a parcel without the proofs of its local outputs
+		// fails, without confirming it halfway.
+		noProofsEvent := *confEvent
+		noProofsEvent.FinalProofs = nil
+		err = exportLog.ConfirmParcelDelivery(ctx, &noProofsEvent)
+		require.Error(t, err)
+		requirePendingParcels(t, exportLog, parcel)
+
+		// The complete event still confirms the parcel.
+		err = exportLog.ConfirmParcelDelivery(ctx, confEvent)
+		require.NoError(t, err)
+		requirePendingParcels(t, exportLog)
+	})
+}
+
+// logParcel logs the given parcel as pending in the export log.
+func logParcel(t *testing.T, exportLog ExportLog, parcel *OutboundParcel) {
+	err := exportLog.LogPendingParcel(
+		context.Background(), parcel,
+		fn.ToArray[[32]byte](test.RandBytes(32)),
+		time.Now().Add(time.Hour),
+	)
+	require.NoError(t, err)
+}
+
+// requirePendingParcels asserts that the export log returns exactly the given
+// pending parcels, identified by their anchor transaction.
+func requirePendingParcels(t *testing.T, exportLog ExportLog,
+	parcels ...*OutboundParcel) {
+
+	pending, err := exportLog.PendingParcels(context.Background())
+	require.NoError(t, err)
+
+	txids := func(p *OutboundParcel) string {
+		return p.AnchorTx.TxHash().String()
+	}
+	require.ElementsMatch(
+		t, fn.Map(parcels, txids), fn.Map(pending, txids),
+	)
+}
@@ -0,0 +1,38 @@
+package tapfreighter
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+// ErrInjectedFault is returned for a parcel that was failed by the fault
+// injection hooks.
+var ErrInjectedFault = errors.New("injected fault")
+
+// FaultHooks are optional fault injection hooks of the send pipeline. They
+// allow integrators to test their error handling against realistic partial
+// failures of an outbound transfer. The hooks are only honored if the daemon
+// is built with the faultinject build tag and are ignored otherwise.
+type FaultHooks struct {
+	// FailAtState, if set, fails the first parcel that is about to execute
+	// the given state with ErrInjectedFault. Only a single parcel is
+	// failed.
+	FailAtState *SendState
+
+	// CourierDelay delays every proof delivery through the proof courier
+	// by the given duration.
+	CourierDelay time.Duration
+
+	// DropConfEvent, if set, drops the first confirmation event of an
+	// anchor transaction, as if it was lost by the chain backend. The
+	// parcel then keeps waiting for the confirmation until the daemon is
+	// restarted.
+	DropConfEvent bool
+
+	// failed is set once a parcel was failed at FailAtState.
+	failed atomic.Bool
+
+	// dropped is set once a confirmation event was dropped.
+	dropped atomic.Bool
+}
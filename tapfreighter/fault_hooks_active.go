@@ -0,0 +1,61 @@
+//go:build faultinject
+
+package tapfreighter
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// failAtState returns ErrInjectedFault if the parcel should be failed before
+// executing the given state.
+func (f *FaultHooks) failAtState(parcelID uint64, state SendState) error {
+	if f == nil || f.FailAtState == nil || *f.FailAtState != state {
+		return nil
+	}
+
+	if !f.failed.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	log.Warnf("Injecting fault into parcel %d at state %v", parcelID,
+		state)
+
+	return fmt.Errorf("%w: parcel %d at state %v", ErrInjectedFault,
+		parcelID, state)
+}
+
+// delayCourier blocks for the configured courier delay or until the context
+// is done.
+func (f *FaultHooks) delayCourier(ctx context.Context) error {
+	if f == nil || f.CourierDelay == 0 {
+		return nil
+	}
+
+	log.Warnf("Injecting proof courier delay of %v", f.CourierDelay)
+
+	select {
+	case <-time.After(f.CourierDelay):
+		return nil
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// dropConfEvent returns true if the given confirmation event should be
+// dropped.
+func (f *FaultHooks) dropConfEvent(parcelID uint64) bool {
+	if f == nil || !f.DropConfEvent {
+		return false
+	}
+
+	if !f.dropped.CompareAndSwap(false, true) {
+		return false
+	}
+
+	log.Warnf("Dropping confirmation event of parcel %d", parcelID)
+
+	return true
+}
@@ -0,0 +1,20 @@
+//go:build !faultinject
+
+package tapfreighter
+
+import "context"
+
+// failAtState is a no-op without the faultinject build tag.
+func (f *FaultHooks) failAtState(uint64, SendState) error {
+	return nil
+}
+
+// delayCourier is a no-op without the faultinject build tag.
+func (f *FaultHooks) delayCourier(context.Context) error {
+	return nil
+}
+
+// dropConfEvent is a no-op without the faultinject build tag.
+func (f *FaultHooks) dropConfEvent(uint64) bool {
+	return false
+}
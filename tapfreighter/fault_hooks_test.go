@@ -0,0 +1,47 @@
+//go:build faultinject
+
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestFaultHooks tests that the fault injection hooks only fire once and only
+// for the configured state.
+func TestFaultHooks(t *testing.T) {
+	t.Parallel()
+
+	// Without hooks, nothing is injected.
+	var noHooks *FaultHooks
+	require.NoError(t, noHooks.failAtState(1, SendStateBroadcast))
+	require.NoError(t, noHooks.delayCourier(context.Background()))
+	require.False(t, noHooks.dropConfEvent(1))
+
+	state := SendStateBroadcast
+	hooks := &FaultHooks{
+		FailAtState:   &state,
+		CourierDelay:  time.Hour,
+		DropConfEvent: true,
+	}
+
+	require.NoError(t, hooks.failAtState(1, SendStateVirtualSign))
+	err := hooks.failAtState(1, SendStateBroadcast)
+	require.ErrorIs(t, err, ErrInjectedFault)
+	require.NoError(t, hooks.failAtState(2, SendStateBroadcast))
+
+	require.True(t, hooks.dropConfEvent(1))
+	require.False(t, hooks.dropConfEvent(1))
+
+	// The courier delay is aborted once the context is done.
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 10*time.Millisecond,
+	)
+	defer cancel()
+	require.ErrorIs(
+		t, hooks.delayCourier(ctx), context.DeadlineExceeded,
+	)
+}
@@ -0,0 +1,253 @@
+package tapfreighter
+
+import (
+	"context"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// DefaultFeeBumpInterval is the default interval in which the fee of
+	// an unconfirmed anchor transaction of a parcel with a confirmation
+	// deadline is bumped.
+	DefaultFeeBumpInterval = 20 * time.Minute
+
+	// DefaultFeeBumpPercent is the default percentage the fee rate is
+	// raised by with each bump.
+	DefaultFeeBumpPercent = 25
+)
+
+// FeeBumper bumps the fee of an unconfirmed transaction.
+type FeeBumper interface {
+	// BumpFee bumps the fee of the transaction that created the given
+	// wallet owned output to the given fee rate, by spending the output in
+	// a child transaction.
+	BumpFee(ctx context.Context, op wire.OutPoint,
+		feeRate chainfee.SatPerKWeight) error
+}
+
+// FeeEscalation is the schedule the fee of an unconfirmed anchor transaction is
+// raised with until its parcel's confirmation deadline is reached.
+type FeeEscalation struct {
+	// Interval is the time between two fee bumps. If zero,
+	// DefaultFeeBumpInterval is used.
+	Interval time.Duration
+
+	// IncreasePercent is the percentage the fee rate is raised by with
+	// each bump. If zero, DefaultFeeBumpPercent is used.
+	IncreasePercent uint32
+
+	// MaxFeeRate is the fee rate that is never exceeded by a bump. If zero,
+	// the fee rate isn't capped.
+	MaxFeeRate chainfee.SatPerKWeight
+}
+
+// interval returns the time between two fee bumps.
+func (e *FeeEscalation) interval() time.Duration {
+	if e.Interval == 0 {
+		return DefaultFeeBumpInterval
+	}
+
+	return e.Interval
+}
+
+// nextFeeRate returns the fee rate of the bump that follows a bump to the given
+// fee rate.
+func (e *FeeEscalation) nextFeeRate(
+	feeRate chainfee.SatPerKWeight) chainfee.SatPerKWeight {
+
+	increasePercent := e.IncreasePercent
+	if increasePercent == 0 {
+		increasePercent = DefaultFeeBumpPercent
+	}
+
+	next := feeRate * chainfee.SatPerKWeight(100+increasePercent) / 100
+	if e.MaxFeeRate != 0 && next > e.MaxFeeRate {
+		return e.MaxFeeRate
+	}
+
+	return next
+}
+
+// ConfDeadline is the time by which the anchor transaction of a parcel needs to
+// be confirmed, together with the schedule the fee is escalated with until
+// then.
+type ConfDeadline struct {
+	// Deadline is the time by which the anchor transaction needs to be
+	// confirmed.
+	Deadline time.Time
+
+	// Escalation is the fee escalation schedule applied until the
+	// deadline.
+	Escalation FeeEscalation
+}
+
+// anchorChangeOutPoint returns the outpoint of the BTC level change output of
+// the parcel's anchor transaction, which is the only output that doesn't
+// anchor any assets.
+func anchorChangeOutPoint(parcel *OutboundParcel) (wire.OutPoint, bool) {
+	txHash := parcel.AnchorTx.TxHash()
+
+	anchorOutputs := make(map[uint32]struct{}, len(parcel.Outputs))
+	for _, out := range parcel.Outputs {
+		anchorOutputs[out.Anchor.OutPoint.Index] = struct{}{}
+	}
+
+	for idx := range parcel.AnchorTx.TxOut {
+		if _, ok := anchorOutputs[uint32(idx)]; ok {
+			continue
+		}
+
+		return wire.OutPoint{Hash: txHash, Index: uint32(idx)}, true
+	}
+
+	return wire.OutPoint{}, false
+}
+
+// feeEscalator raises the fee of a single unconfirmed anchor transaction
+// according to the escalation schedule of its parcel.
+type feeEscalator struct {
+	// deadline is the confirmation deadline of the parcel.
+	deadline *ConfDeadline
+
+	// changeOutPoint is the wallet owned output of the anchor transaction
+	// the fee is bumped through.
+	changeOutPoint wire.OutPoint
+
+	// feeRate is the fee rate of the most recent bump, or the fee rate the
+	// anchor transaction was funded with if it wasn't bumped yet.
+	feeRate chainfee.SatPerKWeight
+}
+
+// newFeeEscalator creates a fee escalator for the anchor transaction of the
+// given package.
+func (p *ChainPorter) newFeeEscalator(ctx context.Context, pkg *sendPackage,
+	deadline *ConfDeadline) *feeEscalator {
+
+	changeOutPoint, ok := anchorChangeOutPoint(pkg.OutboundPkg)
+	if !ok {
+		log.Warnf("Anchor transaction of parcel %d has no change "+
+			"output, fee can't be bumped", pkg.ParcelID)
+		return nil
+	}
+
+	// If the parcel was resumed after a restart, we don't know the fee
+	// rate the anchor transaction was funded with anymore, so we start
+	// escalating from the current estimate.
+	var feeRate chainfee.SatPerKWeight
+	if pkg.AnchorTx != nil {
+		feeRate = pkg.AnchorTx.TargetFeeRate
+	}
+	if feeRate == 0 {
+		var err error
+		feeRate, err = p.feeEstimator.EstimateFee(
+			ctx, tapscript.SendConfTarget,
+		)
+		if err != nil {
+			log.Warnf("Unable to estimate fee, fee of parcel %d "+
+				"can't be bumped: %v", pkg.ParcelID, err)
+			return nil
+		}
+	}
+
+	return &feeEscalator{
+		deadline:       deadline,
+		changeOutPoint: changeOutPoint,
+		feeRate:        feeRate,
+	}
+}
+
+// bump raises the fee of the anchor transaction to the next fee rate of the
+// escalation schedule. If the mempool state is known, the bump is skipped while
+// the anchor transaction already pays enough to be in the next block, and the
+// fee rate is raised to at least the minimum fee rate the mempool accepts.
+// Failed bumps are only logged, since the transfer itself is already
+// broadcast.
+func (e *feeEscalator) bump(ctx context.Context, bumper FeeBumper,
+	mempool *tapgarden.MempoolInfo, parcelID uint64) {
+
+	escalation := e.deadline.Escalation
+	feeRate := escalation.nextFeeRate(e.feeRate)
+	if mempool != nil {
+		if len(mempool.FeeHistogram) > 0 &&
+			mempool.VSizeAbove(e.feeRate) < tapgarden.BlockVSize {
+
+			log.Debugf("Not bumping fee of parcel %d, fee rate %v "+
+				"already in next block", parcelID, e.feeRate)
+			return
+		}
+
+		minFeeRate := mempool.MinAcceptedFee()
+		if feeRate < minFeeRate {
+			feeRate = minFeeRate
+			if escalation.MaxFeeRate != 0 &&
+				feeRate > escalation.MaxFeeRate {
+
+				feeRate = escalation.MaxFeeRate
+			}
+		}
+	}
+	if feeRate <= e.feeRate {
+		log.Debugf("Fee of parcel %d already at max fee rate %v",
+			parcelID, e.feeRate)
+		return
+	}
+
+	log.Infof("Bumping fee of parcel %d to %v through change output %v",
+		parcelID, feeRate, e.changeOutPoint)
+
+	err := bumper.BumpFee(ctx, e.changeOutPoint, feeRate)
+	if err != nil {
+		log.Errorf("Unable to bump fee of parcel %d: %v", parcelID,
+			err)
+		return
+	}
+
+	e.feeRate = feeRate
+}
+
+// ConfDeadlineExceededEvent is an event which indicates that the anchor
+// transaction of a parcel wasn't confirmed by the parcel's confirmation
+// deadline. The porter stops bumping the fee but keeps waiting for the
+// confirmation.
+type ConfDeadlineExceededEvent struct {
+	// timestamp is the time the event was created.
+	timestamp time.Time
+
+	// ParcelID is the identifier of the parcel that missed its deadline.
+	ParcelID uint64
+
+	// AnchorTxHash is the hash of the unconfirmed anchor transaction.
+	AnchorTxHash chainhash.Hash
+
+	// Deadline is the confirmation deadline that was missed.
+	Deadline time.Time
+
+	// FeeRate is the fee rate of the most recent fee bump, or the fee rate
+	// the anchor transaction was funded with if it wasn't bumped.
+	FeeRate chainfee.SatPerKWeight
+}
+
+// Timestamp returns the timestamp of the event.
+func (e *ConfDeadlineExceededEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// NewConfDeadlineExceededEvent creates a new ConfDeadlineExceededEvent.
+func NewConfDeadlineExceededEvent(parcelID uint64, txHash chainhash.Hash,
+	deadline time.Time,
+	feeRate chainfee.SatPerKWeight) *ConfDeadlineExceededEvent {
+
+	return &ConfDeadlineExceededEvent{
+		timestamp:    time.Now().UTC(),
+		ParcelID:     parcelID,
+		AnchorTxHash: txHash,
+		Deadline:     deadline,
+		FeeRate:      feeRate,
+	}
+}
@@ -0,0 +1,129 @@
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFeeEscalation tests that the fee rate is raised by the configured
+// percentage and capped at the maximum fee rate.
+func TestFeeEscalation(t *testing.T) {
+	t.Parallel()
+
+	var escalation FeeEscalation
+	require.Equal(t, DefaultFeeBumpInterval, escalation.interval())
+	require.EqualValues(t, 1250, escalation.nextFeeRate(1000))
+
+	escalation = FeeEscalation{
+		IncreasePercent: 100,
+		MaxFeeRate:      chainfee.SatPerKWeight(3000),
+	}
+	require.EqualValues(t, 2000, escalation.nextFeeRate(1000))
+	require.EqualValues(t, 3000, escalation.nextFeeRate(2000))
+	require.EqualValues(t, 3000, escalation.nextFeeRate(3000))
+}
+
+// mockFeeBumper is a FeeBumper that records the fee rates it was asked to bump
+// to.
+type mockFeeBumper struct {
+	feeRates []chainfee.SatPerKWeight
+}
+
+// BumpFee records the fee rate of the bump.
+func (m *mockFeeBumper) BumpFee(_ context.Context, _ wire.OutPoint,
+	feeRate chainfee.SatPerKWeight) error {
+
+	m.feeRates = append(m.feeRates, feeRate)
+
+	return nil
+}
+
+// TestFeeEscalatorMempool tests that fee bumps take the mempool state into
+// account.
+func TestFeeEscalatorMempool(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	bumper := &mockFeeBumper{}
+	escalator := &feeEscalator{
+		deadline: &ConfDeadline{
+			Escalation: FeeEscalation{
+				MaxFeeRate: 4000,
+			},
+		},
+		feeRate: 1000,
+	}
+
+	// Without a mempool state, the fee is bumped by the schedule.
+	escalator.bump(ctx, bumper, nil, 1)
+	require.Equal(t, []chainfee.SatPerKWeight{1250}, bumper.feeRates)
+
+	// While the anchor transaction is in the next block, the fee isn't
+	// bumped.
+	mempool := &tapgarden.MempoolInfo{
+		FeeHistogram: []tapgarden.FeeHistogramBucket{{
+			FeeRate: 2000,
+			VSize:   500_000,
+		}, {
+			FeeRate: 1000,
+			VSize:   400_000,
+		}},
+	}
+	escalator.bump(ctx, bumper, mempool, 1)
+	require.Len(t, bumper.feeRates, 1)
+
+	// Once the mempool fills up, the fee is raised to at least the minimum
+	// fee rate the mempool accepts, but never above the maximum.
+	mempool.FeeHistogram[0].VSize = tapgarden.BlockVSize
+	mempool.MempoolMinFee = 3000
+	escalator.bump(ctx, bumper, mempool, 1)
+	require.EqualValues(t, 3000, bumper.feeRates[1])
+
+	mempool.MempoolMinFee = 5000
+	escalator.bump(ctx, bumper, mempool, 1)
+	require.EqualValues(t, 4000, bumper.feeRates[2])
+}
+
+// TestAnchorChangeOutPoint tests that the change output of an anchor
+// transaction is the output that doesn't anchor any assets.
+func TestAnchorChangeOutPoint(t *testing.T) {
+	t.Parallel()
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: test.RandHash()},
+	})
+	for i := 0; i < 3; i++ {
+		anchorTx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: []byte{}})
+	}
+
+	txHash := anchorTx.TxHash()
+	parcel := &OutboundParcel{
+		AnchorTx: anchorTx,
+		Outputs: []TransferOutput{{
+			Anchor: Anchor{
+				OutPoint: wire.OutPoint{Hash: txHash, Index: 0},
+			},
+		}, {
+			Anchor: Anchor{
+				OutPoint: wire.OutPoint{Hash: txHash, Index: 2},
+			},
+		}},
+	}
+
+	changeOutPoint, ok := anchorChangeOutPoint(parcel)
+	require.True(t, ok)
+	require.Equal(t, wire.OutPoint{Hash: txHash, Index: 1}, changeOutPoint)
+
+	// Without a change output, there's nothing to bump the fee through.
+	parcel.Outputs[1].Anchor.OutPoint.Index = 1
+	anchorTx.TxOut = anchorTx.TxOut[:2]
+	_, ok = anchorChangeOutPoint(parcel)
+	require.False(t, ok)
+}
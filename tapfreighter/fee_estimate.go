@@ -0,0 +1,166 @@
+package tapfreighter
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// MaxStandardAnchorTxWeight is the maximum weight of an anchor transaction
+// that is still relayed by nodes with the default relay policy.
+const MaxStandardAnchorTxWeight = 400_000
+
+// AnchorTxSizeParams describes a planned transfer whose anchor transaction
+// size should be estimated.
+type AnchorTxSizeParams struct {
+	// Inputs are the asset coins the transfer spends. Coins that are
+	// anchored at the same outpoint are only spent once.
+	Inputs []*AnchoredCommitment
+
+	// Recipients are the addresses the transfer sends to. Each of them is
+	// anchored in its own output.
+	Recipients []*address.Tap
+
+	// NumInteractiveOutputs is the number of additional anchor outputs
+	// created for interactive receivers.
+	NumInteractiveOutputs int
+
+	// NoAssetChange indicates that no anchor output for the asset change
+	// is created. This is only the case for interactive sends of the full
+	// value of inputs that don't anchor any passive assets.
+	NoAssetChange bool
+
+	// InputWitnesses are template witnesses of asset inputs that aren't
+	// spent through the taproot key path, keyed by their anchor outpoint.
+	// Signatures only need to have the correct size, so placeholders can
+	// be used before signing.
+	InputWitnesses map[wire.OutPoint]wire.TxWitness
+
+	// NumWalletInputs is the number of inputs the backing wallet adds to
+	// pay for the chain fees. As we don't know which coins the wallet will
+	// select, they are estimated as P2WKH inputs, which are larger than
+	// taproot key spends.
+	NumWalletInputs int
+
+	// WalletChange indicates whether the backing wallet adds a change
+	// output for the BTC that isn't spent on fees.
+	WalletChange bool
+
+	// OpReturnData is the data of an optional OP_RETURN output. No such
+	// output is added if nil.
+	OpReturnData []byte
+}
+
+// AnchorTxSize is the estimated size of the anchor transaction of a transfer.
+type AnchorTxSize struct {
+	// NumInputs is the number of inputs of the anchor transaction.
+	NumInputs int
+
+	// NumOutputs is the number of outputs of the anchor transaction.
+	NumOutputs int
+
+	// Weight is the estimated weight of the signed anchor transaction.
+	Weight int64
+
+	// VSize is the estimated virtual size of the signed anchor transaction.
+	VSize int64
+}
+
+// IsStandard returns true if the weight of the anchor transaction doesn't
+// exceed the relay policy limit.
+func (s *AnchorTxSize) IsStandard() bool {
+	return s.Weight <= MaxStandardAnchorTxWeight
+}
+
+// Fee returns the chain fee the anchor transaction pays at the given fee rate.
+func (s *AnchorTxSize) Fee(feeRate chainfee.SatPerKWeight) btcutil.Amount {
+	return feeRate.FeeForWeight(s.Weight)
+}
+
+// EstimateAnchorTxSize estimates the size of the signed anchor transaction of
+// a transfer without constructing and funding any packet, so fees can be
+// quoted before a transfer is committed to. The Taproot Asset commitments of
+// all outputs are part of the taproot output keys and don't add any weight,
+// so every anchor output is estimated as a P2TR output, and every asset input
+// as a taproot key spend, unless a template witness is given for it.
+func EstimateAnchorTxSize(params *AnchorTxSizeParams) (*AnchorTxSize, error) {
+	if len(params.Inputs) == 0 {
+		return nil, fmt.Errorf("at least one input must be specified")
+	}
+	numReceivers := len(params.Recipients) + params.NumInteractiveOutputs
+	if numReceivers == 0 {
+		return nil, fmt.Errorf("at least one recipient must be " +
+			"specified")
+	}
+
+	var (
+		estimator input.TxWeightEstimator
+		numInputs int
+	)
+
+	// Multiple coins can be anchored at the same outpoint, but the outpoint
+	// is only spent once.
+	anchorPoints := make(map[wire.OutPoint]struct{}, len(params.Inputs))
+	for _, coin := range params.Inputs {
+		if _, ok := anchorPoints[coin.AnchorPoint]; ok {
+			continue
+		}
+		anchorPoints[coin.AnchorPoint] = struct{}{}
+		numInputs++
+
+		witness, ok := params.InputWitnesses[coin.AnchorPoint]
+		if !ok {
+			estimator.AddTaprootKeySpendInput(
+				txscript.SigHashDefault,
+			)
+			continue
+		}
+
+		estimator.AddWitnessInput(witness.SerializeSize())
+	}
+	for i := 0; i < params.NumWalletInputs; i++ {
+		estimator.AddP2WKHInput()
+		numInputs++
+	}
+
+	// Besides the outputs of the receivers, there usually is an output for
+	// the asset change, which also carries the split root of the transfer
+	// and any passive assets of the inputs.
+	numOutputs := numReceivers
+	if !params.NoAssetChange {
+		numOutputs++
+	}
+	for i := 0; i < numOutputs; i++ {
+		estimator.AddP2TROutput()
+	}
+
+	if params.WalletChange {
+		estimator.AddP2TROutput()
+		numOutputs++
+	}
+
+	if params.OpReturnData != nil {
+		script, err := txscript.NullDataScript(params.OpReturnData)
+		if err != nil {
+			return nil, fmt.Errorf("invalid OP_RETURN data: %w",
+				err)
+		}
+
+		estimator.AddTxOutput(&wire.TxOut{
+			PkScript: script,
+		})
+		numOutputs++
+	}
+
+	return &AnchorTxSize{
+		NumInputs:  numInputs,
+		NumOutputs: numOutputs,
+		Weight:     int64(estimator.Weight()),
+		VSize:      int64(estimator.VSize()),
+	}, nil
+}
@@ -0,0 +1,121 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEstimateAnchorTxSize tests that the estimated anchor transaction size
+// matches the size of an equivalent signed transaction.
+func TestEstimateAnchorTxSize(t *testing.T) {
+	t.Parallel()
+
+	sharedPoint := test.RandOp(t)
+	params := &AnchorTxSizeParams{
+		Inputs: []*AnchoredCommitment{{
+			AnchorPoint: sharedPoint,
+		}, {
+			AnchorPoint: sharedPoint,
+		}, {
+			AnchorPoint: test.RandOp(t),
+		}},
+		Recipients:            []*address.Tap{{}, {}},
+		NumInteractiveOutputs: 1,
+		NumWalletInputs:       2,
+		WalletChange:          true,
+		OpReturnData:          []byte("quote"),
+	}
+
+	size, err := EstimateAnchorTxSize(params)
+	require.NoError(t, err)
+
+	// Two distinct asset anchors and two wallet inputs are spent. Three
+	// receivers, the asset change, the wallet change and the OP_RETURN
+	// output are created.
+	require.Equal(t, 4, size.NumInputs)
+	require.Equal(t, 6, size.NumOutputs)
+
+	// We now build the equivalent transaction with the largest possible
+	// witnesses and make sure the estimate covers it without being too
+	// conservative.
+	tx := wire.NewMsgTx(2)
+	for i := 0; i < 2; i++ {
+		tx.AddTxIn(&wire.TxIn{
+			Witness: wire.TxWitness{make([]byte, 64)},
+		})
+	}
+	for i := 0; i < 2; i++ {
+		tx.AddTxIn(&wire.TxIn{
+			Witness: wire.TxWitness{
+				make([]byte, 73), make([]byte, 33),
+			},
+		})
+	}
+	p2trScript := append([]byte{txscript.OP_1, txscript.OP_DATA_32},
+		make([]byte, 32)...)
+	for i := 0; i < 5; i++ {
+		tx.AddTxOut(&wire.TxOut{PkScript: p2trScript})
+	}
+	opReturn, err := txscript.NullDataScript(params.OpReturnData)
+	require.NoError(t, err)
+	tx.AddTxOut(&wire.TxOut{PkScript: opReturn})
+
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(tx))
+	require.GreaterOrEqual(t, size.Weight, weight)
+	require.LessOrEqual(t, size.Weight-weight, int64(10))
+	require.Equal(t, (size.Weight+3)/4, size.VSize)
+
+	feeRate := chainfee.SatPerKVByte(2000).FeePerKWeight()
+	require.Equal(t, feeRate.FeeForWeight(size.Weight), size.Fee(feeRate))
+
+	// Without an asset change output, one output less is estimated.
+	params.NoAssetChange = true
+	noChangeSize, err := EstimateAnchorTxSize(params)
+	require.NoError(t, err)
+	require.Equal(t, size.NumOutputs-1, noChangeSize.NumOutputs)
+	require.Less(t, noChangeSize.Weight, size.Weight)
+
+	// An input that is spent through a script path is estimated with the
+	// size of its template witness instead.
+	scriptWitness := wire.TxWitness{
+		make([]byte, asset.KeySpendSigSize(txscript.SigHashDefault)),
+		make([]byte, 34), make([]byte, 65),
+	}
+	params.InputWitnesses = map[wire.OutPoint]wire.TxWitness{
+		sharedPoint: scriptWitness,
+	}
+	scriptSize, err := EstimateAnchorTxSize(params)
+	require.NoError(t, err)
+	require.Equal(t, noChangeSize.NumInputs, scriptSize.NumInputs)
+	require.Equal(
+		t, noChangeSize.Weight-int64(
+			asset.KeySpendWitnessSize(txscript.SigHashDefault),
+		)+int64(scriptWitness.SerializeSize()), scriptSize.Weight,
+	)
+	require.True(t, scriptSize.IsStandard())
+	params.InputWitnesses = nil
+
+	// Inputs and recipients are required.
+	_, err = EstimateAnchorTxSize(&AnchorTxSizeParams{
+		Recipients: []*address.Tap{{}},
+	})
+	require.Error(t, err)
+	_, err = EstimateAnchorTxSize(&AnchorTxSizeParams{
+		Inputs: params.Inputs,
+	})
+	require.Error(t, err)
+
+	// OP_RETURN data that exceeds the standardness limit is rejected.
+	params.OpReturnData = make([]byte, txscript.MaxDataCarrierSize+1)
+	_, err = EstimateAnchorTxSize(params)
+	require.Error(t, err)
+}
@@ -0,0 +1,128 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// ErrNoFeeEstimate is returned if none of the fee estimators of a
+// FallbackFeeEstimator returned an estimate within the sanity bounds.
+var ErrNoFeeEstimate = errors.New("no fee estimate within bounds")
+
+// FeeEstimator estimates the fee rate a transaction needs to pay to confirm
+// within a given number of blocks.
+type FeeEstimator interface {
+	// EstimateFee returns a fee estimate for the confirmation target.
+	EstimateFee(ctx context.Context,
+		confTarget uint32) (chainfee.SatPerKWeight, error)
+}
+
+// FeeRateBounds are the sanity bounds of fee estimates. Estimates outside the
+// bounds are considered bogus and aren't used.
+type FeeRateBounds struct {
+	// Min is the lowest acceptable fee rate. If zero, the relay fee floor
+	// chainfee.FeePerKwFloor is used.
+	Min chainfee.SatPerKWeight
+
+	// Max is the highest acceptable fee rate. If zero, fee rates aren't
+	// bounded from above.
+	Max chainfee.SatPerKWeight
+}
+
+// min returns the lowest acceptable fee rate.
+func (b *FeeRateBounds) min() chainfee.SatPerKWeight {
+	if b.Min == 0 {
+		return chainfee.FeePerKwFloor
+	}
+
+	return b.Min
+}
+
+// check returns an error if the given fee rate is outside the bounds.
+func (b *FeeRateBounds) check(feeRate chainfee.SatPerKWeight) error {
+	if feeRate < b.min() {
+		return fmt.Errorf("fee rate %v below minimum %v", feeRate,
+			b.min())
+	}
+
+	if b.Max != 0 && feeRate > b.Max {
+		return fmt.Errorf("fee rate %v above maximum %v", feeRate,
+			b.Max)
+	}
+
+	return nil
+}
+
+// NamedFeeEstimator is a fee estimator with a name that identifies it in the
+// logs.
+type NamedFeeEstimator struct {
+	FeeEstimator
+
+	// Name is the name of the fee estimator, e.g. the source it queries.
+	Name string
+}
+
+// FallbackFeeEstimator is a FeeEstimator that queries a list of fee estimators
+// in order and returns the first estimate within the sanity bounds. Estimators
+// that fail or return an estimate outside the bounds are skipped, so a single
+// misbehaving source can't make transfers overpay.
+type FallbackFeeEstimator struct {
+	bounds FeeRateBounds
+
+	estimators []NamedFeeEstimator
+}
+
+// NewFallbackFeeEstimator creates a new fee estimator that queries the given
+// estimators in order.
+func NewFallbackFeeEstimator(bounds FeeRateBounds,
+	estimators ...NamedFeeEstimator) *FallbackFeeEstimator {
+
+	return &FallbackFeeEstimator{
+		bounds:     bounds,
+		estimators: estimators,
+	}
+}
+
+// EstimateFee returns the first fee estimate for the confirmation target that
+// is within the sanity bounds. If no estimator returns such an estimate,
+// ErrNoFeeEstimate is returned.
+//
+// NOTE: This is part of the FeeEstimator interface.
+func (f *FallbackFeeEstimator) EstimateFee(ctx context.Context,
+	confTarget uint32) (chainfee.SatPerKWeight, error) {
+
+	var lastErr error
+	for _, estimator := range f.estimators {
+		feeRate, err := estimator.EstimateFee(ctx, confTarget)
+		if err == nil {
+			err = f.bounds.check(feeRate)
+		}
+		if err != nil {
+			log.Warnf("Skipping fee estimate of %v for conf "+
+				"target %d: %v", estimator.Name, confTarget,
+				err)
+
+			lastErr = fmt.Errorf("%v: %w", estimator.Name, err)
+			continue
+		}
+
+		log.Debugf("Using fee estimate of %v for conf target %d: %v",
+			estimator.Name, confTarget, feeRate)
+
+		return feeRate, nil
+	}
+
+	if lastErr == nil {
+		return 0, fmt.Errorf("%w: no fee estimators configured",
+			ErrNoFeeEstimate)
+	}
+
+	return 0, fmt.Errorf("%w, last error: %v", ErrNoFeeEstimate, lastErr)
+}
+
+// A compile-time assertion to ensure FallbackFeeEstimator meets the
+// FeeEstimator interface.
+var _ FeeEstimator = (*FallbackFeeEstimator)(nil)
@@ -0,0 +1,84 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// mockFeeEstimator is a FeeEstimator that returns a fixed estimate or error.
+type mockFeeEstimator struct {
+	feeRate chainfee.SatPerKWeight
+	err     error
+	calls   int
+}
+
+// EstimateFee returns the fixed estimate or error.
+func (m *mockFeeEstimator) EstimateFee(context.Context,
+	uint32) (chainfee.SatPerKWeight, error) {
+
+	m.calls++
+
+	return m.feeRate, m.err
+}
+
+// TestFallbackFeeEstimator tests that the fallback fee estimator returns the
+// first estimate within the bounds and skips failing sources.
+func TestFallbackFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	bounds := FeeRateBounds{
+		Min: chainfee.FeePerKwFloor,
+		Max: 10_000,
+	}
+
+	var (
+		failing  = &mockFeeEstimator{err: errors.New("unreachable")}
+		tooHigh  = &mockFeeEstimator{feeRate: 50_000}
+		tooLow   = &mockFeeEstimator{feeRate: 1}
+		valid    = &mockFeeEstimator{feeRate: 2_000}
+		notAsked = &mockFeeEstimator{feeRate: 3_000}
+	)
+
+	estimator := NewFallbackFeeEstimator(
+		bounds, NamedFeeEstimator{failing, "failing"},
+		NamedFeeEstimator{tooHigh, "too high"},
+		NamedFeeEstimator{tooLow, "too low"},
+		NamedFeeEstimator{valid, "valid"},
+		NamedFeeEstimator{notAsked, "not asked"},
+	)
+
+	feeRate, err := estimator.EstimateFee(ctx, 6)
+	require.NoError(t, err)
+	require.Equal(t, valid.feeRate, feeRate)
+	require.Equal(t, 1, failing.calls)
+	require.Equal(t, 1, tooHigh.calls)
+	require.Equal(t, 1, tooLow.calls)
+	require.Zero(t, notAsked.calls)
+
+	// Without an upper bound, the first estimate above the floor is used.
+	estimator = NewFallbackFeeEstimator(
+		FeeRateBounds{}, NamedFeeEstimator{tooLow, "too low"},
+		NamedFeeEstimator{tooHigh, "too high"},
+	)
+	feeRate, err = estimator.EstimateFee(ctx, 6)
+	require.NoError(t, err)
+	require.Equal(t, tooHigh.feeRate, feeRate)
+
+	// If no source returns an estimate within the bounds, an error is
+	// returned instead of a bogus fee rate.
+	estimator = NewFallbackFeeEstimator(
+		bounds, NamedFeeEstimator{failing, "failing"},
+		NamedFeeEstimator{tooHigh, "too high"},
+	)
+	_, err = estimator.EstimateFee(ctx, 6)
+	require.ErrorIs(t, err, ErrNoFeeEstimate)
+
+	estimator = NewFallbackFeeEstimator(bounds)
+	_, err = estimator.EstimateFee(ctx, 6)
+	require.ErrorIs(t, err, ErrNoFeeEstimate)
+}
@@ -0,0 +1,46 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+)
+
+// localScriptKey returns true if the given script key belongs to us, either
+// because the lnd node connected to this daemon knows how to derive it or
+// because it was imported. For imported keys, the returned script key carries
+// the imported internal key and tweak.
+func (p *ChainPorter) localScriptKey(ctx context.Context,
+	key asset.ScriptKey) (asset.ScriptKey, bool) {
+
+	if key.TweakedScriptKey != nil &&
+		p.cfg.KeyRing.IsLocalKey(ctx, key.RawKey) {
+
+		return key, true
+	}
+
+	if p.cfg.ImportedKeys == nil || key.PubKey == nil {
+		return key, false
+	}
+
+	imported, err := p.cfg.ImportedKeys.FetchImportedScriptKey(
+		ctx, key.PubKey,
+	)
+	switch {
+	case errors.Is(err, address.ErrImportedKeyNotFound):
+		return key, false
+
+	case err != nil:
+		log.Errorf("Unable to look up imported script key %x: %v",
+			key.PubKey.SerializeCompressed(), err)
+
+		return key, false
+	}
+
+	return asset.ScriptKey{
+		PubKey:           key.PubKey,
+		TweakedScriptKey: imported.ScriptKey.TweakedScriptKey,
+	}, true
+}
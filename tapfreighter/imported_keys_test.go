@@ -0,0 +1,73 @@
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/stretchr/testify/require"
+)
+
+// mockImportedKeys is an imported key store backed by a map.
+type mockImportedKeys struct {
+	address.ImportedKeyStore
+
+	keys map[asset.SerializedKey]*address.ImportedScriptKey
+}
+
+func (m *mockImportedKeys) FetchImportedScriptKey(_ context.Context,
+	scriptKey *btcec.PublicKey) (*address.ImportedScriptKey, error) {
+
+	key, ok := m.keys[asset.ToSerialized(scriptKey)]
+	if !ok {
+		return nil, address.ErrImportedKeyNotFound
+	}
+
+	return key, nil
+}
+
+// TestLocalScriptKey tests that imported script keys are recognized as local.
+func TestLocalScriptKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	importedKey := asset.NewScriptKeyBip86(test.PubToKeyDesc(
+		test.RandPubKey(t),
+	))
+	remoteKey := asset.NewScriptKey(test.RandPubKey(t))
+
+	porter := &ChainPorter{
+		cfg: &ChainPorterConfig{
+			KeyRing: tapgarden.NewMockKeyRing(),
+		},
+	}
+
+	// Without an imported key store, a script key without tweak info
+	// isn't local.
+	_, isLocal := porter.localScriptKey(
+		ctx, asset.NewScriptKey(importedKey.PubKey),
+	)
+	require.False(t, isLocal)
+
+	porter.cfg.ImportedKeys = &mockImportedKeys{
+		keys: map[asset.SerializedKey]*address.ImportedScriptKey{
+			asset.ToSerialized(importedKey.PubKey): {
+				ScriptKey: importedKey,
+			},
+		},
+	}
+
+	// The imported key is local and gets the imported tweak info.
+	key, isLocal := porter.localScriptKey(
+		ctx, asset.NewScriptKey(importedKey.PubKey),
+	)
+	require.True(t, isLocal)
+	require.Equal(t, importedKey.TweakedScriptKey, key.TweakedScriptKey)
+
+	_, isLocal = porter.localScriptKey(ctx, remoteKey)
+	require.False(t, isLocal)
+}
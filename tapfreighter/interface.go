@@ -10,6 +10,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/fn"
@@ -39,6 +40,29 @@ type CommitmentConstraints struct {
 	// MinAmt is the minimum amount that an asset commitment needs to hold
 	// to satisfy the constraints.
 	MinAmt uint64
+
+	// Lots is an optional list of lots that may be selected. If set, only
+	// the commitments of these lots are considered during coin selection.
+	Lots []asset.PrevID
+}
+
+// AssetLot is the acquisition metadata of an asset UTXO, which is tracked to
+// be able to compute the cost basis of a disposal.
+type AssetLot struct {
+	// AcquiredAt is the time the lot was acquired. Change lots inherit the
+	// acquisition time of the oldest lot spent by the transfer that
+	// created them. This is the zero time if it is unknown, which is the
+	// case for assets that were acquired before lots were tracked.
+	AcquiredAt time.Time
+
+	// SourceTransfer is the anchor transaction hash of the outbound
+	// transfer that created the lot as change. This is nil for minted or
+	// received assets.
+	SourceTransfer *chainhash.Hash
+
+	// PriceAnnotation is an optional, user provided annotation of the
+	// acquisition price of the lot.
+	PriceAnnotation string
 }
 
 // AnchoredCommitment is the response to satisfying the set of
@@ -52,6 +76,11 @@ type AnchoredCommitment struct {
 	// AnchorOutputValue is output value of the anchor output.
 	AnchorOutputValue btcutil.Amount
 
+	// AnchorBlockHeight is the height of the block the anchor transaction
+	// was confirmed in. This is zero if the anchor transaction isn't
+	// confirmed yet.
+	AnchorBlockHeight uint32
+
 	// InternalKey is the internal key that's used to anchor the commitment
 	// in the above out point.
 	InternalKey keychain.KeyDescriptor
@@ -68,6 +97,36 @@ type AnchoredCommitment struct {
 	// Asset is the asset that ratifies the above constraints, and should
 	// be used as an input to a transaction.
 	Asset *asset.Asset
+
+	// Lot is the acquisition metadata of the asset. This is nil if the
+	// lot of the asset isn't tracked.
+	Lot *AssetLot
+
+	// UnconfirmedProof is the encoded proof suffix of the asset if it is
+	// the change of one of our own transfers whose anchor transaction
+	// isn't confirmed yet. The asset doesn't have a full proof file until
+	// then, so the suffix is used as its input proof instead.
+	UnconfirmedProof []byte
+}
+
+// PrevID returns the previous input identifier of the asset of the
+// commitment, which also identifies its lot.
+func (c *AnchoredCommitment) PrevID() asset.PrevID {
+	return asset.PrevID{
+		OutPoint:  c.AnchorPoint,
+		ID:        c.Asset.ID(),
+		ScriptKey: asset.ToSerialized(c.Asset.ScriptKey.PubKey),
+	}
+}
+
+// acquiredAt returns the acquisition time of the lot of the commitment. Coins
+// without a tracked lot are treated as the oldest ones.
+func (c *AnchoredCommitment) acquiredAt() time.Time {
+	if c.Lot == nil {
+		return time.Time{}
+	}
+
+	return c.Lot.AcquiredAt
 }
 
 var (
@@ -77,6 +136,12 @@ var (
 	ErrMatchingAssetsNotFound = fmt.Errorf("failed to find coin(s) that " +
 		"satisfy given constraints; if previous transfers are un-" +
 		"confirmed, wait for them to confirm before trying again")
+
+	// ErrParcelNotConfirmed is returned if a confirmed parcel is looked up
+	// by the txid of an anchor transaction that isn't known or isn't
+	// confirmed yet.
+	ErrParcelNotConfirmed = fmt.Errorf("no confirmed parcel with the " +
+		"given anchor txid")
 )
 
 // CoinLister attracts over the coin selection process needed to be
@@ -105,6 +170,18 @@ type CoinLister interface {
 	DeleteExpiredLeases(ctx context.Context) error
 }
 
+// UnconfirmedChangeLister lists the asset change of our own transfers whose
+// anchor transactions aren't confirmed yet, so it can be spent by a transfer
+// that chains onto the unconfirmed anchor transaction.
+type UnconfirmedChangeLister interface {
+	// ListUnconfirmedChange returns the local outputs of broadcast but
+	// unconfirmed transfers that satisfy the given constraints and aren't
+	// leased or spent by another transfer yet. Only outputs that are the
+	// single asset committed to in their anchor output are returned.
+	ListUnconfirmedChange(context.Context,
+		CommitmentConstraints) ([]*AnchoredCommitment, error)
+}
+
 // MultiCommitmentSelectStrategy is an enum that describes the strategy that
 // should be used when preferentially selecting multiple commitments.
 type MultiCommitmentSelectStrategy uint8
@@ -114,8 +191,47 @@ const (
 	// descending amounts and selects the first subset which cumulatively
 	// sums to at least the minimum target amount.
 	PreferMaxAmount MultiCommitmentSelectStrategy = iota
+
+	// PreferMaxAmountConsolidate is a strategy which selects commitments
+	// like PreferMaxAmount. If the selected commitments leave some change,
+	// the smallest remaining commitment that is anchored at a distinct
+	// outpoint is selected as well, so the change is anchored together
+	// with its contents instead of leaving both in their own outputs.
+	PreferMaxAmountConsolidate
+
+	// PreferOldestLot is a strategy which considers commitments in order of
+	// ascending acquisition time (first in, first out) and selects the
+	// first subset which cumulatively sums to at least the minimum target
+	// amount. Commitments without a tracked lot are considered first.
+	PreferOldestLot
+
+	// PreferNewestLot is a strategy which considers commitments in order of
+	// descending acquisition time (last in, first out) and selects the
+	// first subset which cumulatively sums to at least the minimum target
+	// amount. Commitments without a tracked lot are considered last.
+	PreferNewestLot
 )
 
+// String returns a human-readable name of the strategy.
+func (s MultiCommitmentSelectStrategy) String() string {
+	switch s {
+	case PreferMaxAmount:
+		return "max_amount"
+
+	case PreferMaxAmountConsolidate:
+		return "max_amount_consolidate"
+
+	case PreferOldestLot:
+		return "oldest_lot"
+
+	case PreferNewestLot:
+		return "newest_lot"
+
+	default:
+		return fmt.Sprintf("<unknown(%d)>", s)
+	}
+}
+
 // CoinSelector is an interface that describes the functionality used in
 // selecting coins during the asset send process.
 type CoinSelector interface {
@@ -125,6 +241,22 @@ type CoinSelector interface {
 	SelectCoins(ctx context.Context, constraints CommitmentConstraints,
 		strategy MultiCommitmentSelectStrategy) ([]*AnchoredCommitment,
 		error)
+
+	// ReleaseCoins releases/unlocks coins that were previously leased and
+	// makes them available for coin selection again.
+	ReleaseCoins(ctx context.Context, utxoOutpoints ...wire.OutPoint) error
+}
+
+// ExternalCoinSelector is a coin selector that is implemented by an external
+// service, which allows custom inventory management logic to be applied when
+// selecting the inputs of a transfer.
+type ExternalCoinSelector interface {
+	// SelectCoins selects coins from the given eligible commitments whose
+	// amounts cumulatively sum to at least the minimum amount of the
+	// constraints. The lots of the selected commitments are returned.
+	SelectCoins(ctx context.Context, constraints CommitmentConstraints,
+		strategy MultiCommitmentSelectStrategy,
+		eligible []*AnchoredCommitment) ([]asset.PrevID, error)
 }
 
 // TransferInput represents the database level input to an asset transfer.
@@ -135,6 +267,10 @@ type TransferInput struct {
 
 	// Amount is the input amount that was spent.
 	Amount uint64
+
+	// Lot is the acquisition metadata of the spent asset. This is nil if
+	// the lot of the asset wasn't tracked.
+	Lot *AssetLot
 }
 
 // Anchor represents the database level representation of an anchor output.
@@ -201,6 +337,18 @@ type TransferOutput struct {
 	// includes all the proof information other than the final chain
 	// information.
 	ProofSuffix []byte
+
+	// ReceiverSettledAt is the time the receiver of the output notified
+	// us through the proof courier that it verified and accepted the
+	// output's proof. This is the zero time if no such notification was
+	// received.
+	ReceiverSettledAt time.Time
+}
+
+// SettledByReceiver returns true if the receiver of the output notified us
+// that it verified and accepted the output's proof.
+func (out *TransferOutput) SettledByReceiver() bool {
+	return !out.ReceiverSettledAt.IsZero()
 }
 
 // OutboundParcel represents the database level delta of an outbound Taproot
@@ -236,6 +384,87 @@ type OutboundParcel struct {
 	// Outputs represents the list of new assets that were created with this
 	// transfer.
 	Outputs []TransferOutput
+
+	// RequestID is the ID of the persisted parcel request this parcel was
+	// created from, if any. The request is removed from disk atomically
+	// with logging the parcel.
+	RequestID *[32]byte
+
+	// BroadcastTrigger is the earliest point at which the anchor
+	// transaction may be broadcast, or nil if it is broadcast right after
+	// the parcel is logged.
+	BroadcastTrigger *BroadcastTrigger
+
+	// ChangeKeyPolicy is the policy the script key of the change output of
+	// the transfer was derived with.
+	ChangeKeyPolicy ChangeKeyPolicy
+
+	// Version is the version of the encoding the parcel was persisted
+	// with. New parcels are created with LatestParcelVersion.
+	Version ParcelVersion
+
+	// PartialSend is set if the parcel only pays some of the requested
+	// addresses because the balance didn't cover all of them. It is only
+	// reported to the caller and isn't persisted.
+	PartialSend *PartialSend
+}
+
+// SettledByReceivers returns true if the parcel has outputs to external
+// receivers and all of them notified us that they verified and accepted their
+// proofs.
+func (o *OutboundParcel) SettledByReceivers() bool {
+	var numExternal int
+	for idx := range o.Outputs {
+		out := &o.Outputs[idx]
+		if out.ScriptKeyLocal {
+			continue
+		}
+
+		if !out.SettledByReceiver() {
+			return false
+		}
+		numExternal++
+	}
+
+	return numExternal > 0
+}
+
+// ParcelRequest is an outbound transfer request that was accepted by the
+// chain porter but not yet committed to disk as a pending parcel.
+type ParcelRequest struct {
+	// ID is the unique ID assigned to the request at intake.
+	ID [32]byte
+
+	// DestAddrs is the list of addresses the transfer pays to.
+	DestAddrs []*address.Tap
+
+	// Priority is the priority class the request is scheduled with.
+	Priority ParcelPriority
+
+	// CreatedAt is the time the request was accepted.
+	CreatedAt time.Time
+}
+
+// ParcelRequestLog persists accepted parcel requests, so they can be re-driven
+// from scratch if the daemon restarts before the resulting parcel was
+// committed to disk.
+type ParcelRequestLog interface {
+	// LogParcelRequest persists a newly accepted parcel request.
+	LogParcelRequest(ctx context.Context, req *ParcelRequest) error
+
+	// PendingParcelRequests returns all persisted parcel requests in the
+	// order they were accepted in.
+	PendingParcelRequests(ctx context.Context) ([]*ParcelRequest, error)
+
+	// DeleteParcelRequest removes a parcel request. Deleting a request
+	// that doesn't exist is not an error.
+	DeleteParcelRequest(ctx context.Context, id [32]byte) error
+
+	// ReleaseOrphanedLeases releases all asset coin leases of the
+	// given owner that aren't held by a pending parcel. This frees the
+	// coins selected by requests that were interrupted before their
+	// parcel was committed to disk.
+	ReleaseOrphanedLeases(ctx context.Context, leaseOwner [32]byte) error
 }
 
 // AssetConfirmEvent is used to mark a batched spend as confirmed on disk.
@@ -290,26 +519,101 @@ type PassiveAssetReAnchor struct {
 	NewWitnessData []asset.Witness
 }
 
-// ExportLog is used to track the state of outbound Taproot Asset parcels
-// (batched spends). This log is used by the ChainPorter to mark pending
-// outbound deliveries, and finally confirm the deliveries once they've been
-// committed to the main chain.
-type ExportLog interface {
+// TransferLog is the write half of the export log that records new outbound
+// parcels.
+//
+// LogPendingParcel must be atomic: either the parcel, its input leases and
+// the removal of its parcel request (if any) are all committed, or none of
+// them are and an error is returned. Once it returns without an error, the
+// parcel must be returned by PendingParcelStore.PendingParcels, even after a
+// restart and including its broadcast trigger, as the ChainPorter broadcasts
+// the anchor transaction next, once the trigger is reached.
+type TransferLog interface {
 	// LogPendingParcel marks an outbound parcel as pending on disk. This
 	// commits the set of changes to disk (the asset deltas) but doesn't
-	// mark the batched spend as being finalized.
+	// mark the batched spend as being finalized. The inputs of the parcel
+	// are leased to the given owner until the given expiry.
 	LogPendingParcel(context.Context, *OutboundParcel, [32]byte,
 		time.Time) error
 
+	// CancelPendingParcel removes the pending parcel with the given anchor
+	// txid, whose anchor transaction was never broadcast, and releases the
+	// leases of its inputs. This must be atomic: either the parcel is
+	// removed completely, or it stays pending.
+	CancelPendingParcel(ctx context.Context,
+		anchorTxid chainhash.Hash) error
+}
+
+// PendingParcelStore is the read half of the export log that is used to
+// resume parcels that were logged but not confirmed yet.
+type PendingParcelStore interface {
 	// PendingParcels returns the set of parcels that haven't yet been
 	// finalized. This can be used to query the set of unconfirmed
 	// transactions for re-broadcast.
 	PendingParcels(context.Context) ([]*OutboundParcel, error)
 
+	// UpdateParcelVersion records that the pending parcel with the given
+	// anchor txid was migrated to the given version.
+	UpdateParcelVersion(ctx context.Context, anchorTxid chainhash.Hash,
+		version ParcelVersion) error
+}
+
+// ParcelConfirmation is the location of the confirmed anchor transaction of a
+// parcel in the chain.
+type ParcelConfirmation struct {
+	// BlockHash is the hash of the block the anchor transaction confirmed
+	// in.
+	BlockHash chainhash.Hash
+
+	// BlockHeight is the height of the block the anchor transaction
+	// confirmed in.
+	BlockHeight uint32
+
+	// TxIndex is the index of the anchor transaction within its block.
+	TxIndex uint32
+}
+
+// ConfirmedParcelStore is used to look up parcels whose anchor transaction
+// already confirmed, for example to regenerate their proofs.
+type ConfirmedParcelStore interface {
+	// ConfirmedParcel returns the parcel with the given anchor txid and
+	// the location of its anchor transaction in the chain. If the parcel
+	// is unknown or not confirmed yet, ErrParcelNotConfirmed is returned.
+	ConfirmedParcel(ctx context.Context,
+		anchorTxid chainhash.Hash) (*OutboundParcel,
+		*ParcelConfirmation, error)
+}
+
+// DeliveryLog is the write half of the export log that finalizes parcels once
+// their anchor transaction confirmed.
+//
+// ConfirmParcelDelivery must be atomic: if it returns an error, for example
+// because the parcel is unknown or a proof for a local output is missing from
+// the event, nothing is changed and the parcel stays pending. Once it returns
+// without an error, the parcel must no longer be returned by
+// PendingParcelStore.PendingParcels.
+type DeliveryLog interface {
 	// ConfirmParcelDelivery marks a spend event on disk as confirmed. This
 	// updates the on-chain reference information on disk to point to this
 	// new spend.
 	ConfirmParcelDelivery(context.Context, *AssetConfirmEvent) error
+
+	// MarkReceiverSettled records that the receiver of the transfer output
+	// with the given anchor point and script key verified and accepted
+	// the output's proof.
+	MarkReceiverSettled(ctx context.Context, anchorPoint wire.OutPoint,
+		scriptKey *btcec.PublicKey, settledAt time.Time) error
+}
+
+// ExportLog is used to track the state of outbound Taproot Asset parcels
+// (batched spends). This log is used by the ChainPorter to mark pending
+// outbound deliveries, and finally confirm the deliveries once they've been
+// committed to the main chain. The separate halves can be implemented by
+// different types, as long as they share the same underlying state.
+type ExportLog interface {
+	TransferLog
+	PendingParcelStore
+	DeliveryLog
 }
 
 // ChainBridge aliases into the ChainBridge of the tapgarden package.
@@ -327,6 +631,9 @@ type WalletAnchor interface {
 // KeyRing aliases into the KeyRing of the tapgarden package.
 type KeyRing = tapgarden.KeyRing
 
+// KeyAuditLog aliases into the KeyAuditLog of the tapgarden package.
+type KeyAuditLog = tapgarden.KeyAuditLog
+
 // Signer aliases into the Signer interface of the tapscript package.
 type Signer = tapscript.Signer
 
@@ -338,6 +645,32 @@ type Porter interface {
 	// returned with the pending transfer information.
 	RequestShipment(req Parcel) (*OutboundParcel, error)
 
+	// QueueStats returns the queue depth metrics of each parcel priority
+	// class.
+	QueueStats() map[ParcelPriority]ParcelQueueStats
+
+	// SetParcelDebug enables or disables debug logging scoped to the
+	// parcel with the given ID.
+	SetParcelDebug(parcelID uint64, enabled bool)
+
+	// ExportParcelLog returns the structured log of the parcel with the
+	// given ID.
+	ExportParcelLog(parcelID uint64) (*ParcelLogBundle, error)
+
+	// ScheduledParcels returns the committed parcels whose anchor
+	// transaction is held back until their broadcast trigger is reached.
+	ScheduledParcels() []ScheduledParcel
+
+	// CancelScheduledParcel cancels the scheduled parcel with the given
+	// anchor txid before its anchor transaction is broadcast.
+	CancelScheduledParcel(anchorTxid chainhash.Hash) error
+
+	// RegenerateTransferProofs rebuilds the proofs of the outputs of the
+	// confirmed transfer with the given anchor txid and imports them into
+	// the proof archive again.
+	RegenerateTransferProofs(ctx context.Context,
+		anchorTxid chainhash.Hash) ([]*proof.AnnotatedProof, error)
+
 	// Start signals that the asset minter should being operations.
 	Start() error
 
@@ -0,0 +1,80 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// maxBatchDeferrals is the maximum number of batch intervals in a row the
+// release of held back batchable parcels is deferred for because of a
+// congested mempool.
+const maxBatchDeferrals = 3
+
+// mempoolInfo returns the current state of the mempool of the chain backend,
+// or nil if it is unavailable. The mempool state only refines the timing of
+// broadcasts, so errors are only logged.
+func (p *ChainPorter) mempoolInfo(ctx context.Context) *tapgarden.MempoolInfo {
+	info, err := p.chainBridge.MempoolInfo(ctx)
+	switch {
+	case errors.Is(err, tapgarden.ErrMempoolInfoUnavailable):
+		return nil
+
+	case err != nil:
+		log.Warnf("Unable to query mempool info: %v", err)
+		return nil
+	}
+
+	return info
+}
+
+// congestionFeeRate returns the fee rate a transaction needs to pay to be
+// confirmed soon according to the given mempool state. If the fee histogram
+// is known, this is the lowest fee rate of the next block, otherwise the
+// minimum fee rate of the mempool, which only rises above the relay fee once
+// the mempool is full. False is returned if neither is known.
+func congestionFeeRate(
+	info *tapgarden.MempoolInfo) (chainfee.SatPerKWeight, bool) {
+
+	if feeRate, ok := info.NextBlockFeeRate(); ok {
+		return feeRate, true
+	}
+
+	feeRate := info.MinAcceptedFee()
+
+	return feeRate, feeRate != 0
+}
+
+// deferBatch returns true if the release of the held back batchable parcels
+// should be deferred to the next batch interval, because the mempool is too
+// congested to broadcast them cheaply. The given number of deferrals of the
+// current batch limits how long a batch is held back.
+func (p *ChainPorter) deferBatch(numDeferrals int) bool {
+	maxFeeRate := p.cfg.BatchDeferFeeRate
+	if maxFeeRate == 0 || numDeferrals >= maxBatchDeferrals ||
+		!p.queue.hasHeld() {
+
+		return false
+	}
+
+	ctx, cancel := p.WithCtxQuit()
+	defer cancel()
+
+	info := p.mempoolInfo(ctx)
+	if info == nil {
+		return false
+	}
+
+	feeRate, ok := congestionFeeRate(info)
+	if !ok || feeRate <= maxFeeRate {
+		return false
+	}
+
+	log.Infof("Deferring release of batchable parcels, mempool fee rate "+
+		"%v above %v (mempool_txns=%d, mempool_vsize=%d)", feeRate,
+		maxFeeRate, info.NumTxns, info.VSize)
+
+	return true
+}
@@ -0,0 +1,169 @@
+package tapfreighter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+)
+
+const (
+	// DefaultOfflineSignTimeout is the default time an offline signer has
+	// to submit the signed virtual packet. As signing involves manually
+	// moving the packet to an air-gapped machine and back, this is a lot
+	// longer than the co-sign timeout.
+	DefaultOfflineSignTimeout = 24 * time.Hour
+)
+
+// VirtualPacketSigner signs the virtual transactions of asset transfers in
+// place of the local signer. This is used in watch-only mode, where the daemon
+// tracks assets and builds transfers, but doesn't have access to the private
+// keys of the script keys.
+type VirtualPacketSigner interface {
+	// SignVirtualPacket receives a copy of an unsigned virtual packet and
+	// returns the same packet with the witnesses of all inputs added to
+	// the new asset. Only the witnesses are taken from the returned
+	// packet, they are verified with the Taproot Asset VM before they are
+	// used.
+	SignVirtualPacket(ctx context.Context,
+		vPkt *tappsbt.VPacket) (*tappsbt.VPacket, error)
+}
+
+// offlineSignRequest is a virtual packet that waits for its signature.
+type offlineSignRequest struct {
+	// vPkt is the unsigned virtual packet.
+	vPkt *tappsbt.VPacket
+
+	// signedPkt receives the signed virtual packet.
+	signedPkt chan *tappsbt.VPacket
+}
+
+// OfflineSigner is a VirtualPacketSigner that exports virtual packets to an
+// offline signer, for example a second daemon on an air-gapped machine that
+// holds the script keys. Virtual packets are held until the signed packet is
+// submitted through SubmitSignedPacket, or the timeout expires.
+type OfflineSigner struct {
+	mtx sync.Mutex
+
+	// timeout is the time the offline signer has to submit the signed
+	// virtual packet.
+	timeout time.Duration
+
+	// pending are the virtual packets that wait for their signature,
+	// keyed by their virtual transaction ID.
+	pending map[chainhash.Hash]*offlineSignRequest
+}
+
+// NewOfflineSigner creates a new offline signer that waits for up to the given
+// timeout for each signature.
+func NewOfflineSigner(timeout time.Duration) *OfflineSigner {
+	return &OfflineSigner{
+		timeout: timeout,
+		pending: make(map[chainhash.Hash]*offlineSignRequest),
+	}
+}
+
+// SignVirtualPacket holds the virtual packet until its signed version is
+// submitted.
+//
+// NOTE: This is part of the VirtualPacketSigner interface.
+func (o *OfflineSigner) SignVirtualPacket(ctx context.Context,
+	vPkt *tappsbt.VPacket) (*tappsbt.VPacket, error) {
+
+	vTxID, err := tapscript.VirtualTxID(vPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute virtual tx ID: %w",
+			err)
+	}
+
+	req := &offlineSignRequest{
+		vPkt:      vPkt,
+		signedPkt: make(chan *tappsbt.VPacket, 1),
+	}
+
+	o.mtx.Lock()
+	if _, ok := o.pending[vTxID]; ok {
+		o.mtx.Unlock()
+		return nil, fmt.Errorf("virtual tx %v already waits for "+
+			"signature", vTxID)
+	}
+	o.pending[vTxID] = req
+	o.mtx.Unlock()
+
+	defer func() {
+		o.mtx.Lock()
+		delete(o.pending, vTxID)
+		o.mtx.Unlock()
+	}()
+
+	log.Infof("Waiting for offline signature of virtual tx %v", vTxID)
+
+	select {
+	case signedPkt := <-req.signedPkt:
+		return signedPkt, nil
+
+	case <-time.After(o.timeout):
+		return nil, fmt.Errorf("timed out waiting for offline "+
+			"signature of virtual tx %v", vTxID)
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// PendingPackets returns copies of all virtual packets that wait for their
+// signature.
+func (o *OfflineSigner) PendingPackets() ([]*tappsbt.VPacket, error) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	vPkts := make([]*tappsbt.VPacket, 0, len(o.pending))
+	for _, req := range o.pending {
+		vPkt, err := copyVPacket(req.vPkt)
+		if err != nil {
+			return nil, err
+		}
+		vPkts = append(vPkts, vPkt)
+	}
+
+	return vPkts, nil
+}
+
+// SubmitSignedPacket hands the signed version of a pending virtual packet back
+// to the transfer that waits for it.
+func (o *OfflineSigner) SubmitSignedPacket(signedPkt *tappsbt.VPacket) error {
+	vTxID, err := tapscript.VirtualTxID(signedPkt)
+	if err != nil {
+		return fmt.Errorf("unable to compute virtual tx ID: %w", err)
+	}
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	req, ok := o.pending[vTxID]
+	if !ok {
+		return fmt.Errorf("no virtual tx %v waits for signature",
+			vTxID)
+	}
+
+	// Because the virtual transaction ID matches, the signer can't have
+	// changed the inputs or the new asset, but we make sure all inputs
+	// were signed before we continue the transfer.
+	if _, err := tapscript.VirtualWitnesses(signedPkt); err != nil {
+		return fmt.Errorf("invalid signed virtual tx %v: %w", vTxID,
+			err)
+	}
+
+	delete(o.pending, vTxID)
+	req.signedPkt <- signedPkt
+
+	return nil
+}
+
+// A compile-time assertion to ensure OfflineSigner meets the
+// VirtualPacketSigner interface.
+var _ VirtualPacketSigner = (*OfflineSigner)(nil)
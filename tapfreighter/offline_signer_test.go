@@ -0,0 +1,93 @@
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/stretchr/testify/require"
+)
+
+// newUnsignedVPacket creates a random virtual packet with a single input that
+// isn't signed yet.
+func newUnsignedVPacket(t *testing.T) *tappsbt.VPacket {
+	vPkt := tappsbt.RandPacket(t)
+	vPkt.Inputs = vPkt.Inputs[:1]
+	vPkt.Outputs = vPkt.Outputs[:1]
+	vPkt.Outputs[0].Type = tappsbt.TypeSimple
+	vPkt.Outputs[0].SplitAsset = nil
+
+	// The new asset spends the full input asset to a new script key.
+	newAsset := vPkt.Inputs[0].Asset().Copy()
+	newAsset.ScriptKey = vPkt.Outputs[0].Asset.ScriptKey
+	newAsset.PrevWitnesses = []asset.Witness{{
+		PrevID: &vPkt.Inputs[0].PrevID,
+	}}
+	vPkt.Outputs[0].Asset = newAsset
+
+	return vPkt
+}
+
+// TestOfflineSigner tests the virtual packet round-trip with an offline
+// signer.
+func TestOfflineSigner(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	signer := NewOfflineSigner(time.Minute)
+	vPkt := newUnsignedVPacket(t)
+
+	type result struct {
+		vPkt *tappsbt.VPacket
+		err  error
+	}
+	resultChan := make(chan result, 1)
+	go func() {
+		signedPkt, err := signer.SignVirtualPacket(ctx, vPkt)
+		resultChan <- result{signedPkt, err}
+	}()
+
+	var pending []*tappsbt.VPacket
+	require.Eventually(t, func() bool {
+		var err error
+		pending, err = signer.PendingPackets()
+		require.NoError(t, err)
+
+		return len(pending) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// A packet for a different virtual transaction is rejected, and so is
+	// the pending packet as long as it isn't signed.
+	err := signer.SubmitSignedPacket(newUnsignedVPacket(t))
+	require.ErrorContains(t, err, "waits for signature")
+
+	err = signer.SubmitSignedPacket(pending[0])
+	require.ErrorContains(t, err, "input 0 is not signed")
+
+	// The signed packet is handed back to the waiting transfer.
+	signedPkt := pending[0]
+	witness := wire.TxWitness{test.RandBytes(64)}
+	signedPkt.Outputs[0].Asset.PrevWitnesses[0].TxWitness = witness
+	require.NoError(t, signer.SubmitSignedPacket(signedPkt))
+
+	res := <-resultChan
+	require.NoError(t, res.err)
+
+	witnesses, err := tapscript.VirtualWitnesses(res.vPkt)
+	require.NoError(t, err)
+	require.Equal(t, []wire.TxWitness{witness}, witnesses)
+
+	pending, err = signer.PendingPackets()
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	// Without a submission, the transfer fails after the timeout.
+	signer = NewOfflineSigner(10 * time.Millisecond)
+	_, err = signer.SignVirtualPacket(ctx, vPkt)
+	require.ErrorContains(t, err, "timed out")
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
-	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/tappsbt"
@@ -37,6 +36,11 @@ const (
 	// then finalize to place the necessary signatures in the transaction.
 	SendStateAnchorSign
 
+	// SendStateVerifyProofs is the optional state in which we assemble the
+	// final proofs of the signed transfer against a dry run block and
+	// verify them, before anything is committed to disk or broadcast.
+	SendStateVerifyProofs
+
 	// SendStateLogCommit is the final in memory state. In this state,
 	// we'll extract the signed transaction from the PSBT and log the
 	// transfer information to disk. At this point, after a restart, the
@@ -77,6 +81,9 @@ func (s SendState) String() string {
 	case SendStateAnchorSign:
 		return "SendStateAnchorSign"
 
+	case SendStateVerifyProofs:
+		return "SendStateVerifyProofs"
+
 	case SendStateLogCommit:
 		return "SendStateLogCommit"
 
@@ -117,6 +124,126 @@ type parcelKit struct {
 
 	// errChan is the channel the error will be sent over.
 	errChan chan error
+
+	// priority is the priority class the parcel is scheduled with.
+	priority ParcelPriority
+
+	// requestID is the ID of the persisted parcel request, if the request
+	// was persisted at intake.
+	requestID *[32]byte
+
+	// parcelID is the identifier the porter assigned to the parcel at
+	// intake. It is unique for the lifetime of the porter.
+	parcelID uint64
+
+	// coSigner is the co-signer of anchor inputs the wallet can't sign for
+	// alone, if any.
+	coSigner AnchorCoSigner
+
+	// fundingConstraints restricts the BTC level inputs used to fund the
+	// anchor transaction.
+	fundingConstraints AnchorFundingConstraints
+
+	// anchorLockTime determines the lock time and the input sequence
+	// numbers of the anchor transaction.
+	anchorLockTime AnchorLockTime
+
+	// confDeadline is the optional deadline by which the anchor
+	// transaction needs to be confirmed.
+	confDeadline *ConfDeadline
+
+	// broadcastTrigger is the optional earliest point at which the anchor
+	// transaction may be broadcast.
+	broadcastTrigger *BroadcastTrigger
+
+	// inputWitnesses are the externally provided witnesses of the asset
+	// inputs they are keyed by.
+	inputWitnesses map[asset.PrevID]wire.TxWitness
+
+	// budget is the share of the porter's budget the parcel holds while it
+	// is in flight.
+	budget *budgetShare
+}
+
+// ParcelID returns the identifier the porter assigned to the parcel, which
+// matches the parcel ID of the events it publishes for the parcel. The ID is
+// set once the porter accepts the parcel, so it must only be read after
+// RequestShipment returned.
+func (p *parcelKit) ParcelID() uint64 {
+	return p.parcelID
+}
+
+// SetPriority sets the priority class the parcel is scheduled with. This must
+// be called before the parcel is handed to the chain porter.
+func (p *parcelKit) SetPriority(priority ParcelPriority) {
+	p.priority = priority
+}
+
+// SetAnchorCoSigner sets the co-signer that produces the witnesses of anchor
+// inputs that are co-owned with external parties. This must be called before
+// the parcel is handed to the chain porter.
+func (p *parcelKit) SetAnchorCoSigner(coSigner AnchorCoSigner) {
+	p.coSigner = coSigner
+}
+
+// SetAnchorFundingConstraints restricts the BTC level inputs the wallet may
+// select to fund the anchor transaction of the parcel. This must be called
+// before the parcel is handed to the chain porter.
+func (p *parcelKit) SetAnchorFundingConstraints(
+	constraints AnchorFundingConstraints) {
+
+	p.fundingConstraints = constraints
+}
+
+// SetAnchorLockTime sets an explicit lock time and input sequence numbers of
+// the anchor transaction, for protocols that require them. Without an explicit
+// lock time, the lock time is set to the current height to discourage fee
+// sniping. This must be called before the parcel is handed to the chain
+// porter.
+func (p *parcelKit) SetAnchorLockTime(lockTime AnchorLockTime) {
+	p.anchorLockTime = lockTime
+}
+
+// SetConfDeadline sets the time by which the anchor transaction of the parcel
+// needs to be confirmed. Until then, the fee of the broadcast anchor
+// transaction is raised according to the given escalation schedule. If the
+// deadline is missed, a ConfDeadlineExceededEvent is published. This must be
+// called before the parcel is handed to the chain porter.
+func (p *parcelKit) SetConfDeadline(deadline time.Time,
+	escalation FeeEscalation) {
+
+	p.confDeadline = &ConfDeadline{
+		Deadline:   deadline,
+		Escalation: escalation,
+	}
+}
+
+// SetBroadcastTrigger holds back the broadcast of the anchor transaction of
+// the parcel until the given trigger is reached. The parcel is still signed
+// and committed to disk right away, and can be canceled until it is
+// broadcast. The BTC inputs that fund the anchor transaction are only leased
+// by the wallet for its default lease duration, so spending them before the
+// trigger is reached makes the broadcast fail. This must be called before the
+// parcel is handed to the chain porter.
+func (p *parcelKit) SetBroadcastTrigger(trigger BroadcastTrigger) {
+	p.broadcastTrigger = &trigger
+}
+
+// SetInputWitness sets a pre-built witness for the asset input with the given
+// previous ID, which is used instead of having the wallet sign the input. This
+// allows spending script paths that require data the wallet doesn't have, such
+// as the preimage of an HTLC leaf. The witness is validated together with the
+// rest of the virtual transaction before the anchor transaction is funded, so
+// an invalid witness fails the parcel. It is only used for parcels whose
+// virtual transaction is signed by the chain porter. This must be called
+// before the parcel is handed to the chain porter.
+func (p *parcelKit) SetInputWitness(prevID asset.PrevID,
+	witness wire.TxWitness) {
+
+	if p.inputWitnesses == nil {
+		p.inputWitnesses = make(map[asset.PrevID]wire.TxWitness)
+	}
+	p.inputWitnesses[prevID] = witness
 }
 
 // AddressParcel is the main request to issue an asset transfer. This packages a
@@ -127,6 +254,14 @@ type AddressParcel struct {
 	// destAddrs is the list of address that should be used to satisfy the
 	// transfer.
 	destAddrs []*address.Tap
+
+	// fundOpts are the options the virtual packet of the transfer is
+	// funded with.
+	fundOpts []FundOption
+
+	// partialSendMode determines how the parcel is handled if the
+	// available balance can't cover all addresses.
+	partialSendMode PartialSendMode
 }
 
 // A compile-time assertion to ensure AddressParcel implements the parcel
@@ -144,6 +279,28 @@ func NewAddressParcel(destAddrs ...*address.Tap) *AddressParcel {
 	}
 }
 
+// SetSelectStrategy sets the coin selection strategy the inputs of the
+// transfer are selected with, overriding the default strategy of the wallet.
+// This must be called before the parcel is handed to the chain porter.
+func (p *AddressParcel) SetSelectStrategy(
+	strategy MultiCommitmentSelectStrategy) {
+
+	p.fundOpts = append(p.fundOpts, WithSelectStrategy(strategy))
+}
+
+// SetLots restricts the inputs of the transfer to the given lots. This must be
+// called before the parcel is handed to the chain porter.
+func (p *AddressParcel) SetLots(lots ...asset.PrevID) {
+	p.fundOpts = append(p.fundOpts, WithLots(lots...))
+}
+
+// SetPartialSendMode sets how the parcel is handled if the available balance
+// can't cover all of its addresses. This must be called before the parcel is
+// handed to the chain porter.
+func (p *AddressParcel) SetPartialSendMode(mode PartialSendMode) {
+	p.partialSendMode = mode
+}
+
 // pkg returns the send package that should be delivered.
 func (p *AddressParcel) pkg() *sendPackage {
 	log.Infof("Received to send request to %d addrs: %v", len(p.destAddrs),
@@ -255,6 +412,10 @@ type sendPackage struct {
 	// SendState is the current send state of this parcel.
 	SendState SendState
 
+	// ParcelID is the identifier the porter assigned to the parcel that
+	// kicked off this transfer.
+	ParcelID uint64
+
 	// VirtualPacket is the virtual packet that we'll use to construct the
 	// virtual asset transition transaction.
 	VirtualPacket *tappsbt.VPacket
@@ -282,9 +443,52 @@ type sendPackage struct {
 	// keyed by their script key.
 	FinalProofs map[asset.SerializedKey]*proof.AnnotatedProof
 
+	// PartialSend describes the addresses of an address parcel that are
+	// paid and the ones that aren't, if the parcel is only fulfilled
+	// partially.
+	PartialSend *PartialSend
+
+	// ChangeKeyPolicy is the policy the script key of the change output
+	// was derived with.
+	ChangeKeyPolicy ChangeKeyPolicy
+
 	// TransferTxConfEvent contains transfer transaction on-chain
 	// confirmation data.
 	TransferTxConfEvent *chainntnfs.TxConfirmation
+
+	// proofCache caches the exclusion proofs of the output commitments of
+	// the anchor transaction, shared between the proofs of all outputs.
+	proofCache *commitment.ExclusionProofCache
+
+	// budget is the share of the porter's budget held by the parcel.
+	budget *budgetShare
+}
+
+// exclusionProofCache returns the exclusion proof cache for the output
+// commitments of the anchor transaction, creating it if necessary.
+func (s *sendPackage) exclusionProofCache() *commitment.ExclusionProofCache {
+	if s.proofCache == nil {
+		s.proofCache = commitment.NewExclusionProofCache(
+			s.AnchorTx.OutputCommitments,
+		)
+	}
+
+	return s.proofCache
+}
+
+// hookInfo returns the information about the package that is passed to the
+// state hooks of the given state and stage.
+func (s *sendPackage) hookInfo(state SendState,
+	stage HookStage) *StateHookInfo {
+
+	return &StateHookInfo{
+		ParcelID:       s.ParcelID,
+		State:          state,
+		Stage:          stage,
+		VirtualPacket:  s.VirtualPacket,
+		AnchorTx:       s.AnchorTx,
+		OutboundParcel: s.OutboundPkg,
+	}
 }
 
 // prepareForStorage prepares the send package for storing to the database.
@@ -313,11 +517,14 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 		AnchorTx:           s.AnchorTx.FinalTx,
 		AnchorTxHeightHint: currentHeight,
 		// TODO(bhandras): use clock.Clock instead.
-		TransferTime:  time.Now(),
-		ChainFees:     s.AnchorTx.ChainFees,
-		Inputs:        make([]TransferInput, len(vPkt.Inputs)),
-		Outputs:       make([]TransferOutput, len(vPkt.Outputs)),
-		PassiveAssets: s.PassiveAssets,
+		TransferTime:    time.Now(),
+		ChainFees:       s.AnchorTx.ChainFees,
+		Inputs:          make([]TransferInput, len(vPkt.Inputs)),
+		Outputs:         make([]TransferOutput, len(vPkt.Outputs)),
+		PassiveAssets:   s.PassiveAssets,
+		PartialSend:     s.PartialSend,
+		ChangeKeyPolicy: s.ChangeKeyPolicy,
+		Version:         LatestParcelVersion,
 	}
 
 	for idx := range vPkt.Inputs {
@@ -462,7 +669,9 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 func (s *sendPackage) createProofSuffix(outIndex int) (*proof.Proof, error) {
 	inputPrevID := s.VirtualPacket.Inputs[0].PrevID
 
-	params, err := proofParams(s.AnchorTx, s.VirtualPacket, outIndex)
+	params, err := proofParams(
+		s.AnchorTx, s.VirtualPacket, outIndex, s.exclusionProofCache(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -525,9 +734,11 @@ func newParams(anchorTx *AnchorTransaction, a *asset.Asset, outputIndex int,
 }
 
 // proofParams creates the set of parameters that will be used to create the
-// proofs for the sender and receiver.
+// proofs for the sender and receiver. The exclusion proofs are taken from the
+// given cache, which is shared between all outputs of the anchor transaction.
 func proofParams(anchorTx *AnchorTransaction, vPkt *tappsbt.VPacket,
-	outIndex int) (*proof.TransitionParams, error) {
+	outIndex int, proofCache *commitment.ExclusionProofCache) (
+	*proof.TransitionParams, error) {
 
 	outputCommitments := anchorTx.OutputCommitments
 
@@ -554,8 +765,7 @@ func proofParams(anchorTx *AnchorTransaction, vPkt *tappsbt.VPacket,
 
 		// Add exclusion proofs for all the other outputs.
 		err = addOtherOutputExclusionProofs(
-			vPkt.Outputs, rootOut.Asset, rootParams,
-			outputCommitments,
+			vPkt.Outputs, rootOut.Asset, rootParams, proofCache,
 			func(i int, _ *tappsbt.VOutput) bool {
 				return i == outIndex
 			},
@@ -582,8 +792,8 @@ func proofParams(anchorTx *AnchorTransaction, vPkt *tappsbt.VPacket,
 	splitIndex := splitOut.AnchorOutputIndex
 	splitTapTree := outputCommitments[splitIndex]
 
-	_, splitRootExclusionProof, err := splitRootTree.Proof(
-		splitOut.Asset.TapCommitmentKey(),
+	splitRootExclusionProof, err := proofCache.Proof(
+		splitRootIndex, splitOut.Asset.TapCommitmentKey(),
 		splitOut.Asset.AssetCommitmentKey(),
 	)
 	if err != nil {
@@ -610,7 +820,7 @@ func proofParams(anchorTx *AnchorTransaction, vPkt *tappsbt.VPacket,
 
 	// Add exclusion proofs for all the other outputs.
 	err = addOtherOutputExclusionProofs(
-		vPkt.Outputs, splitOut.Asset, splitParams, outputCommitments,
+		vPkt.Outputs, splitOut.Asset, splitParams, proofCache,
 		func(i int, vOut *tappsbt.VOutput) bool {
 			// We don't need exclusion proofs for:
 			//	- The split output itself.
@@ -633,7 +843,7 @@ func proofParams(anchorTx *AnchorTransaction, vPkt *tappsbt.VPacket,
 // return false for not yet processed outputs, otherwise they'll be skipped).
 func addOtherOutputExclusionProofs(outputs []*tappsbt.VOutput,
 	asset *asset.Asset, params *proof.TransitionParams,
-	outputCommitments map[uint32]*commitment.TapCommitment,
+	proofCache *commitment.ExclusionProofCache,
 	skip func(int, *tappsbt.VOutput) bool) error {
 
 	for idx := range outputs {
@@ -645,20 +855,23 @@ func addOtherOutputExclusionProofs(outputs []*tappsbt.VOutput,
 		}
 
 		outIndex := vOut.AnchorOutputIndex
-		tapTree := outputCommitments[outIndex]
-
-		_, splitExclusionProof, err := tapTree.Proof(
-			asset.TapCommitmentKey(),
+		splitExclusionProof, err := proofCache.Proof(
+			outIndex, asset.TapCommitmentKey(),
 			asset.AssetCommitmentKey(),
 		)
 		if err != nil {
 			return err
 		}
 
+		tapscriptRoot, err := proofCache.TapscriptRoot(outIndex)
+		if err != nil {
+			return err
+		}
+
 		log.Tracef("Generated exclusion proof for anchor output index "+
 			"%d with asset_id=%v, taproot_asset_root=%x, "+
 			"internal_key=%x", outIndex, asset.ID(),
-			fn.ByteSlice(tapTree.TapscriptRoot(nil)),
+			tapscriptRoot[:],
 			vOut.AnchorOutputInternalKey.SerializeCompressed())
 
 		siblingPreimage := vOut.AnchorOutputTapscriptSibling
@@ -714,7 +927,8 @@ func (s *sendPackage) createReAnchorProof(
 	// BTC level outputs.
 	err = addOtherOutputExclusionProofs(
 		s.VirtualPacket.Outputs, passiveOut.Asset, passiveParams,
-		outputCommitments, func(i int, vOut *tappsbt.VOutput) bool {
+		s.exclusionProofCache(),
+		func(i int, vOut *tappsbt.VOutput) bool {
 			return vOut.AnchorOutputIndex == passiveOutputIndex
 		},
 	)
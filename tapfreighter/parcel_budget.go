@@ -0,0 +1,242 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+)
+
+// ErrParcelOverBudget is returned if a parcel on its own needs more memory
+// than the configured budget of the porter allows.
+var ErrParcelOverBudget = errors.New("parcel exceeds memory budget")
+
+// budgetShare is the part of the porter's budget that is held by a single
+// parcel.
+type budgetShare struct {
+	// inFlight is true if the share counts towards the in-flight parcels.
+	inFlight bool
+
+	// passiveProofs is the number of passive asset proofs held by the
+	// parcel.
+	passiveProofs int
+}
+
+// parcelBudget limits the number of parcels that are in flight and the total
+// number of passive asset proofs they hold in memory. A parcel is in flight
+// from the moment it is accepted until its proofs were delivered or it failed.
+type parcelBudget struct {
+	// maxInFlight is the maximum number of parcels in flight. Zero means
+	// unlimited.
+	maxInFlight int
+
+	// maxPassiveProofs is the maximum number of passive asset proofs held
+	// by all parcels together. Zero means unlimited.
+	maxPassiveProofs int
+
+	mtx sync.Mutex
+
+	// inFlight is the number of parcels currently in flight.
+	inFlight int
+
+	// passiveProofs is the number of passive asset proofs currently held.
+	passiveProofs int
+
+	// released is closed and replaced whenever a share is released, which
+	// wakes up all waiters.
+	released chan struct{}
+}
+
+// newParcelBudget creates a new parcel budget with the given limits.
+func newParcelBudget(maxInFlight, maxPassiveProofs int) *parcelBudget {
+	return &parcelBudget{
+		maxInFlight:      maxInFlight,
+		maxPassiveProofs: maxPassiveProofs,
+		released:         make(chan struct{}),
+	}
+}
+
+// reserveParcel waits until another parcel may be in flight and returns its
+// share of the budget.
+func (b *parcelBudget) reserveParcel(quit <-chan struct{}) (*budgetShare,
+	error) {
+
+	for {
+		b.mtx.Lock()
+		if b.maxInFlight == 0 || b.inFlight < b.maxInFlight {
+			b.inFlight++
+			b.mtx.Unlock()
+
+			return &budgetShare{inFlight: true}, nil
+		}
+		released := b.released
+		b.mtx.Unlock()
+
+		select {
+		case <-released:
+		case <-quit:
+			return nil, fmt.Errorf("ChainPorter shutting down")
+		}
+	}
+}
+
+// forceReserveParcel returns the share of a parcel that holds the given number
+// of passive asset proofs, even if this exceeds the budget. This is used for
+// parcels that were accepted before a restart, which must not be refused.
+func (b *parcelBudget) forceReserveParcel(passiveProofs int) *budgetShare {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.inFlight++
+	b.passiveProofs += passiveProofs
+
+	return &budgetShare{
+		inFlight:      true,
+		passiveProofs: passiveProofs,
+	}
+}
+
+// reservePassiveProofs waits until the given number of passive asset proofs
+// fit into the budget and sets them as the passive asset proofs of the given
+// share, replacing those it held before. ErrParcelOverBudget is returned if
+// they exceed the whole budget.
+func (b *parcelBudget) reservePassiveProofs(share *budgetShare, num int,
+	quit <-chan struct{}) error {
+
+	if b.maxPassiveProofs > 0 && num > b.maxPassiveProofs {
+		return fmt.Errorf("%w: %d passive asset proofs, budget is %d",
+			ErrParcelOverBudget, num, b.maxPassiveProofs)
+	}
+
+	for {
+		b.mtx.Lock()
+		total := b.passiveProofs - share.passiveProofs + num
+		if b.maxPassiveProofs == 0 || total <= b.maxPassiveProofs {
+			b.passiveProofs = total
+			share.passiveProofs = num
+			b.mtx.Unlock()
+
+			return nil
+		}
+		released := b.released
+		b.mtx.Unlock()
+
+		select {
+		case <-released:
+		case <-quit:
+			return fmt.Errorf("ChainPorter shutting down")
+		}
+	}
+}
+
+// release returns the given share to the budget. Releasing a share more than
+// once has no effect.
+func (b *parcelBudget) release(share *budgetShare) {
+	if share == nil {
+		return
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if share.inFlight {
+		b.inFlight--
+	}
+	b.passiveProofs -= share.passiveProofs
+	*share = budgetShare{}
+
+	close(b.released)
+	b.released = make(chan struct{})
+}
+
+// reserveProofBudget reserves the passive asset proofs of the given package in
+// the budget of the porter and makes sure the package doesn't buffer too many
+// proof bytes.
+func (p *ChainPorter) reserveProofBudget(ctx context.Context,
+	pkg *sendPackage) error {
+
+	if pkg.budget != nil {
+		err := p.budget.reservePassiveProofs(
+			pkg.budget, len(pkg.PassiveAssets), p.Quit,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return p.checkParcelProofBytes(ctx, pkg)
+}
+
+// checkParcelProofBytes makes sure the proof files the given package buffers
+// once its anchor transaction confirmed don't exceed the configured maximum.
+// Every output proof file contains the proof files of all active inputs, and
+// every passive asset proof file is loaded on top of that. Input proofs that
+// aren't in the archive yet, like those of unconfirmed change, aren't counted.
+func (p *ChainPorter) checkParcelProofBytes(ctx context.Context,
+	pkg *sendPackage) error {
+
+	maxBytes := p.cfg.MaxParcelProofBytes
+	if maxBytes == 0 {
+		return nil
+	}
+
+	proofSize := func(locator proof.Locator) (int64, error) {
+		blob, err := p.cfg.AssetProofs.FetchProof(ctx, locator)
+		switch {
+		case errors.Is(err, proof.ErrProofNotFound):
+			return 0, nil
+
+		case err != nil:
+			return 0, fmt.Errorf("unable to fetch proof: %w", err)
+		}
+
+		return int64(len(blob)), nil
+	}
+
+	vPkt := pkg.VirtualPacket
+	var inputBytes int64
+	for _, vIn := range vPkt.Inputs {
+		scriptKey, err := btcec.ParsePubKey(vIn.PrevID.ScriptKey[:])
+		if err != nil {
+			return fmt.Errorf("unable to parse script key: %w", err)
+		}
+
+		size, err := proofSize(proof.Locator{
+			AssetID:   &vIn.PrevID.ID,
+			ScriptKey: *scriptKey,
+		})
+		if err != nil {
+			return err
+		}
+		inputBytes += size
+	}
+
+	var totalBytes int64
+	for _, vOut := range vPkt.Outputs {
+		if vOut.Type != tappsbt.TypePassiveAssetsOnly {
+			totalBytes += inputBytes
+		}
+	}
+
+	for _, passiveAsset := range pkg.PassiveAssets {
+		size, err := proofSize(proof.Locator{
+			AssetID:   &passiveAsset.GenesisID,
+			ScriptKey: *passiveAsset.ScriptKey.PubKey,
+		})
+		if err != nil {
+			return err
+		}
+		totalBytes += size
+	}
+
+	if totalBytes > maxBytes {
+		return fmt.Errorf("%w: %d proof bytes, maximum is %d",
+			ErrParcelOverBudget, totalBytes, maxBytes)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package tapfreighter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestParcelBudget tests that the budget pushes back on new parcels and
+// passive asset proofs until enough of it was released.
+func TestParcelBudget(t *testing.T) {
+	t.Parallel()
+
+	quit := make(chan struct{})
+	defer close(quit)
+
+	b := newParcelBudget(2, 10)
+
+	share1, err := b.reserveParcel(quit)
+	require.NoError(t, err)
+	share2, err := b.reserveParcel(quit)
+	require.NoError(t, err)
+
+	// The third parcel has to wait until one of the others is released.
+	reserved := make(chan *budgetShare)
+	go func() {
+		share, err := b.reserveParcel(quit)
+		if err == nil {
+			reserved <- share
+		}
+	}()
+
+	select {
+	case <-reserved:
+		t.Fatalf("parcel reserved beyond budget")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	b.release(share1)
+	var share3 *budgetShare
+	select {
+	case share3 = <-reserved:
+	case <-time.After(time.Second):
+		t.Fatalf("parcel not reserved after release")
+	}
+
+	// Releasing a share twice doesn't free up more of the budget.
+	b.release(share1)
+	require.Equal(t, 2, b.inFlight)
+
+	// Passive asset proofs that exceed the whole budget are refused right
+	// away, reserving them again replaces the previous reservation.
+	err = b.reservePassiveProofs(share2, 11, quit)
+	require.ErrorIs(t, err, ErrParcelOverBudget)
+
+	require.NoError(t, b.reservePassiveProofs(share2, 8, quit))
+	require.NoError(t, b.reservePassiveProofs(share2, 6, quit))
+	require.Equal(t, 6, b.passiveProofs)
+
+	// Resumed parcels are accepted even if they exceed the budget.
+	resumed := b.forceReserveParcel(5)
+	require.Equal(t, 3, b.inFlight)
+	require.Equal(t, 11, b.passiveProofs)
+
+	done := make(chan error)
+	go func() {
+		done <- b.reservePassiveProofs(share3, 4, quit)
+	}()
+
+	select {
+	case <-done:
+		t.Fatalf("passive proofs reserved beyond budget")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	b.release(resumed)
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatalf("passive proofs not reserved after release")
+	}
+	require.Equal(t, 10, b.passiveProofs)
+
+	b.release(share2)
+	b.release(share3)
+	require.Zero(t, b.inFlight)
+	require.Zero(t, b.passiveProofs)
+}
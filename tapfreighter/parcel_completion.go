@@ -0,0 +1,157 @@
+package tapfreighter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+var (
+	// completionRetryInitialBackoff is the initial time to wait before a
+	// completion handler that returned an error is called again.
+	completionRetryInitialBackoff = time.Second
+
+	// completionRetryMaxBackoff is the maximum time to wait between calls
+	// of a failing completion handler.
+	completionRetryMaxBackoff = time.Minute
+)
+
+// ParcelCompletion describes a parcel whose delivery was confirmed on disk.
+type ParcelCompletion struct {
+	// ParcelID is the ID of the completed parcel.
+	ParcelID uint64
+
+	// Parcel is the completed outbound parcel.
+	Parcel *OutboundParcel
+
+	// AnchorTXID is the hash of the confirmed anchor transaction, which
+	// uniquely identifies the transfer.
+	AnchorTXID chainhash.Hash
+
+	// BlockHash is the hash of the block that confirmed the anchor
+	// transaction.
+	BlockHash chainhash.Hash
+
+	// BlockHeight is the height of the block that confirmed the anchor
+	// transaction.
+	BlockHeight uint32
+
+	// ProofLocators are the locators of the final proofs of the outputs of
+	// the parcel, in the order of the outputs.
+	ProofLocators []proof.Locator
+
+	// PassiveProofLocators are the locators of the final proofs of the
+	// passive assets that were re-anchored by the parcel.
+	PassiveProofLocators []proof.Locator
+}
+
+// CompletionHandler is notified synchronously once the delivery of a parcel
+// was confirmed, for example to record the transfer in an accounting system.
+type CompletionHandler interface {
+	// ParcelCompleted is called after the delivery of the given parcel
+	// was confirmed on disk. If it returns an error, it is called again
+	// with the same completion until it succeeds or the porter shuts
+	// down, so it must be idempotent. The anchor transaction ID can be
+	// used as the key to deduplicate completions with.
+	ParcelCompleted(ctx context.Context, completion *ParcelCompletion) error
+}
+
+// newParcelCompletion creates the completion of the given package, which was
+// confirmed with the given event.
+func newParcelCompletion(pkg *sendPackage,
+	event *AssetConfirmEvent) (*ParcelCompletion, error) {
+
+	parcel := pkg.OutboundPkg
+	completion := &ParcelCompletion{
+		ParcelID:    pkg.ParcelID,
+		Parcel:      parcel,
+		AnchorTXID:  event.AnchorTXID,
+		BlockHash:   event.BlockHash,
+		BlockHeight: uint32(event.BlockHeight),
+		ProofLocators: make(
+			[]proof.Locator, 0, len(parcel.Outputs),
+		),
+		PassiveProofLocators: make(
+			[]proof.Locator, 0, len(parcel.PassiveAssets),
+		),
+	}
+
+	for idx := range parcel.Outputs {
+		out := &parcel.Outputs[idx]
+
+		var outputProof *proof.AnnotatedProof
+		for _, finalProof := range event.FinalProofs {
+			if finalProof.ScriptKey.IsEqual(out.ScriptKey.PubKey) {
+				outputProof = finalProof
+				break
+			}
+		}
+		if outputProof == nil {
+			return nil, fmt.Errorf("no final proof for output %d",
+				idx)
+		}
+
+		completion.ProofLocators = append(
+			completion.ProofLocators, outputProof.Locator,
+		)
+	}
+
+	for _, passiveAsset := range parcel.PassiveAssets {
+		assetID := passiveAsset.GenesisID
+		completion.PassiveProofLocators = append(
+			completion.PassiveProofLocators, proof.Locator{
+				AssetID:   &assetID,
+				ScriptKey: *passiveAsset.ScriptKey.PubKey,
+			},
+		)
+	}
+
+	return completion, nil
+}
+
+// notifyCompletion calls the completion handler with the completion of the
+// given package until it succeeds or the context is canceled. As the delivery
+// was already confirmed, the parcel doesn't fail if the handler never
+// succeeds, the failure is only logged.
+func (p *ChainPorter) notifyCompletion(ctx context.Context, pkg *sendPackage,
+	event *AssetConfirmEvent) {
+
+	if p.cfg.CompletionHandler == nil {
+		return
+	}
+
+	completion, err := newParcelCompletion(pkg, event)
+	if err != nil {
+		log.Errorf("Unable to create completion of parcel (txid=%v): "+
+			"%v", event.AnchorTXID, err)
+		return
+	}
+
+	backoff := completionRetryInitialBackoff
+	for {
+		err := p.cfg.CompletionHandler.ParcelCompleted(ctx, completion)
+		if err == nil {
+			return
+		}
+
+		log.Warnf("Completion handler failed for parcel (txid=%v), "+
+			"retrying in %v: %v", event.AnchorTXID, backoff, err)
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			log.Errorf("Completion of parcel (txid=%v) wasn't "+
+				"recorded by the completion handler: %v",
+				event.AnchorTXID, ctx.Err())
+			return
+		}
+
+		backoff *= 2
+		if backoff > completionRetryMaxBackoff {
+			backoff = completionRetryMaxBackoff
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// mockCompletionHandler is a CompletionHandler that fails a configured number
+// of times before it records the completions it's called with.
+type mockCompletionHandler struct {
+	failures    int
+	calls       int
+	completions []*ParcelCompletion
+}
+
+// ParcelCompleted records the completion once all failures were returned.
+func (m *mockCompletionHandler) ParcelCompleted(_ context.Context,
+	completion *ParcelCompletion) error {
+
+	m.calls++
+	if m.calls <= m.failures {
+		return errors.New("ledger unavailable")
+	}
+
+	m.completions = append(m.completions, completion)
+
+	return nil
+}
+
+// newCompletedPackage creates a send package with two outputs and a passive
+// asset, along with the event its delivery was confirmed with.
+func newCompletedPackage(t *testing.T) (*sendPackage, *AssetConfirmEvent) {
+	randScriptKey := func() asset.ScriptKey {
+		return asset.NewScriptKey(test.RandPubKey(t))
+	}
+
+	pkg := &sendPackage{
+		ParcelID: 3,
+		OutboundPkg: &OutboundParcel{
+			Outputs: []TransferOutput{{
+				ScriptKey: randScriptKey(),
+			}, {
+				ScriptKey: randScriptKey(),
+			}},
+			PassiveAssets: []*PassiveAssetReAnchor{{
+				GenesisID: asset.RandID(t),
+				ScriptKey: randScriptKey(),
+			}},
+		},
+	}
+
+	finalProofs := make(map[asset.SerializedKey]*proof.AnnotatedProof)
+	event := &AssetConfirmEvent{
+		AnchorTXID:  chainhash.Hash{1},
+		BlockHash:   chainhash.Hash{2},
+		BlockHeight: 800_000,
+		FinalProofs: finalProofs,
+	}
+	for _, out := range pkg.OutboundPkg.Outputs {
+		assetID := asset.RandID(t)
+		key := asset.ToSerialized(out.ScriptKey.PubKey)
+		event.FinalProofs[key] = &proof.AnnotatedProof{
+			Locator: proof.Locator{
+				AssetID:   &assetID,
+				ScriptKey: *out.ScriptKey.PubKey,
+			},
+		}
+	}
+
+	return pkg, event
+}
+
+// TestParcelCompletion tests that the completion handler is called with the
+// proof locators of a completed parcel and retried until it succeeds.
+func TestParcelCompletion(t *testing.T) {
+	t.Parallel()
+
+	pkg, event := newCompletedPackage(t)
+	handler := &mockCompletionHandler{failures: 1}
+	porter := &ChainPorter{
+		cfg: &ChainPorterConfig{CompletionHandler: handler},
+	}
+
+	porter.notifyCompletion(context.Background(), pkg, event)
+	require.Equal(t, 2, handler.calls)
+	require.Len(t, handler.completions, 1)
+
+	completion := handler.completions[0]
+	require.EqualValues(t, 3, completion.ParcelID)
+	require.Equal(t, pkg.OutboundPkg, completion.Parcel)
+	require.Equal(t, event.AnchorTXID, completion.AnchorTXID)
+	require.Equal(t, event.BlockHash, completion.BlockHash)
+	require.EqualValues(t, 800_000, completion.BlockHeight)
+
+	// The proof locators are in the order of the outputs.
+	require.Len(t, completion.ProofLocators, 2)
+	for idx, out := range pkg.OutboundPkg.Outputs {
+		key := asset.ToSerialized(out.ScriptKey.PubKey)
+		require.Equal(
+			t, event.FinalProofs[key].Locator,
+			completion.ProofLocators[idx],
+		)
+	}
+
+	passiveAsset := pkg.OutboundPkg.PassiveAssets[0]
+	require.Equal(t, []proof.Locator{{
+		AssetID:   &passiveAsset.GenesisID,
+		ScriptKey: *passiveAsset.ScriptKey.PubKey,
+	}}, completion.PassiveProofLocators)
+
+	// A handler that never succeeds is given up on once the context is
+	// canceled.
+	failingHandler := &mockCompletionHandler{failures: 1_000}
+	porter.cfg.CompletionHandler = failingHandler
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	porter.notifyCompletion(ctx, pkg, event)
+	require.Equal(t, 1, failingHandler.calls)
+	require.Empty(t, failingHandler.completions)
+}
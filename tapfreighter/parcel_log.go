@@ -0,0 +1,312 @@
+package tapfreighter
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// maxParcelLogEntries is the maximum number of entries that are kept
+	// in the log of a single parcel. Once reached, the oldest entries are
+	// dropped.
+	maxParcelLogEntries = 500
+
+	// maxFinishedParcelLogs is the number of logs of finished parcels that
+	// are retained, so they can still be exported after the parcel
+	// completed or failed.
+	maxFinishedParcelLogs = 100
+)
+
+// ErrParcelLogNotFound is returned when the log of an unknown parcel is
+// requested.
+var ErrParcelLogNotFound = errors.New("parcel log not found")
+
+// ParcelLogEntryType is the type of entry in the structured log of a parcel.
+type ParcelLogEntryType uint8
+
+const (
+	// ParcelLogState is an entry of a state of the send state machine
+	// that was executed.
+	ParcelLogState ParcelLogEntryType = iota
+
+	// ParcelLogBackendCall is an entry of a call to the chain backend,
+	// the wallet or the signer.
+	ParcelLogBackendCall
+
+	// ParcelLogMessage is a debug message of the parcel.
+	ParcelLogMessage
+)
+
+// String returns a human-readable version of the entry type.
+func (t ParcelLogEntryType) String() string {
+	switch t {
+	case ParcelLogState:
+		return "state"
+
+	case ParcelLogBackendCall:
+		return "backend_call"
+
+	case ParcelLogMessage:
+		return "message"
+
+	default:
+		return fmt.Sprintf("<unknown_type(%d)>", t)
+	}
+}
+
+// ParcelLogEntry is a single entry in the structured log of a parcel.
+type ParcelLogEntry struct {
+	// Time is the time the state, call or message started.
+	Time time.Time
+
+	// Type is the type of the entry.
+	Type ParcelLogEntryType
+
+	// State is the send state the parcel was in.
+	State SendState
+
+	// Name is the name of the backend call, only set for backend call
+	// entries.
+	Name string
+
+	// Message is the formatted debug message, only set for message
+	// entries.
+	Message string
+
+	// Duration is how long the state or backend call took.
+	Duration time.Duration
+
+	// Err is the error the state or backend call failed with, if any.
+	Err string
+}
+
+// ParcelLogBundle is the exported structured log of a single parcel.
+type ParcelLogBundle struct {
+	// ParcelID is the identifier of the parcel.
+	ParcelID uint64
+
+	// DebugEnabled is true if scoped debug logging is enabled for the
+	// parcel.
+	DebugEnabled bool
+
+	// Finished is true if the state machine of the parcel stopped, either
+	// because the transfer completed or because it failed.
+	Finished bool
+
+	// DroppedEntries is the number of oldest entries that were dropped
+	// because the log of the parcel reached its maximum size.
+	DroppedEntries uint64
+
+	// Entries are the entries of the log, ordered by time.
+	Entries []ParcelLogEntry
+}
+
+// parcelLog is the structured log of a single parcel.
+type parcelLog struct {
+	state    SendState
+	finished bool
+	dropped  uint64
+	entries  []ParcelLogEntry
+}
+
+// append adds an entry to the log, dropping the oldest entry if the log is
+// full.
+func (l *parcelLog) append(entry ParcelLogEntry) {
+	if len(l.entries) >= maxParcelLogEntries {
+		l.entries = l.entries[1:]
+		l.dropped++
+	}
+
+	l.entries = append(l.entries, entry)
+}
+
+// parcelLogs keeps the structured logs of the parcels handled by the porter
+// and the set of parcels that have scoped debug logging enabled.
+type parcelLogs struct {
+	sync.Mutex
+
+	// logs are the logs of all active and retained finished parcels.
+	logs map[uint64]*parcelLog
+
+	// finished are the IDs of the finished parcels whose logs are
+	// retained, oldest first.
+	finished []uint64
+
+	// debug is the set of parcels that have scoped debug logging
+	// enabled. A parcel ID can be added before the parcel is received.
+	debug map[uint64]struct{}
+}
+
+// newParcelLogs creates a new empty set of parcel logs.
+func newParcelLogs() *parcelLogs {
+	return &parcelLogs{
+		logs:  make(map[uint64]*parcelLog),
+		debug: make(map[uint64]struct{}),
+	}
+}
+
+// start creates the log of a newly received parcel.
+func (p *parcelLogs) start(parcelID uint64) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.logs[parcelID] = &parcelLog{}
+}
+
+// record adds an entry to the log of the given parcel, if it's known. The
+// state of the entry is set to the current state of the parcel.
+func (p *parcelLogs) record(parcelID uint64, entry ParcelLogEntry) {
+	p.Lock()
+	defer p.Unlock()
+
+	l, ok := p.logs[parcelID]
+	if !ok {
+		return
+	}
+
+	if entry.Type != ParcelLogState {
+		entry.State = l.state
+	}
+	l.append(entry)
+}
+
+// stateStarted sets the current state of the given parcel, so entries
+// recorded while the state executes are attributed to it.
+func (p *parcelLogs) stateStarted(parcelID uint64, state SendState) {
+	p.Lock()
+	defer p.Unlock()
+
+	if l, ok := p.logs[parcelID]; ok {
+		l.state = state
+	}
+}
+
+// stateFinished records the execution of a state of the given parcel that
+// started at the given time.
+func (p *parcelLogs) stateFinished(parcelID uint64, state SendState,
+	start time.Time, err error) {
+
+	p.record(parcelID, ParcelLogEntry{
+		Time:     start,
+		Type:     ParcelLogState,
+		State:    state,
+		Duration: time.Since(start),
+		Err:      errString(err),
+	})
+}
+
+// backendCall records a call to the backend that was made on behalf of the
+// given parcel and started at the given time.
+func (p *parcelLogs) backendCall(parcelID uint64, name string,
+	start time.Time, err error) {
+
+	p.record(parcelID, ParcelLogEntry{
+		Time:     start,
+		Type:     ParcelLogBackendCall,
+		Name:     name,
+		Duration: time.Since(start),
+		Err:      errString(err),
+	})
+}
+
+// debugf logs a debug message of the given parcel. If scoped debug logging is
+// enabled for the parcel, the message is logged at info level, so it shows up
+// without changing the global log level, and is added to the parcel's log.
+func (p *parcelLogs) debugf(parcelID uint64, format string,
+	params ...interface{}) {
+
+	if !p.debugEnabled(parcelID) {
+		log.Debugf(format, params...)
+		return
+	}
+
+	msg := fmt.Sprintf(format, params...)
+	log.Infof("[parcel %d] %s", parcelID, msg)
+
+	p.record(parcelID, ParcelLogEntry{
+		Time:    time.Now(),
+		Type:    ParcelLogMessage,
+		Message: msg,
+	})
+}
+
+// finish marks the log of the given parcel as finished. Only the logs of the
+// most recently finished parcels are retained.
+func (p *parcelLogs) finish(parcelID uint64) {
+	p.Lock()
+	defer p.Unlock()
+
+	l, ok := p.logs[parcelID]
+	if !ok || l.finished {
+		return
+	}
+	l.finished = true
+
+	p.finished = append(p.finished, parcelID)
+	if len(p.finished) > maxFinishedParcelLogs {
+		evicted := p.finished[0]
+		p.finished = p.finished[1:]
+
+		delete(p.logs, evicted)
+		delete(p.debug, evicted)
+	}
+}
+
+// setDebug enables or disables scoped debug logging for the given parcel.
+func (p *parcelLogs) setDebug(parcelID uint64, enabled bool) {
+	p.Lock()
+	defer p.Unlock()
+
+	if enabled {
+		p.debug[parcelID] = struct{}{}
+		return
+	}
+
+	delete(p.debug, parcelID)
+}
+
+// debugEnabled returns true if scoped debug logging is enabled for the given
+// parcel.
+func (p *parcelLogs) debugEnabled(parcelID uint64) bool {
+	p.Lock()
+	defer p.Unlock()
+
+	_, ok := p.debug[parcelID]
+	return ok
+}
+
+// export returns a copy of the log of the given parcel.
+func (p *parcelLogs) export(parcelID uint64) (*ParcelLogBundle, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	l, ok := p.logs[parcelID]
+	if !ok {
+		return nil, fmt.Errorf("%w: parcel %d", ErrParcelLogNotFound,
+			parcelID)
+	}
+
+	_, debug := p.debug[parcelID]
+	entries := make([]ParcelLogEntry, len(l.entries))
+	copy(entries, l.entries)
+
+	return &ParcelLogBundle{
+		ParcelID:       parcelID,
+		DebugEnabled:   debug,
+		Finished:       l.finished,
+		DroppedEntries: l.dropped,
+		Entries:        entries,
+	}, nil
+}
+
+// errString returns the message of the given error or an empty string if the
+// error is nil.
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
@@ -0,0 +1,93 @@
+package tapfreighter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestParcelLogs tests that the structured log of a parcel records its states
+// and backend calls, only records debug messages while scoped debug logging is
+// enabled and that only the logs of the most recent finished parcels are
+// retained.
+func TestParcelLogs(t *testing.T) {
+	t.Parallel()
+
+	logs := newParcelLogs()
+
+	_, err := logs.export(1)
+	require.ErrorIs(t, err, ErrParcelLogNotFound)
+
+	// Debug logging can be enabled before the parcel is received.
+	logs.setDebug(1, true)
+	logs.start(1)
+	logs.start(2)
+
+	start := time.Now()
+	logs.stateStarted(1, SendStateVirtualCommitmentSelect)
+	logs.debugf(1, "funding parcel %d", 1)
+	logs.debugf(2, "funding parcel %d", 2)
+	logs.backendCall(1, "FundAddressSend", start, nil)
+	logs.stateFinished(1, SendStateVirtualCommitmentSelect, start, nil)
+
+	logs.stateStarted(1, SendStateAnchorSign)
+	logs.backendCall(1, "EstimateFee", start, errors.New("no backend"))
+
+	bundle, err := logs.export(1)
+	require.NoError(t, err)
+	require.True(t, bundle.DebugEnabled)
+	require.False(t, bundle.Finished)
+	require.Len(t, bundle.Entries, 4)
+
+	require.Equal(t, ParcelLogMessage, bundle.Entries[0].Type)
+	require.Equal(t, "funding parcel 1", bundle.Entries[0].Message)
+	require.Equal(t, ParcelLogBackendCall, bundle.Entries[1].Type)
+	require.Equal(t, "FundAddressSend", bundle.Entries[1].Name)
+	require.Equal(
+		t, SendStateVirtualCommitmentSelect, bundle.Entries[1].State,
+	)
+	require.Equal(t, ParcelLogState, bundle.Entries[2].Type)
+	require.Equal(t, SendStateAnchorSign, bundle.Entries[3].State)
+	require.Equal(t, "no backend", bundle.Entries[3].Err)
+
+	// Without scoped debug logging, no messages are recorded.
+	bundle, err = logs.export(2)
+	require.NoError(t, err)
+	require.False(t, bundle.DebugEnabled)
+	require.Empty(t, bundle.Entries)
+
+	logs.setDebug(1, false)
+	logs.debugf(1, "not recorded")
+	bundle, err = logs.export(1)
+	require.NoError(t, err)
+	require.Len(t, bundle.Entries, 4)
+
+	// The log of a single parcel is bounded.
+	for i := 0; i < maxParcelLogEntries+5; i++ {
+		logs.backendCall(2, "PublishTransaction", start, nil)
+	}
+	bundle, err = logs.export(2)
+	require.NoError(t, err)
+	require.Len(t, bundle.Entries, maxParcelLogEntries)
+	require.EqualValues(t, 5, bundle.DroppedEntries)
+
+	// Finished logs can still be exported until they're evicted by newer
+	// finished parcels.
+	logs.finish(1)
+	bundle, err = logs.export(1)
+	require.NoError(t, err)
+	require.True(t, bundle.Finished)
+
+	for id := uint64(3); id < maxFinishedParcelLogs+3; id++ {
+		logs.start(id)
+		logs.finish(id)
+	}
+
+	_, err = logs.export(1)
+	require.ErrorIs(t, err, ErrParcelLogNotFound)
+
+	_, err = logs.export(2)
+	require.NoError(t, err)
+}
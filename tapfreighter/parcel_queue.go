@@ -0,0 +1,239 @@
+package tapfreighter
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultParcelWorkers is the default number of workers that handle
+	// normal and batchable parcels concurrently.
+	DefaultParcelWorkers = 4
+
+	// DefaultUrgentParcelWorkers is the default number of workers that are
+	// dedicated to urgent parcels.
+	DefaultUrgentParcelWorkers = 1
+
+	// DefaultParcelBatchInterval is the default interval at which held
+	// back batchable parcels are released to the workers.
+	DefaultParcelBatchInterval = 10 * time.Minute
+)
+
+// ParcelPriority is the priority class of a parcel. It determines how soon
+// the chain porter starts working on the parcel.
+type ParcelPriority uint8
+
+const (
+	// PriorityNormal is the default priority class. Normal parcels are
+	// handled in the order they arrive in, as soon as one of the shared
+	// workers is free.
+	PriorityNormal ParcelPriority = 0
+
+	// PriorityUrgent parcels are started immediately on a dedicated
+	// worker. If all dedicated workers are busy, a free shared worker is
+	// used. Urgent parcels are always started before any other parcel.
+	PriorityUrgent ParcelPriority = 1
+
+	// PriorityBatchable parcels aren't time sensitive. They are held back
+	// and released to the shared workers together once per batch
+	// interval, after all normal parcels.
+	PriorityBatchable ParcelPriority = 2
+)
+
+// parcelPriorities is the list of all priority classes, in the order they are
+// scheduled in.
+var parcelPriorities = []ParcelPriority{
+	PriorityUrgent, PriorityNormal, PriorityBatchable,
+}
+
+// String returns a human-readable description of the priority class.
+func (p ParcelPriority) String() string {
+	switch p {
+	case PriorityNormal:
+		return "normal"
+
+	case PriorityUrgent:
+		return "urgent"
+
+	case PriorityBatchable:
+		return "batchable"
+
+	default:
+		return fmt.Sprintf("<unknown(%d)>", p)
+	}
+}
+
+// ParcelQueueStats are the queue depth metrics of a single priority class.
+type ParcelQueueStats struct {
+	// Queued is the number of parcels that wait for a worker, including
+	// batchable parcels that are held back until the next batch interval.
+	Queued int
+
+	// Active is the number of parcels that are currently being worked on.
+	Active int
+}
+
+// parcelSlot is a reserved worker for a parcel of a given priority class.
+type parcelSlot struct {
+	// priority is the priority class of the parcel.
+	priority ParcelPriority
+
+	// urgentWorker is true if the slot belongs to the pool of workers
+	// dedicated to urgent parcels.
+	urgentWorker bool
+}
+
+// parcelQueue schedules parcels onto a limited number of workers according to
+// their priority class.
+type parcelQueue struct {
+	mtx sync.Mutex
+
+	// numWorkers is the size of the shared worker pool.
+	numWorkers int
+
+	// numUrgentWorkers is the size of the worker pool dedicated to urgent
+	// parcels.
+	numUrgentWorkers int
+
+	// holdBatchable is true if batchable parcels are held back until the
+	// next call to releaseBatch.
+	holdBatchable bool
+
+	// queued are the parcels that are ready to be started, per priority
+	// class.
+	queued map[ParcelPriority][]Parcel
+
+	// held are the batchable parcels that are held back until the next
+	// batch is released.
+	held []Parcel
+
+	// active is the number of parcels that are being worked on, per
+	// priority class.
+	active map[ParcelPriority]int
+
+	// busyWorkers and busyUrgentWorkers are the number of workers of the
+	// shared and the urgent pool that are in use.
+	busyWorkers       int
+	busyUrgentWorkers int
+}
+
+// newParcelQueue creates a new parcel queue with the given worker pool sizes.
+func newParcelQueue(numWorkers, numUrgentWorkers int,
+	holdBatchable bool) *parcelQueue {
+
+	return &parcelQueue{
+		numWorkers:       numWorkers,
+		numUrgentWorkers: numUrgentWorkers,
+		holdBatchable:    holdBatchable,
+		queued:           make(map[ParcelPriority][]Parcel),
+		active:           make(map[ParcelPriority]int),
+	}
+}
+
+// push adds a new parcel to the queue of its priority class.
+func (q *parcelQueue) push(parcel Parcel) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	priority := parcel.kit().priority
+	if priority == PriorityBatchable && q.holdBatchable {
+		q.held = append(q.held, parcel)
+		return
+	}
+
+	q.queued[priority] = append(q.queued[priority], parcel)
+}
+
+// releaseBatch makes all held back batchable parcels ready to be started.
+func (q *parcelQueue) releaseBatch() {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	q.queued[PriorityBatchable] = append(
+		q.queued[PriorityBatchable], q.held...,
+	)
+	q.held = nil
+}
+
+// hasHeld returns true if any batchable parcels are held back until the next
+// batch is released.
+func (q *parcelQueue) hasHeld() bool {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	return len(q.held) > 0
+}
+
+// next returns the next parcel that should be started and reserves a worker
+// for it. False is returned if no parcel is ready or no worker is free.
+func (q *parcelQueue) next() (Parcel, parcelSlot, bool) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	for _, priority := range parcelPriorities {
+		if len(q.queued[priority]) == 0 {
+			continue
+		}
+
+		var slot parcelSlot
+		switch {
+		case priority == PriorityUrgent &&
+			q.busyUrgentWorkers < q.numUrgentWorkers:
+
+			q.busyUrgentWorkers++
+			slot.urgentWorker = true
+
+		case q.busyWorkers < q.numWorkers:
+			q.busyWorkers++
+
+		// Parcels of lower priority classes must not overtake the
+		// waiting parcels of this class.
+		default:
+			return nil, parcelSlot{}, false
+		}
+
+		parcel := q.queued[priority][0]
+		q.queued[priority] = q.queued[priority][1:]
+
+		slot.priority = priority
+		q.active[priority]++
+
+		return parcel, slot, true
+	}
+
+	return nil, parcelSlot{}, false
+}
+
+// done frees the worker of the given slot again.
+func (q *parcelQueue) done(slot parcelSlot) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	q.active[slot.priority]--
+	if slot.urgentWorker {
+		q.busyUrgentWorkers--
+	} else {
+		q.busyWorkers--
+	}
+}
+
+// stats returns the queue depth metrics of all priority classes.
+func (q *parcelQueue) stats() map[ParcelPriority]ParcelQueueStats {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	stats := make(map[ParcelPriority]ParcelQueueStats)
+	for _, priority := range parcelPriorities {
+		stats[priority] = ParcelQueueStats{
+			Queued: len(q.queued[priority]),
+			Active: q.active[priority],
+		}
+	}
+
+	batchStats := stats[PriorityBatchable]
+	batchStats.Queued += len(q.held)
+	stats[PriorityBatchable] = batchStats
+
+	return stats
+}
@@ -0,0 +1,122 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestParcel creates a new parcel with the given priority.
+func newTestParcel(priority ParcelPriority) *AddressParcel {
+	parcel := NewAddressParcel()
+	parcel.SetPriority(priority)
+
+	return parcel
+}
+
+// TestParcelQueue tests that parcels are scheduled onto the worker pools in
+// the order of their priority class.
+func TestParcelQueue(t *testing.T) {
+	t.Parallel()
+
+	q := newParcelQueue(1, 1, true)
+
+	requireNext := func(expected Parcel) parcelSlot {
+		t.Helper()
+
+		parcel, slot, ok := q.next()
+		require.True(t, ok)
+		require.Same(t, expected, parcel)
+
+		return slot
+	}
+	requireNone := func() {
+		t.Helper()
+
+		_, _, ok := q.next()
+		require.False(t, ok)
+	}
+
+	normal1 := newTestParcel(PriorityNormal)
+	normal2 := newTestParcel(PriorityNormal)
+	batchable := newTestParcel(PriorityBatchable)
+	urgent1 := newTestParcel(PriorityUrgent)
+	urgent2 := newTestParcel(PriorityUrgent)
+	urgent3 := newTestParcel(PriorityUrgent)
+
+	q.push(normal1)
+	q.push(batchable)
+	q.push(normal2)
+	q.push(urgent1)
+
+	// The urgent parcel is started first on its dedicated worker, then the
+	// first normal parcel takes the shared worker.
+	urgentSlot := requireNext(urgent1)
+	require.True(t, urgentSlot.urgentWorker)
+	normalSlot := requireNext(normal1)
+	require.False(t, normalSlot.urgentWorker)
+	requireNone()
+
+	require.Equal(t, map[ParcelPriority]ParcelQueueStats{
+		PriorityUrgent:    {Active: 1},
+		PriorityNormal:    {Queued: 1, Active: 1},
+		PriorityBatchable: {Queued: 1},
+	}, q.stats())
+
+	// With the dedicated worker busy, further urgent parcels wait for the
+	// next free worker, but are started before the waiting normal parcel.
+	q.push(urgent2)
+	requireNone()
+
+	q.done(normalSlot)
+	slot := requireNext(urgent2)
+	require.False(t, slot.urgentWorker)
+	q.done(slot)
+
+	// Even with an idle urgent worker, normal parcels can only use the
+	// shared workers.
+	q.done(urgentSlot)
+	normalSlot = requireNext(normal2)
+	requireNone()
+
+	// Urgent parcels are started immediately on the idle urgent worker.
+	q.push(urgent3)
+	urgentSlot = requireNext(urgent3)
+	require.True(t, urgentSlot.urgentWorker)
+	q.done(urgentSlot)
+	q.done(normalSlot)
+
+	// The batchable parcel is held back until the batch is released.
+	requireNone()
+	q.releaseBatch()
+	slot = requireNext(batchable)
+	q.done(slot)
+
+	require.Equal(t, map[ParcelPriority]ParcelQueueStats{
+		PriorityUrgent:    {},
+		PriorityNormal:    {},
+		PriorityBatchable: {},
+	}, q.stats())
+}
+
+// TestParcelQueueNoBatching tests that batchable parcels are started right
+// away if they aren't held back, but only after all normal parcels.
+func TestParcelQueueNoBatching(t *testing.T) {
+	t.Parallel()
+
+	q := newParcelQueue(1, 1, false)
+
+	batchable := newTestParcel(PriorityBatchable)
+	normal := newTestParcel(PriorityNormal)
+	q.push(batchable)
+	q.push(normal)
+
+	parcel, slot, ok := q.next()
+	require.True(t, ok)
+	require.Same(t, normal, parcel)
+	q.done(slot)
+
+	parcel, _, ok = q.next()
+	require.True(t, ok)
+	require.Same(t, batchable, parcel)
+}
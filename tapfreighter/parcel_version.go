@@ -0,0 +1,81 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+var (
+	// ErrParcelVersionUnknown is returned for a pending parcel that was
+	// persisted with a newer version than this daemon understands, for
+	// example by a daemon that was downgraded mid-transfer.
+	ErrParcelVersionUnknown = errors.New("unknown parcel version")
+
+	// ErrParcelIncompatible is returned for a pending parcel that was
+	// persisted by a previous version but can't be migrated to the
+	// current version.
+	ErrParcelIncompatible = errors.New("pending parcel incompatible " +
+		"with current version")
+)
+
+// ParcelVersion is the version of the encoding an outbound parcel was
+// persisted with. It allows a daemon that was upgraded mid-transfer to resume
+// the parcels written by the previous version.
+type ParcelVersion uint8
+
+const (
+	// ParcelV0 is the version of parcels that were persisted before the
+	// version was recorded.
+	ParcelV0 ParcelVersion = 0
+
+	// ParcelV1 is the first recorded version. The proof suffix of every
+	// output of a V1 parcel is a complete encoded proof, which is required
+	// to build the final proofs once the anchor transaction confirms.
+	ParcelV1 ParcelVersion = 1
+
+	// LatestParcelVersion is the version new parcels are persisted with.
+	LatestParcelVersion = ParcelV1
+)
+
+// MigrateParcel migrates a pending parcel that was persisted by a previous
+// version to the latest version. A parcel that was persisted by a newer
+// version results in ErrParcelVersionUnknown and a parcel that can't be
+// migrated in ErrParcelIncompatible, so it is never resumed from a partial
+// state. The returned boolean is true if the parcel was migrated.
+func MigrateParcel(parcel *OutboundParcel) (bool, error) {
+	switch {
+	case parcel.Version > LatestParcelVersion:
+		return false, fmt.Errorf("%w: %d, latest known version is %d",
+			ErrParcelVersionUnknown, parcel.Version,
+			LatestParcelVersion)
+
+	case parcel.Version == LatestParcelVersion:
+		return false, nil
+	}
+
+	// V0 parcels may have been written with proof suffixes that the
+	// current proof encoding can't read, so we make sure they decode
+	// before we rely on them.
+	for idx := range parcel.Outputs {
+		out := &parcel.Outputs[idx]
+		if len(out.ProofSuffix) == 0 {
+			return false, fmt.Errorf("%w: output %d has no proof "+
+				"suffix", ErrParcelIncompatible, idx)
+		}
+
+		var proofSuffix proof.Proof
+		err := proofSuffix.Decode(bytes.NewReader(out.ProofSuffix))
+		if err != nil {
+			return false, fmt.Errorf("%w: unable to decode proof "+
+				"suffix of output %d: %v",
+				ErrParcelIncompatible, idx, err)
+		}
+	}
+
+	parcel.Version = LatestParcelVersion
+
+	return true, nil
+}
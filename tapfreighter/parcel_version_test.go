@@ -0,0 +1,74 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMigrateParcel tests that pending parcels of previous versions are
+// migrated and parcels that can't be resumed are rejected explicitly.
+func TestMigrateParcel(t *testing.T) {
+	t.Parallel()
+
+	var proofBuf bytes.Buffer
+	proofSuffix := &proof.Proof{
+		AnchorTx: wire.MsgTx{
+			Version: 2,
+			TxIn: []*wire.TxIn{{
+				Witness: [][]byte{[]byte("foo")},
+			}},
+		},
+		Asset: *asset.RandAsset(t, asset.Normal),
+		InclusionProof: proof.TaprootProof{
+			InternalKey: test.RandPubKey(t),
+		},
+	}
+	require.NoError(t, proofSuffix.Encode(&proofBuf))
+
+	newParcel := func(version ParcelVersion,
+		suffixes ...[]byte) *OutboundParcel {
+
+		parcel := &OutboundParcel{
+			Version: version,
+		}
+		for _, suffix := range suffixes {
+			parcel.Outputs = append(parcel.Outputs, TransferOutput{
+				ProofSuffix: suffix,
+			})
+		}
+
+		return parcel
+	}
+
+	// A parcel of the latest version is left as is.
+	migrated, err := MigrateParcel(newParcel(LatestParcelVersion))
+	require.NoError(t, err)
+	require.False(t, migrated)
+
+	// A V0 parcel with valid proof suffixes is migrated.
+	parcel := newParcel(ParcelV0, proofBuf.Bytes())
+	migrated, err = MigrateParcel(parcel)
+	require.NoError(t, err)
+	require.True(t, migrated)
+	require.Equal(t, LatestParcelVersion, parcel.Version)
+
+	// A V0 parcel with a missing or undecodable proof suffix can't be
+	// migrated and keeps its version.
+	parcel = newParcel(ParcelV0, proofBuf.Bytes(), nil)
+	_, err = MigrateParcel(parcel)
+	require.ErrorIs(t, err, ErrParcelIncompatible)
+	require.Equal(t, ParcelV0, parcel.Version)
+
+	_, err = MigrateParcel(newParcel(ParcelV0, []byte{0xff, 0x01}))
+	require.ErrorIs(t, err, ErrParcelIncompatible)
+
+	// A parcel written by a newer version is rejected.
+	_, err = MigrateParcel(newParcel(LatestParcelVersion + 1))
+	require.ErrorIs(t, err, ErrParcelVersionUnknown)
+}
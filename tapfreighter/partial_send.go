@@ -0,0 +1,177 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/address"
+)
+
+// PartialSendMode determines how the porter handles an address parcel whose
+// total amount can't be covered by the available balance.
+type PartialSendMode uint8
+
+const (
+	// PartialSendDisabled fails the whole parcel if its total amount can't
+	// be covered.
+	PartialSendDisabled PartialSendMode = iota
+
+	// PartialSendPropose fails the parcel with a PartialSendProposal that
+	// describes the part of the parcel that could be sent instead.
+	PartialSendPropose
+
+	// PartialSendExecute sends the part of the parcel that can be covered
+	// and reports the shortfall along with the transfer.
+	PartialSendExecute
+)
+
+// String returns a human-readable name of the partial send mode.
+func (m PartialSendMode) String() string {
+	switch m {
+	case PartialSendDisabled:
+		return "disabled"
+
+	case PartialSendPropose:
+		return "propose"
+
+	case PartialSendExecute:
+		return "execute"
+
+	default:
+		return fmt.Sprintf("<unknown(%d)>", m)
+	}
+}
+
+// PartialSend describes the part of an address parcel that can be funded with
+// the available balance. An address commits to the exact amount it receives,
+// so a parcel is only fulfilled partially by paying a subset of its addresses
+// in full. The addresses are considered in the order they were given in, so
+// the fulfilled addresses are always a prefix of the parcel's addresses.
+type PartialSend struct {
+	// Fulfilled are the addresses that can be paid.
+	Fulfilled []*address.Tap
+
+	// Unfulfilled are the addresses that can't be paid with the available
+	// balance.
+	Unfulfilled []*address.Tap
+}
+
+// newPartialSend splits the given addresses into the first numFulfilled ones
+// that are paid and the remaining ones that aren't.
+func newPartialSend(addrs []*address.Tap, numFulfilled int) *PartialSend {
+	return &PartialSend{
+		Fulfilled:   addrs[:numFulfilled],
+		Unfulfilled: addrs[numFulfilled:],
+	}
+}
+
+// FulfilledAmount returns the amount paid to the fulfilled addresses.
+func (p *PartialSend) FulfilledAmount() uint64 {
+	var amount uint64
+	for _, addr := range p.Fulfilled {
+		amount += addr.Amount
+	}
+
+	return amount
+}
+
+// Shortfall returns the amount that isn't paid because of the unfulfilled
+// addresses.
+func (p *PartialSend) Shortfall() uint64 {
+	var amount uint64
+	for _, addr := range p.Unfulfilled {
+		amount += addr.Amount
+	}
+
+	return amount
+}
+
+// PartialSendProposal is returned for a parcel in the PartialSendPropose mode
+// whose total amount can't be covered. It describes the partial send the
+// parcel could be fulfilled with instead.
+type PartialSendProposal struct {
+	*PartialSend
+}
+
+// Error returns the error message of the partial send proposal.
+func (e *PartialSendProposal) Error() string {
+	return fmt.Sprintf("insufficient balance, only %d of %d addresses "+
+		"(%d units) can be paid, shortfall of %d units",
+		len(e.Fulfilled), len(e.Fulfilled)+len(e.Unfulfilled),
+		e.FulfilledAmount(), e.Shortfall())
+}
+
+// fundAddressParcel funds the virtual packet of the given address parcel. If
+// the available balance can't cover all addresses and the parcel allows a
+// partial send, the trailing addresses are dropped one by one until the
+// remaining ones can be funded. The returned partial send is nil if all
+// addresses are paid.
+func (p *ChainPorter) fundAddressParcel(ctx context.Context, parcelID uint64,
+	addrParcel *AddressParcel) (*FundedVPacket, *PartialSend, error) {
+
+	fund := func(addrs []*address.Tap) (*FundedVPacket, error) {
+		start := time.Now()
+		fundSendRes, err := p.cfg.AssetWallet.FundAddressSend(
+			ctx, addrs, addrParcel.fundOpts...,
+		)
+		p.parcelLogs.backendCall(
+			parcelID, "FundAddressSend", start, err,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fund address send: "+
+				"%w", err)
+		}
+
+		return fundSendRes, nil
+	}
+
+	destAddrs := addrParcel.destAddrs
+	fundSendRes, fullErr := fund(destAddrs)
+	switch {
+	case fullErr == nil:
+		return fundSendRes, nil, nil
+
+	case addrParcel.partialSendMode == PartialSendDisabled,
+		!errors.Is(fullErr, ErrMatchingAssetsNotFound):
+
+		return nil, nil, fullErr
+	}
+
+	for numAddrs := len(destAddrs) - 1; numAddrs > 0; numAddrs-- {
+		fundSendRes, err := fund(destAddrs[:numAddrs])
+		if errors.Is(err, ErrMatchingAssetsNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		partialSend := newPartialSend(destAddrs, numAddrs)
+		if addrParcel.partialSendMode == PartialSendExecute {
+			log.Infof("Partially fulfilling parcel %d, paying %d "+
+				"of %d addresses with a shortfall of %d units",
+				parcelID, numAddrs, len(destAddrs),
+				partialSend.Shortfall())
+
+			return fundSendRes, partialSend, nil
+		}
+
+		// We only proposed the partial send, so the coins selected
+		// for it must be made available again.
+		err = p.cfg.AssetWallet.ReleaseInputs(ctx, fundSendRes.VPacket)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to release inputs "+
+				"of proposed partial send: %w", err)
+		}
+
+		return nil, nil, &PartialSendProposal{
+			PartialSend: partialSend,
+		}
+	}
+
+	// Not even the first address can be paid, so there's nothing to send
+	// or to propose.
+	return nil, nil, fullErr
+}
@@ -0,0 +1,129 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/stretchr/testify/require"
+)
+
+// mockBalanceWallet is a wallet that can only fund address sends up to a
+// fixed balance.
+type mockBalanceWallet struct {
+	Wallet
+
+	balance uint64
+
+	funded [][]*address.Tap
+
+	released []*tappsbt.VPacket
+}
+
+func (m *mockBalanceWallet) FundAddressSend(_ context.Context,
+	addrs []*address.Tap, _ ...FundOption) (*FundedVPacket, error) {
+
+	m.funded = append(m.funded, addrs)
+
+	var amount uint64
+	for _, addr := range addrs {
+		amount += addr.Amount
+	}
+	if amount > m.balance {
+		return nil, fmt.Errorf("unable to select coins: %w",
+			ErrMatchingAssetsNotFound)
+	}
+
+	return &FundedVPacket{
+		VPacket: &tappsbt.VPacket{},
+	}, nil
+}
+
+func (m *mockBalanceWallet) ReleaseInputs(_ context.Context,
+	vPkt *tappsbt.VPacket) error {
+
+	m.released = append(m.released, vPkt)
+
+	return nil
+}
+
+// TestFundAddressParcel tests that an address parcel that can't be funded in
+// full is only fulfilled partially if the partial send mode allows it.
+func TestFundAddressParcel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	addrs := []*address.Tap{{Amount: 50}, {Amount: 30}, {Amount: 40}}
+
+	newPorter := func(balance uint64) (*ChainPorter, *mockBalanceWallet) {
+		wallet := &mockBalanceWallet{balance: balance}
+
+		return &ChainPorter{
+			cfg: &ChainPorterConfig{
+				AssetWallet: wallet,
+			},
+			parcelLogs: newParcelLogs(),
+		}, wallet
+	}
+
+	newParcel := func(mode PartialSendMode) *AddressParcel {
+		parcel := NewAddressParcel(addrs...)
+		parcel.SetPartialSendMode(mode)
+
+		return parcel
+	}
+
+	// A parcel that can be funded in full isn't affected by the mode.
+	porter, _ := newPorter(120)
+	_, partialSend, err := porter.fundAddressParcel(
+		ctx, 1, newParcel(PartialSendExecute),
+	)
+	require.NoError(t, err)
+	require.Nil(t, partialSend)
+
+	// Without a partial send mode, the whole parcel fails.
+	porter, wallet := newPorter(100)
+	_, _, err = porter.fundAddressParcel(
+		ctx, 1, newParcel(PartialSendDisabled),
+	)
+	require.ErrorIs(t, err, ErrMatchingAssetsNotFound)
+	require.Len(t, wallet.funded, 1)
+
+	// In execute mode, the trailing address is dropped and the shortfall
+	// is reported. The smaller second address alone would fit as well,
+	// but the addresses are only ever dropped from the end.
+	porter, wallet = newPorter(100)
+	funded, partialSend, err := porter.fundAddressParcel(
+		ctx, 1, newParcel(PartialSendExecute),
+	)
+	require.NoError(t, err)
+	require.NotNil(t, funded)
+	require.Equal(t, addrs[:2], partialSend.Fulfilled)
+	require.Equal(t, addrs[2:], partialSend.Unfulfilled)
+	require.EqualValues(t, 80, partialSend.FulfilledAmount())
+	require.EqualValues(t, 40, partialSend.Shortfall())
+	require.Empty(t, wallet.released)
+
+	// In propose mode, nothing is sent and the coins selected for the
+	// proposal are released again.
+	porter, wallet = newPorter(60)
+	_, _, err = porter.fundAddressParcel(
+		ctx, 1, newParcel(PartialSendPropose),
+	)
+	var proposal *PartialSendProposal
+	require.True(t, errors.As(err, &proposal))
+	require.Equal(t, addrs[:1], proposal.Fulfilled)
+	require.EqualValues(t, 70, proposal.Shortfall())
+	require.Len(t, wallet.funded, 3)
+	require.Len(t, wallet.released, 1)
+
+	// If not even the first address can be paid, the parcel fails.
+	porter, _ = newPorter(10)
+	_, _, err = porter.fundAddressParcel(
+		ctx, 1, newParcel(PartialSendExecute),
+	)
+	require.ErrorIs(t, err, ErrMatchingAssetsNotFound)
+}
@@ -0,0 +1,186 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+)
+
+// ErrDryRunProofInvalid is returned if a proof that was assembled from a
+// signed but not yet broadcast anchor transaction fails verification.
+var ErrDryRunProofInvalid = errors.New("dry run proof invalid")
+
+// dryRunBlock returns a block that only contains the given anchor transaction.
+// The header commits to the transaction, so proofs updated with this block
+// have a valid merkle proof, but the block itself was never mined.
+func dryRunBlock(anchorTx *wire.MsgTx) *wire.MsgBlock {
+	return &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			MerkleRoot: anchorTx.TxHash(),
+		},
+		Transactions: []*wire.MsgTx{anchorTx},
+	}
+}
+
+// dryRunHeaderVerifier returns a header verifier that accepts the header of
+// the given dry run block and passes all other headers on to the given
+// verifier.
+func dryRunHeaderVerifier(block *wire.MsgBlock,
+	headerVerifier proof.HeaderVerifier) proof.HeaderVerifier {
+
+	dryRunHash := block.Header.BlockHash()
+	return func(header wire.BlockHeader, height uint32) error {
+		if header.BlockHash() == dryRunHash {
+			return nil
+		}
+
+		return headerVerifier(header, height)
+	}
+}
+
+// verifyDryRunProofs assembles the final proofs of all outputs and passive
+// assets of the signed anchor transaction as they would be created once the
+// transaction confirms, using a dry run block instead of the actual block. The
+// proofs are then fully verified, which catches commitment or witness
+// construction bugs before the transaction is irreversibly published.
+func (p *ChainPorter) verifyDryRunProofs(ctx context.Context,
+	pkg *sendPackage) error {
+
+	block := dryRunBlock(pkg.AnchorTx.FinalTx)
+	headerVerifier := dryRunHeaderVerifier(
+		block, tapgarden.GenHeaderVerifier(ctx, p.chainBridge),
+	)
+	baseParams := &proof.BaseProofParams{
+		Block: block,
+		Tx:    pkg.AnchorTx.FinalTx,
+	}
+
+	vPkt := pkg.VirtualPacket
+	inputs := make([]TransferInput, len(vPkt.Inputs))
+	for idx := range vPkt.Inputs {
+		inputs[idx] = TransferInput{
+			PrevID: vPkt.Inputs[idx].PrevID,
+		}
+	}
+
+	// The proof files of unconfirmed change don't contain the transfer
+	// that created the change yet, so there's nothing to append the dry
+	// run proofs to.
+	parents, err := p.unconfirmedParents(ctx, inputs)
+	if err != nil {
+		return err
+	}
+	if len(parents) > 0 {
+		log.Infof("Skipping dry run proof verification of parcel "+
+			"%d chained onto unconfirmed parcels %v", pkg.ParcelID,
+			parents)
+
+		return nil
+	}
+
+	for idx := range vPkt.Outputs {
+		// Outputs without an asset only carry passive assets, which
+		// are verified below.
+		if vPkt.Outputs[idx].Asset == nil {
+			continue
+		}
+
+		proofSuffix, err := pkg.createProofSuffix(idx)
+		if err != nil {
+			return fmt.Errorf("unable to create proof %d: %w", idx,
+				err)
+		}
+
+		for inIdx := 1; inIdx < len(inputs); inIdx++ {
+			inputProofFile, err := p.fetchInputProof(
+				ctx, inputs[inIdx],
+			)
+			if err != nil {
+				return err
+			}
+
+			proofSuffix.AdditionalInputs = append(
+				proofSuffix.AdditionalInputs, *inputProofFile,
+			)
+		}
+
+		err = p.verifyDryRunProof(
+			ctx, inputs[0].ID, inputs[0].ScriptKey, proofSuffix,
+			baseParams, headerVerifier,
+		)
+		if err != nil {
+			return fmt.Errorf("%w: output %d: %v",
+				ErrDryRunProofInvalid, idx, err)
+		}
+	}
+
+	for idx, passiveAsset := range pkg.PassiveAssets {
+		reAnchorProof, err := pkg.createReAnchorProof(
+			passiveAsset.VPacket,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to create re-anchor proof: "+
+				"%w", err)
+		}
+
+		err = p.verifyDryRunProof(
+			ctx, passiveAsset.GenesisID,
+			asset.ToSerialized(passiveAsset.ScriptKey.PubKey),
+			reAnchorProof, baseParams, headerVerifier,
+		)
+		if err != nil {
+			return fmt.Errorf("%w: passive asset %d: %v",
+				ErrDryRunProofInvalid, idx, err)
+		}
+	}
+
+	log.Debugf("Verified dry run proofs of %d outputs and %d passive "+
+		"assets", len(vPkt.Outputs), len(pkg.PassiveAssets))
+
+	return nil
+}
+
+// verifyDryRunProof appends the given new proof, updated with the dry run
+// block, to the proof file of the spent input and verifies the full file.
+func (p *ChainPorter) verifyDryRunProof(ctx context.Context, id asset.ID,
+	scriptKey asset.SerializedKey, newProof *proof.Proof,
+	baseParams *proof.BaseProofParams,
+	headerVerifier proof.HeaderVerifier) error {
+
+	proofFile, err := p.fetchInputProof(ctx, TransferInput{
+		PrevID: asset.PrevID{
+			ID:        id,
+			ScriptKey: scriptKey,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := newProof.UpdateTransitionProof(baseParams); err != nil {
+		return fmt.Errorf("error updating transition proof: %w", err)
+	}
+	if err := proofFile.AppendProof(*newProof); err != nil {
+		return fmt.Errorf("error appending proof: %w", err)
+	}
+
+	// The proof file is encoded and decoded again, so we also catch any
+	// proofs that can't be serialized.
+	var buf bytes.Buffer
+	if err := proofFile.Encode(&buf); err != nil {
+		return fmt.Errorf("error encoding proof: %w", err)
+	}
+	dryRunFile := proof.NewEmptyFile(proof.V0)
+	if err := dryRunFile.Decode(&buf); err != nil {
+		return fmt.Errorf("error decoding proof: %w", err)
+	}
+
+	_, err = dryRunFile.Verify(ctx, headerVerifier)
+	return err
+}
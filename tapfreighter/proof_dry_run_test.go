@@ -0,0 +1,39 @@
+package tapfreighter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDryRunHeaderVerifier tests that the dry run block commits to the anchor
+// transaction and that only its header bypasses the header verifier.
+func TestDryRunHeaderVerifier(t *testing.T) {
+	t.Parallel()
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: test.RandHash()},
+	})
+	anchorTx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: []byte{0x1}})
+
+	block := dryRunBlock(anchorTx)
+	merkleProof, err := proof.NewTxMerkleProof(block.Transactions, 0)
+	require.NoError(t, err)
+	require.True(t, merkleProof.Verify(anchorTx, block.Header.MerkleRoot))
+
+	errUnknownHeader := errors.New("unknown header")
+	headerVerifier := dryRunHeaderVerifier(
+		block, func(wire.BlockHeader, uint32) error {
+			return errUnknownHeader
+		},
+	)
+	require.NoError(t, headerVerifier(block.Header, 100))
+	require.ErrorIs(
+		t, headerVerifier(wire.BlockHeader{}, 100), errUnknownHeader,
+	)
+}
@@ -0,0 +1,114 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+// ErrProofRegenUnsupported is returned if proofs are regenerated but the porter
+// wasn't configured with a store for confirmed parcels.
+var ErrProofRegenUnsupported = errors.New("proof regeneration not supported")
+
+// RegenerateTransferProofs rebuilds the final proofs of the outputs of the
+// confirmed transfer with the given anchor txid from the transfer and its
+// confirmation stored on disk, and imports them into the proof archive again,
+// replacing any existing proofs. This can be used to recover proofs after the
+// proof archive was corrupted or lost while the database survived. The proof
+// files of the inputs of the transfer must still be in the archive.
+//
+// NOTE: Passive assets aren't stored with the parcel, so their proofs aren't
+// regenerated.
+func (p *ChainPorter) RegenerateTransferProofs(ctx context.Context,
+	anchorTxid chainhash.Hash) ([]*proof.AnnotatedProof, error) {
+
+	if p.cfg.ConfirmedParcels == nil {
+		return nil, ErrProofRegenUnsupported
+	}
+
+	parcel, conf, err := p.cfg.ConfirmedParcels.ConfirmedParcel(
+		ctx, anchorTxid,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch parcel: %w", err)
+	}
+
+	confEvent, err := p.fetchParcelConf(ctx, parcel, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	// A parcel without active inputs only re-anchors passive assets, so
+	// there are no output proofs to rebuild.
+	if len(parcel.Inputs) == 0 {
+		return nil, nil
+	}
+
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, p.chainBridge)
+
+	var outputProofs []*proof.AnnotatedProof
+	for idx := range parcel.Outputs {
+		if parcel.Outputs[idx].Type == tappsbt.TypePassiveAssetsOnly {
+			continue
+		}
+
+		outputProof, _, err := p.buildOutputProof(
+			ctx, parcel, idx, confEvent,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		err = p.cfg.AssetProofs.ImportProofs(
+			ctx, headerVerifier, true, outputProof,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error importing proof: %w", err)
+		}
+
+		outputProofs = append(outputProofs, outputProof)
+	}
+
+	log.Infof("Regenerated %d proofs of transfer with anchor_txid=%v",
+		len(outputProofs), anchorTxid)
+
+	return outputProofs, nil
+}
+
+// fetchParcelConf fetches the block the anchor transaction of the parcel
+// confirmed in and returns the confirmation of the transaction. The block is
+// checked to contain the anchor transaction at the stored index.
+func (p *ChainPorter) fetchParcelConf(ctx context.Context,
+	parcel *OutboundParcel,
+	conf *ParcelConfirmation) (*chainntnfs.TxConfirmation, error) {
+
+	block, err := p.chainBridge.GetBlock(ctx, conf.BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch block %v: %w",
+			conf.BlockHash, err)
+	}
+
+	anchorTxid := parcel.AnchorTx.TxHash()
+	if int(conf.TxIndex) >= len(block.Transactions) ||
+		block.Transactions[conf.TxIndex].TxHash() != anchorTxid {
+
+		return nil, fmt.Errorf("anchor tx %v not found at index %d "+
+			"of block %v", anchorTxid, conf.TxIndex,
+			conf.BlockHash)
+	}
+
+	blockHash := conf.BlockHash
+	return &chainntnfs.TxConfirmation{
+		BlockHash:   &blockHash,
+		BlockHeight: conf.BlockHeight,
+		TxIndex:     conf.TxIndex,
+		Tx:          block.Transactions[conf.TxIndex],
+		Block:       block,
+	}, nil
+}
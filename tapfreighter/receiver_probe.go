@@ -0,0 +1,115 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+)
+
+const (
+	// DefaultReceiverProbeTimeout is the default maximum time a single
+	// receiver probe may take.
+	DefaultReceiverProbeTimeout = 30 * time.Second
+)
+
+// ErrReceiverUnreachable is returned if a receiver of a transfer is probed in
+// strict mode and the proof courier can't reach it.
+var ErrReceiverUnreachable = errors.New("receiver unreachable through " +
+	"proof courier")
+
+// ReceiverProbeMode determines whether the porter checks that the proofs of a
+// transfer can be delivered to its receivers before the anchor transaction is
+// funded and broadcast.
+type ReceiverProbeMode uint8
+
+const (
+	// ReceiverProbeDisabled means receivers aren't probed.
+	ReceiverProbeDisabled ReceiverProbeMode = iota
+
+	// ReceiverProbeWarn means receivers are probed, but unreachable
+	// receivers only result in a warning.
+	ReceiverProbeWarn
+
+	// ReceiverProbeStrict means receivers are probed and the transfer is
+	// aborted if any of them is unreachable.
+	ReceiverProbeStrict
+)
+
+// String returns a human-readable version of the probe mode.
+func (m ReceiverProbeMode) String() string {
+	switch m {
+	case ReceiverProbeDisabled:
+		return "disabled"
+
+	case ReceiverProbeWarn:
+		return "warn"
+
+	case ReceiverProbeStrict:
+		return "strict"
+
+	default:
+		return fmt.Sprintf("<unknown>(%d)", m)
+	}
+}
+
+// probeReceivers probes all remote receivers of the virtual packet through the
+// proof courier, if the courier supports it. Depending on the probe mode, an
+// unreachable receiver either aborts the transfer or is only logged. Since the
+// anchor transaction isn't funded yet, aborting doesn't commit any funds.
+func (p *ChainPorter) probeReceivers(ctx context.Context,
+	vPacket *tappsbt.VPacket) error {
+
+	if p.cfg.ReceiverProbeMode == ReceiverProbeDisabled {
+		return nil
+	}
+
+	prober, ok := p.cfg.ProofCourier.(proof.ReceiverProber[proof.Recipient])
+	if !ok {
+		log.Debugf("Proof courier doesn't support probing receivers")
+		return nil
+	}
+
+	timeout := p.cfg.ReceiverProbeTimeout
+	if timeout == 0 {
+		timeout = DefaultReceiverProbeTimeout
+	}
+
+	for idx, vOut := range vPacket.Outputs {
+		// Proofs of our own outputs aren't delivered through the
+		// courier, so there's nothing to probe.
+		key := vOut.ScriptKey
+		if _, isLocal := p.localScriptKey(ctx, key); isLocal {
+			continue
+		}
+
+		recipient := proof.Recipient{
+			ScriptKey: key.PubKey,
+			AssetID:   vPacket.Inputs[0].PrevID.ID,
+			Amount:    vOut.Amount,
+		}
+
+		probeCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := prober.ProbeReceiver(probeCtx, recipient)
+		cancel()
+		if err == nil {
+			continue
+		}
+
+		if p.cfg.ReceiverProbeMode == ReceiverProbeStrict {
+			return fmt.Errorf("%w: output %d (script_key=%x): %v",
+				ErrReceiverUnreachable, idx,
+				key.PubKey.SerializeCompressed(), err)
+		}
+
+		log.Warnf("Receiver of output %d (script_key=%x) is "+
+			"unreachable through the proof courier, proof "+
+			"delivery might fail: %v", idx,
+			key.PubKey.SerializeCompressed(), err)
+	}
+
+	return nil
+}
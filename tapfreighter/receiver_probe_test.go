@@ -0,0 +1,102 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/stretchr/testify/require"
+)
+
+// mockProbeCourier is a proof courier that can probe receivers, failing for
+// the configured script keys.
+type mockProbeCourier struct {
+	proof.Courier[proof.Recipient]
+
+	unreachable map[asset.SerializedKey]struct{}
+
+	probed []*btcec.PublicKey
+}
+
+func (m *mockProbeCourier) ProbeReceiver(_ context.Context,
+	recipient proof.Recipient) error {
+
+	m.probed = append(m.probed, recipient.ScriptKey)
+
+	key := asset.ToSerialized(recipient.ScriptKey)
+	if _, ok := m.unreachable[key]; ok {
+		return errors.New("mailbox unreachable")
+	}
+
+	return nil
+}
+
+// TestProbeReceivers tests that only remote receivers are probed and that an
+// unreachable receiver only aborts the transfer in strict mode.
+func TestProbeReceivers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	localKey := asset.NewScriptKeyBip86(test.PubToKeyDesc(
+		test.RandPubKey(t),
+	))
+	remoteKey := asset.NewScriptKey(test.RandPubKey(t))
+	unreachableKey := asset.NewScriptKey(test.RandPubKey(t))
+
+	vPacket := &tappsbt.VPacket{
+		Inputs: []*tappsbt.VInput{{}},
+		Outputs: []*tappsbt.VOutput{{
+			ScriptKey: localKey,
+		}, {
+			ScriptKey: remoteKey,
+		}, {
+			ScriptKey: unreachableKey,
+		}},
+	}
+
+	newPorter := func(mode ReceiverProbeMode) (*ChainPorter,
+		*mockProbeCourier) {
+
+		courier := &mockProbeCourier{
+			unreachable: map[asset.SerializedKey]struct{}{
+				asset.ToSerialized(unreachableKey.PubKey): {},
+			},
+		}
+
+		return &ChainPorter{
+			cfg: &ChainPorterConfig{
+				KeyRing:           tapgarden.NewMockKeyRing(),
+				ProofCourier:      courier,
+				ReceiverProbeMode: mode,
+			},
+		}, courier
+	}
+
+	// Without probing, the courier isn't used at all.
+	porter, courier := newPorter(ReceiverProbeDisabled)
+	require.NoError(t, porter.probeReceivers(ctx, vPacket))
+	require.Empty(t, courier.probed)
+
+	// In warn mode, all remote receivers are probed, but the transfer
+	// continues.
+	porter, courier = newPorter(ReceiverProbeWarn)
+	require.NoError(t, porter.probeReceivers(ctx, vPacket))
+	require.Equal(t, []*btcec.PublicKey{
+		remoteKey.PubKey, unreachableKey.PubKey,
+	}, courier.probed)
+
+	// In strict mode, the unreachable receiver aborts the transfer.
+	porter, _ = newPorter(ReceiverProbeStrict)
+	err := porter.probeReceivers(ctx, vPacket)
+	require.ErrorIs(t, err, ErrReceiverUnreachable)
+
+	// The reachable receivers alone don't abort the transfer.
+	vPacket.Outputs = vPacket.Outputs[:2]
+	require.NoError(t, porter.probeReceivers(ctx, vPacket))
+}
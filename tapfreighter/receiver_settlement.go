@@ -0,0 +1,58 @@
+package tapfreighter
+
+import (
+	"errors"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+// awaitReceiverSettlement waits in the background for the receiver of a
+// delivered proof to notify us that it verified and accepted the proof, if the
+// proof courier offers such a return channel. Once the notification arrives,
+// the transfer output is marked as settled by the receiver on disk.
+//
+// NOTE: Waiting for the settlement doesn't hold up the parcel, which is
+// completed independently. Settlements that arrive while the daemon isn't
+// running aren't recorded.
+func (p *ChainPorter) awaitReceiverSettlement(recipient proof.Recipient) {
+	courier := p.cfg.ProofCourier
+	settlement, ok := courier.(proof.SettlementChannel[proof.Recipient])
+	if !ok {
+		return
+	}
+
+	p.Wg.Add(1)
+	go func() {
+		defer p.Wg.Done()
+
+		ctx, cancel := p.WithCtxQuitNoTimeout()
+		defer cancel()
+
+		err := settlement.WaitSettled(ctx, recipient)
+		switch {
+		case errors.Is(err, proof.ErrSettlementDisabled):
+			return
+
+		case err != nil:
+			log.Warnf("Receiver with script key %x didn't settle "+
+				"output %v: %v",
+				recipient.ScriptKey.SerializeCompressed(),
+				recipient.OutPoint, err)
+			return
+		}
+
+		log.Infof("Receiver with script key %x settled output %v",
+			recipient.ScriptKey.SerializeCompressed(),
+			recipient.OutPoint)
+
+		err = p.cfg.DeliveryLog.MarkReceiverSettled(
+			ctx, recipient.OutPoint, recipient.ScriptKey,
+			time.Now().UTC(),
+		)
+		if err != nil {
+			log.Errorf("Unable to mark output %v as settled by "+
+				"receiver: %v", recipient.OutPoint, err)
+		}
+	}()
+}
@@ -0,0 +1,441 @@
+package tapfreighter
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+const (
+	// DefaultSignSessionLifetime is the default time a signing session of
+	// the remote signer stays valid.
+	DefaultSignSessionLifetime = time.Hour
+)
+
+var (
+	// ErrSignSessionUnknown is returned if a virtual packet is sent in a
+	// signing session that doesn't exist or already expired.
+	ErrSignSessionUnknown = errors.New("unknown or expired signing " +
+		"session")
+)
+
+// SignSessionID is the ID of a signing session of the remote signer.
+type SignSessionID [32]byte
+
+// String returns the hex encoded session ID.
+func (s SignSessionID) String() string {
+	return hex.EncodeToString(s[:])
+}
+
+// SignSession is an authenticated session of a watch-only daemon with the
+// remote signer.
+type SignSession struct {
+	// ID is the ID of the session.
+	ID SignSessionID
+
+	// ClientName is the name the watch-only daemon opened the session
+	// with.
+	ClientName string
+
+	// AuthID identifies the credentials the session was opened with. Only
+	// requests authenticated with the same credentials can use the
+	// session, so a leaked session ID is useless on its own.
+	AuthID string
+
+	// OpenedAt is the time the session was opened.
+	OpenedAt time.Time
+
+	// ExpiresAt is the time the session expires.
+	ExpiresAt time.Time
+}
+
+// PacketInputSummary summarizes an input of a virtual packet.
+type PacketInputSummary struct {
+	// AssetID is the ID of the asset spent by the input.
+	AssetID asset.ID
+
+	// Amount is the amount of the asset spent by the input.
+	Amount uint64
+
+	// ScriptKey is the script key of the asset spent by the input.
+	ScriptKey *btcec.PublicKey
+
+	// AnchorPoint is the anchor outpoint of the input.
+	AnchorPoint wire.OutPoint
+}
+
+// PacketOutputSummary summarizes an output of a virtual packet.
+type PacketOutputSummary struct {
+	// AssetID is the ID of the asset of the output.
+	AssetID asset.ID
+
+	// Amount is the amount of the asset of the output.
+	Amount uint64
+
+	// ScriptKey is the script key of the output.
+	ScriptKey *btcec.PublicKey
+
+	// AnchorOutputIndex is the index of the anchor output the output is
+	// committed to.
+	AnchorOutputIndex uint32
+
+	// SplitRoot is true if the output is a split root.
+	SplitRoot bool
+}
+
+// PacketSummary summarizes the transfer of a virtual packet, as the context
+// of a signing request to the remote signer.
+type PacketSummary struct {
+	// Inputs are the summaries of the inputs of the packet.
+	Inputs []PacketInputSummary
+
+	// Outputs are the summaries of the outputs of the packet.
+	Outputs []PacketOutputSummary
+}
+
+// SummarizeVirtualPacket creates the summary of the given virtual packet.
+func SummarizeVirtualPacket(vPkt *tappsbt.VPacket) (*PacketSummary, error) {
+	summary := &PacketSummary{
+		Inputs:  make([]PacketInputSummary, len(vPkt.Inputs)),
+		Outputs: make([]PacketOutputSummary, len(vPkt.Outputs)),
+	}
+
+	for idx, vIn := range vPkt.Inputs {
+		inputAsset := vIn.Asset()
+		if inputAsset == nil {
+			return nil, fmt.Errorf("input %d has no asset", idx)
+		}
+
+		summary.Inputs[idx] = PacketInputSummary{
+			AssetID:     inputAsset.ID(),
+			Amount:      inputAsset.Amount,
+			ScriptKey:   inputAsset.ScriptKey.PubKey,
+			AnchorPoint: vIn.PrevID.OutPoint,
+		}
+	}
+
+	for idx, vOut := range vPkt.Outputs {
+		if vOut.Asset == nil {
+			return nil, fmt.Errorf("output %d has no asset", idx)
+		}
+
+		summary.Outputs[idx] = PacketOutputSummary{
+			AssetID:           vOut.Asset.ID(),
+			Amount:            vOut.Amount,
+			ScriptKey:         vOut.Asset.ScriptKey.PubKey,
+			AnchorOutputIndex: vOut.AnchorOutputIndex,
+			SplitRoot:         vOut.Type.IsSplitRoot(),
+		}
+	}
+
+	return summary, nil
+}
+
+// Equal returns true if both summaries describe the same transfer.
+func (p *PacketSummary) Equal(other *PacketSummary) bool {
+	if len(p.Inputs) != len(other.Inputs) ||
+		len(p.Outputs) != len(other.Outputs) {
+
+		return false
+	}
+
+	keysEqual := func(a, b *btcec.PublicKey) bool {
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		return a.IsEqual(b)
+	}
+
+	for idx := range p.Inputs {
+		in, otherIn := p.Inputs[idx], other.Inputs[idx]
+		if in.AssetID != otherIn.AssetID ||
+			in.Amount != otherIn.Amount ||
+			in.AnchorPoint != otherIn.AnchorPoint ||
+			!keysEqual(in.ScriptKey, otherIn.ScriptKey) {
+
+			return false
+		}
+	}
+
+	for idx := range p.Outputs {
+		out, otherOut := p.Outputs[idx], other.Outputs[idx]
+		if out.AssetID != otherOut.AssetID ||
+			out.Amount != otherOut.Amount ||
+			out.AnchorOutputIndex != otherOut.AnchorOutputIndex ||
+			out.SplitRoot != otherOut.SplitRoot ||
+			!keysEqual(out.ScriptKey, otherOut.ScriptKey) {
+
+			return false
+		}
+	}
+
+	return true
+}
+
+// SignRequest is a request to the remote signer to sign a virtual packet.
+type SignRequest struct {
+	// Session is the session the request was sent in.
+	Session SignSession
+
+	// Summary is the summary of the packet, which is verified to match
+	// the packet.
+	Summary *PacketSummary
+
+	// VPacket is the unsigned virtual packet.
+	VPacket *tappsbt.VPacket
+}
+
+// SignPolicy decides whether the remote signer signs a virtual packet.
+type SignPolicy interface {
+	// CheckSignRequest returns an error if the virtual packet of the
+	// given request must not be signed.
+	CheckSignRequest(ctx context.Context, req *SignRequest) error
+}
+
+// SignPolicyFunc is a function that implements the SignPolicy interface.
+type SignPolicyFunc func(ctx context.Context, req *SignRequest) error
+
+// CheckSignRequest returns an error if the virtual packet of the given request
+// must not be signed.
+//
+// NOTE: This is part of the SignPolicy interface.
+func (f SignPolicyFunc) CheckSignRequest(ctx context.Context,
+	req *SignRequest) error {
+
+	return f(ctx, req)
+}
+
+// AllowedAssetsPolicy returns a signature policy that only allows spending the
+// given assets.
+func AllowedAssetsPolicy(assetIDs ...asset.ID) SignPolicy {
+	allowed := make(map[asset.ID]struct{}, len(assetIDs))
+	for _, assetID := range assetIDs {
+		allowed[assetID] = struct{}{}
+	}
+
+	return SignPolicyFunc(func(_ context.Context, req *SignRequest) error {
+		for _, in := range req.Summary.Inputs {
+			if _, ok := allowed[in.AssetID]; !ok {
+				return fmt.Errorf("asset %v is not allowed",
+					in.AssetID)
+			}
+		}
+
+		return nil
+	})
+}
+
+// MaxSendAmountPolicy returns a signature policy that limits the amount of
+// each asset a virtual packet can send to script keys that aren't controlled
+// by the given key ring.
+func MaxSendAmountPolicy(maxAmount uint64, keyRing KeyRing) SignPolicy {
+	checkSends := func(ctx context.Context, req *SignRequest) error {
+		sent := make(map[asset.ID]uint64)
+		for _, vOut := range req.VPacket.Outputs {
+			if vOut.Asset == nil {
+				return fmt.Errorf("output has no asset")
+			}
+
+			outputKey := vOut.Asset.ScriptKey.PubKey
+			if IsLocalScriptKey(ctx, keyRing, vOut.ScriptKey,
+				outputKey) {
+
+				continue
+			}
+
+			assetID := vOut.Asset.ID()
+			sent[assetID] += vOut.Amount
+			if sent[assetID] > maxAmount {
+				return fmt.Errorf("packet sends more than %d "+
+					"units of asset %v", maxAmount, assetID)
+			}
+		}
+
+		return nil
+	}
+
+	return SignPolicyFunc(checkSends)
+}
+
+// IsLocalScriptKey returns true if the given output key is the tweaked key of
+// the given script key and its internal key is controlled by the given key
+// ring.
+func IsLocalScriptKey(ctx context.Context, keyRing KeyRing,
+	scriptKey asset.ScriptKey, outputKey *btcec.PublicKey) bool {
+
+	tweakedKey := scriptKey.TweakedScriptKey
+	if tweakedKey == nil || outputKey == nil {
+		return false
+	}
+
+	if !keyRing.IsLocalKey(ctx, tweakedKey.RawKey) {
+		return false
+	}
+
+	expectedKey := txscript.ComputeTaprootOutputKey(
+		tweakedKey.RawKey.PubKey, tweakedKey.Tweak,
+	)
+
+	return expectedKey.IsEqual(outputKey)
+}
+
+// PacketSigner signs virtual packets with the script keys of the local node.
+type PacketSigner interface {
+	// SignVirtualPacket signs the virtual transaction of the given packet
+	// and returns the input indexes that were signed.
+	SignVirtualPacket(vPkt *tappsbt.VPacket,
+		optFuncs ...SignVirtualPacketOption) ([]uint32, error)
+}
+
+// RemoteSignerConfig is the config of the remote signer.
+type RemoteSignerConfig struct {
+	// Signer signs the virtual packets that pass all policies.
+	Signer PacketSigner
+
+	// Policies are the signature policies every virtual packet is checked
+	// against before it is signed.
+	Policies []SignPolicy
+
+	// SessionLifetime is the time a signing session stays valid.
+	SessionLifetime time.Duration
+
+	// Clock is the clock the expiry of sessions is tracked with.
+	Clock clock.Clock
+}
+
+// RemoteSigner signs the virtual packets of watch-only daemons on a separate,
+// hardened host. A watch-only daemon first opens a session, then sends its
+// virtual packets together with their summary, which are only signed if they
+// pass all signature policies.
+type RemoteSigner struct {
+	cfg *RemoteSignerConfig
+
+	mtx      sync.Mutex
+	sessions map[SignSessionID]*SignSession
+}
+
+// NewRemoteSigner creates a new remote signer.
+func NewRemoteSigner(cfg *RemoteSignerConfig) *RemoteSigner {
+	return &RemoteSigner{
+		cfg:      cfg,
+		sessions: make(map[SignSessionID]*SignSession),
+	}
+}
+
+// OpenSession opens a new signing session for the watch-only daemon with the
+// given name. The caller is expected to have authenticated the daemon with the
+// credentials identified by the given auth ID.
+func (r *RemoteSigner) OpenSession(clientName,
+	authID string) (*SignSession, error) {
+
+	var sessionID SignSessionID
+	if _, err := rand.Read(sessionID[:]); err != nil {
+		return nil, err
+	}
+
+	now := r.cfg.Clock.Now()
+	session := &SignSession{
+		ID:         sessionID,
+		ClientName: clientName,
+		AuthID:     authID,
+		OpenedAt:   now,
+		ExpiresAt:  now.Add(r.cfg.SessionLifetime),
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	// Forget all sessions that expired in the meantime.
+	for id, s := range r.sessions {
+		if !now.Before(s.ExpiresAt) {
+			delete(r.sessions, id)
+		}
+	}
+	r.sessions[sessionID] = session
+
+	log.Infof("Opened signing session %v for %q, expires at %v",
+		sessionID, clientName, session.ExpiresAt)
+
+	return session, nil
+}
+
+// session returns a copy of the signing session with the given ID if it
+// exists, was opened with the given credentials and didn't expire yet.
+func (r *RemoteSigner) session(id SignSessionID,
+	authID string) (SignSession, error) {
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	session, ok := r.sessions[id]
+	if !ok || session.AuthID != authID {
+		return SignSession{}, ErrSignSessionUnknown
+	}
+
+	if !r.cfg.Clock.Now().Before(session.ExpiresAt) {
+		delete(r.sessions, id)
+		return SignSession{}, ErrSignSessionUnknown
+	}
+
+	return *session, nil
+}
+
+// SignVirtualPacket signs all inputs of the given virtual packet in place, if
+// it matches the given summary and passes all signature policies. The request
+// must be authenticated with the credentials the session was opened with.
+func (r *RemoteSigner) SignVirtualPacket(ctx context.Context,
+	sessionID SignSessionID, authID string, vPkt *tappsbt.VPacket,
+	summary *PacketSummary) error {
+
+	session, err := r.session(sessionID, authID)
+	if err != nil {
+		return err
+	}
+
+	packetSummary, err := SummarizeVirtualPacket(vPkt)
+	if err != nil {
+		return fmt.Errorf("invalid virtual packet: %w", err)
+	}
+	if summary == nil || !packetSummary.Equal(summary) {
+		return fmt.Errorf("summary doesn't match virtual packet")
+	}
+
+	req := &SignRequest{
+		Session: session,
+		Summary: packetSummary,
+		VPacket: vPkt,
+	}
+	for _, policy := range r.cfg.Policies {
+		if err := policy.CheckSignRequest(ctx, req); err != nil {
+			log.Warnf("Signature policy rejected virtual packet "+
+				"of %q in session %v: %v", session.ClientName,
+				session.ID, err)
+
+			return fmt.Errorf("rejected by signature policy: %w",
+				err)
+		}
+	}
+
+	_, err = r.cfg.Signer.SignVirtualPacket(vPkt)
+	if err != nil {
+		return fmt.Errorf("unable to sign virtual packet: %w", err)
+	}
+
+	log.Infof("Signed virtual packet with %d inputs and %d outputs for "+
+		"%q in session %v", len(vPkt.Inputs), len(vPkt.Outputs),
+		session.ClientName, session.ID)
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockPacketSigner is a PacketSigner that records the packets it signs.
+type mockPacketSigner struct {
+	signed []*tappsbt.VPacket
+}
+
+// SignVirtualPacket records the packet and reports all inputs as signed.
+func (m *mockPacketSigner) SignVirtualPacket(vPkt *tappsbt.VPacket,
+	_ ...SignVirtualPacketOption) ([]uint32, error) {
+
+	m.signed = append(m.signed, vPkt)
+
+	signedInputs := make([]uint32, len(vPkt.Inputs))
+	for idx := range vPkt.Inputs {
+		signedInputs[idx] = uint32(idx)
+	}
+
+	return signedInputs, nil
+}
+
+// TestRemoteSigner tests that the remote signer only signs virtual packets in
+// valid sessions that match their summary and pass all signature policies.
+func TestRemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testClock := clock.NewTestClock(time.Unix(1_700_000_000, 0))
+	packetSigner := &mockPacketSigner{}
+
+	vPkt := newUnsignedVPacket(t)
+	summary, err := SummarizeVirtualPacket(vPkt)
+	require.NoError(t, err)
+
+	signer := NewRemoteSigner(&RemoteSignerConfig{
+		Signer: packetSigner,
+		Policies: []SignPolicy{
+			AllowedAssetsPolicy(vPkt.Inputs[0].Asset().ID()),
+		},
+		SessionLifetime: time.Hour,
+		Clock:           testClock,
+	})
+
+	session, err := signer.OpenSession("watch-only", "auth")
+	require.NoError(t, err)
+
+	// Sessions are bound to the credentials they were opened with.
+	err = signer.SignVirtualPacket(ctx, session.ID, "other", vPkt, summary)
+	require.ErrorIs(t, err, ErrSignSessionUnknown)
+
+	err = signer.SignVirtualPacket(
+		ctx, SignSessionID{1}, "auth", vPkt, summary,
+	)
+	require.ErrorIs(t, err, ErrSignSessionUnknown)
+
+	// A summary that doesn't describe the packet is rejected.
+	badSummary := *summary
+	badSummary.Outputs = []PacketOutputSummary{summary.Outputs[0]}
+	badSummary.Outputs[0].Amount++
+	err = signer.SignVirtualPacket(
+		ctx, session.ID, "auth", vPkt, &badSummary,
+	)
+	require.ErrorContains(t, err, "summary doesn't match")
+	require.Empty(t, packetSigner.signed)
+
+	// A valid request is signed.
+	err = signer.SignVirtualPacket(ctx, session.ID, "auth", vPkt, summary)
+	require.NoError(t, err)
+	require.Len(t, packetSigner.signed, 1)
+
+	// Packets spending other assets are rejected by the policy.
+	otherPkt := newUnsignedVPacket(t)
+	otherSummary, err := SummarizeVirtualPacket(otherPkt)
+	require.NoError(t, err)
+	err = signer.SignVirtualPacket(
+		ctx, session.ID, "auth", otherPkt, otherSummary,
+	)
+	require.ErrorContains(t, err, "rejected by signature policy")
+	require.Len(t, packetSigner.signed, 1)
+
+	// Once the session expired, a new one must be opened.
+	testClock.SetTime(session.ExpiresAt)
+	err = signer.SignVirtualPacket(ctx, session.ID, "auth", vPkt, summary)
+	require.ErrorIs(t, err, ErrSignSessionUnknown)
+
+	newSession, err := signer.OpenSession("watch-only", "auth")
+	require.NoError(t, err)
+	require.NotEqual(t, session.ID, newSession.ID)
+
+	err = signer.SignVirtualPacket(
+		ctx, newSession.ID, "auth", vPkt, summary,
+	)
+	require.NoError(t, err)
+	require.Len(t, packetSigner.signed, 2)
+}
+
+// TestAllowedAssetsPolicy tests that the allowed assets policy rejects packets
+// spending assets that aren't on its list.
+func TestAllowedAssetsPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	vPkt := newUnsignedVPacket(t)
+	summary, err := SummarizeVirtualPacket(vPkt)
+	require.NoError(t, err)
+
+	req := &SignRequest{Summary: summary, VPacket: vPkt}
+
+	allowed := AllowedAssetsPolicy(vPkt.Inputs[0].Asset().ID())
+	require.NoError(t, allowed.CheckSignRequest(ctx, req))
+
+	denied := AllowedAssetsPolicy(asset.ID{1})
+	require.Error(t, denied.CheckSignRequest(ctx, req))
+}
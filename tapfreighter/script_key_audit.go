@@ -0,0 +1,386 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+)
+
+const (
+	// DefaultScriptKeyAuditInterval is the default interval in which the
+	// transfer log and the proof archive are audited for reused script
+	// keys.
+	DefaultScriptKeyAuditInterval = 24 * time.Hour
+
+	// defaultScriptKeyAuditTimeout is the timeout of a single audit.
+	defaultScriptKeyAuditTimeout = time.Hour
+)
+
+var (
+	// ErrScriptKeyFlagged is returned if a transfer would send assets to
+	// a script key that has an open flag of the script key audit.
+	ErrScriptKeyFlagged = errors.New("script key flagged for reuse")
+
+	// ErrScriptKeyNotFlagged is returned when resolving the flags of a
+	// script key that doesn't have an open flag.
+	ErrScriptKeyNotFlagged = errors.New("script key has no open flag")
+)
+
+// ScriptKeyConflict is the kind of reuse a script key was flagged for. Assets
+// that share a script key can't be told apart by their proof locator, so their
+// proofs overwrite each other, and a witness of one of them might be replayed
+// to spend another.
+type ScriptKeyConflict uint8
+
+const (
+	// ScriptKeyConflictUnspent means the script key is used by more than
+	// one unspent asset.
+	ScriptKeyConflictUnspent ScriptKeyConflict = iota
+
+	// ScriptKeyConflictTransfers means the script key is used by the
+	// outputs of more than one transfer.
+	ScriptKeyConflictTransfers
+
+	// ScriptKeyConflictProof means the archived proof of an unspent asset
+	// ends in another anchor output than the asset itself, so it was
+	// overwritten by the proof of another asset with the same script key.
+	ScriptKeyConflictProof
+)
+
+// String returns a human-readable version of the conflict.
+func (c ScriptKeyConflict) String() string {
+	switch c {
+	case ScriptKeyConflictUnspent:
+		return "unspent"
+
+	case ScriptKeyConflictTransfers:
+		return "transfers"
+
+	case ScriptKeyConflictProof:
+		return "proof"
+
+	default:
+		return fmt.Sprintf("<unknown>(%d)", c)
+	}
+}
+
+// ScriptKeyReuse is a script key that was found to be used more than once.
+type ScriptKeyReuse struct {
+	// ScriptKey is the reused script key.
+	ScriptKey *btcec.PublicKey
+
+	// Conflict is the kind of reuse that was found.
+	Conflict ScriptKeyConflict
+
+	// AnchorPoints are the anchor outputs the script key was found in.
+	AnchorPoints []wire.OutPoint
+}
+
+// FlaggedScriptKey is a stored flag of a reused script key. No assets are
+// sent to a script key while it has an open flag.
+type FlaggedScriptKey struct {
+	// ScriptKey is the flagged script key.
+	ScriptKey *btcec.PublicKey
+
+	// Conflict is the kind of reuse the script key was flagged for.
+	Conflict ScriptKeyConflict
+
+	// NumOccurrences is the number of anchor outputs the script key was
+	// found in when it was last flagged.
+	NumOccurrences int
+
+	// FlaggedAt is the time the script key was flagged.
+	FlaggedAt time.Time
+
+	// ResolvedAt is the time the flag was resolved, or the zero time if
+	// the flag is still open.
+	ResolvedAt time.Time
+}
+
+// Resolved returns true if the flag was resolved.
+func (f *FlaggedScriptKey) Resolved() bool {
+	return !f.ResolvedAt.IsZero()
+}
+
+// ScriptKeyAuditLog keeps track of the script keys of the daemon that were
+// used more than once.
+type ScriptKeyAuditLog interface {
+	// ListScriptKeyReuse returns all script keys that are used by more
+	// than one unspent asset or by the outputs of more than one transfer.
+	ListScriptKeyReuse(ctx context.Context) ([]ScriptKeyReuse, error)
+
+	// FlagScriptKeys flags the given reused script keys and returns the
+	// flags that were opened. A resolved flag is only reopened if the
+	// script key was found in more anchor outputs than when it was
+	// resolved.
+	FlagScriptKeys(ctx context.Context,
+		reuses ...ScriptKeyReuse) ([]FlaggedScriptKey, error)
+
+	// ListFlaggedScriptKeys returns all flags of the given script key, or
+	// all flags if the script key is nil.
+	ListFlaggedScriptKeys(ctx context.Context,
+		scriptKey *btcec.PublicKey) ([]FlaggedScriptKey, error)
+
+	// ResolveFlaggedScriptKey resolves all open flags of the given script
+	// key. If there are none, ErrScriptKeyNotFlagged is returned.
+	ResolveFlaggedScriptKey(ctx context.Context,
+		scriptKey *btcec.PublicKey) error
+}
+
+// AnchoredLocator is the proof locator of an unspent asset along with the
+// anchor output the asset is committed to.
+type AnchoredLocator struct {
+	proof.Locator
+
+	// AnchorPoint is the anchor output of the asset.
+	AnchorPoint wire.OutPoint
+}
+
+// ScriptKeyAuditorConfig is the configuration of the script key auditor.
+type ScriptKeyAuditorConfig struct {
+	// AuditLog is used to find reused script keys in the transfer log and
+	// to store their flags.
+	AuditLog ScriptKeyAuditLog
+
+	// ProofArchive is the archive whose proofs are checked for being
+	// overwritten by the proof of another asset. If nil, the proof
+	// archive isn't audited.
+	ProofArchive proof.Archiver
+
+	// UnspentProofs returns the proof locators of all unspent assets.
+	UnspentProofs func(ctx context.Context) ([]AnchoredLocator, error)
+
+	// Interval is the interval in which the audit runs. If zero, the
+	// audit only runs on demand.
+	Interval time.Duration
+}
+
+// ScriptKeyAuditor audits the transfer log and the proof archive for script
+// keys that were used more than once, which should never happen as every
+// output gets a fresh script key. Reused script keys are flagged, which blocks
+// all further transfers to them until the flag is resolved.
+type ScriptKeyAuditor struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *ScriptKeyAuditorConfig
+
+	// auditMtx makes sure only a single audit runs at a time.
+	auditMtx sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewScriptKeyAuditor creates a new script key auditor from the given config.
+func NewScriptKeyAuditor(cfg *ScriptKeyAuditorConfig) *ScriptKeyAuditor {
+	return &ScriptKeyAuditor{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts the periodic audit, if an interval is configured.
+func (s *ScriptKeyAuditor) Start() error {
+	s.startOnce.Do(func() {
+		if s.cfg.Interval == 0 {
+			return
+		}
+
+		log.Infof("Starting script key auditor")
+
+		s.wg.Add(1)
+		go s.auditPeriodically()
+	})
+
+	return nil
+}
+
+// Stop stops the periodic audit.
+func (s *ScriptKeyAuditor) Stop() error {
+	s.stopOnce.Do(func() {
+		log.Infof("Stopping script key auditor")
+
+		close(s.quit)
+		s.wg.Wait()
+	})
+
+	return nil
+}
+
+// auditPeriodically runs the audit in the configured interval.
+//
+// NOTE: This method MUST be called as a goroutine.
+func (s *ScriptKeyAuditor) auditPeriodically() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-s.quit:
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(
+			context.Background(), defaultScriptKeyAuditTimeout,
+		)
+		_, err := s.Audit(ctx)
+		cancel()
+		if err != nil {
+			log.Errorf("Unable to audit script keys: %v", err)
+		}
+	}
+}
+
+// Audit scans the transfer log and the proof archive for reused script keys
+// once, flags them and returns all reuses that were found.
+func (s *ScriptKeyAuditor) Audit(ctx context.Context) ([]ScriptKeyReuse,
+	error) {
+
+	s.auditMtx.Lock()
+	defer s.auditMtx.Unlock()
+
+	reuses, err := s.cfg.AuditLog.ListScriptKeyReuse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list reused script keys: %w",
+			err)
+	}
+
+	proofReuses, err := s.auditProofArchive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reuses = append(reuses, proofReuses...)
+
+	opened, err := s.cfg.AuditLog.FlagScriptKeys(ctx, reuses...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to flag reused script keys: %w",
+			err)
+	}
+
+	for _, flag := range opened {
+		log.Warnf("Flagged script key %x for %v reuse in %d anchor "+
+			"outputs, transfers to it are blocked until the flag "+
+			"is resolved", flag.ScriptKey.SerializeCompressed(),
+			flag.Conflict, flag.NumOccurrences)
+	}
+
+	log.Debugf("Script key audit found %d reused script keys, %d newly "+
+		"flagged", len(reuses), len(opened))
+
+	return reuses, nil
+}
+
+// auditProofArchive returns the script keys of all unspent assets whose
+// archived proof ends in another anchor output than the asset itself.
+func (s *ScriptKeyAuditor) auditProofArchive(
+	ctx context.Context) ([]ScriptKeyReuse, error) {
+
+	if s.cfg.ProofArchive == nil || s.cfg.UnspentProofs == nil {
+		return nil, nil
+	}
+
+	locators, err := s.cfg.UnspentProofs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list proofs: %w", err)
+	}
+
+	var reuses []ScriptKeyReuse
+	for _, loc := range locators {
+		// Tombstones all share the NUMS key, so their proofs are
+		// expected to overwrite each other.
+		if loc.ScriptKey.IsEqual(asset.NUMSPubKey) {
+			continue
+		}
+
+		// Missing proofs are reported by the proof scrubber.
+		blob, err := s.cfg.ProofArchive.FetchProof(ctx, loc.Locator)
+		if errors.Is(err, proof.ErrProofNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch proof: %w", err)
+		}
+
+		// Damaged proofs are reported by the proof scrubber as well.
+		file := proof.NewEmptyFile(proof.V0)
+		if err := file.Decode(bytes.NewReader(blob)); err != nil {
+			continue
+		}
+		lastProof, err := file.LastProof()
+		if err != nil {
+			continue
+		}
+
+		proofAnchor := wire.OutPoint{
+			Hash:  lastProof.AnchorTx.TxHash(),
+			Index: lastProof.InclusionProof.OutputIndex,
+		}
+		if proofAnchor == loc.AnchorPoint {
+			continue
+		}
+
+		scriptKey := loc.ScriptKey
+		reuses = append(reuses, ScriptKeyReuse{
+			ScriptKey: &scriptKey,
+			Conflict:  ScriptKeyConflictProof,
+			AnchorPoints: []wire.OutPoint{
+				loc.AnchorPoint, proofAnchor,
+			},
+		})
+	}
+
+	return reuses, nil
+}
+
+// checkFlaggedScriptKeys makes sure none of the outputs of the virtual packet
+// sends assets to a script key that has an open flag of the script key audit.
+// Since the anchor transaction isn't funded yet, refusing the transfer doesn't
+// commit any funds.
+func (p *ChainPorter) checkFlaggedScriptKeys(ctx context.Context,
+	vPacket *tappsbt.VPacket) error {
+
+	if p.cfg.ScriptKeyAuditLog == nil {
+		return nil
+	}
+
+	for idx, vOut := range vPacket.Outputs {
+		scriptKey := vOut.ScriptKey.PubKey
+		if scriptKey == nil || scriptKey.IsEqual(asset.NUMSPubKey) {
+			continue
+		}
+
+		flags, err := p.cfg.ScriptKeyAuditLog.ListFlaggedScriptKeys(
+			ctx, scriptKey,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to check script key flags: "+
+				"%w", err)
+		}
+
+		for _, flag := range flags {
+			if flag.Resolved() {
+				continue
+			}
+
+			return fmt.Errorf("%w: output %d sends to script key "+
+				"%x, which was flagged for %v reuse",
+				ErrScriptKeyFlagged, idx,
+				scriptKey.SerializeCompressed(), flag.Conflict)
+		}
+	}
+
+	return nil
+}
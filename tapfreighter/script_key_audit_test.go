@@ -0,0 +1,216 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/stretchr/testify/require"
+)
+
+// mockScriptKeyAuditLog is an in-memory ScriptKeyAuditLog that reports a
+// fixed set of reused script keys.
+type mockScriptKeyAuditLog struct {
+	reuses []ScriptKeyReuse
+	flags  []FlaggedScriptKey
+}
+
+// ListScriptKeyReuse returns the configured reuses.
+func (m *mockScriptKeyAuditLog) ListScriptKeyReuse(
+	context.Context) ([]ScriptKeyReuse, error) {
+
+	return m.reuses, nil
+}
+
+// FlagScriptKeys opens a flag for every reuse that isn't flagged yet.
+func (m *mockScriptKeyAuditLog) FlagScriptKeys(ctx context.Context,
+	reuses ...ScriptKeyReuse) ([]FlaggedScriptKey, error) {
+
+	var opened []FlaggedScriptKey
+	for _, reuse := range reuses {
+		flags, _ := m.ListFlaggedScriptKeys(ctx, reuse.ScriptKey)
+		if len(flags) > 0 {
+			continue
+		}
+
+		flag := FlaggedScriptKey{
+			ScriptKey:      reuse.ScriptKey,
+			Conflict:       reuse.Conflict,
+			NumOccurrences: len(reuse.AnchorPoints),
+		}
+		m.flags = append(m.flags, flag)
+		opened = append(opened, flag)
+	}
+
+	return opened, nil
+}
+
+// ListFlaggedScriptKeys returns the flags of the given script key.
+func (m *mockScriptKeyAuditLog) ListFlaggedScriptKeys(_ context.Context,
+	scriptKey *btcec.PublicKey) ([]FlaggedScriptKey, error) {
+
+	var flags []FlaggedScriptKey
+	for _, flag := range m.flags {
+		if scriptKey == nil || flag.ScriptKey.IsEqual(scriptKey) {
+			flags = append(flags, flag)
+		}
+	}
+
+	return flags, nil
+}
+
+// ResolveFlaggedScriptKey removes all flags of the given script key.
+func (m *mockScriptKeyAuditLog) ResolveFlaggedScriptKey(_ context.Context,
+	scriptKey *btcec.PublicKey) error {
+
+	var flags []FlaggedScriptKey
+	for _, flag := range m.flags {
+		if !flag.ScriptKey.IsEqual(scriptKey) {
+			flags = append(flags, flag)
+		}
+	}
+	if len(flags) == len(m.flags) {
+		return ErrScriptKeyNotFlagged
+	}
+	m.flags = flags
+
+	return nil
+}
+
+// archiveTestProof stores a proof file for the given asset that ends in the
+// output with the given index of a new anchor transaction, and returns the
+// anchor output.
+func archiveTestProof(t *testing.T, archive proof.Archiver, a *asset.Asset,
+	outputIndex uint32) wire.OutPoint {
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{PreviousOutPoint: test.RandOp(t)})
+	for i := uint32(0); i <= outputIndex; i++ {
+		anchorTx.AddTxOut(&wire.TxOut{
+			PkScript: test.RandBytes(34),
+			Value:    1_000,
+		})
+	}
+
+	file, err := proof.NewFile(proof.V0, proof.Proof{
+		AnchorTx: *anchorTx,
+		Asset:    *a,
+		InclusionProof: proof.TaprootProof{
+			OutputIndex: outputIndex,
+			InternalKey: test.RandPubKey(t),
+		},
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, file.Encode(&buf))
+
+	assetID := a.ID()
+	err = archive.ImportProofs(
+		context.Background(), nil, false, &proof.AnnotatedProof{
+			Locator: proof.Locator{
+				AssetID:   &assetID,
+				ScriptKey: *a.ScriptKey.PubKey,
+			},
+			Blob: buf.Bytes(),
+		},
+	)
+	require.NoError(t, err)
+
+	return wire.OutPoint{Hash: anchorTx.TxHash(), Index: outputIndex}
+}
+
+// TestScriptKeyAuditor tests that reused script keys found in the transfer log
+// and overwritten proofs in the proof archive are flagged, and that transfers
+// to flagged script keys are refused.
+func TestScriptKeyAuditor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// The transfer log reports a script key used by two unspent assets.
+	logReuse := ScriptKeyReuse{
+		ScriptKey:    test.RandPubKey(t),
+		Conflict:     ScriptKeyConflictUnspent,
+		AnchorPoints: []wire.OutPoint{test.RandOp(t), test.RandOp(t)},
+	}
+	auditLog := &mockScriptKeyAuditLog{
+		reuses: []ScriptKeyReuse{logReuse},
+	}
+
+	// The proof of the first asset ends in its own anchor output, the
+	// proof of the second one was overwritten by a proof that ends in
+	// another anchor output.
+	archive, err := proof.NewFileArchiver(t.TempDir())
+	require.NoError(t, err)
+
+	intactAsset := asset.RandAsset(t, asset.Normal)
+	intactAnchor := archiveTestProof(t, archive, intactAsset, 1)
+
+	overwrittenAsset := asset.RandAsset(t, asset.Normal)
+	overwrittenAnchor := wire.OutPoint{Hash: test.RandHash(), Index: 0}
+	proofAnchor := archiveTestProof(t, archive, overwrittenAsset, 2)
+
+	locator := func(a *asset.Asset,
+		anchorPoint wire.OutPoint) AnchoredLocator {
+
+		assetID := a.ID()
+		return AnchoredLocator{
+			Locator: proof.Locator{
+				AssetID:   &assetID,
+				ScriptKey: *a.ScriptKey.PubKey,
+			},
+			AnchorPoint: anchorPoint,
+		}
+	}
+	auditor := NewScriptKeyAuditor(&ScriptKeyAuditorConfig{
+		AuditLog:     auditLog,
+		ProofArchive: archive,
+		UnspentProofs: func(context.Context) ([]AnchoredLocator,
+			error) {
+
+			return []AnchoredLocator{
+				locator(intactAsset, intactAnchor),
+				locator(overwrittenAsset, overwrittenAnchor),
+			}, nil
+		},
+	})
+
+	reuses, err := auditor.Audit(ctx)
+	require.NoError(t, err)
+	require.Len(t, reuses, 2)
+	require.Equal(t, logReuse, reuses[0])
+	require.Equal(t, ScriptKeyConflictProof, reuses[1].Conflict)
+	require.True(t, overwrittenAsset.ScriptKey.PubKey.IsEqual(
+		reuses[1].ScriptKey,
+	))
+	require.Equal(t, []wire.OutPoint{
+		overwrittenAnchor, proofAnchor,
+	}, reuses[1].AnchorPoints)
+	require.Len(t, auditLog.flags, 2)
+
+	// Transfers to a flagged script key are refused until the flag is
+	// resolved.
+	porter := &ChainPorter{
+		cfg: &ChainPorterConfig{ScriptKeyAuditLog: auditLog},
+	}
+	vPkt := &tappsbt.VPacket{
+		Outputs: []*tappsbt.VOutput{{
+			ScriptKey: asset.NewScriptKey(test.RandPubKey(t)),
+		}, {
+			ScriptKey: asset.NewScriptKey(logReuse.ScriptKey),
+		}},
+	}
+	err = porter.checkFlaggedScriptKeys(ctx, vPkt)
+	require.ErrorIs(t, err, ErrScriptKeyFlagged)
+
+	err = auditLog.ResolveFlaggedScriptKey(ctx, logReuse.ScriptKey)
+	require.NoError(t, err)
+	require.NoError(t, porter.checkFlaggedScriptKeys(ctx, vPkt))
+}
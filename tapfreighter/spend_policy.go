@@ -0,0 +1,190 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+)
+
+// ErrRecipientNotAllowed is returned if a transfer of an asset with a spend
+// allowlist would send the asset to a recipient that isn't on the list.
+var ErrRecipientNotAllowed = errors.New("recipient not on spend allowlist " +
+	"of asset")
+
+// SpendAllowlist is the set of recipients an asset may be transferred to.
+// Sending to script keys of the local node is always allowed, so assets can
+// be moved between the node's own keys.
+type SpendAllowlist struct {
+	// ScriptKeys is the set of allowed recipient script keys.
+	ScriptKeys map[asset.SerializedKey]struct{}
+
+	// AddrPatterns is a list of glob patterns, as understood by
+	// path.Match, of allowed recipient Taproot Asset addresses. These are
+	// only taken into account for transfers to addresses.
+	AddrPatterns []string
+}
+
+// NewSpendAllowlist creates a new allowlist with the given script keys and
+// address patterns.
+func NewSpendAllowlist(scriptKeys []*btcec.PublicKey,
+	addrPatterns []string) (*SpendAllowlist, error) {
+
+	for _, pattern := range addrPatterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid address pattern %q: %w",
+				pattern, err)
+		}
+	}
+
+	allowlist := &SpendAllowlist{
+		ScriptKeys:   make(map[asset.SerializedKey]struct{}),
+		AddrPatterns: addrPatterns,
+	}
+	for _, scriptKey := range scriptKeys {
+		allowlist.ScriptKeys[asset.ToSerialized(scriptKey)] = struct{}{}
+	}
+
+	return allowlist, nil
+}
+
+// allowsScriptKey returns true if the given script key is on the allowlist.
+func (l *SpendAllowlist) allowsScriptKey(scriptKey *btcec.PublicKey) bool {
+	_, ok := l.ScriptKeys[asset.ToSerialized(scriptKey)]
+	return ok
+}
+
+// allowsAddr returns true if the given address matches one of the address
+// patterns or its script key is on the allowlist.
+func (l *SpendAllowlist) allowsAddr(addr *address.Tap) (bool, error) {
+	if l.allowsScriptKey(&addr.ScriptKey) {
+		return true, nil
+	}
+
+	encoded, err := addr.EncodeAddress()
+	if err != nil {
+		return false, fmt.Errorf("unable to encode address: %w", err)
+	}
+
+	for _, pattern := range l.AddrPatterns {
+		// The patterns were validated when the allowlist was created,
+		// so we can ignore the error here.
+		if match, _ := path.Match(pattern, encoded); match {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// SpendPolicy restricts the recipients of outbound transfers of individual
+// assets. Assets without an allowlist can be sent to any recipient.
+type SpendPolicy struct {
+	// Allowlists maps the ID of a restricted asset to the recipients it
+	// may be sent to.
+	Allowlists map[asset.ID]*SpendAllowlist
+}
+
+// isLocalScriptKey returns true if the given script key belongs to the local
+// node.
+func (f *AssetWallet) isLocalScriptKey(ctx context.Context,
+	scriptKey *btcec.PublicKey) (bool, error) {
+
+	_, err := f.cfg.AddrBook.FetchScriptKey(ctx, scriptKey)
+	switch {
+	case err == nil:
+		return true, nil
+
+	case errors.Is(err, address.ErrScriptKeyNotFound):
+		return false, nil
+
+	default:
+		return false, fmt.Errorf("cannot fetch script key: %w", err)
+	}
+}
+
+// checkAddrRecipients makes sure all the given addresses are allowed by the
+// spend policy of the asset they are for.
+func (f *AssetWallet) checkAddrRecipients(ctx context.Context,
+	addrs []*address.Tap) error {
+
+	if f.cfg.SpendPolicy == nil {
+		return nil
+	}
+
+	for _, addr := range addrs {
+		allowlist, ok := f.cfg.SpendPolicy.Allowlists[addr.AssetID]
+		if !ok {
+			continue
+		}
+
+		allowed, err := allowlist.allowsAddr(addr)
+		if err != nil {
+			return err
+		}
+		if allowed {
+			continue
+		}
+
+		local, err := f.isLocalScriptKey(ctx, &addr.ScriptKey)
+		if err != nil {
+			return err
+		}
+		if !local {
+			return fmt.Errorf("%w %v: script key %x",
+				ErrRecipientNotAllowed, addr.AssetID,
+				addr.ScriptKey.SerializeCompressed())
+		}
+	}
+
+	return nil
+}
+
+// checkPacketRecipients makes sure all outputs of the given virtual packet
+// template are allowed by the spend policy of the asset with the given ID.
+func (f *AssetWallet) checkPacketRecipients(ctx context.Context, id asset.ID,
+	vPkt *tappsbt.VPacket) error {
+
+	if f.cfg.SpendPolicy == nil {
+		return nil
+	}
+
+	allowlist, ok := f.cfg.SpendPolicy.Allowlists[id]
+	if !ok {
+		return nil
+	}
+
+	for idx, vOut := range vPkt.Outputs {
+		scriptKey := vOut.ScriptKey.PubKey
+		if scriptKey == nil {
+			return fmt.Errorf("output %d has no script key", idx)
+		}
+
+		// The NUMS key is used as a placeholder for change outputs
+		// that will receive a local script key during funding.
+		if scriptKey.IsEqual(asset.NUMSPubKey) {
+			continue
+		}
+
+		if allowlist.allowsScriptKey(scriptKey) {
+			continue
+		}
+
+		local, err := f.isLocalScriptKey(ctx, scriptKey)
+		if err != nil {
+			return err
+		}
+		if !local {
+			return fmt.Errorf("%w %v: output %d script key %x",
+				ErrRecipientNotAllowed, id, idx,
+				scriptKey.SerializeCompressed())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAddrBook is a mock implementation of the AddrBook interface that knows a
+// fixed set of local script keys.
+type mockAddrBook struct {
+	localKeys map[asset.SerializedKey]struct{}
+}
+
+func (m *mockAddrBook) FetchScriptKey(_ context.Context,
+	tweakedScriptKey *btcec.PublicKey) (*asset.TweakedScriptKey, error) {
+
+	if _, ok := m.localKeys[asset.ToSerialized(tweakedScriptKey)]; !ok {
+		return nil, address.ErrScriptKeyNotFound
+	}
+
+	return &asset.TweakedScriptKey{}, nil
+}
+
+// TestSpendPolicy tests that transfers of restricted assets are only allowed
+// to recipients on the allowlist of the asset and to local script keys.
+func TestSpendPolicy(t *testing.T) {
+	t.Parallel()
+
+	chainParams := &address.RegressionNetTap
+	allowedAddr, _, _ := address.RandAddr(t, chainParams)
+	patternAddr, _, _ := address.RandAddr(t, chainParams)
+	otherAddr, _, _ := address.RandAddr(t, chainParams)
+
+	// All addresses are for the same restricted asset.
+	assetID := allowedAddr.AssetID
+	patternAddr.AssetID = assetID
+	otherAddr.AssetID = assetID
+
+	patternEncoded, err := patternAddr.EncodeAddress()
+	require.NoError(t, err)
+
+	allowlist, err := NewSpendAllowlist(
+		[]*btcec.PublicKey{&allowedAddr.ScriptKey},
+		[]string{patternEncoded[:len(patternEncoded)-6] + "*"},
+	)
+	require.NoError(t, err)
+
+	_, err = NewSpendAllowlist(nil, []string{"[invalid"})
+	require.Error(t, err)
+
+	localKey := test.RandPubKey(t)
+	wallet := NewAssetWallet(&WalletConfig{
+		AddrBook: &mockAddrBook{
+			localKeys: map[asset.SerializedKey]struct{}{
+				asset.ToSerialized(localKey): {},
+			},
+		},
+		ChainParams: chainParams,
+		SpendPolicy: &SpendPolicy{
+			Allowlists: map[asset.ID]*SpendAllowlist{
+				assetID: allowlist,
+			},
+		},
+	})
+
+	ctx := context.Background()
+	require.NoError(t, wallet.checkAddrRecipients(
+		ctx, []*address.Tap{allowedAddr.Tap, patternAddr.Tap},
+	))
+
+	err = wallet.checkAddrRecipients(
+		ctx, []*address.Tap{allowedAddr.Tap, otherAddr.Tap},
+	)
+	require.ErrorIs(t, err, ErrRecipientNotAllowed)
+
+	// The policy is checked before any coins are selected, so a wallet
+	// without a coin selector rejects the send right away.
+	_, err = wallet.FundAddressSend(
+		ctx, []*address.Tap{otherAddr.Tap},
+	)
+	require.ErrorIs(t, err, ErrRecipientNotAllowed)
+
+	// Addresses of unrestricted assets are always allowed.
+	unrestricted, _, _ := address.RandAddr(t, chainParams)
+	require.NoError(t, wallet.checkAddrRecipients(
+		ctx, []*address.Tap{unrestricted.Tap},
+	))
+
+	// Packet outputs are checked by their script keys only. Local script
+	// keys and the NUMS change placeholder are always allowed.
+	newPacket := func(keys ...*btcec.PublicKey) *tappsbt.VPacket {
+		vPkt := &tappsbt.VPacket{}
+		for _, key := range keys {
+			vPkt.Outputs = append(vPkt.Outputs, &tappsbt.VOutput{
+				ScriptKey: asset.NewScriptKey(key),
+			})
+		}
+
+		return vPkt
+	}
+
+	require.NoError(t, wallet.checkPacketRecipients(
+		ctx, assetID, newPacket(
+			&allowedAddr.ScriptKey, localKey, asset.NUMSPubKey,
+		),
+	))
+
+	err = wallet.checkPacketRecipients(
+		ctx, assetID, newPacket(&patternAddr.ScriptKey),
+	)
+	require.ErrorIs(t, err, ErrRecipientNotAllowed)
+	require.ErrorContains(t, err, "output 0")
+
+	require.NoError(t, wallet.checkPacketRecipients(
+		ctx, unrestricted.AssetID, newPacket(&otherAddr.ScriptKey),
+	))
+}
@@ -0,0 +1,205 @@
+package tapfreighter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+)
+
+// HookStage is the point relative to the execution of a send state at which a
+// state hook runs.
+type HookStage uint8
+
+const (
+	// HookBefore is the stage of hooks that run before a send state is
+	// executed. These hooks can veto the state, for example to run a
+	// compliance check before the anchor transaction is broadcast.
+	HookBefore HookStage = iota
+
+	// HookAfter is the stage of hooks that run after a send state was
+	// executed successfully, for example to notify an external system once
+	// the proofs of a parcel were stored.
+	HookAfter
+)
+
+// String returns a human-readable version of the hook stage.
+func (h HookStage) String() string {
+	switch h {
+	case HookBefore:
+		return "before"
+
+	case HookAfter:
+		return "after"
+
+	default:
+		return fmt.Sprintf("<unknown(%d)>", h)
+	}
+}
+
+// StateHookInfo is the information about a parcel that is passed to a state
+// hook. The fields must not be modified by the hook.
+type StateHookInfo struct {
+	// ParcelID is the ID of the parcel the state is executed for.
+	ParcelID uint64
+
+	// State is the send state the hook runs for.
+	State SendState
+
+	// Stage is the stage the hook runs at.
+	Stage HookStage
+
+	// VirtualPacket is the virtual packet of the parcel, if it was already
+	// funded.
+	VirtualPacket *tappsbt.VPacket
+
+	// AnchorTx is the anchor transaction of the parcel, if it was already
+	// created.
+	AnchorTx *AnchorTransaction
+
+	// OutboundParcel is the parcel as it was committed to disk, if it was
+	// already committed.
+	OutboundParcel *OutboundParcel
+}
+
+// StateHook is a function that runs before or after a send state is executed.
+// If it returns an error, the parcel fails with a StateHookError.
+type StateHook func(ctx context.Context, info *StateHookInfo) error
+
+// StateHookError is the error a parcel fails with if one of its state hooks
+// returned an error.
+type StateHookError struct {
+	// Name is the name the hook was registered with.
+	Name string
+
+	// State is the send state the hook ran for.
+	State SendState
+
+	// Stage is the stage the hook ran at.
+	Stage HookStage
+
+	// Err is the error returned by the hook.
+	Err error
+}
+
+// Error returns the error message of the hook error.
+func (e *StateHookError) Error() string {
+	return fmt.Sprintf("state hook %q %v %v failed: %v", e.Name,
+		e.Stage, e.State, e.Err)
+}
+
+// Unwrap returns the error returned by the hook.
+func (e *StateHookError) Unwrap() error {
+	return e.Err
+}
+
+// stateHookKey identifies the hooks of a send state and stage.
+type stateHookKey struct {
+	state SendState
+	stage HookStage
+}
+
+// namedStateHook is a state hook along with the name it was registered with.
+type namedStateHook struct {
+	name string
+	hook StateHook
+}
+
+// StateHooks is the set of hooks that run before or after the send states of
+// the ChainPorter. Hooks can be registered and removed while the porter is
+// running. Hooks of the same state and stage run in the order they were
+// registered in.
+type StateHooks struct {
+	mu    sync.RWMutex
+	hooks map[stateHookKey][]namedStateHook
+}
+
+// NewStateHooks creates a new empty set of state hooks.
+func NewStateHooks() *StateHooks {
+	return &StateHooks{
+		hooks: make(map[stateHookKey][]namedStateHook),
+	}
+}
+
+// Register adds a hook that runs at the given stage of the given send state.
+// The name must be unique for the state and stage.
+func (s *StateHooks) Register(name string, state SendState, stage HookStage,
+	hook StateHook) error {
+
+	if name == "" || hook == nil {
+		return fmt.Errorf("state hook must have a name and function")
+	}
+	if state > SendStateComplete || stage > HookAfter {
+		return fmt.Errorf("invalid state hook point %v %v", stage,
+			state)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := stateHookKey{state: state, stage: stage}
+	for _, h := range s.hooks[key] {
+		if h.name == name {
+			return fmt.Errorf("state hook %q already registered "+
+				"%v %v", name, stage, state)
+		}
+	}
+
+	s.hooks[key] = append(s.hooks[key], namedStateHook{
+		name: name,
+		hook: hook,
+	})
+
+	return nil
+}
+
+// Remove removes the hook with the given name from the given send state and
+// stage. Removing an unknown hook is a no-op.
+func (s *StateHooks) Remove(name string, state SendState, stage HookStage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := stateHookKey{state: state, stage: stage}
+	hooks := s.hooks[key]
+	for idx := range hooks {
+		if hooks[idx].name != name {
+			continue
+		}
+
+		s.hooks[key] = append(hooks[:idx:idx], hooks[idx+1:]...)
+		if len(s.hooks[key]) == 0 {
+			delete(s.hooks, key)
+		}
+
+		return
+	}
+}
+
+// run runs all hooks of the state and stage of the given info in order. The
+// first hook that returns an error stops the execution and its error is
+// returned as a StateHookError.
+func (s *StateHooks) run(ctx context.Context, info *StateHookInfo) error {
+	if s == nil {
+		return nil
+	}
+
+	// We copy the hooks, so hooks can be changed while they run.
+	s.mu.RLock()
+	key := stateHookKey{state: info.State, stage: info.Stage}
+	hooks := append([]namedStateHook(nil), s.hooks[key]...)
+	s.mu.RUnlock()
+
+	for _, h := range hooks {
+		if err := h.hook(ctx, info); err != nil {
+			return &StateHookError{
+				Name:  h.name,
+				State: info.State,
+				Stage: info.Stage,
+				Err:   err,
+			}
+		}
+	}
+
+	return nil
+}
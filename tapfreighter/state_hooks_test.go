@@ -0,0 +1,79 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestStateHooks tests that state hooks run in order for their state and
+// stage only and that a failing hook surfaces as a StateHookError.
+func TestStateHooks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	hooks := NewStateHooks()
+
+	var calls []string
+	newHook := func(name string, err error) StateHook {
+		return func(_ context.Context, info *StateHookInfo) error {
+			require.EqualValues(t, 7, info.ParcelID)
+			calls = append(calls, name)
+			return err
+		}
+	}
+
+	require.NoError(t, hooks.Register(
+		"compliance", SendStateBroadcast, HookBefore,
+		newHook("compliance", nil),
+	))
+	require.NoError(t, hooks.Register(
+		"audit", SendStateBroadcast, HookBefore, newHook("audit", nil),
+	))
+	require.NoError(t, hooks.Register(
+		"notify", SendStateStoreProofs, HookAfter,
+		newHook("notify", nil),
+	))
+
+	// Names must be unique per state and stage.
+	err := hooks.Register(
+		"audit", SendStateBroadcast, HookBefore, newHook("audit", nil),
+	)
+	require.ErrorContains(t, err, "already registered")
+	require.NoError(t, hooks.Register(
+		"audit", SendStateBroadcast, HookAfter, newHook("audit", nil),
+	))
+
+	info := &StateHookInfo{
+		ParcelID: 7,
+		State:    SendStateBroadcast,
+		Stage:    HookBefore,
+	}
+	require.NoError(t, hooks.run(ctx, info))
+	require.Equal(t, []string{"compliance", "audit"}, calls)
+
+	// A failing hook vetoes the state and stops the remaining hooks.
+	errBlocked := errors.New("recipient blocked")
+	hooks.Remove("compliance", SendStateBroadcast, HookBefore)
+	require.NoError(t, hooks.Register(
+		"compliance", SendStateBroadcast, HookBefore,
+		newHook("compliance", errBlocked),
+	))
+
+	calls = nil
+	err = hooks.run(ctx, info)
+	require.ErrorIs(t, err, errBlocked)
+	require.Equal(t, []string{"audit", "compliance"}, calls)
+
+	var hookErr *StateHookError
+	require.ErrorAs(t, err, &hookErr)
+	require.Equal(t, "compliance", hookErr.Name)
+	require.Equal(t, SendStateBroadcast, hookErr.State)
+	require.Equal(t, HookBefore, hookErr.Stage)
+
+	// Without hooks, nothing runs.
+	var noHooks *StateHooks
+	require.NoError(t, noHooks.run(ctx, info))
+}
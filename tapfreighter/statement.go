@@ -0,0 +1,355 @@
+package tapfreighter
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/address"
+)
+
+// StatementFormat is an enum that describes the serialization format of an
+// accounting statement of asset transfers.
+type StatementFormat uint8
+
+const (
+	// StatementFormatCSV renders the statement as RFC 4180 CSV with a
+	// single header line.
+	StatementFormatCSV StatementFormat = iota
+
+	// StatementFormatJSONL renders the statement as JSON lines, with one
+	// JSON object per statement entry.
+	StatementFormatJSONL
+)
+
+// String returns a human-readable version of StatementFormat.
+func (f StatementFormat) String() string {
+	switch f {
+	case StatementFormatCSV:
+		return "csv"
+
+	case StatementFormatJSONL:
+		return "jsonl"
+
+	default:
+		return fmt.Sprintf("<unknown_format(%d)>", f)
+	}
+}
+
+// StatementEntryType denotes what kind of balance change a statement entry
+// describes.
+type StatementEntryType string
+
+const (
+	// StatementEntryInput is an entry describing an asset that was spent
+	// by a transfer.
+	StatementEntryInput StatementEntryType = "input"
+
+	// StatementEntryOutput is an entry describing an asset that was
+	// created by a transfer.
+	StatementEntryOutput StatementEntryType = "output"
+
+	// StatementEntryFee is an entry describing the on-chain fee paid by
+	// the anchor transaction of a transfer. There is exactly one such entry
+	// per transfer, so summing the fee column yields the total fees paid.
+	StatementEntryFee StatementEntryType = "fee"
+
+	// StatementEntryReceive is an entry describing an asset that was
+	// received through an address.
+	StatementEntryReceive StatementEntryType = "receive"
+)
+
+// StatementColumns is the stable, ordered set of columns emitted for every
+// statement entry. New columns may only ever be appended to this list, so
+// consumers can rely on the position of existing columns.
+var StatementColumns = []string{
+	"transfer_time",
+	"anchor_txid",
+	"chain_fees_sat",
+	"label",
+	"entry_type",
+	"entry_index",
+	"asset_id",
+	"amount",
+	"script_key",
+	"script_key_local",
+	"anchor_outpoint",
+	"output_type",
+	"lot_acquired_at",
+	"lot_source_txid",
+	"lot_price_annotation",
+}
+
+// StatementEntry is a single line of an accounting statement. Each transfer
+// is expanded into one entry per input, one entry per output and a single fee
+// entry. Each completed inbound address transfer results in one receive entry.
+//
+// Passive assets that were re-anchored by a transfer are not part of the
+// statement, as they remain under our control with an unchanged amount and
+// only move to a new anchor output whose fee is already covered by the fee
+// entry of the transfer.
+type StatementEntry struct {
+	// TransferTime is the time the transfer was created or, for receive
+	// entries, first detected, in UTC.
+	TransferTime time.Time `json:"transfer_time"`
+
+	// AnchorTxid is the hex encoded anchor transaction ID.
+	AnchorTxid string `json:"anchor_txid"`
+
+	// ChainFees is the on-chain fee in satoshis paid by the anchor
+	// transaction. This is only set on fee entries and zero on all others.
+	ChainFees int64 `json:"chain_fees_sat"`
+
+	// Label is an optional label of the transfer supplied by the labeler,
+	// for example the names of the counterparties that received it.
+	Label string `json:"label"`
+
+	// EntryType is the type of the entry.
+	EntryType StatementEntryType `json:"entry_type"`
+
+	// EntryIndex is the index of the input or output within the transfer.
+	// This is zero for fee and receive entries.
+	EntryIndex int `json:"entry_index"`
+
+	// AssetID is the hex encoded ID of the asset that was moved. This is
+	// empty for fee entries.
+	AssetID string `json:"asset_id"`
+
+	// Amount is the amount of assets spent or created.
+	Amount uint64 `json:"amount"`
+
+	// ScriptKey is the hex encoded, compressed script key of the entry.
+	// For outputs that aren't local, this is the counterparty's key.
+	ScriptKey string `json:"script_key"`
+
+	// ScriptKeyLocal indicates whether the script key is known to our
+	// wallet. This is always true for inputs and receives.
+	ScriptKeyLocal bool `json:"script_key_local"`
+
+	// AnchorOutpoint is the anchor outpoint the asset was spent from or
+	// committed to. This is empty for fee entries.
+	AnchorOutpoint string `json:"anchor_outpoint"`
+
+	// OutputType is the virtual output type for outputs and empty for all
+	// other entries.
+	OutputType string `json:"output_type"`
+
+	// LotAcquiredAt is the RFC 3339 formatted acquisition time of the lot
+	// an input spent, in UTC. This is empty for all other entries and for
+	// inputs whose lot or acquisition time is unknown.
+	LotAcquiredAt string `json:"lot_acquired_at"`
+
+	// LotSourceTxid is the hex encoded anchor transaction ID of the
+	// transfer that created the lot an input spent as change. This is
+	// empty for all other entries and for lots that were minted or
+	// received.
+	LotSourceTxid string `json:"lot_source_txid"`
+
+	// LotPriceAnnotation is the price annotation of the lot an input
+	// spent. This is empty for all other entries.
+	LotPriceAnnotation string `json:"lot_price_annotation"`
+}
+
+// record returns the entry as a string slice in the order of
+// StatementColumns.
+func (e *StatementEntry) record() []string {
+	return []string{
+		e.TransferTime.Format(time.RFC3339Nano),
+		e.AnchorTxid,
+		strconv.FormatInt(e.ChainFees, 10),
+		e.Label,
+		string(e.EntryType),
+		strconv.Itoa(e.EntryIndex),
+		e.AssetID,
+		strconv.FormatUint(e.Amount, 10),
+		e.ScriptKey,
+		strconv.FormatBool(e.ScriptKeyLocal),
+		e.AnchorOutpoint,
+		e.OutputType,
+		e.LotAcquiredAt,
+		e.LotSourceTxid,
+		e.LotPriceAnnotation,
+	}
+}
+
+// setLot sets the lot columns of the entry to the given lot.
+func (e *StatementEntry) setLot(lot *AssetLot) {
+	if !lot.AcquiredAt.IsZero() {
+		e.LotAcquiredAt = lot.AcquiredAt.UTC().Format(time.RFC3339Nano)
+	}
+	if lot.SourceTransfer != nil {
+		e.LotSourceTxid = lot.SourceTransfer.String()
+	}
+	e.LotPriceAnnotation = lot.PriceAnnotation
+}
+
+// TransferLabeler is a function that returns an optional label for a given
+// transfer, for example an invoice or order reference kept by the caller.
+type TransferLabeler func(*OutboundParcel) string
+
+// StatementEntries expands the given set of outbound transfers and inbound
+// address events into statement entries, ordered by their time. Only completed
+// address events are included. The labeler is optional and may be nil.
+func StatementEntries(parcels []*OutboundParcel, receives []*address.Event,
+	labeler TransferLabeler) ([]*StatementEntry, error) {
+
+	var entries []*StatementEntry
+	for idx := range parcels {
+		parcel := parcels[idx]
+		if parcel.AnchorTx == nil {
+			return nil, fmt.Errorf("transfer %d has no anchor tx",
+				idx)
+		}
+
+		var label string
+		if labeler != nil {
+			label = labeler(parcel)
+		}
+
+		newEntry := func(entryType StatementEntryType,
+			entryIdx int) *StatementEntry {
+
+			return &StatementEntry{
+				TransferTime: parcel.TransferTime.UTC(),
+				AnchorTxid:   parcel.AnchorTx.TxHash().String(),
+				Label:        label,
+				EntryType:    entryType,
+				EntryIndex:   entryIdx,
+			}
+		}
+
+		feeEntry := newEntry(StatementEntryFee, 0)
+		feeEntry.ChainFees = parcel.ChainFees
+
+		// All active outputs of a transfer carry the same asset ID as
+		// the inputs, since we currently don't support sending
+		// multiple assets in a single virtual transaction. Without an
+		// input we can't tell what asset the outputs are of, which is
+		// the case for transfers that only re-anchor passive assets.
+		// Their fee was still paid, so only the fee entry is added.
+		if len(parcel.Inputs) == 0 {
+			entries = append(entries, feeEntry)
+			continue
+		}
+		outputAssetID := parcel.Inputs[0].ID
+
+		for inIdx := range parcel.Inputs {
+			in := parcel.Inputs[inIdx]
+
+			entry := newEntry(StatementEntryInput, inIdx)
+			entry.AssetID = in.ID.String()
+			entry.Amount = in.Amount
+			entry.ScriptKey = hex.EncodeToString(in.ScriptKey[:])
+			entry.ScriptKeyLocal = true
+			entry.AnchorOutpoint = in.OutPoint.String()
+
+			if in.Lot != nil {
+				entry.setLot(in.Lot)
+			}
+
+			entries = append(entries, entry)
+		}
+
+		for outIdx := range parcel.Outputs {
+			out := parcel.Outputs[outIdx]
+
+			entry := newEntry(StatementEntryOutput, outIdx)
+			entry.AssetID = outputAssetID.String()
+			entry.Amount = out.Amount
+			entry.ScriptKeyLocal = out.ScriptKeyLocal
+			entry.AnchorOutpoint = out.Anchor.OutPoint.String()
+			entry.OutputType = out.Type.String()
+
+			if out.ScriptKey.PubKey != nil {
+				entry.ScriptKey = hex.EncodeToString(
+					out.ScriptKey.PubKey.SerializeCompressed(),
+				)
+			}
+
+			entries = append(entries, entry)
+		}
+
+		entries = append(entries, feeEntry)
+	}
+
+	for idx := range receives {
+		event := receives[idx]
+		if event.Status != address.StatusCompleted {
+			continue
+		}
+
+		if event.Addr == nil || event.Addr.Tap == nil {
+			return nil, fmt.Errorf("receive %d has no address", idx)
+		}
+
+		addr := event.Addr.Tap
+		entries = append(entries, &StatementEntry{
+			TransferTime: event.CreationTime.UTC(),
+			AnchorTxid:   event.Outpoint.Hash.String(),
+			EntryType:    StatementEntryReceive,
+			AssetID:      addr.AssetID.String(),
+			Amount:       addr.Amount,
+			ScriptKey: hex.EncodeToString(
+				addr.ScriptKey.SerializeCompressed(),
+			),
+			ScriptKeyLocal: true,
+			AnchorOutpoint: event.Outpoint.String(),
+		})
+	}
+
+	// We keep the entries of the same transfer together and in their
+	// original order by using a stable sort.
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].TransferTime.Before(entries[j].TransferTime)
+	})
+
+	return entries, nil
+}
+
+// WriteStatement renders the given set of outbound transfers and inbound
+// address events as an accounting statement in the given format to the passed
+// writer. The labeler is optional and may be nil.
+func WriteStatement(w io.Writer, format StatementFormat,
+	parcels []*OutboundParcel, receives []*address.Event,
+	labeler TransferLabeler) error {
+
+	entries, err := StatementEntries(parcels, receives, labeler)
+	if err != nil {
+		return fmt.Errorf("unable to create statement entries: %w", err)
+	}
+
+	switch format {
+	case StatementFormatCSV:
+		csvWriter := csv.NewWriter(w)
+		if err := csvWriter.Write(StatementColumns); err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if err := csvWriter.Write(entry.record()); err != nil {
+				return err
+			}
+		}
+
+		csvWriter.Flush()
+		return csvWriter.Error()
+
+	case StatementFormatJSONL:
+		encoder := json.NewEncoder(w)
+		for _, entry := range entries {
+			if err := encoder.Encode(entry); err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("unknown statement format: %v", format)
+	}
+}
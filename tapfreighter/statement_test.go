@@ -0,0 +1,226 @@
+package tapfreighter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/stretchr/testify/require"
+)
+
+// randStatementParcel creates a random outbound parcel with one input and two
+// outputs for the statement tests.
+func randStatementParcel(t *testing.T) *OutboundParcel {
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t),
+	})
+	anchorTx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: []byte{0x01}})
+	anchorTx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: []byte{0x02}})
+
+	assetID := asset.RandID(t)
+	inputKey := test.RandPubKey(t)
+
+	return &OutboundParcel{
+		AnchorTx:     anchorTx,
+		TransferTime: time.Unix(1690000000, 123456789),
+		ChainFees:    321,
+		Inputs: []TransferInput{{
+			PrevID: asset.PrevID{
+				OutPoint:  test.RandOp(t),
+				ID:        assetID,
+				ScriptKey: asset.ToSerialized(inputKey),
+			},
+			Amount: 100,
+		}},
+		Outputs: []TransferOutput{{
+			Type: tappsbt.TypeSimple,
+			ScriptKey: asset.NewScriptKey(
+				test.RandPubKey(t),
+			),
+			Amount: 60,
+		}, {
+			Type: tappsbt.TypeSplitRoot,
+			ScriptKey: asset.NewScriptKeyBip86(
+				test.PubToKeyDesc(test.RandPubKey(t)),
+			),
+			ScriptKeyLocal: true,
+			Amount:         40,
+		}},
+	}
+}
+
+// randStatementReceive creates a random inbound address event with the given
+// status for the statement tests.
+func randStatementReceive(t *testing.T,
+	status address.Status) *address.Event {
+
+	addr, _, _ := address.RandAddr(t, &address.RegressionNetTap)
+
+	return &address.Event{
+		CreationTime: time.Unix(1690000100, 0),
+		Addr:         addr,
+		Status:       status,
+		Outpoint:     test.RandOp(t),
+	}
+}
+
+// TestWriteStatement tests that transfers are rendered into stable CSV and
+// JSON lines statements.
+func TestWriteStatement(t *testing.T) {
+	t.Parallel()
+
+	parcels := []*OutboundParcel{
+		randStatementParcel(t), randStatementParcel(t),
+	}
+	receives := []*address.Event{
+		randStatementReceive(t, address.StatusCompleted),
+		randStatementReceive(t, address.StatusTransactionConfirmed),
+	}
+	labeler := func(p *OutboundParcel) string {
+		return "order-" + p.AnchorTx.TxHash().String()[:8]
+	}
+
+	// The input of the first parcel spends a tracked change lot.
+	sourceTxid := test.RandHash()
+	parcels[0].Inputs[0].Lot = &AssetLot{
+		AcquiredAt:      time.Unix(1680000000, 0),
+		SourceTransfer:  &sourceTxid,
+		PriceAnnotation: "21 sats/unit",
+	}
+
+	// Each parcel expands into one input, two output and one fee entry.
+	// Only the completed receive is part of the statement.
+	entries, err := StatementEntries(parcels, receives, labeler)
+	require.NoError(t, err)
+	require.Len(t, entries, 9)
+
+	first := entries[0]
+	require.Equal(t, StatementEntryInput, first.EntryType)
+	require.Equal(t, parcels[0].Inputs[0].ID.String(), first.AssetID)
+	require.Zero(t, first.ChainFees)
+	require.Equal(t, labeler(parcels[0]), first.Label)
+	require.Equal(t, "2023-03-28T10:40:00Z", first.LotAcquiredAt)
+	require.Equal(t, sourceTxid.String(), first.LotSourceTxid)
+	require.Equal(t, "21 sats/unit", first.LotPriceAnnotation)
+
+	receiver := entries[1]
+	require.Equal(t, StatementEntryOutput, receiver.EntryType)
+	require.Equal(t, first.AssetID, receiver.AssetID)
+	require.Equal(t, uint64(60), receiver.Amount)
+	require.False(t, receiver.ScriptKeyLocal)
+	require.Equal(t, tappsbt.TypeSimple.String(), receiver.OutputType)
+
+	fee := entries[3]
+	require.Equal(t, StatementEntryFee, fee.EntryType)
+	require.Equal(t, first.AnchorTxid, fee.AnchorTxid)
+
+	receive := entries[8]
+	require.Equal(t, StatementEntryReceive, receive.EntryType)
+	require.Equal(t, receives[0].Addr.AssetID.String(), receive.AssetID)
+	require.Equal(t, receives[0].Addr.Amount, receive.Amount)
+	require.Equal(t, receives[0].Outpoint.String(), receive.AnchorOutpoint)
+	require.True(t, receive.ScriptKeyLocal)
+
+	// Summing up the fee column must result in the fees actually paid,
+	// without counting any transfer more than once.
+	var totalFees int64
+	for _, entry := range entries {
+		totalFees += entry.ChainFees
+	}
+	require.Equal(t, parcels[0].ChainFees+parcels[1].ChainFees, totalFees)
+
+	// The CSV output must have a header matching the column schema and
+	// one record per entry.
+	var csvBuf bytes.Buffer
+	err = WriteStatement(
+		&csvBuf, StatementFormatCSV, parcels, receives, labeler,
+	)
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(&csvBuf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, len(entries)+1)
+	require.Equal(t, StatementColumns, records[0])
+	for idx := range entries {
+		require.Equal(t, entries[idx].record(), records[idx+1])
+		require.Len(t, records[idx+1], len(StatementColumns))
+	}
+
+	// The JSON lines output must decode back into the same entries.
+	var jsonBuf bytes.Buffer
+	err = WriteStatement(
+		&jsonBuf, StatementFormatJSONL, parcels, receives, nil,
+	)
+	require.NoError(t, err)
+
+	scanner := bufio.NewScanner(&jsonBuf)
+	var numLines int
+	for scanner.Scan() {
+		var decoded StatementEntry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &decoded))
+
+		expected := *entries[numLines]
+		expected.Label = ""
+		require.True(t, expected.TransferTime.Equal(
+			decoded.TransferTime,
+		))
+		decoded.TransferTime = expected.TransferTime
+		require.Equal(t, expected, decoded)
+
+		numLines++
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, len(entries), numLines)
+
+	// An unknown format is rejected.
+	err = WriteStatement(&jsonBuf, StatementFormat(99), parcels, nil, nil)
+	require.Error(t, err)
+}
+
+// TestStatementEntriesMalformed makes sure malformed transfers without an
+// anchor transaction are rejected.
+func TestStatementEntriesMalformed(t *testing.T) {
+	t.Parallel()
+
+	_, err := StatementEntries([]*OutboundParcel{{
+		Outputs: []TransferOutput{{ScriptKey: asset.NUMSScriptKey}},
+	}}, nil, nil)
+	require.ErrorContains(t, err, "no anchor tx")
+}
+
+// TestStatementEntriesNoInputs makes sure a transfer without any inputs, such
+// as one that only re-anchors passive assets, results in a single fee entry
+// and doesn't fail the statement.
+func TestStatementEntriesNoInputs(t *testing.T) {
+	t.Parallel()
+
+	reAnchor := randStatementParcel(t)
+	reAnchor.Inputs = nil
+	reAnchor.ChainFees = 123
+
+	parcels := []*OutboundParcel{reAnchor, randStatementParcel(t)}
+	entries, err := StatementEntries(parcels, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, entries, 5)
+
+	fee := entries[0]
+	require.Equal(t, StatementEntryFee, fee.EntryType)
+	require.Equal(t, reAnchor.AnchorTx.TxHash().String(), fee.AnchorTxid)
+	require.EqualValues(t, 123, fee.ChainFees)
+
+	for _, entry := range entries[1:] {
+		require.Equal(
+			t, parcels[1].AnchorTx.TxHash().String(),
+			entry.AnchorTxid,
+		)
+	}
+}
@@ -0,0 +1,453 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+)
+
+// TemplateShareTotal is the sum of the shares of all share recipients of a
+// transfer template, in basis points.
+const TemplateShareTotal = 10_000
+
+var (
+	// ErrTemplateNotFound is returned when a transfer template doesn't
+	// exist.
+	ErrTemplateNotFound = errors.New("transfer template not found")
+
+	// ErrTemplateExists is returned when a transfer template is created
+	// with the name of an existing template.
+	ErrTemplateExists = errors.New("transfer template already exists")
+)
+
+// TemplateRecipient is a single recipient of a transfer template. A recipient
+// is either paid to a fixed Taproot Asset address, or to a script key and
+// internal key with a fixed amount or a share of the total amount of an
+// execution.
+type TemplateRecipient struct {
+	// Index is the position of the recipient in the template.
+	Index uint32
+
+	// Addr is the encoded Taproot Asset address of the recipient. If set,
+	// the recipient always receives the amount of the address and the
+	// keys, amount and share are unset.
+	Addr string
+
+	// ScriptKey is the script key the recipient is paid to if no address
+	// is set.
+	ScriptKey *btcec.PublicKey
+
+	// InternalKey is the internal key of the anchor output the recipient
+	// is paid to if no address is set.
+	InternalKey *btcec.PublicKey
+
+	// Amount is the fixed amount the recipient receives. Zero if the
+	// recipient receives a share instead.
+	Amount uint64
+
+	// ShareBps is the share of the total amount of an execution the
+	// recipient receives, in basis points. Zero if the recipient receives
+	// a fixed amount.
+	ShareBps uint32
+}
+
+// TemplateFeePolicy is the fee policy the transfers of a template are sent
+// with.
+type TemplateFeePolicy struct {
+	// Priority is the priority class of the transfers.
+	Priority ParcelPriority
+
+	// ConfTarget is the time after the execution of the template the
+	// transfer should be confirmed by. If zero, the transfer is sent
+	// without a confirmation deadline and its fee is never bumped.
+	ConfTarget time.Duration
+
+	// Escalation configures how the fee is bumped until the confirmation
+	// deadline. Only used if ConfTarget is set.
+	Escalation FeeEscalation
+}
+
+// TransferTemplate is a named set of recipients of an asset that can be paid
+// repeatedly without re-submitting the recipients every time.
+type TransferTemplate struct {
+	// ID is the database ID of the template.
+	ID int64
+
+	// Name is the unique name of the template.
+	Name string
+
+	// AssetID is the ID of the asset the recipients are paid in.
+	AssetID asset.ID
+
+	// Recipients are the recipients of the template, in template order.
+	Recipients []*TemplateRecipient
+
+	// FeePolicy is the fee policy the transfers are sent with.
+	FeePolicy TemplateFeePolicy
+
+	// CreatedAt is the time the template was created.
+	CreatedAt time.Time
+}
+
+// hasShares returns true if any recipient of the template receives a share of
+// the total amount of an execution.
+func (t *TransferTemplate) hasShares() bool {
+	for _, recipient := range t.Recipients {
+		if recipient.ShareBps != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ResolveTemplateAmounts returns the amount each recipient of the template
+// receives if it is executed with the given total amount, in template order.
+// The total amount is only split among the share recipients, any rounding
+// remainder goes to the first of them. The total must be zero if the template
+// has no share recipients.
+func ResolveTemplateAmounts(tmpl *TransferTemplate,
+	total uint64) ([]uint64, error) {
+
+	hasShares := tmpl.hasShares()
+	switch {
+	case hasShares && total == 0:
+		return nil, fmt.Errorf("template %s has share recipients, a "+
+			"total amount is required", tmpl.Name)
+
+	case !hasShares && total != 0:
+		return nil, fmt.Errorf("template %s only has fixed amounts, "+
+			"no total amount can be set", tmpl.Name)
+	}
+
+	var (
+		amounts     = make([]uint64, len(tmpl.Recipients))
+		firstShare  = -1
+		distributed uint64
+	)
+	for idx, recipient := range tmpl.Recipients {
+		if recipient.ShareBps == 0 {
+			amounts[idx] = recipient.Amount
+			continue
+		}
+
+		if firstShare < 0 {
+			firstShare = idx
+		}
+
+		// The share is split into the quotient and remainder of the
+		// total, so the multiplication can't overflow.
+		bps := uint64(recipient.ShareBps)
+		amounts[idx] = total/TemplateShareTotal*bps +
+			total%TemplateShareTotal*bps/TemplateShareTotal
+		distributed += amounts[idx]
+	}
+
+	if firstShare >= 0 {
+		amounts[firstShare] += total - distributed
+	}
+
+	for idx, amount := range amounts {
+		if amount == 0 {
+			return nil, fmt.Errorf("recipient %d of template %s "+
+				"would receive zero", idx, tmpl.Name)
+		}
+	}
+
+	return amounts, nil
+}
+
+// TemplateStore is the persistent store of transfer templates.
+type TemplateStore interface {
+	// CreateTemplate stores a new transfer template with all its
+	// recipients and returns its ID. If a template with the same name
+	// exists, ErrTemplateExists is returned.
+	CreateTemplate(ctx context.Context, tmpl *TransferTemplate) (int64,
+		error)
+
+	// FetchTemplate returns the transfer template with the given name. If
+	// no such template exists, ErrTemplateNotFound is returned.
+	FetchTemplate(ctx context.Context, name string) (*TransferTemplate,
+		error)
+
+	// ListTemplates returns all transfer templates, ordered by their name.
+	ListTemplates(ctx context.Context) ([]*TransferTemplate, error)
+
+	// DeleteTemplate deletes the transfer template with the given name. If
+	// no such template exists, ErrTemplateNotFound is returned.
+	DeleteTemplate(ctx context.Context, name string) error
+}
+
+// AssetGroupQuerier looks up the genesis and group of an asset.
+type AssetGroupQuerier interface {
+	// QueryAssetGroup returns the genesis and group of the asset with the
+	// given ID.
+	QueryAssetGroup(ctx context.Context,
+		assetID asset.ID) (*asset.AssetGroup, error)
+}
+
+// TemplaterConfig is the configuration of the templater.
+type TemplaterConfig struct {
+	// Store is the persistent store of transfer templates.
+	Store TemplateStore
+
+	// Porter is used to send the transfers of a template.
+	Porter Porter
+
+	// AssetGroups is used to look up the asset of a template, which is
+	// needed to pay the recipients that are given by their keys.
+	AssetGroups AssetGroupQuerier
+
+	// ChainParams are the chain parameters the recipient addresses must
+	// be encoded for.
+	ChainParams *address.ChainParams
+}
+
+// Templater maintains named transfer templates and sends their transfers, so
+// recurring distributions to the same recipients can be executed with a
+// single call.
+type Templater struct {
+	cfg *TemplaterConfig
+}
+
+// NewTemplater creates a new templater from the given config.
+func NewTemplater(cfg *TemplaterConfig) *Templater {
+	return &Templater{
+		cfg: cfg,
+	}
+}
+
+// ValidateTemplate validates the recipients and fee policy of the given
+// template and normalizes the addresses of its recipients.
+func (t *Templater) ValidateTemplate(tmpl *TransferTemplate) error {
+	if strings.TrimSpace(tmpl.Name) == "" {
+		return fmt.Errorf("template name must be set")
+	}
+	if len(tmpl.Recipients) == 0 {
+		return fmt.Errorf("template has no recipients")
+	}
+	if tmpl.FeePolicy.ConfTarget < 0 {
+		return fmt.Errorf("confirmation target must not be negative")
+	}
+
+	var (
+		seen        = make(map[string]int, len(tmpl.Recipients))
+		totalShares uint32
+	)
+	for idx, recipient := range tmpl.Recipients {
+		recipient.Index = uint32(idx)
+
+		var key string
+		switch {
+		case recipient.Addr != "":
+			if recipient.ScriptKey != nil ||
+				recipient.InternalKey != nil ||
+				recipient.Amount != 0 ||
+				recipient.ShareBps != 0 {
+
+				return fmt.Errorf("recipient %d: address "+
+					"recipients can't have keys or "+
+					"amounts", idx)
+			}
+
+			tapAddr, err := address.DecodeAddress(
+				recipient.Addr, t.cfg.ChainParams,
+			)
+			if err != nil {
+				return fmt.Errorf("recipient %d: invalid "+
+					"address: %w", idx, err)
+			}
+			if tapAddr.AssetID != tmpl.AssetID {
+				return fmt.Errorf("recipient %d: address is "+
+					"for asset %v, not %v", idx,
+					tapAddr.AssetID, tmpl.AssetID)
+			}
+
+			recipient.Addr, err = tapAddr.EncodeAddress()
+			if err != nil {
+				return fmt.Errorf("recipient %d: unable to "+
+					"encode address: %w", idx, err)
+			}
+			key = recipient.Addr
+
+		case recipient.ScriptKey == nil || recipient.InternalKey == nil:
+			return fmt.Errorf("recipient %d: either an address or "+
+				"a script and internal key must be set", idx)
+
+		case (recipient.Amount == 0) == (recipient.ShareBps == 0):
+			return fmt.Errorf("recipient %d: either an amount or "+
+				"a share must be set", idx)
+
+		default:
+			key = fmt.Sprintf("%x:%x",
+				schnorr.SerializePubKey(recipient.ScriptKey),
+				recipient.InternalKey.SerializeCompressed())
+		}
+
+		if prevIdx, ok := seen[key]; ok {
+			return fmt.Errorf("recipient %d: duplicate of "+
+				"recipient %d", idx, prevIdx)
+		}
+		seen[key] = idx
+
+		totalShares += recipient.ShareBps
+		if totalShares > TemplateShareTotal {
+			return fmt.Errorf("recipient shares exceed %d bps",
+				TemplateShareTotal)
+		}
+	}
+
+	if totalShares != 0 && totalShares != TemplateShareTotal {
+		return fmt.Errorf("recipient shares must add up to %d bps, "+
+			"got %d", TemplateShareTotal, totalShares)
+	}
+
+	return nil
+}
+
+// CreateTemplate validates and stores the given transfer template.
+func (t *Templater) CreateTemplate(ctx context.Context,
+	tmpl *TransferTemplate) error {
+
+	if err := t.ValidateTemplate(tmpl); err != nil {
+		return err
+	}
+
+	var err error
+	tmpl.CreatedAt = time.Now()
+	tmpl.ID, err = t.cfg.Store.CreateTemplate(ctx, tmpl)
+	if err != nil {
+		return fmt.Errorf("unable to store template: %w", err)
+	}
+
+	log.Infof("Created transfer template %s with %d recipients",
+		tmpl.Name, len(tmpl.Recipients))
+
+	return nil
+}
+
+// FetchTemplate returns the transfer template with the given name.
+func (t *Templater) FetchTemplate(ctx context.Context,
+	name string) (*TransferTemplate, error) {
+
+	return t.cfg.Store.FetchTemplate(ctx, name)
+}
+
+// ListTemplates returns all transfer templates.
+func (t *Templater) ListTemplates(
+	ctx context.Context) ([]*TransferTemplate, error) {
+
+	return t.cfg.Store.ListTemplates(ctx)
+}
+
+// DeleteTemplate deletes the transfer template with the given name.
+func (t *Templater) DeleteTemplate(ctx context.Context, name string) error {
+	return t.cfg.Store.DeleteTemplate(ctx, name)
+}
+
+// ExecuteTemplate pays all recipients of the transfer template with the given
+// name in a single transfer. The total amount is split among the share
+// recipients and must be zero if the template has none. The transfer is sent
+// with the fee policy of the template and returned once it is broadcast.
+func (t *Templater) ExecuteTemplate(ctx context.Context, name string,
+	total uint64) (*OutboundParcel, error) {
+
+	tmpl, err := t.cfg.Store.FetchTemplate(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	tapAddrs, err := t.templateAddrs(ctx, tmpl, total)
+	if err != nil {
+		return nil, err
+	}
+
+	parcel := NewAddressParcel(tapAddrs...)
+	parcel.SetPriority(tmpl.FeePolicy.Priority)
+	if tmpl.FeePolicy.ConfTarget != 0 {
+		parcel.SetConfDeadline(
+			time.Now().Add(tmpl.FeePolicy.ConfTarget),
+			tmpl.FeePolicy.Escalation,
+		)
+	}
+
+	log.Infof("Executing transfer template %s to %d recipients",
+		tmpl.Name, len(tapAddrs))
+
+	return t.cfg.Porter.RequestShipment(parcel)
+}
+
+// templateAddrs returns the addresses the recipients of the template are paid
+// to if it is executed with the given total amount.
+func (t *Templater) templateAddrs(ctx context.Context, tmpl *TransferTemplate,
+	total uint64) ([]*address.Tap, error) {
+
+	amounts, err := ResolveTemplateAmounts(tmpl, total)
+	if err != nil {
+		return nil, err
+	}
+
+	var assetGroup *asset.AssetGroup
+	tapAddrs := make([]*address.Tap, len(tmpl.Recipients))
+	for idx, recipient := range tmpl.Recipients {
+		if recipient.Addr != "" {
+			tapAddrs[idx], err = address.DecodeAddress(
+				recipient.Addr, t.cfg.ChainParams,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("recipient %d: invalid "+
+					"address: %w", idx, err)
+			}
+
+			continue
+		}
+
+		// Recipients given by their keys are paid to a fresh address
+		// of the current amount, which requires the genesis and group
+		// of the asset.
+		if assetGroup == nil {
+			assetGroup, err = t.cfg.AssetGroups.QueryAssetGroup(
+				ctx, tmpl.AssetID,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to query asset "+
+					"%v: %w", tmpl.AssetID, err)
+			}
+		}
+
+		tapAddrs[idx], err = newKeyAddr(
+			assetGroup, recipient, amounts[idx],
+			t.cfg.ChainParams,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("recipient %d: %w", idx, err)
+		}
+	}
+
+	return tapAddrs, nil
+}
+
+// newKeyAddr creates an address that pays the given amount of the asset to the
+// script and internal key of the recipient.
+func newKeyAddr(assetGroup *asset.AssetGroup, recipient *TemplateRecipient,
+	amount uint64, net *address.ChainParams) (*address.Tap, error) {
+
+	var groupKey *btcec.PublicKey
+	var groupSig *schnorr.Signature
+	if assetGroup.GroupKey != nil {
+		groupKey = &assetGroup.GroupPubKey
+		groupSig = &assetGroup.Sig
+	}
+
+	return address.New(
+		*assetGroup.Genesis, groupKey, groupSig, *recipient.ScriptKey,
+		*recipient.InternalKey, amount, nil, net,
+	)
+}
@@ -0,0 +1,152 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestResolveTemplateAmounts tests that the total amount of an execution is
+// split among the share recipients of a template.
+func TestResolveTemplateAmounts(t *testing.T) {
+	t.Parallel()
+
+	tmpl := &TransferTemplate{
+		Name: "payroll",
+		Recipients: []*TemplateRecipient{{
+			Amount: 500,
+		}, {
+			ShareBps: 3333,
+		}, {
+			ShareBps: 3333,
+		}, {
+			ShareBps: 3334,
+		}},
+	}
+
+	// The rounding remainder goes to the first share recipient.
+	amounts, err := ResolveTemplateAmounts(tmpl, 1000)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{500, 334, 333, 333}, amounts)
+
+	// Large totals don't overflow.
+	total := uint64(1<<63 + 12_345)
+	amounts, err = ResolveTemplateAmounts(tmpl, total)
+	require.NoError(t, err)
+	require.Equal(t, total, amounts[1]+amounts[2]+amounts[3])
+
+	// A total is required for share recipients and no recipient may
+	// receive zero.
+	_, err = ResolveTemplateAmounts(tmpl, 0)
+	require.ErrorContains(t, err, "total amount is required")
+
+	_, err = ResolveTemplateAmounts(tmpl, 2)
+	require.ErrorContains(t, err, "would receive zero")
+
+	// Templates with fixed amounts only can't be given a total.
+	fixed := &TransferTemplate{
+		Name:       "fixed",
+		Recipients: tmpl.Recipients[:1],
+	}
+	_, err = ResolveTemplateAmounts(fixed, 1000)
+	require.ErrorContains(t, err, "no total amount")
+
+	amounts, err = ResolveTemplateAmounts(fixed, 0)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{500}, amounts)
+}
+
+// TestValidateTemplate tests that invalid transfer templates are rejected.
+func TestValidateTemplate(t *testing.T) {
+	t.Parallel()
+
+	chainParams := &address.TestNet3Tap
+	templater := NewTemplater(&TemplaterConfig{
+		ChainParams: chainParams,
+	})
+
+	tapAddr, _, _ := address.RandAddr(t, chainParams)
+	encodedAddr, err := tapAddr.EncodeAddress()
+	require.NoError(t, err)
+
+	scriptKey, internalKey := test.RandPubKey(t), test.RandPubKey(t)
+	newTemplate := func(
+		recipients ...*TemplateRecipient) *TransferTemplate {
+
+		return &TransferTemplate{
+			Name:       "payroll",
+			AssetID:    tapAddr.AssetID,
+			Recipients: recipients,
+		}
+	}
+
+	valid := newTemplate(&TemplateRecipient{
+		Addr: encodedAddr,
+	}, &TemplateRecipient{
+		ScriptKey:   scriptKey,
+		InternalKey: internalKey,
+		ShareBps:    TemplateShareTotal,
+	})
+	require.NoError(t, templater.ValidateTemplate(valid))
+	require.EqualValues(t, 1, valid.Recipients[1].Index)
+
+	testCases := []struct {
+		name       string
+		recipients []*TemplateRecipient
+		err        string
+	}{{
+		name: "no recipients",
+		err:  "no recipients",
+	}, {
+		name: "address with amount",
+		recipients: []*TemplateRecipient{{
+			Addr:   encodedAddr,
+			Amount: 1,
+		}},
+		err: "can't have keys or amounts",
+	}, {
+		name: "missing internal key",
+		recipients: []*TemplateRecipient{{
+			ScriptKey: scriptKey,
+			Amount:    1,
+		}},
+		err: "either an address or a script and internal key",
+	}, {
+		name: "amount and share",
+		recipients: []*TemplateRecipient{{
+			ScriptKey:   scriptKey,
+			InternalKey: internalKey,
+			Amount:      1,
+			ShareBps:    TemplateShareTotal,
+		}},
+		err: "either an amount or a share",
+	}, {
+		name: "duplicate recipient",
+		recipients: []*TemplateRecipient{{
+			Addr: encodedAddr,
+		}, {
+			Addr: encodedAddr,
+		}},
+		err: "duplicate of recipient 0",
+	}, {
+		name: "incomplete shares",
+		recipients: []*TemplateRecipient{{
+			ScriptKey:   scriptKey,
+			InternalKey: internalKey,
+			ShareBps:    TemplateShareTotal / 2,
+		}},
+		err: "must add up to",
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := templater.ValidateTemplate(
+				newTemplate(tc.recipients...),
+			)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,364 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// DefaultTombstoneSweepInterval is the default interval in which
+	// anchor outputs that only commit to tombstones are swept.
+	DefaultTombstoneSweepInterval = 24 * time.Hour
+
+	// DefaultMaxSweepInputs is the default maximum number of anchor
+	// outputs that are swept in a single transaction.
+	DefaultMaxSweepInputs = 100
+
+	// defaultSweepConfTarget is the confirmation target the fee rate of
+	// sweep transactions is estimated for. Sweeping isn't time critical,
+	// so we aim for a cheap fee rate.
+	defaultSweepConfTarget = 144
+
+	// defaultSweepTimeout is the timeout of a single sweep run.
+	defaultSweepTimeout = time.Minute
+)
+
+// ErrSweepBelowDust is returned if the value of the swept anchor outputs
+// doesn't cover the fee of the sweep transaction with a non-dust output.
+var ErrSweepBelowDust = errors.New("swept value is below dust after fees")
+
+// TombstoneAnchor is an anchor output of the wallet that only commits to
+// tombstone asset outputs. Tombstones can't be spent, so the BTC value of the
+// anchor output can only be reclaimed by sweeping it.
+type TombstoneAnchor struct {
+	// OutPoint is the outpoint of the anchor output.
+	OutPoint wire.OutPoint
+
+	// Value is the BTC value of the anchor output.
+	Value btcutil.Amount
+
+	// InternalKey is the internal key of the anchor output.
+	InternalKey keychain.KeyDescriptor
+
+	// MerkleRoot is the Taproot merkle root the internal key is tweaked
+	// with.
+	MerkleRoot []byte
+}
+
+// TombstoneStore gives access to the anchor outputs that only commit to
+// tombstones and records their sweeps.
+type TombstoneStore interface {
+	// ListTombstoneAnchors returns all anchor outputs that only commit to
+	// tombstone asset outputs and weren't swept yet.
+	ListTombstoneAnchors(ctx context.Context) ([]TombstoneAnchor, error)
+
+	// LogTombstoneSweep records that the anchor outputs spent by the given
+	// sweep transaction were swept, so they aren't swept again.
+	LogTombstoneSweep(ctx context.Context, sweepTx *wire.MsgTx,
+		sweptAt time.Time) error
+}
+
+// SweepAddrSource hands out addresses of the backing wallet that swept funds
+// are sent to.
+type SweepAddrSource interface {
+	// NewSweepAddr returns a new address of the backing wallet.
+	NewSweepAddr(ctx context.Context) (btcutil.Address, error)
+}
+
+// TombstoneSweeperConfig is the configuration of the tombstone sweeper.
+type TombstoneSweeperConfig struct {
+	// Store gives access to the anchor outputs that only commit to
+	// tombstones.
+	Store TombstoneStore
+
+	// Wallet is used to sign the sweep transactions.
+	Wallet WalletAnchor
+
+	// AddrSource hands out the addresses the anchor outputs are swept to.
+	AddrSource SweepAddrSource
+
+	// ChainBridge is used to estimate the fee rate of and publish the
+	// sweep transactions.
+	ChainBridge tapgarden.ChainBridge
+
+	// ChainParams are the chain parameters of the chain we operate on.
+	ChainParams *address.ChainParams
+
+	// Interval is the interval in which the anchor outputs are swept.
+	Interval time.Duration
+
+	// MaxInputs is the maximum number of anchor outputs swept in a single
+	// transaction.
+	MaxInputs int
+}
+
+// TombstoneSweeper periodically sweeps the BTC value of anchor outputs that
+// only commit to tombstone asset outputs back to the wallet. These outputs
+// are left behind by full value sends and would otherwise lock their value
+// forever, as tombstones are never selected for spending.
+type TombstoneSweeper struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *TombstoneSweeperConfig
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewTombstoneSweeper creates a new tombstone sweeper from the given config.
+func NewTombstoneSweeper(cfg *TombstoneSweeperConfig) *TombstoneSweeper {
+	return &TombstoneSweeper{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts the periodic sweeping.
+func (s *TombstoneSweeper) Start() error {
+	s.startOnce.Do(func() {
+		log.Infof("Starting tombstone sweeper")
+
+		s.wg.Add(1)
+		go s.sweepPeriodically()
+	})
+
+	return nil
+}
+
+// Stop stops the periodic sweeping.
+func (s *TombstoneSweeper) Stop() error {
+	s.stopOnce.Do(func() {
+		log.Infof("Stopping tombstone sweeper")
+
+		close(s.quit)
+		s.wg.Wait()
+	})
+
+	return nil
+}
+
+// sweepPeriodically sweeps the tombstone anchors in the configured interval.
+//
+// NOTE: This method MUST be called as a goroutine.
+func (s *TombstoneSweeper) sweepPeriodically() {
+	defer s.wg.Done()
+
+	interval := s.cfg.Interval
+	if interval == 0 {
+		interval = DefaultTombstoneSweepInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-s.quit:
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(
+			context.Background(), defaultSweepTimeout,
+		)
+		_, err := s.Sweep(ctx)
+		cancel()
+		if err != nil {
+			log.Errorf("Unable to sweep tombstone anchors: %v", err)
+		}
+	}
+}
+
+// Sweep sweeps all anchor outputs that only commit to tombstones once, in
+// batches of at most the configured number of inputs, and returns the
+// published sweep transactions. Batches whose value doesn't cover the fee are
+// skipped and retried on the next run.
+func (s *TombstoneSweeper) Sweep(ctx context.Context) ([]*wire.MsgTx, error) {
+	anchors, err := s.cfg.Store.ListTombstoneAnchors(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list tombstone anchors: %w",
+			err)
+	}
+	if len(anchors) == 0 {
+		return nil, nil
+	}
+
+	feeRate, err := s.cfg.ChainBridge.EstimateFee(
+		ctx, defaultSweepConfTarget,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to estimate fee: %w", err)
+	}
+
+	maxInputs := s.cfg.MaxInputs
+	if maxInputs <= 0 {
+		maxInputs = DefaultMaxSweepInputs
+	}
+
+	var sweepTxs []*wire.MsgTx
+	for len(anchors) > 0 {
+		numInputs := maxInputs
+		if numInputs > len(anchors) {
+			numInputs = len(anchors)
+		}
+		batch := anchors[:numInputs]
+		anchors = anchors[numInputs:]
+
+		sweepTx, err := s.sweepBatch(ctx, batch, feeRate)
+		switch {
+		case errors.Is(err, ErrSweepBelowDust):
+			log.Debugf("Skipping sweep of %d tombstone anchors: %v",
+				len(batch), err)
+			continue
+
+		case err != nil:
+			return sweepTxs, err
+		}
+
+		sweepTxs = append(sweepTxs, sweepTx)
+	}
+
+	return sweepTxs, nil
+}
+
+// sweepBatch sweeps the given anchor outputs in a single transaction.
+func (s *TombstoneSweeper) sweepBatch(ctx context.Context,
+	anchors []TombstoneAnchor,
+	feeRate chainfee.SatPerKWeight) (*wire.MsgTx, error) {
+
+	sweepAddr, err := s.cfg.AddrSource.NewSweepAddr(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get sweep address: %w", err)
+	}
+	sweepScript, err := txscript.PayToAddrScript(sweepAddr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create sweep script: %w", err)
+	}
+
+	sweepPkt, err := createSweepPsbt(
+		anchors, sweepScript, feeRate, s.cfg.ChainParams.HDCoinType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	signedPkt, err := s.cfg.Wallet.SignPsbt(ctx, sweepPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign sweep psbt: %w", err)
+	}
+	if err := psbt.MaybeFinalizeAll(signedPkt); err != nil {
+		return nil, fmt.Errorf("unable to finalize sweep psbt: %w",
+			err)
+	}
+	sweepTx, err := psbt.Extract(signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract sweep tx: %w", err)
+	}
+
+	err = s.cfg.ChainBridge.PublishTransaction(ctx, sweepTx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to publish sweep tx: %w", err)
+	}
+
+	err = s.cfg.Store.LogTombstoneSweep(ctx, sweepTx, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("unable to log sweep tx: %w", err)
+	}
+
+	log.Infof("Swept %d tombstone anchors with tx %v", len(anchors),
+		sweepTx.TxHash())
+
+	return sweepTx, nil
+}
+
+// createSweepPsbt creates a PSBT that spends all given anchor outputs with a
+// key spend and sends their value minus the fee to the given script.
+func createSweepPsbt(anchors []TombstoneAnchor, sweepScript []byte,
+	feeRate chainfee.SatPerKWeight, coinType uint32) (*psbt.Packet, error) {
+
+	var (
+		sweepTx         = wire.NewMsgTx(2)
+		pInputs         = make([]psbt.PInput, len(anchors))
+		weightEstimator input.TxWeightEstimator
+		totalValue      btcutil.Amount
+	)
+	for idx, anchor := range anchors {
+		outputKey := txscript.ComputeTaprootOutputKey(
+			anchor.InternalKey.PubKey, anchor.MerkleRoot,
+		)
+		pkScript, err := tapscript.PayToTaprootScript(outputKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create anchor "+
+				"script: %w", err)
+		}
+
+		bip32Derivation, trBip32Derivation :=
+			tappsbt.Bip32DerivationFromKeyDesc(
+				anchor.InternalKey, coinType,
+			)
+
+		sweepTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: anchor.OutPoint,
+		})
+		pInputs[idx] = psbt.PInput{
+			WitnessUtxo: &wire.TxOut{
+				Value:    int64(anchor.Value),
+				PkScript: pkScript,
+			},
+			SighashType: txscript.SigHashDefault,
+			Bip32Derivation: []*psbt.Bip32Derivation{
+				bip32Derivation,
+			},
+			TaprootBip32Derivation: []*psbt.TaprootBip32Derivation{
+				trBip32Derivation,
+			},
+			TaprootInternalKey: schnorr.SerializePubKey(
+				anchor.InternalKey.PubKey,
+			),
+			TaprootMerkleRoot: anchor.MerkleRoot,
+		}
+
+		weightEstimator.AddTaprootKeySpendInput(txscript.SigHashDefault)
+		totalValue += anchor.Value
+	}
+
+	sweepOut := &wire.TxOut{
+		PkScript: sweepScript,
+	}
+	weightEstimator.AddTxOutput(sweepOut)
+
+	fee := feeRate.FeeForWeight(int64(weightEstimator.Weight()))
+	dustLimit := lnwallet.DustLimitForSize(len(sweepScript))
+	if totalValue-fee < dustLimit {
+		return nil, fmt.Errorf("%w: value %v, fee %v",
+			ErrSweepBelowDust, totalValue, fee)
+	}
+	sweepOut.Value = int64(totalValue - fee)
+	sweepTx.AddTxOut(sweepOut)
+
+	sweepPkt, err := psbt.NewFromUnsignedTx(sweepTx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create sweep psbt: %w", err)
+	}
+	sweepPkt.Inputs = pInputs
+
+	return sweepPkt, nil
+}
@@ -0,0 +1,76 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCreateSweepPsbt tests that the sweep PSBT spends all tombstone anchors
+// to a single output that pays the fee, and that sweeps whose value doesn't
+// cover the fee are rejected.
+func TestCreateSweepPsbt(t *testing.T) {
+	t.Parallel()
+
+	anchors := make([]TombstoneAnchor, 3)
+	for idx := range anchors {
+		anchors[idx] = TombstoneAnchor{
+			OutPoint: test.RandOp(t),
+			Value:    1_000,
+			InternalKey: keychain.KeyDescriptor{
+				KeyLocator: keychain.KeyLocator{
+					Family: keychain.KeyFamily(212),
+					Index:  uint32(idx),
+				},
+				PubKey: test.RandPubKey(t),
+			},
+			MerkleRoot: test.RandBytes(32),
+		}
+	}
+
+	sweepScript, err := tapscript.PayToTaprootScript(test.RandPubKey(t))
+	require.NoError(t, err)
+
+	feeRate := chainfee.SatPerKWeight(500)
+	sweepPkt, err := createSweepPsbt(anchors, sweepScript, feeRate, 1)
+	require.NoError(t, err)
+
+	require.Len(t, sweepPkt.UnsignedTx.TxIn, len(anchors))
+	require.Len(t, sweepPkt.Inputs, len(anchors))
+	require.Len(t, sweepPkt.UnsignedTx.TxOut, 1)
+	require.Equal(t, sweepScript, sweepPkt.UnsignedTx.TxOut[0].PkScript)
+
+	for idx, anchor := range anchors {
+		txIn := sweepPkt.UnsignedTx.TxIn[idx]
+		require.Equal(t, anchor.OutPoint, txIn.PreviousOutPoint)
+
+		pIn := sweepPkt.Inputs[idx]
+		outputKey := txscript.ComputeTaprootOutputKey(
+			anchor.InternalKey.PubKey, anchor.MerkleRoot,
+		)
+		pkScript, err := tapscript.PayToTaprootScript(outputKey)
+		require.NoError(t, err)
+		require.Equal(t, pkScript, pIn.WitnessUtxo.PkScript)
+		require.EqualValues(t, anchor.Value, pIn.WitnessUtxo.Value)
+		require.Equal(t, anchor.MerkleRoot, pIn.TaprootMerkleRoot)
+		require.Len(t, pIn.TaprootBip32Derivation, 1)
+	}
+
+	// The fee must be positive but leave most of the value.
+	fee := btcutil.Amount(3_000) -
+		btcutil.Amount(sweepPkt.UnsignedTx.TxOut[0].Value)
+	require.Positive(t, fee)
+	require.Less(t, fee, btcutil.Amount(1_000))
+
+	// With a high enough fee rate, the sweep is below dust.
+	_, err = createSweepPsbt(
+		anchors, sweepScript, chainfee.SatPerKWeight(10_000), 1,
+	)
+	require.ErrorIs(t, err, ErrSweepBelowDust)
+}
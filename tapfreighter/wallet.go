@@ -11,6 +11,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -57,6 +58,10 @@ var (
 		0x19, 0xde, 0xeb, 0xc0, 0x34, 0xad, 0x80, 0x66,
 		0x4f, 0xb7, 0x4e, 0xc2, 0xad, 0x6e, 0x11, 0xd7,
 	}
+
+	// ErrTooManyAnchorInputs is returned if funding an anchor transaction
+	// requires more BTC level inputs than the funding constraints allow.
+	ErrTooManyAnchorInputs = errors.New("too many anchor inputs")
 )
 
 // AnchorTransaction is a type that holds all information about a BTC level
@@ -92,14 +97,19 @@ type Wallet interface {
 	// data which assists in processing the virtual transaction: passive
 	// asset re-anchors and the Taproot Asset level commitment of the
 	// selected assets.
-	FundAddressSend(ctx context.Context,
-		receiverAddrs ...*address.Tap) (*FundedVPacket, error)
+	FundAddressSend(ctx context.Context, receiverAddrs []*address.Tap,
+		optFuncs ...FundOption) (*FundedVPacket, error)
 
 	// FundPacket funds a virtual transaction, selecting assets to spend
 	// in order to pay the given recipient. The selected input is then added
 	// to the given virtual transaction.
 	FundPacket(ctx context.Context, fundDesc *tapscript.FundingDescriptor,
-		vPkt *tappsbt.VPacket) (*FundedVPacket, error)
+		vPkt *tappsbt.VPacket, optFuncs ...FundOption) (*FundedVPacket,
+		error)
+
+	// ReleaseInputs releases the leases of the asset coins spent by the
+	// given funded virtual packet, so they can be selected again.
+	ReleaseInputs(ctx context.Context, vPkt *tappsbt.VPacket) error
 
 	// SignVirtualPacket signs the virtual transaction of the given packet
 	// and returns the input indexes that were signed.
@@ -110,7 +120,8 @@ type Wallet interface {
 	// given input commitment and virtual packet that contains the active
 	// asset transfer.
 	SignPassiveAssets(vPkt *tappsbt.VPacket,
-		inputCommitments tappsbt.InputCommitments) ([]*PassiveAssetReAnchor,
+		inputCommitments tappsbt.InputCommitments,
+		optFuncs ...SignVirtualPacketOption) ([]*PassiveAssetReAnchor,
 		error)
 
 	// AnchorVirtualTransactions creates a BTC level anchor transaction that
@@ -158,13 +169,153 @@ type AnchorVTxnsParams struct {
 	// PassiveAssetsVPkts is a list of all the virtual transactions which
 	// re-anchor passive assets.
 	PassiveAssetsVPkts []*tappsbt.VPacket
+
+	// CoSigner, if set, produces the witnesses of the anchor inputs that
+	// are co-owned with external parties and can't be signed by the
+	// wallet alone.
+	CoSigner AnchorCoSigner
+
+	// FundingConstraints restricts the BTC level inputs the wallet may
+	// select to fund the anchor transaction.
+	FundingConstraints AnchorFundingConstraints
+
+	// LockTime determines the lock time of the anchor transaction and the
+	// sequence numbers of its inputs.
+	LockTime AnchorLockTime
+
+	// BestHeight is the current height of the main chain, which is used
+	// as the lock time of the anchor transaction if no explicit lock time
+	// is set. If zero, no default lock time is set.
+	BestHeight uint32
+}
+
+// DefaultAnchorFundingMinConfs is the number of confirmations a BTC level UTXO
+// needs before it is used to fund an anchor transaction, if not overridden.
+// Unconfirmed outputs, including our own unconfirmed change, are never used so
+// anchor transactions aren't built on top of unconfirmed chains.
+const DefaultAnchorFundingMinConfs = 1
+
+// AnchorFundingConstraints restricts the BTC level inputs the wallet may select
+// to pay for the fees of an anchor transaction.
+type AnchorFundingConstraints struct {
+	// MinConfs is the number of confirmations a BTC level UTXO needs to be
+	// selected. If zero, DefaultAnchorFundingMinConfs is used.
+	MinConfs uint32
+
+	// MaxInputs is the maximum number of BTC level inputs the wallet may
+	// add to the anchor transaction. If zero, the number isn't limited.
+	MaxInputs uint32
+}
+
+// minConfs returns the number of confirmations a BTC level UTXO needs to be
+// selected.
+func (c *AnchorFundingConstraints) minConfs() uint32 {
+	if c.MinConfs == 0 {
+		return DefaultAnchorFundingMinConfs
+	}
+
+	return c.MinConfs
+}
+
+// checkInputs returns ErrTooManyAnchorInputs if the given number of BTC level
+// inputs exceeds the maximum.
+func (c *AnchorFundingConstraints) checkInputs(numInputs int) error {
+	if c.MaxInputs == 0 || numInputs <= int(c.MaxInputs) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: funding anchor transaction requires %d "+
+		"inputs, max is %d", ErrTooManyAnchorInputs, numInputs,
+		c.MaxInputs)
+}
+
+// AnchorConfPolicy determines how many confirmations the anchor transaction of
+// an asset needs before the asset is eligible for coin selection. Requiring
+// more than one confirmation protects receivers of high-value assets from
+// spending them onward before a shallow re-org could undo the transfer.
+type AnchorConfPolicy struct {
+	// DefaultMinConfs is the number of confirmations required for assets
+	// that don't have an entry in AssetMinConfs.
+	DefaultMinConfs uint32
+
+	// AssetMinConfs overrides the number of required confirmations for
+	// individual assets.
+	AssetMinConfs map[asset.ID]uint32
+}
+
+// MinConfs returns the number of confirmations the anchor transaction of an
+// asset with the given ID needs before the asset can be spent.
+func (p *AnchorConfPolicy) MinConfs(id asset.ID) uint32 {
+	if minConfs, ok := p.AssetMinConfs[id]; ok {
+		return minConfs
+	}
+
+	return p.DefaultMinConfs
+}
+
+// IsEligible returns true if the anchor transaction of the given commitment
+// has enough confirmations at the given chain height to be spent.
+func (p *AnchorConfPolicy) IsEligible(c *AnchoredCommitment,
+	currentHeight uint32) bool {
+
+	minConfs := p.MinConfs(c.Asset.ID())
+	if minConfs == 0 {
+		return true
+	}
+
+	if c.AnchorBlockHeight == 0 || c.AnchorBlockHeight > currentHeight {
+		return false
+	}
+
+	return currentHeight-c.AnchorBlockHeight+1 >= minConfs
+}
+
+// CoinSelectOption is a functional option for the CoinSelect.
+type CoinSelectOption func(*CoinSelect)
+
+// WithAnchorConfPolicy makes the CoinSelect only select coins that satisfy the
+// given confirmation policy. The chain bridge is used to look up the current
+// chain height.
+func WithAnchorConfPolicy(chainBridge ChainBridge,
+	policy *AnchorConfPolicy) CoinSelectOption {
+
+	return func(s *CoinSelect) {
+		s.chainBridge = chainBridge
+		s.confPolicy = policy
+	}
+}
+
+// WithExternalCoinSelector makes the CoinSelect delegate the selection of
+// coins from the eligible coins to the given external coin selector, instead
+// of applying the selection strategy itself.
+func WithExternalCoinSelector(selector ExternalCoinSelector) CoinSelectOption {
+	return func(s *CoinSelect) {
+		s.externalSelector = selector
+	}
+}
+
+// WithUnconfirmedChange makes the CoinSelect also select the asset change of
+// our own transfers whose anchor transactions aren't confirmed yet, as listed
+// by the given lister. Transfers that spend such change chain their anchor
+// transaction onto the unconfirmed one.
+func WithUnconfirmedChange(lister UnconfirmedChangeLister) CoinSelectOption {
+	return func(s *CoinSelect) {
+		s.unconfirmedChange = lister
+	}
 }
 
 // NewCoinSelect creates a new CoinSelect.
-func NewCoinSelect(coinLister CoinLister) *CoinSelect {
-	return &CoinSelect{
+func NewCoinSelect(coinLister CoinLister,
+	opts ...CoinSelectOption) *CoinSelect {
+
+	s := &CoinSelect{
 		coinLister: coinLister,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 // CoinSelect selects asset coins to spend in order to fund a send
@@ -172,6 +323,22 @@ func NewCoinSelect(coinLister CoinLister) *CoinSelect {
 type CoinSelect struct {
 	coinLister CoinLister
 
+	// chainBridge is used to look up the current chain height if a
+	// confirmation policy is set.
+	chainBridge ChainBridge
+
+	// confPolicy is the optional policy of how many confirmations the
+	// anchor transaction of a coin needs before it can be selected.
+	confPolicy *AnchorConfPolicy
+
+	// externalSelector is the optional external coin selector that the
+	// selection of coins from the eligible coins is delegated to.
+	externalSelector ExternalCoinSelector
+
+	// unconfirmedChange is the optional lister of the unconfirmed asset
+	// change of our own transfers, which is eligible as well if set.
+	unconfirmedChange UnconfirmedChangeLister
+
 	// coinLock is a read/write mutex that is used to ensure that only one
 	// goroutine is attempting to call any coin selection related methods at
 	// any time. This is necessary as some of the calls to the store (e.g.
@@ -205,17 +372,61 @@ func (s *CoinSelect) SelectCoins(ctx context.Context,
 	eligibleCommitments, err := s.coinLister.ListEligibleCoins(
 		ctx, listConstraints,
 	)
-	if err != nil {
+	switch {
+	// Without confirmed coins, the unconfirmed change might still be
+	// enough to fund the transfer.
+	case errors.Is(err, ErrMatchingAssetsNotFound) &&
+		s.unconfirmedChange != nil:
+
+	case err != nil:
 		return nil, fmt.Errorf("unable to list eligible coins: %w", err)
 	}
 
+	if s.unconfirmedChange != nil {
+		unconfirmed, err := s.unconfirmedChange.ListUnconfirmedChange(
+			ctx, listConstraints,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list unconfirmed "+
+				"change: %w", err)
+		}
+
+		eligibleCommitments = append(
+			eligibleCommitments, unconfirmed...,
+		)
+		if len(eligibleCommitments) == 0 {
+			return nil, ErrMatchingAssetsNotFound
+		}
+	}
+
+	eligibleCommitments, err = s.filterConfirmed(ctx, eligibleCommitments)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(constraints.Lots) > 0 {
+		eligibleCommitments, err = filterLots(
+			eligibleCommitments, constraints.Lots,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	log.Infof("Identified %v eligible asset inputs for send of %d to %x",
 		len(eligibleCommitments), constraints.MinAmt,
 		constraints.AssetID[:])
 
-	selectedCoins, err := s.selectForAmount(
-		constraints.MinAmt, eligibleCommitments, strategy,
-	)
+	var selectedCoins []*AnchoredCommitment
+	if s.externalSelector != nil {
+		selectedCoins, err = s.selectExternal(
+			ctx, constraints, strategy, eligibleCommitments,
+		)
+	} else {
+		selectedCoins, err = s.selectForAmount(
+			constraints.MinAmt, eligibleCommitments, strategy,
+		)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("unable to select coins: %w", err)
 	}
@@ -238,6 +449,114 @@ func (s *CoinSelect) SelectCoins(ctx context.Context,
 	return selectedCoins, nil
 }
 
+// filterConfirmed removes all commitments that don't have enough anchor
+// confirmations according to the confirmation policy, if one is set.
+func (s *CoinSelect) filterConfirmed(ctx context.Context,
+	commitments []*AnchoredCommitment) ([]*AnchoredCommitment, error) {
+
+	if s.confPolicy == nil {
+		return commitments, nil
+	}
+
+	currentHeight, err := s.chainBridge.CurrentHeight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query current height: %w",
+			err)
+	}
+
+	confirmed := fn.Filter(commitments, func(c *AnchoredCommitment) bool {
+		return s.confPolicy.IsEligible(c, currentHeight)
+	})
+	if len(confirmed) == len(commitments) {
+		return commitments, nil
+	}
+
+	log.Infof("Skipping %d asset inputs with insufficient anchor "+
+		"confirmations at height %d", len(commitments)-len(confirmed),
+		currentHeight)
+
+	if len(confirmed) == 0 {
+		return nil, ErrMatchingAssetsNotFound
+	}
+
+	return confirmed, nil
+}
+
+// selectExternal delegates the selection of coins from the given eligible
+// commitments to the external coin selector. The selection is checked to only
+// contain eligible commitments that sum to at least the minimum amount.
+func (s *CoinSelect) selectExternal(ctx context.Context,
+	constraints CommitmentConstraints,
+	strategy MultiCommitmentSelectStrategy,
+	eligibleCommitments []*AnchoredCommitment) ([]*AnchoredCommitment,
+	error) {
+
+	selectedLots, err := s.externalSelector.SelectCoins(
+		ctx, constraints, strategy, eligibleCommitments,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("external coin selection failed: %w",
+			err)
+	}
+
+	// We don't trust the external selector to only return coins we
+	// offered, so we map the lots back to the eligible commitments.
+	selectedCoins, err := filterLots(eligibleCommitments, selectedLots)
+	if err != nil {
+		return nil, fmt.Errorf("invalid external coin selection: %w",
+			err)
+	}
+
+	var amountSum uint64
+	for _, c := range selectedCoins {
+		amountSum += c.Asset.Amount
+	}
+	if len(selectedCoins) == 0 || amountSum < constraints.MinAmt {
+		return nil, fmt.Errorf("%w: external coin selection only "+
+			"selected %d of %d units", ErrMatchingAssetsNotFound,
+			amountSum, constraints.MinAmt)
+	}
+
+	log.Debugf("External coin selector selected %d of %d eligible asset "+
+		"inputs", len(selectedCoins), len(eligibleCommitments))
+
+	return selectedCoins, nil
+}
+
+// filterLots removes all commitments that aren't one of the given lots. An
+// error is returned if any of the lots isn't eligible for coin selection.
+func filterLots(commitments []*AnchoredCommitment,
+	lots []asset.PrevID) ([]*AnchoredCommitment, error) {
+
+	eligible := make(map[[32]byte]*AnchoredCommitment, len(commitments))
+	for _, c := range commitments {
+		prevID := c.PrevID()
+		eligible[prevID.Hash()] = c
+	}
+
+	filtered := make([]*AnchoredCommitment, 0, len(lots))
+	selected := make(map[[32]byte]struct{}, len(lots))
+	for idx := range lots {
+		// A lot that is listed more than once is only selected once.
+		lotHash := lots[idx].Hash()
+		if _, ok := selected[lotHash]; ok {
+			continue
+		}
+
+		c, ok := eligible[lotHash]
+		if !ok {
+			return nil, fmt.Errorf("%w: lot %v of asset %v is not "+
+				"eligible", ErrMatchingAssetsNotFound,
+				lots[idx].OutPoint, lots[idx].ID)
+		}
+
+		selected[lotHash] = struct{}{}
+		filtered = append(filtered, c)
+	}
+
+	return filtered, nil
+}
+
 // LeaseCoins leases/locks/reserves coins for the given lease owner until the
 // given expiry. This is used to prevent multiple concurrent coin selection
 // attempts from selecting the same coin(s).
@@ -277,7 +596,7 @@ func (s *CoinSelect) selectForAmount(minTotalAmount uint64,
 	amountSum := uint64(0)
 
 	switch strategy {
-	case PreferMaxAmount:
+	case PreferMaxAmount, PreferMaxAmountConsolidate:
 		// Sort eligible commitments from the largest amount to
 		// smallest.
 		sort.Slice(
@@ -308,6 +627,34 @@ func (s *CoinSelect) selectForAmount(minTotalAmount uint64,
 			}
 		}
 
+	case PreferOldestLot, PreferNewestLot:
+		// Sort eligible commitments by their acquisition time. The
+		// sort is stable, so coins acquired at the same time keep the
+		// order they were listed in.
+		sort.SliceStable(
+			eligibleCommitments, func(i, j int) bool {
+				iTime := eligibleCommitments[i].acquiredAt()
+				jTime := eligibleCommitments[j].acquiredAt()
+
+				if strategy == PreferNewestLot {
+					return iTime.After(jTime)
+				}
+
+				return iTime.Before(jTime)
+			},
+		)
+
+		for _, anchoredCommitment := range eligibleCommitments {
+			selectedCommitments = append(
+				selectedCommitments, anchoredCommitment,
+			)
+
+			amountSum += anchoredCommitment.Asset.Amount
+			if amountSum >= minTotalAmount {
+				break
+			}
+		}
+
 	default:
 		return nil, fmt.Errorf("unknown multi coin selection "+
 			"strategy: %v", strategy)
@@ -318,6 +665,35 @@ func (s *CoinSelect) selectForAmount(minTotalAmount uint64,
 	if amountSum < minTotalAmount {
 		return nil, ErrMatchingAssetsNotFound
 	}
+
+	// If there's no change, consolidating another commitment wouldn't
+	// save an output, as it would add a change output for its contents.
+	if strategy != PreferMaxAmountConsolidate ||
+		amountSum == minTotalAmount {
+
+		return selectedCommitments, nil
+	}
+
+	usedAnchors := make(map[wire.OutPoint]struct{})
+	for _, selected := range selectedCommitments {
+		usedAnchors[selected.AnchorPoint] = struct{}{}
+	}
+
+	// The eligible commitments are sorted in descending order, so we look
+	// for the smallest unused one from the end.
+	remaining := eligibleCommitments[len(selectedCommitments):]
+	for idx := len(remaining) - 1; idx >= 0; idx-- {
+		candidate := remaining[idx]
+		if _, ok := usedAnchors[candidate.AnchorPoint]; ok {
+			continue
+		}
+
+		log.Debugf("Consolidating change with %d units anchored at %v",
+			candidate.Asset.Amount, candidate.AnchorPoint)
+
+		return append(selectedCommitments, candidate), nil
+	}
+
 	return selectedCommitments, nil
 }
 
@@ -343,6 +719,10 @@ type WalletConfig struct {
 	// process.
 	KeyRing KeyRing
 
+	// KeyAuditLog is the optional log that all keys derived from the
+	// KeyRing are recorded in.
+	KeyAuditLog KeyAuditLog
+
 	// Signer implements the Taproot Asset level signing we need to sign a
 	// virtual transaction.
 	Signer Signer
@@ -351,11 +731,45 @@ type WalletConfig struct {
 	// transaction we create.
 	TxValidator tapscript.TxValidator
 
+	// VirtualSigner, if set, puts the wallet into watch-only mode. All
+	// virtual transactions are then signed by the VirtualSigner instead of
+	// the Signer, which doesn't have access to the script keys.
+	VirtualSigner VirtualPacketSigner
+
 	// Wallet is used to fund+sign PSBTs for the transfer transaction.
 	Wallet WalletAnchor
 
 	// ChainParams is the chain params of the chain we operate on.
 	ChainParams *address.ChainParams
+
+	// SpendPolicy is the optional policy that restricts the recipients of
+	// outbound transfers of individual assets. It is enforced before any
+	// coins are selected.
+	SpendPolicy *SpendPolicy
+
+	// AnchorOutputValue is the value new anchor outputs are created with.
+	// It must not be below the dust limit of a P2TR output. If zero,
+	// tapscript.DummyAmtSats is used.
+	AnchorOutputValue btcutil.Amount
+
+	// AnchorChangeToExisting indicates that the asset change of a transfer
+	// should be anchored together with the contents of an existing owned
+	// anchor output of the same asset, which is spent as an additional
+	// input. This reduces the number of UTXOs of frequent senders.
+	AnchorChangeToExisting bool
+
+	// SelectStrategy is the default coin selection strategy of transfers
+	// that don't specify one. Only PreferMaxAmount is affected by
+	// AnchorChangeToExisting.
+	SelectStrategy MultiCommitmentSelectStrategy
+
+	// ChangeKeyPolicy determines the script keys the change outputs of
+	// transfers are sent to.
+	ChangeKeyPolicy ChangeKeyPolicy
+
+	// ChangeSplit determines whether the change of transfers is split
+	// into multiple outputs of randomized amounts.
+	ChangeSplit ChangeSplitConfig
 }
 
 // AssetWallet is an implementation of the Wallet interface that can create
@@ -382,6 +796,103 @@ type FundedVPacket struct {
 	// InputCommitments is a map from virtual package input index to its
 	// associated Taproot Asset commitment.
 	InputCommitments tappsbt.InputCommitments
+
+	// ChangeKeyPolicy is the policy the script key of the change output
+	// was derived with.
+	ChangeKeyPolicy ChangeKeyPolicy
+}
+
+// FundOptions is a set of functional options that allow callers to supply the
+// keys of the funded virtual packet instead of having them derived by the
+// wallet, and to control where the anchor outputs are placed.
+type FundOptions struct {
+	// ChangeScriptKey is the script key of the change output, if one is
+	// needed. If nil, a new script key is derived.
+	ChangeScriptKey *asset.ScriptKey
+
+	// AnchorInternalKey is the internal key of all anchor outputs that
+	// don't have an internal key yet. If nil, a new internal key is
+	// derived for each of them.
+	AnchorInternalKey *keychain.KeyDescriptor
+
+	// RecipientOutputIndexes are the anchor output indexes of the
+	// recipients of an address send, in the order of the addresses. The
+	// change output is placed at the lowest index that isn't used by a
+	// recipient. If nil, the change output is placed at index 0 and the
+	// recipients follow in the order of the addresses.
+	RecipientOutputIndexes []uint32
+
+	// SortAnchorOutputs indicates that the anchor outputs should be placed
+	// in the deterministic order defined by tapscript.SortAnchorOutputs,
+	// instead of at the indexes set in the virtual packet.
+	SortAnchorOutputs bool
+
+	// SelectStrategy is the coin selection strategy used to select the
+	// inputs. If nil, the default strategy of the wallet is used.
+	SelectStrategy *MultiCommitmentSelectStrategy
+
+	// Lots restricts the coin selection to the given lots. If empty, all
+	// eligible coins can be selected.
+	Lots []asset.PrevID
+}
+
+// defaultFundOptions returns the set of default options for the virtual packet
+// funding functions.
+func defaultFundOptions() *FundOptions {
+	return &FundOptions{}
+}
+
+// FundOption is a functional option that allows a caller to modify the virtual
+// packet funding process.
+type FundOption func(*FundOptions)
+
+// WithChangeScriptKey sets the script key the change output pays to. Change
+// sent to a key the connected lnd node can't derive is treated as sent to a
+// remote party.
+func WithChangeScriptKey(scriptKey asset.ScriptKey) FundOption {
+	return func(o *FundOptions) {
+		o.ChangeScriptKey = &scriptKey
+	}
+}
+
+// WithAnchorInternalKey sets the internal key of the local anchor outputs.
+func WithAnchorInternalKey(keyDesc keychain.KeyDescriptor) FundOption {
+	return func(o *FundOptions) {
+		o.AnchorInternalKey = &keyDesc
+	}
+}
+
+// WithRecipientOutputIndexes sets the anchor output indexes of the recipients
+// of an address send, in the order of the addresses.
+func WithRecipientOutputIndexes(indexes ...uint32) FundOption {
+	return func(o *FundOptions) {
+		o.RecipientOutputIndexes = indexes
+	}
+}
+
+// WithSortedAnchorOutputs places the anchor outputs in a deterministic order
+// derived from their internal keys, so all parties of a transfer can compute
+// the anchor output indexes in advance.
+func WithSortedAnchorOutputs() FundOption {
+	return func(o *FundOptions) {
+		o.SortAnchorOutputs = true
+	}
+}
+
+// WithSelectStrategy sets the coin selection strategy used to select the
+// inputs, overriding the default strategy of the wallet.
+func WithSelectStrategy(strategy MultiCommitmentSelectStrategy) FundOption {
+	return func(o *FundOptions) {
+		o.SelectStrategy = &strategy
+	}
+}
+
+// WithLots restricts the coin selection to the given lots. The selection
+// strategy determines the order in which the lots are spent.
+func WithLots(lots ...asset.PrevID) FundOption {
+	return func(o *FundOptions) {
+		o.Lots = lots
+	}
 }
 
 // FundAddressSend funds a virtual transaction, selecting assets to spend in
@@ -391,7 +902,14 @@ type FundedVPacket struct {
 //
 // NOTE: This is part of the Wallet interface.
 func (f *AssetWallet) FundAddressSend(ctx context.Context,
-	receiverAddrs ...*address.Tap) (*FundedVPacket, error) {
+	receiverAddrs []*address.Tap, optFuncs ...FundOption) (*FundedVPacket,
+	error) {
+
+	// Before doing anything else, make sure the assets may be sent to the
+	// given addresses at all.
+	if err := f.checkAddrRecipients(ctx, receiverAddrs); err != nil {
+		return nil, err
+	}
 
 	// We start by creating a new virtual transaction that will be used to
 	// hold the asset transfer. Because sending to an address is always a
@@ -403,12 +921,25 @@ func (f *AssetWallet) FundAddressSend(ctx context.Context,
 			"from addresses: %w", err)
 	}
 
+	opts := defaultFundOptions()
+	for _, optFunc := range optFuncs {
+		optFunc(opts)
+	}
+	if opts.RecipientOutputIndexes != nil {
+		err := setRecipientOutputIndexes(
+			vPkt, opts.RecipientOutputIndexes,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	fundDesc, err := tapscript.DescribeAddrs(receiverAddrs)
 	if err != nil {
 		return nil, fmt.Errorf("unable to describe recipients: %w", err)
 	}
 
-	fundedVPkt, err := f.FundPacket(ctx, fundDesc, vPkt)
+	fundedVPkt, err := f.fundPacket(ctx, fundDesc, vPkt, optFuncs...)
 	if err != nil {
 		return nil, err
 	}
@@ -416,6 +947,40 @@ func (f *AssetWallet) FundAddressSend(ctx context.Context,
 	return fundedVPkt, nil
 }
 
+// setRecipientOutputIndexes assigns the given anchor output indexes to the
+// recipient outputs of a virtual packet created by tappsbt.FromAddresses. The
+// change output, which is the first output of such a packet, is moved to the
+// lowest index that isn't used by a recipient.
+func setRecipientOutputIndexes(vPkt *tappsbt.VPacket, indexes []uint32) error {
+	recipientOuts := vPkt.Outputs[1:]
+	if len(indexes) != len(recipientOuts) {
+		return fmt.Errorf("got %d recipient output indexes for %d "+
+			"recipients", len(indexes), len(recipientOuts))
+	}
+
+	usedIndexes := make(map[uint32]struct{}, len(indexes))
+	for idx, outputIndex := range indexes {
+		if _, ok := usedIndexes[outputIndex]; ok {
+			return fmt.Errorf("duplicate recipient output index %d",
+				outputIndex)
+		}
+		usedIndexes[outputIndex] = struct{}{}
+
+		recipientOuts[idx].AnchorOutputIndex = outputIndex
+	}
+
+	changeIndex := uint32(0)
+	for {
+		if _, ok := usedIndexes[changeIndex]; !ok {
+			break
+		}
+		changeIndex++
+	}
+	vPkt.Outputs[0].AnchorOutputIndex = changeIndex
+
+	return nil
+}
+
 // passiveAssetVPacket creates a virtual packet for the given passive asset.
 func (f *AssetWallet) passiveAssetVPacket(passiveAsset *asset.Asset,
 	anchorPoint wire.OutPoint, anchorOutputIndex uint32,
@@ -482,8 +1047,43 @@ func (f *AssetWallet) passiveAssetVPacket(passiveAsset *asset.Asset,
 // pay the given recipient. The selected input is then added to the given
 // virtual transaction.
 func (f *AssetWallet) FundPacket(ctx context.Context,
-	fundDesc *tapscript.FundingDescriptor,
-	vPkt *tappsbt.VPacket) (*FundedVPacket, error) {
+	fundDesc *tapscript.FundingDescriptor, vPkt *tappsbt.VPacket,
+	optFuncs ...FundOption) (*FundedVPacket, error) {
+
+	// Before doing anything else, make sure the asset may be sent to the
+	// outputs of the packet at all.
+	err := f.checkPacketRecipients(ctx, fundDesc.ID, vPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.fundPacket(ctx, fundDesc, vPkt, optFuncs...)
+}
+
+// fundPacket funds a virtual transaction, selecting assets to spend in order to
+// pay the given recipient, after the spend policy was checked.
+func (f *AssetWallet) fundPacket(ctx context.Context,
+	fundDesc *tapscript.FundingDescriptor, vPkt *tappsbt.VPacket,
+	optFuncs ...FundOption) (*FundedVPacket, error) {
+
+	opts := defaultFundOptions()
+	for _, optFunc := range optFuncs {
+		optFunc(opts)
+	}
+
+	if opts.ChangeScriptKey != nil && opts.ChangeScriptKey.PubKey == nil {
+		return nil, fmt.Errorf("change script key missing public key")
+	}
+	if opts.AnchorInternalKey != nil &&
+		opts.AnchorInternalKey.PubKey == nil {
+
+		return nil, fmt.Errorf("anchor internal key missing public " +
+			"key")
+	}
+	if opts.SortAnchorOutputs && opts.RecipientOutputIndexes != nil {
+		return nil, fmt.Errorf("sorted anchor outputs can't be " +
+			"combined with recipient output indexes")
+	}
 
 	// The input and address networks must match.
 	if !address.IsForNet(vPkt.ChainParams.TapHRP, f.cfg.ChainParams) {
@@ -497,9 +1097,22 @@ func (f *AssetWallet) FundPacket(ctx context.Context,
 		GroupKey: fundDesc.GroupKey,
 		AssetID:  &fundDesc.ID,
 		MinAmt:   fundDesc.Amount,
+		Lots:     opts.Lots,
+	}
+	strategy := f.cfg.SelectStrategy
+	if opts.SelectStrategy != nil {
+		strategy = *opts.SelectStrategy
+	}
+
+	// If specific lots are requested, we don't consolidate the change with
+	// any other lot.
+	if strategy == PreferMaxAmount && f.cfg.AnchorChangeToExisting &&
+		len(opts.Lots) == 0 {
+
+		strategy = PreferMaxAmountConsolidate
 	}
 	selectedCommitments, err := f.cfg.CoinSelector.SelectCoins(
-		ctx, constraints, PreferMaxAmount,
+		ctx, constraints, strategy,
 	)
 	if err != nil {
 		return nil, err
@@ -593,7 +1206,11 @@ func (f *AssetWallet) FundPacket(ctx context.Context,
 
 	// We expect some change back, or have passive assets to commit to, so
 	// let's make sure we create a transfer output.
-	var changeOut *tappsbt.VOutput
+	var (
+		changeOut       *tappsbt.VOutput
+		changeKeyPolicy = f.cfg.ChangeKeyPolicy
+		changeDerived   bool
+	)
 	if !fullValue || passiveAssetsPresent {
 		// Do we need to add a change output?
 		changeOut, err = vPkt.SplitRootOutput()
@@ -632,19 +1249,26 @@ func (f *AssetWallet) FundPacket(ctx context.Context,
 			return nil, fmt.Errorf("cannot determine if script "+
 				"key is spendable: %w", err)
 		}
-		if unSpendable && !fullValue {
-			changeScriptKey, err := f.cfg.KeyRing.DeriveNextKey(
-				ctx, asset.TaprootAssetsKeyFamily,
+		switch {
+		// The caller might have precomputed the change script key,
+		// in which case we don't derive a new one.
+		case unSpendable && !fullValue && opts.ChangeScriptKey != nil:
+			changeOut.ScriptKey = *opts.ChangeScriptKey
+
+		case unSpendable && !fullValue:
+			// The key is derived according to the change key
+			// policy, which uses BIP-0086 keys for all of them.
+			scriptKey, policy, err := f.deriveChangeScriptKey(
+				ctx, fundDesc.ID, vPkt, inputCommitments,
+				changeOut,
 			)
 			if err != nil {
 				return nil, err
 			}
 
-			// We'll assume BIP-0086 everywhere, and use the tweaked
-			// key from here on out.
-			changeOut.ScriptKey = asset.NewScriptKeyBip86(
-				changeScriptKey,
-			)
+			changeOut.ScriptKey = scriptKey
+			changeKeyPolicy = policy
+			changeDerived = true
 		}
 
 		// For existing change outputs, we'll just update the amount
@@ -653,6 +1277,18 @@ func (f *AssetWallet) FundPacket(ctx context.Context,
 		changeOut.Amount = totalInputAmt - fundDesc.Amount
 	}
 
+	// Only change we derived the key for ourselves is split, since a
+	// caller that picked the change key expects a single change output.
+	if changeDerived && assetType == asset.Normal {
+		err := f.splitChange(
+			ctx, fundDesc.ID, vPkt, inputCommitments, changeOut,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to split change: %w",
+				err)
+		}
+	}
+
 	// Before we can prepare output assets for our send, we need to generate
 	// a new internal key for the anchor outputs. We assume any output that
 	// hasn't got an internal key set is going to a local anchor, and we
@@ -663,8 +1299,20 @@ func (f *AssetWallet) FundPacket(ctx context.Context,
 			continue
 		}
 
-		newInternalKey, err := f.cfg.KeyRing.DeriveNextKey(
-			ctx, asset.TaprootAssetsKeyFamily,
+		if opts.AnchorInternalKey != nil {
+			vOut.SetAnchorInternalKey(
+				*opts.AnchorInternalKey,
+				f.cfg.ChainParams.HDCoinType,
+			)
+			continue
+		}
+
+		newInternalKey, err := tapgarden.DeriveAuditedKey(
+			ctx, f.cfg.KeyRing, f.cfg.KeyAuditLog,
+			asset.TaprootAssetsKeyFamily,
+			tapgarden.KeyPurposeAnchorInternalKey,
+			fmt.Sprintf("asset %v, anchor output %d", fundDesc.ID,
+				vOut.AnchorOutputIndex),
 		)
 		if err != nil {
 			return nil, err
@@ -674,6 +1322,15 @@ func (f *AssetWallet) FundPacket(ctx context.Context,
 		)
 	}
 
+	// The split commitments commit to the anchor output indexes, so we need
+	// to sort the outputs before we prepare the output assets.
+	if opts.SortAnchorOutputs {
+		if err := tapscript.SortAnchorOutputs(vPkt.Outputs); err != nil {
+			return nil, fmt.Errorf("unable to sort anchor outputs: "+
+				"%w", err)
+		}
+	}
+
 	if err := tapscript.PrepareOutputAssets(ctx, vPkt); err != nil {
 		return nil, fmt.Errorf("unable to create split commit: %w", err)
 	}
@@ -681,6 +1338,7 @@ func (f *AssetWallet) FundPacket(ctx context.Context,
 	return &FundedVPacket{
 		VPacket:          vPkt,
 		InputCommitments: inputCommitments,
+		ChangeKeyPolicy:  changeKeyPolicy,
 	}, nil
 }
 
@@ -732,31 +1390,16 @@ func (f *AssetWallet) setVPacketInputs(ctx context.Context,
 		// We'll also include an inclusion proof for the input asset in
 		// the virtual transaction. With that a signer can verify that
 		// the asset was actually committed to in the anchor output.
-		assetID := assetInput.Asset.ID()
-		proofLocator := proof.Locator{
-			AssetID:   &assetID,
-			ScriptKey: *assetInput.Asset.ScriptKey.PubKey,
-		}
-		if assetInput.Asset.GroupKey != nil {
-			proofLocator.GroupKey = &assetInput.Asset.GroupKey.GroupPubKey
-		}
-		inputProofBlob, err := f.cfg.AssetProofs.FetchProof(
-			ctx, proofLocator,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("cannot fetch proof for input "+
-				"asset: %w", err)
-		}
-		inputProofFile := &proof.File{}
-		err = inputProofFile.Decode(bytes.NewReader(inputProofBlob))
-		if err != nil {
-			return nil, fmt.Errorf("cannot decode proof for input "+
-				"asset: %w", err)
-		}
-		inputProof, err := inputProofFile.RawLastProof()
-		if err != nil {
-			return nil, fmt.Errorf("cannot get last proof for "+
-				"input asset: %w", err)
+		// Unconfirmed change doesn't have a proof file yet, but its
+		// proof suffix already contains the inclusion proof.
+		inputProof := assetInput.UnconfirmedProof
+		if inputProof == nil {
+			inputProof, err = f.fetchLastProof(
+				ctx, assetInput.Asset,
+			)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		tapscriptSiblingBytes, _, err := commitment.MaybeEncodeTapscriptPreimage(
@@ -805,17 +1448,60 @@ func (f *AssetWallet) setVPacketInputs(ctx context.Context,
 	return inputCommitments, nil
 }
 
+// fetchLastProof fetches the proof file of the given asset from the proof
+// archive and returns its last proof.
+func (f *AssetWallet) fetchLastProof(ctx context.Context,
+	a *asset.Asset) ([]byte, error) {
+
+	assetID := a.ID()
+	proofLocator := proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *a.ScriptKey.PubKey,
+	}
+	if a.GroupKey != nil {
+		proofLocator.GroupKey = &a.GroupKey.GroupPubKey
+	}
+	inputProofBlob, err := f.cfg.AssetProofs.FetchProof(ctx, proofLocator)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch proof for input asset: %w",
+			err)
+	}
+	inputProofFile := &proof.File{}
+	err = inputProofFile.Decode(bytes.NewReader(inputProofBlob))
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode proof for input asset: "+
+			"%w", err)
+	}
+	inputProof, err := inputProofFile.RawLastProof()
+	if err != nil {
+		return nil, fmt.Errorf("cannot get last proof for input "+
+			"asset: %w", err)
+	}
+
+	return inputProof, nil
+}
+
 // SignVirtualPacketOptions is a set of functional options that allow callers to
 // further modify the virtual packet signing process.
 type SignVirtualPacketOptions struct {
 	// SkipInputProofVerify skips virtual input proof verification when true.
 	SkipInputProofVerify bool
+
+	// Ctx is the context that aborts waiting for the signature of the
+	// virtual signer in watch-only mode.
+	Ctx context.Context
+
+	// InputWitnesses are externally provided witnesses of the inputs they
+	// are keyed by, which are used instead of signing those inputs.
+	InputWitnesses map[asset.PrevID]wire.TxWitness
 }
 
 // defaultSignVirtualPacketOptions returns the set of default options for the
 // virtual packet signing function.
 func defaultSignVirtualPacketOptions() *SignVirtualPacketOptions {
-	return &SignVirtualPacketOptions{}
+	return &SignVirtualPacketOptions{
+		Ctx: context.Background(),
+	}
 }
 
 // SignVirtualPacketOption is a functional option that allows a caller to modify
@@ -830,6 +1516,26 @@ func SkipInputProofVerify() SignVirtualPacketOption {
 	}
 }
 
+// WithSignContext sets the context that aborts waiting for the signature of
+// the virtual signer in watch-only mode.
+func WithSignContext(ctx context.Context) SignVirtualPacketOption {
+	return func(o *SignVirtualPacketOptions) {
+		o.Ctx = ctx
+	}
+}
+
+// WithInputWitnesses sets externally provided witnesses for the given inputs,
+// for example the preimage and signature that satisfy a script path of the
+// input's script key. Those inputs aren't signed, but the witnesses are still
+// verified with the Taproot Asset VM.
+func WithInputWitnesses(
+	witnesses map[asset.PrevID]wire.TxWitness) SignVirtualPacketOption {
+
+	return func(o *SignVirtualPacketOptions) {
+		o.InputWitnesses = witnesses
+	}
+}
+
 // SignVirtualPacket signs the virtual transaction of the given packet and
 // returns the input indexes that were signed (referring to the virtual
 // transaction's inputs).
@@ -860,15 +1566,31 @@ func (f *AssetWallet) SignVirtualPacket(vPkt *tappsbt.VPacket,
 		}
 	}
 
-	// Now we'll use the signer to sign all the inputs for the new Taproot
-	// Asset leaves. The witness data for each input will be assigned for
-	// us.
-	err := tapscript.SignVirtualTransaction(
-		vPkt, f.cfg.Signer, f.cfg.TxValidator,
-	)
+	inputWitnesses, err := indexInputWitnesses(vPkt, opts.InputWitnesses)
 	if err != nil {
-		return nil, fmt.Errorf("unable to generate Taproot Asset "+
-			"witness data: %w", err)
+		return nil, err
+	}
+
+	// In watch-only mode, the packet is signed by the virtual signer
+	// instead.
+	if f.cfg.VirtualSigner != nil {
+		err := f.signVirtualPacketExternally(
+			opts.Ctx, vPkt, inputWitnesses,
+		)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Now we'll use the signer to sign all the inputs for the new
+		// Taproot Asset leaves. The witness data for each input will
+		// be assigned for us.
+		err := tapscript.SignVirtualTransactionWithWitnesses(
+			vPkt, f.cfg.Signer, f.cfg.TxValidator, inputWitnesses,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to generate Taproot "+
+				"Asset witness data: %w", err)
+		}
 	}
 
 	// Mark all inputs as signed.
@@ -880,6 +1602,68 @@ func (f *AssetWallet) SignVirtualPacket(vPkt *tappsbt.VPacket,
 	return signedInputs, nil
 }
 
+// indexInputWitnesses returns the given input witnesses keyed by the index of
+// the input of the packet they belong to.
+func indexInputWitnesses(vPkt *tappsbt.VPacket,
+	witnesses map[asset.PrevID]wire.TxWitness) (map[int]wire.TxWitness,
+	error) {
+
+	if len(witnesses) == 0 {
+		return nil, nil
+	}
+
+	indexed := make(map[int]wire.TxWitness, len(witnesses))
+	for idx := range vPkt.Inputs {
+		witness, ok := witnesses[vPkt.Inputs[idx].PrevID]
+		if ok {
+			indexed[idx] = witness
+		}
+	}
+
+	if len(indexed) != len(witnesses) {
+		return nil, fmt.Errorf("got witnesses for %d inputs, but only "+
+			"%d of them are spent", len(witnesses), len(indexed))
+	}
+
+	return indexed, nil
+}
+
+// signVirtualPacketExternally hands a copy of the given packet to the virtual
+// signer and attaches the witnesses of the signed packet to the new asset,
+// after verifying them with the Taproot Asset VM. The witnesses of the inputs
+// with an externally provided witness are replaced by that witness.
+func (f *AssetWallet) signVirtualPacketExternally(ctx context.Context,
+	vPkt *tappsbt.VPacket, inputWitnesses map[int]wire.TxWitness) error {
+
+	vPktCopy, err := copyVPacket(vPkt)
+	if err != nil {
+		return err
+	}
+
+	signedPkt, err := f.cfg.VirtualSigner.SignVirtualPacket(ctx, vPktCopy)
+	if err != nil {
+		return fmt.Errorf("unable to sign virtual packet: %w", err)
+	}
+
+	// We only take the witnesses from the signed packet and verify them
+	// against our own packet, so the signer can't change the transfer.
+	witnesses, err := tapscript.VirtualWitnesses(signedPkt)
+	if err != nil {
+		return fmt.Errorf("invalid signed virtual packet: %w", err)
+	}
+	for idx, witness := range inputWitnesses {
+		witnesses[idx] = witness
+	}
+
+	err = tapscript.AddVirtualWitnesses(vPkt, witnesses, f.cfg.TxValidator)
+	if err != nil {
+		return fmt.Errorf("invalid witnesses in signed virtual "+
+			"packet: %w", err)
+	}
+
+	return nil
+}
+
 // verifyInclusionProof verifies that the given virtual input's asset is
 // actually committed in the anchor transaction.
 func verifyInclusionProof(vIn *tappsbt.VInput) error {
@@ -1028,9 +1812,12 @@ func removeActiveCommitments(inputCommitment *commitment.TapCommitment,
 }
 
 // SignPassiveAssets creates and signs the passive asset packets for the given
-// virtual packet and input Taproot Asset commitments.
+// virtual packet and input Taproot Asset commitments. The packets are signed
+// concurrently and returned sorted by asset ID, script key and previous anchor
+// point, so the resulting anchor transaction is deterministic.
 func (f *AssetWallet) SignPassiveAssets(vPkt *tappsbt.VPacket,
-	inputCommitments tappsbt.InputCommitments) ([]*PassiveAssetReAnchor,
+	inputCommitments tappsbt.InputCommitments,
+	optFuncs ...SignVirtualPacketOption) ([]*PassiveAssetReAnchor,
 	error) {
 
 	// Gather passive assets found in each input Taproot Asset commitment.
@@ -1085,21 +1872,76 @@ func (f *AssetWallet) SignPassiveAssets(vPkt *tappsbt.VPacket,
 		}
 	}
 
-	// Sign all the passive assets virtual packets.
-	for idx := range passiveAssets {
-		passiveAsset := passiveAssets[idx]
-		_, err := f.SignVirtualPacket(
-			passiveAsset.VPacket, SkipInputProofVerify(),
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to sign passive asset "+
-				"virtual packet: %w", err)
-		}
+	// The commitments are iterated as maps above, so we sort the packets
+	// to make sure the same inputs always result in the same order.
+	sortPassiveAssets(passiveAssets)
+
+	// Sign all the passive assets virtual packets. Each packet only spends
+	// a single passive asset, so they can be signed independently. Every
+	// signer gets the context of the group, so the remaining signers are
+	// aborted as soon as one of them fails. The options are copied for
+	// each signer, as the goroutines must not append to a shared slice.
+	opts := defaultSignVirtualPacketOptions()
+	for _, optFunc := range optFuncs {
+		optFunc(opts)
+	}
+
+	err := fn.ParSlice(
+		opts.Ctx, passiveAssets, func(ctx context.Context,
+			passiveAsset *PassiveAssetReAnchor) error {
+
+			signOpts := make(
+				[]SignVirtualPacketOption, 0, len(optFuncs)+2,
+			)
+			signOpts = append(signOpts, optFuncs...)
+			signOpts = append(
+				signOpts, SkipInputProofVerify(),
+				WithSignContext(ctx),
+			)
+
+			_, err := f.SignVirtualPacket(
+				passiveAsset.VPacket, signOpts...,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to sign passive "+
+					"asset virtual packet: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
 	}
 
 	return passiveAssets, nil
 }
 
+// sortPassiveAssets sorts the given passive asset re-anchors by asset ID,
+// serialized script key and previous anchor point.
+func sortPassiveAssets(passiveAssets []*PassiveAssetReAnchor) {
+	sort.Slice(passiveAssets, func(i, j int) bool {
+		a, b := passiveAssets[i], passiveAssets[j]
+
+		if c := bytes.Compare(a.GenesisID[:], b.GenesisID[:]); c != 0 {
+			return c < 0
+		}
+
+		aKey := asset.ToSerialized(a.ScriptKey.PubKey)
+		bKey := asset.ToSerialized(b.ScriptKey.PubKey)
+		if c := bytes.Compare(aKey[:], bKey[:]); c != 0 {
+			return c < 0
+		}
+
+		aHash, bHash := a.PrevAnchorPoint.Hash, b.PrevAnchorPoint.Hash
+		if c := bytes.Compare(aHash[:], bHash[:]); c != 0 {
+			return c < 0
+		}
+
+		return a.PrevAnchorPoint.Index < b.PrevAnchorPoint.Index
+	})
+}
+
 // AnchorVirtualTransactions creates a BTC level anchor transaction that anchors
 // all the virtual transactions of the given packets (for both sending and
 // passive asset re-anchoring).
@@ -1136,13 +1978,25 @@ func (f *AssetWallet) AnchorVirtualTransactions(ctx context.Context,
 		return nil, fmt.Errorf("error creating anchor TX: %w", err)
 	}
 
+	anchorOutputValue := f.anchorOutputValue()
+	setAnchorOutputValues(sendPacket, vPacket.Outputs, anchorOutputValue)
+
+	constraints := params.FundingConstraints
 	anchorPkt, err := f.cfg.Wallet.FundPsbt(
-		ctx, sendPacket, 1, params.FeeRate,
+		ctx, sendPacket, constraints.minConfs(), params.FeeRate,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fund psbt: %w", err)
 	}
 
+	// The template doesn't have any inputs yet, so all inputs of the funded
+	// packet were selected by the wallet. The UTXOs leased by the wallet
+	// are released once the lease expires.
+	err = constraints.checkInputs(len(anchorPkt.Pkt.UnsignedTx.TxIn))
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO(roasbeef): also want to log the total fee to disk for
 	// accounting, etc.
 
@@ -1152,7 +2006,10 @@ func (f *AssetWallet) AnchorVirtualTransactions(ctx context.Context,
 	// TODO(jhb): Do we need richer handling for the change output?
 	// We could reassign the change value to our Taproot Asset change output
 	// and remove the change output entirely.
-	adjustFundedPsbt(&anchorPkt, int64(vPacket.Inputs[0].Anchor.Value))
+	adjustFundedPsbt(
+		&anchorPkt, int64(vPacket.Inputs[0].Anchor.Value),
+		anchorOutputValue,
+	)
 
 	log.Infof("Received funded PSBT packet")
 	log.Tracef("Packet: %v", spew.Sdump(anchorPkt.Pkt))
@@ -1187,6 +2044,22 @@ func (f *AssetWallet) AnchorVirtualTransactions(ctx context.Context,
 	}
 	anchorPkt.Pkt = signAnchorPkt
 
+	// With all inputs in place, we set the lock time and the input
+	// sequence numbers and make sure they satisfy the lock times of the
+	// spent assets before anything is signed.
+	anchorTx := signAnchorPkt.UnsignedTx
+	err = params.LockTime.apply(anchorTx, params.BestHeight)
+	if err != nil {
+		return nil, err
+	}
+	allVPkts := append(
+		[]*tappsbt.VPacket{vPacket}, params.PassiveAssetsVPkts...,
+	)
+	err = checkAssetLockTimes(anchorTx, allVPkts)
+	if err != nil {
+		return nil, err
+	}
+
 	// With all the input and output information in the packet, we
 	// can now ask lnd to sign it, and then extract the final
 	// version ourselves.
@@ -1199,6 +2072,17 @@ func (f *AssetWallet) AnchorVirtualTransactions(ctx context.Context,
 	log.Debugf("Got signed PSBT")
 	log.Tracef("PSBT: %s", spew.Sdump(signedPsbt))
 
+	// The wallet skips anchor inputs it can't sign for alone, so we let
+	// the co-signer add the witnesses of those.
+	if params.CoSigner != nil {
+		signedPsbt, err = coSignAnchorPsbt(
+			ctx, params.CoSigner, signedPsbt,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Before we finalize, we need to calculate the actual, final fees that
 	// we pay.
 	chainFees, err := tapgarden.GetTxFee(signedPsbt)
@@ -1228,6 +2112,76 @@ func (f *AssetWallet) AnchorVirtualTransactions(ctx context.Context,
 	}, nil
 }
 
+// setAnchorOutputValues sets the value of the anchor outputs of the given
+// template packet that commit to the given virtual outputs. All other outputs,
+// such as BTC-only outputs, keep their value.
+func setAnchorOutputValues(pkt *psbt.Packet, outputs []*tappsbt.VOutput,
+	value btcutil.Amount) {
+
+	txOuts := pkt.UnsignedTx.TxOut
+	for _, vOut := range outputs {
+		if int(vOut.AnchorOutputIndex) >= len(txOuts) {
+			continue
+		}
+
+		txOuts[vOut.AnchorOutputIndex].Value = int64(value)
+	}
+}
+
+// anchorOutputValue returns the value new anchor outputs are created with.
+func (f *AssetWallet) anchorOutputValue() btcutil.Amount {
+	if f.cfg.AnchorOutputValue == 0 {
+		return tapscript.DummyAmtSats
+	}
+
+	return f.cfg.AnchorOutputValue
+}
+
+// coSignAnchorPsbt hands the anchor transaction signed by the wallet to the given
+// co-signer and makes sure the co-signer didn't change the transaction itself.
+func coSignAnchorPsbt(ctx context.Context, coSigner AnchorCoSigner,
+	signedPsbt *psbt.Packet) (*psbt.Packet, error) {
+
+	coSignPsbt, err := copyPsbt(signedPsbt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy PSBT: %w", err)
+	}
+
+	coSignedPsbt, err := coSigner.CoSignAnchorPsbt(ctx, coSignPsbt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to co-sign anchor psbt: %w", err)
+	}
+
+	txid := signedPsbt.UnsignedTx.TxHash()
+	if coSignedPsbt.UnsignedTx.TxHash() != txid {
+		return nil, fmt.Errorf("co-signer modified anchor tx %v", txid)
+	}
+	if len(coSignedPsbt.Inputs) != len(signedPsbt.Inputs) {
+		return nil, fmt.Errorf("co-signed anchor tx %v has %d PSBT "+
+			"inputs, expected %d", txid, len(coSignedPsbt.Inputs),
+			len(signedPsbt.Inputs))
+	}
+
+	log.Debugf("Got co-signed PSBT")
+	log.Tracef("PSBT: %s", spew.Sdump(coSignedPsbt))
+
+	return coSignedPsbt, nil
+}
+
+// ReleaseInputs releases the leases of the asset coins spent by the given
+// funded virtual packet, so they can be selected again.
+func (f *AssetWallet) ReleaseInputs(ctx context.Context,
+	vPkt *tappsbt.VPacket) error {
+
+	outpoints := fn.Map(
+		vPkt.Inputs, func(in *tappsbt.VInput) wire.OutPoint {
+			return in.PrevID.OutPoint
+		},
+	)
+
+	return f.cfg.CoinSelector.ReleaseCoins(ctx, outpoints...)
+}
+
 // SignOwnershipProof creates and signs an ownership proof for the given owned
 // asset. The ownership proof consists of a signed virtual packet that spends
 // the asset fully to the NUMS key.
@@ -1338,7 +2292,9 @@ func trimSplitWitnesses(
 // adjustFundedPsbt takes a funded PSBT which may have used BIP-0069 sorting,
 // and creates a new one with outputs shuffled such that the change output is
 // the last output.
-func adjustFundedPsbt(fPkt *tapgarden.FundedPsbt, anchorInputValue int64) {
+func adjustFundedPsbt(fPkt *tapgarden.FundedPsbt, anchorInputValue int64,
+	anchorOutputValue btcutil.Amount) {
+
 	// If there is no change there's nothing we need to do.
 	changeIndex := fPkt.ChangeOutputIndex
 	if changeIndex == -1 {
@@ -1351,7 +2307,9 @@ func adjustFundedPsbt(fPkt *tapgarden.FundedPsbt, anchorInputValue int64) {
 
 	// Overwrite the existing change output, and restore in at the
 	// highest-index output.
-	fPkt.Pkt.UnsignedTx.TxOut[changeIndex] = createDummyOutput()
+	fPkt.Pkt.UnsignedTx.TxOut[changeIndex] = createDummyOutput(
+		anchorOutputValue,
+	)
 	fPkt.Pkt.UnsignedTx.TxOut[maxOutputIndex].PkScript = changeOutput.PkScript
 	fPkt.Pkt.UnsignedTx.TxOut[maxOutputIndex].Value = changeOutput.Value
 
@@ -1484,6 +2442,17 @@ func addAnchorPsbtInputs(btcPkt *psbt.Packet, vPkt *tappsbt.VPacket,
 	return nil
 }
 
+// copyVPacket creates a deep copy of a virtual packet by serializing and
+// de-serializing it.
+func copyVPacket(vPkt *tappsbt.VPacket) (*tappsbt.VPacket, error) {
+	var buf bytes.Buffer
+	if err := vPkt.Serialize(&buf); err != nil {
+		return nil, err
+	}
+
+	return tappsbt.NewFromRawBytes(&buf, false)
+}
+
 // copyPsbt creates a deep copy of a PSBT packet by serializing and
 // de-serializing it.
 func copyPsbt(packet *psbt.Packet) (*psbt.Packet, error) {
@@ -1,12 +1,24 @@
 package tapfreighter
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"math/rand"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
 	"github.com/stretchr/testify/require"
 )
 
@@ -19,6 +31,10 @@ func (m *mockCoinLister) ListEligibleCoins(
 	ctx context.Context, constraints CommitmentConstraints) (
 	[]*AnchoredCommitment, error) {
 
+	if len(m.eligibleCommitments) == 0 {
+		return nil, ErrMatchingAssetsNotFound
+	}
+
 	return m.eligibleCommitments, nil
 }
 
@@ -163,3 +179,715 @@ func TestCoinSelection(t *testing.T) {
 		_ = idx
 	}
 }
+
+// TestCoinSelectionConsolidate tests that the consolidating strategy adds the
+// smallest commitment at a distinct anchor point if there is change.
+func TestCoinSelectionConsolidate(t *testing.T) {
+	t.Parallel()
+
+	newCommitment := func(anchorIndex uint32,
+		amount uint64) *AnchoredCommitment {
+
+		return &AnchoredCommitment{
+			AnchorPoint: wire.OutPoint{Index: anchorIndex},
+			Asset: &asset.Asset{
+				Amount: amount,
+			},
+		}
+	}
+
+	var (
+		large    = newCommitment(0, 2000)
+		sameAnch = newCommitment(0, 5)
+		medium   = newCommitment(1, 500)
+		small    = newCommitment(2, 10)
+	)
+	eligible := func() []*AnchoredCommitment {
+		return []*AnchoredCommitment{small, large, sameAnch, medium}
+	}
+
+	coinSelect := NewCoinSelect(&mockCoinLister{})
+
+	// The smallest commitment that isn't anchored at the same outpoint as
+	// the selected one is added.
+	selected, err := coinSelect.selectForAmount(
+		1000, eligible(), PreferMaxAmountConsolidate,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*AnchoredCommitment{large, small}, selected)
+
+	// Without change, nothing is consolidated.
+	selected, err = coinSelect.selectForAmount(
+		2000, eligible(), PreferMaxAmountConsolidate,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*AnchoredCommitment{large}, selected)
+
+	// If no other anchor point is left, only the required commitments are
+	// selected.
+	selected, err = coinSelect.selectForAmount(
+		1000, []*AnchoredCommitment{large, sameAnch},
+		PreferMaxAmountConsolidate,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*AnchoredCommitment{large}, selected)
+}
+
+// TestCoinSelectionLots tests that coins are selected by the acquisition time
+// of their lots and that the selection can be restricted to specific lots.
+func TestCoinSelectionLots(t *testing.T) {
+	t.Parallel()
+
+	genesis := asset.RandGenesis(t, asset.Normal)
+	newCommitment := func(amount uint64,
+		acquiredAt int64) *AnchoredCommitment {
+
+		c := &AnchoredCommitment{
+			AnchorPoint: test.RandOp(t),
+			Asset: asset.RandAssetWithValues(
+				t, genesis, nil, asset.RandScriptKey(t),
+			),
+		}
+		c.Asset.Amount = amount
+		if acquiredAt != 0 {
+			c.Lot = &AssetLot{
+				AcquiredAt: time.Unix(acquiredAt, 0),
+			}
+		}
+
+		return c
+	}
+
+	var (
+		untracked = newCommitment(10, 0)
+		oldest    = newCommitment(20, 100)
+		middle    = newCommitment(30, 200)
+		newest    = newCommitment(40, 300)
+	)
+	eligible := func() []*AnchoredCommitment {
+		return []*AnchoredCommitment{middle, newest, untracked, oldest}
+	}
+
+	coinSelect := NewCoinSelect(&mockCoinLister{})
+
+	// First in, first out spends the untracked coin first, as it was
+	// acquired before lots were tracked.
+	selected, err := coinSelect.selectForAmount(
+		25, eligible(), PreferOldestLot,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*AnchoredCommitment{untracked, oldest}, selected)
+
+	// Last in, first out spends the newest coins first.
+	selected, err = coinSelect.selectForAmount(
+		50, eligible(), PreferNewestLot,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*AnchoredCommitment{newest, middle}, selected)
+
+	_, err = coinSelect.selectForAmount(101, eligible(), PreferOldestLot)
+	require.ErrorIs(t, err, ErrMatchingAssetsNotFound)
+
+	// If specific lots are requested, only those are selected, in the
+	// order of the strategy.
+	ctx := context.Background()
+	coinSelect = NewCoinSelect(&mockCoinLister{
+		eligibleCommitments: eligible(),
+	})
+	assetID := genesis.ID()
+	selected, err = coinSelect.SelectCoins(ctx, CommitmentConstraints{
+		AssetID: &assetID,
+		MinAmt:  50,
+		Lots: []asset.PrevID{
+			newest.PrevID(), oldest.PrevID(), newest.PrevID(),
+		},
+	}, PreferOldestLot)
+	require.NoError(t, err)
+	require.Equal(t, []*AnchoredCommitment{oldest, newest}, selected)
+
+	// The requested lots must cover the amount.
+	_, err = coinSelect.SelectCoins(ctx, CommitmentConstraints{
+		AssetID: &assetID,
+		MinAmt:  70,
+		Lots:    []asset.PrevID{newest.PrevID(), oldest.PrevID()},
+	}, PreferOldestLot)
+	require.ErrorIs(t, err, ErrMatchingAssetsNotFound)
+
+	// A lot that isn't eligible can't be selected.
+	unknown := newCommitment(50, 400)
+	_, err = coinSelect.SelectCoins(ctx, CommitmentConstraints{
+		AssetID: &assetID,
+		MinAmt:  50,
+		Lots:    []asset.PrevID{unknown.PrevID()},
+	}, PreferOldestLot)
+	require.ErrorIs(t, err, ErrMatchingAssetsNotFound)
+}
+
+// TestSetAnchorOutputValues tests that only the anchor outputs committing to
+// the virtual outputs get the configured anchor output value.
+func TestSetAnchorOutputValues(t *testing.T) {
+	t.Parallel()
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxOut(&wire.TxOut{Value: 1_000})
+	anchorTx.AddTxOut(&wire.TxOut{Value: 50_000})
+	anchorTx.AddTxOut(&wire.TxOut{Value: 1_000})
+	pkt, err := psbt.NewFromUnsignedTx(anchorTx)
+	require.NoError(t, err)
+
+	// The asset outputs are anchored in the first and last output, the
+	// output in between is a BTC-only output with another value.
+	outputs := []*tappsbt.VOutput{{
+		AnchorOutputIndex: 0,
+	}, {
+		AnchorOutputIndex: 2,
+	}}
+	setAnchorOutputValues(pkt, outputs, 5_000)
+
+	txOuts := pkt.UnsignedTx.TxOut
+	require.EqualValues(t, 5_000, txOuts[0].Value)
+	require.EqualValues(t, 50_000, txOuts[1].Value)
+	require.EqualValues(t, 5_000, txOuts[2].Value)
+}
+
+// heightChainBridge is a mock chain bridge with a fixed chain height.
+type heightChainBridge struct {
+	*tapgarden.MockChainBridge
+
+	height uint32
+}
+
+func (h *heightChainBridge) CurrentHeight(context.Context) (uint32, error) {
+	return h.height, nil
+}
+
+// TestCoinSelectionAnchorConfs tests that coins are only selected once their
+// anchor transaction has the number of confirmations required by the policy.
+func TestCoinSelectionAnchorConfs(t *testing.T) {
+	t.Parallel()
+
+	highValue := asset.RandAsset(t, asset.Normal)
+	highValue.Amount = 1000
+	lowValue := asset.RandAsset(t, asset.Normal)
+	lowValue.Amount = 1000
+
+	newCommitment := func(a *asset.Asset,
+		height uint32) *AnchoredCommitment {
+
+		return &AnchoredCommitment{
+			Asset:             a,
+			AnchorBlockHeight: height,
+		}
+	}
+
+	policy := &AnchorConfPolicy{
+		DefaultMinConfs: 1,
+		AssetMinConfs: map[asset.ID]uint32{
+			highValue.ID(): 6,
+		},
+	}
+
+	// At height 105, a coin confirmed at 100 has 6 confirmations.
+	chainBridge := &heightChainBridge{
+		MockChainBridge: tapgarden.NewMockChainBridge(),
+		height:          105,
+	}
+
+	testCases := []struct {
+		name       string
+		commitment *AnchoredCommitment
+		eligible   bool
+	}{{
+		name:       "unconfirmed",
+		commitment: newCommitment(lowValue, 0),
+		eligible:   false,
+	}, {
+		name:       "default policy confirmed",
+		commitment: newCommitment(lowValue, 105),
+		eligible:   true,
+	}, {
+		name:       "asset policy shallow",
+		commitment: newCommitment(highValue, 101),
+		eligible:   false,
+	}, {
+		name:       "asset policy deep enough",
+		commitment: newCommitment(highValue, 100),
+		eligible:   true,
+	}, {
+		name:       "height from the future",
+		commitment: newCommitment(lowValue, 106),
+		eligible:   false,
+	}}
+
+	ctx := context.Background()
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(
+				t, tc.eligible,
+				policy.IsEligible(tc.commitment, 105),
+			)
+
+			coinLister := &mockCoinLister{
+				eligibleCommitments: []*AnchoredCommitment{
+					tc.commitment,
+				},
+			}
+			coinSelect := NewCoinSelect(
+				coinLister, WithAnchorConfPolicy(
+					chainBridge, policy,
+				),
+			)
+
+			id := tc.commitment.Asset.ID()
+			selected, err := coinSelect.SelectCoins(
+				ctx, CommitmentConstraints{
+					AssetID: &id,
+					MinAmt:  1000,
+				}, PreferMaxAmount,
+			)
+			if !tc.eligible {
+				require.ErrorIs(t, err, ErrMatchingAssetsNotFound)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(
+				t, []*AnchoredCommitment{tc.commitment},
+				selected,
+			)
+		})
+	}
+}
+
+// mockExternalCoinSelector is a mock external coin selector that returns a
+// fixed selection.
+type mockExternalCoinSelector struct {
+	selection []asset.PrevID
+
+	eligible []*AnchoredCommitment
+}
+
+func (m *mockExternalCoinSelector) SelectCoins(_ context.Context,
+	_ CommitmentConstraints, _ MultiCommitmentSelectStrategy,
+	eligible []*AnchoredCommitment) ([]asset.PrevID, error) {
+
+	m.eligible = eligible
+
+	return m.selection, nil
+}
+
+// TestCoinSelectionExternal tests that coin selection can be delegated to an
+// external coin selector and that its selection is validated.
+func TestCoinSelectionExternal(t *testing.T) {
+	t.Parallel()
+
+	genesis := asset.RandGenesis(t, asset.Normal)
+	newCommitment := func(amount uint64) *AnchoredCommitment {
+		c := &AnchoredCommitment{
+			AnchorPoint: test.RandOp(t),
+			Asset: asset.RandAssetWithValues(
+				t, genesis, nil, asset.RandScriptKey(t),
+			),
+		}
+		c.Asset.Amount = amount
+
+		return c
+	}
+
+	var (
+		small  = newCommitment(10)
+		medium = newCommitment(20)
+		large  = newCommitment(30)
+	)
+	eligible := []*AnchoredCommitment{small, medium, large}
+
+	ctx := context.Background()
+	assetID := genesis.ID()
+	constraints := CommitmentConstraints{
+		AssetID: &assetID,
+		MinAmt:  25,
+	}
+
+	// The external selector may pick coins the max amount strategy
+	// wouldn't, and is offered all eligible coins.
+	selector := &mockExternalCoinSelector{
+		selection: []asset.PrevID{small.PrevID(), medium.PrevID()},
+	}
+	coinSelect := NewCoinSelect(
+		&mockCoinLister{eligibleCommitments: eligible},
+		WithExternalCoinSelector(selector),
+	)
+	selected, err := coinSelect.SelectCoins(
+		ctx, constraints, PreferMaxAmount,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*AnchoredCommitment{small, medium}, selected)
+	require.ElementsMatch(t, eligible, selector.eligible)
+
+	// A selection that doesn't cover the amount is rejected.
+	selector.selection = []asset.PrevID{medium.PrevID()}
+	_, err = coinSelect.SelectCoins(ctx, constraints, PreferMaxAmount)
+	require.ErrorIs(t, err, ErrMatchingAssetsNotFound)
+
+	// So is a selection of coins that weren't offered.
+	unknown := newCommitment(50)
+	selector.selection = []asset.PrevID{unknown.PrevID()}
+	_, err = coinSelect.SelectCoins(ctx, constraints, PreferMaxAmount)
+	require.ErrorIs(t, err, ErrMatchingAssetsNotFound)
+}
+
+// mockUnconfirmedChangeLister is a mock implementation of the
+// UnconfirmedChangeLister interface.
+type mockUnconfirmedChangeLister struct {
+	change []*AnchoredCommitment
+}
+
+func (m *mockUnconfirmedChangeLister) ListUnconfirmedChange(context.Context,
+	CommitmentConstraints) ([]*AnchoredCommitment, error) {
+
+	return m.change, nil
+}
+
+// TestCoinSelectionUnconfirmedChange tests that unconfirmed change is only
+// selected if enabled, and that it can fund a transfer on its own.
+func TestCoinSelectionUnconfirmedChange(t *testing.T) {
+	t.Parallel()
+
+	genesis := asset.RandGenesis(t, asset.Normal)
+	newCommitment := func(amount uint64) *AnchoredCommitment {
+		c := &AnchoredCommitment{
+			AnchorPoint: test.RandOp(t),
+			Asset: asset.RandAssetWithValues(
+				t, genesis, nil, asset.RandScriptKey(t),
+			),
+		}
+		c.Asset.Amount = amount
+
+		return c
+	}
+
+	var (
+		confirmed   = newCommitment(10)
+		unconfirmed = newCommitment(20)
+	)
+	unconfirmed.UnconfirmedProof = test.RandBytes(32)
+
+	ctx := context.Background()
+	assetID := genesis.ID()
+	constraints := CommitmentConstraints{
+		AssetID: &assetID,
+		MinAmt:  25,
+	}
+	changeLister := &mockUnconfirmedChangeLister{
+		change: []*AnchoredCommitment{unconfirmed},
+	}
+
+	// Without the option, the unconfirmed change isn't offered.
+	coinLister := &mockCoinLister{
+		eligibleCommitments: []*AnchoredCommitment{confirmed},
+	}
+	coinSelect := NewCoinSelect(coinLister)
+	_, err := coinSelect.SelectCoins(ctx, constraints, PreferMaxAmount)
+	require.ErrorIs(t, err, ErrMatchingAssetsNotFound)
+
+	// With it, the confirmed and unconfirmed coins are combined.
+	coinSelect = NewCoinSelect(
+		coinLister, WithUnconfirmedChange(changeLister),
+	)
+	selected, err := coinSelect.SelectCoins(
+		ctx, constraints, PreferMaxAmount,
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, []*AnchoredCommitment{unconfirmed, confirmed}, selected,
+	)
+
+	// The unconfirmed change is enough if there are no confirmed coins.
+	coinLister.eligibleCommitments = nil
+	constraints.MinAmt = 20
+	selected, err = coinSelect.SelectCoins(
+		ctx, constraints, PreferMaxAmount,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*AnchoredCommitment{unconfirmed}, selected)
+
+	// And without any coins at all, none are found.
+	changeLister.change = nil
+	_, err = coinSelect.SelectCoins(ctx, constraints, PreferMaxAmount)
+	require.ErrorIs(t, err, ErrMatchingAssetsNotFound)
+}
+
+// TestSetRecipientOutputIndexes tests that the recipients of an address send
+// can be placed at caller-specified anchor output indexes.
+func TestSetRecipientOutputIndexes(t *testing.T) {
+	t.Parallel()
+
+	newPacket := func() *tappsbt.VPacket {
+		addr1, _, _ := address.RandAddr(t, &address.RegressionNetTap)
+		addr2 := *addr1.Tap
+		addr2.ScriptKey = *test.RandPubKey(t)
+
+		vPkt, err := tappsbt.FromAddresses(
+			[]*address.Tap{addr1.Tap, &addr2}, 1,
+		)
+		require.NoError(t, err)
+
+		return vPkt
+	}
+	outputIndexes := func(vPkt *tappsbt.VPacket) []uint32 {
+		return fn.Map(vPkt.Outputs, func(o *tappsbt.VOutput) uint32 {
+			return o.AnchorOutputIndex
+		})
+	}
+
+	// The change output takes the lowest index the recipients don't use.
+	vPkt := newPacket()
+	require.NoError(t, setRecipientOutputIndexes(vPkt, []uint32{0, 2}))
+	require.Equal(t, []uint32{1, 0, 2}, outputIndexes(vPkt))
+
+	vPkt = newPacket()
+	require.NoError(t, setRecipientOutputIndexes(vPkt, []uint32{3, 1}))
+	require.Equal(t, []uint32{0, 3, 1}, outputIndexes(vPkt))
+
+	// There must be exactly one unique index per recipient.
+	vPkt = newPacket()
+	require.ErrorContains(
+		t, setRecipientOutputIndexes(vPkt, []uint32{1}),
+		"got 1 recipient output indexes for 2 recipients",
+	)
+	require.ErrorContains(
+		t, setRecipientOutputIndexes(vPkt, []uint32{1, 1}),
+		"duplicate recipient output index 1",
+	)
+}
+
+// TestAnchorFundingConstraints tests the defaults and limits of the BTC level
+// anchor funding constraints.
+func TestAnchorFundingConstraints(t *testing.T) {
+	t.Parallel()
+
+	// Without any constraints, unconfirmed outputs are never spent and the
+	// number of inputs isn't limited.
+	var constraints AnchorFundingConstraints
+	require.EqualValues(
+		t, DefaultAnchorFundingMinConfs, constraints.minConfs(),
+	)
+	require.NoError(t, constraints.checkInputs(100))
+
+	constraints = AnchorFundingConstraints{
+		MinConfs:  6,
+		MaxInputs: 2,
+	}
+	require.EqualValues(t, 6, constraints.minConfs())
+	require.NoError(t, constraints.checkInputs(2))
+	require.ErrorIs(t, constraints.checkInputs(3), ErrTooManyAnchorInputs)
+}
+
+// TestIndexInputWitnesses tests that externally provided witnesses are matched
+// to the inputs of a virtual packet they spend.
+func TestIndexInputWitnesses(t *testing.T) {
+	t.Parallel()
+
+	randPrevID := func() asset.PrevID {
+		return asset.PrevID{
+			OutPoint:  test.RandOp(t),
+			ID:        asset.RandID(t),
+			ScriptKey: asset.RandSerializedKey(t),
+		}
+	}
+	prevID1 := randPrevID()
+	prevID2 := randPrevID()
+	vPkt := &tappsbt.VPacket{
+		Inputs: []*tappsbt.VInput{{
+			PrevID: prevID1,
+		}, {
+			PrevID: prevID2,
+		}},
+	}
+	witness := wire.TxWitness{test.RandBytes(32), test.RandBytes(32)}
+
+	indexed, err := indexInputWitnesses(vPkt, nil)
+	require.NoError(t, err)
+	require.Empty(t, indexed)
+
+	indexed, err = indexInputWitnesses(
+		vPkt, map[asset.PrevID]wire.TxWitness{prevID2: witness},
+	)
+	require.NoError(t, err)
+	require.Equal(t, map[int]wire.TxWitness{1: witness}, indexed)
+
+	// A witness for an input that isn't spent is rejected.
+	_, err = indexInputWitnesses(vPkt, map[asset.PrevID]wire.TxWitness{
+		prevID1:      witness,
+		randPrevID(): witness,
+	})
+	require.ErrorContains(t, err, "only 1 of them are spent")
+}
+
+// TestSortPassiveAssets tests that passive asset re-anchors are sorted by asset
+// ID, script key and previous anchor point regardless of their initial order.
+func TestSortPassiveAssets(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id1 = asset.ID{1}
+		id2 = asset.ID{2}
+		op1 = wire.OutPoint{Hash: [32]byte{1}, Index: 1}
+		op2 = wire.OutPoint{Hash: [32]byte{1}, Index: 2}
+	)
+	key1 := asset.NUMSScriptKey
+	key2 := asset.NewScriptKey(test.RandPubKey(t))
+	key1Bytes := asset.ToSerialized(key1.PubKey)
+	key2Bytes := asset.ToSerialized(key2.PubKey)
+	if bytes.Compare(key1Bytes[:], key2Bytes[:]) > 0 {
+		key1, key2 = key2, key1
+	}
+
+	sorted := []*PassiveAssetReAnchor{
+		{GenesisID: id1, ScriptKey: key1, PrevAnchorPoint: op1},
+		{GenesisID: id1, ScriptKey: key1, PrevAnchorPoint: op2},
+		{GenesisID: id1, ScriptKey: key2, PrevAnchorPoint: op1},
+		{GenesisID: id2, ScriptKey: key1, PrevAnchorPoint: op1},
+	}
+
+	for i := 0; i < 10; i++ {
+		shuffled := fn.CopySlice(sorted)
+		rand.Shuffle(len(shuffled), func(i, j int) {
+			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+		})
+
+		sortPassiveAssets(shuffled)
+		require.Equal(t, sorted, shuffled)
+	}
+}
+
+// acceptingTxValidator is a TxValidator that accepts every transfer.
+type acceptingTxValidator struct{}
+
+// Execute accepts the transfer without validating it.
+func (a *acceptingTxValidator) Execute(*asset.Asset, []*commitment.SplitAsset,
+	commitment.InputSet) error {
+
+	return nil
+}
+
+// passiveAssetSigner is a VirtualPacketSigner that adds a dummy witness to
+// each packet it signs. If an asset ID to fail on is set, it fails to sign the
+// packet of that asset and blocks on all other packets until their context is
+// canceled.
+type passiveAssetSigner struct {
+	failID *asset.ID
+
+	mtx      sync.Mutex
+	signed   []asset.ID
+	canceled int
+}
+
+// SignVirtualPacket adds a dummy witness to the packet, unless it's configured
+// to fail.
+func (p *passiveAssetSigner) SignVirtualPacket(ctx context.Context,
+	vPkt *tappsbt.VPacket) (*tappsbt.VPacket, error) {
+
+	assetID := vPkt.Inputs[0].PrevID.ID
+	if p.failID != nil {
+		if assetID == *p.failID {
+			return nil, errors.New("signer unavailable")
+		}
+
+		select {
+		case <-ctx.Done():
+			p.mtx.Lock()
+			p.canceled++
+			p.mtx.Unlock()
+
+			return nil, ctx.Err()
+
+		case <-time.After(time.Minute):
+			return nil, errors.New("signer wasn't canceled")
+		}
+	}
+
+	witness := &vPkt.Outputs[0].Asset.PrevWitnesses[0]
+	witness.TxWitness = wire.TxWitness{{0x01}}
+
+	p.mtx.Lock()
+	p.signed = append(p.signed, assetID)
+	p.mtx.Unlock()
+
+	return vPkt, nil
+}
+
+// TestSignPassiveAssets tests that multiple passive assets are signed
+// concurrently, and that the remaining signers are aborted once one of them
+// fails.
+func TestSignPassiveAssets(t *testing.T) {
+	t.Parallel()
+
+	const numPassive = 8
+
+	activeAsset := asset.RandAsset(t, asset.Normal)
+	activeAsset.Amount = 10
+	passiveAssets := make([]*asset.Asset, numPassive)
+	passiveIDs := make([]asset.ID, numPassive)
+	for idx := range passiveAssets {
+		passiveAssets[idx] = asset.RandAsset(t, asset.Normal)
+		passiveAssets[idx].Amount = 10
+		passiveIDs[idx] = passiveAssets[idx].ID()
+	}
+	sort.Slice(passiveIDs, func(i, j int) bool {
+		return bytes.Compare(passiveIDs[i][:], passiveIDs[j][:]) < 0
+	})
+
+	inputCommitment, err := commitment.FromAssets(
+		append([]*asset.Asset{activeAsset}, passiveAssets...)...,
+	)
+	require.NoError(t, err)
+	inputCommitments := tappsbt.InputCommitments{0: inputCommitment}
+
+	changeOut := &tappsbt.VOutput{
+		Type:              tappsbt.TypeSplitRoot,
+		AnchorOutputIndex: 1,
+	}
+	changeOut.SetAnchorInternalKey(
+		test.PubToKeyDesc(test.RandPubKey(t)),
+		address.RegressionNetTap.HDCoinType,
+	)
+	vPkt := &tappsbt.VPacket{
+		Outputs:     []*tappsbt.VOutput{changeOut},
+		ChainParams: &address.RegressionNetTap,
+	}
+	vPkt.SetInputAsset(0, activeAsset, nil)
+
+	newWallet := func(signer VirtualPacketSigner) *AssetWallet {
+		return NewAssetWallet(&WalletConfig{
+			TxValidator:   &acceptingTxValidator{},
+			VirtualSigner: signer,
+			ChainParams:   &address.RegressionNetTap,
+		})
+	}
+
+	// All passive assets are signed and returned in a stable order.
+	signer := &passiveAssetSigner{}
+	reAnchors, err := newWallet(signer).SignPassiveAssets(
+		vPkt, inputCommitments,
+	)
+	require.NoError(t, err)
+	require.Len(t, reAnchors, numPassive)
+	require.ElementsMatch(t, passiveIDs, signer.signed)
+	for idx, reAnchor := range reAnchors {
+		require.Equal(t, passiveIDs[idx], reAnchor.GenesisID)
+
+		newAsset := reAnchor.VPacket.Outputs[0].Asset
+		require.NotEmpty(t, newAsset.PrevWitnesses[0].TxWitness)
+	}
+
+	// If signing the first passive asset fails, all other signers are
+	// canceled instead of running to completion.
+	failID := passiveIDs[0]
+	signer = &passiveAssetSigner{failID: &failID}
+	_, err = newWallet(signer).SignPassiveAssets(vPkt, inputCommitments)
+	require.ErrorContains(t, err, "signer unavailable")
+	require.Empty(t, signer.signed)
+	require.Equal(t, numPassive-1, signer.canceled)
+}
@@ -0,0 +1,451 @@
+package tapgarden
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+// OwnedAnchor is an on-chain output that anchors at least one unspent asset
+// of the local wallet.
+type OwnedAnchor struct {
+	// AnchorPoint is the outpoint of the anchor output.
+	AnchorPoint wire.OutPoint
+
+	// PkScript is the pkScript of the anchor output.
+	PkScript []byte
+
+	// HeightHint is the height of the block the anchor transaction
+	// confirmed in, or zero if it isn't confirmed yet.
+	HeightHint uint32
+}
+
+// AtRiskAsset is an asset of the local wallet whose anchor output was spent by
+// a transaction that wasn't created by this daemon. The asset can no longer be
+// assumed to be under the control of the wallet.
+type AtRiskAsset struct {
+	// AnchorPoint is the spent anchor outpoint of the asset.
+	AnchorPoint wire.OutPoint
+
+	// AssetID is the ID of the asset.
+	AssetID asset.ID
+
+	// ScriptKey is the script key of the asset.
+	ScriptKey *btcec.PublicKey
+
+	// Amount is the amount of the asset.
+	Amount uint64
+
+	// SpendingTxid is the ID of the unknown transaction that spent the
+	// anchor output.
+	SpendingTxid chainhash.Hash
+
+	// SpendHeight is the height of the block the spending transaction
+	// confirmed in.
+	SpendHeight uint32
+
+	// DetectedAt is the time the spend was detected.
+	DetectedAt time.Time
+}
+
+// OwnedAnchorStore gives access to the anchor outputs of the local wallet and
+// persists the assets that are at risk.
+type OwnedAnchorStore interface {
+	// ListOwnedAnchors returns all anchor outputs that anchor at least one
+	// unspent asset that isn't already marked as at risk.
+	ListOwnedAnchors(ctx context.Context) ([]OwnedAnchor, error)
+
+	// IsTransferAnchor returns true if the transaction with the given ID
+	// is the anchor transaction of an outbound transfer of this daemon.
+	IsTransferAnchor(ctx context.Context, txid chainhash.Hash) (bool,
+		error)
+
+	// MarkAnchorAtRisk marks all unspent assets anchored at the given
+	// outpoint as at risk because of an unknown spend, and returns them.
+	// The assets are also frozen, so they are no longer selected for
+	// spending.
+	MarkAnchorAtRisk(ctx context.Context, anchorPoint wire.OutPoint,
+		spendingTxid chainhash.Hash,
+		spendHeight uint32) ([]*AtRiskAsset, error)
+
+	// ListAtRiskAssets returns all assets that are marked as at risk,
+	// ordered by the time the spend was detected.
+	ListAtRiskAssets(ctx context.Context) ([]*AtRiskAsset, error)
+}
+
+// AnchorSpendMonitorConfig is the configuration of the anchor spend monitor.
+type AnchorSpendMonitorConfig struct {
+	// Store gives access to the anchor outputs of the local wallet.
+	Store OwnedAnchorStore
+
+	// ChainBridge is used to detect spends of the anchor outputs.
+	ChainBridge ChainBridge
+}
+
+// AnchorSpendMonitor watches all anchor outputs of the local wallet for spends.
+// If an anchor output is spent by a transaction that isn't the anchor
+// transaction of one of our own transfers, for example because a key was
+// compromised or an external co-signer moved the output, the assets anchored
+// at the output are marked as at risk and an AnchorSpendAlertEvent is
+// published.
+type AnchorSpendMonitor struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *AnchorSpendMonitorConfig
+
+	// anchors maps the watched anchor outputs to the function that
+	// cancels their spend notification.
+	anchors map[wire.OutPoint]func()
+
+	// anchorMtx guards the anchors map.
+	anchorMtx sync.Mutex
+
+	// eventBus delivers the events of the monitor to all subscribers.
+	eventBus *fn.EventBus[fn.Event]
+
+	*fn.ContextGuard
+}
+
+// NewAnchorSpendMonitor creates a new anchor spend monitor from the given
+// config.
+func NewAnchorSpendMonitor(
+	cfg *AnchorSpendMonitorConfig) *AnchorSpendMonitor {
+
+	return &AnchorSpendMonitor{
+		cfg:     cfg,
+		anchors: make(map[wire.OutPoint]func()),
+		eventBus: fn.NewEventBus(
+			fn.DefaultEventBusBufferSize,
+			fn.WithDropMarker(fn.MarkDroppedHistory),
+		),
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start registers spend notifications for all anchor outputs of the local
+// wallet and keeps them in sync with the wallet on every new block.
+func (m *AnchorSpendMonitor) Start() error {
+	var startErr error
+	m.startOnce.Do(func() {
+		log.Infof("Starting anchor spend monitor")
+
+		ctx, cancel := m.WithCtxQuit()
+		defer cancel()
+
+		if err := m.syncAnchors(ctx); err != nil {
+			startErr = err
+			return
+		}
+
+		m.Wg.Add(1)
+		go m.watchBlocks()
+	})
+
+	return startErr
+}
+
+// Stop cancels all spend notifications.
+func (m *AnchorSpendMonitor) Stop() error {
+	m.stopOnce.Do(func() {
+		log.Infof("Stopping anchor spend monitor")
+
+		close(m.Quit)
+		m.Wg.Wait()
+	})
+
+	return nil
+}
+
+// ListAtRiskAssets returns all assets that are marked as at risk.
+func (m *AnchorSpendMonitor) ListAtRiskAssets(
+	ctx context.Context) ([]*AtRiskAsset, error) {
+
+	return m.cfg.Store.ListAtRiskAssets(ctx)
+}
+
+// watchBlocks syncs the watched anchor outputs with the wallet on every new
+// block, so anchor outputs created by mints, transfers and receives are picked
+// up and spent ones are dropped.
+//
+// NOTE: This method MUST be called as a goroutine.
+func (m *AnchorSpendMonitor) watchBlocks() {
+	defer m.Wg.Done()
+
+	runCtx, cancel := m.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	newBlocks, blockErr, err := m.cfg.ChainBridge.RegisterBlockEpochNtfn(
+		runCtx,
+	)
+	if err != nil {
+		log.Errorf("Unable to register for block epoch notifications: "+
+			"%v", err)
+		return
+	}
+
+	for {
+		select {
+		case height := <-newBlocks:
+			ctx, cancel := m.WithCtxQuit()
+			err := m.syncAnchors(ctx)
+			cancel()
+			if err != nil {
+				log.Errorf("Unable to sync owned anchors at "+
+					"height %d: %v", height, err)
+			}
+
+		case err := <-blockErr:
+			log.Errorf("Unable to receive block epochs: %v", err)
+			return
+
+		case <-m.Quit:
+			return
+		}
+	}
+}
+
+// syncAnchors registers spend notifications for anchor outputs of the wallet
+// that aren't watched yet, and cancels them for outputs that no longer anchor
+// any unspent asset.
+func (m *AnchorSpendMonitor) syncAnchors(ctx context.Context) error {
+	owned, err := m.cfg.Store.ListOwnedAnchors(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to list owned anchors: %w", err)
+	}
+
+	m.anchorMtx.Lock()
+	defer m.anchorMtx.Unlock()
+
+	ownedPoints := make(map[wire.OutPoint]struct{}, len(owned))
+	for _, anchor := range owned {
+		ownedPoints[anchor.AnchorPoint] = struct{}{}
+	}
+
+	for anchorPoint, cancel := range m.anchors {
+		if _, ok := ownedPoints[anchorPoint]; !ok {
+			cancel()
+			delete(m.anchors, anchorPoint)
+		}
+	}
+
+	currentHeight, err := m.cfg.ChainBridge.CurrentHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch current height: %w", err)
+	}
+
+	for _, anchor := range owned {
+		if _, ok := m.anchors[anchor.AnchorPoint]; ok {
+			continue
+		}
+
+		// Unconfirmed anchor outputs are watched starting at the
+		// current height.
+		heightHint := anchor.HeightHint
+		if heightHint == 0 {
+			heightHint = currentHeight
+		}
+
+		err := m.watchAnchor(anchor.AnchorPoint, anchor.PkScript,
+			heightHint)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// watchAnchor registers a spend notification for the given anchor output.
+//
+// NOTE: The anchorMtx must be held when calling this method.
+func (m *AnchorSpendMonitor) watchAnchor(anchorPoint wire.OutPoint,
+	pkScript []byte, heightHint uint32) error {
+
+	ctx, cancel := m.WithCtxQuitNoTimeout()
+	spendEvent, errChan, err := m.cfg.ChainBridge.RegisterSpendNtfn(
+		ctx, &anchorPoint, pkScript, heightHint,
+	)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("unable to register spend notification for "+
+			"%v: %w", anchorPoint, err)
+	}
+
+	m.anchors[anchorPoint] = func() {
+		spendEvent.Cancel()
+		cancel()
+	}
+
+	m.Wg.Add(1)
+	go m.waitForSpend(ctx, anchorPoint, spendEvent, errChan)
+
+	return nil
+}
+
+// waitForSpend waits for the spend of the given anchor output.
+//
+// NOTE: This method MUST be called as a goroutine.
+func (m *AnchorSpendMonitor) waitForSpend(ctx context.Context,
+	anchorPoint wire.OutPoint, spendEvent *chainntnfs.SpendEvent,
+	errChan chan error) {
+
+	defer m.Wg.Done()
+
+	select {
+	case spend, ok := <-spendEvent.Spend:
+		if !ok {
+			return
+		}
+
+		err := m.handleSpend(anchorPoint, spend)
+		if err != nil {
+			log.Errorf("Unable to handle spend of anchor %v: %v",
+				anchorPoint, err)
+		}
+
+	case err := <-errChan:
+		// The error channel also fires if the notification is
+		// canceled, which isn't worth logging.
+		if ctx.Err() == nil {
+			log.Errorf("Unable to wait for spend of anchor %v: %v",
+				anchorPoint, err)
+		}
+
+	case <-ctx.Done():
+	}
+}
+
+// handleSpend checks whether the spend of the given anchor output was created
+// by one of our own transfers. If it wasn't, the assets anchored at the output
+// are marked as at risk and an alert is published to all subscribers.
+func (m *AnchorSpendMonitor) handleSpend(anchorPoint wire.OutPoint,
+	spend *chainntnfs.SpendDetail) error {
+
+	m.anchorMtx.Lock()
+	cancel, ok := m.anchors[anchorPoint]
+	delete(m.anchors, anchorPoint)
+	m.anchorMtx.Unlock()
+
+	// The anchor output was dropped in the meantime, because its assets
+	// were spent by one of our own transfers.
+	if !ok {
+		return nil
+	}
+	cancel()
+
+	ctx, cancelCtx := m.WithCtxQuit()
+	defer cancelCtx()
+
+	spendingTxid := *spend.SpenderTxHash
+	ownTransfer, err := m.cfg.Store.IsTransferAnchor(ctx, spendingTxid)
+	if err != nil {
+		return fmt.Errorf("unable to look up transfer: %w", err)
+	}
+	if ownTransfer {
+		log.Debugf("Anchor %v was spent by our own transfer %v",
+			anchorPoint, spendingTxid)
+		return nil
+	}
+
+	spendHeight := uint32(spend.SpendingHeight)
+	atRisk, err := m.cfg.Store.MarkAnchorAtRisk(
+		ctx, anchorPoint, spendingTxid, spendHeight,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to mark assets at risk: %w", err)
+	}
+
+	log.Criticalf("Anchor %v of %d asset(s) was spent by unknown tx %v "+
+		"at height %d, assets are at risk", anchorPoint, len(atRisk),
+		spendingTxid, spendHeight)
+	for _, a := range atRisk {
+		log.Criticalf("Asset %v with script key %x and amount %d is "+
+			"at risk", a.AssetID, a.ScriptKey.SerializeCompressed(),
+			a.Amount)
+	}
+
+	m.publishSubscriberEvent(NewAnchorSpendAlertEvent(
+		anchorPoint, spendingTxid, spendHeight, atRisk,
+	))
+
+	return nil
+}
+
+// RegisterSubscriber adds a new subscriber to the set of subscribers that will
+// be notified about unknown spends of anchor outputs.
+func (m *AnchorSpendMonitor) RegisterSubscriber(
+	receiver *fn.EventReceiver[fn.Event], _ bool, _ bool) error {
+
+	m.eventBus.Subscribe(receiver, nil)
+
+	return nil
+}
+
+// RemoveSubscriber removes a subscriber from the set of subscribers that will
+// be notified about unknown spends of anchor outputs.
+func (m *AnchorSpendMonitor) RemoveSubscriber(
+	subscriber *fn.EventReceiver[fn.Event]) error {
+
+	return m.eventBus.Unsubscribe(subscriber)
+}
+
+// publishSubscriberEvent publishes an event to all subscribers.
+func (m *AnchorSpendMonitor) publishSubscriberEvent(event fn.Event) {
+	m.eventBus.Publish(event)
+}
+
+// A compile-time assertion to make sure AnchorSpendMonitor satisfies the
+// fn.EventPublisher interface.
+var _ fn.EventPublisher[fn.Event, bool] = (*AnchorSpendMonitor)(nil)
+
+// AnchorSpendAlertEvent is a critical alert which indicates that an anchor
+// output of the local wallet was spent by an unknown transaction.
+type AnchorSpendAlertEvent struct {
+	// timestamp is the time the event was created.
+	timestamp time.Time
+
+	// AnchorPoint is the spent anchor output.
+	AnchorPoint wire.OutPoint
+
+	// SpendingTxid is the ID of the unknown transaction that spent the
+	// anchor output.
+	SpendingTxid chainhash.Hash
+
+	// SpendHeight is the height of the block the spending transaction
+	// confirmed in.
+	SpendHeight uint32
+
+	// Assets are the assets anchored at the output that are now at risk.
+	Assets []*AtRiskAsset
+}
+
+// Timestamp returns the timestamp of the event.
+func (e *AnchorSpendAlertEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// NewAnchorSpendAlertEvent creates a new AnchorSpendAlertEvent.
+func NewAnchorSpendAlertEvent(anchorPoint wire.OutPoint,
+	spendingTxid chainhash.Hash, spendHeight uint32,
+	assets []*AtRiskAsset) *AnchorSpendAlertEvent {
+
+	return &AnchorSpendAlertEvent{
+		timestamp:    time.Now().UTC(),
+		AnchorPoint:  anchorPoint,
+		SpendingTxid: spendingTxid,
+		SpendHeight:  spendHeight,
+		Assets:       assets,
+	}
+}
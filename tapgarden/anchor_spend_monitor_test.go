@@ -0,0 +1,211 @@
+package tapgarden
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// mockOwnedAnchorStore is an in-memory OwnedAnchorStore.
+type mockOwnedAnchorStore struct {
+	sync.Mutex
+
+	owned     []OwnedAnchor
+	assets    map[wire.OutPoint][]*AtRiskAsset
+	transfers map[chainhash.Hash]struct{}
+	atRisk    []*AtRiskAsset
+}
+
+func (m *mockOwnedAnchorStore) setOwned(owned ...OwnedAnchor) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.owned = owned
+}
+
+func (m *mockOwnedAnchorStore) ListOwnedAnchors(
+	_ context.Context) ([]OwnedAnchor, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	return fn.Filter(m.owned, func(o OwnedAnchor) bool {
+		return !fn.Any(m.atRisk, func(a *AtRiskAsset) bool {
+			return a.AnchorPoint == o.AnchorPoint
+		})
+	}), nil
+}
+
+func (m *mockOwnedAnchorStore) IsTransferAnchor(_ context.Context,
+	txid chainhash.Hash) (bool, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	_, ok := m.transfers[txid]
+	return ok, nil
+}
+
+func (m *mockOwnedAnchorStore) MarkAnchorAtRisk(_ context.Context,
+	anchorPoint wire.OutPoint, spendingTxid chainhash.Hash,
+	spendHeight uint32) ([]*AtRiskAsset, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	assets := m.assets[anchorPoint]
+	for _, a := range assets {
+		a.SpendingTxid = spendingTxid
+		a.SpendHeight = spendHeight
+	}
+	m.atRisk = append(m.atRisk, assets...)
+
+	return assets, nil
+}
+
+func (m *mockOwnedAnchorStore) ListAtRiskAssets(
+	_ context.Context) ([]*AtRiskAsset, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	return m.atRisk, nil
+}
+
+// TestAnchorSpendMonitor tests that only spends of owned anchor outputs by
+// unknown transactions mark the anchored assets as at risk and are published
+// as alerts.
+func TestAnchorSpendMonitor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	chainBridge := NewMockChainBridge()
+
+	newAnchor := func() OwnedAnchor {
+		return OwnedAnchor{
+			AnchorPoint: test.RandOp(t),
+			PkScript:    test.RandBytes(34),
+			HeightHint:  100,
+		}
+	}
+	transferAnchor, foreignAnchor, droppedAnchor := newAnchor(),
+		newAnchor(), newAnchor()
+
+	ownTransferTx := wire.NewMsgTx(2)
+	ownTransferTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: transferAnchor.AnchorPoint,
+	})
+
+	store := &mockOwnedAnchorStore{
+		assets: map[wire.OutPoint][]*AtRiskAsset{
+			foreignAnchor.AnchorPoint: {
+				{
+					AnchorPoint: foreignAnchor.AnchorPoint,
+					AssetID:     asset.RandID(t),
+					ScriptKey:   test.RandPubKey(t),
+					Amount:      100,
+				},
+				{
+					AnchorPoint: foreignAnchor.AnchorPoint,
+					AssetID:     asset.RandID(t),
+					ScriptKey:   test.RandPubKey(t),
+					Amount:      200,
+				},
+			},
+		},
+		transfers: map[chainhash.Hash]struct{}{
+			ownTransferTx.TxHash(): {},
+		},
+	}
+	store.setOwned(transferAnchor, foreignAnchor, droppedAnchor)
+
+	monitor := NewAnchorSpendMonitor(&AnchorSpendMonitorConfig{
+		Store:       store,
+		ChainBridge: chainBridge,
+	})
+
+	sub := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
+	require.NoError(t, monitor.RegisterSubscriber(sub, false, false))
+
+	require.NoError(t, monitor.Start())
+	t.Cleanup(func() {
+		require.NoError(t, monitor.Stop())
+	})
+
+	// A spend notification is registered for every owned anchor output.
+	chainBridge.spendMtx.Lock()
+	require.Len(t, chainBridge.SpendReqs, 3)
+	chainBridge.spendMtx.Unlock()
+
+	requireNoEvent := func() {
+		select {
+		case event := <-sub.NewItemCreated.ChanOut():
+			t.Fatalf("unexpected event: %v", event)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	// A spend by one of our own transfers isn't an alert.
+	require.True(t, chainBridge.SendSpendNtfn(
+		transferAnchor.AnchorPoint, ownTransferTx, 101,
+	))
+	requireNoEvent()
+
+	// A spend by an unknown transaction marks all assets of the anchor
+	// output as at risk and is published as an alert.
+	foreignTx := wire.NewMsgTx(2)
+	foreignTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: foreignAnchor.AnchorPoint,
+	})
+	require.True(t, chainBridge.SendSpendNtfn(
+		foreignAnchor.AnchorPoint, foreignTx, 102,
+	))
+
+	var event fn.Event
+	select {
+	case event = <-sub.NewItemCreated.ChanOut():
+	case <-time.After(DefaultTimeout):
+		t.Fatalf("no alert received")
+	}
+
+	alert, ok := event.(*AnchorSpendAlertEvent)
+	require.True(t, ok)
+	require.Equal(t, foreignAnchor.AnchorPoint, alert.AnchorPoint)
+	require.Equal(t, foreignTx.TxHash(), alert.SpendingTxid)
+	require.EqualValues(t, 102, alert.SpendHeight)
+	require.Len(t, alert.Assets, 2)
+
+	atRisk, err := monitor.ListAtRiskAssets(ctx)
+	require.NoError(t, err)
+	require.Len(t, atRisk, 2)
+	for _, a := range atRisk {
+		require.Equal(t, foreignTx.TxHash(), a.SpendingTxid)
+	}
+
+	// On a new block, anchor outputs that no longer anchor unspent assets
+	// are dropped and new ones are watched.
+	newOwned := newAnchor()
+	store.setOwned(newOwned)
+	chainBridge.NewBlocks <- 103
+
+	require.Eventually(t, func() bool {
+		chainBridge.spendMtx.Lock()
+		defer chainBridge.spendMtx.Unlock()
+
+		_, ok := chainBridge.SpendReqs[newOwned.AnchorPoint]
+		return ok
+	}, DefaultTimeout, 10*time.Millisecond)
+
+	require.True(t, chainBridge.SendSpendNtfn(
+		droppedAnchor.AnchorPoint, wire.NewMsgTx(2), 104,
+	))
+	requireNoEvent()
+}
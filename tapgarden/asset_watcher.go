@@ -0,0 +1,484 @@
+package tapgarden
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+var (
+	// ErrWatchedAssetOwned is returned when an asset that is owned by the
+	// local wallet is added as a watched asset.
+	ErrWatchedAssetOwned = errors.New("asset is owned by the local wallet")
+
+	// ErrWatchedAssetNotFound is returned when a watched asset doesn't
+	// exist.
+	ErrWatchedAssetNotFound = errors.New("watched asset not found")
+)
+
+// WatchedAsset is an asset owned by an external key that is tracked purely for
+// monitoring, for example the collateral of a counterparty. Watched assets are
+// never part of the spendable balance of the wallet.
+type WatchedAsset struct {
+	// ID is the database ID of the watched asset.
+	ID int64
+
+	// Label is a user defined description of the watched asset.
+	Label string
+
+	// AssetID is the ID of the watched asset.
+	AssetID asset.ID
+
+	// GroupKey is the tweaked group key of the watched asset, if it has
+	// one.
+	GroupKey *btcec.PublicKey
+
+	// ScriptKey is the script key the watched asset is locked to.
+	ScriptKey *btcec.PublicKey
+
+	// Amount is the amount of the watched asset.
+	Amount uint64
+
+	// AnchorPoint is the on-chain outpoint the watched asset is anchored
+	// at.
+	AnchorPoint wire.OutPoint
+
+	// AnchorPkScript is the pkScript of the anchor output.
+	AnchorPkScript []byte
+
+	// AnchorHeight is the height of the block the anchor transaction
+	// confirmed in.
+	AnchorHeight uint32
+
+	// ProofFile is the proof file of the watched asset.
+	ProofFile proof.Blob
+
+	// SpendingTxid is the ID of the transaction that spent the anchor
+	// output, or nil if the anchor output is unspent.
+	SpendingTxid *chainhash.Hash
+
+	// SpendHeight is the height of the block the spending transaction
+	// confirmed in.
+	SpendHeight uint32
+
+	// CreatedAt is the time the asset was first watched.
+	CreatedAt time.Time
+}
+
+// Spent returns true if the anchor output of the watched asset was spent.
+func (w *WatchedAsset) Spent() bool {
+	return w.SpendingTxid != nil
+}
+
+// WatchedAssetStore is the persistent store of watched assets.
+type WatchedAssetStore interface {
+	// AddWatchedAsset adds a new watched asset and returns its ID. If the
+	// asset is already watched, its label and proof file are updated. If
+	// the asset is owned by the local wallet, ErrWatchedAssetOwned is
+	// returned.
+	AddWatchedAsset(ctx context.Context, watched *WatchedAsset) (int64,
+		error)
+
+	// ListWatchedAssets returns all watched assets, ordered by the time
+	// they were first watched. Assets with a spent anchor output are only
+	// included if includeSpent is true.
+	ListWatchedAssets(ctx context.Context,
+		includeSpent bool) ([]*WatchedAsset, error)
+
+	// MarkWatchedAssetsSpent marks all watched assets anchored at the given
+	// outpoint as spent by the given transaction.
+	MarkWatchedAssetsSpent(ctx context.Context, anchorPoint wire.OutPoint,
+		spendingTxid chainhash.Hash, spendHeight uint32) error
+
+	// RemoveWatchedAsset stops watching the asset with the given ID. If no
+	// such asset exists, ErrWatchedAssetNotFound is returned.
+	RemoveWatchedAsset(ctx context.Context, id int64) error
+}
+
+// AssetWatcherConfig is the configuration of the asset watcher.
+type AssetWatcherConfig struct {
+	// Store is the persistent store of watched assets.
+	Store WatchedAssetStore
+
+	// ChainBridge is used to verify proofs and to detect spends of the
+	// anchor outputs.
+	ChainBridge ChainBridge
+}
+
+// watchedAnchor is an anchor output of one or more watched assets with its
+// spend notification.
+type watchedAnchor struct {
+	// assets are the watched assets anchored at the output.
+	assets []*WatchedAsset
+
+	// cancel cancels the spend notification.
+	cancel func()
+}
+
+// AssetWatcher tracks assets owned by external keys from their proof files.
+// The assets are stored separately from the assets of the wallet, and once
+// the anchor output of a watched asset is spent, a WatchedAssetSpentEvent is
+// published as an alert.
+type AssetWatcher struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *AssetWatcherConfig
+
+	// anchors are the unspent anchor outputs of all watched assets.
+	anchors map[wire.OutPoint]*watchedAnchor
+
+	// anchorMtx guards the anchors map.
+	anchorMtx sync.Mutex
+
+	// eventBus delivers the events of the watcher to all subscribers.
+	eventBus *fn.EventBus[fn.Event]
+
+	*fn.ContextGuard
+}
+
+// NewAssetWatcher creates a new asset watcher from the given config.
+func NewAssetWatcher(cfg *AssetWatcherConfig) *AssetWatcher {
+	return &AssetWatcher{
+		cfg:     cfg,
+		anchors: make(map[wire.OutPoint]*watchedAnchor),
+		eventBus: fn.NewEventBus(
+			fn.DefaultEventBusBufferSize,
+			fn.WithDropMarker(fn.MarkDroppedHistory),
+		),
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start registers spend notifications for the anchor outputs of all watched
+// assets that aren't spent yet.
+func (w *AssetWatcher) Start() error {
+	var startErr error
+	w.startOnce.Do(func() {
+		log.Infof("Starting asset watcher")
+
+		ctx, cancel := w.WithCtxQuit()
+		defer cancel()
+
+		watched, err := w.cfg.Store.ListWatchedAssets(ctx, false)
+		if err != nil {
+			startErr = fmt.Errorf("unable to list watched assets: "+
+				"%w", err)
+			return
+		}
+
+		for _, watchedAsset := range watched {
+			err := w.watchAnchor(watchedAsset)
+			if err != nil {
+				startErr = err
+				return
+			}
+		}
+	})
+
+	return startErr
+}
+
+// Stop cancels all spend notifications.
+func (w *AssetWatcher) Stop() error {
+	w.stopOnce.Do(func() {
+		log.Infof("Stopping asset watcher")
+
+		close(w.Quit)
+		w.Wg.Wait()
+	})
+
+	return nil
+}
+
+// WatchAsset verifies the given proof file and starts watching the asset it
+// proves the ownership of. The asset must not be owned by the local wallet.
+func (w *AssetWatcher) WatchAsset(ctx context.Context, proofFile proof.Blob,
+	label string) (*WatchedAsset, error) {
+
+	var file proof.File
+	if err := file.Decode(bytes.NewReader(proofFile)); err != nil {
+		return nil, fmt.Errorf("unable to decode proof file: %w", err)
+	}
+
+	headerVerifier := GenHeaderVerifier(ctx, w.cfg.ChainBridge)
+	snapshot, err := file.Verify(ctx, headerVerifier)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proof file: %w", err)
+	}
+
+	anchorOut := snapshot.AnchorTx.TxOut[snapshot.OutputIndex]
+	watched := &WatchedAsset{
+		Label:          label,
+		AssetID:        snapshot.Asset.ID(),
+		ScriptKey:      snapshot.Asset.ScriptKey.PubKey,
+		Amount:         snapshot.Asset.Amount,
+		AnchorPoint:    snapshot.OutPoint,
+		AnchorPkScript: anchorOut.PkScript,
+		AnchorHeight:   snapshot.AnchorBlockHeight,
+		ProofFile:      proofFile,
+		CreatedAt:      time.Now().UTC(),
+	}
+	if snapshot.Asset.GroupKey != nil {
+		watched.GroupKey = &snapshot.Asset.GroupKey.GroupPubKey
+	}
+
+	watched.ID, err = w.cfg.Store.AddWatchedAsset(ctx, watched)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Watching asset %v (%v) anchored at %v", watched.AssetID,
+		label, watched.AnchorPoint)
+
+	if err := w.watchAnchor(watched); err != nil {
+		return nil, err
+	}
+
+	return watched, nil
+}
+
+// UnwatchAsset stops watching the asset with the given ID.
+func (w *AssetWatcher) UnwatchAsset(ctx context.Context, id int64) error {
+	if err := w.cfg.Store.RemoveWatchedAsset(ctx, id); err != nil {
+		return err
+	}
+
+	w.anchorMtx.Lock()
+	defer w.anchorMtx.Unlock()
+
+	for anchorPoint, anchor := range w.anchors {
+		remaining := withoutWatchedAsset(anchor.assets, id)
+		if len(remaining) == len(anchor.assets) {
+			continue
+		}
+
+		anchor.assets = remaining
+		if len(remaining) == 0 {
+			anchor.cancel()
+			delete(w.anchors, anchorPoint)
+		}
+
+		break
+	}
+
+	return nil
+}
+
+// ListWatchedAssets returns all watched assets. Assets with a spent anchor
+// output are only included if includeSpent is true.
+func (w *AssetWatcher) ListWatchedAssets(ctx context.Context,
+	includeSpent bool) ([]*WatchedAsset, error) {
+
+	return w.cfg.Store.ListWatchedAssets(ctx, includeSpent)
+}
+
+// watchAnchor adds the given watched asset to the watched anchor outputs and
+// registers a spend notification if the anchor output isn't watched yet.
+func (w *AssetWatcher) watchAnchor(watched *WatchedAsset) error {
+	w.anchorMtx.Lock()
+	defer w.anchorMtx.Unlock()
+
+	anchor, ok := w.anchors[watched.AnchorPoint]
+	if ok {
+		// An asset that is watched again only replaces the existing
+		// entry.
+		anchor.assets = append(
+			withoutWatchedAsset(anchor.assets, watched.ID), watched,
+		)
+
+		return nil
+	}
+
+	ctx, cancel := w.WithCtxQuitNoTimeout()
+	anchorPoint := watched.AnchorPoint
+	spendEvent, errChan, err := w.cfg.ChainBridge.RegisterSpendNtfn(
+		ctx, &anchorPoint, watched.AnchorPkScript,
+		watched.AnchorHeight,
+	)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("unable to register spend notification for "+
+			"%v: %w", anchorPoint, err)
+	}
+
+	w.anchors[anchorPoint] = &watchedAnchor{
+		assets: []*WatchedAsset{watched},
+		cancel: func() {
+			spendEvent.Cancel()
+			cancel()
+		},
+	}
+
+	w.Wg.Add(1)
+	go w.waitForSpend(ctx, anchorPoint, spendEvent, errChan)
+
+	return nil
+}
+
+// withoutWatchedAsset returns the given watched assets without the one with
+// the given ID.
+func withoutWatchedAsset(assets []*WatchedAsset, id int64) []*WatchedAsset {
+	return fn.Filter(assets, func(a *WatchedAsset) bool {
+		return a.ID != id
+	})
+}
+
+// waitForSpend waits for the spend of the given anchor output.
+//
+// NOTE: This method MUST be called as a goroutine.
+func (w *AssetWatcher) waitForSpend(ctx context.Context,
+	anchorPoint wire.OutPoint, spendEvent *chainntnfs.SpendEvent,
+	errChan chan error) {
+
+	defer w.Wg.Done()
+
+	select {
+	case spend, ok := <-spendEvent.Spend:
+		if !ok {
+			return
+		}
+
+		err := w.handleSpend(anchorPoint, spend)
+		if err != nil {
+			log.Errorf("Unable to handle spend of watched anchor "+
+				"%v: %v", anchorPoint, err)
+		}
+
+	case err := <-errChan:
+		// The error channel also fires if the notification is
+		// canceled, which isn't worth logging.
+		if ctx.Err() == nil {
+			log.Errorf("Unable to wait for spend of watched "+
+				"anchor %v: %v", anchorPoint, err)
+		}
+
+	case <-ctx.Done():
+	}
+}
+
+// handleSpend marks the watched assets of the spent anchor output as spent and
+// publishes the spend to all subscribers.
+func (w *AssetWatcher) handleSpend(anchorPoint wire.OutPoint,
+	spend *chainntnfs.SpendDetail) error {
+
+	w.anchorMtx.Lock()
+	anchor, ok := w.anchors[anchorPoint]
+	delete(w.anchors, anchorPoint)
+	w.anchorMtx.Unlock()
+
+	// The asset was unwatched in the meantime.
+	if !ok {
+		return nil
+	}
+	anchor.cancel()
+
+	ctx, cancel := w.WithCtxQuit()
+	defer cancel()
+
+	spendHeight := uint32(spend.SpendingHeight)
+	err := w.cfg.Store.MarkWatchedAssetsSpent(
+		ctx, anchorPoint, *spend.SpenderTxHash, spendHeight,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to mark watched assets spent: %w",
+			err)
+	}
+
+	spendingTxid := *spend.SpenderTxHash
+	for _, watched := range anchor.assets {
+		watched.SpendingTxid = &spendingTxid
+		watched.SpendHeight = spendHeight
+
+		log.Warnf("Watched asset %v (%v) anchored at %v was spent by "+
+			"tx %v at height %d", watched.AssetID, watched.Label,
+			anchorPoint, spendingTxid, spendHeight)
+	}
+
+	w.publishSubscriberEvent(NewWatchedAssetSpentEvent(
+		anchorPoint, spendingTxid, spendHeight, anchor.assets,
+	))
+
+	return nil
+}
+
+// RegisterSubscriber adds a new subscriber to the set of subscribers that will
+// be notified about spent watched assets.
+func (w *AssetWatcher) RegisterSubscriber(
+	receiver *fn.EventReceiver[fn.Event], _ bool, _ bool) error {
+
+	w.eventBus.Subscribe(receiver, nil)
+
+	return nil
+}
+
+// RemoveSubscriber removes a subscriber from the set of subscribers that will
+// be notified about spent watched assets.
+func (w *AssetWatcher) RemoveSubscriber(
+	subscriber *fn.EventReceiver[fn.Event]) error {
+
+	return w.eventBus.Unsubscribe(subscriber)
+}
+
+// publishSubscriberEvent publishes an event to all subscribers.
+func (w *AssetWatcher) publishSubscriberEvent(event fn.Event) {
+	w.eventBus.Publish(event)
+}
+
+// A compile-time assertion to make sure AssetWatcher satisfies the
+// fn.EventPublisher interface.
+var _ fn.EventPublisher[fn.Event, bool] = (*AssetWatcher)(nil)
+
+// WatchedAssetSpentEvent is an event which indicates that the anchor output of
+// one or more watched assets was spent.
+type WatchedAssetSpentEvent struct {
+	// timestamp is the time the event was created.
+	timestamp time.Time
+
+	// AnchorPoint is the spent anchor output.
+	AnchorPoint wire.OutPoint
+
+	// SpendingTxid is the ID of the transaction that spent the anchor
+	// output.
+	SpendingTxid chainhash.Hash
+
+	// SpendHeight is the height of the block the spending transaction
+	// confirmed in.
+	SpendHeight uint32
+
+	// Assets are the watched assets that were anchored at the output.
+	Assets []*WatchedAsset
+}
+
+// Timestamp returns the timestamp of the event.
+func (e *WatchedAssetSpentEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// NewWatchedAssetSpentEvent creates a new WatchedAssetSpentEvent.
+func NewWatchedAssetSpentEvent(anchorPoint wire.OutPoint,
+	spendingTxid chainhash.Hash, spendHeight uint32,
+	assets []*WatchedAsset) *WatchedAssetSpentEvent {
+
+	return &WatchedAssetSpentEvent{
+		timestamp:    time.Now().UTC(),
+		AnchorPoint:  anchorPoint,
+		SpendingTxid: spendingTxid,
+		SpendHeight:  spendHeight,
+		Assets:       assets,
+	}
+}
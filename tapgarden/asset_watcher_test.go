@@ -0,0 +1,178 @@
+package tapgarden
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// mockWatchedAssetStore is an in-memory WatchedAssetStore.
+type mockWatchedAssetStore struct {
+	sync.Mutex
+
+	assets map[int64]*WatchedAsset
+}
+
+func (m *mockWatchedAssetStore) AddWatchedAsset(_ context.Context,
+	watched *WatchedAsset) (int64, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	id := int64(len(m.assets) + 1)
+	m.assets[id] = watched
+
+	return id, nil
+}
+
+func (m *mockWatchedAssetStore) ListWatchedAssets(_ context.Context,
+	includeSpent bool) ([]*WatchedAsset, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	var watched []*WatchedAsset
+	for id := int64(1); id <= int64(len(m.assets)); id++ {
+		w, ok := m.assets[id]
+		if !ok || (w.Spent() && !includeSpent) {
+			continue
+		}
+
+		watchedCopy := *w
+		watchedCopy.ID = id
+		watched = append(watched, &watchedCopy)
+	}
+
+	return watched, nil
+}
+
+func (m *mockWatchedAssetStore) MarkWatchedAssetsSpent(_ context.Context,
+	anchorPoint wire.OutPoint, spendingTxid chainhash.Hash,
+	spendHeight uint32) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	for _, w := range m.assets {
+		if w.AnchorPoint != anchorPoint || w.Spent() {
+			continue
+		}
+
+		txid := spendingTxid
+		w.SpendingTxid = &txid
+		w.SpendHeight = spendHeight
+	}
+
+	return nil
+}
+
+func (m *mockWatchedAssetStore) RemoveWatchedAsset(_ context.Context,
+	id int64) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	if _, ok := m.assets[id]; !ok {
+		return ErrWatchedAssetNotFound
+	}
+	delete(m.assets, id)
+
+	return nil
+}
+
+// TestAssetWatcher tests that spends of the anchor outputs of watched assets
+// are detected and published.
+func TestAssetWatcher(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := &mockWatchedAssetStore{
+		assets: make(map[int64]*WatchedAsset),
+	}
+	chainBridge := NewMockChainBridge()
+
+	// Two assets share an anchor output, a third one is anchored
+	// separately.
+	sharedAnchor := wire.OutPoint{Hash: test.RandHash(), Index: 1}
+	otherAnchor := wire.OutPoint{Hash: test.RandHash(), Index: 2}
+	for idx, anchor := range []wire.OutPoint{
+		sharedAnchor, sharedAnchor, otherAnchor,
+	} {
+		_, err := store.AddWatchedAsset(ctx, &WatchedAsset{
+			Label:       "collateral",
+			AssetID:     asset.ID(test.RandHash()),
+			ScriptKey:   test.RandPubKey(t),
+			Amount:      uint64(idx+1) * 100,
+			AnchorPoint: anchor,
+		})
+		require.NoError(t, err)
+	}
+
+	watcher := NewAssetWatcher(&AssetWatcherConfig{
+		Store:       store,
+		ChainBridge: chainBridge,
+	})
+
+	sub := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
+	require.NoError(t, watcher.RegisterSubscriber(sub, false, false))
+
+	require.NoError(t, watcher.Start())
+	t.Cleanup(func() {
+		require.NoError(t, watcher.Stop())
+	})
+
+	// A single spend notification is registered per anchor output.
+	chainBridge.spendMtx.Lock()
+	require.Len(t, chainBridge.SpendReqs, 2)
+	chainBridge.spendMtx.Unlock()
+
+	// Once the shared anchor output is spent, both of its assets are
+	// marked as spent and published in a single event.
+	spendingTx := wire.NewMsgTx(2)
+	spendingTx.AddTxIn(&wire.TxIn{PreviousOutPoint: sharedAnchor})
+	require.True(
+		t, chainBridge.SendSpendNtfn(sharedAnchor, spendingTx, 123),
+	)
+
+	var event fn.Event
+	select {
+	case event = <-sub.NewItemCreated.ChanOut():
+	case <-time.After(DefaultTimeout):
+		t.Fatalf("no spend event received")
+	}
+
+	spentEvent, ok := event.(*WatchedAssetSpentEvent)
+	require.True(t, ok)
+	require.Equal(t, sharedAnchor, spentEvent.AnchorPoint)
+	require.Equal(t, spendingTx.TxHash(), spentEvent.SpendingTxid)
+	require.EqualValues(t, 123, spentEvent.SpendHeight)
+	require.Len(t, spentEvent.Assets, 2)
+
+	unspent, err := store.ListWatchedAssets(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, unspent, 1)
+	require.Equal(t, otherAnchor, unspent[0].AnchorPoint)
+
+	// After the remaining asset is unwatched, its spend isn't published
+	// anymore.
+	require.NoError(t, watcher.UnwatchAsset(ctx, unspent[0].ID))
+	require.ErrorIs(
+		t, watcher.UnwatchAsset(ctx, unspent[0].ID),
+		ErrWatchedAssetNotFound,
+	)
+
+	chainBridge.SendSpendNtfn(otherAnchor, wire.NewMsgTx(2), 124)
+	select {
+	case event := <-sub.NewItemCreated.ChanOut():
+		t.Fatalf("unexpected event: %v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -412,8 +412,10 @@ func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
 			assetGen.MetaHash = seedling.Meta.MetaHash()
 		}
 
-		scriptKey, err := b.cfg.KeyRing.DeriveNextKey(
-			ctx, asset.TaprootAssetsKeyFamily,
+		scriptKey, err := DeriveAuditedKey(
+			ctx, b.cfg.KeyRing, b.cfg.KeyAuditLog,
+			asset.TaprootAssetsKeyFamily, KeyPurposeMintScriptKey,
+			fmt.Sprintf("asset %v", assetGen.ID()),
 		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to obtain script "+
@@ -456,8 +458,11 @@ func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
 		// then use that to derive the key group signature
 		// along with the tweaked key group.
 		if seedling.EnableEmission {
-			rawGroupKey, err := b.cfg.KeyRing.DeriveNextKey(
-				ctx, asset.TaprootAssetsKeyFamily,
+			rawGroupKey, err := DeriveAuditedKey(
+				ctx, b.cfg.KeyRing, b.cfg.KeyAuditLog,
+				asset.TaprootAssetsKeyFamily,
+				KeyPurposeGroupKey,
+				fmt.Sprintf("asset %v", assetGen.ID()),
 			)
 			if err != nil {
 				return nil, fmt.Errorf("unable to"+
@@ -2,6 +2,7 @@ package tapgarden
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -54,6 +55,10 @@ type CustodianConfig struct {
 	// to be confirmed safely with a minimum number of confirmations.
 	ProofWatcher proof.Watcher
 
+	// Invoices is the optional storage backend for invoices. If set, the
+	// invoice of an address is settled once a transfer to it completes.
+	Invoices InvoiceStore
+
 	// ErrChan is the main error channel the custodian will report back
 	// critical errors to the main server.
 	ErrChan chan<- error
@@ -82,6 +87,13 @@ type Custodian struct {
 	// address events of inbound assets.
 	events map[wire.OutPoint]*address.Event
 
+	// subscribers is a map of components that want to be notified on new
+	// events, keyed by their subscription ID.
+	subscribers map[uint64]*fn.EventReceiver[fn.Event]
+
+	// subscriberMtx guards the subscribers map.
+	subscriberMtx sync.Mutex
+
 	// ContextGuard provides a wait group and main quit channel that can be
 	// used to create guarded contexts.
 	*fn.ContextGuard
@@ -99,6 +111,9 @@ func NewCustodian(cfg *CustodianConfig) *Custodian {
 		addrSubscription:  addrSub,
 		proofSubscription: proofSub,
 		events:            make(map[wire.OutPoint]*address.Event),
+		subscribers: make(
+			map[uint64]*fn.EventReceiver[fn.Event],
+		),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -158,6 +173,15 @@ func (c *Custodian) Stop() error {
 		if err != nil {
 			stopErr = err
 		}
+
+		// Remove all subscribers.
+		for _, sub := range c.subscribers {
+			err := c.RemoveSubscriber(sub)
+			if err != nil {
+				stopErr = err
+				break
+			}
+		}
 	})
 
 	return stopErr
@@ -325,6 +349,9 @@ func (c *Custodian) inspectWalletTx(walletTx *lndclient.Transaction) error {
 				}
 
 				c.events[op] = event
+				c.publishSubscriberEvent(
+					NewAssetReceiveEvent(event),
+				)
 			}
 
 			continue
@@ -341,6 +368,10 @@ func (c *Custodian) inspectWalletTx(walletTx *lndclient.Transaction) error {
 			continue
 		}
 
+		// We hand a copy of the event to the goroutine below, so we
+		// can notify our subscribers once the proof was received.
+		event = c.events[op]
+
 		// Now that we've seen this output on chain, we'll launch a
 		// goroutine to use the ProofCourier to import the proof into
 		// our local DB.
@@ -356,9 +387,10 @@ func (c *Custodian) inspectWalletTx(walletTx *lndclient.Transaction) error {
 
 			assetID := addr.AssetID
 			recipient := proof.Recipient{
-				ScriptKey: &addr.ScriptKey,
-				AssetID:   assetID,
-				Amount:    addr.Amount,
+				ScriptKey:     &addr.ScriptKey,
+				AssetID:       assetID,
+				Amount:        addr.Amount,
+				EncryptionKey: &addr.InternalKeyDesc,
 			}
 			addrProof, err := c.cfg.ProofCourier.ReceiveProof(
 				ctx, recipient, proof.Locator{
@@ -376,6 +408,10 @@ func (c *Custodian) inspectWalletTx(walletTx *lndclient.Transaction) error {
 				addr.ScriptKey.SerializeCompressed(),
 				assetID[:])
 
+			proofEvent := NewAssetReceiveEvent(event)
+			proofEvent.Event.Status = address.StatusProofReceived
+			c.publishSubscriberEvent(proofEvent)
+
 			ctx, cancel = c.CtxBlocking()
 			defer cancel()
 
@@ -389,17 +425,51 @@ func (c *Custodian) inspectWalletTx(walletTx *lndclient.Transaction) error {
 				log.Errorf("unable to import proofs: %v", err)
 				return
 			}
+
+			// Now that the proof was verified and accepted, we let
+			// the sender know, if the courier offers a return
+			// channel for that.
+			c.notifySettled(ctx, recipient)
 		}()
 	}
 
 	return nil
 }
 
+// notifySettled notifies the sender of a received proof through the proof
+// courier that the proof was verified and accepted. Couriers without a
+// settlement return channel are skipped, and failed notifications are only
+// logged, since the asset was already received.
+func (c *Custodian) notifySettled(ctx context.Context,
+	recipient proof.Recipient) {
+
+	courier := c.cfg.ProofCourier
+	settlement, ok := courier.(proof.SettlementChannel[proof.Recipient])
+	if !ok {
+		return
+	}
+
+	err := settlement.NotifySettled(ctx, recipient)
+	switch {
+	case errors.Is(err, proof.ErrSettlementDisabled):
+		return
+
+	case err != nil:
+		log.Warnf("Unable to notify sender of settlement for script "+
+			"key %x: %v", recipient.ScriptKey.SerializeCompressed(),
+			err)
+		return
+	}
+
+	log.Debugf("Notified sender of settlement for script key %x",
+		recipient.ScriptKey.SerializeCompressed())
+}
+
 // mapToTapAddr attempts to match a transaction output to a Taproot Asset
 // address. If a matching address is found, an event is created for it. If an
 // event already exists, it is updated with the current transaction information.
 func (c *Custodian) mapToTapAddr(walletTx *lndclient.Transaction,
-	outputIdx uint32, op wire.OutPoint) (*address.Tap, error) {
+	outputIdx uint32, op wire.OutPoint) (*address.AddrWithKeyInfo, error) {
 
 	taprootKey, err := proof.ExtractTaprootKey(walletTx.Tx, outputIdx)
 	if err != nil {
@@ -446,10 +516,19 @@ func (c *Custodian) mapToTapAddr(walletTx *lndclient.Transaction,
 		return nil, fmt.Errorf("error creating event: %w", err)
 	}
 
+	// If the address was annotated with a deposit expectation, we flag a
+	// late deposit as soon as we see it.
+	if event.DepositStatus == address.DepositLate {
+		log.Warnf("Late inbound asset transfer for Taproot Asset "+
+			"address %s in %s, expected before %v", addrStr,
+			op.String(), addr.Expectation.Expiry)
+	}
+
 	// Let's update our cache of ongoing events.
 	c.events[op] = event
+	c.publishSubscriberEvent(NewAssetReceiveEvent(event))
 
-	return addr.Tap, nil
+	return addr, nil
 }
 
 // importAddrToWallet imports the given Taproot Asset address into the
@@ -602,9 +681,121 @@ func (c *Custodian) setReceiveCompleted(event *address.Event,
 		Index: lastProof.InclusionProof.OutputIndex,
 	}
 
-	return c.cfg.AddrBook.CompleteEvent(
+	err = c.cfg.AddrBook.CompleteEvent(
 		ctxt, event, address.StatusCompleted, anchorPoint,
 	)
+	if err != nil {
+		return err
+	}
+
+	if err := c.settleInvoice(ctxt, event.Addr, anchorPoint); err != nil {
+		return err
+	}
+
+	completedEvent := NewAssetReceiveEvent(event)
+	completedEvent.Event.Status = address.StatusCompleted
+	completedEvent.Event.HasProof = true
+	c.publishSubscriberEvent(completedEvent)
+
+	return nil
+}
+
+// settleInvoice settles the invoice of the given address, if there is one,
+// now that a transfer to it completed in the given outpoint. An invoice is
+// settled even if it already expired, as the assets were received anyway.
+func (c *Custodian) settleInvoice(ctx context.Context,
+	addr *address.AddrWithKeyInfo, anchorPoint wire.OutPoint) error {
+
+	if c.cfg.Invoices == nil {
+		return nil
+	}
+
+	settled, err := c.cfg.Invoices.SettleInvoice(
+		ctx, &addr.TaprootOutputKey, anchorPoint, time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to settle invoice: %w", err)
+	}
+
+	if settled {
+		log.Infof("Settled invoice of address %v with inbound "+
+			"transfer in %v", addr.Tap, anchorPoint)
+	}
+
+	return nil
+}
+
+// RegisterSubscriber adds a new subscriber to the set of subscribers that will
+// be notified of any changes to inbound asset transfers.
+func (c *Custodian) RegisterSubscriber(receiver *fn.EventReceiver[fn.Event],
+	deliverExisting bool, deliverFrom bool) error {
+
+	c.subscriberMtx.Lock()
+	defer c.subscriberMtx.Unlock()
+
+	c.subscribers[receiver.ID()] = receiver
+
+	return nil
+}
+
+// RemoveSubscriber removes a subscriber from the set of subscribers that will
+// be notified of any changes to inbound asset transfers.
+func (c *Custodian) RemoveSubscriber(
+	subscriber *fn.EventReceiver[fn.Event]) error {
+
+	c.subscriberMtx.Lock()
+	defer c.subscriberMtx.Unlock()
+
+	_, ok := c.subscribers[subscriber.ID()]
+	if !ok {
+		return fmt.Errorf("subscriber with ID %d not found",
+			subscriber.ID())
+	}
+
+	subscriber.Stop()
+	delete(c.subscribers, subscriber.ID())
+
+	return nil
+}
+
+// publishSubscriberEvent publishes an event to all subscribers.
+func (c *Custodian) publishSubscriberEvent(event fn.Event) {
+	c.subscriberMtx.Lock()
+	defer c.subscriberMtx.Unlock()
+
+	for _, sub := range c.subscribers {
+		sub.NewItemCreated.ChanIn() <- event
+	}
+}
+
+// A compile-time assertion to make sure Custodian satisfies the
+// fn.EventPublisher interface.
+var _ fn.EventPublisher[fn.Event, bool] = (*Custodian)(nil)
+
+// AssetReceiveEvent is an event which is sent to the Custodian's event
+// subscribers whenever an inbound asset transfer is detected or advances to a
+// new status.
+type AssetReceiveEvent struct {
+	// timestamp is the time the event was created.
+	timestamp time.Time
+
+	// Event is a snapshot of the address event of the inbound transfer at
+	// the time the event was created.
+	Event address.Event
+}
+
+// Timestamp returns the timestamp of the event.
+func (e *AssetReceiveEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// NewAssetReceiveEvent creates a new AssetReceiveEvent from a snapshot of the
+// given address event.
+func NewAssetReceiveEvent(event *address.Event) *AssetReceiveEvent {
+	return &AssetReceiveEvent{
+		timestamp: time.Now().UTC(),
+		Event:     *event,
+	}
 }
 
 // hasWalletTaprootOutput returns true if one of the outputs of the given
@@ -0,0 +1,604 @@
+package tapgarden
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// DescriptorVersion is the version of the asset descriptor encoding.
+type DescriptorVersion uint8
+
+const (
+	// DescriptorV0 is the initial version of asset descriptors.
+	DescriptorV0 DescriptorVersion = 0
+)
+
+const (
+	descriptorVersionType   tlv.Type = 0
+	descriptorChainHashType tlv.Type = 2
+	descriptorPurposeType   tlv.Type = 4
+	descriptorCoinTypeType  tlv.Type = 6
+	descriptorKeyRangesType tlv.Type = 8
+	descriptorGroupsType    tlv.Type = 10
+	descriptorAssetsType    tlv.Type = 12
+	descriptorServersType   tlv.Type = 14
+)
+
+// KeyRange is the range of keys of a key family that were derived from the
+// seed. All keys of the family from index zero up to and including MaxIndex
+// need to be scanned for during recovery.
+type KeyRange struct {
+	// Family is the key family (account in BIP-0043) of the keys.
+	Family keychain.KeyFamily
+
+	// MaxIndex is the highest index of a key of the family that is known
+	// to be in use.
+	MaxIndex uint32
+}
+
+// DescriptorGroup is an asset group the wallet can issue more assets into.
+type DescriptorGroup struct {
+	// GroupKey is the tweaked group key of the asset group.
+	GroupKey *btcec.PublicKey
+
+	// RawKey is the raw group key before the tweak is applied, together
+	// with the locator needed to re-derive it from the seed.
+	RawKey keychain.KeyDescriptor
+}
+
+// DescriptorAsset is an asset the wallet holds or has held.
+type DescriptorAsset struct {
+	// Genesis is the genesis information of the asset, which commits to
+	// the asset ID.
+	Genesis asset.Genesis
+
+	// GroupKey is the tweaked group key of the asset, if it has one.
+	GroupKey *btcec.PublicKey
+}
+
+// AssetDescriptor is a backup artifact of an asset wallet. Together with the
+// seed of the backing lnd node, it contains everything needed to recover the
+// assets of the wallet: the key derivation scheme and the ranges of keys that
+// were derived, the groups and IDs of the assets to look for, and the universe
+// servers to fetch their proofs from. It doesn't contain any private key
+// material.
+type AssetDescriptor struct {
+	// Version is the version of the descriptor encoding.
+	Version DescriptorVersion
+
+	// ChainHash is the hash of the genesis block of the network the
+	// wallet operates on.
+	ChainHash chainhash.Hash
+
+	// Purpose is the BIP-0043 purpose of the derivation path of all keys.
+	Purpose uint32
+
+	// CoinType is the BIP-0044 coin type of the derivation path of all
+	// keys.
+	CoinType uint32
+
+	// KeyRanges are the ranges of keys that were derived, one per key
+	// family, ordered by family.
+	KeyRanges []KeyRange
+
+	// Groups are the asset groups the wallet can issue into.
+	Groups []DescriptorGroup
+
+	// Assets are the assets the wallet holds or has held.
+	Assets []DescriptorAsset
+
+	// UniverseServers are the hosts of the universe servers the proofs of
+	// the assets can be fetched from.
+	UniverseServers []string
+}
+
+// isLocalLocator returns true if the key locator identifies a key derived
+// from the seed. Keys imported from proofs have a zero locator.
+func isLocalLocator(loc keychain.KeyLocator) bool {
+	return loc.Family != 0 || loc.Index != 0
+}
+
+// NewAssetDescriptor creates the descriptor of a wallet that holds the given
+// assets and derived the given keys.
+func NewAssetDescriptor(params *chaincfg.Params, assets []*asset.Asset,
+	derivations []*KeyDerivation,
+	universeServers []string) *AssetDescriptor {
+
+	maxIndexes := make(map[keychain.KeyFamily]uint32)
+	addKey := func(loc keychain.KeyLocator) {
+		if !isLocalLocator(loc) {
+			return
+		}
+
+		maxIndex, ok := maxIndexes[loc.Family]
+		if !ok || loc.Index > maxIndex {
+			maxIndexes[loc.Family] = loc.Index
+		}
+	}
+
+	for _, derivation := range derivations {
+		addKey(derivation.Key.KeyLocator)
+	}
+
+	desc := &AssetDescriptor{
+		Version:         DescriptorV0,
+		ChainHash:       *params.GenesisHash,
+		Purpose:         keychain.BIP0043Purpose,
+		CoinType:        params.HDCoinType,
+		UniverseServers: universeServers,
+	}
+
+	seenGroups := make(map[asset.SerializedKey]struct{})
+	seenAssets := make(map[asset.ID]struct{})
+	for _, a := range assets {
+		if a.ScriptKey.TweakedScriptKey != nil {
+			addKey(a.ScriptKey.RawKey.KeyLocator)
+		}
+
+		var groupKey *btcec.PublicKey
+		if a.GroupKey != nil {
+			groupKey = &a.GroupKey.GroupPubKey
+
+			// We can only issue into groups with a raw key that
+			// was derived from our seed.
+			rawKey := a.GroupKey.RawKey
+			groupID := asset.ToSerialized(groupKey)
+			_, seen := seenGroups[groupID]
+			if !seen && rawKey.PubKey != nil &&
+				isLocalLocator(rawKey.KeyLocator) {
+
+				addKey(rawKey.KeyLocator)
+				seenGroups[groupID] = struct{}{}
+				group := DescriptorGroup{
+					GroupKey: groupKey,
+					RawKey:   rawKey,
+				}
+				desc.Groups = append(desc.Groups, group)
+			}
+		}
+
+		if _, ok := seenAssets[a.ID()]; ok {
+			continue
+		}
+		seenAssets[a.ID()] = struct{}{}
+		desc.Assets = append(desc.Assets, DescriptorAsset{
+			Genesis:  a.Genesis,
+			GroupKey: groupKey,
+		})
+	}
+
+	for family, maxIndex := range maxIndexes {
+		desc.KeyRanges = append(desc.KeyRanges, KeyRange{
+			Family:   family,
+			MaxIndex: maxIndex,
+		})
+	}
+	sort.Slice(desc.KeyRanges, func(i, j int) bool {
+		return desc.KeyRanges[i].Family < desc.KeyRanges[j].Family
+	})
+
+	return desc
+}
+
+// Validate makes sure the descriptor is well-formed.
+func (d *AssetDescriptor) Validate() error {
+	if d.Version != DescriptorV0 {
+		return fmt.Errorf("unknown descriptor version: %d", d.Version)
+	}
+
+	if d.Purpose != keychain.BIP0043Purpose {
+		return fmt.Errorf("unsupported key derivation purpose: %d",
+			d.Purpose)
+	}
+
+	families := make(map[keychain.KeyFamily]struct{}, len(d.KeyRanges))
+	for _, keyRange := range d.KeyRanges {
+		if _, ok := families[keyRange.Family]; ok {
+			return fmt.Errorf("duplicate key range for family %d",
+				keyRange.Family)
+		}
+		families[keyRange.Family] = struct{}{}
+	}
+
+	for _, group := range d.Groups {
+		if group.GroupKey == nil || group.RawKey.PubKey == nil {
+			return fmt.Errorf("group is missing a key")
+		}
+	}
+
+	assetIDs := make(map[asset.ID]struct{}, len(d.Assets))
+	for _, a := range d.Assets {
+		assetID := a.Genesis.ID()
+		if _, ok := assetIDs[assetID]; ok {
+			return fmt.Errorf("duplicate asset %v", assetID)
+		}
+		assetIDs[assetID] = struct{}{}
+	}
+
+	return nil
+}
+
+// Encode encodes the descriptor to the given writer.
+func (d *AssetDescriptor) Encode(w io.Writer) error {
+	version := uint8(d.Version)
+	chainHash := [32]byte(d.ChainHash)
+
+	keyRanges, err := encodeKeyRanges(d.KeyRanges)
+	if err != nil {
+		return err
+	}
+	groups, err := encodeDescriptorGroups(d.Groups)
+	if err != nil {
+		return err
+	}
+	assets, err := encodeDescriptorAssets(d.Assets)
+	if err != nil {
+		return err
+	}
+	servers, err := encodeStrings(d.UniverseServers)
+	if err != nil {
+		return err
+	}
+
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(descriptorVersionType, &version),
+		tlv.MakePrimitiveRecord(descriptorChainHashType, &chainHash),
+		tlv.MakePrimitiveRecord(descriptorPurposeType, &d.Purpose),
+		tlv.MakePrimitiveRecord(descriptorCoinTypeType, &d.CoinType),
+		tlv.MakePrimitiveRecord(descriptorKeyRangesType, &keyRanges),
+		tlv.MakePrimitiveRecord(descriptorGroupsType, &groups),
+		tlv.MakePrimitiveRecord(descriptorAssetsType, &assets),
+		tlv.MakePrimitiveRecord(descriptorServersType, &servers),
+	)
+	if err != nil {
+		return err
+	}
+
+	return stream.Encode(w)
+}
+
+// Decode decodes a descriptor from the given reader.
+func (d *AssetDescriptor) Decode(r io.Reader) error {
+	var (
+		version   uint8
+		chainHash [32]byte
+		keyRanges []byte
+		groups    []byte
+		assets    []byte
+		servers   []byte
+	)
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(descriptorVersionType, &version),
+		tlv.MakePrimitiveRecord(descriptorChainHashType, &chainHash),
+		tlv.MakePrimitiveRecord(descriptorPurposeType, &d.Purpose),
+		tlv.MakePrimitiveRecord(descriptorCoinTypeType, &d.CoinType),
+		tlv.MakePrimitiveRecord(descriptorKeyRangesType, &keyRanges),
+		tlv.MakePrimitiveRecord(descriptorGroupsType, &groups),
+		tlv.MakePrimitiveRecord(descriptorAssetsType, &assets),
+		tlv.MakePrimitiveRecord(descriptorServersType, &servers),
+	)
+	if err != nil {
+		return err
+	}
+	if err := stream.Decode(r); err != nil {
+		return err
+	}
+
+	d.Version = DescriptorVersion(version)
+	d.ChainHash = chainHash
+
+	d.KeyRanges, err = decodeKeyRanges(keyRanges)
+	if err != nil {
+		return fmt.Errorf("unable to decode key ranges: %w", err)
+	}
+	d.Groups, err = decodeDescriptorGroups(groups)
+	if err != nil {
+		return fmt.Errorf("unable to decode groups: %w", err)
+	}
+	d.Assets, err = decodeDescriptorAssets(assets)
+	if err != nil {
+		return fmt.Errorf("unable to decode assets: %w", err)
+	}
+	d.UniverseServers, err = decodeStrings(servers)
+	if err != nil {
+		return fmt.Errorf("unable to decode universe servers: %w", err)
+	}
+
+	return nil
+}
+
+// maxDescriptorItems is the maximum number of items of a single list within a
+// descriptor we decode, to prevent memory blow ups.
+const maxDescriptorItems = 1 << 20
+
+// encodeList encodes the number of items followed by each item.
+func encodeList(numItems int, encodeItem func(w io.Writer, idx int,
+	buf *[8]byte) error) ([]byte, error) {
+
+	var (
+		b   bytes.Buffer
+		buf [8]byte
+	)
+	if err := tlv.WriteVarInt(&b, uint64(numItems), &buf); err != nil {
+		return nil, err
+	}
+	for idx := 0; idx < numItems; idx++ {
+		if err := encodeItem(&b, idx, &buf); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeList decodes the number of items followed by each item.
+func decodeList(listBytes []byte,
+	decodeItem func(r io.Reader, buf *[8]byte) error) error {
+
+	if len(listBytes) == 0 {
+		return nil
+	}
+
+	var (
+		r   = bytes.NewReader(listBytes)
+		buf [8]byte
+	)
+	numItems, err := tlv.ReadVarInt(r, &buf)
+	if err != nil {
+		return err
+	}
+	if numItems > maxDescriptorItems {
+		return fmt.Errorf("too many items: %d", numItems)
+	}
+
+	for idx := uint64(0); idx < numItems; idx++ {
+		if err := decodeItem(r, &buf); err != nil {
+			return err
+		}
+	}
+
+	if r.Len() != 0 {
+		return fmt.Errorf("%d trailing bytes", r.Len())
+	}
+
+	return nil
+}
+
+// encodeKeyLocator encodes the family and index of a key locator.
+func encodeKeyLocator(w io.Writer, loc keychain.KeyLocator,
+	buf *[8]byte) error {
+
+	if err := tlv.EUint32T(w, uint32(loc.Family), buf); err != nil {
+		return err
+	}
+
+	return tlv.EUint32T(w, loc.Index, buf)
+}
+
+// decodeKeyLocator decodes the family and index of a key locator.
+func decodeKeyLocator(r io.Reader, buf *[8]byte) (keychain.KeyLocator,
+	error) {
+
+	var family, index uint32
+	if err := tlv.DUint32(r, &family, buf, 4); err != nil {
+		return keychain.KeyLocator{}, err
+	}
+	if err := tlv.DUint32(r, &index, buf, 4); err != nil {
+		return keychain.KeyLocator{}, err
+	}
+
+	return keychain.KeyLocator{
+		Family: keychain.KeyFamily(family),
+		Index:  index,
+	}, nil
+}
+
+func encodeKeyRanges(keyRanges []KeyRange) ([]byte, error) {
+	return encodeList(len(keyRanges), func(w io.Writer, idx int,
+		buf *[8]byte) error {
+
+		return encodeKeyLocator(w, keychain.KeyLocator{
+			Family: keyRanges[idx].Family,
+			Index:  keyRanges[idx].MaxIndex,
+		}, buf)
+	})
+}
+
+func decodeKeyRanges(listBytes []byte) ([]KeyRange, error) {
+	var keyRanges []KeyRange
+	err := decodeList(listBytes, func(r io.Reader, buf *[8]byte) error {
+		loc, err := decodeKeyLocator(r, buf)
+		if err != nil {
+			return err
+		}
+
+		keyRanges = append(keyRanges, KeyRange{
+			Family:   loc.Family,
+			MaxIndex: loc.Index,
+		})
+		return nil
+	})
+
+	return keyRanges, err
+}
+
+func encodeDescriptorGroups(groups []DescriptorGroup) ([]byte, error) {
+	return encodeList(len(groups), func(w io.Writer, idx int,
+		buf *[8]byte) error {
+
+		group := groups[idx]
+		err := asset.CompressedPubKeyEncoder(w, &group.GroupKey, buf)
+		if err != nil {
+			return err
+		}
+		rawKey := group.RawKey.PubKey
+		err = asset.CompressedPubKeyEncoder(w, &rawKey, buf)
+		if err != nil {
+			return err
+		}
+
+		return encodeKeyLocator(w, group.RawKey.KeyLocator, buf)
+	})
+}
+
+func decodeDescriptorGroups(listBytes []byte) ([]DescriptorGroup, error) {
+	var groups []DescriptorGroup
+	err := decodeList(listBytes, func(r io.Reader, buf *[8]byte) error {
+		var group DescriptorGroup
+		err := asset.CompressedPubKeyDecoder(
+			r, &group.GroupKey, buf, btcec.PubKeyBytesLenCompressed,
+		)
+		if err != nil {
+			return err
+		}
+		err = asset.CompressedPubKeyDecoder(
+			r, &group.RawKey.PubKey, buf,
+			btcec.PubKeyBytesLenCompressed,
+		)
+		if err != nil {
+			return err
+		}
+		group.RawKey.KeyLocator, err = decodeKeyLocator(r, buf)
+		if err != nil {
+			return err
+		}
+
+		groups = append(groups, group)
+		return nil
+	})
+
+	return groups, err
+}
+
+func encodeDescriptorAssets(assets []DescriptorAsset) ([]byte, error) {
+	return encodeList(len(assets), func(w io.Writer, idx int,
+		buf *[8]byte) error {
+
+		a := assets[idx]
+		if err := asset.GenesisEncoder(w, &a.Genesis, buf); err != nil {
+			return err
+		}
+
+		// The group key is optional, so we prefix it with a flag.
+		var hasGroupKey uint8
+		if a.GroupKey != nil {
+			hasGroupKey = 1
+		}
+		if err := tlv.EUint8T(w, hasGroupKey, buf); err != nil {
+			return err
+		}
+		if hasGroupKey == 0 {
+			return nil
+		}
+
+		return asset.CompressedPubKeyEncoder(w, &a.GroupKey, buf)
+	})
+}
+
+func decodeDescriptorAssets(listBytes []byte) ([]DescriptorAsset, error) {
+	var assets []DescriptorAsset
+	err := decodeList(listBytes, func(r io.Reader, buf *[8]byte) error {
+		var a DescriptorAsset
+		err := asset.GenesisDecoder(r, &a.Genesis, buf, 0)
+		if err != nil {
+			return err
+		}
+
+		var hasGroupKey uint8
+		if err := tlv.DUint8(r, &hasGroupKey, buf, 1); err != nil {
+			return err
+		}
+		if hasGroupKey != 0 {
+			err = asset.CompressedPubKeyDecoder(
+				r, &a.GroupKey, buf,
+				btcec.PubKeyBytesLenCompressed,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		assets = append(assets, a)
+		return nil
+	})
+
+	return assets, err
+}
+
+func encodeStrings(strs []string) ([]byte, error) {
+	return encodeList(len(strs), func(w io.Writer, idx int,
+		buf *[8]byte) error {
+
+		strBytes := []byte(strs[idx])
+		return asset.VarBytesEncoder(w, &strBytes, buf)
+	})
+}
+
+func decodeStrings(listBytes []byte) ([]string, error) {
+	var strs []string
+	err := decodeList(listBytes, func(r io.Reader, buf *[8]byte) error {
+		var strBytes []byte
+		err := asset.VarBytesDecoder(r, &strBytes, buf, 0)
+		if err != nil {
+			return err
+		}
+
+		strs = append(strs, string(strBytes))
+		return nil
+	})
+
+	return strs, err
+}
+
+// RestoreDescriptorKeys makes sure the keys of the descriptor can be derived
+// by the given key ring, which means the key ring is backed by the same seed
+// as the wallet the descriptor was created for. It then advances each key
+// family of the key ring past the range of the descriptor, so keys that are
+// already in use aren't handed out again.
+func RestoreDescriptorKeys(ctx context.Context, keyRing KeyRing,
+	desc *AssetDescriptor) error {
+
+	for _, group := range desc.Groups {
+		derivedKey, err := keyRing.DeriveKey(
+			ctx, group.RawKey.KeyLocator,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to derive raw group key: %w",
+				err)
+		}
+
+		if !derivedKey.PubKey.IsEqual(group.RawKey.PubKey) {
+			return fmt.Errorf("raw key of group %x can't be "+
+				"derived, descriptor of different seed?",
+				group.GroupKey.SerializeCompressed())
+		}
+	}
+
+	for _, keyRange := range desc.KeyRanges {
+		for {
+			keyDesc, err := keyRing.DeriveNextKey(
+				ctx, keyRange.Family,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to derive key of "+
+					"family %d: %w", keyRange.Family, err)
+			}
+
+			if keyDesc.Index >= keyRange.MaxIndex {
+				break
+			}
+		}
+	}
+
+	return nil
+}
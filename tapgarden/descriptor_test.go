@@ -0,0 +1,150 @@
+package tapgarden
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// seedKeyRing is a KeyRing that deterministically derives keys from a seed
+// and keeps track of the next index of each key family.
+type seedKeyRing struct {
+	seed []byte
+
+	nextIndexes map[keychain.KeyFamily]uint32
+}
+
+func newSeedKeyRing(seed []byte) *seedKeyRing {
+	return &seedKeyRing{
+		seed:        seed,
+		nextIndexes: make(map[keychain.KeyFamily]uint32),
+	}
+}
+
+func (s *seedKeyRing) DeriveNextKey(ctx context.Context,
+	keyFam keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	index := s.nextIndexes[keyFam]
+	s.nextIndexes[keyFam]++
+
+	return s.DeriveKey(ctx, keychain.KeyLocator{
+		Family: keyFam,
+		Index:  index,
+	})
+}
+
+func (s *seedKeyRing) DeriveKey(_ context.Context,
+	loc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	h := sha256.New()
+	_, _ = h.Write(s.seed)
+	_ = binary.Write(h, binary.BigEndian, uint32(loc.Family))
+	_ = binary.Write(h, binary.BigEndian, loc.Index)
+
+	privKey, _ := btcec.PrivKeyFromBytes(h.Sum(nil))
+	return keychain.KeyDescriptor{
+		PubKey:     privKey.PubKey(),
+		KeyLocator: loc,
+	}, nil
+}
+
+func (s *seedKeyRing) IsLocalKey(context.Context,
+	keychain.KeyDescriptor) bool {
+
+	return true
+}
+
+// TestAssetDescriptor tests the creation, encoding and restoration of asset
+// descriptors.
+func TestAssetDescriptor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	keyRing := newSeedKeyRing(test.RandBytes(32))
+	family := keychain.KeyFamily(asset.TaprootAssetsKeyFamily)
+
+	// We create a grouped asset with a raw group key derived from our
+	// seed, a second asset of the same group, and an ungrouped asset with
+	// a script key derived from our seed.
+	var keys []keychain.KeyDescriptor
+	for i := 0; i < 4; i++ {
+		keyDesc, err := keyRing.DeriveNextKey(ctx, family)
+		require.NoError(t, err)
+		keys = append(keys, keyDesc)
+	}
+
+	groupedAsset := asset.RandAsset(t, asset.Normal)
+	groupedAsset.GroupKey.RawKey = keys[1]
+	otherGroupedAsset := asset.RandAsset(t, asset.Normal)
+	otherGroupedAsset.GroupKey = groupedAsset.GroupKey
+
+	ungroupedAsset := asset.RandAsset(t, asset.Collectible)
+	ungroupedAsset.GroupKey = nil
+	ungroupedAsset.ScriptKey = asset.NewScriptKeyBip86(keys[2])
+
+	// An asset of a group we can't issue into doesn't add a group.
+	foreignAsset := asset.RandAsset(t, asset.Normal)
+
+	// The last key was only recorded in the key derivation audit log.
+	derivations := []*KeyDerivation{{
+		Purpose: KeyPurposeAnchorInternalKey,
+		Key:     keys[3],
+	}}
+
+	params := &chaincfg.RegressionNetParams
+	desc := NewAssetDescriptor(params, []*asset.Asset{
+		groupedAsset, otherGroupedAsset, ungroupedAsset, foreignAsset,
+		groupedAsset,
+	}, derivations, []string{"universe.example.com:10029"})
+	require.NoError(t, desc.Validate())
+
+	require.Equal(t, *params.GenesisHash, desc.ChainHash)
+	require.Equal(t, params.HDCoinType, desc.CoinType)
+	require.Equal(t, []KeyRange{{
+		Family:   family,
+		MaxIndex: keys[3].Index,
+	}}, desc.KeyRanges)
+	require.Len(t, desc.Groups, 1)
+	require.True(t, desc.Groups[0].GroupKey.IsEqual(
+		&groupedAsset.GroupKey.GroupPubKey,
+	))
+	require.Len(t, desc.Assets, 4)
+	require.Nil(t, desc.Assets[2].GroupKey)
+
+	// The descriptor survives an encoding round trip.
+	var b bytes.Buffer
+	require.NoError(t, desc.Encode(&b))
+
+	var decoded AssetDescriptor
+	require.NoError(t, decoded.Decode(bytes.NewReader(b.Bytes())))
+	require.NoError(t, decoded.Validate())
+	require.Equal(t, desc, &decoded)
+
+	// A descriptor with duplicate assets is invalid.
+	decoded.Assets = append(decoded.Assets, decoded.Assets[0])
+	require.ErrorContains(t, decoded.Validate(), "duplicate asset")
+
+	// A key ring of a different seed can't restore the descriptor.
+	err := RestoreDescriptorKeys(
+		ctx, newSeedKeyRing(test.RandBytes(32)), desc,
+	)
+	require.ErrorContains(t, err, "descriptor of different seed")
+
+	// A key ring restored from the same seed advances past the keys that
+	// are already in use.
+	restoredKeyRing := newSeedKeyRing(keyRing.seed)
+	require.NoError(t, RestoreDescriptorKeys(ctx, restoredKeyRing, desc))
+
+	nextKey, err := restoredKeyRing.DeriveNextKey(ctx, family)
+	require.NoError(t, err)
+	require.Equal(t, keys[3].Index+1, nextKey.Index)
+}
@@ -250,6 +250,13 @@ type ChainBridge interface {
 		reOrgChan chan struct{}) (*chainntnfs.ConfirmationEvent,
 		chan error, error)
 
+	// RegisterSpendNtfn registers an intent to be notified once the given
+	// outpoint is spent by a confirmed transaction. The pkScript is the
+	// script of the spent output.
+	RegisterSpendNtfn(ctx context.Context, outpoint *wire.OutPoint,
+		pkScript []byte, heightHint uint32) (*chainntnfs.SpendEvent,
+		chan error, error)
+
 	// RegisterBlockEpochNtfn registers an intent to be notified of each
 	// new block connected to the main chain.
 	RegisterBlockEpochNtfn(ctx context.Context) (chan int32, chan error,
@@ -278,6 +285,11 @@ type ChainBridge interface {
 	// EstimateFee returns a fee estimate for the confirmation target.
 	EstimateFee(ctx context.Context,
 		confTarget uint32) (chainfee.SatPerKWeight, error)
+
+	// MempoolInfo returns the current state of the mempool of the chain
+	// backend, such as its size and fee histogram. If the backend doesn't
+	// expose its mempool, ErrMempoolInfoUnavailable is returned.
+	MempoolInfo(ctx context.Context) (*MempoolInfo, error)
 }
 
 // FundedPsbt represents a fully funded PSBT transaction.
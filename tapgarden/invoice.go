@@ -0,0 +1,471 @@
+package tapgarden
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// InvoiceVersion is the version of the invoice encoding.
+type InvoiceVersion uint8
+
+const (
+	// InvoiceV0 is the initial version of invoices.
+	InvoiceV0 InvoiceVersion = 0
+)
+
+const (
+	invoiceVersionType   tlv.Type = 0
+	invoiceIDType        tlv.Type = 2
+	invoiceAddrType      tlv.Type = 4
+	invoiceAmountType    tlv.Type = 6
+	invoiceCreatedAtType tlv.Type = 8
+	invoiceExpiryType    tlv.Type = 10
+	invoiceMemoType      tlv.Type = 12
+	invoiceNodeKeyType   tlv.Type = 14
+	invoiceSignatureType tlv.Type = 16
+)
+
+const (
+	// InvoiceHRP is the human-readable part of bech32m encoded invoices.
+	// The network of an invoice is given by the address it contains.
+	InvoiceHRP = "tapinv"
+
+	// DefaultInvoiceExpiry is the expiry used for invoices that are
+	// created without one.
+	DefaultInvoiceExpiry = time.Hour
+
+	// MaxInvoiceMemoLen is the maximum length of an invoice memo in bytes.
+	MaxInvoiceMemoLen = 639
+)
+
+var (
+	// ErrInvoiceNotFound is returned when an invoice isn't known.
+	ErrInvoiceNotFound = errors.New("invoice not found")
+
+	// invoiceTag is prepended to the serialized invoice before signing
+	// it, so an invoice signature can never be mistaken for a signature
+	// over anything else signed with the node's identity key.
+	invoiceTag = []byte("taproot-assets/invoice")
+)
+
+// InvoiceID is the random identifier of an invoice. Like the payment hash of
+// a lightning invoice, it lets both parties refer to a payment request.
+type InvoiceID [32]byte
+
+// String returns the hex encoding of the invoice ID.
+func (i InvoiceID) String() string {
+	return hex.EncodeToString(i[:])
+}
+
+// InvoiceState is the settlement state of an invoice.
+type InvoiceState uint8
+
+const (
+	// InvoiceStateOpen is the state of an invoice that wasn't paid yet
+	// and hasn't expired.
+	InvoiceStateOpen InvoiceState = 0
+
+	// InvoiceStateSettled is the state of an invoice whose payment was
+	// received.
+	InvoiceStateSettled InvoiceState = 1
+
+	// InvoiceStateExpired is the state of an invoice that expired before
+	// its payment was received.
+	InvoiceStateExpired InvoiceState = 2
+)
+
+// String returns a human-readable version of the invoice state.
+func (s InvoiceState) String() string {
+	switch s {
+	case InvoiceStateOpen:
+		return "open"
+
+	case InvoiceStateSettled:
+		return "settled"
+
+	case InvoiceStateExpired:
+		return "expired"
+
+	default:
+		return fmt.Sprintf("unknown <%d>", s)
+	}
+}
+
+// Invoice is a request for payment signed by the identity key of the node
+// that created it. It asks for the given amount of an asset to be sent to a
+// Taproot Asset address of the node before the invoice expires.
+type Invoice struct {
+	// Version is the version of the invoice encoding.
+	Version InvoiceVersion
+
+	// ID is the random identifier of the invoice.
+	ID InvoiceID
+
+	// Addr is the bech32m encoded Taproot Asset address the payment
+	// should be sent to.
+	Addr string
+
+	// Amount is the amount of the asset that is requested. It always
+	// matches the amount of the address.
+	Amount uint64
+
+	// CreatedAt is the time the invoice was created.
+	CreatedAt time.Time
+
+	// Expiry is the duration after its creation for which the invoice can
+	// be paid.
+	Expiry time.Duration
+
+	// Memo is a free-form description of the payment.
+	Memo string
+
+	// NodeKey is the identity key of the node that created the invoice.
+	NodeKey *btcec.PublicKey
+
+	// Signature is the DER encoded ECDSA signature of the node key over
+	// the sha256 hash of the invoice message.
+	Signature []byte
+}
+
+// NewInvoice creates an unsigned invoice that requests a payment to the given
+// address. If the expiry is zero, the default expiry is used.
+func NewInvoice(addr *address.Tap, memo string, expiry time.Duration,
+	nodeKey *btcec.PublicKey, now time.Time) (*Invoice, error) {
+
+	switch {
+	case addr.Amount == 0:
+		return nil, fmt.Errorf("invoice amount must be positive")
+
+	case len(memo) > MaxInvoiceMemoLen:
+		return nil, fmt.Errorf("invoice memo too long: %d bytes, "+
+			"max %d", len(memo), MaxInvoiceMemoLen)
+
+	case expiry < 0:
+		return nil, fmt.Errorf("invoice expiry must not be negative")
+
+	case expiry == 0:
+		expiry = DefaultInvoiceExpiry
+	}
+
+	encodedAddr, err := addr.EncodeAddress()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode address: %w", err)
+	}
+
+	var id InvoiceID
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, fmt.Errorf("unable to create invoice ID: %w", err)
+	}
+
+	return &Invoice{
+		Version: InvoiceV0,
+		ID:      id,
+		Addr:    encodedAddr,
+		Amount:  addr.Amount,
+		// The creation time is encoded with second precision, so we
+		// drop the rest right away.
+		CreatedAt: now.Truncate(time.Second).UTC(),
+		Expiry:    expiry.Truncate(time.Second),
+		Memo:      memo,
+		NodeKey:   nodeKey,
+	}, nil
+}
+
+// ExpiresAt returns the time after which the invoice can no longer be paid.
+func (i *Invoice) ExpiresAt() time.Time {
+	return i.CreatedAt.Add(i.Expiry)
+}
+
+// IsExpired returns true if the invoice is expired at the given time.
+func (i *Invoice) IsExpired(now time.Time) bool {
+	return !now.Before(i.ExpiresAt())
+}
+
+// Address decodes the address of the invoice for the given network and makes
+// sure it requests the amount of the invoice.
+func (i *Invoice) Address(net *address.ChainParams) (*address.Tap, error) {
+	addr, err := address.DecodeAddress(i.Addr, net)
+	if err != nil {
+		return nil, fmt.Errorf("invalid invoice address: %w", err)
+	}
+
+	if addr.Amount != i.Amount {
+		return nil, fmt.Errorf("invoice amount %d doesn't match "+
+			"address amount %d", i.Amount, addr.Amount)
+	}
+
+	return addr, nil
+}
+
+// unsignedRecords returns the TLV records of all fields that are covered by
+// the signature. The returned records point to copies of the variable length
+// and time fields, so they can only be used for encoding.
+func (i *Invoice) unsignedRecords() []tlv.Record {
+	version := uint8(i.Version)
+	id := [32]byte(i.ID)
+	addr := []byte(i.Addr)
+	createdAt := uint64(i.CreatedAt.Unix())
+	expiry := uint64(i.Expiry / time.Second)
+	memo := []byte(i.Memo)
+
+	return []tlv.Record{
+		tlv.MakePrimitiveRecord(invoiceVersionType, &version),
+		tlv.MakePrimitiveRecord(invoiceIDType, &id),
+		tlv.MakePrimitiveRecord(invoiceAddrType, &addr),
+		tlv.MakePrimitiveRecord(invoiceAmountType, &i.Amount),
+		tlv.MakePrimitiveRecord(invoiceCreatedAtType, &createdAt),
+		tlv.MakePrimitiveRecord(invoiceExpiryType, &expiry),
+		tlv.MakePrimitiveRecord(invoiceMemoType, &memo),
+		tlv.MakePrimitiveRecord(invoiceNodeKeyType, &i.NodeKey),
+	}
+}
+
+// Message returns the message that is signed by the node key.
+func (i *Invoice) Message() ([]byte, error) {
+	if i.NodeKey == nil {
+		return nil, fmt.Errorf("invoice is missing the node key")
+	}
+
+	stream, err := tlv.NewStream(i.unsignedRecords()...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	b.Write(invoiceTag)
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// Encode encodes the signed invoice to the given writer.
+func (i *Invoice) Encode(w io.Writer) error {
+	if i.NodeKey == nil {
+		return fmt.Errorf("invoice is missing the node key")
+	}
+
+	records := append(
+		i.unsignedRecords(),
+		tlv.MakePrimitiveRecord(invoiceSignatureType, &i.Signature),
+	)
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+
+	return stream.Encode(w)
+}
+
+// Decode decodes a signed invoice from the given reader.
+func (i *Invoice) Decode(r io.Reader) error {
+	var (
+		version   uint8
+		id        [32]byte
+		addr      []byte
+		createdAt uint64
+		expiry    uint64
+		memo      []byte
+	)
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(invoiceVersionType, &version),
+		tlv.MakePrimitiveRecord(invoiceIDType, &id),
+		tlv.MakePrimitiveRecord(invoiceAddrType, &addr),
+		tlv.MakePrimitiveRecord(invoiceAmountType, &i.Amount),
+		tlv.MakePrimitiveRecord(invoiceCreatedAtType, &createdAt),
+		tlv.MakePrimitiveRecord(invoiceExpiryType, &expiry),
+		tlv.MakePrimitiveRecord(invoiceMemoType, &memo),
+		tlv.MakePrimitiveRecord(invoiceNodeKeyType, &i.NodeKey),
+		tlv.MakePrimitiveRecord(invoiceSignatureType, &i.Signature),
+	)
+	if err != nil {
+		return err
+	}
+	if err := stream.Decode(r); err != nil {
+		return err
+	}
+
+	i.Version = InvoiceVersion(version)
+	i.ID = id
+	i.Addr = string(addr)
+	i.CreatedAt = time.Unix(int64(createdAt), 0).UTC()
+	i.Expiry = time.Duration(expiry) * time.Second
+	i.Memo = string(memo)
+
+	return nil
+}
+
+// EncodeString returns the bech32m string encoding of the signed invoice.
+func (i *Invoice) EncodeString() (string, error) {
+	var b bytes.Buffer
+	if err := i.Encode(&b); err != nil {
+		return "", err
+	}
+
+	converted, err := bech32.ConvertBits(b.Bytes(), 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	return bech32.EncodeM(InvoiceHRP, converted)
+}
+
+// DecodeInvoiceString parses a bech32m encoded invoice. The signature of the
+// invoice isn't checked, this must be done with VerifyInvoice.
+func DecodeInvoiceString(invoice string) (*Invoice, error) {
+	hrp, data, err := bech32.DecodeNoLimit(invoice)
+	if err != nil {
+		return nil, fmt.Errorf("invalid invoice encoding: %w", err)
+	}
+	if hrp != InvoiceHRP {
+		return nil, fmt.Errorf("invalid invoice prefix %q", hrp)
+	}
+
+	converted, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return nil, fmt.Errorf("invalid invoice encoding: %w", err)
+	}
+
+	var decoded Invoice
+	if err := decoded.Decode(bytes.NewReader(converted)); err != nil {
+		return nil, fmt.Errorf("unable to decode invoice: %w", err)
+	}
+
+	return &decoded, nil
+}
+
+// SignInvoice signs the invoice with the identity key of the lnd node behind
+// the given signer. The invoice's node key must be the node's identity key.
+func SignInvoice(ctx context.Context, signer MessageSigner,
+	invoice *Invoice) error {
+
+	msg, err := invoice.Message()
+	if err != nil {
+		return err
+	}
+
+	sig, err := signer.SignMessage(ctx, msg, proof.NodeKeyLocator)
+	if err != nil {
+		return fmt.Errorf("unable to sign invoice: %w", err)
+	}
+
+	invoice.Signature = sig
+
+	// We make sure the signature actually verifies, otherwise nobody
+	// would accept the invoice.
+	if err := verifyInvoiceSig(invoice, msg); err != nil {
+		return fmt.Errorf("signed invoice invalid, node key mismatch? "+
+			"%w", err)
+	}
+
+	return nil
+}
+
+// VerifyInvoice checks that the invoice was signed by the node key it
+// contains and that it requests a payment to a valid address of the given
+// network. Whether the node key belongs to the expected party and whether the
+// invoice is expired must be checked separately.
+func VerifyInvoice(invoice *Invoice, net *address.ChainParams) error {
+	if invoice.Version != InvoiceV0 {
+		return fmt.Errorf("unknown invoice version: %d",
+			invoice.Version)
+	}
+
+	if len(invoice.Memo) > MaxInvoiceMemoLen {
+		return fmt.Errorf("invoice memo too long: %d bytes, max %d",
+			len(invoice.Memo), MaxInvoiceMemoLen)
+	}
+
+	if _, err := invoice.Address(net); err != nil {
+		return err
+	}
+
+	msg, err := invoice.Message()
+	if err != nil {
+		return err
+	}
+
+	return verifyInvoiceSig(invoice, msg)
+}
+
+// verifyInvoiceSig checks the signature of the invoice over the given message.
+func verifyInvoiceSig(invoice *Invoice, msg []byte) error {
+	sig, err := ecdsa.ParseDERSignature(invoice.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid invoice signature: %w", err)
+	}
+
+	digest := sha256.Sum256(msg)
+	if !sig.Verify(digest[:], invoice.NodeKey) {
+		return fmt.Errorf("invoice signature doesn't match node key")
+	}
+
+	return nil
+}
+
+// StoredInvoice is an invoice created by the local node, together with its
+// settlement details.
+type StoredInvoice struct {
+	*Invoice
+
+	// SettledAt is the time the payment of the invoice was received. It
+	// is the zero time if the invoice wasn't settled yet.
+	SettledAt time.Time
+
+	// SettleOutpoint is the outpoint the payment of the invoice was
+	// received in. It is only set if the invoice was settled.
+	SettleOutpoint wire.OutPoint
+}
+
+// State returns the state of the invoice at the given time.
+func (s *StoredInvoice) State(now time.Time) InvoiceState {
+	switch {
+	case !s.SettledAt.IsZero():
+		return InvoiceStateSettled
+
+	case s.IsExpired(now):
+		return InvoiceStateExpired
+
+	default:
+		return InvoiceStateOpen
+	}
+}
+
+// InvoiceStore is the storage backend for the invoices created by the local
+// node.
+type InvoiceStore interface {
+	// AddInvoice stores a new signed invoice for the local address with
+	// the given taproot output key.
+	AddInvoice(ctx context.Context, invoice *Invoice,
+		taprootOutputKey *btcec.PublicKey) error
+
+	// FetchInvoice returns the invoice with the given ID or
+	// ErrInvoiceNotFound if it isn't known.
+	FetchInvoice(ctx context.Context, id InvoiceID) (*StoredInvoice,
+		error)
+
+	// ListInvoices returns all invoices, ordered by their creation time.
+	ListInvoices(ctx context.Context) ([]*StoredInvoice, error)
+
+	// SettleInvoice marks the unsettled invoice of the address with the
+	// given taproot output key as settled by the given outpoint. It
+	// returns false if there is no such invoice.
+	SettleInvoice(ctx context.Context, taprootOutputKey *btcec.PublicKey,
+		outpoint wire.OutPoint, settledAt time.Time) (bool, error)
+}
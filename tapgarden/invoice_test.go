@@ -0,0 +1,96 @@
+package tapgarden
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestInvoice tests that invoices can be signed, encoded, decoded and
+// verified, that tampering is detected and that their state is derived
+// correctly.
+func TestInvoice(t *testing.T) {
+	t.Parallel()
+
+	net := &address.RegressionNetTap
+	addr, _, _ := address.RandAddr(t, net)
+
+	nodePriv := test.RandPrivKey(t)
+	signer := &mockMessageSigner{privKey: nodePriv}
+
+	now := time.Now()
+	invoice, err := NewInvoice(
+		addr.Tap, "coffee", 0, nodePriv.PubKey(), now,
+	)
+	require.NoError(t, err)
+	require.Equal(t, addr.Amount, invoice.Amount)
+	require.Equal(t, DefaultInvoiceExpiry, invoice.Expiry)
+
+	ctx := context.Background()
+	require.NoError(t, SignInvoice(ctx, signer, invoice))
+	require.NoError(t, VerifyInvoice(invoice, net))
+
+	// The invoice survives a string encoding round trip and still
+	// verifies.
+	encoded, err := invoice.EncodeString()
+	require.NoError(t, err)
+
+	decoded, err := DecodeInvoiceString(encoded)
+	require.NoError(t, err)
+	require.Equal(t, invoice, decoded)
+	require.NoError(t, VerifyInvoice(decoded, net))
+
+	decodedAddr, err := decoded.Address(net)
+	require.NoError(t, err)
+	require.Equal(t, addr.ScriptKey, decodedAddr.ScriptKey)
+
+	// The invoice is only valid for the network of its address.
+	require.Error(t, VerifyInvoice(decoded, &address.MainNetTap))
+
+	// Changing any of the signed fields invalidates the signature.
+	tampered := *decoded
+	tampered.Memo = "tea"
+	require.Error(t, VerifyInvoice(&tampered, net))
+
+	tampered = *decoded
+	tampered.Expiry *= 2
+	require.Error(t, VerifyInvoice(&tampered, net))
+
+	// The amount must always match the one of the address.
+	tampered = *decoded
+	tampered.Amount++
+	require.ErrorContains(
+		t, VerifyInvoice(&tampered, net), "doesn't match address",
+	)
+
+	// A signer with a different key than the claimed node key can't
+	// produce a valid invoice.
+	otherSigner := &mockMessageSigner{privKey: test.RandPrivKey(t)}
+	require.Error(t, SignInvoice(ctx, otherSigner, invoice))
+
+	// Strings that aren't invoices are rejected.
+	_, err = DecodeInvoiceString(addr.Tap.String())
+	require.Error(t, err)
+
+	// The state of a stored invoice moves from open to expired, unless it
+	// is settled.
+	stored := &StoredInvoice{Invoice: decoded}
+	require.Equal(t, InvoiceStateOpen, stored.State(now))
+	require.Equal(
+		t, InvoiceStateExpired, stored.State(decoded.ExpiresAt()),
+	)
+
+	stored.SettledAt = now
+	require.Equal(
+		t, InvoiceStateSettled, stored.State(decoded.ExpiresAt()),
+	)
+
+	// Memos can't be longer than the maximum.
+	longMemo := string(make([]byte, MaxInvoiceMemoLen+1))
+	_, err = NewInvoice(addr.Tap, longMemo, 0, nodePriv.PubKey(), now)
+	require.ErrorContains(t, err, "memo too long")
+}
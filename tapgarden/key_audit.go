@@ -0,0 +1,128 @@
+package tapgarden
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// KeyPurpose describes what a key derived from the KeyRing is used for.
+type KeyPurpose uint8
+
+const (
+	// KeyPurposeBatchInternalKey is the internal key of the anchor output
+	// of a minting batch, which also serves as the batch key.
+	KeyPurposeBatchInternalKey KeyPurpose = 0
+
+	// KeyPurposeMintScriptKey is the script key of a newly minted asset.
+	KeyPurposeMintScriptKey KeyPurpose = 1
+
+	// KeyPurposeGroupKey is the raw group key of a newly minted asset that
+	// permits ongoing emission.
+	KeyPurposeGroupKey KeyPurpose = 2
+
+	// KeyPurposeChangeScriptKey is the script key of the change output of
+	// an outbound transfer.
+	KeyPurposeChangeScriptKey KeyPurpose = 3
+
+	// KeyPurposeAnchorInternalKey is the internal key of a local anchor
+	// output of an outbound transfer.
+	KeyPurposeAnchorInternalKey KeyPurpose = 4
+)
+
+// String returns a human-readable description of the key purpose.
+func (k KeyPurpose) String() string {
+	switch k {
+	case KeyPurposeBatchInternalKey:
+		return "batch_internal_key"
+
+	case KeyPurposeMintScriptKey:
+		return "mint_script_key"
+
+	case KeyPurposeGroupKey:
+		return "group_key"
+
+	case KeyPurposeChangeScriptKey:
+		return "change_script_key"
+
+	case KeyPurposeAnchorInternalKey:
+		return "anchor_internal_key"
+
+	default:
+		return fmt.Sprintf("<unknown(%d)>", k)
+	}
+}
+
+// KeyDerivation is an entry of the key derivation audit log. It records that
+// a key was derived from the KeyRing, and what it was used for.
+type KeyDerivation struct {
+	// Purpose is what the derived key is used for.
+	Purpose KeyPurpose
+
+	// Key is the derived key, including its family and index.
+	Key keychain.KeyDescriptor
+
+	// Object is a description of the object the key was derived for, for
+	// example the ID of the minted asset or the asset and anchor output of
+	// an outbound transfer.
+	Object string
+
+	// DerivedAt is the time the key was derived.
+	DerivedAt time.Time
+}
+
+// KeyDerivationFilter is used to filter the entries of the key derivation
+// audit log. Unset fields match any entry.
+type KeyDerivationFilter struct {
+	// Purpose, if set, only matches derivations with the given purpose.
+	Purpose *KeyPurpose
+
+	// RawKey, if set, only matches derivations of the given raw key.
+	RawKey []byte
+}
+
+// KeyAuditLog is a persistent log of the keys derived from the KeyRing, that
+// allows security reviews to map keys to the on-chain artifacts they were used
+// for.
+type KeyAuditLog interface {
+	// LogKeyDerivation adds the given derivation to the audit log.
+	LogKeyDerivation(ctx context.Context, derivation *KeyDerivation) error
+
+	// QueryKeyDerivations returns all derivations in the audit log that
+	// match the given filter, ordered by the time they were derived at.
+	QueryKeyDerivations(ctx context.Context,
+		filter KeyDerivationFilter) ([]*KeyDerivation, error)
+}
+
+// DeriveAuditedKey derives the next key of the given key family and records the
+// derivation in the given audit log, if one is set. If the derivation can't be
+// recorded, no key is returned, so keys that aren't accounted for in the log
+// are never used.
+func DeriveAuditedKey(ctx context.Context, keyRing KeyRing,
+	auditLog KeyAuditLog, family keychain.KeyFamily, purpose KeyPurpose,
+	object string) (keychain.KeyDescriptor, error) {
+
+	keyDesc, err := keyRing.DeriveNextKey(ctx, family)
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	if auditLog == nil {
+		return keyDesc, nil
+	}
+
+	err = auditLog.LogKeyDerivation(ctx, &KeyDerivation{
+		Purpose:   purpose,
+		Key:       keyDesc,
+		Object:    object,
+		DerivedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return keychain.KeyDescriptor{}, fmt.Errorf("unable to log "+
+			"%v derivation: %w", purpose, err)
+	}
+
+	return keyDesc, nil
+}
@@ -0,0 +1,79 @@
+package tapgarden
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/stretchr/testify/require"
+)
+
+// mockKeyAuditLog is an in-memory KeyAuditLog that can be made to fail.
+type mockKeyAuditLog struct {
+	derivations []*KeyDerivation
+
+	err error
+}
+
+func (m *mockKeyAuditLog) LogKeyDerivation(_ context.Context,
+	derivation *KeyDerivation) error {
+
+	if m.err != nil {
+		return m.err
+	}
+
+	m.derivations = append(m.derivations, derivation)
+	return nil
+}
+
+func (m *mockKeyAuditLog) QueryKeyDerivations(context.Context,
+	KeyDerivationFilter) ([]*KeyDerivation, error) {
+
+	return m.derivations, nil
+}
+
+// TestDeriveAuditedKey tests that derived keys are recorded in the audit log
+// and that keys that can't be recorded aren't handed out.
+func TestDeriveAuditedKey(t *testing.T) {
+	t.Parallel()
+
+	keyRing := NewMockKeyRing()
+	go func() {
+		for range keyRing.ReqKeys {
+		}
+	}()
+	defer close(keyRing.ReqKeys)
+
+	ctx := context.Background()
+	auditLog := &mockKeyAuditLog{}
+
+	keyDesc, err := DeriveAuditedKey(
+		ctx, keyRing, auditLog, asset.TaprootAssetsKeyFamily,
+		KeyPurposeMintScriptKey, "asset foo",
+	)
+	require.NoError(t, err)
+	require.Len(t, auditLog.derivations, 1)
+
+	derivation := auditLog.derivations[0]
+	require.Equal(t, KeyPurposeMintScriptKey, derivation.Purpose)
+	require.Equal(t, keyDesc, derivation.Key)
+	require.Equal(t, "asset foo", derivation.Object)
+	require.False(t, derivation.DerivedAt.IsZero())
+
+	// Without an audit log, keys are derived as usual.
+	_, err = DeriveAuditedKey(
+		ctx, keyRing, nil, asset.TaprootAssetsKeyFamily,
+		KeyPurposeGroupKey, "asset foo",
+	)
+	require.NoError(t, err)
+
+	// If the derivation can't be logged, no key is returned.
+	auditLog.err = fmt.Errorf("disk full")
+	_, err = DeriveAuditedKey(
+		ctx, keyRing, auditLog, asset.TaprootAssetsKeyFamily,
+		KeyPurposeBatchInternalKey, "minting batch",
+	)
+	require.ErrorContains(t, err, "disk full")
+	require.Len(t, auditLog.derivations, 1)
+}
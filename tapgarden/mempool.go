@@ -0,0 +1,101 @@
+package tapgarden
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// BlockVSize is the maximum virtual size of a block, which is the amount of
+// mempool transactions that can be confirmed with the next block.
+const BlockVSize = 1_000_000
+
+// ErrMempoolInfoUnavailable is returned by a chain bridge that has no access to
+// the mempool of its chain backend.
+var ErrMempoolInfoUnavailable = errors.New("mempool info unavailable")
+
+// FeeHistogramBucket is a single bucket of a mempool fee histogram.
+type FeeHistogramBucket struct {
+	// FeeRate is the lowest fee rate of the transactions in the bucket.
+	// The highest fee rate is the fee rate of the previous bucket.
+	FeeRate chainfee.SatPerKWeight
+
+	// VSize is the total virtual size of the transactions in the bucket.
+	VSize int64
+}
+
+// MempoolInfo is the state of the mempool of a chain backend.
+type MempoolInfo struct {
+	// NumTxns is the number of transactions in the mempool.
+	NumTxns int64
+
+	// VSize is the total virtual size of the transactions in the mempool.
+	VSize int64
+
+	// MinRelayFee is the lowest fee rate of transactions the backend
+	// relays. Zero if unknown.
+	MinRelayFee chainfee.SatPerKWeight
+
+	// MempoolMinFee is the lowest fee rate of transactions the backend
+	// currently accepts into its mempool, which is above the minimum relay
+	// fee while the mempool is full. Zero if unknown.
+	MempoolMinFee chainfee.SatPerKWeight
+
+	// FeeHistogram is the fee histogram of the mempool, sorted by
+	// descending fee rate. Empty if unknown.
+	FeeHistogram []FeeHistogramBucket
+}
+
+// MinAcceptedFee returns the lowest fee rate a transaction needs to pay to be
+// accepted into the mempool, or zero if unknown.
+func (m *MempoolInfo) MinAcceptedFee() chainfee.SatPerKWeight {
+	if m.MempoolMinFee > m.MinRelayFee {
+		return m.MempoolMinFee
+	}
+
+	return m.MinRelayFee
+}
+
+// VSizeAbove returns the total virtual size of the mempool transactions that
+// pay a higher fee rate than the given one, according to the fee histogram.
+// The bucket that contains the fee rate is counted in full, so the size is
+// never underestimated.
+func (m *MempoolInfo) VSizeAbove(feeRate chainfee.SatPerKWeight) int64 {
+	var vSize int64
+	for idx, bucket := range m.FeeHistogram {
+		if idx > 0 && m.FeeHistogram[idx-1].FeeRate <= feeRate {
+			break
+		}
+
+		vSize += bucket.VSize
+	}
+
+	return vSize
+}
+
+// NextBlockFeeRate returns the lowest fee rate of the mempool transactions that
+// fit into the next block, according to the fee histogram. False is returned
+// if the fee histogram is unknown. If the whole mempool fits into the next
+// block, the minimum accepted fee rate is returned.
+func (m *MempoolInfo) NextBlockFeeRate() (chainfee.SatPerKWeight, bool) {
+	if len(m.FeeHistogram) == 0 {
+		return 0, false
+	}
+
+	var vSize int64
+	for _, bucket := range m.FeeHistogram {
+		vSize += bucket.VSize
+		if vSize >= BlockVSize {
+			return bucket.FeeRate, true
+		}
+	}
+
+	return m.MinAcceptedFee(), true
+}
+
+// MempoolSource provides the state of the mempool of a chain backend.
+type MempoolSource interface {
+	// MempoolInfo returns the current state of the mempool.
+	MempoolInfo(ctx context.Context) (*MempoolInfo, error)
+}
@@ -0,0 +1,53 @@
+package tapgarden
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestMempoolInfo tests that the fee rates and sizes derived from the mempool
+// state respect the fee histogram buckets.
+func TestMempoolInfo(t *testing.T) {
+	t.Parallel()
+
+	info := &MempoolInfo{
+		MinRelayFee:   253,
+		MempoolMinFee: 500,
+	}
+	require.EqualValues(t, 500, info.MinAcceptedFee())
+
+	// Without a fee histogram, the next block fee rate is unknown.
+	_, ok := info.NextBlockFeeRate()
+	require.False(t, ok)
+
+	info.FeeHistogram = []FeeHistogramBucket{{
+		FeeRate: 5000,
+		VSize:   400_000,
+	}, {
+		FeeRate: 2000,
+		VSize:   400_000,
+	}, {
+		FeeRate: 1000,
+		VSize:   400_000,
+	}}
+
+	// The next block is filled up in the bucket of 1000 sat/kw.
+	feeRate, ok := info.NextBlockFeeRate()
+	require.True(t, ok)
+	require.EqualValues(t, 1000, feeRate)
+
+	// The bucket that contains the fee rate is counted in full.
+	require.EqualValues(t, 400_000, info.VSizeAbove(6000))
+	require.EqualValues(t, 400_000, info.VSizeAbove(5000))
+	require.EqualValues(t, 800_000, info.VSizeAbove(3000))
+	require.EqualValues(t, 1_200_000, info.VSizeAbove(1000))
+	require.EqualValues(t, 1_200_000, info.VSizeAbove(500))
+
+	// If the whole mempool fits into the next block, the minimum accepted
+	// fee rate is enough.
+	info.FeeHistogram = info.FeeHistogram[:2]
+	feeRate, ok = info.NextBlockFeeRate()
+	require.True(t, ok)
+	require.EqualValues(t, 500, feeRate)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/rand"
+	"sync"
 	"testing"
 	"time"
 
@@ -240,6 +241,9 @@ type MockChainBridge struct {
 
 	ReqCount int
 	ConfReqs map[int]*chainntnfs.ConfirmationEvent
+
+	spendMtx  sync.Mutex
+	SpendReqs map[wire.OutPoint]*chainntnfs.SpendEvent
 }
 
 func NewMockChainBridge() *MockChainBridge {
@@ -250,6 +254,9 @@ func NewMockChainBridge() *MockChainBridge {
 		ConfReqSignal:     make(chan int),
 		BlockEpochSignal:  make(chan struct{}, 1),
 		NewBlocks:         make(chan int32),
+		SpendReqs: make(
+			map[wire.OutPoint]*chainntnfs.SpendEvent,
+		),
 	}
 }
 
@@ -297,6 +304,53 @@ func (m *MockChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	return req, errChan, nil
 }
 
+func (m *MockChainBridge) RegisterSpendNtfn(ctx context.Context,
+	outpoint *wire.OutPoint, _ []byte,
+	_ uint32) (*chainntnfs.SpendEvent, chan error, error) {
+
+	select {
+	case <-ctx.Done():
+		return nil, nil, fmt.Errorf("shutting down")
+	default:
+	}
+
+	m.spendMtx.Lock()
+	defer m.spendMtx.Unlock()
+
+	req := &chainntnfs.SpendEvent{
+		Spend:  make(chan *chainntnfs.SpendDetail, 1),
+		Cancel: func() {},
+	}
+	m.SpendReqs[*outpoint] = req
+
+	return req, make(chan error, 1), nil
+}
+
+// SendSpendNtfn sends a spend notification for the given outpoint. False is
+// returned if no spend notification is registered for the outpoint.
+func (m *MockChainBridge) SendSpendNtfn(outpoint wire.OutPoint,
+	spendingTx *wire.MsgTx, spendingHeight int32) bool {
+
+	m.spendMtx.Lock()
+	req, ok := m.SpendReqs[outpoint]
+	delete(m.SpendReqs, outpoint)
+	m.spendMtx.Unlock()
+
+	if !ok {
+		return false
+	}
+
+	txHash := spendingTx.TxHash()
+	req.Spend <- &chainntnfs.SpendDetail{
+		SpentOutPoint:  &outpoint,
+		SpenderTxHash:  &txHash,
+		SpendingTx:     spendingTx,
+		SpendingHeight: spendingHeight,
+	}
+
+	return true
+}
+
 func (m *MockChainBridge) RegisterBlockEpochNtfn(
 	ctx context.Context) (chan int32, chan error, error) {
 
@@ -362,6 +416,12 @@ func (m *MockChainBridge) EstimateFee(ctx context.Context,
 	return 253, nil
 }
 
+func (m *MockChainBridge) MempoolInfo(_ context.Context) (*MempoolInfo,
+	error) {
+
+	return nil, ErrMempoolInfoUnavailable
+}
+
 type MockKeyRing struct {
 	FamIndex keychain.KeyFamily
 	KeyIndex uint32
@@ -37,6 +37,10 @@ type GardenKit struct {
 	// for assets created that permit ongoing emission.
 	KeyRing KeyRing
 
+	// KeyAuditLog is the optional log that all keys derived from the
+	// KeyRing are recorded in.
+	KeyAuditLog KeyAuditLog
+
 	// GenSigner is used to generate signatures for the key group tweaked
 	// by the genesis point when creating assets that permit on going
 	// emission.
@@ -740,8 +744,10 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 		// To create a new batch we'll first need to grab a new
 		// internal key, which'll be used in the output we create, and
 		// also will serve as the primary identifier for a batch.
-		newInternalKey, err := c.cfg.KeyRing.DeriveNextKey(
-			ctx, asset.TaprootAssetsKeyFamily,
+		newInternalKey, err := DeriveAuditedKey(
+			ctx, c.cfg.KeyRing, c.cfg.KeyAuditLog,
+			asset.TaprootAssetsKeyFamily,
+			KeyPurposeBatchInternalKey, "minting batch",
 		)
 		if err != nil {
 			return err
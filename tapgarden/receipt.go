@@ -0,0 +1,264 @@
+package tapgarden
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// ReceiptVersion is the version of the transfer receipt encoding.
+type ReceiptVersion uint8
+
+const (
+	// ReceiptV0 is the initial version of transfer receipts.
+	ReceiptV0 ReceiptVersion = 0
+)
+
+const (
+	receiptVersionType   tlv.Type = 0
+	receiptAssetIDType   tlv.Type = 2
+	receiptAmountType    tlv.Type = 4
+	receiptAnchorTxType  tlv.Type = 6
+	receiptScriptKeyType tlv.Type = 8
+	receiptBlockHashType tlv.Type = 10
+	receiptNodeKeyType   tlv.Type = 12
+	receiptSignatureType tlv.Type = 14
+)
+
+// receiptTag is prepended to the serialized receipt before signing it, so a
+// receipt signature can never be mistaken for a signature over anything else
+// signed with the node's identity key.
+var receiptTag = []byte("taproot-assets/transfer-receipt")
+
+// Receipt is a statement signed by the identity key of the receiving node that
+// attests that the given amount of an asset was credited to one of its script
+// keys. It can be handed to third parties as evidence of payment.
+type Receipt struct {
+	// Version is the version of the receipt encoding.
+	Version ReceiptVersion
+
+	// AssetID is the ID of the received asset.
+	AssetID asset.ID
+
+	// Amount is the amount of the asset that was received.
+	Amount uint64
+
+	// AnchorTxid is the ID of the transaction that anchors the received
+	// asset.
+	AnchorTxid chainhash.Hash
+
+	// ScriptKey is the script key the asset was received on.
+	ScriptKey *btcec.PublicKey
+
+	// BlockHash is the hash of the block the anchor transaction was
+	// confirmed in.
+	BlockHash chainhash.Hash
+
+	// NodeKey is the identity key of the receiving node.
+	NodeKey *btcec.PublicKey
+
+	// Signature is the DER encoded ECDSA signature of the node key over
+	// the sha256 hash of the receipt message.
+	Signature []byte
+}
+
+// NewReceipt creates an unsigned receipt for the given completed inbound
+// transfer, using the last proof of the received asset.
+func NewReceipt(event *address.Event, lastProof *proof.Proof,
+	nodeKey *btcec.PublicKey) (*Receipt, error) {
+
+	if event.Status != address.StatusCompleted {
+		return nil, fmt.Errorf("inbound transfer not completed yet, "+
+			"status is %v", event.Status)
+	}
+
+	if !AddrMatchesAsset(event.Addr, &lastProof.Asset) {
+		return nil, fmt.Errorf("proof doesn't match address of " +
+			"inbound transfer")
+	}
+
+	anchorTxid := lastProof.AnchorTx.TxHash()
+	if anchorTxid != event.Outpoint.Hash {
+		return nil, fmt.Errorf("proof anchor tx %v doesn't match "+
+			"inbound transfer outpoint %v", anchorTxid,
+			event.Outpoint)
+	}
+
+	return &Receipt{
+		Version:    ReceiptV0,
+		AssetID:    lastProof.Asset.ID(),
+		Amount:     lastProof.Asset.Amount,
+		AnchorTxid: anchorTxid,
+		ScriptKey:  lastProof.Asset.ScriptKey.PubKey,
+		BlockHash:  lastProof.BlockHeader.BlockHash(),
+		NodeKey:    nodeKey,
+	}, nil
+}
+
+// unsignedRecords returns the TLV records of all fields that are covered by
+// the signature.
+func (r *Receipt) unsignedRecords() []tlv.Record {
+	version := uint8(r.Version)
+	assetID := [32]byte(r.AssetID)
+	anchorTxid := [32]byte(r.AnchorTxid)
+	blockHash := [32]byte(r.BlockHash)
+
+	return []tlv.Record{
+		tlv.MakePrimitiveRecord(receiptVersionType, &version),
+		tlv.MakePrimitiveRecord(receiptAssetIDType, &assetID),
+		tlv.MakePrimitiveRecord(receiptAmountType, &r.Amount),
+		tlv.MakePrimitiveRecord(receiptAnchorTxType, &anchorTxid),
+		tlv.MakePrimitiveRecord(receiptScriptKeyType, &r.ScriptKey),
+		tlv.MakePrimitiveRecord(receiptBlockHashType, &blockHash),
+		tlv.MakePrimitiveRecord(receiptNodeKeyType, &r.NodeKey),
+	}
+}
+
+// Message returns the message that is signed by the node key.
+func (r *Receipt) Message() ([]byte, error) {
+	if r.ScriptKey == nil || r.NodeKey == nil {
+		return nil, fmt.Errorf("receipt is missing a key")
+	}
+
+	stream, err := tlv.NewStream(r.unsignedRecords()...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	b.Write(receiptTag)
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// Encode encodes the signed receipt to the given writer.
+func (r *Receipt) Encode(w io.Writer) error {
+	if r.ScriptKey == nil || r.NodeKey == nil {
+		return fmt.Errorf("receipt is missing a key")
+	}
+
+	records := append(
+		r.unsignedRecords(),
+		tlv.MakePrimitiveRecord(receiptSignatureType, &r.Signature),
+	)
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+
+	return stream.Encode(w)
+}
+
+// Decode decodes a signed receipt from the given reader.
+func (r *Receipt) Decode(rd io.Reader) error {
+	var (
+		version    uint8
+		assetID    [32]byte
+		anchorTxid [32]byte
+		blockHash  [32]byte
+	)
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(receiptVersionType, &version),
+		tlv.MakePrimitiveRecord(receiptAssetIDType, &assetID),
+		tlv.MakePrimitiveRecord(receiptAmountType, &r.Amount),
+		tlv.MakePrimitiveRecord(receiptAnchorTxType, &anchorTxid),
+		tlv.MakePrimitiveRecord(receiptScriptKeyType, &r.ScriptKey),
+		tlv.MakePrimitiveRecord(receiptBlockHashType, &blockHash),
+		tlv.MakePrimitiveRecord(receiptNodeKeyType, &r.NodeKey),
+		tlv.MakePrimitiveRecord(receiptSignatureType, &r.Signature),
+	)
+	if err != nil {
+		return err
+	}
+	if err := stream.Decode(rd); err != nil {
+		return err
+	}
+
+	r.Version = ReceiptVersion(version)
+	r.AssetID = assetID
+	r.AnchorTxid = anchorTxid
+	r.BlockHash = blockHash
+
+	return nil
+}
+
+// MessageSigner signs arbitrary messages with a key of the backing lnd node.
+// This is implemented by lndclient.SignerClient.
+type MessageSigner interface {
+	// SignMessage signs the sha256 hash of the given message with the key
+	// identified by the given locator.
+	SignMessage(ctx context.Context, msg []byte,
+		locator keychain.KeyLocator,
+		opts ...lndclient.SignMessageOption) ([]byte, error)
+}
+
+// SignReceipt signs the receipt with the identity key of the lnd node behind
+// the given signer. The receipt's node key must be the node's identity key.
+func SignReceipt(ctx context.Context, signer MessageSigner,
+	receipt *Receipt) error {
+
+	msg, err := receipt.Message()
+	if err != nil {
+		return err
+	}
+
+	sig, err := signer.SignMessage(ctx, msg, proof.NodeKeyLocator)
+	if err != nil {
+		return fmt.Errorf("unable to sign receipt: %w", err)
+	}
+
+	receipt.Signature = sig
+
+	// We make sure the signature actually verifies, otherwise the receipt
+	// would be useless to the receiver.
+	if err := VerifyReceipt(receipt); err != nil {
+		return fmt.Errorf("signed receipt invalid, node key mismatch? "+
+			"%w", err)
+	}
+
+	return nil
+}
+
+// VerifyReceipt checks that the receipt was signed by the node key it
+// contains. This only depends on the receipt itself, so it can be used by
+// third parties that don't run a node. Whether the node key belongs to the
+// expected party and whether the anchor transaction is in the given block
+// must be checked separately.
+func VerifyReceipt(receipt *Receipt) error {
+	if receipt.Version != ReceiptV0 {
+		return fmt.Errorf("unknown receipt version: %d",
+			receipt.Version)
+	}
+
+	msg, err := receipt.Message()
+	if err != nil {
+		return err
+	}
+
+	sig, err := ecdsa.ParseDERSignature(receipt.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid receipt signature: %w", err)
+	}
+
+	digest := sha256.Sum256(msg)
+	if !sig.Verify(digest[:], receipt.NodeKey) {
+		return fmt.Errorf("receipt signature doesn't match node key")
+	}
+
+	return nil
+}
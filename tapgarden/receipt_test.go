@@ -0,0 +1,108 @@
+package tapgarden
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// mockMessageSigner signs messages like lnd does, with a single private key.
+type mockMessageSigner struct {
+	privKey *btcec.PrivateKey
+}
+
+func (m *mockMessageSigner) SignMessage(_ context.Context, msg []byte,
+	_ keychain.KeyLocator, _ ...lndclient.SignMessageOption) ([]byte,
+	error) {
+
+	digest := sha256.Sum256(msg)
+	return ecdsa.Sign(m.privKey, digest[:]).Serialize(), nil
+}
+
+// TestReceipt tests that receipts for completed inbound transfers can be
+// signed, encoded and verified, and that tampering is detected.
+func TestReceipt(t *testing.T) {
+	t.Parallel()
+
+	receivedAsset := asset.RandAsset(t, asset.Normal)
+	receivedAsset.GroupKey = nil
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{PreviousOutPoint: test.RandOp(t)})
+	anchorTx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: []byte{0x01}})
+
+	lastProof := &proof.Proof{
+		BlockHeader: wire.BlockHeader{
+			PrevBlock: test.RandHash(),
+			Nonce:     1234,
+		},
+		AnchorTx: *anchorTx,
+		Asset:    *receivedAsset,
+	}
+	event := &address.Event{
+		Addr: &address.AddrWithKeyInfo{
+			Tap: &address.Tap{
+				AssetID:   receivedAsset.ID(),
+				ScriptKey: *receivedAsset.ScriptKey.PubKey,
+				Amount:    receivedAsset.Amount,
+			},
+		},
+		Status: address.StatusCompleted,
+		Outpoint: wire.OutPoint{
+			Hash: anchorTx.TxHash(),
+		},
+	}
+
+	nodePriv := test.RandPrivKey(t)
+	signer := &mockMessageSigner{privKey: nodePriv}
+
+	receipt, err := NewReceipt(event, lastProof, nodePriv.PubKey())
+	require.NoError(t, err)
+	require.Equal(t, receivedAsset.Amount, receipt.Amount)
+	require.Equal(t, lastProof.BlockHeader.BlockHash(), receipt.BlockHash)
+
+	ctx := context.Background()
+	require.NoError(t, SignReceipt(ctx, signer, receipt))
+
+	// The receipt survives an encoding round trip and still verifies.
+	var b bytes.Buffer
+	require.NoError(t, receipt.Encode(&b))
+
+	var decoded Receipt
+	require.NoError(t, decoded.Decode(&b))
+	require.Equal(t, *receipt, decoded)
+	require.NoError(t, VerifyReceipt(&decoded))
+
+	// Changing any of the attested fields invalidates the signature.
+	tampered := decoded
+	tampered.Amount++
+	require.Error(t, VerifyReceipt(&tampered))
+
+	// A signer with a different key than the claimed node key can't
+	// produce a valid receipt.
+	otherSigner := &mockMessageSigner{privKey: test.RandPrivKey(t)}
+	require.Error(t, SignReceipt(ctx, otherSigner, receipt))
+
+	// Receipts can only be created for completed transfers that match the
+	// proof.
+	event.Status = address.StatusProofReceived
+	_, err = NewReceipt(event, lastProof, nodePriv.PubKey())
+	require.ErrorContains(t, err, "not completed")
+
+	event.Status = address.StatusCompleted
+	event.Outpoint = test.RandOp(t)
+	_, err = NewReceipt(event, lastProof, nodePriv.PubKey())
+	require.ErrorContains(t, err, "doesn't match inbound transfer")
+}
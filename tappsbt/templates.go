@@ -0,0 +1,173 @@
+package tappsbt
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// Recipient is an interactive recipient of an output of a virtual packet
+// created from one of the templates below.
+type Recipient struct {
+	// Amount is the amount of units the recipient receives.
+	Amount uint64
+
+	// ScriptKey is the script key the recipient receives the units on.
+	ScriptKey asset.ScriptKey
+
+	// AnchorOutputIndex is the index of the BTC level anchor output the
+	// recipient's output is committed to.
+	AnchorOutputIndex uint32
+
+	// AnchorInternalKey is the internal key of the BTC level anchor output.
+	AnchorInternalKey keychain.KeyDescriptor
+}
+
+// ForSendWithChange creates a virtual transaction packet for sending part of
+// an input to a single interactive recipient while receiving the remainder
+// back on a change output. The change output is the split root and is anchored
+// at the given output index. If no change script key is given, the NUMS key is
+// used as a placeholder that is replaced with a freshly derived key by the
+// funding API. The amount of the change output is also set during funding.
+func ForSendWithChange(id asset.ID, recipient Recipient,
+	changeScriptKey *asset.ScriptKey, changeOutputIndex uint32,
+	chainParams *address.ChainParams) *VPacket {
+
+	vPkt := newSplitPacket(
+		id, changeScriptKey, changeOutputIndex, chainParams,
+	)
+	addRecipient(vPkt, recipient)
+
+	return vPkt
+}
+
+// ForFullValueSend creates a virtual transaction packet that sends the full
+// value of the given input asset to a single interactive recipient. Because no
+// change is created, no split root output is needed, which also makes this the
+// only way of sending a collectible without a split. The input asset anchored
+// at the given outpoint is already set on the packet.
+func ForFullValueSend(input *asset.Asset, anchorPoint wire.OutPoint,
+	scriptKey asset.ScriptKey, outputIndex uint32,
+	anchorInternalKey keychain.KeyDescriptor,
+	chainParams *address.ChainParams) *VPacket {
+
+	vPkt := &VPacket{
+		Inputs: []*VInput{{
+			PrevID: asset.PrevID{
+				OutPoint: anchorPoint,
+				ID:       input.ID(),
+				ScriptKey: asset.ToSerialized(
+					input.ScriptKey.PubKey,
+				),
+			},
+		}},
+		ChainParams: chainParams,
+	}
+	vPkt.SetInputAsset(0, input, nil)
+
+	AddOutput(
+		vPkt, input.Amount, scriptKey, outputIndex, anchorInternalKey,
+	)
+
+	return vPkt
+}
+
+// ForSplitSend creates a virtual transaction packet that splits an input
+// between any number of interactive recipients. The remainder is sent back to
+// the split root change output, as described in ForSendWithChange.
+func ForSplitSend(id asset.ID, recipients []Recipient,
+	changeScriptKey *asset.ScriptKey, changeOutputIndex uint32,
+	chainParams *address.ChainParams) (*VPacket, error) {
+
+	if len(recipients) < 1 {
+		return nil, fmt.Errorf("at least one recipient must be " +
+			"specified")
+	}
+
+	vPkt := newSplitPacket(
+		id, changeScriptKey, changeOutputIndex, chainParams,
+	)
+	for idx := range recipients {
+		recipient := recipients[idx]
+		if recipient.Amount == 0 {
+			return nil, fmt.Errorf("recipient %d: amount must be "+
+				"greater than zero", idx)
+		}
+
+		addRecipient(vPkt, recipient)
+	}
+
+	return vPkt, nil
+}
+
+// ForSwapLeg creates a virtual transaction packet for one leg of an atomic
+// swap, where the asset is sent to the counterparty within an anchor
+// transaction that both parties contribute to. The output is anchored in the
+// counterparty's anchor output, so its internal key isn't derived by the local
+// wallet and no BIP-0032 derivation is set. If the amount is not the full input
+// amount, a change output will be added by the funding API.
+func ForSwapLeg(id asset.ID, amount uint64,
+	counterpartyScriptKey asset.ScriptKey,
+	counterpartyAnchorKey *btcec.PublicKey, outputIndex uint32,
+	chainParams *address.ChainParams) *VPacket {
+
+	return &VPacket{
+		Inputs: []*VInput{{
+			PrevID: asset.PrevID{
+				ID: id,
+			},
+		}},
+		Outputs: []*VOutput{{
+			Type:                    TypeSimple,
+			Amount:                  amount,
+			Interactive:             true,
+			AnchorOutputIndex:       outputIndex,
+			AnchorOutputInternalKey: counterpartyAnchorKey,
+			ScriptKey:               counterpartyScriptKey,
+		}},
+		ChainParams: chainParams,
+	}
+}
+
+// newSplitPacket creates a virtual transaction packet for the given asset that
+// only contains the interactive split root change output.
+func newSplitPacket(id asset.ID, changeScriptKey *asset.ScriptKey,
+	changeOutputIndex uint32, chainParams *address.ChainParams) *VPacket {
+
+	// Just like when sending to addresses, we use the NUMS key as a
+	// placeholder if the caller didn't provide a change key, to avoid
+	// deriving a new key for each funding attempt.
+	scriptKey := asset.NUMSScriptKey
+	if changeScriptKey != nil {
+		scriptKey = *changeScriptKey
+	}
+
+	return &VPacket{
+		Inputs: []*VInput{{
+			PrevID: asset.PrevID{
+				ID: id,
+			},
+		}},
+		Outputs: []*VOutput{{
+			Amount:            0,
+			Type:              TypeSplitRoot,
+			Interactive:       true,
+			AnchorOutputIndex: changeOutputIndex,
+			ScriptKey:         scriptKey,
+		}},
+		ChainParams: chainParams,
+	}
+}
+
+// addRecipient adds an interactive output for the given recipient to the
+// packet.
+func addRecipient(vPkt *VPacket, recipient Recipient) {
+	AddOutput(
+		vPkt, recipient.Amount, recipient.ScriptKey,
+		recipient.AnchorOutputIndex, recipient.AnchorInternalKey,
+	)
+}
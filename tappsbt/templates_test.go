@@ -0,0 +1,117 @@
+package tappsbt
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// randRecipient returns a recipient with random keys.
+func randRecipient(t *testing.T, amount uint64, index uint32) Recipient {
+	return Recipient{
+		Amount:            amount,
+		ScriptKey:         asset.RandScriptKey(t),
+		AnchorOutputIndex: index,
+		AnchorInternalKey: keychain.KeyDescriptor{
+			PubKey: test.RandPubKey(t),
+		},
+	}
+}
+
+// assertTemplateRoundTrip asserts that a templated packet survives encoding
+// and decoding.
+func assertTemplateRoundTrip(t *testing.T, vPkt *VPacket) {
+	packet, err := vPkt.EncodeAsPsbt()
+	require.NoError(t, err)
+
+	decoded, err := NewFromPsbt(packet)
+	require.NoError(t, err)
+
+	assertEqualPackets(t, vPkt, decoded)
+}
+
+// TestTemplates tests that the packet templates set the output types and flags
+// the funding and validation logic expects.
+func TestTemplates(t *testing.T) {
+	t.Parallel()
+
+	var (
+		chainParams = &address.TestNet3Tap
+		id          = asset.RandID(t)
+		changeKey   = asset.RandScriptKey(t)
+	)
+
+	// A send with change puts the split root first, using the NUMS key as a
+	// placeholder unless a change key is given.
+	recipient := randRecipient(t, 10, 1)
+	vPkt := ForSendWithChange(id, recipient, nil, 0, chainParams)
+	require.Len(t, vPkt.Outputs, 2)
+	require.True(t, vPkt.HasSplitRootOutput())
+	require.Equal(t, asset.NUMSScriptKey, vPkt.Outputs[0].ScriptKey)
+	require.Equal(t, TypeSimple, vPkt.Outputs[1].Type)
+	require.True(t, vPkt.Outputs[1].Interactive)
+	require.EqualValues(t, 10, vPkt.Outputs[1].Amount)
+	require.Len(t, vPkt.Outputs[1].AnchorOutputBip32Derivation, 1)
+	assertTemplateRoundTrip(t, vPkt)
+
+	vPkt = ForSendWithChange(id, recipient, &changeKey, 2, chainParams)
+	require.Equal(t, changeKey, vPkt.Outputs[0].ScriptKey)
+	require.EqualValues(t, 2, vPkt.Outputs[0].AnchorOutputIndex)
+
+	// A full value send has a single output with the full input amount
+	// and the input asset set.
+	input := asset.RandAsset(t, asset.Collectible)
+	anchorPoint := wire.OutPoint{Hash: test.RandHash(), Index: 1}
+	vPkt = ForFullValueSend(
+		input, anchorPoint, recipient.ScriptKey, 0,
+		recipient.AnchorInternalKey, chainParams,
+	)
+	require.Len(t, vPkt.Outputs, 1)
+	require.False(t, vPkt.HasSplitRootOutput())
+	require.True(t, vPkt.Outputs[0].Interactive)
+	require.Equal(t, input.Amount, vPkt.Outputs[0].Amount)
+	require.Equal(t, input.ID(), vPkt.Inputs[0].PrevID.ID)
+	require.Equal(t, anchorPoint, vPkt.Inputs[0].PrevID.OutPoint)
+	require.Equal(t, input, vPkt.Inputs[0].Asset())
+
+	// A split needs at least one recipient with a non-zero amount.
+	_, err := ForSplitSend(id, nil, nil, 0, chainParams)
+	require.ErrorContains(t, err, "at least one recipient")
+
+	_, err = ForSplitSend(
+		id, []Recipient{randRecipient(t, 0, 1)}, nil, 0, chainParams,
+	)
+	require.ErrorContains(t, err, "greater than zero")
+
+	vPkt, err = ForSplitSend(id, []Recipient{
+		randRecipient(t, 1, 1),
+		randRecipient(t, 2, 2),
+		randRecipient(t, 3, 3),
+	}, nil, 0, chainParams)
+	require.NoError(t, err)
+	require.Len(t, vPkt.Outputs, 4)
+	require.True(t, vPkt.Outputs[0].Type.IsSplitRoot())
+	for idx, vOut := range vPkt.Outputs[1:] {
+		require.True(t, vOut.Interactive)
+		require.EqualValues(t, idx+1, vOut.Amount)
+		require.EqualValues(t, idx+1, vOut.AnchorOutputIndex)
+	}
+	assertTemplateRoundTrip(t, vPkt)
+
+	// A swap leg is anchored to the counterparty's internal key, which we
+	// don't have a derivation path for.
+	counterpartyKey := test.RandPubKey(t)
+	vPkt = ForSwapLeg(
+		id, 5, recipient.ScriptKey, counterpartyKey, 1, chainParams,
+	)
+	require.Len(t, vPkt.Outputs, 1)
+	require.True(t, vPkt.Outputs[0].Interactive)
+	require.Equal(t, counterpartyKey, vPkt.Outputs[0].AnchorOutputInternalKey)
+	require.Empty(t, vPkt.Outputs[0].AnchorOutputBip32Derivation)
+	assertTemplateRoundTrip(t, vPkt)
+}
@@ -53,6 +53,24 @@ type AssetWalletClient interface {
 	// RemoveUTXOLease removes the lease/lock/reservation of the given managed
 	// UTXO.
 	RemoveUTXOLease(ctx context.Context, in *RemoveUTXOLeaseRequest, opts ...grpc.CallOption) (*RemoveUTXOLeaseResponse, error)
+	// ListAnchorCoSignRequests lists the anchor transactions of transfers that
+	// wait for an external party to co-sign the anchor inputs the connected lnd
+	// node can't sign for alone.
+	ListAnchorCoSignRequests(ctx context.Context, in *ListAnchorCoSignRequestsRequest, opts ...grpc.CallOption) (*ListAnchorCoSignRequestsResponse, error)
+	// SubmitAnchorCoSignature submits the co-signed version of an anchor
+	// transaction listed by ListAnchorCoSignRequests, which continues the
+	// transfer that waits for it.
+	SubmitAnchorCoSignature(ctx context.Context, in *SubmitAnchorCoSignatureRequest, opts ...grpc.CallOption) (*SubmitAnchorCoSignatureResponse, error)
+	// ListVirtualSignRequests lists the virtual transactions of transfers that
+	// wait for an offline signer to sign them. This is only available if the
+	// daemon runs in watch-only mode. The listed virtual packets can be signed
+	// with SignVirtualPsbt on a daemon that holds the script keys.
+	ListVirtualSignRequests(ctx context.Context, in *ListVirtualSignRequestsRequest, opts ...grpc.CallOption) (*ListVirtualSignRequestsResponse, error)
+	// SubmitVirtualSignature submits the signed version of a virtual transaction
+	// listed by ListVirtualSignRequests, which continues the transfer that waits
+	// for it. Only the witnesses of the signed virtual packet are used, after
+	// they were verified against the listed virtual transaction.
+	SubmitVirtualSignature(ctx context.Context, in *SubmitVirtualSignatureRequest, opts ...grpc.CallOption) (*SubmitVirtualSignatureResponse, error)
 }
 
 type assetWalletClient struct {
@@ -135,6 +153,42 @@ func (c *assetWalletClient) RemoveUTXOLease(ctx context.Context, in *RemoveUTXOL
 	return out, nil
 }
 
+func (c *assetWalletClient) ListAnchorCoSignRequests(ctx context.Context, in *ListAnchorCoSignRequestsRequest, opts ...grpc.CallOption) (*ListAnchorCoSignRequestsResponse, error) {
+	out := new(ListAnchorCoSignRequestsResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/ListAnchorCoSignRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) SubmitAnchorCoSignature(ctx context.Context, in *SubmitAnchorCoSignatureRequest, opts ...grpc.CallOption) (*SubmitAnchorCoSignatureResponse, error) {
+	out := new(SubmitAnchorCoSignatureResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/SubmitAnchorCoSignature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) ListVirtualSignRequests(ctx context.Context, in *ListVirtualSignRequestsRequest, opts ...grpc.CallOption) (*ListVirtualSignRequestsResponse, error) {
+	out := new(ListVirtualSignRequestsResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/ListVirtualSignRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) SubmitVirtualSignature(ctx context.Context, in *SubmitVirtualSignatureRequest, opts ...grpc.CallOption) (*SubmitVirtualSignatureResponse, error) {
+	out := new(SubmitVirtualSignatureResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/SubmitVirtualSignature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AssetWalletServer is the server API for AssetWallet service.
 // All implementations must embed UnimplementedAssetWalletServer
 // for forward compatibility
@@ -173,6 +227,24 @@ type AssetWalletServer interface {
 	// RemoveUTXOLease removes the lease/lock/reservation of the given managed
 	// UTXO.
 	RemoveUTXOLease(context.Context, *RemoveUTXOLeaseRequest) (*RemoveUTXOLeaseResponse, error)
+	// ListAnchorCoSignRequests lists the anchor transactions of transfers that
+	// wait for an external party to co-sign the anchor inputs the connected lnd
+	// node can't sign for alone.
+	ListAnchorCoSignRequests(context.Context, *ListAnchorCoSignRequestsRequest) (*ListAnchorCoSignRequestsResponse, error)
+	// SubmitAnchorCoSignature submits the co-signed version of an anchor
+	// transaction listed by ListAnchorCoSignRequests, which continues the
+	// transfer that waits for it.
+	SubmitAnchorCoSignature(context.Context, *SubmitAnchorCoSignatureRequest) (*SubmitAnchorCoSignatureResponse, error)
+	// ListVirtualSignRequests lists the virtual transactions of transfers that
+	// wait for an offline signer to sign them. This is only available if the
+	// daemon runs in watch-only mode. The listed virtual packets can be signed
+	// with SignVirtualPsbt on a daemon that holds the script keys.
+	ListVirtualSignRequests(context.Context, *ListVirtualSignRequestsRequest) (*ListVirtualSignRequestsResponse, error)
+	// SubmitVirtualSignature submits the signed version of a virtual transaction
+	// listed by ListVirtualSignRequests, which continues the transfer that waits
+	// for it. Only the witnesses of the signed virtual packet are used, after
+	// they were verified against the listed virtual transaction.
+	SubmitVirtualSignature(context.Context, *SubmitVirtualSignatureRequest) (*SubmitVirtualSignatureResponse, error)
 	mustEmbedUnimplementedAssetWalletServer()
 }
 
@@ -204,6 +276,18 @@ func (UnimplementedAssetWalletServer) VerifyAssetOwnership(context.Context, *Ver
 func (UnimplementedAssetWalletServer) RemoveUTXOLease(context.Context, *RemoveUTXOLeaseRequest) (*RemoveUTXOLeaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveUTXOLease not implemented")
 }
+func (UnimplementedAssetWalletServer) ListAnchorCoSignRequests(context.Context, *ListAnchorCoSignRequestsRequest) (*ListAnchorCoSignRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnchorCoSignRequests not implemented")
+}
+func (UnimplementedAssetWalletServer) SubmitAnchorCoSignature(context.Context, *SubmitAnchorCoSignatureRequest) (*SubmitAnchorCoSignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAnchorCoSignature not implemented")
+}
+func (UnimplementedAssetWalletServer) ListVirtualSignRequests(context.Context, *ListVirtualSignRequestsRequest) (*ListVirtualSignRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVirtualSignRequests not implemented")
+}
+func (UnimplementedAssetWalletServer) SubmitVirtualSignature(context.Context, *SubmitVirtualSignatureRequest) (*SubmitVirtualSignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitVirtualSignature not implemented")
+}
 func (UnimplementedAssetWalletServer) mustEmbedUnimplementedAssetWalletServer() {}
 
 // UnsafeAssetWalletServer may be embedded to opt out of forward compatibility for this service.
@@ -361,6 +445,78 @@ func _AssetWallet_RemoveUTXOLease_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AssetWallet_ListAnchorCoSignRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnchorCoSignRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).ListAnchorCoSignRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/ListAnchorCoSignRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).ListAnchorCoSignRequests(ctx, req.(*ListAnchorCoSignRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_SubmitAnchorCoSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAnchorCoSignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).SubmitAnchorCoSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/SubmitAnchorCoSignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).SubmitAnchorCoSignature(ctx, req.(*SubmitAnchorCoSignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_ListVirtualSignRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVirtualSignRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).ListVirtualSignRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/ListVirtualSignRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).ListVirtualSignRequests(ctx, req.(*ListVirtualSignRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_SubmitVirtualSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitVirtualSignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).SubmitVirtualSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/SubmitVirtualSignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).SubmitVirtualSignature(ctx, req.(*SubmitVirtualSignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AssetWallet_ServiceDesc is the grpc.ServiceDesc for AssetWallet service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -400,6 +556,22 @@ var AssetWallet_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveUTXOLease",
 			Handler:    _AssetWallet_RemoveUTXOLease_Handler,
 		},
+		{
+			MethodName: "ListAnchorCoSignRequests",
+			Handler:    _AssetWallet_ListAnchorCoSignRequests_Handler,
+		},
+		{
+			MethodName: "SubmitAnchorCoSignature",
+			Handler:    _AssetWallet_SubmitAnchorCoSignature_Handler,
+		},
+		{
+			MethodName: "ListVirtualSignRequests",
+			Handler:    _AssetWallet_ListVirtualSignRequests_Handler,
+		},
+		{
+			MethodName: "SubmitVirtualSignature",
+			Handler:    _AssetWallet_SubmitVirtualSignature_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "assetwalletrpc/assetwallet.proto",
@@ -0,0 +1,395 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.6.1
+// source: coinselectrpc/coinselect.proto
+
+package coinselectrpc
+
+import (
+	taprpc "github.com/lightninglabs/taproot-assets/taprpc"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SelectCoinsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the asset to send. This is empty if group_key is set.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The group key of the asset to send. This is empty if asset_id is set.
+	GroupKey []byte `protobuf:"bytes,2,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+	// The minimum amount the selected coins need to sum to.
+	MinAmount uint64 `protobuf:"varint,3,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	// The coin selection strategy of the transfer, which the service may use as a
+	// hint.
+	Strategy taprpc.CoinSelectStrategy `protobuf:"varint,4,opt,name=strategy,proto3,enum=taprpc.CoinSelectStrategy" json:"strategy,omitempty"`
+	// The coins that may be selected. Coins that are leased by other transfers,
+	// lack the required number of anchor confirmations or aren't one of the
+	// requested lots of the transfer aren't included.
+	EligibleCoins []*EligibleCoin `protobuf:"bytes,5,rep,name=eligible_coins,json=eligibleCoins,proto3" json:"eligible_coins,omitempty"`
+}
+
+func (x *SelectCoinsRequest) Reset() {
+	*x = SelectCoinsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinselectrpc_coinselect_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelectCoinsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectCoinsRequest) ProtoMessage() {}
+
+func (x *SelectCoinsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coinselectrpc_coinselect_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectCoinsRequest.ProtoReflect.Descriptor instead.
+func (*SelectCoinsRequest) Descriptor() ([]byte, []int) {
+	return file_coinselectrpc_coinselect_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SelectCoinsRequest) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *SelectCoinsRequest) GetGroupKey() []byte {
+	if x != nil {
+		return x.GroupKey
+	}
+	return nil
+}
+
+func (x *SelectCoinsRequest) GetMinAmount() uint64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *SelectCoinsRequest) GetStrategy() taprpc.CoinSelectStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return taprpc.CoinSelectStrategy(0)
+}
+
+func (x *SelectCoinsRequest) GetEligibleCoins() []*EligibleCoin {
+	if x != nil {
+		return x.EligibleCoins
+	}
+	return nil
+}
+
+type EligibleCoin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The lot that identifies the coin.
+	Lot *taprpc.AssetLotID `protobuf:"bytes,1,opt,name=lot,proto3" json:"lot,omitempty"`
+	// The amount of the asset.
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The value in satoshis of the anchor output of the coin.
+	AnchorOutputValue int64 `protobuf:"varint,3,opt,name=anchor_output_value,json=anchorOutputValue,proto3" json:"anchor_output_value,omitempty"`
+	// The height of the block the anchor transaction was confirmed in. This is
+	// zero if the anchor transaction isn't confirmed yet.
+	AnchorBlockHeight uint32 `protobuf:"varint,4,opt,name=anchor_block_height,json=anchorBlockHeight,proto3" json:"anchor_block_height,omitempty"`
+	// The acquisition metadata of the coin. This is unset if the lot of the coin
+	// isn't tracked.
+	LotInfo *taprpc.AssetLot `protobuf:"bytes,5,opt,name=lot_info,json=lotInfo,proto3" json:"lot_info,omitempty"`
+}
+
+func (x *EligibleCoin) Reset() {
+	*x = EligibleCoin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinselectrpc_coinselect_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EligibleCoin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EligibleCoin) ProtoMessage() {}
+
+func (x *EligibleCoin) ProtoReflect() protoreflect.Message {
+	mi := &file_coinselectrpc_coinselect_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EligibleCoin.ProtoReflect.Descriptor instead.
+func (*EligibleCoin) Descriptor() ([]byte, []int) {
+	return file_coinselectrpc_coinselect_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EligibleCoin) GetLot() *taprpc.AssetLotID {
+	if x != nil {
+		return x.Lot
+	}
+	return nil
+}
+
+func (x *EligibleCoin) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *EligibleCoin) GetAnchorOutputValue() int64 {
+	if x != nil {
+		return x.AnchorOutputValue
+	}
+	return 0
+}
+
+func (x *EligibleCoin) GetAnchorBlockHeight() uint32 {
+	if x != nil {
+		return x.AnchorBlockHeight
+	}
+	return 0
+}
+
+func (x *EligibleCoin) GetLotInfo() *taprpc.AssetLot {
+	if x != nil {
+		return x.LotInfo
+	}
+	return nil
+}
+
+type SelectCoinsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The lots of the eligible coins to spend.
+	Selected []*taprpc.AssetLotID `protobuf:"bytes,1,rep,name=selected,proto3" json:"selected,omitempty"`
+}
+
+func (x *SelectCoinsResponse) Reset() {
+	*x = SelectCoinsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_coinselectrpc_coinselect_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelectCoinsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectCoinsResponse) ProtoMessage() {}
+
+func (x *SelectCoinsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coinselectrpc_coinselect_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectCoinsResponse.ProtoReflect.Descriptor instead.
+func (*SelectCoinsResponse) Descriptor() ([]byte, []int) {
+	return file_coinselectrpc_coinselect_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SelectCoinsResponse) GetSelected() []*taprpc.AssetLotID {
+	if x != nil {
+		return x.Selected
+	}
+	return nil
+}
+
+var File_coinselectrpc_coinselect_proto protoreflect.FileDescriptor
+
+var file_coinselectrpc_coinselect_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x72, 0x70, 0x63, 0x2f,
+	0x63, 0x6f, 0x69, 0x6e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0d, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x72, 0x70, 0x63, 0x1a,
+	0x13, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x42, 0x0a, 0x0e, 0x65, 0x6c,
+	0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x52,
+	0x0d, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x22, 0xd9,
+	0x01, 0x0a, 0x0c, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x12,
+	0x24, 0x0a, 0x03, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x74, 0x49, 0x44,
+	0x52, 0x03, 0x6c, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a,
+	0x13, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2e, 0x0a,
+	0x13, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2b, 0x0a,
+	0x08, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x6f,
+	0x74, 0x52, 0x07, 0x6c, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x45, 0x0a, 0x13, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x4c, 0x6f, 0x74, 0x49, 0x44, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x32, 0x64, 0x0a, 0x0c, 0x43, 0x6f, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x54, 0x0a, 0x0b, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73,
+	0x12, 0x21, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2d, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_coinselectrpc_coinselect_proto_rawDescOnce sync.Once
+	file_coinselectrpc_coinselect_proto_rawDescData = file_coinselectrpc_coinselect_proto_rawDesc
+)
+
+func file_coinselectrpc_coinselect_proto_rawDescGZIP() []byte {
+	file_coinselectrpc_coinselect_proto_rawDescOnce.Do(func() {
+		file_coinselectrpc_coinselect_proto_rawDescData = protoimpl.X.CompressGZIP(file_coinselectrpc_coinselect_proto_rawDescData)
+	})
+	return file_coinselectrpc_coinselect_proto_rawDescData
+}
+
+var file_coinselectrpc_coinselect_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_coinselectrpc_coinselect_proto_goTypes = []interface{}{
+	(*SelectCoinsRequest)(nil),     // 0: coinselectrpc.SelectCoinsRequest
+	(*EligibleCoin)(nil),           // 1: coinselectrpc.EligibleCoin
+	(*SelectCoinsResponse)(nil),    // 2: coinselectrpc.SelectCoinsResponse
+	(taprpc.CoinSelectStrategy)(0), // 3: taprpc.CoinSelectStrategy
+	(*taprpc.AssetLotID)(nil),      // 4: taprpc.AssetLotID
+	(*taprpc.AssetLot)(nil),        // 5: taprpc.AssetLot
+}
+var file_coinselectrpc_coinselect_proto_depIdxs = []int32{
+	3, // 0: coinselectrpc.SelectCoinsRequest.strategy:type_name -> taprpc.CoinSelectStrategy
+	1, // 1: coinselectrpc.SelectCoinsRequest.eligible_coins:type_name -> coinselectrpc.EligibleCoin
+	4, // 2: coinselectrpc.EligibleCoin.lot:type_name -> taprpc.AssetLotID
+	5, // 3: coinselectrpc.EligibleCoin.lot_info:type_name -> taprpc.AssetLot
+	4, // 4: coinselectrpc.SelectCoinsResponse.selected:type_name -> taprpc.AssetLotID
+	0, // 5: coinselectrpc.CoinSelector.SelectCoins:input_type -> coinselectrpc.SelectCoinsRequest
+	2, // 6: coinselectrpc.CoinSelector.SelectCoins:output_type -> coinselectrpc.SelectCoinsResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_coinselectrpc_coinselect_proto_init() }
+func file_coinselectrpc_coinselect_proto_init() {
+	if File_coinselectrpc_coinselect_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_coinselectrpc_coinselect_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelectCoinsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinselectrpc_coinselect_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EligibleCoin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_coinselectrpc_coinselect_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelectCoinsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_coinselectrpc_coinselect_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_coinselectrpc_coinselect_proto_goTypes,
+		DependencyIndexes: file_coinselectrpc_coinselect_proto_depIdxs,
+		MessageInfos:      file_coinselectrpc_coinselect_proto_msgTypes,
+	}.Build()
+	File_coinselectrpc_coinselect_proto = out.File
+	file_coinselectrpc_coinselect_proto_rawDesc = nil
+	file_coinselectrpc_coinselect_proto_goTypes = nil
+	file_coinselectrpc_coinselect_proto_depIdxs = nil
+}
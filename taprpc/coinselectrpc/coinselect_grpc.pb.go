@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package coinselectrpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CoinSelectorClient is the client API for CoinSelector service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CoinSelectorClient interface {
+	// SelectCoins selects the coins that fund an outbound transfer from the given
+	// eligible coins. The amounts of the selected coins must cumulatively sum to
+	// at least the minimum amount.
+	SelectCoins(ctx context.Context, in *SelectCoinsRequest, opts ...grpc.CallOption) (*SelectCoinsResponse, error)
+}
+
+type coinSelectorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCoinSelectorClient(cc grpc.ClientConnInterface) CoinSelectorClient {
+	return &coinSelectorClient{cc}
+}
+
+func (c *coinSelectorClient) SelectCoins(ctx context.Context, in *SelectCoinsRequest, opts ...grpc.CallOption) (*SelectCoinsResponse, error) {
+	out := new(SelectCoinsResponse)
+	err := c.cc.Invoke(ctx, "/coinselectrpc.CoinSelector/SelectCoins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CoinSelectorServer is the server API for CoinSelector service.
+// All implementations must embed UnimplementedCoinSelectorServer
+// for forward compatibility
+type CoinSelectorServer interface {
+	// SelectCoins selects the coins that fund an outbound transfer from the given
+	// eligible coins. The amounts of the selected coins must cumulatively sum to
+	// at least the minimum amount.
+	SelectCoins(context.Context, *SelectCoinsRequest) (*SelectCoinsResponse, error)
+	mustEmbedUnimplementedCoinSelectorServer()
+}
+
+// UnimplementedCoinSelectorServer must be embedded to have forward compatible implementations.
+type UnimplementedCoinSelectorServer struct {
+}
+
+func (UnimplementedCoinSelectorServer) SelectCoins(context.Context, *SelectCoinsRequest) (*SelectCoinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectCoins not implemented")
+}
+func (UnimplementedCoinSelectorServer) mustEmbedUnimplementedCoinSelectorServer() {}
+
+// UnsafeCoinSelectorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CoinSelectorServer will
+// result in compilation errors.
+type UnsafeCoinSelectorServer interface {
+	mustEmbedUnimplementedCoinSelectorServer()
+}
+
+func RegisterCoinSelectorServer(s grpc.ServiceRegistrar, srv CoinSelectorServer) {
+	s.RegisterService(&CoinSelector_ServiceDesc, srv)
+}
+
+func _CoinSelector_SelectCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectCoinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoinSelectorServer).SelectCoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coinselectrpc.CoinSelector/SelectCoins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoinSelectorServer).SelectCoins(ctx, req.(*SelectCoinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CoinSelector_ServiceDesc is the grpc.ServiceDesc for CoinSelector service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CoinSelector_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "coinselectrpc.CoinSelector",
+	HandlerType: (*CoinSelectorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SelectCoins",
+			Handler:    _CoinSelector_SelectCoins_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coinselectrpc/coinselect.proto",
+}
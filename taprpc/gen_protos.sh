@@ -38,6 +38,21 @@ function generate() {
       "${file}"
   done
 
+  # The following services are implemented by external services that the
+  # daemon connects to, or are only called by other daemons, so they don't
+  # require REST proxies or swagger docs.
+  EXTERNAL_PROTOS="coinselectrpc/coinselect.proto remotesignerrpc/remotesigner.proto"
+
+  for file in $EXTERNAL_PROTOS; do
+    DIRECTORY=$(dirname "${file}")
+    echo "Generating protos from ${file}, into ${DIRECTORY}"
+
+    protoc -I/usr/local/include -I. \
+      --go_out . --go_opt paths=source_relative \
+      --go-grpc_out . --go-grpc_opt paths=source_relative \
+      "${file}"
+  done
+
   # Generate the JSON/WASM client stubs.
   falafel=$(which falafel)
   pkg="taprpc"
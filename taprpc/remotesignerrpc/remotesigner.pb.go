@@ -0,0 +1,687 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.6.1
+// source: remotesignerrpc/remotesigner.proto
+
+package remotesignerrpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OpenSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the watch-only daemon, which is recorded with all signing
+	// requests of the session.
+	ClientName string `protobuf:"bytes,1,opt,name=client_name,json=clientName,proto3" json:"client_name,omitempty"`
+}
+
+func (x *OpenSessionRequest) Reset() {
+	*x = OpenSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotesignerrpc_remotesigner_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenSessionRequest) ProtoMessage() {}
+
+func (x *OpenSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_remotesignerrpc_remotesigner_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenSessionRequest.ProtoReflect.Descriptor instead.
+func (*OpenSessionRequest) Descriptor() ([]byte, []int) {
+	return file_remotesignerrpc_remotesigner_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OpenSessionRequest) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+type OpenSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the session, which must be sent with every signing request.
+	SessionId []byte `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// The Unix timestamp in seconds at which the session expires.
+	ExpiryTimestamp int64 `protobuf:"varint,2,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty"`
+}
+
+func (x *OpenSessionResponse) Reset() {
+	*x = OpenSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotesignerrpc_remotesigner_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenSessionResponse) ProtoMessage() {}
+
+func (x *OpenSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remotesignerrpc_remotesigner_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenSessionResponse.ProtoReflect.Descriptor instead.
+func (*OpenSessionResponse) Descriptor() ([]byte, []int) {
+	return file_remotesignerrpc_remotesigner_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OpenSessionResponse) GetSessionId() []byte {
+	if x != nil {
+		return x.SessionId
+	}
+	return nil
+}
+
+func (x *OpenSessionResponse) GetExpiryTimestamp() int64 {
+	if x != nil {
+		return x.ExpiryTimestamp
+	}
+	return 0
+}
+
+type PacketInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the asset spent by the input.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The amount of the asset spent by the input.
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The script key of the asset spent by the input.
+	ScriptKey []byte `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The anchor outpoint of the input, in the form of txid:index.
+	AnchorOutpoint string `protobuf:"bytes,4,opt,name=anchor_outpoint,json=anchorOutpoint,proto3" json:"anchor_outpoint,omitempty"`
+}
+
+func (x *PacketInput) Reset() {
+	*x = PacketInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotesignerrpc_remotesigner_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketInput) ProtoMessage() {}
+
+func (x *PacketInput) ProtoReflect() protoreflect.Message {
+	mi := &file_remotesignerrpc_remotesigner_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketInput.ProtoReflect.Descriptor instead.
+func (*PacketInput) Descriptor() ([]byte, []int) {
+	return file_remotesignerrpc_remotesigner_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PacketInput) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *PacketInput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PacketInput) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *PacketInput) GetAnchorOutpoint() string {
+	if x != nil {
+		return x.AnchorOutpoint
+	}
+	return ""
+}
+
+type PacketOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the asset of the output.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The amount of the asset of the output.
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The script key of the output.
+	ScriptKey []byte `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The index of the anchor output the output is committed to.
+	AnchorOutputIndex uint32 `protobuf:"varint,4,opt,name=anchor_output_index,json=anchorOutputIndex,proto3" json:"anchor_output_index,omitempty"`
+	// Whether the output is a split root, which usually carries the change of a
+	// transfer.
+	SplitRoot bool `protobuf:"varint,5,opt,name=split_root,json=splitRoot,proto3" json:"split_root,omitempty"`
+}
+
+func (x *PacketOutput) Reset() {
+	*x = PacketOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotesignerrpc_remotesigner_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketOutput) ProtoMessage() {}
+
+func (x *PacketOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_remotesignerrpc_remotesigner_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketOutput.ProtoReflect.Descriptor instead.
+func (*PacketOutput) Descriptor() ([]byte, []int) {
+	return file_remotesignerrpc_remotesigner_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PacketOutput) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *PacketOutput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PacketOutput) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *PacketOutput) GetAnchorOutputIndex() uint32 {
+	if x != nil {
+		return x.AnchorOutputIndex
+	}
+	return 0
+}
+
+func (x *PacketOutput) GetSplitRoot() bool {
+	if x != nil {
+		return x.SplitRoot
+	}
+	return false
+}
+
+type PacketSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The inputs of the virtual packet.
+	Inputs []*PacketInput `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// The outputs of the virtual packet.
+	Outputs []*PacketOutput `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+}
+
+func (x *PacketSummary) Reset() {
+	*x = PacketSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotesignerrpc_remotesigner_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketSummary) ProtoMessage() {}
+
+func (x *PacketSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_remotesignerrpc_remotesigner_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketSummary.ProtoReflect.Descriptor instead.
+func (*PacketSummary) Descriptor() ([]byte, []int) {
+	return file_remotesignerrpc_remotesigner_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PacketSummary) GetInputs() []*PacketInput {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *PacketSummary) GetOutputs() []*PacketOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+type SignVirtualPacketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the session the request is sent in.
+	SessionId []byte `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// The unsigned virtual packet.
+	VirtualPsbt []byte `protobuf:"bytes,2,opt,name=virtual_psbt,json=virtualPsbt,proto3" json:"virtual_psbt,omitempty"`
+	// The summary of the virtual packet as built by the watch-only daemon. The
+	// remote signer rejects the request if the summary doesn't match the packet,
+	// so its signature policies and logs can rely on it.
+	Summary *PacketSummary `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (x *SignVirtualPacketRequest) Reset() {
+	*x = SignVirtualPacketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotesignerrpc_remotesigner_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignVirtualPacketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignVirtualPacketRequest) ProtoMessage() {}
+
+func (x *SignVirtualPacketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_remotesignerrpc_remotesigner_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignVirtualPacketRequest.ProtoReflect.Descriptor instead.
+func (*SignVirtualPacketRequest) Descriptor() ([]byte, []int) {
+	return file_remotesignerrpc_remotesigner_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SignVirtualPacketRequest) GetSessionId() []byte {
+	if x != nil {
+		return x.SessionId
+	}
+	return nil
+}
+
+func (x *SignVirtualPacketRequest) GetVirtualPsbt() []byte {
+	if x != nil {
+		return x.VirtualPsbt
+	}
+	return nil
+}
+
+func (x *SignVirtualPacketRequest) GetSummary() *PacketSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type SignVirtualPacketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The virtual packet with the witnesses of all inputs added.
+	SignedVirtualPsbt []byte `protobuf:"bytes,1,opt,name=signed_virtual_psbt,json=signedVirtualPsbt,proto3" json:"signed_virtual_psbt,omitempty"`
+}
+
+func (x *SignVirtualPacketResponse) Reset() {
+	*x = SignVirtualPacketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotesignerrpc_remotesigner_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignVirtualPacketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignVirtualPacketResponse) ProtoMessage() {}
+
+func (x *SignVirtualPacketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remotesignerrpc_remotesigner_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignVirtualPacketResponse.ProtoReflect.Descriptor instead.
+func (*SignVirtualPacketResponse) Descriptor() ([]byte, []int) {
+	return file_remotesignerrpc_remotesigner_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SignVirtualPacketResponse) GetSignedVirtualPsbt() []byte {
+	if x != nil {
+		return x.SignedVirtualPsbt
+	}
+	return nil
+}
+
+var File_remotesignerrpc_remotesigner_proto protoreflect.FileDescriptor
+
+var file_remotesignerrpc_remotesigner_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x72, 0x70,
+	0x63, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x72, 0x70, 0x63, 0x22, 0x35, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5f, 0x0a, 0x13,
+	0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x88, 0x01,
+	0x0a, 0x0b, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12,
+	0x27, 0x0a, 0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0xaf, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x7e, 0x0a, 0x0d, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x73, 0x12, 0x37, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x18, 0x53,
+	0x69, 0x67, 0x6e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x5f, 0x70, 0x73, 0x62, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x76, 0x69,
+	0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x22, 0x4b, 0x0a, 0x19, 0x53, 0x69, 0x67, 0x6e, 0x56, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x13, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x5f, 0x70, 0x73, 0x62, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74,
+	0x32, 0xd4, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x12, 0x58, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x23, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x72,
+	0x70, 0x63, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x11, 0x53,
+	0x69, 0x67, 0x6e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x29, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2d, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_remotesignerrpc_remotesigner_proto_rawDescOnce sync.Once
+	file_remotesignerrpc_remotesigner_proto_rawDescData = file_remotesignerrpc_remotesigner_proto_rawDesc
+)
+
+func file_remotesignerrpc_remotesigner_proto_rawDescGZIP() []byte {
+	file_remotesignerrpc_remotesigner_proto_rawDescOnce.Do(func() {
+		file_remotesignerrpc_remotesigner_proto_rawDescData = protoimpl.X.CompressGZIP(file_remotesignerrpc_remotesigner_proto_rawDescData)
+	})
+	return file_remotesignerrpc_remotesigner_proto_rawDescData
+}
+
+var file_remotesignerrpc_remotesigner_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_remotesignerrpc_remotesigner_proto_goTypes = []interface{}{
+	(*OpenSessionRequest)(nil),        // 0: remotesignerrpc.OpenSessionRequest
+	(*OpenSessionResponse)(nil),       // 1: remotesignerrpc.OpenSessionResponse
+	(*PacketInput)(nil),               // 2: remotesignerrpc.PacketInput
+	(*PacketOutput)(nil),              // 3: remotesignerrpc.PacketOutput
+	(*PacketSummary)(nil),             // 4: remotesignerrpc.PacketSummary
+	(*SignVirtualPacketRequest)(nil),  // 5: remotesignerrpc.SignVirtualPacketRequest
+	(*SignVirtualPacketResponse)(nil), // 6: remotesignerrpc.SignVirtualPacketResponse
+}
+var file_remotesignerrpc_remotesigner_proto_depIdxs = []int32{
+	2, // 0: remotesignerrpc.PacketSummary.inputs:type_name -> remotesignerrpc.PacketInput
+	3, // 1: remotesignerrpc.PacketSummary.outputs:type_name -> remotesignerrpc.PacketOutput
+	4, // 2: remotesignerrpc.SignVirtualPacketRequest.summary:type_name -> remotesignerrpc.PacketSummary
+	0, // 3: remotesignerrpc.RemoteSigner.OpenSession:input_type -> remotesignerrpc.OpenSessionRequest
+	5, // 4: remotesignerrpc.RemoteSigner.SignVirtualPacket:input_type -> remotesignerrpc.SignVirtualPacketRequest
+	1, // 5: remotesignerrpc.RemoteSigner.OpenSession:output_type -> remotesignerrpc.OpenSessionResponse
+	6, // 6: remotesignerrpc.RemoteSigner.SignVirtualPacket:output_type -> remotesignerrpc.SignVirtualPacketResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_remotesignerrpc_remotesigner_proto_init() }
+func file_remotesignerrpc_remotesigner_proto_init() {
+	if File_remotesignerrpc_remotesigner_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_remotesignerrpc_remotesigner_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotesignerrpc_remotesigner_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotesignerrpc_remotesigner_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotesignerrpc_remotesigner_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotesignerrpc_remotesigner_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotesignerrpc_remotesigner_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignVirtualPacketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotesignerrpc_remotesigner_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignVirtualPacketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_remotesignerrpc_remotesigner_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_remotesignerrpc_remotesigner_proto_goTypes,
+		DependencyIndexes: file_remotesignerrpc_remotesigner_proto_depIdxs,
+		MessageInfos:      file_remotesignerrpc_remotesigner_proto_msgTypes,
+	}.Build()
+	File_remotesignerrpc_remotesigner_proto = out.File
+	file_remotesignerrpc_remotesigner_proto_rawDesc = nil
+	file_remotesignerrpc_remotesigner_proto_goTypes = nil
+	file_remotesignerrpc_remotesigner_proto_depIdxs = nil
+}
@@ -0,0 +1,149 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package remotesignerrpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RemoteSignerClient is the client API for RemoteSigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RemoteSignerClient interface {
+	// OpenSession authenticates a watch-only daemon with the remote signer and
+	// opens a signing session. All virtual packets are signed within a session,
+	// which expires after the session lifetime of the remote signer.
+	OpenSession(ctx context.Context, in *OpenSessionRequest, opts ...grpc.CallOption) (*OpenSessionResponse, error)
+	// SignVirtualPacket signs all inputs of the given virtual packet, if the
+	// packet matches its summary and is accepted by all signature policies of the
+	// remote signer.
+	SignVirtualPacket(ctx context.Context, in *SignVirtualPacketRequest, opts ...grpc.CallOption) (*SignVirtualPacketResponse, error)
+}
+
+type remoteSignerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRemoteSignerClient(cc grpc.ClientConnInterface) RemoteSignerClient {
+	return &remoteSignerClient{cc}
+}
+
+func (c *remoteSignerClient) OpenSession(ctx context.Context, in *OpenSessionRequest, opts ...grpc.CallOption) (*OpenSessionResponse, error) {
+	out := new(OpenSessionResponse)
+	err := c.cc.Invoke(ctx, "/remotesignerrpc.RemoteSigner/OpenSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerClient) SignVirtualPacket(ctx context.Context, in *SignVirtualPacketRequest, opts ...grpc.CallOption) (*SignVirtualPacketResponse, error) {
+	out := new(SignVirtualPacketResponse)
+	err := c.cc.Invoke(ctx, "/remotesignerrpc.RemoteSigner/SignVirtualPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RemoteSignerServer is the server API for RemoteSigner service.
+// All implementations must embed UnimplementedRemoteSignerServer
+// for forward compatibility
+type RemoteSignerServer interface {
+	// OpenSession authenticates a watch-only daemon with the remote signer and
+	// opens a signing session. All virtual packets are signed within a session,
+	// which expires after the session lifetime of the remote signer.
+	OpenSession(context.Context, *OpenSessionRequest) (*OpenSessionResponse, error)
+	// SignVirtualPacket signs all inputs of the given virtual packet, if the
+	// packet matches its summary and is accepted by all signature policies of the
+	// remote signer.
+	SignVirtualPacket(context.Context, *SignVirtualPacketRequest) (*SignVirtualPacketResponse, error)
+	mustEmbedUnimplementedRemoteSignerServer()
+}
+
+// UnimplementedRemoteSignerServer must be embedded to have forward compatible implementations.
+type UnimplementedRemoteSignerServer struct {
+}
+
+func (UnimplementedRemoteSignerServer) OpenSession(context.Context, *OpenSessionRequest) (*OpenSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenSession not implemented")
+}
+func (UnimplementedRemoteSignerServer) SignVirtualPacket(context.Context, *SignVirtualPacketRequest) (*SignVirtualPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignVirtualPacket not implemented")
+}
+func (UnimplementedRemoteSignerServer) mustEmbedUnimplementedRemoteSignerServer() {}
+
+// UnsafeRemoteSignerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RemoteSignerServer will
+// result in compilation errors.
+type UnsafeRemoteSignerServer interface {
+	mustEmbedUnimplementedRemoteSignerServer()
+}
+
+func RegisterRemoteSignerServer(s grpc.ServiceRegistrar, srv RemoteSignerServer) {
+	s.RegisterService(&RemoteSigner_ServiceDesc, srv)
+}
+
+func _RemoteSigner_OpenSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).OpenSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/remotesignerrpc.RemoteSigner/OpenSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).OpenSession(ctx, req.(*OpenSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSigner_SignVirtualPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignVirtualPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).SignVirtualPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/remotesignerrpc.RemoteSigner/SignVirtualPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).SignVirtualPacket(ctx, req.(*SignVirtualPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RemoteSigner_ServiceDesc is the grpc.ServiceDesc for RemoteSigner service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RemoteSigner_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "remotesignerrpc.RemoteSigner",
+	HandlerType: (*RemoteSignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OpenSession",
+			Handler:    _RemoteSigner_OpenSession_Handler,
+		},
+		{
+			MethodName: "SignVirtualPacket",
+			Handler:    _RemoteSigner_SignVirtualPacket_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "remotesignerrpc/remotesigner.proto",
+}
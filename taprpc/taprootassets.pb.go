@@ -78,15 +78,20 @@ const (
 	// Opaque is used for asset meta blobs that have no true structure and instead
 	// should be interpreted as opaque blobs.
 	AssetMetaType_META_TYPE_OPAQUE AssetMetaType = 0
+	// URI is used for asset meta that references content stored off-chain. The
+	// data is a TLV encoded URI and the sha256 hash of the referenced content.
+	AssetMetaType_META_TYPE_URI AssetMetaType = 2
 )
 
 // Enum value maps for AssetMetaType.
 var (
 	AssetMetaType_name = map[int32]string{
 		0: "META_TYPE_OPAQUE",
+		2: "META_TYPE_URI",
 	}
 	AssetMetaType_value = map[string]int32{
 		"META_TYPE_OPAQUE": 0,
+		"META_TYPE_URI":    2,
 	}
 )
 
@@ -117,6 +122,436 @@ func (AssetMetaType) EnumDescriptor() ([]byte, []int) {
 	return file_taprootassets_proto_rawDescGZIP(), []int{1}
 }
 
+type ParcelPriority int32
+
+const (
+	// Normal transfers are started in the order they arrive in, as soon as a
+	// worker is free.
+	ParcelPriority_PARCEL_PRIORITY_NORMAL ParcelPriority = 0
+	// Urgent transfers are started immediately on a dedicated worker, before any
+	// other transfer.
+	ParcelPriority_PARCEL_PRIORITY_URGENT ParcelPriority = 1
+	// Batchable transfers aren't time sensitive. They are held back and started
+	// together once per batch interval, after all normal transfers.
+	ParcelPriority_PARCEL_PRIORITY_BATCHABLE ParcelPriority = 2
+)
+
+// Enum value maps for ParcelPriority.
+var (
+	ParcelPriority_name = map[int32]string{
+		0: "PARCEL_PRIORITY_NORMAL",
+		1: "PARCEL_PRIORITY_URGENT",
+		2: "PARCEL_PRIORITY_BATCHABLE",
+	}
+	ParcelPriority_value = map[string]int32{
+		"PARCEL_PRIORITY_NORMAL":    0,
+		"PARCEL_PRIORITY_URGENT":    1,
+		"PARCEL_PRIORITY_BATCHABLE": 2,
+	}
+)
+
+func (x ParcelPriority) Enum() *ParcelPriority {
+	p := new(ParcelPriority)
+	*p = x
+	return p
+}
+
+func (x ParcelPriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParcelPriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_taprootassets_proto_enumTypes[2].Descriptor()
+}
+
+func (ParcelPriority) Type() protoreflect.EnumType {
+	return &file_taprootassets_proto_enumTypes[2]
+}
+
+func (x ParcelPriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParcelPriority.Descriptor instead.
+func (ParcelPriority) EnumDescriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{2}
+}
+
+type ParcelLogEntryType int32
+
+const (
+	// An executed state of the send state machine.
+	ParcelLogEntryType_PARCEL_LOG_ENTRY_STATE ParcelLogEntryType = 0
+	// A call to the chain backend or wallet.
+	ParcelLogEntryType_PARCEL_LOG_ENTRY_BACKEND_CALL ParcelLogEntryType = 1
+	// A debug message, only recorded if scoped debug logging is enabled.
+	ParcelLogEntryType_PARCEL_LOG_ENTRY_MESSAGE ParcelLogEntryType = 2
+)
+
+// Enum value maps for ParcelLogEntryType.
+var (
+	ParcelLogEntryType_name = map[int32]string{
+		0: "PARCEL_LOG_ENTRY_STATE",
+		1: "PARCEL_LOG_ENTRY_BACKEND_CALL",
+		2: "PARCEL_LOG_ENTRY_MESSAGE",
+	}
+	ParcelLogEntryType_value = map[string]int32{
+		"PARCEL_LOG_ENTRY_STATE":        0,
+		"PARCEL_LOG_ENTRY_BACKEND_CALL": 1,
+		"PARCEL_LOG_ENTRY_MESSAGE":      2,
+	}
+)
+
+func (x ParcelLogEntryType) Enum() *ParcelLogEntryType {
+	p := new(ParcelLogEntryType)
+	*p = x
+	return p
+}
+
+func (x ParcelLogEntryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParcelLogEntryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_taprootassets_proto_enumTypes[3].Descriptor()
+}
+
+func (ParcelLogEntryType) Type() protoreflect.EnumType {
+	return &file_taprootassets_proto_enumTypes[3]
+}
+
+func (x ParcelLogEntryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParcelLogEntryType.Descriptor instead.
+func (ParcelLogEntryType) EnumDescriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{3}
+}
+
+type StatementFormat int32
+
+const (
+	// The statement is rendered as CSV with a single header line.
+	StatementFormat_STATEMENT_FORMAT_CSV StatementFormat = 0
+	// The statement is rendered as JSON lines, one object per entry.
+	StatementFormat_STATEMENT_FORMAT_JSONL StatementFormat = 1
+)
+
+// Enum value maps for StatementFormat.
+var (
+	StatementFormat_name = map[int32]string{
+		0: "STATEMENT_FORMAT_CSV",
+		1: "STATEMENT_FORMAT_JSONL",
+	}
+	StatementFormat_value = map[string]int32{
+		"STATEMENT_FORMAT_CSV":   0,
+		"STATEMENT_FORMAT_JSONL": 1,
+	}
+)
+
+func (x StatementFormat) Enum() *StatementFormat {
+	p := new(StatementFormat)
+	*p = x
+	return p
+}
+
+func (x StatementFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatementFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_taprootassets_proto_enumTypes[4].Descriptor()
+}
+
+func (StatementFormat) Type() protoreflect.EnumType {
+	return &file_taprootassets_proto_enumTypes[4]
+}
+
+func (x StatementFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatementFormat.Descriptor instead.
+func (StatementFormat) EnumDescriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{4}
+}
+
+type CoinSelectStrategy int32
+
+const (
+	// The default coin selection strategy of the daemon is used.
+	CoinSelectStrategy_COIN_SELECT_STRATEGY_DEFAULT CoinSelectStrategy = 0
+	// The coins with the largest amounts are spent first.
+	CoinSelectStrategy_COIN_SELECT_STRATEGY_MAX_AMOUNT CoinSelectStrategy = 1
+	// The oldest lots are spent first (first in, first out). Lots acquired before
+	// lots were tracked are considered the oldest.
+	CoinSelectStrategy_COIN_SELECT_STRATEGY_OLDEST_LOT CoinSelectStrategy = 2
+	// The newest lots are spent first (last in, first out).
+	CoinSelectStrategy_COIN_SELECT_STRATEGY_NEWEST_LOT CoinSelectStrategy = 3
+)
+
+// Enum value maps for CoinSelectStrategy.
+var (
+	CoinSelectStrategy_name = map[int32]string{
+		0: "COIN_SELECT_STRATEGY_DEFAULT",
+		1: "COIN_SELECT_STRATEGY_MAX_AMOUNT",
+		2: "COIN_SELECT_STRATEGY_OLDEST_LOT",
+		3: "COIN_SELECT_STRATEGY_NEWEST_LOT",
+	}
+	CoinSelectStrategy_value = map[string]int32{
+		"COIN_SELECT_STRATEGY_DEFAULT":    0,
+		"COIN_SELECT_STRATEGY_MAX_AMOUNT": 1,
+		"COIN_SELECT_STRATEGY_OLDEST_LOT": 2,
+		"COIN_SELECT_STRATEGY_NEWEST_LOT": 3,
+	}
+)
+
+func (x CoinSelectStrategy) Enum() *CoinSelectStrategy {
+	p := new(CoinSelectStrategy)
+	*p = x
+	return p
+}
+
+func (x CoinSelectStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CoinSelectStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_taprootassets_proto_enumTypes[5].Descriptor()
+}
+
+func (CoinSelectStrategy) Type() protoreflect.EnumType {
+	return &file_taprootassets_proto_enumTypes[5]
+}
+
+func (x CoinSelectStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CoinSelectStrategy.Descriptor instead.
+func (CoinSelectStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{5}
+}
+
+type AssetAliasSource int32
+
+const (
+	// The alias was set by the operator of the local node.
+	AssetAliasSource_ASSET_ALIAS_SOURCE_LOCAL AssetAliasSource = 0
+	// The alias was resolved through the remote registry.
+	AssetAliasSource_ASSET_ALIAS_SOURCE_REMOTE AssetAliasSource = 1
+)
+
+// Enum value maps for AssetAliasSource.
+var (
+	AssetAliasSource_name = map[int32]string{
+		0: "ASSET_ALIAS_SOURCE_LOCAL",
+		1: "ASSET_ALIAS_SOURCE_REMOTE",
+	}
+	AssetAliasSource_value = map[string]int32{
+		"ASSET_ALIAS_SOURCE_LOCAL":  0,
+		"ASSET_ALIAS_SOURCE_REMOTE": 1,
+	}
+)
+
+func (x AssetAliasSource) Enum() *AssetAliasSource {
+	p := new(AssetAliasSource)
+	*p = x
+	return p
+}
+
+func (x AssetAliasSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssetAliasSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_taprootassets_proto_enumTypes[6].Descriptor()
+}
+
+func (AssetAliasSource) Type() protoreflect.EnumType {
+	return &file_taprootassets_proto_enumTypes[6]
+}
+
+func (x AssetAliasSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssetAliasSource.Descriptor instead.
+func (AssetAliasSource) EnumDescriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{6}
+}
+
+type KeyPurpose int32
+
+const (
+	KeyPurpose_KEY_PURPOSE_UNKNOWN KeyPurpose = 0
+	// The internal key of the anchor output of a minting batch, which is also
+	// the batch key.
+	KeyPurpose_KEY_PURPOSE_BATCH_INTERNAL_KEY KeyPurpose = 1
+	// The script key of a newly minted asset.
+	KeyPurpose_KEY_PURPOSE_MINT_SCRIPT_KEY KeyPurpose = 2
+	// The raw group key of a newly minted asset that permits ongoing emission.
+	KeyPurpose_KEY_PURPOSE_GROUP_KEY KeyPurpose = 3
+	// The script key of the change output of an outbound transfer.
+	KeyPurpose_KEY_PURPOSE_CHANGE_SCRIPT_KEY KeyPurpose = 4
+	// The internal key of a local anchor output of an outbound transfer.
+	KeyPurpose_KEY_PURPOSE_ANCHOR_INTERNAL_KEY KeyPurpose = 5
+)
+
+// Enum value maps for KeyPurpose.
+var (
+	KeyPurpose_name = map[int32]string{
+		0: "KEY_PURPOSE_UNKNOWN",
+		1: "KEY_PURPOSE_BATCH_INTERNAL_KEY",
+		2: "KEY_PURPOSE_MINT_SCRIPT_KEY",
+		3: "KEY_PURPOSE_GROUP_KEY",
+		4: "KEY_PURPOSE_CHANGE_SCRIPT_KEY",
+		5: "KEY_PURPOSE_ANCHOR_INTERNAL_KEY",
+	}
+	KeyPurpose_value = map[string]int32{
+		"KEY_PURPOSE_UNKNOWN":             0,
+		"KEY_PURPOSE_BATCH_INTERNAL_KEY":  1,
+		"KEY_PURPOSE_MINT_SCRIPT_KEY":     2,
+		"KEY_PURPOSE_GROUP_KEY":           3,
+		"KEY_PURPOSE_CHANGE_SCRIPT_KEY":   4,
+		"KEY_PURPOSE_ANCHOR_INTERNAL_KEY": 5,
+	}
+)
+
+func (x KeyPurpose) Enum() *KeyPurpose {
+	p := new(KeyPurpose)
+	*p = x
+	return p
+}
+
+func (x KeyPurpose) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyPurpose) Descriptor() protoreflect.EnumDescriptor {
+	return file_taprootassets_proto_enumTypes[7].Descriptor()
+}
+
+func (KeyPurpose) Type() protoreflect.EnumType {
+	return &file_taprootassets_proto_enumTypes[7]
+}
+
+func (x KeyPurpose) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyPurpose.Descriptor instead.
+func (KeyPurpose) EnumDescriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{7}
+}
+
+type ScriptKeyConflict int32
+
+const (
+	// The script key is used by more than one unspent asset.
+	ScriptKeyConflict_SCRIPT_KEY_CONFLICT_UNSPENT ScriptKeyConflict = 0
+	// The script key is used by the outputs of more than one transfer.
+	ScriptKeyConflict_SCRIPT_KEY_CONFLICT_TRANSFERS ScriptKeyConflict = 1
+	// The archived proof of an unspent asset was overwritten by the proof of
+	// another asset with the same script key.
+	ScriptKeyConflict_SCRIPT_KEY_CONFLICT_PROOF ScriptKeyConflict = 2
+)
+
+// Enum value maps for ScriptKeyConflict.
+var (
+	ScriptKeyConflict_name = map[int32]string{
+		0: "SCRIPT_KEY_CONFLICT_UNSPENT",
+		1: "SCRIPT_KEY_CONFLICT_TRANSFERS",
+		2: "SCRIPT_KEY_CONFLICT_PROOF",
+	}
+	ScriptKeyConflict_value = map[string]int32{
+		"SCRIPT_KEY_CONFLICT_UNSPENT":   0,
+		"SCRIPT_KEY_CONFLICT_TRANSFERS": 1,
+		"SCRIPT_KEY_CONFLICT_PROOF":     2,
+	}
+)
+
+func (x ScriptKeyConflict) Enum() *ScriptKeyConflict {
+	p := new(ScriptKeyConflict)
+	*p = x
+	return p
+}
+
+func (x ScriptKeyConflict) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScriptKeyConflict) Descriptor() protoreflect.EnumDescriptor {
+	return file_taprootassets_proto_enumTypes[8].Descriptor()
+}
+
+func (ScriptKeyConflict) Type() protoreflect.EnumType {
+	return &file_taprootassets_proto_enumTypes[8]
+}
+
+func (x ScriptKeyConflict) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScriptKeyConflict.Descriptor instead.
+func (ScriptKeyConflict) EnumDescriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{8}
+}
+
+type ChangeKeyPolicy int32
+
+const (
+	// A new change key was derived for the transfer from the main key family.
+	ChangeKeyPolicy_CHANGE_KEY_POLICY_ROTATE ChangeKeyPolicy = 0
+	// The change was sent to the stable change key shared by all transfers.
+	ChangeKeyPolicy_CHANGE_KEY_POLICY_STABLE ChangeKeyPolicy = 1
+	// A new change key was derived for the transfer from the dedicated change
+	// key family.
+	ChangeKeyPolicy_CHANGE_KEY_POLICY_SEPARATE_ACCOUNT ChangeKeyPolicy = 2
+)
+
+// Enum value maps for ChangeKeyPolicy.
+var (
+	ChangeKeyPolicy_name = map[int32]string{
+		0: "CHANGE_KEY_POLICY_ROTATE",
+		1: "CHANGE_KEY_POLICY_STABLE",
+		2: "CHANGE_KEY_POLICY_SEPARATE_ACCOUNT",
+	}
+	ChangeKeyPolicy_value = map[string]int32{
+		"CHANGE_KEY_POLICY_ROTATE":           0,
+		"CHANGE_KEY_POLICY_STABLE":           1,
+		"CHANGE_KEY_POLICY_SEPARATE_ACCOUNT": 2,
+	}
+)
+
+func (x ChangeKeyPolicy) Enum() *ChangeKeyPolicy {
+	p := new(ChangeKeyPolicy)
+	*p = x
+	return p
+}
+
+func (x ChangeKeyPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeKeyPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_taprootassets_proto_enumTypes[9].Descriptor()
+}
+
+func (ChangeKeyPolicy) Type() protoreflect.EnumType {
+	return &file_taprootassets_proto_enumTypes[9]
+}
+
+func (x ChangeKeyPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeKeyPolicy.Descriptor instead.
+func (ChangeKeyPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{9}
+}
+
 type OutputType int32
 
 const (
@@ -166,11 +601,11 @@ func (x OutputType) String() string {
 }
 
 func (OutputType) Descriptor() protoreflect.EnumDescriptor {
-	return file_taprootassets_proto_enumTypes[2].Descriptor()
+	return file_taprootassets_proto_enumTypes[10].Descriptor()
 }
 
 func (OutputType) Type() protoreflect.EnumType {
-	return &file_taprootassets_proto_enumTypes[2]
+	return &file_taprootassets_proto_enumTypes[10]
 }
 
 func (x OutputType) Number() protoreflect.EnumNumber {
@@ -179,7 +614,7 @@ func (x OutputType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OutputType.Descriptor instead.
 func (OutputType) EnumDescriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{2}
+	return file_taprootassets_proto_rawDescGZIP(), []int{10}
 }
 
 type AddrEventStatus int32
@@ -221,11 +656,11 @@ func (x AddrEventStatus) String() string {
 }
 
 func (AddrEventStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_taprootassets_proto_enumTypes[3].Descriptor()
+	return file_taprootassets_proto_enumTypes[11].Descriptor()
 }
 
 func (AddrEventStatus) Type() protoreflect.EnumType {
-	return &file_taprootassets_proto_enumTypes[3]
+	return &file_taprootassets_proto_enumTypes[11]
 }
 
 func (x AddrEventStatus) Number() protoreflect.EnumNumber {
@@ -234,45 +669,264 @@ func (x AddrEventStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AddrEventStatus.Descriptor instead.
 func (AddrEventStatus) EnumDescriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{3}
+	return file_taprootassets_proto_rawDescGZIP(), []int{11}
 }
 
-type AssetMeta struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type AddrDepositStatus int32
 
-	// The raw data of the asset meta data. Based on the type below, this may be
-	// structured data such as a text file or PDF.
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	// The type of the asset meta data.
-	Type AssetMetaType `protobuf:"varint,2,opt,name=type,proto3,enum=taprpc.AssetMetaType" json:"type,omitempty"`
-	// The hash of the meta. This is the hash of the TLV serialization of the meta
-	// itself.
-	MetaHash []byte `protobuf:"bytes,3,opt,name=meta_hash,json=metaHash,proto3" json:"meta_hash,omitempty"`
-}
+const (
+	// The address wasn't annotated with a deposit expectation.
+	AddrDepositStatus_ADDR_DEPOSIT_STATUS_UNANNOTATED AddrDepositStatus = 0
+	// The deposit arrived before the expiry of the address expectation.
+	AddrDepositStatus_ADDR_DEPOSIT_STATUS_EXPECTED AddrDepositStatus = 1
+	// The deposit arrived after the expiry of the address expectation.
+	AddrDepositStatus_ADDR_DEPOSIT_STATUS_LATE AddrDepositStatus = 2
+)
 
-func (x *AssetMeta) Reset() {
-	*x = AssetMeta{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for AddrDepositStatus.
+var (
+	AddrDepositStatus_name = map[int32]string{
+		0: "ADDR_DEPOSIT_STATUS_UNANNOTATED",
+		1: "ADDR_DEPOSIT_STATUS_EXPECTED",
+		2: "ADDR_DEPOSIT_STATUS_LATE",
 	}
+	AddrDepositStatus_value = map[string]int32{
+		"ADDR_DEPOSIT_STATUS_UNANNOTATED": 0,
+		"ADDR_DEPOSIT_STATUS_EXPECTED":    1,
+		"ADDR_DEPOSIT_STATUS_LATE":        2,
+	}
+)
+
+func (x AddrDepositStatus) Enum() *AddrDepositStatus {
+	p := new(AddrDepositStatus)
+	*p = x
+	return p
 }
 
-func (x *AssetMeta) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x AddrDepositStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*AssetMeta) ProtoMessage() {}
+func (AddrDepositStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_taprootassets_proto_enumTypes[12].Descriptor()
+}
 
-func (x *AssetMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+func (AddrDepositStatus) Type() protoreflect.EnumType {
+	return &file_taprootassets_proto_enumTypes[12]
+}
+
+func (x AddrDepositStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AddrDepositStatus.Descriptor instead.
+func (AddrDepositStatus) EnumDescriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{12}
+}
+
+type InvoiceState int32
+
+const (
+	// The invoice wasn't paid yet and hasn't expired.
+	InvoiceState_INVOICE_STATE_OPEN InvoiceState = 0
+	// The payment of the invoice was received.
+	InvoiceState_INVOICE_STATE_SETTLED InvoiceState = 1
+	// The invoice expired before its payment was received.
+	InvoiceState_INVOICE_STATE_EXPIRED InvoiceState = 2
+)
+
+// Enum value maps for InvoiceState.
+var (
+	InvoiceState_name = map[int32]string{
+		0: "INVOICE_STATE_OPEN",
+		1: "INVOICE_STATE_SETTLED",
+		2: "INVOICE_STATE_EXPIRED",
+	}
+	InvoiceState_value = map[string]int32{
+		"INVOICE_STATE_OPEN":    0,
+		"INVOICE_STATE_SETTLED": 1,
+		"INVOICE_STATE_EXPIRED": 2,
+	}
+)
+
+func (x InvoiceState) Enum() *InvoiceState {
+	p := new(InvoiceState)
+	*p = x
+	return p
+}
+
+func (x InvoiceState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InvoiceState) Descriptor() protoreflect.EnumDescriptor {
+	return file_taprootassets_proto_enumTypes[13].Descriptor()
+}
+
+func (InvoiceState) Type() protoreflect.EnumType {
+	return &file_taprootassets_proto_enumTypes[13]
+}
+
+func (x InvoiceState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InvoiceState.Descriptor instead.
+func (InvoiceState) EnumDescriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{13}
+}
+
+type PartialSendMode int32
+
+const (
+	// The transfer fails if the balance can't cover all addresses.
+	PartialSendMode_PARTIAL_SEND_DISABLED PartialSendMode = 0
+	// If the balance can't cover all addresses, nothing is sent and the
+	// response only describes the partial send that is possible instead.
+	PartialSendMode_PARTIAL_SEND_PROPOSE PartialSendMode = 1
+	// If the balance can't cover all addresses, the addresses that can be
+	// covered are paid and the shortfall is reported in the response.
+	PartialSendMode_PARTIAL_SEND_EXECUTE PartialSendMode = 2
+)
+
+// Enum value maps for PartialSendMode.
+var (
+	PartialSendMode_name = map[int32]string{
+		0: "PARTIAL_SEND_DISABLED",
+		1: "PARTIAL_SEND_PROPOSE",
+		2: "PARTIAL_SEND_EXECUTE",
+	}
+	PartialSendMode_value = map[string]int32{
+		"PARTIAL_SEND_DISABLED": 0,
+		"PARTIAL_SEND_PROPOSE":  1,
+		"PARTIAL_SEND_EXECUTE":  2,
+	}
+)
+
+func (x PartialSendMode) Enum() *PartialSendMode {
+	p := new(PartialSendMode)
+	*p = x
+	return p
+}
+
+func (x PartialSendMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartialSendMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_taprootassets_proto_enumTypes[14].Descriptor()
+}
+
+func (PartialSendMode) Type() protoreflect.EnumType {
+	return &file_taprootassets_proto_enumTypes[14]
+}
+
+func (x PartialSendMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartialSendMode.Descriptor instead.
+func (PartialSendMode) EnumDescriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{14}
+}
+
+type AirdropRecipientStatus int32
+
+const (
+	// The recipient wasn't paid yet.
+	AirdropRecipientStatus_AIRDROP_PENDING AirdropRecipientStatus = 0
+	// The transfer to the recipient was started, but its outcome isn't known
+	// yet. If the daemon was restarted in this state, the outbound transfers
+	// need to be checked before the recipient is paid again.
+	AirdropRecipientStatus_AIRDROP_IN_FLIGHT AirdropRecipientStatus = 1
+	// The anchor transaction that pays the recipient was broadcast.
+	AirdropRecipientStatus_AIRDROP_SENT AirdropRecipientStatus = 2
+	// The transfer to the recipient failed and can be retried.
+	AirdropRecipientStatus_AIRDROP_FAILED AirdropRecipientStatus = 3
+)
+
+// Enum value maps for AirdropRecipientStatus.
+var (
+	AirdropRecipientStatus_name = map[int32]string{
+		0: "AIRDROP_PENDING",
+		1: "AIRDROP_IN_FLIGHT",
+		2: "AIRDROP_SENT",
+		3: "AIRDROP_FAILED",
+	}
+	AirdropRecipientStatus_value = map[string]int32{
+		"AIRDROP_PENDING":   0,
+		"AIRDROP_IN_FLIGHT": 1,
+		"AIRDROP_SENT":      2,
+		"AIRDROP_FAILED":    3,
+	}
+)
+
+func (x AirdropRecipientStatus) Enum() *AirdropRecipientStatus {
+	p := new(AirdropRecipientStatus)
+	*p = x
+	return p
+}
+
+func (x AirdropRecipientStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AirdropRecipientStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_taprootassets_proto_enumTypes[15].Descriptor()
+}
+
+func (AirdropRecipientStatus) Type() protoreflect.EnumType {
+	return &file_taprootassets_proto_enumTypes[15]
+}
+
+func (x AirdropRecipientStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AirdropRecipientStatus.Descriptor instead.
+func (AirdropRecipientStatus) EnumDescriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{15}
+}
+
+type AssetMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The raw data of the asset meta data. Based on the type below, this may be
+	// structured data such as a text file or PDF.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// The type of the asset meta data.
+	Type AssetMetaType `protobuf:"varint,2,opt,name=type,proto3,enum=taprpc.AssetMetaType" json:"type,omitempty"`
+	// The hash of the meta. This is the hash of the TLV serialization of the meta
+	// itself.
+	MetaHash []byte `protobuf:"bytes,3,opt,name=meta_hash,json=metaHash,proto3" json:"meta_hash,omitempty"`
+	// The verified content referenced by URI meta data. This is only set if the
+	// meta is of type META_TYPE_URI and the content was requested to be resolved.
+	UriContent *URIMetaContent `protobuf:"bytes,4,opt,name=uri_content,json=uriContent,proto3" json:"uri_content,omitempty"`
+}
+
+func (x *AssetMeta) Reset() {
+	*x = AssetMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetMeta) ProtoMessage() {}
+
+func (x *AssetMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
 		return ms
 	}
@@ -305,6 +959,79 @@ func (x *AssetMeta) GetMetaHash() []byte {
 	return nil
 }
 
+func (x *AssetMeta) GetUriContent() *URIMetaContent {
+	if x != nil {
+		return x.UriContent
+	}
+	return nil
+}
+
+type URIMetaContent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The URI the content is referenced by.
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// The MIME type of the content.
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// The raw content, verified against the hash committed to in the meta.
+	Content []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *URIMetaContent) Reset() {
+	*x = URIMetaContent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *URIMetaContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*URIMetaContent) ProtoMessage() {}
+
+func (x *URIMetaContent) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use URIMetaContent.ProtoReflect.Descriptor instead.
+func (*URIMetaContent) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *URIMetaContent) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *URIMetaContent) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *URIMetaContent) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
 type ListAssetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -318,7 +1045,7 @@ type ListAssetRequest struct {
 func (x *ListAssetRequest) Reset() {
 	*x = ListAssetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[1]
+		mi := &file_taprootassets_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -331,7 +1058,7 @@ func (x *ListAssetRequest) String() string {
 func (*ListAssetRequest) ProtoMessage() {}
 
 func (x *ListAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[1]
+	mi := &file_taprootassets_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,7 +1071,7 @@ func (x *ListAssetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAssetRequest.ProtoReflect.Descriptor instead.
 func (*ListAssetRequest) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{1}
+	return file_taprootassets_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ListAssetRequest) GetWithWitness() bool {
@@ -400,7 +1127,7 @@ type AnchorInfo struct {
 func (x *AnchorInfo) Reset() {
 	*x = AnchorInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[2]
+		mi := &file_taprootassets_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -413,7 +1140,7 @@ func (x *AnchorInfo) String() string {
 func (*AnchorInfo) ProtoMessage() {}
 
 func (x *AnchorInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[2]
+	mi := &file_taprootassets_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,7 +1153,7 @@ func (x *AnchorInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnchorInfo.ProtoReflect.Descriptor instead.
 func (*AnchorInfo) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{2}
+	return file_taprootassets_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AnchorInfo) GetAnchorTx() []byte {
@@ -508,7 +1235,7 @@ type GenesisInfo struct {
 func (x *GenesisInfo) Reset() {
 	*x = GenesisInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[3]
+		mi := &file_taprootassets_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -521,7 +1248,7 @@ func (x *GenesisInfo) String() string {
 func (*GenesisInfo) ProtoMessage() {}
 
 func (x *GenesisInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[3]
+	mi := &file_taprootassets_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -534,7 +1261,7 @@ func (x *GenesisInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenesisInfo.ProtoReflect.Descriptor instead.
 func (*GenesisInfo) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{3}
+	return file_taprootassets_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GenesisInfo) GetGenesisPoint() string {
@@ -596,7 +1323,7 @@ type AssetGroup struct {
 func (x *AssetGroup) Reset() {
 	*x = AssetGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[4]
+		mi := &file_taprootassets_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -609,7 +1336,7 @@ func (x *AssetGroup) String() string {
 func (*AssetGroup) ProtoMessage() {}
 
 func (x *AssetGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[4]
+	mi := &file_taprootassets_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,7 +1349,7 @@ func (x *AssetGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssetGroup.ProtoReflect.Descriptor instead.
 func (*AssetGroup) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{4}
+	return file_taprootassets_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AssetGroup) GetRawGroupKey() []byte {
@@ -688,7 +1415,7 @@ type Asset struct {
 func (x *Asset) Reset() {
 	*x = Asset{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[5]
+		mi := &file_taprootassets_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -701,7 +1428,7 @@ func (x *Asset) String() string {
 func (*Asset) ProtoMessage() {}
 
 func (x *Asset) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[5]
+	mi := &file_taprootassets_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -714,7 +1441,7 @@ func (x *Asset) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Asset.ProtoReflect.Descriptor instead.
 func (*Asset) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{5}
+	return file_taprootassets_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Asset) GetVersion() int32 {
@@ -835,7 +1562,7 @@ type PrevWitness struct {
 func (x *PrevWitness) Reset() {
 	*x = PrevWitness{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[6]
+		mi := &file_taprootassets_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -848,7 +1575,7 @@ func (x *PrevWitness) String() string {
 func (*PrevWitness) ProtoMessage() {}
 
 func (x *PrevWitness) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[6]
+	mi := &file_taprootassets_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -861,7 +1588,7 @@ func (x *PrevWitness) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrevWitness.ProtoReflect.Descriptor instead.
 func (*PrevWitness) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{6}
+	return file_taprootassets_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PrevWitness) GetPrevId() *PrevInputAsset {
@@ -896,7 +1623,7 @@ type SplitCommitment struct {
 func (x *SplitCommitment) Reset() {
 	*x = SplitCommitment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[7]
+		mi := &file_taprootassets_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -909,7 +1636,7 @@ func (x *SplitCommitment) String() string {
 func (*SplitCommitment) ProtoMessage() {}
 
 func (x *SplitCommitment) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[7]
+	mi := &file_taprootassets_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,7 +1649,7 @@ func (x *SplitCommitment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SplitCommitment.ProtoReflect.Descriptor instead.
 func (*SplitCommitment) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{7}
+	return file_taprootassets_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SplitCommitment) GetRootAsset() *Asset {
@@ -943,7 +1670,7 @@ type ListAssetResponse struct {
 func (x *ListAssetResponse) Reset() {
 	*x = ListAssetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[8]
+		mi := &file_taprootassets_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -956,7 +1683,7 @@ func (x *ListAssetResponse) String() string {
 func (*ListAssetResponse) ProtoMessage() {}
 
 func (x *ListAssetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[8]
+	mi := &file_taprootassets_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -969,7 +1696,7 @@ func (x *ListAssetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAssetResponse.ProtoReflect.Descriptor instead.
 func (*ListAssetResponse) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{8}
+	return file_taprootassets_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListAssetResponse) GetAssets() []*Asset {
@@ -990,7 +1717,7 @@ type ListUtxosRequest struct {
 func (x *ListUtxosRequest) Reset() {
 	*x = ListUtxosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[9]
+		mi := &file_taprootassets_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1003,7 +1730,7 @@ func (x *ListUtxosRequest) String() string {
 func (*ListUtxosRequest) ProtoMessage() {}
 
 func (x *ListUtxosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[9]
+	mi := &file_taprootassets_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1016,7 +1743,7 @@ func (x *ListUtxosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUtxosRequest.ProtoReflect.Descriptor instead.
 func (*ListUtxosRequest) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{9}
+	return file_taprootassets_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListUtxosRequest) GetIncludeLeased() bool {
@@ -1050,7 +1777,7 @@ type ManagedUtxo struct {
 func (x *ManagedUtxo) Reset() {
 	*x = ManagedUtxo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[10]
+		mi := &file_taprootassets_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1063,7 +1790,7 @@ func (x *ManagedUtxo) String() string {
 func (*ManagedUtxo) ProtoMessage() {}
 
 func (x *ManagedUtxo) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[10]
+	mi := &file_taprootassets_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1076,7 +1803,7 @@ func (x *ManagedUtxo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ManagedUtxo.ProtoReflect.Descriptor instead.
 func (*ManagedUtxo) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{10}
+	return file_taprootassets_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ManagedUtxo) GetOutPoint() string {
@@ -1133,7 +1860,7 @@ type ListUtxosResponse struct {
 func (x *ListUtxosResponse) Reset() {
 	*x = ListUtxosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[11]
+		mi := &file_taprootassets_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1146,7 +1873,7 @@ func (x *ListUtxosResponse) String() string {
 func (*ListUtxosResponse) ProtoMessage() {}
 
 func (x *ListUtxosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[11]
+	mi := &file_taprootassets_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1159,7 +1886,7 @@ func (x *ListUtxosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUtxosResponse.ProtoReflect.Descriptor instead.
 func (*ListUtxosResponse) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{11}
+	return file_taprootassets_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListUtxosResponse) GetManagedUtxos() map[string]*ManagedUtxo {
@@ -1178,7 +1905,7 @@ type ListGroupsRequest struct {
 func (x *ListGroupsRequest) Reset() {
 	*x = ListGroupsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[12]
+		mi := &file_taprootassets_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1191,7 +1918,7 @@ func (x *ListGroupsRequest) String() string {
 func (*ListGroupsRequest) ProtoMessage() {}
 
 func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[12]
+	mi := &file_taprootassets_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1204,7 +1931,7 @@ func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListGroupsRequest.ProtoReflect.Descriptor instead.
 func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{12}
+	return file_taprootassets_proto_rawDescGZIP(), []int{13}
 }
 
 type AssetHumanReadable struct {
@@ -1231,7 +1958,7 @@ type AssetHumanReadable struct {
 func (x *AssetHumanReadable) Reset() {
 	*x = AssetHumanReadable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[13]
+		mi := &file_taprootassets_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1244,7 +1971,7 @@ func (x *AssetHumanReadable) String() string {
 func (*AssetHumanReadable) ProtoMessage() {}
 
 func (x *AssetHumanReadable) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[13]
+	mi := &file_taprootassets_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1257,7 +1984,7 @@ func (x *AssetHumanReadable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssetHumanReadable.ProtoReflect.Descriptor instead.
 func (*AssetHumanReadable) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{13}
+	return file_taprootassets_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AssetHumanReadable) GetId() []byte {
@@ -1321,7 +2048,7 @@ type GroupedAssets struct {
 func (x *GroupedAssets) Reset() {
 	*x = GroupedAssets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[14]
+		mi := &file_taprootassets_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1334,7 +2061,7 @@ func (x *GroupedAssets) String() string {
 func (*GroupedAssets) ProtoMessage() {}
 
 func (x *GroupedAssets) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[14]
+	mi := &file_taprootassets_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1347,7 +2074,7 @@ func (x *GroupedAssets) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupedAssets.ProtoReflect.Descriptor instead.
 func (*GroupedAssets) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{14}
+	return file_taprootassets_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GroupedAssets) GetAssets() []*AssetHumanReadable {
@@ -1369,7 +2096,7 @@ type ListGroupsResponse struct {
 func (x *ListGroupsResponse) Reset() {
 	*x = ListGroupsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[15]
+		mi := &file_taprootassets_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1382,7 +2109,7 @@ func (x *ListGroupsResponse) String() string {
 func (*ListGroupsResponse) ProtoMessage() {}
 
 func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[15]
+	mi := &file_taprootassets_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1395,7 +2122,7 @@ func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListGroupsResponse.ProtoReflect.Descriptor instead.
 func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{15}
+	return file_taprootassets_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListGroupsResponse) GetGroups() map[string]*GroupedAssets {
@@ -1427,7 +2154,7 @@ type ListBalancesRequest struct {
 func (x *ListBalancesRequest) Reset() {
 	*x = ListBalancesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[16]
+		mi := &file_taprootassets_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1440,7 +2167,7 @@ func (x *ListBalancesRequest) String() string {
 func (*ListBalancesRequest) ProtoMessage() {}
 
 func (x *ListBalancesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[16]
+	mi := &file_taprootassets_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1453,7 +2180,7 @@ func (x *ListBalancesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBalancesRequest.ProtoReflect.Descriptor instead.
 func (*ListBalancesRequest) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{16}
+	return file_taprootassets_proto_rawDescGZIP(), []int{17}
 }
 
 func (m *ListBalancesRequest) GetGroupBy() isListBalancesRequest_GroupBy {
@@ -1520,12 +2247,14 @@ type AssetBalance struct {
 	AssetType AssetType `protobuf:"varint,2,opt,name=asset_type,json=assetType,proto3,enum=taprpc.AssetType" json:"asset_type,omitempty"`
 	// The balance of the asset owned by the target daemon.
 	Balance uint64 `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	// The alias of the asset or its group, if one is known.
+	Alias *AssetAlias `protobuf:"bytes,4,opt,name=alias,proto3" json:"alias,omitempty"`
 }
 
 func (x *AssetBalance) Reset() {
 	*x = AssetBalance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[17]
+		mi := &file_taprootassets_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1538,7 +2267,7 @@ func (x *AssetBalance) String() string {
 func (*AssetBalance) ProtoMessage() {}
 
 func (x *AssetBalance) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[17]
+	mi := &file_taprootassets_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1551,7 +2280,7 @@ func (x *AssetBalance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssetBalance.ProtoReflect.Descriptor instead.
 func (*AssetBalance) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{17}
+	return file_taprootassets_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *AssetBalance) GetAssetGenesis() *GenesisInfo {
@@ -1575,6 +2304,13 @@ func (x *AssetBalance) GetBalance() uint64 {
 	return 0
 }
 
+func (x *AssetBalance) GetAlias() *AssetAlias {
+	if x != nil {
+		return x.Alias
+	}
+	return nil
+}
+
 type AssetGroupBalance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1584,12 +2320,14 @@ type AssetGroupBalance struct {
 	GroupKey []byte `protobuf:"bytes,1,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
 	// The total balance of the assets in the group.
 	Balance uint64 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// The alias of the asset group, if one is known.
+	Alias *AssetAlias `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
 }
 
 func (x *AssetGroupBalance) Reset() {
 	*x = AssetGroupBalance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[18]
+		mi := &file_taprootassets_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1602,7 +2340,7 @@ func (x *AssetGroupBalance) String() string {
 func (*AssetGroupBalance) ProtoMessage() {}
 
 func (x *AssetGroupBalance) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[18]
+	mi := &file_taprootassets_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1615,7 +2353,7 @@ func (x *AssetGroupBalance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssetGroupBalance.ProtoReflect.Descriptor instead.
 func (*AssetGroupBalance) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{18}
+	return file_taprootassets_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *AssetGroupBalance) GetGroupKey() []byte {
@@ -1632,6 +2370,13 @@ func (x *AssetGroupBalance) GetBalance() uint64 {
 	return 0
 }
 
+func (x *AssetGroupBalance) GetAlias() *AssetAlias {
+	if x != nil {
+		return x.Alias
+	}
+	return nil
+}
+
 type ListBalancesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1644,7 +2389,7 @@ type ListBalancesResponse struct {
 func (x *ListBalancesResponse) Reset() {
 	*x = ListBalancesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[19]
+		mi := &file_taprootassets_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1657,7 +2402,7 @@ func (x *ListBalancesResponse) String() string {
 func (*ListBalancesResponse) ProtoMessage() {}
 
 func (x *ListBalancesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[19]
+	mi := &file_taprootassets_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1670,7 +2415,7 @@ func (x *ListBalancesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBalancesResponse.ProtoReflect.Descriptor instead.
 func (*ListBalancesResponse) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{19}
+	return file_taprootassets_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ListBalancesResponse) GetAssetBalances() map[string]*AssetBalance {
@@ -1687,29 +2432,38 @@ func (x *ListBalancesResponse) GetAssetGroupBalances() map[string]*AssetGroupBal
 	return nil
 }
 
-type ListTransfersRequest struct {
+type ListBalanceHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The block height the balances are queried for. If zero, the height isn't
+	// restricted.
+	Height uint32 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// The time the balances are queried for, in unix seconds. If zero, the time
+	// isn't restricted.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// If set, only the balance of the asset with the given ID is returned.
+	AssetId []byte `protobuf:"bytes,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
 }
 
-func (x *ListTransfersRequest) Reset() {
-	*x = ListTransfersRequest{}
+func (x *ListBalanceHistoryRequest) Reset() {
+	*x = ListBalanceHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[20]
+		mi := &file_taprootassets_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListTransfersRequest) String() string {
+func (x *ListBalanceHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransfersRequest) ProtoMessage() {}
+func (*ListBalanceHistoryRequest) ProtoMessage() {}
 
-func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[20]
+func (x *ListBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1720,78 +2474,51 @@ func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransfersRequest.ProtoReflect.Descriptor instead.
-func (*ListTransfersRequest) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{20}
-}
-
-type ListTransfersResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// The unordered list of outgoing asset transfers.
-	Transfers []*AssetTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+// Deprecated: Use ListBalanceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListBalanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListTransfersResponse) Reset() {
-	*x = ListTransfersResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ListBalanceHistoryRequest) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
 	}
+	return 0
 }
 
-func (x *ListTransfersResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListTransfersResponse) ProtoMessage() {}
-
-func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ListBalanceHistoryRequest) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListTransfersResponse.ProtoReflect.Descriptor instead.
-func (*ListTransfersResponse) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{21}
+	return 0
 }
 
-func (x *ListTransfersResponse) GetTransfers() []*AssetTransfer {
+func (x *ListBalanceHistoryRequest) GetAssetId() []byte {
 	if x != nil {
-		return x.Transfers
+		return x.AssetId
 	}
 	return nil
 }
 
-type AssetTransfer struct {
+type BalanceSnapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TransferTimestamp int64 `protobuf:"varint,1,opt,name=transfer_timestamp,json=transferTimestamp,proto3" json:"transfer_timestamp,omitempty"`
-	// The new transaction that commits to the set of Taproot Assets found
-	// at the above new anchor point.
-	AnchorTxHash       []byte `protobuf:"bytes,2,opt,name=anchor_tx_hash,json=anchorTxHash,proto3" json:"anchor_tx_hash,omitempty"`
-	AnchorTxHeightHint uint32 `protobuf:"varint,3,opt,name=anchor_tx_height_hint,json=anchorTxHeightHint,proto3" json:"anchor_tx_height_hint,omitempty"`
-	AnchorTxChainFees  int64  `protobuf:"varint,4,opt,name=anchor_tx_chain_fees,json=anchorTxChainFees,proto3" json:"anchor_tx_chain_fees,omitempty"`
-	// Describes the set of spent assets.
-	Inputs []*TransferInput `protobuf:"bytes,5,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	// Describes the set of newly created asset outputs.
-	Outputs []*TransferOutput `protobuf:"bytes,6,rep,name=outputs,proto3" json:"outputs,omitempty"`
-}
-
-func (x *AssetTransfer) Reset() {
-	*x = AssetTransfer{}
+	// The ID of the asset.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The block height the snapshot was taken at.
+	Height uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// The time the snapshot was taken at, in unix seconds.
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The total amount of the confirmed, unspent asset UTXOs.
+	Balance uint64 `protobuf:"varint,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	// The number of confirmed, unspent asset UTXOs.
+	UtxoCount uint32 `protobuf:"varint,5,opt,name=utxo_count,json=utxoCount,proto3" json:"utxo_count,omitempty"`
+}
+
+func (x *BalanceSnapshot) Reset() {
+	*x = BalanceSnapshot{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taprootassets_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1799,13 +2526,13 @@ func (x *AssetTransfer) Reset() {
 	}
 }
 
-func (x *AssetTransfer) String() string {
+func (x *BalanceSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetTransfer) ProtoMessage() {}
+func (*BalanceSnapshot) ProtoMessage() {}
 
-func (x *AssetTransfer) ProtoReflect() protoreflect.Message {
+func (x *BalanceSnapshot) ProtoReflect() protoreflect.Message {
 	mi := &file_taprootassets_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1817,71 +2544,58 @@ func (x *AssetTransfer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetTransfer.ProtoReflect.Descriptor instead.
-func (*AssetTransfer) Descriptor() ([]byte, []int) {
+// Deprecated: Use BalanceSnapshot.ProtoReflect.Descriptor instead.
+func (*BalanceSnapshot) Descriptor() ([]byte, []int) {
 	return file_taprootassets_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *AssetTransfer) GetTransferTimestamp() int64 {
-	if x != nil {
-		return x.TransferTimestamp
-	}
-	return 0
-}
-
-func (x *AssetTransfer) GetAnchorTxHash() []byte {
+func (x *BalanceSnapshot) GetAssetId() []byte {
 	if x != nil {
-		return x.AnchorTxHash
+		return x.AssetId
 	}
 	return nil
 }
 
-func (x *AssetTransfer) GetAnchorTxHeightHint() uint32 {
+func (x *BalanceSnapshot) GetHeight() uint32 {
 	if x != nil {
-		return x.AnchorTxHeightHint
+		return x.Height
 	}
 	return 0
 }
 
-func (x *AssetTransfer) GetAnchorTxChainFees() int64 {
+func (x *BalanceSnapshot) GetTimestamp() int64 {
 	if x != nil {
-		return x.AnchorTxChainFees
+		return x.Timestamp
 	}
 	return 0
 }
 
-func (x *AssetTransfer) GetInputs() []*TransferInput {
+func (x *BalanceSnapshot) GetBalance() uint64 {
 	if x != nil {
-		return x.Inputs
+		return x.Balance
 	}
-	return nil
+	return 0
 }
 
-func (x *AssetTransfer) GetOutputs() []*TransferOutput {
+func (x *BalanceSnapshot) GetUtxoCount() uint32 {
 	if x != nil {
-		return x.Outputs
+		return x.UtxoCount
 	}
-	return nil
+	return 0
 }
 
-type TransferInput struct {
+type ListBalanceHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The old/current location of the Taproot Asset commitment that was spent
-	// as an input.
-	AnchorPoint string `protobuf:"bytes,1,opt,name=anchor_point,json=anchorPoint,proto3" json:"anchor_point,omitempty"`
-	// The ID of the asset that was spent.
-	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
-	// The script key of the asset that was spent.
-	ScriptKey []byte `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
-	// The amount of the asset that was spent.
-	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The most recent snapshot of each asset with a non-zero balance at the
+	// queried point.
+	Snapshots []*BalanceSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
 }
 
-func (x *TransferInput) Reset() {
-	*x = TransferInput{}
+func (x *ListBalanceHistoryResponse) Reset() {
+	*x = ListBalanceHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taprootassets_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1889,13 +2603,13 @@ func (x *TransferInput) Reset() {
 	}
 }
 
-func (x *TransferInput) String() string {
+func (x *ListBalanceHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferInput) ProtoMessage() {}
+func (*ListBalanceHistoryResponse) ProtoMessage() {}
 
-func (x *TransferInput) ProtoReflect() protoreflect.Message {
+func (x *ListBalanceHistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_taprootassets_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1907,72 +2621,82 @@ func (x *TransferInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferInput.ProtoReflect.Descriptor instead.
-func (*TransferInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBalanceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListBalanceHistoryResponse) Descriptor() ([]byte, []int) {
 	return file_taprootassets_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *TransferInput) GetAnchorPoint() string {
+func (x *ListBalanceHistoryResponse) GetSnapshots() []*BalanceSnapshot {
 	if x != nil {
-		return x.AnchorPoint
+		return x.Snapshots
 	}
-	return ""
+	return nil
 }
 
-func (x *TransferInput) GetAssetId() []byte {
-	if x != nil {
-		return x.AssetId
-	}
-	return nil
+type ListTransfersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *TransferInput) GetScriptKey() []byte {
-	if x != nil {
-		return x.ScriptKey
+func (x *ListTransfersRequest) Reset() {
+	*x = ListTransfersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *TransferInput) GetAmount() uint64 {
-	if x != nil {
-		return x.Amount
+func (x *ListTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersRequest) ProtoMessage() {}
+
+func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type TransferOutputAnchor struct {
+// Deprecated: Use ListTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{24}
+}
+
+type ListTransfersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The new location of the Taproot Asset commitment that was created on
-	// chain.
-	Outpoint         string `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
-	Value            int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
-	InternalKey      []byte `protobuf:"bytes,3,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
-	TaprootAssetRoot []byte `protobuf:"bytes,4,opt,name=taproot_asset_root,json=taprootAssetRoot,proto3" json:"taproot_asset_root,omitempty"`
-	MerkleRoot       []byte `protobuf:"bytes,5,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
-	TapscriptSibling []byte `protobuf:"bytes,6,opt,name=tapscript_sibling,json=tapscriptSibling,proto3" json:"tapscript_sibling,omitempty"`
-	NumPassiveAssets uint32 `protobuf:"varint,7,opt,name=num_passive_assets,json=numPassiveAssets,proto3" json:"num_passive_assets,omitempty"`
+	// The unordered list of outgoing asset transfers.
+	Transfers []*AssetTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
 }
 
-func (x *TransferOutputAnchor) Reset() {
-	*x = TransferOutputAnchor{}
+func (x *ListTransfersResponse) Reset() {
+	*x = ListTransfersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[24]
+		mi := &file_taprootassets_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransferOutputAnchor) String() string {
+func (x *ListTransfersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferOutputAnchor) ProtoMessage() {}
+func (*ListTransfersResponse) ProtoMessage() {}
 
-func (x *TransferOutputAnchor) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[24]
+func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1983,91 +2707,87 @@ func (x *TransferOutputAnchor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferOutputAnchor.ProtoReflect.Descriptor instead.
-func (*TransferOutputAnchor) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ListTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *TransferOutputAnchor) GetOutpoint() string {
+func (x *ListTransfersResponse) GetTransfers() []*AssetTransfer {
 	if x != nil {
-		return x.Outpoint
+		return x.Transfers
 	}
-	return ""
+	return nil
 }
 
-func (x *TransferOutputAnchor) GetValue() int64 {
-	if x != nil {
-		return x.Value
-	}
-	return 0
+type ParcelQueueStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *TransferOutputAnchor) GetInternalKey() []byte {
-	if x != nil {
-		return x.InternalKey
+func (x *ParcelQueueStatsRequest) Reset() {
+	*x = ParcelQueueStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *TransferOutputAnchor) GetTaprootAssetRoot() []byte {
-	if x != nil {
-		return x.TaprootAssetRoot
-	}
-	return nil
+func (x *ParcelQueueStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TransferOutputAnchor) GetMerkleRoot() []byte {
-	if x != nil {
-		return x.MerkleRoot
-	}
-	return nil
-}
+func (*ParcelQueueStatsRequest) ProtoMessage() {}
 
-func (x *TransferOutputAnchor) GetTapscriptSibling() []byte {
-	if x != nil {
-		return x.TapscriptSibling
+func (x *ParcelQueueStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *TransferOutputAnchor) GetNumPassiveAssets() uint32 {
-	if x != nil {
-		return x.NumPassiveAssets
-	}
-	return 0
+// Deprecated: Use ParcelQueueStatsRequest.ProtoReflect.Descriptor instead.
+func (*ParcelQueueStatsRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{26}
 }
 
-type TransferOutput struct {
+type ParcelQueueDepth struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Anchor              *TransferOutputAnchor `protobuf:"bytes,1,opt,name=anchor,proto3" json:"anchor,omitempty"`
-	ScriptKey           []byte                `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
-	ScriptKeyIsLocal    bool                  `protobuf:"varint,3,opt,name=script_key_is_local,json=scriptKeyIsLocal,proto3" json:"script_key_is_local,omitempty"`
-	Amount              uint64                `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	NewProofBlob        []byte                `protobuf:"bytes,5,opt,name=new_proof_blob,json=newProofBlob,proto3" json:"new_proof_blob,omitempty"`
-	SplitCommitRootHash []byte                `protobuf:"bytes,6,opt,name=split_commit_root_hash,json=splitCommitRootHash,proto3" json:"split_commit_root_hash,omitempty"`
-	OutputType          OutputType            `protobuf:"varint,7,opt,name=output_type,json=outputType,proto3,enum=taprpc.OutputType" json:"output_type,omitempty"`
+	// The priority class the metrics are for.
+	Priority ParcelPriority `protobuf:"varint,1,opt,name=priority,proto3,enum=taprpc.ParcelPriority" json:"priority,omitempty"`
+	// The number of transfers that wait for a worker, including batchable
+	// transfers that are held back until the next batch interval.
+	Queued uint32 `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"`
+	// The number of transfers that are currently signed and broadcast.
+	Active uint32 `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
 }
 
-func (x *TransferOutput) Reset() {
-	*x = TransferOutput{}
+func (x *ParcelQueueDepth) Reset() {
+	*x = ParcelQueueDepth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[25]
+		mi := &file_taprootassets_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransferOutput) String() string {
+func (x *ParcelQueueDepth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferOutput) ProtoMessage() {}
+func (*ParcelQueueDepth) ProtoMessage() {}
 
-func (x *TransferOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[25]
+func (x *ParcelQueueDepth) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2078,83 +2798,58 @@ func (x *TransferOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferOutput.ProtoReflect.Descriptor instead.
-func (*TransferOutput) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *TransferOutput) GetAnchor() *TransferOutputAnchor {
-	if x != nil {
-		return x.Anchor
-	}
-	return nil
-}
-
-func (x *TransferOutput) GetScriptKey() []byte {
-	if x != nil {
-		return x.ScriptKey
-	}
-	return nil
+// Deprecated: Use ParcelQueueDepth.ProtoReflect.Descriptor instead.
+func (*ParcelQueueDepth) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *TransferOutput) GetScriptKeyIsLocal() bool {
+func (x *ParcelQueueDepth) GetPriority() ParcelPriority {
 	if x != nil {
-		return x.ScriptKeyIsLocal
+		return x.Priority
 	}
-	return false
+	return ParcelPriority_PARCEL_PRIORITY_NORMAL
 }
 
-func (x *TransferOutput) GetAmount() uint64 {
+func (x *ParcelQueueDepth) GetQueued() uint32 {
 	if x != nil {
-		return x.Amount
+		return x.Queued
 	}
 	return 0
 }
 
-func (x *TransferOutput) GetNewProofBlob() []byte {
-	if x != nil {
-		return x.NewProofBlob
-	}
-	return nil
-}
-
-func (x *TransferOutput) GetSplitCommitRootHash() []byte {
-	if x != nil {
-		return x.SplitCommitRootHash
-	}
-	return nil
-}
-
-func (x *TransferOutput) GetOutputType() OutputType {
+func (x *ParcelQueueDepth) GetActive() uint32 {
 	if x != nil {
-		return x.OutputType
+		return x.Active
 	}
-	return OutputType_OUTPUT_TYPE_SIMPLE
+	return 0
 }
 
-type StopRequest struct {
+type ParcelQueueStatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The queue depth metrics of each priority class.
+	Queues []*ParcelQueueDepth `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
 }
 
-func (x *StopRequest) Reset() {
-	*x = StopRequest{}
+func (x *ParcelQueueStatsResponse) Reset() {
+	*x = ParcelQueueStatsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[26]
+		mi := &file_taprootassets_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StopRequest) String() string {
+func (x *ParcelQueueStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopRequest) ProtoMessage() {}
+func (*ParcelQueueStatsResponse) ProtoMessage() {}
 
-func (x *StopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[26]
+func (x *ParcelQueueStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2165,34 +2860,47 @@ func (x *StopRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
-func (*StopRequest) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ParcelQueueStatsResponse.ProtoReflect.Descriptor instead.
+func (*ParcelQueueStatsResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{28}
 }
 
-type StopResponse struct {
+func (x *ParcelQueueStatsResponse) GetQueues() []*ParcelQueueDepth {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+type SetParcelDebugLoggingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The identifier of the transfer, as reported by the send events. This can
+	// also be the identifier of a transfer that wasn't received yet.
+	ParcelId uint64 `protobuf:"varint,1,opt,name=parcel_id,json=parcelId,proto3" json:"parcel_id,omitempty"`
+	// Whether scoped debug logging should be enabled or disabled.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (x *StopResponse) Reset() {
-	*x = StopResponse{}
+func (x *SetParcelDebugLoggingRequest) Reset() {
+	*x = SetParcelDebugLoggingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[27]
+		mi := &file_taprootassets_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StopResponse) String() string {
+func (x *SetParcelDebugLoggingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopResponse) ProtoMessage() {}
+func (*SetParcelDebugLoggingRequest) ProtoMessage() {}
 
-func (x *StopResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[27]
+func (x *SetParcelDebugLoggingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2203,38 +2911,48 @@ func (x *StopResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopResponse.ProtoReflect.Descriptor instead.
-func (*StopResponse) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use SetParcelDebugLoggingRequest.ProtoReflect.Descriptor instead.
+func (*SetParcelDebugLoggingRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{29}
 }
 
-type DebugLevelRequest struct {
+func (x *SetParcelDebugLoggingRequest) GetParcelId() uint64 {
+	if x != nil {
+		return x.ParcelId
+	}
+	return 0
+}
+
+func (x *SetParcelDebugLoggingRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetParcelDebugLoggingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// If true, all the valid debug sub-systems will be returned.
-	Show      bool   `protobuf:"varint,1,opt,name=show,proto3" json:"show,omitempty"`
-	LevelSpec string `protobuf:"bytes,2,opt,name=level_spec,json=levelSpec,proto3" json:"level_spec,omitempty"`
 }
 
-func (x *DebugLevelRequest) Reset() {
-	*x = DebugLevelRequest{}
+func (x *SetParcelDebugLoggingResponse) Reset() {
+	*x = SetParcelDebugLoggingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[28]
+		mi := &file_taprootassets_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DebugLevelRequest) String() string {
+func (x *SetParcelDebugLoggingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DebugLevelRequest) ProtoMessage() {}
+func (*SetParcelDebugLoggingResponse) ProtoMessage() {}
 
-func (x *DebugLevelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[28]
+func (x *SetParcelDebugLoggingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2245,50 +2963,37 @@ func (x *DebugLevelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DebugLevelRequest.ProtoReflect.Descriptor instead.
-func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *DebugLevelRequest) GetShow() bool {
-	if x != nil {
-		return x.Show
-	}
-	return false
-}
-
-func (x *DebugLevelRequest) GetLevelSpec() string {
-	if x != nil {
-		return x.LevelSpec
-	}
-	return ""
+// Deprecated: Use SetParcelDebugLoggingResponse.ProtoReflect.Descriptor instead.
+func (*SetParcelDebugLoggingResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{30}
 }
 
-type DebugLevelResponse struct {
+type ExportParcelLogRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubSystems string `protobuf:"bytes,1,opt,name=sub_systems,json=subSystems,proto3" json:"sub_systems,omitempty"`
+	// The identifier of the transfer to export the log of.
+	ParcelId uint64 `protobuf:"varint,1,opt,name=parcel_id,json=parcelId,proto3" json:"parcel_id,omitempty"`
 }
 
-func (x *DebugLevelResponse) Reset() {
-	*x = DebugLevelResponse{}
+func (x *ExportParcelLogRequest) Reset() {
+	*x = ExportParcelLogRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[29]
+		mi := &file_taprootassets_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DebugLevelResponse) String() string {
+func (x *ExportParcelLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DebugLevelResponse) ProtoMessage() {}
+func (*ExportParcelLogRequest) ProtoMessage() {}
 
-func (x *DebugLevelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[29]
+func (x *ExportParcelLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2299,66 +3004,56 @@ func (x *DebugLevelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DebugLevelResponse.ProtoReflect.Descriptor instead.
-func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ExportParcelLogRequest.ProtoReflect.Descriptor instead.
+func (*ExportParcelLogRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *DebugLevelResponse) GetSubSystems() string {
+func (x *ExportParcelLogRequest) GetParcelId() uint64 {
 	if x != nil {
-		return x.SubSystems
+		return x.ParcelId
 	}
-	return ""
+	return 0
 }
 
-type Addr struct {
+type ParcelLogEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The bech32 encoded Taproot Asset address.
-	Encoded string `protobuf:"bytes,1,opt,name=encoded,proto3" json:"encoded,omitempty"`
-	// The asset ID that uniquely identifies the asset.
-	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
-	// The type of the asset.
-	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=taprpc.AssetType" json:"asset_type,omitempty"`
-	// The total amount of the asset stored in this Taproot Asset UTXO.
-	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	// The group key of the asset (if it exists)
-	GroupKey []byte `protobuf:"bytes,5,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
-	// The specific script key the asset must commit to in order to transfer
-	// ownership to the creator of the address.
-	ScriptKey []byte `protobuf:"bytes,6,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
-	// The internal key used for the on-chain output.
-	InternalKey []byte `protobuf:"bytes,7,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
-	// The optional serialized tapscript sibling preimage to use for the receiving
-	// asset. This is usually empty as it is only needed when there should be an
-	// additional script path in the Taproot tree alongside the Taproot Asset
-	// commitment of the asset.
-	TapscriptSibling []byte `protobuf:"bytes,8,opt,name=tapscript_sibling,json=tapscriptSibling,proto3" json:"tapscript_sibling,omitempty"`
-	// The tweaked internal key that commits to the asset and represents the
-	// on-chain output key the Bitcoin transaction must send to in order to
-	// transfer assets described in this address.
-	TaprootOutputKey []byte `protobuf:"bytes,9,opt,name=taproot_output_key,json=taprootOutputKey,proto3" json:"taproot_output_key,omitempty"`
-}
-
-func (x *Addr) Reset() {
-	*x = Addr{}
+	// The time the state, call or message started, in unix microseconds.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The type of the entry.
+	Type ParcelLogEntryType `protobuf:"varint,2,opt,name=type,proto3,enum=taprpc.ParcelLogEntryType" json:"type,omitempty"`
+	// The send state the transfer was in.
+	SendState string `protobuf:"bytes,3,opt,name=send_state,json=sendState,proto3" json:"send_state,omitempty"`
+	// The name of the backend call, only set for backend call entries.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// The debug message, only set for message entries.
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// How long the state or backend call took, in microseconds.
+	DurationUs int64 `protobuf:"varint,6,opt,name=duration_us,json=durationUs,proto3" json:"duration_us,omitempty"`
+	// The error the state or backend call failed with, if any.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ParcelLogEntry) Reset() {
+	*x = ParcelLogEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[30]
+		mi := &file_taprootassets_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Addr) String() string {
+func (x *ParcelLogEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Addr) ProtoMessage() {}
+func (*ParcelLogEntry) ProtoMessage() {}
 
-func (x *Addr) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[30]
+func (x *ParcelLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2369,108 +3064,96 @@ func (x *Addr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Addr.ProtoReflect.Descriptor instead.
-func (*Addr) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ParcelLogEntry.ProtoReflect.Descriptor instead.
+func (*ParcelLogEntry) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *Addr) GetEncoded() string {
+func (x *ParcelLogEntry) GetTimestamp() int64 {
 	if x != nil {
-		return x.Encoded
+		return x.Timestamp
 	}
-	return ""
+	return 0
 }
 
-func (x *Addr) GetAssetId() []byte {
+func (x *ParcelLogEntry) GetType() ParcelLogEntryType {
 	if x != nil {
-		return x.AssetId
+		return x.Type
 	}
-	return nil
+	return ParcelLogEntryType_PARCEL_LOG_ENTRY_STATE
 }
 
-func (x *Addr) GetAssetType() AssetType {
+func (x *ParcelLogEntry) GetSendState() string {
 	if x != nil {
-		return x.AssetType
+		return x.SendState
 	}
-	return AssetType_NORMAL
+	return ""
 }
 
-func (x *Addr) GetAmount() uint64 {
+func (x *ParcelLogEntry) GetName() string {
 	if x != nil {
-		return x.Amount
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *Addr) GetGroupKey() []byte {
+func (x *ParcelLogEntry) GetMessage() string {
 	if x != nil {
-		return x.GroupKey
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *Addr) GetScriptKey() []byte {
+func (x *ParcelLogEntry) GetDurationUs() int64 {
 	if x != nil {
-		return x.ScriptKey
-	}
-	return nil
-}
-
-func (x *Addr) GetInternalKey() []byte {
-	if x != nil {
-		return x.InternalKey
+		return x.DurationUs
 	}
-	return nil
-}
-
-func (x *Addr) GetTapscriptSibling() []byte {
-	if x != nil {
-		return x.TapscriptSibling
-	}
-	return nil
+	return 0
 }
 
-func (x *Addr) GetTaprootOutputKey() []byte {
+func (x *ParcelLogEntry) GetError() string {
 	if x != nil {
-		return x.TaprootOutputKey
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-type QueryAddrRequest struct {
+type ExportParcelLogResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// If set, then only addresses created after this Unix timestamp will be
-	// returned.
-	CreatedAfter int64 `protobuf:"varint,1,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
-	// If set, then only addresses created before this Unix timestamp will be
-	// returned.
-	CreatedBefore int64 `protobuf:"varint,2,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
-	// The max number of addresses that should be returned.
-	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	// The offset from the addresses that should be returned.
-	Offset int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
-}
-
-func (x *QueryAddrRequest) Reset() {
-	*x = QueryAddrRequest{}
+	// The identifier of the transfer.
+	ParcelId uint64 `protobuf:"varint,1,opt,name=parcel_id,json=parcelId,proto3" json:"parcel_id,omitempty"`
+	// Whether scoped debug logging is enabled for the transfer.
+	DebugEnabled bool `protobuf:"varint,2,opt,name=debug_enabled,json=debugEnabled,proto3" json:"debug_enabled,omitempty"`
+	// Whether the send state machine of the transfer stopped, either because the
+	// transfer completed or because it failed.
+	Finished bool `protobuf:"varint,3,opt,name=finished,proto3" json:"finished,omitempty"`
+	// The number of oldest entries that were dropped because the log reached its
+	// maximum size.
+	DroppedEntries uint64 `protobuf:"varint,4,opt,name=dropped_entries,json=droppedEntries,proto3" json:"dropped_entries,omitempty"`
+	// The entries of the log, ordered by time.
+	Entries []*ParcelLogEntry `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ExportParcelLogResponse) Reset() {
+	*x = ExportParcelLogResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[31]
+		mi := &file_taprootassets_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAddrRequest) String() string {
+func (x *ExportParcelLogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAddrRequest) ProtoMessage() {}
+func (*ExportParcelLogResponse) ProtoMessage() {}
 
-func (x *QueryAddrRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[31]
+func (x *ExportParcelLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2481,64 +3164,74 @@ func (x *QueryAddrRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryAddrRequest.ProtoReflect.Descriptor instead.
-func (*QueryAddrRequest) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ExportParcelLogResponse.ProtoReflect.Descriptor instead.
+func (*ExportParcelLogResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *QueryAddrRequest) GetCreatedAfter() int64 {
+func (x *ExportParcelLogResponse) GetParcelId() uint64 {
 	if x != nil {
-		return x.CreatedAfter
+		return x.ParcelId
 	}
 	return 0
 }
 
-func (x *QueryAddrRequest) GetCreatedBefore() int64 {
+func (x *ExportParcelLogResponse) GetDebugEnabled() bool {
 	if x != nil {
-		return x.CreatedBefore
+		return x.DebugEnabled
 	}
-	return 0
+	return false
 }
 
-func (x *QueryAddrRequest) GetLimit() int32 {
+func (x *ExportParcelLogResponse) GetFinished() bool {
 	if x != nil {
-		return x.Limit
+		return x.Finished
 	}
-	return 0
+	return false
 }
 
-func (x *QueryAddrRequest) GetOffset() int32 {
+func (x *ExportParcelLogResponse) GetDroppedEntries() uint64 {
 	if x != nil {
-		return x.Offset
+		return x.DroppedEntries
 	}
 	return 0
 }
 
-type QueryAddrResponse struct {
+func (x *ExportParcelLogResponse) GetEntries() []*ParcelLogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type AnnotateAssetLotRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addrs []*Addr `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	// The lot to annotate.
+	Lot *AssetLotID `protobuf:"bytes,1,opt,name=lot,proto3" json:"lot,omitempty"`
+	// The annotation of the acquisition price of the lot.
+	PriceAnnotation string `protobuf:"bytes,2,opt,name=price_annotation,json=priceAnnotation,proto3" json:"price_annotation,omitempty"`
 }
 
-func (x *QueryAddrResponse) Reset() {
-	*x = QueryAddrResponse{}
+func (x *AnnotateAssetLotRequest) Reset() {
+	*x = AnnotateAssetLotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[32]
+		mi := &file_taprootassets_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAddrResponse) String() string {
+func (x *AnnotateAssetLotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAddrResponse) ProtoMessage() {}
+func (*AnnotateAssetLotRequest) ProtoMessage() {}
 
-func (x *QueryAddrResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[32]
+func (x *AnnotateAssetLotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2549,63 +3242,100 @@ func (x *QueryAddrResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryAddrResponse.ProtoReflect.Descriptor instead.
-func (*QueryAddrResponse) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use AnnotateAssetLotRequest.ProtoReflect.Descriptor instead.
+func (*AnnotateAssetLotRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *QueryAddrResponse) GetAddrs() []*Addr {
+func (x *AnnotateAssetLotRequest) GetLot() *AssetLotID {
 	if x != nil {
-		return x.Addrs
+		return x.Lot
 	}
 	return nil
 }
 
-type NewAddrRequest struct {
+func (x *AnnotateAssetLotRequest) GetPriceAnnotation() string {
+	if x != nil {
+		return x.PriceAnnotation
+	}
+	return ""
+}
+
+type AnnotateAssetLotResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
-	Amt     uint64 `protobuf:"varint,2,opt,name=amt,proto3" json:"amt,omitempty"`
-	// The optional script key that the receiving asset should be locked to. If no
-	// script key is provided, a normal BIP-86 key will be derived from the
-	// underlying wallet.
-	//
-	// NOTE: The script_key and internal_key fields should either both be set or
-	// both be empty.
-	ScriptKey *ScriptKey `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
-	// The optional internal key of the receiving BTC level transaction output on
-	// which the receiving asset transfers will be committed to. If no internal key
-	// is provided, a key will be derived from the underlying wallet.
-	//
-	// NOTE: The script_key and internal_key fields should either both be set or
-	// both be empty.
-	InternalKey *KeyDescriptor `protobuf:"bytes,4,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
-	// The optional serialized tapscript sibling preimage to use for the receiving
-	// asset. This is usually empty as it is only needed when there should be an
-	// additional script path in the Taproot tree alongside the Taproot Asset
-	// commitment of the asset.
-	TapscriptSibling []byte `protobuf:"bytes,5,opt,name=tapscript_sibling,json=tapscriptSibling,proto3" json:"tapscript_sibling,omitempty"`
+func (x *AnnotateAssetLotResponse) Reset() {
+	*x = AnnotateAssetLotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *NewAddrRequest) Reset() {
-	*x = NewAddrRequest{}
+func (x *AnnotateAssetLotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnotateAssetLotResponse) ProtoMessage() {}
+
+func (x *AnnotateAssetLotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnotateAssetLotResponse.ProtoReflect.Descriptor instead.
+func (*AnnotateAssetLotResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{35}
+}
+
+type AssetAlias struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The normalized name of the alias.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The ID of the asset the alias refers to, if it refers to an asset ID.
+	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The tweaked group key of the asset group the alias refers to, if it
+	// refers to an asset group.
+	GroupKey []byte `protobuf:"bytes,3,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+	// Where the alias was resolved from.
+	Source AssetAliasSource `protobuf:"varint,4,opt,name=source,proto3,enum=taprpc.AssetAliasSource" json:"source,omitempty"`
+	// Whether the alias is unverified. Aliases aren't committed to on-chain and
+	// only aliases set by the operator of the local node are verified. An
+	// unverified alias must not be relied upon to identify an asset.
+	Unverified bool `protobuf:"varint,5,opt,name=unverified,proto3" json:"unverified,omitempty"`
+}
+
+func (x *AssetAlias) Reset() {
+	*x = AssetAlias{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[33]
+		mi := &file_taprootassets_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NewAddrRequest) String() string {
+func (x *AssetAlias) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewAddrRequest) ProtoMessage() {}
+func (*AssetAlias) ProtoMessage() {}
 
-func (x *NewAddrRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[33]
+func (x *AssetAlias) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2616,79 +3346,77 @@ func (x *NewAddrRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewAddrRequest.ProtoReflect.Descriptor instead.
-func (*NewAddrRequest) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use AssetAlias.ProtoReflect.Descriptor instead.
+func (*AssetAlias) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *NewAddrRequest) GetAssetId() []byte {
+func (x *AssetAlias) GetName() string {
 	if x != nil {
-		return x.AssetId
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *NewAddrRequest) GetAmt() uint64 {
+func (x *AssetAlias) GetAssetId() []byte {
 	if x != nil {
-		return x.Amt
+		return x.AssetId
 	}
-	return 0
+	return nil
 }
 
-func (x *NewAddrRequest) GetScriptKey() *ScriptKey {
+func (x *AssetAlias) GetGroupKey() []byte {
 	if x != nil {
-		return x.ScriptKey
+		return x.GroupKey
 	}
 	return nil
 }
 
-func (x *NewAddrRequest) GetInternalKey() *KeyDescriptor {
+func (x *AssetAlias) GetSource() AssetAliasSource {
 	if x != nil {
-		return x.InternalKey
+		return x.Source
 	}
-	return nil
+	return AssetAliasSource_ASSET_ALIAS_SOURCE_LOCAL
 }
 
-func (x *NewAddrRequest) GetTapscriptSibling() []byte {
+func (x *AssetAlias) GetUnverified() bool {
 	if x != nil {
-		return x.TapscriptSibling
+		return x.Unverified
 	}
-	return nil
+	return false
 }
 
-type ScriptKey struct {
+type SetAssetAliasRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The full Taproot output key the asset is locked to. This is either a BIP-86
-	// key if the tap_tweak below is empty, or a key with the tap tweak applied to
-	// it.
-	PubKey []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	// The key descriptor describing the internal key of the above Taproot key.
-	KeyDesc *KeyDescriptor `protobuf:"bytes,2,opt,name=key_desc,json=keyDesc,proto3" json:"key_desc,omitempty"`
-	// The optional Taproot tweak to apply to the above internal key. If this is
-	// empty then a BIP-86 style tweak is applied to the internal key.
-	TapTweak []byte `protobuf:"bytes,3,opt,name=tap_tweak,json=tapTweak,proto3" json:"tap_tweak,omitempty"`
+	// The name of the alias. Names are case-insensitive and may only consist
+	// of letters, digits, dashes, underscores and dots.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Target:
+	//	*SetAssetAliasRequest_AssetId
+	//	*SetAssetAliasRequest_GroupKey
+	Target isSetAssetAliasRequest_Target `protobuf_oneof:"target"`
 }
 
-func (x *ScriptKey) Reset() {
-	*x = ScriptKey{}
+func (x *SetAssetAliasRequest) Reset() {
+	*x = SetAssetAliasRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[34]
+		mi := &file_taprootassets_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ScriptKey) String() string {
+func (x *SetAssetAliasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScriptKey) ProtoMessage() {}
+func (*SetAssetAliasRequest) ProtoMessage() {}
 
-func (x *ScriptKey) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[34]
+func (x *SetAssetAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2699,60 +3427,84 @@ func (x *ScriptKey) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScriptKey.ProtoReflect.Descriptor instead.
-func (*ScriptKey) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use SetAssetAliasRequest.ProtoReflect.Descriptor instead.
+func (*SetAssetAliasRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ScriptKey) GetPubKey() []byte {
+func (x *SetAssetAliasRequest) GetName() string {
 	if x != nil {
-		return x.PubKey
+		return x.Name
+	}
+	return ""
+}
+
+func (m *SetAssetAliasRequest) GetTarget() isSetAssetAliasRequest_Target {
+	if m != nil {
+		return m.Target
 	}
 	return nil
 }
 
-func (x *ScriptKey) GetKeyDesc() *KeyDescriptor {
-	if x != nil {
-		return x.KeyDesc
+func (x *SetAssetAliasRequest) GetAssetId() []byte {
+	if x, ok := x.GetTarget().(*SetAssetAliasRequest_AssetId); ok {
+		return x.AssetId
 	}
 	return nil
 }
 
-func (x *ScriptKey) GetTapTweak() []byte {
-	if x != nil {
-		return x.TapTweak
+func (x *SetAssetAliasRequest) GetGroupKey() []byte {
+	if x, ok := x.GetTarget().(*SetAssetAliasRequest_GroupKey); ok {
+		return x.GroupKey
 	}
 	return nil
 }
 
-type KeyLocator struct {
+type isSetAssetAliasRequest_Target interface {
+	isSetAssetAliasRequest_Target()
+}
+
+type SetAssetAliasRequest_AssetId struct {
+	// The ID of the asset the alias should refer to.
+	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3,oneof"`
+}
+
+type SetAssetAliasRequest_GroupKey struct {
+	// The tweaked group key of the asset group the alias should refer
+	// to.
+	GroupKey []byte `protobuf:"bytes,3,opt,name=group_key,json=groupKey,proto3,oneof"`
+}
+
+func (*SetAssetAliasRequest_AssetId) isSetAssetAliasRequest_Target() {}
+
+func (*SetAssetAliasRequest_GroupKey) isSetAssetAliasRequest_Target() {}
+
+type SetAssetAliasResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The family of key being identified.
-	KeyFamily int32 `protobuf:"varint,1,opt,name=key_family,json=keyFamily,proto3" json:"key_family,omitempty"`
-	// The precise index of the key being identified.
-	KeyIndex int32 `protobuf:"varint,2,opt,name=key_index,json=keyIndex,proto3" json:"key_index,omitempty"`
+	// The alias as it was stored.
+	Alias *AssetAlias `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
 }
 
-func (x *KeyLocator) Reset() {
-	*x = KeyLocator{}
+func (x *SetAssetAliasResponse) Reset() {
+	*x = SetAssetAliasResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[35]
+		mi := &file_taprootassets_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *KeyLocator) String() string {
+func (x *SetAssetAliasResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyLocator) ProtoMessage() {}
+func (*SetAssetAliasResponse) ProtoMessage() {}
 
-func (x *KeyLocator) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[35]
+func (x *SetAssetAliasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2763,53 +3515,44 @@ func (x *KeyLocator) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyLocator.ProtoReflect.Descriptor instead.
-func (*KeyLocator) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *KeyLocator) GetKeyFamily() int32 {
-	if x != nil {
-		return x.KeyFamily
-	}
-	return 0
+// Deprecated: Use SetAssetAliasResponse.ProtoReflect.Descriptor instead.
+func (*SetAssetAliasResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *KeyLocator) GetKeyIndex() int32 {
+func (x *SetAssetAliasResponse) GetAlias() *AssetAlias {
 	if x != nil {
-		return x.KeyIndex
+		return x.Alias
 	}
-	return 0
+	return nil
 }
 
-type KeyDescriptor struct {
+type RemoveAssetAliasRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The raw bytes of the key being identified.
-	RawKeyBytes []byte `protobuf:"bytes,1,opt,name=raw_key_bytes,json=rawKeyBytes,proto3" json:"raw_key_bytes,omitempty"`
-	// The key locator that identifies which key to use for signing.
-	KeyLoc *KeyLocator `protobuf:"bytes,2,opt,name=key_loc,json=keyLoc,proto3" json:"key_loc,omitempty"`
+	// The name of the alias to remove.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *KeyDescriptor) Reset() {
-	*x = KeyDescriptor{}
+func (x *RemoveAssetAliasRequest) Reset() {
+	*x = RemoveAssetAliasRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[36]
+		mi := &file_taprootassets_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *KeyDescriptor) String() string {
+func (x *RemoveAssetAliasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyDescriptor) ProtoMessage() {}
+func (*RemoveAssetAliasRequest) ProtoMessage() {}
 
-func (x *KeyDescriptor) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[36]
+func (x *RemoveAssetAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2820,50 +3563,41 @@ func (x *KeyDescriptor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyDescriptor.ProtoReflect.Descriptor instead.
-func (*KeyDescriptor) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *KeyDescriptor) GetRawKeyBytes() []byte {
-	if x != nil {
-		return x.RawKeyBytes
-	}
-	return nil
+// Deprecated: Use RemoveAssetAliasRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAssetAliasRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *KeyDescriptor) GetKeyLoc() *KeyLocator {
+func (x *RemoveAssetAliasRequest) GetName() string {
 	if x != nil {
-		return x.KeyLoc
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type DecodeAddrRequest struct {
+type RemoveAssetAliasResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 }
 
-func (x *DecodeAddrRequest) Reset() {
-	*x = DecodeAddrRequest{}
+func (x *RemoveAssetAliasResponse) Reset() {
+	*x = RemoveAssetAliasResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[37]
+		mi := &file_taprootassets_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DecodeAddrRequest) String() string {
+func (x *RemoveAssetAliasResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecodeAddrRequest) ProtoMessage() {}
+func (*RemoveAssetAliasResponse) ProtoMessage() {}
 
-func (x *DecodeAddrRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[37]
+func (x *RemoveAssetAliasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2874,44 +3608,75 @@ func (x *DecodeAddrRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecodeAddrRequest.ProtoReflect.Descriptor instead.
-func (*DecodeAddrRequest) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use RemoveAssetAliasResponse.ProtoReflect.Descriptor instead.
+func (*RemoveAssetAliasResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *DecodeAddrRequest) GetAddr() string {
-	if x != nil {
-		return x.Addr
+type ListAssetAliasesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListAssetAliasesRequest) Reset() {
+	*x = ListAssetAliasesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-type ProofFile struct {
+func (x *ListAssetAliasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssetAliasesRequest) ProtoMessage() {}
+
+func (x *ListAssetAliasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssetAliasesRequest.ProtoReflect.Descriptor instead.
+func (*ListAssetAliasesRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{41}
+}
+
+type ListAssetAliasesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RawProof     []byte `protobuf:"bytes,1,opt,name=raw_proof,json=rawProof,proto3" json:"raw_proof,omitempty"`
-	GenesisPoint string `protobuf:"bytes,2,opt,name=genesis_point,json=genesisPoint,proto3" json:"genesis_point,omitempty"`
+	// All aliases of the local alias registry, ordered by name.
+	Aliases []*AssetAlias `protobuf:"bytes,1,rep,name=aliases,proto3" json:"aliases,omitempty"`
 }
 
-func (x *ProofFile) Reset() {
-	*x = ProofFile{}
+func (x *ListAssetAliasesResponse) Reset() {
+	*x = ListAssetAliasesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[38]
+		mi := &file_taprootassets_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProofFile) String() string {
+func (x *ListAssetAliasesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProofFile) ProtoMessage() {}
+func (*ListAssetAliasesResponse) ProtoMessage() {}
 
-func (x *ProofFile) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[38]
+func (x *ListAssetAliasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2922,80 +3687,44 @@ func (x *ProofFile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProofFile.ProtoReflect.Descriptor instead.
-func (*ProofFile) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ListAssetAliasesResponse.ProtoReflect.Descriptor instead.
+func (*ListAssetAliasesResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ProofFile) GetRawProof() []byte {
+func (x *ListAssetAliasesResponse) GetAliases() []*AssetAlias {
 	if x != nil {
-		return x.RawProof
+		return x.Aliases
 	}
 	return nil
 }
 
-func (x *ProofFile) GetGenesisPoint() string {
-	if x != nil {
-		return x.GenesisPoint
-	}
-	return ""
-}
-
-type DecodedProof struct {
+type ResolveAssetAliasRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The index depth of the decoded proof, with 0 being the latest proof.
-	ProofAtDepth uint32 `protobuf:"varint,1,opt,name=proof_at_depth,json=proofAtDepth,proto3" json:"proof_at_depth,omitempty"`
-	// The total number of proofs contained in the raw proof.
-	NumberOfProofs uint32 `protobuf:"varint,2,opt,name=number_of_proofs,json=numberOfProofs,proto3" json:"number_of_proofs,omitempty"`
-	// The asset referenced in the proof.
-	Asset *Asset `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
-	// The reveal meta data associated with the proof, if available.
-	MetaReveal *AssetMeta `protobuf:"bytes,4,opt,name=meta_reveal,json=metaReveal,proto3" json:"meta_reveal,omitempty"`
-	// The merkle proof for AnchorTx used to prove its
-	// inclusion within BlockHeader.
-	TxMerkleProof []byte `protobuf:"bytes,5,opt,name=tx_merkle_proof,json=txMerkleProof,proto3" json:"tx_merkle_proof,omitempty"`
-	// The TaprootProof proving the new inclusion of the
-	// resulting asset within AnchorTx.
-	InclusionProof []byte `protobuf:"bytes,6,opt,name=inclusion_proof,json=inclusionProof,proto3" json:"inclusion_proof,omitempty"`
-	// The set of TaprootProofs proving the exclusion of
-	// the resulting asset from all other Taproot outputs within AnchorTx.
-	ExclusionProofs [][]byte `protobuf:"bytes,7,rep,name=exclusion_proofs,json=exclusionProofs,proto3" json:"exclusion_proofs,omitempty"`
-	// An optional TaprootProof needed if this asset is
-	// the result of a split. SplitRootProof proves inclusion of the root
-	// asset of the split.
-	SplitRootProof []byte `protobuf:"bytes,8,opt,name=split_root_proof,json=splitRootProof,proto3" json:"split_root_proof,omitempty"`
-	// The number of additional nested full proofs for any inputs found within
-	// the resulting asset.
-	NumAdditionalInputs uint32 `protobuf:"varint,9,opt,name=num_additional_inputs,json=numAdditionalInputs,proto3" json:"num_additional_inputs,omitempty"`
-	// ChallengeWitness is an optional virtual transaction witness that serves
-	// as an ownership proof for the asset. If this is non-nil, then it is a
-	// valid transfer witness for a 1-input, 1-output virtual transaction that
-	// spends the asset in this proof and sends it to the NUMS key, to prove
-	// that the creator of the proof is able to produce a valid signature to
-	// spend the asset.
-	ChallengeWitness [][]byte `protobuf:"bytes,10,rep,name=challenge_witness,json=challengeWitness,proto3" json:"challenge_witness,omitempty"`
+	// The name of the alias to resolve.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *DecodedProof) Reset() {
-	*x = DecodedProof{}
+func (x *ResolveAssetAliasRequest) Reset() {
+	*x = ResolveAssetAliasRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[39]
+		mi := &file_taprootassets_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DecodedProof) String() string {
+func (x *ResolveAssetAliasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecodedProof) ProtoMessage() {}
+func (*ResolveAssetAliasRequest) ProtoMessage() {}
 
-func (x *DecodedProof) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[39]
+func (x *ResolveAssetAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3006,107 +3735,94 @@ func (x *DecodedProof) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecodedProof.ProtoReflect.Descriptor instead.
-func (*DecodedProof) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{39}
-}
-
-func (x *DecodedProof) GetProofAtDepth() uint32 {
-	if x != nil {
-		return x.ProofAtDepth
-	}
-	return 0
+// Deprecated: Use ResolveAssetAliasRequest.ProtoReflect.Descriptor instead.
+func (*ResolveAssetAliasRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *DecodedProof) GetNumberOfProofs() uint32 {
+func (x *ResolveAssetAliasRequest) GetName() string {
 	if x != nil {
-		return x.NumberOfProofs
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *DecodedProof) GetAsset() *Asset {
-	if x != nil {
-		return x.Asset
-	}
-	return nil
-}
+type ExportTransferStatementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *DecodedProof) GetMetaReveal() *AssetMeta {
-	if x != nil {
-		return x.MetaReveal
-	}
-	return nil
+	// The serialization format of the statement.
+	Format StatementFormat `protobuf:"varint,1,opt,name=format,proto3,enum=taprpc.StatementFormat" json:"format,omitempty"`
 }
 
-func (x *DecodedProof) GetTxMerkleProof() []byte {
-	if x != nil {
-		return x.TxMerkleProof
+func (x *ExportTransferStatementRequest) Reset() {
+	*x = ExportTransferStatementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *DecodedProof) GetInclusionProof() []byte {
-	if x != nil {
-		return x.InclusionProof
-	}
-	return nil
+func (x *ExportTransferStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DecodedProof) GetExclusionProofs() [][]byte {
-	if x != nil {
-		return x.ExclusionProofs
-	}
-	return nil
-}
+func (*ExportTransferStatementRequest) ProtoMessage() {}
 
-func (x *DecodedProof) GetSplitRootProof() []byte {
-	if x != nil {
-		return x.SplitRootProof
+func (x *ExportTransferStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *DecodedProof) GetNumAdditionalInputs() uint32 {
-	if x != nil {
-		return x.NumAdditionalInputs
-	}
-	return 0
+// Deprecated: Use ExportTransferStatementRequest.ProtoReflect.Descriptor instead.
+func (*ExportTransferStatementRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *DecodedProof) GetChallengeWitness() [][]byte {
+func (x *ExportTransferStatementRequest) GetFormat() StatementFormat {
 	if x != nil {
-		return x.ChallengeWitness
+		return x.Format
 	}
-	return nil
+	return StatementFormat_STATEMENT_FORMAT_CSV
 }
 
-type VerifyProofResponse struct {
+type ExportTransferStatementResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Valid        bool          `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
-	DecodedProof *DecodedProof `protobuf:"bytes,2,opt,name=decoded_proof,json=decodedProof,proto3" json:"decoded_proof,omitempty"`
+	// The serialized statement. Each outbound transfer is expanded into one entry
+	// per input, one entry per output and a single fee entry, each completed
+	// inbound address receive results in one receive entry.
+	Statement []byte `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
 }
 
-func (x *VerifyProofResponse) Reset() {
-	*x = VerifyProofResponse{}
+func (x *ExportTransferStatementResponse) Reset() {
+	*x = ExportTransferStatementResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[40]
+		mi := &file_taprootassets_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VerifyProofResponse) String() string {
+func (x *ExportTransferStatementResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyProofResponse) ProtoMessage() {}
+func (*ExportTransferStatementResponse) ProtoMessage() {}
 
-func (x *VerifyProofResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[40]
+func (x *ExportTransferStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3117,57 +3833,51 @@ func (x *VerifyProofResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyProofResponse.ProtoReflect.Descriptor instead.
-func (*VerifyProofResponse) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{40}
-}
-
-func (x *VerifyProofResponse) GetValid() bool {
-	if x != nil {
-		return x.Valid
-	}
-	return false
+// Deprecated: Use ExportTransferStatementResponse.ProtoReflect.Descriptor instead.
+func (*ExportTransferStatementResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *VerifyProofResponse) GetDecodedProof() *DecodedProof {
+func (x *ExportTransferStatementResponse) GetStatement() []byte {
 	if x != nil {
-		return x.DecodedProof
+		return x.Statement
 	}
 	return nil
 }
 
-type DecodeProofRequest struct {
+type FrozenAssetOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The raw proof in bytes to decode, which may contain multiple proofs.
-	RawProof []byte `protobuf:"bytes,1,opt,name=raw_proof,json=rawProof,proto3" json:"raw_proof,omitempty"`
-	// The index depth of the decoded proof, with 0 being the latest proof.
-	ProofAtDepth uint32 `protobuf:"varint,2,opt,name=proof_at_depth,json=proofAtDepth,proto3" json:"proof_at_depth,omitempty"`
-	// An option to include previous witnesses in decoding.
-	WithPrevWitnesses bool `protobuf:"varint,3,opt,name=with_prev_witnesses,json=withPrevWitnesses,proto3" json:"with_prev_witnesses,omitempty"`
-	// An option to attempt to retrieve the meta data associated with the proof.
-	WithMetaReveal bool `protobuf:"varint,4,opt,name=with_meta_reveal,json=withMetaReveal,proto3" json:"with_meta_reveal,omitempty"`
+	// The anchor outpoint of the frozen asset in the form txid:vout.
+	AnchorOutpoint string `protobuf:"bytes,1,opt,name=anchor_outpoint,json=anchorOutpoint,proto3" json:"anchor_outpoint,omitempty"`
+	// The script key of the frozen asset.
+	ScriptKey []byte `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The reason the asset output was frozen for.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The time the asset output was frozen at, as a Unix timestamp in
+	// seconds.
+	FrozenAt int64 `protobuf:"varint,4,opt,name=frozen_at,json=frozenAt,proto3" json:"frozen_at,omitempty"`
 }
 
-func (x *DecodeProofRequest) Reset() {
-	*x = DecodeProofRequest{}
+func (x *FrozenAssetOutput) Reset() {
+	*x = FrozenAssetOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[41]
+		mi := &file_taprootassets_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DecodeProofRequest) String() string {
+func (x *FrozenAssetOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecodeProofRequest) ProtoMessage() {}
+func (*FrozenAssetOutput) ProtoMessage() {}
 
-func (x *DecodeProofRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[41]
+func (x *FrozenAssetOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3178,64 +3888,70 @@ func (x *DecodeProofRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecodeProofRequest.ProtoReflect.Descriptor instead.
-func (*DecodeProofRequest) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use FrozenAssetOutput.ProtoReflect.Descriptor instead.
+func (*FrozenAssetOutput) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *DecodeProofRequest) GetRawProof() []byte {
+func (x *FrozenAssetOutput) GetAnchorOutpoint() string {
 	if x != nil {
-		return x.RawProof
+		return x.AnchorOutpoint
 	}
-	return nil
+	return ""
 }
 
-func (x *DecodeProofRequest) GetProofAtDepth() uint32 {
+func (x *FrozenAssetOutput) GetScriptKey() []byte {
 	if x != nil {
-		return x.ProofAtDepth
+		return x.ScriptKey
 	}
-	return 0
+	return nil
 }
 
-func (x *DecodeProofRequest) GetWithPrevWitnesses() bool {
+func (x *FrozenAssetOutput) GetReason() string {
 	if x != nil {
-		return x.WithPrevWitnesses
+		return x.Reason
 	}
-	return false
+	return ""
 }
 
-func (x *DecodeProofRequest) GetWithMetaReveal() bool {
+func (x *FrozenAssetOutput) GetFrozenAt() int64 {
 	if x != nil {
-		return x.WithMetaReveal
+		return x.FrozenAt
 	}
-	return false
+	return 0
 }
 
-type DecodeProofResponse struct {
+type FreezeAssetOutputsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DecodedProof *DecodedProof `protobuf:"bytes,1,opt,name=decoded_proof,json=decodedProof,proto3" json:"decoded_proof,omitempty"`
+	// The anchor outpoint of the assets to freeze in the form txid:vout.
+	AnchorOutpoint string `protobuf:"bytes,1,opt,name=anchor_outpoint,json=anchorOutpoint,proto3" json:"anchor_outpoint,omitempty"`
+	// The optional script key of the asset to freeze. If not set, all assets
+	// anchored at the outpoint are frozen.
+	ScriptKey []byte `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The reason the asset outputs are frozen for. This is required.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *DecodeProofResponse) Reset() {
-	*x = DecodeProofResponse{}
+func (x *FreezeAssetOutputsRequest) Reset() {
+	*x = FreezeAssetOutputsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[42]
+		mi := &file_taprootassets_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DecodeProofResponse) String() string {
+func (x *FreezeAssetOutputsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecodeProofResponse) ProtoMessage() {}
+func (*FreezeAssetOutputsRequest) ProtoMessage() {}
 
-func (x *DecodeProofResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[42]
+func (x *FreezeAssetOutputsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3246,44 +3962,58 @@ func (x *DecodeProofResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecodeProofResponse.ProtoReflect.Descriptor instead.
-func (*DecodeProofResponse) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use FreezeAssetOutputsRequest.ProtoReflect.Descriptor instead.
+func (*FreezeAssetOutputsRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *DecodeProofResponse) GetDecodedProof() *DecodedProof {
+func (x *FreezeAssetOutputsRequest) GetAnchorOutpoint() string {
 	if x != nil {
-		return x.DecodedProof
+		return x.AnchorOutpoint
+	}
+	return ""
+}
+
+func (x *FreezeAssetOutputsRequest) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
 	}
 	return nil
 }
 
-type ExportProofRequest struct {
+func (x *FreezeAssetOutputsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type FreezeAssetOutputsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssetId   []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
-	ScriptKey []byte `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The asset outputs that were frozen.
+	Frozen []*FrozenAssetOutput `protobuf:"bytes,1,rep,name=frozen,proto3" json:"frozen,omitempty"`
 }
 
-func (x *ExportProofRequest) Reset() {
-	*x = ExportProofRequest{}
+func (x *FreezeAssetOutputsResponse) Reset() {
+	*x = FreezeAssetOutputsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[43]
+		mi := &file_taprootassets_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExportProofRequest) String() string {
+func (x *FreezeAssetOutputsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportProofRequest) ProtoMessage() {}
+func (*FreezeAssetOutputsResponse) ProtoMessage() {}
 
-func (x *ExportProofRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[43]
+func (x *FreezeAssetOutputsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3294,69 +4024,47 @@ func (x *ExportProofRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportProofRequest.ProtoReflect.Descriptor instead.
-func (*ExportProofRequest) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *ExportProofRequest) GetAssetId() []byte {
-	if x != nil {
-		return x.AssetId
-	}
-	return nil
+// Deprecated: Use FreezeAssetOutputsResponse.ProtoReflect.Descriptor instead.
+func (*FreezeAssetOutputsResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ExportProofRequest) GetScriptKey() []byte {
+func (x *FreezeAssetOutputsResponse) GetFrozen() []*FrozenAssetOutput {
 	if x != nil {
-		return x.ScriptKey
+		return x.Frozen
 	}
 	return nil
 }
 
-type AddrEvent struct {
+type UnfreezeAssetOutputsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The time the event was created in unix timestamp seconds.
-	CreationTimeUnixSeconds uint64 `protobuf:"varint,1,opt,name=creation_time_unix_seconds,json=creationTimeUnixSeconds,proto3" json:"creation_time_unix_seconds,omitempty"`
-	// The address the event was created for.
-	Addr *Addr `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	// The current status of the event.
-	Status AddrEventStatus `protobuf:"varint,3,opt,name=status,proto3,enum=taprpc.AddrEventStatus" json:"status,omitempty"`
-	// The outpoint that contains the inbound asset transfer.
-	Outpoint string `protobuf:"bytes,4,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
-	// The amount in satoshis that were transferred on chain along with the asset.
-	// This amount is independent of the requested asset amount, which can be
-	// looked up on the address.
-	UtxoAmtSat uint64 `protobuf:"varint,5,opt,name=utxo_amt_sat,json=utxoAmtSat,proto3" json:"utxo_amt_sat,omitempty"`
-	// The taproot sibling hash that was used to send to the Taproot output.
-	TaprootSibling []byte `protobuf:"bytes,6,opt,name=taproot_sibling,json=taprootSibling,proto3" json:"taproot_sibling,omitempty"`
-	// The height at which the on-chain output was confirmed. If this is zero, it
-	// means the output is unconfirmed.
-	ConfirmationHeight uint32 `protobuf:"varint,7,opt,name=confirmation_height,json=confirmationHeight,proto3" json:"confirmation_height,omitempty"`
-	// Indicates whether a proof file can be found for the address' asset ID and
-	// script key.
-	HasProof bool `protobuf:"varint,8,opt,name=has_proof,json=hasProof,proto3" json:"has_proof,omitempty"`
+	// The anchor outpoint of the assets to unfreeze in the form txid:vout.
+	AnchorOutpoint string `protobuf:"bytes,1,opt,name=anchor_outpoint,json=anchorOutpoint,proto3" json:"anchor_outpoint,omitempty"`
+	// The optional script key of the asset to unfreeze. If not set, all frozen
+	// assets anchored at the outpoint are unfrozen.
+	ScriptKey []byte `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
 }
 
-func (x *AddrEvent) Reset() {
-	*x = AddrEvent{}
+func (x *UnfreezeAssetOutputsRequest) Reset() {
+	*x = UnfreezeAssetOutputsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[44]
+		mi := &file_taprootassets_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddrEvent) String() string {
+func (x *UnfreezeAssetOutputsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddrEvent) ProtoMessage() {}
+func (*UnfreezeAssetOutputsRequest) ProtoMessage() {}
 
-func (x *AddrEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[44]
+func (x *UnfreezeAssetOutputsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3367,95 +4075,51 @@ func (x *AddrEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddrEvent.ProtoReflect.Descriptor instead.
-func (*AddrEvent) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *AddrEvent) GetCreationTimeUnixSeconds() uint64 {
-	if x != nil {
-		return x.CreationTimeUnixSeconds
-	}
-	return 0
-}
-
-func (x *AddrEvent) GetAddr() *Addr {
-	if x != nil {
-		return x.Addr
-	}
-	return nil
-}
-
-func (x *AddrEvent) GetStatus() AddrEventStatus {
-	if x != nil {
-		return x.Status
-	}
-	return AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN
+// Deprecated: Use UnfreezeAssetOutputsRequest.ProtoReflect.Descriptor instead.
+func (*UnfreezeAssetOutputsRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *AddrEvent) GetOutpoint() string {
+func (x *UnfreezeAssetOutputsRequest) GetAnchorOutpoint() string {
 	if x != nil {
-		return x.Outpoint
+		return x.AnchorOutpoint
 	}
 	return ""
 }
 
-func (x *AddrEvent) GetUtxoAmtSat() uint64 {
-	if x != nil {
-		return x.UtxoAmtSat
-	}
-	return 0
-}
-
-func (x *AddrEvent) GetTaprootSibling() []byte {
+func (x *UnfreezeAssetOutputsRequest) GetScriptKey() []byte {
 	if x != nil {
-		return x.TaprootSibling
+		return x.ScriptKey
 	}
 	return nil
 }
 
-func (x *AddrEvent) GetConfirmationHeight() uint32 {
-	if x != nil {
-		return x.ConfirmationHeight
-	}
-	return 0
-}
-
-func (x *AddrEvent) GetHasProof() bool {
-	if x != nil {
-		return x.HasProof
-	}
-	return false
-}
-
-type AddrReceivesRequest struct {
+type UnfreezeAssetOutputsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Filter receives by a specific address. Leave empty to get all receives.
-	FilterAddr string `protobuf:"bytes,1,opt,name=filter_addr,json=filterAddr,proto3" json:"filter_addr,omitempty"`
-	// Filter receives by a specific status. Leave empty to get all receives.
-	FilterStatus AddrEventStatus `protobuf:"varint,2,opt,name=filter_status,json=filterStatus,proto3,enum=taprpc.AddrEventStatus" json:"filter_status,omitempty"`
+	// The number of asset outputs that were unfrozen.
+	NumUnfrozen uint32 `protobuf:"varint,1,opt,name=num_unfrozen,json=numUnfrozen,proto3" json:"num_unfrozen,omitempty"`
 }
 
-func (x *AddrReceivesRequest) Reset() {
-	*x = AddrReceivesRequest{}
+func (x *UnfreezeAssetOutputsResponse) Reset() {
+	*x = UnfreezeAssetOutputsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[45]
+		mi := &file_taprootassets_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddrReceivesRequest) String() string {
+func (x *UnfreezeAssetOutputsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddrReceivesRequest) ProtoMessage() {}
+func (*UnfreezeAssetOutputsResponse) ProtoMessage() {}
 
-func (x *AddrReceivesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[45]
+func (x *UnfreezeAssetOutputsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3466,51 +4130,41 @@ func (x *AddrReceivesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddrReceivesRequest.ProtoReflect.Descriptor instead.
-func (*AddrReceivesRequest) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *AddrReceivesRequest) GetFilterAddr() string {
-	if x != nil {
-		return x.FilterAddr
-	}
-	return ""
+// Deprecated: Use UnfreezeAssetOutputsResponse.ProtoReflect.Descriptor instead.
+func (*UnfreezeAssetOutputsResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *AddrReceivesRequest) GetFilterStatus() AddrEventStatus {
+func (x *UnfreezeAssetOutputsResponse) GetNumUnfrozen() uint32 {
 	if x != nil {
-		return x.FilterStatus
+		return x.NumUnfrozen
 	}
-	return AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN
+	return 0
 }
 
-type AddrReceivesResponse struct {
+type ListFrozenAssetOutputsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// The events that match the filter criteria.
-	Events []*AddrEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (x *AddrReceivesResponse) Reset() {
-	*x = AddrReceivesResponse{}
+func (x *ListFrozenAssetOutputsRequest) Reset() {
+	*x = ListFrozenAssetOutputsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[46]
+		mi := &file_taprootassets_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddrReceivesResponse) String() string {
+func (x *ListFrozenAssetOutputsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddrReceivesResponse) ProtoMessage() {}
+func (*ListFrozenAssetOutputsRequest) ProtoMessage() {}
 
-func (x *AddrReceivesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[46]
+func (x *ListFrozenAssetOutputsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3521,43 +4175,37 @@ func (x *AddrReceivesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddrReceivesResponse.ProtoReflect.Descriptor instead.
-func (*AddrReceivesResponse) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{46}
-}
-
-func (x *AddrReceivesResponse) GetEvents() []*AddrEvent {
-	if x != nil {
-		return x.Events
-	}
-	return nil
+// Deprecated: Use ListFrozenAssetOutputsRequest.ProtoReflect.Descriptor instead.
+func (*ListFrozenAssetOutputsRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{51}
 }
 
-type SendAssetRequest struct {
+type ListFrozenAssetOutputsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TapAddrs []string `protobuf:"bytes,1,rep,name=tap_addrs,json=tapAddrs,proto3" json:"tap_addrs,omitempty"`
+	// All frozen asset outputs in the order they were frozen in.
+	Frozen []*FrozenAssetOutput `protobuf:"bytes,1,rep,name=frozen,proto3" json:"frozen,omitempty"`
 }
 
-func (x *SendAssetRequest) Reset() {
-	*x = SendAssetRequest{}
+func (x *ListFrozenAssetOutputsResponse) Reset() {
+	*x = ListFrozenAssetOutputsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[47]
+		mi := &file_taprootassets_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendAssetRequest) String() string {
+func (x *ListFrozenAssetOutputsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendAssetRequest) ProtoMessage() {}
+func (*ListFrozenAssetOutputsResponse) ProtoMessage() {}
 
-func (x *SendAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[47]
+func (x *ListFrozenAssetOutputsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3568,46 +4216,65 @@ func (x *SendAssetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendAssetRequest.ProtoReflect.Descriptor instead.
-func (*SendAssetRequest) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ListFrozenAssetOutputsResponse.ProtoReflect.Descriptor instead.
+func (*ListFrozenAssetOutputsResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *SendAssetRequest) GetTapAddrs() []string {
+func (x *ListFrozenAssetOutputsResponse) GetFrozen() []*FrozenAssetOutput {
 	if x != nil {
-		return x.TapAddrs
+		return x.Frozen
 	}
 	return nil
 }
 
-type PrevInputAsset struct {
+type WatchedAsset struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AnchorPoint string `protobuf:"bytes,1,opt,name=anchor_point,json=anchorPoint,proto3" json:"anchor_point,omitempty"`
-	AssetId     []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
-	ScriptKey   []byte `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
-	Amount      uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-}
-
-func (x *PrevInputAsset) Reset() {
-	*x = PrevInputAsset{}
+	// The ID of the watched asset.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The user defined description of the watched asset.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// The ID of the watched asset.
+	AssetId []byte `protobuf:"bytes,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The tweaked group key of the watched asset, if it has one.
+	GroupKey []byte `protobuf:"bytes,4,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+	// The script key the watched asset is locked to.
+	ScriptKey []byte `protobuf:"bytes,5,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The amount of the watched asset.
+	Amount uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The anchor outpoint of the watched asset in the form txid:vout.
+	AnchorOutpoint string `protobuf:"bytes,7,opt,name=anchor_outpoint,json=anchorOutpoint,proto3" json:"anchor_outpoint,omitempty"`
+	// The height of the block the anchor transaction confirmed in.
+	AnchorHeight uint32 `protobuf:"varint,8,opt,name=anchor_height,json=anchorHeight,proto3" json:"anchor_height,omitempty"`
+	// The ID of the transaction that spent the anchor output, or empty if the
+	// anchor output is unspent.
+	SpendingTxid string `protobuf:"bytes,9,opt,name=spending_txid,json=spendingTxid,proto3" json:"spending_txid,omitempty"`
+	// The height of the block the spending transaction confirmed in.
+	SpendHeight uint32 `protobuf:"varint,10,opt,name=spend_height,json=spendHeight,proto3" json:"spend_height,omitempty"`
+	// The unix timestamp in seconds the asset was first watched at.
+	CreatedAt int64 `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *WatchedAsset) Reset() {
+	*x = WatchedAsset{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[48]
+		mi := &file_taprootassets_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PrevInputAsset) String() string {
+func (x *WatchedAsset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrevInputAsset) ProtoMessage() {}
+func (*WatchedAsset) ProtoMessage() {}
 
-func (x *PrevInputAsset) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[48]
+func (x *WatchedAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3618,64 +4285,117 @@ func (x *PrevInputAsset) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrevInputAsset.ProtoReflect.Descriptor instead.
-func (*PrevInputAsset) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use WatchedAsset.ProtoReflect.Descriptor instead.
+func (*WatchedAsset) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *PrevInputAsset) GetAnchorPoint() string {
+func (x *WatchedAsset) GetId() int64 {
 	if x != nil {
-		return x.AnchorPoint
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WatchedAsset) GetLabel() string {
+	if x != nil {
+		return x.Label
 	}
 	return ""
 }
 
-func (x *PrevInputAsset) GetAssetId() []byte {
+func (x *WatchedAsset) GetAssetId() []byte {
 	if x != nil {
 		return x.AssetId
 	}
 	return nil
 }
 
-func (x *PrevInputAsset) GetScriptKey() []byte {
+func (x *WatchedAsset) GetGroupKey() []byte {
 	if x != nil {
-		return x.ScriptKey
+		return x.GroupKey
 	}
 	return nil
 }
 
-func (x *PrevInputAsset) GetAmount() uint64 {
+func (x *WatchedAsset) GetScriptKey() []byte {
 	if x != nil {
-		return x.Amount
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *WatchedAsset) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
 	}
 	return 0
 }
 
-type SendAssetResponse struct {
+func (x *WatchedAsset) GetAnchorOutpoint() string {
+	if x != nil {
+		return x.AnchorOutpoint
+	}
+	return ""
+}
+
+func (x *WatchedAsset) GetAnchorHeight() uint32 {
+	if x != nil {
+		return x.AnchorHeight
+	}
+	return 0
+}
+
+func (x *WatchedAsset) GetSpendingTxid() string {
+	if x != nil {
+		return x.SpendingTxid
+	}
+	return ""
+}
+
+func (x *WatchedAsset) GetSpendHeight() uint32 {
+	if x != nil {
+		return x.SpendHeight
+	}
+	return 0
+}
+
+func (x *WatchedAsset) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type WatchAssetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transfer *AssetTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	// The proof file of the asset to watch. The asset must not be owned by the
+	// daemon.
+	RawProofFile []byte `protobuf:"bytes,1,opt,name=raw_proof_file,json=rawProofFile,proto3" json:"raw_proof_file,omitempty"`
+	// An optional description of the watched asset.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func (x *SendAssetResponse) Reset() {
-	*x = SendAssetResponse{}
+func (x *WatchAssetRequest) Reset() {
+	*x = WatchAssetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[49]
+		mi := &file_taprootassets_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendAssetResponse) String() string {
+func (x *WatchAssetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendAssetResponse) ProtoMessage() {}
+func (*WatchAssetRequest) ProtoMessage() {}
 
-func (x *SendAssetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[49]
+func (x *WatchAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3686,41 +4406,51 @@ func (x *SendAssetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendAssetResponse.ProtoReflect.Descriptor instead.
-func (*SendAssetResponse) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use WatchAssetRequest.ProtoReflect.Descriptor instead.
+func (*WatchAssetRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *SendAssetResponse) GetTransfer() *AssetTransfer {
+func (x *WatchAssetRequest) GetRawProofFile() []byte {
 	if x != nil {
-		return x.Transfer
+		return x.RawProofFile
 	}
 	return nil
 }
 
-type GetInfoRequest struct {
+func (x *WatchAssetRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type WatchAssetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The watched asset.
+	WatchedAsset *WatchedAsset `protobuf:"bytes,1,opt,name=watched_asset,json=watchedAsset,proto3" json:"watched_asset,omitempty"`
 }
 
-func (x *GetInfoRequest) Reset() {
-	*x = GetInfoRequest{}
+func (x *WatchAssetResponse) Reset() {
+	*x = WatchAssetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[50]
+		mi := &file_taprootassets_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetInfoRequest) String() string {
+func (x *WatchAssetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInfoRequest) ProtoMessage() {}
+func (*WatchAssetResponse) ProtoMessage() {}
 
-func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[50]
+func (x *WatchAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3731,38 +4461,44 @@ func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use WatchAssetResponse.ProtoReflect.Descriptor instead.
+func (*WatchAssetResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{55}
 }
 
-type GetInfoResponse struct {
+func (x *WatchAssetResponse) GetWatchedAsset() *WatchedAsset {
+	if x != nil {
+		return x.WatchedAsset
+	}
+	return nil
+}
+
+type UnwatchAssetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version    string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	LndVersion string `protobuf:"bytes,2,opt,name=lnd_version,json=lndVersion,proto3" json:"lnd_version,omitempty"`
-	Network    string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	// The ID of the watched asset.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetInfoResponse) Reset() {
-	*x = GetInfoResponse{}
+func (x *UnwatchAssetRequest) Reset() {
+	*x = UnwatchAssetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[51]
+		mi := &file_taprootassets_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetInfoResponse) String() string {
+func (x *UnwatchAssetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInfoResponse) ProtoMessage() {}
+func (*UnwatchAssetRequest) ProtoMessage() {}
 
-func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[51]
+func (x *UnwatchAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3773,55 +4509,41 @@ func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{51}
-}
-
-func (x *GetInfoResponse) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
-}
-
-func (x *GetInfoResponse) GetLndVersion() string {
-	if x != nil {
-		return x.LndVersion
-	}
-	return ""
+// Deprecated: Use UnwatchAssetRequest.ProtoReflect.Descriptor instead.
+func (*UnwatchAssetRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetInfoResponse) GetNetwork() string {
+func (x *UnwatchAssetRequest) GetId() int64 {
 	if x != nil {
-		return x.Network
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type SubscribeSendAssetEventNtfnsRequest struct {
+type UnwatchAssetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SubscribeSendAssetEventNtfnsRequest) Reset() {
-	*x = SubscribeSendAssetEventNtfnsRequest{}
+func (x *UnwatchAssetResponse) Reset() {
+	*x = UnwatchAssetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[52]
+		mi := &file_taprootassets_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscribeSendAssetEventNtfnsRequest) String() string {
+func (x *UnwatchAssetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeSendAssetEventNtfnsRequest) ProtoMessage() {}
+func (*UnwatchAssetResponse) ProtoMessage() {}
 
-func (x *SubscribeSendAssetEventNtfnsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[52]
+func (x *UnwatchAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3832,40 +4554,37 @@ func (x *SubscribeSendAssetEventNtfnsRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeSendAssetEventNtfnsRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeSendAssetEventNtfnsRequest) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use UnwatchAssetResponse.ProtoReflect.Descriptor instead.
+func (*UnwatchAssetResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{57}
 }
 
-type SendAssetEvent struct {
+type ListWatchedAssetsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Event:
-	//
-	//	*SendAssetEvent_ExecuteSendStateEvent
-	//	*SendAssetEvent_ReceiverProofBackoffWaitEvent
-	Event isSendAssetEvent_Event `protobuf_oneof:"event"`
+	// Whether to include watched assets with a spent anchor output.
+	IncludeSpent bool `protobuf:"varint,1,opt,name=include_spent,json=includeSpent,proto3" json:"include_spent,omitempty"`
 }
 
-func (x *SendAssetEvent) Reset() {
-	*x = SendAssetEvent{}
+func (x *ListWatchedAssetsRequest) Reset() {
+	*x = ListWatchedAssetsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[53]
+		mi := &file_taprootassets_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendAssetEvent) String() string {
+func (x *ListWatchedAssetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendAssetEvent) ProtoMessage() {}
+func (*ListWatchedAssetsRequest) ProtoMessage() {}
 
-func (x *SendAssetEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[53]
+func (x *ListWatchedAssetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3876,79 +4595,44 @@ func (x *SendAssetEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendAssetEvent.ProtoReflect.Descriptor instead.
-func (*SendAssetEvent) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{53}
-}
-
-func (m *SendAssetEvent) GetEvent() isSendAssetEvent_Event {
-	if m != nil {
-		return m.Event
-	}
-	return nil
-}
-
-func (x *SendAssetEvent) GetExecuteSendStateEvent() *ExecuteSendStateEvent {
-	if x, ok := x.GetEvent().(*SendAssetEvent_ExecuteSendStateEvent); ok {
-		return x.ExecuteSendStateEvent
-	}
-	return nil
+// Deprecated: Use ListWatchedAssetsRequest.ProtoReflect.Descriptor instead.
+func (*ListWatchedAssetsRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *SendAssetEvent) GetReceiverProofBackoffWaitEvent() *ReceiverProofBackoffWaitEvent {
-	if x, ok := x.GetEvent().(*SendAssetEvent_ReceiverProofBackoffWaitEvent); ok {
-		return x.ReceiverProofBackoffWaitEvent
+func (x *ListWatchedAssetsRequest) GetIncludeSpent() bool {
+	if x != nil {
+		return x.IncludeSpent
 	}
-	return nil
-}
-
-type isSendAssetEvent_Event interface {
-	isSendAssetEvent_Event()
-}
-
-type SendAssetEvent_ExecuteSendStateEvent struct {
-	// An event which indicates that a send state is about to be executed.
-	ExecuteSendStateEvent *ExecuteSendStateEvent `protobuf:"bytes,1,opt,name=execute_send_state_event,json=executeSendStateEvent,proto3,oneof"`
-}
-
-type SendAssetEvent_ReceiverProofBackoffWaitEvent struct {
-	// An event which indicates that the proof send backoff wait period will
-	// start imminently.
-	ReceiverProofBackoffWaitEvent *ReceiverProofBackoffWaitEvent `protobuf:"bytes,2,opt,name=receiver_proof_backoff_wait_event,json=receiverProofBackoffWaitEvent,proto3,oneof"`
+	return false
 }
 
-func (*SendAssetEvent_ExecuteSendStateEvent) isSendAssetEvent_Event() {}
-
-func (*SendAssetEvent_ReceiverProofBackoffWaitEvent) isSendAssetEvent_Event() {}
-
-type ExecuteSendStateEvent struct {
+type ListWatchedAssetsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Execute timestamp (microseconds).
-	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// The send state that is about to be executed.
-	SendState string `protobuf:"bytes,2,opt,name=send_state,json=sendState,proto3" json:"send_state,omitempty"`
+	// The watched assets in the order they were first watched in.
+	WatchedAssets []*WatchedAsset `protobuf:"bytes,1,rep,name=watched_assets,json=watchedAssets,proto3" json:"watched_assets,omitempty"`
 }
 
-func (x *ExecuteSendStateEvent) Reset() {
-	*x = ExecuteSendStateEvent{}
+func (x *ListWatchedAssetsResponse) Reset() {
+	*x = ListWatchedAssetsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[54]
+		mi := &file_taprootassets_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExecuteSendStateEvent) String() string {
+func (x *ListWatchedAssetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteSendStateEvent) ProtoMessage() {}
+func (*ListWatchedAssetsResponse) ProtoMessage() {}
 
-func (x *ExecuteSendStateEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[54]
+func (x *ListWatchedAssetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3959,57 +4643,56 @@ func (x *ExecuteSendStateEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteSendStateEvent.ProtoReflect.Descriptor instead.
-func (*ExecuteSendStateEvent) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{54}
-}
-
-func (x *ExecuteSendStateEvent) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
+// Deprecated: Use ListWatchedAssetsResponse.ProtoReflect.Descriptor instead.
+func (*ListWatchedAssetsResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ExecuteSendStateEvent) GetSendState() string {
+func (x *ListWatchedAssetsResponse) GetWatchedAssets() []*WatchedAsset {
 	if x != nil {
-		return x.SendState
+		return x.WatchedAssets
 	}
-	return ""
+	return nil
 }
 
-type ReceiverProofBackoffWaitEvent struct {
+type AtRiskAsset struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Transfer attempt timestamp (microseconds).
-	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// Backoff is the active backoff wait duration.
-	Backoff int64 `protobuf:"varint,2,opt,name=backoff,proto3" json:"backoff,omitempty"`
-	// Tries counter is the number of tries we've made so far during the
-	// course of the current backoff procedure to deliver the proof to the
-	// receiver.
-	TriesCounter int64 `protobuf:"varint,3,opt,name=tries_counter,json=triesCounter,proto3" json:"tries_counter,omitempty"`
+	// The ID of the asset.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The tweaked script key of the asset.
+	ScriptKey []byte `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The amount of the asset.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The spent anchor outpoint of the asset in the form txid:vout.
+	AnchorOutpoint string `protobuf:"bytes,4,opt,name=anchor_outpoint,json=anchorOutpoint,proto3" json:"anchor_outpoint,omitempty"`
+	// The ID of the unknown transaction that spent the anchor output.
+	SpendingTxid string `protobuf:"bytes,5,opt,name=spending_txid,json=spendingTxid,proto3" json:"spending_txid,omitempty"`
+	// The height of the block the spending transaction confirmed in.
+	SpendHeight uint32 `protobuf:"varint,6,opt,name=spend_height,json=spendHeight,proto3" json:"spend_height,omitempty"`
+	// The unix timestamp in seconds the spend was detected at.
+	DetectedAt int64 `protobuf:"varint,7,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
 }
 
-func (x *ReceiverProofBackoffWaitEvent) Reset() {
-	*x = ReceiverProofBackoffWaitEvent{}
+func (x *AtRiskAsset) Reset() {
+	*x = AtRiskAsset{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[55]
+		mi := &file_taprootassets_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReceiverProofBackoffWaitEvent) String() string {
+func (x *AtRiskAsset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceiverProofBackoffWaitEvent) ProtoMessage() {}
+func (*AtRiskAsset) ProtoMessage() {}
 
-func (x *ReceiverProofBackoffWaitEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[55]
+func (x *AtRiskAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4020,63 +4703,83 @@ func (x *ReceiverProofBackoffWaitEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceiverProofBackoffWaitEvent.ProtoReflect.Descriptor instead.
-func (*ReceiverProofBackoffWaitEvent) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use AtRiskAsset.ProtoReflect.Descriptor instead.
+func (*AtRiskAsset) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ReceiverProofBackoffWaitEvent) GetTimestamp() int64 {
+func (x *AtRiskAsset) GetAssetId() []byte {
 	if x != nil {
-		return x.Timestamp
+		return x.AssetId
 	}
-	return 0
+	return nil
 }
 
-func (x *ReceiverProofBackoffWaitEvent) GetBackoff() int64 {
+func (x *AtRiskAsset) GetScriptKey() []byte {
 	if x != nil {
-		return x.Backoff
+		return x.ScriptKey
 	}
-	return 0
+	return nil
 }
 
-func (x *ReceiverProofBackoffWaitEvent) GetTriesCounter() int64 {
+func (x *AtRiskAsset) GetAmount() uint64 {
 	if x != nil {
-		return x.TriesCounter
+		return x.Amount
 	}
 	return 0
 }
 
-type FetchAssetMetaRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AtRiskAsset) GetAnchorOutpoint() string {
+	if x != nil {
+		return x.AnchorOutpoint
+	}
+	return ""
+}
 
-	// Types that are assignable to Asset:
-	//
-	//	*FetchAssetMetaRequest_AssetId
-	//	*FetchAssetMetaRequest_MetaHash
-	//	*FetchAssetMetaRequest_AssetIdStr
-	//	*FetchAssetMetaRequest_MetaHashStr
-	Asset isFetchAssetMetaRequest_Asset `protobuf_oneof:"asset"`
+func (x *AtRiskAsset) GetSpendingTxid() string {
+	if x != nil {
+		return x.SpendingTxid
+	}
+	return ""
 }
 
-func (x *FetchAssetMetaRequest) Reset() {
-	*x = FetchAssetMetaRequest{}
+func (x *AtRiskAsset) GetSpendHeight() uint32 {
+	if x != nil {
+		return x.SpendHeight
+	}
+	return 0
+}
+
+func (x *AtRiskAsset) GetDetectedAt() int64 {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return 0
+}
+
+type ListAtRiskAssetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListAtRiskAssetsRequest) Reset() {
+	*x = ListAtRiskAssetsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taprootassets_proto_msgTypes[56]
+		mi := &file_taprootassets_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FetchAssetMetaRequest) String() string {
+func (x *ListAtRiskAssetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchAssetMetaRequest) ProtoMessage() {}
+func (*ListAtRiskAssetsRequest) ProtoMessage() {}
 
-func (x *FetchAssetMetaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taprootassets_proto_msgTypes[56]
+func (x *ListAtRiskAssetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4087,898 +4790,13065 @@ func (x *FetchAssetMetaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchAssetMetaRequest.ProtoReflect.Descriptor instead.
-func (*FetchAssetMetaRequest) Descriptor() ([]byte, []int) {
-	return file_taprootassets_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use ListAtRiskAssetsRequest.ProtoReflect.Descriptor instead.
+func (*ListAtRiskAssetsRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{61}
 }
 
-func (m *FetchAssetMetaRequest) GetAsset() isFetchAssetMetaRequest_Asset {
-	if m != nil {
-		return m.Asset
+type ListAtRiskAssetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The at risk assets in the order their spend was detected in.
+	AtRiskAssets []*AtRiskAsset `protobuf:"bytes,1,rep,name=at_risk_assets,json=atRiskAssets,proto3" json:"at_risk_assets,omitempty"`
+}
+
+func (x *ListAtRiskAssetsResponse) Reset() {
+	*x = ListAtRiskAssetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *FetchAssetMetaRequest) GetAssetId() []byte {
-	if x, ok := x.GetAsset().(*FetchAssetMetaRequest_AssetId); ok {
-		return x.AssetId
+func (x *ListAtRiskAssetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAtRiskAssetsResponse) ProtoMessage() {}
+
+func (x *ListAtRiskAssetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *FetchAssetMetaRequest) GetMetaHash() []byte {
-	if x, ok := x.GetAsset().(*FetchAssetMetaRequest_MetaHash); ok {
-		return x.MetaHash
+// Deprecated: Use ListAtRiskAssetsResponse.ProtoReflect.Descriptor instead.
+func (*ListAtRiskAssetsResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListAtRiskAssetsResponse) GetAtRiskAssets() []*AtRiskAsset {
+	if x != nil {
+		return x.AtRiskAssets
 	}
 	return nil
 }
 
-func (x *FetchAssetMetaRequest) GetAssetIdStr() string {
-	if x, ok := x.GetAsset().(*FetchAssetMetaRequest_AssetIdStr); ok {
-		return x.AssetIdStr
-	}
-	return ""
+type KeyDerivation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// What the derived key is used for.
+	Purpose KeyPurpose `protobuf:"varint,1,opt,name=purpose,proto3,enum=taprpc.KeyPurpose" json:"purpose,omitempty"`
+	// The derived key and its locator.
+	Key *KeyDescriptor `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// A description of the object the key was derived for.
+	Object string `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+	// The unix timestamp in seconds of when the key was derived.
+	DerivedAt int64 `protobuf:"varint,4,opt,name=derived_at,json=derivedAt,proto3" json:"derived_at,omitempty"`
 }
 
-func (x *FetchAssetMetaRequest) GetMetaHashStr() string {
-	if x, ok := x.GetAsset().(*FetchAssetMetaRequest_MetaHashStr); ok {
-		return x.MetaHashStr
+func (x *KeyDerivation) Reset() {
+	*x = KeyDerivation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-type isFetchAssetMetaRequest_Asset interface {
-	isFetchAssetMetaRequest_Asset()
+func (x *KeyDerivation) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type FetchAssetMetaRequest_AssetId struct {
-	// The asset ID of the asset to fetch the meta for.
-	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3,oneof"`
+func (*KeyDerivation) ProtoMessage() {}
+
+func (x *KeyDerivation) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type FetchAssetMetaRequest_MetaHash struct {
-	// The 32-byte meta hash of the asset meta.
-	MetaHash []byte `protobuf:"bytes,2,opt,name=meta_hash,json=metaHash,proto3,oneof"`
+// Deprecated: Use KeyDerivation.ProtoReflect.Descriptor instead.
+func (*KeyDerivation) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{63}
 }
 
-type FetchAssetMetaRequest_AssetIdStr struct {
-	// The hex encoded asset ID of the asset to fetch the meta for.
-	AssetIdStr string `protobuf:"bytes,3,opt,name=asset_id_str,json=assetIdStr,proto3,oneof"`
+func (x *KeyDerivation) GetPurpose() KeyPurpose {
+	if x != nil {
+		return x.Purpose
+	}
+	return KeyPurpose_KEY_PURPOSE_UNKNOWN
 }
 
-type FetchAssetMetaRequest_MetaHashStr struct {
-	// The hex encoded meta hash of the asset meta.
-	MetaHashStr string `protobuf:"bytes,4,opt,name=meta_hash_str,json=metaHashStr,proto3,oneof"`
+func (x *KeyDerivation) GetKey() *KeyDescriptor {
+	if x != nil {
+		return x.Key
+	}
+	return nil
 }
 
-func (*FetchAssetMetaRequest_AssetId) isFetchAssetMetaRequest_Asset() {}
+func (x *KeyDerivation) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
 
-func (*FetchAssetMetaRequest_MetaHash) isFetchAssetMetaRequest_Asset() {}
+func (x *KeyDerivation) GetDerivedAt() int64 {
+	if x != nil {
+		return x.DerivedAt
+	}
+	return 0
+}
 
-func (*FetchAssetMetaRequest_AssetIdStr) isFetchAssetMetaRequest_Asset() {}
+type ListKeyDerivationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (*FetchAssetMetaRequest_MetaHashStr) isFetchAssetMetaRequest_Asset() {}
+	// If set, only derivations with the given purpose are returned.
+	FilterPurpose KeyPurpose `protobuf:"varint,1,opt,name=filter_purpose,json=filterPurpose,proto3,enum=taprpc.KeyPurpose" json:"filter_purpose,omitempty"`
+	// If set, only derivations of the given 33-byte raw key are returned.
+	FilterRawKey []byte `protobuf:"bytes,2,opt,name=filter_raw_key,json=filterRawKey,proto3" json:"filter_raw_key,omitempty"`
+}
 
-var File_taprootassets_proto protoreflect.FileDescriptor
+func (x *ListKeyDerivationsRequest) Reset() {
+	*x = ListKeyDerivationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-var file_taprootassets_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x22, 0x67, 0x0a,
-	0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x29,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x74,
-	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74,
-	0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x22, 0x81, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77,
-	0x69, 0x74, 0x68, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x23,
-	0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x70,
-	0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6c,
-	0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x22, 0xb3, 0x02, 0x0a, 0x0a, 0x41,
-	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63,
-	0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x6e,
-	0x63, 0x68, 0x6f, 0x72, 0x54, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
-	0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6e, 0x63,
-	0x68, 0x6f, 0x72, 0x54, 0x78, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f,
-	0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75,
-	0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6e,
-	0x63, 0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12,
-	0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74,
-	0x12, 0x2b, 0x0a, 0x11, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x73, 0x69,
-	0x62, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x61, 0x70,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a,
-	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x22, 0xbb, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x23, 0x0a, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74,
-	0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7e,
-	0x0a, 0x0a, 0x41, 0x73, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x22, 0x0a, 0x0d,
-	0x72, 0x61, 0x77, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79,
-	0x12, 0x2a, 0x0a, 0x11, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x74, 0x77, 0x65,
-	0x61, 0x6b, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0c,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x53, 0x69, 0x67, 0x22, 0xec,
-	0x04, 0x0a, 0x05, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x67, 0x65, 0x6e, 0x65,
-	0x73, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x70, 0x72,
-	0x70, 0x63, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x30, 0x0a, 0x0a,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x13, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x49,
-	0x73, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61,
-	0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x35, 0x0a, 0x0c, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x6e, 0x63, 0x68,
-	0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x70,
-	0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52,
-	0x0d, 0x70, 0x72, 0x65, 0x76, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x19,
-	0x0a, 0x08, 0x69, 0x73, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x69, 0x73, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x65, 0x61,
-	0x73, 0x65, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0b, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0xa1, 0x01,
-	0x0a, 0x0b, 0x50, 0x72, 0x65, 0x76, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a,
-	0x07, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x49, 0x6e, 0x70, 0x75,
-	0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x70, 0x72, 0x65, 0x76, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0c, 0x52, 0x09, 0x74, 0x78, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x42, 0x0a,
-	0x10, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
-	0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x0f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x22, 0x3f, 0x0a, 0x0f, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
-	0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x22, 0x3a, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
-	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0x39,
-	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c,
-	0x75, 0x64, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x22, 0xdc, 0x01, 0x0a, 0x0b, 0x4d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x74, 0x78, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x75, 0x74,
-	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75,
-	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b,
-	0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10,
-	0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f,
-	0x74, 0x12, 0x25, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0xbb, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73,
-	0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50,
-	0x0a, 0x0d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x5f, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x74, 0x78, 0x6f, 0x73,
-	0x1a, 0x54, 0x0a, 0x11, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x74, 0x78, 0x6f, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
-	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x12,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x48, 0x75, 0x6d, 0x61, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c,
-	0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6c, 0x61, 0x74,
-	0x69, 0x76, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63,
-	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x43, 0x0a, 0x0d, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x06,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74,
-	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x48, 0x75, 0x6d, 0x61, 0x6e,
-	0x52, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73,
-	0x22, 0xa6, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0x50, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
-	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xaa, 0x01, 0x0a, 0x13, 0x4c, 0x69,
-	0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x00, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a,
-	0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x12, 0x28, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x22, 0x94, 0x01, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x4a, 0x0a,
-	0x11, 0x41, 0x73, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12,
-	0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x90, 0x03, 0x0a, 0x14, 0x4c, 0x69,
-	0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x56, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x74, 0x61, 0x70,
-	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x66, 0x0a, 0x14, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x73, 0x1a, 0x56, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x70, 0x72,
-	0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x60, 0x0a, 0x17, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x16, 0x0a, 0x14,
-	0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
-	0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x73, 0x22, 0xa9, 0x02, 0x0a, 0x0d, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78,
-	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x61, 0x6e, 0x63,
-	0x68, 0x6f, 0x72, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x31, 0x0a, 0x15, 0x61, 0x6e, 0x63,
-	0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x68, 0x69,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
-	0x54, 0x78, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x14,
-	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
-	0x66, 0x65, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x61, 0x6e, 0x63, 0x68,
-	0x6f, 0x72, 0x54, 0x78, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x2d, 0x0a,
-	0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x07,
-	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0x84,
-	0x01, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x95, 0x02, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x1a,
-	0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x10, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f,
-	0x6f, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
-	0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74,
-	0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x12,
-	0x2c, 0x0a, 0x12, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6e, 0x75, 0x6d,
-	0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0xbc, 0x02,
-	0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x12, 0x34, 0x0a, 0x06, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x52, 0x06,
-	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x13, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
-	0x6b, 0x65, 0x79, 0x5f, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x10, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x49, 0x73, 0x4c,
-	0x6f, 0x63, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e,
-	0x6e, 0x65, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x6c,
-	0x6f, 0x62, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x13, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
-	0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x33, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74,
-	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x0d, 0x0a, 0x0b,
-	0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x53,
-	0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x11, 0x44,
-	0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
-	0x73, 0x68, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x73, 0x70,
-	0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x53,
-	0x70, 0x65, 0x63, 0x22, 0x35, 0x0a, 0x12, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62,
-	0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x73, 0x75, 0x62, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xbf, 0x02, 0x0a, 0x04, 0x41,
-	0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x12, 0x19, 0x0a,
-	0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74,
-	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x21,
-	0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65,
-	0x79, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x73,
-	0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x61,
-	0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x2c,
-	0x0a, 0x12, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x61, 0x70, 0x72,
-	0x6f, 0x6f, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x8c, 0x01, 0x0a,
-	0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x37, 0x0a, 0x11, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x22, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x05, 0x61,
-	0x64, 0x64, 0x72, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x03, 0x61, 0x6d, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
-	0x63, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
-	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x6f, 0x72, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79,
-	0x12, 0x2b, 0x0a, 0x11, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x73, 0x69,
-	0x62, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x61, 0x70,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x73, 0x0a,
-	0x09, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75,
-	0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62,
-	0x4b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4b,
-	0x65, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x6b, 0x65,
-	0x79, 0x44, 0x65, 0x73, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x70, 0x5f, 0x74, 0x77, 0x65,
-	0x61, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x74, 0x61, 0x70, 0x54, 0x77, 0x65,
-	0x61, 0x6b, 0x22, 0x48, 0x0a, 0x0a, 0x4b, 0x65, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72,
-	0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12,
-	0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x60, 0x0a, 0x0d,
-	0x4b, 0x65, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x22, 0x0a,
-	0x0d, 0x72, 0x61, 0x77, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x4b, 0x65, 0x79, 0x42, 0x79, 0x74, 0x65,
-	0x73, 0x12, 0x2b, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x5f, 0x6c, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4b, 0x65, 0x79, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x06, 0x6b, 0x65, 0x79, 0x4c, 0x6f, 0x63, 0x22, 0x27,
-	0x0a, 0x11, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x22, 0x4d, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6f, 0x66,
-	0x46, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x6f,
-	0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0xbe, 0x03, 0x0a, 0x0c, 0x44, 0x65, 0x63, 0x6f, 0x64,
-	0x65, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x5f, 0x61, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0c, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x41, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x28, 0x0a,
-	0x10, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f,
-	0x66, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x0b,
-	0x6d, 0x65, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x4d, 0x65, 0x74, 0x61, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c,
-	0x12, 0x26, 0x0a, 0x0f, 0x74, 0x78, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x72,
-	0x6f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x78, 0x4d, 0x65, 0x72,
-	0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c,
-	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
-	0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x78, 0x63,
-	0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x28, 0x0a, 0x10,
-	0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x6f, 0x6f,
-	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x32, 0x0a, 0x15, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x64,
-	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6e, 0x75, 0x6d, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x68,
-	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18,
-	0x0a, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
-	0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x22, 0x66, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0d, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f,
-	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61,
-	0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22,
-	0xb1, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x77, 0x5f, 0x70, 0x72,
-	0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x61, 0x77, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x61, 0x74, 0x5f,
-	0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x72, 0x6f,
-	0x6f, 0x66, 0x41, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x13, 0x77, 0x69, 0x74,
-	0x68, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x50, 0x72, 0x65, 0x76,
-	0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x69, 0x74,
-	0x68, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x76,
-	0x65, 0x61, 0x6c, 0x22, 0x50, 0x0a, 0x13, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f,
-	0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x64, 0x65,
-	0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64,
-	0x65, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x4e, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50,
-	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x4b, 0x65, 0x79, 0x22, 0xd0, 0x02, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x72, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
-	0x12, 0x20, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x04, 0x61, 0x64,
-	0x64, 0x72, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
-	0x20, 0x0a, 0x0c, 0x75, 0x74, 0x78, 0x6f, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x75, 0x74, 0x78, 0x6f, 0x41, 0x6d, 0x74, 0x53, 0x61,
-	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x73, 0x69, 0x62,
-	0x6c, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x74, 0x61, 0x70, 0x72,
-	0x6f, 0x6f, 0x74, 0x53, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x68,
-	0x61, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x68, 0x61, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x74, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x72,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
-	0x12, 0x3c, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x41,
-	0x0a, 0x14, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0x2f, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x70, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x70, 0x41, 0x64, 0x64,
-	0x72, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x76, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6e, 0x63,
-	0x68, 0x6f, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b,
-	0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x46, 0x0a, 0x11, 0x53, 0x65,
-	0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x31, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x66, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6e, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x22, 0x25, 0x0a, 0x23,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x74, 0x66, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0xe6, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x58, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x65, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
-	0x63, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x15, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x71, 0x0a, 0x21, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f,
-	0x6f, 0x66, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x61,
-	0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x50, 0x72, 0x6f,
-	0x6f, 0x66, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x48, 0x00, 0x52, 0x1d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x15,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x22, 0x7c, 0x0a, 0x1d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x23, 0x0a, 0x0d, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
-	0x22, 0xa6, 0x01, 0x0a, 0x15, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d,
-	0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
-	0x69, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x53, 0x74, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x65,
-	0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x53, 0x74, 0x72,
-	0x42, 0x07, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2a, 0x28, 0x0a, 0x09, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c,
-	0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x42, 0x4c,
-	0x45, 0x10, 0x01, 0x2a, 0x25, 0x0a, 0x0d, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x45, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x4f, 0x50, 0x41, 0x51, 0x55, 0x45, 0x10, 0x00, 0x2a, 0x89, 0x01, 0x0a, 0x0a, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x55, 0x54,
-	0x50, 0x55, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x10,
-	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x23, 0x0a,
-	0x1f, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41, 0x53,
-	0x53, 0x49, 0x56, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x53, 0x5f, 0x4f, 0x4e, 0x4c, 0x59,
-	0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f,
-	0x52, 0x4f, 0x4f, 0x54, 0x10, 0x03, 0x2a, 0xd0, 0x01, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x72, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x44,
-	0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x2a, 0x0a, 0x26, 0x41, 0x44, 0x44,
-	0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54,
-	0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x54, 0x45, 0x43,
-	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x2b, 0x0a, 0x27, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
-	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44,
-	0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x52, 0x45,
-	0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x44, 0x44, 0x52,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f,
-	0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04, 0x32, 0xd4, 0x09, 0x0a, 0x0d, 0x54, 0x61,
-	0x70, 0x72, 0x6f, 0x6f, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x4c,
-	0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x74, 0x61, 0x70, 0x72,
-	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
-	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x18, 0x2e, 0x74, 0x61,
-	0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x43, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x19,
-	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x61, 0x70, 0x72,
-	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4c, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x73, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1d, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
-	0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x74,
-	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x14, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x44, 0x65, 0x62, 0x75, 0x67,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44,
-	0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x18, 0x2e, 0x74, 0x61, 0x70,
-	0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2f, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x2e, 0x74, 0x61, 0x70,
-	0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x12, 0x35, 0x0a, 0x0a, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x19,
-	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x64,
-	0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x74, 0x61, 0x70, 0x72,
-	0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x49, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x72, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x12, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66,
-	0x46, 0x69, 0x6c, 0x65, 0x1a, 0x1b, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x46, 0x0a, 0x0b, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66,
-	0x12, 0x1a, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74,
-	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x45, 0x78, 0x70,
-	0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1a, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
-	0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65,
-	0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
-	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74,
-	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x1c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x4e, 0x74, 0x66, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x74, 0x66, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x0e,
-	0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x1d,
-	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
-	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
-	0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
-	0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x70,
-	0x72, 0x6f, 0x6f, 0x74, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72,
-	0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *ListKeyDerivationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var (
-	file_taprootassets_proto_rawDescOnce sync.Once
-	file_taprootassets_proto_rawDescData = file_taprootassets_proto_rawDesc
-)
+func (*ListKeyDerivationsRequest) ProtoMessage() {}
 
-func file_taprootassets_proto_rawDescGZIP() []byte {
-	file_taprootassets_proto_rawDescOnce.Do(func() {
-		file_taprootassets_proto_rawDescData = protoimpl.X.CompressGZIP(file_taprootassets_proto_rawDescData)
-	})
-	return file_taprootassets_proto_rawDescData
+func (x *ListKeyDerivationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_taprootassets_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_taprootassets_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
-var file_taprootassets_proto_goTypes = []interface{}{
-	(AssetType)(0),                              // 0: taprpc.AssetType
-	(AssetMetaType)(0),                          // 1: taprpc.AssetMetaType
-	(OutputType)(0),                             // 2: taprpc.OutputType
-	(AddrEventStatus)(0),                        // 3: taprpc.AddrEventStatus
-	(*AssetMeta)(nil),                           // 4: taprpc.AssetMeta
-	(*ListAssetRequest)(nil),                    // 5: taprpc.ListAssetRequest
-	(*AnchorInfo)(nil),                          // 6: taprpc.AnchorInfo
-	(*GenesisInfo)(nil),                         // 7: taprpc.GenesisInfo
-	(*AssetGroup)(nil),                          // 8: taprpc.AssetGroup
-	(*Asset)(nil),                               // 9: taprpc.Asset
-	(*PrevWitness)(nil),                         // 10: taprpc.PrevWitness
-	(*SplitCommitment)(nil),                     // 11: taprpc.SplitCommitment
-	(*ListAssetResponse)(nil),                   // 12: taprpc.ListAssetResponse
-	(*ListUtxosRequest)(nil),                    // 13: taprpc.ListUtxosRequest
-	(*ManagedUtxo)(nil),                         // 14: taprpc.ManagedUtxo
-	(*ListUtxosResponse)(nil),                   // 15: taprpc.ListUtxosResponse
-	(*ListGroupsRequest)(nil),                   // 16: taprpc.ListGroupsRequest
-	(*AssetHumanReadable)(nil),                  // 17: taprpc.AssetHumanReadable
-	(*GroupedAssets)(nil),                       // 18: taprpc.GroupedAssets
-	(*ListGroupsResponse)(nil),                  // 19: taprpc.ListGroupsResponse
-	(*ListBalancesRequest)(nil),                 // 20: taprpc.ListBalancesRequest
-	(*AssetBalance)(nil),                        // 21: taprpc.AssetBalance
-	(*AssetGroupBalance)(nil),                   // 22: taprpc.AssetGroupBalance
-	(*ListBalancesResponse)(nil),                // 23: taprpc.ListBalancesResponse
-	(*ListTransfersRequest)(nil),                // 24: taprpc.ListTransfersRequest
-	(*ListTransfersResponse)(nil),               // 25: taprpc.ListTransfersResponse
-	(*AssetTransfer)(nil),                       // 26: taprpc.AssetTransfer
-	(*TransferInput)(nil),                       // 27: taprpc.TransferInput
-	(*TransferOutputAnchor)(nil),                // 28: taprpc.TransferOutputAnchor
-	(*TransferOutput)(nil),                      // 29: taprpc.TransferOutput
-	(*StopRequest)(nil),                         // 30: taprpc.StopRequest
-	(*StopResponse)(nil),                        // 31: taprpc.StopResponse
-	(*DebugLevelRequest)(nil),                   // 32: taprpc.DebugLevelRequest
-	(*DebugLevelResponse)(nil),                  // 33: taprpc.DebugLevelResponse
-	(*Addr)(nil),                                // 34: taprpc.Addr
-	(*QueryAddrRequest)(nil),                    // 35: taprpc.QueryAddrRequest
-	(*QueryAddrResponse)(nil),                   // 36: taprpc.QueryAddrResponse
-	(*NewAddrRequest)(nil),                      // 37: taprpc.NewAddrRequest
-	(*ScriptKey)(nil),                           // 38: taprpc.ScriptKey
-	(*KeyLocator)(nil),                          // 39: taprpc.KeyLocator
-	(*KeyDescriptor)(nil),                       // 40: taprpc.KeyDescriptor
-	(*DecodeAddrRequest)(nil),                   // 41: taprpc.DecodeAddrRequest
-	(*ProofFile)(nil),                           // 42: taprpc.ProofFile
-	(*DecodedProof)(nil),                        // 43: taprpc.DecodedProof
-	(*VerifyProofResponse)(nil),                 // 44: taprpc.VerifyProofResponse
-	(*DecodeProofRequest)(nil),                  // 45: taprpc.DecodeProofRequest
-	(*DecodeProofResponse)(nil),                 // 46: taprpc.DecodeProofResponse
-	(*ExportProofRequest)(nil),                  // 47: taprpc.ExportProofRequest
-	(*AddrEvent)(nil),                           // 48: taprpc.AddrEvent
-	(*AddrReceivesRequest)(nil),                 // 49: taprpc.AddrReceivesRequest
-	(*AddrReceivesResponse)(nil),                // 50: taprpc.AddrReceivesResponse
-	(*SendAssetRequest)(nil),                    // 51: taprpc.SendAssetRequest
-	(*PrevInputAsset)(nil),                      // 52: taprpc.PrevInputAsset
-	(*SendAssetResponse)(nil),                   // 53: taprpc.SendAssetResponse
-	(*GetInfoRequest)(nil),                      // 54: taprpc.GetInfoRequest
-	(*GetInfoResponse)(nil),                     // 55: taprpc.GetInfoResponse
-	(*SubscribeSendAssetEventNtfnsRequest)(nil), // 56: taprpc.SubscribeSendAssetEventNtfnsRequest
-	(*SendAssetEvent)(nil),                      // 57: taprpc.SendAssetEvent
-	(*ExecuteSendStateEvent)(nil),               // 58: taprpc.ExecuteSendStateEvent
-	(*ReceiverProofBackoffWaitEvent)(nil),       // 59: taprpc.ReceiverProofBackoffWaitEvent
-	(*FetchAssetMetaRequest)(nil),               // 60: taprpc.FetchAssetMetaRequest
-	nil,                                         // 61: taprpc.ListUtxosResponse.ManagedUtxosEntry
-	nil,                                         // 62: taprpc.ListGroupsResponse.GroupsEntry
-	nil,                                         // 63: taprpc.ListBalancesResponse.AssetBalancesEntry
-	nil,                                         // 64: taprpc.ListBalancesResponse.AssetGroupBalancesEntry
-}
-var file_taprootassets_proto_depIdxs = []int32{
-	1,  // 0: taprpc.AssetMeta.type:type_name -> taprpc.AssetMetaType
-	7,  // 1: taprpc.Asset.asset_genesis:type_name -> taprpc.GenesisInfo
-	0,  // 2: taprpc.Asset.asset_type:type_name -> taprpc.AssetType
-	8,  // 3: taprpc.Asset.asset_group:type_name -> taprpc.AssetGroup
-	6,  // 4: taprpc.Asset.chain_anchor:type_name -> taprpc.AnchorInfo
-	10, // 5: taprpc.Asset.prev_witnesses:type_name -> taprpc.PrevWitness
-	52, // 6: taprpc.PrevWitness.prev_id:type_name -> taprpc.PrevInputAsset
-	11, // 7: taprpc.PrevWitness.split_commitment:type_name -> taprpc.SplitCommitment
-	9,  // 8: taprpc.SplitCommitment.root_asset:type_name -> taprpc.Asset
-	9,  // 9: taprpc.ListAssetResponse.assets:type_name -> taprpc.Asset
-	9,  // 10: taprpc.ManagedUtxo.assets:type_name -> taprpc.Asset
-	61, // 11: taprpc.ListUtxosResponse.managed_utxos:type_name -> taprpc.ListUtxosResponse.ManagedUtxosEntry
-	0,  // 12: taprpc.AssetHumanReadable.type:type_name -> taprpc.AssetType
-	17, // 13: taprpc.GroupedAssets.assets:type_name -> taprpc.AssetHumanReadable
-	62, // 14: taprpc.ListGroupsResponse.groups:type_name -> taprpc.ListGroupsResponse.GroupsEntry
-	7,  // 15: taprpc.AssetBalance.asset_genesis:type_name -> taprpc.GenesisInfo
-	0,  // 16: taprpc.AssetBalance.asset_type:type_name -> taprpc.AssetType
-	63, // 17: taprpc.ListBalancesResponse.asset_balances:type_name -> taprpc.ListBalancesResponse.AssetBalancesEntry
-	64, // 18: taprpc.ListBalancesResponse.asset_group_balances:type_name -> taprpc.ListBalancesResponse.AssetGroupBalancesEntry
-	26, // 19: taprpc.ListTransfersResponse.transfers:type_name -> taprpc.AssetTransfer
-	27, // 20: taprpc.AssetTransfer.inputs:type_name -> taprpc.TransferInput
-	29, // 21: taprpc.AssetTransfer.outputs:type_name -> taprpc.TransferOutput
-	28, // 22: taprpc.TransferOutput.anchor:type_name -> taprpc.TransferOutputAnchor
-	2,  // 23: taprpc.TransferOutput.output_type:type_name -> taprpc.OutputType
-	0,  // 24: taprpc.Addr.asset_type:type_name -> taprpc.AssetType
-	34, // 25: taprpc.QueryAddrResponse.addrs:type_name -> taprpc.Addr
-	38, // 26: taprpc.NewAddrRequest.script_key:type_name -> taprpc.ScriptKey
-	40, // 27: taprpc.NewAddrRequest.internal_key:type_name -> taprpc.KeyDescriptor
-	40, // 28: taprpc.ScriptKey.key_desc:type_name -> taprpc.KeyDescriptor
-	39, // 29: taprpc.KeyDescriptor.key_loc:type_name -> taprpc.KeyLocator
-	9,  // 30: taprpc.DecodedProof.asset:type_name -> taprpc.Asset
-	4,  // 31: taprpc.DecodedProof.meta_reveal:type_name -> taprpc.AssetMeta
-	43, // 32: taprpc.VerifyProofResponse.decoded_proof:type_name -> taprpc.DecodedProof
-	43, // 33: taprpc.DecodeProofResponse.decoded_proof:type_name -> taprpc.DecodedProof
-	34, // 34: taprpc.AddrEvent.addr:type_name -> taprpc.Addr
-	3,  // 35: taprpc.AddrEvent.status:type_name -> taprpc.AddrEventStatus
-	3,  // 36: taprpc.AddrReceivesRequest.filter_status:type_name -> taprpc.AddrEventStatus
-	48, // 37: taprpc.AddrReceivesResponse.events:type_name -> taprpc.AddrEvent
-	26, // 38: taprpc.SendAssetResponse.transfer:type_name -> taprpc.AssetTransfer
-	58, // 39: taprpc.SendAssetEvent.execute_send_state_event:type_name -> taprpc.ExecuteSendStateEvent
-	59, // 40: taprpc.SendAssetEvent.receiver_proof_backoff_wait_event:type_name -> taprpc.ReceiverProofBackoffWaitEvent
-	14, // 41: taprpc.ListUtxosResponse.ManagedUtxosEntry.value:type_name -> taprpc.ManagedUtxo
-	18, // 42: taprpc.ListGroupsResponse.GroupsEntry.value:type_name -> taprpc.GroupedAssets
-	21, // 43: taprpc.ListBalancesResponse.AssetBalancesEntry.value:type_name -> taprpc.AssetBalance
-	22, // 44: taprpc.ListBalancesResponse.AssetGroupBalancesEntry.value:type_name -> taprpc.AssetGroupBalance
-	5,  // 45: taprpc.TaprootAssets.ListAssets:input_type -> taprpc.ListAssetRequest
-	13, // 46: taprpc.TaprootAssets.ListUtxos:input_type -> taprpc.ListUtxosRequest
-	16, // 47: taprpc.TaprootAssets.ListGroups:input_type -> taprpc.ListGroupsRequest
-	20, // 48: taprpc.TaprootAssets.ListBalances:input_type -> taprpc.ListBalancesRequest
-	24, // 49: taprpc.TaprootAssets.ListTransfers:input_type -> taprpc.ListTransfersRequest
-	30, // 50: taprpc.TaprootAssets.StopDaemon:input_type -> taprpc.StopRequest
-	32, // 51: taprpc.TaprootAssets.DebugLevel:input_type -> taprpc.DebugLevelRequest
-	35, // 52: taprpc.TaprootAssets.QueryAddrs:input_type -> taprpc.QueryAddrRequest
-	37, // 53: taprpc.TaprootAssets.NewAddr:input_type -> taprpc.NewAddrRequest
-	41, // 54: taprpc.TaprootAssets.DecodeAddr:input_type -> taprpc.DecodeAddrRequest
-	49, // 55: taprpc.TaprootAssets.AddrReceives:input_type -> taprpc.AddrReceivesRequest
-	42, // 56: taprpc.TaprootAssets.VerifyProof:input_type -> taprpc.ProofFile
-	45, // 57: taprpc.TaprootAssets.DecodeProof:input_type -> taprpc.DecodeProofRequest
-	47, // 58: taprpc.TaprootAssets.ExportProof:input_type -> taprpc.ExportProofRequest
-	51, // 59: taprpc.TaprootAssets.SendAsset:input_type -> taprpc.SendAssetRequest
-	54, // 60: taprpc.TaprootAssets.GetInfo:input_type -> taprpc.GetInfoRequest
-	56, // 61: taprpc.TaprootAssets.SubscribeSendAssetEventNtfns:input_type -> taprpc.SubscribeSendAssetEventNtfnsRequest
-	60, // 62: taprpc.TaprootAssets.FetchAssetMeta:input_type -> taprpc.FetchAssetMetaRequest
-	12, // 63: taprpc.TaprootAssets.ListAssets:output_type -> taprpc.ListAssetResponse
-	15, // 64: taprpc.TaprootAssets.ListUtxos:output_type -> taprpc.ListUtxosResponse
-	19, // 65: taprpc.TaprootAssets.ListGroups:output_type -> taprpc.ListGroupsResponse
-	23, // 66: taprpc.TaprootAssets.ListBalances:output_type -> taprpc.ListBalancesResponse
-	25, // 67: taprpc.TaprootAssets.ListTransfers:output_type -> taprpc.ListTransfersResponse
-	31, // 68: taprpc.TaprootAssets.StopDaemon:output_type -> taprpc.StopResponse
-	33, // 69: taprpc.TaprootAssets.DebugLevel:output_type -> taprpc.DebugLevelResponse
-	36, // 70: taprpc.TaprootAssets.QueryAddrs:output_type -> taprpc.QueryAddrResponse
-	34, // 71: taprpc.TaprootAssets.NewAddr:output_type -> taprpc.Addr
-	34, // 72: taprpc.TaprootAssets.DecodeAddr:output_type -> taprpc.Addr
-	50, // 73: taprpc.TaprootAssets.AddrReceives:output_type -> taprpc.AddrReceivesResponse
-	44, // 74: taprpc.TaprootAssets.VerifyProof:output_type -> taprpc.VerifyProofResponse
-	46, // 75: taprpc.TaprootAssets.DecodeProof:output_type -> taprpc.DecodeProofResponse
-	42, // 76: taprpc.TaprootAssets.ExportProof:output_type -> taprpc.ProofFile
-	53, // 77: taprpc.TaprootAssets.SendAsset:output_type -> taprpc.SendAssetResponse
-	55, // 78: taprpc.TaprootAssets.GetInfo:output_type -> taprpc.GetInfoResponse
-	57, // 79: taprpc.TaprootAssets.SubscribeSendAssetEventNtfns:output_type -> taprpc.SendAssetEvent
-	4,  // 80: taprpc.TaprootAssets.FetchAssetMeta:output_type -> taprpc.AssetMeta
-	63, // [63:81] is the sub-list for method output_type
-	45, // [45:63] is the sub-list for method input_type
-	45, // [45:45] is the sub-list for extension type_name
-	45, // [45:45] is the sub-list for extension extendee
-	0,  // [0:45] is the sub-list for field type_name
+// Deprecated: Use ListKeyDerivationsRequest.ProtoReflect.Descriptor instead.
+func (*ListKeyDerivationsRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{64}
 }
 
-func init() { file_taprootassets_proto_init() }
-func file_taprootassets_proto_init() {
-	if File_taprootassets_proto != nil {
-		return
+func (x *ListKeyDerivationsRequest) GetFilterPurpose() KeyPurpose {
+	if x != nil {
+		return x.FilterPurpose
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_taprootassets_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetMeta); i {
+	return KeyPurpose_KEY_PURPOSE_UNKNOWN
+}
+
+func (x *ListKeyDerivationsRequest) GetFilterRawKey() []byte {
+	if x != nil {
+		return x.FilterRawKey
+	}
+	return nil
+}
+
+type ListKeyDerivationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The derivations that match the filter, in the order they were derived
+	// in.
+	Derivations []*KeyDerivation `protobuf:"bytes,1,rep,name=derivations,proto3" json:"derivations,omitempty"`
+}
+
+func (x *ListKeyDerivationsResponse) Reset() {
+	*x = ListKeyDerivationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListKeyDerivationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKeyDerivationsResponse) ProtoMessage() {}
+
+func (x *ListKeyDerivationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKeyDerivationsResponse.ProtoReflect.Descriptor instead.
+func (*ListKeyDerivationsResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListKeyDerivationsResponse) GetDerivations() []*KeyDerivation {
+	if x != nil {
+		return x.Derivations
+	}
+	return nil
+}
+
+type ReusedAnchorKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The internal key that was used for more than one anchor output.
+	InternalKey *KeyDescriptor `protobuf:"bytes,1,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+	// Whether the key belongs to the wallet of this node. Keys that don't belong
+	// to the wallet were chosen by the receivers of outbound transfers.
+	Local bool `protobuf:"varint,2,opt,name=local,proto3" json:"local,omitempty"`
+	// The anchor outputs created with the key, in the form of txid:index.
+	AnchorOutpoints []string `protobuf:"bytes,3,rep,name=anchor_outpoints,json=anchorOutpoints,proto3" json:"anchor_outpoints,omitempty"`
+}
+
+func (x *ReusedAnchorKey) Reset() {
+	*x = ReusedAnchorKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReusedAnchorKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReusedAnchorKey) ProtoMessage() {}
+
+func (x *ReusedAnchorKey) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReusedAnchorKey.ProtoReflect.Descriptor instead.
+func (*ReusedAnchorKey) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ReusedAnchorKey) GetInternalKey() *KeyDescriptor {
+	if x != nil {
+		return x.InternalKey
+	}
+	return nil
+}
+
+func (x *ReusedAnchorKey) GetLocal() bool {
+	if x != nil {
+		return x.Local
+	}
+	return false
+}
+
+func (x *ReusedAnchorKey) GetAnchorOutpoints() []string {
+	if x != nil {
+		return x.AnchorOutpoints
+	}
+	return nil
+}
+
+type ListReusedAnchorKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListReusedAnchorKeysRequest) Reset() {
+	*x = ListReusedAnchorKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReusedAnchorKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReusedAnchorKeysRequest) ProtoMessage() {}
+
+func (x *ListReusedAnchorKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReusedAnchorKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListReusedAnchorKeysRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{67}
+}
+
+type ListReusedAnchorKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The internal keys that were used for more than one anchor output.
+	ReusedKeys []*ReusedAnchorKey `protobuf:"bytes,1,rep,name=reused_keys,json=reusedKeys,proto3" json:"reused_keys,omitempty"`
+}
+
+func (x *ListReusedAnchorKeysResponse) Reset() {
+	*x = ListReusedAnchorKeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReusedAnchorKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReusedAnchorKeysResponse) ProtoMessage() {}
+
+func (x *ListReusedAnchorKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReusedAnchorKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListReusedAnchorKeysResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListReusedAnchorKeysResponse) GetReusedKeys() []*ReusedAnchorKey {
+	if x != nil {
+		return x.ReusedKeys
+	}
+	return nil
+}
+
+type ScriptKeyReuse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The script key that was found to be used more than once.
+	ScriptKey []byte `protobuf:"bytes,1,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The kind of reuse that was found.
+	Conflict ScriptKeyConflict `protobuf:"varint,2,opt,name=conflict,proto3,enum=taprpc.ScriptKeyConflict" json:"conflict,omitempty"`
+	// The anchor outputs the script key was found in, in the form of
+	// txid:index.
+	AnchorOutpoints []string `protobuf:"bytes,3,rep,name=anchor_outpoints,json=anchorOutpoints,proto3" json:"anchor_outpoints,omitempty"`
+}
+
+func (x *ScriptKeyReuse) Reset() {
+	*x = ScriptKeyReuse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScriptKeyReuse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScriptKeyReuse) ProtoMessage() {}
+
+func (x *ScriptKeyReuse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScriptKeyReuse.ProtoReflect.Descriptor instead.
+func (*ScriptKeyReuse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ScriptKeyReuse) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *ScriptKeyReuse) GetConflict() ScriptKeyConflict {
+	if x != nil {
+		return x.Conflict
+	}
+	return ScriptKeyConflict_SCRIPT_KEY_CONFLICT_UNSPENT
+}
+
+func (x *ScriptKeyReuse) GetAnchorOutpoints() []string {
+	if x != nil {
+		return x.AnchorOutpoints
+	}
+	return nil
+}
+
+type FlaggedScriptKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The flagged script key.
+	ScriptKey []byte `protobuf:"bytes,1,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The kind of reuse the script key was flagged for.
+	Conflict ScriptKeyConflict `protobuf:"varint,2,opt,name=conflict,proto3,enum=taprpc.ScriptKeyConflict" json:"conflict,omitempty"`
+	// The number of anchor outputs the script key was found in when it was
+	// last flagged.
+	NumOccurrences uint32 `protobuf:"varint,3,opt,name=num_occurrences,json=numOccurrences,proto3" json:"num_occurrences,omitempty"`
+	// The unix timestamp in seconds when the script key was flagged.
+	FlaggedAt int64 `protobuf:"varint,4,opt,name=flagged_at,json=flaggedAt,proto3" json:"flagged_at,omitempty"`
+	// The unix timestamp in seconds when the flag was resolved, or 0 if the
+	// flag is still open.
+	ResolvedAt int64 `protobuf:"varint,5,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+}
+
+func (x *FlaggedScriptKey) Reset() {
+	*x = FlaggedScriptKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlaggedScriptKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlaggedScriptKey) ProtoMessage() {}
+
+func (x *FlaggedScriptKey) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlaggedScriptKey.ProtoReflect.Descriptor instead.
+func (*FlaggedScriptKey) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *FlaggedScriptKey) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *FlaggedScriptKey) GetConflict() ScriptKeyConflict {
+	if x != nil {
+		return x.Conflict
+	}
+	return ScriptKeyConflict_SCRIPT_KEY_CONFLICT_UNSPENT
+}
+
+func (x *FlaggedScriptKey) GetNumOccurrences() uint32 {
+	if x != nil {
+		return x.NumOccurrences
+	}
+	return 0
+}
+
+func (x *FlaggedScriptKey) GetFlaggedAt() int64 {
+	if x != nil {
+		return x.FlaggedAt
+	}
+	return 0
+}
+
+func (x *FlaggedScriptKey) GetResolvedAt() int64 {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return 0
+}
+
+type AuditScriptKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AuditScriptKeysRequest) Reset() {
+	*x = AuditScriptKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditScriptKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditScriptKeysRequest) ProtoMessage() {}
+
+func (x *AuditScriptKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditScriptKeysRequest.ProtoReflect.Descriptor instead.
+func (*AuditScriptKeysRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{71}
+}
+
+type AuditScriptKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The reused script keys that were found by the audit.
+	ReusedKeys []*ScriptKeyReuse `protobuf:"bytes,1,rep,name=reused_keys,json=reusedKeys,proto3" json:"reused_keys,omitempty"`
+	// All flags of reused script keys, including resolved ones.
+	FlaggedKeys []*FlaggedScriptKey `protobuf:"bytes,2,rep,name=flagged_keys,json=flaggedKeys,proto3" json:"flagged_keys,omitempty"`
+}
+
+func (x *AuditScriptKeysResponse) Reset() {
+	*x = AuditScriptKeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditScriptKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditScriptKeysResponse) ProtoMessage() {}
+
+func (x *AuditScriptKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditScriptKeysResponse.ProtoReflect.Descriptor instead.
+func (*AuditScriptKeysResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AuditScriptKeysResponse) GetReusedKeys() []*ScriptKeyReuse {
+	if x != nil {
+		return x.ReusedKeys
+	}
+	return nil
+}
+
+func (x *AuditScriptKeysResponse) GetFlaggedKeys() []*FlaggedScriptKey {
+	if x != nil {
+		return x.FlaggedKeys
+	}
+	return nil
+}
+
+type ResolveFlaggedScriptKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The 33-byte script key to resolve the open flags of.
+	ScriptKey []byte `protobuf:"bytes,1,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+}
+
+func (x *ResolveFlaggedScriptKeyRequest) Reset() {
+	*x = ResolveFlaggedScriptKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveFlaggedScriptKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveFlaggedScriptKeyRequest) ProtoMessage() {}
+
+func (x *ResolveFlaggedScriptKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveFlaggedScriptKeyRequest.ProtoReflect.Descriptor instead.
+func (*ResolveFlaggedScriptKeyRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ResolveFlaggedScriptKeyRequest) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+type ResolveFlaggedScriptKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResolveFlaggedScriptKeyResponse) Reset() {
+	*x = ResolveFlaggedScriptKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveFlaggedScriptKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveFlaggedScriptKeyResponse) ProtoMessage() {}
+
+func (x *ResolveFlaggedScriptKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveFlaggedScriptKeyResponse.ProtoReflect.Descriptor instead.
+func (*ResolveFlaggedScriptKeyResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{74}
+}
+
+type ScriptKeyDisclosure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the asset held in the output.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The amount of the asset held in the output.
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The outpoint of the BTC output the asset is anchored in, in the form
+	// of txid:index.
+	AnchorOutpoint string `protobuf:"bytes,3,opt,name=anchor_outpoint,json=anchorOutpoint,proto3" json:"anchor_outpoint,omitempty"`
+	// The script key of the output, including the internal key and the tweak
+	// needed to re-derive it.
+	ScriptKey *ScriptKey `protobuf:"bytes,4,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+}
+
+func (x *ScriptKeyDisclosure) Reset() {
+	*x = ScriptKeyDisclosure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScriptKeyDisclosure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScriptKeyDisclosure) ProtoMessage() {}
+
+func (x *ScriptKeyDisclosure) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScriptKeyDisclosure.ProtoReflect.Descriptor instead.
+func (*ScriptKeyDisclosure) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ScriptKeyDisclosure) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *ScriptKeyDisclosure) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ScriptKeyDisclosure) GetAnchorOutpoint() string {
+	if x != nil {
+		return x.AnchorOutpoint
+	}
+	return ""
+}
+
+func (x *ScriptKeyDisclosure) GetScriptKey() *ScriptKey {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+type ExportScriptKeyDisclosuresRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, only the disclosures of outputs holding the asset with the given
+	// 32-byte ID are exported.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// If set, the disclosures are encrypted to the given 33-byte public key of
+	// the auditor and returned in encrypted_disclosures only.
+	AuditorKey []byte `protobuf:"bytes,2,opt,name=auditor_key,json=auditorKey,proto3" json:"auditor_key,omitempty"`
+}
+
+func (x *ExportScriptKeyDisclosuresRequest) Reset() {
+	*x = ExportScriptKeyDisclosuresRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportScriptKeyDisclosuresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportScriptKeyDisclosuresRequest) ProtoMessage() {}
+
+func (x *ExportScriptKeyDisclosuresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportScriptKeyDisclosuresRequest.ProtoReflect.Descriptor instead.
+func (*ExportScriptKeyDisclosuresRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ExportScriptKeyDisclosuresRequest) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *ExportScriptKeyDisclosuresRequest) GetAuditorKey() []byte {
+	if x != nil {
+		return x.AuditorKey
+	}
+	return nil
+}
+
+type ExportScriptKeyDisclosuresResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The disclosures of all owned asset outputs, if no auditor key was
+	// given.
+	Disclosures []*ScriptKeyDisclosure `protobuf:"bytes,1,rep,name=disclosures,proto3" json:"disclosures,omitempty"`
+	// The serialized ExportScriptKeyDisclosuresResponse that carries the
+	// disclosures, encrypted to the auditor key, if one was given.
+	EncryptedDisclosures []byte `protobuf:"bytes,2,opt,name=encrypted_disclosures,json=encryptedDisclosures,proto3" json:"encrypted_disclosures,omitempty"`
+}
+
+func (x *ExportScriptKeyDisclosuresResponse) Reset() {
+	*x = ExportScriptKeyDisclosuresResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportScriptKeyDisclosuresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportScriptKeyDisclosuresResponse) ProtoMessage() {}
+
+func (x *ExportScriptKeyDisclosuresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportScriptKeyDisclosuresResponse.ProtoReflect.Descriptor instead.
+func (*ExportScriptKeyDisclosuresResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ExportScriptKeyDisclosuresResponse) GetDisclosures() []*ScriptKeyDisclosure {
+	if x != nil {
+		return x.Disclosures
+	}
+	return nil
+}
+
+func (x *ExportScriptKeyDisclosuresResponse) GetEncryptedDisclosures() []byte {
+	if x != nil {
+		return x.EncryptedDisclosures
+	}
+	return nil
+}
+
+type DescriptorKeyRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The key family (account in BIP-0043) of the keys.
+	KeyFamily uint32 `protobuf:"varint,1,opt,name=key_family,json=keyFamily,proto3" json:"key_family,omitempty"`
+	// The highest index of a key of the family that is known to be in use.
+	MaxIndex uint32 `protobuf:"varint,2,opt,name=max_index,json=maxIndex,proto3" json:"max_index,omitempty"`
+}
+
+func (x *DescriptorKeyRange) Reset() {
+	*x = DescriptorKeyRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescriptorKeyRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescriptorKeyRange) ProtoMessage() {}
+
+func (x *DescriptorKeyRange) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescriptorKeyRange.ProtoReflect.Descriptor instead.
+func (*DescriptorKeyRange) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DescriptorKeyRange) GetKeyFamily() uint32 {
+	if x != nil {
+		return x.KeyFamily
+	}
+	return 0
+}
+
+func (x *DescriptorKeyRange) GetMaxIndex() uint32 {
+	if x != nil {
+		return x.MaxIndex
+	}
+	return 0
+}
+
+type DescriptorGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The tweaked group key of the asset group.
+	TweakedGroupKey []byte `protobuf:"bytes,1,opt,name=tweaked_group_key,json=tweakedGroupKey,proto3" json:"tweaked_group_key,omitempty"`
+	// The raw group key and the locator needed to re-derive it.
+	RawGroupKey *KeyDescriptor `protobuf:"bytes,2,opt,name=raw_group_key,json=rawGroupKey,proto3" json:"raw_group_key,omitempty"`
+}
+
+func (x *DescriptorGroup) Reset() {
+	*x = DescriptorGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescriptorGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescriptorGroup) ProtoMessage() {}
+
+func (x *DescriptorGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescriptorGroup.ProtoReflect.Descriptor instead.
+func (*DescriptorGroup) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DescriptorGroup) GetTweakedGroupKey() []byte {
+	if x != nil {
+		return x.TweakedGroupKey
+	}
+	return nil
+}
+
+func (x *DescriptorGroup) GetRawGroupKey() *KeyDescriptor {
+	if x != nil {
+		return x.RawGroupKey
+	}
+	return nil
+}
+
+type DescriptorAsset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The genesis information of the asset.
+	AssetGenesis *GenesisInfo `protobuf:"bytes,1,opt,name=asset_genesis,json=assetGenesis,proto3" json:"asset_genesis,omitempty"`
+	// The type of the asset.
+	AssetType AssetType `protobuf:"varint,2,opt,name=asset_type,json=assetType,proto3,enum=taprpc.AssetType" json:"asset_type,omitempty"`
+	// The tweaked group key of the asset, if it has one.
+	GroupKey []byte `protobuf:"bytes,3,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+}
+
+func (x *DescriptorAsset) Reset() {
+	*x = DescriptorAsset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescriptorAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescriptorAsset) ProtoMessage() {}
+
+func (x *DescriptorAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescriptorAsset.ProtoReflect.Descriptor instead.
+func (*DescriptorAsset) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DescriptorAsset) GetAssetGenesis() *GenesisInfo {
+	if x != nil {
+		return x.AssetGenesis
+	}
+	return nil
+}
+
+func (x *DescriptorAsset) GetAssetType() AssetType {
+	if x != nil {
+		return x.AssetType
+	}
+	return AssetType_NORMAL
+}
+
+func (x *DescriptorAsset) GetGroupKey() []byte {
+	if x != nil {
+		return x.GroupKey
+	}
+	return nil
+}
+
+type AssetDescriptor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version of the descriptor encoding.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The hash of the genesis block of the network the wallet operates on.
+	ChainHash []byte `protobuf:"bytes,2,opt,name=chain_hash,json=chainHash,proto3" json:"chain_hash,omitempty"`
+	// The BIP-0043 purpose of the derivation path of all keys.
+	Purpose uint32 `protobuf:"varint,3,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	// The BIP-0044 coin type of the derivation path of all keys.
+	CoinType uint32 `protobuf:"varint,4,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	// The ranges of keys that were derived, one per key family.
+	KeyRanges []*DescriptorKeyRange `protobuf:"bytes,5,rep,name=key_ranges,json=keyRanges,proto3" json:"key_ranges,omitempty"`
+	// The asset groups the wallet can issue into.
+	Groups []*DescriptorGroup `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty"`
+	// The assets the wallet holds or has held.
+	Assets []*DescriptorAsset `protobuf:"bytes,7,rep,name=assets,proto3" json:"assets,omitempty"`
+	// The hosts of the universe servers to fetch the proofs of the assets
+	// from.
+	UniverseServers []string `protobuf:"bytes,8,rep,name=universe_servers,json=universeServers,proto3" json:"universe_servers,omitempty"`
+}
+
+func (x *AssetDescriptor) Reset() {
+	*x = AssetDescriptor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetDescriptor) ProtoMessage() {}
+
+func (x *AssetDescriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetDescriptor.ProtoReflect.Descriptor instead.
+func (*AssetDescriptor) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *AssetDescriptor) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *AssetDescriptor) GetChainHash() []byte {
+	if x != nil {
+		return x.ChainHash
+	}
+	return nil
+}
+
+func (x *AssetDescriptor) GetPurpose() uint32 {
+	if x != nil {
+		return x.Purpose
+	}
+	return 0
+}
+
+func (x *AssetDescriptor) GetCoinType() uint32 {
+	if x != nil {
+		return x.CoinType
+	}
+	return 0
+}
+
+func (x *AssetDescriptor) GetKeyRanges() []*DescriptorKeyRange {
+	if x != nil {
+		return x.KeyRanges
+	}
+	return nil
+}
+
+func (x *AssetDescriptor) GetGroups() []*DescriptorGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *AssetDescriptor) GetAssets() []*DescriptorAsset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *AssetDescriptor) GetUniverseServers() []string {
+	if x != nil {
+		return x.UniverseServers
+	}
+	return nil
+}
+
+type ExportAssetDescriptorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExportAssetDescriptorRequest) Reset() {
+	*x = ExportAssetDescriptorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAssetDescriptorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAssetDescriptorRequest) ProtoMessage() {}
+
+func (x *ExportAssetDescriptorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAssetDescriptorRequest.ProtoReflect.Descriptor instead.
+func (*ExportAssetDescriptorRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{82}
+}
+
+type ExportAssetDescriptorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The serialized asset descriptor.
+	AssetDescriptor []byte `protobuf:"bytes,1,opt,name=asset_descriptor,json=assetDescriptor,proto3" json:"asset_descriptor,omitempty"`
+	// The decoded asset descriptor, for inspection.
+	Decoded *AssetDescriptor `protobuf:"bytes,2,opt,name=decoded,proto3" json:"decoded,omitempty"`
+}
+
+func (x *ExportAssetDescriptorResponse) Reset() {
+	*x = ExportAssetDescriptorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAssetDescriptorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAssetDescriptorResponse) ProtoMessage() {}
+
+func (x *ExportAssetDescriptorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAssetDescriptorResponse.ProtoReflect.Descriptor instead.
+func (*ExportAssetDescriptorResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ExportAssetDescriptorResponse) GetAssetDescriptor() []byte {
+	if x != nil {
+		return x.AssetDescriptor
+	}
+	return nil
+}
+
+func (x *ExportAssetDescriptorResponse) GetDecoded() *AssetDescriptor {
+	if x != nil {
+		return x.Decoded
+	}
+	return nil
+}
+
+type ImportAssetDescriptorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The serialized asset descriptor to import.
+	AssetDescriptor []byte `protobuf:"bytes,1,opt,name=asset_descriptor,json=assetDescriptor,proto3" json:"asset_descriptor,omitempty"`
+}
+
+func (x *ImportAssetDescriptorRequest) Reset() {
+	*x = ImportAssetDescriptorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportAssetDescriptorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAssetDescriptorRequest) ProtoMessage() {}
+
+func (x *ImportAssetDescriptorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAssetDescriptorRequest.ProtoReflect.Descriptor instead.
+func (*ImportAssetDescriptorRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ImportAssetDescriptorRequest) GetAssetDescriptor() []byte {
+	if x != nil {
+		return x.AssetDescriptor
+	}
+	return nil
+}
+
+type ImportAssetDescriptorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The decoded asset descriptor that was imported.
+	Decoded *AssetDescriptor `protobuf:"bytes,1,opt,name=decoded,proto3" json:"decoded,omitempty"`
+	// The universe servers of the descriptor that weren't yet part of the
+	// federation of the local universe and were added.
+	AddedUniverseServers []string `protobuf:"bytes,2,rep,name=added_universe_servers,json=addedUniverseServers,proto3" json:"added_universe_servers,omitempty"`
+}
+
+func (x *ImportAssetDescriptorResponse) Reset() {
+	*x = ImportAssetDescriptorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportAssetDescriptorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAssetDescriptorResponse) ProtoMessage() {}
+
+func (x *ImportAssetDescriptorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAssetDescriptorResponse.ProtoReflect.Descriptor instead.
+func (*ImportAssetDescriptorResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ImportAssetDescriptorResponse) GetDecoded() *AssetDescriptor {
+	if x != nil {
+		return x.Decoded
+	}
+	return nil
+}
+
+func (x *ImportAssetDescriptorResponse) GetAddedUniverseServers() []string {
+	if x != nil {
+		return x.AddedUniverseServers
+	}
+	return nil
+}
+
+type ListProofDeliveryAttemptsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, only attempts for the transfer with the given anchor
+	// transaction hash are returned.
+	AnchorTxHash []byte `protobuf:"bytes,1,opt,name=anchor_tx_hash,json=anchorTxHash,proto3" json:"anchor_tx_hash,omitempty"`
+	// If set, only attempts for the receiver with the given 33-byte script
+	// key are returned.
+	ScriptKey []byte `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+}
+
+func (x *ListProofDeliveryAttemptsRequest) Reset() {
+	*x = ListProofDeliveryAttemptsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProofDeliveryAttemptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProofDeliveryAttemptsRequest) ProtoMessage() {}
+
+func (x *ListProofDeliveryAttemptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProofDeliveryAttemptsRequest.ProtoReflect.Descriptor instead.
+func (*ListProofDeliveryAttemptsRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListProofDeliveryAttemptsRequest) GetAnchorTxHash() []byte {
+	if x != nil {
+		return x.AnchorTxHash
+	}
+	return nil
+}
+
+func (x *ListProofDeliveryAttemptsRequest) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+type ListProofDeliveryAttemptsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The attempts that match the filter, oldest first.
+	Attempts []*ProofDeliveryAttempt `protobuf:"bytes,1,rep,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (x *ListProofDeliveryAttemptsResponse) Reset() {
+	*x = ListProofDeliveryAttemptsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProofDeliveryAttemptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProofDeliveryAttemptsResponse) ProtoMessage() {}
+
+func (x *ListProofDeliveryAttemptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProofDeliveryAttemptsResponse.ProtoReflect.Descriptor instead.
+func (*ListProofDeliveryAttemptsResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListProofDeliveryAttemptsResponse) GetAttempts() []*ProofDeliveryAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+type ProofDeliveryAttempt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The anchor outpoint of the transfer output the proof was delivered for.
+	AnchorPoint string `protobuf:"bytes,1,opt,name=anchor_point,json=anchorPoint,proto3" json:"anchor_point,omitempty"`
+	// The script key of the receiver.
+	ScriptKey []byte `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The ID of the transferred asset.
+	AssetId []byte `protobuf:"bytes,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The address of the proof courier service the proof was delivered
+	// through.
+	CourierAddr string `protobuf:"bytes,4,opt,name=courier_addr,json=courierAddr,proto3" json:"courier_addr,omitempty"`
+	// The time the attempt finished (unix timestamp in seconds).
+	Timestamp int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Whether the proof was delivered by the attempt.
+	Delivered bool `protobuf:"varint,6,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	// The error the attempt failed with, empty if the proof was delivered.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// The time the next attempt is scheduled for (unix timestamp in seconds),
+	// zero if no further attempt is scheduled.
+	NextAttemptTimestamp int64 `protobuf:"varint,8,opt,name=next_attempt_timestamp,json=nextAttemptTimestamp,proto3" json:"next_attempt_timestamp,omitempty"`
+}
+
+func (x *ProofDeliveryAttempt) Reset() {
+	*x = ProofDeliveryAttempt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofDeliveryAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofDeliveryAttempt) ProtoMessage() {}
+
+func (x *ProofDeliveryAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofDeliveryAttempt.ProtoReflect.Descriptor instead.
+func (*ProofDeliveryAttempt) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ProofDeliveryAttempt) GetAnchorPoint() string {
+	if x != nil {
+		return x.AnchorPoint
+	}
+	return ""
+}
+
+func (x *ProofDeliveryAttempt) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *ProofDeliveryAttempt) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *ProofDeliveryAttempt) GetCourierAddr() string {
+	if x != nil {
+		return x.CourierAddr
+	}
+	return ""
+}
+
+func (x *ProofDeliveryAttempt) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ProofDeliveryAttempt) GetDelivered() bool {
+	if x != nil {
+		return x.Delivered
+	}
+	return false
+}
+
+func (x *ProofDeliveryAttempt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ProofDeliveryAttempt) GetNextAttemptTimestamp() int64 {
+	if x != nil {
+		return x.NextAttemptTimestamp
+	}
+	return 0
+}
+
+type AssetTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransferTimestamp int64 `protobuf:"varint,1,opt,name=transfer_timestamp,json=transferTimestamp,proto3" json:"transfer_timestamp,omitempty"`
+	// The new transaction that commits to the set of Taproot Assets found
+	// at the above new anchor point.
+	AnchorTxHash       []byte `protobuf:"bytes,2,opt,name=anchor_tx_hash,json=anchorTxHash,proto3" json:"anchor_tx_hash,omitempty"`
+	AnchorTxHeightHint uint32 `protobuf:"varint,3,opt,name=anchor_tx_height_hint,json=anchorTxHeightHint,proto3" json:"anchor_tx_height_hint,omitempty"`
+	AnchorTxChainFees  int64  `protobuf:"varint,4,opt,name=anchor_tx_chain_fees,json=anchorTxChainFees,proto3" json:"anchor_tx_chain_fees,omitempty"`
+	// Describes the set of spent assets.
+	Inputs []*TransferInput `protobuf:"bytes,5,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// Describes the set of newly created asset outputs.
+	Outputs []*TransferOutput `protobuf:"bytes,6,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// Whether all receivers of outputs not belonging to our node notified us
+	// that they verified and accepted their proofs. This is only ever true if
+	// the proof courier supports such a return channel.
+	SettledByReceiver bool `protobuf:"varint,7,opt,name=settled_by_receiver,json=settledByReceiver,proto3" json:"settled_by_receiver,omitempty"`
+	// The earliest block height at which the anchor transaction is broadcast,
+	// zero if the transfer wasn't scheduled with a height constraint.
+	BroadcastAfterHeight uint32 `protobuf:"varint,8,opt,name=broadcast_after_height,json=broadcastAfterHeight,proto3" json:"broadcast_after_height,omitempty"`
+	// The earliest time (unix timestamp in seconds) at which the anchor
+	// transaction is broadcast, zero if the transfer wasn't scheduled with a
+	// time constraint.
+	BroadcastAfterUnixSeconds int64 `protobuf:"varint,9,opt,name=broadcast_after_unix_seconds,json=broadcastAfterUnixSeconds,proto3" json:"broadcast_after_unix_seconds,omitempty"`
+	// The policy the script key of the change output of the transfer was
+	// derived with.
+	ChangeKeyPolicy ChangeKeyPolicy `protobuf:"varint,10,opt,name=change_key_policy,json=changeKeyPolicy,proto3,enum=taprpc.ChangeKeyPolicy" json:"change_key_policy,omitempty"`
+}
+
+func (x *AssetTransfer) Reset() {
+	*x = AssetTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetTransfer) ProtoMessage() {}
+
+func (x *AssetTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetTransfer.ProtoReflect.Descriptor instead.
+func (*AssetTransfer) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *AssetTransfer) GetTransferTimestamp() int64 {
+	if x != nil {
+		return x.TransferTimestamp
+	}
+	return 0
+}
+
+func (x *AssetTransfer) GetAnchorTxHash() []byte {
+	if x != nil {
+		return x.AnchorTxHash
+	}
+	return nil
+}
+
+func (x *AssetTransfer) GetAnchorTxHeightHint() uint32 {
+	if x != nil {
+		return x.AnchorTxHeightHint
+	}
+	return 0
+}
+
+func (x *AssetTransfer) GetAnchorTxChainFees() int64 {
+	if x != nil {
+		return x.AnchorTxChainFees
+	}
+	return 0
+}
+
+func (x *AssetTransfer) GetInputs() []*TransferInput {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *AssetTransfer) GetOutputs() []*TransferOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *AssetTransfer) GetSettledByReceiver() bool {
+	if x != nil {
+		return x.SettledByReceiver
+	}
+	return false
+}
+
+func (x *AssetTransfer) GetBroadcastAfterHeight() uint32 {
+	if x != nil {
+		return x.BroadcastAfterHeight
+	}
+	return 0
+}
+
+func (x *AssetTransfer) GetBroadcastAfterUnixSeconds() int64 {
+	if x != nil {
+		return x.BroadcastAfterUnixSeconds
+	}
+	return 0
+}
+
+func (x *AssetTransfer) GetChangeKeyPolicy() ChangeKeyPolicy {
+	if x != nil {
+		return x.ChangeKeyPolicy
+	}
+	return ChangeKeyPolicy_CHANGE_KEY_POLICY_ROTATE
+}
+
+type TransferInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The old/current location of the Taproot Asset commitment that was spent
+	// as an input.
+	AnchorPoint string `protobuf:"bytes,1,opt,name=anchor_point,json=anchorPoint,proto3" json:"anchor_point,omitempty"`
+	// The ID of the asset that was spent.
+	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The script key of the asset that was spent.
+	ScriptKey []byte `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The amount of the asset that was spent.
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The lot of the asset that was spent, if it was tracked.
+	Lot *AssetLot `protobuf:"bytes,5,opt,name=lot,proto3" json:"lot,omitempty"`
+	// The alias of the asset that was spent, if one is known.
+	Alias *AssetAlias `protobuf:"bytes,6,opt,name=alias,proto3" json:"alias,omitempty"`
+}
+
+func (x *TransferInput) Reset() {
+	*x = TransferInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferInput) ProtoMessage() {}
+
+func (x *TransferInput) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferInput.ProtoReflect.Descriptor instead.
+func (*TransferInput) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *TransferInput) GetAnchorPoint() string {
+	if x != nil {
+		return x.AnchorPoint
+	}
+	return ""
+}
+
+func (x *TransferInput) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *TransferInput) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *TransferInput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransferInput) GetLot() *AssetLot {
+	if x != nil {
+		return x.Lot
+	}
+	return nil
+}
+
+func (x *TransferInput) GetAlias() *AssetAlias {
+	if x != nil {
+		return x.Alias
+	}
+	return nil
+}
+
+type AssetLot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The time the lot was acquired, as a Unix timestamp in seconds. Change lots
+	// inherit the acquisition time of the oldest lot spent by the transfer that
+	// created them. This is zero if the acquisition time is unknown.
+	AcquiredAt int64 `protobuf:"varint,1,opt,name=acquired_at,json=acquiredAt,proto3" json:"acquired_at,omitempty"`
+	// The anchor transaction ID of the transfer that created the lot as change.
+	// This is empty for minted or received assets.
+	SourceTransferTxid string `protobuf:"bytes,2,opt,name=source_transfer_txid,json=sourceTransferTxid,proto3" json:"source_transfer_txid,omitempty"`
+	// The user provided annotation of the acquisition price of the lot.
+	PriceAnnotation string `protobuf:"bytes,3,opt,name=price_annotation,json=priceAnnotation,proto3" json:"price_annotation,omitempty"`
+}
+
+func (x *AssetLot) Reset() {
+	*x = AssetLot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetLot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetLot) ProtoMessage() {}
+
+func (x *AssetLot) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetLot.ProtoReflect.Descriptor instead.
+func (*AssetLot) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *AssetLot) GetAcquiredAt() int64 {
+	if x != nil {
+		return x.AcquiredAt
+	}
+	return 0
+}
+
+func (x *AssetLot) GetSourceTransferTxid() string {
+	if x != nil {
+		return x.SourceTransferTxid
+	}
+	return ""
+}
+
+func (x *AssetLot) GetPriceAnnotation() string {
+	if x != nil {
+		return x.PriceAnnotation
+	}
+	return ""
+}
+
+type AssetLotID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The anchor outpoint of the asset in the form txid:vout.
+	AnchorOutpoint string `protobuf:"bytes,1,opt,name=anchor_outpoint,json=anchorOutpoint,proto3" json:"anchor_outpoint,omitempty"`
+	// The ID of the asset.
+	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The script key of the asset.
+	ScriptKey []byte `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+}
+
+func (x *AssetLotID) Reset() {
+	*x = AssetLotID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetLotID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetLotID) ProtoMessage() {}
+
+func (x *AssetLotID) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetLotID.ProtoReflect.Descriptor instead.
+func (*AssetLotID) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *AssetLotID) GetAnchorOutpoint() string {
+	if x != nil {
+		return x.AnchorOutpoint
+	}
+	return ""
+}
+
+func (x *AssetLotID) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *AssetLotID) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+type TransferOutputAnchor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The new location of the Taproot Asset commitment that was created on
+	// chain.
+	Outpoint         string `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	Value            int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	InternalKey      []byte `protobuf:"bytes,3,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+	TaprootAssetRoot []byte `protobuf:"bytes,4,opt,name=taproot_asset_root,json=taprootAssetRoot,proto3" json:"taproot_asset_root,omitempty"`
+	MerkleRoot       []byte `protobuf:"bytes,5,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	TapscriptSibling []byte `protobuf:"bytes,6,opt,name=tapscript_sibling,json=tapscriptSibling,proto3" json:"tapscript_sibling,omitempty"`
+	NumPassiveAssets uint32 `protobuf:"varint,7,opt,name=num_passive_assets,json=numPassiveAssets,proto3" json:"num_passive_assets,omitempty"`
+}
+
+func (x *TransferOutputAnchor) Reset() {
+	*x = TransferOutputAnchor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferOutputAnchor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOutputAnchor) ProtoMessage() {}
+
+func (x *TransferOutputAnchor) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOutputAnchor.ProtoReflect.Descriptor instead.
+func (*TransferOutputAnchor) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *TransferOutputAnchor) GetOutpoint() string {
+	if x != nil {
+		return x.Outpoint
+	}
+	return ""
+}
+
+func (x *TransferOutputAnchor) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *TransferOutputAnchor) GetInternalKey() []byte {
+	if x != nil {
+		return x.InternalKey
+	}
+	return nil
+}
+
+func (x *TransferOutputAnchor) GetTaprootAssetRoot() []byte {
+	if x != nil {
+		return x.TaprootAssetRoot
+	}
+	return nil
+}
+
+func (x *TransferOutputAnchor) GetMerkleRoot() []byte {
+	if x != nil {
+		return x.MerkleRoot
+	}
+	return nil
+}
+
+func (x *TransferOutputAnchor) GetTapscriptSibling() []byte {
+	if x != nil {
+		return x.TapscriptSibling
+	}
+	return nil
+}
+
+func (x *TransferOutputAnchor) GetNumPassiveAssets() uint32 {
+	if x != nil {
+		return x.NumPassiveAssets
+	}
+	return 0
+}
+
+type TransferOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Anchor              *TransferOutputAnchor `protobuf:"bytes,1,opt,name=anchor,proto3" json:"anchor,omitempty"`
+	ScriptKey           []byte                `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	ScriptKeyIsLocal    bool                  `protobuf:"varint,3,opt,name=script_key_is_local,json=scriptKeyIsLocal,proto3" json:"script_key_is_local,omitempty"`
+	Amount              uint64                `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	NewProofBlob        []byte                `protobuf:"bytes,5,opt,name=new_proof_blob,json=newProofBlob,proto3" json:"new_proof_blob,omitempty"`
+	SplitCommitRootHash []byte                `protobuf:"bytes,6,opt,name=split_commit_root_hash,json=splitCommitRootHash,proto3" json:"split_commit_root_hash,omitempty"`
+	OutputType          OutputType            `protobuf:"varint,7,opt,name=output_type,json=outputType,proto3,enum=taprpc.OutputType" json:"output_type,omitempty"`
+	// Whether the receiver of this output notified us that it verified and
+	// accepted the proof.
+	SettledByReceiver bool `protobuf:"varint,8,opt,name=settled_by_receiver,json=settledByReceiver,proto3" json:"settled_by_receiver,omitempty"`
+	// The unix timestamp at which the receiver settled this output, or zero
+	// if it hasn't yet.
+	ReceiverSettledTimestamp int64 `protobuf:"varint,9,opt,name=receiver_settled_timestamp,json=receiverSettledTimestamp,proto3" json:"receiver_settled_timestamp,omitempty"`
+	// The name of the counterparty the output was sent to, if its script key
+	// is in the counterparty book.
+	CounterpartyName string `protobuf:"bytes,10,opt,name=counterparty_name,json=counterpartyName,proto3" json:"counterparty_name,omitempty"`
+}
+
+func (x *TransferOutput) Reset() {
+	*x = TransferOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOutput) ProtoMessage() {}
+
+func (x *TransferOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOutput.ProtoReflect.Descriptor instead.
+func (*TransferOutput) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *TransferOutput) GetAnchor() *TransferOutputAnchor {
+	if x != nil {
+		return x.Anchor
+	}
+	return nil
+}
+
+func (x *TransferOutput) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *TransferOutput) GetScriptKeyIsLocal() bool {
+	if x != nil {
+		return x.ScriptKeyIsLocal
+	}
+	return false
+}
+
+func (x *TransferOutput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransferOutput) GetNewProofBlob() []byte {
+	if x != nil {
+		return x.NewProofBlob
+	}
+	return nil
+}
+
+func (x *TransferOutput) GetSplitCommitRootHash() []byte {
+	if x != nil {
+		return x.SplitCommitRootHash
+	}
+	return nil
+}
+
+func (x *TransferOutput) GetOutputType() OutputType {
+	if x != nil {
+		return x.OutputType
+	}
+	return OutputType_OUTPUT_TYPE_SIMPLE
+}
+
+func (x *TransferOutput) GetSettledByReceiver() bool {
+	if x != nil {
+		return x.SettledByReceiver
+	}
+	return false
+}
+
+func (x *TransferOutput) GetReceiverSettledTimestamp() int64 {
+	if x != nil {
+		return x.ReceiverSettledTimestamp
+	}
+	return 0
+}
+
+func (x *TransferOutput) GetCounterpartyName() string {
+	if x != nil {
+		return x.CounterpartyName
+	}
+	return ""
+}
+
+type StopRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StopRequest) Reset() {
+	*x = StopRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopRequest) ProtoMessage() {}
+
+func (x *StopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
+func (*StopRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{95}
+}
+
+type StopResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StopResponse) Reset() {
+	*x = StopResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopResponse) ProtoMessage() {}
+
+func (x *StopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopResponse.ProtoReflect.Descriptor instead.
+func (*StopResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{96}
+}
+
+type DebugLevelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If true, all the valid debug sub-systems will be returned.
+	Show      bool   `protobuf:"varint,1,opt,name=show,proto3" json:"show,omitempty"`
+	LevelSpec string `protobuf:"bytes,2,opt,name=level_spec,json=levelSpec,proto3" json:"level_spec,omitempty"`
+}
+
+func (x *DebugLevelRequest) Reset() {
+	*x = DebugLevelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugLevelRequest) ProtoMessage() {}
+
+func (x *DebugLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugLevelRequest.ProtoReflect.Descriptor instead.
+func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *DebugLevelRequest) GetShow() bool {
+	if x != nil {
+		return x.Show
+	}
+	return false
+}
+
+func (x *DebugLevelRequest) GetLevelSpec() string {
+	if x != nil {
+		return x.LevelSpec
+	}
+	return ""
+}
+
+type DebugLevelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubSystems string `protobuf:"bytes,1,opt,name=sub_systems,json=subSystems,proto3" json:"sub_systems,omitempty"`
+}
+
+func (x *DebugLevelResponse) Reset() {
+	*x = DebugLevelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugLevelResponse) ProtoMessage() {}
+
+func (x *DebugLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugLevelResponse.ProtoReflect.Descriptor instead.
+func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *DebugLevelResponse) GetSubSystems() string {
+	if x != nil {
+		return x.SubSystems
+	}
+	return ""
+}
+
+type Addr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The bech32 encoded Taproot Asset address.
+	Encoded string `protobuf:"bytes,1,opt,name=encoded,proto3" json:"encoded,omitempty"`
+	// The asset ID that uniquely identifies the asset.
+	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The type of the asset.
+	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=taprpc.AssetType" json:"asset_type,omitempty"`
+	// The total amount of the asset stored in this Taproot Asset UTXO.
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The group key of the asset (if it exists)
+	GroupKey []byte `protobuf:"bytes,5,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+	// The specific script key the asset must commit to in order to transfer
+	// ownership to the creator of the address.
+	ScriptKey []byte `protobuf:"bytes,6,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The internal key used for the on-chain output.
+	InternalKey []byte `protobuf:"bytes,7,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+	// The optional serialized tapscript sibling preimage to use for the receiving
+	// asset. This is usually empty as it is only needed when there should be an
+	// additional script path in the Taproot tree alongside the Taproot Asset
+	// commitment of the asset.
+	TapscriptSibling []byte `protobuf:"bytes,8,opt,name=tapscript_sibling,json=tapscriptSibling,proto3" json:"tapscript_sibling,omitempty"`
+	// The tweaked internal key that commits to the asset and represents the
+	// on-chain output key the Bitcoin transaction must send to in order to
+	// transfer assets described in this address.
+	TaprootOutputKey []byte `protobuf:"bytes,9,opt,name=taproot_output_key,json=taprootOutputKey,proto3" json:"taproot_output_key,omitempty"`
+}
+
+func (x *Addr) Reset() {
+	*x = Addr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Addr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Addr) ProtoMessage() {}
+
+func (x *Addr) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Addr.ProtoReflect.Descriptor instead.
+func (*Addr) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *Addr) GetEncoded() string {
+	if x != nil {
+		return x.Encoded
+	}
+	return ""
+}
+
+func (x *Addr) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *Addr) GetAssetType() AssetType {
+	if x != nil {
+		return x.AssetType
+	}
+	return AssetType_NORMAL
+}
+
+func (x *Addr) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Addr) GetGroupKey() []byte {
+	if x != nil {
+		return x.GroupKey
+	}
+	return nil
+}
+
+func (x *Addr) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *Addr) GetInternalKey() []byte {
+	if x != nil {
+		return x.InternalKey
+	}
+	return nil
+}
+
+func (x *Addr) GetTapscriptSibling() []byte {
+	if x != nil {
+		return x.TapscriptSibling
+	}
+	return nil
+}
+
+func (x *Addr) GetTaprootOutputKey() []byte {
+	if x != nil {
+		return x.TaprootOutputKey
+	}
+	return nil
+}
+
+type QueryAddrRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, then only addresses created after this Unix timestamp will be
+	// returned.
+	CreatedAfter int64 `protobuf:"varint,1,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// If set, then only addresses created before this Unix timestamp will be
+	// returned.
+	CreatedBefore int64 `protobuf:"varint,2,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// The max number of addresses that should be returned.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// The offset from the addresses that should be returned.
+	Offset int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *QueryAddrRequest) Reset() {
+	*x = QueryAddrRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAddrRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAddrRequest) ProtoMessage() {}
+
+func (x *QueryAddrRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAddrRequest.ProtoReflect.Descriptor instead.
+func (*QueryAddrRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *QueryAddrRequest) GetCreatedAfter() int64 {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *QueryAddrRequest) GetCreatedBefore() int64 {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *QueryAddrRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueryAddrRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type QueryAddrResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addrs []*Addr `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs,omitempty"`
+}
+
+func (x *QueryAddrResponse) Reset() {
+	*x = QueryAddrResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAddrResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAddrResponse) ProtoMessage() {}
+
+func (x *QueryAddrResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAddrResponse.ProtoReflect.Descriptor instead.
+func (*QueryAddrResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *QueryAddrResponse) GetAddrs() []*Addr {
+	if x != nil {
+		return x.Addrs
+	}
+	return nil
+}
+
+type NewAddrRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amt     uint64 `protobuf:"varint,2,opt,name=amt,proto3" json:"amt,omitempty"`
+	// The optional script key that the receiving asset should be locked to. If no
+	// script key is provided, a normal BIP-86 key will be derived from the
+	// underlying wallet.
+	//
+	// NOTE: The script_key and internal_key fields should either both be set or
+	// both be empty.
+	ScriptKey *ScriptKey `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The optional internal key of the receiving BTC level transaction output on
+	// which the receiving asset transfers will be committed to. If no internal key
+	// is provided, a key will be derived from the underlying wallet.
+	//
+	// NOTE: The script_key and internal_key fields should either both be set or
+	// both be empty.
+	InternalKey *KeyDescriptor `protobuf:"bytes,4,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+	// The optional serialized tapscript sibling preimage to use for the receiving
+	// asset. This is usually empty as it is only needed when there should be an
+	// additional script path in the Taproot tree alongside the Taproot Asset
+	// commitment of the asset.
+	TapscriptSibling []byte `protobuf:"bytes,5,opt,name=tapscript_sibling,json=tapscriptSibling,proto3" json:"tapscript_sibling,omitempty"`
+	// The optional deposit expectation the address should be annotated with. If
+	// set, inbound transfers to the address are flagged if they arrive late.
+	DepositExpectation *DepositExpectation `protobuf:"bytes,6,opt,name=deposit_expectation,json=depositExpectation,proto3" json:"deposit_expectation,omitempty"`
+}
+
+func (x *NewAddrRequest) Reset() {
+	*x = NewAddrRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewAddrRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewAddrRequest) ProtoMessage() {}
+
+func (x *NewAddrRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewAddrRequest.ProtoReflect.Descriptor instead.
+func (*NewAddrRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *NewAddrRequest) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *NewAddrRequest) GetAmt() uint64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *NewAddrRequest) GetScriptKey() *ScriptKey {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *NewAddrRequest) GetInternalKey() *KeyDescriptor {
+	if x != nil {
+		return x.InternalKey
+	}
+	return nil
+}
+
+func (x *NewAddrRequest) GetTapscriptSibling() []byte {
+	if x != nil {
+		return x.TapscriptSibling
+	}
+	return nil
+}
+
+func (x *NewAddrRequest) GetDepositExpectation() *DepositExpectation {
+	if x != nil {
+		return x.DepositExpectation
+	}
+	return nil
+}
+
+type DepositExpectation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The asset amount the address is expected to receive. This must either be
+	// zero, in which case the address amount is used, or equal to the address
+	// amount.
+	Amt uint64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	// The time in unix timestamp seconds after which a deposit is considered
+	// late. If this is zero, the expectation never expires.
+	ExpiryUnixSeconds int64 `protobuf:"varint,2,opt,name=expiry_unix_seconds,json=expiryUnixSeconds,proto3" json:"expiry_unix_seconds,omitempty"`
+}
+
+func (x *DepositExpectation) Reset() {
+	*x = DepositExpectation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositExpectation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositExpectation) ProtoMessage() {}
+
+func (x *DepositExpectation) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositExpectation.ProtoReflect.Descriptor instead.
+func (*DepositExpectation) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *DepositExpectation) GetAmt() uint64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *DepositExpectation) GetExpiryUnixSeconds() int64 {
+	if x != nil {
+		return x.ExpiryUnixSeconds
+	}
+	return 0
+}
+
+type ScriptKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The full Taproot output key the asset is locked to. This is either a BIP-86
+	// key if the tap_tweak below is empty, or a key with the tap tweak applied to
+	// it.
+	PubKey []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// The key descriptor describing the internal key of the above Taproot key.
+	KeyDesc *KeyDescriptor `protobuf:"bytes,2,opt,name=key_desc,json=keyDesc,proto3" json:"key_desc,omitempty"`
+	// The optional Taproot tweak to apply to the above internal key. If this is
+	// empty then a BIP-86 style tweak is applied to the internal key.
+	TapTweak []byte `protobuf:"bytes,3,opt,name=tap_tweak,json=tapTweak,proto3" json:"tap_tweak,omitempty"`
+}
+
+func (x *ScriptKey) Reset() {
+	*x = ScriptKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScriptKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScriptKey) ProtoMessage() {}
+
+func (x *ScriptKey) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScriptKey.ProtoReflect.Descriptor instead.
+func (*ScriptKey) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ScriptKey) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
+func (x *ScriptKey) GetKeyDesc() *KeyDescriptor {
+	if x != nil {
+		return x.KeyDesc
+	}
+	return nil
+}
+
+func (x *ScriptKey) GetTapTweak() []byte {
+	if x != nil {
+		return x.TapTweak
+	}
+	return nil
+}
+
+type KeyLocator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The family of key being identified.
+	KeyFamily int32 `protobuf:"varint,1,opt,name=key_family,json=keyFamily,proto3" json:"key_family,omitempty"`
+	// The precise index of the key being identified.
+	KeyIndex int32 `protobuf:"varint,2,opt,name=key_index,json=keyIndex,proto3" json:"key_index,omitempty"`
+}
+
+func (x *KeyLocator) Reset() {
+	*x = KeyLocator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyLocator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyLocator) ProtoMessage() {}
+
+func (x *KeyLocator) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyLocator.ProtoReflect.Descriptor instead.
+func (*KeyLocator) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *KeyLocator) GetKeyFamily() int32 {
+	if x != nil {
+		return x.KeyFamily
+	}
+	return 0
+}
+
+func (x *KeyLocator) GetKeyIndex() int32 {
+	if x != nil {
+		return x.KeyIndex
+	}
+	return 0
+}
+
+type KeyDescriptor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The raw bytes of the key being identified.
+	RawKeyBytes []byte `protobuf:"bytes,1,opt,name=raw_key_bytes,json=rawKeyBytes,proto3" json:"raw_key_bytes,omitempty"`
+	// The key locator that identifies which key to use for signing.
+	KeyLoc *KeyLocator `protobuf:"bytes,2,opt,name=key_loc,json=keyLoc,proto3" json:"key_loc,omitempty"`
+}
+
+func (x *KeyDescriptor) Reset() {
+	*x = KeyDescriptor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyDescriptor) ProtoMessage() {}
+
+func (x *KeyDescriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyDescriptor.ProtoReflect.Descriptor instead.
+func (*KeyDescriptor) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *KeyDescriptor) GetRawKeyBytes() []byte {
+	if x != nil {
+		return x.RawKeyBytes
+	}
+	return nil
+}
+
+func (x *KeyDescriptor) GetKeyLoc() *KeyLocator {
+	if x != nil {
+		return x.KeyLoc
+	}
+	return nil
+}
+
+type DecodeAddrRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+}
+
+func (x *DecodeAddrRequest) Reset() {
+	*x = DecodeAddrRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeAddrRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeAddrRequest) ProtoMessage() {}
+
+func (x *DecodeAddrRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeAddrRequest.ProtoReflect.Descriptor instead.
+func (*DecodeAddrRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *DecodeAddrRequest) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+type InspectAddrRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The Taproot Asset address to inspect.
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+}
+
+func (x *InspectAddrRequest) Reset() {
+	*x = InspectAddrRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InspectAddrRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectAddrRequest) ProtoMessage() {}
+
+func (x *InspectAddrRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectAddrRequest.ProtoReflect.Descriptor instead.
+func (*InspectAddrRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *InspectAddrRequest) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+type InspectAddrResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The decoded address.
+	Addr *Addr `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// The protocol of the proof courier that would deliver the proof to the
+	// receiver. This is empty if no proof courier is configured.
+	CourierScheme string `protobuf:"bytes,2,opt,name=courier_scheme,json=courierScheme,proto3" json:"courier_scheme,omitempty"`
+	// The endpoint of the proof courier service.
+	CourierAddr string `protobuf:"bytes,3,opt,name=courier_addr,json=courierAddr,proto3" json:"courier_addr,omitempty"`
+	// Whether this node is able to deliver proofs through the courier.
+	CourierSupported bool `protobuf:"varint,4,opt,name=courier_supported,json=courierSupported,proto3" json:"courier_supported,omitempty"`
+	// The reason the courier isn't supported, if courier_supported is false.
+	UnsupportedReason string `protobuf:"bytes,5,opt,name=unsupported_reason,json=unsupportedReason,proto3" json:"unsupported_reason,omitempty"`
+	// Whether the proof is pinned to IPFS and only its content ID is sent
+	// through the courier, which requires the receiver to have IPFS access.
+	ReceiverNeedsIpfs bool `protobuf:"varint,6,opt,name=receiver_needs_ipfs,json=receiverNeedsIpfs,proto3" json:"receiver_needs_ipfs,omitempty"`
+	// The expected size of the proof file sent to the receiver in bytes,
+	// estimated from the proofs of the asset this node owns. This is zero if
+	// the node doesn't have any proofs of the asset.
+	ExpectedProofSize uint64 `protobuf:"varint,7,opt,name=expected_proof_size,json=expectedProofSize,proto3" json:"expected_proof_size,omitempty"`
+}
+
+func (x *InspectAddrResponse) Reset() {
+	*x = InspectAddrResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InspectAddrResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectAddrResponse) ProtoMessage() {}
+
+func (x *InspectAddrResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectAddrResponse.ProtoReflect.Descriptor instead.
+func (*InspectAddrResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *InspectAddrResponse) GetAddr() *Addr {
+	if x != nil {
+		return x.Addr
+	}
+	return nil
+}
+
+func (x *InspectAddrResponse) GetCourierScheme() string {
+	if x != nil {
+		return x.CourierScheme
+	}
+	return ""
+}
+
+func (x *InspectAddrResponse) GetCourierAddr() string {
+	if x != nil {
+		return x.CourierAddr
+	}
+	return ""
+}
+
+func (x *InspectAddrResponse) GetCourierSupported() bool {
+	if x != nil {
+		return x.CourierSupported
+	}
+	return false
+}
+
+func (x *InspectAddrResponse) GetUnsupportedReason() string {
+	if x != nil {
+		return x.UnsupportedReason
+	}
+	return ""
+}
+
+func (x *InspectAddrResponse) GetReceiverNeedsIpfs() bool {
+	if x != nil {
+		return x.ReceiverNeedsIpfs
+	}
+	return false
+}
+
+func (x *InspectAddrResponse) GetExpectedProofSize() uint64 {
+	if x != nil {
+		return x.ExpectedProofSize
+	}
+	return 0
+}
+
+type ProofFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RawProof     []byte `protobuf:"bytes,1,opt,name=raw_proof,json=rawProof,proto3" json:"raw_proof,omitempty"`
+	GenesisPoint string `protobuf:"bytes,2,opt,name=genesis_point,json=genesisPoint,proto3" json:"genesis_point,omitempty"`
+}
+
+func (x *ProofFile) Reset() {
+	*x = ProofFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofFile) ProtoMessage() {}
+
+func (x *ProofFile) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofFile.ProtoReflect.Descriptor instead.
+func (*ProofFile) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ProofFile) GetRawProof() []byte {
+	if x != nil {
+		return x.RawProof
+	}
+	return nil
+}
+
+func (x *ProofFile) GetGenesisPoint() string {
+	if x != nil {
+		return x.GenesisPoint
+	}
+	return ""
+}
+
+type DecodedProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The index depth of the decoded proof, with 0 being the latest proof.
+	ProofAtDepth uint32 `protobuf:"varint,1,opt,name=proof_at_depth,json=proofAtDepth,proto3" json:"proof_at_depth,omitempty"`
+	// The total number of proofs contained in the raw proof.
+	NumberOfProofs uint32 `protobuf:"varint,2,opt,name=number_of_proofs,json=numberOfProofs,proto3" json:"number_of_proofs,omitempty"`
+	// The asset referenced in the proof.
+	Asset *Asset `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	// The reveal meta data associated with the proof, if available.
+	MetaReveal *AssetMeta `protobuf:"bytes,4,opt,name=meta_reveal,json=metaReveal,proto3" json:"meta_reveal,omitempty"`
+	// The merkle proof for AnchorTx used to prove its
+	// inclusion within BlockHeader.
+	TxMerkleProof []byte `protobuf:"bytes,5,opt,name=tx_merkle_proof,json=txMerkleProof,proto3" json:"tx_merkle_proof,omitempty"`
+	// The TaprootProof proving the new inclusion of the
+	// resulting asset within AnchorTx.
+	InclusionProof []byte `protobuf:"bytes,6,opt,name=inclusion_proof,json=inclusionProof,proto3" json:"inclusion_proof,omitempty"`
+	// The set of TaprootProofs proving the exclusion of
+	// the resulting asset from all other Taproot outputs within AnchorTx.
+	ExclusionProofs [][]byte `protobuf:"bytes,7,rep,name=exclusion_proofs,json=exclusionProofs,proto3" json:"exclusion_proofs,omitempty"`
+	// An optional TaprootProof needed if this asset is
+	// the result of a split. SplitRootProof proves inclusion of the root
+	// asset of the split.
+	SplitRootProof []byte `protobuf:"bytes,8,opt,name=split_root_proof,json=splitRootProof,proto3" json:"split_root_proof,omitempty"`
+	// The number of additional nested full proofs for any inputs found within
+	// the resulting asset.
+	NumAdditionalInputs uint32 `protobuf:"varint,9,opt,name=num_additional_inputs,json=numAdditionalInputs,proto3" json:"num_additional_inputs,omitempty"`
+	// ChallengeWitness is an optional virtual transaction witness that serves
+	// as an ownership proof for the asset. If this is non-nil, then it is a
+	// valid transfer witness for a 1-input, 1-output virtual transaction that
+	// spends the asset in this proof and sends it to the NUMS key, to prove
+	// that the creator of the proof is able to produce a valid signature to
+	// spend the asset.
+	ChallengeWitness [][]byte `protobuf:"bytes,10,rep,name=challenge_witness,json=challengeWitness,proto3" json:"challenge_witness,omitempty"`
+}
+
+func (x *DecodedProof) Reset() {
+	*x = DecodedProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodedProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodedProof) ProtoMessage() {}
+
+func (x *DecodedProof) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodedProof.ProtoReflect.Descriptor instead.
+func (*DecodedProof) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *DecodedProof) GetProofAtDepth() uint32 {
+	if x != nil {
+		return x.ProofAtDepth
+	}
+	return 0
+}
+
+func (x *DecodedProof) GetNumberOfProofs() uint32 {
+	if x != nil {
+		return x.NumberOfProofs
+	}
+	return 0
+}
+
+func (x *DecodedProof) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *DecodedProof) GetMetaReveal() *AssetMeta {
+	if x != nil {
+		return x.MetaReveal
+	}
+	return nil
+}
+
+func (x *DecodedProof) GetTxMerkleProof() []byte {
+	if x != nil {
+		return x.TxMerkleProof
+	}
+	return nil
+}
+
+func (x *DecodedProof) GetInclusionProof() []byte {
+	if x != nil {
+		return x.InclusionProof
+	}
+	return nil
+}
+
+func (x *DecodedProof) GetExclusionProofs() [][]byte {
+	if x != nil {
+		return x.ExclusionProofs
+	}
+	return nil
+}
+
+func (x *DecodedProof) GetSplitRootProof() []byte {
+	if x != nil {
+		return x.SplitRootProof
+	}
+	return nil
+}
+
+func (x *DecodedProof) GetNumAdditionalInputs() uint32 {
+	if x != nil {
+		return x.NumAdditionalInputs
+	}
+	return 0
+}
+
+func (x *DecodedProof) GetChallengeWitness() [][]byte {
+	if x != nil {
+		return x.ChallengeWitness
+	}
+	return nil
+}
+
+type VerifyProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid        bool          `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	DecodedProof *DecodedProof `protobuf:"bytes,2,opt,name=decoded_proof,json=decodedProof,proto3" json:"decoded_proof,omitempty"`
+}
+
+func (x *VerifyProofResponse) Reset() {
+	*x = VerifyProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyProofResponse) ProtoMessage() {}
+
+func (x *VerifyProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyProofResponse.ProtoReflect.Descriptor instead.
+func (*VerifyProofResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *VerifyProofResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyProofResponse) GetDecodedProof() *DecodedProof {
+	if x != nil {
+		return x.DecodedProof
+	}
+	return nil
+}
+
+type DecodeProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The raw proof in bytes to decode, which may contain multiple proofs.
+	RawProof []byte `protobuf:"bytes,1,opt,name=raw_proof,json=rawProof,proto3" json:"raw_proof,omitempty"`
+	// The index depth of the decoded proof, with 0 being the latest proof.
+	ProofAtDepth uint32 `protobuf:"varint,2,opt,name=proof_at_depth,json=proofAtDepth,proto3" json:"proof_at_depth,omitempty"`
+	// An option to include previous witnesses in decoding.
+	WithPrevWitnesses bool `protobuf:"varint,3,opt,name=with_prev_witnesses,json=withPrevWitnesses,proto3" json:"with_prev_witnesses,omitempty"`
+	// An option to attempt to retrieve the meta data associated with the proof.
+	WithMetaReveal bool `protobuf:"varint,4,opt,name=with_meta_reveal,json=withMetaReveal,proto3" json:"with_meta_reveal,omitempty"`
+}
+
+func (x *DecodeProofRequest) Reset() {
+	*x = DecodeProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeProofRequest) ProtoMessage() {}
+
+func (x *DecodeProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeProofRequest.ProtoReflect.Descriptor instead.
+func (*DecodeProofRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *DecodeProofRequest) GetRawProof() []byte {
+	if x != nil {
+		return x.RawProof
+	}
+	return nil
+}
+
+func (x *DecodeProofRequest) GetProofAtDepth() uint32 {
+	if x != nil {
+		return x.ProofAtDepth
+	}
+	return 0
+}
+
+func (x *DecodeProofRequest) GetWithPrevWitnesses() bool {
+	if x != nil {
+		return x.WithPrevWitnesses
+	}
+	return false
+}
+
+func (x *DecodeProofRequest) GetWithMetaReveal() bool {
+	if x != nil {
+		return x.WithMetaReveal
+	}
+	return false
+}
+
+type DecodeProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DecodedProof *DecodedProof `protobuf:"bytes,1,opt,name=decoded_proof,json=decodedProof,proto3" json:"decoded_proof,omitempty"`
+}
+
+func (x *DecodeProofResponse) Reset() {
+	*x = DecodeProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeProofResponse) ProtoMessage() {}
+
+func (x *DecodeProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeProofResponse.ProtoReflect.Descriptor instead.
+func (*DecodeProofResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *DecodeProofResponse) GetDecodedProof() *DecodedProof {
+	if x != nil {
+		return x.DecodedProof
+	}
+	return nil
+}
+
+type ExportProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssetId   []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	ScriptKey []byte `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+}
+
+func (x *ExportProofRequest) Reset() {
+	*x = ExportProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProofRequest) ProtoMessage() {}
+
+func (x *ExportProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProofRequest.ProtoReflect.Descriptor instead.
+func (*ExportProofRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ExportProofRequest) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *ExportProofRequest) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+type ProofArchiveStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProofArchiveStatsRequest) Reset() {
+	*x = ProofArchiveStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofArchiveStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofArchiveStatsRequest) ProtoMessage() {}
+
+func (x *ProofArchiveStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofArchiveStatsRequest.ProtoReflect.Descriptor instead.
+func (*ProofArchiveStatsRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{116}
+}
+
+type ProofTierStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of proofs stored in the tier.
+	NumProofs uint64 `protobuf:"varint,1,opt,name=num_proofs,json=numProofs,proto3" json:"num_proofs,omitempty"`
+	// The total size in bytes the proofs take up in the tier.
+	SizeBytes uint64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (x *ProofTierStats) Reset() {
+	*x = ProofTierStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofTierStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofTierStats) ProtoMessage() {}
+
+func (x *ProofTierStats) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofTierStats.ProtoReflect.Descriptor instead.
+func (*ProofTierStats) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ProofTierStats) GetNumProofs() uint64 {
+	if x != nil {
+		return x.NumProofs
+	}
+	return 0
+}
+
+func (x *ProofTierStats) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type ProofArchiveStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The tier that holds the proofs of unspent asset outputs. Only set if
+	// tiering is enabled.
+	Hot *ProofTierStats `protobuf:"bytes,1,opt,name=hot,proto3" json:"hot,omitempty"`
+	// The compressed tier the proofs of spent asset outputs are moved to.
+	// Only set if tiering is enabled.
+	Cold *ProofTierStats `protobuf:"bytes,2,opt,name=cold,proto3" json:"cold,omitempty"`
+	// The metrics of the proof scrubber since the daemon was started. Only
+	// set if scrubbing is enabled.
+	Scrub *ProofScrubStats `protobuf:"bytes,3,opt,name=scrub,proto3" json:"scrub,omitempty"`
+}
+
+func (x *ProofArchiveStatsResponse) Reset() {
+	*x = ProofArchiveStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofArchiveStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofArchiveStatsResponse) ProtoMessage() {}
+
+func (x *ProofArchiveStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofArchiveStatsResponse.ProtoReflect.Descriptor instead.
+func (*ProofArchiveStatsResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ProofArchiveStatsResponse) GetHot() *ProofTierStats {
+	if x != nil {
+		return x.Hot
+	}
+	return nil
+}
+
+func (x *ProofArchiveStatsResponse) GetCold() *ProofTierStats {
+	if x != nil {
+		return x.Cold
+	}
+	return nil
+}
+
+func (x *ProofArchiveStatsResponse) GetScrub() *ProofScrubStats {
+	if x != nil {
+		return x.Scrub
+	}
+	return nil
+}
+
+type ArchivedProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The asset ID of the asset the proof is for.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The group key of the asset the proof is for, if it has one.
+	GroupKey []byte `protobuf:"bytes,2,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+	// The script key of the asset the proof is for.
+	ScriptKey []byte `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The raw proof file.
+	RawProofFile []byte `protobuf:"bytes,4,opt,name=raw_proof_file,json=rawProofFile,proto3" json:"raw_proof_file,omitempty"`
+}
+
+func (x *ArchivedProof) Reset() {
+	*x = ArchivedProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchivedProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedProof) ProtoMessage() {}
+
+func (x *ArchivedProof) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedProof.ProtoReflect.Descriptor instead.
+func (*ArchivedProof) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ArchivedProof) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *ArchivedProof) GetGroupKey() []byte {
+	if x != nil {
+		return x.GroupKey
+	}
+	return nil
+}
+
+func (x *ArchivedProof) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *ArchivedProof) GetRawProofFile() []byte {
+	if x != nil {
+		return x.RawProofFile
+	}
+	return nil
+}
+
+type PushArchiveProofsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The proofs to store. Proofs that are already stored are replaced.
+	Proofs []*ArchivedProof `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
+}
+
+func (x *PushArchiveProofsRequest) Reset() {
+	*x = PushArchiveProofsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushArchiveProofsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushArchiveProofsRequest) ProtoMessage() {}
+
+func (x *PushArchiveProofsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushArchiveProofsRequest.ProtoReflect.Descriptor instead.
+func (*PushArchiveProofsRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *PushArchiveProofsRequest) GetProofs() []*ArchivedProof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type PushArchiveProofsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PushArchiveProofsResponse) Reset() {
+	*x = PushArchiveProofsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushArchiveProofsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushArchiveProofsResponse) ProtoMessage() {}
+
+func (x *PushArchiveProofsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushArchiveProofsResponse.ProtoReflect.Descriptor instead.
+func (*PushArchiveProofsResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{121}
+}
+
+type PullArchiveProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The asset ID of the asset the proof is for.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The group key of the asset the proof is for, if it has one.
+	GroupKey []byte `protobuf:"bytes,2,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+	// The script key of the asset the proof is for.
+	ScriptKey []byte `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+}
+
+func (x *PullArchiveProofRequest) Reset() {
+	*x = PullArchiveProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullArchiveProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullArchiveProofRequest) ProtoMessage() {}
+
+func (x *PullArchiveProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullArchiveProofRequest.ProtoReflect.Descriptor instead.
+func (*PullArchiveProofRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *PullArchiveProofRequest) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *PullArchiveProofRequest) GetGroupKey() []byte {
+	if x != nil {
+		return x.GroupKey
+	}
+	return nil
+}
+
+func (x *PullArchiveProofRequest) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+type BakeArchiveMacaroonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the user the macaroon is scoped to. Only letters, digits, '_'
+	// and '-' are allowed.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *BakeArchiveMacaroonRequest) Reset() {
+	*x = BakeArchiveMacaroonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BakeArchiveMacaroonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BakeArchiveMacaroonRequest) ProtoMessage() {}
+
+func (x *BakeArchiveMacaroonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BakeArchiveMacaroonRequest.ProtoReflect.Descriptor instead.
+func (*BakeArchiveMacaroonRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *BakeArchiveMacaroonRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type BakeArchiveMacaroonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hex encoded macaroon.
+	Macaroon string `protobuf:"bytes,1,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
+}
+
+func (x *BakeArchiveMacaroonResponse) Reset() {
+	*x = BakeArchiveMacaroonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BakeArchiveMacaroonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BakeArchiveMacaroonResponse) ProtoMessage() {}
+
+func (x *BakeArchiveMacaroonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BakeArchiveMacaroonResponse.ProtoReflect.Descriptor instead.
+func (*BakeArchiveMacaroonResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *BakeArchiveMacaroonResponse) GetMacaroon() string {
+	if x != nil {
+		return x.Macaroon
+	}
+	return ""
+}
+
+type ProofScrubStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of completed scrub passes.
+	NumPasses uint64 `protobuf:"varint,1,opt,name=num_passes,json=numPasses,proto3" json:"num_passes,omitempty"`
+	// The unix timestamp of the completion of the last scrub pass.
+	LastPassAt int64 `protobuf:"varint,2,opt,name=last_pass_at,json=lastPassAt,proto3" json:"last_pass_at,omitempty"`
+	// The number of proofs that were checked for decodability, counting
+	// each archive separately.
+	NumChecked uint64 `protobuf:"varint,3,opt,name=num_checked,json=numChecked,proto3" json:"num_checked,omitempty"`
+	// The number of proofs that were verified end-to-end.
+	NumVerified uint64 `protobuf:"varint,4,opt,name=num_verified,json=numVerified,proto3" json:"num_verified,omitempty"`
+	// The number of proofs that weren't found in an archive.
+	NumMissing uint64 `protobuf:"varint,5,opt,name=num_missing,json=numMissing,proto3" json:"num_missing,omitempty"`
+	// The number of proofs that couldn't be decoded.
+	NumCorrupted uint64 `protobuf:"varint,6,opt,name=num_corrupted,json=numCorrupted,proto3" json:"num_corrupted,omitempty"`
+	// The number of proofs that failed the end-to-end verification.
+	NumInvalid uint64 `protobuf:"varint,7,opt,name=num_invalid,json=numInvalid,proto3" json:"num_invalid,omitempty"`
+}
+
+func (x *ProofScrubStats) Reset() {
+	*x = ProofScrubStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofScrubStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofScrubStats) ProtoMessage() {}
+
+func (x *ProofScrubStats) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofScrubStats.ProtoReflect.Descriptor instead.
+func (*ProofScrubStats) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ProofScrubStats) GetNumPasses() uint64 {
+	if x != nil {
+		return x.NumPasses
+	}
+	return 0
+}
+
+func (x *ProofScrubStats) GetLastPassAt() int64 {
+	if x != nil {
+		return x.LastPassAt
+	}
+	return 0
+}
+
+func (x *ProofScrubStats) GetNumChecked() uint64 {
+	if x != nil {
+		return x.NumChecked
+	}
+	return 0
+}
+
+func (x *ProofScrubStats) GetNumVerified() uint64 {
+	if x != nil {
+		return x.NumVerified
+	}
+	return 0
+}
+
+func (x *ProofScrubStats) GetNumMissing() uint64 {
+	if x != nil {
+		return x.NumMissing
+	}
+	return 0
+}
+
+func (x *ProofScrubStats) GetNumCorrupted() uint64 {
+	if x != nil {
+		return x.NumCorrupted
+	}
+	return 0
+}
+
+func (x *ProofScrubStats) GetNumInvalid() uint64 {
+	if x != nil {
+		return x.NumInvalid
+	}
+	return 0
+}
+
+type AddrEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The time the event was created in unix timestamp seconds.
+	CreationTimeUnixSeconds uint64 `protobuf:"varint,1,opt,name=creation_time_unix_seconds,json=creationTimeUnixSeconds,proto3" json:"creation_time_unix_seconds,omitempty"`
+	// The address the event was created for.
+	Addr *Addr `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	// The current status of the event.
+	Status AddrEventStatus `protobuf:"varint,3,opt,name=status,proto3,enum=taprpc.AddrEventStatus" json:"status,omitempty"`
+	// The outpoint that contains the inbound asset transfer.
+	Outpoint string `protobuf:"bytes,4,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// The amount in satoshis that were transferred on chain along with the asset.
+	// This amount is independent of the requested asset amount, which can be
+	// looked up on the address.
+	UtxoAmtSat uint64 `protobuf:"varint,5,opt,name=utxo_amt_sat,json=utxoAmtSat,proto3" json:"utxo_amt_sat,omitempty"`
+	// The taproot sibling hash that was used to send to the Taproot output.
+	TaprootSibling []byte `protobuf:"bytes,6,opt,name=taproot_sibling,json=taprootSibling,proto3" json:"taproot_sibling,omitempty"`
+	// The height at which the on-chain output was confirmed. If this is zero, it
+	// means the output is unconfirmed.
+	ConfirmationHeight uint32 `protobuf:"varint,7,opt,name=confirmation_height,json=confirmationHeight,proto3" json:"confirmation_height,omitempty"`
+	// Indicates whether a proof file can be found for the address' asset ID and
+	// script key.
+	HasProof bool `protobuf:"varint,8,opt,name=has_proof,json=hasProof,proto3" json:"has_proof,omitempty"`
+	// The result of comparing the inbound transfer against the deposit
+	// expectation of the address.
+	DepositStatus AddrDepositStatus `protobuf:"varint,9,opt,name=deposit_status,json=depositStatus,proto3,enum=taprpc.AddrDepositStatus" json:"deposit_status,omitempty"`
+}
+
+func (x *AddrEvent) Reset() {
+	*x = AddrEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddrEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddrEvent) ProtoMessage() {}
+
+func (x *AddrEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddrEvent.ProtoReflect.Descriptor instead.
+func (*AddrEvent) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *AddrEvent) GetCreationTimeUnixSeconds() uint64 {
+	if x != nil {
+		return x.CreationTimeUnixSeconds
+	}
+	return 0
+}
+
+func (x *AddrEvent) GetAddr() *Addr {
+	if x != nil {
+		return x.Addr
+	}
+	return nil
+}
+
+func (x *AddrEvent) GetStatus() AddrEventStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN
+}
+
+func (x *AddrEvent) GetOutpoint() string {
+	if x != nil {
+		return x.Outpoint
+	}
+	return ""
+}
+
+func (x *AddrEvent) GetUtxoAmtSat() uint64 {
+	if x != nil {
+		return x.UtxoAmtSat
+	}
+	return 0
+}
+
+func (x *AddrEvent) GetTaprootSibling() []byte {
+	if x != nil {
+		return x.TaprootSibling
+	}
+	return nil
+}
+
+func (x *AddrEvent) GetConfirmationHeight() uint32 {
+	if x != nil {
+		return x.ConfirmationHeight
+	}
+	return 0
+}
+
+func (x *AddrEvent) GetHasProof() bool {
+	if x != nil {
+		return x.HasProof
+	}
+	return false
+}
+
+func (x *AddrEvent) GetDepositStatus() AddrDepositStatus {
+	if x != nil {
+		return x.DepositStatus
+	}
+	return AddrDepositStatus_ADDR_DEPOSIT_STATUS_UNANNOTATED
+}
+
+type ExportReceiptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The address the asset was received on.
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// The outpoint of the inbound transfer, in the format <txid>:<vout>.
+	Outpoint string `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+}
+
+func (x *ExportReceiptRequest) Reset() {
+	*x = ExportReceiptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReceiptRequest) ProtoMessage() {}
+
+func (x *ExportReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReceiptRequest.ProtoReflect.Descriptor instead.
+func (*ExportReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ExportReceiptRequest) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ExportReceiptRequest) GetOutpoint() string {
+	if x != nil {
+		return x.Outpoint
+	}
+	return ""
+}
+
+type TransferReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The serialized, signed receipt. This is what should be handed to third
+	// parties, the remaining fields are only informational.
+	Receipt []byte `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// The ID of the received asset.
+	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The amount of the asset that was received.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The ID of the transaction that anchors the received asset.
+	AnchorTxid string `protobuf:"bytes,4,opt,name=anchor_txid,json=anchorTxid,proto3" json:"anchor_txid,omitempty"`
+	// The script key the asset was received on.
+	ScriptKey []byte `protobuf:"bytes,5,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The hash of the block the anchor transaction was confirmed in.
+	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// The identity key of the receiving node that signed the receipt.
+	NodeKey []byte `protobuf:"bytes,7,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+}
+
+func (x *TransferReceipt) Reset() {
+	*x = TransferReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferReceipt) ProtoMessage() {}
+
+func (x *TransferReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferReceipt.ProtoReflect.Descriptor instead.
+func (*TransferReceipt) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *TransferReceipt) GetReceipt() []byte {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *TransferReceipt) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *TransferReceipt) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransferReceipt) GetAnchorTxid() string {
+	if x != nil {
+		return x.AnchorTxid
+	}
+	return ""
+}
+
+func (x *TransferReceipt) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *TransferReceipt) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *TransferReceipt) GetNodeKey() []byte {
+	if x != nil {
+		return x.NodeKey
+	}
+	return nil
+}
+
+type Counterparty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the counterparty.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The script key the counterparty receives assets on.
+	ScriptKey []byte `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The address the script key was taken from, if the counterparty was
+	// added by address.
+	TapAddr string `protobuf:"bytes,3,opt,name=tap_addr,json=tapAddr,proto3" json:"tap_addr,omitempty"`
+}
+
+func (x *Counterparty) Reset() {
+	*x = Counterparty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Counterparty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Counterparty) ProtoMessage() {}
+
+func (x *Counterparty) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Counterparty.ProtoReflect.Descriptor instead.
+func (*Counterparty) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *Counterparty) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Counterparty) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *Counterparty) GetTapAddr() string {
+	if x != nil {
+		return x.TapAddr
+	}
+	return ""
+}
+
+type SetCounterpartyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the counterparty. A counterparty that uses several script
+	// keys is added once for each of them under the same name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Target:
+	//	*SetCounterpartyRequest_ScriptKey
+	//	*SetCounterpartyRequest_TapAddr
+	Target isSetCounterpartyRequest_Target `protobuf_oneof:"target"`
+}
+
+func (x *SetCounterpartyRequest) Reset() {
+	*x = SetCounterpartyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCounterpartyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCounterpartyRequest) ProtoMessage() {}
+
+func (x *SetCounterpartyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCounterpartyRequest.ProtoReflect.Descriptor instead.
+func (*SetCounterpartyRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *SetCounterpartyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *SetCounterpartyRequest) GetTarget() isSetCounterpartyRequest_Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (x *SetCounterpartyRequest) GetScriptKey() []byte {
+	if x, ok := x.GetTarget().(*SetCounterpartyRequest_ScriptKey); ok {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *SetCounterpartyRequest) GetTapAddr() string {
+	if x, ok := x.GetTarget().(*SetCounterpartyRequest_TapAddr); ok {
+		return x.TapAddr
+	}
+	return ""
+}
+
+type isSetCounterpartyRequest_Target interface {
+	isSetCounterpartyRequest_Target()
+}
+
+type SetCounterpartyRequest_ScriptKey struct {
+	// The script key the counterparty receives assets on.
+	ScriptKey []byte `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3,oneof"`
+}
+
+type SetCounterpartyRequest_TapAddr struct {
+	// An address of the counterparty, the script key of which is added.
+	TapAddr string `protobuf:"bytes,3,opt,name=tap_addr,json=tapAddr,proto3,oneof"`
+}
+
+func (*SetCounterpartyRequest_ScriptKey) isSetCounterpartyRequest_Target() {}
+
+func (*SetCounterpartyRequest_TapAddr) isSetCounterpartyRequest_Target() {}
+
+type SetCounterpartyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The counterparty as it was stored.
+	Counterparty *Counterparty `protobuf:"bytes,1,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+}
+
+func (x *SetCounterpartyResponse) Reset() {
+	*x = SetCounterpartyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCounterpartyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCounterpartyResponse) ProtoMessage() {}
+
+func (x *SetCounterpartyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCounterpartyResponse.ProtoReflect.Descriptor instead.
+func (*SetCounterpartyResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *SetCounterpartyResponse) GetCounterparty() *Counterparty {
+	if x != nil {
+		return x.Counterparty
+	}
+	return nil
+}
+
+type RemoveCounterpartyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the counterparty to remove.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RemoveCounterpartyRequest) Reset() {
+	*x = RemoveCounterpartyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCounterpartyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCounterpartyRequest) ProtoMessage() {}
+
+func (x *RemoveCounterpartyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCounterpartyRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCounterpartyRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *RemoveCounterpartyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RemoveCounterpartyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveCounterpartyResponse) Reset() {
+	*x = RemoveCounterpartyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCounterpartyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCounterpartyResponse) ProtoMessage() {}
+
+func (x *RemoveCounterpartyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCounterpartyResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCounterpartyResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{133}
+}
+
+type ListCounterpartiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListCounterpartiesRequest) Reset() {
+	*x = ListCounterpartiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCounterpartiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCounterpartiesRequest) ProtoMessage() {}
+
+func (x *ListCounterpartiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCounterpartiesRequest.ProtoReflect.Descriptor instead.
+func (*ListCounterpartiesRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{134}
+}
+
+type ListCounterpartiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// All script keys of the counterparty book, ordered by name.
+	Counterparties []*Counterparty `protobuf:"bytes,1,rep,name=counterparties,proto3" json:"counterparties,omitempty"`
+}
+
+func (x *ListCounterpartiesResponse) Reset() {
+	*x = ListCounterpartiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCounterpartiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCounterpartiesResponse) ProtoMessage() {}
+
+func (x *ListCounterpartiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCounterpartiesResponse.ProtoReflect.Descriptor instead.
+func (*ListCounterpartiesResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ListCounterpartiesResponse) GetCounterparties() []*Counterparty {
+	if x != nil {
+		return x.Counterparties
+	}
+	return nil
+}
+
+type ImportedScriptKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The imported script key, including its internal key and tweak.
+	ScriptKey *ScriptKey `protobuf:"bytes,1,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The unix timestamp in seconds the script key was imported at.
+	ImportedAt int64 `protobuf:"varint,2,opt,name=imported_at,json=importedAt,proto3" json:"imported_at,omitempty"`
+}
+
+func (x *ImportedScriptKey) Reset() {
+	*x = ImportedScriptKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportedScriptKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedScriptKey) ProtoMessage() {}
+
+func (x *ImportedScriptKey) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedScriptKey.ProtoReflect.Descriptor instead.
+func (*ImportedScriptKey) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ImportedScriptKey) GetScriptKey() *ScriptKey {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *ImportedScriptKey) GetImportedAt() int64 {
+	if x != nil {
+		return x.ImportedAt
+	}
+	return 0
+}
+
+type ImportScriptKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The script key to import. The internal key must be set in the key
+	// descriptor and, together with the optional tap tweak, must derive the
+	// script key.
+	ScriptKey *ScriptKey `protobuf:"bytes,1,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+}
+
+func (x *ImportScriptKeyRequest) Reset() {
+	*x = ImportScriptKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportScriptKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportScriptKeyRequest) ProtoMessage() {}
+
+func (x *ImportScriptKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportScriptKeyRequest.ProtoReflect.Descriptor instead.
+func (*ImportScriptKeyRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *ImportScriptKeyRequest) GetScriptKey() *ScriptKey {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+type ImportScriptKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The script key as it was imported.
+	ImportedKey *ImportedScriptKey `protobuf:"bytes,1,opt,name=imported_key,json=importedKey,proto3" json:"imported_key,omitempty"`
+}
+
+func (x *ImportScriptKeyResponse) Reset() {
+	*x = ImportScriptKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportScriptKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportScriptKeyResponse) ProtoMessage() {}
+
+func (x *ImportScriptKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportScriptKeyResponse.ProtoReflect.Descriptor instead.
+func (*ImportScriptKeyResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ImportScriptKeyResponse) GetImportedKey() *ImportedScriptKey {
+	if x != nil {
+		return x.ImportedKey
+	}
+	return nil
+}
+
+type ListImportedScriptKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListImportedScriptKeysRequest) Reset() {
+	*x = ListImportedScriptKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListImportedScriptKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImportedScriptKeysRequest) ProtoMessage() {}
+
+func (x *ListImportedScriptKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImportedScriptKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListImportedScriptKeysRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{139}
+}
+
+type ListImportedScriptKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// All imported script keys, ordered by the time they were imported.
+	ImportedKeys []*ImportedScriptKey `protobuf:"bytes,1,rep,name=imported_keys,json=importedKeys,proto3" json:"imported_keys,omitempty"`
+}
+
+func (x *ListImportedScriptKeysResponse) Reset() {
+	*x = ListImportedScriptKeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListImportedScriptKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImportedScriptKeysResponse) ProtoMessage() {}
+
+func (x *ListImportedScriptKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImportedScriptKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListImportedScriptKeysResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ListImportedScriptKeysResponse) GetImportedKeys() []*ImportedScriptKey {
+	if x != nil {
+		return x.ImportedKeys
+	}
+	return nil
+}
+
+type Invoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The bech32m encoded, signed invoice.
+	Invoice string `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	// The random identifier of the invoice.
+	InvoiceId []byte `protobuf:"bytes,2,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	// The Taproot Asset address the payment should be sent to.
+	Addr string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	// The ID of the requested asset.
+	AssetId []byte `protobuf:"bytes,4,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The requested amount of the asset.
+	Amount uint64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The unix timestamp in seconds the invoice was created at.
+	CreatedAt int64 `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The unix timestamp in seconds after which the invoice expires.
+	ExpiresAt int64 `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// The free-form description of the payment.
+	Memo string `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The identity key of the node that created and signed the invoice.
+	NodeKey []byte `protobuf:"bytes,9,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *Invoice) GetInvoice() string {
+	if x != nil {
+		return x.Invoice
+	}
+	return ""
+}
+
+func (x *Invoice) GetInvoiceId() []byte {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return nil
+}
+
+func (x *Invoice) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Invoice) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *Invoice) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Invoice) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Invoice) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *Invoice) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *Invoice) GetNodeKey() []byte {
+	if x != nil {
+		return x.NodeKey
+	}
+	return nil
+}
+
+type LocalInvoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The invoice.
+	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	// The settlement state of the invoice.
+	State InvoiceState `protobuf:"varint,2,opt,name=state,proto3,enum=taprpc.InvoiceState" json:"state,omitempty"`
+	// The unix timestamp in seconds the payment of the invoice was received at,
+	// or zero if it wasn't settled yet.
+	SettledAt int64 `protobuf:"varint,3,opt,name=settled_at,json=settledAt,proto3" json:"settled_at,omitempty"`
+	// The outpoint the payment of the invoice was received in, in the format
+	// <txid>:<vout>. Only set if the invoice was settled.
+	SettleOutpoint string `protobuf:"bytes,4,opt,name=settle_outpoint,json=settleOutpoint,proto3" json:"settle_outpoint,omitempty"`
+}
+
+func (x *LocalInvoice) Reset() {
+	*x = LocalInvoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalInvoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalInvoice) ProtoMessage() {}
+
+func (x *LocalInvoice) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalInvoice.ProtoReflect.Descriptor instead.
+func (*LocalInvoice) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *LocalInvoice) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+func (x *LocalInvoice) GetState() InvoiceState {
+	if x != nil {
+		return x.State
+	}
+	return InvoiceState_INVOICE_STATE_OPEN
+}
+
+func (x *LocalInvoice) GetSettledAt() int64 {
+	if x != nil {
+		return x.SettledAt
+	}
+	return 0
+}
+
+func (x *LocalInvoice) GetSettleOutpoint() string {
+	if x != nil {
+		return x.SettleOutpoint
+	}
+	return ""
+}
+
+type CreateInvoiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the asset to request.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The amount of the asset to request.
+	Amt uint64 `protobuf:"varint,2,opt,name=amt,proto3" json:"amt,omitempty"`
+	// The number of seconds after its creation for which the invoice can be
+	// paid. If zero, the invoice expires after one hour.
+	ExpirySeconds int64 `protobuf:"varint,3,opt,name=expiry_seconds,json=expirySeconds,proto3" json:"expiry_seconds,omitempty"`
+	// An optional free-form description of the payment.
+	Memo string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *CreateInvoiceRequest) Reset() {
+	*x = CreateInvoiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvoiceRequest) ProtoMessage() {}
+
+func (x *CreateInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *CreateInvoiceRequest) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *CreateInvoiceRequest) GetAmt() uint64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *CreateInvoiceRequest) GetExpirySeconds() int64 {
+	if x != nil {
+		return x.ExpirySeconds
+	}
+	return 0
+}
+
+func (x *CreateInvoiceRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+type DecodeInvoiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The bech32m encoded invoice to decode.
+	Invoice string `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+}
+
+func (x *DecodeInvoiceRequest) Reset() {
+	*x = DecodeInvoiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeInvoiceRequest) ProtoMessage() {}
+
+func (x *DecodeInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*DecodeInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *DecodeInvoiceRequest) GetInvoice() string {
+	if x != nil {
+		return x.Invoice
+	}
+	return ""
+}
+
+type LookupInvoiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier of the invoice to look up.
+	InvoiceId []byte `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+}
+
+func (x *LookupInvoiceRequest) Reset() {
+	*x = LookupInvoiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupInvoiceRequest) ProtoMessage() {}
+
+func (x *LookupInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*LookupInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *LookupInvoiceRequest) GetInvoiceId() []byte {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return nil
+}
+
+type ListInvoicesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListInvoicesRequest) Reset() {
+	*x = ListInvoicesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInvoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesRequest) ProtoMessage() {}
+
+func (x *ListInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{146}
+}
+
+type ListInvoicesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// All invoices created by this node, ordered by their creation time.
+	Invoices []*LocalInvoice `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+}
+
+func (x *ListInvoicesResponse) Reset() {
+	*x = ListInvoicesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInvoicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesResponse) ProtoMessage() {}
+
+func (x *ListInvoicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvoicesResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ListInvoicesResponse) GetInvoices() []*LocalInvoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+type AddrReceivesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Filter receives by a specific address. Leave empty to get all receives.
+	FilterAddr string `protobuf:"bytes,1,opt,name=filter_addr,json=filterAddr,proto3" json:"filter_addr,omitempty"`
+	// Filter receives by a specific status. Leave empty to get all receives.
+	FilterStatus AddrEventStatus `protobuf:"varint,2,opt,name=filter_status,json=filterStatus,proto3,enum=taprpc.AddrEventStatus" json:"filter_status,omitempty"`
+}
+
+func (x *AddrReceivesRequest) Reset() {
+	*x = AddrReceivesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddrReceivesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddrReceivesRequest) ProtoMessage() {}
+
+func (x *AddrReceivesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddrReceivesRequest.ProtoReflect.Descriptor instead.
+func (*AddrReceivesRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *AddrReceivesRequest) GetFilterAddr() string {
+	if x != nil {
+		return x.FilterAddr
+	}
+	return ""
+}
+
+func (x *AddrReceivesRequest) GetFilterStatus() AddrEventStatus {
+	if x != nil {
+		return x.FilterStatus
+	}
+	return AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN
+}
+
+type AddrReceivesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The events that match the filter criteria.
+	Events []*AddrEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *AddrReceivesResponse) Reset() {
+	*x = AddrReceivesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddrReceivesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddrReceivesResponse) ProtoMessage() {}
+
+func (x *AddrReceivesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddrReceivesResponse.ProtoReflect.Descriptor instead.
+func (*AddrReceivesResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *AddrReceivesResponse) GetEvents() []*AddrEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type SendAssetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TapAddrs []string `protobuf:"bytes,1,rep,name=tap_addrs,json=tapAddrs,proto3" json:"tap_addrs,omitempty"`
+	// The priority class the transfer is scheduled with. The call only returns
+	// once the transfer was broadcast, so batchable transfers can block for up to
+	// the configured batch interval.
+	Priority ParcelPriority `protobuf:"varint,2,opt,name=priority,proto3,enum=taprpc.ParcelPriority" json:"priority,omitempty"`
+	// The coin selection strategy the spent lots are selected with. If not set,
+	// the default strategy of the daemon is used.
+	CoinSelectStrategy CoinSelectStrategy `protobuf:"varint,3,opt,name=coin_select_strategy,json=coinSelectStrategy,proto3,enum=taprpc.CoinSelectStrategy" json:"coin_select_strategy,omitempty"`
+	// The lots that may be spent by the transfer. If set, only these lots are
+	// selected, in the order of the coin selection strategy.
+	SpendLots []*AssetLotID `protobuf:"bytes,4,rep,name=spend_lots,json=spendLots,proto3" json:"spend_lots,omitempty"`
+	// The number of confirmations a BTC UTXO needs to be used to pay for the fees
+	// of the anchor transaction. If zero, a single confirmation is required, so
+	// unconfirmed outputs are never spent.
+	AnchorMinConfs uint32 `protobuf:"varint,5,opt,name=anchor_min_confs,json=anchorMinConfs,proto3" json:"anchor_min_confs,omitempty"`
+	// The maximum number of BTC inputs that may be used to pay for the fees of
+	// the anchor transaction. If zero, the number of inputs isn't limited.
+	AnchorMaxInputs uint32 `protobuf:"varint,6,opt,name=anchor_max_inputs,json=anchorMaxInputs,proto3" json:"anchor_max_inputs,omitempty"`
+	// The time (unix timestamp in seconds) by which the anchor transaction needs
+	// to be confirmed. Until then, the fee of the anchor transaction is bumped
+	// through its change output in the configured interval. If the deadline is
+	// missed, a ConfDeadlineExceededEvent is emitted. If zero, no deadline is
+	// set.
+	ConfDeadlineUnixSeconds int64 `protobuf:"varint,7,opt,name=conf_deadline_unix_seconds,json=confDeadlineUnixSeconds,proto3" json:"conf_deadline_unix_seconds,omitempty"`
+	// The interval in seconds between two fee bumps of an anchor transaction
+	// with a confirmation deadline. If zero, a default of 20 minutes is used.
+	FeeBumpIntervalSeconds uint32 `protobuf:"varint,8,opt,name=fee_bump_interval_seconds,json=feeBumpIntervalSeconds,proto3" json:"fee_bump_interval_seconds,omitempty"`
+	// The percentage the fee rate is raised by with each bump. If zero, a
+	// default of 25 percent is used.
+	FeeBumpPercent uint32 `protobuf:"varint,9,opt,name=fee_bump_percent,json=feeBumpPercent,proto3" json:"fee_bump_percent,omitempty"`
+	// The maximum fee rate in sat/vB a fee bump may use. If zero, the fee rate
+	// isn't capped.
+	MaxFeeRateSatPerVbyte uint64 `protobuf:"varint,10,opt,name=max_fee_rate_sat_per_vbyte,json=maxFeeRateSatPerVbyte,proto3" json:"max_fee_rate_sat_per_vbyte,omitempty"`
+	// The earliest block height at which the anchor transaction is broadcast. The
+	// transfer is signed and committed right away and the call returns once it is
+	// committed. Until it is broadcast, the transfer can be canceled with
+	// CancelScheduledTransfer. If zero, there is no height constraint.
+	BroadcastAfterHeight uint32 `protobuf:"varint,11,opt,name=broadcast_after_height,json=broadcastAfterHeight,proto3" json:"broadcast_after_height,omitempty"`
+	// The earliest time (unix timestamp in seconds) at which the anchor
+	// transaction is broadcast. If both a height and a time are set, the anchor
+	// transaction is broadcast once both are reached. If zero, there is no time
+	// constraint.
+	BroadcastAfterUnixSeconds int64 `protobuf:"varint,12,opt,name=broadcast_after_unix_seconds,json=broadcastAfterUnixSeconds,proto3" json:"broadcast_after_unix_seconds,omitempty"`
+	// The explicit lock time and input sequence numbers of the anchor
+	// transaction, for protocols that require them. Unless overridden, the lock
+	// time of the anchor transaction is set to the current block height to
+	// discourage fee sniping.
+	AnchorLockTime *AnchorLockTime `protobuf:"bytes,13,opt,name=anchor_lock_time,json=anchorLockTime,proto3" json:"anchor_lock_time,omitempty"`
+	// Determines how the transfer is handled if the available balance can't
+	// cover all addresses. Addresses commit to the exact amount they receive, so
+	// a transfer can only be fulfilled partially by paying a subset of its
+	// addresses in full, in the order they were given in.
+	PartialSendMode PartialSendMode `protobuf:"varint,14,opt,name=partial_send_mode,json=partialSendMode,proto3,enum=taprpc.PartialSendMode" json:"partial_send_mode,omitempty"`
+}
+
+func (x *SendAssetRequest) Reset() {
+	*x = SendAssetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAssetRequest) ProtoMessage() {}
+
+func (x *SendAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAssetRequest.ProtoReflect.Descriptor instead.
+func (*SendAssetRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *SendAssetRequest) GetTapAddrs() []string {
+	if x != nil {
+		return x.TapAddrs
+	}
+	return nil
+}
+
+func (x *SendAssetRequest) GetPriority() ParcelPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return ParcelPriority_PARCEL_PRIORITY_NORMAL
+}
+
+func (x *SendAssetRequest) GetCoinSelectStrategy() CoinSelectStrategy {
+	if x != nil {
+		return x.CoinSelectStrategy
+	}
+	return CoinSelectStrategy_COIN_SELECT_STRATEGY_DEFAULT
+}
+
+func (x *SendAssetRequest) GetSpendLots() []*AssetLotID {
+	if x != nil {
+		return x.SpendLots
+	}
+	return nil
+}
+
+func (x *SendAssetRequest) GetAnchorMinConfs() uint32 {
+	if x != nil {
+		return x.AnchorMinConfs
+	}
+	return 0
+}
+
+func (x *SendAssetRequest) GetAnchorMaxInputs() uint32 {
+	if x != nil {
+		return x.AnchorMaxInputs
+	}
+	return 0
+}
+
+func (x *SendAssetRequest) GetConfDeadlineUnixSeconds() int64 {
+	if x != nil {
+		return x.ConfDeadlineUnixSeconds
+	}
+	return 0
+}
+
+func (x *SendAssetRequest) GetFeeBumpIntervalSeconds() uint32 {
+	if x != nil {
+		return x.FeeBumpIntervalSeconds
+	}
+	return 0
+}
+
+func (x *SendAssetRequest) GetFeeBumpPercent() uint32 {
+	if x != nil {
+		return x.FeeBumpPercent
+	}
+	return 0
+}
+
+func (x *SendAssetRequest) GetMaxFeeRateSatPerVbyte() uint64 {
+	if x != nil {
+		return x.MaxFeeRateSatPerVbyte
+	}
+	return 0
+}
+
+func (x *SendAssetRequest) GetBroadcastAfterHeight() uint32 {
+	if x != nil {
+		return x.BroadcastAfterHeight
+	}
+	return 0
+}
+
+func (x *SendAssetRequest) GetBroadcastAfterUnixSeconds() int64 {
+	if x != nil {
+		return x.BroadcastAfterUnixSeconds
+	}
+	return 0
+}
+
+func (x *SendAssetRequest) GetAnchorLockTime() *AnchorLockTime {
+	if x != nil {
+		return x.AnchorLockTime
+	}
+	return nil
+}
+
+func (x *SendAssetRequest) GetPartialSendMode() PartialSendMode {
+	if x != nil {
+		return x.PartialSendMode
+	}
+	return PartialSendMode_PARTIAL_SEND_DISABLED
+}
+
+type PartialSend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The addresses that are paid, or can be paid if only proposed.
+	FulfilledTapAddrs []string `protobuf:"bytes,1,rep,name=fulfilled_tap_addrs,json=fulfilledTapAddrs,proto3" json:"fulfilled_tap_addrs,omitempty"`
+	// The addresses that can't be paid with the available balance.
+	UnfulfilledTapAddrs []string `protobuf:"bytes,2,rep,name=unfulfilled_tap_addrs,json=unfulfilledTapAddrs,proto3" json:"unfulfilled_tap_addrs,omitempty"`
+	// The amount paid to the fulfilled addresses.
+	FulfilledAmount uint64 `protobuf:"varint,3,opt,name=fulfilled_amount,json=fulfilledAmount,proto3" json:"fulfilled_amount,omitempty"`
+	// The amount of the unfulfilled addresses that isn't paid.
+	ShortfallAmount uint64 `protobuf:"varint,4,opt,name=shortfall_amount,json=shortfallAmount,proto3" json:"shortfall_amount,omitempty"`
+	// Whether the partial send was only proposed and nothing was sent.
+	Proposed bool `protobuf:"varint,5,opt,name=proposed,proto3" json:"proposed,omitempty"`
+}
+
+func (x *PartialSend) Reset() {
+	*x = PartialSend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartialSend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartialSend) ProtoMessage() {}
+
+func (x *PartialSend) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartialSend.ProtoReflect.Descriptor instead.
+func (*PartialSend) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *PartialSend) GetFulfilledTapAddrs() []string {
+	if x != nil {
+		return x.FulfilledTapAddrs
+	}
+	return nil
+}
+
+func (x *PartialSend) GetUnfulfilledTapAddrs() []string {
+	if x != nil {
+		return x.UnfulfilledTapAddrs
+	}
+	return nil
+}
+
+func (x *PartialSend) GetFulfilledAmount() uint64 {
+	if x != nil {
+		return x.FulfilledAmount
+	}
+	return 0
+}
+
+func (x *PartialSend) GetShortfallAmount() uint64 {
+	if x != nil {
+		return x.ShortfallAmount
+	}
+	return 0
+}
+
+func (x *PartialSend) GetProposed() bool {
+	if x != nil {
+		return x.Proposed
+	}
+	return false
+}
+
+type AnchorLockTime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, the lock time of the anchor transaction is set to the given lock
+	// time instead of the current block height.
+	OverrideLockTime bool `protobuf:"varint,1,opt,name=override_lock_time,json=overrideLockTime,proto3" json:"override_lock_time,omitempty"`
+	// The lock time of the anchor transaction if override_lock_time is set. A
+	// block height lock time above the current height requires
+	// broadcast_after_height to be at or above the lock time. Zero disables the
+	// lock time.
+	LockTime uint32 `protobuf:"varint,2,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
+	// If set, the sequence number of all inputs of the anchor transaction is set
+	// to the given sequence instead of the sequence numbers chosen by the
+	// wallet.
+	OverrideSequence bool `protobuf:"varint,3,opt,name=override_sequence,json=overrideSequence,proto3" json:"override_sequence,omitempty"`
+	// The sequence number of all inputs if override_sequence is set.
+	Sequence uint32 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *AnchorLockTime) Reset() {
+	*x = AnchorLockTime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnchorLockTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnchorLockTime) ProtoMessage() {}
+
+func (x *AnchorLockTime) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnchorLockTime.ProtoReflect.Descriptor instead.
+func (*AnchorLockTime) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *AnchorLockTime) GetOverrideLockTime() bool {
+	if x != nil {
+		return x.OverrideLockTime
+	}
+	return false
+}
+
+func (x *AnchorLockTime) GetLockTime() uint32 {
+	if x != nil {
+		return x.LockTime
+	}
+	return 0
+}
+
+func (x *AnchorLockTime) GetOverrideSequence() bool {
+	if x != nil {
+		return x.OverrideSequence
+	}
+	return false
+}
+
+func (x *AnchorLockTime) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type PrevInputAsset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnchorPoint string `protobuf:"bytes,1,opt,name=anchor_point,json=anchorPoint,proto3" json:"anchor_point,omitempty"`
+	AssetId     []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	ScriptKey   []byte `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	Amount      uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *PrevInputAsset) Reset() {
+	*x = PrevInputAsset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrevInputAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrevInputAsset) ProtoMessage() {}
+
+func (x *PrevInputAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrevInputAsset.ProtoReflect.Descriptor instead.
+func (*PrevInputAsset) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *PrevInputAsset) GetAnchorPoint() string {
+	if x != nil {
+		return x.AnchorPoint
+	}
+	return ""
+}
+
+func (x *PrevInputAsset) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *PrevInputAsset) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *PrevInputAsset) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type SendAssetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The transfer, unset if a partial send was only proposed.
+	Transfer *AssetTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	// Set if not all addresses of the request are paid or can be paid.
+	PartialSend *PartialSend `protobuf:"bytes,2,opt,name=partial_send,json=partialSend,proto3" json:"partial_send,omitempty"`
+}
+
+func (x *SendAssetResponse) Reset() {
+	*x = SendAssetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendAssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAssetResponse) ProtoMessage() {}
+
+func (x *SendAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAssetResponse.ProtoReflect.Descriptor instead.
+func (*SendAssetResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *SendAssetResponse) GetTransfer() *AssetTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *SendAssetResponse) GetPartialSend() *PartialSend {
+	if x != nil {
+		return x.PartialSend
+	}
+	return nil
+}
+
+type ScheduledTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier the porter assigned to the transfer.
+	ParcelId uint64 `protobuf:"varint,1,opt,name=parcel_id,json=parcelId,proto3" json:"parcel_id,omitempty"`
+	// The ID of the anchor transaction that is held back.
+	AnchorTxid string `protobuf:"bytes,2,opt,name=anchor_txid,json=anchorTxid,proto3" json:"anchor_txid,omitempty"`
+	// The earliest block height at which the anchor transaction is broadcast,
+	// zero if there is no height constraint.
+	BroadcastAfterHeight uint32 `protobuf:"varint,3,opt,name=broadcast_after_height,json=broadcastAfterHeight,proto3" json:"broadcast_after_height,omitempty"`
+	// The earliest time (unix timestamp in seconds) at which the anchor
+	// transaction is broadcast, zero if there is no time constraint.
+	BroadcastAfterUnixSeconds int64 `protobuf:"varint,4,opt,name=broadcast_after_unix_seconds,json=broadcastAfterUnixSeconds,proto3" json:"broadcast_after_unix_seconds,omitempty"`
+	// The time (unix timestamp in seconds) the transfer was committed.
+	ScheduledAt int64 `protobuf:"varint,5,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+}
+
+func (x *ScheduledTransfer) Reset() {
+	*x = ScheduledTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledTransfer) ProtoMessage() {}
+
+func (x *ScheduledTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledTransfer.ProtoReflect.Descriptor instead.
+func (*ScheduledTransfer) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *ScheduledTransfer) GetParcelId() uint64 {
+	if x != nil {
+		return x.ParcelId
+	}
+	return 0
+}
+
+func (x *ScheduledTransfer) GetAnchorTxid() string {
+	if x != nil {
+		return x.AnchorTxid
+	}
+	return ""
+}
+
+func (x *ScheduledTransfer) GetBroadcastAfterHeight() uint32 {
+	if x != nil {
+		return x.BroadcastAfterHeight
+	}
+	return 0
+}
+
+func (x *ScheduledTransfer) GetBroadcastAfterUnixSeconds() int64 {
+	if x != nil {
+		return x.BroadcastAfterUnixSeconds
+	}
+	return 0
+}
+
+func (x *ScheduledTransfer) GetScheduledAt() int64 {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return 0
+}
+
+type ListScheduledTransfersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListScheduledTransfersRequest) Reset() {
+	*x = ListScheduledTransfersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListScheduledTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTransfersRequest) ProtoMessage() {}
+
+func (x *ListScheduledTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{156}
+}
+
+type ListScheduledTransfersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The scheduled transfers ordered by their parcel ID.
+	Transfers []*ScheduledTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+}
+
+func (x *ListScheduledTransfersResponse) Reset() {
+	*x = ListScheduledTransfersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListScheduledTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTransfersResponse) ProtoMessage() {}
+
+func (x *ListScheduledTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *ListScheduledTransfersResponse) GetTransfers() []*ScheduledTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+type CancelScheduledTransferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the anchor transaction of the scheduled transfer to cancel.
+	AnchorTxid string `protobuf:"bytes,1,opt,name=anchor_txid,json=anchorTxid,proto3" json:"anchor_txid,omitempty"`
+}
+
+func (x *CancelScheduledTransferRequest) Reset() {
+	*x = CancelScheduledTransferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledTransferRequest) ProtoMessage() {}
+
+func (x *CancelScheduledTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledTransferRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledTransferRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *CancelScheduledTransferRequest) GetAnchorTxid() string {
+	if x != nil {
+		return x.AnchorTxid
+	}
+	return ""
+}
+
+type CancelScheduledTransferResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelScheduledTransferResponse) Reset() {
+	*x = CancelScheduledTransferResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledTransferResponse) ProtoMessage() {}
+
+func (x *CancelScheduledTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledTransferResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledTransferResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{159}
+}
+
+type OpenAnchorSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the anchor session to open.
+	SessionName string `protobuf:"bytes,1,opt,name=session_name,json=sessionName,proto3" json:"session_name,omitempty"`
+}
+
+func (x *OpenAnchorSessionRequest) Reset() {
+	*x = OpenAnchorSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenAnchorSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenAnchorSessionRequest) ProtoMessage() {}
+
+func (x *OpenAnchorSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenAnchorSessionRequest.ProtoReflect.Descriptor instead.
+func (*OpenAnchorSessionRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *OpenAnchorSessionRequest) GetSessionName() string {
+	if x != nil {
+		return x.SessionName
+	}
+	return ""
+}
+
+type OpenAnchorSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *OpenAnchorSessionResponse) Reset() {
+	*x = OpenAnchorSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenAnchorSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenAnchorSessionResponse) ProtoMessage() {}
+
+func (x *OpenAnchorSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenAnchorSessionResponse.ProtoReflect.Descriptor instead.
+func (*OpenAnchorSessionResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{161}
+}
+
+type RegisterAnchorSessionParcelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the open anchor session to register the parcel in.
+	SessionName string `protobuf:"bytes,1,opt,name=session_name,json=sessionName,proto3" json:"session_name,omitempty"`
+	// The addresses the parcel sends to. All parcels of a session must send the
+	// same asset and every receiver must use its own script key.
+	TapAddrs []string `protobuf:"bytes,2,rep,name=tap_addrs,json=tapAddrs,proto3" json:"tap_addrs,omitempty"`
+}
+
+func (x *RegisterAnchorSessionParcelRequest) Reset() {
+	*x = RegisterAnchorSessionParcelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterAnchorSessionParcelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterAnchorSessionParcelRequest) ProtoMessage() {}
+
+func (x *RegisterAnchorSessionParcelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterAnchorSessionParcelRequest.ProtoReflect.Descriptor instead.
+func (*RegisterAnchorSessionParcelRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *RegisterAnchorSessionParcelRequest) GetSessionName() string {
+	if x != nil {
+		return x.SessionName
+	}
+	return ""
+}
+
+func (x *RegisterAnchorSessionParcelRequest) GetTapAddrs() []string {
+	if x != nil {
+		return x.TapAddrs
+	}
+	return nil
+}
+
+type RegisterAnchorSessionParcelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The caller's view of the shared transfer, which only contains the outputs
+	// of the parcel and its share of the chain fees.
+	Transfer *AssetTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+}
+
+func (x *RegisterAnchorSessionParcelResponse) Reset() {
+	*x = RegisterAnchorSessionParcelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterAnchorSessionParcelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterAnchorSessionParcelResponse) ProtoMessage() {}
+
+func (x *RegisterAnchorSessionParcelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterAnchorSessionParcelResponse.ProtoReflect.Descriptor instead.
+func (*RegisterAnchorSessionParcelResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *RegisterAnchorSessionParcelResponse) GetTransfer() *AssetTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type SealAnchorSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the anchor session to seal.
+	SessionName string `protobuf:"bytes,1,opt,name=session_name,json=sessionName,proto3" json:"session_name,omitempty"`
+	// The priority class the shared transfer is scheduled with.
+	Priority ParcelPriority `protobuf:"varint,2,opt,name=priority,proto3,enum=taprpc.ParcelPriority" json:"priority,omitempty"`
+}
+
+func (x *SealAnchorSessionRequest) Reset() {
+	*x = SealAnchorSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SealAnchorSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SealAnchorSessionRequest) ProtoMessage() {}
+
+func (x *SealAnchorSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SealAnchorSessionRequest.ProtoReflect.Descriptor instead.
+func (*SealAnchorSessionRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *SealAnchorSessionRequest) GetSessionName() string {
+	if x != nil {
+		return x.SessionName
+	}
+	return ""
+}
+
+func (x *SealAnchorSessionRequest) GetPriority() ParcelPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return ParcelPriority_PARCEL_PRIORITY_NORMAL
+}
+
+type SealAnchorSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The shared transfer of all parcels of the session.
+	Transfer *AssetTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+}
+
+func (x *SealAnchorSessionResponse) Reset() {
+	*x = SealAnchorSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SealAnchorSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SealAnchorSessionResponse) ProtoMessage() {}
+
+func (x *SealAnchorSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SealAnchorSessionResponse.ProtoReflect.Descriptor instead.
+func (*SealAnchorSessionResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *SealAnchorSessionResponse) GetTransfer() *AssetTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type AbortAnchorSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the anchor session to abort.
+	SessionName string `protobuf:"bytes,1,opt,name=session_name,json=sessionName,proto3" json:"session_name,omitempty"`
+}
+
+func (x *AbortAnchorSessionRequest) Reset() {
+	*x = AbortAnchorSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AbortAnchorSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortAnchorSessionRequest) ProtoMessage() {}
+
+func (x *AbortAnchorSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortAnchorSessionRequest.ProtoReflect.Descriptor instead.
+func (*AbortAnchorSessionRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *AbortAnchorSessionRequest) GetSessionName() string {
+	if x != nil {
+		return x.SessionName
+	}
+	return ""
+}
+
+type AbortAnchorSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AbortAnchorSessionResponse) Reset() {
+	*x = AbortAnchorSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AbortAnchorSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortAnchorSessionResponse) ProtoMessage() {}
+
+func (x *AbortAnchorSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortAnchorSessionResponse.ProtoReflect.Descriptor instead.
+func (*AbortAnchorSessionResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{167}
+}
+
+type StartAirdropRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A user defined description of the airdrop.
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// The manifest of the airdrop in CSV format, with one address,amount pair
+	// per line. The amount must match the amount of the address. An optional
+	// header line and lines starting with '#' are skipped.
+	ManifestCsv string `protobuf:"bytes,2,opt,name=manifest_csv,json=manifestCsv,proto3" json:"manifest_csv,omitempty"`
+	// The maximum number of recipients paid by a single anchor transaction. If
+	// zero, a default of 25 is used.
+	MaxRecipientsPerTx uint32 `protobuf:"varint,3,opt,name=max_recipients_per_tx,json=maxRecipientsPerTx,proto3" json:"max_recipients_per_tx,omitempty"`
+	// If set, the manifest is only validated and split into batches, without
+	// storing the airdrop or sending any assets.
+	ValidateOnly bool `protobuf:"varint,4,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *StartAirdropRequest) Reset() {
+	*x = StartAirdropRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartAirdropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartAirdropRequest) ProtoMessage() {}
+
+func (x *StartAirdropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartAirdropRequest.ProtoReflect.Descriptor instead.
+func (*StartAirdropRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *StartAirdropRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *StartAirdropRequest) GetManifestCsv() string {
+	if x != nil {
+		return x.ManifestCsv
+	}
+	return ""
+}
+
+func (x *StartAirdropRequest) GetMaxRecipientsPerTx() uint32 {
+	if x != nil {
+		return x.MaxRecipientsPerTx
+	}
+	return 0
+}
+
+func (x *StartAirdropRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type AirdropRecipient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The position of the recipient in the manifest.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// The Taproot Asset address of the recipient.
+	TapAddr string `protobuf:"bytes,2,opt,name=tap_addr,json=tapAddr,proto3" json:"tap_addr,omitempty"`
+	// The ID of the asset the recipient receives.
+	AssetId []byte `protobuf:"bytes,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The amount the recipient receives.
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The status of the send to the recipient.
+	Status AirdropRecipientStatus `protobuf:"varint,5,opt,name=status,proto3,enum=taprpc.AirdropRecipientStatus" json:"status,omitempty"`
+	// The ID of the anchor transaction that paid the recipient, if any.
+	AnchorTxid string `protobuf:"bytes,6,opt,name=anchor_txid,json=anchorTxid,proto3" json:"anchor_txid,omitempty"`
+	// The error the last send attempt failed with, if any.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// The unix timestamp in seconds the status was last updated at.
+	UpdatedAt int64 `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *AirdropRecipient) Reset() {
+	*x = AirdropRecipient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AirdropRecipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AirdropRecipient) ProtoMessage() {}
+
+func (x *AirdropRecipient) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AirdropRecipient.ProtoReflect.Descriptor instead.
+func (*AirdropRecipient) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *AirdropRecipient) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *AirdropRecipient) GetTapAddr() string {
+	if x != nil {
+		return x.TapAddr
+	}
+	return ""
+}
+
+func (x *AirdropRecipient) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *AirdropRecipient) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AirdropRecipient) GetStatus() AirdropRecipientStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AirdropRecipientStatus_AIRDROP_PENDING
+}
+
+func (x *AirdropRecipient) GetAnchorTxid() string {
+	if x != nil {
+		return x.AnchorTxid
+	}
+	return ""
+}
+
+func (x *AirdropRecipient) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AirdropRecipient) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type AirdropBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the asset sent in the batch.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The manifest positions of the recipients paid by the batch.
+	RecipientIndexes []uint32 `protobuf:"varint,2,rep,packed,name=recipient_indexes,json=recipientIndexes,proto3" json:"recipient_indexes,omitempty"`
+}
+
+func (x *AirdropBatch) Reset() {
+	*x = AirdropBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AirdropBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AirdropBatch) ProtoMessage() {}
+
+func (x *AirdropBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AirdropBatch.ProtoReflect.Descriptor instead.
+func (*AirdropBatch) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *AirdropBatch) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *AirdropBatch) GetRecipientIndexes() []uint32 {
+	if x != nil {
+		return x.RecipientIndexes
+	}
+	return nil
+}
+
+type Airdrop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the airdrop.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The user defined description of the airdrop.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// The maximum number of recipients paid by a single anchor transaction.
+	MaxRecipientsPerTx uint32 `protobuf:"varint,3,opt,name=max_recipients_per_tx,json=maxRecipientsPerTx,proto3" json:"max_recipients_per_tx,omitempty"`
+	// The unix timestamp in seconds the airdrop was created at.
+	CreatedAt int64 `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The number of recipients that weren't paid yet.
+	NumPending uint32 `protobuf:"varint,5,opt,name=num_pending,json=numPending,proto3" json:"num_pending,omitempty"`
+	// The number of recipients whose transfer is in flight.
+	NumInFlight uint32 `protobuf:"varint,6,opt,name=num_in_flight,json=numInFlight,proto3" json:"num_in_flight,omitempty"`
+	// The number of recipients that were paid.
+	NumSent uint32 `protobuf:"varint,7,opt,name=num_sent,json=numSent,proto3" json:"num_sent,omitempty"`
+	// The number of recipients whose transfer failed.
+	NumFailed uint32 `protobuf:"varint,8,opt,name=num_failed,json=numFailed,proto3" json:"num_failed,omitempty"`
+	// The recipients of the airdrop in manifest order.
+	Recipients []*AirdropRecipient `protobuf:"bytes,9,rep,name=recipients,proto3" json:"recipients,omitempty"`
+}
+
+func (x *Airdrop) Reset() {
+	*x = Airdrop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Airdrop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Airdrop) ProtoMessage() {}
+
+func (x *Airdrop) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Airdrop.ProtoReflect.Descriptor instead.
+func (*Airdrop) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *Airdrop) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Airdrop) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Airdrop) GetMaxRecipientsPerTx() uint32 {
+	if x != nil {
+		return x.MaxRecipientsPerTx
+	}
+	return 0
+}
+
+func (x *Airdrop) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Airdrop) GetNumPending() uint32 {
+	if x != nil {
+		return x.NumPending
+	}
+	return 0
+}
+
+func (x *Airdrop) GetNumInFlight() uint32 {
+	if x != nil {
+		return x.NumInFlight
+	}
+	return 0
+}
+
+func (x *Airdrop) GetNumSent() uint32 {
+	if x != nil {
+		return x.NumSent
+	}
+	return 0
+}
+
+func (x *Airdrop) GetNumFailed() uint32 {
+	if x != nil {
+		return x.NumFailed
+	}
+	return 0
+}
+
+func (x *Airdrop) GetRecipients() []*AirdropRecipient {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+type StartAirdropResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The started airdrop. If only the manifest was validated, the ID of the
+	// airdrop is zero.
+	Airdrop *Airdrop `protobuf:"bytes,1,opt,name=airdrop,proto3" json:"airdrop,omitempty"`
+	// The batches the pending recipients are sent in.
+	Batches []*AirdropBatch `protobuf:"bytes,2,rep,name=batches,proto3" json:"batches,omitempty"`
+}
+
+func (x *StartAirdropResponse) Reset() {
+	*x = StartAirdropResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartAirdropResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartAirdropResponse) ProtoMessage() {}
+
+func (x *StartAirdropResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartAirdropResponse.ProtoReflect.Descriptor instead.
+func (*StartAirdropResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *StartAirdropResponse) GetAirdrop() *Airdrop {
+	if x != nil {
+		return x.Airdrop
+	}
+	return nil
+}
+
+func (x *StartAirdropResponse) GetBatches() []*AirdropBatch {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
+}
+
+type ResumeAirdropRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the airdrop to resume.
+	AirdropId int64 `protobuf:"varint,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+}
+
+func (x *ResumeAirdropRequest) Reset() {
+	*x = ResumeAirdropRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeAirdropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeAirdropRequest) ProtoMessage() {}
+
+func (x *ResumeAirdropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeAirdropRequest.ProtoReflect.Descriptor instead.
+func (*ResumeAirdropRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *ResumeAirdropRequest) GetAirdropId() int64 {
+	if x != nil {
+		return x.AirdropId
+	}
+	return 0
+}
+
+type ResumeAirdropResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The airdrop as it was before it was resumed.
+	Airdrop *Airdrop `protobuf:"bytes,1,opt,name=airdrop,proto3" json:"airdrop,omitempty"`
+	// The batches the pending and failed recipients are sent in.
+	Batches []*AirdropBatch `protobuf:"bytes,2,rep,name=batches,proto3" json:"batches,omitempty"`
+}
+
+func (x *ResumeAirdropResponse) Reset() {
+	*x = ResumeAirdropResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeAirdropResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeAirdropResponse) ProtoMessage() {}
+
+func (x *ResumeAirdropResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeAirdropResponse.ProtoReflect.Descriptor instead.
+func (*ResumeAirdropResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *ResumeAirdropResponse) GetAirdrop() *Airdrop {
+	if x != nil {
+		return x.Airdrop
+	}
+	return nil
+}
+
+func (x *ResumeAirdropResponse) GetBatches() []*AirdropBatch {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
+}
+
+type ListAirdropsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, only the airdrop with the given ID is returned.
+	AirdropId int64 `protobuf:"varint,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	// If set, the recipients of the airdrops are included.
+	IncludeRecipients bool `protobuf:"varint,2,opt,name=include_recipients,json=includeRecipients,proto3" json:"include_recipients,omitempty"`
+}
+
+func (x *ListAirdropsRequest) Reset() {
+	*x = ListAirdropsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAirdropsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAirdropsRequest) ProtoMessage() {}
+
+func (x *ListAirdropsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAirdropsRequest.ProtoReflect.Descriptor instead.
+func (*ListAirdropsRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *ListAirdropsRequest) GetAirdropId() int64 {
+	if x != nil {
+		return x.AirdropId
+	}
+	return 0
+}
+
+func (x *ListAirdropsRequest) GetIncludeRecipients() bool {
+	if x != nil {
+		return x.IncludeRecipients
+	}
+	return false
+}
+
+type ListAirdropsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The airdrops ordered by their ID.
+	Airdrops []*Airdrop `protobuf:"bytes,1,rep,name=airdrops,proto3" json:"airdrops,omitempty"`
+}
+
+func (x *ListAirdropsResponse) Reset() {
+	*x = ListAirdropsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAirdropsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAirdropsResponse) ProtoMessage() {}
+
+func (x *ListAirdropsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAirdropsResponse.ProtoReflect.Descriptor instead.
+func (*ListAirdropsResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *ListAirdropsResponse) GetAirdrops() []*Airdrop {
+	if x != nil {
+		return x.Airdrops
+	}
+	return nil
+}
+
+type TemplateRecipient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The Taproot Asset address the recipient is paid to. The recipient always
+	// receives the amount of the address, so the keys, amount and share must be
+	// unset.
+	TapAddr string `protobuf:"bytes,1,opt,name=tap_addr,json=tapAddr,proto3" json:"tap_addr,omitempty"`
+	// The script key the recipient is paid to if no address is set, as a 33-byte
+	// compressed public key.
+	ScriptKey []byte `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The internal key of the anchor output the recipient is paid to if no
+	// address is set, as a 33-byte compressed public key.
+	InternalKey []byte `protobuf:"bytes,3,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+	// The fixed amount the recipient receives. Exclusive with share_bps.
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The share of the total amount of an execution the recipient receives, in
+	// basis points. The shares of all recipients must add up to 10000.
+	// Exclusive with amount.
+	ShareBps uint32 `protobuf:"varint,5,opt,name=share_bps,json=shareBps,proto3" json:"share_bps,omitempty"`
+}
+
+func (x *TemplateRecipient) Reset() {
+	*x = TemplateRecipient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateRecipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateRecipient) ProtoMessage() {}
+
+func (x *TemplateRecipient) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateRecipient.ProtoReflect.Descriptor instead.
+func (*TemplateRecipient) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *TemplateRecipient) GetTapAddr() string {
+	if x != nil {
+		return x.TapAddr
+	}
+	return ""
+}
+
+func (x *TemplateRecipient) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *TemplateRecipient) GetInternalKey() []byte {
+	if x != nil {
+		return x.InternalKey
+	}
+	return nil
+}
+
+func (x *TemplateRecipient) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TemplateRecipient) GetShareBps() uint32 {
+	if x != nil {
+		return x.ShareBps
+	}
+	return 0
+}
+
+type TemplateFeePolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The priority class the transfers of the template are sent with.
+	Priority ParcelPriority `protobuf:"varint,1,opt,name=priority,proto3,enum=taprpc.ParcelPriority" json:"priority,omitempty"`
+	// The number of seconds after the execution of the template the anchor
+	// transaction needs to be confirmed by. Until then, its fee is bumped. If
+	// zero, no deadline is set.
+	ConfTargetSeconds uint64 `protobuf:"varint,2,opt,name=conf_target_seconds,json=confTargetSeconds,proto3" json:"conf_target_seconds,omitempty"`
+	// The interval in seconds between two fee bumps. If zero, a default of 20
+	// minutes is used.
+	FeeBumpIntervalSeconds uint32 `protobuf:"varint,3,opt,name=fee_bump_interval_seconds,json=feeBumpIntervalSeconds,proto3" json:"fee_bump_interval_seconds,omitempty"`
+	// The percentage the fee rate is raised by with each bump. If zero, a
+	// default of 25 percent is used.
+	FeeBumpPercent uint32 `protobuf:"varint,4,opt,name=fee_bump_percent,json=feeBumpPercent,proto3" json:"fee_bump_percent,omitempty"`
+	// The maximum fee rate in sat/vB a fee bump may use. If zero, the fee rate
+	// isn't capped.
+	MaxFeeRateSatPerVbyte uint64 `protobuf:"varint,5,opt,name=max_fee_rate_sat_per_vbyte,json=maxFeeRateSatPerVbyte,proto3" json:"max_fee_rate_sat_per_vbyte,omitempty"`
+}
+
+func (x *TemplateFeePolicy) Reset() {
+	*x = TemplateFeePolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateFeePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateFeePolicy) ProtoMessage() {}
+
+func (x *TemplateFeePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateFeePolicy.ProtoReflect.Descriptor instead.
+func (*TemplateFeePolicy) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *TemplateFeePolicy) GetPriority() ParcelPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return ParcelPriority_PARCEL_PRIORITY_NORMAL
+}
+
+func (x *TemplateFeePolicy) GetConfTargetSeconds() uint64 {
+	if x != nil {
+		return x.ConfTargetSeconds
+	}
+	return 0
+}
+
+func (x *TemplateFeePolicy) GetFeeBumpIntervalSeconds() uint32 {
+	if x != nil {
+		return x.FeeBumpIntervalSeconds
+	}
+	return 0
+}
+
+func (x *TemplateFeePolicy) GetFeeBumpPercent() uint32 {
+	if x != nil {
+		return x.FeeBumpPercent
+	}
+	return 0
+}
+
+func (x *TemplateFeePolicy) GetMaxFeeRateSatPerVbyte() uint64 {
+	if x != nil {
+		return x.MaxFeeRateSatPerVbyte
+	}
+	return 0
+}
+
+type TransferTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique name of the template.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The ID of the asset the recipients are paid in.
+	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The recipients of the template, in template order.
+	Recipients []*TemplateRecipient `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	// The fee policy the transfers of the template are sent with.
+	FeePolicy *TemplateFeePolicy `protobuf:"bytes,4,opt,name=fee_policy,json=feePolicy,proto3" json:"fee_policy,omitempty"`
+	// The time the template was created (unix timestamp in seconds).
+	CreatedAt int64 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *TransferTemplate) Reset() {
+	*x = TransferTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferTemplate) ProtoMessage() {}
+
+func (x *TransferTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferTemplate.ProtoReflect.Descriptor instead.
+func (*TransferTemplate) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *TransferTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransferTemplate) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *TransferTemplate) GetRecipients() []*TemplateRecipient {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *TransferTemplate) GetFeePolicy() *TemplateFeePolicy {
+	if x != nil {
+		return x.FeePolicy
+	}
+	return nil
+}
+
+func (x *TransferTemplate) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreateTransferTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The template to create. The name must not be used by another template.
+	Template *TransferTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *CreateTransferTemplateRequest) Reset() {
+	*x = CreateTransferTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTransferTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransferTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTransferTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransferTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTransferTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *CreateTransferTemplateRequest) GetTemplate() *TransferTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type CreateTransferTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The stored template.
+	Template *TransferTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *CreateTransferTemplateResponse) Reset() {
+	*x = CreateTransferTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTransferTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransferTemplateResponse) ProtoMessage() {}
+
+func (x *CreateTransferTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransferTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateTransferTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *CreateTransferTemplateResponse) GetTemplate() *TransferTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type ListTransferTemplatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTransferTemplatesRequest) Reset() {
+	*x = ListTransferTemplatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTransferTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransferTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTransferTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransferTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTransferTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{182}
+}
+
+type ListTransferTemplatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The templates ordered by their name.
+	Templates []*TransferTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+}
+
+func (x *ListTransferTemplatesResponse) Reset() {
+	*x = ListTransferTemplatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTransferTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransferTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTransferTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransferTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTransferTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *ListTransferTemplatesResponse) GetTemplates() []*TransferTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type DeleteTransferTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the template to delete.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteTransferTemplateRequest) Reset() {
+	*x = DeleteTransferTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTransferTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransferTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTransferTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransferTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTransferTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *DeleteTransferTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteTransferTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteTransferTemplateResponse) Reset() {
+	*x = DeleteTransferTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTransferTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransferTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteTransferTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransferTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTransferTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{185}
+}
+
+type ExecuteTransferTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the template to execute.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The total amount that is split among the recipients with a share. Must
+	// be zero if the template only has recipients with a fixed amount.
+	TotalAmount uint64 `protobuf:"varint,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+}
+
+func (x *ExecuteTransferTemplateRequest) Reset() {
+	*x = ExecuteTransferTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecuteTransferTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteTransferTemplateRequest) ProtoMessage() {}
+
+func (x *ExecuteTransferTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteTransferTemplateRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteTransferTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *ExecuteTransferTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExecuteTransferTemplateRequest) GetTotalAmount() uint64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+type GetInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetInfoRequest) Reset() {
+	*x = GetInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoRequest) ProtoMessage() {}
+
+func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetInfoRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{187}
+}
+
+type GetInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version    string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	LndVersion string `protobuf:"bytes,2,opt,name=lnd_version,json=lndVersion,proto3" json:"lnd_version,omitempty"`
+	Network    string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+}
+
+func (x *GetInfoResponse) Reset() {
+	*x = GetInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoResponse) ProtoMessage() {}
+
+func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetInfoResponse) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *GetInfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetLndVersion() string {
+	if x != nil {
+		return x.LndVersion
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+type SubscribeSendAssetEventNtfnsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribeSendAssetEventNtfnsRequest) Reset() {
+	*x = SubscribeSendAssetEventNtfnsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeSendAssetEventNtfnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeSendAssetEventNtfnsRequest) ProtoMessage() {}
+
+func (x *SubscribeSendAssetEventNtfnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeSendAssetEventNtfnsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeSendAssetEventNtfnsRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{189}
+}
+
+type SendAssetEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//
+	//	*SendAssetEvent_ExecuteSendStateEvent
+	//	*SendAssetEvent_ReceiverProofBackoffWaitEvent
+	//	*SendAssetEvent_ProofDeliveryAttemptEvent
+	//	*SendAssetEvent_BackendBreakerEvent
+	//	*SendAssetEvent_ConfDeadlineExceededEvent
+	//	*SendAssetEvent_TransferCounterpartyEvent
+	Event isSendAssetEvent_Event `protobuf_oneof:"event"`
+	// The number of events that were dropped right before this event because the
+	// subscriber didn't keep up with the daemon. If this is non-zero, the event
+	// history of the stream is incomplete.
+	NumDroppedEvents uint64 `protobuf:"varint,7,opt,name=num_dropped_events,json=numDroppedEvents,proto3" json:"num_dropped_events,omitempty"`
+}
+
+func (x *SendAssetEvent) Reset() {
+	*x = SendAssetEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendAssetEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAssetEvent) ProtoMessage() {}
+
+func (x *SendAssetEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAssetEvent.ProtoReflect.Descriptor instead.
+func (*SendAssetEvent) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{190}
+}
+
+func (m *SendAssetEvent) GetEvent() isSendAssetEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *SendAssetEvent) GetExecuteSendStateEvent() *ExecuteSendStateEvent {
+	if x, ok := x.GetEvent().(*SendAssetEvent_ExecuteSendStateEvent); ok {
+		return x.ExecuteSendStateEvent
+	}
+	return nil
+}
+
+func (x *SendAssetEvent) GetReceiverProofBackoffWaitEvent() *ReceiverProofBackoffWaitEvent {
+	if x, ok := x.GetEvent().(*SendAssetEvent_ReceiverProofBackoffWaitEvent); ok {
+		return x.ReceiverProofBackoffWaitEvent
+	}
+	return nil
+}
+
+func (x *SendAssetEvent) GetProofDeliveryAttemptEvent() *ProofDeliveryAttemptEvent {
+	if x, ok := x.GetEvent().(*SendAssetEvent_ProofDeliveryAttemptEvent); ok {
+		return x.ProofDeliveryAttemptEvent
+	}
+	return nil
+}
+
+func (x *SendAssetEvent) GetBackendBreakerEvent() *BackendBreakerEvent {
+	if x, ok := x.GetEvent().(*SendAssetEvent_BackendBreakerEvent); ok {
+		return x.BackendBreakerEvent
+	}
+	return nil
+}
+
+func (x *SendAssetEvent) GetConfDeadlineExceededEvent() *ConfDeadlineExceededEvent {
+	if x, ok := x.GetEvent().(*SendAssetEvent_ConfDeadlineExceededEvent); ok {
+		return x.ConfDeadlineExceededEvent
+	}
+	return nil
+}
+
+func (x *SendAssetEvent) GetTransferCounterpartyEvent() *TransferCounterpartyEvent {
+	if x, ok := x.GetEvent().(*SendAssetEvent_TransferCounterpartyEvent); ok {
+		return x.TransferCounterpartyEvent
+	}
+	return nil
+}
+
+func (x *SendAssetEvent) GetNumDroppedEvents() uint64 {
+	if x != nil {
+		return x.NumDroppedEvents
+	}
+	return 0
+}
+
+type isSendAssetEvent_Event interface {
+	isSendAssetEvent_Event()
+}
+
+type SendAssetEvent_ExecuteSendStateEvent struct {
+	// An event which indicates that a send state is about to be executed.
+	ExecuteSendStateEvent *ExecuteSendStateEvent `protobuf:"bytes,1,opt,name=execute_send_state_event,json=executeSendStateEvent,proto3,oneof"`
+}
+
+type SendAssetEvent_ReceiverProofBackoffWaitEvent struct {
+	// An event which indicates that the proof send backoff wait period will
+	// start imminently.
+	ReceiverProofBackoffWaitEvent *ReceiverProofBackoffWaitEvent `protobuf:"bytes,2,opt,name=receiver_proof_backoff_wait_event,json=receiverProofBackoffWaitEvent,proto3,oneof"`
+}
+
+type SendAssetEvent_ProofDeliveryAttemptEvent struct {
+	// An event which indicates that an attempt to deliver a proof to the
+	// receiver finished.
+	ProofDeliveryAttemptEvent *ProofDeliveryAttemptEvent `protobuf:"bytes,3,opt,name=proof_delivery_attempt_event,json=proofDeliveryAttemptEvent,proto3,oneof"`
+}
+
+type SendAssetEvent_BackendBreakerEvent struct {
+	// An event which indicates that the start of new transfers was paused
+	// or resumed because the chain backend became unavailable or
+	// recovered.
+	BackendBreakerEvent *BackendBreakerEvent `protobuf:"bytes,4,opt,name=backend_breaker_event,json=backendBreakerEvent,proto3,oneof"`
+}
+
+type SendAssetEvent_ConfDeadlineExceededEvent struct {
+	// An event which indicates that the anchor transaction of a transfer
+	// wasn't confirmed by the transfer's confirmation deadline.
+	ConfDeadlineExceededEvent *ConfDeadlineExceededEvent `protobuf:"bytes,5,opt,name=conf_deadline_exceeded_event,json=confDeadlineExceededEvent,proto3,oneof"`
+}
+
+type SendAssetEvent_TransferCounterpartyEvent struct {
+	// An event which reports the counterparty an output of a transfer is
+	// sent to.
+	TransferCounterpartyEvent *TransferCounterpartyEvent `protobuf:"bytes,6,opt,name=transfer_counterparty_event,json=transferCounterpartyEvent,proto3,oneof"`
+}
+
+func (*SendAssetEvent_ExecuteSendStateEvent) isSendAssetEvent_Event() {}
+
+func (*SendAssetEvent_ReceiverProofBackoffWaitEvent) isSendAssetEvent_Event() {}
+
+func (*SendAssetEvent_ProofDeliveryAttemptEvent) isSendAssetEvent_Event() {}
+
+func (*SendAssetEvent_BackendBreakerEvent) isSendAssetEvent_Event() {}
+
+func (*SendAssetEvent_ConfDeadlineExceededEvent) isSendAssetEvent_Event() {}
+
+func (*SendAssetEvent_TransferCounterpartyEvent) isSendAssetEvent_Event() {}
+
+type ExecuteSendStateEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Execute timestamp (microseconds).
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The send state that is about to be executed.
+	SendState string `protobuf:"bytes,2,opt,name=send_state,json=sendState,proto3" json:"send_state,omitempty"`
+	// The sequence number of the event. Sequence numbers are strictly
+	// increasing across the states of all parcels, so events can be ordered
+	// reliably even if their timestamps are equal. They are reset when the
+	// daemon restarts.
+	SequenceNum uint64 `protobuf:"varint,3,opt,name=sequence_num,json=sequenceNum,proto3" json:"sequence_num,omitempty"`
+	// The identifier of the parcel whose state is about to be executed. It is
+	// unique until the daemon restarts.
+	ParcelId uint64 `protobuf:"varint,4,opt,name=parcel_id,json=parcelId,proto3" json:"parcel_id,omitempty"`
+}
+
+func (x *ExecuteSendStateEvent) Reset() {
+	*x = ExecuteSendStateEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecuteSendStateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteSendStateEvent) ProtoMessage() {}
+
+func (x *ExecuteSendStateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteSendStateEvent.ProtoReflect.Descriptor instead.
+func (*ExecuteSendStateEvent) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *ExecuteSendStateEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ExecuteSendStateEvent) GetSendState() string {
+	if x != nil {
+		return x.SendState
+	}
+	return ""
+}
+
+func (x *ExecuteSendStateEvent) GetSequenceNum() uint64 {
+	if x != nil {
+		return x.SequenceNum
+	}
+	return 0
+}
+
+func (x *ExecuteSendStateEvent) GetParcelId() uint64 {
+	if x != nil {
+		return x.ParcelId
+	}
+	return 0
+}
+
+type ReceiverProofBackoffWaitEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Transfer attempt timestamp (microseconds).
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Backoff is the active backoff wait duration.
+	Backoff int64 `protobuf:"varint,2,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	// Tries counter is the number of tries we've made so far during the
+	// course of the current backoff procedure to deliver the proof to the
+	// receiver.
+	TriesCounter int64 `protobuf:"varint,3,opt,name=tries_counter,json=triesCounter,proto3" json:"tries_counter,omitempty"`
+}
+
+func (x *ReceiverProofBackoffWaitEvent) Reset() {
+	*x = ReceiverProofBackoffWaitEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[192]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiverProofBackoffWaitEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiverProofBackoffWaitEvent) ProtoMessage() {}
+
+func (x *ReceiverProofBackoffWaitEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[192]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiverProofBackoffWaitEvent.ProtoReflect.Descriptor instead.
+func (*ReceiverProofBackoffWaitEvent) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *ReceiverProofBackoffWaitEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ReceiverProofBackoffWaitEvent) GetBackoff() int64 {
+	if x != nil {
+		return x.Backoff
+	}
+	return 0
+}
+
+func (x *ReceiverProofBackoffWaitEvent) GetTriesCounter() int64 {
+	if x != nil {
+		return x.TriesCounter
+	}
+	return 0
+}
+
+type ProofDeliveryAttemptEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Event timestamp (microseconds).
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The outcome of the delivery attempt.
+	Attempt *ProofDeliveryAttempt `protobuf:"bytes,2,opt,name=attempt,proto3" json:"attempt,omitempty"`
+}
+
+func (x *ProofDeliveryAttemptEvent) Reset() {
+	*x = ProofDeliveryAttemptEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[193]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofDeliveryAttemptEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofDeliveryAttemptEvent) ProtoMessage() {}
+
+func (x *ProofDeliveryAttemptEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[193]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofDeliveryAttemptEvent.ProtoReflect.Descriptor instead.
+func (*ProofDeliveryAttemptEvent) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *ProofDeliveryAttemptEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ProofDeliveryAttemptEvent) GetAttempt() *ProofDeliveryAttempt {
+	if x != nil {
+		return x.Attempt
+	}
+	return nil
+}
+
+type BackendBreakerEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Event timestamp (microseconds).
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// True if the chain backend became unavailable and the start of new
+	// transfers is paused, false if it recovered and transfers are resumed.
+	Tripped bool `protobuf:"varint,2,opt,name=tripped,proto3" json:"tripped,omitempty"`
+	// The error of the most recent failed chain backend or wallet call.
+	LastError string `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (x *BackendBreakerEvent) Reset() {
+	*x = BackendBreakerEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[194]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendBreakerEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendBreakerEvent) ProtoMessage() {}
+
+func (x *BackendBreakerEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[194]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendBreakerEvent.ProtoReflect.Descriptor instead.
+func (*BackendBreakerEvent) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *BackendBreakerEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *BackendBreakerEvent) GetTripped() bool {
+	if x != nil {
+		return x.Tripped
+	}
+	return false
+}
+
+func (x *BackendBreakerEvent) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type ConfDeadlineExceededEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Event timestamp (microseconds).
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The identifier of the transfer that missed its deadline.
+	ParcelId uint64 `protobuf:"varint,2,opt,name=parcel_id,json=parcelId,proto3" json:"parcel_id,omitempty"`
+	// The hash of the unconfirmed anchor transaction.
+	AnchorTxid string `protobuf:"bytes,3,opt,name=anchor_txid,json=anchorTxid,proto3" json:"anchor_txid,omitempty"`
+	// The missed confirmation deadline (unix timestamp in seconds).
+	DeadlineUnixSeconds int64 `protobuf:"varint,4,opt,name=deadline_unix_seconds,json=deadlineUnixSeconds,proto3" json:"deadline_unix_seconds,omitempty"`
+	// The fee rate in sat/vB of the most recent fee bump.
+	FeeRateSatPerVbyte uint64 `protobuf:"varint,5,opt,name=fee_rate_sat_per_vbyte,json=feeRateSatPerVbyte,proto3" json:"fee_rate_sat_per_vbyte,omitempty"`
+}
+
+func (x *ConfDeadlineExceededEvent) Reset() {
+	*x = ConfDeadlineExceededEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[195]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfDeadlineExceededEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfDeadlineExceededEvent) ProtoMessage() {}
+
+func (x *ConfDeadlineExceededEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[195]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfDeadlineExceededEvent.ProtoReflect.Descriptor instead.
+func (*ConfDeadlineExceededEvent) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *ConfDeadlineExceededEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ConfDeadlineExceededEvent) GetParcelId() uint64 {
+	if x != nil {
+		return x.ParcelId
+	}
+	return 0
+}
+
+func (x *ConfDeadlineExceededEvent) GetAnchorTxid() string {
+	if x != nil {
+		return x.AnchorTxid
+	}
+	return ""
+}
+
+func (x *ConfDeadlineExceededEvent) GetDeadlineUnixSeconds() int64 {
+	if x != nil {
+		return x.DeadlineUnixSeconds
+	}
+	return 0
+}
+
+func (x *ConfDeadlineExceededEvent) GetFeeRateSatPerVbyte() uint64 {
+	if x != nil {
+		return x.FeeRateSatPerVbyte
+	}
+	return 0
+}
+
+type TransferCounterpartyEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Event timestamp (microseconds).
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The identifier of the transfer the output belongs to.
+	ParcelId uint64 `protobuf:"varint,2,opt,name=parcel_id,json=parcelId,proto3" json:"parcel_id,omitempty"`
+	// The index of the output in the virtual transaction.
+	OutputIndex uint32 `protobuf:"varint,3,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	// The script key the output is sent to.
+	ScriptKey []byte `protobuf:"bytes,4,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The name of the counterparty, empty if the script key isn't in the
+	// counterparty book.
+	CounterpartyName string `protobuf:"bytes,5,opt,name=counterparty_name,json=counterpartyName,proto3" json:"counterparty_name,omitempty"`
+	// Whether the script key is in the counterparty book.
+	Known bool `protobuf:"varint,6,opt,name=known,proto3" json:"known,omitempty"`
+}
+
+func (x *TransferCounterpartyEvent) Reset() {
+	*x = TransferCounterpartyEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[196]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferCounterpartyEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferCounterpartyEvent) ProtoMessage() {}
+
+func (x *TransferCounterpartyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[196]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferCounterpartyEvent.ProtoReflect.Descriptor instead.
+func (*TransferCounterpartyEvent) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *TransferCounterpartyEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *TransferCounterpartyEvent) GetParcelId() uint64 {
+	if x != nil {
+		return x.ParcelId
+	}
+	return 0
+}
+
+func (x *TransferCounterpartyEvent) GetOutputIndex() uint32 {
+	if x != nil {
+		return x.OutputIndex
+	}
+	return 0
+}
+
+func (x *TransferCounterpartyEvent) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *TransferCounterpartyEvent) GetCounterpartyName() string {
+	if x != nil {
+		return x.CounterpartyName
+	}
+	return ""
+}
+
+func (x *TransferCounterpartyEvent) GetKnown() bool {
+	if x != nil {
+		return x.Known
+	}
+	return false
+}
+
+type FetchAssetMetaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Asset:
+	//
+	//	*FetchAssetMetaRequest_AssetId
+	//	*FetchAssetMetaRequest_MetaHash
+	//	*FetchAssetMetaRequest_AssetIdStr
+	//	*FetchAssetMetaRequest_MetaHashStr
+	Asset isFetchAssetMetaRequest_Asset `protobuf_oneof:"asset"`
+	// If set and the meta is of type META_TYPE_URI, the referenced content is
+	// fetched, verified against the committed hash and returned as well.
+	ResolveUri bool `protobuf:"varint,5,opt,name=resolve_uri,json=resolveUri,proto3" json:"resolve_uri,omitempty"`
+}
+
+func (x *FetchAssetMetaRequest) Reset() {
+	*x = FetchAssetMetaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taprootassets_proto_msgTypes[197]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchAssetMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchAssetMetaRequest) ProtoMessage() {}
+
+func (x *FetchAssetMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taprootassets_proto_msgTypes[197]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchAssetMetaRequest.ProtoReflect.Descriptor instead.
+func (*FetchAssetMetaRequest) Descriptor() ([]byte, []int) {
+	return file_taprootassets_proto_rawDescGZIP(), []int{197}
+}
+
+func (m *FetchAssetMetaRequest) GetAsset() isFetchAssetMetaRequest_Asset {
+	if m != nil {
+		return m.Asset
+	}
+	return nil
+}
+
+func (x *FetchAssetMetaRequest) GetAssetId() []byte {
+	if x, ok := x.GetAsset().(*FetchAssetMetaRequest_AssetId); ok {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *FetchAssetMetaRequest) GetMetaHash() []byte {
+	if x, ok := x.GetAsset().(*FetchAssetMetaRequest_MetaHash); ok {
+		return x.MetaHash
+	}
+	return nil
+}
+
+func (x *FetchAssetMetaRequest) GetAssetIdStr() string {
+	if x, ok := x.GetAsset().(*FetchAssetMetaRequest_AssetIdStr); ok {
+		return x.AssetIdStr
+	}
+	return ""
+}
+
+func (x *FetchAssetMetaRequest) GetMetaHashStr() string {
+	if x, ok := x.GetAsset().(*FetchAssetMetaRequest_MetaHashStr); ok {
+		return x.MetaHashStr
+	}
+	return ""
+}
+
+func (x *FetchAssetMetaRequest) GetResolveUri() bool {
+	if x != nil {
+		return x.ResolveUri
+	}
+	return false
+}
+
+type isFetchAssetMetaRequest_Asset interface {
+	isFetchAssetMetaRequest_Asset()
+}
+
+type FetchAssetMetaRequest_AssetId struct {
+	// The asset ID of the asset to fetch the meta for.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3,oneof"`
+}
+
+type FetchAssetMetaRequest_MetaHash struct {
+	// The 32-byte meta hash of the asset meta.
+	MetaHash []byte `protobuf:"bytes,2,opt,name=meta_hash,json=metaHash,proto3,oneof"`
+}
+
+type FetchAssetMetaRequest_AssetIdStr struct {
+	// The hex encoded asset ID of the asset to fetch the meta for.
+	AssetIdStr string `protobuf:"bytes,3,opt,name=asset_id_str,json=assetIdStr,proto3,oneof"`
+}
+
+type FetchAssetMetaRequest_MetaHashStr struct {
+	// The hex encoded meta hash of the asset meta.
+	MetaHashStr string `protobuf:"bytes,4,opt,name=meta_hash_str,json=metaHashStr,proto3,oneof"`
+}
+
+func (*FetchAssetMetaRequest_AssetId) isFetchAssetMetaRequest_Asset() {}
+
+func (*FetchAssetMetaRequest_MetaHash) isFetchAssetMetaRequest_Asset() {}
+
+func (*FetchAssetMetaRequest_AssetIdStr) isFetchAssetMetaRequest_Asset() {}
+
+func (*FetchAssetMetaRequest_MetaHashStr) isFetchAssetMetaRequest_Asset() {}
+
+var File_taprootassets_proto protoreflect.FileDescriptor
+
+var file_taprootassets_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x22, 0xa0, 0x01,
+	0x0a, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65,
+	0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x37, 0x0a, 0x0b, 0x75, 0x72, 0x69, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x52, 0x49, 0x4d, 0x65, 0x74, 0x61, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x75, 0x72, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x22, 0x5f, 0x0a, 0x0e, 0x55, 0x52, 0x49, 0x4d, 0x65, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x22, 0x81, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x77,
+	0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x77, 0x69,
+	0x74, 0x68, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x64, 0x22, 0xb3, 0x02, 0x0a, 0x0a, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54,
+	0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x78,
+	0x69, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x27,
+	0x0a, 0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4f,
+	0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x74,
+	0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x53, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x0b,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x67,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7e, 0x0a, 0x0a, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x61, 0x77, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b,
+	0x72, 0x61, 0x77, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x74,
+	0x77, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x53, 0x69, 0x67, 0x22, 0xec, 0x04, 0x0a, 0x05, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
+	0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c,
+	0x0a, 0x12, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x2d, 0x0a, 0x13, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x49, 0x73, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x12, 0x33, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x35, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x3a, 0x0a,
+	0x0e, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50,
+	0x72, 0x65, 0x76, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76,
+	0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f,
+	0x73, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x53,
+	0x70, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0xa1, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x65,
+	0x76, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x76,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x52, 0x06, 0x70, 0x72, 0x65, 0x76, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f,
+	0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x74,
+	0x78, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x42, 0x0a, 0x10, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x73, 0x70, 0x6c,
+	0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x0f,
+	0x53, 0x70, 0x6c, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x2c, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x0a,
+	0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0x39, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x64, 0x22, 0xdc, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64,
+	0x55, 0x74, 0x78, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a,
+	0x12, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x61, 0x70, 0x72, 0x6f,
+	0x6f, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x25, 0x0a, 0x06,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x73, 0x22, 0xbb, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x64, 0x5f, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74,
+	0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x64, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x1a, 0x54, 0x0a, 0x11, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x64, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x48, 0x75, 0x6d, 0x61, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74,
+	0x61, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x43, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65,
+	0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x48, 0x75, 0x6d, 0x61, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x1a, 0x50, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xaa, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62,
+	0x79, 0x22, 0xbe, 0x01, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x67, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x30, 0x0a, 0x0a,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x05, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x22, 0x74, 0x0a, 0x11, 0x41, 0x73, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x28,
+	0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x90, 0x03, 0x0a, 0x14, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x56, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x66, 0x0a, 0x14, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x1a, 0x56, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x60, 0x0a, 0x17, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6c, 0x0a, 0x19, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x22, 0x9b, 0x01, 0x0a, 0x0f, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x74, 0x78, 0x6f,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x75, 0x74,
+	0x78, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x53, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x22, 0x16, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x76, 0x0a,
+	0x10, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74,
+	0x68, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x72,
+	0x63, 0x65, 0x6c, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x4c, 0x0a, 0x18, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x30, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x72, 0x63, 0x65,
+	0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68, 0x52, 0x06, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x53, 0x65,
+	0x74, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x6f, 0x67, 0x67,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x16, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x72, 0x63, 0x65, 0x6c,
+	0x49, 0x64, 0x22, 0xe2, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x72, 0x63, 0x65,
+	0x6c, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xd2, 0x01, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x65, 0x62, 0x75, 0x67, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x64, 0x72, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x67, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x6a, 0x0a, 0x17,
+	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x03, 0x6c, 0x6f, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x4c, 0x6f, 0x74, 0x49, 0x44, 0x52, 0x03, 0x6c, 0x6f, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x0a, 0x18, 0x41, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c,
+	0x69, 0x61, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12,
+	0x30, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c,
+	0x69, 0x61, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x22, 0x70, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x22, 0x41, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41,
+	0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05,
+	0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x2d, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c,
+	0x69, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x18,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x07, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x22, 0x2e, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x51, 0x0a, 0x1e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x3f, 0x0a, 0x1f, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x11, 0x46,
+	0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x41, 0x74, 0x22, 0x7b, 0x0a,
+	0x19, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x1a, 0x46, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x66, 0x72, 0x6f, 0x7a,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x52, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x22, 0x65, 0x0a, 0x1b, 0x55,
+	0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b,
+	0x65, 0x79, 0x22, 0x41, 0x0a, 0x1c, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x75, 0x6e, 0x66, 0x72, 0x6f, 0x7a,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x66,
+	0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x22, 0x1f, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x6f,
+	0x7a, 0x65, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x53, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72,
+	0x6f, 0x7a, 0x65, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x66, 0x72, 0x6f, 0x7a,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x52, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x22, 0xd8, 0x02, 0x0a, 0x0c,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x69, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x54, 0x78, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x70, 0x65, 0x6e,
+	0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4f, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x72,
+	0x61, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x4f, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x0d, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0c, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x22, 0x25, 0x0a, 0x13, 0x55, 0x6e, 0x77, 0x61,
+	0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x16, 0x0a, 0x14, 0x55, 0x6e, 0x77, 0x61, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73,
+	0x70, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x22, 0x58, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x52, 0x0d, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x73, 0x22, 0xf1, 0x01, 0x0a, 0x0b, 0x41, 0x74, 0x52, 0x69, 0x73, 0x6b, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f,
+	0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78,
+	0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74,
+	0x52, 0x69, 0x73, 0x6b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x55, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x52, 0x69, 0x73, 0x6b, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x0e, 0x61, 0x74, 0x5f, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x74, 0x52, 0x69, 0x73, 0x6b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0c, 0x61, 0x74, 0x52, 0x69,
+	0x73, 0x6b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x0d, 0x4b, 0x65, 0x79,
+	0x44, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x07, 0x70, 0x75,
+	0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x4b, 0x65, 0x79, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x52,
+	0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4b,
+	0x65, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x72,
+	0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64,
+	0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x22, 0x7c, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74,
+	0x4b, 0x65, 0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f,
+	0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4b, 0x65, 0x79, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73,
+	0x65, 0x52, 0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65,
+	0x12, 0x24, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x61, 0x77, 0x4b, 0x65, 0x79, 0x22, 0x55, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65,
+	0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8c, 0x01,
+	0x0a, 0x0f, 0x52, 0x65, 0x75, 0x73, 0x65, 0x64, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4b, 0x65,
+	0x79, 0x12, 0x38, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x0b,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x1d, 0x0a, 0x1b,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x75, 0x73, 0x65, 0x64, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x1c, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x75, 0x73, 0x65, 0x64, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4b,
+	0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x72,
+	0x65, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x75, 0x73, 0x65, 0x64,
+	0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x0a, 0x72, 0x65, 0x75, 0x73, 0x65,
+	0x64, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x0e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x75, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x29,
+	0x0a, 0x10, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x10, 0x46, 0x6c,
+	0x61, 0x67, 0x67, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x35, 0x0a,
+	0x08, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b,
+	0x65, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x63, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6e,
+	0x75, 0x6d, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x66, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x41, 0x74, 0x22, 0x18, 0x0a,
+	0x16, 0x41, 0x75, 0x64, 0x69, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x17, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x72, 0x65, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x75, 0x73, 0x65,
+	0x52, 0x0a, 0x72, 0x65, 0x75, 0x73, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x3b, 0x0a, 0x0c,
+	0x66, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x6c, 0x61, 0x67,
+	0x67, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x0b, 0x66, 0x6c,
+	0x61, 0x67, 0x67, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x3f, 0x0a, 0x1e, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x21, 0x0a, 0x1f, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa3, 0x01,
+	0x0a, 0x13, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x44, 0x69, 0x73, 0x63, 0x6c,
+	0x6f, 0x73, 0x75, 0x72, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x30, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x4b, 0x65, 0x79, 0x22, 0x5f, 0x0a, 0x21, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x44, 0x69, 0x73, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x75, 0x64, 0x69, 0x74, 0x6f,
+	0x72, 0x4b, 0x65, 0x79, 0x22, 0x98, 0x01, 0x0a, 0x22, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x44, 0x69, 0x73, 0x63, 0x6c, 0x6f, 0x73, 0x75,
+	0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x64,
+	0x69, 0x73, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x4b, 0x65, 0x79, 0x44, 0x69, 0x73, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x52, 0x0b, 0x64,
+	0x69, 0x73, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x65, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6c, 0x6f, 0x73, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x65, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x44, 0x69, 0x73, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x73, 0x22,
+	0x50, 0x0a, 0x12, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x4b, 0x65, 0x79,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x46, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x78, 0x0a, 0x0f, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0f, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79,
+	0x12, 0x39, 0x0a, 0x0d, 0x72, 0x61, 0x77, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x0b,
+	0x72, 0x61, 0x77, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x22, 0x9a, 0x01, 0x0a, 0x0f,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12,
+	0x38, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x22, 0xc9, 0x02, 0x0a, 0x0f, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0a,
+	0x6b, 0x65, 0x79, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x6b, 0x65,
+	0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x22, 0x1e, 0x0a, 0x1c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x7d, 0x0a, 0x1d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x12, 0x31, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x64, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x64, 0x22, 0x49, 0x0a, 0x1c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x22, 0x88,
+	0x01, 0x0a, 0x1d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x31, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x64, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x14, 0x61, 0x64, 0x64, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x67, 0x0a, 0x20, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x78, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b,
+	0x65, 0x79, 0x22, 0x5d, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x22, 0x9e, 0x02, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x72, 0x69,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x16,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6e, 0x65,
+	0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x22, 0x95, 0x04, 0x0a, 0x0d, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x31, 0x0a, 0x15, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x68, 0x69,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x54, 0x78, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x14,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x66, 0x65, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x54, 0x78, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x2d, 0x0a,
+	0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x07,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x34,
+	0x0a, 0x16, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14,
+	0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x3f, 0x0a, 0x1c, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x62, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x78, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x43, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x6b, 0x65, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x4b, 0x65, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x4b, 0x65, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xd2, 0x01, 0x0a, 0x0d, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x22, 0x0a, 0x03, 0x6c, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x74,
+	0x52, 0x03, 0x6c, 0x6f, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22,
+	0x88, 0x01, 0x0a, 0x08, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a,
+	0x14, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x78, 0x69, 0x64, 0x12,
+	0x29, 0x0a, 0x10, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x0a, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x4c, 0x6f, 0x74, 0x49, 0x44, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x95, 0x02, 0x0a, 0x14,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x41, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x61, 0x70,
+	0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c,
+	0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x61, 0x70, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x69,
+	0x62, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x61, 0x73,
+	0x73, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x10, 0x6e, 0x75, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x73, 0x22, 0xd7, 0x03, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x41, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x52, 0x06, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x13, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x4b, 0x65, 0x79, 0x49, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f,
+	0x62, 0x6c, 0x6f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x33, 0x0a,
+	0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x11, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x72, 0x12, 0x3c, 0x0a, 0x1a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x0d, 0x0a,
+	0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c,
+	0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x11,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x73, 0x68, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x73,
+	0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x53, 0x70, 0x65, 0x63, 0x22, 0x35, 0x0a, 0x12, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75,
+	0x62, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x75, 0x62, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xbf, 0x02, 0x0a, 0x04,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12,
+	0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b,
+	0x65, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74,
+	0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x12,
+	0x2c, 0x0a, 0x12, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x61, 0x70,
+	0x72, 0x6f, 0x6f, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x8c, 0x01,
+	0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x37, 0x0a, 0x11,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x22, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x05,
+	0x61, 0x64, 0x64, 0x72, 0x73, 0x22, 0xa3, 0x02, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65,
+	0x79, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x73,
+	0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x61,
+	0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x4b,
+	0x0a, 0x13, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x56, 0x0a, 0x12, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03,
+	0x61, 0x6d, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x75, 0x6e,
+	0x69, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x78, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0x73, 0x0a, 0x09, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x08, 0x6b, 0x65, 0x79,
+	0x5f, 0x64, 0x65, 0x73, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x44, 0x65, 0x73, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x61, 0x70, 0x5f, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x74, 0x61, 0x70, 0x54, 0x77, 0x65, 0x61, 0x6b, 0x22, 0x48, 0x0a, 0x0a, 0x4b, 0x65, 0x79, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x46,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0x60, 0x0a, 0x0d, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x61, 0x77, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x4b,
+	0x65, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x5f, 0x6c,
+	0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x4b, 0x65, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x06, 0x6b, 0x65,
+	0x79, 0x4c, 0x6f, 0x63, 0x22, 0x27, 0x0a, 0x11, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x22, 0x28, 0x0a,
+	0x12, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x22, 0xbd, 0x02, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x20, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x04, 0x61, 0x64, 0x64,
+	0x72, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x75, 0x72, 0x69,
+	0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x72,
+	0x69, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x63,
+	0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x53,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x75, 0x6e, 0x73, 0x75,
+	0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x75, 0x6e, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x72, 0x5f, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x5f, 0x69, 0x70, 0x66, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x4e, 0x65,
+	0x65, 0x64, 0x73, 0x49, 0x70, 0x66, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x4d, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0xbe, 0x03, 0x0a, 0x0c, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x5f, 0x61, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x41, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x28, 0x0a,
+	0x10, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f,
+	0x66, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x0b,
+	0x6d, 0x65, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c,
+	0x12, 0x26, 0x0a, 0x0f, 0x74, 0x78, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x78, 0x4d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x28, 0x0a, 0x10,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x6f, 0x6f,
+	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x32, 0x0a, 0x15, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x64,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6e, 0x75, 0x6d, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x22, 0x66, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0d, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22,
+	0xb1, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x77, 0x5f, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x61, 0x77, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x61, 0x74, 0x5f,
+	0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x41, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x13, 0x77, 0x69, 0x74,
+	0x68, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x50, 0x72, 0x65, 0x76,
+	0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x69, 0x74,
+	0x68, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x76,
+	0x65, 0x61, 0x6c, 0x22, 0x50, 0x0a, 0x13, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x64, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x4e, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x1a, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x4e, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x54, 0x69, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x22, 0xa0, 0x01, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x28, 0x0a, 0x03, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x54, 0x69, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x03, 0x68, 0x6f, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x6f, 0x6c,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x54, 0x69, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x04, 0x63, 0x6f, 0x6c, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x63, 0x72, 0x75, 0x62, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x53, 0x63, 0x72, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73,
+	0x63, 0x72, 0x75, 0x62, 0x22, 0x8c, 0x01, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a,
+	0x0e, 0x72, 0x61, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46,
+	0x69, 0x6c, 0x65, 0x22, 0x49, 0x0a, 0x18, 0x50, 0x75, 0x73, 0x68, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2d, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x22, 0x1b,
+	0x0a, 0x19, 0x50, 0x75, 0x73, 0x68, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x70, 0x0a, 0x17, 0x50,
+	0x75, 0x6c, 0x6c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x30, 0x0a,
+	0x1a, 0x42, 0x61, 0x6b, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x63, 0x61,
+	0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22,
+	0x39, 0x0a, 0x1b, 0x42, 0x61, 0x6b, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x61,
+	0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x22, 0xfd, 0x01, 0x0a, 0x0f, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x53, 0x63, 0x72, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x20, 0x0a,
+	0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x41, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x4d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x6f, 0x72, 0x72,
+	0x75, 0x70, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6e, 0x75, 0x6d,
+	0x43, 0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d,
+	0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x6e, 0x75, 0x6d, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x92, 0x03, 0x0a, 0x09, 0x41,
+	0x64, 0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x74, 0x78, 0x6f, 0x5f, 0x61, 0x6d, 0x74,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x75, 0x74, 0x78, 0x6f,
+	0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f,
+	0x74, 0x5f, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0e, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x12,
+	0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x61, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x40, 0x0a,
+	0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x0d, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x46, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
+	0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f,
+	0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0xd8, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x78, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4b,
+	0x65, 0x79, 0x22, 0x5c, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x70, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x70, 0x41, 0x64, 0x64, 0x72,
+	0x22, 0x74, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12,
+	0x1b, 0x0a, 0x08, 0x74, 0x61, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x07, 0x74, 0x61, 0x70, 0x41, 0x64, 0x64, 0x72, 0x42, 0x08, 0x0a, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x53, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x38, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x52, 0x0c, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x22, 0x2f, 0x0a, 0x19, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1c, 0x0a, 0x1a,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5a, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x52, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x22, 0x66, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52,
+	0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4a, 0x0a, 0x16, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x57, 0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x4b, 0x65, 0x79, 0x52, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79,
+	0x22, 0x1f, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x60, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x0c, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x73, 0x22, 0xf6, 0x01, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d,
+	0x6f, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x22, 0xad, 0x01, 0x0a,
+	0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x29, 0x0a,
+	0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52,
+	0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x6f, 0x75,
+	0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x7e, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61, 0x6d,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x22, 0x30, 0x0a, 0x14,
+	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x35,
+	0x0a, 0x14, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x22, 0x74, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x3c,
+	0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x41, 0x0a, 0x14,
+	0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x95, 0x06, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x70, 0x41, 0x64, 0x64, 0x72,
+	0x73, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x72,
+	0x63, 0x65, 0x6c, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x4c, 0x0a, 0x14, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52,
+	0x12, 0x63, 0x6f, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x6f, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x74, 0x49, 0x44, 0x52, 0x09, 0x73, 0x70, 0x65,
+	0x6e, 0x64, 0x4c, 0x6f, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x73,
+	0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x4d, 0x61, 0x78, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x1a,
+	0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x75, 0x6e,
+	0x69, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x6e,
+	0x69, 0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x66, 0x65, 0x65,
+	0x5f, 0x62, 0x75, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x66, 0x65,
+	0x65, 0x42, 0x75, 0x6d, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x75, 0x6d, 0x70,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x66, 0x65, 0x65, 0x42, 0x75, 0x6d, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x39,
+	0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73,
+	0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61,
+	0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x62, 0x72, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x62, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x3f, 0x0a, 0x1c, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x40, 0x0a, 0x10, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69,
+	0x6d, 0x65, 0x52, 0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x43, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x65,
+	0x6e, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x65,
+	0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53,
+	0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0xe3, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x61, 0x6c, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x75, 0x6c, 0x66, 0x69,
+	0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x54,
+	0x61, 0x70, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x75, 0x6e, 0x66, 0x75, 0x6c,
+	0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x75, 0x6e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c,
+	0x6c, 0x65, 0x64, 0x54, 0x61, 0x70, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x66,
+	0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x66,
+	0x61, 0x6c, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x66, 0x61, 0x6c, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x22, 0xa4, 0x01,
+	0x0a, 0x0e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x6f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x76, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7e, 0x0a, 0x11,
+	0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f,
+	0x73, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x65, 0x6e, 0x64, 0x52,
+	0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x65, 0x6e, 0x64, 0x22, 0xeb, 0x01, 0x0a,
+	0x11, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x78, 0x69, 0x64,
+	0x12, 0x34, 0x0a, 0x16, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x14, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3f, 0x0a, 0x1c, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x62, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x78,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x59, 0x0a, 0x1e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22, 0x41, 0x0a, 0x1e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x78, 0x69, 0x64, 0x22, 0x21, 0x0a, 0x1f, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x0a, 0x18,
+	0x4f, 0x70, 0x65, 0x6e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x4f,
+	0x70, 0x65, 0x6e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0x0a, 0x22, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x70, 0x41, 0x64, 0x64, 0x72, 0x73, 0x22, 0x58,
+	0x0a, 0x23, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x08,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0x71, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x6c,
+	0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x4e, 0x0a, 0x19, 0x53,
+	0x65, 0x61, 0x6c, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0x3e, 0x0a, 0x19, 0x41,
+	0x62, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x41,
+	0x62, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x13, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x5f, 0x63, 0x73, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x43, 0x73, 0x76, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x74, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x54, 0x78, 0x12, 0x23, 0x0a,
+	0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e,
+	0x6c, 0x79, 0x22, 0x84, 0x02, 0x0a, 0x10, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x0a,
+	0x08, 0x74, 0x61, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x74, 0x61, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x54, 0x78, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x56, 0x0a, 0x0c, 0x41, 0x69, 0x72,
+	0x64, 0x72, 0x6f, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52,
+	0x10, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x73, 0x22, 0xba, 0x02, 0x0a, 0x07, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x50, 0x65, 0x72, 0x54, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e,
+	0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e,
+	0x75, 0x6d, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x75,
+	0x6d, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x75,
+	0x6d, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x71,
+	0x0a, 0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x69, 0x72, 0x64, 0x72, 0x6f,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x52, 0x07, 0x61, 0x69, 0x72, 0x64, 0x72, 0x6f,
+	0x70, 0x12, 0x2e, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x69, 0x72, 0x64,
+	0x72, 0x6f, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x22, 0x35, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41, 0x69, 0x72, 0x64, 0x72,
+	0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x69, 0x72,
+	0x64, 0x72, 0x6f, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61,
+	0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x49, 0x64, 0x22, 0x72, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x69, 0x72, 0x64,
+	0x72, 0x6f, 0x70, 0x52, 0x07, 0x61, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x12, 0x2e, 0x0a, 0x07,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x63, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70,
+	0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x43, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x61, 0x69, 0x72,
+	0x64, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x52, 0x08, 0x61, 0x69,
+	0x72, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x11, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x61, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x61, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x42, 0x70, 0x73, 0x22, 0x97,
+	0x02, 0x0a, 0x11, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x66,
+	0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x66, 0x65, 0x65, 0x5f,
+	0x62, 0x75, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x66, 0x65, 0x65,
+	0x42, 0x75, 0x6d, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x75, 0x6d, 0x70, 0x5f,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66,
+	0x65, 0x65, 0x42, 0x75, 0x6d, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a,
+	0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61,
+	0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74,
+	0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x22, 0xd5, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x55, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x56, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22,
+	0x1e, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x57, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0x33, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x20, 0x0a,
+	0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x57, 0x0a, 0x1e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x66, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6e, 0x64, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6e,
+	0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x22, 0x25, 0x0a, 0x23, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53,
+	0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x74, 0x66,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x98, 0x05, 0x0a, 0x0e, 0x53, 0x65,
+	0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x58, 0x0a, 0x18,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53,
+	0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x15, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x71, 0x0a, 0x21, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
+	0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x57,
+	0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x1d, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
+	0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x64, 0x0a, 0x1c, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x19, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x51, 0x0a, 0x15, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x13, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x64, 0x0a, 0x1c, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78,
+	0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x19, 0x63,
+	0x6f, 0x6e, 0x66, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x63, 0x65, 0x65,
+	0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x63, 0x0a, 0x1b, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a,
+	0x12, 0x6e, 0x75, 0x6d, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6e, 0x75, 0x6d, 0x44, 0x72,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x15, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x53, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x70, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x7c, 0x0a, 0x1d, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x61, 0x63, 0x6b,
+	0x6f, 0x66, 0x66, 0x57, 0x61, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61,
+	0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x63,
+	0x6b, 0x6f, 0x66, 0x66, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22, 0x71, 0x0a, 0x19, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x36, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x22, 0x6c, 0x0a, 0x13,
+	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x74, 0x72, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xdf, 0x01, 0x0a, 0x19, 0x43,
+	0x6f, 0x6e, 0x66, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x63, 0x65, 0x65,
+	0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x63, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x72, 0x63, 0x65,
+	0x6c, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x54, 0x78, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x6e, 0x69,
+	0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x16, 0x66, 0x65, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x22, 0xdb, 0x01, 0x0a,
+	0x19, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x63,
+	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x72,
+	0x63, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x22, 0xc7, 0x01, 0x0a, 0x15, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x73, 0x74, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49,
+	0x64, 0x53, 0x74, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x6d,
+	0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x53, 0x74, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x55, 0x72, 0x69, 0x42, 0x07, 0x0a, 0x05, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x2a, 0x28, 0x0a, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0f, 0x0a,
+	0x0b, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x2a, 0x38,
+	0x0a, 0x0d, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x14, 0x0a, 0x10, 0x4d, 0x45, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x50, 0x41,
+	0x51, 0x55, 0x45, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x45, 0x54, 0x41, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x52, 0x49, 0x10, 0x02, 0x2a, 0x67, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x63,
+	0x65, 0x6c, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41,
+	0x52, 0x43, 0x45, 0x4c, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f,
+	0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41, 0x52, 0x43, 0x45, 0x4c,
+	0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x52, 0x47, 0x45, 0x4e, 0x54,
+	0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x41, 0x52, 0x43, 0x45, 0x4c, 0x5f, 0x50, 0x52, 0x49,
+	0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10,
+	0x02, 0x2a, 0x71, 0x0a, 0x12, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x67, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41, 0x52, 0x43, 0x45,
+	0x4c, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x41, 0x52, 0x43, 0x45, 0x4c, 0x5f, 0x4c, 0x4f,
+	0x47, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x5f,
+	0x43, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x41, 0x52, 0x43, 0x45, 0x4c,
+	0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41,
+	0x47, 0x45, 0x10, 0x02, 0x2a, 0x47, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10,
+	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x10, 0x01, 0x2a, 0xa5, 0x01,
+	0x0a, 0x12, 0x43, 0x6f, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x4c,
+	0x45, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x44, 0x45, 0x46,
+	0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x4f, 0x49, 0x4e, 0x5f, 0x53,
+	0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x4d,
+	0x41, 0x58, 0x5f, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x43,
+	0x4f, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54,
+	0x45, 0x47, 0x59, 0x5f, 0x4f, 0x4c, 0x44, 0x45, 0x53, 0x54, 0x5f, 0x4c, 0x4f, 0x54, 0x10, 0x02,
+	0x12, 0x23, 0x0a, 0x1f, 0x43, 0x4f, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f,
+	0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x4e, 0x45, 0x57, 0x45, 0x53, 0x54, 0x5f,
+	0x4c, 0x4f, 0x54, 0x10, 0x03, 0x2a, 0x4f, 0x0a, 0x10, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c,
+	0x69, 0x61, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x53, 0x53,
+	0x45, 0x54, 0x5f, 0x41, 0x4c, 0x49, 0x41, 0x53, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
+	0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x53, 0x53, 0x45, 0x54,
+	0x5f, 0x41, 0x4c, 0x49, 0x41, 0x53, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x52, 0x45,
+	0x4d, 0x4f, 0x54, 0x45, 0x10, 0x01, 0x2a, 0xcd, 0x01, 0x0a, 0x0a, 0x4b, 0x65, 0x79, 0x50, 0x75,
+	0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x4b, 0x45, 0x59, 0x5f, 0x50, 0x55, 0x52,
+	0x50, 0x4f, 0x53, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x22,
+	0x0a, 0x1e, 0x4b, 0x45, 0x59, 0x5f, 0x50, 0x55, 0x52, 0x50, 0x4f, 0x53, 0x45, 0x5f, 0x42, 0x41,
+	0x54, 0x43, 0x48, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x4b, 0x45, 0x59,
+	0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x4b, 0x45, 0x59, 0x5f, 0x50, 0x55, 0x52, 0x50, 0x4f, 0x53,
+	0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x5f, 0x4b, 0x45,
+	0x59, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x4b, 0x45, 0x59, 0x5f, 0x50, 0x55, 0x52, 0x50, 0x4f,
+	0x53, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x03, 0x12, 0x21,
+	0x0a, 0x1d, 0x4b, 0x45, 0x59, 0x5f, 0x50, 0x55, 0x52, 0x50, 0x4f, 0x53, 0x45, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x10,
+	0x04, 0x12, 0x23, 0x0a, 0x1f, 0x4b, 0x45, 0x59, 0x5f, 0x50, 0x55, 0x52, 0x50, 0x4f, 0x53, 0x45,
+	0x5f, 0x41, 0x4e, 0x43, 0x48, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c,
+	0x5f, 0x4b, 0x45, 0x59, 0x10, 0x05, 0x2a, 0x76, 0x0a, 0x11, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x1b, 0x53,
+	0x43, 0x52, 0x49, 0x50, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49,
+	0x43, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d,
+	0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c,
+	0x49, 0x43, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x53, 0x10, 0x01, 0x12,
+	0x1d, 0x0a, 0x19, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x43, 0x4f,
+	0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x10, 0x02, 0x2a, 0x75,
+	0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x5f,
+	0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x4f, 0x54, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12,
+	0x1c, 0x0a, 0x18, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x50, 0x4f,
+	0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x26, 0x0a,
+	0x22, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x50, 0x4f, 0x4c, 0x49,
+	0x43, 0x59, 0x5f, 0x53, 0x45, 0x50, 0x41, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x4f,
+	0x55, 0x4e, 0x54, 0x10, 0x02, 0x2a, 0x89, 0x01, 0x0a, 0x0a, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
+	0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x50, 0x4c, 0x49,
+	0x54, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x4f, 0x55, 0x54, 0x50,
+	0x55, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x49, 0x56, 0x45, 0x5f,
+	0x41, 0x53, 0x53, 0x45, 0x54, 0x53, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x02, 0x12, 0x22, 0x0a,
+	0x1e, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41, 0x53,
+	0x53, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10,
+	0x03, 0x2a, 0xd0, 0x01, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x2a, 0x0a, 0x26, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x2b, 0x0a, 0x27, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x02, 0x12, 0x24, 0x0a,
+	0x20, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54,
+	0x45, 0x44, 0x10, 0x04, 0x2a, 0x78, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x72, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x44, 0x44,
+	0x52, 0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20,
+	0x0a, 0x1c, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x1c, 0x0a, 0x18, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x10, 0x02, 0x2a, 0x5c,
+	0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x12, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x4f, 0x50, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x60, 0x0a, 0x0f,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x19, 0x0a, 0x15, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f,
+	0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x41,
+	0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f,
+	0x53, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f,
+	0x53, 0x45, 0x4e, 0x44, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x10, 0x02, 0x2a, 0x6a,
+	0x0a, 0x16, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x49, 0x52, 0x44,
+	0x52, 0x4f, 0x50, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x15, 0x0a,
+	0x11, 0x41, 0x49, 0x52, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4c, 0x49, 0x47,
+	0x48, 0x54, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x49, 0x52, 0x44, 0x52, 0x4f, 0x50, 0x5f,
+	0x53, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x49, 0x52, 0x44, 0x52, 0x4f,
+	0x50, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x32, 0x91, 0x2f, 0x0a, 0x0d, 0x54,
+	0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x0a,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x40, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x18, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x43, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
+	0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12,
+	0x1c, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55,
+	0x0a, 0x10, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x72, 0x63,
+	0x65, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x72,
+	0x63, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x63,
+	0x65, 0x6c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x24,
+	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x63, 0x65,
+	0x6c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65,
+	0x74, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x6f, 0x67, 0x67,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x67, 0x12, 0x1e,
+	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61,
+	0x72, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61,
+	0x72, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6a, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x12, 0x46,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x73, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x55, 0x6e, 0x66, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73,
+	0x12, 0x23, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x55,
+	0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x55, 0x6e, 0x77,
+	0x61, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x55, 0x6e, 0x77, 0x61, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x55, 0x6e, 0x77, 0x61, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x52, 0x69, 0x73, 0x6b, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x74, 0x52, 0x69, 0x73, 0x6b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x74, 0x52, 0x69, 0x73, 0x6b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x74, 0x12, 0x1f, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x4c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x4c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d,
+	0x53, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1c, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41,
+	0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1f,
+	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x55, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c,
+	0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x20, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6c,
+	0x69, 0x61, 0x73, 0x12, 0x5b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x44, 0x65,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x61, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x75, 0x73, 0x65, 0x64, 0x41, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x75, 0x73, 0x65, 0x64, 0x41, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x75, 0x73, 0x65,
+	0x64, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x41, 0x75, 0x64, 0x69, 0x74, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1e, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x26, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x67,
+	0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x1a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x44, 0x69, 0x73, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x29, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x44, 0x69, 0x73, 0x63, 0x6c, 0x6f,
+	0x73, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x4b, 0x65, 0x79, 0x44, 0x69, 0x73, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x12, 0x24, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64,
+	0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a,
+	0x0a, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x19, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x73,
+	0x12, 0x18, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x16, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x35, 0x0a, 0x0a, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0c, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x46, 0x0a,
+	0x0b, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x46, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x52, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x1e, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x12,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x21, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x4b, 0x65, 0x79, 0x73, 0x12, 0x25, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a,
+	0x0c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x1a, 0x1b, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1a, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3c, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1a,
+	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x58, 0x0a,
+	0x11, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x50, 0x75, 0x73, 0x68, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x20, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4a, 0x0a, 0x10, 0x50, 0x75, 0x6c, 0x6c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1f, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50,
+	0x75, 0x6c, 0x6c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x5e, 0x0a,
+	0x13, 0x42, 0x61, 0x6b, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x63, 0x61,
+	0x72, 0x6f, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61,
+	0x6b, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x42, 0x61, 0x6b, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x63,
+	0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
+	0x09, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x67, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76,
+	0x0a, 0x1b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x12, 0x2a, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x63,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x6c, 0x41, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x6c, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x6c, 0x41, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5b, 0x0a, 0x12, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x62, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a,
+	0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x12, 0x1b, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x69, 0x72, 0x64,
+	0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x69,
+	0x72, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x67, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x25, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x15, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x67, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x25, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x17, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x16, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x1c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x74,
+	0x66, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4e, 0x74, 0x66, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x0e, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x1d, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x30,
+	0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x6f,
+	0x6f, 0x74, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_taprootassets_proto_rawDescOnce sync.Once
+	file_taprootassets_proto_rawDescData = file_taprootassets_proto_rawDesc
+)
+
+func file_taprootassets_proto_rawDescGZIP() []byte {
+	file_taprootassets_proto_rawDescOnce.Do(func() {
+		file_taprootassets_proto_rawDescData = protoimpl.X.CompressGZIP(file_taprootassets_proto_rawDescData)
+	})
+	return file_taprootassets_proto_rawDescData
+}
+
+var file_taprootassets_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
+var file_taprootassets_proto_msgTypes = make([]protoimpl.MessageInfo, 202)
+var file_taprootassets_proto_goTypes = []interface{}{
+	(AssetType)(0),                              // 0: taprpc.AssetType
+	(AssetMetaType)(0),                          // 1: taprpc.AssetMetaType
+	(ParcelPriority)(0),                         // 2: taprpc.ParcelPriority
+	(ParcelLogEntryType)(0),                     // 3: taprpc.ParcelLogEntryType
+	(StatementFormat)(0),                        // 4: taprpc.StatementFormat
+	(CoinSelectStrategy)(0),                     // 5: taprpc.CoinSelectStrategy
+	(AssetAliasSource)(0),                       // 6: taprpc.AssetAliasSource
+	(KeyPurpose)(0),                             // 7: taprpc.KeyPurpose
+	(ScriptKeyConflict)(0),                      // 8: taprpc.ScriptKeyConflict
+	(ChangeKeyPolicy)(0),                        // 9: taprpc.ChangeKeyPolicy
+	(OutputType)(0),                             // 10: taprpc.OutputType
+	(AddrEventStatus)(0),                        // 11: taprpc.AddrEventStatus
+	(AddrDepositStatus)(0),                      // 12: taprpc.AddrDepositStatus
+	(InvoiceState)(0),                           // 13: taprpc.InvoiceState
+	(PartialSendMode)(0),                        // 14: taprpc.PartialSendMode
+	(AirdropRecipientStatus)(0),                 // 15: taprpc.AirdropRecipientStatus
+	(*AssetMeta)(nil),                           // 16: taprpc.AssetMeta
+	(*URIMetaContent)(nil),                      // 17: taprpc.URIMetaContent
+	(*ListAssetRequest)(nil),                    // 18: taprpc.ListAssetRequest
+	(*AnchorInfo)(nil),                          // 19: taprpc.AnchorInfo
+	(*GenesisInfo)(nil),                         // 20: taprpc.GenesisInfo
+	(*AssetGroup)(nil),                          // 21: taprpc.AssetGroup
+	(*Asset)(nil),                               // 22: taprpc.Asset
+	(*PrevWitness)(nil),                         // 23: taprpc.PrevWitness
+	(*SplitCommitment)(nil),                     // 24: taprpc.SplitCommitment
+	(*ListAssetResponse)(nil),                   // 25: taprpc.ListAssetResponse
+	(*ListUtxosRequest)(nil),                    // 26: taprpc.ListUtxosRequest
+	(*ManagedUtxo)(nil),                         // 27: taprpc.ManagedUtxo
+	(*ListUtxosResponse)(nil),                   // 28: taprpc.ListUtxosResponse
+	(*ListGroupsRequest)(nil),                   // 29: taprpc.ListGroupsRequest
+	(*AssetHumanReadable)(nil),                  // 30: taprpc.AssetHumanReadable
+	(*GroupedAssets)(nil),                       // 31: taprpc.GroupedAssets
+	(*ListGroupsResponse)(nil),                  // 32: taprpc.ListGroupsResponse
+	(*ListBalancesRequest)(nil),                 // 33: taprpc.ListBalancesRequest
+	(*AssetBalance)(nil),                        // 34: taprpc.AssetBalance
+	(*AssetGroupBalance)(nil),                   // 35: taprpc.AssetGroupBalance
+	(*ListBalancesResponse)(nil),                // 36: taprpc.ListBalancesResponse
+	(*ListBalanceHistoryRequest)(nil),           // 37: taprpc.ListBalanceHistoryRequest
+	(*BalanceSnapshot)(nil),                     // 38: taprpc.BalanceSnapshot
+	(*ListBalanceHistoryResponse)(nil),          // 39: taprpc.ListBalanceHistoryResponse
+	(*ListTransfersRequest)(nil),                // 40: taprpc.ListTransfersRequest
+	(*ListTransfersResponse)(nil),               // 41: taprpc.ListTransfersResponse
+	(*ParcelQueueStatsRequest)(nil),             // 42: taprpc.ParcelQueueStatsRequest
+	(*ParcelQueueDepth)(nil),                    // 43: taprpc.ParcelQueueDepth
+	(*ParcelQueueStatsResponse)(nil),            // 44: taprpc.ParcelQueueStatsResponse
+	(*SetParcelDebugLoggingRequest)(nil),        // 45: taprpc.SetParcelDebugLoggingRequest
+	(*SetParcelDebugLoggingResponse)(nil),       // 46: taprpc.SetParcelDebugLoggingResponse
+	(*ExportParcelLogRequest)(nil),              // 47: taprpc.ExportParcelLogRequest
+	(*ParcelLogEntry)(nil),                      // 48: taprpc.ParcelLogEntry
+	(*ExportParcelLogResponse)(nil),             // 49: taprpc.ExportParcelLogResponse
+	(*AnnotateAssetLotRequest)(nil),             // 50: taprpc.AnnotateAssetLotRequest
+	(*AnnotateAssetLotResponse)(nil),            // 51: taprpc.AnnotateAssetLotResponse
+	(*AssetAlias)(nil),                          // 52: taprpc.AssetAlias
+	(*SetAssetAliasRequest)(nil),                // 53: taprpc.SetAssetAliasRequest
+	(*SetAssetAliasResponse)(nil),               // 54: taprpc.SetAssetAliasResponse
+	(*RemoveAssetAliasRequest)(nil),             // 55: taprpc.RemoveAssetAliasRequest
+	(*RemoveAssetAliasResponse)(nil),            // 56: taprpc.RemoveAssetAliasResponse
+	(*ListAssetAliasesRequest)(nil),             // 57: taprpc.ListAssetAliasesRequest
+	(*ListAssetAliasesResponse)(nil),            // 58: taprpc.ListAssetAliasesResponse
+	(*ResolveAssetAliasRequest)(nil),            // 59: taprpc.ResolveAssetAliasRequest
+	(*ExportTransferStatementRequest)(nil),      // 60: taprpc.ExportTransferStatementRequest
+	(*ExportTransferStatementResponse)(nil),     // 61: taprpc.ExportTransferStatementResponse
+	(*FrozenAssetOutput)(nil),                   // 62: taprpc.FrozenAssetOutput
+	(*FreezeAssetOutputsRequest)(nil),           // 63: taprpc.FreezeAssetOutputsRequest
+	(*FreezeAssetOutputsResponse)(nil),          // 64: taprpc.FreezeAssetOutputsResponse
+	(*UnfreezeAssetOutputsRequest)(nil),         // 65: taprpc.UnfreezeAssetOutputsRequest
+	(*UnfreezeAssetOutputsResponse)(nil),        // 66: taprpc.UnfreezeAssetOutputsResponse
+	(*ListFrozenAssetOutputsRequest)(nil),       // 67: taprpc.ListFrozenAssetOutputsRequest
+	(*ListFrozenAssetOutputsResponse)(nil),      // 68: taprpc.ListFrozenAssetOutputsResponse
+	(*WatchedAsset)(nil),                        // 69: taprpc.WatchedAsset
+	(*WatchAssetRequest)(nil),                   // 70: taprpc.WatchAssetRequest
+	(*WatchAssetResponse)(nil),                  // 71: taprpc.WatchAssetResponse
+	(*UnwatchAssetRequest)(nil),                 // 72: taprpc.UnwatchAssetRequest
+	(*UnwatchAssetResponse)(nil),                // 73: taprpc.UnwatchAssetResponse
+	(*ListWatchedAssetsRequest)(nil),            // 74: taprpc.ListWatchedAssetsRequest
+	(*ListWatchedAssetsResponse)(nil),           // 75: taprpc.ListWatchedAssetsResponse
+	(*AtRiskAsset)(nil),                         // 76: taprpc.AtRiskAsset
+	(*ListAtRiskAssetsRequest)(nil),             // 77: taprpc.ListAtRiskAssetsRequest
+	(*ListAtRiskAssetsResponse)(nil),            // 78: taprpc.ListAtRiskAssetsResponse
+	(*KeyDerivation)(nil),                       // 79: taprpc.KeyDerivation
+	(*ListKeyDerivationsRequest)(nil),           // 80: taprpc.ListKeyDerivationsRequest
+	(*ListKeyDerivationsResponse)(nil),          // 81: taprpc.ListKeyDerivationsResponse
+	(*ReusedAnchorKey)(nil),                     // 82: taprpc.ReusedAnchorKey
+	(*ListReusedAnchorKeysRequest)(nil),         // 83: taprpc.ListReusedAnchorKeysRequest
+	(*ListReusedAnchorKeysResponse)(nil),        // 84: taprpc.ListReusedAnchorKeysResponse
+	(*ScriptKeyReuse)(nil),                      // 85: taprpc.ScriptKeyReuse
+	(*FlaggedScriptKey)(nil),                    // 86: taprpc.FlaggedScriptKey
+	(*AuditScriptKeysRequest)(nil),              // 87: taprpc.AuditScriptKeysRequest
+	(*AuditScriptKeysResponse)(nil),             // 88: taprpc.AuditScriptKeysResponse
+	(*ResolveFlaggedScriptKeyRequest)(nil),      // 89: taprpc.ResolveFlaggedScriptKeyRequest
+	(*ResolveFlaggedScriptKeyResponse)(nil),     // 90: taprpc.ResolveFlaggedScriptKeyResponse
+	(*ScriptKeyDisclosure)(nil),                 // 91: taprpc.ScriptKeyDisclosure
+	(*ExportScriptKeyDisclosuresRequest)(nil),   // 92: taprpc.ExportScriptKeyDisclosuresRequest
+	(*ExportScriptKeyDisclosuresResponse)(nil),  // 93: taprpc.ExportScriptKeyDisclosuresResponse
+	(*DescriptorKeyRange)(nil),                  // 94: taprpc.DescriptorKeyRange
+	(*DescriptorGroup)(nil),                     // 95: taprpc.DescriptorGroup
+	(*DescriptorAsset)(nil),                     // 96: taprpc.DescriptorAsset
+	(*AssetDescriptor)(nil),                     // 97: taprpc.AssetDescriptor
+	(*ExportAssetDescriptorRequest)(nil),        // 98: taprpc.ExportAssetDescriptorRequest
+	(*ExportAssetDescriptorResponse)(nil),       // 99: taprpc.ExportAssetDescriptorResponse
+	(*ImportAssetDescriptorRequest)(nil),        // 100: taprpc.ImportAssetDescriptorRequest
+	(*ImportAssetDescriptorResponse)(nil),       // 101: taprpc.ImportAssetDescriptorResponse
+	(*ListProofDeliveryAttemptsRequest)(nil),    // 102: taprpc.ListProofDeliveryAttemptsRequest
+	(*ListProofDeliveryAttemptsResponse)(nil),   // 103: taprpc.ListProofDeliveryAttemptsResponse
+	(*ProofDeliveryAttempt)(nil),                // 104: taprpc.ProofDeliveryAttempt
+	(*AssetTransfer)(nil),                       // 105: taprpc.AssetTransfer
+	(*TransferInput)(nil),                       // 106: taprpc.TransferInput
+	(*AssetLot)(nil),                            // 107: taprpc.AssetLot
+	(*AssetLotID)(nil),                          // 108: taprpc.AssetLotID
+	(*TransferOutputAnchor)(nil),                // 109: taprpc.TransferOutputAnchor
+	(*TransferOutput)(nil),                      // 110: taprpc.TransferOutput
+	(*StopRequest)(nil),                         // 111: taprpc.StopRequest
+	(*StopResponse)(nil),                        // 112: taprpc.StopResponse
+	(*DebugLevelRequest)(nil),                   // 113: taprpc.DebugLevelRequest
+	(*DebugLevelResponse)(nil),                  // 114: taprpc.DebugLevelResponse
+	(*Addr)(nil),                                // 115: taprpc.Addr
+	(*QueryAddrRequest)(nil),                    // 116: taprpc.QueryAddrRequest
+	(*QueryAddrResponse)(nil),                   // 117: taprpc.QueryAddrResponse
+	(*NewAddrRequest)(nil),                      // 118: taprpc.NewAddrRequest
+	(*DepositExpectation)(nil),                  // 119: taprpc.DepositExpectation
+	(*ScriptKey)(nil),                           // 120: taprpc.ScriptKey
+	(*KeyLocator)(nil),                          // 121: taprpc.KeyLocator
+	(*KeyDescriptor)(nil),                       // 122: taprpc.KeyDescriptor
+	(*DecodeAddrRequest)(nil),                   // 123: taprpc.DecodeAddrRequest
+	(*InspectAddrRequest)(nil),                  // 124: taprpc.InspectAddrRequest
+	(*InspectAddrResponse)(nil),                 // 125: taprpc.InspectAddrResponse
+	(*ProofFile)(nil),                           // 126: taprpc.ProofFile
+	(*DecodedProof)(nil),                        // 127: taprpc.DecodedProof
+	(*VerifyProofResponse)(nil),                 // 128: taprpc.VerifyProofResponse
+	(*DecodeProofRequest)(nil),                  // 129: taprpc.DecodeProofRequest
+	(*DecodeProofResponse)(nil),                 // 130: taprpc.DecodeProofResponse
+	(*ExportProofRequest)(nil),                  // 131: taprpc.ExportProofRequest
+	(*ProofArchiveStatsRequest)(nil),            // 132: taprpc.ProofArchiveStatsRequest
+	(*ProofTierStats)(nil),                      // 133: taprpc.ProofTierStats
+	(*ProofArchiveStatsResponse)(nil),           // 134: taprpc.ProofArchiveStatsResponse
+	(*ArchivedProof)(nil),                       // 135: taprpc.ArchivedProof
+	(*PushArchiveProofsRequest)(nil),            // 136: taprpc.PushArchiveProofsRequest
+	(*PushArchiveProofsResponse)(nil),           // 137: taprpc.PushArchiveProofsResponse
+	(*PullArchiveProofRequest)(nil),             // 138: taprpc.PullArchiveProofRequest
+	(*BakeArchiveMacaroonRequest)(nil),          // 139: taprpc.BakeArchiveMacaroonRequest
+	(*BakeArchiveMacaroonResponse)(nil),         // 140: taprpc.BakeArchiveMacaroonResponse
+	(*ProofScrubStats)(nil),                     // 141: taprpc.ProofScrubStats
+	(*AddrEvent)(nil),                           // 142: taprpc.AddrEvent
+	(*ExportReceiptRequest)(nil),                // 143: taprpc.ExportReceiptRequest
+	(*TransferReceipt)(nil),                     // 144: taprpc.TransferReceipt
+	(*Counterparty)(nil),                        // 145: taprpc.Counterparty
+	(*SetCounterpartyRequest)(nil),              // 146: taprpc.SetCounterpartyRequest
+	(*SetCounterpartyResponse)(nil),             // 147: taprpc.SetCounterpartyResponse
+	(*RemoveCounterpartyRequest)(nil),           // 148: taprpc.RemoveCounterpartyRequest
+	(*RemoveCounterpartyResponse)(nil),          // 149: taprpc.RemoveCounterpartyResponse
+	(*ListCounterpartiesRequest)(nil),           // 150: taprpc.ListCounterpartiesRequest
+	(*ListCounterpartiesResponse)(nil),          // 151: taprpc.ListCounterpartiesResponse
+	(*ImportedScriptKey)(nil),                   // 152: taprpc.ImportedScriptKey
+	(*ImportScriptKeyRequest)(nil),              // 153: taprpc.ImportScriptKeyRequest
+	(*ImportScriptKeyResponse)(nil),             // 154: taprpc.ImportScriptKeyResponse
+	(*ListImportedScriptKeysRequest)(nil),       // 155: taprpc.ListImportedScriptKeysRequest
+	(*ListImportedScriptKeysResponse)(nil),      // 156: taprpc.ListImportedScriptKeysResponse
+	(*Invoice)(nil),                             // 157: taprpc.Invoice
+	(*LocalInvoice)(nil),                        // 158: taprpc.LocalInvoice
+	(*CreateInvoiceRequest)(nil),                // 159: taprpc.CreateInvoiceRequest
+	(*DecodeInvoiceRequest)(nil),                // 160: taprpc.DecodeInvoiceRequest
+	(*LookupInvoiceRequest)(nil),                // 161: taprpc.LookupInvoiceRequest
+	(*ListInvoicesRequest)(nil),                 // 162: taprpc.ListInvoicesRequest
+	(*ListInvoicesResponse)(nil),                // 163: taprpc.ListInvoicesResponse
+	(*AddrReceivesRequest)(nil),                 // 164: taprpc.AddrReceivesRequest
+	(*AddrReceivesResponse)(nil),                // 165: taprpc.AddrReceivesResponse
+	(*SendAssetRequest)(nil),                    // 166: taprpc.SendAssetRequest
+	(*PartialSend)(nil),                         // 167: taprpc.PartialSend
+	(*AnchorLockTime)(nil),                      // 168: taprpc.AnchorLockTime
+	(*PrevInputAsset)(nil),                      // 169: taprpc.PrevInputAsset
+	(*SendAssetResponse)(nil),                   // 170: taprpc.SendAssetResponse
+	(*ScheduledTransfer)(nil),                   // 171: taprpc.ScheduledTransfer
+	(*ListScheduledTransfersRequest)(nil),       // 172: taprpc.ListScheduledTransfersRequest
+	(*ListScheduledTransfersResponse)(nil),      // 173: taprpc.ListScheduledTransfersResponse
+	(*CancelScheduledTransferRequest)(nil),      // 174: taprpc.CancelScheduledTransferRequest
+	(*CancelScheduledTransferResponse)(nil),     // 175: taprpc.CancelScheduledTransferResponse
+	(*OpenAnchorSessionRequest)(nil),            // 176: taprpc.OpenAnchorSessionRequest
+	(*OpenAnchorSessionResponse)(nil),           // 177: taprpc.OpenAnchorSessionResponse
+	(*RegisterAnchorSessionParcelRequest)(nil),  // 178: taprpc.RegisterAnchorSessionParcelRequest
+	(*RegisterAnchorSessionParcelResponse)(nil), // 179: taprpc.RegisterAnchorSessionParcelResponse
+	(*SealAnchorSessionRequest)(nil),            // 180: taprpc.SealAnchorSessionRequest
+	(*SealAnchorSessionResponse)(nil),           // 181: taprpc.SealAnchorSessionResponse
+	(*AbortAnchorSessionRequest)(nil),           // 182: taprpc.AbortAnchorSessionRequest
+	(*AbortAnchorSessionResponse)(nil),          // 183: taprpc.AbortAnchorSessionResponse
+	(*StartAirdropRequest)(nil),                 // 184: taprpc.StartAirdropRequest
+	(*AirdropRecipient)(nil),                    // 185: taprpc.AirdropRecipient
+	(*AirdropBatch)(nil),                        // 186: taprpc.AirdropBatch
+	(*Airdrop)(nil),                             // 187: taprpc.Airdrop
+	(*StartAirdropResponse)(nil),                // 188: taprpc.StartAirdropResponse
+	(*ResumeAirdropRequest)(nil),                // 189: taprpc.ResumeAirdropRequest
+	(*ResumeAirdropResponse)(nil),               // 190: taprpc.ResumeAirdropResponse
+	(*ListAirdropsRequest)(nil),                 // 191: taprpc.ListAirdropsRequest
+	(*ListAirdropsResponse)(nil),                // 192: taprpc.ListAirdropsResponse
+	(*TemplateRecipient)(nil),                   // 193: taprpc.TemplateRecipient
+	(*TemplateFeePolicy)(nil),                   // 194: taprpc.TemplateFeePolicy
+	(*TransferTemplate)(nil),                    // 195: taprpc.TransferTemplate
+	(*CreateTransferTemplateRequest)(nil),       // 196: taprpc.CreateTransferTemplateRequest
+	(*CreateTransferTemplateResponse)(nil),      // 197: taprpc.CreateTransferTemplateResponse
+	(*ListTransferTemplatesRequest)(nil),        // 198: taprpc.ListTransferTemplatesRequest
+	(*ListTransferTemplatesResponse)(nil),       // 199: taprpc.ListTransferTemplatesResponse
+	(*DeleteTransferTemplateRequest)(nil),       // 200: taprpc.DeleteTransferTemplateRequest
+	(*DeleteTransferTemplateResponse)(nil),      // 201: taprpc.DeleteTransferTemplateResponse
+	(*ExecuteTransferTemplateRequest)(nil),      // 202: taprpc.ExecuteTransferTemplateRequest
+	(*GetInfoRequest)(nil),                      // 203: taprpc.GetInfoRequest
+	(*GetInfoResponse)(nil),                     // 204: taprpc.GetInfoResponse
+	(*SubscribeSendAssetEventNtfnsRequest)(nil), // 205: taprpc.SubscribeSendAssetEventNtfnsRequest
+	(*SendAssetEvent)(nil),                      // 206: taprpc.SendAssetEvent
+	(*ExecuteSendStateEvent)(nil),               // 207: taprpc.ExecuteSendStateEvent
+	(*ReceiverProofBackoffWaitEvent)(nil),       // 208: taprpc.ReceiverProofBackoffWaitEvent
+	(*ProofDeliveryAttemptEvent)(nil),           // 209: taprpc.ProofDeliveryAttemptEvent
+	(*BackendBreakerEvent)(nil),                 // 210: taprpc.BackendBreakerEvent
+	(*ConfDeadlineExceededEvent)(nil),           // 211: taprpc.ConfDeadlineExceededEvent
+	(*TransferCounterpartyEvent)(nil),           // 212: taprpc.TransferCounterpartyEvent
+	(*FetchAssetMetaRequest)(nil),               // 213: taprpc.FetchAssetMetaRequest
+	nil,                                         // 214: taprpc.ListUtxosResponse.ManagedUtxosEntry
+	nil,                                         // 215: taprpc.ListGroupsResponse.GroupsEntry
+	nil,                                         // 216: taprpc.ListBalancesResponse.AssetBalancesEntry
+	nil,                                         // 217: taprpc.ListBalancesResponse.AssetGroupBalancesEntry
+}
+var file_taprootassets_proto_depIdxs = []int32{
+	1,   // 0: taprpc.AssetMeta.type:type_name -> taprpc.AssetMetaType
+	17,  // 1: taprpc.AssetMeta.uri_content:type_name -> taprpc.URIMetaContent
+	20,  // 2: taprpc.Asset.asset_genesis:type_name -> taprpc.GenesisInfo
+	0,   // 3: taprpc.Asset.asset_type:type_name -> taprpc.AssetType
+	21,  // 4: taprpc.Asset.asset_group:type_name -> taprpc.AssetGroup
+	19,  // 5: taprpc.Asset.chain_anchor:type_name -> taprpc.AnchorInfo
+	23,  // 6: taprpc.Asset.prev_witnesses:type_name -> taprpc.PrevWitness
+	169, // 7: taprpc.PrevWitness.prev_id:type_name -> taprpc.PrevInputAsset
+	24,  // 8: taprpc.PrevWitness.split_commitment:type_name -> taprpc.SplitCommitment
+	22,  // 9: taprpc.SplitCommitment.root_asset:type_name -> taprpc.Asset
+	22,  // 10: taprpc.ListAssetResponse.assets:type_name -> taprpc.Asset
+	22,  // 11: taprpc.ManagedUtxo.assets:type_name -> taprpc.Asset
+	214, // 12: taprpc.ListUtxosResponse.managed_utxos:type_name -> taprpc.ListUtxosResponse.ManagedUtxosEntry
+	0,   // 13: taprpc.AssetHumanReadable.type:type_name -> taprpc.AssetType
+	30,  // 14: taprpc.GroupedAssets.assets:type_name -> taprpc.AssetHumanReadable
+	215, // 15: taprpc.ListGroupsResponse.groups:type_name -> taprpc.ListGroupsResponse.GroupsEntry
+	20,  // 16: taprpc.AssetBalance.asset_genesis:type_name -> taprpc.GenesisInfo
+	0,   // 17: taprpc.AssetBalance.asset_type:type_name -> taprpc.AssetType
+	52,  // 18: taprpc.AssetBalance.alias:type_name -> taprpc.AssetAlias
+	52,  // 19: taprpc.AssetGroupBalance.alias:type_name -> taprpc.AssetAlias
+	216, // 20: taprpc.ListBalancesResponse.asset_balances:type_name -> taprpc.ListBalancesResponse.AssetBalancesEntry
+	217, // 21: taprpc.ListBalancesResponse.asset_group_balances:type_name -> taprpc.ListBalancesResponse.AssetGroupBalancesEntry
+	38,  // 22: taprpc.ListBalanceHistoryResponse.snapshots:type_name -> taprpc.BalanceSnapshot
+	105, // 23: taprpc.ListTransfersResponse.transfers:type_name -> taprpc.AssetTransfer
+	2,   // 24: taprpc.ParcelQueueDepth.priority:type_name -> taprpc.ParcelPriority
+	43,  // 25: taprpc.ParcelQueueStatsResponse.queues:type_name -> taprpc.ParcelQueueDepth
+	3,   // 26: taprpc.ParcelLogEntry.type:type_name -> taprpc.ParcelLogEntryType
+	48,  // 27: taprpc.ExportParcelLogResponse.entries:type_name -> taprpc.ParcelLogEntry
+	108, // 28: taprpc.AnnotateAssetLotRequest.lot:type_name -> taprpc.AssetLotID
+	6,   // 29: taprpc.AssetAlias.source:type_name -> taprpc.AssetAliasSource
+	52,  // 30: taprpc.SetAssetAliasResponse.alias:type_name -> taprpc.AssetAlias
+	52,  // 31: taprpc.ListAssetAliasesResponse.aliases:type_name -> taprpc.AssetAlias
+	4,   // 32: taprpc.ExportTransferStatementRequest.format:type_name -> taprpc.StatementFormat
+	62,  // 33: taprpc.FreezeAssetOutputsResponse.frozen:type_name -> taprpc.FrozenAssetOutput
+	62,  // 34: taprpc.ListFrozenAssetOutputsResponse.frozen:type_name -> taprpc.FrozenAssetOutput
+	69,  // 35: taprpc.WatchAssetResponse.watched_asset:type_name -> taprpc.WatchedAsset
+	69,  // 36: taprpc.ListWatchedAssetsResponse.watched_assets:type_name -> taprpc.WatchedAsset
+	76,  // 37: taprpc.ListAtRiskAssetsResponse.at_risk_assets:type_name -> taprpc.AtRiskAsset
+	7,   // 38: taprpc.KeyDerivation.purpose:type_name -> taprpc.KeyPurpose
+	122, // 39: taprpc.KeyDerivation.key:type_name -> taprpc.KeyDescriptor
+	7,   // 40: taprpc.ListKeyDerivationsRequest.filter_purpose:type_name -> taprpc.KeyPurpose
+	79,  // 41: taprpc.ListKeyDerivationsResponse.derivations:type_name -> taprpc.KeyDerivation
+	122, // 42: taprpc.ReusedAnchorKey.internal_key:type_name -> taprpc.KeyDescriptor
+	82,  // 43: taprpc.ListReusedAnchorKeysResponse.reused_keys:type_name -> taprpc.ReusedAnchorKey
+	8,   // 44: taprpc.ScriptKeyReuse.conflict:type_name -> taprpc.ScriptKeyConflict
+	8,   // 45: taprpc.FlaggedScriptKey.conflict:type_name -> taprpc.ScriptKeyConflict
+	85,  // 46: taprpc.AuditScriptKeysResponse.reused_keys:type_name -> taprpc.ScriptKeyReuse
+	86,  // 47: taprpc.AuditScriptKeysResponse.flagged_keys:type_name -> taprpc.FlaggedScriptKey
+	120, // 48: taprpc.ScriptKeyDisclosure.script_key:type_name -> taprpc.ScriptKey
+	91,  // 49: taprpc.ExportScriptKeyDisclosuresResponse.disclosures:type_name -> taprpc.ScriptKeyDisclosure
+	122, // 50: taprpc.DescriptorGroup.raw_group_key:type_name -> taprpc.KeyDescriptor
+	20,  // 51: taprpc.DescriptorAsset.asset_genesis:type_name -> taprpc.GenesisInfo
+	0,   // 52: taprpc.DescriptorAsset.asset_type:type_name -> taprpc.AssetType
+	94,  // 53: taprpc.AssetDescriptor.key_ranges:type_name -> taprpc.DescriptorKeyRange
+	95,  // 54: taprpc.AssetDescriptor.groups:type_name -> taprpc.DescriptorGroup
+	96,  // 55: taprpc.AssetDescriptor.assets:type_name -> taprpc.DescriptorAsset
+	97,  // 56: taprpc.ExportAssetDescriptorResponse.decoded:type_name -> taprpc.AssetDescriptor
+	97,  // 57: taprpc.ImportAssetDescriptorResponse.decoded:type_name -> taprpc.AssetDescriptor
+	104, // 58: taprpc.ListProofDeliveryAttemptsResponse.attempts:type_name -> taprpc.ProofDeliveryAttempt
+	106, // 59: taprpc.AssetTransfer.inputs:type_name -> taprpc.TransferInput
+	110, // 60: taprpc.AssetTransfer.outputs:type_name -> taprpc.TransferOutput
+	9,   // 61: taprpc.AssetTransfer.change_key_policy:type_name -> taprpc.ChangeKeyPolicy
+	107, // 62: taprpc.TransferInput.lot:type_name -> taprpc.AssetLot
+	52,  // 63: taprpc.TransferInput.alias:type_name -> taprpc.AssetAlias
+	109, // 64: taprpc.TransferOutput.anchor:type_name -> taprpc.TransferOutputAnchor
+	10,  // 65: taprpc.TransferOutput.output_type:type_name -> taprpc.OutputType
+	0,   // 66: taprpc.Addr.asset_type:type_name -> taprpc.AssetType
+	115, // 67: taprpc.QueryAddrResponse.addrs:type_name -> taprpc.Addr
+	120, // 68: taprpc.NewAddrRequest.script_key:type_name -> taprpc.ScriptKey
+	122, // 69: taprpc.NewAddrRequest.internal_key:type_name -> taprpc.KeyDescriptor
+	119, // 70: taprpc.NewAddrRequest.deposit_expectation:type_name -> taprpc.DepositExpectation
+	122, // 71: taprpc.ScriptKey.key_desc:type_name -> taprpc.KeyDescriptor
+	121, // 72: taprpc.KeyDescriptor.key_loc:type_name -> taprpc.KeyLocator
+	115, // 73: taprpc.InspectAddrResponse.addr:type_name -> taprpc.Addr
+	22,  // 74: taprpc.DecodedProof.asset:type_name -> taprpc.Asset
+	16,  // 75: taprpc.DecodedProof.meta_reveal:type_name -> taprpc.AssetMeta
+	127, // 76: taprpc.VerifyProofResponse.decoded_proof:type_name -> taprpc.DecodedProof
+	127, // 77: taprpc.DecodeProofResponse.decoded_proof:type_name -> taprpc.DecodedProof
+	133, // 78: taprpc.ProofArchiveStatsResponse.hot:type_name -> taprpc.ProofTierStats
+	133, // 79: taprpc.ProofArchiveStatsResponse.cold:type_name -> taprpc.ProofTierStats
+	141, // 80: taprpc.ProofArchiveStatsResponse.scrub:type_name -> taprpc.ProofScrubStats
+	135, // 81: taprpc.PushArchiveProofsRequest.proofs:type_name -> taprpc.ArchivedProof
+	115, // 82: taprpc.AddrEvent.addr:type_name -> taprpc.Addr
+	11,  // 83: taprpc.AddrEvent.status:type_name -> taprpc.AddrEventStatus
+	12,  // 84: taprpc.AddrEvent.deposit_status:type_name -> taprpc.AddrDepositStatus
+	145, // 85: taprpc.SetCounterpartyResponse.counterparty:type_name -> taprpc.Counterparty
+	145, // 86: taprpc.ListCounterpartiesResponse.counterparties:type_name -> taprpc.Counterparty
+	120, // 87: taprpc.ImportedScriptKey.script_key:type_name -> taprpc.ScriptKey
+	120, // 88: taprpc.ImportScriptKeyRequest.script_key:type_name -> taprpc.ScriptKey
+	152, // 89: taprpc.ImportScriptKeyResponse.imported_key:type_name -> taprpc.ImportedScriptKey
+	152, // 90: taprpc.ListImportedScriptKeysResponse.imported_keys:type_name -> taprpc.ImportedScriptKey
+	157, // 91: taprpc.LocalInvoice.invoice:type_name -> taprpc.Invoice
+	13,  // 92: taprpc.LocalInvoice.state:type_name -> taprpc.InvoiceState
+	158, // 93: taprpc.ListInvoicesResponse.invoices:type_name -> taprpc.LocalInvoice
+	11,  // 94: taprpc.AddrReceivesRequest.filter_status:type_name -> taprpc.AddrEventStatus
+	142, // 95: taprpc.AddrReceivesResponse.events:type_name -> taprpc.AddrEvent
+	2,   // 96: taprpc.SendAssetRequest.priority:type_name -> taprpc.ParcelPriority
+	5,   // 97: taprpc.SendAssetRequest.coin_select_strategy:type_name -> taprpc.CoinSelectStrategy
+	108, // 98: taprpc.SendAssetRequest.spend_lots:type_name -> taprpc.AssetLotID
+	168, // 99: taprpc.SendAssetRequest.anchor_lock_time:type_name -> taprpc.AnchorLockTime
+	14,  // 100: taprpc.SendAssetRequest.partial_send_mode:type_name -> taprpc.PartialSendMode
+	105, // 101: taprpc.SendAssetResponse.transfer:type_name -> taprpc.AssetTransfer
+	167, // 102: taprpc.SendAssetResponse.partial_send:type_name -> taprpc.PartialSend
+	171, // 103: taprpc.ListScheduledTransfersResponse.transfers:type_name -> taprpc.ScheduledTransfer
+	105, // 104: taprpc.RegisterAnchorSessionParcelResponse.transfer:type_name -> taprpc.AssetTransfer
+	2,   // 105: taprpc.SealAnchorSessionRequest.priority:type_name -> taprpc.ParcelPriority
+	105, // 106: taprpc.SealAnchorSessionResponse.transfer:type_name -> taprpc.AssetTransfer
+	15,  // 107: taprpc.AirdropRecipient.status:type_name -> taprpc.AirdropRecipientStatus
+	185, // 108: taprpc.Airdrop.recipients:type_name -> taprpc.AirdropRecipient
+	187, // 109: taprpc.StartAirdropResponse.airdrop:type_name -> taprpc.Airdrop
+	186, // 110: taprpc.StartAirdropResponse.batches:type_name -> taprpc.AirdropBatch
+	187, // 111: taprpc.ResumeAirdropResponse.airdrop:type_name -> taprpc.Airdrop
+	186, // 112: taprpc.ResumeAirdropResponse.batches:type_name -> taprpc.AirdropBatch
+	187, // 113: taprpc.ListAirdropsResponse.airdrops:type_name -> taprpc.Airdrop
+	2,   // 114: taprpc.TemplateFeePolicy.priority:type_name -> taprpc.ParcelPriority
+	193, // 115: taprpc.TransferTemplate.recipients:type_name -> taprpc.TemplateRecipient
+	194, // 116: taprpc.TransferTemplate.fee_policy:type_name -> taprpc.TemplateFeePolicy
+	195, // 117: taprpc.CreateTransferTemplateRequest.template:type_name -> taprpc.TransferTemplate
+	195, // 118: taprpc.CreateTransferTemplateResponse.template:type_name -> taprpc.TransferTemplate
+	195, // 119: taprpc.ListTransferTemplatesResponse.templates:type_name -> taprpc.TransferTemplate
+	207, // 120: taprpc.SendAssetEvent.execute_send_state_event:type_name -> taprpc.ExecuteSendStateEvent
+	208, // 121: taprpc.SendAssetEvent.receiver_proof_backoff_wait_event:type_name -> taprpc.ReceiverProofBackoffWaitEvent
+	209, // 122: taprpc.SendAssetEvent.proof_delivery_attempt_event:type_name -> taprpc.ProofDeliveryAttemptEvent
+	210, // 123: taprpc.SendAssetEvent.backend_breaker_event:type_name -> taprpc.BackendBreakerEvent
+	211, // 124: taprpc.SendAssetEvent.conf_deadline_exceeded_event:type_name -> taprpc.ConfDeadlineExceededEvent
+	212, // 125: taprpc.SendAssetEvent.transfer_counterparty_event:type_name -> taprpc.TransferCounterpartyEvent
+	104, // 126: taprpc.ProofDeliveryAttemptEvent.attempt:type_name -> taprpc.ProofDeliveryAttempt
+	27,  // 127: taprpc.ListUtxosResponse.ManagedUtxosEntry.value:type_name -> taprpc.ManagedUtxo
+	31,  // 128: taprpc.ListGroupsResponse.GroupsEntry.value:type_name -> taprpc.GroupedAssets
+	34,  // 129: taprpc.ListBalancesResponse.AssetBalancesEntry.value:type_name -> taprpc.AssetBalance
+	35,  // 130: taprpc.ListBalancesResponse.AssetGroupBalancesEntry.value:type_name -> taprpc.AssetGroupBalance
+	18,  // 131: taprpc.TaprootAssets.ListAssets:input_type -> taprpc.ListAssetRequest
+	26,  // 132: taprpc.TaprootAssets.ListUtxos:input_type -> taprpc.ListUtxosRequest
+	29,  // 133: taprpc.TaprootAssets.ListGroups:input_type -> taprpc.ListGroupsRequest
+	33,  // 134: taprpc.TaprootAssets.ListBalances:input_type -> taprpc.ListBalancesRequest
+	37,  // 135: taprpc.TaprootAssets.ListBalanceHistory:input_type -> taprpc.ListBalanceHistoryRequest
+	40,  // 136: taprpc.TaprootAssets.ListTransfers:input_type -> taprpc.ListTransfersRequest
+	102, // 137: taprpc.TaprootAssets.ListProofDeliveryAttempts:input_type -> taprpc.ListProofDeliveryAttemptsRequest
+	42,  // 138: taprpc.TaprootAssets.ParcelQueueStats:input_type -> taprpc.ParcelQueueStatsRequest
+	45,  // 139: taprpc.TaprootAssets.SetParcelDebugLogging:input_type -> taprpc.SetParcelDebugLoggingRequest
+	47,  // 140: taprpc.TaprootAssets.ExportParcelLog:input_type -> taprpc.ExportParcelLogRequest
+	60,  // 141: taprpc.TaprootAssets.ExportTransferStatement:input_type -> taprpc.ExportTransferStatementRequest
+	63,  // 142: taprpc.TaprootAssets.FreezeAssetOutputs:input_type -> taprpc.FreezeAssetOutputsRequest
+	65,  // 143: taprpc.TaprootAssets.UnfreezeAssetOutputs:input_type -> taprpc.UnfreezeAssetOutputsRequest
+	67,  // 144: taprpc.TaprootAssets.ListFrozenAssetOutputs:input_type -> taprpc.ListFrozenAssetOutputsRequest
+	70,  // 145: taprpc.TaprootAssets.WatchAsset:input_type -> taprpc.WatchAssetRequest
+	72,  // 146: taprpc.TaprootAssets.UnwatchAsset:input_type -> taprpc.UnwatchAssetRequest
+	74,  // 147: taprpc.TaprootAssets.ListWatchedAssets:input_type -> taprpc.ListWatchedAssetsRequest
+	77,  // 148: taprpc.TaprootAssets.ListAtRiskAssets:input_type -> taprpc.ListAtRiskAssetsRequest
+	50,  // 149: taprpc.TaprootAssets.AnnotateAssetLot:input_type -> taprpc.AnnotateAssetLotRequest
+	53,  // 150: taprpc.TaprootAssets.SetAssetAlias:input_type -> taprpc.SetAssetAliasRequest
+	55,  // 151: taprpc.TaprootAssets.RemoveAssetAlias:input_type -> taprpc.RemoveAssetAliasRequest
+	57,  // 152: taprpc.TaprootAssets.ListAssetAliases:input_type -> taprpc.ListAssetAliasesRequest
+	59,  // 153: taprpc.TaprootAssets.ResolveAssetAlias:input_type -> taprpc.ResolveAssetAliasRequest
+	80,  // 154: taprpc.TaprootAssets.ListKeyDerivations:input_type -> taprpc.ListKeyDerivationsRequest
+	83,  // 155: taprpc.TaprootAssets.ListReusedAnchorKeys:input_type -> taprpc.ListReusedAnchorKeysRequest
+	87,  // 156: taprpc.TaprootAssets.AuditScriptKeys:input_type -> taprpc.AuditScriptKeysRequest
+	89,  // 157: taprpc.TaprootAssets.ResolveFlaggedScriptKey:input_type -> taprpc.ResolveFlaggedScriptKeyRequest
+	92,  // 158: taprpc.TaprootAssets.ExportScriptKeyDisclosures:input_type -> taprpc.ExportScriptKeyDisclosuresRequest
+	98,  // 159: taprpc.TaprootAssets.ExportAssetDescriptor:input_type -> taprpc.ExportAssetDescriptorRequest
+	100, // 160: taprpc.TaprootAssets.ImportAssetDescriptor:input_type -> taprpc.ImportAssetDescriptorRequest
+	111, // 161: taprpc.TaprootAssets.StopDaemon:input_type -> taprpc.StopRequest
+	113, // 162: taprpc.TaprootAssets.DebugLevel:input_type -> taprpc.DebugLevelRequest
+	116, // 163: taprpc.TaprootAssets.QueryAddrs:input_type -> taprpc.QueryAddrRequest
+	118, // 164: taprpc.TaprootAssets.NewAddr:input_type -> taprpc.NewAddrRequest
+	123, // 165: taprpc.TaprootAssets.DecodeAddr:input_type -> taprpc.DecodeAddrRequest
+	124, // 166: taprpc.TaprootAssets.InspectAddr:input_type -> taprpc.InspectAddrRequest
+	164, // 167: taprpc.TaprootAssets.AddrReceives:input_type -> taprpc.AddrReceivesRequest
+	143, // 168: taprpc.TaprootAssets.ExportReceipt:input_type -> taprpc.ExportReceiptRequest
+	146, // 169: taprpc.TaprootAssets.SetCounterparty:input_type -> taprpc.SetCounterpartyRequest
+	148, // 170: taprpc.TaprootAssets.RemoveCounterparty:input_type -> taprpc.RemoveCounterpartyRequest
+	150, // 171: taprpc.TaprootAssets.ListCounterparties:input_type -> taprpc.ListCounterpartiesRequest
+	153, // 172: taprpc.TaprootAssets.ImportScriptKey:input_type -> taprpc.ImportScriptKeyRequest
+	155, // 173: taprpc.TaprootAssets.ListImportedScriptKeys:input_type -> taprpc.ListImportedScriptKeysRequest
+	159, // 174: taprpc.TaprootAssets.CreateInvoice:input_type -> taprpc.CreateInvoiceRequest
+	160, // 175: taprpc.TaprootAssets.DecodeInvoice:input_type -> taprpc.DecodeInvoiceRequest
+	161, // 176: taprpc.TaprootAssets.LookupInvoice:input_type -> taprpc.LookupInvoiceRequest
+	162, // 177: taprpc.TaprootAssets.ListInvoices:input_type -> taprpc.ListInvoicesRequest
+	126, // 178: taprpc.TaprootAssets.VerifyProof:input_type -> taprpc.ProofFile
+	129, // 179: taprpc.TaprootAssets.DecodeProof:input_type -> taprpc.DecodeProofRequest
+	131, // 180: taprpc.TaprootAssets.ExportProof:input_type -> taprpc.ExportProofRequest
+	132, // 181: taprpc.TaprootAssets.ProofArchiveStats:input_type -> taprpc.ProofArchiveStatsRequest
+	136, // 182: taprpc.TaprootAssets.PushArchiveProofs:input_type -> taprpc.PushArchiveProofsRequest
+	138, // 183: taprpc.TaprootAssets.PullArchiveProof:input_type -> taprpc.PullArchiveProofRequest
+	139, // 184: taprpc.TaprootAssets.BakeArchiveMacaroon:input_type -> taprpc.BakeArchiveMacaroonRequest
+	166, // 185: taprpc.TaprootAssets.SendAsset:input_type -> taprpc.SendAssetRequest
+	172, // 186: taprpc.TaprootAssets.ListScheduledTransfers:input_type -> taprpc.ListScheduledTransfersRequest
+	174, // 187: taprpc.TaprootAssets.CancelScheduledTransfer:input_type -> taprpc.CancelScheduledTransferRequest
+	176, // 188: taprpc.TaprootAssets.OpenAnchorSession:input_type -> taprpc.OpenAnchorSessionRequest
+	178, // 189: taprpc.TaprootAssets.RegisterAnchorSessionParcel:input_type -> taprpc.RegisterAnchorSessionParcelRequest
+	180, // 190: taprpc.TaprootAssets.SealAnchorSession:input_type -> taprpc.SealAnchorSessionRequest
+	182, // 191: taprpc.TaprootAssets.AbortAnchorSession:input_type -> taprpc.AbortAnchorSessionRequest
+	184, // 192: taprpc.TaprootAssets.StartAirdrop:input_type -> taprpc.StartAirdropRequest
+	189, // 193: taprpc.TaprootAssets.ResumeAirdrop:input_type -> taprpc.ResumeAirdropRequest
+	191, // 194: taprpc.TaprootAssets.ListAirdrops:input_type -> taprpc.ListAirdropsRequest
+	196, // 195: taprpc.TaprootAssets.CreateTransferTemplate:input_type -> taprpc.CreateTransferTemplateRequest
+	198, // 196: taprpc.TaprootAssets.ListTransferTemplates:input_type -> taprpc.ListTransferTemplatesRequest
+	200, // 197: taprpc.TaprootAssets.DeleteTransferTemplate:input_type -> taprpc.DeleteTransferTemplateRequest
+	202, // 198: taprpc.TaprootAssets.ExecuteTransferTemplate:input_type -> taprpc.ExecuteTransferTemplateRequest
+	203, // 199: taprpc.TaprootAssets.GetInfo:input_type -> taprpc.GetInfoRequest
+	205, // 200: taprpc.TaprootAssets.SubscribeSendAssetEventNtfns:input_type -> taprpc.SubscribeSendAssetEventNtfnsRequest
+	213, // 201: taprpc.TaprootAssets.FetchAssetMeta:input_type -> taprpc.FetchAssetMetaRequest
+	25,  // 202: taprpc.TaprootAssets.ListAssets:output_type -> taprpc.ListAssetResponse
+	28,  // 203: taprpc.TaprootAssets.ListUtxos:output_type -> taprpc.ListUtxosResponse
+	32,  // 204: taprpc.TaprootAssets.ListGroups:output_type -> taprpc.ListGroupsResponse
+	36,  // 205: taprpc.TaprootAssets.ListBalances:output_type -> taprpc.ListBalancesResponse
+	39,  // 206: taprpc.TaprootAssets.ListBalanceHistory:output_type -> taprpc.ListBalanceHistoryResponse
+	41,  // 207: taprpc.TaprootAssets.ListTransfers:output_type -> taprpc.ListTransfersResponse
+	103, // 208: taprpc.TaprootAssets.ListProofDeliveryAttempts:output_type -> taprpc.ListProofDeliveryAttemptsResponse
+	44,  // 209: taprpc.TaprootAssets.ParcelQueueStats:output_type -> taprpc.ParcelQueueStatsResponse
+	46,  // 210: taprpc.TaprootAssets.SetParcelDebugLogging:output_type -> taprpc.SetParcelDebugLoggingResponse
+	49,  // 211: taprpc.TaprootAssets.ExportParcelLog:output_type -> taprpc.ExportParcelLogResponse
+	61,  // 212: taprpc.TaprootAssets.ExportTransferStatement:output_type -> taprpc.ExportTransferStatementResponse
+	64,  // 213: taprpc.TaprootAssets.FreezeAssetOutputs:output_type -> taprpc.FreezeAssetOutputsResponse
+	66,  // 214: taprpc.TaprootAssets.UnfreezeAssetOutputs:output_type -> taprpc.UnfreezeAssetOutputsResponse
+	68,  // 215: taprpc.TaprootAssets.ListFrozenAssetOutputs:output_type -> taprpc.ListFrozenAssetOutputsResponse
+	71,  // 216: taprpc.TaprootAssets.WatchAsset:output_type -> taprpc.WatchAssetResponse
+	73,  // 217: taprpc.TaprootAssets.UnwatchAsset:output_type -> taprpc.UnwatchAssetResponse
+	75,  // 218: taprpc.TaprootAssets.ListWatchedAssets:output_type -> taprpc.ListWatchedAssetsResponse
+	78,  // 219: taprpc.TaprootAssets.ListAtRiskAssets:output_type -> taprpc.ListAtRiskAssetsResponse
+	51,  // 220: taprpc.TaprootAssets.AnnotateAssetLot:output_type -> taprpc.AnnotateAssetLotResponse
+	54,  // 221: taprpc.TaprootAssets.SetAssetAlias:output_type -> taprpc.SetAssetAliasResponse
+	56,  // 222: taprpc.TaprootAssets.RemoveAssetAlias:output_type -> taprpc.RemoveAssetAliasResponse
+	58,  // 223: taprpc.TaprootAssets.ListAssetAliases:output_type -> taprpc.ListAssetAliasesResponse
+	52,  // 224: taprpc.TaprootAssets.ResolveAssetAlias:output_type -> taprpc.AssetAlias
+	81,  // 225: taprpc.TaprootAssets.ListKeyDerivations:output_type -> taprpc.ListKeyDerivationsResponse
+	84,  // 226: taprpc.TaprootAssets.ListReusedAnchorKeys:output_type -> taprpc.ListReusedAnchorKeysResponse
+	88,  // 227: taprpc.TaprootAssets.AuditScriptKeys:output_type -> taprpc.AuditScriptKeysResponse
+	90,  // 228: taprpc.TaprootAssets.ResolveFlaggedScriptKey:output_type -> taprpc.ResolveFlaggedScriptKeyResponse
+	93,  // 229: taprpc.TaprootAssets.ExportScriptKeyDisclosures:output_type -> taprpc.ExportScriptKeyDisclosuresResponse
+	99,  // 230: taprpc.TaprootAssets.ExportAssetDescriptor:output_type -> taprpc.ExportAssetDescriptorResponse
+	101, // 231: taprpc.TaprootAssets.ImportAssetDescriptor:output_type -> taprpc.ImportAssetDescriptorResponse
+	112, // 232: taprpc.TaprootAssets.StopDaemon:output_type -> taprpc.StopResponse
+	114, // 233: taprpc.TaprootAssets.DebugLevel:output_type -> taprpc.DebugLevelResponse
+	117, // 234: taprpc.TaprootAssets.QueryAddrs:output_type -> taprpc.QueryAddrResponse
+	115, // 235: taprpc.TaprootAssets.NewAddr:output_type -> taprpc.Addr
+	115, // 236: taprpc.TaprootAssets.DecodeAddr:output_type -> taprpc.Addr
+	125, // 237: taprpc.TaprootAssets.InspectAddr:output_type -> taprpc.InspectAddrResponse
+	165, // 238: taprpc.TaprootAssets.AddrReceives:output_type -> taprpc.AddrReceivesResponse
+	144, // 239: taprpc.TaprootAssets.ExportReceipt:output_type -> taprpc.TransferReceipt
+	147, // 240: taprpc.TaprootAssets.SetCounterparty:output_type -> taprpc.SetCounterpartyResponse
+	149, // 241: taprpc.TaprootAssets.RemoveCounterparty:output_type -> taprpc.RemoveCounterpartyResponse
+	151, // 242: taprpc.TaprootAssets.ListCounterparties:output_type -> taprpc.ListCounterpartiesResponse
+	154, // 243: taprpc.TaprootAssets.ImportScriptKey:output_type -> taprpc.ImportScriptKeyResponse
+	156, // 244: taprpc.TaprootAssets.ListImportedScriptKeys:output_type -> taprpc.ListImportedScriptKeysResponse
+	158, // 245: taprpc.TaprootAssets.CreateInvoice:output_type -> taprpc.LocalInvoice
+	157, // 246: taprpc.TaprootAssets.DecodeInvoice:output_type -> taprpc.Invoice
+	158, // 247: taprpc.TaprootAssets.LookupInvoice:output_type -> taprpc.LocalInvoice
+	163, // 248: taprpc.TaprootAssets.ListInvoices:output_type -> taprpc.ListInvoicesResponse
+	128, // 249: taprpc.TaprootAssets.VerifyProof:output_type -> taprpc.VerifyProofResponse
+	130, // 250: taprpc.TaprootAssets.DecodeProof:output_type -> taprpc.DecodeProofResponse
+	126, // 251: taprpc.TaprootAssets.ExportProof:output_type -> taprpc.ProofFile
+	134, // 252: taprpc.TaprootAssets.ProofArchiveStats:output_type -> taprpc.ProofArchiveStatsResponse
+	137, // 253: taprpc.TaprootAssets.PushArchiveProofs:output_type -> taprpc.PushArchiveProofsResponse
+	135, // 254: taprpc.TaprootAssets.PullArchiveProof:output_type -> taprpc.ArchivedProof
+	140, // 255: taprpc.TaprootAssets.BakeArchiveMacaroon:output_type -> taprpc.BakeArchiveMacaroonResponse
+	170, // 256: taprpc.TaprootAssets.SendAsset:output_type -> taprpc.SendAssetResponse
+	173, // 257: taprpc.TaprootAssets.ListScheduledTransfers:output_type -> taprpc.ListScheduledTransfersResponse
+	175, // 258: taprpc.TaprootAssets.CancelScheduledTransfer:output_type -> taprpc.CancelScheduledTransferResponse
+	177, // 259: taprpc.TaprootAssets.OpenAnchorSession:output_type -> taprpc.OpenAnchorSessionResponse
+	179, // 260: taprpc.TaprootAssets.RegisterAnchorSessionParcel:output_type -> taprpc.RegisterAnchorSessionParcelResponse
+	181, // 261: taprpc.TaprootAssets.SealAnchorSession:output_type -> taprpc.SealAnchorSessionResponse
+	183, // 262: taprpc.TaprootAssets.AbortAnchorSession:output_type -> taprpc.AbortAnchorSessionResponse
+	188, // 263: taprpc.TaprootAssets.StartAirdrop:output_type -> taprpc.StartAirdropResponse
+	190, // 264: taprpc.TaprootAssets.ResumeAirdrop:output_type -> taprpc.ResumeAirdropResponse
+	192, // 265: taprpc.TaprootAssets.ListAirdrops:output_type -> taprpc.ListAirdropsResponse
+	197, // 266: taprpc.TaprootAssets.CreateTransferTemplate:output_type -> taprpc.CreateTransferTemplateResponse
+	199, // 267: taprpc.TaprootAssets.ListTransferTemplates:output_type -> taprpc.ListTransferTemplatesResponse
+	201, // 268: taprpc.TaprootAssets.DeleteTransferTemplate:output_type -> taprpc.DeleteTransferTemplateResponse
+	170, // 269: taprpc.TaprootAssets.ExecuteTransferTemplate:output_type -> taprpc.SendAssetResponse
+	204, // 270: taprpc.TaprootAssets.GetInfo:output_type -> taprpc.GetInfoResponse
+	206, // 271: taprpc.TaprootAssets.SubscribeSendAssetEventNtfns:output_type -> taprpc.SendAssetEvent
+	16,  // 272: taprpc.TaprootAssets.FetchAssetMeta:output_type -> taprpc.AssetMeta
+	202, // [202:273] is the sub-list for method output_type
+	131, // [131:202] is the sub-list for method input_type
+	131, // [131:131] is the sub-list for extension type_name
+	131, // [131:131] is the sub-list for extension extendee
+	0,   // [0:131] is the sub-list for field type_name
+}
+
+func init() { file_taprootassets_proto_init() }
+func file_taprootassets_proto_init() {
+	if File_taprootassets_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_taprootassets_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*URIMetaContent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAssetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnchorInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Asset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrevWitness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitCommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAssetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUtxosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManagedUtxo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUtxosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetHumanReadable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupedAssets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBalancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetGroupBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBalancesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBalanceHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBalanceHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTransfersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTransfersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParcelQueueStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParcelQueueDepth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParcelQueueStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetParcelDebugLoggingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetParcelDebugLoggingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportParcelLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParcelLogEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportParcelLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnotateAssetLotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnotateAssetLotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetAlias); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAssetAliasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAssetAliasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAssetAliasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAssetAliasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAssetAliasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAssetAliasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveAssetAliasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportTransferStatementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportTransferStatementResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrozenAssetOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreezeAssetOutputsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreezeAssetOutputsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfreezeAssetOutputsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfreezeAssetOutputsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFrozenAssetOutputsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFrozenAssetOutputsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchedAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchAssetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchAssetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnwatchAssetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnwatchAssetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWatchedAssetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWatchedAssetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AtRiskAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAtRiskAssetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAtRiskAssetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyDerivation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKeyDerivationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKeyDerivationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReusedAnchorKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReusedAnchorKeysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReusedAnchorKeysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScriptKeyReuse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlaggedScriptKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditScriptKeysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditScriptKeysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveFlaggedScriptKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveFlaggedScriptKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScriptKeyDisclosure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportScriptKeyDisclosuresRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportScriptKeyDisclosuresResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescriptorKeyRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescriptorGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescriptorAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetDescriptor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportAssetDescriptorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportAssetDescriptorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportAssetDescriptorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportAssetDescriptorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProofDeliveryAttemptsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProofDeliveryAttemptsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofDeliveryAttempt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetLot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetLotID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferOutputAnchor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugLevelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugLevelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Addr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAddrRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAddrResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewAddrRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositExpectation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScriptKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyLocator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyDescriptor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeAddrRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectAddrRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectAddrResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodedProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofArchiveStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofTierStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofArchiveStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchivedProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushArchiveProofsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushArchiveProofsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullArchiveProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BakeArchiveMacaroonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BakeArchiveMacaroonResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofScrubStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddrEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportReceiptRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4989,8 +17859,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAssetRequest); i {
+		file_taprootassets_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferReceipt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5001,8 +17871,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnchorInfo); i {
+		file_taprootassets_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Counterparty); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5013,8 +17883,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenesisInfo); i {
+		file_taprootassets_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCounterpartyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5025,8 +17895,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetGroup); i {
+		file_taprootassets_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCounterpartyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5037,8 +17907,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Asset); i {
+		file_taprootassets_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCounterpartyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5049,8 +17919,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrevWitness); i {
+		file_taprootassets_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCounterpartyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5061,8 +17931,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SplitCommitment); i {
+		file_taprootassets_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCounterpartiesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5073,8 +17943,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAssetResponse); i {
+		file_taprootassets_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCounterpartiesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5085,8 +17955,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUtxosRequest); i {
+		file_taprootassets_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportedScriptKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5097,8 +17967,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ManagedUtxo); i {
+		file_taprootassets_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportScriptKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5109,8 +17979,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUtxosResponse); i {
+		file_taprootassets_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportScriptKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5121,8 +17991,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListGroupsRequest); i {
+		file_taprootassets_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListImportedScriptKeysRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5133,8 +18003,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetHumanReadable); i {
+		file_taprootassets_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListImportedScriptKeysResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5145,8 +18015,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupedAssets); i {
+		file_taprootassets_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invoice); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5157,8 +18027,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListGroupsResponse); i {
+		file_taprootassets_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalInvoice); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5169,8 +18039,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBalancesRequest); i {
+		file_taprootassets_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInvoiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5181,8 +18051,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetBalance); i {
+		file_taprootassets_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeInvoiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5193,8 +18063,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetGroupBalance); i {
+		file_taprootassets_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupInvoiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5205,8 +18075,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBalancesResponse); i {
+		file_taprootassets_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInvoicesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5217,8 +18087,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTransfersRequest); i {
+		file_taprootassets_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInvoicesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5229,8 +18099,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTransfersResponse); i {
+		file_taprootassets_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddrReceivesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5241,8 +18111,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetTransfer); i {
+		file_taprootassets_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddrReceivesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5253,8 +18123,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransferInput); i {
+		file_taprootassets_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendAssetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5265,8 +18135,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransferOutputAnchor); i {
+		file_taprootassets_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartialSend); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5277,8 +18147,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransferOutput); i {
+		file_taprootassets_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnchorLockTime); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5289,8 +18159,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopRequest); i {
+		file_taprootassets_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrevInputAsset); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5301,8 +18171,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopResponse); i {
+		file_taprootassets_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendAssetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5313,8 +18183,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DebugLevelRequest); i {
+		file_taprootassets_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledTransfer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5325,8 +18195,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DebugLevelResponse); i {
+		file_taprootassets_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScheduledTransfersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5337,8 +18207,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Addr); i {
+		file_taprootassets_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScheduledTransfersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5349,8 +18219,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAddrRequest); i {
+		file_taprootassets_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledTransferRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5361,8 +18231,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAddrResponse); i {
+		file_taprootassets_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledTransferResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5373,8 +18243,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewAddrRequest); i {
+		file_taprootassets_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenAnchorSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5385,8 +18255,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScriptKey); i {
+		file_taprootassets_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenAnchorSessionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5397,8 +18267,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyLocator); i {
+		file_taprootassets_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterAnchorSessionParcelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5409,8 +18279,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyDescriptor); i {
+		file_taprootassets_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterAnchorSessionParcelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5421,8 +18291,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecodeAddrRequest); i {
+		file_taprootassets_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SealAnchorSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5433,8 +18303,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProofFile); i {
+		file_taprootassets_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SealAnchorSessionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5445,8 +18315,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecodedProof); i {
+		file_taprootassets_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AbortAnchorSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5457,8 +18327,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyProofResponse); i {
+		file_taprootassets_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AbortAnchorSessionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5469,8 +18339,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecodeProofRequest); i {
+		file_taprootassets_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartAirdropRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5481,8 +18351,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecodeProofResponse); i {
+		file_taprootassets_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AirdropRecipient); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5493,8 +18363,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExportProofRequest); i {
+		file_taprootassets_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AirdropBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5505,8 +18375,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddrEvent); i {
+		file_taprootassets_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Airdrop); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5517,8 +18387,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddrReceivesRequest); i {
+		file_taprootassets_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartAirdropResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5529,8 +18399,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddrReceivesResponse); i {
+		file_taprootassets_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeAirdropRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5541,8 +18411,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendAssetRequest); i {
+		file_taprootassets_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeAirdropResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5553,8 +18423,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrevInputAsset); i {
+		file_taprootassets_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAirdropsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5565,8 +18435,8 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendAssetResponse); i {
+		file_taprootassets_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAirdropsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5577,7 +18447,127 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_taprootassets_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateRecipient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateFeePolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTransferTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTransferTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTransferTemplatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTransferTemplatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTransferTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTransferTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteTransferTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetInfoRequest); i {
 			case 0:
 				return &v.state
@@ -5589,7 +18579,7 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_taprootassets_proto_msgTypes[188].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetInfoResponse); i {
 			case 0:
 				return &v.state
@@ -5601,7 +18591,7 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_taprootassets_proto_msgTypes[189].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeSendAssetEventNtfnsRequest); i {
 			case 0:
 				return &v.state
@@ -5613,7 +18603,7 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_taprootassets_proto_msgTypes[190].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SendAssetEvent); i {
 			case 0:
 				return &v.state
@@ -5625,7 +18615,7 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_taprootassets_proto_msgTypes[191].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExecuteSendStateEvent); i {
 			case 0:
 				return &v.state
@@ -5637,7 +18627,7 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_taprootassets_proto_msgTypes[192].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReceiverProofBackoffWaitEvent); i {
 			case 0:
 				return &v.state
@@ -5649,7 +18639,55 @@ func file_taprootassets_proto_init() {
 				return nil
 			}
 		}
-		file_taprootassets_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_taprootassets_proto_msgTypes[193].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofDeliveryAttemptEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[194].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendBreakerEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[195].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfDeadlineExceededEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[196].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferCounterpartyEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taprootassets_proto_msgTypes[197].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FetchAssetMetaRequest); i {
 			case 0:
 				return &v.state
@@ -5662,15 +18700,27 @@ func file_taprootassets_proto_init() {
 			}
 		}
 	}
-	file_taprootassets_proto_msgTypes[16].OneofWrappers = []interface{}{
+	file_taprootassets_proto_msgTypes[17].OneofWrappers = []interface{}{
 		(*ListBalancesRequest_AssetId)(nil),
 		(*ListBalancesRequest_GroupKey)(nil),
 	}
-	file_taprootassets_proto_msgTypes[53].OneofWrappers = []interface{}{
+	file_taprootassets_proto_msgTypes[37].OneofWrappers = []interface{}{
+		(*SetAssetAliasRequest_AssetId)(nil),
+		(*SetAssetAliasRequest_GroupKey)(nil),
+	}
+	file_taprootassets_proto_msgTypes[130].OneofWrappers = []interface{}{
+		(*SetCounterpartyRequest_ScriptKey)(nil),
+		(*SetCounterpartyRequest_TapAddr)(nil),
+	}
+	file_taprootassets_proto_msgTypes[190].OneofWrappers = []interface{}{
 		(*SendAssetEvent_ExecuteSendStateEvent)(nil),
 		(*SendAssetEvent_ReceiverProofBackoffWaitEvent)(nil),
+		(*SendAssetEvent_ProofDeliveryAttemptEvent)(nil),
+		(*SendAssetEvent_BackendBreakerEvent)(nil),
+		(*SendAssetEvent_ConfDeadlineExceededEvent)(nil),
+		(*SendAssetEvent_TransferCounterpartyEvent)(nil),
 	}
-	file_taprootassets_proto_msgTypes[56].OneofWrappers = []interface{}{
+	file_taprootassets_proto_msgTypes[197].OneofWrappers = []interface{}{
 		(*FetchAssetMetaRequest_AssetId)(nil),
 		(*FetchAssetMetaRequest_MetaHash)(nil),
 		(*FetchAssetMetaRequest_AssetIdStr)(nil),
@@ -5681,8 +18731,8 @@ func file_taprootassets_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_taprootassets_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   61,
+			NumEnums:      16,
+			NumMessages:   202,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -32,9 +32,139 @@ type TaprootAssetsClient interface {
 	// tapcli: `assets balance`
 	// ListBalances lists asset balances
 	ListBalances(ctx context.Context, in *ListBalancesRequest, opts ...grpc.CallOption) (*ListBalancesResponse, error)
+	// tapcli: `assets balancehistory`
+	// ListBalanceHistory lists the confirmed balance of each asset as of a given
+	// block height or time, as recorded by the most recent balance snapshot
+	// taken at or before that point. Snapshots are taken periodically if
+	// balancesnapshotinterval is set.
+	ListBalanceHistory(ctx context.Context, in *ListBalanceHistoryRequest, opts ...grpc.CallOption) (*ListBalanceHistoryResponse, error)
 	// tapcli: `assets transfers`
 	// ListTransfers lists outbound asset transfers tracked by the target daemon.
 	ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error)
+	// tapcli: `assets deliveries`
+	// ListProofDeliveryAttempts lists the attempts of the proof courier to
+	// deliver the proofs of outbound transfers to their receivers, including the
+	// errors failed attempts ran into and when the next attempt is scheduled.
+	// New attempts are streamed through SubscribeSendAssetEventNtfns.
+	ListProofDeliveryAttempts(ctx context.Context, in *ListProofDeliveryAttemptsRequest, opts ...grpc.CallOption) (*ListProofDeliveryAttemptsResponse, error)
+	// tapcli: `assets queue`
+	// ParcelQueueStats returns the number of queued and active outbound transfers
+	// of each priority class.
+	ParcelQueueStats(ctx context.Context, in *ParcelQueueStatsRequest, opts ...grpc.CallOption) (*ParcelQueueStatsResponse, error)
+	// tapcli: `assets parcellog debug`
+	// SetParcelDebugLogging enables or disables debug logging scoped to a single
+	// outbound transfer, without changing the global log level. The debug
+	// messages of the transfer are then logged at info level and added to its
+	// structured log.
+	SetParcelDebugLogging(ctx context.Context, in *SetParcelDebugLoggingRequest, opts ...grpc.CallOption) (*SetParcelDebugLoggingResponse, error)
+	// tapcli: `assets parcellog export`
+	// ExportParcelLog exports the structured log of a single outbound transfer,
+	// consisting of the executed send states, the calls to the chain backend and
+	// wallet including their timings and errors, and the debug messages of the
+	// transfer if scoped debug logging is enabled.
+	ExportParcelLog(ctx context.Context, in *ExportParcelLogRequest, opts ...grpc.CallOption) (*ExportParcelLogResponse, error)
+	// tapcli: `assets statement`
+	// ExportTransferStatement exports all outbound asset transfers and completed
+	// inbound address receives tracked by the target daemon as an accounting
+	// statement.
+	ExportTransferStatement(ctx context.Context, in *ExportTransferStatementRequest, opts ...grpc.CallOption) (*ExportTransferStatementResponse, error)
+	// tapcli: `assets freeze`
+	// FreezeAssetOutputs freezes asset outputs so they are skipped by coin
+	// selection, for example because of a compliance hold. As spending the anchor
+	// output of a frozen asset would move the frozen asset as well, no asset
+	// anchored at the same outpoint can be spent while the asset is frozen.
+	FreezeAssetOutputs(ctx context.Context, in *FreezeAssetOutputsRequest, opts ...grpc.CallOption) (*FreezeAssetOutputsResponse, error)
+	// tapcli: `assets unfreeze`
+	// UnfreezeAssetOutputs unfreezes previously frozen asset outputs.
+	UnfreezeAssetOutputs(ctx context.Context, in *UnfreezeAssetOutputsRequest, opts ...grpc.CallOption) (*UnfreezeAssetOutputsResponse, error)
+	// tapcli: `assets frozen`
+	// ListFrozenAssetOutputs lists all frozen asset outputs and the reasons they
+	// were frozen for.
+	ListFrozenAssetOutputs(ctx context.Context, in *ListFrozenAssetOutputsRequest, opts ...grpc.CallOption) (*ListFrozenAssetOutputsResponse, error)
+	// tapcli: `assets watch add`
+	// WatchAsset imports the proof file of an asset owned by an external key to
+	// track it purely for monitoring, for example the collateral of a
+	// counterparty. Watched assets are never part of the balances, UTXOs or coin
+	// selection of the daemon. Once the anchor output of a watched asset is
+	// spent, a watched_asset_spent webhook notification is posted.
+	WatchAsset(ctx context.Context, in *WatchAssetRequest, opts ...grpc.CallOption) (*WatchAssetResponse, error)
+	// tapcli: `assets watch remove`
+	// UnwatchAsset stops watching an asset.
+	UnwatchAsset(ctx context.Context, in *UnwatchAssetRequest, opts ...grpc.CallOption) (*UnwatchAssetResponse, error)
+	// tapcli: `assets watch list`
+	// ListWatchedAssets lists the assets that are watched for monitoring.
+	ListWatchedAssets(ctx context.Context, in *ListWatchedAssetsRequest, opts ...grpc.CallOption) (*ListWatchedAssetsResponse, error)
+	// tapcli: `assets atrisk`
+	// ListAtRiskAssets lists the assets whose anchor output was spent by a
+	// transaction that wasn't created by the daemon, for example because a key
+	// was compromised. At risk assets are frozen, and an anchor_spend_alert
+	// webhook notification is posted once such a spend is detected.
+	ListAtRiskAssets(ctx context.Context, in *ListAtRiskAssetsRequest, opts ...grpc.CallOption) (*ListAtRiskAssetsResponse, error)
+	// tapcli: `assets annotatelot`
+	// AnnotateAssetLot sets the price annotation of the lot of an unspent asset
+	// UTXO. The annotation is carried over to the change of transfers that spend
+	// the lot.
+	AnnotateAssetLot(ctx context.Context, in *AnnotateAssetLotRequest, opts ...grpc.CallOption) (*AnnotateAssetLotResponse, error)
+	// tapcli: `assets alias set`
+	// SetAssetAlias sets a human-readable alias for an asset ID or asset group in
+	// the local alias registry. An existing alias of the same asset ID or group
+	// is replaced.
+	SetAssetAlias(ctx context.Context, in *SetAssetAliasRequest, opts ...grpc.CallOption) (*SetAssetAliasResponse, error)
+	// tapcli: `assets alias remove`
+	// RemoveAssetAlias removes an alias from the local alias registry.
+	RemoveAssetAlias(ctx context.Context, in *RemoveAssetAliasRequest, opts ...grpc.CallOption) (*RemoveAssetAliasResponse, error)
+	// tapcli: `assets alias list`
+	// ListAssetAliases lists all aliases of the local alias registry.
+	ListAssetAliases(ctx context.Context, in *ListAssetAliasesRequest, opts ...grpc.CallOption) (*ListAssetAliasesResponse, error)
+	// tapcli: `assets alias resolve`
+	// ResolveAssetAlias resolves an alias to the asset ID or group key it refers
+	// to. Aliases of the local registry take precedence over aliases of the
+	// remote registry, if one is configured. Aliases resolved through the remote
+	// registry are marked as unverified.
+	ResolveAssetAlias(ctx context.Context, in *ResolveAssetAliasRequest, opts ...grpc.CallOption) (*AssetAlias, error)
+	// tapcli: `keys derivations`
+	// ListKeyDerivations lists the audit log of all keys that were derived from
+	// the backing lnd node for minting and transfers, together with the purpose
+	// and the object they were derived for.
+	ListKeyDerivations(ctx context.Context, in *ListKeyDerivationsRequest, opts ...grpc.CallOption) (*ListKeyDerivationsResponse, error)
+	// tapcli: `keys reused`
+	// ListReusedAnchorKeys lists all internal keys that were used for more than
+	// one anchor output, together with the anchor outputs. Reusing internal keys
+	// links the anchor outputs on chain, which degrades privacy.
+	ListReusedAnchorKeys(ctx context.Context, in *ListReusedAnchorKeysRequest, opts ...grpc.CallOption) (*ListReusedAnchorKeysResponse, error)
+	// tapcli: `keys audit`
+	// AuditScriptKeys scans the transfer log and the proof archive for script
+	// keys that are used by more than one unspent asset or by the outputs of more
+	// than one transfer, and flags them. No assets are sent to a script key while
+	// it has an open flag. The audit also runs periodically, if configured.
+	AuditScriptKeys(ctx context.Context, in *AuditScriptKeysRequest, opts ...grpc.CallOption) (*AuditScriptKeysResponse, error)
+	// tapcli: `keys resolve`
+	// ResolveFlaggedScriptKey resolves the open flags of a reused script key,
+	// which allows sending assets to it again. A resolved flag is only reopened
+	// if the script key is later found in more outputs.
+	ResolveFlaggedScriptKey(ctx context.Context, in *ResolveFlaggedScriptKeyRequest, opts ...grpc.CallOption) (*ResolveFlaggedScriptKeyResponse, error)
+	// tapcli: `keys disclosures`
+	// ExportScriptKeyDisclosures exports the internal key and tweak of the script
+	// key of each owned asset output. This allows a third party auditor to
+	// re-derive the script keys and audit the holdings of the daemon without
+	// being handed any spending keys. The disclosures can optionally be encrypted
+	// to the key of the auditor.
+	ExportScriptKeyDisclosures(ctx context.Context, in *ExportScriptKeyDisclosuresRequest, opts ...grpc.CallOption) (*ExportScriptKeyDisclosuresResponse, error)
+	// tapcli: `keys descriptor export`
+	// ExportAssetDescriptor exports the asset descriptor of the wallet. Together
+	// with the seed of the backing lnd node, the descriptor contains everything
+	// needed to recover the assets of the wallet: the key derivation scheme and
+	// the ranges of keys that were derived, the groups and IDs of the assets to
+	// look for and the universe servers to fetch their proofs from. The
+	// descriptor doesn't contain any private key material.
+	ExportAssetDescriptor(ctx context.Context, in *ExportAssetDescriptorRequest, opts ...grpc.CallOption) (*ExportAssetDescriptorResponse, error)
+	// tapcli: `keys descriptor import`
+	// ImportAssetDescriptor imports an asset descriptor into a wallet that was
+	// restored from the same seed. It makes sure the keys of the descriptor can
+	// be derived, advances the key families of the wallet past the keys that are
+	// already in use and adds the universe servers of the descriptor to the
+	// federation of the local universe.
+	ImportAssetDescriptor(ctx context.Context, in *ImportAssetDescriptorRequest, opts ...grpc.CallOption) (*ImportAssetDescriptorResponse, error)
 	// tapcli: `stop`
 	// StopDaemon will send a shutdown request to the interrupt handler, triggering
 	// a graceful shutdown of the daemon.
@@ -56,10 +186,60 @@ type TaprootAssetsClient interface {
 	// DecodeAddr decode a Taproot Asset address into a partial asset message that
 	// represents the asset it wants to receive.
 	DecodeAddr(ctx context.Context, in *DecodeAddrRequest, opts ...grpc.CallOption) (*Addr, error)
+	// tapcli: `addrs inspect`
+	// InspectAddr decodes a Taproot Asset address and reports how the proofs of
+	// a transfer to it would be delivered: which proof courier would be used,
+	// whether this node supports it and the expected size of the proof. This
+	// allows senders to detect incompatible receivers before funding anything.
+	InspectAddr(ctx context.Context, in *InspectAddrRequest, opts ...grpc.CallOption) (*InspectAddrResponse, error)
 	// tapcli: `addrs receives`
 	// List all receives for incoming asset transfers for addresses that were
 	// created previously.
 	AddrReceives(ctx context.Context, in *AddrReceivesRequest, opts ...grpc.CallOption) (*AddrReceivesResponse, error)
+	// tapcli: `addrs receipt`
+	// ExportReceipt creates a receipt for a completed inbound transfer to an
+	// address. The receipt is signed by the identity key of the backing lnd node
+	// and can be handed to third parties as evidence of payment.
+	ExportReceipt(ctx context.Context, in *ExportReceiptRequest, opts ...grpc.CallOption) (*TransferReceipt, error)
+	// tapcli: `addrs counterparty set`
+	// SetCounterparty adds a script key to the counterparty book under the given
+	// name, or renames it if it is already known. The names of known
+	// counterparties are shown for the outputs of transfers that are sent to
+	// them.
+	SetCounterparty(ctx context.Context, in *SetCounterpartyRequest, opts ...grpc.CallOption) (*SetCounterpartyResponse, error)
+	// tapcli: `addrs counterparty remove`
+	// RemoveCounterparty removes all script keys of a counterparty from the
+	// counterparty book.
+	RemoveCounterparty(ctx context.Context, in *RemoveCounterpartyRequest, opts ...grpc.CallOption) (*RemoveCounterpartyResponse, error)
+	// tapcli: `addrs counterparty list`
+	// ListCounterparties lists all script keys of the counterparty book.
+	ListCounterparties(ctx context.Context, in *ListCounterpartiesRequest, opts ...grpc.CallOption) (*ListCounterpartiesResponse, error)
+	// tapcli: `addrs importscriptkey`
+	// ImportScriptKey imports an externally derived script key, for example one
+	// generated by a legacy system or another wallet, together with its internal
+	// key and tweak. Assets sent to an imported script key are recognized as
+	// our own and their proofs are retained.
+	ImportScriptKey(ctx context.Context, in *ImportScriptKeyRequest, opts ...grpc.CallOption) (*ImportScriptKeyResponse, error)
+	// tapcli: `addrs importedscriptkeys`
+	// ListImportedScriptKeys lists all imported script keys.
+	ListImportedScriptKeys(ctx context.Context, in *ListImportedScriptKeysRequest, opts ...grpc.CallOption) (*ListImportedScriptKeysResponse, error)
+	// tapcli: `addrs invoice create`
+	// CreateInvoice creates a new address for the given asset and amount and
+	// returns an invoice for it that is signed by the identity key of the
+	// backing lnd node. The invoice is settled once a transfer to its address
+	// completes.
+	CreateInvoice(ctx context.Context, in *CreateInvoiceRequest, opts ...grpc.CallOption) (*LocalInvoice, error)
+	// tapcli: `addrs invoice decode`
+	// DecodeInvoice decodes an invoice and verifies its signature and address.
+	DecodeInvoice(ctx context.Context, in *DecodeInvoiceRequest, opts ...grpc.CallOption) (*Invoice, error)
+	// tapcli: `addrs invoice lookup`
+	// LookupInvoice returns an invoice created by this node, together with its
+	// settlement state.
+	LookupInvoice(ctx context.Context, in *LookupInvoiceRequest, opts ...grpc.CallOption) (*LocalInvoice, error)
+	// tapcli: `addrs invoice list`
+	// ListInvoices lists all invoices created by this node, together with their
+	// settlement state.
+	ListInvoices(ctx context.Context, in *ListInvoicesRequest, opts ...grpc.CallOption) (*ListInvoicesResponse, error)
 	// tapcli: `proofs verify`
 	// VerifyProof attempts to verify a given proof file that claims to be anchored
 	// at the specified genesis point.
@@ -72,12 +252,93 @@ type TaprootAssetsClient interface {
 	// ExportProof exports the latest raw proof file anchored at the specified
 	// script_key.
 	ExportProof(ctx context.Context, in *ExportProofRequest, opts ...grpc.CallOption) (*ProofFile, error)
+	// tapcli: `proofs tiers`
+	// ProofArchiveStats returns the number and total size of the proofs in the
+	// hot and the cold tier of the on-disk proof archive, and the metrics of the
+	// proof scrubber. Requires tiering of the proof archive or scrubbing to be
+	// enabled.
+	ProofArchiveStats(ctx context.Context, in *ProofArchiveStatsRequest, opts ...grpc.CallOption) (*ProofArchiveStatsResponse, error)
+	// PushArchiveProofs stores proofs in the central proof archive served by this
+	// node. The proofs are stored in the archive of the user the macaroon of the
+	// caller is scoped to. Requires the central proof archive to be enabled.
+	PushArchiveProofs(ctx context.Context, in *PushArchiveProofsRequest, opts ...grpc.CallOption) (*PushArchiveProofsResponse, error)
+	// PullArchiveProof fetches a proof from the archive of the user the macaroon
+	// of the caller is scoped to. Requires the central proof archive to be
+	// enabled.
+	PullArchiveProof(ctx context.Context, in *PullArchiveProofRequest, opts ...grpc.CallOption) (*ArchivedProof, error)
+	// tapcli: `proofs archivemacaroon`
+	// BakeArchiveMacaroon bakes a macaroon that only grants access to the archive
+	// of the given user in the central proof archive served by this node. The
+	// macaroon is handed to the node of the user.
+	BakeArchiveMacaroon(ctx context.Context, in *BakeArchiveMacaroonRequest, opts ...grpc.CallOption) (*BakeArchiveMacaroonResponse, error)
 	// tapcli: `assets send`
 	// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt
 	// to complete an asset send. The method returns information w.r.t the on chain
 	// send, as well as the proof file information the receiver needs to fully
 	// receive the asset.
 	SendAsset(ctx context.Context, in *SendAssetRequest, opts ...grpc.CallOption) (*SendAssetResponse, error)
+	// tapcli: `assets scheduled list`
+	// ListScheduledTransfers lists the transfers that are signed and committed,
+	// but whose anchor transaction is held back until their broadcast trigger is
+	// reached.
+	ListScheduledTransfers(ctx context.Context, in *ListScheduledTransfersRequest, opts ...grpc.CallOption) (*ListScheduledTransfersResponse, error)
+	// tapcli: `assets scheduled cancel`
+	// CancelScheduledTransfer cancels a scheduled transfer before its anchor
+	// transaction is broadcast. The transfer is removed and its asset inputs can
+	// be spent again right away.
+	CancelScheduledTransfer(ctx context.Context, in *CancelScheduledTransferRequest, opts ...grpc.CallOption) (*CancelScheduledTransferResponse, error)
+	// tapcli: `assets session open`
+	// OpenAnchorSession opens a named anchor session. Separate callers can
+	// register parcels into the session, which are all sent in a single anchor
+	// transaction once the session is sealed.
+	OpenAnchorSession(ctx context.Context, in *OpenAnchorSessionRequest, opts ...grpc.CallOption) (*OpenAnchorSessionResponse, error)
+	// tapcli: `assets session register`
+	// RegisterAnchorSessionParcel registers a parcel that sends to the given
+	// addresses in an open anchor session. The call blocks until the session is
+	// sealed and returns the caller's view of the shared transfer, which only
+	// contains the caller's outputs and its share of the chain fees. If the call
+	// is canceled, the parcel stays registered and is sent once the session is
+	// sealed.
+	RegisterAnchorSessionParcel(ctx context.Context, in *RegisterAnchorSessionParcelRequest, opts ...grpc.CallOption) (*RegisterAnchorSessionParcelResponse, error)
+	// tapcli: `assets session seal`
+	// SealAnchorSession closes an anchor session and sends the parcels of all
+	// callers in a single anchor transaction. Either all or none of the parcels
+	// are sent.
+	SealAnchorSession(ctx context.Context, in *SealAnchorSessionRequest, opts ...grpc.CallOption) (*SealAnchorSessionResponse, error)
+	// tapcli: `assets session abort`
+	// AbortAnchorSession aborts an open anchor session. None of its parcels are
+	// sent and all callers that registered a parcel receive an error.
+	AbortAnchorSession(ctx context.Context, in *AbortAnchorSessionRequest, opts ...grpc.CallOption) (*AbortAnchorSessionResponse, error)
+	// tapcli: `assets airdrop start`
+	// StartAirdrop sends assets to a manifest of many recipients. All addresses
+	// of the manifest are validated up front, then the recipients are split into
+	// batched transfers that are sent in the background. The status of every
+	// recipient is tracked, so a partially failed airdrop can be resumed.
+	StartAirdrop(ctx context.Context, in *StartAirdropRequest, opts ...grpc.CallOption) (*StartAirdropResponse, error)
+	// tapcli: `assets airdrop resume`
+	// ResumeAirdrop retries the pending and failed recipients of an airdrop.
+	// Recipients whose transfer is still in flight aren't retried, as the
+	// transfer may still be completed.
+	ResumeAirdrop(ctx context.Context, in *ResumeAirdropRequest, opts ...grpc.CallOption) (*ResumeAirdropResponse, error)
+	// tapcli: `assets airdrop list`
+	// ListAirdrops lists the airdrops and the status of their recipients.
+	ListAirdrops(ctx context.Context, in *ListAirdropsRequest, opts ...grpc.CallOption) (*ListAirdropsResponse, error)
+	// tapcli: `assets templates create`
+	// CreateTransferTemplate stores a named set of recipients of an asset
+	// together with the fee policy they are paid with, so recurring transfers
+	// to the same recipients can be sent with a single call.
+	CreateTransferTemplate(ctx context.Context, in *CreateTransferTemplateRequest, opts ...grpc.CallOption) (*CreateTransferTemplateResponse, error)
+	// tapcli: `assets templates list`
+	// ListTransferTemplates lists the stored transfer templates.
+	ListTransferTemplates(ctx context.Context, in *ListTransferTemplatesRequest, opts ...grpc.CallOption) (*ListTransferTemplatesResponse, error)
+	// tapcli: `assets templates delete`
+	// DeleteTransferTemplate deletes a transfer template.
+	DeleteTransferTemplate(ctx context.Context, in *DeleteTransferTemplateRequest, opts ...grpc.CallOption) (*DeleteTransferTemplateResponse, error)
+	// tapcli: `assets templates execute`
+	// ExecuteTransferTemplate pays all recipients of a transfer template in a
+	// single transfer, sent with the fee policy of the template. The call
+	// returns once the anchor transaction is broadcast.
+	ExecuteTransferTemplate(ctx context.Context, in *ExecuteTransferTemplateRequest, opts ...grpc.CallOption) (*SendAssetResponse, error)
 	// tapcli: `getinfo`
 	// GetInfo returns the information for the node.
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
@@ -133,6 +394,15 @@ func (c *taprootAssetsClient) ListBalances(ctx context.Context, in *ListBalances
 	return out, nil
 }
 
+func (c *taprootAssetsClient) ListBalanceHistory(ctx context.Context, in *ListBalanceHistoryRequest, opts ...grpc.CallOption) (*ListBalanceHistoryResponse, error) {
+	out := new(ListBalanceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListBalanceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error) {
 	out := new(ListTransfersResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListTransfers", in, out, opts...)
@@ -142,6 +412,222 @@ func (c *taprootAssetsClient) ListTransfers(ctx context.Context, in *ListTransfe
 	return out, nil
 }
 
+func (c *taprootAssetsClient) ListProofDeliveryAttempts(ctx context.Context, in *ListProofDeliveryAttemptsRequest, opts ...grpc.CallOption) (*ListProofDeliveryAttemptsResponse, error) {
+	out := new(ListProofDeliveryAttemptsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListProofDeliveryAttempts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ParcelQueueStats(ctx context.Context, in *ParcelQueueStatsRequest, opts ...grpc.CallOption) (*ParcelQueueStatsResponse, error) {
+	out := new(ParcelQueueStatsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ParcelQueueStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) SetParcelDebugLogging(ctx context.Context, in *SetParcelDebugLoggingRequest, opts ...grpc.CallOption) (*SetParcelDebugLoggingResponse, error) {
+	out := new(SetParcelDebugLoggingResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SetParcelDebugLogging", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ExportParcelLog(ctx context.Context, in *ExportParcelLogRequest, opts ...grpc.CallOption) (*ExportParcelLogResponse, error) {
+	out := new(ExportParcelLogResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ExportParcelLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ExportTransferStatement(ctx context.Context, in *ExportTransferStatementRequest, opts ...grpc.CallOption) (*ExportTransferStatementResponse, error) {
+	out := new(ExportTransferStatementResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ExportTransferStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) FreezeAssetOutputs(ctx context.Context, in *FreezeAssetOutputsRequest, opts ...grpc.CallOption) (*FreezeAssetOutputsResponse, error) {
+	out := new(FreezeAssetOutputsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/FreezeAssetOutputs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) UnfreezeAssetOutputs(ctx context.Context, in *UnfreezeAssetOutputsRequest, opts ...grpc.CallOption) (*UnfreezeAssetOutputsResponse, error) {
+	out := new(UnfreezeAssetOutputsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/UnfreezeAssetOutputs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ListFrozenAssetOutputs(ctx context.Context, in *ListFrozenAssetOutputsRequest, opts ...grpc.CallOption) (*ListFrozenAssetOutputsResponse, error) {
+	out := new(ListFrozenAssetOutputsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListFrozenAssetOutputs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) WatchAsset(ctx context.Context, in *WatchAssetRequest, opts ...grpc.CallOption) (*WatchAssetResponse, error) {
+	out := new(WatchAssetResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/WatchAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) UnwatchAsset(ctx context.Context, in *UnwatchAssetRequest, opts ...grpc.CallOption) (*UnwatchAssetResponse, error) {
+	out := new(UnwatchAssetResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/UnwatchAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ListWatchedAssets(ctx context.Context, in *ListWatchedAssetsRequest, opts ...grpc.CallOption) (*ListWatchedAssetsResponse, error) {
+	out := new(ListWatchedAssetsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListWatchedAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ListAtRiskAssets(ctx context.Context, in *ListAtRiskAssetsRequest, opts ...grpc.CallOption) (*ListAtRiskAssetsResponse, error) {
+	out := new(ListAtRiskAssetsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListAtRiskAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) AnnotateAssetLot(ctx context.Context, in *AnnotateAssetLotRequest, opts ...grpc.CallOption) (*AnnotateAssetLotResponse, error) {
+	out := new(AnnotateAssetLotResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/AnnotateAssetLot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) SetAssetAlias(ctx context.Context, in *SetAssetAliasRequest, opts ...grpc.CallOption) (*SetAssetAliasResponse, error) {
+	out := new(SetAssetAliasResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SetAssetAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) RemoveAssetAlias(ctx context.Context, in *RemoveAssetAliasRequest, opts ...grpc.CallOption) (*RemoveAssetAliasResponse, error) {
+	out := new(RemoveAssetAliasResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/RemoveAssetAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ListAssetAliases(ctx context.Context, in *ListAssetAliasesRequest, opts ...grpc.CallOption) (*ListAssetAliasesResponse, error) {
+	out := new(ListAssetAliasesResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListAssetAliases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ResolveAssetAlias(ctx context.Context, in *ResolveAssetAliasRequest, opts ...grpc.CallOption) (*AssetAlias, error) {
+	out := new(AssetAlias)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ResolveAssetAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ListKeyDerivations(ctx context.Context, in *ListKeyDerivationsRequest, opts ...grpc.CallOption) (*ListKeyDerivationsResponse, error) {
+	out := new(ListKeyDerivationsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListKeyDerivations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ListReusedAnchorKeys(ctx context.Context, in *ListReusedAnchorKeysRequest, opts ...grpc.CallOption) (*ListReusedAnchorKeysResponse, error) {
+	out := new(ListReusedAnchorKeysResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListReusedAnchorKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) AuditScriptKeys(ctx context.Context, in *AuditScriptKeysRequest, opts ...grpc.CallOption) (*AuditScriptKeysResponse, error) {
+	out := new(AuditScriptKeysResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/AuditScriptKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ResolveFlaggedScriptKey(ctx context.Context, in *ResolveFlaggedScriptKeyRequest, opts ...grpc.CallOption) (*ResolveFlaggedScriptKeyResponse, error) {
+	out := new(ResolveFlaggedScriptKeyResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ResolveFlaggedScriptKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ExportScriptKeyDisclosures(ctx context.Context, in *ExportScriptKeyDisclosuresRequest, opts ...grpc.CallOption) (*ExportScriptKeyDisclosuresResponse, error) {
+	out := new(ExportScriptKeyDisclosuresResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ExportScriptKeyDisclosures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ExportAssetDescriptor(ctx context.Context, in *ExportAssetDescriptorRequest, opts ...grpc.CallOption) (*ExportAssetDescriptorResponse, error) {
+	out := new(ExportAssetDescriptorResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ExportAssetDescriptor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ImportAssetDescriptor(ctx context.Context, in *ImportAssetDescriptorRequest, opts ...grpc.CallOption) (*ImportAssetDescriptorResponse, error) {
+	out := new(ImportAssetDescriptorResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ImportAssetDescriptor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
 	out := new(StopResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/StopDaemon", in, out, opts...)
@@ -187,6 +673,15 @@ func (c *taprootAssetsClient) DecodeAddr(ctx context.Context, in *DecodeAddrRequ
 	return out, nil
 }
 
+func (c *taprootAssetsClient) InspectAddr(ctx context.Context, in *InspectAddrRequest, opts ...grpc.CallOption) (*InspectAddrResponse, error) {
+	out := new(InspectAddrResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/InspectAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) AddrReceives(ctx context.Context, in *AddrReceivesRequest, opts ...grpc.CallOption) (*AddrReceivesResponse, error) {
 	out := new(AddrReceivesResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/AddrReceives", in, out, opts...)
@@ -196,6 +691,96 @@ func (c *taprootAssetsClient) AddrReceives(ctx context.Context, in *AddrReceives
 	return out, nil
 }
 
+func (c *taprootAssetsClient) ExportReceipt(ctx context.Context, in *ExportReceiptRequest, opts ...grpc.CallOption) (*TransferReceipt, error) {
+	out := new(TransferReceipt)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ExportReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) SetCounterparty(ctx context.Context, in *SetCounterpartyRequest, opts ...grpc.CallOption) (*SetCounterpartyResponse, error) {
+	out := new(SetCounterpartyResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SetCounterparty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) RemoveCounterparty(ctx context.Context, in *RemoveCounterpartyRequest, opts ...grpc.CallOption) (*RemoveCounterpartyResponse, error) {
+	out := new(RemoveCounterpartyResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/RemoveCounterparty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ListCounterparties(ctx context.Context, in *ListCounterpartiesRequest, opts ...grpc.CallOption) (*ListCounterpartiesResponse, error) {
+	out := new(ListCounterpartiesResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListCounterparties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ImportScriptKey(ctx context.Context, in *ImportScriptKeyRequest, opts ...grpc.CallOption) (*ImportScriptKeyResponse, error) {
+	out := new(ImportScriptKeyResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ImportScriptKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ListImportedScriptKeys(ctx context.Context, in *ListImportedScriptKeysRequest, opts ...grpc.CallOption) (*ListImportedScriptKeysResponse, error) {
+	out := new(ListImportedScriptKeysResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListImportedScriptKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) CreateInvoice(ctx context.Context, in *CreateInvoiceRequest, opts ...grpc.CallOption) (*LocalInvoice, error) {
+	out := new(LocalInvoice)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/CreateInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) DecodeInvoice(ctx context.Context, in *DecodeInvoiceRequest, opts ...grpc.CallOption) (*Invoice, error) {
+	out := new(Invoice)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/DecodeInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) LookupInvoice(ctx context.Context, in *LookupInvoiceRequest, opts ...grpc.CallOption) (*LocalInvoice, error) {
+	out := new(LocalInvoice)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/LookupInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ListInvoices(ctx context.Context, in *ListInvoicesRequest, opts ...grpc.CallOption) (*ListInvoicesResponse, error) {
+	out := new(ListInvoicesResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListInvoices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) VerifyProof(ctx context.Context, in *ProofFile, opts ...grpc.CallOption) (*VerifyProofResponse, error) {
 	out := new(VerifyProofResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/VerifyProof", in, out, opts...)
@@ -223,59 +808,212 @@ func (c *taprootAssetsClient) ExportProof(ctx context.Context, in *ExportProofRe
 	return out, nil
 }
 
-func (c *taprootAssetsClient) SendAsset(ctx context.Context, in *SendAssetRequest, opts ...grpc.CallOption) (*SendAssetResponse, error) {
-	out := new(SendAssetResponse)
-	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SendAsset", in, out, opts...)
+func (c *taprootAssetsClient) ProofArchiveStats(ctx context.Context, in *ProofArchiveStatsRequest, opts ...grpc.CallOption) (*ProofArchiveStatsResponse, error) {
+	out := new(ProofArchiveStatsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ProofArchiveStats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *taprootAssetsClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
-	out := new(GetInfoResponse)
-	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/GetInfo", in, out, opts...)
+func (c *taprootAssetsClient) PushArchiveProofs(ctx context.Context, in *PushArchiveProofsRequest, opts ...grpc.CallOption) (*PushArchiveProofsResponse, error) {
+	out := new(PushArchiveProofsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/PushArchiveProofs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *taprootAssetsClient) SubscribeSendAssetEventNtfns(ctx context.Context, in *SubscribeSendAssetEventNtfnsRequest, opts ...grpc.CallOption) (TaprootAssets_SubscribeSendAssetEventNtfnsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &TaprootAssets_ServiceDesc.Streams[0], "/taprpc.TaprootAssets/SubscribeSendAssetEventNtfns", opts...)
+func (c *taprootAssetsClient) PullArchiveProof(ctx context.Context, in *PullArchiveProofRequest, opts ...grpc.CallOption) (*ArchivedProof, error) {
+	out := new(ArchivedProof)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/PullArchiveProof", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &taprootAssetsSubscribeSendAssetEventNtfnsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type TaprootAssets_SubscribeSendAssetEventNtfnsClient interface {
-	Recv() (*SendAssetEvent, error)
-	grpc.ClientStream
+	return out, nil
 }
 
-type taprootAssetsSubscribeSendAssetEventNtfnsClient struct {
-	grpc.ClientStream
+func (c *taprootAssetsClient) BakeArchiveMacaroon(ctx context.Context, in *BakeArchiveMacaroonRequest, opts ...grpc.CallOption) (*BakeArchiveMacaroonResponse, error) {
+	out := new(BakeArchiveMacaroonResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/BakeArchiveMacaroon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *taprootAssetsSubscribeSendAssetEventNtfnsClient) Recv() (*SendAssetEvent, error) {
-	m := new(SendAssetEvent)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *taprootAssetsClient) SendAsset(ctx context.Context, in *SendAssetRequest, opts ...grpc.CallOption) (*SendAssetResponse, error) {
+	out := new(SendAssetResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SendAsset", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *taprootAssetsClient) FetchAssetMeta(ctx context.Context, in *FetchAssetMetaRequest, opts ...grpc.CallOption) (*AssetMeta, error) {
-	out := new(AssetMeta)
-	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/FetchAssetMeta", in, out, opts...)
+func (c *taprootAssetsClient) ListScheduledTransfers(ctx context.Context, in *ListScheduledTransfersRequest, opts ...grpc.CallOption) (*ListScheduledTransfersResponse, error) {
+	out := new(ListScheduledTransfersResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListScheduledTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) CancelScheduledTransfer(ctx context.Context, in *CancelScheduledTransferRequest, opts ...grpc.CallOption) (*CancelScheduledTransferResponse, error) {
+	out := new(CancelScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/CancelScheduledTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) OpenAnchorSession(ctx context.Context, in *OpenAnchorSessionRequest, opts ...grpc.CallOption) (*OpenAnchorSessionResponse, error) {
+	out := new(OpenAnchorSessionResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/OpenAnchorSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) RegisterAnchorSessionParcel(ctx context.Context, in *RegisterAnchorSessionParcelRequest, opts ...grpc.CallOption) (*RegisterAnchorSessionParcelResponse, error) {
+	out := new(RegisterAnchorSessionParcelResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/RegisterAnchorSessionParcel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) SealAnchorSession(ctx context.Context, in *SealAnchorSessionRequest, opts ...grpc.CallOption) (*SealAnchorSessionResponse, error) {
+	out := new(SealAnchorSessionResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SealAnchorSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) AbortAnchorSession(ctx context.Context, in *AbortAnchorSessionRequest, opts ...grpc.CallOption) (*AbortAnchorSessionResponse, error) {
+	out := new(AbortAnchorSessionResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/AbortAnchorSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) StartAirdrop(ctx context.Context, in *StartAirdropRequest, opts ...grpc.CallOption) (*StartAirdropResponse, error) {
+	out := new(StartAirdropResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/StartAirdrop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ResumeAirdrop(ctx context.Context, in *ResumeAirdropRequest, opts ...grpc.CallOption) (*ResumeAirdropResponse, error) {
+	out := new(ResumeAirdropResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ResumeAirdrop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ListAirdrops(ctx context.Context, in *ListAirdropsRequest, opts ...grpc.CallOption) (*ListAirdropsResponse, error) {
+	out := new(ListAirdropsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListAirdrops", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) CreateTransferTemplate(ctx context.Context, in *CreateTransferTemplateRequest, opts ...grpc.CallOption) (*CreateTransferTemplateResponse, error) {
+	out := new(CreateTransferTemplateResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/CreateTransferTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ListTransferTemplates(ctx context.Context, in *ListTransferTemplatesRequest, opts ...grpc.CallOption) (*ListTransferTemplatesResponse, error) {
+	out := new(ListTransferTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListTransferTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) DeleteTransferTemplate(ctx context.Context, in *DeleteTransferTemplateRequest, opts ...grpc.CallOption) (*DeleteTransferTemplateResponse, error) {
+	out := new(DeleteTransferTemplateResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/DeleteTransferTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ExecuteTransferTemplate(ctx context.Context, in *ExecuteTransferTemplateRequest, opts ...grpc.CallOption) (*SendAssetResponse, error) {
+	out := new(SendAssetResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ExecuteTransferTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
+	out := new(GetInfoResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/GetInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) SubscribeSendAssetEventNtfns(ctx context.Context, in *SubscribeSendAssetEventNtfnsRequest, opts ...grpc.CallOption) (TaprootAssets_SubscribeSendAssetEventNtfnsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TaprootAssets_ServiceDesc.Streams[0], "/taprpc.TaprootAssets/SubscribeSendAssetEventNtfns", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taprootAssetsSubscribeSendAssetEventNtfnsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TaprootAssets_SubscribeSendAssetEventNtfnsClient interface {
+	Recv() (*SendAssetEvent, error)
+	grpc.ClientStream
+}
+
+type taprootAssetsSubscribeSendAssetEventNtfnsClient struct {
+	grpc.ClientStream
+}
+
+func (x *taprootAssetsSubscribeSendAssetEventNtfnsClient) Recv() (*SendAssetEvent, error) {
+	m := new(SendAssetEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *taprootAssetsClient) FetchAssetMeta(ctx context.Context, in *FetchAssetMetaRequest, opts ...grpc.CallOption) (*AssetMeta, error) {
+	out := new(AssetMeta)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/FetchAssetMeta", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -300,9 +1038,139 @@ type TaprootAssetsServer interface {
 	// tapcli: `assets balance`
 	// ListBalances lists asset balances
 	ListBalances(context.Context, *ListBalancesRequest) (*ListBalancesResponse, error)
+	// tapcli: `assets balancehistory`
+	// ListBalanceHistory lists the confirmed balance of each asset as of a given
+	// block height or time, as recorded by the most recent balance snapshot
+	// taken at or before that point. Snapshots are taken periodically if
+	// balancesnapshotinterval is set.
+	ListBalanceHistory(context.Context, *ListBalanceHistoryRequest) (*ListBalanceHistoryResponse, error)
 	// tapcli: `assets transfers`
 	// ListTransfers lists outbound asset transfers tracked by the target daemon.
 	ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error)
+	// tapcli: `assets deliveries`
+	// ListProofDeliveryAttempts lists the attempts of the proof courier to
+	// deliver the proofs of outbound transfers to their receivers, including the
+	// errors failed attempts ran into and when the next attempt is scheduled.
+	// New attempts are streamed through SubscribeSendAssetEventNtfns.
+	ListProofDeliveryAttempts(context.Context, *ListProofDeliveryAttemptsRequest) (*ListProofDeliveryAttemptsResponse, error)
+	// tapcli: `assets queue`
+	// ParcelQueueStats returns the number of queued and active outbound transfers
+	// of each priority class.
+	ParcelQueueStats(context.Context, *ParcelQueueStatsRequest) (*ParcelQueueStatsResponse, error)
+	// tapcli: `assets parcellog debug`
+	// SetParcelDebugLogging enables or disables debug logging scoped to a single
+	// outbound transfer, without changing the global log level. The debug
+	// messages of the transfer are then logged at info level and added to its
+	// structured log.
+	SetParcelDebugLogging(context.Context, *SetParcelDebugLoggingRequest) (*SetParcelDebugLoggingResponse, error)
+	// tapcli: `assets parcellog export`
+	// ExportParcelLog exports the structured log of a single outbound transfer,
+	// consisting of the executed send states, the calls to the chain backend and
+	// wallet including their timings and errors, and the debug messages of the
+	// transfer if scoped debug logging is enabled.
+	ExportParcelLog(context.Context, *ExportParcelLogRequest) (*ExportParcelLogResponse, error)
+	// tapcli: `assets statement`
+	// ExportTransferStatement exports all outbound asset transfers and completed
+	// inbound address receives tracked by the target daemon as an accounting
+	// statement.
+	ExportTransferStatement(context.Context, *ExportTransferStatementRequest) (*ExportTransferStatementResponse, error)
+	// tapcli: `assets freeze`
+	// FreezeAssetOutputs freezes asset outputs so they are skipped by coin
+	// selection, for example because of a compliance hold. As spending the anchor
+	// output of a frozen asset would move the frozen asset as well, no asset
+	// anchored at the same outpoint can be spent while the asset is frozen.
+	FreezeAssetOutputs(context.Context, *FreezeAssetOutputsRequest) (*FreezeAssetOutputsResponse, error)
+	// tapcli: `assets unfreeze`
+	// UnfreezeAssetOutputs unfreezes previously frozen asset outputs.
+	UnfreezeAssetOutputs(context.Context, *UnfreezeAssetOutputsRequest) (*UnfreezeAssetOutputsResponse, error)
+	// tapcli: `assets frozen`
+	// ListFrozenAssetOutputs lists all frozen asset outputs and the reasons they
+	// were frozen for.
+	ListFrozenAssetOutputs(context.Context, *ListFrozenAssetOutputsRequest) (*ListFrozenAssetOutputsResponse, error)
+	// tapcli: `assets watch add`
+	// WatchAsset imports the proof file of an asset owned by an external key to
+	// track it purely for monitoring, for example the collateral of a
+	// counterparty. Watched assets are never part of the balances, UTXOs or coin
+	// selection of the daemon. Once the anchor output of a watched asset is
+	// spent, a watched_asset_spent webhook notification is posted.
+	WatchAsset(context.Context, *WatchAssetRequest) (*WatchAssetResponse, error)
+	// tapcli: `assets watch remove`
+	// UnwatchAsset stops watching an asset.
+	UnwatchAsset(context.Context, *UnwatchAssetRequest) (*UnwatchAssetResponse, error)
+	// tapcli: `assets watch list`
+	// ListWatchedAssets lists the assets that are watched for monitoring.
+	ListWatchedAssets(context.Context, *ListWatchedAssetsRequest) (*ListWatchedAssetsResponse, error)
+	// tapcli: `assets atrisk`
+	// ListAtRiskAssets lists the assets whose anchor output was spent by a
+	// transaction that wasn't created by the daemon, for example because a key
+	// was compromised. At risk assets are frozen, and an anchor_spend_alert
+	// webhook notification is posted once such a spend is detected.
+	ListAtRiskAssets(context.Context, *ListAtRiskAssetsRequest) (*ListAtRiskAssetsResponse, error)
+	// tapcli: `assets annotatelot`
+	// AnnotateAssetLot sets the price annotation of the lot of an unspent asset
+	// UTXO. The annotation is carried over to the change of transfers that spend
+	// the lot.
+	AnnotateAssetLot(context.Context, *AnnotateAssetLotRequest) (*AnnotateAssetLotResponse, error)
+	// tapcli: `assets alias set`
+	// SetAssetAlias sets a human-readable alias for an asset ID or asset group in
+	// the local alias registry. An existing alias of the same asset ID or group
+	// is replaced.
+	SetAssetAlias(context.Context, *SetAssetAliasRequest) (*SetAssetAliasResponse, error)
+	// tapcli: `assets alias remove`
+	// RemoveAssetAlias removes an alias from the local alias registry.
+	RemoveAssetAlias(context.Context, *RemoveAssetAliasRequest) (*RemoveAssetAliasResponse, error)
+	// tapcli: `assets alias list`
+	// ListAssetAliases lists all aliases of the local alias registry.
+	ListAssetAliases(context.Context, *ListAssetAliasesRequest) (*ListAssetAliasesResponse, error)
+	// tapcli: `assets alias resolve`
+	// ResolveAssetAlias resolves an alias to the asset ID or group key it refers
+	// to. Aliases of the local registry take precedence over aliases of the
+	// remote registry, if one is configured. Aliases resolved through the remote
+	// registry are marked as unverified.
+	ResolveAssetAlias(context.Context, *ResolveAssetAliasRequest) (*AssetAlias, error)
+	// tapcli: `keys derivations`
+	// ListKeyDerivations lists the audit log of all keys that were derived from
+	// the backing lnd node for minting and transfers, together with the purpose
+	// and the object they were derived for.
+	ListKeyDerivations(context.Context, *ListKeyDerivationsRequest) (*ListKeyDerivationsResponse, error)
+	// tapcli: `keys reused`
+	// ListReusedAnchorKeys lists all internal keys that were used for more than
+	// one anchor output, together with the anchor outputs. Reusing internal keys
+	// links the anchor outputs on chain, which degrades privacy.
+	ListReusedAnchorKeys(context.Context, *ListReusedAnchorKeysRequest) (*ListReusedAnchorKeysResponse, error)
+	// tapcli: `keys audit`
+	// AuditScriptKeys scans the transfer log and the proof archive for script
+	// keys that are used by more than one unspent asset or by the outputs of more
+	// than one transfer, and flags them. No assets are sent to a script key while
+	// it has an open flag. The audit also runs periodically, if configured.
+	AuditScriptKeys(context.Context, *AuditScriptKeysRequest) (*AuditScriptKeysResponse, error)
+	// tapcli: `keys resolve`
+	// ResolveFlaggedScriptKey resolves the open flags of a reused script key,
+	// which allows sending assets to it again. A resolved flag is only reopened
+	// if the script key is later found in more outputs.
+	ResolveFlaggedScriptKey(context.Context, *ResolveFlaggedScriptKeyRequest) (*ResolveFlaggedScriptKeyResponse, error)
+	// tapcli: `keys disclosures`
+	// ExportScriptKeyDisclosures exports the internal key and tweak of the script
+	// key of each owned asset output. This allows a third party auditor to
+	// re-derive the script keys and audit the holdings of the daemon without
+	// being handed any spending keys. The disclosures can optionally be encrypted
+	// to the key of the auditor.
+	ExportScriptKeyDisclosures(context.Context, *ExportScriptKeyDisclosuresRequest) (*ExportScriptKeyDisclosuresResponse, error)
+	// tapcli: `keys descriptor export`
+	// ExportAssetDescriptor exports the asset descriptor of the wallet. Together
+	// with the seed of the backing lnd node, the descriptor contains everything
+	// needed to recover the assets of the wallet: the key derivation scheme and
+	// the ranges of keys that were derived, the groups and IDs of the assets to
+	// look for and the universe servers to fetch their proofs from. The
+	// descriptor doesn't contain any private key material.
+	ExportAssetDescriptor(context.Context, *ExportAssetDescriptorRequest) (*ExportAssetDescriptorResponse, error)
+	// tapcli: `keys descriptor import`
+	// ImportAssetDescriptor imports an asset descriptor into a wallet that was
+	// restored from the same seed. It makes sure the keys of the descriptor can
+	// be derived, advances the key families of the wallet past the keys that are
+	// already in use and adds the universe servers of the descriptor to the
+	// federation of the local universe.
+	ImportAssetDescriptor(context.Context, *ImportAssetDescriptorRequest) (*ImportAssetDescriptorResponse, error)
 	// tapcli: `stop`
 	// StopDaemon will send a shutdown request to the interrupt handler, triggering
 	// a graceful shutdown of the daemon.
@@ -324,10 +1192,60 @@ type TaprootAssetsServer interface {
 	// DecodeAddr decode a Taproot Asset address into a partial asset message that
 	// represents the asset it wants to receive.
 	DecodeAddr(context.Context, *DecodeAddrRequest) (*Addr, error)
+	// tapcli: `addrs inspect`
+	// InspectAddr decodes a Taproot Asset address and reports how the proofs of
+	// a transfer to it would be delivered: which proof courier would be used,
+	// whether this node supports it and the expected size of the proof. This
+	// allows senders to detect incompatible receivers before funding anything.
+	InspectAddr(context.Context, *InspectAddrRequest) (*InspectAddrResponse, error)
 	// tapcli: `addrs receives`
 	// List all receives for incoming asset transfers for addresses that were
 	// created previously.
 	AddrReceives(context.Context, *AddrReceivesRequest) (*AddrReceivesResponse, error)
+	// tapcli: `addrs receipt`
+	// ExportReceipt creates a receipt for a completed inbound transfer to an
+	// address. The receipt is signed by the identity key of the backing lnd node
+	// and can be handed to third parties as evidence of payment.
+	ExportReceipt(context.Context, *ExportReceiptRequest) (*TransferReceipt, error)
+	// tapcli: `addrs counterparty set`
+	// SetCounterparty adds a script key to the counterparty book under the given
+	// name, or renames it if it is already known. The names of known
+	// counterparties are shown for the outputs of transfers that are sent to
+	// them.
+	SetCounterparty(context.Context, *SetCounterpartyRequest) (*SetCounterpartyResponse, error)
+	// tapcli: `addrs counterparty remove`
+	// RemoveCounterparty removes all script keys of a counterparty from the
+	// counterparty book.
+	RemoveCounterparty(context.Context, *RemoveCounterpartyRequest) (*RemoveCounterpartyResponse, error)
+	// tapcli: `addrs counterparty list`
+	// ListCounterparties lists all script keys of the counterparty book.
+	ListCounterparties(context.Context, *ListCounterpartiesRequest) (*ListCounterpartiesResponse, error)
+	// tapcli: `addrs importscriptkey`
+	// ImportScriptKey imports an externally derived script key, for example one
+	// generated by a legacy system or another wallet, together with its internal
+	// key and tweak. Assets sent to an imported script key are recognized as
+	// our own and their proofs are retained.
+	ImportScriptKey(context.Context, *ImportScriptKeyRequest) (*ImportScriptKeyResponse, error)
+	// tapcli: `addrs importedscriptkeys`
+	// ListImportedScriptKeys lists all imported script keys.
+	ListImportedScriptKeys(context.Context, *ListImportedScriptKeysRequest) (*ListImportedScriptKeysResponse, error)
+	// tapcli: `addrs invoice create`
+	// CreateInvoice creates a new address for the given asset and amount and
+	// returns an invoice for it that is signed by the identity key of the
+	// backing lnd node. The invoice is settled once a transfer to its address
+	// completes.
+	CreateInvoice(context.Context, *CreateInvoiceRequest) (*LocalInvoice, error)
+	// tapcli: `addrs invoice decode`
+	// DecodeInvoice decodes an invoice and verifies its signature and address.
+	DecodeInvoice(context.Context, *DecodeInvoiceRequest) (*Invoice, error)
+	// tapcli: `addrs invoice lookup`
+	// LookupInvoice returns an invoice created by this node, together with its
+	// settlement state.
+	LookupInvoice(context.Context, *LookupInvoiceRequest) (*LocalInvoice, error)
+	// tapcli: `addrs invoice list`
+	// ListInvoices lists all invoices created by this node, together with their
+	// settlement state.
+	ListInvoices(context.Context, *ListInvoicesRequest) (*ListInvoicesResponse, error)
 	// tapcli: `proofs verify`
 	// VerifyProof attempts to verify a given proof file that claims to be anchored
 	// at the specified genesis point.
@@ -340,12 +1258,93 @@ type TaprootAssetsServer interface {
 	// ExportProof exports the latest raw proof file anchored at the specified
 	// script_key.
 	ExportProof(context.Context, *ExportProofRequest) (*ProofFile, error)
+	// tapcli: `proofs tiers`
+	// ProofArchiveStats returns the number and total size of the proofs in the
+	// hot and the cold tier of the on-disk proof archive, and the metrics of the
+	// proof scrubber. Requires tiering of the proof archive or scrubbing to be
+	// enabled.
+	ProofArchiveStats(context.Context, *ProofArchiveStatsRequest) (*ProofArchiveStatsResponse, error)
+	// PushArchiveProofs stores proofs in the central proof archive served by this
+	// node. The proofs are stored in the archive of the user the macaroon of the
+	// caller is scoped to. Requires the central proof archive to be enabled.
+	PushArchiveProofs(context.Context, *PushArchiveProofsRequest) (*PushArchiveProofsResponse, error)
+	// PullArchiveProof fetches a proof from the archive of the user the macaroon
+	// of the caller is scoped to. Requires the central proof archive to be
+	// enabled.
+	PullArchiveProof(context.Context, *PullArchiveProofRequest) (*ArchivedProof, error)
+	// tapcli: `proofs archivemacaroon`
+	// BakeArchiveMacaroon bakes a macaroon that only grants access to the archive
+	// of the given user in the central proof archive served by this node. The
+	// macaroon is handed to the node of the user.
+	BakeArchiveMacaroon(context.Context, *BakeArchiveMacaroonRequest) (*BakeArchiveMacaroonResponse, error)
 	// tapcli: `assets send`
 	// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt
 	// to complete an asset send. The method returns information w.r.t the on chain
 	// send, as well as the proof file information the receiver needs to fully
 	// receive the asset.
 	SendAsset(context.Context, *SendAssetRequest) (*SendAssetResponse, error)
+	// tapcli: `assets scheduled list`
+	// ListScheduledTransfers lists the transfers that are signed and committed,
+	// but whose anchor transaction is held back until their broadcast trigger is
+	// reached.
+	ListScheduledTransfers(context.Context, *ListScheduledTransfersRequest) (*ListScheduledTransfersResponse, error)
+	// tapcli: `assets scheduled cancel`
+	// CancelScheduledTransfer cancels a scheduled transfer before its anchor
+	// transaction is broadcast. The transfer is removed and its asset inputs can
+	// be spent again right away.
+	CancelScheduledTransfer(context.Context, *CancelScheduledTransferRequest) (*CancelScheduledTransferResponse, error)
+	// tapcli: `assets session open`
+	// OpenAnchorSession opens a named anchor session. Separate callers can
+	// register parcels into the session, which are all sent in a single anchor
+	// transaction once the session is sealed.
+	OpenAnchorSession(context.Context, *OpenAnchorSessionRequest) (*OpenAnchorSessionResponse, error)
+	// tapcli: `assets session register`
+	// RegisterAnchorSessionParcel registers a parcel that sends to the given
+	// addresses in an open anchor session. The call blocks until the session is
+	// sealed and returns the caller's view of the shared transfer, which only
+	// contains the caller's outputs and its share of the chain fees. If the call
+	// is canceled, the parcel stays registered and is sent once the session is
+	// sealed.
+	RegisterAnchorSessionParcel(context.Context, *RegisterAnchorSessionParcelRequest) (*RegisterAnchorSessionParcelResponse, error)
+	// tapcli: `assets session seal`
+	// SealAnchorSession closes an anchor session and sends the parcels of all
+	// callers in a single anchor transaction. Either all or none of the parcels
+	// are sent.
+	SealAnchorSession(context.Context, *SealAnchorSessionRequest) (*SealAnchorSessionResponse, error)
+	// tapcli: `assets session abort`
+	// AbortAnchorSession aborts an open anchor session. None of its parcels are
+	// sent and all callers that registered a parcel receive an error.
+	AbortAnchorSession(context.Context, *AbortAnchorSessionRequest) (*AbortAnchorSessionResponse, error)
+	// tapcli: `assets airdrop start`
+	// StartAirdrop sends assets to a manifest of many recipients. All addresses
+	// of the manifest are validated up front, then the recipients are split into
+	// batched transfers that are sent in the background. The status of every
+	// recipient is tracked, so a partially failed airdrop can be resumed.
+	StartAirdrop(context.Context, *StartAirdropRequest) (*StartAirdropResponse, error)
+	// tapcli: `assets airdrop resume`
+	// ResumeAirdrop retries the pending and failed recipients of an airdrop.
+	// Recipients whose transfer is still in flight aren't retried, as the
+	// transfer may still be completed.
+	ResumeAirdrop(context.Context, *ResumeAirdropRequest) (*ResumeAirdropResponse, error)
+	// tapcli: `assets airdrop list`
+	// ListAirdrops lists the airdrops and the status of their recipients.
+	ListAirdrops(context.Context, *ListAirdropsRequest) (*ListAirdropsResponse, error)
+	// tapcli: `assets templates create`
+	// CreateTransferTemplate stores a named set of recipients of an asset
+	// together with the fee policy they are paid with, so recurring transfers
+	// to the same recipients can be sent with a single call.
+	CreateTransferTemplate(context.Context, *CreateTransferTemplateRequest) (*CreateTransferTemplateResponse, error)
+	// tapcli: `assets templates list`
+	// ListTransferTemplates lists the stored transfer templates.
+	ListTransferTemplates(context.Context, *ListTransferTemplatesRequest) (*ListTransferTemplatesResponse, error)
+	// tapcli: `assets templates delete`
+	// DeleteTransferTemplate deletes a transfer template.
+	DeleteTransferTemplate(context.Context, *DeleteTransferTemplateRequest) (*DeleteTransferTemplateResponse, error)
+	// tapcli: `assets templates execute`
+	// ExecuteTransferTemplate pays all recipients of a transfer template in a
+	// single transfer, sent with the fee policy of the template. The call
+	// returns once the anchor transaction is broadcast.
+	ExecuteTransferTemplate(context.Context, *ExecuteTransferTemplateRequest) (*SendAssetResponse, error)
 	// tapcli: `getinfo`
 	// GetInfo returns the information for the node.
 	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
@@ -374,9 +1373,84 @@ func (UnimplementedTaprootAssetsServer) ListGroups(context.Context, *ListGroupsR
 func (UnimplementedTaprootAssetsServer) ListBalances(context.Context, *ListBalancesRequest) (*ListBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBalances not implemented")
 }
+func (UnimplementedTaprootAssetsServer) ListBalanceHistory(context.Context, *ListBalanceHistoryRequest) (*ListBalanceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBalanceHistory not implemented")
+}
 func (UnimplementedTaprootAssetsServer) ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTransfers not implemented")
 }
+func (UnimplementedTaprootAssetsServer) ListProofDeliveryAttempts(context.Context, *ListProofDeliveryAttemptsRequest) (*ListProofDeliveryAttemptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProofDeliveryAttempts not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ParcelQueueStats(context.Context, *ParcelQueueStatsRequest) (*ParcelQueueStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParcelQueueStats not implemented")
+}
+func (UnimplementedTaprootAssetsServer) SetParcelDebugLogging(context.Context, *SetParcelDebugLoggingRequest) (*SetParcelDebugLoggingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetParcelDebugLogging not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ExportParcelLog(context.Context, *ExportParcelLogRequest) (*ExportParcelLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportParcelLog not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ExportTransferStatement(context.Context, *ExportTransferStatementRequest) (*ExportTransferStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTransferStatement not implemented")
+}
+func (UnimplementedTaprootAssetsServer) FreezeAssetOutputs(context.Context, *FreezeAssetOutputsRequest) (*FreezeAssetOutputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeAssetOutputs not implemented")
+}
+func (UnimplementedTaprootAssetsServer) UnfreezeAssetOutputs(context.Context, *UnfreezeAssetOutputsRequest) (*UnfreezeAssetOutputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeAssetOutputs not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ListFrozenAssetOutputs(context.Context, *ListFrozenAssetOutputsRequest) (*ListFrozenAssetOutputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFrozenAssetOutputs not implemented")
+}
+func (UnimplementedTaprootAssetsServer) WatchAsset(context.Context, *WatchAssetRequest) (*WatchAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchAsset not implemented")
+}
+func (UnimplementedTaprootAssetsServer) UnwatchAsset(context.Context, *UnwatchAssetRequest) (*UnwatchAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnwatchAsset not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ListWatchedAssets(context.Context, *ListWatchedAssetsRequest) (*ListWatchedAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWatchedAssets not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ListAtRiskAssets(context.Context, *ListAtRiskAssetsRequest) (*ListAtRiskAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAtRiskAssets not implemented")
+}
+func (UnimplementedTaprootAssetsServer) AnnotateAssetLot(context.Context, *AnnotateAssetLotRequest) (*AnnotateAssetLotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnotateAssetLot not implemented")
+}
+func (UnimplementedTaprootAssetsServer) SetAssetAlias(context.Context, *SetAssetAliasRequest) (*SetAssetAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAssetAlias not implemented")
+}
+func (UnimplementedTaprootAssetsServer) RemoveAssetAlias(context.Context, *RemoveAssetAliasRequest) (*RemoveAssetAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAssetAlias not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ListAssetAliases(context.Context, *ListAssetAliasesRequest) (*ListAssetAliasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssetAliases not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ResolveAssetAlias(context.Context, *ResolveAssetAliasRequest) (*AssetAlias, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveAssetAlias not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ListKeyDerivations(context.Context, *ListKeyDerivationsRequest) (*ListKeyDerivationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKeyDerivations not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ListReusedAnchorKeys(context.Context, *ListReusedAnchorKeysRequest) (*ListReusedAnchorKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReusedAnchorKeys not implemented")
+}
+func (UnimplementedTaprootAssetsServer) AuditScriptKeys(context.Context, *AuditScriptKeysRequest) (*AuditScriptKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditScriptKeys not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ResolveFlaggedScriptKey(context.Context, *ResolveFlaggedScriptKeyRequest) (*ResolveFlaggedScriptKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveFlaggedScriptKey not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ExportScriptKeyDisclosures(context.Context, *ExportScriptKeyDisclosuresRequest) (*ExportScriptKeyDisclosuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportScriptKeyDisclosures not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ExportAssetDescriptor(context.Context, *ExportAssetDescriptorRequest) (*ExportAssetDescriptorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAssetDescriptor not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ImportAssetDescriptor(context.Context, *ImportAssetDescriptorRequest) (*ImportAssetDescriptorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportAssetDescriptor not implemented")
+}
 func (UnimplementedTaprootAssetsServer) StopDaemon(context.Context, *StopRequest) (*StopResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopDaemon not implemented")
 }
@@ -392,9 +1466,42 @@ func (UnimplementedTaprootAssetsServer) NewAddr(context.Context, *NewAddrRequest
 func (UnimplementedTaprootAssetsServer) DecodeAddr(context.Context, *DecodeAddrRequest) (*Addr, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DecodeAddr not implemented")
 }
+func (UnimplementedTaprootAssetsServer) InspectAddr(context.Context, *InspectAddrRequest) (*InspectAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectAddr not implemented")
+}
 func (UnimplementedTaprootAssetsServer) AddrReceives(context.Context, *AddrReceivesRequest) (*AddrReceivesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddrReceives not implemented")
 }
+func (UnimplementedTaprootAssetsServer) ExportReceipt(context.Context, *ExportReceiptRequest) (*TransferReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportReceipt not implemented")
+}
+func (UnimplementedTaprootAssetsServer) SetCounterparty(context.Context, *SetCounterpartyRequest) (*SetCounterpartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCounterparty not implemented")
+}
+func (UnimplementedTaprootAssetsServer) RemoveCounterparty(context.Context, *RemoveCounterpartyRequest) (*RemoveCounterpartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCounterparty not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ListCounterparties(context.Context, *ListCounterpartiesRequest) (*ListCounterpartiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCounterparties not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ImportScriptKey(context.Context, *ImportScriptKeyRequest) (*ImportScriptKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportScriptKey not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ListImportedScriptKeys(context.Context, *ListImportedScriptKeysRequest) (*ListImportedScriptKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListImportedScriptKeys not implemented")
+}
+func (UnimplementedTaprootAssetsServer) CreateInvoice(context.Context, *CreateInvoiceRequest) (*LocalInvoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvoice not implemented")
+}
+func (UnimplementedTaprootAssetsServer) DecodeInvoice(context.Context, *DecodeInvoiceRequest) (*Invoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeInvoice not implemented")
+}
+func (UnimplementedTaprootAssetsServer) LookupInvoice(context.Context, *LookupInvoiceRequest) (*LocalInvoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupInvoice not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ListInvoices(context.Context, *ListInvoicesRequest) (*ListInvoicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvoices not implemented")
+}
 func (UnimplementedTaprootAssetsServer) VerifyProof(context.Context, *ProofFile) (*VerifyProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyProof not implemented")
 }
@@ -404,297 +1511,1302 @@ func (UnimplementedTaprootAssetsServer) DecodeProof(context.Context, *DecodeProo
 func (UnimplementedTaprootAssetsServer) ExportProof(context.Context, *ExportProofRequest) (*ProofFile, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportProof not implemented")
 }
+func (UnimplementedTaprootAssetsServer) ProofArchiveStats(context.Context, *ProofArchiveStatsRequest) (*ProofArchiveStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProofArchiveStats not implemented")
+}
+func (UnimplementedTaprootAssetsServer) PushArchiveProofs(context.Context, *PushArchiveProofsRequest) (*PushArchiveProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushArchiveProofs not implemented")
+}
+func (UnimplementedTaprootAssetsServer) PullArchiveProof(context.Context, *PullArchiveProofRequest) (*ArchivedProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullArchiveProof not implemented")
+}
+func (UnimplementedTaprootAssetsServer) BakeArchiveMacaroon(context.Context, *BakeArchiveMacaroonRequest) (*BakeArchiveMacaroonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BakeArchiveMacaroon not implemented")
+}
 func (UnimplementedTaprootAssetsServer) SendAsset(context.Context, *SendAssetRequest) (*SendAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendAsset not implemented")
 }
+func (UnimplementedTaprootAssetsServer) ListScheduledTransfers(context.Context, *ListScheduledTransfersRequest) (*ListScheduledTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledTransfers not implemented")
+}
+func (UnimplementedTaprootAssetsServer) CancelScheduledTransfer(context.Context, *CancelScheduledTransferRequest) (*CancelScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledTransfer not implemented")
+}
+func (UnimplementedTaprootAssetsServer) OpenAnchorSession(context.Context, *OpenAnchorSessionRequest) (*OpenAnchorSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenAnchorSession not implemented")
+}
+func (UnimplementedTaprootAssetsServer) RegisterAnchorSessionParcel(context.Context, *RegisterAnchorSessionParcelRequest) (*RegisterAnchorSessionParcelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAnchorSessionParcel not implemented")
+}
+func (UnimplementedTaprootAssetsServer) SealAnchorSession(context.Context, *SealAnchorSessionRequest) (*SealAnchorSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SealAnchorSession not implemented")
+}
+func (UnimplementedTaprootAssetsServer) AbortAnchorSession(context.Context, *AbortAnchorSessionRequest) (*AbortAnchorSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortAnchorSession not implemented")
+}
+func (UnimplementedTaprootAssetsServer) StartAirdrop(context.Context, *StartAirdropRequest) (*StartAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartAirdrop not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ResumeAirdrop(context.Context, *ResumeAirdropRequest) (*ResumeAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeAirdrop not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ListAirdrops(context.Context, *ListAirdropsRequest) (*ListAirdropsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAirdrops not implemented")
+}
+func (UnimplementedTaprootAssetsServer) CreateTransferTemplate(context.Context, *CreateTransferTemplateRequest) (*CreateTransferTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransferTemplate not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ListTransferTemplates(context.Context, *ListTransferTemplatesRequest) (*ListTransferTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransferTemplates not implemented")
+}
+func (UnimplementedTaprootAssetsServer) DeleteTransferTemplate(context.Context, *DeleteTransferTemplateRequest) (*DeleteTransferTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTransferTemplate not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ExecuteTransferTemplate(context.Context, *ExecuteTransferTemplateRequest) (*SendAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteTransferTemplate not implemented")
+}
 func (UnimplementedTaprootAssetsServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
 }
-func (UnimplementedTaprootAssetsServer) SubscribeSendAssetEventNtfns(*SubscribeSendAssetEventNtfnsRequest, TaprootAssets_SubscribeSendAssetEventNtfnsServer) error {
-	return status.Errorf(codes.Unimplemented, "method SubscribeSendAssetEventNtfns not implemented")
+func (UnimplementedTaprootAssetsServer) SubscribeSendAssetEventNtfns(*SubscribeSendAssetEventNtfnsRequest, TaprootAssets_SubscribeSendAssetEventNtfnsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeSendAssetEventNtfns not implemented")
+}
+func (UnimplementedTaprootAssetsServer) FetchAssetMeta(context.Context, *FetchAssetMetaRequest) (*AssetMeta, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchAssetMeta not implemented")
+}
+func (UnimplementedTaprootAssetsServer) mustEmbedUnimplementedTaprootAssetsServer() {}
+
+// UnsafeTaprootAssetsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaprootAssetsServer will
+// result in compilation errors.
+type UnsafeTaprootAssetsServer interface {
+	mustEmbedUnimplementedTaprootAssetsServer()
+}
+
+func RegisterTaprootAssetsServer(s grpc.ServiceRegistrar, srv TaprootAssetsServer) {
+	s.RegisterService(&TaprootAssets_ServiceDesc, srv)
+}
+
+func _TaprootAssets_ListAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListAssets(ctx, req.(*ListAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListUtxos(ctx, req.(*ListUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListGroups(ctx, req.(*ListGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListBalances(ctx, req.(*ListBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListBalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListBalanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListBalanceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListBalanceHistory(ctx, req.(*ListBalanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListTransfers(ctx, req.(*ListTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListProofDeliveryAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProofDeliveryAttemptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListProofDeliveryAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListProofDeliveryAttempts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListProofDeliveryAttempts(ctx, req.(*ListProofDeliveryAttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ParcelQueueStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParcelQueueStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ParcelQueueStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ParcelQueueStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ParcelQueueStats(ctx, req.(*ParcelQueueStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_SetParcelDebugLogging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetParcelDebugLoggingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).SetParcelDebugLogging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/SetParcelDebugLogging",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).SetParcelDebugLogging(ctx, req.(*SetParcelDebugLoggingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ExportParcelLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportParcelLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ExportParcelLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ExportParcelLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ExportParcelLog(ctx, req.(*ExportParcelLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ExportTransferStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTransferStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ExportTransferStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ExportTransferStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ExportTransferStatement(ctx, req.(*ExportTransferStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_FreezeAssetOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeAssetOutputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).FreezeAssetOutputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/FreezeAssetOutputs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).FreezeAssetOutputs(ctx, req.(*FreezeAssetOutputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_UnfreezeAssetOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeAssetOutputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).UnfreezeAssetOutputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/UnfreezeAssetOutputs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).UnfreezeAssetOutputs(ctx, req.(*UnfreezeAssetOutputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListFrozenAssetOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFrozenAssetOutputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListFrozenAssetOutputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListFrozenAssetOutputs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListFrozenAssetOutputs(ctx, req.(*ListFrozenAssetOutputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_WatchAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).WatchAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/WatchAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).WatchAsset(ctx, req.(*WatchAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_UnwatchAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnwatchAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).UnwatchAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/UnwatchAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).UnwatchAsset(ctx, req.(*UnwatchAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListWatchedAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWatchedAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListWatchedAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListWatchedAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListWatchedAssets(ctx, req.(*ListWatchedAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListAtRiskAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAtRiskAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListAtRiskAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListAtRiskAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListAtRiskAssets(ctx, req.(*ListAtRiskAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_AnnotateAssetLot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnotateAssetLotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).AnnotateAssetLot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/AnnotateAssetLot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).AnnotateAssetLot(ctx, req.(*AnnotateAssetLotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_SetAssetAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAssetAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).SetAssetAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/SetAssetAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).SetAssetAlias(ctx, req.(*SetAssetAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_RemoveAssetAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAssetAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).RemoveAssetAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/RemoveAssetAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).RemoveAssetAlias(ctx, req.(*RemoveAssetAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListAssetAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssetAliasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListAssetAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListAssetAliases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListAssetAliases(ctx, req.(*ListAssetAliasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ResolveAssetAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveAssetAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ResolveAssetAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ResolveAssetAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ResolveAssetAlias(ctx, req.(*ResolveAssetAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListKeyDerivations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKeyDerivationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListKeyDerivations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListKeyDerivations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListKeyDerivations(ctx, req.(*ListKeyDerivationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListReusedAnchorKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReusedAnchorKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListReusedAnchorKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListReusedAnchorKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListReusedAnchorKeys(ctx, req.(*ListReusedAnchorKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_AuditScriptKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditScriptKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).AuditScriptKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/AuditScriptKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).AuditScriptKeys(ctx, req.(*AuditScriptKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ResolveFlaggedScriptKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveFlaggedScriptKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ResolveFlaggedScriptKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ResolveFlaggedScriptKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ResolveFlaggedScriptKey(ctx, req.(*ResolveFlaggedScriptKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ExportScriptKeyDisclosures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportScriptKeyDisclosuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ExportScriptKeyDisclosures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ExportScriptKeyDisclosures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ExportScriptKeyDisclosures(ctx, req.(*ExportScriptKeyDisclosuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ExportAssetDescriptor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAssetDescriptorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ExportAssetDescriptor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ExportAssetDescriptor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ExportAssetDescriptor(ctx, req.(*ExportAssetDescriptorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ImportAssetDescriptor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAssetDescriptorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ImportAssetDescriptor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ImportAssetDescriptor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ImportAssetDescriptor(ctx, req.(*ImportAssetDescriptorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_StopDaemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).StopDaemon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/StopDaemon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).StopDaemon(ctx, req.(*StopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_DebugLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).DebugLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/DebugLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).DebugLevel(ctx, req.(*DebugLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_QueryAddrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).QueryAddrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/QueryAddrs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).QueryAddrs(ctx, req.(*QueryAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_NewAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).NewAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/NewAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).NewAddr(ctx, req.(*NewAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_DecodeAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).DecodeAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/DecodeAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).DecodeAddr(ctx, req.(*DecodeAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_InspectAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).InspectAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/InspectAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).InspectAddr(ctx, req.(*InspectAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_AddrReceives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddrReceivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).AddrReceives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/AddrReceives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).AddrReceives(ctx, req.(*AddrReceivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ExportReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ExportReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ExportReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ExportReceipt(ctx, req.(*ExportReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_SetCounterparty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCounterpartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).SetCounterparty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/SetCounterparty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).SetCounterparty(ctx, req.(*SetCounterpartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_RemoveCounterparty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCounterpartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).RemoveCounterparty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/RemoveCounterparty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).RemoveCounterparty(ctx, req.(*RemoveCounterpartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListCounterparties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCounterpartiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListCounterparties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListCounterparties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListCounterparties(ctx, req.(*ListCounterpartiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ImportScriptKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportScriptKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ImportScriptKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ImportScriptKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ImportScriptKey(ctx, req.(*ImportScriptKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListImportedScriptKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImportedScriptKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListImportedScriptKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListImportedScriptKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListImportedScriptKeys(ctx, req.(*ListImportedScriptKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_CreateInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).CreateInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/CreateInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).CreateInvoice(ctx, req.(*CreateInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_DecodeInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).DecodeInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/DecodeInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).DecodeInvoice(ctx, req.(*DecodeInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_LookupInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).LookupInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/LookupInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).LookupInvoice(ctx, req.(*LookupInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvoicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListInvoices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListInvoices(ctx, req.(*ListInvoicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_VerifyProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProofFile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).VerifyProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/VerifyProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).VerifyProof(ctx, req.(*ProofFile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_DecodeProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).DecodeProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/DecodeProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).DecodeProof(ctx, req.(*DecodeProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ExportProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ExportProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ExportProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ExportProof(ctx, req.(*ExportProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTaprootAssetsServer) FetchAssetMeta(context.Context, *FetchAssetMetaRequest) (*AssetMeta, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FetchAssetMeta not implemented")
+
+func _TaprootAssets_ProofArchiveStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProofArchiveStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ProofArchiveStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ProofArchiveStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ProofArchiveStats(ctx, req.(*ProofArchiveStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTaprootAssetsServer) mustEmbedUnimplementedTaprootAssetsServer() {}
 
-// UnsafeTaprootAssetsServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to TaprootAssetsServer will
-// result in compilation errors.
-type UnsafeTaprootAssetsServer interface {
-	mustEmbedUnimplementedTaprootAssetsServer()
+func _TaprootAssets_PushArchiveProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushArchiveProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).PushArchiveProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/PushArchiveProofs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).PushArchiveProofs(ctx, req.(*PushArchiveProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterTaprootAssetsServer(s grpc.ServiceRegistrar, srv TaprootAssetsServer) {
-	s.RegisterService(&TaprootAssets_ServiceDesc, srv)
+func _TaprootAssets_PullArchiveProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullArchiveProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).PullArchiveProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/PullArchiveProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).PullArchiveProof(ctx, req.(*PullArchiveProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_ListAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAssetRequest)
+func _TaprootAssets_BakeArchiveMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BakeArchiveMacaroonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).ListAssets(ctx, in)
+		return srv.(TaprootAssetsServer).BakeArchiveMacaroon(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/ListAssets",
+		FullMethod: "/taprpc.TaprootAssets/BakeArchiveMacaroon",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).ListAssets(ctx, req.(*ListAssetRequest))
+		return srv.(TaprootAssetsServer).BakeArchiveMacaroon(ctx, req.(*BakeArchiveMacaroonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_ListUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUtxosRequest)
+func _TaprootAssets_SendAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendAssetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).ListUtxos(ctx, in)
+		return srv.(TaprootAssetsServer).SendAsset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/ListUtxos",
+		FullMethod: "/taprpc.TaprootAssets/SendAsset",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).ListUtxos(ctx, req.(*ListUtxosRequest))
+		return srv.(TaprootAssetsServer).SendAsset(ctx, req.(*SendAssetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListGroupsRequest)
+func _TaprootAssets_ListScheduledTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledTransfersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).ListGroups(ctx, in)
+		return srv.(TaprootAssetsServer).ListScheduledTransfers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/ListGroups",
+		FullMethod: "/taprpc.TaprootAssets/ListScheduledTransfers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).ListGroups(ctx, req.(*ListGroupsRequest))
+		return srv.(TaprootAssetsServer).ListScheduledTransfers(ctx, req.(*ListScheduledTransfersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_ListBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBalancesRequest)
+func _TaprootAssets_CancelScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledTransferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).ListBalances(ctx, in)
+		return srv.(TaprootAssetsServer).CancelScheduledTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/ListBalances",
+		FullMethod: "/taprpc.TaprootAssets/CancelScheduledTransfer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).ListBalances(ctx, req.(*ListBalancesRequest))
+		return srv.(TaprootAssetsServer).CancelScheduledTransfer(ctx, req.(*CancelScheduledTransferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_ListTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTransfersRequest)
+func _TaprootAssets_OpenAnchorSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenAnchorSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).ListTransfers(ctx, in)
+		return srv.(TaprootAssetsServer).OpenAnchorSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/ListTransfers",
+		FullMethod: "/taprpc.TaprootAssets/OpenAnchorSession",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).ListTransfers(ctx, req.(*ListTransfersRequest))
+		return srv.(TaprootAssetsServer).OpenAnchorSession(ctx, req.(*OpenAnchorSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_StopDaemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopRequest)
+func _TaprootAssets_RegisterAnchorSessionParcel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterAnchorSessionParcelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).StopDaemon(ctx, in)
+		return srv.(TaprootAssetsServer).RegisterAnchorSessionParcel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/StopDaemon",
+		FullMethod: "/taprpc.TaprootAssets/RegisterAnchorSessionParcel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).StopDaemon(ctx, req.(*StopRequest))
+		return srv.(TaprootAssetsServer).RegisterAnchorSessionParcel(ctx, req.(*RegisterAnchorSessionParcelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_DebugLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DebugLevelRequest)
+func _TaprootAssets_SealAnchorSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SealAnchorSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).DebugLevel(ctx, in)
+		return srv.(TaprootAssetsServer).SealAnchorSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/DebugLevel",
+		FullMethod: "/taprpc.TaprootAssets/SealAnchorSession",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).DebugLevel(ctx, req.(*DebugLevelRequest))
+		return srv.(TaprootAssetsServer).SealAnchorSession(ctx, req.(*SealAnchorSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_QueryAddrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAddrRequest)
+func _TaprootAssets_AbortAnchorSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortAnchorSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).QueryAddrs(ctx, in)
+		return srv.(TaprootAssetsServer).AbortAnchorSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/QueryAddrs",
+		FullMethod: "/taprpc.TaprootAssets/AbortAnchorSession",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).QueryAddrs(ctx, req.(*QueryAddrRequest))
+		return srv.(TaprootAssetsServer).AbortAnchorSession(ctx, req.(*AbortAnchorSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_NewAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewAddrRequest)
+func _TaprootAssets_StartAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartAirdropRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).NewAddr(ctx, in)
+		return srv.(TaprootAssetsServer).StartAirdrop(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/NewAddr",
+		FullMethod: "/taprpc.TaprootAssets/StartAirdrop",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).NewAddr(ctx, req.(*NewAddrRequest))
+		return srv.(TaprootAssetsServer).StartAirdrop(ctx, req.(*StartAirdropRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_DecodeAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DecodeAddrRequest)
+func _TaprootAssets_ResumeAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeAirdropRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).DecodeAddr(ctx, in)
+		return srv.(TaprootAssetsServer).ResumeAirdrop(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/DecodeAddr",
+		FullMethod: "/taprpc.TaprootAssets/ResumeAirdrop",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).DecodeAddr(ctx, req.(*DecodeAddrRequest))
+		return srv.(TaprootAssetsServer).ResumeAirdrop(ctx, req.(*ResumeAirdropRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_AddrReceives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddrReceivesRequest)
+func _TaprootAssets_ListAirdrops_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAirdropsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).AddrReceives(ctx, in)
+		return srv.(TaprootAssetsServer).ListAirdrops(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/AddrReceives",
+		FullMethod: "/taprpc.TaprootAssets/ListAirdrops",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).AddrReceives(ctx, req.(*AddrReceivesRequest))
+		return srv.(TaprootAssetsServer).ListAirdrops(ctx, req.(*ListAirdropsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_VerifyProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProofFile)
+func _TaprootAssets_CreateTransferTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransferTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).VerifyProof(ctx, in)
+		return srv.(TaprootAssetsServer).CreateTransferTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/VerifyProof",
+		FullMethod: "/taprpc.TaprootAssets/CreateTransferTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).VerifyProof(ctx, req.(*ProofFile))
+		return srv.(TaprootAssetsServer).CreateTransferTemplate(ctx, req.(*CreateTransferTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_DecodeProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DecodeProofRequest)
+func _TaprootAssets_ListTransferTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransferTemplatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).DecodeProof(ctx, in)
+		return srv.(TaprootAssetsServer).ListTransferTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/DecodeProof",
+		FullMethod: "/taprpc.TaprootAssets/ListTransferTemplates",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).DecodeProof(ctx, req.(*DecodeProofRequest))
+		return srv.(TaprootAssetsServer).ListTransferTemplates(ctx, req.(*ListTransferTemplatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_ExportProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExportProofRequest)
+func _TaprootAssets_DeleteTransferTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTransferTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).ExportProof(ctx, in)
+		return srv.(TaprootAssetsServer).DeleteTransferTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/ExportProof",
+		FullMethod: "/taprpc.TaprootAssets/DeleteTransferTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).ExportProof(ctx, req.(*ExportProofRequest))
+		return srv.(TaprootAssetsServer).DeleteTransferTemplate(ctx, req.(*DeleteTransferTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaprootAssets_SendAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendAssetRequest)
+func _TaprootAssets_ExecuteTransferTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteTransferTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaprootAssetsServer).SendAsset(ctx, in)
+		return srv.(TaprootAssetsServer).ExecuteTransferTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/taprpc.TaprootAssets/SendAsset",
+		FullMethod: "/taprpc.TaprootAssets/ExecuteTransferTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaprootAssetsServer).SendAsset(ctx, req.(*SendAssetRequest))
+		return srv.(TaprootAssetsServer).ExecuteTransferTemplate(ctx, req.(*ExecuteTransferTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -779,10 +2891,110 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBalances",
 			Handler:    _TaprootAssets_ListBalances_Handler,
 		},
+		{
+			MethodName: "ListBalanceHistory",
+			Handler:    _TaprootAssets_ListBalanceHistory_Handler,
+		},
 		{
 			MethodName: "ListTransfers",
 			Handler:    _TaprootAssets_ListTransfers_Handler,
 		},
+		{
+			MethodName: "ListProofDeliveryAttempts",
+			Handler:    _TaprootAssets_ListProofDeliveryAttempts_Handler,
+		},
+		{
+			MethodName: "ParcelQueueStats",
+			Handler:    _TaprootAssets_ParcelQueueStats_Handler,
+		},
+		{
+			MethodName: "SetParcelDebugLogging",
+			Handler:    _TaprootAssets_SetParcelDebugLogging_Handler,
+		},
+		{
+			MethodName: "ExportParcelLog",
+			Handler:    _TaprootAssets_ExportParcelLog_Handler,
+		},
+		{
+			MethodName: "ExportTransferStatement",
+			Handler:    _TaprootAssets_ExportTransferStatement_Handler,
+		},
+		{
+			MethodName: "FreezeAssetOutputs",
+			Handler:    _TaprootAssets_FreezeAssetOutputs_Handler,
+		},
+		{
+			MethodName: "UnfreezeAssetOutputs",
+			Handler:    _TaprootAssets_UnfreezeAssetOutputs_Handler,
+		},
+		{
+			MethodName: "ListFrozenAssetOutputs",
+			Handler:    _TaprootAssets_ListFrozenAssetOutputs_Handler,
+		},
+		{
+			MethodName: "WatchAsset",
+			Handler:    _TaprootAssets_WatchAsset_Handler,
+		},
+		{
+			MethodName: "UnwatchAsset",
+			Handler:    _TaprootAssets_UnwatchAsset_Handler,
+		},
+		{
+			MethodName: "ListWatchedAssets",
+			Handler:    _TaprootAssets_ListWatchedAssets_Handler,
+		},
+		{
+			MethodName: "ListAtRiskAssets",
+			Handler:    _TaprootAssets_ListAtRiskAssets_Handler,
+		},
+		{
+			MethodName: "AnnotateAssetLot",
+			Handler:    _TaprootAssets_AnnotateAssetLot_Handler,
+		},
+		{
+			MethodName: "SetAssetAlias",
+			Handler:    _TaprootAssets_SetAssetAlias_Handler,
+		},
+		{
+			MethodName: "RemoveAssetAlias",
+			Handler:    _TaprootAssets_RemoveAssetAlias_Handler,
+		},
+		{
+			MethodName: "ListAssetAliases",
+			Handler:    _TaprootAssets_ListAssetAliases_Handler,
+		},
+		{
+			MethodName: "ResolveAssetAlias",
+			Handler:    _TaprootAssets_ResolveAssetAlias_Handler,
+		},
+		{
+			MethodName: "ListKeyDerivations",
+			Handler:    _TaprootAssets_ListKeyDerivations_Handler,
+		},
+		{
+			MethodName: "ListReusedAnchorKeys",
+			Handler:    _TaprootAssets_ListReusedAnchorKeys_Handler,
+		},
+		{
+			MethodName: "AuditScriptKeys",
+			Handler:    _TaprootAssets_AuditScriptKeys_Handler,
+		},
+		{
+			MethodName: "ResolveFlaggedScriptKey",
+			Handler:    _TaprootAssets_ResolveFlaggedScriptKey_Handler,
+		},
+		{
+			MethodName: "ExportScriptKeyDisclosures",
+			Handler:    _TaprootAssets_ExportScriptKeyDisclosures_Handler,
+		},
+		{
+			MethodName: "ExportAssetDescriptor",
+			Handler:    _TaprootAssets_ExportAssetDescriptor_Handler,
+		},
+		{
+			MethodName: "ImportAssetDescriptor",
+			Handler:    _TaprootAssets_ImportAssetDescriptor_Handler,
+		},
 		{
 			MethodName: "StopDaemon",
 			Handler:    _TaprootAssets_StopDaemon_Handler,
@@ -803,10 +3015,54 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DecodeAddr",
 			Handler:    _TaprootAssets_DecodeAddr_Handler,
 		},
+		{
+			MethodName: "InspectAddr",
+			Handler:    _TaprootAssets_InspectAddr_Handler,
+		},
 		{
 			MethodName: "AddrReceives",
 			Handler:    _TaprootAssets_AddrReceives_Handler,
 		},
+		{
+			MethodName: "ExportReceipt",
+			Handler:    _TaprootAssets_ExportReceipt_Handler,
+		},
+		{
+			MethodName: "SetCounterparty",
+			Handler:    _TaprootAssets_SetCounterparty_Handler,
+		},
+		{
+			MethodName: "RemoveCounterparty",
+			Handler:    _TaprootAssets_RemoveCounterparty_Handler,
+		},
+		{
+			MethodName: "ListCounterparties",
+			Handler:    _TaprootAssets_ListCounterparties_Handler,
+		},
+		{
+			MethodName: "ImportScriptKey",
+			Handler:    _TaprootAssets_ImportScriptKey_Handler,
+		},
+		{
+			MethodName: "ListImportedScriptKeys",
+			Handler:    _TaprootAssets_ListImportedScriptKeys_Handler,
+		},
+		{
+			MethodName: "CreateInvoice",
+			Handler:    _TaprootAssets_CreateInvoice_Handler,
+		},
+		{
+			MethodName: "DecodeInvoice",
+			Handler:    _TaprootAssets_DecodeInvoice_Handler,
+		},
+		{
+			MethodName: "LookupInvoice",
+			Handler:    _TaprootAssets_LookupInvoice_Handler,
+		},
+		{
+			MethodName: "ListInvoices",
+			Handler:    _TaprootAssets_ListInvoices_Handler,
+		},
 		{
 			MethodName: "VerifyProof",
 			Handler:    _TaprootAssets_VerifyProof_Handler,
@@ -819,10 +3075,78 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExportProof",
 			Handler:    _TaprootAssets_ExportProof_Handler,
 		},
+		{
+			MethodName: "ProofArchiveStats",
+			Handler:    _TaprootAssets_ProofArchiveStats_Handler,
+		},
+		{
+			MethodName: "PushArchiveProofs",
+			Handler:    _TaprootAssets_PushArchiveProofs_Handler,
+		},
+		{
+			MethodName: "PullArchiveProof",
+			Handler:    _TaprootAssets_PullArchiveProof_Handler,
+		},
+		{
+			MethodName: "BakeArchiveMacaroon",
+			Handler:    _TaprootAssets_BakeArchiveMacaroon_Handler,
+		},
 		{
 			MethodName: "SendAsset",
 			Handler:    _TaprootAssets_SendAsset_Handler,
 		},
+		{
+			MethodName: "ListScheduledTransfers",
+			Handler:    _TaprootAssets_ListScheduledTransfers_Handler,
+		},
+		{
+			MethodName: "CancelScheduledTransfer",
+			Handler:    _TaprootAssets_CancelScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "OpenAnchorSession",
+			Handler:    _TaprootAssets_OpenAnchorSession_Handler,
+		},
+		{
+			MethodName: "RegisterAnchorSessionParcel",
+			Handler:    _TaprootAssets_RegisterAnchorSessionParcel_Handler,
+		},
+		{
+			MethodName: "SealAnchorSession",
+			Handler:    _TaprootAssets_SealAnchorSession_Handler,
+		},
+		{
+			MethodName: "AbortAnchorSession",
+			Handler:    _TaprootAssets_AbortAnchorSession_Handler,
+		},
+		{
+			MethodName: "StartAirdrop",
+			Handler:    _TaprootAssets_StartAirdrop_Handler,
+		},
+		{
+			MethodName: "ResumeAirdrop",
+			Handler:    _TaprootAssets_ResumeAirdrop_Handler,
+		},
+		{
+			MethodName: "ListAirdrops",
+			Handler:    _TaprootAssets_ListAirdrops_Handler,
+		},
+		{
+			MethodName: "CreateTransferTemplate",
+			Handler:    _TaprootAssets_CreateTransferTemplate_Handler,
+		},
+		{
+			MethodName: "ListTransferTemplates",
+			Handler:    _TaprootAssets_ListTransferTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteTransferTemplate",
+			Handler:    _TaprootAssets_DeleteTransferTemplate_Handler,
+		},
+		{
+			MethodName: "ExecuteTransferTemplate",
+			Handler:    _TaprootAssets_ExecuteTransferTemplate_Handler,
+		},
 		{
 			MethodName: "GetInfo",
 			Handler:    _TaprootAssets_GetInfo_Handler,
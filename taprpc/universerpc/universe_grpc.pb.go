@@ -83,6 +83,29 @@ type UniverseClient interface {
 	// DeleteFederationServer removes a server from the federation of the local
 	// Universe server.
 	DeleteFederationServer(ctx context.Context, in *DeleteFederationServerRequest, opts ...grpc.CallOption) (*DeleteFederationServerResponse, error)
+	// tapcli: `universe federation progress`
+	// SyncProgress returns the progress of the syncs of the local Universe
+	// server with remote servers, per server and asset. For each asset, the
+	// remote root that was last synced to, the number of fetched leaves and the
+	// last sync error are returned. Interrupted syncs are resumed from this
+	// progress.
+	SyncProgress(ctx context.Context, in *SyncProgressRequest, opts ...grpc.CallOption) (*SyncProgressResponse, error)
+	// tapcli: `universe federation quarantine list`
+	// ListQuarantinedProofs lists the proofs that were fetched from remote
+	// Universe servers during a sync, but failed validation. These proofs were
+	// not inserted into the local Universe trees.
+	ListQuarantinedProofs(ctx context.Context, in *ListQuarantinedProofsRequest, opts ...grpc.CallOption) (*ListQuarantinedProofsResponse, error)
+	// tapcli: `universe federation quarantine purge`
+	// PurgeQuarantinedProofs removes proofs from the quarantine, either all of
+	// them or only those of a given server or asset.
+	PurgeQuarantinedProofs(ctx context.Context, in *PurgeQuarantinedProofsRequest, opts ...grpc.CallOption) (*PurgeQuarantinedProofsResponse, error)
+	// tapcli: `universe supplyreport`
+	// SupplyReport returns a report about the total supply of an asset or asset
+	// group, signed by the identity key of the node. The supply is re-derived by
+	// verifying each issuance proof of the asset's Universe against the chain.
+	// Unless a new verification is requested, the latest report of the
+	// background supply verifier is returned.
+	SupplyReport(ctx context.Context, in *SupplyReportRequest, opts ...grpc.CallOption) (*SupplyReportResponse, error)
 	// tapcli: `universe stats`
 	// UniverseStats returns a set of aggregate statistics for the current state
 	// of the Universe. Stats returned include: total number of syncs, total
@@ -216,6 +239,42 @@ func (c *universeClient) DeleteFederationServer(ctx context.Context, in *DeleteF
 	return out, nil
 }
 
+func (c *universeClient) SyncProgress(ctx context.Context, in *SyncProgressRequest, opts ...grpc.CallOption) (*SyncProgressResponse, error) {
+	out := new(SyncProgressResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/SyncProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) ListQuarantinedProofs(ctx context.Context, in *ListQuarantinedProofsRequest, opts ...grpc.CallOption) (*ListQuarantinedProofsResponse, error) {
+	out := new(ListQuarantinedProofsResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/ListQuarantinedProofs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) PurgeQuarantinedProofs(ctx context.Context, in *PurgeQuarantinedProofsRequest, opts ...grpc.CallOption) (*PurgeQuarantinedProofsResponse, error) {
+	out := new(PurgeQuarantinedProofsResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/PurgeQuarantinedProofs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) SupplyReport(ctx context.Context, in *SupplyReportRequest, opts ...grpc.CallOption) (*SupplyReportResponse, error) {
+	out := new(SupplyReportResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/SupplyReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) UniverseStats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
 	out := new(StatsResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/UniverseStats", in, out, opts...)
@@ -312,6 +371,29 @@ type UniverseServer interface {
 	// DeleteFederationServer removes a server from the federation of the local
 	// Universe server.
 	DeleteFederationServer(context.Context, *DeleteFederationServerRequest) (*DeleteFederationServerResponse, error)
+	// tapcli: `universe federation progress`
+	// SyncProgress returns the progress of the syncs of the local Universe
+	// server with remote servers, per server and asset. For each asset, the
+	// remote root that was last synced to, the number of fetched leaves and the
+	// last sync error are returned. Interrupted syncs are resumed from this
+	// progress.
+	SyncProgress(context.Context, *SyncProgressRequest) (*SyncProgressResponse, error)
+	// tapcli: `universe federation quarantine list`
+	// ListQuarantinedProofs lists the proofs that were fetched from remote
+	// Universe servers during a sync, but failed validation. These proofs were
+	// not inserted into the local Universe trees.
+	ListQuarantinedProofs(context.Context, *ListQuarantinedProofsRequest) (*ListQuarantinedProofsResponse, error)
+	// tapcli: `universe federation quarantine purge`
+	// PurgeQuarantinedProofs removes proofs from the quarantine, either all of
+	// them or only those of a given server or asset.
+	PurgeQuarantinedProofs(context.Context, *PurgeQuarantinedProofsRequest) (*PurgeQuarantinedProofsResponse, error)
+	// tapcli: `universe supplyreport`
+	// SupplyReport returns a report about the total supply of an asset or asset
+	// group, signed by the identity key of the node. The supply is re-derived by
+	// verifying each issuance proof of the asset's Universe against the chain.
+	// Unless a new verification is requested, the latest report of the
+	// background supply verifier is returned.
+	SupplyReport(context.Context, *SupplyReportRequest) (*SupplyReportResponse, error)
 	// tapcli: `universe stats`
 	// UniverseStats returns a set of aggregate statistics for the current state
 	// of the Universe. Stats returned include: total number of syncs, total
@@ -370,6 +452,18 @@ func (UnimplementedUniverseServer) AddFederationServer(context.Context, *AddFede
 func (UnimplementedUniverseServer) DeleteFederationServer(context.Context, *DeleteFederationServerRequest) (*DeleteFederationServerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFederationServer not implemented")
 }
+func (UnimplementedUniverseServer) SyncProgress(context.Context, *SyncProgressRequest) (*SyncProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncProgress not implemented")
+}
+func (UnimplementedUniverseServer) ListQuarantinedProofs(context.Context, *ListQuarantinedProofsRequest) (*ListQuarantinedProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuarantinedProofs not implemented")
+}
+func (UnimplementedUniverseServer) PurgeQuarantinedProofs(context.Context, *PurgeQuarantinedProofsRequest) (*PurgeQuarantinedProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeQuarantinedProofs not implemented")
+}
+func (UnimplementedUniverseServer) SupplyReport(context.Context, *SupplyReportRequest) (*SupplyReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyReport not implemented")
+}
 func (UnimplementedUniverseServer) UniverseStats(context.Context, *StatsRequest) (*StatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UniverseStats not implemented")
 }
@@ -608,6 +702,78 @@ func _Universe_DeleteFederationServer_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_SyncProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).SyncProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/SyncProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).SyncProgress(ctx, req.(*SyncProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_ListQuarantinedProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuarantinedProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).ListQuarantinedProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/ListQuarantinedProofs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).ListQuarantinedProofs(ctx, req.(*ListQuarantinedProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_PurgeQuarantinedProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeQuarantinedProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).PurgeQuarantinedProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/PurgeQuarantinedProofs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).PurgeQuarantinedProofs(ctx, req.(*PurgeQuarantinedProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_SupplyReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplyReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).SupplyReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/SupplyReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).SupplyReport(ctx, req.(*SupplyReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Universe_UniverseStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StatsRequest)
 	if err := dec(in); err != nil {
@@ -717,6 +883,22 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFederationServer",
 			Handler:    _Universe_DeleteFederationServer_Handler,
 		},
+		{
+			MethodName: "SyncProgress",
+			Handler:    _Universe_SyncProgress_Handler,
+		},
+		{
+			MethodName: "ListQuarantinedProofs",
+			Handler:    _Universe_ListQuarantinedProofs_Handler,
+		},
+		{
+			MethodName: "PurgeQuarantinedProofs",
+			Handler:    _Universe_PurgeQuarantinedProofs_Handler,
+		},
+		{
+			MethodName: "SupplyReport",
+			Handler:    _Universe_SupplyReport_Handler,
+		},
 		{
 			MethodName: "UniverseStats",
 			Handler:    _Universe_UniverseStats_Handler,
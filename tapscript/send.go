@@ -1,6 +1,7 @@
 package tapscript
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -19,6 +20,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 )
 
@@ -576,33 +578,28 @@ func PrepareOutputAssets(ctx context.Context, vPkt *tappsbt.VPacket) error {
 func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer Signer,
 	validator TxValidator) error {
 
-	inputs := vPkt.Inputs
-	outputs := vPkt.Outputs
-
-	// If this is a split transfer, it means that the asset to be signed is
-	// the root asset, which is located at the change output.
-	isSplit, err := vPkt.HasSplitCommitment()
-	if err != nil {
-		return err
-	}
+	return SignVirtualTransactionWithWitnesses(vPkt, signer, validator, nil)
+}
 
-	// Identify new output asset. For splits, the new asset that receives
-	// the signature is the one with the split root set to true.
-	newAsset := outputs[0].Asset
-	if isSplit {
-		splitOut, err := vPkt.SplitRootOutput()
-		if err != nil {
-			return fmt.Errorf("no split root output found for "+
-				"split transaction: %w", err)
+// SignVirtualTransactionWithWitnesses is like SignVirtualTransaction, but uses
+// the given witnesses, keyed by the index of the input they spend, instead of
+// creating a signature for those inputs. This allows spending script paths
+// that require data the signer doesn't have, such as the preimage of a hash
+// lock. The given witnesses are verified with the Taproot Asset VM just like
+// the created ones.
+func SignVirtualTransactionWithWitnesses(vPkt *tappsbt.VPacket, signer Signer,
+	validator TxValidator, inputWitnesses map[int]wire.TxWitness) error {
+
+	for idx := range inputWitnesses {
+		if idx < 0 || idx >= len(vPkt.Inputs) {
+			return fmt.Errorf("witness for input %d of packet "+
+				"with %d inputs", idx, len(vPkt.Inputs))
 		}
-		newAsset = splitOut.Asset
 	}
 
-	// Construct input set from all input assets.
-	prevAssets := make(commitment.InputSet, len(inputs))
-	for idx := range vPkt.Inputs {
-		input := vPkt.Inputs[idx]
-		prevAssets[input.PrevID] = input.Asset()
+	newAsset, prevAssets, err := virtualTxAssets(vPkt)
+	if err != nil {
+		return err
 	}
 
 	// Create a Taproot Asset virtual transaction representing the asset
@@ -612,12 +609,18 @@ func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer Signer,
 		return err
 	}
 
-	for idx := range inputs {
-		input := inputs[idx]
+	witnesses := make([]wire.TxWitness, len(vPkt.Inputs))
+	for idx := range vPkt.Inputs {
+		input := vPkt.Inputs[idx]
+
+		// Inputs that were given a witness aren't signed by us.
+		if witness, ok := inputWitnesses[idx]; ok {
+			witnesses[idx] = witness
+			continue
+		}
 
 		// For each input asset leaf, we need to produce a witness.
-		// Update the input of the virtual TX, generate a witness, and
-		// attach it to the copy of the new Asset.
+		// Update the input of the virtual TX and generate a witness.
 		virtualTxCopy := virtualTx.Copy()
 		inputSpecificVirtualTx := VirtualTxWithInput(
 			virtualTxCopy, input.Asset(), uint32(idx), nil,
@@ -625,15 +628,135 @@ func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer Signer,
 
 		// Sign the virtual transaction based on the input script
 		// information (key spend or script spend).
-		newWitness, err := CreateTaprootSignature(
+		witnesses[idx], err = CreateTaprootSignature(
 			input, inputSpecificVirtualTx, 0, signer,
 		)
 		if err != nil {
 			return fmt.Errorf("error creating taproot "+
 				"signature: %w", err)
 		}
+	}
+
+	return addVirtualWitnesses(
+		vPkt, newAsset, prevAssets, witnesses, validator,
+	)
+}
+
+// VirtualTxID returns the ID of the virtual transaction of the given packet.
+// As the virtual transaction doesn't commit to the witnesses of the new asset,
+// the ID is the same before and after the packet is signed.
+func VirtualTxID(vPkt *tappsbt.VPacket) (chainhash.Hash, error) {
+	newAsset, prevAssets, err := virtualTxAssets(vPkt)
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+
+	virtualTx, _, err := VirtualTx(newAsset, prevAssets)
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+
+	return virtualTx.TxHash(), nil
+}
+
+// VirtualWitnesses returns the witnesses of the inputs of the given signed
+// packet, in the order of the inputs.
+func VirtualWitnesses(vPkt *tappsbt.VPacket) ([]wire.TxWitness, error) {
+	newAsset, _, err := virtualTxAssets(vPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(newAsset.PrevWitnesses) != len(vPkt.Inputs) {
+		return nil, fmt.Errorf("new asset has %d witnesses, expected "+
+			"%d", len(newAsset.PrevWitnesses), len(vPkt.Inputs))
+	}
+
+	witnesses := make([]wire.TxWitness, len(vPkt.Inputs))
+	for idx := range newAsset.PrevWitnesses {
+		witness := newAsset.PrevWitnesses[idx].TxWitness
+		if len(witness) == 0 {
+			return nil, fmt.Errorf("input %d is not signed", idx)
+		}
+		witnesses[idx] = witness
+	}
+
+	return witnesses, nil
+}
+
+// AddVirtualWitnesses attaches the given witnesses, which were created by an
+// external signer, to the new asset of the packet, in the order of the inputs.
+// Just like SignVirtualTransaction, the transfer is verified with the Taproot
+// Asset VM before the split assets are updated with the signed root asset.
+func AddVirtualWitnesses(vPkt *tappsbt.VPacket, witnesses []wire.TxWitness,
+	validator TxValidator) error {
+
+	newAsset, prevAssets, err := virtualTxAssets(vPkt)
+	if err != nil {
+		return err
+	}
+
+	return addVirtualWitnesses(
+		vPkt, newAsset, prevAssets, witnesses, validator,
+	)
+}
+
+// virtualTxAssets returns the new asset of the packet that receives the
+// witnesses, and the set of input assets it spends.
+func virtualTxAssets(vPkt *tappsbt.VPacket) (*asset.Asset,
+	commitment.InputSet, error) {
+
+	// If this is a split transfer, it means that the asset to be signed is
+	// the root asset, which is located at the change output.
+	isSplit, err := vPkt.HasSplitCommitment()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Identify new output asset. For splits, the new asset that receives
+	// the signature is the one with the split root set to true.
+	newAsset := vPkt.Outputs[0].Asset
+	if isSplit {
+		splitOut, err := vPkt.SplitRootOutput()
+		if err != nil {
+			return nil, nil, fmt.Errorf("no split root output "+
+				"found for split transaction: %w", err)
+		}
+		newAsset = splitOut.Asset
+	}
 
-		newAsset.PrevWitnesses[idx].TxWitness = newWitness
+	// Construct input set from all input assets.
+	prevAssets := make(commitment.InputSet, len(vPkt.Inputs))
+	for idx := range vPkt.Inputs {
+		input := vPkt.Inputs[idx]
+		prevAssets[input.PrevID] = input.Asset()
+	}
+
+	return newAsset, prevAssets, nil
+}
+
+// addVirtualWitnesses attaches the given witnesses to the new asset, verifies
+// the transfer with the Taproot Asset VM and updates the split assets with the
+// signed root asset.
+func addVirtualWitnesses(vPkt *tappsbt.VPacket, newAsset *asset.Asset,
+	prevAssets commitment.InputSet, witnesses []wire.TxWitness,
+	validator TxValidator) error {
+
+	outputs := vPkt.Outputs
+
+	if len(witnesses) != len(vPkt.Inputs) ||
+		len(newAsset.PrevWitnesses) != len(vPkt.Inputs) {
+
+		return fmt.Errorf("got %d witnesses for %d inputs",
+			len(witnesses), len(vPkt.Inputs))
+	}
+	for idx := range witnesses {
+		newAsset.PrevWitnesses[idx].TxWitness = witnesses[idx]
+	}
+
+	isSplit, err := vPkt.HasSplitCommitment()
+	if err != nil {
+		return err
 	}
 
 	// Create an instance of the Taproot Asset VM and validate the transfer.
@@ -934,6 +1057,107 @@ func AreValidAnchorOutputIndexes(outputs []*tappsbt.VOutput) (bool, error) {
 	return assetOnlySpend, nil
 }
 
+// anchorOutputSortKey is the key an anchor output is sorted by in
+// SortAnchorOutputs.
+type anchorOutputSortKey struct {
+	// index is the anchor output index the virtual outputs were assigned
+	// to before sorting.
+	index uint32
+
+	// internalKey is the x-only serialized internal key of the anchor
+	// output.
+	internalKey []byte
+
+	// siblingHash is the tapscript sibling hash of the anchor output, or
+	// nil if it has no sibling.
+	siblingHash []byte
+}
+
+// SortAnchorOutputs assigns new anchor output indexes to the given virtual
+// outputs, so the anchor outputs are placed in a deterministic order that all
+// parties of a transfer can compute in advance. Similar to BIP-0069, the anchor
+// outputs are sorted lexicographically, by the x-only serialization of their
+// internal key and then by their tapscript sibling hash. Virtual outputs that
+// share an anchor output keep sharing it, and the new indexes form a continuous
+// range starting at zero.
+//
+// NOTE: The anchor output index is committed to by split commitments, so the
+// outputs must be sorted before the output assets are prepared.
+func SortAnchorOutputs(outputs []*tappsbt.VOutput) error {
+	sortKeys := make(map[uint32]*anchorOutputSortKey)
+	for idx := range outputs {
+		vOut := outputs[idx]
+		if vOut.AnchorOutputInternalKey == nil {
+			return fmt.Errorf("output %d is missing anchor "+
+				"internal key", idx)
+		}
+
+		var siblingHash []byte
+		if vOut.AnchorOutputTapscriptSibling != nil {
+			hash, err := vOut.AnchorOutputTapscriptSibling.TapHash()
+			if err != nil {
+				return fmt.Errorf("unable to hash tapscript "+
+					"sibling of output %d: %w", idx, err)
+			}
+			siblingHash = hash[:]
+		}
+
+		sortKey := &anchorOutputSortKey{
+			index: vOut.AnchorOutputIndex,
+			internalKey: schnorr.SerializePubKey(
+				vOut.AnchorOutputInternalKey,
+			),
+			siblingHash: siblingHash,
+		}
+
+		// All virtual outputs that are committed to the same anchor
+		// output must agree on its internal key and sibling, otherwise
+		// the anchor output doesn't have a well-defined place.
+		existing, ok := sortKeys[vOut.AnchorOutputIndex]
+		switch {
+		case !ok:
+			sortKeys[vOut.AnchorOutputIndex] = sortKey
+
+		case !bytes.Equal(existing.internalKey, sortKey.internalKey) ||
+			!bytes.Equal(existing.siblingHash, sortKey.siblingHash):
+
+			return fmt.Errorf("outputs committed to anchor output "+
+				"%d have different internal keys or tapscript "+
+				"siblings", vOut.AnchorOutputIndex)
+		}
+	}
+
+	sorted := maps.Values(sortKeys)
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+
+		keyCmp := bytes.Compare(a.internalKey, b.internalKey)
+		if keyCmp != 0 {
+			return keyCmp < 0
+		}
+
+		siblingCmp := bytes.Compare(a.siblingHash, b.siblingHash)
+		if siblingCmp != 0 {
+			return siblingCmp < 0
+		}
+
+		// Distinct anchor outputs with the same internal key and
+		// sibling keep their relative order.
+		return a.index < b.index
+	})
+
+	newIndexes := make(map[uint32]uint32, len(sorted))
+	for newIndex, sortKey := range sorted {
+		newIndexes[sortKey.index] = uint32(newIndex)
+	}
+	for idx := range outputs {
+		vOut := outputs[idx]
+		vOut.AnchorOutputIndex = newIndexes[vOut.AnchorOutputIndex]
+	}
+
+	return nil
+}
+
 // CreateAnchorTx creates a template BTC anchor TX with dummy outputs.
 func CreateAnchorTx(outputs []*tappsbt.VOutput) (*psbt.Packet, error) {
 	// Check if our outputs are valid, and if we will need to add extra
@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"sort"
 	"testing"
 
 	"github.com/btcsuite/btcd/blockchain"
@@ -963,6 +964,68 @@ var signVirtualTransactionTestCases = []testCase{{
 		)
 		return nil
 	},
+}, {
+	name: "add external witnesses to non-interactive asset split",
+	f: func(t *testing.T) error {
+		state := initSpendScenario(t)
+
+		pkt := createPacket(
+			state.address1, state.asset2PrevID,
+			state, state.asset2InputAssets, false,
+		)
+		err := tapscript.PrepareOutputAssets(context.Background(), pkt)
+		require.NoError(t, err)
+
+		// The external signer signs a copy of the packet, which has
+		// the same virtual transaction ID before and after signing.
+		var buf bytes.Buffer
+		require.NoError(t, pkt.Serialize(&buf))
+		signedPkt, err := tappsbt.NewFromRawBytes(&buf, false)
+		require.NoError(t, err)
+
+		vTxID, err := tapscript.VirtualTxID(pkt)
+		require.NoError(t, err)
+
+		_, err = tapscript.VirtualWitnesses(signedPkt)
+		require.ErrorContains(t, err, "not signed")
+
+		err = tapscript.SignVirtualTransaction(
+			signedPkt, state.signer, state.validator,
+		)
+		require.NoError(t, err)
+
+		signedVTxID, err := tapscript.VirtualTxID(signedPkt)
+		require.NoError(t, err)
+		require.Equal(t, vTxID, signedVTxID)
+
+		witnesses, err := tapscript.VirtualWitnesses(signedPkt)
+		require.NoError(t, err)
+
+		// A tampered witness doesn't pass validation.
+		invalidWitness := wire.TxWitness{
+			test.RandBytes(len(witnesses[0][0])),
+		}
+		err = tapscript.AddVirtualWitnesses(
+			pkt, []wire.TxWitness{invalidWitness}, state.validator,
+		)
+		require.Error(t, err)
+
+		// With the witnesses of the signed packet, our own packet
+		// ends up signed identically.
+		unvalidatedAsset := pkt.Outputs[0].Asset.Copy()
+		err = tapscript.AddVirtualWitnesses(
+			pkt, witnesses, state.validator,
+		)
+		require.NoError(t, err)
+
+		checkSignedAsset(
+			t, unvalidatedAsset, pkt.Outputs[0].Asset, true, false,
+		)
+		require.True(t, pkt.Outputs[1].Asset.DeepEqual(
+			signedPkt.Outputs[1].Asset,
+		))
+		return nil
+	},
 }, {
 	name: "validate non-interactive collectible with group key",
 	f: func(t *testing.T) error {
@@ -989,6 +1052,80 @@ var signVirtualTransactionTestCases = []testCase{{
 		return nil
 	},
 	err: nil,
+}, {
+	name: "validate script path spend with external witness",
+	f: func(t *testing.T) error {
+		state := initSpendScenario(t)
+		state.spenderScriptKey = *asset.NUMSPubKey
+
+		// We lock the input to a hash lock leaf, which the signer
+		// can't create a witness for.
+		preimage := []byte("foobar")
+		leaf := test.ScriptHashLock(t, preimage)
+		internalKey := test.RandPubKey(t)
+		tapTree := txscript.AssembleTaprootScriptTree(leaf)
+		rootHash := tapTree.RootNode.TapHash()
+		outputKey := txscript.ComputeTaprootOutputKey(
+			internalKey, rootHash[:],
+		)
+		scriptKey, err := schnorr.ParsePubKey(
+			schnorr.SerializePubKey(outputKey),
+		)
+		require.NoError(t, err)
+		controlBlock := tapTree.LeafMerkleProofs[0].ToControlBlock(
+			internalKey,
+		)
+		controlBlockBytes, err := controlBlock.ToBytes()
+		require.NoError(t, err)
+
+		pkt := createPacket(
+			state.address1, state.asset1PrevID,
+			state, state.asset1InputAssets, false,
+		)
+		pkt.Inputs[0].Asset().ScriptKey = asset.NewScriptKey(scriptKey)
+		pkt.Inputs[0].PrevID.ScriptKey = asset.ToSerialized(scriptKey)
+
+		err = tapscript.PrepareOutputAssets(context.Background(), pkt)
+		require.NoError(t, err)
+
+		// A witness for an input the packet doesn't have is rejected.
+		witness := wire.TxWitness{
+			preimage, leaf.Script, controlBlockBytes,
+		}
+		err = tapscript.SignVirtualTransactionWithWitnesses(
+			pkt, state.signer, state.validator,
+			map[int]wire.TxWitness{1: witness},
+		)
+		require.Error(t, err)
+
+		// A witness with the wrong preimage doesn't pass validation.
+		invalidWitness := wire.TxWitness{
+			[]byte("not-foobar"), leaf.Script, controlBlockBytes,
+		}
+		err = tapscript.SignVirtualTransactionWithWitnesses(
+			pkt, state.signer, state.validator,
+			map[int]wire.TxWitness{0: invalidWitness},
+		)
+		require.Error(t, err)
+
+		// With the correct preimage, the witness is attached to the
+		// new asset as is, which the split asset commits to.
+		err = tapscript.SignVirtualTransactionWithWitnesses(
+			pkt, state.signer, state.validator,
+			map[int]wire.TxWitness{0: witness},
+		)
+		require.NoError(t, err)
+
+		rootAsset := pkt.Outputs[0].Asset
+		require.Equal(t, witness, rootAsset.PrevWitnesses[0].TxWitness)
+
+		splitWitness := pkt.Outputs[1].Asset.PrevWitnesses[0]
+		require.True(t, rootAsset.DeepEqual(
+			&splitWitness.SplitCommitment.RootAsset,
+		))
+		return nil
+	},
+	err: nil,
 }}
 
 // TestCreateOutputCommitments tests edge cases around creating TapCommitments
@@ -1840,6 +1977,81 @@ func TestAreValidAnchorOutputIndexes(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// TestSortAnchorOutputs tests that anchor outputs are placed in a deterministic
+// order, independent of the indexes they were assigned to before.
+func TestSortAnchorOutputs(t *testing.T) {
+	t.Parallel()
+
+	keys := []*btcec.PublicKey{
+		test.RandPubKey(t), test.RandPubKey(t), test.RandPubKey(t),
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(
+			schnorr.SerializePubKey(keys[i]),
+			schnorr.SerializePubKey(keys[j]),
+		) < 0
+	})
+
+	// The split root and a passive output share the anchor output with
+	// the largest key, the two other recipients get their own. The indexes
+	// have a gap and are in the opposite order of the keys.
+	splitRoot := &tappsbt.VOutput{
+		AnchorOutputIndex:       0,
+		AnchorOutputInternalKey: keys[2],
+	}
+	passive := &tappsbt.VOutput{
+		AnchorOutputIndex:       0,
+		AnchorOutputInternalKey: keys[2],
+	}
+	recipient1 := &tappsbt.VOutput{
+		AnchorOutputIndex:       1,
+		AnchorOutputInternalKey: keys[1],
+	}
+	recipient2 := &tappsbt.VOutput{
+		AnchorOutputIndex:       3,
+		AnchorOutputInternalKey: keys[0],
+	}
+	outputs := []*tappsbt.VOutput{splitRoot, passive, recipient1, recipient2}
+
+	require.NoError(t, tapscript.SortAnchorOutputs(outputs))
+	require.EqualValues(t, 2, splitRoot.AnchorOutputIndex)
+	require.EqualValues(t, 2, passive.AnchorOutputIndex)
+	require.EqualValues(t, 1, recipient1.AnchorOutputIndex)
+	require.EqualValues(t, 0, recipient2.AnchorOutputIndex)
+
+	// Sorting again doesn't change anything.
+	require.NoError(t, tapscript.SortAnchorOutputs(outputs))
+	require.EqualValues(t, 2, splitRoot.AnchorOutputIndex)
+	require.EqualValues(t, 1, recipient1.AnchorOutputIndex)
+	require.EqualValues(t, 0, recipient2.AnchorOutputIndex)
+
+	// Anchor outputs with the same internal key are sorted by their
+	// tapscript sibling, an output without a sibling goes first.
+	sibling := commitment.NewPreimageFromLeaf(
+		txscript.NewBaseTapLeaf([]byte{txscript.OP_TRUE}),
+	)
+	recipient1.AnchorOutputInternalKey = keys[0]
+	recipient2.AnchorOutputTapscriptSibling = sibling
+
+	require.NoError(t, tapscript.SortAnchorOutputs(outputs))
+	require.EqualValues(t, 0, recipient1.AnchorOutputIndex)
+	require.EqualValues(t, 1, recipient2.AnchorOutputIndex)
+	require.EqualValues(t, 2, splitRoot.AnchorOutputIndex)
+
+	// Outputs that share an anchor output must agree on its internal key.
+	passive.AnchorOutputInternalKey = keys[1]
+	require.ErrorContains(
+		t, tapscript.SortAnchorOutputs(outputs),
+		"different internal keys",
+	)
+
+	// All outputs need an internal key to be sorted.
+	passive.AnchorOutputInternalKey = nil
+	require.ErrorContains(
+		t, tapscript.SortAnchorOutputs(outputs), "missing anchor",
+	)
+}
+
 // TestAddressValidInput tests edge cases around validating inputs for asset
 // transfers with isValidInput.
 func TestAddressValidInput(t *testing.T) {
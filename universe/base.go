@@ -25,6 +25,15 @@ type MintingArchiveConfig struct {
 	// genesis proof.
 	HeaderVerifier proof.HeaderVerifier
 
+	// ProofVerifier is the optional verifier pool that bounds the number
+	// of issuance proofs that are verified concurrently. If it is nil, each
+	// proof is verified right away.
+	ProofVerifier *proof.VerifierPool
+
+	// ProofLimits bounds the size of the issuance proofs that are
+	// registered.
+	ProofLimits proof.FileLimits
+
 	// Multiverse is used to interact with the set of known base
 	// universe trees, and also obtain associated metadata and statistics.
 	Multiverse BaseMultiverse
@@ -130,19 +139,28 @@ func (a *MintingArchive) RootNodes(ctx context.Context) ([]BaseRoot, error) {
 
 // RegisterIssuance attempts to register a new issuance proof for a new minting
 // event for the specified base universe identifier. This method will return an
-// error if the passed minting proof is invalid. If the leaf is already known,
-// then no action is taken and the existing issuance commitment proof returned.
+// error wrapping ErrInvalidProof if the passed minting proof is invalid. If the
+// leaf is already known, then no action is taken and the existing issuance
+// commitment proof returned.
 func (a *MintingArchive) RegisterIssuance(ctx context.Context, id Identifier,
 	key BaseKey, leaf *MintingLeaf) (*IssuanceProof, error) {
 
 	log.Debugf("Inserting new proof into Universe: id=%v, base_key=%v",
 		id.StringForLog(), spew.Sdump(key))
 
+	// Oversized proofs are rejected before we spend any resources on
+	// them.
+	err := a.cfg.ProofLimits.CheckSize(uint64(len(leaf.GenesisProof)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidProof, err)
+	}
+
 	// We first decode the proof to make sure it's at least well-formed.
 	var newProof proof.Proof
-	err := newProof.Decode(bytes.NewReader(leaf.GenesisProof))
+	err = newProof.Decode(bytes.NewReader(leaf.GenesisProof))
 	if err != nil {
-		return nil, fmt.Errorf("unable to decode proof: %v", err)
+		return nil, fmt.Errorf("%w: unable to decode proof: %v",
+			ErrInvalidProof, err)
 	}
 
 	// We'll first check to see if we already know of this leaf within the
@@ -188,9 +206,25 @@ func (a *MintingArchive) RegisterIssuance(ctx context.Context, id Identifier,
 	// it as a file first as that's what the expected wants.
 	//
 	// TODO(roasbeef): add option to skip proof verification?
-	assetSnapshot, err := newProof.Verify(ctx, nil, a.cfg.HeaderVerifier)
-	if err != nil {
-		return nil, fmt.Errorf("unable to verify proof: %v", err)
+	var assetSnapshot *proof.AssetSnapshot
+	if a.cfg.ProofVerifier != nil {
+		assetSnapshot, err = a.cfg.ProofVerifier.VerifyProof(
+			ctx, &newProof, nil, a.cfg.HeaderVerifier,
+		)
+	} else {
+		assetSnapshot, err = newProof.Verify(
+			ctx, nil, a.cfg.HeaderVerifier,
+		)
+	}
+	switch {
+	// A verification that was aborted doesn't tell us anything about the
+	// validity of the proof.
+	case err != nil && ctx.Err() != nil:
+		return nil, fmt.Errorf("unable to verify proof: %w", err)
+
+	case err != nil:
+		return nil, fmt.Errorf("%w: unable to verify proof: %v",
+			ErrInvalidProof, err)
 	}
 
 	newAsset := assetSnapshot.Asset
@@ -204,27 +238,30 @@ func (a *MintingArchive) RegisterIssuance(ctx context.Context, id Identifier,
 		schnorr.SerializePubKey(id.GroupKey),
 		schnorr.SerializePubKey(&newAsset.GroupKey.GroupPubKey),
 	):
-		return nil, fmt.Errorf("group key mismatch: expected %x, "+
-			"got %x", id.GroupKey.SerializeCompressed(),
+		return nil, fmt.Errorf("%w: group key mismatch: expected %x, "+
+			"got %x", ErrInvalidProof,
+			id.GroupKey.SerializeCompressed(),
 			newAsset.GroupKey.GroupPubKey.SerializeCompressed())
 
 	// If the group key is nil, then the asset ID should match.
 	case id.GroupKey == nil && id.AssetID != newAsset.ID():
-		return nil, fmt.Errorf("asset id mismatch: expected %v, got %v",
-			id.AssetID, newAsset.ID())
+		return nil, fmt.Errorf("%w: asset id mismatch: expected %v, "+
+			"got %v", ErrInvalidProof, id.AssetID, newAsset.ID())
 
 	// The outpoint of the final resting place of the asset should match
 	// the leaf key
 	//
 	// TODO(roasbeef): this restrict to issuance
 	case assetSnapshot.OutPoint != key.MintingOutpoint:
-		return nil, fmt.Errorf("outpoint mismatch: expected %v, got %v",
-			key.MintingOutpoint, assetSnapshot.OutPoint)
+		return nil, fmt.Errorf("%w: outpoint mismatch: expected %v, "+
+			"got %v", ErrInvalidProof, key.MintingOutpoint,
+			assetSnapshot.OutPoint)
 
 	// The script key should also match exactly.
 	case !newAsset.ScriptKey.PubKey.IsEqual(key.ScriptKey.PubKey):
-		return nil, fmt.Errorf("script key mismatch: expected %v, "+
-			"got %v", key.ScriptKey.PubKey.SerializeCompressed(),
+		return nil, fmt.Errorf("%w: script key mismatch: expected "+
+			"%v, got %v", ErrInvalidProof,
+			key.ScriptKey.PubKey.SerializeCompressed(),
 			newAsset.ScriptKey.PubKey.SerializeCompressed())
 	}
 
@@ -0,0 +1,73 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+var (
+	// ErrInvalidProof is returned when a universe proof fails validation
+	// and therefore can't be inserted into a universe tree.
+	ErrInvalidProof = fmt.Errorf("invalid universe proof")
+)
+
+// QuarantinedProof is a proof that was fetched from a remote universe server
+// during a sync, but failed validation. Instead of being inserted into the
+// local universe tree, it is kept aside together with the reason it was
+// rejected for.
+type QuarantinedProof struct {
+	// ID is the database ID of the quarantined proof.
+	ID int64
+
+	// ServerHost is the host of the remote universe server the proof was
+	// fetched from.
+	ServerHost string
+
+	// UniverseID identifies the universe the proof was fetched for.
+	UniverseID Identifier
+
+	// Key is the key of the leaf the proof was fetched for.
+	Key BaseKey
+
+	// Proof is the rejected issuance proof.
+	Proof proof.Blob
+
+	// Reason is the reason the proof was rejected for.
+	Reason string
+
+	// QuarantinedAt is the time the proof was quarantined.
+	QuarantinedAt time.Time
+}
+
+// QuarantineQuery is used to filter quarantined proofs. Unset fields match all
+// quarantined proofs.
+type QuarantineQuery struct {
+	// ServerHost is the host of the remote universe server the proofs
+	// were fetched from.
+	ServerHost string
+
+	// UniverseID identifies the universe the proofs were fetched for.
+	UniverseID *Identifier
+}
+
+// ProofQuarantine keeps proofs that failed validation during a universe sync
+// out of the local universe trees, so they can be inspected later on.
+type ProofQuarantine interface {
+	// QuarantineProof adds the given proof to the quarantine. A proof that
+	// is quarantined again for the same server and leaf replaces the
+	// earlier entry.
+	QuarantineProof(ctx context.Context, p *QuarantinedProof) error
+
+	// QueryQuarantinedProofs returns all quarantined proofs that match
+	// the given query, ordered by the time they were quarantined at.
+	QueryQuarantinedProofs(ctx context.Context,
+		q QuarantineQuery) ([]*QuarantinedProof, error)
+
+	// PurgeQuarantinedProofs removes all quarantined proofs that match
+	// the given query and returns the number of removed proofs.
+	PurgeQuarantinedProofs(ctx context.Context,
+		q QuarantineQuery) (int64, error)
+}
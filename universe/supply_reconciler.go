@@ -0,0 +1,335 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+const (
+	// DefaultSupplyReconcileInterval is the default interval in which the
+	// local holdings are reconciled with the universe supply.
+	DefaultSupplyReconcileInterval = time.Hour
+
+	// defaultReconcileTimeout is the timeout of a single reconciliation.
+	defaultReconcileTimeout = 10 * time.Minute
+)
+
+// SupplyMismatchReason describes why the local holdings of an asset are
+// inconsistent with its universe supply.
+type SupplyMismatchReason uint8
+
+const (
+	// MismatchHoldingsExceedSupply means the local holdings of an asset
+	// are larger than the total supply reported by the universe. Either
+	// the local database is corrupt or the universe omits issuances.
+	MismatchHoldingsExceedSupply SupplyMismatchReason = iota
+
+	// MismatchRootSum means the sum of the universe root doesn't match the
+	// sum of the amounts of its minting leaves, so the universe tree
+	// itself is inconsistent.
+	MismatchRootSum
+)
+
+// String returns a human-readable description of the mismatch reason.
+func (r SupplyMismatchReason) String() string {
+	switch r {
+	case MismatchHoldingsExceedSupply:
+		return "holdings_exceed_supply"
+
+	case MismatchRootSum:
+		return "root_sum"
+
+	default:
+		return fmt.Sprintf("<unknown(%d)>", r)
+	}
+}
+
+// Holding is the total amount of an asset or an asset group held by the local
+// daemon.
+type Holding struct {
+	// ID is the universe identifier of the asset or asset group.
+	ID Identifier
+
+	// Balance is the total amount of unspent units held.
+	Balance uint64
+}
+
+// SupplySource is the universe that reports the total supply of assets.
+type SupplySource interface {
+	// RootNode returns the root node of the base universe corresponding
+	// to the passed ID. The sum of the root is the total supply.
+	RootNode(ctx context.Context, id Identifier) (BaseRoot, error)
+
+	// MintingLeaves returns the set of minting leaves known for the
+	// specified base universe.
+	MintingLeaves(ctx context.Context, id Identifier) ([]MintingLeaf,
+		error)
+}
+
+// SupplyReconcilerConfig is the configuration of the supply reconciler.
+type SupplyReconcilerConfig struct {
+	// Universe is the universe whose supply the holdings are reconciled
+	// with.
+	Universe SupplySource
+
+	// Holdings returns the local holdings of each asset and asset group.
+	Holdings func(ctx context.Context) ([]Holding, error)
+
+	// Interval is the interval in which the holdings are reconciled.
+	Interval time.Duration
+}
+
+// SupplyReconciler periodically compares the local holdings of each asset with
+// the total supply the universe reports in the sum of its MS-SMT root. The
+// universe root sum is in turn verified against the amounts of its minting
+// leaves. Inconsistencies are published as SupplyMismatchEvent, which catches
+// both local database corruption and a universe that equivocates about the
+// supply of an asset.
+type SupplyReconciler struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *SupplyReconcilerConfig
+
+	// eventBus delivers the events of the reconciler to all subscribers.
+	eventBus *fn.EventBus[fn.Event]
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewSupplyReconciler creates a new supply reconciler from the given config.
+func NewSupplyReconciler(cfg *SupplyReconcilerConfig) *SupplyReconciler {
+	return &SupplyReconciler{
+		cfg: cfg,
+		eventBus: fn.NewEventBus(
+			fn.DefaultEventBusBufferSize,
+			fn.WithDropMarker(fn.MarkDroppedHistory),
+		),
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts the periodic reconciliation.
+func (s *SupplyReconciler) Start() error {
+	s.startOnce.Do(func() {
+		log.Infof("Starting supply reconciler")
+
+		s.wg.Add(1)
+		go s.reconcilePeriodically()
+	})
+
+	return nil
+}
+
+// Stop stops the periodic reconciliation.
+func (s *SupplyReconciler) Stop() error {
+	s.stopOnce.Do(func() {
+		log.Infof("Stopping supply reconciler")
+
+		close(s.quit)
+		s.wg.Wait()
+	})
+
+	return nil
+}
+
+// reconcilePeriodically reconciles the holdings with the universe supply in
+// the configured interval.
+//
+// NOTE: This method MUST be called as a goroutine.
+func (s *SupplyReconciler) reconcilePeriodically() {
+	defer s.wg.Done()
+
+	interval := s.cfg.Interval
+	if interval == 0 {
+		interval = DefaultSupplyReconcileInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-s.quit:
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(
+			context.Background(), defaultReconcileTimeout,
+		)
+		_, err := s.Reconcile(ctx)
+		cancel()
+		if err != nil {
+			log.Errorf("Unable to reconcile holdings with universe "+
+				"supply: %v", err)
+		}
+	}
+}
+
+// Reconcile compares the local holdings of each asset with the universe
+// supply once. All found mismatches are published to the subscribers and
+// returned.
+func (s *SupplyReconciler) Reconcile(
+	ctx context.Context) ([]*SupplyMismatchEvent, error) {
+
+	holdings, err := s.cfg.Holdings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch holdings: %w", err)
+	}
+
+	var mismatches []*SupplyMismatchEvent
+	for _, holding := range holdings {
+		mismatch, err := s.reconcileHolding(ctx, holding)
+		if err != nil {
+			return mismatches, err
+		}
+		if mismatch == nil {
+			continue
+		}
+
+		log.Warnf("Supply mismatch (%v) for universe %v: holdings=%d, "+
+			"universe_supply=%d, leaf_sum=%d", mismatch.Reason,
+			holding.ID.StringForLog(), mismatch.LocalBalance,
+			mismatch.UniverseSupply, mismatch.LeafSum)
+
+		mismatches = append(mismatches, mismatch)
+		s.publishSubscriberEvent(mismatch)
+	}
+
+	log.Debugf("Reconciled %d holdings with universe supply, found %d "+
+		"mismatches", len(holdings), len(mismatches))
+
+	return mismatches, nil
+}
+
+// reconcileHolding reconciles a single holding with the universe supply. Nil
+// is returned if the holding is consistent or the universe doesn't know the
+// asset.
+func (s *SupplyReconciler) reconcileHolding(ctx context.Context,
+	holding Holding) (*SupplyMismatchEvent, error) {
+
+	root, err := s.cfg.Universe.RootNode(ctx, holding.ID)
+	switch {
+	// Assets that were never registered with the universe can't be
+	// reconciled.
+	case errors.Is(err, ErrNoUniverseRoot):
+		log.Debugf("No universe root for %v, skipping reconciliation",
+			holding.ID.StringForLog())
+		return nil, nil
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch universe root of "+
+			"%v: %w", holding.ID.StringForLog(), err)
+	}
+
+	leaves, err := s.cfg.Universe.MintingLeaves(ctx, holding.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch minting leaves of "+
+			"%v: %w", holding.ID.StringForLog(), err)
+	}
+
+	var leafSum uint64
+	for _, leaf := range leaves {
+		leafSum += leaf.Amt
+	}
+
+	var (
+		supply = root.NodeSum()
+		reason SupplyMismatchReason
+	)
+	switch {
+	case supply != leafSum:
+		reason = MismatchRootSum
+
+	case holding.Balance > supply:
+		reason = MismatchHoldingsExceedSupply
+
+	default:
+		return nil, nil
+	}
+
+	return NewSupplyMismatchEvent(
+		holding.ID, reason, holding.Balance, supply, leafSum,
+	), nil
+}
+
+// RegisterSubscriber adds a new subscriber to the set of subscribers that will
+// be notified of any supply mismatches.
+func (s *SupplyReconciler) RegisterSubscriber(
+	receiver *fn.EventReceiver[fn.Event], _ bool, _ bool) error {
+
+	s.eventBus.Subscribe(receiver, nil)
+
+	return nil
+}
+
+// RemoveSubscriber removes a subscriber from the set of subscribers that will
+// be notified of any supply mismatches.
+func (s *SupplyReconciler) RemoveSubscriber(
+	subscriber *fn.EventReceiver[fn.Event]) error {
+
+	return s.eventBus.Unsubscribe(subscriber)
+}
+
+// publishSubscriberEvent publishes an event to all subscribers.
+func (s *SupplyReconciler) publishSubscriberEvent(event fn.Event) {
+	s.eventBus.Publish(event)
+}
+
+// A compile-time assertion to make sure SupplyReconciler satisfies the
+// fn.EventPublisher interface.
+var _ fn.EventPublisher[fn.Event, bool] = (*SupplyReconciler)(nil)
+
+// SupplyMismatchEvent is an event which indicates that the local holdings of
+// an asset are inconsistent with its universe supply.
+type SupplyMismatchEvent struct {
+	// timestamp is the time the event was created.
+	timestamp time.Time
+
+	// ID is the universe identifier of the asset or asset group.
+	ID Identifier
+
+	// Reason describes the inconsistency.
+	Reason SupplyMismatchReason
+
+	// LocalBalance is the total amount of the asset held locally.
+	LocalBalance uint64
+
+	// UniverseSupply is the total supply reported by the sum of the
+	// universe root.
+	UniverseSupply uint64
+
+	// LeafSum is the sum of the amounts of all minting leaves of the
+	// universe.
+	LeafSum uint64
+}
+
+// NewSupplyMismatchEvent creates a new SupplyMismatchEvent.
+func NewSupplyMismatchEvent(id Identifier, reason SupplyMismatchReason,
+	localBalance, universeSupply, leafSum uint64) *SupplyMismatchEvent {
+
+	return &SupplyMismatchEvent{
+		timestamp:      time.Now().UTC(),
+		ID:             id,
+		Reason:         reason,
+		LocalBalance:   localBalance,
+		UniverseSupply: universeSupply,
+		LeafSum:        leafSum,
+	}
+}
+
+// Timestamp returns the timestamp of the event.
+func (e *SupplyMismatchEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// A compile-time assertion to make sure SupplyMismatchEvent satisfies the
+// fn.Event interface.
+var _ fn.Event = (*SupplyMismatchEvent)(nil)
@@ -0,0 +1,108 @@
+package universe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSupplySource is a universe with a fixed root sum and minting leaves per
+// asset.
+type mockSupplySource struct {
+	rootSums map[asset.ID]uint64
+	leaves   map[asset.ID][]uint64
+}
+
+func (m *mockSupplySource) RootNode(_ context.Context,
+	id Identifier) (BaseRoot, error) {
+
+	sum, ok := m.rootSums[id.AssetID]
+	if !ok {
+		return BaseRoot{}, ErrNoUniverseRoot
+	}
+
+	return BaseRoot{
+		ID:   id,
+		Node: mssmt.NewComputedBranch(mssmt.EmptyTreeRootHash, sum),
+	}, nil
+}
+
+func (m *mockSupplySource) MintingLeaves(_ context.Context,
+	id Identifier) ([]MintingLeaf, error) {
+
+	return fn.Map(m.leaves[id.AssetID], func(amt uint64) MintingLeaf {
+		return MintingLeaf{Amt: amt}
+	}), nil
+}
+
+// TestSupplyReconciler tests that holdings exceeding the universe supply and
+// universe roots that don't match their leaves are detected.
+func TestSupplyReconciler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		consistent     = asset.ID{1}
+		exceedsSupply  = asset.ID{2}
+		corruptRoot    = asset.ID{3}
+		unknownToUni   = asset.ID{4}
+		ctx            = context.Background()
+		mismatchEvents = fn.NewEventReceiver[fn.Event](
+			fn.DefaultQueueSize,
+		)
+	)
+
+	reconciler := NewSupplyReconciler(&SupplyReconcilerConfig{
+		Universe: &mockSupplySource{
+			rootSums: map[asset.ID]uint64{
+				consistent:    100,
+				exceedsSupply: 100,
+				corruptRoot:   100,
+			},
+			leaves: map[asset.ID][]uint64{
+				consistent:    {60, 40},
+				exceedsSupply: {100},
+				corruptRoot:   {60, 60},
+			},
+		},
+		Holdings: func(context.Context) ([]Holding, error) {
+			return []Holding{
+				{ID: Identifier{AssetID: consistent}, Balance: 100},
+				{ID: Identifier{AssetID: exceedsSupply}, Balance: 101},
+				{ID: Identifier{AssetID: corruptRoot}, Balance: 10},
+				{ID: Identifier{AssetID: unknownToUni}, Balance: 10},
+			}, nil
+		},
+	})
+	require.NoError(t, reconciler.RegisterSubscriber(
+		mismatchEvents, false, false,
+	))
+
+	mismatches, err := reconciler.Reconcile(ctx)
+	require.NoError(t, err)
+	require.Len(t, mismatches, 2)
+
+	require.Equal(t, exceedsSupply, mismatches[0].ID.AssetID)
+	require.Equal(t, MismatchHoldingsExceedSupply, mismatches[0].Reason)
+	require.EqualValues(t, 101, mismatches[0].LocalBalance)
+	require.EqualValues(t, 100, mismatches[0].UniverseSupply)
+
+	require.Equal(t, corruptRoot, mismatches[1].ID.AssetID)
+	require.Equal(t, MismatchRootSum, mismatches[1].Reason)
+	require.EqualValues(t, 120, mismatches[1].LeafSum)
+
+	// The mismatches are published to the subscribers as well.
+	for _, mismatch := range mismatches {
+		event, err := fn.RecvOrTimeout(
+			mismatchEvents.NewItemCreated.ChanOut(), time.Second,
+		)
+		require.NoError(t, err)
+		require.Equal(t, mismatch, *event)
+	}
+
+	require.NoError(t, reconciler.RemoveSubscriber(mismatchEvents))
+}
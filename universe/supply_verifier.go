@@ -0,0 +1,480 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// DefaultSupplyVerifyInterval is the default interval in which the
+	// supply of the selected assets is verified against the chain.
+	DefaultSupplyVerifyInterval = 6 * time.Hour
+
+	// defaultSupplyVerifyTimeout is the timeout of verifying the supply of
+	// a single asset.
+	defaultSupplyVerifyTimeout = 30 * time.Minute
+)
+
+// SupplyReportVersion is the version of the supply report encoding.
+type SupplyReportVersion uint8
+
+const (
+	// SupplyReportV0 is the initial version of supply reports.
+	SupplyReportV0 SupplyReportVersion = 0
+)
+
+const (
+	supplyReportVersionType        tlv.Type = 0
+	supplyReportAssetIDType        tlv.Type = 2
+	supplyReportGroupKeyType       tlv.Type = 4
+	supplyReportRootHashType       tlv.Type = 6
+	supplyReportRootSumType        tlv.Type = 8
+	supplyReportVerifiedSupplyType tlv.Type = 10
+	supplyReportNumLeavesType      tlv.Type = 12
+	supplyReportNumInvalidType     tlv.Type = 14
+	supplyReportTimestampType      tlv.Type = 16
+	supplyReportNodeKeyType        tlv.Type = 18
+)
+
+// supplyReportTag is prepended to the serialized supply report before signing
+// it, so a report signature can never be mistaken for a signature over
+// anything else signed with the node's identity key.
+var supplyReportTag = []byte("taproot-assets/supply-report")
+
+// InvalidSupplyLeaf is a minting leaf whose issuance proof failed
+// verification, so its amount isn't counted towards the verified supply.
+type InvalidSupplyLeaf struct {
+	// OutPoint is the outpoint the leaf claims to have minted the asset
+	// at. This is zero if the proof couldn't be decoded.
+	OutPoint wire.OutPoint
+
+	// Amt is the amount the leaf claims to have minted.
+	Amt uint64
+
+	// Reason is the reason the leaf was rejected for.
+	Reason string
+}
+
+// SupplyReport is a statement signed by the identity key of the local node
+// about the total supply of an asset or asset group. The supply is re-derived
+// by verifying each issuance proof of the universe against the chain, so a
+// report can be handed to explorers or used in supply attestations.
+type SupplyReport struct {
+	// Version is the version of the report encoding.
+	Version SupplyReportVersion
+
+	// ID identifies the universe of the asset or asset group.
+	ID Identifier
+
+	// RootHash is the hash of the universe root at the time of the
+	// verification.
+	RootHash mssmt.NodeHash
+
+	// RootSum is the total supply claimed by the sum of the universe root.
+	RootSum uint64
+
+	// VerifiedSupply is the sum of the amounts of all minting leaves whose
+	// issuance proofs were verified against the chain.
+	VerifiedSupply uint64
+
+	// NumLeaves is the number of minting leaves of the universe.
+	NumLeaves uint64
+
+	// InvalidLeaves are the minting leaves that failed verification. Only
+	// their number is covered by the signature.
+	InvalidLeaves []InvalidSupplyLeaf
+
+	// Timestamp is the time the verification finished at.
+	Timestamp time.Time
+
+	// NodeKey is the identity key of the node that signed the report.
+	NodeKey *btcec.PublicKey
+
+	// Signature is the DER encoded ECDSA signature of the node key over the
+	// sha256 hash of the report message.
+	Signature []byte
+}
+
+// Consistent returns true if all issuance proofs were verified and their
+// amounts add up to the supply claimed by the universe root.
+func (r *SupplyReport) Consistent() bool {
+	return len(r.InvalidLeaves) == 0 && r.VerifiedSupply == r.RootSum
+}
+
+// Message returns the message that is signed by the node key.
+func (r *SupplyReport) Message() ([]byte, error) {
+	if r.NodeKey == nil {
+		return nil, fmt.Errorf("supply report is missing the node key")
+	}
+
+	var groupKey []byte
+	if r.ID.GroupKey != nil {
+		groupKey = schnorr.SerializePubKey(r.ID.GroupKey)
+	}
+
+	var (
+		version    = uint8(r.Version)
+		assetID    = [32]byte(r.ID.AssetID)
+		rootHash   = [32]byte(r.RootHash)
+		numInvalid = uint64(len(r.InvalidLeaves))
+		timestamp  = uint64(r.Timestamp.Unix())
+	)
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(supplyReportVersionType, &version),
+		tlv.MakePrimitiveRecord(supplyReportAssetIDType, &assetID),
+		tlv.MakePrimitiveRecord(supplyReportGroupKeyType, &groupKey),
+		tlv.MakePrimitiveRecord(supplyReportRootHashType, &rootHash),
+		tlv.MakePrimitiveRecord(supplyReportRootSumType, &r.RootSum),
+		tlv.MakePrimitiveRecord(
+			supplyReportVerifiedSupplyType, &r.VerifiedSupply,
+		),
+		tlv.MakePrimitiveRecord(
+			supplyReportNumLeavesType, &r.NumLeaves,
+		),
+		tlv.MakePrimitiveRecord(
+			supplyReportNumInvalidType, &numInvalid,
+		),
+		tlv.MakePrimitiveRecord(supplyReportTimestampType, &timestamp),
+		tlv.MakePrimitiveRecord(supplyReportNodeKeyType, &r.NodeKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	b.Write(supplyReportTag)
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// VerifySupplyReport checks that the report was signed by the node key it
+// contains. Whether the node key belongs to a trusted party must be checked
+// separately.
+func VerifySupplyReport(report *SupplyReport) error {
+	if report.Version != SupplyReportV0 {
+		return fmt.Errorf("unknown supply report version: %d",
+			report.Version)
+	}
+
+	msg, err := report.Message()
+	if err != nil {
+		return err
+	}
+
+	sig, err := ecdsa.ParseDERSignature(report.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid supply report signature: %w", err)
+	}
+
+	digest := sha256.Sum256(msg)
+	if !sig.Verify(digest[:], report.NodeKey) {
+		return fmt.Errorf("supply report signature doesn't match " +
+			"node key")
+	}
+
+	return nil
+}
+
+// SupplyVerifierConfig is the configuration of the supply verifier.
+type SupplyVerifierConfig struct {
+	// Universe is the universe whose issuance leaves are verified.
+	Universe SupplySource
+
+	// HeaderVerifier is used to verify that the blocks the issuance proofs
+	// are anchored in are part of the chain.
+	HeaderVerifier proof.HeaderVerifier
+
+	// Signer is used to sign the supply reports with the identity key of
+	// the node.
+	Signer proof.MessageSigner
+
+	// NodeKey is the identity key of the node.
+	NodeKey *btcec.PublicKey
+
+	// Assets are the assets and asset groups whose supply is verified
+	// periodically.
+	Assets []Identifier
+
+	// Interval is the interval in which the supply of the assets is
+	// verified.
+	Interval time.Duration
+}
+
+// SupplyVerifier periodically re-derives the total supply of the selected
+// assets by walking their universe issuance leaves and verifying each genesis
+// and re-issuance proof against the chain. The outcome is kept as a supply
+// report signed by the node key, which can be queried at any time.
+type SupplyVerifier struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *SupplyVerifierConfig
+
+	// reports is the latest supply report of each verified universe,
+	// keyed by the universe ID.
+	reports map[[32]byte]*SupplyReport
+
+	// reportsMtx guards the reports map.
+	reportsMtx sync.RWMutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewSupplyVerifier creates a new supply verifier from the given config.
+func NewSupplyVerifier(cfg *SupplyVerifierConfig) *SupplyVerifier {
+	return &SupplyVerifier{
+		cfg:     cfg,
+		reports: make(map[[32]byte]*SupplyReport),
+		quit:    make(chan struct{}),
+	}
+}
+
+// Start starts the periodic supply verification. If no assets are selected,
+// supplies are only verified on demand.
+func (s *SupplyVerifier) Start() error {
+	s.startOnce.Do(func() {
+		if len(s.cfg.Assets) == 0 {
+			return
+		}
+
+		log.Infof("Starting supply verifier for %d assets",
+			len(s.cfg.Assets))
+
+		s.wg.Add(1)
+		go s.verifyPeriodically()
+	})
+
+	return nil
+}
+
+// Stop stops the periodic supply verification.
+func (s *SupplyVerifier) Stop() error {
+	s.stopOnce.Do(func() {
+		log.Infof("Stopping supply verifier")
+
+		close(s.quit)
+		s.wg.Wait()
+	})
+
+	return nil
+}
+
+// verifyPeriodically verifies the supply of the selected assets right away and
+// then in the configured interval.
+//
+// NOTE: This method MUST be called as a goroutine.
+func (s *SupplyVerifier) verifyPeriodically() {
+	defer s.wg.Done()
+
+	interval := s.cfg.Interval
+	if interval == 0 {
+		interval = DefaultSupplyVerifyInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for _, id := range s.cfg.Assets {
+			ctx, cancel := context.WithTimeout(
+				context.Background(),
+				defaultSupplyVerifyTimeout,
+			)
+			_, err := s.VerifySupply(ctx, id)
+			cancel()
+			if err != nil {
+				log.Errorf("Unable to verify supply of %v: %v",
+					id.StringForLog(), err)
+			}
+
+			select {
+			case <-s.quit:
+				return
+			default:
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// VerifySupply re-derives the supply of the given asset or asset group from
+// its universe issuance leaves, verifying each issuance proof against the
+// chain. The resulting report is signed, stored as the latest report of the
+// universe and returned.
+func (s *SupplyVerifier) VerifySupply(ctx context.Context,
+	id Identifier) (*SupplyReport, error) {
+
+	root, err := s.cfg.Universe.RootNode(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch universe root of "+
+			"%v: %w", id.StringForLog(), err)
+	}
+
+	leaves, err := s.cfg.Universe.MintingLeaves(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch minting leaves of "+
+			"%v: %w", id.StringForLog(), err)
+	}
+
+	report := &SupplyReport{
+		Version:   SupplyReportV0,
+		ID:        id,
+		RootHash:  root.NodeHash(),
+		RootSum:   root.NodeSum(),
+		NumLeaves: uint64(len(leaves)),
+		NodeKey:   s.cfg.NodeKey,
+	}
+	for _, leaf := range leaves {
+		outPoint, err := s.verifyLeaf(ctx, id, leaf)
+		switch {
+		// An aborted verification doesn't tell us anything about the
+		// validity of the leaf, so we can't produce a report.
+		case err != nil && ctx.Err() != nil:
+			return nil, fmt.Errorf("unable to verify minting "+
+				"leaf: %w", err)
+
+		case err != nil:
+			log.Warnf("Invalid minting leaf in universe %v: %v",
+				id.StringForLog(), err)
+
+			report.InvalidLeaves = append(
+				report.InvalidLeaves, InvalidSupplyLeaf{
+					OutPoint: outPoint,
+					Amt:      leaf.Amt,
+					Reason:   err.Error(),
+				},
+			)
+
+		default:
+			report.VerifiedSupply += leaf.Amt
+		}
+	}
+	report.Timestamp = time.Now().UTC()
+
+	if err := s.signReport(ctx, report); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Verified supply of %v: root_sum=%d, verified_supply=%d, "+
+		"num_leaves=%d, num_invalid=%d", id.StringForLog(),
+		report.RootSum, report.VerifiedSupply, report.NumLeaves,
+		len(report.InvalidLeaves))
+
+	s.reportsMtx.Lock()
+	s.reports[id.Bytes()] = report
+	s.reportsMtx.Unlock()
+
+	return report, nil
+}
+
+// verifyLeaf verifies the issuance proof of a single minting leaf against the
+// chain and makes sure it issues the claimed amount of the given asset. The
+// outpoint the proof mints the asset at is returned, if the proof could be
+// decoded.
+func (s *SupplyVerifier) verifyLeaf(ctx context.Context, id Identifier,
+	leaf MintingLeaf) (wire.OutPoint, error) {
+
+	var issuanceProof proof.Proof
+	err := issuanceProof.Decode(bytes.NewReader(leaf.GenesisProof))
+	if err != nil {
+		return wire.OutPoint{}, fmt.Errorf("unable to decode issuance "+
+			"proof: %w", err)
+	}
+
+	outPoint := wire.OutPoint{
+		Hash:  issuanceProof.AnchorTx.TxHash(),
+		Index: issuanceProof.InclusionProof.OutputIndex,
+	}
+
+	snapshot, err := issuanceProof.Verify(ctx, nil, s.cfg.HeaderVerifier)
+	if err != nil {
+		return outPoint, fmt.Errorf("unable to verify issuance proof: "+
+			"%w", err)
+	}
+
+	newAsset := snapshot.Asset
+	prevWitnesses := newAsset.PrevWitnesses
+	switch {
+	// Only genesis and re-issuance proofs create new supply.
+	case len(prevWitnesses) != 1 || prevWitnesses[0].PrevID == nil ||
+		*prevWitnesses[0].PrevID != asset.ZeroPrevID:
+
+		return outPoint, fmt.Errorf("proof is not an issuance proof")
+
+	case id.GroupKey != nil && (newAsset.GroupKey == nil ||
+		!bytes.Equal(
+			schnorr.SerializePubKey(id.GroupKey),
+			schnorr.SerializePubKey(&newAsset.GroupKey.GroupPubKey),
+		)):
+
+		return outPoint, fmt.Errorf("group key mismatch")
+
+	case id.GroupKey == nil && id.AssetID != newAsset.ID():
+		return outPoint, fmt.Errorf("asset id mismatch: expected %v, "+
+			"got %v", id.AssetID, newAsset.ID())
+
+	case newAsset.Amount != leaf.Amt:
+		return outPoint, fmt.Errorf("amount mismatch: leaf claims %d, "+
+			"proof issues %d", leaf.Amt, newAsset.Amount)
+	}
+
+	return snapshot.OutPoint, nil
+}
+
+// signReport signs the given supply report with the identity key of the node.
+func (s *SupplyVerifier) signReport(ctx context.Context,
+	report *SupplyReport) error {
+
+	msg, err := report.Message()
+	if err != nil {
+		return err
+	}
+
+	sig, err := s.cfg.Signer.SignMessage(ctx, msg, proof.NodeKeyLocator)
+	if err != nil {
+		return fmt.Errorf("unable to sign supply report: %w", err)
+	}
+
+	report.Signature = sig
+
+	// A report that doesn't verify would be useless to anyone we hand it
+	// to, so we rather fail early.
+	if err := VerifySupplyReport(report); err != nil {
+		return fmt.Errorf("signed supply report invalid, node key "+
+			"mismatch? %w", err)
+	}
+
+	return nil
+}
+
+// LatestReport returns the latest supply report of the given asset or asset
+// group, if its supply was verified before.
+func (s *SupplyVerifier) LatestReport(id Identifier) (*SupplyReport, bool) {
+	s.reportsMtx.RLock()
+	defer s.reportsMtx.RUnlock()
+
+	report, ok := s.reports[id.Bytes()]
+	return report, ok
+}
@@ -0,0 +1,99 @@
+package universe
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// mockMessageSigner signs messages with a single private key, the same way lnd
+// does.
+type mockMessageSigner struct {
+	privKey *btcec.PrivateKey
+}
+
+func (m *mockMessageSigner) SignMessage(_ context.Context, msg []byte,
+	_ keychain.KeyLocator, _ ...lndclient.SignMessageOption) ([]byte,
+	error) {
+
+	digest := sha256.Sum256(msg)
+	return ecdsa.Sign(m.privKey, digest[:]).Serialize(), nil
+}
+
+// TestSupplyVerifier tests that leaves with invalid issuance proofs aren't
+// counted towards the verified supply, and that the resulting report is
+// signed by the node key.
+func TestSupplyVerifier(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		nodeKey = test.RandPrivKey(t)
+		id      = Identifier{AssetID: asset.RandID(t)}
+	)
+
+	verifier := NewSupplyVerifier(&SupplyVerifierConfig{
+		Universe: &mockSupplySource{
+			rootSums: map[asset.ID]uint64{
+				id.AssetID: 100,
+			},
+			leaves: map[asset.ID][]uint64{
+				id.AssetID: {60, 40},
+			},
+		},
+		HeaderVerifier: proof.MockHeaderVerifier,
+		Signer:         &mockMessageSigner{privKey: nodeKey},
+		NodeKey:        nodeKey.PubKey(),
+	})
+
+	_, ok := verifier.LatestReport(id)
+	require.False(t, ok)
+
+	// The mock leaves carry no proofs, so none of them can be verified.
+	report, err := verifier.VerifySupply(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), report.RootSum)
+	require.Equal(t, uint64(2), report.NumLeaves)
+	require.Zero(t, report.VerifiedSupply)
+	require.Len(t, report.InvalidLeaves, 2)
+	require.Equal(t, uint64(60), report.InvalidLeaves[0].Amt)
+	require.False(t, report.Consistent())
+	require.NoError(t, VerifySupplyReport(report))
+
+	latest, ok := verifier.LatestReport(id)
+	require.True(t, ok)
+	require.Equal(t, report, latest)
+
+	// A tampered report no longer verifies.
+	tampered := *report
+	tampered.VerifiedSupply = report.RootSum
+	require.Error(t, VerifySupplyReport(&tampered))
+
+	// Neither does a report claimed by a different node.
+	tampered = *report
+	tampered.NodeKey = test.RandPubKey(t)
+	require.Error(t, VerifySupplyReport(&tampered))
+
+	// A signer with a mismatched node key fails early.
+	badVerifier := NewSupplyVerifier(&SupplyVerifierConfig{
+		Universe:       verifier.cfg.Universe,
+		HeaderVerifier: proof.MockHeaderVerifier,
+		Signer:         verifier.cfg.Signer,
+		NodeKey:        test.RandPubKey(t),
+	})
+	_, err = badVerifier.VerifySupply(ctx, id)
+	require.ErrorContains(t, err, "node key mismatch")
+
+	// Unknown assets can't be verified.
+	_, err = verifier.VerifySupply(ctx, Identifier{AssetID: asset.ID{1}})
+	require.ErrorIs(t, err, ErrNoUniverseRoot)
+}
@@ -0,0 +1,76 @@
+package universe
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/mssmt"
+)
+
+const (
+	// checkpointInterval is the number of fetched leaves after which the
+	// progress of a running universe sync is written to its checkpoint.
+	checkpointInterval = 50
+)
+
+// SyncCheckpoint records the progress of syncing a single universe with a
+// remote universe server. If a sync is interrupted, the next sync to the same
+// remote root resumes from the checkpoint, and universes that were already
+// fully synced to the current remote root are skipped.
+type SyncCheckpoint struct {
+	// ServerHost is the host of the remote universe server.
+	ServerHost string
+
+	// ID identifies the synced universe.
+	ID Identifier
+
+	// Root is the remote universe root that is synced to.
+	Root mssmt.Node
+
+	// LeavesFetched is the number of leaves that were fetched from the
+	// remote server while syncing to the root.
+	LeavesFetched uint64
+
+	// LeavesTotal is the number of leaves that were missing locally when
+	// the sync to the root started.
+	LeavesTotal uint64
+
+	// Complete is true once all missing leaves were fetched.
+	Complete bool
+
+	// LastError is the error the last sync attempt failed with, if any.
+	LastError string
+
+	// UpdatedAt is the time the checkpoint was last updated.
+	UpdatedAt time.Time
+}
+
+// MatchesRoot returns true if the checkpoint tracks the sync to the given
+// remote universe root.
+func (c *SyncCheckpoint) MatchesRoot(root mssmt.Node) bool {
+	return c.Root != nil && mssmt.IsEqualNode(c.Root, root)
+}
+
+// SyncCheckpointQuery is used to filter the sync checkpoints that are
+// returned. Unset fields match all checkpoints.
+type SyncCheckpointQuery struct {
+	// ServerHost is the host of the remote universe server.
+	ServerHost string
+
+	// ID identifies the synced universe.
+	ID *Identifier
+}
+
+// SyncCheckpointStore is used to persist the progress of universe syncs, per
+// remote server and universe.
+type SyncCheckpointStore interface {
+	// UpsertSyncCheckpoint inserts or replaces the checkpoint of the sync
+	// of a universe with a remote server.
+	UpsertSyncCheckpoint(ctx context.Context,
+		checkpoint *SyncCheckpoint) error
+
+	// QuerySyncCheckpoints returns all sync checkpoints that match the
+	// given query, ordered by server and universe.
+	QuerySyncCheckpoints(ctx context.Context,
+		q SyncCheckpointQuery) ([]*SyncCheckpoint, error)
+}
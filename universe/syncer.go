@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
 )
 
 var (
@@ -31,6 +34,16 @@ type SimpleSyncCfg struct {
 	// This is used to insert new proof into the local DB as a result of
 	// the diff operation.
 	LocalRegistrar Registrar
+
+	// SyncCheckpoints is used to record the progress of each universe
+	// sync, so interrupted syncs can be resumed. If nil, every sync starts
+	// from scratch.
+	SyncCheckpoints SyncCheckpointStore
+
+	// ProofQuarantine is used to keep proofs that fail validation out of
+	// the local universe. If nil, an invalid proof fails the sync of its
+	// universe.
+	ProofQuarantine ProofQuarantine
 }
 
 // SimpleSyncer is a simple implementation of the Syncer interface. It's based
@@ -49,8 +62,9 @@ func NewSimpleSyncer(cfg SimpleSyncCfg) *SimpleSyncer {
 // executeSync attempts to sync the local Universe with the remote diff engine.
 // A simple approach where a set difference is used to find the set of assets
 // that need to be synced is used.
-func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
-	syncType SyncType, idsToSync []Identifier) ([]AssetSyncDiff, error) {
+func (s *SimpleSyncer) executeSync(ctx context.Context, host ServerAddr,
+	diffEngine DiffEngine, syncType SyncType,
+	idsToSync []Identifier) ([]AssetSyncDiff, error) {
 
 	var (
 		rootsToSync = make(chan BaseRoot, len(idsToSync))
@@ -109,8 +123,38 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 	// the diff operation for each of them.
 	syncDiffs := make(chan AssetSyncDiff, len(targetRoots))
 	err = fn.ParSlice(ctx, targetRoots, func(ctx context.Context, remoteRoot BaseRoot) error {
-		// First, we'll compare the remote root against the local root.
+		// If a previous sync already fetched all leaves of this very
+		// remote root, then nothing changed on the remote side since.
 		uniID := remoteRoot.ID
+		checkpoint := s.fetchCheckpoint(ctx, host, uniID)
+		if checkpoint != nil && checkpoint.Complete &&
+			checkpoint.MatchesRoot(remoteRoot) {
+
+			log.Infof("Root for %v unchanged since last sync, no "+
+				"sync needed", uniID.String())
+
+			return nil
+		}
+
+		progress := SyncCheckpoint{
+			ServerHost: host.HostStr(),
+			ID:         uniID,
+			Root:       remoteRoot.Node,
+		}
+
+		// If an earlier sync to the same remote root was interrupted,
+		// the leaves it already fetched are part of our local universe
+		// now and aren't fetched again. We resume its progress.
+		if checkpoint != nil && checkpoint.MatchesRoot(remoteRoot) {
+			log.Infof("UniverseRoot(%v): resuming sync, %d leaves "+
+				"already fetched", uniID.String(),
+				checkpoint.LeavesFetched)
+
+			progress.LeavesFetched = checkpoint.LeavesFetched
+			progress.LeavesTotal = checkpoint.LeavesFetched
+		}
+
+		// Next, we'll compare the remote root against the local root.
 		localRoot, err := s.cfg.LocalDiffEngine.RootNode(ctx, uniID)
 		switch {
 		// If we don't have this root, then we don't have anything to
@@ -125,6 +169,9 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 			log.Infof("Root for %v matches, no sync needed",
 				uniID.String())
 
+			progress.Complete = true
+			s.storeCheckpoint(progress)
+
 			return nil
 
 		case err != nil:
@@ -138,6 +185,9 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 		// the set of keys we need to fetch.
 		remoteUnikeys, err := diffEngine.MintingKeys(ctx, uniID)
 		if err != nil {
+			progress.LastError = err.Error()
+			s.storeCheckpoint(progress)
+
 			return err
 		}
 		localUnikeys, err := s.cfg.LocalDiffEngine.MintingKeys(
@@ -150,6 +200,8 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 		// With the set of keys fetched, we can now find the set of
 		// keys that need to be synced.
 		keysToFetch := fn.SetDiff(remoteUnikeys, localUnikeys)
+		progress.LeavesTotal += uint64(len(keysToFetch))
+		s.storeCheckpoint(progress)
 
 		log.Infof("UniverseRoot(%v): diff_size=%v", uniID.String(),
 			len(keysToFetch))
@@ -159,6 +211,17 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 
 		// Now that we know where the divergence is, we can fetch the
 		// issuance proofs from the remote party.
+		var progressMtx sync.Mutex
+		leafDone := func() {
+			progressMtx.Lock()
+			defer progressMtx.Unlock()
+
+			progress.LeavesFetched++
+			if progress.LeavesFetched%checkpointInterval == 0 {
+				s.storeCheckpoint(progress)
+			}
+		}
+
 		newLeaves := make(chan *MintingLeaf, len(keysToFetch))
 		err = fn.ParSlice(ctx, keysToFetch, func(ctx context.Context, key BaseKey) error {
 			newProof, err := diffEngine.FetchIssuanceProof(ctx, uniID, key)
@@ -172,8 +235,14 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 			// that it's actually part of the remote root we were
 			// given.
 			if !leafProof.VerifyRoot(remoteRoot) {
-				return fmt.Errorf("proof for key=%v is "+
-					"invalid", spew.Sdump(key))
+				err := fmt.Errorf("%w: proof for key=%v is "+
+					"not part of the remote root",
+					ErrInvalidProof, spew.Sdump(key))
+
+				return s.quarantineProof(
+					ctx, host, uniID, key, leafProof.Leaf,
+					err, leafDone,
+				)
 			}
 
 			// TODO(roasbeef): inclusion w/ root here, also that
@@ -189,18 +258,35 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 			_, err = s.cfg.LocalRegistrar.RegisterIssuance(
 				ctx, uniID, key, leafProof.Leaf,
 			)
-			if err != nil {
+			switch {
+			// The registrar fully verifies the proof before it is
+			// inserted, invalid proofs are quarantined.
+			case errors.Is(err, ErrInvalidProof):
+				return s.quarantineProof(
+					ctx, host, uniID, key, leafProof.Leaf,
+					err, leafDone,
+				)
+
+			case err != nil:
 				return fmt.Errorf("unable to register "+
 					"issuance proof: %w", err)
 			}
 
+			leafDone()
+
 			newLeaves <- leafProof.Leaf
 			return nil
 		})
 		if err != nil {
+			progress.LastError = err.Error()
+			s.storeCheckpoint(progress)
+
 			return err
 		}
 
+		progress.Complete = true
+		s.storeCheckpoint(progress)
+
 		log.Infof("Universe sync for UniverseRoot(%v) complete, %d "+
 			"new leaves inserted", uniID.String(), len(keysToFetch))
 
@@ -262,5 +348,91 @@ func (s *SimpleSyncer) SyncUniverse(ctx context.Context, host ServerAddr,
 
 	// With the engine created, we can now sync the local Universe with the
 	// remote instance.
-	return s.executeSync(ctx, diffEngine, syncType, idsToSync)
+	return s.executeSync(ctx, host, diffEngine, syncType, idsToSync)
+}
+
+// quarantineProof diverts a proof that failed validation into the quarantine,
+// so an invalid leaf served by a remote server neither ends up in our local
+// universe nor aborts the sync of all other leaves. The done callback is
+// called once the proof is quarantined. Without a quarantine, the validation
+// error is returned and the sync of the universe fails.
+func (s *SimpleSyncer) quarantineProof(ctx context.Context, host ServerAddr,
+	id Identifier, key BaseKey, leaf *MintingLeaf, reason error,
+	done func()) error {
+
+	if s.cfg.ProofQuarantine == nil {
+		return reason
+	}
+
+	log.Warnf("UniverseRoot(%v): quarantining proof from server=%v: %v",
+		id.String(), host.HostStr(), reason)
+
+	var proofBlob proof.Blob
+	if leaf != nil {
+		proofBlob = leaf.GenesisProof
+	}
+
+	err := s.cfg.ProofQuarantine.QuarantineProof(ctx, &QuarantinedProof{
+		ServerHost:    host.HostStr(),
+		UniverseID:    id,
+		Key:           key,
+		Proof:         proofBlob,
+		Reason:        reason.Error(),
+		QuarantinedAt: time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to quarantine proof: %w", err)
+	}
+
+	done()
+
+	return nil
+}
+
+// fetchCheckpoint returns the checkpoint of the last sync of the given
+// universe with the given server, or nil if there is none.
+func (s *SimpleSyncer) fetchCheckpoint(ctx context.Context, host ServerAddr,
+	id Identifier) *SyncCheckpoint {
+
+	if s.cfg.SyncCheckpoints == nil {
+		return nil
+	}
+
+	checkpoints, err := s.cfg.SyncCheckpoints.QuerySyncCheckpoints(
+		ctx, SyncCheckpointQuery{
+			ServerHost: host.HostStr(),
+			ID:         &id,
+		},
+	)
+	if err != nil {
+		log.Warnf("Unable to fetch sync checkpoint of %v: %v",
+			id.String(), err)
+		return nil
+	}
+	if len(checkpoints) == 0 {
+		return nil
+	}
+
+	return checkpoints[0]
+}
+
+// storeCheckpoint persists the given sync progress. Checkpoints are stored
+// with their own timeout, so the progress of a sync that is interrupted isn't
+// lost. As checkpoints are only an optimization, failures are only logged.
+func (s *SimpleSyncer) storeCheckpoint(progress SyncCheckpoint) {
+	if s.cfg.SyncCheckpoints == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), DefaultTimeout,
+	)
+	defer cancel()
+
+	progress.UpdatedAt = time.Now()
+	err := s.cfg.SyncCheckpoints.UpsertSyncCheckpoint(ctx, &progress)
+	if err != nil {
+		log.Warnf("Unable to store sync checkpoint of %v: %v",
+			progress.ID.String(), err)
+	}
 }
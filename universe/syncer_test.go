@@ -0,0 +1,73 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// mockProofQuarantine is a proof quarantine that keeps proofs in memory.
+type mockProofQuarantine struct {
+	ProofQuarantine
+
+	proofs []*QuarantinedProof
+}
+
+func (m *mockProofQuarantine) QuarantineProof(_ context.Context,
+	p *QuarantinedProof) error {
+
+	m.proofs = append(m.proofs, p)
+	return nil
+}
+
+// TestQuarantineProof tests that invalid proofs are quarantined if a
+// quarantine is configured, and fail the sync otherwise.
+func TestQuarantineProof(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	host := NewServerAddrFromStr("localhost:10029")
+	id := Identifier{
+		AssetID: asset.RandID(t),
+	}
+	scriptKey := asset.RandScriptKey(t)
+	key := BaseKey{
+		MintingOutpoint: test.RandOp(t),
+		ScriptKey:       &scriptKey,
+	}
+	leaf := &MintingLeaf{
+		GenesisProof: test.RandBytes(100),
+	}
+	reason := fmt.Errorf("%w: outpoint mismatch", ErrInvalidProof)
+
+	var numDone int
+	done := func() {
+		numDone++
+	}
+
+	// Without a quarantine, the validation error is returned.
+	syncer := NewSimpleSyncer(SimpleSyncCfg{})
+	err := syncer.quarantineProof(ctx, host, id, key, leaf, reason, done)
+	require.ErrorIs(t, err, ErrInvalidProof)
+	require.Zero(t, numDone)
+
+	// With a quarantine, the proof is kept aside and the sync goes on.
+	quarantine := &mockProofQuarantine{}
+	syncer = NewSimpleSyncer(SimpleSyncCfg{
+		ProofQuarantine: quarantine,
+	})
+	err = syncer.quarantineProof(ctx, host, id, key, leaf, reason, done)
+	require.NoError(t, err)
+	require.Equal(t, 1, numDone)
+
+	require.Len(t, quarantine.proofs, 1)
+	require.Equal(t, host.HostStr(), quarantine.proofs[0].ServerHost)
+	require.Equal(t, id, quarantine.proofs[0].UniverseID)
+	require.Equal(t, key, quarantine.proofs[0].Key)
+	require.Equal(t, leaf.GenesisProof, quarantine.proofs[0].Proof)
+	require.Equal(t, reason.Error(), quarantine.proofs[0].Reason)
+}
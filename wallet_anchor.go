@@ -135,6 +135,14 @@ func (l *LndRpcWalletAnchor) UnlockInput(ctx context.Context) error {
 	return nil
 }
 
+// BumpFee bumps the fee of the transaction that created the given wallet owned
+// output to the given fee rate, by spending the output in a child transaction.
+func (l *LndRpcWalletAnchor) BumpFee(ctx context.Context, op wire.OutPoint,
+	feeRate chainfee.SatPerKWeight) error {
+
+	return l.lnd.WalletKit.BumpFee(ctx, op, feeRate)
+}
+
 // ListUnspentImportScripts lists all UTXOs of the imported Taproot scripts.
 func (l *LndRpcWalletAnchor) ListUnspentImportScripts(
 	ctx context.Context) ([]*lnwallet.Utxo, error) {
@@ -169,8 +177,23 @@ func (l *LndRpcWalletAnchor) ListTransactions(ctx context.Context, startHeight,
 	)
 }
 
+// NewSweepAddr returns a new Taproot address of the default account of the
+// backing lnd node.
+func (l *LndRpcWalletAnchor) NewSweepAddr(
+	ctx context.Context) (btcutil.Address, error) {
+
+	return l.lnd.WalletKit.NextAddr(
+		ctx, lnwallet.DefaultAccountName,
+		walletrpc.AddressType_TAPROOT_PUBKEY, false,
+	)
+}
+
 // A compile time assertion to ensure LndRpcWalletAnchor meets the
 // tapgarden.WalletAnchor interface.
 var _ tapgarden.WalletAnchor = (*LndRpcWalletAnchor)(nil)
 
 var _ tapfreighter.WalletAnchor = (*LndRpcWalletAnchor)(nil)
+
+var _ tapfreighter.FeeBumper = (*LndRpcWalletAnchor)(nil)
+
+var _ tapfreighter.SweepAddrSource = (*LndRpcWalletAnchor)(nil)
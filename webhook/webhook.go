@@ -0,0 +1,824 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/universe"
+)
+
+const (
+	// DefaultMaxAttempts is the default number of times a notification is
+	// posted to an endpoint before it is dropped.
+	DefaultMaxAttempts = 8
+
+	// DefaultInitialBackoff is the default time to wait before the first
+	// retry of a failed notification. The wait time doubles with each
+	// further retry.
+	DefaultInitialBackoff = time.Second
+
+	// DefaultMaxBackoff is the default maximum time to wait between two
+	// attempts of posting a notification.
+	DefaultMaxBackoff = 5 * time.Minute
+
+	// DefaultTimeout is the default timeout of a single attempt of posting
+	// a notification.
+	DefaultTimeout = 10 * time.Second
+
+	// SignatureHeader is the HTTP header that carries the hex encoded
+	// HMAC-SHA256 signature of the request body, prefixed with "sha256=".
+	SignatureHeader = "X-Tapd-Signature"
+
+	// EventTypeHeader is the HTTP header that carries the type of the
+	// notification.
+	EventTypeHeader = "X-Tapd-Event"
+
+	// signaturePrefix is the prefix of the value of the signature header.
+	signaturePrefix = "sha256="
+)
+
+// EventType is the type of a webhook notification.
+type EventType string
+
+const (
+	// EventTypeSendState is the type of notifications that are sent when
+	// an outbound parcel is about to execute a new send state.
+	EventTypeSendState EventType = "send_state"
+
+	// EventTypeReceive is the type of notifications that are sent when an
+	// inbound transfer is detected, confirmed, its proof is received or it
+	// is completed.
+	EventTypeReceive EventType = "receive"
+
+	// EventTypeSupplyMismatch is the type of notifications that are sent
+	// when the local holdings of an asset are inconsistent with its
+	// universe supply.
+	EventTypeSupplyMismatch EventType = "supply_mismatch"
+
+	// EventTypeWatchedAssetSpent is the type of notifications that are
+	// sent when the anchor output of a watched asset is spent.
+	EventTypeWatchedAssetSpent EventType = "watched_asset_spent"
+
+	// EventTypeAnchorSpendAlert is the type of critical alerts that are
+	// sent when an anchor output of the local wallet is spent by an
+	// unknown transaction.
+	EventTypeAnchorSpendAlert EventType = "anchor_spend_alert"
+
+	// EventTypeProofScrubAlert is the type of alerts that are sent when
+	// the proof scrubber finds a missing, corrupted or invalid proof.
+	EventTypeProofScrubAlert EventType = "proof_scrub_alert"
+)
+
+// Cfg is the user facing config of the webhook notifier.
+type Cfg struct {
+	URLs []string `long:"url" description:"An HTTP(S) endpoint that is notified about outbound parcel state changes, inbound transfers, supply mismatches, spent watched assets, unknown spends of owned anchor outputs and damaged proofs with a JSON POST request. Can be specified multiple times."`
+
+	Secret string `long:"secret" description:"The secret the JSON payload of each notification is signed with. The hex encoded HMAC-SHA256 signature of the request body is sent in the X-Tapd-Signature header, prefixed with sha256=. If not set, notifications are not signed."`
+
+	MaxAttempts int `long:"maxattempts" description:"The number of times a notification is posted to an endpoint that doesn't respond with a 2xx status code before it is dropped."`
+
+	InitialBackoff time.Duration `long:"initialbackoff" description:"The time to wait before the first retry of a failed notification. The wait time doubles with each further retry."`
+
+	MaxBackoff time.Duration `long:"maxbackoff" description:"The maximum time to wait between two attempts of posting a notification."`
+
+	Timeout time.Duration `long:"timeout" description:"The timeout of a single attempt of posting a notification."`
+}
+
+// SendStateData is the payload of an EventTypeSendState notification.
+type SendStateData struct {
+	// ParcelID is the identifier of the parcel.
+	ParcelID uint64 `json:"parcel_id"`
+
+	// SeqNum is the sequence number of the send state event, which is
+	// strictly increasing across all parcels.
+	SeqNum uint64 `json:"seq_num"`
+
+	// SendState is the state that is about to be executed.
+	SendState string `json:"send_state"`
+}
+
+// ReceiveData is the payload of an EventTypeReceive notification.
+type ReceiveData struct {
+	// Address is the Taproot Asset address the transfer was received
+	// with.
+	Address string `json:"address"`
+
+	// AssetID is the hex encoded ID of the received asset.
+	AssetID string `json:"asset_id"`
+
+	// Amount is the asset amount the address requested.
+	Amount uint64 `json:"amount"`
+
+	// Outpoint is the on-chain outpoint of the transfer.
+	Outpoint string `json:"outpoint"`
+
+	// Status is the status of the transfer.
+	Status string `json:"status"`
+
+	// ConfirmationHeight is the block height the transfer was confirmed
+	// at, zero if it isn't confirmed yet.
+	ConfirmationHeight uint32 `json:"confirmation_height"`
+
+	// DepositStatus describes how the transfer compares to the deposit
+	// expectation of the address.
+	DepositStatus string `json:"deposit_status"`
+}
+
+// SupplyMismatchData is the payload of an EventTypeSupplyMismatch
+// notification.
+type SupplyMismatchData struct {
+	// UniverseID is the hex encoded identifier of the universe of the
+	// asset or asset group.
+	UniverseID string `json:"universe_id"`
+
+	// Reason describes the inconsistency.
+	Reason string `json:"reason"`
+
+	// LocalBalance is the total amount of the asset held locally.
+	LocalBalance uint64 `json:"local_balance"`
+
+	// UniverseSupply is the total supply reported by the universe root.
+	UniverseSupply uint64 `json:"universe_supply"`
+
+	// LeafSum is the sum of the amounts of all minting leaves of the
+	// universe.
+	LeafSum uint64 `json:"leaf_sum"`
+}
+
+// WatchedAssetData describes a single watched asset in an
+// EventTypeWatchedAssetSpent notification.
+type WatchedAssetData struct {
+	// ID is the ID of the watched asset.
+	ID int64 `json:"id"`
+
+	// Label is the user defined description of the watched asset.
+	Label string `json:"label"`
+
+	// AssetID is the hex encoded ID of the watched asset.
+	AssetID string `json:"asset_id"`
+
+	// Amount is the amount of the watched asset.
+	Amount uint64 `json:"amount"`
+}
+
+// WatchedAssetSpentData is the payload of an EventTypeWatchedAssetSpent
+// notification.
+type WatchedAssetSpentData struct {
+	// AnchorPoint is the spent anchor output.
+	AnchorPoint string `json:"anchor_point"`
+
+	// SpendingTxid is the ID of the transaction that spent the anchor
+	// output.
+	SpendingTxid string `json:"spending_txid"`
+
+	// SpendHeight is the height of the block the spending transaction
+	// confirmed in.
+	SpendHeight uint32 `json:"spend_height"`
+
+	// Assets are the watched assets that were anchored at the output.
+	Assets []WatchedAssetData `json:"assets"`
+}
+
+// AtRiskAssetData describes a single asset in an EventTypeAnchorSpendAlert
+// notification.
+type AtRiskAssetData struct {
+	// AssetID is the hex encoded ID of the asset.
+	AssetID string `json:"asset_id"`
+
+	// ScriptKey is the hex encoded script key of the asset.
+	ScriptKey string `json:"script_key"`
+
+	// Amount is the amount of the asset.
+	Amount uint64 `json:"amount"`
+}
+
+// AnchorSpendAlertData is the payload of an EventTypeAnchorSpendAlert
+// notification.
+type AnchorSpendAlertData struct {
+	// AnchorPoint is the spent anchor output.
+	AnchorPoint string `json:"anchor_point"`
+
+	// SpendingTxid is the ID of the unknown transaction that spent the
+	// anchor output.
+	SpendingTxid string `json:"spending_txid"`
+
+	// SpendHeight is the height of the block the spending transaction
+	// confirmed in.
+	SpendHeight uint32 `json:"spend_height"`
+
+	// Assets are the assets anchored at the output that are now at risk.
+	Assets []AtRiskAssetData `json:"assets"`
+}
+
+// ProofScrubAlertData is the payload of an EventTypeProofScrubAlert
+// notification.
+type ProofScrubAlertData struct {
+	// Archive is the name of the archive the damaged proof was fetched
+	// from. It is empty if the proof failed the end-to-end verification.
+	Archive string `json:"archive,omitempty"`
+
+	// AssetID is the ID of the asset the proof belongs to.
+	AssetID string `json:"asset_id,omitempty"`
+
+	// ScriptKey is the hex encoded script key of the asset.
+	ScriptKey string `json:"script_key"`
+
+	// Failure describes how the proof is damaged, which is either
+	// missing, corrupted or invalid.
+	Failure string `json:"failure"`
+
+	// Error is the error the proof failed with.
+	Error string `json:"error"`
+}
+
+// Notification is the JSON payload that is posted to the webhook endpoints.
+type Notification struct {
+	// ID is the identifier of the notification, which is unique and
+	// increasing for the lifetime of the daemon. Retries of a notification
+	// carry the same ID, so receivers can de-duplicate them.
+	ID uint64 `json:"id"`
+
+	// Type is the type of the notification.
+	Type EventType `json:"type"`
+
+	// Timestamp is the RFC 3339 formatted time the event was created at,
+	// in UTC.
+	Timestamp string `json:"timestamp"`
+
+	// SendState is set for EventTypeSendState notifications.
+	SendState *SendStateData `json:"send_state,omitempty"`
+
+	// Receive is set for EventTypeReceive notifications.
+	Receive *ReceiveData `json:"receive,omitempty"`
+
+	// SupplyMismatch is set for EventTypeSupplyMismatch notifications.
+	SupplyMismatch *SupplyMismatchData `json:"supply_mismatch,omitempty"`
+
+	// WatchedAssetSpent is set for EventTypeWatchedAssetSpent
+	// notifications.
+	WatchedAssetSpent *WatchedAssetSpentData `json:"watched_asset_spent,omitempty"`
+
+	// AnchorSpendAlert is set for EventTypeAnchorSpendAlert notifications.
+	AnchorSpendAlert *AnchorSpendAlertData `json:"anchor_spend_alert,omitempty"`
+
+	// ProofScrubAlert is set for EventTypeProofScrubAlert notifications.
+	ProofScrubAlert *ProofScrubAlertData `json:"proof_scrub_alert,omitempty"`
+}
+
+// Sign returns the value of the signature header for the given request body.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature returns true if the given signature header value is a valid
+// signature of the request body with the given secret.
+func VerifySignature(secret, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// NotifierConfig houses everything the webhook notifier needs to carry out
+// its duties.
+type NotifierConfig struct {
+	*Cfg
+
+	// SendEvents publishes the events of outbound parcels.
+	SendEvents fn.EventPublisher[fn.Event, bool]
+
+	// ReceiveEvents publishes the events of inbound transfers.
+	ReceiveEvents fn.EventPublisher[fn.Event, bool]
+
+	// SupplyEvents publishes the supply mismatches found when reconciling
+	// the local holdings with the universe supply. This is optional.
+	SupplyEvents fn.EventPublisher[fn.Event, bool]
+
+	// WatchEvents publishes the spends of the anchor outputs of watched
+	// assets. This is optional.
+	WatchEvents fn.EventPublisher[fn.Event, bool]
+
+	// AnchorEvents publishes the spends of anchor outputs of the local
+	// wallet by unknown transactions. This is optional.
+	AnchorEvents fn.EventPublisher[fn.Event, bool]
+
+	// ScrubEvents publishes the damaged proofs found by the proof
+	// scrubber. This is optional.
+	ScrubEvents fn.EventPublisher[fn.Event, bool]
+
+	// Client is the HTTP client used to post notifications. If nil, a
+	// client with the configured timeout is used.
+	Client *http.Client
+}
+
+// endpoint is a single webhook endpoint with its queue of pending
+// notifications. Notifications are posted to an endpoint in order.
+type endpoint struct {
+	url string
+
+	queue *fn.ConcurrentQueue[*delivery]
+}
+
+// delivery is an encoded notification that is pending delivery.
+type delivery struct {
+	id uint64
+
+	eventType EventType
+
+	body []byte
+}
+
+// Notifier posts signed JSON notifications about outbound parcel state
+// changes, inbound transfers, supply mismatches, spent watched assets,
+// unknown spends of owned anchor outputs and damaged proofs to the configured
+// webhook endpoints, retrying failed attempts with an exponential backoff.
+type Notifier struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *NotifierConfig
+
+	client *http.Client
+
+	endpoints []*endpoint
+
+	sendSub *fn.EventReceiver[fn.Event]
+
+	receiveSub *fn.EventReceiver[fn.Event]
+
+	supplySub *fn.EventReceiver[fn.Event]
+
+	watchSub *fn.EventReceiver[fn.Event]
+
+	anchorSub *fn.EventReceiver[fn.Event]
+
+	scrubSub *fn.EventReceiver[fn.Event]
+
+	// nextID is the ID of the most recently created notification.
+	nextID atomic.Uint64
+
+	*fn.ContextGuard
+}
+
+// NewNotifier creates a new webhook notifier from the given config.
+func NewNotifier(cfg *NotifierConfig) *Notifier {
+	client := cfg.Client
+	if client == nil {
+		timeout := cfg.Timeout
+		if timeout == 0 {
+			timeout = DefaultTimeout
+		}
+		client = &http.Client{
+			Timeout: timeout,
+		}
+	}
+
+	endpoints := make([]*endpoint, len(cfg.URLs))
+	for idx, url := range cfg.URLs {
+		endpoints[idx] = &endpoint{
+			url: url,
+			queue: fn.NewConcurrentQueue[*delivery](
+				fn.DefaultQueueSize,
+			),
+		}
+	}
+
+	return &Notifier{
+		cfg:        cfg,
+		client:     client,
+		endpoints:  endpoints,
+		sendSub:    fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize),
+		receiveSub: fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize),
+		supplySub:  fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize),
+		watchSub:   fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize),
+		anchorSub:  fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize),
+		scrubSub:   fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize),
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start subscribes to the configured event publishers and starts posting
+// notifications. If no endpoint is configured, this is a no-op.
+func (n *Notifier) Start() error {
+	var startErr error
+	n.startOnce.Do(func() {
+		if len(n.endpoints) == 0 {
+			return
+		}
+
+		log.Infof("Starting webhook notifier for %d endpoints",
+			len(n.endpoints))
+
+		for _, e := range n.endpoints {
+			e.queue.Start()
+
+			n.Wg.Add(1)
+			go n.deliverNotifications(e)
+		}
+
+		n.Wg.Add(1)
+		go n.watchEvents()
+
+		if n.cfg.SendEvents != nil {
+			err := n.cfg.SendEvents.RegisterSubscriber(
+				n.sendSub, false, false,
+			)
+			if err != nil {
+				startErr = err
+				return
+			}
+		}
+
+		if n.cfg.ReceiveEvents != nil {
+			err := n.cfg.ReceiveEvents.RegisterSubscriber(
+				n.receiveSub, false, false,
+			)
+			if err != nil {
+				startErr = err
+				return
+			}
+		}
+
+		if n.cfg.SupplyEvents != nil {
+			err := n.cfg.SupplyEvents.RegisterSubscriber(
+				n.supplySub, false, false,
+			)
+			if err != nil {
+				startErr = err
+				return
+			}
+		}
+
+		if n.cfg.WatchEvents != nil {
+			err := n.cfg.WatchEvents.RegisterSubscriber(
+				n.watchSub, false, false,
+			)
+			if err != nil {
+				startErr = err
+				return
+			}
+		}
+
+		if n.cfg.AnchorEvents != nil {
+			err := n.cfg.AnchorEvents.RegisterSubscriber(
+				n.anchorSub, false, false,
+			)
+			if err != nil {
+				startErr = err
+				return
+			}
+		}
+
+		if n.cfg.ScrubEvents != nil {
+			err := n.cfg.ScrubEvents.RegisterSubscriber(
+				n.scrubSub, false, false,
+			)
+			if err != nil {
+				startErr = err
+				return
+			}
+		}
+	})
+
+	return startErr
+}
+
+// Stop signals the notifier to stop posting notifications. Notifications that
+// weren't delivered yet are dropped.
+func (n *Notifier) Stop() error {
+	var stopErr error
+	n.stopOnce.Do(func() {
+		if len(n.endpoints) == 0 {
+			return
+		}
+
+		close(n.Quit)
+		n.Wg.Wait()
+
+		for _, e := range n.endpoints {
+			e.queue.Stop()
+		}
+
+		if n.cfg.SendEvents != nil {
+			err := n.cfg.SendEvents.RemoveSubscriber(n.sendSub)
+			if err != nil {
+				stopErr = err
+			}
+		}
+
+		if n.cfg.ReceiveEvents != nil {
+			err := n.cfg.ReceiveEvents.RemoveSubscriber(
+				n.receiveSub,
+			)
+			if err != nil {
+				stopErr = err
+			}
+		}
+
+		if n.cfg.SupplyEvents != nil {
+			err := n.cfg.SupplyEvents.RemoveSubscriber(n.supplySub)
+			if err != nil {
+				stopErr = err
+			}
+		}
+
+		if n.cfg.WatchEvents != nil {
+			err := n.cfg.WatchEvents.RemoveSubscriber(n.watchSub)
+			if err != nil {
+				stopErr = err
+			}
+		}
+
+		if n.cfg.AnchorEvents != nil {
+			err := n.cfg.AnchorEvents.RemoveSubscriber(n.anchorSub)
+			if err != nil {
+				stopErr = err
+			}
+		}
+
+		if n.cfg.ScrubEvents != nil {
+			err := n.cfg.ScrubEvents.RemoveSubscriber(n.scrubSub)
+			if err != nil {
+				stopErr = err
+			}
+		}
+	})
+
+	return stopErr
+}
+
+// watchEvents turns the events of the subscriptions into notifications and
+// queues them for delivery to all endpoints.
+func (n *Notifier) watchEvents() {
+	defer n.Wg.Done()
+
+	for {
+		var event fn.Event
+		select {
+		case event = <-n.sendSub.NewItemCreated.ChanOut():
+		case event = <-n.receiveSub.NewItemCreated.ChanOut():
+		case event = <-n.supplySub.NewItemCreated.ChanOut():
+		case event = <-n.watchSub.NewItemCreated.ChanOut():
+		case event = <-n.anchorSub.NewItemCreated.ChanOut():
+		case event = <-n.scrubSub.NewItemCreated.ChanOut():
+		case <-n.Quit:
+			return
+		}
+
+		event, numDropped := fn.UnwrapDroppedHistory(event)
+		if numDropped > 0 {
+			log.Warnf("Missed %d events, no notifications were "+
+				"sent for them", numDropped)
+		}
+
+		notification, err := n.newNotification(event)
+		if err != nil {
+			log.Errorf("Unable to create webhook notification: %v",
+				err)
+			continue
+		}
+
+		// Not all events of the subscriptions are of interest.
+		if notification == nil {
+			continue
+		}
+
+		body, err := json.Marshal(notification)
+		if err != nil {
+			log.Errorf("Unable to encode webhook notification: %v",
+				err)
+			continue
+		}
+
+		for _, e := range n.endpoints {
+			d := &delivery{
+				id:        notification.ID,
+				eventType: notification.Type,
+				body:      body,
+			}
+			if !fn.SendOrQuit(e.queue.ChanIn(), d, n.Quit) {
+				return
+			}
+		}
+	}
+}
+
+// newNotification creates the notification of the given event. Nil is
+// returned if no notification should be sent for the event.
+func (n *Notifier) newNotification(event fn.Event) (*Notification, error) {
+	notification := &Notification{
+		Timestamp: event.Timestamp().UTC().Format(time.RFC3339Nano),
+	}
+
+	switch e := event.(type) {
+	case *tapfreighter.ExecuteSendStateEvent:
+		notification.Type = EventTypeSendState
+		notification.SendState = &SendStateData{
+			ParcelID:  e.ParcelID,
+			SeqNum:    e.SeqNum,
+			SendState: e.SendState.String(),
+		}
+
+	case *tapgarden.AssetReceiveEvent:
+		addr, err := e.Event.Addr.EncodeAddress()
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode address: %w",
+				err)
+		}
+
+		notification.Type = EventTypeReceive
+		notification.Receive = &ReceiveData{
+			Address:            addr,
+			AssetID:            e.Event.Addr.AssetID.String(),
+			Amount:             e.Event.Addr.Amount,
+			Outpoint:           e.Event.Outpoint.String(),
+			Status:             e.Event.Status.String(),
+			ConfirmationHeight: e.Event.ConfirmationHeight,
+			DepositStatus:      e.Event.DepositStatus.String(),
+		}
+
+	case *universe.SupplyMismatchEvent:
+		notification.Type = EventTypeSupplyMismatch
+		notification.SupplyMismatch = &SupplyMismatchData{
+			UniverseID:     e.ID.String(),
+			Reason:         e.Reason.String(),
+			LocalBalance:   e.LocalBalance,
+			UniverseSupply: e.UniverseSupply,
+			LeafSum:        e.LeafSum,
+		}
+
+	case *tapgarden.WatchedAssetSpentEvent:
+		assets := make([]WatchedAssetData, len(e.Assets))
+		for idx, watched := range e.Assets {
+			assets[idx] = WatchedAssetData{
+				ID:      watched.ID,
+				Label:   watched.Label,
+				AssetID: watched.AssetID.String(),
+				Amount:  watched.Amount,
+			}
+		}
+
+		notification.Type = EventTypeWatchedAssetSpent
+		notification.WatchedAssetSpent = &WatchedAssetSpentData{
+			AnchorPoint:  e.AnchorPoint.String(),
+			SpendingTxid: e.SpendingTxid.String(),
+			SpendHeight:  e.SpendHeight,
+			Assets:       assets,
+		}
+
+	case *tapgarden.AnchorSpendAlertEvent:
+		assets := make([]AtRiskAssetData, len(e.Assets))
+		for idx, atRisk := range e.Assets {
+			scriptKey := atRisk.ScriptKey.SerializeCompressed()
+			assets[idx] = AtRiskAssetData{
+				AssetID:   atRisk.AssetID.String(),
+				ScriptKey: hex.EncodeToString(scriptKey),
+				Amount:    atRisk.Amount,
+			}
+		}
+
+		notification.Type = EventTypeAnchorSpendAlert
+		notification.AnchorSpendAlert = &AnchorSpendAlertData{
+			AnchorPoint:  e.AnchorPoint.String(),
+			SpendingTxid: e.SpendingTxid.String(),
+			SpendHeight:  e.SpendHeight,
+			Assets:       assets,
+		}
+
+	case *proof.ProofScrubEvent:
+		var assetID string
+		if e.Locator.AssetID != nil {
+			assetID = e.Locator.AssetID.String()
+		}
+		scriptKey := e.Locator.ScriptKey.SerializeCompressed()
+
+		notification.Type = EventTypeProofScrubAlert
+		notification.ProofScrubAlert = &ProofScrubAlertData{
+			Archive:   e.Archive,
+			AssetID:   assetID,
+			ScriptKey: hex.EncodeToString(scriptKey),
+			Failure:   e.Failure.String(),
+			Error:     e.Err.Error(),
+		}
+
+	default:
+		return nil, nil
+	}
+
+	notification.ID = n.nextID.Add(1)
+
+	return notification, nil
+}
+
+// deliverNotifications posts the queued notifications to the given endpoint
+// in order, retrying each one until it is delivered or the maximum number of
+// attempts is reached.
+func (n *Notifier) deliverNotifications(e *endpoint) {
+	defer n.Wg.Done()
+
+	maxAttempts := n.cfg.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+	initialBackoff := n.cfg.InitialBackoff
+	if initialBackoff == 0 {
+		initialBackoff = DefaultInitialBackoff
+	}
+	maxBackoff := n.cfg.MaxBackoff
+	if maxBackoff == 0 {
+		maxBackoff = DefaultMaxBackoff
+	}
+
+	for {
+		var d *delivery
+		select {
+		case d = <-e.queue.ChanOut():
+		case <-n.Quit:
+			return
+		}
+
+		backoff := initialBackoff
+		for attempt := 1; ; attempt++ {
+			err := n.post(e.url, d)
+			if err == nil {
+				log.Debugf("Delivered webhook notification %d "+
+					"to %v", d.id, e.url)
+				break
+			}
+
+			if attempt >= maxAttempts {
+				log.Errorf("Dropping webhook notification %d "+
+					"to %v after %d attempts: %v", d.id,
+					e.url, attempt, err)
+				break
+			}
+
+			log.Warnf("Unable to deliver webhook notification %d "+
+				"to %v (attempt %d), retrying in %v: %v", d.id,
+				e.url, attempt, backoff, err)
+
+			select {
+			case <-time.After(backoff):
+			case <-n.Quit:
+				return
+			}
+
+			backoff *= 2
+			if backoff > maxBackoff {
+				backoff = maxBackoff
+			}
+		}
+	}
+}
+
+// post makes a single attempt of posting the given notification to the given
+// endpoint URL.
+func (n *Notifier) post(url string, d *delivery) error {
+	ctx, cancel := n.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, url, bytes.NewReader(d.body),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventTypeHeader, string(d.eventType))
+	if n.cfg.Secret != "" {
+		req.Header.Set(
+			SignatureHeader, Sign([]byte(n.cfg.Secret), d.body),
+		)
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// We drain the body, so the connection can be re-used.
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
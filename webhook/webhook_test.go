@@ -0,0 +1,302 @@
+package webhook
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/stretchr/testify/require"
+)
+
+// mockPublisher is a minimal event publisher that hands events to a single
+// subscriber.
+type mockPublisher struct {
+	sync.Mutex
+
+	sub *fn.EventReceiver[fn.Event]
+}
+
+func (m *mockPublisher) RegisterSubscriber(
+	receiver *fn.EventReceiver[fn.Event], _ bool, _ bool) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.sub = receiver
+
+	return nil
+}
+
+func (m *mockPublisher) RemoveSubscriber(
+	subscriber *fn.EventReceiver[fn.Event]) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	subscriber.Stop()
+	m.sub = nil
+
+	return nil
+}
+
+func (m *mockPublisher) publish(event fn.Event) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.sub.NewItemCreated.ChanIn() <- event
+}
+
+// defaultTimeout is the time to wait for a notification to be posted.
+const defaultTimeout = time.Second * 5
+
+// request is a request received by the test endpoint.
+type request struct {
+	eventType string
+
+	signature string
+
+	body []byte
+}
+
+// TestNotifier tests that events are posted as signed notifications and that
+// failed attempts are retried.
+func TestNotifier(t *testing.T) {
+	t.Parallel()
+
+	const secret = "hunter2"
+
+	var (
+		requests = make(chan request, 10)
+		failures = 1
+		mtx      sync.Mutex
+	)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+
+			requests <- request{
+				eventType: r.Header.Get(EventTypeHeader),
+				signature: r.Header.Get(SignatureHeader),
+				body:      body,
+			}
+
+			// The first attempt fails, so it needs to be retried.
+			mtx.Lock()
+			defer mtx.Unlock()
+			if failures > 0 {
+				failures--
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		},
+	))
+	defer server.Close()
+
+	sendEvents := &mockPublisher{}
+	receiveEvents := &mockPublisher{}
+	supplyEvents := &mockPublisher{}
+	watchEvents := &mockPublisher{}
+	anchorEvents := &mockPublisher{}
+	scrubEvents := &mockPublisher{}
+	notifier := NewNotifier(&NotifierConfig{
+		Cfg: &Cfg{
+			URLs:           []string{server.URL},
+			Secret:         secret,
+			InitialBackoff: time.Millisecond,
+		},
+		SendEvents:    sendEvents,
+		ReceiveEvents: receiveEvents,
+		SupplyEvents:  supplyEvents,
+		WatchEvents:   watchEvents,
+		AnchorEvents:  anchorEvents,
+		ScrubEvents:   scrubEvents,
+	})
+	require.NoError(t, notifier.Start())
+	defer func() {
+		require.NoError(t, notifier.Stop())
+	}()
+
+	readNotification := func() (*request, *Notification) {
+		req, err := fn.RecvOrTimeout(requests, defaultTimeout)
+		require.NoError(t, err)
+		require.True(t, VerifySignature(
+			[]byte(secret), req.body, req.signature,
+		))
+
+		var n Notification
+		require.NoError(t, json.Unmarshal(req.body, &n))
+		require.Equal(t, req.eventType, string(n.Type))
+
+		return req, &n
+	}
+
+	sendEvents.publish(tapfreighter.NewExecuteSendStateEvent(
+		7, 3, tapfreighter.SendStateAnchorSign,
+	))
+
+	// The first attempt is rejected, so the same notification is posted
+	// again.
+	firstReq, first := readNotification()
+	retryReq, retry := readNotification()
+	require.Equal(t, firstReq.body, retryReq.body)
+	require.Equal(t, first, retry)
+	require.Equal(t, EventTypeSendState, first.Type)
+	require.Equal(t, &SendStateData{
+		ParcelID:  3,
+		SeqNum:    7,
+		SendState: tapfreighter.SendStateAnchorSign.String(),
+	}, first.SendState)
+
+	// A receive event is delivered after the send event.
+	addr, _, _ := address.RandAddr(t, &address.RegressionNetTap)
+	receiveEvents.publish(tapgarden.NewAssetReceiveEvent(&address.Event{
+		Addr:     addr,
+		Status:   address.StatusProofReceived,
+		Outpoint: test.RandOp(t),
+	}))
+
+	_, received := readNotification()
+	require.Equal(t, EventTypeReceive, received.Type)
+	require.Greater(t, received.ID, first.ID)
+
+	addrStr, err := addr.EncodeAddress()
+	require.NoError(t, err)
+	require.Equal(t, addrStr, received.Receive.Address)
+	require.Equal(t, "proof_received", received.Receive.Status)
+
+	// Supply mismatches are posted as alerts.
+	uniID := universe.Identifier{AssetID: asset.RandID(t)}
+	supplyEvents.publish(universe.NewSupplyMismatchEvent(
+		uniID, universe.MismatchHoldingsExceedSupply, 11, 10, 10,
+	))
+
+	_, mismatch := readNotification()
+	require.Equal(t, EventTypeSupplyMismatch, mismatch.Type)
+	require.Equal(t, &SupplyMismatchData{
+		UniverseID:     uniID.String(),
+		Reason:         "holdings_exceed_supply",
+		LocalBalance:   11,
+		UniverseSupply: 10,
+		LeafSum:        10,
+	}, mismatch.SupplyMismatch)
+
+	// Spends of watched assets are posted as alerts.
+	anchorPoint := test.RandOp(t)
+	spendingTxid := test.RandHash()
+	watched := &tapgarden.WatchedAsset{
+		ID:      3,
+		Label:   "collateral",
+		AssetID: asset.RandID(t),
+		Amount:  500,
+	}
+	watchEvents.publish(tapgarden.NewWatchedAssetSpentEvent(
+		anchorPoint, spendingTxid, 123, []*tapgarden.WatchedAsset{
+			watched,
+		},
+	))
+
+	_, spent := readNotification()
+	require.Equal(t, EventTypeWatchedAssetSpent, spent.Type)
+	require.Equal(t, &WatchedAssetSpentData{
+		AnchorPoint:  anchorPoint.String(),
+		SpendingTxid: spendingTxid.String(),
+		SpendHeight:  123,
+		Assets: []WatchedAssetData{{
+			ID:      3,
+			Label:   "collateral",
+			AssetID: watched.AssetID.String(),
+			Amount:  500,
+		}},
+	}, spent.WatchedAssetSpent)
+
+	// Unknown spends of owned anchor outputs are posted as critical
+	// alerts.
+	atRisk := &tapgarden.AtRiskAsset{
+		AnchorPoint:  anchorPoint,
+		AssetID:      asset.RandID(t),
+		ScriptKey:    test.RandPubKey(t),
+		Amount:       700,
+		SpendingTxid: spendingTxid,
+		SpendHeight:  124,
+	}
+	anchorEvents.publish(tapgarden.NewAnchorSpendAlertEvent(
+		anchorPoint, spendingTxid, 124, []*tapgarden.AtRiskAsset{
+			atRisk,
+		},
+	))
+
+	_, alert := readNotification()
+	require.Equal(t, EventTypeAnchorSpendAlert, alert.Type)
+	require.Equal(t, &AnchorSpendAlertData{
+		AnchorPoint:  anchorPoint.String(),
+		SpendingTxid: spendingTxid.String(),
+		SpendHeight:  124,
+		Assets: []AtRiskAssetData{{
+			AssetID: atRisk.AssetID.String(),
+			ScriptKey: hex.EncodeToString(
+				atRisk.ScriptKey.SerializeCompressed(),
+			),
+			Amount: 700,
+		}},
+	}, alert.AnchorSpendAlert)
+
+	// Damaged proofs found by the scrubber are posted as alerts too.
+	damagedID := asset.RandID(t)
+	damaged := proof.Locator{
+		AssetID:   &damagedID,
+		ScriptKey: *test.RandPubKey(t),
+	}
+	scrubEvents.publish(proof.NewProofScrubEvent(
+		"disk", damaged, proof.ScrubProofCorrupted,
+		io.ErrUnexpectedEOF,
+	))
+
+	_, scrubAlert := readNotification()
+	require.Equal(t, EventTypeProofScrubAlert, scrubAlert.Type)
+	require.Equal(t, &ProofScrubAlertData{
+		Archive: "disk",
+		AssetID: damagedID.String(),
+		ScriptKey: hex.EncodeToString(
+			damaged.ScriptKey.SerializeCompressed(),
+		),
+		Failure: "corrupted",
+		Error:   "unexpected EOF",
+	}, scrubAlert.ProofScrubAlert)
+
+	// Events that aren't of interest aren't posted.
+	sendEvents.publish(&proof.ReceiverProofBackoffWaitEvent{})
+	select {
+	case <-requests:
+		t.Fatalf("unexpected notification")
+
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// TestVerifySignature tests that only signatures of the exact body with the
+// same secret are valid.
+func TestVerifySignature(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"id":1}`)
+	signature := Sign([]byte("secret"), body)
+
+	require.True(t, VerifySignature([]byte("secret"), body, signature))
+	require.False(t, VerifySignature([]byte("other"), body, signature))
+	require.False(t, VerifySignature(
+		[]byte("secret"), []byte(`{"id":2}`), signature,
+	))
+}